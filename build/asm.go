@@ -0,0 +1,209 @@
+package build
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler"
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+	"golang.org/x/tools/go/buildutil"
+)
+
+// substituteAssembly provides implementations for functions of a non-standard
+// package that are declared without a body in files, because they are
+// implemented in assembly, which GopherJS doesn't support.
+//
+// Such packages usually have pure Go implementations for architectures without
+// assembly in files that the build constraints exclude for the architectures
+// with it, or unless a tag like purego or noasm is set. If a file ignored for
+// this build implements some of the functions and doesn't conflict with the
+// rest of the package, it's added to files in place of their declarations.
+// Functions left without an implementation are reported as errors rather than
+// failing at run time.
+func substituteAssembly(bctx *build.Context, pkg *build.Package, files []*ast.File, fileSet *token.FileSet) ([]*ast.File, error) {
+	if pkg.Goroot || !hasAssembly(pkg) {
+		return files, nil
+	}
+
+	declared := make(map[string]bool)
+	missing := make(map[string]*ast.FuncDecl)
+	var order []string
+	for _, file := range files {
+		linknamed := linknamedFuncs(file)
+		for _, decl := range file.Decls {
+			for _, name := range declNames(decl) {
+				declared[name] = true
+			}
+			if d, ok := decl.(*ast.FuncDecl); ok && d.Body == nil && d.Name.Name != "_" && !linknamed[d.Name.Name] {
+				k := astutil.FuncKey(d)
+				missing[k] = d
+				order = append(order, k)
+			}
+		}
+	}
+
+	for _, name := range fallbackCandidates(pkg) {
+		if len(missing) == 0 {
+			break
+		}
+		r, err := buildutil.OpenFile(bctx, filepath.Join(pkg.Dir, name))
+		if err != nil {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, filepath.Join(pkg.Dir, name), r, parser.ParseComments)
+		r.Close()
+		if err != nil || file.Name.Name != pkg.Name {
+			continue
+		}
+		provided := fallbackFuncs(file, missing, declared)
+		if len(provided) == 0 {
+			continue
+		}
+		for _, k := range provided {
+			missing[k].Name = ast.NewIdent("_")
+			delete(missing, k)
+		}
+		for _, decl := range file.Decls {
+			for _, name := range declNames(decl) {
+				declared[name] = true
+			}
+		}
+		files = append(files, file)
+	}
+
+	var errList compiler.ErrorList
+	for _, k := range order {
+		if d, ok := missing[k]; ok {
+			errList = append(errList, &scanner.Error{
+				Pos: fileSet.Position(d.Pos()),
+				Msg: fmt.Sprintf("%s.%s is implemented in assembly, which GopherJS doesn't support, and the package has no pure Go implementation of it", pkg.ImportPath, k),
+			})
+		}
+	}
+	if errList != nil {
+		return nil, errList
+	}
+	return files, nil
+}
+
+func hasAssembly(pkg *build.Package) bool {
+	if len(pkg.SFiles) > 0 {
+		return true
+	}
+	for _, name := range pkg.IgnoredOtherFiles {
+		if strings.HasSuffix(name, ".s") {
+			return true
+		}
+	}
+	return false
+}
+
+// linknamedFuncs returns the names of functions that file pulls in from other
+// packages with //go:linkname directives, which don't have a body either.
+func linknamedFuncs(file *ast.File) map[string]bool {
+	names := make(map[string]bool)
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			if fields := strings.Fields(c.Text); len(fields) == 3 && fields[0] == "//go:linkname" {
+				names[fields[1]] = true
+			}
+		}
+	}
+	return names
+}
+
+// declNames returns the keys of the package-level declarations of decl, see
+// astutil.FuncKey for functions and methods.
+func declNames(decl ast.Decl) []string {
+	var names []string
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if k := astutil.FuncKey(d); d.Name.Name != "_" && k != "init" {
+			names = append(names, k)
+		}
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, name := range s.Names {
+					if name.Name != "_" {
+						names = append(names, name.Name)
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// fallbackCandidates returns the Go files of pkg excluded from this build that
+// may contain pure Go implementations of its assembly functions, files with
+// purego, noasm or generic in their name first.
+func fallbackCandidates(pkg *build.Package) []string {
+	var names []string
+	for _, name := range pkg.IgnoredGoFiles {
+		if !strings.HasSuffix(name, "_test.go") {
+			names = append(names, name)
+		}
+	}
+	rank := func(name string) int {
+		for _, hint := range []string{"purego", "noasm", "generic"} {
+			if strings.Contains(name, hint) {
+				return 0
+			}
+		}
+		return 1
+	}
+	sort.SliceStable(names, func(i, j int) bool { return rank(names[i]) < rank(names[j]) })
+	return names
+}
+
+// fallbackFuncs returns the keys of the missing functions that file implements,
+// or none if it can't be added to the package: if it declares functions
+// without a body itself, uses cgo, is excluded from all builds with the ignore
+// tag, or declares anything else that the package already does.
+func fallbackFuncs(file *ast.File, missing map[string]*ast.FuncDecl, declared map[string]bool) []string {
+	for _, imp := range file.Imports {
+		if imp.Path.Value == `"C"` {
+			return nil
+		}
+	}
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			break
+		}
+		for _, c := range group.List {
+			if text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//")); strings.HasPrefix(text, "+build ") || strings.HasPrefix(c.Text, "//go:build ") {
+				for _, f := range strings.FieldsFunc(text, func(r rune) bool { return strings.ContainsRune(" ,()&|", r) }) {
+					if f == "ignore" {
+						return nil
+					}
+				}
+			}
+		}
+	}
+
+	var provided []string
+	for _, decl := range file.Decls {
+		if d, ok := decl.(*ast.FuncDecl); ok && d.Body == nil {
+			return nil
+		}
+		for _, name := range declNames(decl) {
+			if _, ok := missing[name]; ok {
+				provided = append(provided, name)
+			} else if declared[name] {
+				return nil
+			}
+		}
+	}
+	return provided
+}
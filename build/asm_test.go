@@ -0,0 +1,83 @@
+package build
+
+import (
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSubstituteAssembly(t *testing.T) {
+	const decl = "package p\n\nfunc Add(a, b int) int { return add(a, b) }\n\n//go:noescape\nfunc add(a, b int) int\n"
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{{
+		name: "fallback",
+		files: map[string]string{
+			"add_noasm.go": "// +build noasm\n\npackage p\n\nfunc add(a, b int) int { return a + b }\n",
+		},
+	}, {
+		name:    "no fallback",
+		wantErr: ".add is implemented in assembly",
+	}, {
+		name: "conflicting fallback",
+		files: map[string]string{
+			"add_noasm.go": "// +build noasm\n\npackage p\n\nfunc Add(a, b int) int { return a + b }\n\nfunc add(a, b int) int { return a + b }\n",
+		},
+		wantErr: ".add is implemented in assembly",
+	}, {
+		name: "ignored fallback",
+		files: map[string]string{
+			"gen.go": "// +build ignore\n\npackage p\n\nfunc add(a, b int) int { return a + b }\n",
+		},
+		wantErr: ".add is implemented in assembly",
+	}, {
+		name: "linkname",
+		files: map[string]string{
+			"decl.go": "package p\n\nimport _ \"unsafe\"\n\n//go:linkname add q.add\nfunc add(a, b int) int\n",
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "gopherjs-asm")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			files := map[string]string{"decl.go": decl, "add_amd64.s": "TEXT ·add(SB),4,$0\n\tRET\n"}
+			for name, src := range test.files {
+				files[name] = src
+			}
+			for name, src := range files {
+				if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			bctx := NewBuildContext("", nil)
+			pkg, err := bctx.ImportDir(dir, 0)
+			if err != nil {
+				t.Fatalf("ImportDir returned error: %s", err)
+			}
+			parsed, err := parseAndAugment(bctx, pkg, false, token.NewFileSet())
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("parseAndAugment returned error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseAndAugment returned error: %s", err)
+			}
+			if _, ok := test.files["add_noasm.go"]; ok {
+				if body := findFuncBody(parsed, "add"); body == nil {
+					t.Errorf("add has no body after substitution")
+				}
+			}
+		})
+	}
+}
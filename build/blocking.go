@@ -0,0 +1,100 @@
+package build
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// BlockingCall is a step of the chain of calls that makes a function
+// blocking, see BlockingChain.
+type BlockingCall struct {
+	// Func is the full name of the function, like "(*net/http.Client).Get".
+	Func string
+	// Reason describes the operation that makes Func blocking, with its
+	// position, like "receives from a channel at x.go:12:3".
+	Reason string
+}
+
+// BlockingChain returns the chain of calls that makes the function fullName
+// of the packages pkgs blocking: each function of the chain is blocking
+// because it calls the next one, and the last one because of an operation
+// that may block, like a channel receive. It returns nil if the function isn't
+// blocking.
+func BlockingChain(pkgs []*compiler.Archive, fullName string) []BlockingCall {
+	return blockingChain(funcDecls(pkgs), fullName)
+}
+
+// funcDecls returns the declarations of functions and methods of the packages
+// pkgs by full name.
+func funcDecls(pkgs []*compiler.Archive) map[string]*compiler.Decl {
+	byName := map[string]*compiler.Decl{}
+	for _, a := range pkgs {
+		for _, d := range a.Declarations {
+			if d.FullName != "" {
+				byName[d.FullName] = d
+			}
+		}
+	}
+	return byName
+}
+
+func blockingChain(byName map[string]*compiler.Decl, fullName string) []BlockingCall {
+	var chain []BlockingCall
+	seen := map[string]bool{}
+	for name := fullName; name != "" && !seen[name]; {
+		seen[name] = true
+		d := byName[name]
+		if d == nil || !d.Blocking {
+			break
+		}
+		chain = append(chain, BlockingCall{Func: name, Reason: d.BlockingReason})
+		name = d.BlockingCallee
+	}
+	return chain
+}
+
+// isStandardImportPath reports whether importPath is likely to be the path of
+// a standard library package, which has no dot in its first element.
+func isStandardImportPath(importPath string) bool {
+	first := strings.SplitN(importPath, "/", 2)[0]
+	return !strings.Contains(first, ".")
+}
+
+// WriteBlockingReport writes the blocking functions of the program of the
+// packages pkgs, as returned by compiler.ImportDependencies, to w, each with
+// the chain of calls that makes it blocking. If filter is nil, the functions
+// of the main package and of packages outside of the standard library are
+// written, otherwise those whose full name matches filter.
+func WriteBlockingReport(w io.Writer, pkgs []*compiler.Archive, filter *regexp.Regexp) error {
+	byName := funcDecls(pkgs)
+	mainPkg := pkgs[len(pkgs)-1]
+
+	p := &printer{w: w}
+	for _, a := range pkgs {
+		if filter == nil && a != mainPkg && isStandardImportPath(a.ImportPath) {
+			continue
+		}
+		for _, d := range a.Declarations {
+			if !d.Blocking || d.FullName == "" || (filter != nil && !filter.MatchString(d.FullName)) {
+				continue
+			}
+			chain := blockingChain(byName, d.FullName)
+			p.printf("%s is blocking:\n", d.FullName)
+			for i, call := range chain {
+				if i > 0 {
+					p.printf("\t%s ", call.Func)
+				} else {
+					p.printf("\tit ")
+				}
+				if call.Reason == "" {
+					call.Reason = "is blocking"
+				}
+				p.printf("%s\n", call.Reason)
+			}
+		}
+	}
+	return p.err
+}
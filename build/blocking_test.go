@@ -0,0 +1,66 @@
+package build
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"regexp"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+func TestWriteBlockingReport(t *testing.T) {
+	const src = `package app
+
+var ready = make(chan bool)
+
+func wait() { <-ready }
+
+func Handle() { wait() }
+
+func Pure() int { return 42 }
+
+func literal() {
+	func() { ready <- true }()
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "app.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive, err := compiler.Compile("example.com/app", []*ast.File{file}, fset, &compiler.ImportContext{Packages: map[string]*types.Package{}}, false)
+	if err != nil {
+		t.Fatalf("Compile() returned error: %v", err)
+	}
+	pkgs := []*compiler.Archive{archive}
+
+	chain := BlockingChain(pkgs, "example.com/app.Handle")
+	want := []BlockingCall{
+		{Func: "example.com/app.Handle", Reason: "calls example.com/app.wait at app.go:7:17"},
+		{Func: "example.com/app.wait", Reason: "receives from a channel at app.go:5:15"},
+	}
+	if len(chain) != len(want) || chain[0] != want[0] || chain[1] != want[1] {
+		t.Errorf("BlockingChain() = %+v, want %+v", chain, want)
+	}
+	if chain := BlockingChain(pkgs, "example.com/app.Pure"); chain != nil {
+		t.Errorf("BlockingChain() of a function that isn't blocking = %+v, want nil", chain)
+	}
+
+	var out bytes.Buffer
+	if err := WriteBlockingReport(&out, pkgs, regexp.MustCompile(`Handle|literal`)); err != nil {
+		t.Fatalf("WriteBlockingReport() returned error: %v", err)
+	}
+	wantReport := `example.com/app.Handle is blocking:
+	it calls example.com/app.wait at app.go:7:17
+	example.com/app.wait receives from a channel at app.go:5:15
+example.com/app.literal is blocking:
+	it calls a function literal that sends to a channel at app.go:12:11
+`
+	if out.String() != wantReport {
+		t.Errorf("WriteBlockingReport() wrote:\n%s\nwant:\n%s", out.String(), wantReport)
+	}
+}
@@ -0,0 +1,162 @@
+package build
+
+import (
+	"compress/gzip"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// BudgetAll is the package of a size budget that applies to the whole
+// program, including the prelude and the code that starts the program.
+const BudgetAll = "all"
+
+// SizeBudget limits the gzipped size of the code generated for a package of a
+// program, or for the whole program.
+type SizeBudget struct {
+	// Package is the import path of the package, or BudgetAll.
+	Package string
+	// Limit is the maximum gzipped size, in bytes.
+	Limit int64
+}
+
+// sizeUnits are the suffixes of sizes accepted by ParseSizeBudgets.
+var sizeUnits = []struct {
+	suffix string
+	factor int64
+}{
+	{"KiB", 1 << 10},
+	{"MiB", 1 << 20},
+	{"KB", 1 << 10},
+	{"MB", 1 << 20},
+	{"K", 1 << 10},
+	{"M", 1 << 20},
+	{"B", 1},
+}
+
+// ParseSizeBudgets parses -size-budget flag values, like "all=300KB" or
+// "github.com/foo/bar=20KiB", into size budgets sorted by package. Sizes are
+// in bytes, or in kilobytes or megabytes of 1024 bytes with a K, KB, KiB, M,
+// MB or MiB suffix.
+func ParseSizeBudgets(specs []string) ([]SizeBudget, error) {
+	var budgets []SizeBudget
+	seen := map[string]bool{}
+	for _, spec := range specs {
+		i := strings.LastIndex(spec, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid size budget %q, want PACKAGE=SIZE", spec)
+		}
+		b := SizeBudget{Package: spec[:i]}
+		size, factor := strings.TrimSpace(spec[i+1:]), int64(1)
+		for _, u := range sizeUnits {
+			if strings.HasSuffix(size, u.suffix) {
+				size, factor = strings.TrimSpace(strings.TrimSuffix(size, u.suffix)), u.factor
+				break
+			}
+		}
+		n, err := strconv.ParseFloat(size, 64)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid size in size budget %q", spec)
+		}
+		b.Limit = int64(n * float64(factor))
+		if seen[b.Package] {
+			return nil, fmt.Errorf("duplicate size budget for %s", b.Package)
+		}
+		seen[b.Package] = true
+		budgets = append(budgets, b)
+	}
+	sort.Slice(budgets, func(i, j int) bool { return budgets[i].Package < budgets[j].Package })
+	return budgets, nil
+}
+
+// SizeReport is the size of the code generated for a program, as written
+// into its output file.
+type SizeReport struct {
+	// Total is the gzipped size of the whole program.
+	Total int64
+	// Packages are the gzipped sizes of the code of each package of the
+	// program, by import path, each compressed on its own.
+	Packages map[string]int64
+}
+
+// Exceeded returns a message for each budget of budgets that the sizes of
+// the report exceed. Budgets for packages that aren't part of the program
+// are ignored.
+func (r *SizeReport) Exceeded(budgets []SizeBudget) []string {
+	var msgs []string
+	for _, b := range budgets {
+		size, ok := r.Total, true
+		if b.Package != BudgetAll {
+			size, ok = r.Packages[b.Package]
+		}
+		if ok && size > b.Limit {
+			msgs = append(msgs, fmt.Sprintf("%s is %s gzipped, over its size budget of %s", b.Package, formatSize(size), formatSize(b.Limit)))
+		}
+	}
+	return msgs
+}
+
+// formatSize formats a size in bytes for messages.
+func formatSize(n int64) string {
+	if n < 1<<10 {
+		return fmt.Sprintf("%d B", n)
+	}
+	return fmt.Sprintf("%.1f KiB", float64(n)/(1<<10))
+}
+
+// sizeMeter is a writer that measures the gzipped size of everything written
+// to it, and of the code of each package, when it is notified of the start of
+// packages with its packageStart method, see compiler.LinkOptions.
+type sizeMeter struct {
+	report SizeReport
+
+	total *gzip.Writer
+	// pkg compresses the code of the current package, if any.
+	pkg     *gzip.Writer
+	pkgPath string
+	// totalSize and pkgSize count the compressed bytes.
+	totalSize, pkgSize countingWriter
+}
+
+func newSizeMeter() *sizeMeter {
+	m := &sizeMeter{report: SizeReport{Packages: map[string]int64{}}}
+	m.total = gzip.NewWriter(&m.totalSize)
+	return m
+}
+
+func (m *sizeMeter) Write(p []byte) (int, error) {
+	if m.pkg != nil {
+		m.pkg.Write(p)
+	}
+	return m.total.Write(p)
+}
+
+func (m *sizeMeter) packageStart(importPath string) {
+	if m.pkg != nil {
+		m.pkg.Close()
+		m.report.Packages[m.pkgPath] = int64(m.pkgSize)
+		m.pkg = nil
+	}
+	if importPath != "" {
+		m.pkgSize = 0
+		m.pkg = gzip.NewWriter(&m.pkgSize)
+		m.pkgPath = importPath
+	}
+}
+
+// close ends the measurement and returns the report.
+func (m *sizeMeter) close() *SizeReport {
+	m.packageStart("")
+	m.total.Close()
+	m.report.Total = int64(m.totalSize)
+	return &m.report
+}
+
+// countingWriter counts the bytes written to it and discards them.
+type countingWriter int64
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	*w += countingWriter(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,58 @@
+package build
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSizeBudgets(t *testing.T) {
+	got, err := ParseSizeBudgets([]string{"example.com/ui=1.5KiB", "all=300KB", "fmt=2048", "strings=1M"})
+	if err != nil {
+		t.Fatalf("ParseSizeBudgets() returned error: %v", err)
+	}
+	want := []SizeBudget{
+		{Package: "all", Limit: 300 << 10},
+		{Package: "example.com/ui", Limit: 1536},
+		{Package: "fmt", Limit: 2048},
+		{Package: "strings", Limit: 1 << 20},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSizeBudgets() = %v, want %v", got, want)
+	}
+
+	for _, spec := range []string{"all", "=10KB", "all=", "all=-1", "all=10GB", "fmt=1KB fmt=2KB"} {
+		if _, err := ParseSizeBudgets(strings.Fields(spec)); err == nil {
+			t.Errorf("ParseSizeBudgets(%q) returned no error", spec)
+		}
+	}
+}
+
+func TestSizeMeter(t *testing.T) {
+	m := newSizeMeter()
+	m.Write([]byte("prelude;"))
+	m.packageStart("runtime")
+	m.Write([]byte(strings.Repeat("runtime code;", 100)))
+	m.packageStart("main")
+	m.Write([]byte("main code;"))
+	m.packageStart("")
+	m.Write([]byte("start;"))
+	r := m.close()
+
+	if len(r.Packages) != 2 || r.Packages["runtime"] == 0 || r.Packages["main"] == 0 {
+		t.Fatalf("sizeMeter measured packages %v, want runtime and main", r.Packages)
+	}
+	if r.Total <= r.Packages["main"] {
+		t.Errorf("sizeMeter measured total size %d, want more than main's %d", r.Total, r.Packages["main"])
+	}
+
+	exceeded := r.Exceeded([]SizeBudget{
+		{Package: "all", Limit: 1},
+		{Package: "main", Limit: 1 << 10},
+		{Package: "runtime", Limit: 1},
+		{Package: "other", Limit: 1},
+	})
+	if len(exceeded) != 2 || !strings.HasPrefix(exceeded[0], "all is") || !strings.HasPrefix(exceeded[1], "runtime is") {
+		t.Errorf("Exceeded() = %q, want the budgets of all and runtime", exceeded)
+	}
+}
@@ -1,6 +1,8 @@
 package build
 
 import (
+	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
 	"go/ast"
 	"go/build"
@@ -8,12 +10,15 @@ import (
 	"go/scanner"
 	"go/token"
 	"go/types"
+	"hash"
 	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"regexp"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -41,6 +46,76 @@ var DefaultGOROOT = func() string {
 	return build.Default.GOROOT
 }()
 
+// goRootVersion is the Go 1.x version of DefaultGOROOT that packages are
+// built for, or the latest version supported by GopherJS if it is unknown.
+var goRootVersion = supportedGoRootVersion(DefaultGOROOT)
+
+func supportedGoRootVersion(goroot string) int {
+	if v, err := compiler.GoRootVersion(goroot); err == nil && v >= compiler.MinGoVersion && v <= compiler.GoVersion {
+		return v
+	}
+	return compiler.GoVersion
+}
+
+// SetDefaultGOROOT makes goroot the default GOROOT value for builds, for a Go
+// distribution selected after startup, see
+// github.com/gopherjs/gopherjs/internal/toolchain.
+func SetDefaultGOROOT(goroot string) {
+	DefaultGOROOT = goroot
+	goRootVersion = supportedGoRootVersion(goroot)
+}
+
+// TargetGOOS is the GOOS value packages are built for, and SyscallGOARCH the
+// GOARCH value the syscall package and hostArchPackages are built with, since
+// they need the definitions of a real architecture. They follow the platform
+// the gopherjs command runs on rather than the GOOS and GOARCH environment
+// variables, which may be set for other targets, see SetTarget.
+var (
+	TargetGOOS    = defaultTargetGOOS()
+	SyscallGOARCH = runtime.GOARCH
+)
+
+// supportedGOOS are the supported values of TargetGOOS.
+var supportedGOOS = []string{"linux", "darwin"}
+
+func defaultTargetGOOS() string {
+	for _, goos := range supportedGOOS {
+		if runtime.GOOS == goos {
+			return goos
+		}
+	}
+	return "linux"
+}
+
+// SetTarget overrides TargetGOOS and SyscallGOARCH with goos and goarch, unless
+// they are empty.
+func SetTarget(goos, goarch string) error {
+	if goos != "" {
+		supported := false
+		for _, s := range supportedGOOS {
+			supported = supported || s == goos
+		}
+		if !supported {
+			return fmt.Errorf("unsupported GOOS %q, want one of %s", goos, strings.Join(supportedGOOS, ", "))
+		}
+		TargetGOOS = goos
+	}
+	if goarch != "" {
+		SyscallGOARCH = goarch
+	}
+	return nil
+}
+
+// releaseTags returns the release tags of Go 1.x version goVersion, like
+// build.Default.ReleaseTags.
+func releaseTags(goVersion int) []string {
+	tags := make([]string, goVersion)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("go1.%d", i+1)
+	}
+	return tags
+}
+
 type ImportCError struct {
 	pkgPath string
 }
@@ -59,7 +134,7 @@ func NewBuildContext(installSuffix string, buildTags []string) *build.Context {
 	return &build.Context{
 		GOROOT:        DefaultGOROOT,
 		GOPATH:        build.Default.GOPATH,
-		GOOS:          build.Default.GOOS,
+		GOOS:          TargetGOOS,
 		GOARCH:        "js",
 		InstallSuffix: installSuffix,
 		Compiler:      "gc",
@@ -68,7 +143,7 @@ func NewBuildContext(installSuffix string, buildTags []string) *build.Context {
 			"purego",           // See https://golang.org/issues/23172.
 			"math_big_pure_go", // Use pure Go version of math/big.
 		),
-		ReleaseTags: build.Default.ReleaseTags[:compiler.GoVersion],
+		ReleaseTags: releaseTags(goRootVersion),
 		CgoEnabled:  true, // detect `import "C"` to throw proper error
 
 		IsDir: func(path string) bool {
@@ -142,17 +217,29 @@ func Import(path string, mode build.ImportMode, installSuffix string, buildTags
 	return importWithSrcDir(*bctx, path, wd, mode, installSuffix)
 }
 
+// hostArchPackages are the non-standard packages that, like syscall, only
+// define their types and constants for real architectures, so they are built
+// for the host GOARCH. Their natives replace the functions they implement in
+// assembly and shim the ones commonly used by portable code.
+var hostArchPackages = map[string]bool{
+	"golang.org/x/sys/unix":            true,
+	"golang.org/x/net/internal/socket": true,
+	"golang.org/x/net/ipv4":            true,
+	"golang.org/x/net/ipv6":            true,
+}
+
 func importWithSrcDir(bctx build.Context, path string, srcDir string, mode build.ImportMode, installSuffix string) (*PackageData, error) {
 	// bctx is passed by value, so it can be modified here.
 	var isVirtual bool
-	switch path {
-	case "syscall":
-		// syscall needs to use a typical GOARCH like amd64 to pick up definitions for _Socklen, BpfInsn, IFNAMSIZ, Timeval, BpfStat, SYS_FCNTL, Flock_t, etc.
-		bctx.GOARCH = build.Default.GOARCH
+	if path == "syscall" || hostArchPackages[path] {
+		// syscall (and hostArchPackages) need to use a typical GOARCH like amd64 to pick up definitions for _Socklen, BpfInsn, IFNAMSIZ, Timeval, BpfStat, SYS_FCNTL, Flock_t, etc.
+		bctx.GOARCH = SyscallGOARCH
 		bctx.InstallSuffix = "js"
 		if installSuffix != "" {
 			bctx.InstallSuffix += "_" + installSuffix
 		}
+	}
+	switch path {
 	case "syscall/js":
 		// There are no buildable files in this package, but we need to use files in the virtual directory.
 		mode |= build.FindOnly
@@ -296,6 +383,10 @@ func ImportDir(dir string, mode build.ImportMode, installSuffix string, buildTag
 // as an existing file from the standard library). For all identifiers that exist
 // in the original AND the overrides, the original identifier in the AST gets
 // replaced by `_`. New identifiers that don't exist in original package get added.
+//
+// The natives of a package are taken from the overlay set of the Go release
+// bctx is for, as given by its release tags, falling back to those of older
+// releases, see natives.Roots.
 func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileSet *token.FileSet) ([]*ast.File, error) {
 	var files []*ast.File
 	replacedDeclNames := make(map[string]bool)
@@ -348,15 +439,26 @@ func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileS
 		},
 	}
 
-	if importPath == "syscall" {
+	// Build tags select natives compiled out by -sandbox restrictions.
+	nativesContext.BuildTags = append([]string{}, bctx.BuildTags...)
+
+	if importPath == "syscall" || hostArchPackages[importPath] {
 		// Special handling for the syscall package, which uses OS native
 		// GOOS/GOARCH pair. This will no longer be necessary after
 		// https://github.com/gopherjs/gopherjs/issues/693.
-		nativesContext.GOARCH = build.Default.GOARCH
+		nativesContext.GOARCH = SyscallGOARCH
 		nativesContext.BuildTags = append(nativesContext.BuildTags, "js")
 	}
 
-	if nativesPkg, err := nativesContext.Import(importPath, "", 0); err == nil {
+	var nativesPkg *build.Package
+	for _, root := range natives.Roots(len(bctx.ReleaseTags)) {
+		nativesContext.GOROOT = root
+		if p, err := nativesContext.Import(importPath, "", 0); err == nil {
+			nativesPkg = p
+			break
+		}
+	}
+	if nativesPkg != nil {
 		names := nativesPkg.GoFiles
 		if isTest {
 			names = append(names, nativesPkg.TestGoFiles...)
@@ -479,7 +581,12 @@ func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileS
 	if errList != nil {
 		return nil, errList
 	}
-	return files, nil
+	if nativesPkg != nil {
+		// Natives replace the assembly functions the package needs under
+		// GopherJS, any others compile to stubs that panic when called.
+		return files, nil
+	}
+	return substituteAssembly(bctx, pkg, files, fileSet)
 }
 
 type Options struct {
@@ -493,6 +600,114 @@ type Options struct {
 	Minify         bool
 	Color          bool
 	BuildTags      []string
+	InitReport     bool
+	// Sandbox restricts capabilities available to compiled programs, see
+	// ParseSandbox.
+	Sandbox string
+	// CSP makes generated code compatible with a Content-Security-Policy that
+	// doesn't allow eval, and writes a Subresource Integrity hash of command
+	// packages next to them.
+	CSP bool
+	// ExportNamespace is the object functions exported with //gopherjs:export
+	// directives are set on, see compiler.LinkOptions.
+	ExportNamespace string
+	// BuildMode is the kind of output to produce for the package being built,
+	// BuildModeDefault if empty.
+	BuildMode string
+	// Format is the module format of command packages and libraries, and
+	// GlobalName the global variable or module name it uses, see
+	// compiler.LinkOptions.
+	Format     string
+	GlobalName string
+	// DevTools registers a Chrome DevTools custom formatter for Go values in
+	// command packages, see compiler.LinkOptions.
+	DevTools bool
+	// HeapNames names the constructors of Go types in command packages after
+	// the types for heap snapshots, see compiler.LinkOptions. It can't be
+	// combined with CSP.
+	HeapNames bool
+	// StrictUnsupported rejects packages outside of GOROOT that use standard
+	// library symbols which always fail at run time with GopherJS, see
+	// compiler.SymbolSupport.
+	StrictUnsupported bool
+	// PreciseMath makes the math package use its pure Go implementations
+	// instead of Math methods for functions that JavaScript engines only
+	// approximate, like Sin or Exp.
+	PreciseMath bool
+	// StartStop makes command packages export start and stop functions that
+	// run and shut down the program, instead of running it when loaded, see
+	// compiler.LinkOptions.
+	StartStop bool
+	// Env are environment variables of command packages set at build time, as
+	// KEY=VALUE strings, and EnvSources a comma-separated list of the sources
+	// environment variables are collected from when they start, see
+	// compiler.ParseEnvSources.
+	Env        []string
+	EnvSources string
+	// StdlibBundle is a file path or URL of a standard library bundle that
+	// the archives of standard library packages are seeded from when they
+	// aren't installed yet, see StdlibBundleName.
+	StdlibBundle string
+	// SizeBudgets limit the gzipped size of the code generated for packages
+	// of command packages and libraries, or for the whole output, as
+	// PACKAGE=SIZE strings, see ParseSizeBudgets. Exceeded budgets are
+	// reported as warnings, or fail the build if SizeBudgetsFatal is set.
+	SizeBudgets      []string
+	SizeBudgetsFatal bool
+	// PrintBlocking prints the blocking functions of command packages and
+	// libraries, with the chain of calls that makes each of them blocking,
+	// see WriteBlockingReport. BlockingFilter is a regular expression that
+	// selects the functions by full name instead of the default ones.
+	PrintBlocking  bool
+	BlockingFilter string
+	// LTO optimizes across package boundaries: small functions of imported
+	// packages are inlined into the packages that call them, and unused
+	// exported methods are eliminated from command packages and libraries,
+	// see compiler.ImportContext and compiler.LinkOptions.
+	LTO bool
+	// TargetES is the version of ECMAScript that code generated for Go
+	// packages may use, see compiler.CheckTargetES. It can't be ES2015 or
+	// later with HeapNames.
+	TargetES int
+	// Readable generates code for reviewing: it is annotated with the file
+	// and line of the Go code it was generated from, and anonymous types are
+	// named after their types, see compiler.ImportContext. It can't be
+	// combined with Minify.
+	Readable bool
+}
+
+// preciseMathTag is the build tag set by Options.PreciseMath, which selects
+// the pure Go implementations in the natives of the math package.
+const preciseMathTag = "gopherjs_precise_math"
+
+// Supported values of Options.BuildMode.
+const (
+	// BuildModeDefault compiles command packages into programs, and installs
+	// other packages as archives.
+	BuildModeDefault = "default"
+	// BuildModeJSLib compiles a non-main package into a library that sets the
+	// functions exported with //gopherjs:export directives, and initializes
+	// the package when one of them is called for the first time.
+	BuildModeJSLib = "js-lib"
+)
+
+// LinkOptions returns program linking options corresponding to the build
+// options. The build options must have been checked by NewSession.
+func (o *Options) LinkOptions() compiler.LinkOptions {
+	envSources, _ := compiler.ParseEnvSources(o.EnvSources) // Checked by NewSession.
+	return compiler.LinkOptions{
+		InitReport:      o.InitReport,
+		ExportNamespace: o.ExportNamespace,
+		Library:         o.BuildMode == BuildModeJSLib,
+		Format:          o.Format,
+		GlobalName:      o.GlobalName,
+		DevTools:        o.DevTools,
+		HeapNames:       o.HeapNames,
+		StartStop:       o.StartStop,
+		Env:             o.Env,
+		EnvSources:      envSources,
+		LTO:             o.LTO,
+	}
 }
 
 func (o *Options) PrintError(format string, a ...interface{}) {
@@ -524,6 +739,10 @@ type Session struct {
 	Archives map[string]*compiler.Archive
 	Types    map[string]*types.Package
 	Watcher  *fsnotify.Watcher
+
+	// stdlibSeeded are the archives seeded from Options.StdlibBundle by
+	// import path, nil if not seeded yet.
+	stdlibSeeded map[string]string
 }
 
 func NewSession(options *Options) (*Session, error) {
@@ -540,6 +759,53 @@ func NewSession(options *Options) (*Session, error) {
 		return nil, err
 	}
 
+	switch options.BuildMode {
+	case "", BuildModeDefault, BuildModeJSLib:
+	default:
+		return nil, fmt.Errorf("unsupported build mode %q", options.BuildMode)
+	}
+	if err := compiler.CheckFormat(options.Format, options.GlobalName); err != nil {
+		return nil, err
+	}
+	for _, kv := range options.Env {
+		if !strings.Contains(kv, "=") {
+			return nil, fmt.Errorf("invalid environment variable %q, want KEY=VALUE", kv)
+		}
+	}
+	if _, err := compiler.ParseEnvSources(options.EnvSources); err != nil {
+		return nil, err
+	}
+	if _, err := regexp.Compile(options.BlockingFilter); err != nil {
+		return nil, fmt.Errorf("invalid blocking functions filter: %v", err)
+	}
+	if _, err := ParseSizeBudgets(options.SizeBudgets); err != nil {
+		return nil, err
+	}
+	if options.HeapNames && options.CSP {
+		return nil, fmt.Errorf("heap names mode uses the Function constructor, which CSP mode doesn't allow")
+	}
+	if options.TargetES == 0 {
+		options.TargetES = compiler.ES5
+	}
+	if err := compiler.CheckTargetES(options.TargetES); err != nil {
+		return nil, err
+	}
+	if options.HeapNames && options.TargetES >= compiler.ES2015 {
+		return nil, fmt.Errorf("heap names mode calls constructors as functions, which the classes of ES%d don't allow", options.TargetES)
+	}
+	if options.Readable && options.Minify {
+		return nil, fmt.Errorf("readable mode can't be combined with minification")
+	}
+
+	sandboxTags, err := ParseSandbox(options.Sandbox)
+	if err != nil {
+		return nil, err
+	}
+	options.BuildTags = addTags(options.BuildTags, sandboxTags...)
+	if options.PreciseMath {
+		options.BuildTags = addTags(options.BuildTags, preciseMathTag)
+	}
+
 	s := &Session{
 		options:  options,
 		Archives: make(map[string]*compiler.Archive),
@@ -566,10 +832,32 @@ func NewSession(options *Options) (*Session, error) {
 func (s *Session) BuildContext() *build.Context { return s.bctx }
 
 func (s *Session) InstallSuffix() string {
+	// Sandboxed, CSP, precise math, LTO, ES2015 and readable builds produce
+	// different code, so they are installed separately.
+	var parts []string
 	if s.options.Minify {
-		return "min"
+		parts = append(parts, "min")
+	}
+	if s.options.CSP {
+		parts = append(parts, "csp")
+	}
+	sandboxTags, _ := ParseSandbox(s.options.Sandbox) // Validated by NewSession.
+	for _, tag := range sandboxTags {
+		parts = append(parts, strings.TrimPrefix(tag, "gopherjs_"))
 	}
-	return ""
+	if s.options.PreciseMath {
+		parts = append(parts, strings.TrimPrefix(preciseMathTag, "gopherjs_"))
+	}
+	if s.options.LTO {
+		parts = append(parts, "lto")
+	}
+	if s.options.TargetES >= compiler.ES2015 {
+		parts = append(parts, "es2015")
+	}
+	if s.options.Readable {
+		parts = append(parts, "readable")
+	}
+	return strings.Join(parts, "_")
 }
 
 func (s *Session) BuildDir(packagePath string, importPath string, pkgObj string) error {
@@ -655,6 +943,10 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 		return archive, nil
 	}
 
+	if err := s.seedStdlibFor(pkg); err != nil {
+		return nil, err
+	}
+
 	if pkg.PkgObj != "" {
 		var fileInfo os.FileInfo
 		gopherjsBinary, err := os.Executable()
@@ -699,7 +991,13 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 			}
 		}
 
-		for _, name := range append(pkg.GoFiles, pkg.JSFiles...) {
+		names := append(append([]string{}, pkg.GoFiles...), pkg.JSFiles...)
+		if hasAssembly(pkg.Package) {
+			// Pure Go implementations of assembly functions may come from
+			// ignored files, see substituteAssembly.
+			names = append(names, pkg.IgnoredGoFiles...)
+		}
+		for _, name := range names {
 			fileInfo, err := statFile(filepath.Join(pkg.Dir, name))
 			if err != nil {
 				return nil, err
@@ -727,6 +1025,9 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 			if err != nil {
 				return nil, err
 			}
+			if err := s.checkUnavailable(pkg, archive); err != nil {
+				return nil, err
+			}
 
 			s.Archives[pkg.ImportPath] = archive
 			return archive, err
@@ -753,11 +1054,18 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 			localImportPathCache[path] = archive
 			return archive, nil
 		},
+		Inline:   s.options.LTO,
+		TargetES: s.options.TargetES,
+		Readable: s.options.Readable,
+		CSP:      s.options.CSP,
 	}
 	archive, err := compiler.Compile(pkg.ImportPath, files, fileSet, importContext, s.options.Minify)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkUnavailable(pkg, archive); err != nil {
+		return nil, err
+	}
 
 	for _, jsFile := range pkg.JSFiles {
 		code, err := ioutil.ReadFile(filepath.Join(pkg.Dir, jsFile))
@@ -779,21 +1087,53 @@ func (s *Session) BuildPackage(pkg *PackageData) (*compiler.Archive, error) {
 		return archive, nil
 	}
 
-	if err := s.writeLibraryPackage(archive, pkg.PkgObj); err != nil {
-		if strings.HasPrefix(pkg.PkgObj, s.options.GOROOT) {
-			// fall back to first GOPATH workspace
-			firstGopathWorkspace := filepath.SplitList(s.options.GOPATH)[0]
-			if err := s.writeLibraryPackage(archive, filepath.Join(firstGopathWorkspace, pkg.PkgObj[len(s.options.GOROOT):])); err != nil {
-				return nil, err
-			}
-			return archive, nil
-		}
+	if _, err := s.installPkgObj(pkg.PkgObj, func(pkgObj string) error {
+		return s.writeLibraryPackage(archive, pkgObj)
+	}); err != nil {
 		return nil, err
 	}
 
 	return archive, nil
 }
 
+// installPkgObj writes the archive pkgObj with write, falling back to the
+// first GOPATH workspace for archives in GOROOT that can't be written, and
+// returns the path written.
+func (s *Session) installPkgObj(pkgObj string, write func(pkgObj string) error) (string, error) {
+	err := write(pkgObj)
+	if err == nil {
+		return pkgObj, nil
+	}
+	if !strings.HasPrefix(pkgObj, s.options.GOROOT) {
+		return "", err
+	}
+	// fall back to first GOPATH workspace
+	firstGopathWorkspace := filepath.SplitList(s.options.GOPATH)[0]
+	gopathPkgObj := filepath.Join(firstGopathWorkspace, pkgObj[len(s.options.GOROOT):])
+	if err := write(gopathPkgObj); err != nil {
+		return "", err
+	}
+	return gopathPkgObj, nil
+}
+
+// checkUnavailable returns the uses of standard library symbols that always
+// fail at run time with GopherJS in archive as errors, if the session doesn't
+// allow them. Standard library packages are exempt, since they only use such
+// symbols on behalf of their own unavailable functionality.
+func (s *Session) checkUnavailable(pkg *PackageData, archive *compiler.Archive) error {
+	if !s.options.StrictUnsupported || pkg.Goroot {
+		return nil
+	}
+	var errList compiler.ErrorList
+	for _, use := range archive.Unavailable {
+		errList = append(errList, &scanner.Error{
+			Pos: use.Pos,
+			Msg: fmt.Sprintf("%s is unavailable with GopherJS: %s", use.Symbol, use.Reason),
+		})
+	}
+	return errList.Normalize()
+}
+
 func (s *Session) writeLibraryPackage(archive *compiler.Archive, pkgObj string) error {
 	if err := os.MkdirAll(filepath.Dir(pkgObj), 0777); err != nil {
 		return err
@@ -809,6 +1149,12 @@ func (s *Session) writeLibraryPackage(archive *compiler.Archive, pkgObj string)
 }
 
 func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string) error {
+	return s.writeProgram(archive, pkgObj, s.options.LinkOptions())
+}
+
+// writeProgram links archive and its dependencies into a program with the
+// options opts, and writes it into pkgObj.
+func (s *Session) writeProgram(archive *compiler.Archive, pkgObj string, opts compiler.LinkOptions) (err error) {
 	if err := os.MkdirAll(filepath.Dir(pkgObj), 0777); err != nil {
 		return err
 	}
@@ -818,7 +1164,19 @@ func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string)
 	}
 	defer codeFile.Close()
 
-	sourceMapFilter := &compiler.SourceMapFilter{Writer: codeFile}
+	var out io.Writer = codeFile
+	if s.options.CSP {
+		// Deferred first, so that the hash covers the source map comment.
+		integrity := sha512.New384()
+		out = io.MultiWriter(codeFile, integrity)
+		defer func() {
+			if err == nil {
+				err = writeIntegrity(pkgObj, integrity)
+			}
+		}()
+	}
+
+	sourceMapFilter := &compiler.SourceMapFilter{Writer: out}
 	if s.options.CreateMapFile {
 		m := &sourcemap.Map{File: filepath.Base(pkgObj)}
 		mapFile, err := os.Create(pkgObj + ".map")
@@ -829,7 +1187,7 @@ func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string)
 		defer func() {
 			m.WriteTo(mapFile)
 			mapFile.Close()
-			fmt.Fprintf(codeFile, "//# sourceMappingURL=%s.map\n", filepath.Base(pkgObj))
+			fmt.Fprintf(out, "//# sourceMappingURL=%s.map\n", filepath.Base(pkgObj))
 		}()
 
 		sourceMapFilter.MappingCallback = NewMappingCallback(m, s.options.GOROOT, s.options.GOPATH, s.options.MapToLocalDisk)
@@ -845,7 +1203,41 @@ func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string)
 	if err != nil {
 		return err
 	}
-	return compiler.WriteProgramCode(deps, sourceMapFilter)
+	if s.options.PrintBlocking {
+		var filter *regexp.Regexp
+		if s.options.BlockingFilter != "" {
+			filter = regexp.MustCompile(s.options.BlockingFilter) // Checked by NewSession.
+		}
+		if err := WriteBlockingReport(os.Stdout, deps, filter); err != nil {
+			return err
+		}
+	}
+	budgets, _ := ParseSizeBudgets(s.options.SizeBudgets) // Checked by NewSession.
+	if len(budgets) == 0 {
+		return compiler.WriteProgramCode(deps, sourceMapFilter, opts)
+	}
+
+	meter := newSizeMeter()
+	sourceMapFilter.Writer = io.MultiWriter(sourceMapFilter.Writer, meter)
+	opts.PackageStart = meter.packageStart
+	if err := compiler.WriteProgramCode(deps, sourceMapFilter, opts); err != nil {
+		return err
+	}
+	exceeded := meter.close().Exceeded(budgets)
+	if len(exceeded) != 0 && s.options.SizeBudgetsFatal {
+		return fmt.Errorf("%s exceeds size budgets:\n\t%s", archive.ImportPath, strings.Join(exceeded, "\n\t"))
+	}
+	for _, msg := range exceeded {
+		s.options.PrintError("warning: %s\n", msg)
+	}
+	return nil
+}
+
+// writeIntegrity writes the Subresource Integrity metadata for the file
+// pkgObj with the SHA-384 hash h into pkgObj.sri, for use in the integrity
+// attribute of the script element that loads it.
+func writeIntegrity(pkgObj string, h hash.Hash) error {
+	return ioutil.WriteFile(pkgObj+".sri", []byte("sha384-"+base64.StdEncoding.EncodeToString(h.Sum(nil))+"\n"), 0666)
 }
 
 func NewMappingCallback(m *sourcemap.Map, goroot, gopath string, localMap bool) func(generatedLine, generatedColumn int, originalPos token.Position) {
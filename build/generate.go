@@ -0,0 +1,190 @@
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// GoToolEnv returns the environment of go commands and other host tools run
+// by gopherjs, which work on the platform gopherjs runs on, without GOOS,
+// GOARCH and GOFLAGS that may be set for other targets.
+func GoToolEnv() []string {
+	var env []string
+	for _, kv := range os.Environ() {
+		switch strings.SplitN(kv, "=", 2)[0] {
+		case "GOOS", "GOARCH", "GOFLAGS":
+			continue
+		}
+		env = append(env, kv)
+	}
+	return env
+}
+
+// GenerateOptions configures Generate.
+type GenerateOptions struct {
+	// Run selects the directives whose full original source text matches
+	// it, or all directives if nil.
+	Run *regexp.Regexp
+	// DryRun prints the commands that would be run without running them.
+	DryRun bool
+	// Trace prints commands as they are run.
+	Trace bool
+	// Verbose prints the names of files as they are processed.
+	Verbose bool
+	// Stdout and Stderr are the outputs of commands and of the printed
+	// information.
+	Stdout, Stderr io.Writer
+}
+
+var generateDirective = []byte("//go:generate")
+
+// Generate runs the //go:generate directives in the Go files of pkg, which
+// was imported for GopherJS, so files are selected with the js build tag and
+// the GOOS and GOARCH of GopherJS builds, like go generate does for other
+// targets. Directives are processed in file order, and Generate stops at the
+// first failing command.
+//
+// Like go generate, the $GOFILE, $GOLINE, $GOPACKAGE, $GOOS, $GOARCH and
+// $DOLLAR variables are expanded in directives, and set in the environment of
+// commands except for $GOOS and $GOARCH: commands are host tools, often run
+// with go run, so they get the host environment of GoToolEnv. Instead,
+// GOPHERJS_VERSION is set to the GopherJS version and GOPHERJS_TARGET to the
+// target GOOS and GOARCH, like "linux/js", so that generators can detect
+// GopherJS. Both are also expanded in directives.
+func Generate(pkg *PackageData, opts GenerateOptions) error {
+	var files []string
+	files = append(files, pkg.GoFiles...)
+	files = append(files, pkg.TestGoFiles...)
+	files = append(files, pkg.XTestGoFiles...)
+	for _, file := range files {
+		if err := generateFile(pkg, file, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generateFile(pkg *PackageData, file string, opts GenerateOptions) error {
+	path := filepath.Join(pkg.Dir, file)
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if !bytes.Contains(src, generateDirective) {
+		return nil
+	}
+	if opts.Verbose {
+		fmt.Fprintln(opts.Stderr, path)
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(src))
+	s.Buffer(nil, len(src)+1)
+	for line := 1; s.Scan(); line++ {
+		text := s.Bytes()
+		if !bytes.HasPrefix(text, generateDirective) || len(text) == len(generateDirective) || (text[len(generateDirective)] != ' ' && text[len(generateDirective)] != '\t') {
+			continue
+		}
+		if opts.Run != nil && !opts.Run.Match(text) {
+			continue
+		}
+
+		vars := map[string]string{
+			"GOFILE":    file,
+			"GOLINE":    strconv.Itoa(line),
+			"GOPACKAGE": pkg.Name,
+			"GOOS":      TargetGOOS,
+			"GOARCH":    "js",
+			"DOLLAR":    "$",
+
+			"GOPHERJS_VERSION": compiler.Version,
+			"GOPHERJS_TARGET":  TargetGOOS + "/js",
+		}
+		expand := func(name string) string {
+			if v, ok := vars[name]; ok {
+				return v
+			}
+			return os.Getenv(name)
+		}
+		words, err := splitGenerateWords(string(text[len(generateDirective):]))
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		if len(words) == 0 {
+			return fmt.Errorf("%s:%d: no arguments to directive", path, line)
+		}
+		for i, w := range words {
+			words[i] = os.Expand(w, expand)
+		}
+
+		if opts.DryRun || opts.Trace {
+			fmt.Fprintln(opts.Stderr, strings.Join(words, " "))
+		}
+		if opts.DryRun {
+			continue
+		}
+		cmd := exec.Command(words[0], words[1:]...)
+		cmd.Dir = pkg.Dir
+		cmd.Stdout = opts.Stdout
+		cmd.Stderr = opts.Stderr
+		cmd.Env = append(GoToolEnv(),
+			"GOFILE="+vars["GOFILE"],
+			"GOLINE="+vars["GOLINE"],
+			"GOPACKAGE="+vars["GOPACKAGE"],
+			"DOLLAR=$",
+			"GOPHERJS_VERSION="+vars["GOPHERJS_VERSION"],
+			"GOPHERJS_TARGET="+vars["GOPHERJS_TARGET"],
+		)
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("%s:%d: running %q: %v", path, line, words[0], err)
+		}
+	}
+	return s.Err()
+}
+
+// splitGenerateWords splits the arguments of a //go:generate directive into
+// words, which are separated by spaces and tabs, or are double-quoted Go
+// strings.
+func splitGenerateWords(line string) ([]string, error) {
+	var words []string
+	for {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" {
+			return words, nil
+		}
+		if line[0] != '"' {
+			end := strings.IndexAny(line, " \t")
+			if end == -1 {
+				end = len(line)
+			}
+			words = append(words, line[:end])
+			line = line[end:]
+			continue
+		}
+		end := 1
+		for ; end < len(line) && line[end] != '"'; end++ {
+			if line[end] == '\\' {
+				end++
+			}
+		}
+		if end >= len(line) {
+			return nil, fmt.Errorf("unterminated quoted string")
+		}
+		word, err := strconv.Unquote(line[:end+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted string %s", line[:end+1])
+		}
+		words = append(words, word)
+		line = line[end+1:]
+	}
+}
@@ -0,0 +1,60 @@
+package build
+
+import (
+	"bytes"
+	"go/build"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+func TestSplitGenerateWords(t *testing.T) {
+	got, err := splitGenerateWords(` stringer	-type=Pill "a b\"c" $GOFILE`)
+	if err != nil {
+		t.Fatalf("splitGenerateWords() returned error: %v", err)
+	}
+	if want := []string{"stringer", "-type=Pill", `a b"c`, "$GOFILE"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("splitGenerateWords() = %q, want %q", got, want)
+	}
+	if _, err := splitGenerateWords(` echo "unterminated`); err == nil {
+		t.Errorf("splitGenerateWords() of an unterminated string returned no error")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	src := `package pill
+
+//go:generate sh -c "echo $GOFILE:$GOLINE $GOPACKAGE $GOOS/$GOARCH $GOPHERJS_TARGET $DOLLAR{GOPHERJS_VERSION}"
+//go:generate echo skipped
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "pill.go"), []byte(src), 0666); err != nil {
+		t.Fatal(err)
+	}
+	pkg := &PackageData{Package: &build.Package{Dir: dir, Name: "pill", GoFiles: []string{"pill.go"}}}
+
+	var stdout, stderr bytes.Buffer
+	err := Generate(pkg, GenerateOptions{Run: regexp.MustCompile("sh"), Stdout: &stdout, Stderr: &stderr})
+	if err != nil {
+		t.Fatalf("Generate() returned error: %v\n%s", err, stderr.String())
+	}
+	// Variables are expanded in the directive, and GOPHERJS_VERSION is also
+	// set in the environment of the command.
+	want := "pill.go:3 pill " + TargetGOOS + "/js " + TargetGOOS + "/js " + compiler.Version + "\n"
+	if stdout.String() != want {
+		t.Errorf("Generate() printed %q, want %q", stdout.String(), want)
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if err := Generate(pkg, GenerateOptions{DryRun: true, Stdout: &stdout, Stderr: &stderr}); err != nil {
+		t.Fatalf("Generate() with DryRun returned error: %v", err)
+	}
+	if stdout.Len() != 0 || !bytes.Contains(stderr.Bytes(), []byte("echo skipped")) {
+		t.Errorf("Generate() with DryRun printed %q to stdout and %q to stderr, want only the commands on stderr", stdout.String(), stderr.String())
+	}
+}
@@ -0,0 +1,153 @@
+package build
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// Files of npm packages written by WriteNPMPackage.
+const (
+	npmCommonJS     = "index.js"
+	npmModule       = "index.mjs"
+	npmDeclarations = "index.d.ts"
+)
+
+// npmManifest is the package.json of an npm package.
+type npmManifest struct {
+	Name        string                   `json:"name"`
+	Version     string                   `json:"version"`
+	Description string                   `json:"description"`
+	Main        string                   `json:"main"`
+	Module      string                   `json:"module"`
+	Types       string                   `json:"types"`
+	Exports     map[string]npmConditions `json:"exports"`
+	Files       []string                 `json:"files"`
+	License     string                   `json:"license,omitempty"`
+}
+
+// npmConditions are the files of an entry of the exports of an npm package.
+// TypeScript requires the types condition to come first.
+type npmConditions struct {
+	Types   string `json:"types"`
+	Import  string `json:"import"`
+	Require string `json:"require"`
+}
+
+// NPMDir returns the directory the npm package of the package importPath is
+// installed into, next to the pkg and bin directories of the first GOPATH
+// workspace.
+func NPMDir(gopath, importPath string) string {
+	return filepath.Join(filepath.SplitList(gopath)[0], "npm", filepath.FromSlash(importPath))
+}
+
+// WriteNPMPackage writes a directory that can be published to npm with the
+// package pkg, compiled into archive, as a library of the functions it exports
+// with //gopherjs:export directives. The directory has a CommonJS and an ES
+// module build of the library, TypeScript declarations of the exported
+// functions, a package.json referring to them, and the license of the Go
+// package if one is found.
+func (s *Session) WriteNPMPackage(archive *compiler.Archive, pkg *PackageData, dir, version string) error {
+	if pkg.IsCommand() {
+		return fmt.Errorf("cannot install main package %s as an npm package", pkg.ImportPath)
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+
+	opts := s.options.LinkOptions()
+	opts.Library = true
+	opts.ExportNamespace = "" // Exported functions are the module exports.
+	opts.Format = compiler.FormatIIFE
+	if err := s.writeProgram(archive, filepath.Join(dir, npmCommonJS), opts); err != nil {
+		return err
+	}
+	opts.Format = compiler.FormatESM
+	if err := s.writeProgram(archive, filepath.Join(dir, npmModule), opts); err != nil {
+		return err
+	}
+
+	decls, err := os.Create(filepath.Join(dir, npmDeclarations))
+	if err != nil {
+		return err
+	}
+	defer decls.Close()
+	if err := compiler.WriteTypeDeclarations(archive, decls); err != nil {
+		return err
+	}
+	if err := decls.Close(); err != nil {
+		return err
+	}
+
+	m := npmManifest{
+		Name:        npmName(pkg.ImportPath),
+		Version:     version,
+		Description: fmt.Sprintf("Go package %s compiled with GopherJS.", pkg.ImportPath),
+		Main:        npmCommonJS,
+		Module:      npmModule,
+		Types:       npmDeclarations,
+		Exports: map[string]npmConditions{
+			".": {
+				Types:   "./" + npmDeclarations,
+				Import:  "./" + npmModule,
+				Require: "./" + npmCommonJS,
+			},
+		},
+		Files: []string{npmCommonJS, npmModule, npmDeclarations},
+	}
+	if s.options.CreateMapFile {
+		m.Files = append(m.Files, npmCommonJS+".map", npmModule+".map")
+	}
+	if license := findLicense(pkg.Dir); license != "" {
+		data, err := ioutil.ReadFile(license)
+		if err != nil {
+			return err
+		}
+		name := filepath.Base(license)
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0666); err != nil {
+			return err
+		}
+		m.Files = append(m.Files, name)
+		m.License = "SEE LICENSE IN " + name
+	}
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "package.json"), append(manifest, '\n'), 0666)
+}
+
+// npmName returns the npm package name for the Go package importPath: the last
+// element of the import path, which npm requires to be lower case.
+func npmName(importPath string) string {
+	return strings.ToLower(path.Base(importPath))
+}
+
+// findLicense returns the license file of the package in dir, looking in the
+// parent directories up to the root of the module or repository, or an empty
+// string if there is none.
+func findLicense(dir string) string {
+	for {
+		for _, name := range []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "COPYING"} {
+			if fi, err := os.Stat(filepath.Join(dir, name)); err == nil && fi.Mode().IsRegular() {
+				return filepath.Join(dir, name)
+			}
+		}
+		for _, root := range []string{"go.mod", ".git"} {
+			if _, err := os.Stat(filepath.Join(dir, root)); err == nil {
+				return ""
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
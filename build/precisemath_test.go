@@ -0,0 +1,48 @@
+package build
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+)
+
+// TestPreciseMathSelectsNatives checks that the precise math mode replaces the
+// natives of the math package that call Math methods with the pure Go
+// implementations.
+func TestPreciseMathSelectsNatives(t *testing.T) {
+	for _, precise := range []bool{false, true} {
+		s, err := NewSession(&Options{PreciseMath: precise})
+		if err != nil {
+			t.Fatalf("NewSession returned error: %s", err)
+		}
+		pkg, err := importWithSrcDir(*s.bctx, "math", "", 0, "")
+		if err != nil {
+			t.Fatalf("importWithSrcDir(%q) returned error: %s", "math", err)
+		}
+		files, err := parseAndAugment(s.bctx, pkg.Package, false, token.NewFileSet())
+		if err != nil {
+			t.Fatalf("parseAndAugment(%q) returned error: %s", "math", err)
+		}
+		for _, name := range []string{"Sin", "Exp", "Pow"} {
+			body := findFuncBody(files, name)
+			if body == nil || len(body.List) == 0 {
+				t.Fatalf("math.%s has no body with precise = %v", name, precise)
+			}
+			ret, ok := body.List[len(body.List)-1].(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				t.Fatalf("math.%s doesn't end with a return statement with precise = %v", name, precise)
+			}
+			call, ok := ret.Results[0].(*ast.CallExpr)
+			if !ok {
+				t.Fatalf("math.%s doesn't return a call with precise = %v", name, precise)
+			}
+			_, pure := call.Fun.(*ast.Ident)
+			if pure != precise {
+				t.Errorf("math.%s calls pure Go implementation = %v with precise = %v, want %v", name, pure, precise, precise)
+			}
+		}
+		if got, want := s.InstallSuffix() == "precise_math", precise; got != want {
+			t.Errorf("InstallSuffix() = %q with precise = %v", s.InstallSuffix(), precise)
+		}
+	}
+}
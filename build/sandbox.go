@@ -0,0 +1,82 @@
+package build
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// sandboxLevels maps each capability that can be restricted with the -sandbox
+// flag to its allowed levels, and the levels to the build tags that compile
+// out the corresponding bridge layers in the natives. The "all" level is the
+// default and doesn't restrict anything.
+var sandboxLevels = map[string]map[string]string{
+	"fs": {
+		"all":  "",
+		"none": "gopherjs_sandbox_fs_none",
+	},
+	"net": {
+		"all":        "",
+		"fetch-only": "gopherjs_sandbox_net_fetch_only",
+		"none":       "gopherjs_sandbox_net_none",
+	},
+}
+
+// ParseSandbox parses a -sandbox flag value, a comma-separated list of
+// capability:level pairs such as "fs:none,net:fetch-only", and returns the
+// build tags that implement the restrictions, sorted.
+//
+// Supported capabilities are:
+//
+//  fs   all (default), none: no file system access through the os package.
+//  net  all (default), fetch-only: net/http uses only the Fetch API,
+//       none: no network access through net/http.
+//
+// Any restriction also compiles out the native system calls module, which
+// gives unrestricted access to the operating system.
+func ParseSandbox(spec string) ([]string, error) {
+	var tags []string
+	seen := map[string]bool{}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid sandbox restriction %q, want capability:level", item)
+		}
+		capability, level := parts[0], parts[1]
+		levels, ok := sandboxLevels[capability]
+		if !ok {
+			return nil, fmt.Errorf("unknown sandbox capability %q", capability)
+		}
+		tag, ok := levels[level]
+		if !ok {
+			return nil, fmt.Errorf("unknown level %q for sandbox capability %q", level, capability)
+		}
+		if seen[capability] {
+			return nil, fmt.Errorf("sandbox capability %q specified more than once", capability)
+		}
+		seen[capability] = true
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+// addTags appends the build tags extra to tags, skipping those already present.
+func addTags(tags []string, extra ...string) []string {
+	for _, tag := range extra {
+		present := false
+		for _, t := range tags {
+			present = present || t == tag
+		}
+		if !present {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,96 @@
+package build
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+	"testing"
+)
+
+func TestParseSandbox(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    []string
+		wantErr bool
+	}{
+		{spec: "", want: nil},
+		{spec: "fs:all,net:all", want: nil},
+		{spec: "fs:none", want: []string{"gopherjs_sandbox_fs_none"}},
+		{spec: "net:fetch-only, fs:none", want: []string{"gopherjs_sandbox_fs_none", "gopherjs_sandbox_net_fetch_only"}},
+		{spec: "net:none", want: []string{"gopherjs_sandbox_net_none"}},
+		{spec: "fs", wantErr: true},
+		{spec: "disk:none", wantErr: true},
+		{spec: "fs:read-only", wantErr: true},
+		{spec: "fs:none,fs:all", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseSandbox(test.spec)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseSandbox(%q) returned no error, want error", test.spec)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseSandbox(%q) returned error: %s", test.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseSandbox(%q) = %q, want %q", test.spec, got, test.want)
+		}
+	}
+}
+
+// TestSandboxCompilesOutNatives checks that sandbox restrictions remove the
+// bridge layers from the augmented packages, rather than disabling them at
+// run time.
+func TestSandboxCompilesOutNatives(t *testing.T) {
+	tests := []struct {
+		spec    string
+		pkg     string
+		removed []string
+	}{
+		{spec: "net:fetch-only", pkg: "net/http", removed: []string{"newXHRTransport"}},
+		{spec: "net:none", pkg: "net/http", removed: []string{"newFetchTransport", "newXHRTransport"}},
+		{spec: "fs:none", pkg: "syscall", removed: []string{"nodeCall", "syscallByName"}},
+	}
+	for _, test := range tests {
+		tags, err := ParseSandbox(test.spec)
+		if err != nil {
+			t.Fatalf("ParseSandbox(%q) returned error: %s", test.spec, err)
+		}
+		for _, sandboxed := range []bool{false, true} {
+			bctx := NewBuildContext("", nil)
+			if sandboxed {
+				bctx = NewBuildContext("", tags)
+			}
+			pkg, err := importWithSrcDir(*bctx, test.pkg, "", 0, "")
+			if err != nil {
+				t.Fatalf("importWithSrcDir(%q) returned error: %s", test.pkg, err)
+			}
+			files, err := parseAndAugment(bctx, pkg.Package, false, token.NewFileSet())
+			if err != nil {
+				t.Fatalf("parseAndAugment(%q) returned error: %s", test.pkg, err)
+			}
+			for _, name := range test.removed {
+				// Sandboxed builds may keep stubs that consist of a single
+				// return statement.
+				body := findFuncBody(files, name)
+				if got := body != nil && len(body.List) > 1; got == sandboxed {
+					t.Errorf("%s.%s implemented = %v in sandboxed = %v build with %q, want %v", test.pkg, name, got, sandboxed, test.spec, !sandboxed)
+				}
+			}
+		}
+	}
+}
+
+func findFuncBody(files []*ast.File, name string) *ast.BlockStmt {
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == name {
+				return fn.Body
+			}
+		}
+	}
+	return nil
+}
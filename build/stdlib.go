@@ -0,0 +1,277 @@
+package build
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// A standard library bundle is a gzipped tar file with precompiled archives of
+// standard library packages, which seed the archives installed in GOROOT/pkg
+// (or the first GOPATH workspace) so that first builds on fresh machines don't
+// need to compile the standard library. Its first entry, stdlibManifestName,
+// is a stdlibManifest describing the archives, which follow as entries named
+// after their import paths with an ".a" suffix.
+//
+// Archives depend on the GopherJS release, the Go distribution and the install
+// suffix, which covers minification and the other options that change the
+// generated code, so a release publishes one bundle per install suffix, named
+// by StdlibBundleName.
+const stdlibManifestName = "manifest.json"
+
+// stdlibManifest is the index of a standard library bundle.
+type stdlibManifest struct {
+	GopherJS      string        `json:"gopherjs"`      // compiler.Version
+	Go            string        `json:"go"`            // Go release of GOROOT, like "go1.16.5".
+	GOOS          string        `json:"goos"`          // GOOS of the build context.
+	InstallSuffix string        `json:"installSuffix"` // Session.InstallSuffix, like "min".
+	Packages      []stdlibEntry `json:"packages"`
+}
+
+// stdlibEntry describes an archive of a standard library bundle.
+type stdlibEntry struct {
+	ImportPath string `json:"importPath"`
+	File       string `json:"file"`
+	SHA256     string `json:"sha256"`
+}
+
+// StdlibBundleName returns the file name of the standard library bundle of
+// this GopherJS release for builds with install suffix installSuffix, see
+// Session.InstallSuffix, like "gopherjs-1.16.3+go1.16.5-stdlib-min.tar.gz".
+func StdlibBundleName(installSuffix string) string {
+	if installSuffix == "" {
+		installSuffix = "default"
+	}
+	return fmt.Sprintf("gopherjs-%s-stdlib-%s.tar.gz", compiler.Version, installSuffix)
+}
+
+// goRelease returns the Go release of the distribution at goroot, like
+// "go1.16.5", as recorded in its VERSION file.
+func goRelease(goroot string) (string, error) {
+	v, err := ioutil.ReadFile(filepath.Join(goroot, "VERSION"))
+	if err != nil {
+		return "", err
+	}
+	if fields := strings.Fields(string(v)); len(fields) > 0 {
+		return fields[0], nil
+	}
+	return "", fmt.Errorf("empty VERSION file in %s", goroot)
+}
+
+// newStdlibManifest returns a manifest without packages for bundles usable by
+// the session.
+func (s *Session) newStdlibManifest() (*stdlibManifest, error) {
+	release, err := goRelease(s.options.GOROOT)
+	if err != nil {
+		return nil, err
+	}
+	return &stdlibManifest{
+		GopherJS:      compiler.Version,
+		Go:            release,
+		GOOS:          s.bctx.GOOS,
+		InstallSuffix: s.InstallSuffix(),
+	}, nil
+}
+
+// WriteStdlibBundle builds the standard library packages importPaths and
+// their dependencies, and writes a bundle of their archives to w. Packages
+// that GopherJS can't build are left out, and reported if the session is
+// verbose.
+func (s *Session) WriteStdlibBundle(w io.Writer, importPaths []string) error {
+	m, err := s.newStdlibManifest()
+	if err != nil {
+		return err
+	}
+	for _, path := range importPaths {
+		if _, err := s.BuildImportPath(path); err != nil && s.options.Verbose {
+			fmt.Fprintf(os.Stderr, "skipping %s: %v\n", path, err)
+		}
+	}
+
+	var paths []string
+	for path := range s.Archives {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	files := map[string][]byte{}
+	for _, path := range paths {
+		pkg, err := importWithSrcDir(*s.bctx, path, "", 0, s.InstallSuffix())
+		if err != nil || !pkg.Goroot {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := compiler.WriteArchive(s.Archives[path], &buf); err != nil {
+			return err
+		}
+		sum := sha256.Sum256(buf.Bytes())
+		entry := stdlibEntry{ImportPath: path, File: path + ".a", SHA256: hex.EncodeToString(sum[:])}
+		m.Packages = append(m.Packages, entry)
+		files[entry.File] = buf.Bytes()
+	}
+	if len(m.Packages) == 0 {
+		return fmt.Errorf("no standard library packages were built")
+	}
+
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	add := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: now, Typeflag: tar.TypeReg}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+	if err := add(stdlibManifestName, manifest); err != nil {
+		return err
+	}
+	for _, entry := range m.Packages {
+		if err := add(entry.File, files[entry.File]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// openStdlibBundle opens the standard library bundle at src, a file path or an
+// http or https URL.
+func openStdlibBundle(src string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.Open(src)
+	}
+	resp, err := http.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("fetching %s: %s", src, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// SeedStdlib installs the archives of the standard library bundle at src, a
+// file path or an http or https URL, as if the packages had been built by the
+// session, and returns the paths of the installed archives by import path.
+// The bundle must have been built by the same GopherJS release, for the same
+// Go distribution and install suffix as the session. Archives are installed
+// with the current time, so that they are up to date unless the sources of
+// the standard library change later.
+func (s *Session) SeedStdlib(src string) (map[string]string, error) {
+	want, err := s.newStdlibManifest()
+	if err != nil {
+		return nil, err
+	}
+	r, err := openStdlibBundle(src)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading standard library bundle %s: %v", src, err)
+	}
+	tr := tar.NewReader(gz)
+
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != stdlibManifestName {
+		return nil, fmt.Errorf("%s is not a standard library bundle: missing %s", src, stdlibManifestName)
+	}
+	var m stdlibManifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return nil, fmt.Errorf("reading %s of %s: %v", stdlibManifestName, src, err)
+	}
+	if m.GopherJS != want.GopherJS || m.Go != want.Go || m.GOOS != want.GOOS || m.InstallSuffix != want.InstallSuffix {
+		return nil, fmt.Errorf("standard library bundle %s was built by GopherJS %s with %s for GOOS %s and install suffix %q, but this build uses GopherJS %s with %s for GOOS %s and install suffix %q",
+			src, m.GopherJS, m.Go, m.GOOS, m.InstallSuffix, want.GopherJS, want.Go, want.GOOS, want.InstallSuffix)
+	}
+	entries := map[string]stdlibEntry{}
+	for _, entry := range m.Packages {
+		entries[entry.File] = entry
+	}
+
+	installed := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading standard library bundle %s: %v", src, err)
+		}
+		entry, ok := entries[hdr.Name]
+		if !ok {
+			return nil, fmt.Errorf("standard library bundle %s has unlisted entry %s", src, hdr.Name)
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("reading standard library bundle %s: %v", src, err)
+		}
+		if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != entry.SHA256 {
+			return nil, fmt.Errorf("standard library bundle %s: checksum mismatch for %s", src, entry.File)
+		}
+		pkg, err := importWithSrcDir(*s.bctx, entry.ImportPath, "", 0, s.InstallSuffix())
+		if err != nil || !pkg.Goroot || pkg.PkgObj == "" {
+			continue // Not a standard library package of this GOROOT.
+		}
+		pkgObj, err := s.installPkgObj(pkg.PkgObj, func(pkgObj string) error {
+			if err := os.MkdirAll(filepath.Dir(pkgObj), 0777); err != nil {
+				return err
+			}
+			return ioutil.WriteFile(pkgObj, data, 0666)
+		})
+		if err != nil {
+			return nil, err
+		}
+		installed[entry.ImportPath] = pkgObj
+	}
+	return installed, nil
+}
+
+// seedStdlibFor seeds the archives of the standard library from the bundle
+// configured with Options.StdlibBundle, the first time a standard library
+// package without an installed archive is built, and points pkg to its seeded
+// archive if there is one.
+func (s *Session) seedStdlibFor(pkg *PackageData) error {
+	if s.options.StdlibBundle == "" || !pkg.Goroot || pkg.PkgObj == "" {
+		return nil
+	}
+	if s.stdlibSeeded == nil {
+		if _, err := os.Stat(pkg.PkgObj); err == nil {
+			return nil
+		}
+		installed, err := s.SeedStdlib(s.options.StdlibBundle)
+		if err != nil {
+			return err
+		}
+		if s.options.Verbose {
+			fmt.Fprintf(os.Stderr, "seeded %d standard library packages from %s\n", len(installed), s.options.StdlibBundle)
+		}
+		s.stdlibSeeded = installed
+	}
+	if pkgObj, ok := s.stdlibSeeded[pkg.ImportPath]; ok {
+		pkg.PkgObj = pkgObj
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package build
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+func writeTestBundle(t *testing.T, m stdlibManifest) string {
+	t.Helper()
+	bundle := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	f, err := os.Create(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	manifest, _ := json.Marshal(m)
+	tw.WriteHeader(&tar.Header{Name: stdlibManifestName, Mode: 0644, Size: int64(len(manifest)), Typeflag: tar.TypeReg})
+	tw.Write(manifest)
+	tw.Close()
+	gz.Close()
+	return bundle
+}
+
+func TestSeedStdlibChecksBundle(t *testing.T) {
+	goroot := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(goroot, "VERSION"), []byte("go1.16.5\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(&Options{GOROOT: goroot, Minify: true})
+	if err != nil {
+		t.Fatalf("NewSession() returned error: %v", err)
+	}
+
+	matching := stdlibManifest{GopherJS: compiler.Version, Go: "go1.16.5", GOOS: s.bctx.GOOS, InstallSuffix: "min"}
+	if installed, err := s.SeedStdlib(writeTestBundle(t, matching)); err != nil || len(installed) != 0 {
+		t.Errorf("SeedStdlib() of an empty matching bundle = %v, %v, want no packages", installed, err)
+	}
+
+	other := matching
+	other.Go = "go1.16.4"
+	if _, err := s.SeedStdlib(writeTestBundle(t, other)); err == nil || !strings.Contains(err.Error(), "go1.16.4") {
+		t.Errorf("SeedStdlib() of a bundle for another Go release returned error %v", err)
+	}
+	other = matching
+	other.InstallSuffix = ""
+	if _, err := s.SeedStdlib(writeTestBundle(t, other)); err == nil {
+		t.Errorf("SeedStdlib() of a bundle for another install suffix returned no error")
+	}
+	if _, err := s.SeedStdlib(filepath.Join(goroot, "VERSION")); err == nil {
+		t.Errorf("SeedStdlib() of a file that isn't a bundle returned no error")
+	}
+}
@@ -0,0 +1,107 @@
+package build
+
+import (
+	"go/ast"
+	"go/build"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+
+	"github.com/gopherjs/gopherjs/compiler"
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+)
+
+// SymbolSupport describes how well an exported symbol of a package works when
+// compiled by GopherJS.
+type SymbolSupport struct {
+	// Name of the symbol, qualified by the receiver type for methods, like
+	// "Dialer.Dial".
+	Name   string
+	Level  compiler.SupportLevel
+	Reason string // Why the symbol doesn't fully work, if it doesn't.
+	// Overlaid reports whether the symbol is implemented by the natives of
+	// GopherJS instead of the original source.
+	Overlaid bool
+}
+
+// PackageSupport builds the package importPath, and reports how well each of
+// its exported symbols works, sorted by name. The support levels come from
+// compiler.SymbolSupport.
+func (s *Session) PackageSupport(importPath string) ([]SymbolSupport, error) {
+	pkg, _, err := s.buildImportPathWithSrcDir(importPath, "")
+	if err != nil {
+		return nil, err
+	}
+	overlaid, err := overlaidDecls(s.bctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	scope := s.Types[importPath].Scope()
+	for _, name := range scope.Names() {
+		obj := scope.Lookup(name)
+		if !obj.Exported() {
+			continue
+		}
+		names = append(names, name)
+		tn, ok := obj.(*types.TypeName)
+		if !ok || tn.IsAlias() {
+			continue
+		}
+		named, ok := tn.Type().(*types.Named)
+		if !ok || types.IsInterface(named) {
+			continue
+		}
+		for i := 0; i < named.NumMethods(); i++ {
+			if m := named.Method(i); m.Exported() {
+				names = append(names, name+"."+m.Name())
+			}
+		}
+	}
+	sort.Strings(names)
+
+	symbols := make([]SymbolSupport, len(names))
+	for i, name := range names {
+		level, reason := compiler.SymbolSupport(importPath, name)
+		symbols[i] = SymbolSupport{Name: name, Level: level, Reason: reason, Overlaid: overlaid[name]}
+	}
+	return symbols, nil
+}
+
+// overlaidDecls returns the names of the package-level declarations of pkg
+// that come from the natives, named like in compiler.SymbolSupport.
+func overlaidDecls(bctx *build.Context, pkg *PackageData) (map[string]bool, error) {
+	fileSet := token.NewFileSet()
+	files, err := parseAndAugment(bctx, pkg.Package, false, fileSet)
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for _, file := range files {
+		// Original sources are in the package directory, natives in the
+		// virtual file system of natives.FS.
+		if filepath.Dir(fileSet.Position(file.Package).Filename) == pkg.Dir {
+			continue
+		}
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				names[astutil.FuncKey(d)] = true
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						names[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							names[name.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return names, nil
+}
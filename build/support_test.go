@@ -0,0 +1,25 @@
+package build
+
+import "testing"
+
+func TestOverlaidDecls(t *testing.T) {
+	bctx := NewBuildContext("", nil)
+	pkg, err := importWithSrcDir(*bctx, "net", "", 0, "")
+	if err != nil {
+		t.Fatalf("importWithSrcDir(%q) returned error: %s", "net", err)
+	}
+	got, err := overlaidDecls(bctx, pkg)
+	if err != nil {
+		t.Fatalf("overlaidDecls(%q) returned error: %s", "net", err)
+	}
+	for name, want := range map[string]bool{
+		"Listen":             true,
+		"Dialer.Dial":        true,
+		"Dialer.DialContext": false,
+		"ParseIP":            false,
+	} {
+		if got[name] != want {
+			t.Errorf("overlaidDecls(%q)[%q] = %v, want %v", "net", name, got[name], want)
+		}
+	}
+}
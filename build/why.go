@@ -0,0 +1,188 @@
+package build
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// ChainLink is a package of an import chain, see ImportChain.
+type ChainLink struct {
+	ImportPath string
+	// Uses are the package-level symbols of the package whose generated code
+	// refers to symbols of the next package of the chain. It is empty for the
+	// last package, and may be empty if the next package is only imported for
+	// its initialization.
+	Uses []string
+}
+
+// declName returns the qualified name of the package-level symbol d of the
+// package archive, or "" if it has none.
+func declName(archive *compiler.Archive, d *compiler.Decl) string {
+	switch {
+	case d.FullName != "":
+		return d.FullName
+	case d.DceObjectFilter != "":
+		return archive.ImportPath + "." + d.DceObjectFilter
+	}
+	return ""
+}
+
+// uses returns the names of the symbols of archive whose code refers to
+// symbols of the package importPath, with the DCE dependencies recorded in
+// archive.
+func uses(archive *compiler.Archive, importPath string) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, d := range archive.Declarations {
+		for _, dep := range d.DceDeps {
+			if !strings.HasPrefix(dep, importPath+".") || strings.Contains(dep[len(importPath)+1:], "/") {
+				continue
+			}
+			name := declName(archive, d)
+			if name == "" {
+				name = archive.ImportPath + ".init"
+			}
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+			break
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ImportChain returns the shortest chain of imports through which the program
+// of the packages pkgs, as returned by compiler.ImportDependencies for the
+// main package mainPath, depends on the package target. The chain starts with
+// mainPath, or with the runtime package, which every program depends on, and
+// ends with target.
+func ImportChain(pkgs []*compiler.Archive, mainPath, target string) ([]ChainLink, error) {
+	byPath := map[string]*compiler.Archive{}
+	for _, a := range pkgs {
+		byPath[a.ImportPath] = a
+	}
+	if byPath[target] == nil {
+		return nil, fmt.Errorf("package %s is not part of the program of %s", target, mainPath)
+	}
+
+	// Breadth-first search, recording the importer each package was first
+	// reached from.
+	from := map[string]string{}
+	queue := []string{}
+	for _, root := range []string{mainPath, "runtime"} {
+		if _, ok := from[root]; !ok && byPath[root] != nil {
+			from[root] = ""
+			queue = append(queue, root)
+		}
+	}
+	for len(queue) > 0 && queue[0] != target {
+		a := byPath[queue[0]]
+		queue = queue[1:]
+		for _, imp := range a.Imports {
+			if _, ok := from[imp]; !ok && byPath[imp] != nil {
+				from[imp] = a.ImportPath
+				queue = append(queue, imp)
+			}
+		}
+	}
+	if _, ok := from[target]; !ok {
+		return nil, fmt.Errorf("package %s is not imported by the program of %s", target, mainPath)
+	}
+
+	var chain []ChainLink
+	for path, next := target, ""; path != ""; path, next = from[path], path {
+		link := ChainLink{ImportPath: path}
+		if next != "" {
+			link.Uses = uses(byPath[path], next)
+		}
+		chain = append([]ChainLink{link}, chain...)
+	}
+	return chain, nil
+}
+
+// WriteImportGraph writes the import graph of the program of the packages
+// pkgs, as returned by compiler.ImportDependencies, in the DOT language of
+// Graphviz to w. If target is not empty, only the packages through which the
+// program depends on target are included, and the edges of the shortest
+// import chain from the main package mainPath to target are highlighted.
+func WriteImportGraph(w io.Writer, pkgs []*compiler.Archive, mainPath, target string) error {
+	byPath := map[string]*compiler.Archive{}
+	for _, a := range pkgs {
+		byPath[a.ImportPath] = a
+	}
+	include := func(string) bool { return true }
+	chainEdges := map[[2]string]bool{}
+	if target != "" {
+		chain, err := ImportChain(pkgs, mainPath, target)
+		if err != nil {
+			return err
+		}
+		for i := 1; i < len(chain); i++ {
+			chainEdges[[2]string{chain[i-1].ImportPath, chain[i].ImportPath}] = true
+		}
+		// Packages that reach target, found by following imports backwards.
+		importers := map[string][]string{}
+		for _, a := range pkgs {
+			for _, imp := range a.Imports {
+				importers[imp] = append(importers[imp], a.ImportPath)
+			}
+		}
+		reaches := map[string]bool{target: true}
+		queue := []string{target}
+		for len(queue) > 0 {
+			path := queue[0]
+			queue = queue[1:]
+			for _, importer := range importers[path] {
+				if !reaches[importer] {
+					reaches[importer] = true
+					queue = append(queue, importer)
+				}
+			}
+		}
+		include = func(path string) bool { return reaches[path] }
+	}
+
+	p := &printer{w: w}
+	p.printf("digraph imports {\n")
+	p.printf("\tnode [shape=box];\n")
+	for _, a := range pkgs {
+		if !include(a.ImportPath) {
+			continue
+		}
+		attrs := ""
+		if a.ImportPath == mainPath || a.ImportPath == target {
+			attrs = " [style=bold]"
+		}
+		p.printf("\t%q%s;\n", a.ImportPath, attrs)
+		for _, imp := range a.Imports {
+			if byPath[imp] == nil || !include(imp) {
+				continue
+			}
+			attrs := ""
+			if chainEdges[[2]string{a.ImportPath, imp}] {
+				attrs = " [color=red, penwidth=2]"
+			}
+			p.printf("\t%q -> %q%s;\n", a.ImportPath, imp, attrs)
+		}
+	}
+	p.printf("}\n")
+	return p.err
+}
+
+// printer writes to w, remembering the first error.
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
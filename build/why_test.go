@@ -0,0 +1,64 @@
+package build
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+func TestImportChain(t *testing.T) {
+	pkgs := []*compiler.Archive{
+		{ImportPath: "internal/bytealg"},
+		{ImportPath: "runtime", Imports: []string{"internal/bytealg"}},
+		{ImportPath: "errors", Imports: []string{"runtime"}},
+		{ImportPath: "regexp/syntax", Imports: []string{"errors"}},
+		{ImportPath: "regexp", Imports: []string{"regexp/syntax"}, Declarations: []*compiler.Decl{
+			{FullName: "regexp.Compile", DceObjectFilter: "Compile", DceDeps: []string{"regexp/syntax.Parse"}},
+		}},
+		{ImportPath: "example.com/app/util", Imports: []string{"errors", "regexp"}, Declarations: []*compiler.Decl{
+			{FullName: "example.com/app/util.Match", DceObjectFilter: "Match", DceDeps: []string{"errors.New", "regexp.MatchString"}},
+			{FullName: "(*example.com/app/util.Matcher).Match", DceObjectFilter: "Matcher", DceDeps: []string{"regexp.Regexp", "regexp.Compile"}},
+			{FullName: "example.com/app/util.Other", DceObjectFilter: "Other", DceDeps: []string{"regexp/syntax.Op"}},
+		}},
+		{ImportPath: "main", Imports: []string{"example.com/app/util"}},
+	}
+
+	chain, err := ImportChain(pkgs, "main", "regexp/syntax")
+	if err != nil {
+		t.Fatalf("ImportChain() returned error: %v", err)
+	}
+	want := []ChainLink{
+		{ImportPath: "main"},
+		{ImportPath: "example.com/app/util", Uses: []string{"(*example.com/app/util.Matcher).Match", "example.com/app/util.Match"}},
+		{ImportPath: "regexp", Uses: []string{"regexp.Compile"}},
+		{ImportPath: "regexp/syntax"},
+	}
+	if !reflect.DeepEqual(chain, want) {
+		t.Errorf("ImportChain() = %+v, want %+v", chain, want)
+	}
+
+	// The runtime package and its dependencies are part of every program.
+	chain, err = ImportChain(pkgs, "main", "internal/bytealg")
+	if err != nil || len(chain) != 2 || chain[0].ImportPath != "runtime" {
+		t.Errorf("ImportChain() of a runtime dependency = %+v, %v, want a chain from runtime", chain, err)
+	}
+
+	if _, err := ImportChain(pkgs, "main", "net/http"); err == nil {
+		t.Errorf("ImportChain() of a package that isn't part of the program returned no error")
+	}
+
+	var graph strings.Builder
+	if err := WriteImportGraph(&graph, pkgs, "main", "regexp"); err != nil {
+		t.Fatalf("WriteImportGraph() returned error: %v", err)
+	}
+	for _, want := range []string{`"main" -> "example.com/app/util" [color=red, penwidth=2];`, `"example.com/app/util" -> "regexp" [color=red, penwidth=2];`} {
+		if !strings.Contains(graph.String(), want) {
+			t.Errorf("WriteImportGraph() output does not contain %s:\n%s", want, graph.String())
+		}
+	}
+	if strings.Contains(graph.String(), `"errors"`) {
+		t.Errorf("WriteImportGraph() output contains a package that doesn't lead to the target:\n%s", graph.String())
+	}
+}
@@ -0,0 +1,301 @@
+package analysis
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+)
+
+// InBounds returns the index expressions of slices, arrays and pointers to
+// arrays in files whose indexes are provably within bounds, so that their
+// bounds checks can be omitted. It finds:
+//
+//   - s[i] in the body of for i := range s, and of for i := 0; i < len(s); i++,
+//     if neither s nor i may change in the body;
+//   - s[k] with a constant k that follows a statement indexing s[j] with a
+//     constant j >= k in the same block, like the _ = s[3] hints used with the
+//     gc compiler, if s may not change in between.
+//
+// Only local variables are considered, and variables whose address is taken or
+// that are assigned by function literals are assumed to change at any time.
+func InBounds(files []*ast.File, info *types.Info) map[*ast.IndexExpr]bool {
+	b := &boundsAnalysis{info: info, inBounds: make(map[*ast.IndexExpr]bool)}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			if fun, ok := decl.(*ast.FuncDecl); ok && fun.Body != nil {
+				b.unstable = unstableVars(fun.Body, info)
+				ast.Inspect(fun.Body, b.visit)
+			}
+		}
+	}
+	return b.inBounds
+}
+
+type boundsAnalysis struct {
+	info     *types.Info
+	inBounds map[*ast.IndexExpr]bool
+	// unstable are the variables of the current function that may change
+	// without being assigned to directly, see unstableVars.
+	unstable map[types.Object]bool
+}
+
+func (b *boundsAnalysis) visit(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.RangeStmt:
+		if n.Tok != token.DEFINE || n.Key == nil {
+			break
+		}
+		s, i := b.localVar(n.X), b.localVar(n.Key)
+		if s != nil && i != nil && b.indexable(s) {
+			b.loop(n.Body, s, i)
+		}
+	case *ast.ForStmt:
+		if s, i := b.countingLoop(n); s != nil {
+			b.loop(n.Body, s, i)
+		}
+	case *ast.BlockStmt:
+		b.hints(n.List)
+	case *ast.CaseClause:
+		b.hints(n.Body)
+	case *ast.CommClause:
+		b.hints(n.Body)
+	}
+	return true
+}
+
+// loop marks s[i] in the body of a loop in which 0 <= i < len(s) holds at the
+// start of each iteration.
+func (b *boundsAnalysis) loop(body *ast.BlockStmt, s, i *types.Var) {
+	if b.mayChange(body, i) || (!isArray(s.Type()) && b.mayChange(body, s)) {
+		return
+	}
+	inspectSkippingFuncLits(body, func(n ast.Node) {
+		if e, ok := n.(*ast.IndexExpr); ok && b.localVar(e.X) == s && b.localVar(e.Index) == i {
+			b.inBounds[e] = true
+		}
+	})
+}
+
+// countingLoop returns the variables s and i of a loop like
+// for i := 0; i < len(s); i++, or nils if loop isn't like that.
+func (b *boundsAnalysis) countingLoop(loop *ast.ForStmt) (s, i *types.Var) {
+	init, ok := loop.Init.(*ast.AssignStmt)
+	if !ok || init.Tok != token.DEFINE || len(init.Lhs) != 1 || len(init.Rhs) != 1 {
+		return nil, nil
+	}
+	if start := b.info.Types[init.Rhs[0]].Value; start == nil || start.Kind() != constant.Int || constant.Sign(start) < 0 {
+		return nil, nil
+	}
+	i = b.localVar(init.Lhs[0])
+	cond, ok := loop.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.LSS || b.localVar(cond.X) != i {
+		return nil, nil
+	}
+	call, ok := cond.Y.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil, nil
+	}
+	if fun, ok := call.Fun.(*ast.Ident); !ok || b.info.Uses[fun] != types.Universe.Lookup("len") {
+		return nil, nil
+	}
+	s = b.localVar(call.Args[0])
+	post, ok := loop.Post.(*ast.IncDecStmt)
+	if !ok || post.Tok != token.INC || b.localVar(post.X) != i {
+		return nil, nil
+	}
+	if i == nil || s == nil || !b.indexable(s) {
+		return nil, nil
+	}
+	return s, i
+}
+
+// hints marks constant indexes of the statements of list that follow a
+// statement which indexes the same variable with a constant that is at least
+// as large.
+func (b *boundsAnalysis) hints(list []ast.Stmt) {
+	checked := map[*types.Var]int64{}
+	for _, stmt := range list {
+		if _, ok := stmt.(*ast.LabeledStmt); ok {
+			// The statement may be reached by a goto, skipping the checks.
+			return
+		}
+		for s, max := range checked {
+			if b.mayChange(stmt, s) {
+				delete(checked, s)
+				continue
+			}
+			inspectSkippingFuncLits(stmt, func(n ast.Node) {
+				if e, ok := n.(*ast.IndexExpr); ok && b.localVar(e.X) == s {
+					if k, ok := b.constIndex(e); ok && k <= max {
+						b.inBounds[e] = true
+					}
+				}
+			})
+		}
+		// Only expressions that are always evaluated by the statement are
+		// checked.
+		if assign, ok := stmt.(*ast.AssignStmt); ok {
+			for _, e := range append(append([]ast.Expr{}, assign.Lhs...), assign.Rhs...) {
+				e, ok := astutil.RemoveParens(e).(*ast.IndexExpr)
+				if !ok {
+					continue
+				}
+				s := b.localVar(e.X)
+				if s == nil || !b.indexable(s) || (!isArray(s.Type()) && b.mayChange(stmt, s)) {
+					continue
+				}
+				if k, ok := b.constIndex(e); ok {
+					if max, ok := checked[s]; !ok || k > max {
+						checked[s] = k
+					}
+				}
+			}
+		}
+	}
+}
+
+func (b *boundsAnalysis) constIndex(e *ast.IndexExpr) (int64, bool) {
+	v := b.info.Types[e.Index].Value
+	if v == nil || v.Kind() != constant.Int {
+		return 0, false
+	}
+	return constant.Int64Val(v)
+}
+
+// localVar returns the local variable that e refers to, if e is an identifier
+// of one.
+func (b *boundsAnalysis) localVar(e ast.Expr) *types.Var {
+	if e == nil {
+		return nil
+	}
+	id, ok := astutil.RemoveParens(e).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	v, ok := b.info.ObjectOf(id).(*types.Var)
+	if !ok || v.IsField() || v.Pkg() == nil || v.Parent() == v.Pkg().Scope() {
+		return nil
+	}
+	return v
+}
+
+// indexable reports whether v is a slice, an array or a pointer to an array.
+func (b *boundsAnalysis) indexable(v *types.Var) bool {
+	switch t := v.Type().Underlying().(type) {
+	case *types.Slice, *types.Array:
+		return true
+	case *types.Pointer:
+		_, ok := t.Elem().Underlying().(*types.Array)
+		return ok
+	}
+	return false
+}
+
+// isArray reports whether t is an array or a pointer to an array, whose
+// length can't change.
+func isArray(t types.Type) bool {
+	if p, ok := t.Underlying().(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	_, ok := t.Underlying().(*types.Array)
+	return ok
+}
+
+// mayChange reports whether the variable v may change while n runs.
+func (b *boundsAnalysis) mayChange(n ast.Node, v *types.Var) bool {
+	if b.unstable[v] {
+		return true
+	}
+	changed := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		for _, e := range assignedExprs(n) {
+			if b.localVar(e) == v {
+				changed = true
+			}
+		}
+		return !changed
+	})
+	return changed
+}
+
+// assignedExprs returns the expressions that the statement n assigns to.
+func assignedExprs(n ast.Node) []ast.Expr {
+	switch n := n.(type) {
+	case *ast.AssignStmt:
+		return n.Lhs
+	case *ast.IncDecStmt:
+		return []ast.Expr{n.X}
+	case *ast.RangeStmt:
+		return []ast.Expr{n.Key, n.Value}
+	}
+	return nil
+}
+
+// unstableVars returns the variables of the function body whose address is
+// taken, explicitly or by calling methods with pointer receivers, or that are
+// assigned by function literals, which may change whenever a function is
+// called.
+func unstableVars(body *ast.BlockStmt, info *types.Info) map[types.Object]bool {
+	unstable := map[types.Object]bool{}
+	mark := func(e ast.Expr) {
+		if e == nil {
+			return
+		}
+		if id, ok := astutil.RemoveParens(e).(*ast.Ident); ok {
+			if obj := info.ObjectOf(id); obj != nil {
+				unstable[obj] = true
+			}
+		}
+	}
+	var inspect func(n ast.Node, inFuncLit bool)
+	inspect = func(n ast.Node, inFuncLit bool) {
+		ast.Inspect(n, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				if !inFuncLit {
+					inspect(n.Body, true)
+					return false
+				}
+			case *ast.UnaryExpr:
+				if n.Op == token.AND {
+					mark(n.X)
+				}
+			case *ast.SelectorExpr:
+				// Calls of methods with pointer receivers take the address of
+				// their receivers implicitly.
+				if sel := info.Selections[n]; sel != nil && sel.Kind() == types.MethodVal {
+					_, ptrRecv := sel.Obj().Type().(*types.Signature).Recv().Type().Underlying().(*types.Pointer)
+					_, ptrX := sel.Recv().Underlying().(*types.Pointer)
+					if ptrRecv && !ptrX {
+						mark(n.X)
+					}
+				}
+			}
+			if inFuncLit {
+				for _, e := range assignedExprs(n) {
+					mark(e)
+				}
+			}
+			return true
+		})
+	}
+	inspect(body, false)
+	return unstable
+}
+
+// inspectSkippingFuncLits calls f for the nodes of n, except those of function
+// literals, which may run at any later time.
+func inspectSkippingFuncLits(n ast.Node, f func(ast.Node)) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		if n != nil {
+			f(n)
+		}
+		return true
+	})
+}
@@ -54,6 +54,9 @@ type Info struct {
 	FuncDeclInfos map[*types.Func]*FuncInfo
 	FuncLitInfos  map[*ast.FuncLit]*FuncInfo
 	InitFuncInfo  *FuncInfo // Context for package variable initialization.
+	// InBounds are the index expressions that need no bounds checks, see
+	// InBounds.
+	InBounds map[*ast.IndexExpr]bool
 
 	isImportedBlocking func(*types.Func) bool // For functions from other packages.
 	allInfos           []*FuncInfo
@@ -94,6 +97,7 @@ func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info
 		isImportedBlocking: isBlocking,
 		FuncDeclInfos:      make(map[*types.Func]*FuncInfo),
 		FuncLitInfos:       make(map[*ast.FuncLit]*FuncInfo),
+		InBounds:           InBounds(files, typesInfo),
 	}
 	info.InitFuncInfo = info.newFuncInfo(nil)
 
@@ -114,7 +118,7 @@ func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info
 		// call is actually blocking. Doing so might reduce generated code size a
 		// bit.
 		for _, returnStmt := range funcInfo.returnStmts {
-			funcInfo.markBlocking(returnStmt)
+			funcInfo.blockedBy(returnStmt, "has a deferred call, which may block, and returns", nil)
 		}
 	}
 
@@ -125,7 +129,7 @@ func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info
 			for callee, callSites := range caller.localCallees {
 				if info.IsBlocking(callee) {
 					for _, callSite := range callSites {
-						caller.markBlocking(callSite)
+						caller.blockedBy(callSite, "calls "+callee.FullName(), callee)
 					}
 					delete(caller.localCallees, callee)
 					done = false
@@ -152,8 +156,20 @@ func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info
 	return info
 }
 
+// BlockingReason is the first operation found in a function that may block,
+// which makes the function blocking.
+type BlockingReason struct {
+	Pos token.Pos
+	// What describes the operation, like "receives from a channel".
+	What string
+	// Callee is the blocking function called by the operation, if any.
+	Callee *types.Func
+}
+
 type FuncInfo struct {
 	HasDefer bool
+	// BlockingReason explains why the function is blocking, nil if it isn't.
+	BlockingReason *BlockingReason
 	// Nodes are "flattened" into a switch-case statement when we need to be able
 	// to jump into an arbitrary position in the code with a GOTO statement, or
 	// resume a goroutine after a blocking call unblocks.
@@ -210,7 +226,7 @@ func (fi *FuncInfo) Visit(node ast.Node) ast.Visitor {
 		return fi.visitCallExpr(n)
 	case *ast.SendStmt:
 		// Sending into a channel is blocking.
-		fi.markBlocking(fi.visitorStack)
+		fi.blockedBy(fi.visitorStack, "sends to a channel", nil)
 		return fi
 	case *ast.UnaryExpr:
 		switch n.Op {
@@ -220,13 +236,13 @@ func (fi *FuncInfo) Visit(node ast.Node) ast.Visitor {
 			}
 		case token.ARROW:
 			// Receiving from a channel is blocking.
-			fi.markBlocking(fi.visitorStack)
+			fi.blockedBy(fi.visitorStack, "receives from a channel", nil)
 		}
 		return fi
 	case *ast.RangeStmt:
 		if _, ok := fi.pkgInfo.TypeOf(n.X).Underlying().(*types.Chan); ok {
 			// for-range loop over a channel is blocking.
-			fi.markBlocking(fi.visitorStack)
+			fi.blockedBy(fi.visitorStack, "ranges over a channel", nil)
 		}
 		return fi
 	case *ast.SelectStmt:
@@ -236,7 +252,7 @@ func (fi *FuncInfo) Visit(node ast.Node) ast.Visitor {
 			}
 		}
 		// Select statements without a default case are blocking.
-		fi.markBlocking(fi.visitorStack)
+		fi.blockedBy(fi.visitorStack, "has a select statement without a default case", nil)
 		return fi
 	case *ast.CommClause:
 		// FIXME(nevkontakte): Does this need to be manually spelled out? Presumably
@@ -307,8 +323,12 @@ func (fi *FuncInfo) visitCallExpr(n *ast.CallExpr) ast.Visitor {
 		// FIXME(nevkontakte): What if the function literal is calling a blocking
 		// function through several layers of indirection? This will only become
 		// known at a later stage of analysis.
-		if len(fi.pkgInfo.FuncLitInfos[f].Blocking) != 0 {
-			fi.markBlocking(fi.visitorStack)
+		if lit := fi.pkgInfo.FuncLitInfos[f]; len(lit.Blocking) != 0 {
+			if r := lit.BlockingReason; fi.BlockingReason == nil && r != nil {
+				// Point to the operation in the function literal that blocks.
+				fi.BlockingReason = &BlockingReason{Pos: r.Pos, What: "calls a function literal that " + r.What, Callee: r.Callee}
+			}
+			fi.blockedBy(fi.visitorStack, "calls a blocking function literal", nil)
 		}
 		return nil // No need to walk under this CallExpr, we already did it manually.
 	default:
@@ -318,7 +338,7 @@ func (fi *FuncInfo) visitCallExpr(n *ast.CallExpr) ast.Visitor {
 		} else {
 			// The function is returned by a non-trivial expression. We have to be
 			// conservative and assume that function might be blocking.
-			fi.markBlocking(fi.visitorStack)
+			fi.blockedBy(fi.visitorStack, "calls a function value", nil)
 		}
 	}
 
@@ -331,13 +351,13 @@ func (fi *FuncInfo) callTo(callee types.Object) {
 		if recv := o.Type().(*types.Signature).Recv(); recv != nil {
 			if _, ok := recv.Type().Underlying().(*types.Interface); ok {
 				// Conservatively assume that an interfact implementation might be blocking.
-				fi.markBlocking(fi.visitorStack)
+				fi.blockedBy(fi.visitorStack, "calls interface method "+o.FullName(), nil)
 				return
 			}
 		}
 		if o.Pkg() != fi.pkgInfo.Pkg {
 			if fi.pkgInfo.isImportedBlocking(o) {
-				fi.markBlocking(fi.visitorStack)
+				fi.blockedBy(fi.visitorStack, "calls "+o.FullName(), o)
 			}
 			return
 		}
@@ -346,8 +366,23 @@ func (fi *FuncInfo) callTo(callee types.Object) {
 		fi.localCallees[o] = append(fi.localCallees[o], fi.visitorStack.copy())
 	case *types.Var:
 		// Conservatively assume that a function in a variable might be blocking.
-		fi.markBlocking(fi.visitorStack)
+		fi.blockedBy(fi.visitorStack, "calls function variable "+o.Name(), nil)
+	}
+}
+
+// blockedBy marks the nodes of stack as blocking because of the operation at
+// its end, and records the operation as the reason the function is blocking if
+// it is the first one found.
+func (fi *FuncInfo) blockedBy(stack astPath, what string, callee *types.Func) {
+	if fi.BlockingReason == nil {
+		r := &BlockingReason{What: what, Callee: callee}
+		// Nodes synthesized when simplifying the AST have no position.
+		for i := len(stack) - 1; i >= 0 && !r.Pos.IsValid(); i-- {
+			r.Pos = stack[i].Pos()
+		}
+		fi.BlockingReason = r
 	}
+	fi.markBlocking(stack)
 }
 
 func (fi *FuncInfo) markBlocking(stack astPath) {
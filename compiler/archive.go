@@ -0,0 +1,75 @@
+package compiler
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ArchiveFormat is the version of the encoding of archives written by
+// WriteArchive. It must be incremented whenever the encoding of Archive
+// changes incompatibly, e.g. when fields are removed or change their type.
+const ArchiveFormat = 1
+
+// archiveMagic starts the header of archives, which is followed by the
+// archive format and the GopherJS version that wrote the archive, separated
+// by a space and terminated by a newline. Archives written before the header
+// was introduced start with a gob stream instead.
+const archiveMagic = "gopherjs archive "
+
+// ArchiveVersionError is returned by ReadArchive for archives that were
+// written by another version of GopherJS, or with another archive format.
+// Such archives need to be rebuilt, which gopherjs clean forces.
+type ArchiveVersionError struct {
+	File    string
+	Format  int    // Archive format of the archive, 0 if it has no header.
+	Version string // GopherJS version that wrote the archive, if known.
+}
+
+func (e *ArchiveVersionError) Error() string {
+	built := "an older GopherJS release without versioned archives"
+	if e.Version != "" {
+		built = fmt.Sprintf("GopherJS %s (archive format %d)", e.Version, e.Format)
+	}
+	return fmt.Sprintf("archive %s was built by %s, need GopherJS %s (archive format %d); rebuild it with gopherjs clean", e.File, built, Version, ArchiveFormat)
+}
+
+// writeArchiveHeader writes the header of an archive written by this version
+// of GopherJS to w.
+func writeArchiveHeader(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s%d %s\n", archiveMagic, ArchiveFormat, Version)
+	return err
+}
+
+// readArchiveHeader reads the header of the archive filename from r, and
+// reports an *ArchiveVersionError unless it was written by this version of
+// GopherJS.
+func readArchiveHeader(filename string, r *bufio.Reader) error {
+	magic, err := r.Peek(len(archiveMagic))
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if !bytes.Equal(magic, []byte(archiveMagic)) {
+		return &ArchiveVersionError{File: filename}
+	}
+	r.Discard(len(archiveMagic))
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("archive %s has a malformed header: %v", filename, err)
+	}
+	fields := strings.Fields(line)
+	if len(fields) != 2 {
+		return fmt.Errorf("archive %s has a malformed header %q", filename, archiveMagic+line)
+	}
+	format, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return fmt.Errorf("archive %s has a malformed header %q", filename, archiveMagic+line)
+	}
+	if format != ArchiveFormat || fields[1] != Version {
+		return &ArchiveVersionError{File: filename, Format: format, Version: fields[1]}
+	}
+	return nil
+}
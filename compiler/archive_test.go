@@ -0,0 +1,50 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/gob"
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestArchiveVersioning(t *testing.T) {
+	file, fset := parseSource(t, `package testcase; func Answer() int { return 42 }`)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}}
+	archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+	if err != nil {
+		t.Fatalf("Compile() returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteArchive(archive, &buf); err != nil {
+		t.Fatalf("WriteArchive() returned error: %v", err)
+	}
+	got, err := ReadArchive("testcase.a", "testcase", bytes.NewReader(buf.Bytes()), map[string]*types.Package{})
+	if err != nil {
+		t.Fatalf("ReadArchive() returned error: %v", err)
+	}
+	if got.ImportPath != "testcase" {
+		t.Errorf("ReadArchive() returned archive of %q, want %q", got.ImportPath, "testcase")
+	}
+
+	// An archive of another GopherJS version.
+	other := strings.Replace(buf.String(), Version, "1.0.0+go1.10", 1)
+	_, err = ReadArchive("testcase.a", "testcase", strings.NewReader(other), map[string]*types.Package{})
+	if verr, ok := err.(*ArchiveVersionError); !ok || verr.Version != "1.0.0+go1.10" || verr.Format != ArchiveFormat {
+		t.Errorf("ReadArchive() of an archive of another version returned error %v, want an ArchiveVersionError", err)
+	} else if !strings.Contains(err.Error(), "gopherjs clean") {
+		t.Errorf("ArchiveVersionError %q doesn't mention how to rebuild archives", err)
+	}
+
+	// An archive written before archives had a header.
+	var old bytes.Buffer
+	if err := gob.NewEncoder(&old).Encode(archive); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ReadArchive("testcase.a", "testcase", &old, map[string]*types.Package{})
+	if verr, ok := err.(*ArchiveVersionError); !ok || verr.Format != 0 {
+		t.Errorf("ReadArchive() of an unversioned archive returned error %v, want an ArchiveVersionError", err)
+	}
+}
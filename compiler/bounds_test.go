@@ -0,0 +1,118 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestBoundsCheckElimination(t *testing.T) {
+	file, fset := parseSource(t, `package testcase
+
+	type Stack []int
+
+	func (s *Stack) Push(v int) { *s = append(*s, v) }
+
+	func Range(s []int) (sum int) {
+		for i := range s {
+			sum += s[i]
+		}
+		for _, v := range s {
+			sum += v
+		}
+		return sum
+	}
+
+	func Counting(s []int, a *[4]int) {
+		for i := 0; i < len(s); i++ {
+			s[i] = 0
+		}
+		for i := range a {
+			a[i] = 0
+		}
+	}
+
+	func Hint(b []byte) uint32 {
+		_ = b[3]
+		return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+	}
+
+	func Reassigned(s []int) (sum int) {
+		for i := range s {
+			sum += s[i]
+			s = s[:0]
+		}
+		return sum
+	}
+
+	func IndexChanged(s []int) (sum int) {
+		for i := 0; i < len(s); i++ {
+			sum += s[i]
+			i++
+		}
+		return sum
+	}
+
+	func Captured(s []int) (sum int) {
+		shrink := func() { s = nil }
+		for i := range s {
+			shrink()
+			sum += s[i]
+		}
+		return sum
+	}
+
+	func Method(s Stack) (sum int) {
+		for i := range s {
+			s.Push(1)
+			sum += s[i]
+		}
+		return sum
+	}
+
+	func HintTooSmall(b []byte) byte {
+		_ = b[1]
+		return b[0] + b[2]
+	}
+
+	var global []int
+
+	func Global() (sum int) {
+		for i := range global {
+			sum += global[i]
+		}
+		return sum
+	}
+	`)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}}
+	archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+	if err != nil {
+		t.Fatalf("Compile() returned error: %v", err)
+	}
+
+	want := map[string]int{
+		"testcase.Range":        0,
+		"testcase.Counting":     0,
+		"testcase.Hint":         1,
+		"testcase.Reassigned":   1,
+		"testcase.IndexChanged": 1,
+		"testcase.Captured":     1,
+		"testcase.Method":       1,
+		"testcase.HintTooSmall": 2,
+		"testcase.Global":       1,
+	}
+	for _, d := range archive.Declarations {
+		n, ok := want[d.FullName]
+		if !ok {
+			continue
+		}
+		if got := strings.Count(string(d.DeclCode), "index out of range"); got != n {
+			t.Errorf("%s has %d bounds checks, want %d:\n%s", d.FullName, got, n, d.DeclCode)
+		}
+		delete(want, d.FullName)
+	}
+	for name := range want {
+		t.Errorf("No declaration of %s", name)
+	}
+}
@@ -6,6 +6,7 @@
 package compiler
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/binary"
 	"encoding/gob"
@@ -14,6 +15,7 @@ import (
 	"go/token"
 	"go/types"
 	"io"
+	"path"
 	"strings"
 
 	"github.com/gopherjs/gopherjs/compiler/prelude"
@@ -80,6 +82,18 @@ type Archive struct {
 	Minified bool
 	// A list of go:linkname directives encountered in the package.
 	GoLinknames []GoLinkname
+	// Names of functions exported with //gopherjs:export directives.
+	Exports []string
+	// TypeScript declarations of the functions in Exports, in the same order.
+	ExportSignatures []string
+	// Uses of standard library symbols that are unavailable with GopherJS,
+	// rejected by builds that don't allow them.
+	Unavailable []UnsupportedUse
+	// Whether initializing the package has no side effects besides
+	// initializing its imports and its own package-level variables. If none
+	// of the symbols of such a package are live, it is left out of the
+	// program, along with its initialization. See pureDirective.
+	PureInit bool
 }
 
 // Decl represents a package-level symbol (e.g. a function, variable or type).
@@ -124,6 +138,26 @@ type Decl struct {
 	// that it can be resumed after a blocking operation completes without
 	// blocking the main thread in the meantime.
 	Blocking bool
+	// BlockingReason describes the operation that makes a blocking function
+	// blocking, with its position, and BlockingCallee is the full name of the
+	// blocking function it calls, if any, so that the chain of calls that
+	// makes a function blocking can be followed across packages.
+	BlockingReason string
+	BlockingCallee string
+	// Set to true if calling the function or method has no side effects, so
+	// that unused package-level variables initialized by calling it can be
+	// eliminated. See pureDirective.
+	Pure bool
+	// JavaScript code of the result of a function or method that is simple
+	// enough to replace its calls from other packages with, with the
+	// placeholders of inlineParam for its receiver and parameters. It's only
+	// set if the package is compiled with ImportContext.Inline, like the
+	// packages whose calls are replaced. See inlineTemplate.
+	Inline string
+	// The import path of the package that the decl imports and initializes,
+	// for the decls of the imports of a package, which are live if the
+	// imported package is part of the program.
+	ImportedPkg string
 }
 
 type Dependency struct {
@@ -173,24 +207,40 @@ type dceInfo struct {
 	methodFilter string
 }
 
-func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
+// selectDecls returns the live decls of the packages pkgs of a program, which
+// are reachable from its entry points, and the packages that are part of the
+// program. Packages whose initialization has no side effects, see
+// Archive.PureInit, are left out if none of their symbols are live, unless
+// they are needed to initialize a package that is part of the program.
+//
+// In the LTO mode, exported methods are also only live if live code may call
+// them, see exportedMethodFilter.
+func selectDecls(pkgs []*Archive, gls goLinknameSet, lto bool) (map[*Decl]struct{}, []*Archive) {
 	mainPkg := pkgs[len(pkgs)-1]
-	minify := mainPkg.Minified
-
-	// Aggregate all go:linkname directives in the program together.
-	gls := goLinknameSet{}
-	for _, pkg := range pkgs {
-		gls.Add(pkg.GoLinknames)
+	pureInit := func(pkg *Archive) bool {
+		return pkg.PureInit && len(pkg.IncJSCode) == 0 && pkg != mainPkg && pkg.ImportPath != "runtime"
 	}
 
 	byFilter := make(map[string][]*dceInfo)
 	var pendingDecls []*Decl // A queue of live decls to find other live decls.
+	// The entry points of packages whose initialization has no side effects,
+	// which are only live if the package is part of the program.
+	initDecls := make(map[*Archive][]*Decl)
 	for _, pkg := range pkgs {
 		for _, d := range pkg.Declarations {
+			if d.ImportedPkg != "" {
+				// Imports are live if the imported package is part of the
+				// program, see below.
+				continue
+			}
 			if d.DceObjectFilter == "" && d.DceMethodFilter == "" {
 				// This is an entry point (like main() or init() functions) or a variable
 				// initializer which has a side effect, consider it live.
-				pendingDecls = append(pendingDecls, d)
+				if pureInit(pkg) {
+					initDecls[pkg] = append(initDecls[pkg], d)
+				} else {
+					pendingDecls = append(pendingDecls, d)
+				}
 				continue
 			}
 			if gls.IsImplementation(d.LinkingName) {
@@ -209,37 +259,152 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 				info.methodFilter = pkg.ImportPath + "." + d.DceMethodFilter
 				byFilter[info.methodFilter] = append(byFilter[info.methodFilter], info)
 			}
+			if filter := ltoMethodFilter(pkg, d); lto && filter != "" {
+				info.methodFilter = filter
+				byFilter[filter] = append(byFilter[filter], info)
+			}
 		}
 	}
 
 	dceSelection := make(map[*Decl]struct{}) // Known live decls.
-	for len(pendingDecls) != 0 {
-		d := pendingDecls[len(pendingDecls)-1]
-		pendingDecls = pendingDecls[:len(pendingDecls)-1]
-
-		dceSelection[d] = struct{}{} // Mark the decl as live.
-
-		// Consider all decls the current one is known to depend on and possible add
-		// them to the live queue.
-		for _, dep := range d.DceDeps {
-			if infos, ok := byFilter[dep]; ok {
-				delete(byFilter, dep)
-				for _, info := range infos {
-					if info.objectFilter == dep {
-						info.objectFilter = ""
+	kept := make(map[string]bool)            // Packages that are part of the program.
+	for {
+		for len(pendingDecls) != 0 {
+			d := pendingDecls[len(pendingDecls)-1]
+			pendingDecls = pendingDecls[:len(pendingDecls)-1]
+
+			dceSelection[d] = struct{}{} // Mark the decl as live.
+
+			deps := d.DceDeps
+			for _, dep := range d.DceDeps {
+				if lto && reflectiveDeps[dep] {
+					// Any exported method may be called.
+					for filter := range byFilter {
+						if isExportedMethodFilter(filter) {
+							deps = append(deps, filter)
+						}
 					}
-					if info.methodFilter == dep {
-						info.methodFilter = ""
+					break
+				}
+			}
+
+			// Consider all decls the current one is known to depend on and possible add
+			// them to the live queue.
+			for _, dep := range deps {
+				if infos, ok := byFilter[dep]; ok {
+					delete(byFilter, dep)
+					for _, info := range infos {
+						if info.objectFilter == dep {
+							info.objectFilter = ""
+						}
+						if info.methodFilter == dep {
+							info.methodFilter = ""
+						}
+						if info.objectFilter == "" && info.methodFilter == "" {
+							pendingDecls = append(pendingDecls, info.decl)
+						}
 					}
-					if info.objectFilter == "" && info.methodFilter == "" {
-						pendingDecls = append(pendingDecls, info.decl)
+				}
+			}
+		}
+
+		// A package is part of the program if its initialization has side
+		// effects or some of its symbols are live.
+		for _, pkg := range pkgs {
+			if kept[pkg.ImportPath] {
+				continue
+			}
+			live := !pureInit(pkg)
+			for _, d := range pkg.Declarations {
+				if _, ok := dceSelection[d]; ok {
+					live = true
+					break
+				}
+			}
+			if live {
+				kept[pkg.ImportPath] = true
+				pendingDecls = append(pendingDecls, initDecls[pkg]...)
+			}
+		}
+		if len(pendingDecls) != 0 {
+			continue
+		}
+
+		// Packages are initialized by the packages that import them, so the
+		// importers of a package that is part of the program are too, unless
+		// another importer of it is.
+		initialized := make(map[string]bool)
+		for _, pkg := range pkgs {
+			if kept[pkg.ImportPath] {
+				for _, imp := range pkg.Imports {
+					initialized[imp] = true
+				}
+			}
+		}
+		for _, pkg := range pkgs {
+			if kept[pkg.ImportPath] || !pureInit(pkg) {
+				continue
+			}
+			for _, imp := range pkg.Imports {
+				if kept[imp] && !initialized[imp] && imp != "runtime" {
+					kept[pkg.ImportPath] = true
+					pendingDecls = append(pendingDecls, initDecls[pkg]...)
+					for _, imp := range pkg.Imports {
+						initialized[imp] = true
 					}
+					break
 				}
 			}
 		}
+		if len(pendingDecls) == 0 {
+			break
+		}
+	}
+
+	var keptPkgs []*Archive
+	for _, pkg := range pkgs {
+		if !kept[pkg.ImportPath] {
+			continue
+		}
+		keptPkgs = append(keptPkgs, pkg)
+		for _, d := range pkg.Declarations {
+			if d.ImportedPkg != "" && kept[d.ImportedPkg] {
+				dceSelection[d] = struct{}{}
+			}
+		}
+	}
+	return dceSelection, keptPkgs
+}
+
+func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter, opts LinkOptions) error {
+	mainPkg := pkgs[len(pkgs)-1]
+	minify := mainPkg.Minified
+
+	var exports []string
+	for _, pkg := range pkgs {
+		exports = append(exports, pkg.Exports...)
+	}
+	if opts.StartStop && mainPkg.Name != "main" {
+		return fmt.Errorf("cannot link package %s with start and stop functions, it isn't a command", mainPkg.ImportPath)
+	}
+	if opts.Library {
+		if mainPkg.Name == "main" {
+			return fmt.Errorf("cannot link main package %s as a library", mainPkg.ImportPath)
+		}
+		if len(mainPkg.Exports) == 0 {
+			return fmt.Errorf("package %s has no functions exported with %s directives", mainPkg.ImportPath, exportDirective)
+		}
+	}
+
+	// Aggregate all go:linkname directives in the program together.
+	gls := goLinknameSet{}
+	for _, pkg := range pkgs {
+		gls.Add(pkg.GoLinknames)
 	}
 
-	if _, err := w.Write([]byte("\"use strict\";\n(function() {\n\n")); err != nil {
+	dceSelection, pkgs := selectDecls(pkgs, gls, opts.LTO)
+
+	if _, err := io.WriteString(w, programHeader(opts)); err != nil {
 		return err
 	}
 	preludeJS := prelude.Prelude
@@ -252,15 +417,90 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 	if _, err := w.Write([]byte("\n")); err != nil {
 		return err
 	}
+	if _, err := io.WriteString(w, moduleRuntime(opts)); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, envRuntime(opts)); err != nil {
+		return err
+	}
+	if opts.InitReport {
+		if _, err := io.WriteString(w, initReportRuntime); err != nil {
+			return err
+		}
+	}
+	if opts.DevTools {
+		if _, err := io.WriteString(w, devtoolsRuntime); err != nil {
+			return err
+		}
+	}
+	if opts.HeapNames {
+		if _, err := io.WriteString(w, heapNamesRuntime); err != nil {
+			return err
+		}
+	}
+	if len(exports) != 0 || opts.StartStop {
+		if _, err := io.WriteString(w, exportRuntime(opts.ExportNamespace, exportRoot(opts))); err != nil {
+			return err
+		}
+	}
+	if opts.Library {
+		if _, err := io.WriteString(w, libraryExportRuntime); err != nil {
+			return err
+		}
+	}
 
 	// write packages
 	for _, pkg := range pkgs {
+		if opts.InitReport {
+			if _, err := w.Write([]byte("var $initReportStart = $initReport.now();\n")); err != nil {
+				return err
+			}
+		}
+		if opts.PackageStart != nil {
+			opts.PackageStart(pkg.ImportPath)
+		}
 		if err := WritePkgCode(pkg, dceSelection, gls, minify, w); err != nil {
 			return err
 		}
+		if opts.InitReport {
+			if _, err := fmt.Fprintf(w, "$initReport.defined(%q, $initReportStart);\n", pkg.ImportPath); err != nil {
+				return err
+			}
+		}
+	}
+	if opts.PackageStart != nil {
+		opts.PackageStart("")
 	}
 
-	if _, err := w.Write([]byte("$synthesizeMethods();\n$initAllLinknames();var $mainPkg = $packages[\"" + string(mainPkg.ImportPath) + "\"];\n$packages[\"runtime\"].$init();\n$go($mainPkg.$init, []);\n$flushConsole();\n\n}).call(this);\n")); err != nil {
+	if _, err := w.Write([]byte("$synthesizeMethods();\n$initAllLinknames();var $mainPkg = $packages[\"" + string(mainPkg.ImportPath) + "\"];\n")); err != nil {
+		return err
+	}
+	if opts.InitReport {
+		if err := writeInitReportWrap(mainPkg, pkgs, w); err != nil {
+			return err
+		}
+	}
+	if opts.Library {
+		// Libraries are initialized when one of the exported functions is called
+		// for the first time, rather than when loaded.
+		if _, err := w.Write([]byte("var $libInit = function() {\n$libInit = function() {};\n$packages[\"runtime\"].$init();\n$go($mainPkg.$init, []);\n$flushConsole();\n};\n")); err != nil {
+			return err
+		}
+		for _, name := range exports {
+			if _, err := fmt.Fprintf(w, "$exportLazy(%s, function() { $libInit(); });\n", encodeString(name)); err != nil {
+				return err
+			}
+		}
+	} else if opts.StartStop {
+		if _, err := fmt.Fprintf(w, startStopRuntime, encodeString(path.Base(mainPkg.ImportPath))); err != nil {
+			return err
+		}
+	} else {
+		if _, err := w.Write([]byte("$packages[\"runtime\"].$init();\n$go($mainPkg.$init, []);\n$flushConsole();\n")); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(w, programFooter(opts, exports)); err != nil {
 		return err
 	}
 
@@ -356,11 +596,18 @@ func WritePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, gls goLinknameS
 	return nil
 }
 
+// ReadArchive reads the archive of the package path written by WriteArchive
+// from r, and adds its types to packages. It returns an *ArchiveVersionError if
+// the archive was written by another version of GopherJS.
 func ReadArchive(filename, path string, r io.Reader, packages map[string]*types.Package) (*Archive, error) {
-	var a Archive
-	if err := gob.NewDecoder(r).Decode(&a); err != nil {
+	br := bufio.NewReader(r)
+	if err := readArchiveHeader(filename, br); err != nil {
 		return nil, err
 	}
+	var a Archive
+	if err := gob.NewDecoder(br).Decode(&a); err != nil {
+		return nil, fmt.Errorf("reading archive %s: %v", filename, err)
+	}
 
 	var err error
 	packages[path], err = gcexportdata.Read(bytes.NewReader(a.ExportData), token.NewFileSet(), packages, path)
@@ -371,7 +618,12 @@ func ReadArchive(filename, path string, r io.Reader, packages map[string]*types.
 	return &a, nil
 }
 
+// WriteArchive writes the archive a to w, with a header identifying the
+// version of GopherJS that wrote it.
 func WriteArchive(a *Archive, w io.Writer) error {
+	if err := writeArchiveHeader(w); err != nil {
+		return err
+	}
 	return gob.NewEncoder(w).Encode(a)
 }
 
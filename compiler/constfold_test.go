@@ -0,0 +1,135 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestDeadBranchElimination(t *testing.T) {
+	file, fset := parseSource(t, `package testcase
+
+	const (
+		goos  = "js"
+		debug = false
+	)
+
+	func live()      {}
+	func dead()      {}
+	func cond() bool { return true }
+
+	func If() {
+		if debug {
+			dead()
+		}
+		if goos == "js" {
+			live()
+		} else {
+			dead()
+		}
+		if goos != "js" {
+			dead()
+		} else if cond() {
+			live()
+		} else {
+			live()
+		}
+	}
+
+	func Operators() bool {
+		if debug && cond() {
+			dead()
+		}
+		return goos == "js" || cond()
+	}
+
+	func Switch() {
+		switch goos {
+		case "linux", "darwin":
+			dead()
+		case "js":
+			live()
+			fallthrough
+		case "wasm":
+			live()
+		default:
+			dead()
+		}
+		switch {
+		case debug:
+			dead()
+		case goos == "js":
+			live()
+		}
+	}
+
+	func Kept(b bool) {
+		if b {
+			live()
+		}
+		switch b {
+		case true:
+			live()
+		}
+	}
+	`)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}}
+	archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+	if err != nil {
+		t.Fatalf("Compile() returned error: %v", err)
+	}
+
+	// The code of each function should contain, or not contain, calls of live
+	// and dead, and of cond.
+	tests := map[string]struct{ live, cond bool }{
+		"testcase.If":        {live: true, cond: true},
+		"testcase.Operators": {live: false, cond: false},
+		"testcase.Switch":    {live: true, cond: false},
+		"testcase.Kept":      {live: true, cond: false},
+	}
+	for _, d := range archive.Declarations {
+		tt, ok := tests[d.FullName]
+		if !ok {
+			continue
+		}
+		code := string(d.DeclCode)
+		if strings.Contains(code, "dead") {
+			t.Errorf("%s contains code of branches that are never taken:\n%s", d.FullName, code)
+		}
+		if got := strings.Contains(code, "live"); got != tt.live {
+			t.Errorf("%s calls live: %t, want %t:\n%s", d.FullName, got, tt.live, code)
+		}
+		if got := strings.Contains(code, "cond"); got != tt.cond {
+			t.Errorf("%s calls cond: %t, want %t:\n%s", d.FullName, got, tt.cond, code)
+		}
+		for _, dep := range d.DceDeps {
+			if dep == "testcase.dead" {
+				t.Errorf("%s depends on %s", d.FullName, dep)
+			}
+		}
+		delete(tests, d.FullName)
+	}
+	for name := range tests {
+		t.Errorf("No declaration of %s", name)
+	}
+
+	// The AST of the caller is left as it is.
+	tagged := 0
+	ast.Inspect(file, func(n ast.Node) bool {
+		if s, ok := n.(*ast.SwitchStmt); ok && s.Tag != nil {
+			tagged++
+			for _, c := range s.Body.List {
+				for _, e := range c.(*ast.CaseClause).List {
+					if _, ok := e.(*ast.BinaryExpr); ok {
+						t.Errorf("Compile() changed the case clause at %s", fset.Position(c.Pos()))
+					}
+				}
+			}
+		}
+		return true
+	})
+	if tagged != 2 {
+		t.Errorf("The AST has %d switch statements with tag, want 2", tagged)
+	}
+}
@@ -0,0 +1,65 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+)
+
+// In CSP mode, generated code must run under a Content-Security-Policy that
+// doesn't allow 'unsafe-eval'. The prelude and generated code never use eval
+// or the Function constructor, but Go code may call eval through the js
+// package, which is commonly done to define JavaScript helpers:
+//
+//  var helper = js.Global.Call("eval", `(function(x) { ... })`)
+//
+// Such calls with a constant argument are replaced with a call to a function
+// that evaluates the code as an expression, emitted at the top level of the
+// program, outside of any package scope. That's close to the semantics of
+// calling eval indirectly, except that the code must be a single expression.
+// All other uses of eval are rejected at compile time.
+
+// cspEvalObject is the name of the object holding the functions that replace
+// eval calls.
+const cspEvalObject = "$cspEval"
+
+// translateCSPEval translates a call of the "eval" method of a js.Object with
+// the arguments args in CSP mode.
+func (fc *funcContext) translateCSPEval(e *ast.CallExpr, args []ast.Expr) *expression {
+	var code string
+	if len(args) == 1 && !e.Ellipsis.IsValid() {
+		if val := fc.pkgCtx.Types[args[0]].Value; val != nil && val.Kind() == constant.String {
+			code = constant.StringVal(val)
+		}
+	}
+	if code == "" {
+		fc.pkgCtx.errList = append(fc.pkgCtx.errList, types.Error{Fset: fc.pkgCtx.fileSet, Pos: e.Pos(), Msg: "eval can only be called with a single constant string in CSP mode"})
+		return fc.formatExpr("undefined")
+	}
+	key := fmt.Sprintf("%s#%d", fc.pkgCtx.Pkg.Path(), len(fc.pkgCtx.cspEvals))
+	fc.pkgCtx.cspEvals = append(fc.pkgCtx.cspEvals, code)
+	return fc.formatExpr("%s[%s]()", cspEvalObject, encodeString(key))
+}
+
+// rejectCSPEvalRef reports an error for a reference to eval that can't be
+// replaced in CSP mode.
+func (fc *funcContext) rejectCSPEvalRef(e ast.Expr) {
+	fc.pkgCtx.errList = append(fc.pkgCtx.errList, types.Error{Fset: fc.pkgCtx.fileSet, Pos: e.Pos(), Msg: "eval can only be called directly in CSP mode"})
+}
+
+// cspEvalCode returns the top-level code defining the functions that replace
+// eval calls of a package.
+func cspEvalCode(importPath string, evals []string) []byte {
+	if len(evals) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "\tvar %s = %s || {};\n", cspEvalObject, cspEvalObject)
+	for i, code := range evals {
+		key := fmt.Sprintf("%s#%d", importPath, i)
+		fmt.Fprintf(&buf, "\t%s[%s] = function() { return (\n%s\n); };\n", cspEvalObject, encodeString(key), code)
+	}
+	return buf.Bytes()
+}
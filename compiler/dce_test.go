@@ -0,0 +1,102 @@
+package compiler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelectDeclsPrunesPackages(t *testing.T) {
+	importDecl := func(path string) *Decl { return &Decl{ImportedPkg: path} }
+	usedInit := &Decl{FullName: "example.com/used.init"}
+	usedF := &Decl{FullName: "example.com/used.F", DceObjectFilter: "F"}
+	unusedX := &Decl{DceObjectFilter: "X"}
+	mainImports := []*Decl{importDecl("example.com/lib"), importDecl("example.com/unused"), importDecl("example.com/used")}
+	mainFunc := &Decl{FullName: "main.main", DceDeps: []string{"example.com/used.F"}}
+	libImport := importDecl("example.com/dep")
+
+	pkgs := []*Archive{
+		{ImportPath: "runtime", Declarations: []*Decl{{FullName: "runtime.init"}}},
+		{ImportPath: "example.com/dep", Declarations: []*Decl{{FullName: "example.com/dep.init"}}},
+		{ImportPath: "example.com/lib", Imports: []string{"example.com/dep"}, PureInit: true, Declarations: []*Decl{libImport}},
+		{ImportPath: "example.com/unused", PureInit: true, Declarations: []*Decl{unusedX}},
+		{ImportPath: "example.com/used", PureInit: true, Declarations: []*Decl{usedInit, usedF}},
+		{ImportPath: "main", Imports: []string{"example.com/lib", "example.com/unused", "example.com/used"}, PureInit: true, Declarations: append(mainImports, mainFunc)},
+	}
+	selection, kept := selectDecls(pkgs, goLinknameSet{}, false)
+
+	var keptPaths []string
+	for _, pkg := range kept {
+		keptPaths = append(keptPaths, pkg.ImportPath)
+	}
+	// The lib package has no live symbols, but initializes dep, whose
+	// initialization has side effects.
+	wantPaths := []string{"runtime", "example.com/dep", "example.com/lib", "example.com/used", "main"}
+	if !reflect.DeepEqual(keptPaths, wantPaths) {
+		t.Errorf("selectDecls() kept packages %q, want %q", keptPaths, wantPaths)
+	}
+
+	for _, tt := range []struct {
+		name string
+		d    *Decl
+		live bool
+	}{
+		{"import of lib", mainImports[0], true},
+		{"import of unused", mainImports[1], false},
+		{"import of used", mainImports[2], true},
+		{"import of dep", libImport, true},
+		{"main", mainFunc, true},
+		{"used.F", usedF, true},
+		{"used.init", usedInit, true},
+		{"unused.X", unusedX, false},
+	} {
+		if _, live := selection[tt.d]; live != tt.live {
+			t.Errorf("Decl of %s is live: %t, want %t", tt.name, live, tt.live)
+		}
+	}
+}
+
+func TestSelectDeclsLTOMethods(t *testing.T) {
+	typeT := &Decl{FullName: "example.com/lib.T", DceObjectFilter: "T"}
+	called := &Decl{FullName: "(*example.com/lib.T).Called", DceObjectFilter: "T"}
+	uncalled := &Decl{FullName: "(*example.com/lib.T).Uncalled", DceObjectFilter: "T"}
+	stringer := &Decl{FullName: "(*example.com/lib.T).String", DceObjectFilter: "T"}
+	unexported := &Decl{FullName: "(*example.com/lib.T).unexported", DceObjectFilter: "T", DceMethodFilter: "unexported~"}
+
+	newPkgs := func(mainDeps ...string) []*Archive {
+		mainDeps = append([]string{"example.com/lib.T", exportedMethodFilter("Called")}, mainDeps...)
+		return []*Archive{
+			{ImportPath: "example.com/lib", Declarations: []*Decl{typeT, called, uncalled, stringer, unexported}},
+			{ImportPath: "main", Imports: []string{"example.com/lib"}, Declarations: []*Decl{
+				{FullName: "main.main", DceDeps: mainDeps},
+			}},
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		lto      bool
+		mainDeps []string
+		live     map[*Decl]bool
+	}{{
+		name: "without LTO",
+		live: map[*Decl]bool{typeT: true, called: true, uncalled: true, stringer: true, unexported: false},
+	}, {
+		name: "LTO",
+		lto:  true,
+		live: map[*Decl]bool{typeT: true, called: true, uncalled: false, stringer: true, unexported: false},
+	}, {
+		name:     "LTO with reflection",
+		lto:      true,
+		mainDeps: []string{exportedMethodFilter("MethodByName")},
+		live:     map[*Decl]bool{typeT: true, called: true, uncalled: true, stringer: true, unexported: false},
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			selection, _ := selectDecls(newPkgs(tt.mainDeps...), goLinknameSet{}, tt.lto)
+			for d, want := range tt.live {
+				if _, live := selection[d]; live != want {
+					t.Errorf("Decl of %s is live: %t, want %t", d.FullName, live, want)
+				}
+			}
+		})
+	}
+}
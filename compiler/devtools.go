@@ -0,0 +1,193 @@
+package compiler
+
+// devtoolsRuntime is a JavaScript snippet that registers a custom formatter
+// for the -devtools mode. It is emitted right after the prelude, before any
+// package is defined.
+//
+// Chrome DevTools calls custom formatters for every object it displays in the
+// console, in scopes of the debugger and in watch expressions, once they are
+// enabled in its settings. The formatter renders Go values in Go syntax instead
+// of their JavaScript representation: slices without $array and $offset,
+// 64-bit integers as numbers rather than $high and $low pairs, strings decoded
+// from their byte representation, maps by their keys, and structs and the
+// values boxed in interfaces with their fields. Objects that aren't recognized
+// as Go values are left to the default rendering. Since a struct and a pointer
+// to it are represented by the same object, both are rendered like a struct.
+//
+// See https://firefox-source-docs.mozilla.org/devtools-user/custom_formatters/
+// for the JsonML format formatters return.
+const devtoolsRuntime = `(function() {
+  var maxHeaderItems = 5, maxBodyItems = 100;
+  var styles = {
+    header: "font-family: monospace",
+    name: "color: #881391",
+    row: "margin-left: 1.5em; font-family: monospace"
+  };
+  var decode = function(s) {
+    try { return decodeURIComponent(escape(s)); } catch (e) { return s; }
+  };
+  var goType = function(v) {
+    var t = v.constructor;
+    if (typeof t === "function" && typeof t.kind === "number" && typeof t.string === "string") { return t; }
+    return undefined;
+  };
+  var isArray = function(v) { return Array.isArray(v) || ArrayBuffer.isView(v); };
+  var isMap = function(v) {
+    if (Object.getPrototypeOf(v) !== Object.prototype) { return false; }
+    var keys = Object.keys(v);
+    return keys.length > 0 && keys.every(function(k) {
+      var e = v[k];
+      return e !== null && typeof e === "object" && "k" in e && "v" in e;
+    });
+  };
+  var list = function(items, max) {
+    var s = items.slice(0, max).join(", ");
+    return items.length > max ? s + ", …" : s;
+  };
+
+  /* format returns v in Go syntax, or null if v is not known to be a Go value.
+     Objects nested deeper than depth 0 are abbreviated. */
+  var format = function(v, depth, known) {
+    switch (typeof v) {
+    case "undefined":
+      return "nil";
+    case "string":
+      return JSON.stringify(decode(v));
+    case "number":
+    case "boolean":
+    case "bigint":
+      return String(v);
+    case "function":
+      return "func";
+    }
+    if (v === null || v === $ifaceNil || v === $chanNil) {
+      return "nil";
+    }
+    if (v instanceof $Chan) {
+      return "chan " + v.$elem.string + " (len: " + v.$buffer.length + ", cap: " + v.$capacity + (v.$closed ? ", closed)" : ")");
+    }
+    var items = function(n, item) {
+      if (depth > 0) { return n > 0 ? "…" : ""; }
+      var s = [];
+      for (var i = 0; i < n && i <= maxHeaderItems; i++) { s.push(item(i)); }
+      return list(s, maxHeaderItems);
+    };
+    var t = goType(v);
+    if (t !== undefined) {
+      switch (t.kind) {
+      case $kindInt64:
+      case $kindUint64:
+        var n = typeof BigInt === "function" ? String(BigInt(v.$high) * BigInt(4294967296) + BigInt(v.$low)) : String(v.$high * 4294967296 + v.$low);
+        return t.pkg === "" ? n : t.string + "(" + n + ")";
+      case $kindComplex64:
+      case $kindComplex128:
+        return "(" + v.$real + (v.$imag < 0 ? "" : "+") + v.$imag + "i)";
+      case $kindSlice:
+        if (v === t.nil) { return t.string + "(nil)"; }
+        return t.string + "{" + items(v.$length, function(i) { return format(v.$array[v.$offset + i], depth + 1, true); }) + "}";
+      case $kindPtr:
+        if (v === t.nil) { return "(" + t.string + ")(nil)"; }
+        if (t.elem.kind === $kindStruct) {
+          return t.elem.string + "{" + items(t.elem.fields.length, function(i) {
+            var f = t.elem.fields[i];
+            return f.name + ": " + format(v[f.prop], depth + 1, true);
+          }) + "}";
+        }
+        return "&" + format(v.$get(), depth + 1, true);
+      }
+      if (t.wrapped && v.$val !== v) {
+        switch (t.kind) {
+        case $kindStruct:
+          return format(v.$val, depth, true);
+        case $kindMap:
+          return t.string + "{" + mapItems(v.$val, items) + "}";
+        case $kindArray:
+          return t.string + "{" + arrayItems(v.$val, items) + "}";
+        }
+        return t.string + "(" + format(v.$val, depth + 1, true) + ")";
+      }
+      return null;
+    }
+    if (isArray(v)) {
+      if (!known && depth === 0) { return null; }
+      return "[" + v.length + "]{" + arrayItems(v, items) + "}";
+    }
+    if (isMap(v)) {
+      return "map[" + mapItems(v, items) + "]";
+    }
+    return known || depth > 0 ? "{…}" : null;
+  };
+  var arrayItems = function(a, items) {
+    return items(a.length, function(i) { return format(a[i], 1, true); });
+  };
+  var mapItems = function(m, items) {
+    var keys = Object.keys(m);
+    return items(keys.length, function(i) {
+      var e = m[keys[i]];
+      return format(e.k, 1, true) + ": " + format(e.v, 1, true);
+    });
+  };
+
+  /* children returns the elements, fields or entries of v, as pairs of their
+     names in Go syntax and values. */
+  var children = function(v) {
+    var c = [];
+    var t = goType(v);
+    if (t !== undefined) {
+      switch (t.kind) {
+      case $kindSlice:
+        for (var i = 0; i < v.$length && i < maxBodyItems; i++) { c.push(["[" + i + "]", v.$array[v.$offset + i]]); }
+        return c;
+      case $kindPtr:
+        if (v === t.nil) { return c; }
+        if (t.elem.kind === $kindStruct) {
+          t.elem.fields.forEach(function(f) { c.push([f.name, v[f.prop]]); });
+        } else {
+          c.push(["*", v.$get()]);
+        }
+        return c;
+      }
+      if (t.wrapped && v.$val !== v && v.$val !== null && typeof v.$val === "object") {
+        return children(v.$val);
+      }
+      return c;
+    }
+    if (isArray(v)) {
+      for (var i = 0; i < v.length && i < maxBodyItems; i++) { c.push(["[" + i + "]", v[i]]); }
+      return c;
+    }
+    if (isMap(v)) {
+      Object.keys(v).slice(0, maxBodyItems).forEach(function(k) { c.push(["[" + format(v[k].k, 1, true) + "]", v[k].v]); });
+    }
+    return c;
+  };
+
+  var formatter = {
+    header: function(v, config) {
+      try {
+        var s = format(v, 0, config !== undefined && config.go);
+        return s === null ? null : ["span", { style: styles.header }, s];
+      } catch (e) {
+        return null;
+      }
+    },
+    hasBody: function(v) {
+      try {
+        return children(v).length > 0;
+      } catch (e) {
+        return false;
+      }
+    },
+    body: function(v) {
+      var rows = ["div", {}];
+      children(v).forEach(function(c) {
+        var value = c[1];
+        var rendered = (value !== null && typeof value === "object") ? ["object", { object: value, config: { go: true } }] : ["span", {}, format(value, 0, true)];
+        rows.push(["div", { style: styles.row }, ["span", { style: styles.name }, c[0]], ": ", rendered]);
+      });
+      return rows;
+    }
+  };
+  ($global.devtoolsFormatters = $global.devtoolsFormatters || []).push(formatter);
+})();
+`
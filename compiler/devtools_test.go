@@ -0,0 +1,82 @@
+package compiler
+
+import (
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler/prelude"
+)
+
+func TestDevtoolsFormatter(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("Node.js is not installed")
+	}
+
+	// Values are constructed the way generated code does.
+	tests := []struct {
+		expr string
+		want string // Empty if the value is left to the default rendering.
+	}{
+		{expr: `new ($sliceType($Int))([1, 2, 3])`, want: `[]int{1, 2, 3}`},
+		{expr: `$subslice(new ($sliceType($String))(["a", "b", "p\xC3\xA9"]), 1)`, want: `[]string{"b", "pé"}`},
+		{expr: `$sliceType($Int).nil`, want: `[]int(nil)`},
+		{expr: `new ($sliceType($Int))([1, 2, 3, 4, 5, 6, 7])`, want: `[]int{1, 2, 3, 4, 5, …}`},
+		{expr: `new $Int64(-1, 4294967295)`, want: `-1`},
+		{expr: `new $Uint64(1, 0)`, want: `4294967296`},
+		{expr: `new Point.ptr(1, "p\xC3\xA9", new ($sliceType($Int))([1]))`, want: `main.Point{X: 1, Name: "pé", S: []int{…}}`},
+		{expr: `new Point(new Point.ptr(2, "", $sliceType($Int).nil))`, want: `main.Point{X: 2, Name: "", S: []int(nil)}`},
+		{expr: `$ptrType(Point).nil`, want: `(*main.Point)(nil)`},
+		{expr: `new ($ptrType($Int))(function() { return 5; }, function() {})`, want: `&5`},
+		{expr: `new MyInt(3)`, want: `main.MyInt(3)`},
+		{expr: `$ifaceNil`, want: `nil`},
+		{expr: `$makeMap($String.keyFor, [{k: "a", v: 1}])`, want: `map["a": 1]`},
+		{expr: `new $Chan($Int, 2)`, want: `chan int (len: 0, cap: 2)`},
+		{expr: `[1, 2]`},
+		{expr: `{}`},
+		{expr: `{a: 1}`},
+	}
+
+	var script strings.Builder
+	script.WriteString(prelude.Prelude)
+	script.WriteString(devtoolsRuntime)
+	script.WriteString(`
+var Point = $newType(0, $kindStruct, "main.Point", true, "main", true, function(X_, Name_, S_) {
+  this.$val = this;
+  if (arguments.length === 0) { this.X = 0; this.Name = ""; this.S = $sliceType($Int).nil; return; }
+  this.X = X_; this.Name = Name_; this.S = S_;
+});
+Point.init("", [{prop: "X", name: "X", embedded: false, exported: true, typ: $Int, tag: ""}, {prop: "Name", name: "Name", embedded: false, exported: true, typ: $String, tag: ""}, {prop: "S", name: "S", embedded: false, exported: true, typ: $sliceType($Int), tag: ""}]);
+var MyInt = $newType(4, $kindInt, "main.MyInt", true, "main", true, null);
+var formatter = $global.devtoolsFormatters[0];
+var headers = [`)
+	for _, test := range tests {
+		script.WriteString("(" + test.expr + "),\n")
+	}
+	script.WriteString(`].map(function(v) {
+  var h = formatter.header(v);
+  return h === null ? "" : h[2];
+});
+console.log(JSON.stringify(headers));
+`)
+
+	cmd := exec.Command("node")
+	cmd.Stdin = strings.NewReader(script.String())
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Running formatter in Node.js failed: %v\n%s", err, out)
+	}
+	var got []string
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("Failed to parse output %q: %v", out, err)
+	}
+	if len(got) != len(tests) {
+		t.Fatalf("Got %d headers, want %d", len(got), len(tests))
+	}
+	for i, test := range tests {
+		if got[i] != test.want {
+			t.Errorf("header(%s) = %q, want %q", test.expr, got[i], test.want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package compiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Sources of the environment variables of a program, see
+// LinkOptions.EnvSources.
+const (
+	// EnvBuild are the variables set at build time in LinkOptions.Env.
+	EnvBuild = "build"
+	// EnvProcess are the variables of the Node.js process.
+	EnvProcess = "process"
+	// EnvGlobal are the properties of the __gopherjs_env object of the
+	// global object, which a page or bundler can set before loading the
+	// program.
+	EnvGlobal = "global"
+	// EnvQuery are the query parameters of the URL of the page or worker.
+	EnvQuery = "query"
+	// EnvLocalStorage are the properties of the JSON object stored in the
+	// __gopherjs_env item of localStorage.
+	EnvLocalStorage = "localstorage"
+)
+
+// DefaultEnvSources are the sources of environment variables if none are
+// configured. Query parameters and localStorage are left out, since anyone
+// who can make a user open a link controls them.
+var DefaultEnvSources = []string{EnvBuild, EnvProcess, EnvGlobal}
+
+// envSourceCode maps each source of environment variables to the JavaScript
+// code that copies its variables into env with set.
+var envSourceCode = map[string]string{
+	EnvProcess: `if ($global.process !== undefined && $global.process.env !== undefined) { set($global.process.env); }`,
+	EnvGlobal:  `if ($global.__gopherjs_env !== undefined && $global.__gopherjs_env !== null) { set($global.__gopherjs_env); }`,
+	EnvQuery: `if ($global.location !== undefined && typeof URLSearchParams === "function") {
+    new URLSearchParams($global.location.search).forEach(function(v, k) { env[k] = v; });
+  }`,
+	EnvLocalStorage: `try {
+    var stored = $global.localStorage.getItem("__gopherjs_env");
+    if (stored !== null) { set(JSON.parse(stored)); }
+  } catch (e) { /* no or inaccessible localStorage */ }`,
+}
+
+// ParseEnvSources parses a comma-separated list of sources of environment
+// variables, in increasing order of precedence. An empty list selects
+// DefaultEnvSources.
+func ParseEnvSources(spec string) ([]string, error) {
+	if strings.TrimSpace(spec) == "" {
+		return DefaultEnvSources, nil
+	}
+	var sources []string
+	seen := map[string]bool{}
+	for _, source := range strings.Split(spec, ",") {
+		source = strings.TrimSpace(source)
+		if _, ok := envSourceCode[source]; !ok && source != EnvBuild {
+			var known []string
+			for s := range envSourceCode {
+				known = append(known, s)
+			}
+			sort.Strings(known)
+			return nil, fmt.Errorf("unknown environment source %q, want one of %s, %s", source, EnvBuild, strings.Join(known, ", "))
+		}
+		if seen[source] {
+			return nil, fmt.Errorf("environment source %q listed more than once", source)
+		}
+		seen[source] = true
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// envRuntime returns a JavaScript snippet that defines $environ, which returns
+// the environment variables of the program as KEY=VALUE strings, collected
+// from the sources selected by opts. Later sources override variables of
+// earlier ones. It is emitted right after the prelude.
+func envRuntime(opts LinkOptions) string {
+	sources := opts.EnvSources
+	if len(sources) == 0 {
+		sources = DefaultEnvSources
+	}
+	var b strings.Builder
+	b.WriteString(`var $environ = function() {
+  var env = {};
+  var set = function(vars) {
+    for (var k in vars) {
+      if (Object.prototype.hasOwnProperty.call(vars, k)) { env[k] = String(vars[k]); }
+    }
+  };
+`)
+	for _, source := range sources {
+		if source == EnvBuild {
+			for _, kv := range opts.Env {
+				// JSON strings are JavaScript strings of UTF-16 code units,
+				// like those of the other sources, unlike encodeString.
+				parts := strings.SplitN(kv, "=", 2)
+				k, _ := json.Marshal(parts[0])
+				v, _ := json.Marshal(parts[1])
+				fmt.Fprintf(&b, "  env[%s] = %s;\n", k, v)
+			}
+			continue
+		}
+		fmt.Fprintf(&b, "  %s\n", envSourceCode[source])
+	}
+	b.WriteString(`  return $keys(env).map(function(k) { return k + "=" + env[k]; });
+};
+`)
+	return b.String()
+}
@@ -0,0 +1,37 @@
+package compiler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEnvSources(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    []string
+		wantErr bool
+	}{
+		{spec: "", want: DefaultEnvSources},
+		{spec: "process", want: []string{EnvProcess}},
+		{spec: "build, query,localstorage", want: []string{EnvBuild, EnvQuery, EnvLocalStorage}},
+		{spec: "cookies", wantErr: true},
+		{spec: "global,global", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseEnvSources(test.spec)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseEnvSources(%q) returned %q, want error", test.spec, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseEnvSources(%q) returned error: %s", test.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseEnvSources(%q) = %q, want %q", test.spec, got, test.want)
+		}
+	}
+}
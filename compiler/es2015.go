@@ -0,0 +1,160 @@
+package compiler
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+	"github.com/gopherjs/gopherjs/compiler/linebuf"
+)
+
+// Supported values of ImportContext.TargetES, the version of ECMAScript that
+// generated code may use. Versions after ES2015 are supported too, and
+// generate the same code as ES2015.
+const (
+	// ES5 is the default version, which all supported engines run.
+	ES5 = 5
+	// ES2015 defines struct types with class syntax, declares local variables
+	// with let, makes function literals arrow functions, and concatenates
+	// strings with template literals.
+	ES2015 = 2015
+)
+
+// CheckTargetES returns an error if the ECMAScript version level isn't
+// supported.
+func CheckTargetES(level int) error {
+	if level == ES5 || level >= ES2015 {
+		return nil
+	}
+	return fmt.Errorf("unsupported ECMAScript version %d, must be %d, or a year from %d on", level, ES5, ES2015)
+}
+
+// dialect returns the dialect that the code of functions is printed in.
+func (p *pkgContext) dialect() linebuf.Dialect {
+	if p.targetES >= ES2015 {
+		return linebuf.ES2015
+	}
+	return linebuf.ES5
+}
+
+// structConstructor returns the constructor of a struct type with the
+// parameters params and the lines of code body, as a function, or as a class
+// in ES2015.
+func (p *pkgContext) structConstructor(params []string, body []string) string {
+	var b strings.Builder
+	indent := "\t\t"
+	if p.dialect() >= linebuf.ES2015 {
+		fmt.Fprintf(&b, "class {\n\t\tconstructor(%s) {\n", strings.Join(params, ", "))
+		indent = "\t\t\t"
+	} else {
+		fmt.Fprintf(&b, "function(%s) {\n", strings.Join(params, ", "))
+	}
+	for _, line := range body {
+		b.WriteString(indent + line + "\n")
+	}
+	if p.dialect() >= linebuf.ES2015 {
+		b.WriteString("\t\t}\n")
+	}
+	b.WriteString("\t}")
+	return b.String()
+}
+
+// templateLiteral returns the template literal of the string concatenation e
+// in ES2015, or nil if none of the operands of e, or of the concatenations it
+// is made of, is a constant, in which case a template literal wouldn't be
+// shorter.
+func (fc *funcContext) templateLiteral(e *ast.BinaryExpr) *expression {
+	if fc.pkgCtx.dialect() < linebuf.ES2015 {
+		return nil
+	}
+	var operands []ast.Expr
+	var collect func(e ast.Expr)
+	collect = func(e ast.Expr) {
+		if b, ok := astutil.RemoveParens(e).(*ast.BinaryExpr); ok && b.Op == token.ADD && fc.pkgCtx.Types[b].Value == nil {
+			collect(b.X)
+			collect(b.Y)
+			return
+		}
+		operands = append(operands, e)
+	}
+	collect(e)
+
+	hasConst := false
+	for _, operand := range operands {
+		hasConst = hasConst || fc.pkgCtx.Types[operand].Value != nil
+	}
+	if !hasConst {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteByte('`')
+	for _, operand := range operands {
+		if v := fc.pkgCtx.Types[operand].Value; v != nil {
+			b.WriteString(escapeString(constant.StringVal(v), '`'))
+			continue
+		}
+		fmt.Fprintf(&b, "${%s}", fc.translateExpr(operand))
+	}
+	b.WriteByte('`')
+	return fc.formatExpr("%s", b.String())
+}
+
+// templateLen returns the length of the template literal at the start of the
+// code b, which is copied as is when removing whitespace.
+func templateLen(b []byte) int {
+	i := 1
+	for {
+		switch b[i] {
+		case '\\':
+			i += 2
+		case '`':
+			return i + 1
+		case '$':
+			i++
+			if b[i] == '{' {
+				i += substitutionLen(b[i+1:]) + 2
+			}
+		default:
+			i++
+		}
+	}
+}
+
+// substitutionLen returns the length of the code of a substitution of a
+// template literal at the start of b, up to its closing brace.
+func substitutionLen(b []byte) int {
+	depth := 0
+	i := 0
+	for {
+		switch b[i] {
+		case '\b':
+			i += 5 // Position marker.
+		case '{':
+			depth++
+			i++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+			i++
+		case '"':
+			i++
+			for b[i] != '"' {
+				if b[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+		case '`':
+			i += templateLen(b[i:])
+		default:
+			i++
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestES2015(t *testing.T) {
+	const src = `package testcase
+
+	type Point struct{ X, Y int }
+
+	func greet(name string) string {
+		return "Hello, " + name + "! $" + "{x} ` + "`" + `"
+	}
+
+	func counter() func() int {
+		n := 0
+		return func() int {
+			n++
+			return n
+		}
+	}
+
+	func sum(p *Point) int {
+		s := p.X
+		s += p.Y
+		return s
+	}
+	`
+	compile := func(targetES int) string {
+		file, fset := parseSource(t, src)
+		importContext := &ImportContext{Packages: map[string]*types.Package{}, TargetES: targetES}
+		archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+		if err != nil {
+			t.Fatalf("Compile() returned error: %s", err)
+		}
+		var code strings.Builder
+		for _, d := range archive.Declarations {
+			code.Write(d.DeclCode)
+		}
+		return code.String()
+	}
+
+	es2015 := []string{
+		"class {\n\t\tconstructor(X_, Y_) {\n\t\t\tthis.$val = this;",
+		"let s;",
+		"return (() => {",
+		"`Hello, ${name}! \\${x} \\``",
+	}
+	code := compile(ES2015)
+	for _, want := range es2015 {
+		if !strings.Contains(code, want) {
+			t.Errorf("Code for ES2015 doesn't contain %q:\n%s", want, code)
+		}
+	}
+	code = compile(0)
+	for _, want := range []string{"function(X_, Y_) {", "var p, s;", "return (function() {", `"Hello, " + name + "! $" + "{x} ` + "`" + `"`} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Code for ES5 doesn't contain %q:\n%s", want, code)
+		}
+	}
+	for _, unwanted := range es2015 {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("Code for ES5 contains %q:\n%s", unwanted, code)
+		}
+	}
+}
+
+func TestRemoveWhitespaceKeepsTemplateLiterals(t *testing.T) {
+	code := "x = `a  b ${f( \"}\" ,  `c ${ d }` )}\\` e`;\n\ty  =  1;\n"
+	want := "x=`a  b ${f( \"}\" ,  `c ${ d }` )}\\` e`;y=1;"
+	if got := string(removeWhitespace([]byte(code), true)); got != want {
+		t.Errorf("removeWhitespace() returned %q, want %q", got, want)
+	}
+}
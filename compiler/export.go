@@ -0,0 +1,106 @@
+package compiler
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// exportDirective is the prefix of the directive that exports a package-level
+// function to JavaScript:
+//
+//  //gopherjs:export [name]
+//  func Add(a, b int) int { return a + b }
+//
+// The function is set as the property name (the Go function name by default)
+// of the export namespace object when the package is initialized. Arguments
+// and results are converted the same way as for functions passed to
+// js.Object.Set.
+const exportDirective = "//gopherjs:export"
+
+// parseExportDirective returns the name a function is exported with, or an
+// empty string if it doesn't have an export directive.
+func parseExportDirective(fset *token.FileSet, fun *ast.FuncDecl) (string, error) {
+	if fun.Doc == nil {
+		return "", nil
+	}
+	for _, c := range fun.Doc.List {
+		if c.Text != exportDirective && !strings.HasPrefix(c.Text, exportDirective+" ") {
+			continue
+		}
+		if fun.Recv != nil {
+			return "", types.Error{Fset: fset, Pos: c.Pos(), Msg: "gopherjs:export directive can only be applied to package-level functions"}
+		}
+		fields := strings.Fields(strings.TrimPrefix(c.Text, exportDirective))
+		switch len(fields) {
+		case 0:
+			return fun.Name.Name, nil
+		case 1:
+			if !isJSIdentifier(fields[0]) {
+				return "", types.Error{Fset: fset, Pos: c.Pos(), Msg: fmt.Sprintf("invalid gopherjs:export name %q", fields[0])}
+			}
+			return fields[0], nil
+		default:
+			return "", types.Error{Fset: fset, Pos: c.Pos(), Msg: "usage: //gopherjs:export [name]"}
+		}
+	}
+	return "", nil
+}
+
+func isJSIdentifier(s string) bool {
+	for i, c := range s {
+		if !((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9') || c == '_' || c == '$') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// exportRuntime returns a JavaScript snippet that defines $exportFunction,
+// which sets exported functions on the namespace object. The namespace is a
+// dot-separated path of properties of the root object, created as needed, or
+// the root object itself if empty. It is emitted right after the prelude,
+// before any package is defined.
+func exportRuntime(namespace, root string) string {
+	var path []string
+	if namespace != "" {
+		path = strings.Split(namespace, ".")
+	}
+	return fmt.Sprintf(`var $exportTarget = function() {
+  var target = %s, path = %s;
+  for (var i = 0; i < path.length; i++) {
+    if (target[path[i]] === undefined) { target[path[i]] = {}; }
+    target = target[path[i]];
+  }
+  return target;
+};
+var $exportFunction = function(name, fn) { $exportTarget()[name] = fn; };
+`, root, encodeStrings(path))
+}
+
+// libraryExportRuntime is a JavaScript snippet that defines $exportLazy, which
+// sets a placeholder for an exported function of a library that initializes
+// the program with init on the first call. Package initialization replaces
+// placeholders with the exported functions.
+const libraryExportRuntime = `var $exportLazy = function(name, init) {
+  var placeholder = function() {
+    init();
+    var fn = $exportTarget()[name];
+    if (fn === placeholder) {
+      throw new Error("gopherjs: " + name + " called before package initialization completed");
+    }
+    return fn.apply(this, arguments);
+  };
+  $exportFunction(name, placeholder);
+};
+`
+
+func encodeStrings(s []string) string {
+	encoded := make([]string, len(s))
+	for i, v := range s {
+		encoded[i] = encodeString(v)
+	}
+	return "[" + strings.Join(encoded, ", ") + "]"
+}
@@ -0,0 +1,68 @@
+package compiler
+
+import (
+	"go/ast"
+	"testing"
+)
+
+func TestParseExportDirective(t *testing.T) {
+	file, fset := parseSource(t, `package testcase
+
+	//gopherjs:export
+	func Default() {}
+
+	//gopherjs:export renamed_$1
+	func Renamed() {}
+
+	func NotExported() {}
+
+	type T struct{}
+
+	//gopherjs:export
+	func (T) Method() {}
+
+	//gopherjs:export 1st
+	func InvalidName() {}
+
+	//gopherjs:export a b
+	func TooManyArgs() {}
+	`)
+
+	tests := []struct {
+		fun     string
+		want    string
+		wantErr bool
+	}{
+		{fun: "Default", want: "Default"},
+		{fun: "Renamed", want: "renamed_$1"},
+		{fun: "NotExported", want: ""},
+		{fun: "Method", wantErr: true},
+		{fun: "InvalidName", wantErr: true},
+		{fun: "TooManyArgs", wantErr: true},
+	}
+
+	funcs := map[string]*ast.FuncDecl{}
+	for _, decl := range file.Decls {
+		if fun, ok := decl.(*ast.FuncDecl); ok {
+			funcs[fun.Name.Name] = fun
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.fun, func(t *testing.T) {
+			got, err := parseExportDirective(fset, funcs[test.fun])
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("parseExportDirective() returned %q, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseExportDirective() returned error: %s", err)
+			}
+			if got != test.want {
+				t.Errorf("parseExportDirective() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/gopherjs/gopherjs/compiler/analysis"
 	"github.com/gopherjs/gopherjs/compiler/astutil"
+	"github.com/gopherjs/gopherjs/compiler/linebuf"
 	"github.com/gopherjs/gopherjs/compiler/typesutil"
 )
 
@@ -93,6 +94,8 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 			return fc.formatExpr("$global")
 		case "Module":
 			return fc.formatExpr("$module")
+		case "ImportMeta":
+			return fc.formatExpr("$importMeta")
 		case "Undefined":
 			return fc.formatExpr("undefined")
 		}
@@ -177,7 +180,7 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 		}
 
 	case *ast.FuncLit:
-		_, fun := translateFunction(e.Type, nil, e.Body, fc, exprType.(*types.Signature), fc.pkgCtx.FuncLitInfos[e], "")
+		fun := translateFunction(e.Type, nil, e.Body, fc, exprType.(*types.Signature), fc.pkgCtx.FuncLitInfos[e], "").Print()
 		if len(fc.pkgCtx.escapingVars) != 0 {
 			names := make([]string, 0, len(fc.pkgCtx.escapingVars))
 			for obj := range fc.pkgCtx.escapingVars {
@@ -185,6 +188,9 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 			}
 			sort.Strings(names)
 			list := strings.Join(names, ", ")
+			if fc.pkgCtx.dialect() >= linebuf.ES2015 {
+				return fc.formatExpr("((%s) => %s)(%s)", list, fun, list)
+			}
 			return fc.formatExpr("(function(%s) { return %s; })(%s)", list, fun, list)
 		}
 		return fc.formatExpr("(%s)", fun)
@@ -325,10 +331,14 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 				switch e.Op {
 				case token.EQL:
 					return fc.formatExpr("(%1r === %2r && %1i === %2i)", e.X, e.Y)
-				case token.ADD, token.SUB:
-					return fc.formatExpr("new %3s(%1r %4t %2r, %1i %4t %2i)", e.X, e.Y, fc.typeName(t), e.Op)
-				case token.MUL:
-					return fc.formatExpr("new %3s(%1r * %2r - %1i * %2i, %1r * %2i + %1i * %2r)", e.X, e.Y, fc.typeName(t))
+				case token.ADD, token.SUB, token.MUL:
+					var assignments []string
+					re, im := fc.complexArithmetic(e, &assignments)
+					value := fmt.Sprintf("new %s(%s, %s)", fc.typeName(t), re, im)
+					if len(assignments) == 0 {
+						return fc.formatExpr("%s", value)
+					}
+					return fc.formatExpr("(%s, %s)", strings.Join(assignments, ", "), value)
 				case token.QUO:
 					return fc.formatExpr("$divComplex(%e, %e)", e.X, e.Y)
 				default:
@@ -399,8 +409,19 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 
 		switch e.Op {
 		case token.ADD, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			if e.Op == token.ADD {
+				if literal := fc.templateLiteral(e); literal != nil {
+					return literal
+				}
+			}
 			return fc.formatExpr("%e %t %e", e.X, e.Op, e.Y)
 		case token.LAND:
+			if x := fc.constValue(e.X); x != nil {
+				if constant.BoolVal(x) {
+					return fc.translateExpr(e.Y)
+				}
+				return fc.formatExpr("false")
+			}
 			if fc.Blocking[e.Y] {
 				skipCase := fc.caseCounter
 				fc.caseCounter++
@@ -411,6 +432,12 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 			}
 			return fc.formatExpr("%e && %e", e.X, e.Y)
 		case token.LOR:
+			if x := fc.constValue(e.X); x != nil {
+				if !constant.BoolVal(x) {
+					return fc.translateExpr(e.Y)
+				}
+				return fc.formatExpr("true")
+			}
 			if fc.Blocking[e.Y] {
 				skipCase := fc.caseCounter
 				fc.caseCounter++
@@ -431,6 +458,21 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 					return fc.formatExpr("$equal(%s, %s, %s)", fc.translateImplicitConversion(e.X, t), fc.translateImplicitConversion(e.Y, t), fc.typeName(u.Elem()))
 				}
 			case *types.Basic:
+				// Compare the bytes in place instead of converting them to a string.
+				// Operands are passed in order, as either may have side effects.
+				if isString(u) {
+					x, xIsBytes := fc.bytesToStringOperand(e.X)
+					y, yIsBytes := fc.bytesToStringOperand(e.Y)
+					if xIsBytes || yIsBytes {
+						if !xIsBytes {
+							x = e.X
+						}
+						if !yIsBytes {
+							y = e.Y
+						}
+						return fc.formatExpr("$bytesEqualString(%e, %e)", x, y)
+					}
+				}
 				if isBoolean(u) {
 					if b, ok := analysis.BoolValue(e.X, fc.pkgCtx.Info.Info); ok && b {
 						return fc.translateExpr(e.Y)
@@ -451,13 +493,13 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 	case *ast.IndexExpr:
 		switch t := fc.pkgCtx.TypeOf(e.X).Underlying().(type) {
 		case *types.Array, *types.Pointer:
-			pattern := rangeCheck("%1e[%2f]", fc.pkgCtx.Types[e.Index].Value != nil, true)
+			pattern := fc.rangeCheck(e, "%1e[%2f]", true)
 			if _, ok := t.(*types.Pointer); ok { // check pointer for nix (attribute getter causes a panic)
 				pattern = `(%1e.nilCheck, ` + pattern + `)`
 			}
 			return fc.formatExpr(pattern, e.X, e.Index)
 		case *types.Slice:
-			return fc.formatExpr(rangeCheck("%1e.$array[%1e.$offset + %2f]", fc.pkgCtx.Types[e.Index].Value != nil, false), e.X, e.Index)
+			return fc.formatExpr(fc.rangeCheck(e, "%1e.$array[%1e.$offset + %2f]", false), e.X, e.Index)
 		case *types.Map:
 			if typesutil.IsJsObject(fc.pkgCtx.TypeOf(e.Index)) {
 				fc.pkgCtx.errList = append(fc.pkgCtx.errList, types.Error{Fset: fc.pkgCtx.fileSet, Pos: e.Index.Pos(), Msg: "cannot use js.Object as map key"})
@@ -524,9 +566,7 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 		case types.MethodVal:
 			return fc.formatExpr(`$methodVal(%s, "%s")`, fc.makeReceiver(e), sel.Obj().(*types.Func).Name())
 		case types.MethodExpr:
-			if !sel.Obj().Exported() {
-				fc.pkgCtx.dependencies[sel.Obj()] = true
-			}
+			fc.pkgCtx.dependencies[sel.Obj()] = true
 			if _, ok := sel.Recv().Underlying().(*types.Interface); ok {
 				return fc.formatExpr(`$ifaceMethodExpr("%s")`, sel.Obj().(*types.Func).Name())
 			}
@@ -568,6 +608,11 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 						return fc.translateExpr(e.Args[0])
 					}
 				}
+				if fn, ok := obj.(*types.Func); ok {
+					if inlined := fc.inlineCall(e, fn, nil); inlined != nil {
+						return inlined
+					}
+				}
 				return fc.translateCall(e, sig, fc.translateExpr(f))
 			}
 
@@ -588,6 +633,11 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 
 			switch sel.Kind() {
 			case types.MethodVal:
+				if !types.IsInterface(sel.Recv()) && len(sel.Index()) == 1 {
+					if inlined := fc.inlineCall(e, sel.Obj().(*types.Func), f.X); inlined != nil {
+						return inlined
+					}
+				}
 				recv := fc.makeReceiver(f)
 				declaredFuncRecv := sel.Obj().(*types.Func).Type().(*types.Signature).Recv().Type()
 				if typesutil.IsJsObject(declaredFuncRecv) {
@@ -600,6 +650,9 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 					switch sel.Obj().Name() {
 					case "Get":
 						if id, ok := fc.identifierConstant(e.Args[0]); ok {
+							if fc.pkgCtx.csp && id == "eval" {
+								fc.rejectCSPEvalRef(e)
+							}
 							return fc.formatExpr("%s", globalRef(id))
 						}
 						return fc.formatExpr("%s[$externalize(%e, $String)]", recv, e.Args[0])
@@ -618,6 +671,9 @@ func (fc *funcContext) translateExpr(expr ast.Expr) *expression {
 						return fc.formatExpr("%s[%e] = %s", recv, e.Args[0], externalizeExpr(e.Args[1]))
 					case "Call":
 						if id, ok := fc.identifierConstant(e.Args[0]); ok {
+							if fc.pkgCtx.csp && id == "eval" {
+								return fc.translateCSPEval(e, e.Args[1:])
+							}
 							if e.Ellipsis.IsValid() {
 								objVar := fc.newVariable("obj")
 								return fc.formatExpr("(%s = %s, %s.%s.apply(%s, %s))", objVar, recv, objVar, id, objVar, externalizeExpr(e.Args[1]))
@@ -785,9 +841,7 @@ func (fc *funcContext) translateCall(e *ast.CallExpr, sig *types.Signature, fun
 
 func (fc *funcContext) makeReceiver(e *ast.SelectorExpr) *expression {
 	sel, _ := fc.pkgCtx.SelectionOf(e)
-	if !sel.Obj().Exported() {
-		fc.pkgCtx.dependencies[sel.Obj()] = true
-	}
+	fc.pkgCtx.dependencies[sel.Obj()] = true
 
 	x := e.X
 	recvType := sel.Recv()
@@ -864,6 +918,14 @@ func (fc *funcContext) translateBuiltin(name string, sig *types.Signature, args
 			panic(fmt.Sprintf("Unhandled make type: %T\n", argType))
 		}
 	case "len":
+		// GopherJS strings hold one character per byte, so the conversion can be
+		// skipped.
+		if b, ok := fc.bytesToStringOperand(args[0]); ok {
+			return fc.formatExpr("%e.$length", b)
+		}
+		if str, ok := fc.stringToBytesOperand(args[0]); ok {
+			return fc.formatExpr("%e.length", str)
+		}
 		switch argType := fc.pkgCtx.TypeOf(args[0]).Underlying().(type) {
 		case *types.Basic:
 			return fc.formatExpr("%e.length", args[0])
@@ -892,11 +954,18 @@ func (fc *funcContext) translateBuiltin(name string, sig *types.Signature, args
 	case "panic":
 		return fc.formatExpr("$panic(%s)", fc.translateImplicitConversion(args[0], types.NewInterface(nil, nil)))
 	case "append":
+		isBytes := isByteSlice(sig.Results().At(0).Type())
 		if ellipsis || len(args) == 1 {
 			argStr := fc.translateArgs(sig, args, ellipsis)
+			if basic, isBasic := fc.pkgCtx.TypeOf(args[len(args)-1]).Underlying().(*types.Basic); isBytes && ellipsis && isBasic && isString(basic) {
+				return fc.formatExpr("$appendString(%s, %s)", argStr[0], argStr[1])
+			}
 			return fc.formatExpr("$appendSlice(%s, %s)", argStr[0], argStr[1])
 		}
 		sliceType := sig.Results().At(0).Type().Underlying().(*types.Slice)
+		if isBytes {
+			return fc.formatExpr("$appendBytes(%e, %s)", args[0], strings.Join(fc.translateExprSlice(args[1:], sliceType.Elem()), ", "))
+		}
 		return fc.formatExpr("$append(%e, %s)", args[0], strings.Join(fc.translateExprSlice(args[1:], sliceType.Elem()), ", "))
 	case "delete":
 		args = fc.expandTupleArgs(args)
@@ -996,6 +1065,9 @@ func (fc *funcContext) translateConversion(expr ast.Expr, desiredType types.Type
 				}
 				return fc.fixNumber(fc.formatExpr("%s.$low", fc.translateExpr(expr)), t)
 			case isFloat(basicExprType):
+				if is32BitUnsigned(t) {
+					return fc.formatParenExpr("%e >>> 0", expr)
+				}
 				return fc.formatParenExpr("%e >> 0", expr)
 			case types.Identical(exprType, types.Typ[types.UnsafePointer]):
 				return fc.translateExpr(expr)
@@ -1003,8 +1075,16 @@ func (fc *funcContext) translateConversion(expr ast.Expr, desiredType types.Type
 				return fc.fixNumber(fc.translateExpr(expr), t)
 			}
 		case isFloat(t):
-			if t.Kind() == types.Float32 && exprType.Underlying().(*types.Basic).Kind() == types.Float64 {
-				return fc.formatExpr("$fround(%e)", expr)
+			if basicExprType := exprType.Underlying().(*types.Basic); t.Kind() == types.Float32 && basicExprType.Kind() != types.Float32 {
+				switch basicExprType.Kind() {
+				case types.Int64, types.Uint64:
+					// Rounding the flattened value to float64 first could round
+					// it differently than rounding directly to float32.
+					return fc.formatExpr("$fround64(%e)", expr)
+				case types.Int8, types.Int16, types.Uint8, types.Uint16:
+					return fc.formatExpr("%f", expr) // Exactly representable.
+				}
+				return fc.formatExpr("$fround(%f)", expr)
 			}
 			return fc.formatExpr("%f", expr)
 		case isComplex(t):
@@ -1213,6 +1293,56 @@ func (fc *funcContext) loadStruct(array, target string, s *types.Struct) string
 	return code
 }
 
+// complexArithmetic returns the real and imaginary parts of the result of a
+// complex addition, subtraction or multiplication. Operands that are such
+// operations themselves are computed into temporary variables appended to
+// assignments instead of allocating intermediate complex values.
+func (fc *funcContext) complexArithmetic(e *ast.BinaryExpr, assignments *[]string) (re, im string) {
+	xr, xi := fc.complexOperand(e.X, assignments)
+	yr, yi := fc.complexOperand(e.Y, assignments)
+	if e.Op == token.MUL {
+		return fmt.Sprintf("%s * %s - %s * %s", xr, yr, xi, yi), fmt.Sprintf("%s * %s + %s * %s", xr, yi, xi, yr)
+	}
+	return fmt.Sprintf("%s %s %s", xr, e.Op, yr), fmt.Sprintf("%s %s %s", xi, e.Op, yi)
+}
+
+// complexOperand returns expressions for the real and imaginary parts of the
+// complex operand e, which may be repeated without evaluating e again.
+func (fc *funcContext) complexOperand(e ast.Expr, assignments *[]string) (re, im string) {
+	if val := fc.pkgCtx.Types[e].Value; val != nil {
+		r, _ := constant.Float64Val(constant.Real(val))
+		i, _ := constant.Float64Val(constant.Imag(val))
+		return strconv.FormatFloat(r, 'g', -1, 64), strconv.FormatFloat(i, 'g', -1, 64)
+	}
+	switch e := astutil.RemoveParens(e).(type) {
+	case *ast.Ident:
+		name := fc.translateExpr(e).String()
+		return name + ".$real", name + ".$imag"
+	case *ast.CallExpr:
+		if id, ok := astutil.RemoveParens(e.Fun).(*ast.Ident); ok {
+			if b, ok := fc.pkgCtx.Uses[id].(*types.Builtin); ok && b.Name() == "complex" {
+				r, i := fc.newVariable("_re"), fc.newVariable("_im")
+				*assignments = append(*assignments, r+" = "+fc.translateExpr(e.Args[0]).String(), i+" = "+fc.translateExpr(e.Args[1]).String())
+				return r, i
+			}
+		}
+	case *ast.BinaryExpr:
+		switch e.Op {
+		case token.ADD, token.SUB, token.MUL:
+			re, im := fc.complexArithmetic(e, assignments)
+			if fc.pkgCtx.TypeOf(e).Underlying().(*types.Basic).Kind() == types.Complex64 {
+				re, im = "$fround("+re+")", "$fround("+im+")"
+			}
+			r, i := fc.newVariable("_re"), fc.newVariable("_im")
+			*assignments = append(*assignments, r+" = "+re, i+" = "+im)
+			return r, i
+		}
+	}
+	v := fc.newVariable("x")
+	*assignments = append(*assignments, v+" = "+fc.translateExpr(e).String())
+	return v + ".$real", v + ".$imag"
+}
+
 func (fc *funcContext) fixNumber(value *expression, basic *types.Basic) *expression {
 	switch basic.Kind() {
 	case types.Int8:
@@ -1248,7 +1378,7 @@ func (fc *funcContext) internalize(s *expression, t types.Type) *expression {
 		case isInteger(u) && !is64Bit(u):
 			return fc.fixNumber(fc.formatExpr("$parseInt(%s)", s), u)
 		case isFloat(u):
-			return fc.formatExpr("$parseFloat(%s)", s)
+			return fc.fixNumber(fc.formatExpr("$parseFloat(%s)", s), u)
 		}
 	}
 	return fc.formatExpr("$internalize(%s, %s)", s, fc.typeName(t))
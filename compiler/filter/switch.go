@@ -0,0 +1,108 @@
+package filter
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+)
+
+// ConstSwitchTags rewrites the simplified form of the switch statements of
+// the simplified file whose tag and case expressions are constants, like
+// switch runtime.GOOS { case "js": ... }. The simplification assigns the tag
+// to a variable that the conditions of an if-else chain compare with the case
+// expressions, so the conditions aren't known to be constant anymore. They are
+// made to compare the tag itself instead, and the variable is removed. Only
+// nodes created by the simplification are changed, so that the files it was
+// given, which callers may reuse, stay intact.
+func ConstSwitchTags(file *ast.File, info *types.Info) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		// The simplified form of a switch statement is a switch statement
+		// without tag, with a single clause that break statements leave.
+		s, ok := n.(*ast.SwitchStmt)
+		if !ok || s.Tag != nil || len(s.Body.List) != 1 {
+			return true
+		}
+		clause := s.Body.List[0].(*ast.CaseClause)
+		if clause.List != nil {
+			return true
+		}
+		for i := 0; i+1 < len(clause.Body); i++ {
+			if constSwitchTag(clause.Body[i], clause.Body[i+1:], info) {
+				clause.Body = append(clause.Body[:i:i], clause.Body[i+1:]...)
+				break
+			}
+		}
+		return true
+	})
+}
+
+// constSwitchTag reports whether stmt assigns a constant switch tag to a
+// variable of the simplification, which is only compared with constants in
+// the if-else chain at the start of the statements rest, and if so, changes the
+// comparisons to compare the tag.
+func constSwitchTag(stmt ast.Stmt, rest []ast.Stmt, info *types.Info) bool {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return false
+	}
+	tagVar, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || info.Defs[tagVar] != nil {
+		return false
+	}
+	if v, ok := info.Uses[tagVar].(*types.Var); !ok || v.Pkg() != nil {
+		return false // Not a variable of the simplification.
+	}
+	tag := assign.Rhs[0]
+	if !isConst(tag, info) {
+		return false
+	}
+
+	var comparisons []*ast.BinaryExpr
+	var collect func(e ast.Expr) bool
+	collect = func(e ast.Expr) bool {
+		b, ok := e.(*ast.BinaryExpr)
+		switch {
+		case !ok:
+			return false
+		case b.Op == token.LOR:
+			return collect(b.X) && collect(b.Y)
+		case b.Op == token.EQL && b.X == tagVar && isConst(b.Y, info):
+			comparisons = append(comparisons, b)
+			return true
+		}
+		return false
+	}
+	ifStmt, ok := rest[0].(*ast.IfStmt)
+	for ok {
+		if !collect(ifStmt.Cond) {
+			return false
+		}
+		ifStmt, ok = ifStmt.Else.(*ast.IfStmt)
+	}
+
+	uses := 0
+	for _, s := range rest {
+		ast.Inspect(s, func(n ast.Node) bool {
+			if n == tagVar {
+				uses++
+			}
+			return true
+		})
+	}
+	if len(comparisons) == 0 || uses != len(comparisons) {
+		return false
+	}
+	for _, b := range comparisons {
+		b.X = tag
+	}
+	return true
+}
+
+// isConst reports whether the value of e is a known constant.
+func isConst(e ast.Expr, info *types.Info) bool {
+	v := info.Types[astutil.RemoveParens(e)].Value
+	return v != nil && v.Kind() != constant.Unknown
+}
@@ -0,0 +1,159 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Supported values of LinkOptions.Format.
+const (
+	// FormatIIFE wraps the program into a function expression that is invoked
+	// immediately. This is the default format, suitable for script elements
+	// and CommonJS module loaders.
+	FormatIIFE = "iife"
+	// FormatUMD wraps the program into a Universal Module Definition, which
+	// can be loaded by AMD loaders such as RequireJS, CommonJS module loaders,
+	// or as a script that sets a global variable.
+	FormatUMD = "umd"
+	// FormatSystemJS wraps the program into a System.register() module for
+	// the SystemJS loader.
+	FormatSystemJS = "systemjs"
+	// FormatESM makes the program an ECMAScript module, which exports the
+	// export namespace object as the default export, and each exported
+	// function as a named export if no namespace is set.
+	FormatESM = "esm"
+)
+
+// exportsObject is the name of the variable holding the module exports in
+// formats that return them to a module loader.
+const exportsObject = "$exports"
+
+// CheckFormat returns an error if the program format isn't supported, or is
+// missing the options it requires.
+func CheckFormat(format, globalName string) error {
+	switch format {
+	case "", FormatIIFE, FormatSystemJS, FormatESM:
+		return nil
+	case FormatUMD:
+		if globalName == "" {
+			return fmt.Errorf("%s format requires a global name", FormatUMD)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+// exportRoot returns the JavaScript expression of the object that the export
+// namespace is relative to.
+func exportRoot(opts LinkOptions) string {
+	switch {
+	case opts.Format == FormatUMD || opts.Format == FormatSystemJS || opts.Format == FormatESM:
+		return exportsObject
+	case opts.Library:
+		return "($module !== undefined ? $module.exports : $global)"
+	default:
+		return "$global"
+	}
+}
+
+// programHeader returns the code that opens the wrapper of the program in the
+// format selected by opts.
+func programHeader(opts LinkOptions) string {
+	switch opts.Format {
+	case FormatUMD:
+		return fmt.Sprintf(`(function(root, factory) {
+  if (typeof define === "function" && define.amd) {
+    define([], factory);
+  } else if (typeof module === "object" && module.exports) {
+    module.exports = factory();
+  } else {
+    root[%s] = factory();
+  }
+})(this, function() {
+"use strict";
+var %s = {};
+
+`, encodeString(opts.GlobalName), exportsObject)
+	case FormatSystemJS:
+		name := ""
+		if opts.GlobalName != "" {
+			name = encodeString(opts.GlobalName) + ", "
+		}
+		return fmt.Sprintf(`System.register(%s[], function(_export, _context) {
+"use strict";
+return { execute: function() {
+var %s = {};
+
+`, name, exportsObject)
+	case FormatESM:
+		// Modules are strict and have no this or require, which the prelude
+		// expects to be able to look up.
+		return fmt.Sprintf(`var %s = {};
+(function(require) {
+"use strict";
+
+`, exportsObject)
+	default:
+		return "\"use strict\";\n(function() {\n\n"
+	}
+}
+
+// programFooter returns the code that closes the wrapper opened by
+// programHeader. exports are the names of the exported functions of the
+// program.
+func programFooter(opts LinkOptions, exports []string) string {
+	switch opts.Format {
+	case FormatUMD:
+		return fmt.Sprintf("\nreturn %s;\n});\n", exportsObject)
+	case FormatSystemJS:
+		return fmt.Sprintf("\n_export(%s);\n} };\n});\n", exportsObject)
+	case FormatESM:
+		var b strings.Builder
+		b.WriteString("\n}).call(undefined, typeof require !== \"undefined\" ? require : undefined);\n")
+		fmt.Fprintf(&b, "export default %s;\n", exportsObject)
+		if opts.ExportNamespace == "" {
+			for _, name := range exports {
+				if reservedKeywords[name] {
+					continue // Only available on the default export.
+				}
+				fmt.Fprintf(&b, "export var %s = %s.%s;\n", name, exportsObject, name)
+			}
+		}
+		return b.String()
+	default:
+		return "\n}).call(this);\n"
+	}
+}
+
+// moduleRuntime returns a JavaScript snippet that defines $importMeta and
+// $moduleURL, see js.ImportMeta and js.ModuleURL. It is emitted right after
+// the prelude, so that the URL of the script is determined while it is being
+// run synchronously.
+func moduleRuntime(opts LinkOptions) string {
+	importMeta := "undefined"
+	if opts.Format == FormatESM {
+		importMeta = "import.meta"
+	}
+	return fmt.Sprintf(`var $importMeta = %s;
+var $moduleURL = (function() {
+  if ($importMeta !== undefined && typeof $importMeta.url === "string") {
+    return $importMeta.url;
+  }
+  if ($module !== undefined && typeof $module.filename === "string") {
+    try {
+      return $global.require("url").pathToFileURL($module.filename).href;
+    } catch (e) {
+      return "file://" + encodeURI($module.filename);
+    }
+  }
+  if (typeof document !== "undefined" && document.currentScript && document.currentScript.src) {
+    return document.currentScript.src;
+  }
+  if (typeof self !== "undefined" && self.location !== undefined) {
+    return String(self.location.href);
+  }
+  return "";
+})();
+`, importMeta)
+}
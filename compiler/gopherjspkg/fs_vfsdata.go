@@ -21,47 +21,61 @@ var FS = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2021, 6, 12, 16, 11, 21, 726780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 15, 12, 21, 281078071, time.UTC),
 		},
 		"/js": &vfsgen۰DirInfo{
 			name:    "js",
-			modTime: time.Date(2021, 3, 28, 16, 13, 12, 537781400, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/js/js.go": &vfsgen۰CompressedFileInfo{
 			name:             "js.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 12, 538783300, time.UTC),
-			uncompressedSize: 8002,
+			modTime:          time.Date(2026, 10, 15, 22, 3, 34, 370328616, time.UTC),
+			uncompressedSize: 17612,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x59\x5f\x6f\xdc\x36\x12\x7f\x5e\x7d\x8a\x39\xa1\x40\x56\xcd\x56\xbe\x26\x86\x51\x38\xe7\x87\xa4\xb9\xfa\xd2\x4b\xdc\x00\x6e\xd0\x07\x23\x30\xb8\xd2\x68\x97\xb1\x44\xea\x48\x6a\x37\x7b\xb6\xbf\xfb\x61\xf8\x47\x2b\xad\xa4\xc4\xbe\x24\x2f\x75\xc5\xe1\x6f\x7e\x9c\x19\xce\x1f\xee\xd1\x11\xbc\x67\xd9\x0d\x5b\x21\x7c\xd2\x50\x2b\xb9\xe1\x39\x6a\x28\x1a\x91\x19\x2e\x85\x86\x42\x2a\xe0\xc2\xa0\x62\x99\xe1\x62\x05\x5b\x6e\xd6\x20\x98\xe1\x1b\x84\xdf\xd9\x86\x5d\x66\x8a\xd7\x06\x5e\xbe\x7f\xa3\x53\xf8\x95\x95\xa5\x06\x23\xc1\xac\x51\x63\x07\x85\x29\x04\xa3\x90\x19\xcc\x41\xd7\x98\x71\x56\x96\x3b\x58\xee\xe0\x5c\xd6\x6b\x54\xbf\x5f\x02\x13\x39\x18\xc5\x84\x2e\xad\x50\xce\x15\x66\xa6\xdc\x79\x30\xae\x20\x93\x4a\xa1\xae\xa5\xc8\x89\x46\x47\xb5\xde\x09\xc3\x3e\xa7\xd1\xd1\x51\x74\x74\x04\x1f\x34\xc2\x3b\x76\x83\x7f\x29\x56\xd7\xa8\x68\x3f\x7e\xae\xa5\x46\xa8\xd0\xac\x65\x6e\xe9\xed\x77\xa7\xf0\xd7\x1a\x05\xd4\x4c\x6b\x82\xdd\xb0\xb2\x41\xdd\x6a\x5f\x90\x6e\x28\x64\x59\xca\x2d\x2d\x9b\x5d\x8d\x90\x49\xb1\x41\xa5\xdb\x73\xd5\xa8\x0a\xa9\x2a\xcc\x4f\x3d\x05\xb8\x83\x73\xe9\x64\xfb\xff\xee\xba\xb4\x3b\xeb\x77\xf0\x6b\x07\x73\xc9\xb2\x1b\x22\x69\xad\x5e\xb0\x0c\x6f\xef\xe1\xce\xe3\xfe\x34\xf6\xef\xb1\xdf\xbb\x12\x1e\x77\x29\x65\x09\x83\x7f\x77\xf0\x4a\xca\x12\x99\x18\x7c\x1f\x97\xef\x48\x78\x5c\x3a\xc3\x0a\x95\xb6\xee\x2d\x4a\xc9\x8c\xb6\xfb\x2f\x9a\x6a\x89\x6a\xa8\xcf\x8a\x9c\x1c\x7f\x15\x57\x1b\x45\xfe\x18\xec\xbf\x9c\xf8\x3e\x2e\x3f\xc4\xbd\xfa\xc8\x85\xf9\x65\xb8\xff\x8d\x30\xbf\xbc\x54\x8a\xed\x0e\xbe\x8f\xcb\x4f\xe0\xfe\x7c\x32\x86\xfb\xf3\xc9\x00\x78\x4a\x7e\x02\xf7\xf9\xb3\x85\xfb\xa3\x87\xfb\xfc\xd9\x14\x2e\x3c\x84\x6f\x33\x72\xb0\x3b\xf8\xc0\xc7\x0c\x31\x25\x3f\x85\x7b\x78\x30\x87\x3b\x34\xc4\x94\xfc\x14\xae\x33\x44\xd3\x1e\xd1\xe1\x0e\x0d\x71\xd7\x93\xfa\x32\xae\x8d\xc8\xe7\xcf\x0e\xf8\xfe\xe6\xbe\x1e\x00\x4f\xc9\x4f\xe2\x1e\x44\xba\xc7\x3d\x39\x9e\xc2\x9d\xbc\x19\x01\x97\x95\x25\x48\xb3\x46\x05\xba\xe4\x19\xea\xb0\x7f\x18\xbb\x9d\x78\x68\xb3\xcc\x17\x70\x69\xbf\x1e\xb9\x57\x88\x4e\x53\x2f\xdd\x4d\x7d\x1f\xe2\xee\x2b\xc4\x81\x1d\xfc\xf7\x41\x7e\x68\x44\x36\x4f\xd3\xb4\xc3\x3a\x81\x1f\x3f\xe9\xf4\x8f\xe5\x27\xcc\x4c\x8b\x6b\x78\x85\xe9\x9f\xbc\xc2\x83\xfd\xaf\x99\x19\x63\x33\x21\x3f\xe4\xfb\xd3\xf8\x2a\x70\xa1\x0d\x13\x19\xca\x02\x2e\x64\xbe\xcf\xeb\x1d\x6a\x5f\xc4\xad\x58\xad\x17\x94\xa5\x9a\xcc\xe8\x71\xdc\x0e\x8c\x95\xbf\x72\x39\x6d\xdc\x81\x77\xbe\x14\xbd\xcc\x73\x4e\x76\xa4\x72\xbb\xb0\xb5\x9c\x79\x2d\x54\xc6\x0c\xe3\x82\xd2\x22\xeb\xf2\x2c\x38\x96\xf9\x02\xa4\xa0\xe2\xbb\xb6\xe5\xce\xa0\x30\x20\x0b\x57\x0c\x69\x19\xb6\xbc\x2c\x61\x89\xb6\x6e\x62\xde\x2f\xa9\x36\xd7\x6f\xc8\xf7\x54\xd2\x58\x1a\xd5\x6d\x83\x11\x11\x27\xaf\x87\x6b\x60\x81\x04\x2a\xcf\x6d\xd8\x58\x48\x2b\xdd\x69\x2d\xb8\xd1\x6d\x29\xff\x0e\x6d\xc5\xb0\x91\x80\x97\x20\x78\x09\xb5\xb4\x96\x25\xc9\x3d\x63\xfc\x4f\xc3\xca\xfe\x71\x9f\x68\x88\x45\x53\x96\x71\x1a\xe4\x32\x26\x40\x48\x43\xf6\x69\xc8\x3a\x8c\x4e\x5a\xb1\x1a\x6e\x70\x97\x46\xf6\x42\x78\x49\xe7\x8a\x5b\x7f\x48\xf8\xd1\x7f\xbe\xb7\x76\x3a\x47\x03\x0a\x4d\xa3\x84\xb6\x96\x77\x42\x4f\x6c\x97\x56\xa3\x32\x3b\xd7\x8b\xd1\xd2\x8a\x6f\x50\x38\x78\xba\x21\x30\x97\x01\x2b\x21\x98\xf9\x0d\xee\x7c\x09\x4c\x5a\x25\xb7\x1e\x1c\x64\xea\x6d\xec\x25\x13\xaf\xff\x12\x0d\x50\x5b\xb4\xf2\xfa\x6d\x6f\xe4\x0d\xf7\xff\x92\xb9\xec\x91\x59\x78\xcc\xde\x6d\xbe\xdd\x13\xf2\xd2\x5e\x2c\xf0\x7a\x8d\x25\x1a\x04\x85\x95\xdc\xe0\x37\x99\xc6\x21\xf5\xac\xd3\xd1\xbe\x5f\x0d\x9a\xdf\xa2\x58\x99\xf5\xb8\x53\xe2\xd2\x2e\xc6\x2d\x85\x85\x6f\x14\x8d\xbb\x1f\x5c\x98\x11\x06\x0e\x71\x9e\xd0\xf2\x88\x47\xda\x65\xa7\xff\x8d\xc8\xf1\x73\x4f\x3d\x7f\x62\xd6\x80\x25\x56\xfe\x86\x32\xe1\x52\xf5\x88\x2a\xbb\x79\xce\x49\xd3\x97\x82\xc0\x8b\x75\x82\xc0\x69\xd5\x68\x1e\xad\x32\x6c\x76\x5a\x1f\xe0\x6d\x2f\x7d\xe0\x70\xba\xfa\x90\xb9\xfb\xdf\x35\xb9\xcb\x02\x87\xae\x16\xac\xc2\x11\x2e\x04\x32\xa7\xb5\x36\xf6\x98\x5a\x69\x18\xd4\x92\x49\xc3\xb4\x00\x6e\x67\x9a\xa6\x7b\xb7\x6c\xe4\x0d\x0e\x18\x52\xa6\xc2\xb2\x48\xe1\xcf\x35\xd7\x2e\x63\x16\x8c\x97\xc0\x0b\xe0\x36\x99\x50\x8e\x60\x6d\x09\x1c\x75\x19\x01\xcf\x1f\x49\xb4\xb3\xab\x43\xf2\x02\xb7\x90\xd9\x54\x49\xd9\x48\xe0\xb6\xad\x2d\x2e\xb3\x73\xed\x4a\x75\xc8\xb7\xa3\xa4\xfb\x8c\x61\x9e\x49\xe1\x52\x98\x54\xc9\x08\xff\x0b\xdc\x3e\x96\x7c\xd8\xd2\x61\x4e\x33\xc8\xc8\x9d\xeb\x5f\x2f\x3b\x90\xb0\x2c\x93\xca\x8e\x87\xfd\x82\x74\x38\xb6\x8d\x50\x25\x25\xf3\xc4\xc1\x0c\x59\xf9\x55\x7f\x25\xdc\x2c\xf1\x35\x46\x7e\xe4\xf8\x06\x4e\x4e\xd1\x3c\x09\x50\x43\x5e\xad\x44\x08\x44\xf3\x55\x5a\x94\x68\x1e\xca\x09\xe6\x35\x53\x1a\xdf\x08\x93\x8c\x46\xa7\x99\x4c\x5c\x6e\xad\x65\x75\x72\xfc\x10\x5e\x27\xc7\xdf\x8f\xd9\xc9\xb1\xe3\x76\x72\x3c\xce\xce\xae\x3b\x7e\x1f\xf8\x83\x08\x36\xdf\x93\xa1\xd3\x39\x4f\x02\xea\x90\x63\x2b\xe1\x48\xda\xc1\xe0\xab\x1c\xc3\x90\xf0\x48\x92\x16\x7c\x8c\xa6\x5d\x98\x27\x2d\xee\x90\x66\x90\x68\x5d\xed\x2e\xf9\x43\xdc\x1d\xd2\x41\x0a\x97\x88\x60\xd8\xb2\xa4\xda\x00\xa1\x5b\xcc\x64\x65\x4b\x0c\x35\x86\x39\x1a\xc6\x4b\x3d\xee\x6a\x87\xe3\xdc\xdd\x76\xc2\xa3\x4e\x6f\x25\xbd\xe3\x85\x66\xc5\x28\x55\xea\xd8\x84\xf5\x4d\x6d\xd4\x02\xb6\x6b\x9e\xad\x6d\x5b\xb7\xc4\xce\x31\x36\x9c\x41\x63\x31\xd2\xf7\xae\x59\x4c\xe1\x42\x1a\xcb\x43\xe4\x98\x5b\xea\x75\xb3\x2c\x79\x46\x8d\xe0\x58\x18\xd8\xdd\x3e\x0c\x6a\xa3\xc6\xe2\x20\x88\x38\xce\xff\x54\x4a\x2a\x40\x91\xb1\x5a\x37\xa5\xcd\xe6\x1d\xff\x22\xad\x6a\x4a\xde\x52\xa3\xeb\x8e\x1b\x25\x30\x27\x4a\x12\x18\x9c\x4b\xa8\x99\xe0\x99\x6d\x8b\x2b\xb6\xa3\xf3\x28\xcc\xe4\x06\x15\xe6\x0b\x2a\xa0\x36\x65\x09\xf8\xd1\xe9\x31\x6b\x66\x60\x2d\xcb\xdc\x59\xe7\x50\x53\x28\x16\xae\xa7\x75\x5b\xfc\x74\x71\x1b\xcd\xfc\x29\xa3\x2e\xf1\xae\xad\x2b\xd4\x9a\x1c\xed\x07\x8b\xce\x99\xf2\x69\x4d\xce\x84\xa8\x94\xa7\x98\x38\xe0\x4e\x92\x8c\x66\xde\x84\xf1\x21\xc8\x29\xc4\xf0\x94\xfe\xb4\x9d\x6e\xec\xf5\xc7\x49\x9b\x46\xa3\x90\xe0\x59\x76\xd3\xa3\xaa\xed\x97\xb6\xb9\xfc\x46\xc6\x16\x7f\x8c\x71\x4b\xcd\xea\x1b\x12\x3b\x2f\xe5\x92\x95\xb6\xcf\xd1\xfd\x09\x64\xe5\x56\x7c\xf8\xce\xe3\x2d\x17\xb9\xdc\xc6\x36\x02\x97\x4a\x6e\x75\x78\x83\x8b\xcf\xdf\xfe\xf1\xea\xe5\x5b\xb7\x42\xa3\x6a\xfa\x49\x27\x69\xb4\x61\x2a\xa0\x07\xb7\x91\xc2\x77\x32\x6f\x4a\xf4\x0a\xf7\x33\x80\x3f\x7f\x5c\xd9\xe5\x18\x36\x4c\x71\x7b\x7d\x35\x1a\x9a\xbe\x3c\x6e\x0a\xff\xe2\xc2\x9c\xba\x41\x02\x9c\xb0\x7d\x8c\x55\xc6\x35\x6d\x4f\x3e\xe9\xd4\xa9\x70\xc7\x76\x6b\x9a\x0e\xbe\xff\xdf\x0b\x56\x61\xbc\xa0\x16\x22\x79\xe2\x88\x7a\x56\x5d\xa2\x1f\x44\x8e\x05\xa7\x48\xdf\x73\xed\x78\xc4\xd1\x8e\x9b\x20\x15\x3b\xa0\xfd\xae\x2e\xd6\x6b\x5c\x36\xab\x15\x2a\x58\x51\xcb\x9b\xc9\xaa\xe6\xe5\xe1\x8c\x4b\x0d\x7f\xee\xe5\x5e\xc4\x14\x1f\xc6\x36\xc4\xde\xdd\x01\x62\x9e\xc0\x6d\x27\x33\x0a\x56\xfa\xc6\xa7\xd7\xc3\xfb\xa5\xe1\xd4\xeb\xee\x9f\xc2\x5a\xa1\x46\x61\x34\xf0\x87\x24\x98\xbe\x2a\xd7\x7b\x8f\xb4\x5e\x6d\xd4\x09\x5e\xfa\xf8\x7a\xc7\x6e\xf0\x37\x82\xd8\x2a\x56\xeb\x6e\xa7\x47\xa1\xe3\x2c\xcb\xb2\x0c\x75\x78\xe3\x0f\xef\xe5\xb2\x38\xb0\x0d\xf5\x93\xb1\x0b\x38\xa6\x56\x0d\x99\x46\xc7\x34\x85\x6d\xa5\xca\x43\x1e\x0f\xea\xe6\x85\x70\x0f\x3b\xb6\x0b\xf5\x04\x6d\x97\xed\x36\xc2\xd5\xc7\x36\x63\x7e\xe5\x2c\x2e\x86\x5d\xaf\x1e\xff\x50\x79\x05\xf1\xe2\xd0\x28\x85\x48\xc2\xa5\xfa\x37\xee\x74\xcf\x1f\x37\xf4\xc1\x87\xb8\x1b\x29\x86\xcf\x11\xee\x00\xb4\xb5\x9b\xce\xaf\x3e\xee\xaf\x34\x2f\x40\xc2\xd9\x99\x7d\x4a\xb8\xbb\x73\x7f\xef\xe3\xed\x36\x9a\x75\xcd\x3f\xbb\x8f\x66\x0c\x4e\xcf\x02\x7f\x7b\x1b\x1c\x6a\x9c\xf8\xd3\x10\xad\x78\x01\x32\x89\x66\x9a\x44\xe9\x70\xf3\xa0\x71\x01\xac\x1d\x16\x93\x68\x66\x7f\xb4\x21\xa1\xbf\xbf\x00\x0e\xff\xe8\x2c\xbe\x00\xfe\xf4\xa9\x55\xaf\xaf\xf8\x47\x38\x03\xd6\x4e\x7c\xfb\x6c\x43\x74\x3c\x3b\xdd\x09\x8d\xf0\x93\xca\x7e\x8c\x18\x46\xac\x2b\x95\x6b\xa6\x6d\x0c\xd5\x94\x76\x0a\x5b\x48\xc2\xcd\xc7\xbc\x7d\xbd\x91\x05\x05\xf4\x07\x6d\x97\x4a\x9e\x71\x43\x57\xce\xa0\xb2\x81\xa3\xdd\x9f\x9d\x5f\x6d\xfc\xef\x38\xbe\xc2\xd8\x87\xa8\xc3\x5f\x73\xf6\x81\xe5\xc9\x7e\x21\xfc\x37\x64\xa0\xc3\xcb\x92\x44\x33\x39\xe9\x08\x1a\x4e\x48\xc0\xa5\xa7\xeb\xeb\x70\x73\xaf\xdd\xe1\xaf\xaf\xe3\x05\x6c\x92\x68\x16\x38\x9f\x9e\xc1\xc6\x41\x74\x06\xa5\x38\x09\xe5\xc7\x0a\xc5\x23\xee\xf2\x4b\x23\x4e\xab\xac\xe7\xfd\x72\x70\x5c\x34\xa3\x68\xab\x1c\x6c\x7d\xb3\xea\x14\x0e\xf8\xdb\x19\xc4\x31\xdc\xc2\xd1\x91\x1d\xde\x82\x0f\xa2\xd9\x6c\x96\x49\x61\xb8\x68\x30\x9a\x91\xbf\xfd\xa9\x3c\x0a\xcd\xb9\x1d\x98\x85\xbb\x9f\x61\x96\x6b\x03\xbe\x63\xcd\xd9\xf8\x15\xc4\xcf\xce\x44\xfc\xbf\x18\xde\x74\xc9\x48\x56\x4b\x60\xac\x64\xdd\xd1\x95\x2c\xc2\x51\xcc\xae\x8e\x93\x05\x18\xd5\x60\xb8\x04\xac\xae\xcb\x1d\x01\xb8\x21\x9c\x8e\x7e\xdf\x8b\x57\x19\xb5\xe3\xae\x7d\xf3\x7e\xd5\x14\xc5\x54\xc8\x76\x05\x0a\x25\x2b\x60\xb0\xdc\x19\xff\x70\xed\x43\xa9\x8f\x33\x5f\xc2\xd5\x47\x92\xe9\x1d\xdd\x3d\x74\x0f\x83\x69\x49\xb1\x52\x14\x54\x14\x4f\xcf\x3c\xaa\x3d\xd8\x0f\xee\x6b\x9c\xb8\x39\x29\x9a\xb9\xb7\xa3\x43\x29\xff\xa2\xd4\x4a\x85\x2b\xd9\x11\xb1\x2f\x2f\x21\xa2\x96\x96\x63\x9b\x30\xac\x1c\x65\x0c\xab\x2c\xfc\xf7\xa9\x43\x0d\xd9\xef\x9d\x7b\x87\xd5\xbc\xaa\x4b\xb4\x8f\x94\xd4\xcb\xa5\xf0\xc6\xbe\x50\xb4\x85\xc6\x3e\x61\xea\xb5\x54\x66\x6d\x7f\xc9\x93\x6a\x78\xf7\x35\xcc\x97\x58\x48\xd5\x9d\x30\x12\xdf\x1b\xbe\x9b\x78\xb1\x76\xfd\x56\x8f\xc3\xfe\x67\x83\x47\xb2\xf0\xbf\x51\x4c\x93\xb8\xec\xff\xdc\x11\x39\x0f\x73\xc1\x69\x80\xb9\x8d\x66\x47\x47\xc0\x36\x92\xe7\x90\x23\xcb\x21\x93\x39\x02\x96\xbc\xe2\x82\x51\xd8\x46\x33\xeb\x63\xdb\xc3\xdd\xde\x47\xb3\x6b\x38\x03\x8c\xee\xa3\xff\x05\x00\x00\xff\xff\x72\x0d\xcb\x80\x42\x1f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x7c\x5b\x93\xdb\x36\xb2\xf0\xb3\xf4\x2b\x7a\x59\x5b\xb6\x98\x28\xf4\x26\xeb\x72\x6d\x4d\xbe\x79\x70\x2e\xeb\xcf\x7b\x62\x27\x27\x8e\xcf\x3e\xf8\xb8\x5c\x18\xb2\x29\xc1\x43\x01\x0c\x00\x4a\xd6\x8e\xe7\xbf\x9f\x6a\xa0\x41\x82\x22\x35\xbe\x24\x7e\xc9\x08\x68\x74\x37\xba\xd1\x57\x80\x79\xf0\x00\x7e\x11\xe5\xb5\xd8\x20\xbc\xb5\xd0\x1a\xbd\x97\x15\x5a\xa8\x3b\x55\x3a\xa9\x95\x85\x5a\x1b\x90\xca\xa1\x11\xa5\x93\x6a\x03\x07\xe9\xb6\xa0\x84\x93\x7b\x84\x7f\x89\xbd\x78\x51\x1a\xd9\x3a\x78\xfc\xcb\x53\x5b\xc0\xf7\xa2\x69\x2c\x38\x0d\x6e\x8b\x16\x13\x2c\xc2\x20\x38\x83\xc2\x61\x05\xb6\xc5\x52\x8a\xa6\x39\xc2\xd5\x11\x9e\xe8\x76\x8b\xe6\x5f\x2f\x40\xa8\x0a\x9c\x11\xca\x36\x1e\xa8\x92\x06\x4b\xd7\x1c\x19\x99\x34\x50\x6a\x63\xd0\xb6\x5a\x55\xc4\x46\x42\xda\x1e\x95\x13\xef\x8a\xe5\x83\x07\xcb\x07\x0f\xe0\xa5\x45\x78\x26\xae\xf1\xdf\x46\xb4\x2d\x1a\x5a\x8f\xef\x5a\x6d\x11\x76\xe8\xb6\xba\xf2\xec\x0d\xab\x0b\xf8\xf7\x16\x15\xb4\xc2\x5a\x42\xbb\x17\x4d\x87\xb6\xa7\xbe\x26\xda\x50\xeb\xa6\xd1\x07\x9a\x76\xc7\x16\xa1\xd4\x6a\x8f\xc6\xf6\xfb\x6a\xd1\xd4\xda\xec\xb0\xba\x60\x16\xe0\x3d\x3c\xd1\x01\x76\xfc\xef\x7d\xca\x76\x32\xff\x1e\xbe\x4f\x70\x5e\x89\xf2\x9a\x98\xf4\x52\xaf\x45\x89\x37\xb7\xf0\x9e\xf1\x7e\x35\xf7\xef\x53\xc7\x53\x08\xc6\x7b\xa5\x75\x03\x93\x7f\xef\xe1\x3b\xad\x1b\x14\x6a\x32\x3e\x0f\x9f\x40\x30\x5e\xda\xc3\x06\x8d\xf5\xea\xad\x1b\x2d\x9c\xf5\xeb\x9f\x77\xbb\x2b\x34\x53\x7a\x1e\xe4\xd1\xc3\x0f\xe2\xb5\xce\x90\x3e\x26\xeb\x5f\x9c\x19\x9f\x87\x9f\xe2\x7d\xf5\x5a\x2a\xf7\x8f\xe9\xfa\xa7\xca\xfd\xe3\xb1\x31\xe2\x78\x32\x3e\x0f\x7f\x06\xef\xd7\x8f\xe6\xf0\x7e\xfd\x68\x82\xf8\x1c\xfc\x19\xbc\x7f\xff\x66\x1d\xfe\x18\xe1\xfd\xfb\x37\xe7\xf0\xc2\xc7\xf0\xdb\xcd\x6c\xec\x3d\xbc\x94\x73\x82\x38\x07\x7f\x0e\xef\xd7\x8f\xe6\xf0\x4e\x05\x71\x0e\xfe\x1c\xde\x20\x88\xae\xdf\x62\xc0\x3b\x15\xc4\xfb\x11\xd4\xdd\x78\xfd\x89\xfc\xfb\x37\xe3\x59\xf8\x67\x18\x3d\x41\x7c\x0e\xfe\x2c\xde\x47\x0f\xe7\xf0\x3e\x7a\x78\x0e\xef\xa3\x87\x1f\xc0\x2b\x9a\x06\xb4\xdb\xa2\x01\xdb\xc8\x12\x6d\x5c\x3f\x3d\xbb\xc9\x79\xe8\xbd\xcc\x1d\x78\x69\xbd\x9d\xb1\x2b\xc4\x40\x69\xe4\xee\xce\x8d\x4f\xf1\x0e\x11\xe2\x44\x0e\x3c\x3e\xf1\x0f\x9d\x2a\x57\x45\x51\x24\x5c\xe7\xf0\xc5\x5b\x5b\xfc\x7c\xf5\x16\x4b\xd7\xe3\x75\x72\x87\xc5\x6f\x72\x87\x27\xeb\x7f\x10\x6e\x8e\x9b\x33\xf0\x53\x7e\xbf\x9a\x9f\x05\xa9\xac\x13\xaa\x44\x5d\xc3\x73\x5d\x0d\x7e\x3d\x61\xed\x4e\xbc\x3b\xd1\xda\x35\x58\x67\xba\xd2\xd9\x79\xbc\x09\x1a\x0f\xff\x2a\xf8\xb4\x79\x05\xbe\xe7\x50\xf4\xb8\xaa\x24\xc9\x91\xc2\xed\xda\xc7\x72\xc1\x54\x28\x8c\x39\x21\x15\xb9\x45\x91\xf2\x59\x4b\x6c\xaa\x35\x68\x45\xc1\x77\xeb\xc3\x9d\x43\xe5\x40\xd7\xfe\xa7\x9f\x86\x83\x6c\x1a\xb8\x42\x1f\x37\xb1\x1a\x87\x54\xef\xeb\xf7\xa4\x7b\x0a\x69\xa2\x58\xb6\x7d\x82\xb1\x24\x9e\x98\x8e\xb4\x20\x22\x13\x68\x98\xb7\x69\x62\xa1\x3d\x74\x92\x5a\x48\x67\xfb\x50\xfe\x27\xa4\x15\xd3\x44\x02\x1e\x83\x92\x0d\xb4\xda\x4b\x96\x20\x07\x8e\xf1\xf7\x4e\x34\xe3\xed\xde\xb7\x90\xa9\xae\x69\xb2\x22\xc2\x95\x42\x81\xd2\x8e\xe4\xd3\x91\x74\x04\xed\x74\x27\x5a\xb8\xc6\x63\xb1\xf4\x06\xc1\x90\x41\x15\x37\xbc\x49\xf8\x82\x87\x6f\xbd\x9c\x9e\xa0\x03\x83\xae\x33\xca\x7a\xc9\x07\xa0\xfb\x3e\x4b\x6b\xd1\xb8\x63\xc8\xc5\x68\x6a\x23\xf7\xa8\x02\x7a\xb2\x10\x58\xe9\x88\x2b\x27\x34\xab\x6b\x3c\x72\x08\xcc\x7b\x22\x37\x8c\x1c\x74\xc1\x32\x66\xc8\x9c\xe9\xbf\x40\x07\x94\x16\x6d\x98\xbe\xcf\x8d\x58\x70\x9f\xcb\xcc\x8b\x11\x33\x6b\xc6\x39\xb2\xe6\x9b\x81\x21\x86\x66\xb0\xc8\xd7\x0f\xd8\xa0\x43\x30\xb8\xd3\x7b\xfc\x43\xa2\x09\x98\x46\xd2\x49\xa8\x0f\xb3\x91\xf2\x4f\xa8\x36\x6e\x3b\xaf\x94\xac\xf1\x93\x59\xcf\xc2\x9a\x13\x45\x17\xec\x43\x2a\x37\xc3\x41\xc0\xb8\xca\x69\x7a\x46\x23\xfd\x74\xa0\xff\x54\x55\xf8\x6e\x44\x5e\xde\x77\x5b\xc0\x06\x77\x6c\xa1\x42\x05\x57\x3d\x43\xca\x2f\x5e\x49\xa2\x74\xd7\x21\x60\xb0\xe4\x10\x04\xaa\x16\xdd\x27\x93\x8c\x8b\x03\xd5\x8f\xd0\x36\x43\x9f\x28\x9c\x4c\x1f\xca\x60\xff\xa9\xc8\x83\x17\x38\x55\xb5\x12\x3b\x9c\xe1\x85\x90\xac\x68\xae\x3f\x7b\xc2\x6c\x2c\x4c\x62\xc9\x59\xc1\xf4\x08\xc2\xca\xa2\x28\x06\xb5\xec\xf5\x35\x4e\x38\x24\x4f\x85\x4d\x5d\xc0\x6f\x5b\x69\x83\xc7\xac\x85\x6c\x40\xd6\x20\xbd\x33\x51\xda\x81\xe8\x43\xe0\xac\xca\x08\xf1\xea\x13\x19\x4d\x56\x25\x4c\x3e\xc7\x03\x94\xde\x55\x5a\x10\xa0\xf0\xd0\xc7\x96\xe0\xd9\xa5\x0d\xa1\x9a\x91\xcc\x33\x3d\xe6\x18\x56\xa5\x56\xc1\x85\x69\x93\xcf\xf0\xff\x1c\x0f\x9f\xca\x7c\x5c\x92\x70\x4e\x35\xc8\x8c\xcd\x8d\xcd\xcb\x17\x24\xa2\x2c\xb5\xf1\xe5\xe1\x38\x20\x9d\x96\x6d\x33\xac\x12\x91\x55\x1e\xd0\x4c\xb9\xe2\x59\x36\x89\x50\x4b\x7c\x88\x23\x2e\x39\xfe\x00\x4f\x81\xd0\x2a\x8f\xa8\xa6\x7c\xf5\x10\xf1\x20\xba\x0f\xb2\x25\x95\xfb\x68\x9e\x60\xd5\x0a\x63\xf1\xa9\x72\xf9\xec\xe9\x74\x67\x1d\x57\x98\xeb\xb9\x7a\xf4\xf0\x63\xf8\x7a\xf4\xf0\xcf\xe3\xec\xd1\xc3\xc0\xdb\xa3\x87\xf3\xdc\x3d\x7a\xd8\xf3\xf7\x52\x7e\x14\x83\xdd\x9f\xc9\x61\xa0\xb9\xca\xa1\x3b\xc7\xe3\x4b\x39\x62\xd2\x17\x06\x1f\xe4\x31\x16\x09\x9f\xc8\xa4\x47\x3e\xc7\xa6\x9f\x58\xe5\x3d\xde\x29\x9b\x11\xa2\x57\x75\x30\xf2\x8f\x51\x77\x74\x07\x05\xbc\x40\x04\x27\xae\x1a\x04\xa9\x20\x66\x8b\xa5\xde\xf9\x10\x43\x89\x61\x85\x4e\xc8\xc6\xce\xab\x3a\xe0\x09\xea\x8e\x38\xe7\x95\xde\x43\xb2\xe2\x95\x15\xf5\x2c\xab\xc2\x82\x50\x5e\x37\xad\x33\x6b\x38\x6c\x65\xb9\xf5\x69\xdd\x15\x26\xdb\xd8\x4b\x01\x9d\xc7\x51\xfc\x12\x92\xc5\x02\x9e\x6b\xe7\xf9\x50\x15\x56\x9e\xf5\xb6\xbb\x6a\x64\x09\x9d\x9d\x0b\x4a\x81\x03\x3e\x06\xad\x33\x73\xe7\x20\x82\x04\x9e\x7f\x34\x46\x1b\x40\x55\x8a\xd6\x76\x8d\xf7\xe6\x89\x7e\x91\x66\x2d\x39\x6f\x6d\x31\x64\xc7\x9d\x51\x58\x11\x4b\x1a\x04\xb5\xa5\x5a\xa1\x64\xe9\xd3\xe2\x9d\x38\xd2\x7e\x0c\x96\x7a\x8f\x06\xab\x35\x05\x50\xef\xb2\x14\x7c\x11\xe8\xb8\xad\x70\xb0\xd5\x4d\x15\xa4\x73\x4a\x29\x06\x8b\x90\xd3\x86\x25\x5c\x5d\xdc\x2c\x17\xbc\xcb\x65\xca\x78\x2a\xeb\x1d\x5a\x2b\x36\x1c\x7e\x30\xdd\x53\x75\x9e\x52\x10\x21\x1a\xc3\x2c\xe6\x01\x71\xe2\x24\x97\x0b\x16\x61\x76\x8a\xe4\x02\x32\xf8\x92\xfe\x2c\x9e\x05\xd2\xab\x9c\x99\xe3\xdf\xb3\xec\xf5\x29\xe5\x27\xf0\xb9\x06\x6d\x92\x9c\x79\x74\xf2\x45\xe4\x34\x84\xd4\xad\xb0\xa0\xb4\xc2\x35\x34\xf2\x1a\xe1\xb0\x45\x95\x62\x2d\xa9\x9a\x74\x5b\xa3\x0f\xb6\x5f\x39\x27\x85\x7e\x47\x89\x1c\x64\xed\x37\xcb\xb1\xf6\xf2\xd2\x97\x35\xef\xdf\x9f\x0c\xbe\x54\x15\xd6\x92\x0e\xc9\xcd\x72\x11\x65\xf7\xa4\xd1\x57\xa2\x09\x39\x4f\x16\xa2\x4c\xb6\x4e\x16\xe6\x7d\xe8\x59\x2e\x6e\x3d\xa5\x9d\xdd\xc0\xc5\xa5\x07\xa1\x3a\x22\x63\xf1\x65\xf9\xb7\x7e\xea\x2f\x67\x08\xed\xec\x66\x8c\x8a\xc7\x09\x4f\x3f\xce\x19\x0c\x25\x6f\xa9\x86\x7c\x36\xf7\x79\xea\xf1\xb2\xce\x7e\x3b\xb6\xe8\xe5\x97\x79\x85\x65\x8f\xaf\xb4\x71\x61\x80\xeb\xd1\x1f\x7e\x7e\xf6\xe3\xbb\x12\x5b\x9f\xee\x70\xb2\x4b\x40\x58\x01\x91\x15\x34\x5e\xc0\xd3\xc1\x33\x0b\x05\xb8\x6b\xdd\x31\x51\xf2\x70\x0e\x82\xae\x47\x19\x6a\xaa\x43\xda\xe0\x9f\xa5\xc0\x2c\x8b\x8a\xf1\x52\x4a\x35\x43\x03\xa4\x16\x3f\x31\xd2\xcb\xbd\x7b\xfd\x20\x51\x4a\xd0\x79\x96\xe7\xf4\x94\x65\xac\x9d\xa7\x16\x0c\xb6\xda\x38\x4b\x67\xd8\xb7\xa0\x68\x6f\x3b\xe1\xca\x2d\x5a\x70\xc2\x6c\x30\x38\x73\x76\x52\x4f\xed\x05\x08\x15\xcc\xd7\xab\xb6\xaf\xad\x7b\xdd\x7e\xaa\xc5\x0d\x8e\x6b\x58\xe2\x83\xe0\x16\xc1\x7a\x84\x67\xbd\xc8\x53\xbb\x62\x16\x31\x0c\x84\x4c\x70\xb9\xb0\x07\xe9\xca\x6d\xe4\xff\xe2\x92\xff\x2a\x56\xe4\xf3\x72\x82\x28\x85\xc5\x61\x1b\x17\x83\xd0\x48\xe2\xac\xd3\xcb\x4b\x56\x2b\x53\xc9\x79\x59\x20\x9f\xac\x61\x3a\xac\x81\x7b\xf7\x4e\x74\xcd\xc4\xc3\x40\xaa\x87\x5a\x34\x16\x97\x31\xae\x1d\x8c\x68\x47\xa6\x82\x83\x3b\xa7\x5d\xd3\xbc\x1d\x2b\xc3\x87\x04\xfe\xf5\xd8\x5e\x24\xa7\x96\xb5\xd0\xc7\x0e\x8f\x65\x36\x1c\x1c\x84\x0d\xbe\x4a\x79\xdc\x7d\xa1\x23\x54\x00\x58\xfb\xbe\xe0\x2f\x84\xe5\x7f\x08\xb5\x57\x1a\x36\x16\x7d\x53\xa7\x14\x9d\xc5\xa4\x7c\x16\x16\x2c\x3a\xbe\x70\xc2\x03\x3b\x7a\xf6\x2b\x6b\xb8\xe1\x05\xb7\xf9\x9a\xe3\x75\x10\xe6\xc8\x1c\x55\x28\x5d\xe8\x38\x22\x71\xa2\x50\xd2\xdf\x73\x27\x20\x48\x6d\x95\xf3\x5e\xfe\x98\xf9\x29\xd9\x44\xfb\xdb\xaf\x41\x5f\x47\x03\x1c\xf6\xbe\xca\xbf\xa5\x71\x5a\x43\x74\x22\xd0\xbe\x58\x85\x03\xd8\xcf\xf6\xa7\x69\xb9\x58\xdc\x46\xa4\x61\xeb\xa9\x55\xfb\x11\x32\xeb\x30\x75\x6a\xd7\xfd\xe8\x89\x61\xdf\xf3\xbb\xbf\x09\xdb\xb9\x08\x60\xb7\xe9\xc1\xa2\x9d\x24\x11\xdc\x5b\xaa\xb4\x23\x1b\x15\x70\x2d\x55\x45\x7f\x4d\x52\x91\x98\x3b\xf5\x6e\x20\x9e\x45\x1b\x8e\x95\x70\x01\x8b\x57\xf2\xe0\x18\xe2\x45\x9a\xac\x87\x41\x92\xcb\x1a\xde\xda\x62\xf0\xd2\x64\x7e\x04\x16\x79\xf5\xbd\x02\x55\x62\x83\xdc\x15\x10\x0a\x3c\xf4\xf7\x5a\x39\xa3\x9b\x86\x34\x4f\x0b\x6e\x93\x8c\xe5\xf9\xd0\x13\xe8\xc3\x8c\x8d\x87\xde\x3a\xa1\x2a\x61\xaa\xb9\x9d\x89\xb0\x67\xca\x53\xb5\x1a\x47\x0a\x66\x7a\xe9\xeb\x63\x58\x2d\x17\x7d\xa4\x01\xff\x6f\x20\x7c\x99\x46\xa1\xe5\xe2\x57\xa1\x36\x09\xe0\x08\x6e\x98\xcb\x96\x8b\x17\xbe\x57\xd9\x43\x8e\x00\x93\x39\x42\x89\x35\x1a\x54\x25\xa3\x1d\xa3\x1c\xcd\x65\xcb\xe5\x62\x10\x6e\xdf\x4b\x1e\xad\x18\xe6\xb3\xe5\xe2\xb9\x76\xff\xd4\x9d\xaa\xce\xed\x6c\x34\x1f\xe0\x1f\xd3\x55\x2a\x56\xf3\x8c\x9f\xcc\x87\x15\x2f\xba\xb6\xf5\x11\x97\xd7\x9c\xae\x18\xcf\x67\xcb\xc5\x53\xb5\x17\x8d\xac\x5e\x38\xe1\x70\x6e\xcd\x64\x9e\xe8\xa0\x3b\x68\x73\x9d\x6e\x7c\x4c\x27\x99\xcf\x96\x8b\xff\xee\xb4\x13\xa4\x6e\xac\x22\x5f\x23\xf0\xe9\x3c\xa9\x0c\xcb\xce\x48\x77\x3c\x27\xad\xd1\x7c\xb6\x5c\xd0\x75\x84\xee\xdc\x59\x9e\xd2\xf9\x6c\xb9\xf8\x41\x38\xf1\x7d\xa3\xd5\xb9\xe3\x33\x9e\xcf\x96\xf9\x92\x9d\x60\x12\x83\x3f\x32\x9b\xa6\x34\x9a\xc3\x99\x8a\xd9\xd9\x0b\x47\xd7\xd6\x69\xcc\xb1\x7e\xe4\x73\xf2\xb3\x39\xf7\xec\xf1\xff\xc9\xd9\x51\x12\xa7\xbd\x0f\xf5\x1c\x67\xf9\x69\xe2\x39\x38\xed\xd1\xfe\x3e\x10\x1d\xef\xde\xe8\x38\x54\xae\x93\x58\xc9\x78\x62\x53\xae\x35\x7a\x63\xc4\x2e\xe0\x35\x28\xca\xed\x08\x1d\xa7\xb2\x5a\xf9\xda\x39\xe9\xcd\x51\x37\x12\x2b\xba\x0b\x49\x81\xfd\x75\xc8\x16\x13\x26\x4a\xd1\x6d\xb6\x6e\x0c\x17\xaa\x8e\x2b\xac\xb5\x41\xd8\xa0\xf3\x49\x54\x7c\x96\xf0\x44\x17\x41\x22\x36\xf0\xb4\x41\x97\xcc\xf9\x4d\x75\x9b\x6d\x8a\xed\x1a\xb1\xe5\xdb\x16\x7e\x5f\x21\xd4\xf1\x20\x8e\x6b\xb0\x3a\x96\x9f\xa9\x64\x77\x60\x84\x4f\x20\xdd\x56\xa8\x34\xb0\x9f\x72\x58\x4b\xc5\x95\x69\x85\xd6\x8f\x73\x9a\x4e\x43\x41\x8c\x52\xf9\x1f\x1b\xed\x59\x9e\x9e\x46\x8f\x78\xe6\xb8\xa5\x81\x1a\x56\x49\x67\x61\xed\x33\xc3\xfc\x8f\xe7\x07\x6b\xce\xd9\xe8\x20\xb6\xa3\x50\xfe\xd7\x8d\x1e\xc8\x67\xb9\x27\xd4\x8e\x51\xbd\x7f\x0f\x6d\x00\xd6\x07\x85\x26\xcb\x29\xb4\x73\xd1\x16\x70\xf8\xed\xff\xcc\x93\xe7\x29\xef\x85\x81\x7d\x7f\x0f\x35\x69\xd0\xc3\x2d\xf9\x53\x87\x46\x89\x26\x6c\x69\x75\x6f\x9f\xfb\x8b\x99\xcc\x03\x67\xeb\xc8\x48\xf8\x99\xe7\xbd\x59\xed\x8b\x7d\x48\xf2\x9c\xe9\x62\x6a\xfa\xe3\xbb\x80\x4b\xfe\x87\xdd\x14\x97\xc6\x96\x76\x3f\x3e\x9d\x16\xa4\xe3\x44\x95\xbb\x17\x93\x5b\x41\x9f\x65\x36\x7a\xb3\xa1\xf3\xd9\xca\x16\x1b\xa9\x30\xc9\x64\xb9\x22\xa1\x59\x8b\x86\xae\x24\x6d\xb0\x97\x98\x5f\xdc\x50\x1a\x70\x01\xd9\x17\xf5\xce\x15\x44\x2b\x56\x83\x9c\x65\x5e\x40\x66\x50\xf8\x46\x5a\xa9\x55\x2d\x37\x17\x30\x6d\x2a\x50\xcb\xa7\xa6\x30\x97\xad\x43\xfe\x64\x2f\xe0\x95\x47\x9f\x10\x78\x6b\x8b\x29\xee\xbb\x71\x79\x6f\x74\x71\x12\x45\x13\x90\xff\x55\x00\x00\xc2\x51\x77\x3d\x25\xfd\xfa\xd6\x13\xf7\xff\xa1\xbf\x7e\xdb\x22\x4f\x86\x8e\xd0\x20\xe0\xa0\xa8\xe0\x25\x84\x3a\xcd\x80\xb5\x19\x46\x5e\xbd\x0e\x63\xe1\xca\x85\x9d\x8e\xf3\x5d\x26\x5d\x03\x49\xcf\x73\x57\xa7\x55\xc4\xbf\xb4\x54\x85\xa7\xee\x77\x02\x32\xe4\xf3\x43\xd5\xc1\x39\xda\xa9\x6b\x1f\xd1\x88\xa6\x3f\x39\x38\x27\x19\x3e\x1a\x43\x04\x94\x6c\xd8\x9a\x4f\x17\x78\xeb\xe6\xda\xae\xbf\x7e\x88\x36\x1c\x8d\x77\x26\x83\xd7\x70\x31\xb6\xac\xb0\x36\xcb\xfd\x55\x45\xbe\x5c\xe8\x60\x0d\xa4\xe8\x6c\x0d\x27\xc6\x82\xc6\xe4\x61\x59\x72\x55\x92\xe5\x31\xdc\xf8\xbe\x4a\x3e\x20\x89\x4d\x93\xd0\x6a\xe1\x58\x1c\x1c\x80\x4d\xcb\x88\xc4\x21\x9d\x4a\xef\x76\xa8\x1c\x18\x69\x88\x6a\x6b\xb0\x05\x83\x86\x02\x9e\x8f\xc4\xc9\xee\xfc\x43\x91\x61\x73\x5c\xfd\x76\x3d\xe1\x71\xd5\x9b\xb0\x71\x72\x78\x6e\x2f\x42\x61\xd3\x17\x2a\x5d\x11\x21\xbe\x9d\x16\x23\xcc\x0c\xf7\x9b\xda\xce\x6e\xb3\xf5\x54\x83\x1e\x88\xb8\x5f\xdc\xde\x41\xff\xd5\xeb\x84\x03\x3a\x6c\x6f\xd6\x03\x17\x86\x72\xe8\x84\x97\x40\x5d\xd6\x33\x2c\x7d\x2a\x4f\xc4\x14\x17\x68\x2c\xf8\x80\xa0\x37\xcd\xc1\x33\x6a\xf6\x86\x41\xf0\xfe\xde\xd2\x8e\x5f\x14\x6c\xc2\x0c\xbb\xb9\x55\x76\x90\xaa\xd2\x87\xd0\x95\xba\xa2\x66\x60\x7c\x53\x97\x3d\xf9\xe9\xe7\xef\x1e\xff\x14\x66\xe8\xe9\x49\xf1\xd6\xe6\xc5\x92\xdc\x3a\x63\x8f\x6d\x58\xdf\xe7\xd4\x55\xd7\x20\x13\x9c\xe4\x30\xd9\xce\x4f\x67\xb0\x17\x46\xfa\x76\x3c\xd9\xeb\xd5\x31\xe2\x2d\xe0\xff\x4b\xe5\x2e\xc2\xc3\x00\x08\xc0\xfe\x71\xa5\xe1\x2a\xfd\xfe\x5b\x5b\x04\x12\xe1\x30\x85\x39\x9b\x71\xc0\x08\x3f\x29\xcf\xcc\xd6\xe4\xb4\xf2\xfb\x81\x51\xe6\x2a\x65\xf4\xe9\x8e\x40\x9f\xa1\x13\x09\xb3\x99\xf4\xa3\xc5\x0e\x9d\xc8\xa2\x6c\x62\xb4\xe7\x34\xa9\xcf\xa3\x1a\x2d\x2a\x7e\x7b\xa1\xe0\xc7\xef\x9f\x3d\x66\x47\xcb\x6c\xaf\xae\x3a\xd9\x30\xdb\x5f\x7d\x45\xef\x37\x85\xbb\x44\xbb\xcb\x87\x1c\x69\x50\x08\x8b\x29\xeb\x62\xfc\xcd\xc2\x23\xab\x83\xb4\x58\xc0\x77\x9d\xaa\x1a\xd2\x07\x35\xd4\x45\x55\x71\xa8\xe8\x42\x77\x30\x3c\x59\x61\x6f\x96\x6c\xa0\x40\xb5\x0f\xdb\x4f\xf6\x9a\x8a\x60\x08\xf6\x83\x04\xee\x62\x29\x20\x1b\x56\xa5\xb8\x7e\xc0\xab\x6e\xb3\x41\x03\x1b\x74\x96\x2a\xd6\x56\x36\xa7\xcf\x76\xe8\x0d\x43\xc5\x70\xdf\x66\x60\x9d\x70\xfe\x8e\x9f\xfd\x69\x44\x41\x36\x93\x5c\xf6\xf4\xae\x6e\xfc\x2c\x81\xa7\x66\x42\x36\xe7\xb1\xad\x41\x8b\xca\x59\x90\x1f\x73\x67\x72\xe2\x55\x25\xcc\xdf\x26\xcf\x34\x2d\xe8\x29\x30\x3d\x26\xe3\x4c\x22\x49\x90\x85\x8a\x92\x15\x65\x89\x36\x3e\x5b\x8e\x29\xaa\xae\x4f\x64\x43\xd9\x78\x16\x6c\x4e\x98\x4d\x47\xa2\xb1\x19\x3d\x2c\x39\x68\x53\xc5\xab\xa9\x48\x6e\x55\x2b\x4f\x69\x45\xab\x22\x83\x6b\xe8\x17\xc2\xab\xd7\xfd\x25\xd0\x07\xf6\x32\x6a\xc5\xff\x75\xc7\x04\xa6\xa1\xa6\x56\x79\x2c\x58\x08\xe0\x19\x25\xc8\x16\x1b\x2c\x9d\x85\xad\x3e\x8c\x6a\x03\x7e\x32\x75\x75\xf4\xa0\x3f\xd7\x60\x3a\x65\xc3\xdd\x43\xb0\x9e\xb9\xea\x81\xaf\x79\x7a\xe4\x52\xb9\xe5\xd0\xe1\xa0\x12\xf0\xa8\xca\x80\xc9\x3f\x14\x8b\xd4\xec\x51\x95\x5b\xa3\x95\xee\x6c\x73\x9c\x12\x09\x06\x17\x4f\x8f\x74\x16\x0c\xda\xae\x71\x51\x1f\x1e\xca\xb0\x01\x45\xf9\x86\xbc\x62\xd8\x90\x50\xf7\x1d\x5c\x35\xba\xbc\x5e\x83\x95\xaa\xc4\xa4\xe5\xa7\x61\xa3\x8d\xee\x9c\x54\x48\x38\x6d\x67\x5b\x54\x55\xe8\x45\x12\x81\x07\x0f\x36\xfe\xb5\xd8\x5b\x7b\xa1\xb4\xf2\x48\x28\x90\x86\xa7\x62\x72\x8f\x85\xef\xb6\x94\xc3\xc6\x2f\xe1\x6f\x7e\xbf\x8f\x69\x67\x50\x61\x4d\xc6\x3f\xda\xb2\x4f\x57\x77\xb2\x34\xda\x09\x7b\xbd\x06\xa9\xb8\x0f\x26\x5d\x10\x90\xaf\xd5\xa8\xa9\xd9\xb3\xe6\x8b\x21\x19\x1e\x8f\x79\x1e\x0a\x4e\xdc\x9a\xe1\xf1\x83\x80\x5f\x8c\xde\x49\x8b\xc1\x8a\xa4\x17\x95\x6e\xf6\x98\x3c\x82\x61\xe1\xe9\x7a\xc4\x91\xef\xb4\x1a\xa4\x83\x82\x15\x3b\x49\x5f\x21\xd8\x62\xb9\x78\x6c\x4f\xb6\xf7\xb5\xdf\xde\x0f\x58\x75\x6d\x23\x4b\xe1\x10\x4a\x2d\x1a\xb4\x25\x5a\x7e\xe5\xb2\x13\x15\xdd\x55\xc9\x06\x41\x40\x6b\x70\x2f\x75\x17\xe6\x88\xab\x83\x90\x8e\x2f\x9f\x4d\xa7\x3c\xe9\x4e\xf9\x97\x86\x9c\xc9\xd3\x5b\xfb\x26\x6c\x6e\x3d\xb0\xce\x26\x16\x3c\x1b\x1d\x8a\xc1\x5c\x78\x3b\x8d\xb0\x71\x6b\xbb\xf0\x9c\x11\xf7\x7e\xde\xcb\xa3\x96\x86\xf6\xdf\xfa\xc3\xdd\x1c\xf9\xc8\x18\xb4\xf2\x3f\x48\x4c\xd8\x92\x7a\x80\x05\x7c\xcf\x9b\xa9\x78\x33\xb1\xf5\x1e\x6f\x09\x58\xc8\xbe\x91\x2c\x77\x6d\x23\xd1\x06\x5d\x17\xcb\x45\x2a\x94\x44\x62\xdf\x2c\xf3\xde\xf2\x7e\xae\x19\xa3\x1d\xd7\x2c\xc3\xe9\x20\x66\x03\xe9\x5a\x0d\xdb\xff\x3c\xc7\xb3\x06\x4b\x2d\x81\x8e\xcc\x75\x74\xed\xbf\xa3\xa0\xcd\x0c\xad\x6a\xb5\xf6\xe6\x99\xc7\xde\xad\xdf\xa8\xb0\xa9\xc3\x8a\x0f\x00\xfa\x25\x9f\xe3\xc1\xd6\x9e\x6e\x2f\x9a\x91\x43\xe3\x64\x32\x26\x8f\x04\x78\x2f\x95\xe7\x5f\x2e\xe1\x6f\x3e\x69\xa3\x4c\xed\x5e\x3c\x72\x15\xe1\xba\xa9\xd5\x05\xd4\xea\x76\xc8\xcf\x07\xc6\x0b\x12\x65\x9e\x22\x0d\x07\x3a\xa2\x9b\x2c\xf8\xf4\x5d\x71\x92\x4a\x7c\x29\x3c\x10\x16\xef\x8e\x09\x49\xb2\xd8\x67\x81\xbf\x77\xd8\xe1\xb3\x68\xfa\x81\x58\x0e\x37\xb0\xd1\x50\x16\xa6\x53\x24\x68\xb8\xcd\x93\xc6\x7e\x59\xb4\xe1\xc0\x51\xfe\x38\xba\x5c\x4b\x95\xc3\xae\xbd\x66\xda\xfc\x7c\x96\xfe\xf2\x1d\xf8\x3b\x7c\x3b\xb7\x40\x82\x50\x48\x3e\xb1\x29\x3f\x71\x2b\xec\xe1\x7b\x1a\xc3\x5d\xbe\x17\xd7\xdc\xbf\x98\x65\x2c\x06\x11\x9e\xfc\xeb\x25\xba\x5c\xf0\x3e\xd7\xd1\x6d\xad\xd9\x25\x41\xf2\x54\x80\x88\x4f\x84\xfc\x01\x4d\x7d\xd1\x73\x7c\xb3\x0c\x5a\xba\x17\x47\x6e\x68\xf9\x05\x9c\x68\xea\x62\xf8\x93\x4a\x89\xa8\x00\x18\xd7\x42\x2c\x15\xae\x86\xbc\x26\xcf\x30\x1e\xea\x88\xb2\xe8\xa7\xe9\x4f\x0f\x70\x79\xba\xd7\xa5\xd7\x71\x54\x3d\x6b\xd5\x74\x6a\x70\x08\x64\xe9\x16\x9d\x6b\x38\xe9\x63\x36\xa2\x0f\x24\xb8\xe0\x34\x86\x58\x19\x3b\x58\xe5\x20\x8a\x1c\xc2\x61\xfb\xbc\x34\xe4\x66\xb9\xf0\x71\x0d\xe2\xf9\x0d\xd5\x9c\xf1\x35\x54\xe8\xd8\x51\x21\x67\xc6\x45\x1c\x6f\x3a\xbe\x40\xf4\x01\x26\x94\x49\x26\x96\x6d\xb7\x54\x55\xf6\x82\x8a\x90\xb5\x5a\x95\x45\xd0\x51\x59\x0c\xf6\x14\x0f\xfd\xc8\x15\x84\x93\xff\xe1\xf3\x9e\x7a\x16\xef\x0b\x97\xfc\xfa\x29\xc5\x35\x9c\xf0\x9a\xbf\x35\xf8\x0c\x71\x2d\x17\x94\x50\x90\xdb\x1d\x0e\x62\xdf\x77\x69\x9a\x93\x70\xe8\x7b\xbd\x42\x1d\x8b\xe5\x22\x46\x45\xea\x28\xf6\x67\x7f\x55\xc3\x17\x23\x26\x73\x8f\xe5\x33\x3c\x96\xac\xa1\x2e\x22\x6b\x89\xa2\xfa\x41\x16\xf9\xf0\x7b\xc0\x7a\x79\x6a\x32\x83\x23\x1d\xc0\x7b\xbf\x75\xbb\x1c\x90\xc2\x87\x7c\xa4\xac\xe1\x2f\x75\x11\xf7\x7e\xb3\x9c\xfa\xcc\xc2\x3a\x61\xdc\xc8\x15\x4e\x89\xde\x21\x2f\xbf\x7c\x95\xf7\xde\xa0\x5f\x9c\xb0\xb9\x86\x81\x87\xcb\xd0\x15\xf5\x7d\xca\xc5\x46\x87\x19\xcf\x7d\x99\xdf\x45\xa7\x87\x4a\xcd\xee\xc6\x9f\x6f\xb2\xbd\x82\xdc\xf6\x22\x25\xc3\x7d\xd7\x73\x8a\xb9\x43\x0e\xc1\x10\xc6\x00\xd1\x65\x84\x6c\xb2\x4f\x37\xd9\x19\x9c\x22\x63\x53\x8e\x9d\xeb\xdf\x4f\x3b\x3f\x63\x78\xba\x49\xfe\x7d\xfa\x6a\xe7\xf7\xde\x62\xf3\xfe\x40\x78\x35\xcd\xfb\xcd\x50\xb4\xb0\xb1\xf7\xbf\xdd\x16\x55\xb6\x86\x3a\x9a\xf7\xe0\x27\x46\x45\xe4\xa4\xd8\xed\xaf\x56\x12\x8f\xf8\xb1\x31\xd0\x0e\xd9\xaf\xf7\x9d\xb1\x0c\x69\xc3\x4d\x86\x1f\x8b\x17\x01\xa9\xdf\x3a\x5b\x9d\x51\xb1\x4d\x0f\x9b\xf8\xe6\x38\x26\x37\xc1\x43\x9e\xf6\xc9\x26\xcf\x3c\x4c\xff\x84\xc3\xcf\x63\x9c\x26\x8c\xb4\x3e\x50\x8f\x49\x4d\xa0\x91\xcc\x7b\xef\x2c\xba\xc6\x0d\x63\x59\x7f\xdf\x31\x27\x86\xb7\xb6\x08\x92\x08\x17\x5f\x68\xcc\xa9\xfa\x3d\x45\x0e\x75\x3b\xbb\xc9\x7b\x95\x72\xe1\x29\x9c\x13\xe5\xd6\xdf\x4b\x84\xf6\xe4\xa4\x00\x35\xf9\x1a\xc2\xca\xe1\x5e\x8d\x35\xfc\x5f\x78\xb4\x23\xdd\x5e\xd3\x00\x07\xb4\xf0\x6c\x7f\xfa\xc9\x4f\x50\x06\x2d\x4d\x9f\x4c\xbe\x7a\x3d\xba\x01\xd4\xc9\xbd\x8b\xfe\xe0\x73\x0c\x71\xb6\x99\x1b\x76\x49\x6c\xd1\x5b\x30\xea\x7c\x12\x28\x55\xdb\xab\x48\x71\x0d\xa2\xff\x20\x83\xec\x5a\x1b\x90\x04\xf4\xb7\x6f\x41\xc2\xff\x4b\x26\xbf\x05\xf9\xe5\x97\x9e\xbc\x7d\x25\x5f\xc3\x25\x88\xfe\xab\x8a\xd9\x37\x54\x36\xf6\x2a\x7c\x6b\xea\xe5\xaf\x3f\x8d\x44\x45\xbf\x59\x52\x96\xe5\x63\x62\x17\x2b\xed\x7c\x1d\x44\xdf\xf4\xaa\x8d\xde\xc5\x07\x51\xa7\xaf\xd1\x86\x4f\x0f\xae\x95\x3e\x84\xc7\x6b\xd2\x8e\x7a\x6b\x45\x67\x9a\xd0\x50\x9c\xf4\xcd\x2c\x7f\x11\x2d\x9b\x11\x63\x61\x12\xa8\x19\xd5\x77\x21\x03\xa4\xd5\x9d\x29\xf1\x64\x03\xf1\xeb\x11\x6f\xd0\xcc\x73\xba\x15\xa9\xfa\x26\xe7\xd0\x8e\x6b\x74\x29\xe2\xfd\xde\x01\xaf\x80\xee\xe3\xd1\xd8\x02\x1e\x5b\xff\x6d\x8a\xdd\xca\xb6\xc5\x0a\x14\xbe\xeb\x7b\x07\x11\x21\x3f\xdd\xf5\xd7\x26\x43\xab\x32\x3d\x08\x2f\x7f\xfd\x89\x0f\x7f\x26\x08\x5f\xd1\xfa\x17\x8f\x7d\x43\xf3\xe5\xaf\x3f\xad\xf2\xfc\x3e\x1f\xca\x64\x6c\x7a\x53\x3e\xba\x86\xdb\x45\xc8\xe9\xb5\x72\xfa\x95\xfa\xf0\x65\xc6\xc4\x0e\x38\x59\xdf\x0a\xeb\x7b\x58\x2d\x9a\xf0\x72\x8b\xf6\x17\x3a\xaa\x58\xf5\x1f\xc4\xe9\x1a\x64\xe1\xbf\x81\xc7\x77\x94\xfc\x48\x7f\x3f\xeb\xd0\xf4\x59\x25\x9a\xf4\x43\x78\xfe\x34\x9e\xd3\x20\xff\x6d\xdf\xe9\x07\xf2\x43\x63\x8b\x99\xbd\xa3\xfd\xb6\x27\x7b\x38\x6d\xd6\xe5\x1f\x7f\x89\xf2\xe6\x4d\xec\x1c\xbe\x09\x9b\x7f\xf3\x26\x5b\xc3\x7e\x16\xc0\x74\x8a\xbe\xda\xf4\x10\x23\x91\xf3\x84\xbf\x57\x89\x5b\xf5\x2f\xab\xce\xdd\xc3\x30\x50\x36\x63\xd4\x3c\x35\x63\xda\x3b\xef\x1f\x78\x3a\x9a\x77\xc8\x92\x77\x01\x6d\x7b\xbd\x49\x94\x4e\xb1\x34\xcb\xe0\x86\x32\x43\xb2\xbe\xa8\x3a\x9f\x38\x6b\xe5\xa4\xea\xf8\x85\x17\xef\x75\x97\xbe\xd6\xec\xd1\xac\x43\x18\x8f\x5f\xd5\x0c\x25\xd0\xa0\x84\xf9\x47\xbc\x7f\xc5\xe1\xea\x22\x7e\x5d\x4b\xb2\x2d\x9e\x0c\xb4\xe8\x76\x3c\xa1\x45\xfe\x3c\x4c\xb8\x63\x9b\xe5\x21\x41\x8a\xae\x52\xb4\x6d\x73\x24\x04\xe1\x73\xa8\x7c\x52\xbc\xc6\x4b\x8e\xe7\x78\xf0\x97\x4a\xdf\x75\x75\x7d\xee\xa4\xa7\x00\xe4\xbc\x40\xc0\xd5\xd1\xf1\x27\xc4\x7c\x02\xc7\x78\x56\x57\xf0\xea\x35\xc1\x8c\xb6\xee\xe1\x67\xce\xe0\x15\x9d\xa0\xba\xb6\xe1\x05\x67\xc0\x1a\x0e\x4b\x18\xcd\xf2\xf0\xc5\xca\x72\x11\xbe\xe2\x3b\x85\x0a\xa3\x03\x54\x74\xdc\x09\x88\xe0\x8b\x33\xff\xeb\xca\xf3\xd8\x87\x15\x0f\x47\x71\xc5\x13\x8b\xff\xfd\x32\x60\xed\xdd\x41\x28\x6c\x2c\x75\x9c\xd0\x7f\x2e\x4a\x39\x44\xf4\xcf\x7d\x7f\x5c\x78\xa0\xad\x36\x6e\xeb\xff\x9f\x0a\xda\x4c\x5d\x86\x85\x15\x3f\xde\x18\xbe\xf5\xc8\xb9\xfe\x79\x76\xe6\xdb\xe1\xf0\x88\x67\xc4\xc3\xf0\x01\xf7\x27\x72\xc1\x5f\x8b\x9f\x67\xe2\xc5\xf8\xc3\x73\xce\xb0\xa5\x92\x9c\xb5\x3f\x78\x00\x62\xaf\x65\x05\x15\x8a\x2a\xbc\xf5\xc0\x46\xee\xa4\xf2\x11\x60\xb9\xf0\x3a\x0e\x2f\x17\x6f\x97\x8b\x37\x70\x09\x54\x0f\xfc\xdf\x00\xbd\x8e\x4e\x05\xcc\x44\x00\x00"),
+		},
+		"/js/js_test.go": &vfsgen۰CompressedFileInfo{
+			name:             "js_test.go",
+			modTime:          time.Date(2026, 10, 15, 22, 3, 38, 698260023, time.UTC),
+			uncompressedSize: 6940,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x58\x5f\x73\xdb\x36\x12\x7f\x26\x3f\xc5\x86\x37\x4a\x48\x1f\x4b\x25\x4a\xa6\xd3\xda\xf5\x43\x26\x4d\x72\xe9\x5c\x92\x4e\x9d\xde\x8b\xc7\xe3\x40\x24\x28\x42\xa2\x00\x16\x80\xe4\xf8\x5c\x7d\xf7\x9b\x05\x40\x12\xa4\x24\xbb\xb9\xc9\x5d\x1e\x62\x91\x58\xec\x6f\xff\xe3\x47\x4c\xa7\x7f\x9f\x6f\x58\x5d\xc0\x52\x85\x61\x43\xf2\x15\x59\x50\x58\xaa\x6b\x4d\x95\x0e\x43\xb6\x6e\x84\xd4\x10\x87\x41\x44\xa5\x14\x52\x45\x61\x10\x95\x6b\x8d\x7f\x50\x82\xf1\x85\xf9\xc9\xd6\x34\x0a\xc3\x20\x5a\x30\x5d\x6d\xe6\x59\x2e\xd6\xd3\x85\x68\x2a\x2a\x97\xaa\xff\xb1\x54\x51\x98\x84\x61\xb9\xe1\x39\x7c\xa2\x4a\xbf\xe3\x9a\x4a\x4e\x6a\xf6\x6f\xfa\x8a\xc9\x7c\x53\x13\xf9\x1b\x2d\xa9\xa4\x3c\xa7\xb1\x86\x13\x07\x90\x7d\x4a\xe0\x2e\x0c\xa6\x53\xb8\xa0\x14\x2a\xad\x1b\x75\x3a\x9d\xde\x8b\xc4\x94\xda\x50\x35\xfd\xf1\xfb\x1f\xb2\x30\x58\xaa\xec\x6d\x2d\xe6\xa4\xce\x5e\x91\xba\x8e\x23\xba\x25\x75\x94\xc2\xe7\x30\xd8\x12\x09\x46\xf4\xc7\xef\x7f\x20\x70\x0e\x77\xbb\xb3\xe1\xcb\x39\xbe\x7c\x42\x9e\x9c\xf6\x62\x28\xd2\x3d\x64\x28\xd0\x09\x9f\x7d\x4e\xc2\xe0\x1a\xce\xa1\x47\x7c\x4b\x75\x1c\x75\xe2\x51\x92\x19\x9f\x4b\x92\xd3\x38\x09\x77\x61\x38\x9d\x02\xb9\x21\x4c\x03\xfe\xa7\xa0\x14\x12\x74\x45\xe1\x57\x29\xd6\x4c\x51\x68\x40\x0b\x98\x53\x50\x54\xeb\x9a\x16\x29\x10\x5e\x80\xa4\x7a\x23\xb9\x02\xdc\xb0\x25\xf5\x86\x9a\xb7\x37\x15\xd5\x15\x95\x60\x74\x29\x28\x37\x75\xc9\xea\x9a\x16\x99\x8d\xb7\x41\x89\x1b\x38\x59\xaa\xec\xe3\x7c\x49\x73\x9d\x40\xdc\x3f\xa4\x30\x17\xa2\x36\x71\xd6\xb7\x0d\x05\x49\xd5\xa6\xd6\xa0\xb4\xdc\xe4\x1a\xdf\x06\x16\x09\xff\xf5\xbb\xc2\x20\xe8\x70\x8c\x82\x30\xd8\x85\x41\x5e\xc1\xe9\x39\xac\xc9\x8a\xc6\x79\x45\xb8\xd3\x95\xc2\xb3\x24\x0c\x1a\x97\x03\x5d\x51\x1e\xa5\x80\xa6\xc5\xdb\x81\x51\x77\x90\x57\xf0\xd3\x77\x6e\xd7\xdd\x36\x05\x2d\x37\x74\x07\x3b\x27\x4d\xa5\xbc\x4f\x9e\x4a\x99\x42\x49\x6a\x85\x5b\x92\x30\x90\x68\xcb\x4f\xdf\xe5\x55\x18\xd8\xb8\x81\xcc\x8c\x2b\x29\xc8\xac\x33\x1e\x53\xd1\x95\xe5\x9b\x0d\xcf\x3f\x96\x2f\xd5\x2d\xcf\xf7\xab\x50\x12\x8e\x1a\x0d\x42\x18\x94\xf8\x7b\xa9\x32\xbb\x25\x36\x06\xea\x8a\x29\xf0\x23\x4b\xe4\x42\xc1\xe5\x95\x6f\x34\x6b\xab\xe0\x6e\x67\x82\x8b\x5a\xcf\x8d\xa3\x61\x10\x60\x33\x65\x17\x35\xa5\x4d\x6c\x7e\xbe\x67\x75\xcd\x14\xcd\x05\x2f\x12\x98\x4e\xc1\x58\x66\x82\xa1\x99\xe0\x0a\x72\xc2\x61\x5e\x8b\x7c\x95\x85\x41\xeb\x24\x62\x5e\x3e\xbd\xc2\x72\x8b\x13\x38\x81\x59\x18\xec\x52\xb4\xd4\x6c\xc6\x44\x18\x2f\xb2\x77\x7c\x2b\x56\x34\x9e\x61\x6e\x58\x09\x68\x07\xda\xa3\xb3\xd7\xd8\xed\x71\x34\xc4\x32\xeb\xf8\xa6\x92\x82\x8b\x8d\xaa\x6f\xa3\xc4\xa4\x9c\x95\xb0\x4d\x41\xac\x50\xa9\xab\xb4\xe4\x0c\x1e\x89\x15\xfc\xf9\x27\x6c\x9d\x15\x8f\xce\xe1\xc5\xcc\x57\x5f\xee\xe9\xcf\x49\x5d\xb7\xc5\x0e\x37\x4c\x57\x30\xd9\xa6\x30\xd1\x29\xdc\x10\xae\xe1\xc5\xcc\x56\x43\x94\x5a\x38\x03\x1e\x06\x25\x61\x35\xe3\x8b\x6f\x94\x8b\x86\x70\x96\xc7\x91\x10\x8d\x8a\x92\x71\xd8\x58\x09\xa6\xc2\x7c\x57\x1d\x7c\x1b\xcb\x24\x39\x03\xeb\x38\x95\xd2\x0e\x80\x35\x55\x8a\x2c\x68\x94\x64\x17\x5a\x32\xbe\xb0\xc1\xb0\x10\xa3\x80\xfc\x8a\xe8\x2b\xf4\x86\x7c\x55\x68\x08\x48\xba\xa4\x56\xd4\xac\x12\x0e\x66\x62\x47\x69\x6b\xb1\x89\xd6\x2e\x0c\x4d\x83\x1b\x2f\x2f\x6c\x7b\xdb\x2e\xbf\x83\x5c\x14\x14\xc3\x01\x7e\x37\x18\x83\xfe\x85\x1d\xb3\xdf\x0c\x06\xf9\xf4\x1c\x1e\x7b\xda\xee\x50\xcb\x29\xbc\x98\xed\xfe\x37\x89\x41\xcc\xbd\xb4\x48\x4a\x94\xe0\x0f\xa4\xc5\x64\x4f\xac\x5c\xc0\xdf\x10\x4d\xea\x87\xe2\x3d\x98\xa4\x7d\xad\xeb\x5b\xd3\x3e\x16\xd5\xa6\x78\x21\x8c\xa6\x28\xb1\x8f\x18\x63\x2f\xdd\x67\x66\x0b\xe6\xfc\xc4\x1d\xad\x99\x17\xb2\x71\x0d\xbc\x15\x1a\x9c\xbe\xcc\x24\x6b\xf2\x87\xcb\xf2\xe4\x8f\x28\x45\x55\xe9\x11\x45\x9d\x85\x0b\xa1\xdb\x68\xc4\x8f\x97\xca\xaa\xbe\xb3\x91\x3d\x75\x86\xef\x92\xcc\x4b\x6e\xdf\xaf\x0b\xa1\xd1\x54\x03\xb8\x6f\x98\xbf\x65\x58\x82\x93\x6d\xd7\x9d\x0e\xde\x2e\x74\x46\x5d\xdf\x63\xd2\xe8\xc8\x34\x8b\x51\x92\x7d\xa0\x37\x78\x58\x48\x71\xc3\x61\x7e\x0b\xbf\x90\x2d\xb9\xc8\x25\x6b\x74\x94\xec\x99\xdf\xa5\xd6\xcd\x2e\x7f\x15\x44\xd9\x75\x04\x1c\xd2\xe7\x4e\x55\x5a\x58\x17\xac\xcd\x78\x1c\x5f\xa7\xb6\x2f\x30\xdf\x84\x2f\x28\x5c\x5e\x29\x93\xd5\x3b\x6b\x17\xf0\x4d\x8d\x74\xc2\x3d\x6d\x78\x41\x4b\xc6\x69\x11\xd9\xa2\xf5\xdd\xb6\xb8\xd6\x3a\x6d\xd5\x1e\xf1\x61\x3c\x10\x3c\x2f\x26\x6a\x64\xaa\x53\x14\x06\xc1\xce\x35\xf8\x74\xea\x43\x75\x7c\x01\x89\xc5\x49\x1b\x78\xd0\x15\x41\xa7\x73\xb1\xa5\xbd\x48\xcb\x3f\xe8\x97\x9c\x36\xd8\x06\xbd\x2e\x0c\x17\x2e\x79\x21\x43\x5c\x47\x2f\x06\xae\x79\x63\xc2\x05\xcf\x46\x2c\x81\xee\xf4\x36\x92\x96\x6e\x64\xff\xa0\x75\x43\x65\x9c\x84\x41\x81\xec\xcf\x1e\xf3\x66\x2d\xb0\x61\xb7\x46\xa2\x80\x21\x67\x62\xe5\xb8\x86\x3f\x8d\xcf\x81\x66\x71\xaf\xd9\x16\xb3\x0b\xa5\x69\xf5\x32\x8e\x7e\xb3\x8a\x68\x01\x93\xbf\x6d\xa1\x94\x62\x0d\x13\xd5\xcd\xcf\x6e\x33\x4e\xcc\x61\x50\x11\xfa\x08\x8b\x74\x62\xee\xd8\xe5\xac\x1e\x50\x09\xa3\xef\x9d\xda\x9f\x9c\x18\x07\x1c\x9c\x0f\xf5\xc1\xcf\x1f\xdf\xbf\x6e\x73\xd1\xb6\xc3\xa7\x8a\x82\x68\xa8\x24\x76\xd8\x13\x05\x64\x2e\xa4\xa6\x45\x86\x65\xf8\x12\x7f\xbb\xf6\xe9\x66\x01\x62\xe1\x69\xf4\x81\xac\x4d\x99\xb9\x16\xf7\x85\x0f\xb4\xba\x95\x1e\x4d\x1f\xd3\xda\x03\x94\x30\xd8\xc3\x31\x2b\x3e\x90\x57\x35\xa6\x07\x4f\xe1\x1e\x2f\x0e\x98\xe2\x14\x1e\xb4\xe5\xab\x74\x77\xd6\x3e\x32\xb2\x2a\x7b\xa7\x62\x53\x43\x78\x9a\x74\x4e\x25\xc3\x49\x72\xbf\xa8\x63\x83\xce\x30\x6f\x7c\xd8\xf2\x1c\xed\xfc\x20\xf4\x1b\xb1\xe1\xc5\x5f\xc3\x19\x49\x5b\x8e\xe8\x90\x0c\xea\x71\x87\xf6\x6b\x0b\x33\x63\x7f\xef\xee\x07\xee\xb7\x0e\xb6\x1c\x73\x34\x0c\xdc\x88\x78\xa0\xa2\x87\x9f\x5f\x11\x71\x63\x21\xfa\x3c\x2c\x54\xab\x2c\x7b\x6f\x49\x93\x5f\x45\xdd\x8e\x03\x15\xd2\x89\x9b\x51\xef\x94\x38\xf9\x83\x65\xd3\x29\x1b\x17\xb0\xc3\x1f\xf7\xca\x3d\x1d\x72\x1f\x22\x5d\x37\xfa\xd6\x82\xb6\x04\x6c\x38\x1e\x7e\xe7\x37\x92\x34\xfb\x23\x22\x27\x1b\x45\x1d\x75\xf2\x47\xc2\xa7\xdb\x86\x0e\x8e\xc7\x39\x29\xc0\xf2\x8d\x30\x10\x8d\xfd\x26\xd8\xdf\x66\xd3\xe1\xf6\xf4\x92\xd9\x05\x2e\x1a\x2c\x9c\x66\xf8\x37\xf9\xeb\xe3\x69\x60\x07\xba\xd1\xd0\x22\x4a\xc1\xe9\x4e\x8e\x76\x5a\xe7\xc3\x83\x0d\xe0\x49\x1e\xed\x33\x3c\x14\x34\x91\x0b\xaa\xfb\x21\x3e\x40\x7e\x69\xf4\x65\x2e\xd2\x49\x0a\x8f\xad\x78\x82\x74\xc7\xfe\x74\x55\x8e\x99\xb6\x81\xdf\x4f\x76\xbb\xdd\x10\x1d\x6b\x43\x45\xa1\x0d\x9d\x0f\xe0\xba\xa2\xa5\xc7\xce\x0a\x13\xa4\x85\xb0\x8f\x68\xfa\xff\x9d\x1e\x5f\x3f\xc4\x8e\xbd\x54\x1d\x65\x8d\x8e\xd4\x1d\x4b\x9c\xeb\x86\xb7\x62\x48\xb6\xb4\xf0\x99\xc3\xf1\x49\xf2\x0d\x28\x97\x2b\xde\x43\x6c\xcb\x72\x06\xef\xa0\x6a\x33\xd6\xb5\x39\x67\xf5\x98\x81\x0d\x72\x6f\x19\x58\x57\x01\x9c\xb5\x24\xa0\xed\xf0\x60\x67\x41\x46\xa5\xdc\xc7\xcc\xd3\x3c\x8a\xda\x44\x1d\x1a\xf0\xfb\xfc\x6e\x74\x53\xf1\x33\x2d\x36\x4d\xcd\x72\xa2\x0f\x7c\xa2\x61\x6f\xe0\xa7\x0c\x56\x0f\xe3\xfa\xdb\x5d\x59\x58\xa5\xe7\x80\x3d\xcf\x8b\xd8\x3c\xa6\xc3\x7b\x87\xe4\x81\xcb\x8c\x30\xf0\x79\x93\xab\x59\xcf\x1b\xbc\xa6\x78\x36\xb8\xa7\x30\x57\x48\xb3\xe1\xd5\x85\xb9\x13\x79\x25\x48\x4d\x55\xde\x7e\x1a\x63\x63\x96\x4c\x2a\x6d\x7c\x4f\xe1\xa6\x62\x79\x05\x15\x51\xfc\x89\x06\xa5\x09\x72\x01\xb8\xa5\x3a\x33\x55\xdf\x3c\xc3\xcc\x37\xb3\x61\x49\xf7\x2a\xad\xab\x1d\xeb\x2e\x58\x69\x2e\x29\x35\x34\xf6\x9a\x4e\xb9\x39\xe4\x6e\x3e\x8c\x91\xcf\x07\x46\x3e\x4f\xba\xd5\xe7\xb6\xd3\x6a\xca\x6d\xcc\xcc\x55\xc0\x0c\x07\x91\x79\xbc\x7c\x7a\x35\x7a\xf1\xcc\xbc\x78\x3e\x1a\x48\x5e\x98\x8a\xfe\xa3\x15\xa9\x0e\xee\xf2\xaf\x08\x4c\x29\x5d\xce\xe0\xf9\x95\x19\xf0\x08\xb9\x77\x0e\x7d\xe9\x2e\x62\xf7\x29\xbc\x29\xa3\xbe\x6b\x70\x2c\x8c\xc5\x39\xab\xf7\x3b\x68\x44\xe0\x0e\xed\x19\xf7\x51\x7b\x46\x22\xdb\x7e\xfd\xdf\x1c\x41\x5c\x68\x28\x91\x2f\x19\xee\xbb\x10\x4e\x89\x3f\x80\x1b\x2a\xd7\x4c\x29\x8c\x56\x41\x39\xb3\x9f\xf5\x6e\x64\x94\xeb\xde\x68\x49\x49\x81\xc3\x39\x17\xbc\x64\x8b\x53\x98\xdc\x44\x69\xab\xe8\x17\xc1\x78\x6c\x4c\x44\x9b\x5f\x4b\x89\xa5\x2e\x8e\x45\x87\x4a\x99\xf8\x11\x14\x47\xae\x08\x5a\xa2\x71\x82\x66\xe0\xb8\x39\x4a\xcc\x0f\x5c\x0a\x58\x4e\x33\xda\x3a\x66\x36\xe2\xd8\x75\x54\x07\xee\x91\xf7\x03\xb8\x6e\xe3\x21\x68\x6f\xe3\x11\x54\xa5\x49\xbe\x8a\x7a\xa8\xa5\xca\x7e\x6f\x27\xf7\x01\x2c\x23\x0e\x93\xed\xf0\x38\x71\xb8\xfd\xc8\xef\x8b\x26\x58\x0a\xa3\xaa\x47\x34\x47\xb3\x32\x97\xee\x05\xfd\x12\x3f\x1d\x64\xc1\x4a\x8f\x04\xff\x49\xf9\x42\x57\x5e\x3c\x66\xfe\x6d\x91\xb3\x6c\x52\xd8\x43\x5f\xa1\x69\x38\x69\x1c\xb0\x6f\xe0\xcc\x37\xac\x67\x72\x87\x30\x0f\x19\x67\x16\x1f\x2a\x93\xee\x7b\xf5\xab\x2a\xa4\xdb\x35\x4e\x93\x87\xe9\x52\xb5\x0f\x6a\x6a\x3e\xbb\xc0\xe5\x83\x05\xe2\x92\xb6\x8f\x3b\xd8\x38\x46\xbe\x27\x2c\xcf\x92\x07\x4b\xf6\x40\x47\x7f\x5d\xe9\x1e\x1e\x09\x38\x21\xff\x33\x00\xa7\x89\x0d\xb1\x1c\x1b\x00\x00"),
 		},
 		"/nosync": &vfsgen۰DirInfo{
 			name:    "nosync",
-			modTime: time.Date(2021, 3, 28, 16, 13, 12, 635790600, time.UTC),
+			modTime: time.Date(2026, 10, 15, 16, 28, 27, 932829971, time.UTC),
 		},
 		"/nosync/map.go": &vfsgen۰CompressedFileInfo{
 			name:             "map.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 12, 588798700, time.UTC),
-			uncompressedSize: 1958,
+			modTime:          time.Date(2026, 10, 15, 16, 28, 16, 691301214, time.UTC),
+			uncompressedSize: 3315,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x57\x4f\x8f\xdb\xb6\x13\x3d\xdb\x9f\x62\xf6\xf4\xb3\x7f\x50\x9c\x7b\x8a\x3d\x2c\xda\x4b\x80\xa6\x0b\xb4\xbd\x05\x39\xd0\xd2\x70\x4d\x98\x22\x15\xce\x68\x5d\x65\xb3\xdf\xbd\x98\xa1\x24\x4b\xb2\x9d\x06\x68\x7a\xdb\xa5\xc8\x99\x37\xef\xcd\x3f\x37\xa6\x3c\x9a\x27\x84\x10\xa9\x0b\xe5\x7a\xfd\xf6\x2d\x7c\x30\x0d\x38\x02\x03\x65\x0c\x65\x9b\x12\x06\x86\xda\x34\x70\x72\x7c\x00\x53\xc7\xc4\xee\x0b\x56\x6f\xca\x18\x88\x4d\xe0\x37\xec\x6a\x04\x1f\x4d\x45\x05\x10\xc7\x84\x54\x80\x09\x15\x54\xe8\x91\x91\x76\x62\xf3\x3d\x8b\x49\x32\x16\xc1\xc6\x04\x75\xeb\xd9\x35\x1e\xe1\x29\xa6\xd8\xb2\x0b\x48\xc0\x11\x4a\xe3\x3d\x18\x01\xf0\x3f\x82\x1a\xf9\x10\x2b\x9a\xa0\xf0\x9d\xd8\x12\x73\x7f\x1e\x10\xbe\x60\x8a\x03\xd6\x67\xe3\x5d\xa5\x4e\xb1\x6e\x78\xbc\xf6\xa0\xdf\xeb\x96\x18\x42\x64\xd8\x23\x94\xb1\x71\x58\x81\xb1\x8c\x09\xac\x4b\xc4\xd0\x12\xee\xd6\xdc\x35\xa8\x97\x89\x53\x5b\x32\xbc\xac\x57\xb5\x04\xfd\xd1\x05\xc6\x64\x4d\x89\x2f\xaf\x9f\x26\x7f\xaf\x5f\x95\xaa\x5f\xa3\xa9\x20\x21\xb7\x29\x10\xf0\x01\x05\x48\x8b\x99\x85\x0a\x5c\xd0\x33\xe1\x4e\x82\x36\x70\xc4\xae\x80\x98\x20\x38\x0f\xce\x42\x88\x62\x23\x3f\x71\x04\x4d\x42\xc2\xc0\xbb\x21\xc0\x78\x84\x84\xd4\x7a\x06\x17\x2a\x57\x1a\x46\x82\xd3\x01\xf9\x80\xa9\x7f\x74\x32\x04\x36\xb6\x61\xea\x6a\xb7\xb6\x6d\x28\x61\x53\xc3\xff\x3f\x98\x66\xab\x10\x37\x47\xec\x60\x82\x7e\x0b\x9b\xde\xeb\xf9\xac\x10\x7f\xfb\x18\xfd\x56\x82\xd7\xcf\x7a\x74\x0f\xf5\xae\xfe\x78\xc4\xee\xd3\x7a\x95\x23\x85\xf1\x63\xcf\xc2\x1f\x12\x2e\x10\xf2\x94\x83\x31\xe2\x25\x20\xbd\xbd\x51\x2a\x2e\x40\xa8\x6f\x67\xc5\x25\xdc\xdf\x2b\x4f\x2f\xeb\xd5\x4a\xff\x85\xda\x1c\x71\xf3\x0d\x4d\xb6\xeb\xd5\xeb\x7a\x35\xa0\x85\xfb\x6c\x7e\xa2\xd4\x63\xca\x48\xa7\x82\xe1\x5f\x8e\xd8\x85\xa7\x09\x6a\x39\x56\xc2\xec\x4c\x92\x47\x21\xfe\xe4\x08\x0b\x70\xdc\x27\xba\xa6\xdc\xd4\xdc\x93\x7b\xc6\x9e\xa0\x51\x47\x29\x0d\xac\x46\x2d\x09\x38\x49\xd4\x76\x42\x96\x08\x99\xaf\x15\x60\x8d\x27\xfd\x9c\xb3\xe8\x9a\x9e\x7d\x20\x37\x49\xdc\x98\x92\x5b\xe3\xe7\xf2\xf6\x30\x46\x89\x9d\x3d\x0b\x09\xef\xce\x32\xff\x24\xff\x0b\xeb\x73\xb5\x05\xb4\x12\xfc\x83\xe5\x59\xb8\xd1\xe8\x27\x9a\x3d\x84\xea\x17\xed\x23\x43\x3b\xb9\x96\x62\x45\xaf\x81\xe8\x28\x9f\x9b\x84\xcf\x2e\xb6\x34\x70\x63\xc1\x84\xee\x86\x1e\x09\x9b\x98\xf8\x5c\x59\x83\xfa\x22\xc9\x28\xff\xa5\x04\x23\xae\xef\xab\xad\x25\xf9\x7d\xb4\xfd\xf1\xb4\xc6\x9c\x1d\x4e\x85\xda\x1c\xf4\xa6\xde\xd5\x85\xa0\xca\x1c\xce\x19\xcb\xb7\x87\x52\x3c\x49\x0f\x3b\x99\xe6\x2a\x4f\x17\xe9\xfa\x1f\x13\x25\x68\x6e\x27\xe9\xe8\xfc\x9b\x4c\x0d\xb7\xae\x92\x55\xef\x96\x85\xb0\x1d\xe9\x59\x3e\xec\x19\xfa\x39\xd6\x8d\x49\xf8\x10\xaa\x05\x57\xd1\xe7\x09\x12\xf0\x94\x4d\x91\x12\x77\xc4\x4e\x9e\xcd\xca\xf5\xb2\xbf\x3b\x02\xfc\x2c\x25\xc7\x51\x0c\x9d\x7b\xb8\xaf\xfa\x37\x3a\x85\xf6\x08\xd1\xea\x64\x15\x10\x66\xef\x11\x64\xf2\x2c\x79\x9b\x63\xcc\xd1\x45\x5f\x15\x8a\x6d\x4e\xa2\xe0\x6f\xbe\xaf\xac\xef\xe2\x11\xbe\x7e\xed\xe1\xdc\xdd\x2b\xb6\x49\x9d\xe7\xca\x5b\xd4\x68\xc0\xd3\x48\xa8\x76\x80\x25\x89\x57\x8a\x13\x03\xa7\x6e\xe0\x4e\x88\x73\x4c\xe7\x29\xf7\xaf\x68\xca\x63\xfd\xbd\x6a\x91\xd4\x5c\x88\x30\xec\x28\xe7\x64\xcf\x25\x39\x88\x53\x5c\xc0\x15\x23\x43\x19\xe4\x76\xbb\x41\xe9\xdb\xbd\xc8\x67\x34\x2e\x47\xa4\xf3\x7a\xa0\xbd\xcf\xb3\xdb\x9a\x9d\xfb\x82\xaa\xb6\x10\x2c\x33\xf5\x63\x05\xbb\xec\x12\x97\x92\xfd\x73\x17\x5d\x46\x74\xab\xbf\x5d\x1d\xd1\xd9\xe1\x75\x34\x19\xc0\xef\x26\x3c\xa1\xae\x78\x04\x16\x08\x3f\xb7\x18\xd8\x19\xef\x73\xae\xa0\x29\x0f\x63\x8f\xca\xc8\xfa\xae\x32\x5b\x6e\xb2\xfc\x76\x2e\x5f\x01\x49\x8d\x13\xc7\xbe\x98\x1d\x63\x32\xec\x62\x18\x72\x26\x7b\xaf\x22\x92\xae\x82\x01\x4b\x24\x32\xc9\xf9\x0e\xca\x98\x12\x52\x13\x43\x25\x69\x69\x82\x9c\x04\x72\xc4\xe2\x9b\x82\x69\xe8\x10\x59\x12\x52\x0c\xeb\x6e\x2a\x06\xcb\x18\xe4\x02\xbd\x93\x14\xd4\x3e\xe8\xbc\x97\xcc\x7d\x76\xe4\x44\xe0\x5a\x56\x0d\x3e\x98\x00\x31\x94\x58\xc0\xbe\xe5\x79\x17\x51\xe2\x43\x37\x76\x18\x1a\xfa\x4a\x4c\x30\x64\xc9\x74\xf9\x2d\xfa\x20\x6a\xd3\x41\x42\xeb\xb1\x64\x7d\x5f\x9b\xa6\x91\xc9\x97\x77\x17\xc3\x83\x41\x9b\x62\xad\x17\x9a\xe8\x02\x43\xd5\xa6\x61\x3e\x9e\xa5\x98\xd3\x23\x96\xf7\x08\x8f\x9b\xdf\xb6\x79\xdb\xd7\xe4\x6f\xeb\x3d\x26\x89\x1f\x3d\xd6\x12\xf2\xb4\xf1\x0d\x75\x33\x2a\xa2\x9e\xb5\xa6\xf2\x92\xad\x3f\x23\xf4\x87\xc2\xc4\x92\x66\xc1\x32\xdf\x14\xc3\xc6\x82\x9c\xde\x9c\x1a\x63\xd9\x68\x9d\x17\xf0\x2c\x25\x93\xd5\x97\x8c\x94\x54\x74\x16\xee\xec\x46\xbe\xe9\xc5\xd5\x6a\x9f\xd0\x1c\xd7\x2b\xc9\x4d\x59\xdc\xff\x0e\x00\x00\xff\xff\x85\x2e\x56\xe5\xf3\x0c\x00\x00"),
+		},
+		"/nosync/map_test.go": &vfsgen۰CompressedFileInfo{
+			name:             "map_test.go",
+			modTime:          time.Date(2026, 10, 15, 16, 28, 27, 936372659, time.UTC),
+			uncompressedSize: 1197,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x55\x4d\x8f\xdb\x46\x0c\x3d\x5b\xbf\x82\x3d\x55\x2e\x14\xe7\x9e\x62\x0f\x05\x7a\x29\xd0\x34\x40\xdb\x5b\x90\x03\x2d\x71\xac\x81\xe7\x43\x1d\x52\xeb\x2a\x8b\xfd\xef\x05\x39\xb2\x57\xde\x24\x45\x0f\xbd\xd9\x23\x0e\xf9\xf8\xde\x23\x67\xc2\xfe\x8c\x27\x82\x94\x79\x49\x7d\xd3\xbc\x7d\x0b\xef\x71\x02\xcf\x80\xd0\xe7\xd4\xcf\xa5\x50\x12\x88\x38\xc1\xc5\xcb\x08\x18\x73\x11\xff\x99\x86\x37\x7d\x4e\x2c\x98\xe4\x8d\xf8\x48\x10\x32\x0e\xdc\x01\x4b\x2e\xc4\x1d\x60\x1a\x60\xa0\x40\x42\x7c\xd0\x9c\xbf\x88\xa6\x64\x74\x04\x2e\x17\x88\x73\x10\x3f\x05\x82\x53\x2e\x79\x16\x9f\x88\x41\x32\xf4\x18\x02\xa0\x02\xf8\x9e\x21\x92\x8c\x79\xe0\x0d\x8a\xb0\x68\x2e\x4d\xf7\xe7\x48\xf0\x99\x4a\xbe\x62\x7d\xc4\xe0\x07\x2b\x4a\x71\x92\x5b\xd8\x4f\xf6\x3d\xce\x2c\x90\xb2\xc0\x91\xa0\xcf\x93\xa7\x01\xd0\x09\x15\x70\xbe\xb0\xc0\xcc\x74\x68\x64\x99\xc8\x82\x59\xca\xdc\x0b\x3c\x35\xbb\xa8\x4d\x7f\xf4\x49\xa8\x38\xec\xe9\xe9\xf9\xd3\xe6\x77\xf3\x6c\x54\xfd\x9a\x71\x80\x42\x32\x97\xc4\x20\x23\x29\x90\x99\x2a\x0b\x03\xf8\x64\x67\xca\x9d\x36\x8d\x70\xa6\xa5\x83\x5c\x20\xf9\x00\xde\x41\xca\x9a\xa3\x5e\xf1\x0c\x53\x21\xa6\x24\x87\x6b\x83\xf9\x0c\x85\x78\x0e\x02\x3e\x0d\xbe\x47\x21\x86\xcb\x48\x32\x52\x59\x2f\x5d\x90\xc1\xe5\x39\x6d\x4b\x1d\x1a\x37\xa7\x1e\xda\x08\x3f\xbc\xc7\x69\x6f\x10\xdb\x33\x2d\xb0\x41\xbf\x87\x76\xad\xfa\x72\xd6\x69\xbd\x63\xce\x61\xaf\xcd\xdb\x67\x3b\x7a\x80\x78\x88\x1f\xcf\xb4\x7c\x6a\x76\xb5\x53\xb8\x7d\x5c\x59\xf8\x43\xdb\x05\x26\xd9\x72\x70\xeb\xf8\x35\x20\x8b\x6e\x8d\x8a\x2f\x40\x58\x6d\xef\xb4\x24\x3c\x3c\x18\x4f\x4f\xcd\x6e\x67\x7f\x21\xe2\x99\xda\x7f\xd1\x64\xdf\xec\x9e\x9b\xdd\x15\x2d\x3c\xd4\xf4\x1b\xa5\x3e\x94\x8a\x74\x2b\x18\xfd\xed\x59\x7c\x3a\x6d\x50\xeb\xb1\x11\xe6\xee\x24\xf9\xa0\xc4\x5f\x3c\x53\x07\x5e\x56\xa3\x9b\xe5\xb6\xe9\x4e\xfe\x91\x56\x82\x6e\x3a\xea\x68\xd0\x70\xd3\x92\x41\x8a\x76\xed\x36\x64\xa9\x90\x35\xac\x03\x87\x81\xed\x73\x75\xd1\xd7\xf4\x5c\x1b\xf9\x26\x89\x2d\xf6\x32\x63\xb8\x97\x77\x85\x71\x93\xd8\xbb\x17\x21\xe1\xdd\x8b\xcc\x3f\xea\x7f\x65\xfd\x5e\x6d\x05\x6d\x04\xff\xcf\xf2\xbc\x2a\x63\xdd\xaf\x9a\xfd\x6c\x0b\xe4\xba\x47\xfe\x8b\xb7\xea\x8d\x2f\xed\xfe\x55\x57\xd5\xc2\x86\xaa\x96\x68\xe3\x21\x76\x9a\x76\xbf\x02\xf8\x1d\xd3\x89\x6c\x2b\x31\x38\x60\xfa\x6b\xa6\x24\x1e\x43\x58\x0c\x02\x61\x3f\x9a\x53\xd4\x05\x15\xd9\x6a\x98\xbb\x79\xd4\xf5\xe7\xc0\xdd\x7c\x62\x2d\x76\x50\x2c\x39\x4b\x9e\x6a\x6b\x5e\xa8\xa0\xf8\x9c\xae\xdb\xab\x56\x1f\x32\xb1\x6d\xaf\x44\x3d\x31\x63\xf1\x61\x81\x3e\x97\x42\x3c\xe5\x34\xe8\xda\xc4\xa4\x27\x89\x3d\x8b\xd6\xe6\x84\x13\x8f\x59\x20\x57\x8b\xd9\x3a\xd5\x84\x7d\x4e\x1a\xc0\xef\x20\x65\xc3\x7d\xf1\x21\xe8\x56\x7c\xf4\xec\x85\x06\x88\x3a\x1d\x32\x62\x82\x9c\x7a\xea\xe0\x38\xcb\xbd\x4f\x8d\xf8\xb4\xe8\x65\x4d\xa8\x2b\xbd\xae\xba\x5c\x56\x99\x86\xbb\x7d\xdd\xad\x4d\x44\x5c\xa0\x90\x0b\xd4\x8b\xdd\x8f\x38\x4d\x3a\x74\x75\xdc\x50\xae\x09\x5d\xc9\xd1\x02\xa6\xec\x93\xc0\x30\x17\x8d\xd2\xfa\x2f\x52\xdc\xd3\xa3\x99\x8f\x04\x1f\xda\xdf\xf6\xf5\x81\xd2\xe0\x34\xc7\x23\x15\xed\x9f\x02\x45\x6d\x79\xbb\x8b\x49\x47\xd4\x6f\x14\xb1\xca\x36\x75\xf5\x5d\xb0\x97\xcf\xde\xb6\x4d\x26\x73\xc1\x6b\xbf\x19\x86\xd6\x81\x9e\x7e\x73\x1a\x6f\x13\xa7\xdd\x9e\x3b\x78\xd4\x69\xab\xea\xab\x23\xd5\x8a\xde\xc1\x77\xae\xd5\x6f\x16\xb8\xdb\x1d\x0b\xe1\xb9\xd9\xa9\x37\xf5\xad\xf9\x27\x00\x00\xff\xff\xe8\x19\x65\x16\xa6\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x53\xbb\x6e\xdb\x3c\x18\x9d\xa5\xa7\x38\x21\x10\xfc\xe2\x6f\xc2\xa8\x9c\xad\x6e\x86\xa2\x97\xa5\x0d\x3a\x24\x7b\xc1\x88\x9f\x5c\xc2\x12\x49\x90\xb4\x8d\x20\xf1\xbb\x17\xa4\x64\x5b\x71\x1d\x64\xb0\x64\x49\xe7\xf6\xf1\x90\x4e\x36\x6b\xb9\x22\x18\x1b\x9e\x4c\x53\x96\xba\x77\xd6\x47\xb0\x48\x21\x6a\xb3\x62\x65\xd9\x6e\x4c\x83\x07\x0a\xf1\x4e\xba\x2f\xb6\x77\xd2\xd3\x67\xa3\xee\x77\xd2\x55\x11\xff\x8f\xb8\xf9\x03\xc7\x73\x59\x6c\xa5\x47\x8f\x3b\xe9\xca\x42\xb7\xe8\xe7\x67\x78\x26\x99\x80\xd1\x9d\x40\x9d\xe1\x45\x9c\x7f\xf3\xde\xfa\xb6\x62\x67\x48\x0e\xdb\x42\xa2\xd7\x21\x68\xb3\xc2\x9a\x9e\x10\x76\xd2\x39\x52\x8c\x97\xc5\x3e\xcb\x3b\x4f\x5b\x6d\x37\x41\xa0\xb3\x52\x91\xc2\xc7\x5b\xf4\xf3\x93\x51\xcd\x97\x87\x2f\xaf\xbd\xde\x72\xb8\xc5\xf5\x56\x20\xfa\x0d\x09\xec\xa4\x89\x43\xd4\x56\x76\x81\x98\x38\xda\x1d\xfd\xaf\x2e\xcf\x57\x0b\x2c\xde\x99\x6e\x80\x28\xad\xcc\x7f\x31\xcf\x85\xfa\x34\xd6\x54\xf5\x2b\x75\x14\xe9\x30\xce\x9b\xa2\x23\xac\xe6\x50\xf9\x9f\xc2\x82\x5d\x8e\x39\x15\x5c\xbc\x2b\x78\x0a\x39\xe8\x1e\x65\xfb\xf9\x7d\xb4\x9e\x2a\xf6\xc8\x04\x6e\x78\xf6\xd9\xca\x2e\x2d\xdc\xb4\x8b\x9f\x56\xaa\x89\xe9\x23\xe3\xcb\x01\x86\xab\x5b\xdc\xe0\xe5\x05\x57\x17\x0b\x7a\xcd\xe3\x63\x31\xe9\x97\x6b\xb9\x19\x4a\x62\xe2\xb5\xe7\x71\xe2\xdf\x02\x76\x7d\x4a\x30\x1a\xdb\xf5\x99\xcb\x0f\x7a\x82\x0e\xa9\xd7\x40\x26\x42\xb6\x91\x3c\xce\xac\x87\x79\xf7\x93\x53\xf0\xcb\x34\xf4\x7d\x63\x9a\x7f\x37\x7f\x23\xbb\x2e\x24\xdb\x0f\x65\xd1\xa6\xfb\x11\x9a\xc8\x15\xc7\x33\x32\x64\x36\xc3\x9e\x97\x45\x5b\x1d\x2e\xba\x1d\x3e\xa4\x55\xa9\xcf\x42\x26\x7e\xd4\xd6\x64\x04\x29\x5c\x2b\x44\xdd\x53\x18\x97\xa2\x66\x62\xe0\xe6\x9c\x65\xb1\xba\xec\xeb\xa4\xd1\x4d\xc5\x1e\xad\xed\x19\x1f\xdc\xad\x87\xce\x61\x97\xd0\xf8\x84\xc5\x12\x7a\x36\xcb\xe6\x07\x56\x59\x14\x85\xa2\x96\x3c\xa6\x6f\xf2\xc9\x4b\x44\x4f\x8d\xdd\x92\xaf\xf8\x12\x2e\x25\x1f\xd4\x47\xd0\x74\x57\xc9\xae\x4b\xb1\x73\x86\x35\x29\xec\x74\xfc\x73\x2a\x33\xb3\x04\xb4\x80\xe3\x99\xba\x4f\xd7\x7d\x95\x1f\x56\xf9\x96\x1f\x52\x0b\x7f\x03\x00\x00\xff\xff\x94\x26\x75\xfd\xad\x04\x00\x00"),
 		},
 		"/nosync/mutex.go": &vfsgen۰CompressedFileInfo{
 			name:             "mutex.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 12, 605781500, time.UTC),
-			uncompressedSize: 2073,
+			modTime:          time.Date(2026, 10, 15, 16, 28, 27, 932829971, time.UTC),
+			uncompressedSize: 3117,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x54\xcb\x6e\xdb\x30\x10\x3c\x4b\x5f\xb1\xc9\xc9\x4e\x62\xa5\xbd\xb6\xf5\xa1\x68\x81\x22\x40\x7a\x09\x50\xe4\x4c\x53\x2b\x99\xb0\x44\x1a\x24\x55\xd5\x4d\xf2\xef\xc5\xf2\x21\xcb\x92\xec\xc4\x2d\xaa\x93\xb0\xe4\xce\xce\xec\x0c\xb8\x65\x7c\xc3\x4a\x04\xa9\xcc\x4e\xf2\x34\xbd\xbd\x85\xef\x8d\xc5\x5f\x20\x0c\x30\xc8\x9b\xba\xde\x41\xbb\x16\x7c\x4d\x05\xa9\xe4\x62\x55\x29\xbe\x11\xb2\xcc\x52\xbb\xdb\x62\xb8\x6c\xac\x6e\xb8\x85\xa7\x34\xa1\x53\xcc\x61\xa5\x54\x95\xbe\x38\xb8\x7b\xc5\x37\x40\x65\x03\x75\x06\x77\xd6\x23\xeb\x46\x2e\xac\xa8\x11\x50\x6b\xa5\x41\x14\x50\xbb\x83\x4a\x23\xcb\x77\xe0\x61\xb2\xb4\x68\x24\x87\x59\x0d\x57\x6e\xce\xdc\x81\xcd\xe6\x34\x88\x3a\xb2\x30\xed\x29\x4d\x92\x2d\x93\x82\xcf\x2e\xbd\x8e\x0f\x50\x77\x22\x0e\x10\x2f\xe7\x69\xf2\x92\x26\x5d\xe7\x12\xac\x6e\x30\x30\xfd\x21\xa9\x0a\x8d\x7c\x2b\x5b\xa9\xec\x51\xa6\x1e\xac\xe3\x7a\x71\x8a\xac\x9f\x08\xaa\x08\x7f\x98\x7b\xfe\x63\xb6\x05\xab\x4c\xa4\xfb\xf0\x78\x96\x53\xf1\xfa\xde\xab\x56\x0b\x8b\xf7\x1e\x9a\x3e\x67\x5a\x42\xeb\xa2\xe2\x17\xd5\x48\x8b\x1a\x84\xb4\x13\x4e\x42\xa1\x34\x10\x00\x0d\x38\xb1\x27\xdd\x8e\x4d\x70\xbd\x54\x10\xb2\x84\x1e\x4c\xd8\xa1\x6e\xe1\x2a\x90\x1d\x18\xae\xdb\x6c\xc8\xee\x62\x09\xef\xe0\xf9\x99\x8e\xfa\x72\xce\x4e\xc4\xa0\xff\x54\x2e\x74\x7b\x9e\xf8\x7d\x4a\x0e\xfa\xa6\xd4\x0e\x43\xf3\xba\xaa\x57\xa2\x33\x92\x75\x10\xa0\x91\xa1\xc1\x94\xff\x69\xe8\xc3\xd0\xd1\x7f\xb5\x6d\x90\x88\xeb\xeb\xa8\xae\xb3\x2d\x57\x48\x5a\x8c\x90\x65\x85\x41\x35\x67\x55\xf5\x11\x84\x05\x77\x48\x16\xb1\xa2\x40\x6e\x41\xd9\x35\x6a\x30\xa2\x6e\x2a\xcb\x24\xaa\xc6\x38\x65\xa8\xcd\xd9\x4e\xc7\x6d\x4e\xae\x61\x60\xf5\x44\xb4\x97\x14\xed\xbf\xb2\x7c\x80\xb4\x58\x84\x95\x3c\x32\x61\xbf\x69\xd5\x6c\xdf\xfa\x66\xec\x1b\xf6\xaf\x06\x1f\xbd\x0b\x9f\xf3\x1c\x58\x9e\x1b\xc8\xb1\xb2\xec\x26\x20\xd6\x6c\x07\x2b\x04\x89\x25\xb3\xe2\x27\xde\x80\x55\x60\xd7\x7d\xcc\xbb\xc2\x15\x22\x60\xe9\x9c\xe8\xae\x13\xaa\x53\x6e\xe2\x02\xdb\x12\xae\xba\xee\x39\x5d\x98\xb9\x89\x44\xc5\xed\xb1\x2d\xb3\x08\x76\xbd\xf4\x6c\xdc\x72\x7b\xf5\x4f\x87\x3b\xf5\x1b\x8d\x43\x7b\xdc\xc2\x7d\xbf\x53\x2f\xf3\xab\x92\x08\x39\x72\x8d\x35\x4a\x6b\x06\x62\x42\xc3\x11\xae\xd4\x3b\x8b\x1c\x89\xf8\xe2\xfd\xbc\x67\x4a\x10\x4a\x49\x9a\x44\x8d\xe1\xfa\x8d\x5a\x1d\x99\x40\xbf\x5d\x9a\x7a\x82\x2f\x96\x53\x8a\xc7\x13\x22\x7c\x54\xfc\x27\x00\x00\xff\xff\xec\x95\x29\x83\x19\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x96\x4b\x6f\xe3\x36\x10\xc7\xcf\xd6\xa7\x98\xec\x49\x72\x62\x6f\x7b\x6d\xeb\xc3\xa2\x05\x8a\x00\xe9\xc5\x68\x91\x33\x2d\x8d\x64\x36\x12\x69\x8c\xa8\xaa\xae\x93\xef\x5e\x0c\x1f\xb2\x2c\xc9\xaf\x06\xdd\xd3\x9a\x9a\xc7\x7f\x66\x7e\x13\x72\x27\xd2\x37\x51\x20\x28\x5d\xef\x55\x1a\x45\x5f\xbf\xc2\x37\x78\xd1\xe9\x1b\x12\x10\xee\x08\x6b\x54\xa6\x06\xa1\x40\x6f\xfe\xc4\xd4\x80\xd9\x0a\x03\xa9\x50\xb0\x41\x28\xd9\x2e\x03\xa1\x32\x68\x94\xfb\xb1\x8c\xcc\x7e\x87\x21\x82\x54\x06\x29\x17\x29\xc2\x21\x9a\xf1\x59\x9c\x44\xb3\x3f\xac\x69\x9c\x44\x1f\x36\xdd\x6f\x8d\xc1\xbf\x41\xd6\x20\x20\x6b\xaa\x6a\x0f\xed\x56\xa6\x5b\x3e\x50\x5a\x2d\x36\x6c\x2b\x55\xe1\xe3\x3a\xe3\xda\x50\x93\x1a\x8e\xe9\x15\x6c\xb4\x2e\x7d\x38\xce\x62\x85\xd5\x50\x2d\xe1\xd9\xb8\xc8\xd4\xa8\x85\x91\x15\x02\x12\x69\x02\x99\x43\x65\x3f\x94\x84\x22\xdb\x43\xd0\x9e\x37\x2a\x85\xb8\x82\xb9\xcd\x93\x80\x93\xcc\x89\xd8\x63\xe9\xb3\x1d\xa2\xd9\x6c\x27\x94\x4c\xe3\x2f\xae\x6d\x3f\x40\xd5\x15\x71\x12\xf1\x4b\x12\xcd\x3e\xa2\x59\xe7\xb9\x02\x43\x0d\x7a\xa5\xbf\xd3\xbe\x2f\x96\x45\x49\xe3\xea\x36\xde\xff\xc9\xf6\x96\x70\xa7\xc9\xd4\xd0\x6e\xd1\x6c\xb9\xab\x06\x32\x39\xa1\xd6\x07\x8c\x13\xdb\x8f\x29\xd5\x84\xa6\x21\x05\xb9\x28\x6b\x9c\x54\x16\x2c\x7a\x32\xdd\xb8\xfc\x80\x6f\x69\xea\x51\xfe\x58\x62\x98\xbd\x17\xf7\x70\xa9\xa7\x2e\x23\xe8\xbc\x83\xcb\xb5\x79\xdc\x54\x57\x8f\x93\xbb\x7e\xbd\x0b\xa8\x60\x7e\x44\xaa\x25\x69\xf0\xc5\x85\xe6\x7f\x96\xad\x19\x4f\x95\x0f\x7f\xd6\x0d\x33\xcd\x64\x4f\x00\x07\xb9\x26\xe0\x00\x9c\xe0\x42\x9f\xa8\x1d\xb3\x62\x7d\xf9\x40\xaa\x02\x7a\x61\x7c\x0f\xa9\x85\xb9\x17\x3b\xe0\x92\xda\xe5\x50\xdd\xc3\x0a\xbe\x83\xf7\x77\xfe\xd4\x2f\xe7\x6e\x70\x07\xfe\x17\xf0\xa5\xb6\x5f\xfb\x14\xcb\x67\xea\xbb\x05\xf1\x93\xda\x27\x29\xbf\xaf\x07\xa3\x35\x98\xae\xf3\xfa\x32\x9c\x56\x7d\x7d\xe2\x83\x6e\x5c\x1a\xf1\x70\x53\xae\x8f\xf2\xca\xbe\x8c\x6a\x3c\xd9\x9a\x11\xc5\x7e\x52\xff\x27\xc5\xeb\x21\xc6\x9f\x65\x75\x80\xc0\xe3\xe3\x11\xd5\xf5\x98\xd5\xa0\xf2\x1c\xab\x9f\x00\x74\x3d\x49\xe8\x2d\x18\x8e\x4b\x98\xa0\x70\xdd\x61\x98\x69\xe4\xd9\xd4\x52\x15\x25\xfa\x29\xa6\xa2\x2c\x7f\xb4\x02\xf9\x23\x17\x25\xf2\x9c\xaf\x6e\x6d\x95\xd7\xb2\x6a\x4a\x23\x14\xea\xa6\xb6\x3d\x40\xaa\xef\x26\x37\xd0\x31\x39\xd6\x01\xba\x13\xbb\xb9\xe2\xdd\xfc\x4f\x08\x0f\x22\x2d\x16\x7d\x80\xed\x9b\x85\x9b\xc5\x95\x8c\x9e\x20\xf6\xe5\x22\xab\x5d\x89\x95\x7d\xd3\x98\xad\x7b\xa7\xd8\xf9\xfa\x8e\x56\x68\xb6\x3a\xab\x61\xb3\xb7\x6d\x64\x3c\xa8\x5d\xae\x3b\x33\xfe\xe1\x4c\xcf\x13\x8d\x14\x27\x21\xfb\xa1\x1b\x5f\x3c\x27\x5b\x11\x25\x31\xb5\xf6\xed\x63\xef\x1f\x7f\x18\xee\xa1\x28\x44\x85\xce\x3c\xfc\xb1\x07\x38\x40\xdc\xa5\x8a\x29\x59\x06\xca\x3e\x26\x9c\x8e\x33\x18\x3a\x75\x5f\x5c\xe3\x5e\x85\x34\xbf\x92\x6e\x76\xb7\xde\x98\x47\x87\xe3\x9d\x99\x8e\x6e\xc5\x6f\x59\x06\x22\xcb\x6a\xc8\xb0\x34\xe2\xc9\x47\xac\xc4\x9e\xdf\x8d\x0a\x0b\x61\xe4\x5f\xf8\x04\x46\xdb\x31\x1c\x63\x3e\xe7\xf6\x20\x04\x2c\x2c\xc2\x9d\x39\x47\xb5\xc8\xd4\xa1\xfd\x6d\x01\xf3\xce\x3b\x61\x83\xd8\x66\x64\x29\x16\xc0\xb6\x58\x86\x60\x8f\x2b\xa7\xc6\x52\xd9\x3b\xff\xe9\x14\x46\x87\x62\x48\xda\xd3\xe6\xed\x1d\x8c\xae\xcc\x5f\xb4\x42\xc8\x30\xa5\x1e\x53\x23\x87\x33\x5a\xd9\x37\x0e\x1a\x59\xf8\xe2\xfb\xf0\x26\x66\x2b\x5f\x28\xaf\xe0\x64\xd4\xb0\x95\xff\x20\xe9\x33\x19\xf8\xbf\xdd\x1a\xf6\x0a\x7e\x58\x4d\x55\x3c\xce\x10\xc2\x87\x8a\xff\x0d\x00\x00\xff\xff\x19\x4e\xed\x05\x2d\x0c\x00\x00"),
 		},
 		"/nosync/once.go": &vfsgen۰CompressedFileInfo{
 			name:             "once.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 12, 620783200, time.UTC),
-			uncompressedSize: 1072,
+			modTime:          time.Date(2026, 10, 15, 16, 28, 16, 694188811, time.UTC),
+			uncompressedSize: 1546,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x54\x53\xcb\x92\xda\x40\x0c\x3c\xdb\x5f\xd1\xb5\x27\x9c\xa2\xe0\xbe\xa9\x1c\x52\xc5\x65\x4f\x39\xe4\x0b\xc4\x58\x03\xca\x0e\x1a\x32\x0f\x58\x67\x8b\x7f\x4f\x69\x6c\x08\xb9\xd9\x92\xba\xd5\x6a\x69\xce\xe4\xde\xe9\xc0\xd0\x98\x27\x75\x7d\xbf\xdd\xe2\x87\x3a\x86\x64\x90\x22\xee\x7f\xb1\x2b\x28\x47\x2a\xb8\x4a\x08\x38\x73\xf2\x31\x9d\xc0\x1f\xe4\x4a\x98\x10\x95\x41\xae\x48\xd4\x4d\x5f\xa6\x33\xcf\xe0\x5c\x52\x75\x05\x9f\x7d\x37\x46\xd1\x03\xf6\x31\x06\xfb\x56\xc6\xfc\x7d\x6b\x8d\x76\x11\x8e\x42\xc8\x28\x47\x86\xaf\xda\x78\xe0\x21\x1e\xa4\x23\xa2\x86\xc9\xbe\x77\xd1\xd4\xec\xd9\x98\xac\x9e\x47\xf8\x98\x0c\x64\x24\x5e\x52\x2e\x28\x72\xe2\x25\x2a\x19\xa2\xb9\x90\x09\x89\xbe\x09\xda\xe0\x4d\x11\xcb\x91\x13\xae\x31\x8d\x79\x8d\x83\x5c\x58\x0d\xde\x5d\x28\x21\x5a\xad\x15\x5a\x44\x7c\xfb\xdf\xec\xe2\xca\x0f\xd6\x79\xe9\x79\xaa\xa1\xc8\x39\x70\xeb\x95\xd7\xb3\xbc\xa6\xbc\x29\xb0\xaa\xd9\x23\xd1\x4b\x7c\x67\xf8\xb5\xb1\xf1\x85\xd5\x28\x3d\x8e\x94\x41\x18\xc5\x7b\x4e\xac\x05\x17\x0a\x95\x21\x0a\x26\x77\x6c\x20\x47\xcd\x48\xe0\x3b\x94\xaf\xcf\x53\x3c\xaf\x25\xf1\xef\x2a\x69\x31\xa1\x61\x1f\xd6\x95\x08\xfe\x60\x57\x0b\x6f\xfa\xed\x76\xb1\xb8\xf9\x51\x58\xc7\x05\x22\x2a\x45\x28\xc8\x1f\x9a\x31\xb6\xdb\x53\xcd\x05\x7b\x46\xaa\xfa\xb4\x5a\x33\x0e\x3f\xc5\xfa\x36\x05\x92\xa1\x12\x68\x14\xb7\x86\x14\x9c\x68\x32\x8c\xb2\xe3\x9c\x29\x4d\xd6\xbe\x66\x06\xfd\x13\x14\xa4\x70\xa2\x60\x19\x47\xe7\x52\x13\xdf\xd7\x46\xe9\x50\x4f\xac\x25\x5b\x8e\xfe\x1b\x61\xcf\x8b\x85\x23\xf6\x13\x76\xf1\xb5\xed\xc9\x45\xf5\x72\xd8\x3c\x56\x53\xd5\xad\x06\x7c\x62\x89\xdb\x54\x2b\x2f\x81\x95\x4e\x3c\xe0\x36\x2c\x06\xbc\x99\xf5\x8e\x6a\xe6\x6c\x66\xcc\xf4\xf3\x46\xdb\x10\xf3\x55\x93\x8a\xdb\x3c\x23\x5a\x24\xaf\xdb\x89\x46\xcd\x32\x72\xca\x56\x5e\x22\x8e\x74\x61\x24\x2e\x35\x29\x8f\x5f\xe1\x6b\x1b\x6b\x3e\xe4\xd8\xae\x75\x4e\x1a\xd7\x55\xca\x31\xd6\xf9\x38\xec\x7c\x7d\x6b\x62\xda\xb1\x8a\xf8\x62\x2b\x1d\x60\xd3\x60\x9e\x67\xb0\x37\x63\x07\xb8\x69\x8f\xe5\xb3\xef\xba\x85\xac\xbb\x3d\x12\x46\x64\x99\xa6\x71\xf5\x32\xbf\xdc\xd7\xfb\x6b\xe2\xb1\x75\x15\x85\x7f\x19\x1a\xec\x8e\xf9\x86\x92\x2a\xf7\xdd\xc8\x9e\x13\xee\x06\xf6\xdd\x53\x81\xa7\x90\x79\x89\x28\x3f\x10\xb7\xd5\xd0\x77\x7e\x35\xf4\xb7\xfe\x6f\x00\x00\x00\xff\xff\xf9\x72\xbe\xa9\x30\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x54\xcb\x8e\x23\x37\x0c\x3c\xab\xbf\xa2\xb2\x27\x77\x60\x78\xee\x13\xcc\x21\xc0\x20\xc0\x9e\x72\x48\x7e\x40\x56\x53\x36\xb3\x32\xe5\xe8\xe1\x59\x67\xe0\x7f\x0f\x28\xc9\x9e\x9e\x64\x7d\x6a\x4b\x2c\xb2\x58\x45\xea\x6c\xdd\x37\x7b\x20\x48\xcc\x57\x71\xd3\xf4\xf4\x84\xdf\xc5\x11\x38\xc3\x0a\xe2\xfe\x2f\x72\x05\xe5\x68\x0b\xde\x38\x04\x9c\x29\xf9\x98\x4e\xa0\xef\xd6\x95\x70\x45\x14\x82\x75\x85\xa3\xec\xa6\x72\x3d\x53\x07\xe7\x92\xaa\x2b\x78\x9f\xcc\x12\x59\x0e\xd8\xc7\x18\xf4\x5b\x08\xfd\xfb\xd6\x0a\xbd\x46\x38\x1b\x42\x46\x39\x12\x7c\x95\x96\x07\x1e\xec\x61\x65\x41\x94\x70\xd5\xef\xd7\xa8\x6c\xf6\xa4\x99\x34\x9e\x16\xf8\x98\x14\xa4\x49\x3c\xa7\x5c\x50\xf8\x44\xe3\x94\x33\x58\x72\xb1\x4a\x24\xfa\x46\x68\x87\xaf\x82\x58\x8e\x94\xf0\x16\xd3\x92\xb7\x38\xf0\x85\x44\xe1\xe6\x62\x13\xa2\xc6\x6a\xa0\x9e\xb0\x6f\xff\x77\xaf\x71\xe3\x67\xad\x3c\x6a\x9e\x6a\x28\x7c\x0e\xd4\x6a\xe5\x6d\xa7\xd7\x98\x37\x06\x1a\xd5\x35\x62\xb9\xc4\x6f\x04\xbf\xd5\x6c\x74\x21\xd1\x94\x1e\x47\x9b\x61\xb1\xb0\xf7\x94\x48\x0a\x2e\x36\x54\x02\x0b\xc8\xba\x63\x03\x39\xdb\x84\x04\x7e\x85\xd0\xdb\xba\x8b\xb5\x2d\x89\xfe\xae\x9c\x86\x08\x0d\xfb\x90\xae\x44\xd0\x77\x72\xb5\xd0\x6e\x7a\x7a\x1a\x12\x37\x3d\x0a\xc9\x32\x20\x2c\x5c\xd8\x06\xfe\xc7\x76\x8c\x7a\x7b\xaa\xb9\x60\x4f\x48\x55\x56\xd6\xaa\x70\xf8\x83\xb5\x6e\x63\xc0\x19\xc2\xc1\x2e\xec\xb6\xe0\x82\x93\xbd\x2a\x46\xc8\x51\xce\x36\x5d\xb5\x7c\xcd\x04\xfb\x41\x28\x70\xa1\x64\x83\xde\x38\x7b\x2e\x35\xd1\xdd\x36\x9b\x0e\xf5\x44\x52\xb2\xde\xd9\x4f\x2d\xec\x69\x48\xb8\x60\x7f\xc5\x6b\x7c\x6e\x3e\xb9\x28\x9e\x0f\xbb\x87\x35\x55\xdc\x66\xc6\x3b\xc6\xb9\x76\xb5\xf1\x1c\x48\xec\x89\x66\xdc\xe6\x21\xc0\x57\x95\xde\xd9\x9a\x29\xab\x18\x3d\x7d\x77\xb4\x35\xd1\xa7\xda\x0a\xbb\xdd\x1a\xd1\x4e\xf2\xb6\x8d\x68\x94\xcc\x0b\xa5\xac\xe1\x25\xe2\x68\x2f\x84\x44\xa5\x26\xa1\xe5\x17\xf8\xda\xda\xea\x83\x1c\xdb\xb4\xf6\x4b\xcd\xf5\xc6\xe5\x18\x6b\x1f\x0e\x1d\x5f\xdf\x8a\x28\x77\x6c\x22\x7e\x56\x4b\x67\x68\x37\xe8\xfd\xcc\xba\x33\x3a\x80\xbb\xb6\x2c\xef\x93\x31\x23\x99\xb9\x3d\x2e\x34\x91\xde\x34\x8e\x9b\x2f\x7d\x73\x9f\xef\xdb\x44\x4b\xab\xca\x02\xff\x65\x6e\xb0\x3b\xe6\x05\x25\x55\x9a\xcc\x42\x9e\x12\xee\x02\x4e\x66\x15\xe0\x6d\xc8\x34\x4e\x84\x1e\x88\xdb\x66\x9e\x8c\xdf\xcc\x63\x6f\x95\xf6\x6f\xda\x43\xe7\x96\x3f\xf9\xa7\xe3\xd4\xed\xcb\xf0\x7d\x49\xfa\x24\xfd\x79\xfc\x90\xed\x11\xaf\xe9\xc6\x1c\x0d\xf2\x2e\x8a\xab\x49\x37\x24\x5c\x7f\x68\x49\xf9\x51\x9e\x95\x8f\xad\xfd\x16\x95\xed\x89\xc6\x9a\x45\xd1\x4d\x4c\xd7\x56\x65\xd7\x1d\xb8\xb7\xb1\x52\xff\x43\x14\x7d\x16\x36\xaa\x84\x4e\x7f\x7f\x1b\x8c\xb9\xd8\xc0\xcb\x78\xcd\xcc\x19\xfa\xd3\xd5\x4a\xde\x3a\x7a\xbf\x4d\x66\x9e\xcc\x01\xcf\x2f\x6b\x6d\xff\x27\xb6\x39\xe3\x05\x89\x5c\xbc\x50\x52\x59\x8d\xda\xfa\x53\xcf\xdc\xee\x87\xaf\xe7\x76\x77\x9b\x4c\x57\xbf\xc9\x6f\x8c\xc7\x8b\xee\xe0\x83\xcb\xc3\xa1\x69\x0c\xca\x27\x5f\xc7\xae\x1c\x14\xf9\x9f\x2a\xab\x22\x37\x85\xdf\xa6\x7f\x03\x00\x00\xff\xff\xca\x39\x50\x65\x0a\x06\x00\x00"),
 		},
 		"/nosync/pool.go": &vfsgen۰CompressedFileInfo{
 			name:             "pool.go",
-			modTime:          time.Date(2021, 3, 28, 16, 13, 12, 636794100, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 2130,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x55\x3f\x93\xdb\xc6\x0f\xad\x4f\x9f\x02\xbf\xea\x77\xca\xe8\x74\x49\xeb\x99\x2b\x32\x29\x1c\x37\x89\x8b\x74\x1e\x17\x10\x09\x8a\x88\x97\x0b\x06\xc0\x4a\xa2\x3d\xf7\xdd\x33\x58\xfe\x39\x39\xee\x44\xee\xf2\xe1\xe1\xbd\x07\x68\xc4\xe6\x0b\x9e\x09\xb2\xd8\x94\x9b\xdd\xee\xf9\x19\x7e\x85\x8f\x22\x09\xd8\x00\xc1\xc8\x41\x3a\x70\x1a\x46\x51\xd4\x09\xe4\xf4\x37\x35\x6e\xe0\x3d\x3a\x0c\x38\xc1\x89\x80\x73\xcb\x17\x6e\x0b\xa6\x34\x81\xe1\x85\x5a\xc0\xdc\x06\x94\x92\x2b\xd3\x85\xda\xe3\xee\xf9\xb9\x62\xe7\x09\xd8\x69\x00\x73\x51\x6a\x81\x33\x78\x4f\x73\xc1\x05\x4d\x69\x90\x0a\x51\x5c\x06\x74\x6e\x2a\x2c\x3a\x60\x9e\xc0\x79\x20\xb8\xb2\xf7\x52\x3c\xf0\xb2\x38\x77\xdc\xa0\xb3\xe4\x23\x7c\xe8\xde\xd0\x7a\x49\xad\xd5\x47\xc9\x69\x02\xa5\x8e\x94\x72\x43\x70\xed\x29\x8a\xb2\x41\x8f\xe3\x48\xd9\x0e\x71\x2b\xc0\x2a\xb1\x81\xcf\xbd\x07\x8f\x96\x30\x25\x69\xd0\xef\xd8\x6f\xca\x18\x76\x04\x9d\x28\x14\x23\x38\x4d\x30\x94\xe4\x3c\x26\x82\xb3\xa8\x14\xe7\x4c\x06\xc6\xf1\x16\x33\x49\xb1\x34\xad\x18\x81\xf0\x7f\x83\xb1\xe8\x28\x46\x81\xe5\x02\x0d\x36\x3d\xc1\x56\x0f\x4e\xc5\xa1\xe4\x62\xa1\x90\xd3\x60\xb5\x54\x42\x27\x05\xa5\x62\x74\x98\xc5\x4d\x4c\x17\xce\x67\x18\x95\xcc\x8a\x46\xab\xb5\xe3\x33\xea\x29\x4c\x6d\x24\x25\x6a\x5c\xf4\x08\x7f\x85\x5f\x6c\x07\xe0\xb0\xed\x0b\x59\xfc\x20\xb4\x09\x5c\x02\xec\x54\x38\xb5\x40\x5d\xc7\x0d\x53\xf6\xd0\x44\x09\xdb\xa7\xb9\x51\x25\x82\xc4\xe6\x76\x84\xdf\xe5\x4a\x17\xd2\x0a\xc4\x16\x06\x80\x15\x76\x3c\xa5\x59\x10\x4c\x29\xf0\xee\x3e\xd9\xac\x07\x1c\x47\x95\x51\x19\x9d\xaa\x70\xd2\x01\x6e\x92\xba\xc0\x80\x39\x68\x23\x9c\x55\xca\xf8\x7d\xf0\xaa\x0e\x81\x63\x9c\x28\x7b\x24\xad\xc7\x88\x10\x0e\x92\xcf\x11\x38\x18\xc5\x29\x3b\xd7\xbc\x54\x99\xda\xb0\xa6\x91\xdc\x14\x55\xca\x1e\x41\xa5\x91\x72\x4b\xb9\x86\xa7\x49\xd1\xaa\xcd\x34\x96\x41\x38\xce\x7c\x46\x95\x0b\xb7\x14\x23\x70\xc5\xd0\x28\xca\xa8\xf3\xd7\xcd\x25\x96\x0c\x72\x21\xed\x09\x6b\xd4\xb1\x51\x31\x8b\x16\xa6\x15\xf8\xae\x73\xba\xe1\x10\xf1\x90\x0e\xce\x22\xed\x8f\xdd\x2f\x83\xd0\x0d\xbe\x32\x39\xc0\xb5\xe7\xa6\x87\x01\x39\x3b\x72\x36\xc0\x00\x6b\xa7\x8c\xc3\x3c\x14\x4f\xc6\x5f\xa9\x9d\x47\xe9\x3f\x53\x5a\x7c\x2c\x0e\xa7\xd2\x75\xa4\x16\xee\xd3\x72\xcd\x1a\x4c\x64\x50\x72\x4b\x1a\x70\x49\xb0\x85\xc7\x3a\x13\x95\xfa\x5d\x7e\x51\x09\xb0\x71\xbe\x50\x9a\x60\x54\xce\xce\xf9\xbc\xaf\x4a\x5b\xaf\x9c\xbf\x58\x9d\xa5\x40\xf9\xa7\x30\x59\x43\xd9\xd7\x96\xff\x9c\xdb\x11\xef\x49\xa1\xc7\xdc\x1e\x00\xdf\x32\xb1\xf5\x14\xf6\x19\x8c\xa8\x3e\xab\x61\xbd\xa8\x3f\x25\x8e\xf9\x9f\x37\x0d\xb0\x2d\x73\x1e\xc7\x6b\xd0\x42\xbe\x1a\xb6\xaa\xdf\x01\x8c\x63\xb2\x6b\xc5\xc5\x12\x68\x85\xe6\x74\x6e\xc6\x5d\x29\x25\xe0\xca\xb7\x6e\xaf\x20\x8c\xca\x72\x84\x0f\x35\xca\x43\xe8\xb3\x4d\x40\x78\xde\xe3\x85\xc0\x4a\xd3\x6f\x6b\x8f\xc3\xc5\xa1\x1e\xf7\xc4\x0a\x72\xcd\xdf\xa5\xbd\xf6\xef\xd3\xb8\x2c\x21\x73\x2d\x8d\xc3\xb7\xdd\xc3\xac\xfe\xa7\xcf\x9c\x9d\xb4\xc3\x86\xbe\xbd\xee\x1e\xfe\xa0\x2b\x00\x74\x25\x37\x8f\x7b\xb8\x3f\x79\xad\x8b\xf8\x3d\x39\x18\xa5\x5a\x18\x33\xa0\x9e\xd8\xb7\x59\x80\x4e\x65\xd8\xd6\xdd\x61\x59\x9b\x75\xac\xd7\x93\x75\xdd\x1c\xaa\x67\x4a\x5e\x34\xd7\x0b\x2e\xf5\xc3\x08\x11\xe9\x71\x2d\x15\xfb\xb7\xe9\x25\xb6\x92\x0b\xf0\x39\x07\xe3\xb8\x37\x46\x2b\x01\xe1\x4a\xb1\x45\x3c\x4c\xa3\x61\xf4\xba\xd4\xe0\xb7\x0a\x63\x61\x5e\x49\xed\xac\xb9\x59\x19\xa8\x6e\x6c\xa5\x34\x0f\xcb\x89\xfc\x4a\x94\xe1\x82\xa9\x50\x98\x6e\x31\xa0\x2e\xf0\xb1\xf8\xfa\x7f\x11\xd5\x96\xf3\x99\xee\x3c\xc2\xef\x69\x0b\xd6\x87\xae\x72\xbd\xd6\x52\x35\x5e\x57\x36\x5a\x6e\x43\xe6\x99\xe8\x78\x0c\x69\xeb\x7a\xca\x4f\x99\xd3\xa1\x7e\xb4\x28\xb0\x16\x52\xb2\x92\x6a\xf0\x42\x88\xba\x47\xe3\xb3\xe3\x2e\x0c\x81\xc7\x11\x7e\x0a\xf1\xf6\xf1\xe9\xf7\xf6\x84\x9f\xdc\x41\xa2\xfc\x38\x1e\xab\xb1\x7b\x78\x79\x81\x9f\xe3\x7d\x1c\xcc\xd5\xff\xf7\x52\xe9\xc4\xbb\x87\x85\x5e\x3d\x78\xdc\xef\x1e\x1e\x5e\x77\xdb\xcb\xcc\x69\x17\xcf\x37\x78\xf7\x02\x0b\xde\xa7\x7b\xec\xa7\x5f\x3e\xef\x1e\x96\x07\x78\xbb\xf2\xee\x87\x3b\x0b\xe0\x6d\x89\x4f\xd5\xb5\x6d\x0d\x6e\xab\xe1\x61\xe4\x0f\xed\x7d\x2c\xfe\x78\xbb\x6f\x6f\xbf\xf4\x77\x8b\xa6\xd6\x16\x66\xec\x4a\xf4\x8d\x4a\xfd\xff\x6c\x57\x12\x07\xb8\xed\x77\xaf\xbb\x7f\x03\x00\x00\xff\xff\x07\xba\x3e\x57\x52\x08\x00\x00"),
@@ -73,9 +87,11 @@ var FS = func() http.FileSystem {
 	}
 	fs["/js"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/js/js.go"].(os.FileInfo),
+		fs["/js/js_test.go"].(os.FileInfo),
 	}
 	fs["/nosync"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/nosync/map.go"].(os.FileInfo),
+		fs["/nosync/map_test.go"].(os.FileInfo),
 		fs["/nosync/mutex.go"].(os.FileInfo),
 		fs["/nosync/once.go"].(os.FileInfo),
 		fs["/nosync/pool.go"].(os.FileInfo),
@@ -0,0 +1,31 @@
+package compiler
+
+// heapNamesRuntime is a JavaScript snippet that names the constructors of Go
+// types in the -heap-names mode. It is emitted right after the prelude, before
+// any package is defined.
+//
+// Heap snapshots of V8 group objects by the name of their constructor, but the
+// constructors of Go types are anonymous functions, so all Go values end up
+// under a handful of names like "Object" or "typ". In this mode, $newType
+// replaces each constructor with a wrapper whose name is the Go type prefixed
+// with "go$", like "go$[]int" or "go$*mypkg.MyStruct", which lets memory be
+// attributed to Go types. Struct values are listed under their pointer type,
+// since both are represented by the same object. Types defined by the prelude
+// itself, like int64, are created before this snippet runs and keep their
+// anonymous constructors.
+//
+// V8 only takes the name of a function from its source, so the wrappers are
+// created with the Function constructor, which makes this mode incompatible
+// with CSP mode.
+const heapNamesRuntime = `$heapNamed = function(typ, name) {
+  var key = JSON.stringify("go$" + name);
+  var named = new Function("typ", "return ({ " + key + ": function() { return typ.apply(this, arguments); } })[" + key + "];")(typ);
+  named.prototype = typ.prototype;
+  named.prototype.constructor = named;
+  Object.keys(typ).forEach(function(k) { named[k] = typ[k]; });
+  if (named.ptr !== undefined && named.ptr.elem === typ) {
+    named.ptr.elem = named;
+  }
+  return named;
+};
+`
@@ -0,0 +1,148 @@
+package compiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// LinkOptions controls how package archives are assembled into a program.
+type LinkOptions struct {
+	// InitReport enables instrumentation that measures time spent defining and
+	// initializing each package at program startup and prints a ranking once all
+	// packages the main package depends on have been initialized.
+	InitReport bool
+	// ExportNamespace is the dot-separated path of the object, relative to the
+	// global object, that functions exported with //gopherjs:export directives
+	// are set on. Exported functions are set on the global object if empty.
+	ExportNamespace string
+	// Library links the program as a library: the main package must not be a
+	// command, and instead of being initialized when loaded, the program is
+	// initialized when a function exported with a //gopherjs:export directive
+	// is called for the first time.
+	Library bool
+	// Format is the module format of the program, one of FormatIIFE (the
+	// default if empty), FormatUMD, FormatSystemJS or FormatESM. In the UMD,
+	// SystemJS and ES module formats, exported functions are set on the module
+	// exports rather than the global object.
+	Format string
+	// GlobalName is the name of the global variable that the UMD format sets to
+	// the module exports when loaded without a module loader. In the SystemJS
+	// format, it is the name the module is registered with, if not empty.
+	GlobalName string
+	// DevTools registers a Chrome DevTools custom formatter that renders Go
+	// values in Go syntax, see devtoolsRuntime.
+	DevTools bool
+	// HeapNames names the constructors of Go types after the types, so that
+	// heap snapshots group objects by Go type, see heapNamesRuntime.
+	HeapNames bool
+	// StartStop links a command that isn't run when loaded. Instead, it
+	// exports start and stop functions that let the host run and shut down the
+	// program, see startStopRuntime.
+	StartStop bool
+	// Env are environment variables of the program set at build time, as
+	// KEY=VALUE strings.
+	Env []string
+	// EnvSources are the sources the environment variables of the program are
+	// collected from when it starts, in increasing order of precedence, see
+	// ParseEnvSources. DefaultEnvSources are used if empty.
+	EnvSources []string
+	// LTO enables whole-program optimizations at link time, which depend on
+	// the code of all packages of the program: exported methods are only
+	// live if live code may call them, see exportedMethodFilter. Code that
+	// calls exported methods of Go values by name from JavaScript, other than
+	// through js.MakeWrapper, doesn't work in this mode.
+	LTO bool
+	// PackageStart, if not nil, is called right before the code of each
+	// package is written, and with an empty import path once the code of the
+	// last package has been written, so that the output can be attributed to
+	// packages.
+	PackageStart func(importPath string)
+}
+
+// initReportRuntime is a JavaScript snippet that implements startup cost
+// measurements for the -init-report mode. It is emitted right after the
+// prelude, before any package is defined.
+//
+// Package definition time covers creation of type descriptors, method tables
+// and other reflection metadata. Package init time covers package-level
+// variable initialization and init() functions, excluding time spent
+// initializing imported packages. Only synchronous execution is accounted for:
+// if a package initializer blocks, time after it is resumed is not counted.
+const initReportRuntime = `var $initReport = (function() {
+  var now = (typeof performance !== "undefined" && performance.now) ? function() { return performance.now(); } : function() { return Date.now(); };
+  var stats = {}, order = [], stack = [], started = now();
+  var entry = function(path) {
+    if (stats[path] === undefined) {
+      stats[path] = { path: path, define: 0, init: 0 };
+      order.push(path);
+    }
+    return stats[path];
+  };
+  var pad = function(s, n) { s = String(s); while (s.length < n) { s = " " + s; } return s; };
+  var report = function() {
+    var total = now() - started, rows = [];
+    for (var i = 0; i < order.length; i++) { rows.push(stats[order[i]]); }
+    rows.sort(function(a, b) { return (b.define + b.init) - (a.define + a.init); });
+    var lines = ["gopherjs: startup cost by package (" + total.toFixed(1) + "ms before main package initialization):", pad("define", 10) + pad("init", 10) + pad("total", 10) + "  package"];
+    for (var i = 0; i < rows.length; i++) {
+      var r = rows[i];
+      lines.push(pad(r.define.toFixed(2), 10) + pad(r.init.toFixed(2), 10) + pad((r.define + r.init).toFixed(2), 10) + "  " + r.path);
+    }
+    console.error(lines.join("\n"));
+  };
+  return {
+    now: now,
+    defined: function(path, start) { entry(path).define += now() - start; },
+    wrap: function(lastPaths) {
+      var remaining = lastPaths.length;
+      if (remaining === 0) { report(); }
+      var isLast = {};
+      for (var i = 0; i < lastPaths.length; i++) { isLast[lastPaths[i]] = true; }
+      $keys($packages).forEach(function(path) {
+        var pkg = $packages[path], init = pkg.$init;
+        if (pkg === $mainPkg || typeof init !== "function") { return; }
+        pkg.$init = function() {
+          var start = now();
+          stack.push(0);
+          try {
+            return init.apply(this, arguments);
+          } finally {
+            var elapsed = now() - start, nested = stack.pop();
+            if (stack.length > 0) { stack[stack.length - 1] += elapsed; }
+            entry(path).init += elapsed - nested;
+            if (isLast[path]) {
+              remaining--;
+              if (remaining === 0) { report(); }
+            }
+          }
+        };
+      });
+    }
+  };
+})();
+`
+
+// writeInitReportWrap emits code that installs init time instrumentation for
+// all defined packages. The report is printed after all direct imports of the
+// main package that are part of the program, pkgs, have been initialized,
+// which happens right before the main package's own initializers and main()
+// run.
+func writeInitReportWrap(mainPkg *Archive, pkgs []*Archive, w io.Writer) error {
+	linked := map[string]bool{}
+	for _, pkg := range pkgs {
+		linked[pkg.ImportPath] = true
+	}
+	imports := []string{}
+	for _, imp := range mainPkg.Imports {
+		if linked[imp] {
+			imports = append(imports, imp)
+		}
+	}
+	importsJSON, err := json.Marshal(imports)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "$initReport.wrap(%s);\n", importsJSON)
+	return err
+}
@@ -0,0 +1,232 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/analysis"
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+	"github.com/gopherjs/gopherjs/compiler/typesutil"
+)
+
+// inlineParam returns the placeholder of the i-th parameter, counting the
+// receiver of methods as the first, in inline templates, see Decl.Inline.
+func inlineParam(i int) string {
+	return "$inline$" + strconv.Itoa(i) + "$"
+}
+
+// inlineTemplate returns the inline template of the function or method fun,
+// see Decl.Inline, or an empty string if it can't be inlined.
+//
+// Functions can be inlined if their body is a single return statement with
+// one expression that only reads parameters and their fields, with unary and
+// binary operators, len and cap, and constants. The types involved must not
+// need the package-level variables of the package that represent types, so
+// that the code is the same in any package. This is decided on the typed AST
+// by inlinable, so that the template only refers to the parameters and to the
+// prelude.
+func (fc *funcContext) inlineTemplate(fun *ast.FuncDecl, info *analysis.FuncInfo) string {
+	if typesutil.IsJsPackage(fc.pkgCtx.Pkg) {
+		return "" // The js package is implemented by the compiler.
+	}
+	o := fc.pkgCtx.Defs[fun.Name].(*types.Func)
+	sig := o.Type().(*types.Signature)
+	if fun.Body == nil || len(fun.Body.List) != 1 || sig.Variadic() || sig.Results().Len() != 1 || len(info.Blocking) != 0 {
+		return ""
+	}
+	ret, ok := fun.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return ""
+	}
+	result := ret.Results[0]
+	if !types.Identical(fc.pkgCtx.TypeOf(result), sig.Results().At(0).Type()) {
+		return "" // Needs an implicit conversion.
+	}
+
+	var params []*types.Var
+	if sig.Recv() != nil {
+		params = append(params, sig.Recv())
+	}
+	for i := 0; i < sig.Params().Len(); i++ {
+		params = append(params, sig.Params().At(i))
+	}
+	if !fc.inlinable(result, false, params) {
+		return ""
+	}
+
+	// Translate the expression with placeholders as the names of the
+	// parameters, in a separate context to notice temporary variables.
+	c := &funcContext{
+		FuncInfo:    info,
+		pkgCtx:      fc.pkgCtx,
+		parent:      fc,
+		sig:         sig,
+		allVars:     map[string]int{},
+		flowDatas:   map[*types.Label]*flowData{nil: {}},
+		caseCounter: 1,
+		labelCases:  map[*types.Label]int{},
+	}
+	for i, p := range params {
+		if prev, ok := fc.pkgCtx.objectNames[p]; ok {
+			defer func(p *types.Var) { fc.pkgCtx.objectNames[p] = prev }(p)
+		} else {
+			defer delete(fc.pkgCtx.objectNames, p)
+		}
+		fc.pkgCtx.objectNames[p] = inlineParam(i)
+	}
+	template := c.translateExpr(result).String()
+	if len(c.output) != 0 || len(c.localVars) != 0 {
+		return ""
+	}
+	return template
+}
+
+// inlinable reports whether the expression e can be part of an inline
+// template, see inlineTemplate, with the parameters params. The type of e doesn't matter if it is only the operand of a
+// selector, len or cap.
+func (fc *funcContext) inlinable(e ast.Expr, operand bool, params []*types.Var) bool {
+	tv := fc.pkgCtx.Types[e]
+	if tv.Value != nil {
+		return portableType(tv.Type)
+	}
+	if !operand && !portableType(tv.Type) {
+		return false
+	}
+	switch e := e.(type) {
+	case *ast.Ident:
+		for _, p := range params {
+			if fc.pkgCtx.Uses[e] == p {
+				return true
+			}
+		}
+		return false
+	case *ast.ParenExpr:
+		return fc.inlinable(e.X, operand, params)
+	case *ast.SelectorExpr:
+		sel, ok := fc.pkgCtx.SelectionOf(e)
+		if !ok || sel.Kind() != types.FieldVal || len(sel.Index()) != 1 {
+			return false
+		}
+		if s, ok := indirectStruct(sel.Recv()); !ok || getJsTag(s.Tag(sel.Index()[0])) != "" {
+			return false
+		}
+		return fc.inlinable(e.X, true, params)
+	case *ast.StarExpr:
+		return fc.inlinable(e.X, false, params)
+	case *ast.UnaryExpr:
+		switch e.Op {
+		case token.ADD, token.SUB, token.NOT, token.XOR:
+			return fc.inlinable(e.X, false, params)
+		}
+		return false
+	case *ast.BinaryExpr:
+		return fc.inlinable(e.X, false, params) && fc.inlinable(e.Y, false, params)
+	case *ast.CallExpr:
+		id, ok := astutil.RemoveParens(e.Fun).(*ast.Ident)
+		if !ok || len(e.Args) != 1 {
+			return false
+		}
+		if b, ok := fc.pkgCtx.Uses[id].(*types.Builtin); !ok || (b.Name() != "len" && b.Name() != "cap") {
+			return false
+		}
+		switch fc.pkgCtx.TypeOf(e.Args[0]).Underlying().(type) {
+		case *types.Basic, *types.Slice:
+			return fc.inlinable(e.Args[0], true, params)
+		}
+		return false
+	}
+	return false
+}
+
+// indirectStruct returns the struct type t, or that t points to.
+func indirectStruct(t types.Type) (*types.Struct, bool) {
+	if p, ok := t.Underlying().(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	s, ok := t.Underlying().(*types.Struct)
+	return s, ok
+}
+
+// portableType reports whether the values of type t are represented the same
+// way, and the operators on them translated the same way, in any package:
+// they are pointers, or of basic types, except named 64-bit integer and
+// complex types, whose values are created with their constructors. Pointers to
+// arrays aren't portable either, since comparing them needs the type of the
+// arrays.
+func portableType(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		_, isArray := u.Elem().Underlying().(*types.Array)
+		return !isArray
+	case *types.Basic:
+		if u.Kind() == types.UnsafePointer {
+			return false
+		}
+		if _, named := t.(*types.Named); named && (is64Bit(u) || isComplex(u)) {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// inlineCall returns the code of the call e of the function or method f of
+// another package, whose receiver is recv for methods, with the inline
+// template of f, or nil if the call can't be inlined. Only calls whose
+// arguments are variables or constants are inlined, so that evaluating them
+// in the order, and as many times, as the template does, makes no difference.
+func (fc *funcContext) inlineCall(e *ast.CallExpr, f *types.Func, recv ast.Expr) *expression {
+	if fc.pkgCtx.importedDecl == nil || f.Pkg() == nil || f.Pkg() == fc.pkgCtx.Pkg || fc.Blocking[e] || e.Ellipsis.IsValid() {
+		return nil
+	}
+	sig := f.Type().(*types.Signature)
+	var args []ast.Expr
+	var params []types.Type
+	if sig.Recv() != nil {
+		args = append(args, recv)
+		params = append(params, sig.Recv().Type())
+	}
+	args = append(args, e.Args...)
+	for i := 0; i < sig.Params().Len(); i++ {
+		params = append(params, sig.Params().At(i).Type())
+	}
+	if len(args) != len(params) {
+		return nil // Arguments of a call that returns several results.
+	}
+	for i, arg := range args {
+		if !types.Identical(fc.pkgCtx.TypeOf(arg), params[i]) || !fc.simpleOperand(arg) {
+			return nil
+		}
+	}
+	d := fc.pkgCtx.importedDecl(f)
+	if d == nil || d.Inline == "" {
+		return nil
+	}
+
+	oldnew := make([]string, 0, 2*len(args))
+	for i, arg := range args {
+		code := fc.translateExpr(arg)
+		oldnew = append(oldnew, inlineParam(i), code.StringWithParens())
+	}
+	// The template only refers to the parameters and to the prelude, so the
+	// inlined function isn't a dependency.
+	return fc.formatParenExpr("%s", strings.NewReplacer(oldnew...).Replace(d.Inline))
+}
+
+// simpleOperand reports whether e is a variable or a constant, whose
+// evaluation has no side effects.
+func (fc *funcContext) simpleOperand(e ast.Expr) bool {
+	e = astutil.RemoveParens(e)
+	if fc.pkgCtx.Types[e].Value != nil {
+		return true
+	}
+	id, ok := e.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, isVar := fc.pkgCtx.Uses[id].(*types.Var)
+	return isVar
+}
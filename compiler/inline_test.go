@@ -0,0 +1,124 @@
+package compiler
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestInlineCalls(t *testing.T) {
+	libFile, libFset := parseSource(t, `package lib
+
+	type Point struct{ X, Y int }
+
+	func (p *Point) Sum() int { return p.X + p.Y }
+
+	func Add(a, b int) int { return a + b }
+
+	func Twice(a int) int { return a + a }
+
+	func Empty(s string) bool { return len(s) == 0 }
+
+	func IsJS(goos string) bool { return goos == "js" }
+
+	func Same(a, b *[2]int) bool { return a == b }
+
+	func Loop(n int) int {
+		for n > 10 {
+			n--
+		}
+		return n
+	}
+
+	var limit = 10
+
+	func Limit(n int) bool { return n < limit }
+	`)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}, Inline: true}
+	lib, err := Compile("example.com/lib", []*ast.File{libFile}, libFset, importContext, false)
+	if err != nil {
+		t.Fatalf("Compile(lib) returned error: %s", err)
+	}
+
+	templates := map[string]bool{}
+	for _, d := range lib.Declarations {
+		if d.Inline != "" {
+			templates[d.FullName] = true
+		}
+	}
+	for _, tt := range []struct {
+		name   string
+		inline bool
+	}{
+		{"(*example.com/lib.Point).Sum", true},
+		{"example.com/lib.Add", true},
+		{"example.com/lib.Twice", true},
+		{"example.com/lib.Empty", true},
+		{"example.com/lib.IsJS", true},   // Identifier-like contents of string constants don't matter.
+		{"example.com/lib.Same", false},  // Comparing pointers to arrays needs the type of the arrays.
+		{"example.com/lib.Loop", false},  // Not a single return statement.
+		{"example.com/lib.Limit", false}, // Reads a package-level variable.
+	} {
+		if templates[tt.name] != tt.inline {
+			t.Errorf("%s has inline template: %t, want %t", tt.name, templates[tt.name], tt.inline)
+		}
+	}
+
+	compileMain := func(inline bool) *Decl {
+		file, fset := parseSource(t, `package main
+
+		import "example.com/lib"
+
+		func f(x, y int, p *lib.Point, a *[2]int) int {
+			if lib.Same(a, a) {
+				return 0
+			}
+			return lib.Add(x, 2) + lib.Add(x, y*2) + p.Sum() + lib.Twice(y)
+		}
+
+		func main() { f(1, 2, &lib.Point{}, nil) }
+		`)
+		importContext.Import = func(path string) (*Archive, error) {
+			if path != "example.com/lib" {
+				return nil, fmt.Errorf("unexpected import of %q", path)
+			}
+			return lib, nil
+		}
+		importContext.Inline = inline
+		archive, err := Compile("main", []*ast.File{file}, fset, importContext, false)
+		if err != nil {
+			t.Fatalf("Compile(main) returned error: %s", err)
+		}
+		for _, d := range archive.Declarations {
+			if d.FullName == "main.f" {
+				return d
+			}
+		}
+		t.Fatalf("main.f not found")
+		return nil
+	}
+
+	d := compileMain(true)
+	code := string(d.DeclCode)
+	for _, want := range []string{"(x + 2 >> 0)", "lib.Add(x, $imul(y, 2))", "(p.X + p.Y >> 0)", "(y + y >> 0)", "lib.Same(a, a)"} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Code of main.f with inlining doesn't contain %q:\n%s", want, code)
+		}
+	}
+	// Inlined functions aren't dependencies, so that they can be eliminated.
+	deps := map[string]bool{}
+	for _, dep := range d.DceDeps {
+		deps[dep] = true
+	}
+	if !deps["example.com/lib.Add"] || deps["example.com/lib.Twice"] {
+		t.Errorf("Dependencies of main.f with inlining are %q, want example.com/lib.Add but not example.com/lib.Twice", d.DceDeps)
+	}
+	code = string(compileMain(false).DeclCode)
+	for _, want := range []string{"lib.Add(x, 2)", "p.Sum()"} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Code of main.f without inlining doesn't contain %q:\n%s", want, code)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Package linebuf buffers the JavaScript code that the compiler generates for
+// Go functions before printing it. It isn't an intermediate representation:
+// the code is kept as lines of text, with the positions of the Go code they
+// were generated from for source maps. Only the frame of functions is kept
+// apart from their bodies, their parameters, local variables and what they
+// need to block, defer and resume, so that it can be printed in different
+// dialects of JavaScript.
+package linebuf
+
+import "go/token"
+
+// Entry is an entry of the code of the body of a function, or of the code
+// that initializes a package.
+type Entry interface {
+	isEntry()
+}
+
+// Line is a line of code, generated from the Go code at the position of the
+// last Mark before it.
+type Line struct {
+	Indent int
+	Code   string
+}
+
+// Mark is the position of the Go code that the following code was generated
+// from, which may be token.NoPos for code that has none.
+type Mark struct {
+	Pos token.Pos
+}
+
+// Comment is a line comment, like the positions of Go code in readable
+// output.
+type Comment struct {
+	Indent int
+	Text   string
+}
+
+func (*Line) isEntry()    {}
+func (*Mark) isEntry()    {}
+func (*Comment) isEntry() {}
+
+// Dialect is the version of JavaScript that code is printed in.
+type Dialect int
+
+const (
+	// ES5 is the default dialect, which all supported engines run.
+	ES5 Dialect = iota
+	// ES2015 declares local variables with let, and prints functions that
+	// don't refer to this as arrow functions.
+	ES2015
+)
+
+// Func is the JavaScript function of a Go function, method or function
+// literal.
+type Func struct {
+	// Name is the name of the function expression, which it refers to itself
+	// with, or empty.
+	Name   string
+	Params []string
+	// Vars are the local variables, declared at the start of the body,
+	// excluding those of the frame. They may include the parameters, which
+	// are saved in the frame too.
+	Vars []string
+	Body []Entry
+	// Indent is the indentation of the function expression, whose body is
+	// indented one level deeper.
+	Indent int
+	// Dialect is the dialect the function is printed in. Arrow is set if the
+	// function doesn't refer to this, so that it can be an arrow function.
+	Dialect Dialect
+	Arrow   bool
+
+	// Flattened is set if the body is a loop over the cases of a switch
+	// statement, to resume the function at a case or to jump to a label.
+	Flattened bool
+	// Blocking is set if the function may block, so that its local variables
+	// are saved in a frame object when it does, and loaded from it when it
+	// resumes. FrameRef is the expression of the function that the frame
+	// resumes.
+	Blocking bool
+	FrameRef string
+	// Defer is set if the function defers calls. PanicResults is the code of
+	// the results returned when it recovers from a panic, if it has results
+	// but no named ones, and NamedResults the code of its named results, if
+	// any, both with a leading space.
+	Defer        bool
+	PanicResults string
+	NamedResults string
+}
@@ -0,0 +1,145 @@
+package linebuf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// PosMarker starts the position of a Mark in printed code, which is followed
+// by the position as a big-endian uint32. The markers are removed from the
+// output when linking, see compiler.SourceMapFilter.
+const PosMarker = '\b'
+
+// Print returns the code of entries.
+func Print(entries []Entry) []byte {
+	var b bytes.Buffer
+	printEntries(&b, entries)
+	return b.Bytes()
+}
+
+func printEntries(b *bytes.Buffer, entries []Entry) {
+	for _, s := range entries {
+		switch s := s.(type) {
+		case *Line:
+			b.WriteString(strings.Repeat("\t", s.Indent))
+			b.WriteString(s.Code)
+			b.WriteByte('\n')
+		case *Comment:
+			b.WriteString(strings.Repeat("\t", s.Indent))
+			b.WriteString("// " + s.Text)
+			b.WriteByte('\n')
+		case *Mark:
+			b.WriteByte(PosMarker)
+			binary.Write(b, binary.BigEndian, uint32(s.Pos))
+		default:
+			panic(fmt.Sprintf("unexpected statement %T", s))
+		}
+	}
+}
+
+// Print returns the code of the function expression f.
+func (f *Func) Print() string {
+	vars := append([]string{}, f.Vars...)
+	var prefix, suffix string
+	decl := "var"
+	if f.Dialect >= ES2015 {
+		decl = "let"
+	}
+
+	if f.Flattened {
+		vars = append(vars, "$s")
+		prefix = prefix + " $s = 0;"
+	}
+
+	if f.Defer {
+		vars = append(vars, "$deferred")
+		suffix = " }" + suffix
+		if f.Blocking {
+			suffix = " }" + suffix
+		}
+	}
+
+	if f.Blocking {
+		vars = append(vars, "$r")
+		var stores, loads string
+		for _, v := range vars {
+			loads += fmt.Sprintf("%s = $f.%s; ", v, v)
+			stores += fmt.Sprintf("$f.%s = %s; ", v, v)
+		}
+		prefix = prefix + " " + decl + " $f, $c = false; if (this !== undefined && this.$blk !== undefined) { $f = this; $c = true; " + loads + "}"
+		suffix = " if ($f === undefined) { $f = { $blk: " + f.FrameRef + " }; } " + stores + "return $f;" + suffix
+	}
+
+	if f.Defer {
+		prefix = prefix + " " + decl + " $err = null; try {"
+		deferSuffix := " } catch(err) { $err = err;"
+		if f.Blocking {
+			deferSuffix += " $s = -1;"
+		}
+		if f.PanicResults != "" {
+			deferSuffix += fmt.Sprintf(" return%s;", f.PanicResults)
+		}
+		deferSuffix += " } finally { $callDeferred($deferred, $err);"
+		if f.NamedResults != "" {
+			deferSuffix += fmt.Sprintf(" if (!$curGoroutine.asleep) { return %s; }", f.NamedResults)
+		}
+		if f.Blocking {
+			deferSuffix += " if($curGoroutine.asleep) {"
+		}
+		suffix = deferSuffix + suffix
+	}
+
+	if f.Flattened {
+		prefix = prefix + " s: while (true) { switch ($s) { case 0:"
+		suffix = " } return; }" + suffix
+	}
+
+	if f.Defer {
+		prefix = prefix + " $deferred = []; $deferred.index = $curGoroutine.deferStack.length; $curGoroutine.deferStack.push($deferred);"
+	}
+
+	var b bytes.Buffer
+	params := strings.Join(f.Params, ", ")
+	switch {
+	case f.Dialect >= ES2015 && f.Arrow && f.Name == "":
+		fmt.Fprintf(&b, "(%s) => {\n", params)
+	case f.Name != "":
+		fmt.Fprintf(&b, "function %s(%s) {\n", f.Name, params)
+	default:
+		fmt.Fprintf(&b, "function(%s) {\n", params)
+	}
+	indent := strings.Repeat("\t", f.Indent+1)
+	if f.Dialect >= ES2015 {
+		// Parameters can't be declared again with let.
+		vars = removeParams(vars, f.Params)
+	}
+	if len(vars) != 0 {
+		fmt.Fprintf(&b, "%s%s %s;\n", indent, decl, strings.Join(vars, ", "))
+	}
+	if prefix != "" {
+		fmt.Fprintf(&b, "%s/* */%s\n", indent, prefix)
+	}
+	printEntries(&b, f.Body)
+	if suffix != "" {
+		fmt.Fprintf(&b, "%s/* */%s\n", indent, suffix)
+	}
+	b.WriteString(strings.Repeat("\t", f.Indent) + "}")
+	return b.String()
+}
+
+// removeParams returns vars without the parameters params.
+func removeParams(vars, params []string) []string {
+	var result []string
+	for _, v := range vars {
+		isParam := false
+		for _, p := range params {
+			isParam = isParam || v == p
+		}
+		if !isParam {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package linebuf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrint(t *testing.T) {
+	entries := []Entry{
+		&Comment{Indent: 1, Text: "main.go:3"},
+		&Mark{Pos: 0x01020304},
+		&Line{Indent: 1, Code: "x = 1;"},
+		&Line{Indent: 2, Code: "y = x;"},
+	}
+	want := []byte("\t// main.go:3\n\b\x01\x02\x03\x04\tx = 1;\n\t\ty = x;\n")
+	if got := Print(entries); !bytes.Equal(got, want) {
+		t.Errorf("Print() returned %q, want %q", got, want)
+	}
+}
+
+func TestPrintFunc(t *testing.T) {
+	tests := []struct {
+		name string
+		f    *Func
+		want string
+	}{{
+		name: "simple",
+		f: &Func{
+			Name:   "f",
+			Params: []string{"a", "b"},
+			Vars:   []string{"c"},
+			Body:   []Entry{&Line{Indent: 2, Code: "c = a + b;"}, &Line{Indent: 2, Code: "return c;"}},
+			Indent: 1,
+		},
+		want: "function f(a, b) {\n\t\tvar c;\n\t\tc = a + b;\n\t\treturn c;\n\t}",
+	}, {
+		name: "blocking",
+		f: &Func{
+			Params:    []string{"ch"},
+			Vars:      []string{"v"},
+			Body:      []Entry{&Line{Indent: 1, Code: "case 0:"}},
+			Flattened: true,
+			Blocking:  true,
+			FrameRef:  "f",
+		},
+		want: "function(ch) {\n" +
+			"\tvar v, $s, $r;\n" +
+			"\t/* */ $s = 0; var $f, $c = false; if (this !== undefined && this.$blk !== undefined) { $f = this; $c = true; v = $f.v; $s = $f.$s; $r = $f.$r; } s: while (true) { switch ($s) { case 0:\n" +
+			"\tcase 0:\n" +
+			"\t/* */ } return; } if ($f === undefined) { $f = { $blk: f }; } $f.v = v; $f.$s = $s; $f.$r = $r; return $f;\n" +
+			"}",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Print(); got != tt.want {
+				t.Errorf("Print() returned:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -124,6 +124,11 @@ func parseGoLinknames(fset *token.FileSet, pkgPath string, file *ast.File) ([]Go
 		}
 
 		decl := obj.Decl.(*ast.FuncDecl)
+		if decl.Name.Name == "_" {
+			// The function was replaced by a native override, which takes precedence
+			// over the directive of the original declaration.
+			return nil
+		}
 		if decl.Body != nil {
 			if pkgPath == "runtime" || pkgPath == "internal/bytealg" {
 				// These standard library packages are known to use unsupported
@@ -0,0 +1,54 @@
+package compiler
+
+import "strings"
+
+// exportedMethodFilter returns the DCE filter of the exported methods named
+// name. Unlike unexported methods, which can only be called by the code of
+// their package, exported methods may be called through interfaces by code of
+// any package, so their filter isn't qualified by a package path.
+//
+// The code that calls exported methods records them as dependencies, but the
+// filter is only set for the decls of exported methods in the LTO mode, see
+// LinkOptions.LTO. Otherwise exported methods are live when their receiver
+// type is.
+func exportedMethodFilter(name string) string {
+	return "*." + name + "~"
+}
+
+func isExportedMethodFilter(filter string) bool {
+	return strings.HasPrefix(filter, "*.")
+}
+
+// keptMethods are the names of exported methods that the prelude calls, which
+// are live when their receiver type is, even in the LTO mode.
+var keptMethods = map[string]bool{
+	"Error":    true, // Of panic values.
+	"String":   true, // Of panic values.
+	"UnixNano": true, // Of time.Time values externalized as Date.
+}
+
+// reflectiveDeps are dependencies of code that may call any exported method,
+// by index or by a name that isn't known at compile time. If a live decl
+// depends on one of them, exported methods are live when their receiver type
+// is, even in the LTO mode.
+var reflectiveDeps = map[string]bool{
+	exportedMethodFilter("Method"):                true, // Of reflect.Value and reflect.Type.
+	exportedMethodFilter("MethodByName"):          true, // Of reflect.Value and reflect.Type.
+	"github.com/gopherjs/gopherjs/js.MakeWrapper": true,
+}
+
+// ltoMethodFilter returns the DCE filter that the decl d of pkg has in the LTO
+// mode besides its own, or an empty string if it has no other. It is
+// exportedMethodFilter for the decls of exported methods, except those of
+// keptMethods and the methods of the js package, which are implemented by the
+// compiler.
+func ltoMethodFilter(pkg *Archive, d *Decl) string {
+	if d.DceMethodFilter != "" || !strings.HasPrefix(d.FullName, "(") || pkg.ImportPath == "github.com/gopherjs/gopherjs/js" {
+		return ""
+	}
+	name := d.FullName[strings.LastIndex(d.FullName, ".")+1:]
+	if keptMethods[name] {
+		return ""
+	}
+	return exportedMethodFilter(name)
+}
@@ -2,7 +2,8 @@
 //
 // See documentation of parseAndAugment in github.com/gopherjs/gopherjs/build
 // for explanation of behavior used to augment the native packages using the files
-// in src subfolder.
+// in src subfolder. Natives specific to newer Go releases are in go1.N/src
+// subfolders, see Roots.
 package natives
 
 //go:generate vfsgendev -source="github.com/gopherjs/gopherjs/compiler/natives".FS -tag=gopherjsdev
@@ -7,16 +7,21 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/shurcooL/httpfs/filter"
 )
 
+// overlaySet matches the paths of the overlay sets of specific Go releases,
+// see Roots.
+var overlaySet = regexp.MustCompile(`^/go1\.[0-9]+(/src(/.*)?)?$`)
+
 // FS is a virtual filesystem that contains native packages.
 var FS = filter.Keep(
 	http.Dir(importPathToDir("github.com/gopherjs/gopherjs/compiler/natives")),
 	func(path string, fi os.FileInfo) bool {
-		return path == "/" || path == "/src" || strings.HasPrefix(path, "/src/")
+		return path == "/" || path == "/src" || strings.HasPrefix(path, "/src/") || overlaySet.MatchString(path)
 	},
 )
 
@@ -21,816 +21,1107 @@ var FS = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2021, 6, 19, 20, 0, 23, 930000000, time.UTC),
+			modTime: time.Date(2026, 10, 15, 17, 48, 40, 346919965, time.UTC),
 		},
 		"/src": &vfsgen۰DirInfo{
 			name:    "src",
-			modTime: time.Date(2021, 5, 23, 13, 5, 43, 160000000, time.UTC),
+			modTime: time.Date(2026, 10, 15, 22, 4, 46, 341596286, time.UTC),
 		},
 		"/src/bufio": &vfsgen۰DirInfo{
 			name:    "bufio",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/bufio/bufio_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "bufio_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 164,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x2c\xca\xc1\x8a\x83\x30\x10\x00\xd0\xf3\xce\x57\x0c\x39\xe9\x2e\xe8\x37\xec\x69\x61\xa1\x97\xea\xbd\x8c\x71\xb4\x53\x63\x26\x24\x93\x42\x29\xfd\xf7\x22\xf4\xf8\xe0\xf5\xfd\xcf\x54\x25\xcc\x78\x2b\x00\x89\xfc\x46\x2b\xe3\x54\x17\xd1\x8b\x71\x31\x00\xd9\x93\x66\x43\x77\x48\xe2\xea\x00\x96\x1a\x3d\x8e\x5c\xec\xcc\x34\x0f\x96\x25\xae\xbf\x21\xa8\x2f\x8d\xe1\xf7\xa7\x75\x63\x8b\x4f\xf8\xb2\x6e\xd8\x24\x35\xee\xc4\xbb\xe6\x07\xd2\xd1\xc8\x44\x23\x7a\xad\xd1\x38\x17\xa4\xcc\x18\xd5\x90\xee\x24\x81\xa6\xc0\x28\x11\xff\x34\x5d\x39\xff\x0f\x9d\x6b\xe1\x05\xef\x00\x00\x00\xff\xff\x87\xe9\x3a\xd5\xa4\x00\x00\x00"),
 		},
 		"/src/bytes": &vfsgen۰DirInfo{
 			name:    "bytes",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/bytes/bytes.go": &vfsgen۰CompressedFileInfo{
 			name:             "bytes.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 508,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x90\xcd\x4e\xc3\x30\x10\x84\xcf\xde\xa7\x18\x6e\x8d\x68\x55\x72\x45\x4d\x0f\x20\x0e\x3c\x43\xd5\xc3\xda\xdd\x54\x86\xe0\x14\x27\x91\xa8\x50\xde\x1d\xd9\x71\x1a\x19\x55\xca\x21\xde\x9f\x99\x6f\x67\xbb\xc5\xa3\x1e\x6c\x73\xc2\x47\x47\x74\x61\xf3\xc9\x67\x81\xbe\xf6\xd2\x11\xd5\x83\x33\x78\x77\x27\xf9\x79\xb9\xf6\xb2\xea\x70\x38\x86\xce\x1a\x26\x4e\x14\xb0\xae\xc7\x2f\xa9\xba\xf5\xb0\x6b\x68\x3c\x57\xf0\xec\xce\x82\x2e\x94\x95\xad\xa1\x51\x55\x30\xf1\xa5\xbc\xf4\x83\x77\xb0\xa4\xd4\x48\xe1\x4b\x85\x4d\x49\x63\x32\x7b\xfb\x1e\xb8\x59\x71\xd0\x9a\xbc\x0a\xe8\xb6\x6d\xc2\xbe\xad\xd1\x88\x5b\x71\x81\x87\x2a\xfe\xe9\x22\xca\x26\x91\x9a\x9b\x4e\xa2\x6a\xa2\x31\x0b\x0d\xcf\x34\x26\xec\xea\x83\x3d\x66\x40\x69\x35\x87\xea\xfd\x20\x37\xac\xd7\xf6\xeb\xc2\x5e\x72\xb0\xfc\x78\xc3\x77\xfc\x2c\xf6\x19\xeb\x2c\x5e\x4e\x6e\xca\xc4\xc8\x02\x50\xe2\x63\xec\x60\x74\x36\xbb\x99\x87\xa7\xfe\xfe\x7f\xbf\xbc\x91\x2f\x09\xed\xee\x04\x14\x74\x96\xf3\x9e\x68\xa4\xbf\x00\x00\x00\xff\xff\x23\x2d\xfc\x5d\xfc\x01\x00\x00"),
 		},
 		"/src/bytes/bytes_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "bytes_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 215,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x54\xcc\xc1\x4a\xc4\x30\x10\x87\xf1\x73\xe7\x29\x86\x5c\x6c\x55\xba\x8f\xb1\xe0\xb5\xde\x44\x24\x4d\xff\xb6\xe3\xa6\x93\x90\x99\x22\xab\xf8\xee\xb2\xe0\xc5\xeb\xc7\x8f\xef\x74\xe2\x87\xf9\x90\xbc\xf0\x87\x11\xd5\x98\x2e\x71\x05\xcf\x57\x87\xbd\x39\xcc\x89\x64\xaf\xa5\x39\xf7\xd4\x85\x5b\x10\x5d\x03\x0d\x44\xef\x87\x26\x5e\xa2\xae\x68\xe5\xb0\x29\x4b\x42\xef\x7c\xff\x47\xc6\xe7\x81\x5f\x5e\x6f\x1b\xfe\xa6\xce\xc7\xe9\x22\xb5\x0f\xff\x39\x37\x64\x81\x71\x51\xb6\xab\xa5\x98\xf3\x78\x86\xd7\xb8\xc2\xe4\x0b\x8f\xfc\xb9\x49\xda\xf8\x5c\xea\x86\xf6\x34\xf1\x52\x60\x7a\xe7\x2c\x7b\xcd\xd8\xa1\x1e\x06\xa2\xae\x46\x95\xd4\x87\x43\x1b\x62\xda\xe2\x9c\x11\x06\xfa\xa1\xdf\x00\x00\x00\xff\xff\x25\x40\x6e\x83\xd7\x00\x00\x00"),
 		},
 		"/src/crypto": &vfsgen۰DirInfo{
 			name:    "crypto",
-			modTime: time.Date(2021, 3, 28, 16, 13, 10, 447844200, time.UTC),
+			modTime: time.Date(2026, 10, 15, 20, 12, 35, 933196210, time.UTC),
 		},
 		"/src/crypto/internal": &vfsgen۰DirInfo{
 			name:    "internal",
-			modTime: time.Date(2021, 3, 28, 16, 13, 10, 371779600, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/crypto/internal/subtle": &vfsgen۰DirInfo{
 			name:    "subtle",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/crypto/internal/subtle/aliasing.go": &vfsgen۰CompressedFileInfo{
 			name:             "aliasing.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 782,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x90\x4b\x6b\xdc\x3e\x14\xc5\xd7\xa3\x4f\x71\x18\xfe\xe4\x6f\x93\x8c\xd5\x6c\x43\x53\xe8\x2a\xb4\x9b\x2c\x1a\xe8\xa2\x74\x21\xcb\x77\x6c\x4d\x65\x5d\x73\x75\xdd\x58\x94\x7e\xf7\xe2\xc9\xab\x0f\x92\xd2\x9d\x2e\xfc\xce\x43\xc7\x5a\x9c\xb6\x73\x88\x1d\x0e\xd9\x98\xc9\xf9\x2f\xae\x27\xe4\xb9\xd5\x48\xc6\x58\x8b\x9b\x21\x64\xec\x43\x24\x74\xf3\x14\x83\x77\x4a\x1d\x42\x86\x0e\x94\x09\x7a\xcb\x88\xec\x9d\x06\x4e\xf9\x62\xe5\x77\xc8\xe2\xad\x97\x32\x29\xdb\x90\x94\x24\xb9\x68\xef\x0c\xed\x13\xd0\x73\x74\xa9\x6f\x58\x7a\xbb\x3c\x4b\x9b\x30\x4e\x2c\x8a\x6d\x1f\x74\x98\xdb\xc6\xf3\x68\x7b\x9e\x06\x92\x43\x7e\x7a\x1c\xf2\xf6\xd8\xf4\x6d\x2a\xd7\x5f\x49\xa2\x9b\x20\xb4\xea\x32\x6e\x07\xd2\x81\x04\x0b\x5c\xea\x50\x90\x07\x27\x84\x91\x46\x96\x02\xa7\x70\xa9\xa0\x4a\xac\x48\xe4\x29\x67\x27\x21\x96\xd5\xca\xb3\x08\xe5\x89\x53\x17\x52\x5f\x23\xa4\x8e\x96\x06\x37\xc3\xa3\xb6\xa5\xc2\xa9\x5b\x47\x40\x8e\xc1\x13\x22\xa5\x5e\x87\x75\x98\xd0\x27\x16\xea\x1a\xb3\x9f\x93\xff\xa9\x54\xb5\x9c\xa1\xe0\xd3\xe7\xb6\x28\xd5\x68\x99\x23\xbe\x99\x8d\xb5\xb8\x3a\x7e\xe4\xfd\x87\x0b\x7c\x24\x78\x97\xfe\x57\x08\xc5\x02\x4e\x98\xf8\xb8\x09\x9c\x04\x1d\x46\xd2\xe0\xcf\x90\x19\x73\xa6\x47\xd5\x7d\xfe\xc3\x76\xb9\x31\x1b\x21\x9d\x25\xad\x95\xaa\xa5\xc6\x1b\xbc\xc2\xc9\xc9\xf1\x2a\x0f\x97\xd9\x6c\x0e\xb9\x79\x77\xaf\xb9\x6e\x0f\xe4\xb5\x5a\xea\xe6\x8a\xb4\xda\xfe\xe7\x44\x5c\xd9\xd6\xb8\xbc\xc4\x9f\x54\xf9\x9d\xfa\x9b\x1b\xef\xf7\x99\x74\x5b\xaf\x40\x55\xe3\xf5\x8b\xa6\xbf\xc2\xa7\x77\xa5\x77\xe7\xcf\x85\x94\x7f\x09\x59\x5e\x08\x59\xea\xdd\xb9\xf9\x6e\x7e\x04\x00\x00\xff\xff\x19\x84\xbe\x4e\x0e\x03\x00\x00"),
 		},
 		"/src/crypto/rand": &vfsgen۰DirInfo{
 			name:    "rand",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/crypto/rand/rand.go": &vfsgen۰CompressedFileInfo{
 			name:             "rand.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1415,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x54\x41\x4f\xeb\x38\x10\x3e\xc7\xbf\x62\xc8\xae\x56\xf1\x12\x12\x24\x04\x87\xae\x8a\xc4\x22\x84\x38\x2c\xbb\x8b\x76\xdf\x3b\x20\x0e\x76\x32\x69\x5c\x52\xbb\x6f\xec\x34\x54\xa5\xff\xfd\xc9\x71\x52\x0a\xf4\xe9\x5d\xda\x38\xdf\x37\xdf\x37\x33\x9e\x49\x9e\xc3\xb1\x6c\x55\x53\xc2\xdc\x32\xb6\x14\xc5\xb3\x98\x21\x90\xd0\x25\x63\x6a\xb1\x34\xe4\x20\x61\x51\x8c\x44\x86\x6c\xcc\x58\x14\xcf\x94\xab\x5b\x99\x15\x66\x91\xcf\xcc\xb2\x46\x9a\xdb\xb7\x87\xb9\x8d\x19\x67\xac\x6a\x75\x01\x4a\x2b\x97\x70\xd8\xb0\xe8\x01\x45\x89\x04\x53\xf8\x8d\xf4\x2c\x1c\x36\x5b\xb6\x65\xcc\xad\x97\x08\xbb\x77\x60\x1d\xb5\x85\xdb\x6c\x07\x81\x84\xe0\xf7\x1d\xc8\xc1\xff\x27\x12\x1e\x9f\xe4\xda\x21\x87\x44\x83\xd2\x2e\x05\x24\x82\x3e\xbd\xde\x4a\x10\x89\x35\x4c\xa6\x30\xb7\xd9\x9d\x76\x48\x5a\x34\x7f\xcb\x39\x16\x2e\x91\x3c\xbb\x45\x97\xc4\xbf\xf6\x9c\x98\xb3\xc8\x54\x95\x45\xf7\x13\x76\x20\xc5\xdc\x13\x12\xce\x58\x94\xe7\x20\xc9\x74\x16\x89\x45\x05\xad\x97\xce\x0c\x0a\xb7\x8d\x91\xa2\x09\x61\x01\xf0\x26\xaa\x82\x81\x35\xed\x59\xff\xeb\x12\x2b\xa5\xb1\xf4\xe9\x8e\x02\x9f\xe2\x17\xf6\x7a\xa7\xb0\xdd\x17\x39\x3a\x20\xb2\x43\x43\xec\x0c\xdd\x83\xd0\xa5\x59\x7c\x11\x4d\x8b\x36\xe6\x07\x83\x22\x0d\x53\x68\x50\x27\x92\xfb\x93\xaa\x40\xc3\x25\x5c\x9c\x9f\x9f\x5d\x04\xdc\x17\x7a\xb5\x32\xaa\x84\x7f\x5b\xe3\xc4\xcd\x4b\x81\x58\x62\x79\xe3\x7b\x0d\xae\x26\xd3\x69\x90\x6b\xf8\xe0\x36\x46\x76\x35\x6a\x2f\x3f\x73\x35\x28\x0b\x0b\x43\x08\xae\x16\x3a\x38\xa4\x20\x2c\xd8\x25\x16\xaa\x52\x58\x82\xd2\x63\x58\xed\xdc\x72\x92\xe7\x5d\xd7\x65\xdd\x59\x66\x68\x96\xff\xf7\x90\x7f\x45\x19\xba\x71\xf5\xcf\x5d\xfe\x4b\x78\x3c\x59\xa0\xab\x4d\x79\x72\xc8\xde\x57\xd6\xdb\xf8\xd3\xd6\xff\x0c\xed\xb9\x16\x4d\xf3\xb9\x3f\x29\xf4\x13\x31\xa0\xb6\x95\x61\x40\x52\x08\x57\x3f\xfe\x1f\x6b\xde\x77\x8a\xd0\xb5\xa4\x41\xa7\xa0\x55\xc3\x7a\x83\x6d\x18\x8b\x7b\x53\x62\x36\xb7\xfd\x75\x11\x7e\x6b\x15\xe1\x81\xd1\x18\x90\x98\xff\xb1\x23\xfd\xe0\x52\xa9\xcf\xf2\xcf\xb5\x43\xeb\x75\x06\x76\x76\xa7\x57\xe6\x19\xdf\x66\x6c\x90\x7d\x23\xf7\xd2\x7b\xb1\x07\xaf\xff\x5d\xcd\xe8\xe2\x74\x3f\x64\xf4\x08\xf3\xc1\xc7\x16\xec\xd7\x1f\xa0\x0f\x4d\x18\xb0\xd3\x34\xac\xa4\xcd\xee\xb1\x1b\x13\xcd\xbd\x3e\x68\xe3\x40\xac\x84\x6a\x84\x6c\x10\x94\x06\x57\x2b\x0b\xa8\x57\x8a\x8c\x5e\xa0\x76\x31\x67\xe3\x07\x40\x0a\x57\xd4\x58\x26\x15\xf8\x63\x32\x6e\xbe\x34\xa6\x49\x81\x50\x94\x7f\x89\x17\xff\x11\xe0\x9f\x71\x5f\xe3\x90\x4c\x8f\xc9\xb6\x82\x8f\x78\x54\x19\x0a\x65\xb4\x15\x87\xcb\x9d\xe2\x66\xd8\x87\xa3\xca\x23\x8f\x93\xe1\xfd\x13\x1f\xf6\x62\xd4\x15\x8d\xc5\xdd\x84\x79\x83\x29\x78\xfe\x40\x9f\x3c\x85\xb6\xbc\xeb\x97\x37\x9a\x4e\xe1\x14\x5e\x5f\xa1\x57\xef\xd7\x7b\xcb\xbe\x07\x00\x00\xff\xff\x4b\xf2\x65\x42\x87\x05\x00\x00"),
 		},
+		"/src/crypto/tls": &vfsgen۰DirInfo{
+			name:    "tls",
+			modTime: time.Date(2026, 10, 15, 20, 12, 35, 937196211, time.UTC),
+		},
+		"/src/crypto/tls/tls.go": &vfsgen۰CompressedFileInfo{
+			name:             "tls.go",
+			modTime:          time.Date(2026, 10, 15, 20, 12, 35, 943908882, time.UTC),
+			uncompressedSize: 472,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\x91\x41\xaf\xd3\x30\x10\x84\xcf\xf8\x57\x8c\x72\xa1\x05\x94\xdc\x39\xc2\x01\x84\x9e\xde\xe5\xf1\x07\xfc\x9c\x4d\xe3\xd6\xd9\xb5\x76\xd7\xad\x22\xc4\x7f\x47\x29\x6d\x11\xef\xe8\xb1\xe6\xd3\xcc\xec\x30\xe0\xe3\x6b\xcb\x65\xc4\xd1\x42\xa8\x31\x9d\xe2\x81\xe0\xc5\x42\xc8\x4b\x15\x75\x74\xa4\x2a\x6a\x5d\x08\xc3\x00\x23\x3d\x93\x7e\x8f\x3c\xda\x1c\x4f\x84\x29\xe6\x62\x9f\x60\x99\x13\xe1\x9b\xd4\x99\xf4\xc7\x0b\xaa\xca\x41\xe3\x62\x48\x91\xdf\x3b\x62\x4a\x54\x1d\x49\x98\x29\x79\x16\x36\xf8\x1c\x7d\xe3\x45\xfc\x7c\x7a\xb9\x51\x71\x91\x56\x46\x68\x63\x08\xf7\xf8\xa2\x72\x31\x52\x43\xe4\x11\xcf\x32\x52\x7f\x34\x38\xe9\x92\x39\x3a\x5d\x7d\x3e\xd3\x62\x54\xce\x64\xfd\x06\x7b\xa2\x78\xce\x7c\xd8\xe4\x3b\x72\x8e\x65\x82\x4c\x57\x69\x7e\xa4\x96\xe6\x77\xf1\xde\xf8\x44\x54\x0d\xd9\x6f\x7f\x1b\xee\xd1\x62\x0b\x0b\xe1\xb2\xa2\x19\x21\xe9\x5a\x5d\x06\x2f\x86\x49\x14\xa9\x64\xe2\xff\xcb\x89\x22\xbb\xc1\xd7\xba\x05\x9b\x1a\x27\xec\x12\x3e\x7c\x15\xe6\xfd\xdb\x05\x77\x7b\x5c\xf7\xc5\xaf\xf0\x4e\xc9\x9b\xf2\xdf\xb7\xf5\xcf\x74\xd9\x75\x5e\xec\xf3\xbf\x2e\x37\x8f\x21\x2a\x81\xc5\x61\xad\x6e\x37\xa2\x11\xaf\xeb\x63\xfe\x6e\x1f\x7e\x87\x3f\x03\x00\x39\x75\xb7\x8a\xd8\x01\x00\x00"),
+		},
 		"/src/crypto/x509": &vfsgen۰DirInfo{
 			name:    "x509",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/crypto/x509/x509.go": &vfsgen۰CompressedFileInfo{
 			name:             "x509.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 177,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x34\x8d\xb1\x6e\xc2\x40\x10\x05\xeb\xec\x57\x3c\x5d\x65\x27\x51\x9c\x26\x45\xd2\xa6\x88\x94\x02\x21\xfc\x05\x67\x7b\x81\x83\xf3\xed\x69\x6f\x0d\x58\x88\x7f\x47\x58\xa2\x1d\x8d\x66\x9a\x06\x6f\xdd\x14\xe2\x80\x43\x21\xca\xbe\x3f\xfa\x1d\xe3\xf2\xf5\xf9\x4d\x14\xc6\x2c\x6a\x70\xac\x2a\x5a\x1c\xd1\x76\x4a\x3d\xa2\xf8\xa1\x9d\x8b\xf1\xb8\x11\xb1\x52\xd5\xa8\x5e\x7f\x59\x6d\x2d\x12\xdf\xb1\xb8\x35\xae\xf4\xa2\x6c\x93\x26\xa4\xf0\xa4\xe5\x63\xc5\xe7\xca\xf5\x3a\x67\x93\xe6\xb1\xf8\x41\x59\x42\x50\x11\x43\x16\x89\x08\x05\x49\x0c\xfe\xe4\x43\xf4\x5d\x64\x84\x84\x3f\xc9\x7b\xd6\xff\xd6\xd5\x74\xa3\x7b\x00\x00\x00\xff\xff\xa1\x8b\x91\x39\xb1\x00\x00\x00"),
 		},
 		"/src/crypto/x509/x509_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "x509_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 457,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x90\x41\x4b\x85\x50\x10\x85\xd7\xcd\xaf\x18\xee\x4a\x0b\xb4\x4d\x8b\xd6\xd6\x22\x68\x11\x29\xee\x6f\x3a\xca\x4d\xbd\x73\xb9\x33\x46\x12\xfd\xf7\xd0\x1e\x3c\x78\x6f\x23\x2e\x87\x39\xdf\xc7\xe1\xe4\x39\xde\x7d\xcc\x6e\x6c\xf1\x53\x00\x82\x6d\x06\xdb\x13\x7e\x3f\xdc\x3f\x02\xb8\x29\x70\x54\x34\x4a\xa2\xce\xf7\x06\xa0\x9b\x7d\x83\x15\x89\x96\x8b\x28\x4d\x05\x45\x7d\x63\x1e\x13\xc5\xdb\x53\x28\xab\x52\xfc\x81\x1b\xcd\xca\xc1\x85\xc4\x78\x46\xd9\xa2\x18\x99\x55\x4c\x0a\xbf\x57\x96\xf7\xf5\x73\x54\xf1\xca\xb6\x3d\x97\x91\xf5\x2c\x78\x64\x5f\x52\xb0\xd1\x2a\xb5\x4f\x2e\x1e\x96\x3f\xfb\xaf\xda\x1e\xc7\xff\x7b\xd5\x14\x5d\xb7\xec\x70\x5c\xd0\x2f\xdb\xfa\xb2\x17\xfc\x0b\x00\x00\xff\xff\xad\x76\xfa\xa9\xc9\x01\x00\x00"),
 		},
 		"/src/database": &vfsgen۰DirInfo{
 			name:    "database",
-			modTime: time.Date(2021, 3, 28, 16, 13, 10, 506779300, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/database/sql": &vfsgen۰DirInfo{
 			name:    "sql",
-			modTime: time.Date(2021, 3, 28, 16, 13, 10, 514778500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/database/sql/driver": &vfsgen۰DirInfo{
 			name:    "driver",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/database/sql/driver/driver_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "driver_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1185,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x53\x4d\x8f\xd3\x30\x10\x3d\x93\x5f\x31\x9a\x03\x38\x60\x35\xc9\x0a\xad\x44\x24\x2e\xb0\xe2\xba\x1c\x7a\xdb\xf6\xe0\x24\x0e\x32\x18\x3b\xf8\x23\xa5\xaa\xfa\xdf\x91\xe3\x06\xa4\xd6\x6d\xc3\x25\x9e\xcc\x9b\x79\xf3\xe4\x79\x2e\x0a\x78\xd7\x78\x21\x3b\xf8\x6e\xb3\x6c\x60\xed\x0f\xf6\x8d\x43\x67\xc4\xc8\x4d\x96\x8d\xcc\xc0\xc8\xa4\xe7\x9f\xb5\x1a\xb9\x71\xdc\xac\xb9\x75\x16\x3e\xc2\xcb\xf6\x32\x7f\xc8\x5e\x1d\x3e\x69\x2d\x29\xa0\x33\x9e\x23\x85\x70\x50\x40\x3c\xd2\x7f\xd0\xfa\x2a\xf4\xb2\x6d\xf6\x8e\x13\x74\x98\x27\xf1\x98\x4a\x71\x56\x69\xc2\x2a\x99\x15\xca\x3d\xbe\x27\x55\x7a\x86\x17\xca\x55\x8f\xd7\x50\xec\x99\xb4\x41\xfd\x74\x9e\x81\xa7\x5c\x0a\xc2\xf2\x4a\x4f\x99\x4e\x47\x89\x65\x9e\x46\x4f\x1a\x2f\xe1\xb6\x86\xb9\xbf\x06\xec\xb5\x46\x0a\xdc\x98\x1a\xd0\xfe\x92\x45\x5c\x6a\x0d\xad\xf6\xb2\x53\x6f\x1c\xb4\x71\x79\xb0\x09\xa5\x1b\x0c\x53\x35\xb8\xfd\xc0\xa1\xd1\x5a\x26\x28\x1f\x16\xd1\x3d\x24\x89\x9e\x78\xcf\xbc\x74\x5f\x99\x61\x3f\xb9\xe3\xe6\xaf\x73\x28\x28\xbd\x3b\x7d\xf0\x6e\x2d\x79\x3b\xdd\x4d\x4e\x94\x90\x39\x05\x25\xe4\x92\xae\xd7\x4c\xd9\x5d\x08\xe6\x73\x41\xcb\xb9\xaa\xa2\xb8\x55\x2e\xc8\x87\x7c\xde\x5b\x88\x42\x0f\x14\x05\xac\x9f\x9f\x9e\x6b\xf8\x22\x7e\xaf\x6e\x8f\xeb\x49\xb9\x0a\xa6\xeb\xa5\x66\xd3\xee\xa7\xbf\xfb\x32\x1b\x12\x6c\x7a\xe6\xd6\xdb\x52\x1b\x7b\xa8\x8e\xf3\x6b\x9b\xc2\xff\x15\x6b\x09\xb2\xf0\x46\x91\xe1\x12\x8d\x22\x0e\x8c\xbb\xf2\xca\xfa\x61\xd0\xc6\xf1\x2e\x5a\x24\xfa\x68\x25\x2c\x05\x06\x56\x8a\x96\x83\xee\xc3\x4d\x06\xde\x63\xf6\x27\x00\x00\xff\xff\x8d\xf2\x41\x9a\xa1\x04\x00\x00"),
 		},
 		"/src/debug": &vfsgen۰DirInfo{
 			name:    "debug",
-			modTime: time.Date(2021, 3, 28, 16, 13, 10, 556783500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/debug/elf": &vfsgen۰DirInfo{
 			name:    "elf",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/debug/elf/elf_test.go": &vfsgen۰FileInfo{
 			name:    "elf_test.go",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x65\x6c\x66\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x22\x74\x65\x73\x74\x69\x6e\x67\x22\x0a\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x4e\x6f\x53\x65\x63\x74\x69\x6f\x6e\x4f\x76\x65\x72\x6c\x61\x70\x73\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x20\x7b\x0a\x09\x74\x2e\x53\x6b\x69\x70\x28\x22\x6e\x6f\x74\x20\x36\x6c\x22\x29\x0a\x7d\x0a"),
 		},
 		"/src/encoding": &vfsgen۰DirInfo{
 			name:    "encoding",
-			modTime: time.Date(2021, 3, 28, 16, 13, 10, 632781800, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/encoding/gob": &vfsgen۰DirInfo{
 			name:    "gob",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/encoding/gob/gob_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "gob_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 2598,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x56\x51\x6f\xdb\x3e\x0e\x7f\xb6\x3e\x05\x67\xdc\x0a\xa7\xe7\x39\x95\x93\xae\x9d\x81\x3e\xac\x5b\x77\xd8\x43\x3b\x60\x33\x70\xdb\x8a\x62\x70\x6c\x26\xd1\xea\x48\x3e\x49\x6e\x1a\x04\xf9\xee\x07\x4a\x76\x9c\xae\xff\x0d\x2b\xd0\x56\xa4\x7e\xfc\x91\xa2\x48\xca\xe3\x31\xfc\x7b\xd6\x8a\xba\x82\x9f\x86\xb1\xa6\x28\xef\x8b\x05\xc2\x42\xcd\x18\x13\xab\x46\x69\x0b\x11\x0b\xc2\xd9\xc6\xa2\x09\x59\x10\x6a\x9c\xd7\x58\x5a\x5a\x5a\x34\x56\xc8\x45\xc8\x46\x8c\x8d\xc7\x90\x7f\x7a\xff\x29\x83\x1c\x8d\xbd\x92\x55\xae\xae\x64\x05\xea\x01\xb5\x16\x15\x42\x59\x48\x98\x21\x68\x5c\xa9\x07\xac\x40\xc9\x12\xc1\x2e\x11\x66\xed\x02\xd6\xc2\x2e\xe1\xba\xd0\x1a\xe6\x02\xeb\x0a\x84\x81\xb9\x78\xc4\x2a\x61\xf3\x56\x96\x4f\x08\x23\x0b\xc7\x9d\xd7\x24\x1f\xc1\x96\x05\x76\xd3\x20\xe4\x29\x18\xab\xdb\xd2\x92\x26\xc8\x49\x10\x72\xc1\x82\x5d\xbf\x3f\x39\xdc\xff\x0a\xf3\x5a\x15\xf6\xf5\x94\x05\xc1\x77\x38\x16\xd2\x1e\x20\xf9\x21\xf2\x6d\x0c\x97\x31\xbc\x03\x70\x98\xe0\x1a\xba\x9f\x55\xd1\xdc\x7a\x1f\x77\xc7\x03\xd7\x75\x7a\xb0\x2d\xa4\xbd\xcb\x27\xa4\xf5\xc0\x27\x46\x7d\x7c\xc1\xb5\x90\xb6\xb1\x7a\x30\x39\xee\x3c\x95\x6a\xd5\xf4\x54\xb4\xae\xf1\x91\xa7\xe7\x77\xc3\x92\x40\x94\xb2\x1e\x74\x9b\x76\xac\x77\xb7\xe9\x61\x50\x57\xab\xc6\x6e\xae\x8b\xe6\xd0\xbd\x90\x16\xc6\x63\xb0\x0a\xca\x25\x96\xf7\x60\x97\x85\x85\x35\xdd\x4e\x89\xe2\x01\xa1\x00\xa9\xe4\x2b\x29\x6a\x32\x4a\x58\x10\xdc\xf4\x07\x3f\xbe\x9d\xdc\x0d\xdc\x5f\xac\x36\x9d\x3a\x1d\xce\xf4\x51\xda\xd7\x53\xe3\xb4\xe4\xc9\x21\x3f\x7f\xec\x08\xba\x03\x78\xf3\x9e\x75\x6f\xfa\xad\xd7\xdc\xde\x51\xbd\xb9\xbb\xec\x3d\xe7\xa9\xbb\xa5\x46\x40\x76\x01\x93\x84\x4f\xf9\xe9\x1b\x16\x20\x49\x69\x72\xc6\xcf\x29\x25\x76\xad\xbc\x7c\xc2\x82\x15\x16\x92\xf2\x9e\x5d\xc0\x34\x65\xc1\x5c\xc8\x05\x6a\x43\xe2\x29\x0b\x0c\xa7\x45\xe8\x1d\xf3\x90\x05\x26\x3d\x50\xa4\x21\x0b\x1e\x0a\xed\x82\xe5\x30\xe4\x1c\x2e\x7a\x21\xe2\xc9\x49\x0c\x3c\x39\x19\x0d\xc8\xf4\xaf\x90\x85\xd6\x1c\x0e\xd2\x45\xf2\xed\xc9\x1d\x5c\x80\xe1\x9d\xc4\x9d\x94\xee\xf1\xe9\x2f\xf8\xb4\xc3\xa7\x9d\xc4\x7b\x6b\xc2\xbb\xdb\x79\xdb\x39\x19\xea\x60\xaf\xf6\xb6\x47\x8d\x38\xd4\x39\x86\x23\x7c\xca\x90\xfe\x33\x43\xe7\x9d\xd0\x83\xca\x13\xd8\xb5\x62\x81\x75\xa9\x3d\xca\xb9\x6b\xa0\xac\xbb\x3e\x7e\x16\xb3\x20\xb8\xdc\x8b\xe7\x24\xbe\xeb\xc5\x57\xa7\x24\x5e\x67\xbf\x6f\xaf\x6d\xd8\x88\x30\xa3\xb8\x63\x08\x91\x56\xb8\x73\x36\x69\xf6\x6b\xcf\x6d\xa7\x19\xe4\x93\xed\xd7\x0c\x08\xfc\x3d\x83\xa3\xae\x14\x76\x31\xf0\x93\x7e\x0f\xfd\x56\x57\x16\x3b\x4f\xe6\x9d\x66\xcf\x5b\xb5\x73\x1f\x52\xdd\x85\x5d\x04\x21\x95\x5d\xe8\x0d\x7d\x1b\x67\x4f\xda\x78\xdb\xb9\x1d\xbc\xc4\xd0\x2d\x0e\x63\xea\xbb\x3d\xfb\x53\xb7\x6f\x5d\x29\x66\xbe\xce\x62\xff\xcf\x4b\xdc\x31\xec\xa7\xef\x07\xf1\x08\x76\x29\x0c\x34\x5a\xcd\x6a\x5c\x65\x7e\x33\xc8\x37\x0d\x5e\x69\xad\x74\x06\x95\xb1\xc9\xbf\x0c\x5a\x9a\xb3\x52\x59\x28\x80\xc6\xac\x15\x4a\x76\x58\x4a\x67\x61\x81\xe6\x61\xb5\xc2\x15\x4d\x6c\x88\xc6\x0b\x61\x97\xed\x2c\x29\xd5\x6a\xbc\x50\xcd\x12\xf5\x4f\x33\x2c\xba\x47\x21\x59\xa8\x6c\x7a\x7e\x96\x4d\x46\x8e\x8a\x06\x54\xf6\xe7\x09\xb5\xa5\x8a\xcf\x86\xaa\x8d\x5d\xc1\x0f\x8a\xd4\x1d\xaf\x1f\x62\x94\xe0\x7b\x8c\x9e\x8e\xb2\x11\x21\x6e\xfa\xda\x81\xa3\x61\x44\x6d\x79\x72\x1a\x43\x4a\x7f\x26\xc9\xa9\x63\xa2\x91\x95\x75\xb8\x3e\x9e\xad\xe1\x31\x18\xef\xc9\x0f\xaf\xcc\xed\xfb\xe9\xb5\x3d\x3b\x8b\xe1\xfc\x4d\x0c\x3c\x9d\x4c\xe9\x37\xe5\x93\xa9\xc3\x7e\xfe\x38\x54\x37\xbc\x82\x74\x22\x9c\x87\x7d\x24\xe1\x8d\x5a\x53\x92\xe9\x9d\xb3\x62\x85\x21\x6d\x7f\xcb\x9e\xce\xb8\x28\x5c\x62\x5d\xab\x18\x4c\x21\x6a\xa5\x43\x77\x9a\x7c\x38\x4d\x9e\x6e\x43\x77\xa1\xc2\x40\x9e\xba\x72\xdb\xb1\x60\x46\x3d\x26\x71\x1d\xb9\x67\x39\xb9\x6c\xe7\x73\xd4\x23\x16\xa0\xd6\xb4\x73\x83\xeb\x2b\x59\xaa\x0a\x75\x34\x1b\x25\x7e\x19\x59\x3e\x62\x81\x98\x03\x61\x5e\x5c\x00\x8d\x77\x6a\x51\x9b\xb8\xba\x88\x42\x74\xb0\x2c\x8c\x09\x31\x72\x6e\x68\x1c\xfc\xb0\x1c\x72\xee\xa9\x1d\xf3\x7b\xdc\x33\xfb\x65\x74\xf4\xe3\xb7\xdc\x1f\x0a\x5b\xd4\x51\x58\xe1\x33\x6e\x31\x87\x17\x7d\xd9\xbc\x47\x6c\xae\xfe\xd7\x16\x75\x64\x79\x0c\x8e\xee\x30\xb6\x79\x1f\x1c\xe0\x63\x83\xa5\xc5\x0a\x5e\x3e\xc0\x42\x59\x78\xf9\x10\xc6\x70\x4c\x46\x3e\x84\x1d\xa3\x0a\xbe\x44\x28\x66\x46\xd5\xad\xc5\x7a\x03\xa6\xd5\xfe\x5b\xa3\x7b\xde\x2a\xaa\x46\x5f\xfc\xee\x91\x4b\x5c\x2c\x96\x27\xfb\xa7\xf2\xe2\x59\x76\xe6\x51\xd8\x3d\x87\x60\x50\xda\x70\x7f\x84\x1f\x7f\x6d\xd7\x7b\xf7\xb6\x3b\x36\x7c\xdc\x50\x6f\x7e\x2e\x4a\x7c\xfe\x71\x33\x1e\x83\x3b\xb8\x90\x8b\xf1\x42\xcd\xa0\x6c\xb5\x46\x69\xeb\x0d\xb4\x06\xe9\x00\x66\x23\xcb\x04\x72\xaa\x0f\xb2\xf4\x6a\xa7\xfc\x6f\x21\xec\x7f\xb4\x6a\x1b\x28\x64\xe5\x98\xca\x42\x52\xbb\x9b\xb6\x2c\x11\x2b\x58\x2f\x51\x76\x0c\x94\x8c\xd6\xd0\x07\x57\x60\x93\x2f\xf7\xa2\x89\xc2\xd6\xd0\xdb\xe9\xb7\xc3\x11\xdb\xb1\xff\x07\x00\x00\xff\xff\x9b\x7c\x41\xd0\x26\x0a\x00\x00"),
 		},
 		"/src/encoding/json": &vfsgen۰DirInfo{
 			name:    "json",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 17, 44, 23, 451572676, time.UTC),
+		},
+		"/src/encoding/json/json.go": &vfsgen۰CompressedFileInfo{
+			name:             "json.go",
+			modTime:          time.Date(2026, 10, 15, 17, 43, 41, 195570164, time.UTC),
+			uncompressedSize: 11933,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x5a\xdd\x77\xdb\xb6\x92\x7f\x96\xfe\x8a\x89\x1e\x1c\xb2\x61\x68\x37\xe9\xcd\x49\x9c\xb8\x7b\x72\x53\xe7\xae\xdb\x9b\x8f\x73\x9d\xdc\x3d\x67\x15\x6f\x03\x91\x43\x0b\x16\x05\xa8\x04\x48\x5b\x75\xfc\xbf\xef\x99\x01\xf8\x29\xc9\x4e\xd3\xed\xe6\x21\x16\x09\x60\x30\x33\x98\xf9\xcd\x07\xb8\xbf\x0f\x0f\x66\xa5\xcc\x53\xb8\x30\xe3\xf1\x4a\x24\x0b\x71\x8e\x70\x61\xb4\x1a\x8f\xe5\x72\xa5\x0b\x0b\xc1\x78\x34\x99\xad\x2d\x9a\xc9\x78\x34\x59\x0a\x3b\xa7\xbf\x05\x66\x39\x26\x96\x7e\x1a\x5d\xf0\xdf\x52\xc9\x44\xa7\xb8\x5f\xda\xec\xe9\x64\x3c\x1e\x4d\xce\xa5\x9d\x97\xb3\x38\xd1\xcb\xfd\x73\xbd\x9a\x63\x71\x61\xda\x1f\x17\x66\x32\x0e\xc7\xe3\xfd\x7d\x78\x23\x0a\x33\x17\x39\x08\x95\xc2\x47\xb5\xf4\x4f\xe7\x1a\xec\xbc\xd0\xe5\xf9\x1c\x7e\x3e\x7d\xf7\x36\x36\xb6\x90\xea\x5c\x66\x6b\x9e\xc7\xaf\x56\xa2\x30\x08\x99\x2e\xc0\xce\x11\x52\x61\x05\x91\x33\x73\xb1\x42\x03\x97\x73\x6d\x10\x50\x25\x3a\x95\xea\x1c\xa4\xe1\x49\x46\x2c\x11\x2e\xa5\x9d\xf3\x93\x12\x56\x56\x08\x59\xa9\x12\x2b\xb5\x32\x11\x5c\xce\x65\x32\x07\x51\x20\x2c\xcb\x64\x4e\xe4\x32\x61\x2c\xd2\x0e\x42\x39\x0a\x89\x50\x0a\x0b\xe6\x82\xa9\x63\x01\x45\xa9\x14\x6d\xa2\x15\xe0\xb2\xcc\x85\xc5\x14\x48\x63\x60\x72\x99\xa0\x89\xe1\xa5\x5a\xdb\xb9\x54\xe7\x44\x10\x73\x83\x11\xe4\x72\x81\x50\x89\xbc\x24\x56\x89\x9f\xa4\x34\x56\x2f\xc1\x8b\x8f\x85\x89\x20\xcb\xb5\xb0\x8f\x1f\xf1\x56\x52\x59\x3c\xc7\xc2\xc0\x0c\xd7\x5a\xa5\xf0\xe8\x7f\xfe\xf6\x18\x74\x41\x04\xa5\xaa\x44\x2e\x53\xf8\xf8\xe1\xf5\xc3\xa7\x11\x64\x22\xcf\x0d\xcc\x44\xb2\x00\xab\x99\xe5\x9a\x4d\xa2\xc3\x9a\x42\x7e\x8e\xc7\x63\x92\xbc\xd6\x7f\x50\xf1\x26\x45\x26\x12\xbc\xbe\x09\x21\x98\x9e\x91\x0c\x11\x60\x51\xe8\x22\x84\xeb\xf1\x48\x66\x30\x8b\x40\x2f\xe0\xf0\xa8\x66\xf4\x2d\xab\x30\xa8\xc2\xe7\xf4\xfe\x7a\x3c\x1a\x15\x68\xcb\x42\xd1\x44\x25\xf3\xf1\xe8\x66\x3c\x1e\x21\x2d\x50\x78\x79\xcc\x8c\x9c\x5a\x61\x31\x08\xe9\x7d\x51\xd0\x08\xc6\xcb\x9a\x85\x88\x98\x7d\xb7\xb2\xe6\x1a\x4d\x22\x56\xf8\x9f\x1f\xde\xfc\xf3\x10\x6c\x51\xe2\x4d\xc8\xfb\xd3\x92\x7b\x47\x44\xba\xbb\x99\x92\x39\xf3\x49\xdb\x8d\x66\x65\x46\x54\xc5\x6a\x85\x2a\xf5\x52\x04\x4a\xe6\x61\x04\x18\xff\x9d\x0c\x39\x08\xe3\x38\x66\x06\x5a\x86\xde\x6b\x9d\xc7\xef\x4b\x1b\x20\x0d\xd4\x42\x94\x99\x13\xe3\xc6\xeb\xaa\xb1\xcf\x80\xcc\x0d\x6a\x15\x0d\x54\xc7\x1a\xf3\x0a\x2b\x55\x5f\x53\xb4\x2e\x82\x2a\x1c\xb0\xef\x14\xb5\xbf\x0f\xaf\xe6\x98\x2c\xd8\xa8\x2f\x31\xcf\x1f\x66\xba\x58\x62\xaa\xd0\x98\x98\x87\x5f\x56\x5a\xa6\x06\x32\x99\xe7\x6c\x71\xa5\x85\xb9\xc8\x33\x10\x6c\xff\x60\x6c\x51\x26\xb6\x2c\x90\x27\xcf\x30\xd3\x05\x42\x2a\x4d\xa2\x2b\x24\xff\x01\xc1\xae\x03\x66\xad\xac\xb8\x72\x8c\xc6\xe3\x51\x25\x0a\x48\xbd\x5d\xb0\x36\x9a\xb3\x49\x88\x9d\x7f\x93\x7d\x79\xce\xf7\xd2\x98\x5c\xe0\xb6\xd3\xf0\x07\x31\x1e\xa5\xb1\x54\xd2\xf2\xc2\xb0\xd1\x69\x1a\x37\x2a\x09\xaa\x90\x34\x4b\xbb\x07\xe3\x91\xf3\x46\x66\xaf\xfd\x77\x04\x17\x26\xfe\x47\xae\x67\x22\x8f\xff\x81\x36\x98\xd0\xf8\x24\xac\x67\xbf\x9b\x5d\x60\x62\x3f\xe8\x53\x46\x87\xcd\xd9\x6e\x7c\x12\xba\xa7\x55\xa1\xad\xb6\xeb\x15\xd6\x2f\xac\x5f\x38\x09\x9d\xf2\x1d\xd1\x63\x36\x3d\x03\x4b\x61\x93\x39\x3a\xe0\x70\xe6\x08\x06\x7f\x2b\x51\x25\x68\xd8\x99\x92\xb9\x28\x44\x62\xc9\x2d\xed\x5c\x58\x26\x31\x00\xab\xcb\x42\x5a\x34\x90\xca\x2c\xc3\x02\x95\xcd\xd7\x90\x15\x7a\x09\x1d\xcb\xf3\xb3\xe3\x5a\xa6\x7a\xfb\x0d\x61\xfe\x85\xe7\xc7\x57\xab\x49\x18\xbf\xc5\xcb\xe0\xf3\xa7\x4f\xf1\x97\xe9\x8b\x1f\xf7\x3e\x95\x8f\x0e\x1e\x3d\xe5\xff\x9f\x9d\x7d\x8e\x60\x32\x90\xe6\xa3\x32\x22\xc3\x13\xb5\x2a\x6d\x23\x91\xe4\x27\xe2\xb9\x8b\xa4\xee\xfc\x37\x99\x65\x62\x1d\xe0\x38\xf4\xda\x48\xc1\x94\x45\xa1\xcf\x85\xc5\x06\x3a\x97\x62\x0d\x33\x84\x52\xad\x84\x2c\x30\x8d\x58\x4f\xaa\x5c\xce\x7a\x4a\xf2\xb3\xc8\x7a\x75\xc6\xa4\x19\xeb\x9e\xfc\x00\x85\x50\xe7\xd8\xa8\xa2\xcb\xfb\x9d\xea\x28\xa7\xe9\x4f\x67\xd3\xa7\xcf\xc4\xc3\xec\xe5\xc3\xd7\x67\x5f\xa6\x9f\xd2\xf8\x6c\x8a\xc7\x67\xd3\x87\x0f\xce\xfe\xe3\x53\x7a\xfd\xf8\xe6\x0b\xfd\x7f\xf0\xec\xe6\x33\x69\xa8\x31\x43\x2c\x3e\xac\x57\xe8\xac\xcd\xc7\xb5\x98\xde\xbc\xcb\x82\xe0\xbb\x8f\xed\xac\x90\x51\x24\x8c\x8f\x73\x5c\x06\xe1\x78\x84\xcb\x95\x5d\x9f\xd4\x8e\xcf\x34\xb6\x10\xe8\x22\x43\x9f\x80\x0b\x7f\x4b\x71\xe5\xa0\xe1\x27\x5c\xd9\x39\xcc\x74\xa9\x52\x67\x73\x0a\x8d\x65\x27\xcf\xea\x48\xe1\xac\x86\x82\x4b\x1f\x7f\x23\x30\xda\x69\x77\x7f\x1f\x92\x75\x92\x93\x81\x16\x08\x39\x66\x76\x10\x05\xea\x83\x2a\x90\x82\x3b\x6f\xb4\x8c\xc7\x89\x56\xc6\x0e\x59\x39\x02\x63\x45\x61\x7f\x42\x8b\x09\x31\xf2\x8a\x09\xbf\xcc\x2c\x16\x1e\x0e\x07\x41\x60\x47\x00\x99\x69\x9d\x33\xde\xe9\x3a\x78\xb8\xd3\xfd\x37\x09\x45\x98\x7f\xe0\xd0\xe4\x5e\x3f\x82\x30\xa8\x67\x22\x37\xc8\xb0\x6e\xda\x85\x6c\xb3\xaf\x44\x9e\x07\x93\xc6\xd3\x26\x11\xe8\xd0\xbf\x2c\x70\x95\x8b\x04\x27\x51\xdf\xa1\xfb\x8f\x61\xec\x7c\x3f\x68\xa1\xc9\xc7\x0a\x13\x46\x1c\x72\x1a\xd4\xef\x2e\x0b\x0c\xb8\x3d\x43\xff\x97\x58\x36\x97\xd2\x26\x73\x30\xf4\x3b\x11\x06\xe1\xf3\xa7\xd9\xe7\xc3\x56\x94\xcf\x9f\xca\x83\x83\x83\xa7\x9f\x9b\xd1\x6c\x73\x34\xa9\x47\x27\x2f\x26\xc3\xc1\xc7\xed\xe0\x8f\x9b\x83\xd8\x0c\xee\x6d\x0c\x3e\x7a\xd2\x0c\x3a\xa0\x18\xcc\xa0\x57\xfd\x19\xcf\x36\x67\x3c\xfb\xcc\x27\xe0\xdf\x19\xd2\x4b\x03\x2f\x7c\x88\xe0\x86\x0c\x08\x67\xa9\x0e\x5a\xf0\xca\x62\xa1\x44\x2e\x7f\x27\x10\x75\x56\xf8\xb3\xa8\xc4\x69\x52\xc8\x95\xf5\x33\x29\xd2\x55\x11\xd1\xd3\x85\x3b\x6d\x90\x19\x54\x90\x08\x75\xdf\x12\x48\xd4\x36\xcf\x59\x52\x1f\x5d\x63\xf8\x30\x47\x48\xf4\x72\xa9\x15\xa4\x6b\x25\x96\x32\x01\xc2\x77\x43\xf4\xc8\x01\xe6\x42\xa5\xb9\x5f\x4c\x68\xe3\xbd\x53\x6a\x15\x77\x8f\xd6\x5b\x62\xd7\x7e\x23\x48\xd9\x09\xa4\xb2\x21\x04\xbd\x81\xc6\x9e\x65\xe6\x27\xfd\x38\x74\x9d\x5b\xcc\xd8\x99\x4a\x45\xd6\x5c\xc5\x01\x71\x1b\x36\x66\xa3\x64\x7e\x38\x58\xc9\x86\xe8\x46\x9d\xdc\x9d\x09\x55\x04\x94\x6c\xc7\x1c\x9f\xbd\x35\x57\xa1\x9f\xed\x11\xb5\x3f\xdd\x89\xfb\x9a\x86\xda\x99\x24\x4f\x7f\x5a\x67\xd3\xa5\x58\x4d\xdd\xc6\x67\x1d\x25\xd0\x74\x3e\xa6\xa3\x36\xfa\x6f\x61\x9b\x04\x1e\x2d\x70\x6d\x5c\xc6\xb8\xc0\x60\x7a\xe6\x88\x45\x70\x10\x41\x8e\x2a\xa8\xc2\x70\x3c\x1a\x91\x15\x30\x32\x30\xfe\x43\xe5\x08\x12\x26\x38\x8e\x7f\xc1\x75\xb0\x70\x39\xd3\x56\xc5\xba\x9d\xdc\x56\x4d\xea\x47\x4f\x11\x2c\x42\xcf\x07\xd5\x29\xde\xe7\x0d\x8f\xd1\x80\xa6\x4d\x77\x25\x0d\x0e\x4d\x92\x02\x85\x65\x30\x91\x79\xcd\xeb\xaf\x51\x97\x5d\xde\x96\x79\xc3\xad\x08\x37\x5d\x9c\x79\x73\x7a\xf0\x7d\x58\x0b\xe6\xc1\xee\x36\x71\x74\x7c\x8a\x36\x58\x44\x80\xb5\x0c\x7e\xae\xee\x1d\xd1\xf4\x5b\x4f\x46\x6c\x11\xfe\x65\x51\x88\xb5\x0f\xaa\xfd\xf3\x91\x11\x5c\x6d\x1c\xd1\x56\x81\xaf\xbe\x49\x5a\x41\xd2\x9e\xa8\x14\xaf\x02\xb9\x29\xb2\xa8\x39\x6f\x81\xc8\x6d\xf9\x2f\xe7\xd2\x6e\xe7\x3a\xfa\xf2\xd3\xbb\x2c\xa8\x42\xcf\x4a\x38\x00\xf3\xde\xaa\x0a\x7a\xeb\xfe\x2a\xd7\xb7\xce\xe7\x29\x33\x08\x5c\xc4\xb3\x7c\x4a\x9c\x1d\x71\xfa\xb0\x7b\x2d\x4d\x8e\x4f\x96\xab\x1c\x97\xa8\xac\x09\x7a\xa9\x4b\x08\x5f\xbe\xf4\x87\x2d\x5e\xd9\x37\xfd\x29\x77\xd0\xfe\x45\xaa\x34\x08\x29\x9b\xaf\x75\xf1\xde\x16\xb0\xb7\x07\x41\xe7\xf9\x83\x0e\x6c\x78\x3b\x1b\xb7\xcd\xde\xe4\xea\x16\xb6\x1a\xa8\x6c\x78\xab\x51\xb2\xde\xe2\xef\x03\xdc\xe2\x17\x41\xd8\xf3\x8d\x7a\xee\x89\xb2\x51\xf7\xe1\x69\xef\xe9\xfb\x27\xbd\xc7\xc7\x8f\x7a\x8f\x0e\x44\xa5\x3b\xbd\x13\x65\xe9\xf0\xea\x4d\x3d\xca\x06\x32\x8c\xe0\xe1\xf7\x2f\x5e\xfc\xed\x31\xbc\x38\x02\x49\x8a\x93\xf4\x8b\x5f\x0d\x78\xf9\x28\xbb\xcc\xd0\xd3\xd3\xfe\x63\x97\x1d\x7a\xee\xf2\x43\xcf\x4f\x7e\xe8\x3f\xaf\x6c\xd1\xe1\x90\xde\xec\x62\x71\x27\x4f\xaf\xdb\x68\x91\x39\x32\x2e\x48\x74\xe9\xf4\xc3\x47\x16\x0e\x48\x9c\x36\xe1\xc9\x38\x0a\x6d\x7e\xd5\xa4\x0e\x5b\x02\x96\x09\x37\x8f\xca\x39\x5c\x0d\x65\xf1\x89\x79\x2b\xf3\x20\xbc\x15\xcb\xa8\x1a\x75\xdb\xba\xec\xfa\x39\x60\xfc\x4a\xa8\x86\xd8\x70\x79\x07\xae\x30\xee\xcc\xea\x41\x57\x07\x7f\xb6\xc1\x86\xdf\xaa\xbb\xa4\x27\xc9\x7b\x7f\x2e\x5f\x2b\xc3\x9f\xd8\xea\x94\x0b\xff\xc3\x6f\x8a\x68\x99\xc4\x3c\x35\x87\x6d\x68\xcb\x5a\x98\x4f\x44\x32\xc7\x94\x7c\xf5\x35\xcf\x22\x8f\xce\xa5\xb1\x4d\x80\xce\xe2\xdf\x4a\x6d\x31\x25\xdf\xef\x04\xeb\x2c\x56\x62\x89\x77\x47\xec\xcc\x25\x42\xfc\xd3\x6d\x2e\xdb\xcd\xb3\x58\x52\x38\xf0\x44\x68\xb3\xaa\xc6\x82\xa3\x3e\x4e\xb9\x19\x7e\x4a\x4f\xd5\xa3\xd1\x28\xd1\xca\x4a\x45\xb9\x26\x8b\xe0\xde\xde\xb8\x3f\x59\x05\x47\xb4\xa6\xae\xe9\x9a\x91\x7a\x80\xc5\x0e\x64\xd8\x70\xcc\x32\xeb\xa5\xb4\xc7\x54\xfe\xb1\xa3\x1b\xfe\xe9\x4e\x2a\xab\xea\x8d\xeb\x6d\x9b\x95\x83\x58\xd9\x3b\xe0\xac\xfa\x13\x19\x82\x53\xf6\x5d\x69\x42\xad\xaf\x37\x62\xe5\xad\xd2\xc6\x74\x54\xe1\x16\xec\x3f\x6d\x4a\x9b\xed\xbb\xdf\xfc\x11\xb3\xae\x13\xc0\x8a\xb6\xfe\x05\xd7\x86\x15\xed\xd2\xb1\x5c\x26\xe8\x13\x35\x8a\xce\x14\xf9\x2f\x5c\xe0\xa5\x50\x0b\xd7\xbe\xb2\xe0\x14\x6b\x2a\xcf\x1a\x4c\x81\x17\xee\xd5\x45\xe7\xd5\xcd\x5f\x90\xd0\x0d\x52\xd0\x66\xb3\xbb\x2d\xfb\xb6\xd3\x66\x4d\xb8\x54\x67\x11\xfe\x99\xd4\xb0\xe1\xe7\x6b\x0f\x9f\x15\xde\x46\x0f\xce\xf6\x1a\x6b\x70\x4e\xb0\xc5\xc5\x38\x46\x91\xa9\xdb\x2d\x83\x4c\x72\x87\xa9\xc0\xfe\x3e\x1c\x77\x0b\xb8\x99\x30\xf8\xe4\x87\xb8\x35\xa1\xdd\x14\xf7\xf6\xbe\xd2\xbe\xee\x4a\x63\xab\xf8\x9f\xa8\x82\x36\x8f\xa5\xe9\x07\xcf\x29\x16\x82\x1f\x7a\x0e\xf2\xc1\x83\xad\x09\xed\xe0\xd8\x7c\x7a\x1a\xfe\x3f\x25\xb8\x2d\x8d\x6e\xc9\xcd\xf1\xb7\x69\xe0\x5c\xce\xd1\xce\xb1\x18\x36\x1e\x33\x5d\x2c\x85\x35\x90\xb9\xab\x06\x4e\x02\x8e\x7d\x0b\x88\x48\xb9\x2e\x90\xbf\x16\x71\x05\x23\x15\xe0\x52\x49\x8b\x4d\xc3\x0e\xaf\x12\x5c\x59\x50\x78\xee\x6e\x4a\x7e\xc7\x42\xf7\x0a\x67\x9f\x0a\xd4\x39\x46\xed\xb5\x8d\x04\xf7\x96\xc2\xce\xe3\x13\x73\xa2\xb2\x20\x8b\xe0\x20\xa4\x53\xad\x5f\xbe\x15\x6f\x83\x8c\xdf\x04\x19\x81\xcf\x01\xc7\x10\x1e\x3c\x95\xe7\x6a\x26\x29\xc9\x08\x7b\xa2\xff\x82\xeb\x0d\xc1\x17\x90\x08\x05\x33\x04\xa1\x40\xb3\xa7\x93\xff\xb2\x38\xc3\x76\xc1\x89\xbb\x45\x21\x7a\xec\xe2\xa2\x40\x92\x31\x2f\x53\x4c\x23\x98\x61\x22\x4a\xd3\xeb\x51\x38\x7a\x06\x38\xe4\xd9\x39\x2e\x21\x93\x85\xb1\x3d\x1d\x30\x30\x34\x4d\xa1\x5a\x01\x64\x13\x1b\xb9\xce\xa2\x97\xef\xb6\x19\xf8\xd0\x2c\xa9\xf0\x5a\x74\xac\x52\x66\xb0\x98\xca\x33\x78\x01\xf7\x0f\xee\x93\x96\xf8\xe9\x47\xb8\xff\xec\x7e\xcf\x33\x5a\xa7\x68\x8d\x68\x41\x9e\x35\x99\x78\x35\x0e\xae\x23\xda\x9e\xaf\xb0\x82\x70\x57\x43\xd5\xe9\xb4\x70\x5f\x38\x86\x13\xdb\x34\x78\x9c\x57\xfb\x76\x0a\xb7\x2f\x75\x2a\xb3\x35\x05\x8b\x0a\xa4\xeb\xe5\x16\x68\xca\xdc\x42\xa2\xcb\x3c\xf5\xcd\x64\xd7\xf4\xee\xf4\x90\xef\x37\x1d\x63\xa9\x58\xff\xae\x65\x93\xe7\xee\x4e\xc2\x78\x0d\x6f\xbb\x3d\xd9\x75\xeb\xd2\x98\x1e\xa7\x14\x9b\x95\x20\x9f\x49\x51\xed\xa8\x74\xa8\x74\xe9\xc3\xcd\xc6\x29\x15\x94\x12\x14\x6d\xae\xc0\xf5\x5c\xd1\x2b\xe8\x7c\xac\xf8\x89\x84\x14\xb3\x1c\x03\xbb\x83\xd6\x5d\xb5\xcd\x30\x09\xb6\xf1\xdb\x72\xf9\x06\xed\x5c\x7b\xde\x9d\xb3\x74\x58\xde\xdb\x6b\x79\xbb\x2d\x4b\xea\xb3\x42\xbc\x7c\x6b\x76\xb0\x59\xed\xb9\x15\x1b\x35\xdf\x47\xb5\xad\x5c\x75\xac\x12\xdd\x2d\x8d\xf4\xaf\x61\x95\xc3\x84\x67\xb6\xab\x89\xff\x23\xea\x75\x46\x9d\x62\x26\xca\xdc\x1e\x6e\x39\x46\x3e\x73\x72\x56\xbe\xe2\xf2\xbd\x00\xd7\xb5\x77\xcd\x80\xbd\x3d\xbe\x08\x36\xf1\x2b\x5d\x2a\xeb\xaf\xd0\x7c\x9b\x79\x32\x9d\x84\xe1\x83\xdd\xc3\xd7\x93\x70\x1b\xc9\xa1\x39\x51\x88\xfd\xd0\xb9\x98\x6e\x1a\xfb\x5a\x43\x8a\xb8\xaa\xaf\x11\xe2\xb6\x8b\xee\xb0\xaa\xbe\x97\x93\xd9\xe6\x4d\x91\xcf\x94\x2c\x1a\x3b\x89\xc0\x84\xbe\xb4\xde\x6e\xcb\x4d\x5b\x9f\xe1\xc2\x7b\xaa\xd9\xda\xd3\xef\x6b\x6e\x6b\xec\x67\xb5\x77\xd7\x0c\x30\xc0\x4d\x08\x74\x04\x05\xf9\xf4\xcd\x78\xc4\x5d\x29\xdd\x2d\xe2\x98\xfa\x55\xb7\x05\x56\x54\x9c\x30\xd5\xbb\xfc\x37\x16\x3a\xb0\x61\xa7\x34\x6d\x62\xef\x1f\xf2\xcc\x01\xd9\x1a\x6c\xae\xc2\x70\xbb\x5b\x2d\x6b\x55\x5d\xc5\xc1\xf6\xce\x6a\x38\xee\xe7\x13\x9b\x56\xea\x61\xac\x9f\x19\xf5\xf9\x78\x23\x16\xf8\x46\xac\xfe\x4b\xda\xf9\xa9\xfc\x1d\x03\xeb\x7a\xad\xcb\x30\xac\x93\x0e\x6e\xb8\x46\xae\x64\x76\x39\xef\xd2\xe7\x3f\x3d\xf4\x74\x7a\xf2\xce\x54\xe7\x3a\xd8\xbd\xf3\xe5\x15\x9b\x70\x8b\x6d\xc1\xe4\x78\x6b\x12\xde\xe1\xcc\x45\x18\xbf\xd2\xaa\xc2\xc2\x36\xf8\x11\x01\x56\xe1\x6d\x0e\x2f\x3a\x6a\x9c\xfe\x41\xed\xed\x38\x32\xd1\x61\x23\x0c\xbb\x01\xb4\xbe\x0a\xda\x8c\x9e\xde\x56\xeb\x18\x3a\xb8\xdf\xf0\xb9\x88\x76\x61\x95\xbf\x1d\x71\xf3\xab\x08\x64\x56\x87\x3b\xbe\xfc\xe4\xba\x14\xa4\x35\x2e\x2d\x29\x90\x42\xa6\xd5\x9c\x9f\x50\x58\x43\xa1\x4c\xe4\xdd\xb6\xfe\x30\xe4\xc9\x0f\xa0\x0b\x07\x6e\xee\x2b\x10\xaf\x04\x77\xcd\x5a\xe7\x44\x06\x6d\x73\xf9\x21\xf2\x5c\x27\x82\xaf\x14\x85\xff\x20\x65\x7b\x98\xad\x5d\x0c\xbe\xbb\x30\xb1\x2b\x9e\x28\xda\xf6\x54\xd6\xc6\x5b\xef\x30\xdb\x2e\xe4\xeb\x82\x4b\xe4\xb9\xbb\x9f\x6b\x6a\xb5\xda\xab\x26\x53\xaf\xa6\xb7\x65\x9e\x9f\x75\xaf\x9e\x3a\xc5\x4b\x33\xc9\x51\xe7\x69\xb7\xa2\x72\xf3\x1d\x45\xad\x61\x56\x2b\xa7\x6e\xcd\x55\x29\xa5\x0f\x06\x94\xb6\x2e\x85\x91\x98\xd6\xf9\x8b\x4f\x51\x60\x2e\x08\x45\xfd\x97\x14\x50\x1a\x4c\xe3\xf1\xc8\x52\xfc\x10\xc6\xc8\x73\x45\x01\xae\x3e\x53\xb2\xb6\x81\x86\xc6\xa3\xd1\x55\x37\x43\x71\x99\x9e\x3b\xea\xc3\xa3\xcd\xce\x4a\x9d\x48\x84\xe3\xa6\x62\xbe\xbd\x8e\xa5\x59\xac\xd6\xf1\xa8\x65\xa8\x7b\xd5\xd2\xbe\xe5\xeb\x16\x9a\x4f\xf5\xce\xb9\x9d\xf3\x2e\xc3\xac\xb3\x37\xde\x26\x9f\x94\x4c\x1f\x1e\xb9\xd1\xba\xfe\xe9\xf6\xf6\xb2\xb6\x5a\x72\xcd\x12\x27\x23\xef\xe7\xfd\x31\xeb\xdd\x46\x74\x1a\x23\x1e\xce\xb6\x34\x91\xda\x14\x2a\x8b\xed\x7a\x15\xee\xf4\xe7\xb6\x83\x74\x47\x03\xe9\xee\xfe\xd1\x80\x7a\xd3\xa1\x1a\x36\x82\x3c\xd7\xf7\xb2\x2a\x7e\x25\x14\xa1\xc9\x6e\xf6\xd0\x05\x27\x3a\x3f\xd2\x47\x3f\x4a\xd5\xbe\xd3\xf2\x45\x64\xb6\x36\xb9\x69\xc3\x5f\x6b\xd8\xc3\x38\xe0\x9b\x88\xe7\x8c\x74\x7b\x7b\x43\x48\xde\x26\xc8\x8e\x5e\xed\x90\xae\xaf\x63\xbe\x99\x72\xa7\x91\x3c\x24\x5d\x17\x89\xdf\x4c\xbb\x17\x7a\xfd\x79\x6e\x4d\x8b\xb3\x5e\x5a\x9c\x7d\x4d\x5a\xbc\x75\xf7\x0e\xc8\x6c\x3b\xdb\xae\xd7\x35\xb7\x8e\x9d\x97\x51\x07\x28\xae\xb3\x2a\x02\xfe\x8a\x8d\x00\xca\x9b\xaa\x68\x4d\xb5\x4b\xeb\xba\xb5\x8c\xc6\x1e\x44\xbc\xcd\x76\x1b\x8d\x90\x9c\x22\xae\x53\x9e\x43\xd7\x6e\xd8\x92\xf3\xd0\x4b\x0f\x33\x61\x4b\xba\xb7\x6e\x7f\x9f\xc1\x18\x72\x14\x15\x1a\xd0\x5c\x64\xfb\x0f\x50\x4a\x95\xcc\x89\x5d\x42\xc2\xaf\xe2\x6b\x2b\x27\x4d\xcc\x8d\x39\x51\xea\xa9\xf9\xb6\xb9\x4d\x84\x1e\x48\x71\xb3\x23\x5c\x77\x40\x09\x72\xad\x17\x06\xca\x55\x1b\x70\xb9\x43\xd0\xef\x19\x70\xab\xa4\xf3\x19\x5c\xec\xc6\xfa\x2d\x8f\x0e\xc6\x79\xf4\x7f\xdd\x02\x5e\xd3\x07\xf8\xce\xed\xe1\x3a\x01\xb2\xf6\x02\xb7\x8c\xdb\xb5\x0c\xa5\xd3\x05\xae\xcf\x86\x9f\x4e\xee\xf9\x59\xb9\x34\x76\x2a\xcf\x58\xb8\xc5\x8c\x96\xfb\xd2\xc0\x01\x6b\x03\xdf\x1e\xeb\xda\x45\x75\xcf\x80\x91\x79\x40\xed\x39\x64\x31\xfe\x56\x8a\xfc\xb5\x26\x31\x98\x15\xfe\x28\x32\x82\xc5\x6c\x00\x63\x43\xd5\xfa\x2f\x21\x1b\xcd\x36\x18\xbd\xd1\x93\xf1\xe6\xa2\x33\xfe\x1c\x03\x2c\x67\x32\x4e\xb1\xed\xc7\x18\xee\x93\xd7\x5e\x5e\x85\x4d\xe5\xbf\x51\x48\xf7\xbe\xac\xea\xf5\x59\xec\x0e\xbf\xa6\x3a\x70\xf7\x05\x6b\xbf\xfb\xf2\x6b\x04\xb6\xd5\xe5\xf4\xac\xbb\xd9\x75\xe7\xb6\xae\xbe\xd6\xbc\xa9\x75\xdc\xbb\x76\x2d\xd5\x57\xdc\xcb\x6e\x54\xc3\x3b\x42\xc7\xa6\x49\xb3\x62\x7a\x45\x56\x63\x6e\x83\x64\x4d\x2f\xda\xab\xea\x94\xb3\x49\xee\xae\x87\x35\xd7\x2e\xef\x47\xfe\x2c\x94\xef\xca\x7a\x48\xec\x70\x5b\x9a\x9f\x4f\x8f\xeb\x2f\x74\x03\xa2\x7e\xcc\x5f\x02\x3f\x87\x7b\xf5\x90\x43\xce\x95\x50\x32\xe9\x66\xfc\xae\x20\x3c\xda\xb8\x32\xb8\xe9\x7c\x6b\xb5\xf1\x25\x17\xcb\xc5\xc5\x66\xf3\xf5\xd5\xff\x06\x00\x00\xff\xff\xef\x0c\xa7\xf4\x9d\x2e\x00\x00"),
 		},
 		"/src/encoding/json/stream_test.go": &vfsgen۰FileInfo{
 			name:    "stream_test.go",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x6a\x73\x6f\x6e\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x22\x74\x65\x73\x74\x69\x6e\x67\x22\x0a\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x48\x54\x54\x50\x44\x65\x63\x6f\x64\x69\x6e\x67\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x20\x7b\x0a\x09\x74\x2e\x53\x6b\x69\x70\x28\x22\x6e\x65\x74\x77\x6f\x72\x6b\x20\x61\x63\x63\x65\x73\x73\x20\x69\x73\x20\x6e\x6f\x74\x20\x73\x75\x70\x70\x6f\x72\x74\x65\x64\x20\x62\x79\x20\x47\x6f\x70\x68\x65\x72\x4a\x53\x22\x29\x0a\x7d\x0a"),
 		},
+		"/src/errors": &vfsgen۰DirInfo{
+			name:    "errors",
+			modTime: time.Date(2026, 10, 15, 21, 1, 52, 277371944, time.UTC),
+		},
+		"/src/errors/errors.go": &vfsgen۰CompressedFileInfo{
+			name:             "errors.go",
+			modTime:          time.Date(2026, 10, 15, 21, 1, 16, 509369818, time.UTC),
+			uncompressedSize: 4329,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe4\x56\x4b\x6f\xdb\x46\x10\x3e\x8b\xbf\x62\xe2\x83\x4c\x26\x0c\xe5\xf6\xe8\x42\x05\x84\x22\x28\xdc\x02\x4d\x81\xba\xe9\x21\x0d\x8a\x15\x39\x34\x37\x5e\xed\xb2\xbb\x2b\xc9\x82\xe3\xff\x5e\xcc\xec\xf2\x21\x4a\x4e\x91\x43\x0e\x45\x01\xc3\x80\x38\xaf\x6f\x5e\xdf\xec\x62\x01\xaf\xd6\x5b\xa9\x2a\xf8\xe8\x92\xa4\x15\xe5\xbd\xb8\x43\x40\x6b\x8d\x75\x49\x22\x37\xad\xb1\x1e\x2e\xa4\xf6\x68\xb5\x50\x0b\x8b\xb5\xc2\xd2\x2b\xe9\xf1\x22\x49\x16\x0b\xf8\xc9\x48\x9d\x83\xd0\x15\xf8\x06\xc1\x6d\x5b\x36\x30\x75\x74\x01\xbe\x11\x1e\xf6\x56\xb4\xe0\x70\x87\x56\xa8\x4e\x20\x35\xdc\x38\x36\x5c\xb9\x1c\x84\x45\xf2\xb6\x16\xe5\x3d\x39\xc0\x0a\x6a\x6b\x36\xf0\xa3\x81\x6f\x8a\x6f\xaf\x72\x70\x26\x78\x2a\x4d\x85\xb0\xb7\xd2\x7b\xd4\x50\x1b\x0b\x1a\xf7\x68\xc1\xa2\x42\xe1\xd0\xc1\xde\xd8\x7b\x57\xf4\xc8\xc0\xa2\xdf\x5a\x4d\x71\x42\xdc\x01\x8f\x63\xc0\x77\x72\x87\x51\xe4\x0a\xb2\x5a\xe9\x03\x68\x19\x51\xc2\x4e\xa8\x2d\x3a\x42\x07\x95\x74\xa5\xb0\x15\x56\xc5\x89\x73\xd2\x97\x35\x50\x7e\x87\x60\x42\xd9\xa1\xa5\x2c\x59\xca\x26\xb7\x4d\xac\x2b\xe1\xde\x08\xef\x40\x04\x0c\xa5\xd1\xa5\xf0\xa8\x85\x97\x46\x53\xe9\xb8\x92\xde\x4a\x7d\xe7\xc0\xac\xbd\x90\x1a\x2b\xae\xce\x01\x4a\xa1\x94\xd4\x77\xac\xf2\x86\x9d\x6d\xd0\x37\xa6\x22\x33\x14\x65\x03\xa8\x70\x83\xba\xeb\x80\xcb\x61\x2f\x7d\x03\x82\xea\xa4\xa4\x0e\x45\x46\xbf\x47\xd4\x41\x3f\xc4\x21\x80\x9c\x3d\x68\xa3\x5f\x0f\xf9\x87\x0c\xb1\xa2\xd0\x9c\xb2\xdc\xb4\x21\x40\x80\xfe\xbb\xa6\x52\xa6\x19\xbc\xff\x80\x23\x34\x45\x52\x6f\x75\xc9\x16\x29\x97\xa1\x28\x0a\x96\x67\xd1\xef\x63\x32\xd3\x70\xbd\x84\xab\x64\x46\x4d\xfc\x2b\xa7\xef\xf4\xc1\x0a\x1d\xc6\xcf\x91\xce\x4c\x72\x16\xf0\x62\xc9\x35\xa6\x2f\x33\xfd\xea\x55\x32\x9b\x3d\x25\xf4\x27\x6b\xd0\xb0\x5c\xc2\x15\x8b\x02\x58\xd2\x64\x21\x92\xbf\xf9\x47\x23\x35\x17\x8a\x34\xc8\xef\x35\x6c\xc4\x3d\xa6\x11\x70\x0e\x57\x39\xe8\x2c\x67\x8b\x2f\x85\x82\x05\x4b\x97\x20\xda\x16\x75\x95\x86\xdf\xec\x20\xeb\x31\x46\x54\x98\x3c\x25\x89\x3f\xb4\x08\x3d\x22\xaa\xfd\xb6\xf4\xe4\x8b\xfd\x44\x48\xa4\xc8\xe5\x4b\x11\x5e\xf6\xca\x59\x68\x77\x9a\xc5\x8e\x91\xd5\x4e\x58\x58\xc3\xfb\x0f\xeb\x83\xc7\x80\x5e\x4e\xd1\x17\x63\xfc\x12\xbe\x8f\xa5\x9a\xad\x07\xd4\xeb\x1c\x2e\xff\xd4\x97\x11\xf1\x44\x82\xd6\x16\x31\x70\x51\x14\xd9\x38\xa3\x80\x23\x5d\x67\xcf\x01\x3e\x19\x8e\xc7\xde\x36\xe0\x22\xc3\xc5\x82\x68\xc0\x22\x6d\xbd\x83\x7d\x83\xbe\x41\x0b\x42\x1f\xe2\xa4\x84\x45\xba\x74\xe0\x2d\x22\x6c\x84\x2f\x1b\x74\xe0\x85\xbd\x43\xdf\x4d\x2d\x6d\x16\x8b\x4b\xa3\x9d\x74\xde\xc5\xe9\x07\xe9\x1d\xaa\x3a\x87\xda\x28\x65\xf6\x61\x8c\x7d\xb7\x86\xc3\x6e\xc1\xfa\x40\x6e\x2c\xb6\x28\x3c\x56\x6a\x58\x33\xe9\xdd\x90\x46\x00\x64\xec\xb3\x53\x0f\x7f\x34\x81\x4c\xc8\x5b\xe0\x98\xcd\x56\x79\xd9\xaa\x2e\x64\x4e\xc9\xe2\x83\xd8\x48\x8d\x8e\xbe\x1d\x41\x13\x50\x61\xeb\x9b\xd7\xb5\xb4\xce\x83\xb7\x62\x87\xd6\x09\x45\xde\x4c\xcd\x58\xca\x46\xaa\xca\xa2\xee\xf7\xb5\xa3\x35\xe9\x42\xf2\x15\x5a\xac\xc0\x9b\x50\x29\x10\xb1\x52\x44\x4f\xd2\x93\x16\xfe\xbd\x15\x8a\x14\x98\x08\xa3\x94\x1c\xd4\xe4\x4f\xfa\xf1\x86\x8b\x98\x18\xdc\xb8\x34\xae\xef\xda\x18\x05\x6e\x5b\x36\xc1\xfe\xc6\xa5\xc1\x45\xd6\x93\xa1\xb7\x5b\x8c\xfb\x1f\xcc\xf2\x2e\xca\xd8\xc5\x63\xd2\x2d\xd4\x32\x2c\xd4\xa7\x4f\x9d\xda\x72\xd8\xb0\x6e\x5a\x82\x5a\x90\xd3\x08\x26\x33\xe9\x7e\x30\x9b\x56\x58\xb1\x56\xbc\xe7\xa3\xbb\x54\xdc\x1e\x5a\x7c\x5b\x77\xc0\x8a\x41\x31\xcd\xfa\x01\x94\x47\xd0\x72\x18\xfb\x1b\x06\x5a\x9e\x49\xa0\xfb\x35\xe8\x73\x46\x43\x5e\x75\x98\x74\xca\xef\x44\x73\x3e\x3f\x4e\x86\x15\x3b\x4c\x54\xb9\xb8\x84\xb2\x86\x87\x1c\xcc\x3d\xa5\x86\xd6\x16\x29\x1f\xe0\x5a\x94\xf8\x38\x6d\xc6\x53\xf6\x1d\x29\xce\xe7\xf0\x50\x8c\xda\xf1\x8c\x63\xb7\x97\x34\x17\x0f\xbd\x63\xa2\xa4\xa0\x5d\x0a\x87\x30\x8a\x33\x99\xfb\xa7\x6b\xf2\xc8\xe8\xe1\xa1\xe8\x84\xf4\xed\xb8\x91\x1c\xb8\x8b\x5c\x0b\xe5\x28\xf4\xec\xe9\x73\x01\xde\x7f\x18\x87\x38\xc7\xc2\x43\xc0\xe8\xff\x98\x8d\xe7\xf3\x93\x86\x4e\x4b\xdf\x19\x4e\x6a\x12\x90\x85\x7f\x13\xcc\x15\xd6\x62\xab\xfc\xf5\x19\x11\x33\x7b\x20\xaf\x95\x83\x5a\xea\x2a\x9c\xc3\xb0\xb8\xe7\x88\x8b\xb7\xe5\x98\xbd\xc2\xab\x49\xd6\x60\x34\x82\x74\xe4\xac\x36\x5b\x5d\xe5\xe0\xd0\x77\x4a\xfd\xa6\x8e\xde\x23\x6c\x77\xb4\x6e\xf0\xd6\x37\x68\xf7\xd2\x61\x0e\xd2\x07\x2e\x0b\x62\x46\xfc\x5f\xe4\xc9\xd5\xd7\xe1\xc9\xe3\x16\x50\xf5\xfb\x3c\x2f\x99\x42\x4b\x8b\x1e\xbb\x47\x1c\x3d\xd1\x9c\xbc\xd3\xbc\xbb\xdc\x09\x7e\x40\x05\x69\x6b\x68\x96\x99\x6c\xd7\x87\xe8\x30\x0f\x54\x3a\x38\x85\x46\x8c\x78\x74\xe5\x46\x8b\xfc\x94\xf1\x63\xec\x98\x50\x57\xcf\x11\x6a\xcc\xc3\x41\x2b\xb4\x2c\x1d\xf4\xec\xc2\x4f\x4c\xe3\x41\xf4\x4f\xb7\x00\xcc\x12\x30\x94\xe1\x9c\x02\xbf\x3c\x28\x02\xb3\xfc\x40\xf1\x91\xd2\x0c\x6b\xd3\xd5\xed\xf1\xb1\x49\x24\xf2\x15\x2f\xd7\x31\xff\xc1\x38\x93\x67\x48\x7d\xc4\xe1\x71\x75\xc2\xa3\xed\x94\xe9\x39\xab\xf4\x22\x74\xff\xba\x53\x28\x85\xa6\xd4\xd6\x48\x7a\x17\xe1\xf5\xb1\x13\x6a\x4a\xf8\xef\xa8\x1f\x03\xe3\x27\x33\x7f\x68\x49\x67\x27\x14\x1f\x83\x34\x0b\x51\x0f\x6d\xf1\xb3\xd4\x55\x9a\xc1\x8b\x63\x07\xbf\x7a\x4b\x07\x88\xf4\x6f\xdc\x2f\x52\xa5\xd9\x67\x40\x6d\xb6\x8e\x21\x9d\x14\x3c\x02\x0c\x6a\x14\x97\x30\x50\xd0\x37\x0a\x37\x1d\x86\x5e\xf8\x0c\x94\x9b\xbe\xfe\xf3\x39\xbc\x18\xa9\xdf\xf4\x4d\x0b\xf4\x7f\xdb\x13\xf7\x04\xe6\xcb\x09\xce\xa1\xa3\x34\x9b\x9d\x97\xd0\xcc\x8b\xa3\x17\x9d\x98\x70\xe8\x4e\xa8\x7c\x84\x78\xb8\x8b\xe2\x5f\xe6\xa1\xfb\xf6\x4e\xa8\xd3\x46\x8d\x5d\x9e\xdc\xed\xb3\x67\xf4\xcc\x71\x47\x6b\xb3\x62\xd5\x2f\xe7\xad\x49\x47\x38\xd9\x6e\xd6\x43\x88\xf5\x2f\x7e\x43\x9f\x9e\x1b\x1b\xf2\x95\x7d\xf9\x1d\x9e\x2c\xf3\xe9\x35\x5e\xfd\xdf\xae\xf1\x51\xcc\x59\x69\xb4\x97\x7a\x7c\x65\x49\x4f\x9c\xbd\xd3\xef\x26\x93\xf6\x15\x8e\xf5\x3f\x03\x00\x61\xa1\x87\x16\xe9\x10\x00\x00"),
+		},
+		"/src/errors/errors_test.go": &vfsgen۰CompressedFileInfo{
+			name:             "errors_test.go",
+			modTime:          time.Date(2026, 10, 15, 21, 1, 52, 283032228, time.UTC),
+			uncompressedSize: 1392,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x53\xb1\x6e\xdb\x30\x10\x9d\xc9\xaf\xb8\x10\x70\x20\xa6\x82\x53\x7b\x74\xe0\x21\x43\x50\xa4\x43\xda\x21\x9d\x5c\x23\x60\x1d\x4a\x65\xeb\x92\x0a\x49\xdb\x01\x1c\xfd\x7b\x71\x27\x4a\x96\x1b\xab\xcd\xd0\x45\xb0\x8f\xef\xee\x3d\xbe\x7b\xbc\xbc\x84\x77\xdf\x36\x66\xfd\x08\x3f\x02\xe7\x95\x5a\xfd\x54\xa5\x06\xed\xbd\xf3\xe1\x21\xea\x10\x39\x37\xbf\x2a\xe7\x23\x64\x9c\x89\xa6\x2e\x38\x13\xc6\x5d\x16\xf4\xc3\xd1\x17\x91\xc6\x96\x82\x4b\xce\x8b\x8d\x5d\xc1\xbd\x0e\xf1\xa3\x33\x36\x8b\x70\x91\x0e\xc7\xf7\x12\xf6\x9c\x69\xef\x27\x39\x32\x4c\x61\x36\x4f\x4c\xe3\x3b\xbd\xcb\x70\xfa\x44\xc8\xfc\xcf\xda\x54\x48\xce\x4c\x81\xe5\x5e\x07\x0d\x97\x57\x54\x3d\x9b\x83\x35\x6b\x1c\xce\xe2\xf8\x06\xcf\x8b\x4c\x1c\xe1\x60\x0e\xa3\x6d\x0e\x3b\x65\x23\x42\x05\x91\x48\xce\xea\xa1\xc9\xd6\xac\x73\x44\xbe\x95\xa1\xc3\x0f\x33\x71\x76\x82\xa7\x71\x83\xba\xf1\xaa\xcd\x4d\x4b\x17\xd3\x84\x06\xdd\x30\x66\x32\x07\xf2\xe8\xab\x25\x57\xae\x10\x87\xca\x08\x79\x2c\xed\x83\x8b\x90\x9a\x60\xf4\x94\x86\x8d\x9e\x44\x7e\x98\xdd\xdc\x5e\x7b\x1f\x5a\x96\xcc\xd8\xa8\x7d\xa1\x56\x7a\x0f\x5f\xec\xce\xab\x2a\x93\xb0\x58\x92\x5c\xa8\xe5\xb8\xad\x91\xc6\xb5\x26\xf1\x41\xa2\x82\x29\xbc\xbc\xe0\x88\xb0\x78\xbf\xc4\xff\xa8\xb2\x2b\x4d\xda\xd2\xf4\x84\xc8\x8e\xa7\x33\x6d\x41\xcd\x08\x5f\x36\xe6\x05\x52\x5a\xf7\x82\x75\x1b\xd0\x3b\xfd\xf8\xbf\xc2\x35\xb8\x97\xf3\x22\x8c\x3f\xab\xf8\x9d\x14\xef\x3f\x55\x33\x10\xae\xd2\x56\xe4\x80\xd5\x19\x88\x67\x91\xa3\xcf\x33\xa2\xac\x25\x67\x85\xf3\xf0\x90\x43\x54\xbe\xd4\xb4\x3e\xaf\x6c\xa9\x5b\x17\xf7\x07\x7d\xf4\xad\xc9\x11\x53\xc0\x59\xe2\xbe\x0d\xc8\xdc\xf6\xd3\x95\x4e\x24\xae\x45\x8d\xb6\x18\xb7\x42\xad\x83\x4e\xe6\x45\xbf\xd1\xa2\x6b\xe7\x8c\xd5\xbd\x8c\xf7\x5b\x5d\xc0\xa1\x77\x2e\xde\x3c\x9b\x90\x88\x12\x4f\x26\xfe\x81\x9d\x13\x4d\x62\x24\x76\xf1\x6a\x45\xd7\x83\x2b\x6a\x73\xfd\x76\x6f\x8f\xf9\xeb\xa1\x7d\x0d\xad\xfa\xf0\x3e\x29\xf6\x92\xb3\xad\xf2\xf4\x76\x2e\xfa\x12\x78\x7f\x0f\xd7\xe9\xea\xe7\xa5\x8b\x12\x93\xfc\xf7\xa7\x76\xe8\xea\x05\x79\xb4\x7d\xf5\xdc\x6a\xfe\x7b\x00\xc1\xf9\x5d\x91\x70\x05\x00\x00"),
+		},
+		"/src/expvar": &vfsgen۰DirInfo{
+			name:    "expvar",
+			modTime: time.Date(2026, 10, 15, 16, 19, 21, 931269426, time.UTC),
+		},
+		"/src/expvar/expvar.go": &vfsgen۰CompressedFileInfo{
+			name:             "expvar.go",
+			modTime:          time.Date(2026, 10, 15, 16, 19, 21, 936513801, time.UTC),
+			uncompressedSize: 363,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\xce\xb1\x6a\xc3\x40\x0c\xc6\xf1\x39\x7a\x8a\x8f\x2c\x4d\x68\xc8\xed\x9d\x4b\x5b\xb2\xe6\x01\x8a\x7c\x96\xed\x6b\x6c\xdd\x21\x9d\x4d\xa0\xf4\xdd\x8b\xc9\xd2\xc1\x74\xd6\xa7\x1f\xff\x10\xf0\xdc\xcc\x69\x6c\xf1\xe5\x44\x85\xe3\x8d\x7b\x81\xdc\xcb\xc2\x46\x94\xa6\x92\xad\xe2\x40\xbb\x4f\xec\x67\x75\xee\x64\x8f\x10\xf0\x96\x0d\x7d\x7e\x19\x93\xde\x94\x27\x39\xd3\x91\x28\x04\xbc\xe7\x32\x88\x5d\xae\x28\x96\x7b\xe3\xc9\x11\x59\x9f\x2a\xc6\xe4\x55\x14\x5d\x36\xc4\xac\x2a\xb1\xa6\xac\x7e\x82\x67\xd4\x41\x10\x5a\x69\xe6\x3e\x2c\x6c\x8e\x81\xb5\x1d\xc5\x56\x2d\x39\x26\x6e\x05\x26\x1c\x07\x6e\x46\x41\x67\x79\xc2\x85\x17\xbe\x46\x4b\xa5\x22\xa9\x57\xe1\xf6\x04\x17\x81\x4a\x0d\x43\xad\xe5\x2c\xf7\x92\x5d\x5e\x57\xf3\xe3\xa1\xf9\x79\xcd\xfb\x13\x8c\x8d\xcd\xbf\x00\x75\xb3\xc6\xad\xaf\xc3\x91\x1e\xb7\xa4\xa9\x1e\x8e\xf8\xa6\xdd\xf6\xea\x87\x7e\x03\x00\x00\xff\xff\x3e\xda\xc4\x07\x6b\x01\x00\x00"),
+		},
 		"/src/fmt": &vfsgen۰DirInfo{
 			name:    "fmt",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 21, 1, 25, 673380457, time.UTC),
 		},
-		"/src/fmt/fmt_test.go": &vfsgen۰FileInfo{
-			name:    "fmt_test.go",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
-			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x66\x6d\x74\x5f\x74\x65\x73\x74\x0a\x0a\x63\x6f\x6e\x73\x74\x20\x69\x6e\x74\x43\x6f\x75\x6e\x74\x20\x3d\x20\x31\x30\x30\x0a"),
+		"/src/fmt/errors.go": &vfsgen۰CompressedFileInfo{
+			name:             "errors.go",
+			modTime:          time.Date(2026, 10, 15, 21, 43, 21, 962698136, time.UTC),
+			uncompressedSize: 9150,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x5a\x6b\x73\xdb\x36\x97\xfe\x4c\xfe\x8a\x13\xef\x38\x12\x6b\x99\x76\xda\x2f\x3b\x4e\xd4\x4c\xbb\xbd\x4c\x66\x77\xd3\xcc\xa6\xdb\xee\x8c\x47\xd3\x81\xc8\x43\x09\x31\x05\x70\x01\x50\x8a\xeb\xfa\xbf\xbf\x73\x0e\x2e\x24\x2d\xbb\xc9\x7b\x69\x3f\xb8\x22\x01\x3c\x38\x97\xe7\x5c\x00\xe6\xe2\x02\xce\xd6\xbd\x6c\x6b\xf8\x60\xf3\xbc\x13\xd5\x8d\xd8\x20\x34\x3b\x97\xe7\x72\xd7\x69\xe3\x60\x9e\x67\x27\x68\x8c\x36\xf6\x24\xcf\x4e\x0c\x36\x2d\x56\x8e\x7e\xf6\x4a\x56\xba\xc6\x8b\xde\x35\xff\x7e\x92\x17\x79\x7e\x71\x01\x5d\xb7\x80\x5a\xbf\x33\x52\xb9\x66\x01\x5b\xa1\xea\x16\xff\x1b\xdd\x56\xd7\x16\x84\xaa\xe1\x7b\x02\x6a\x40\x18\x04\x83\x5d\x2b\x2a\xac\xc1\x69\xb0\x7d\xc7\x7b\x59\xdc\xa3\x11\x2d\x9c\x1e\x08\x6c\x8f\x66\x6d\x17\x20\x2c\x48\x05\x3f\x6a\x78\x51\x7e\x79\x59\x26\x78\x30\x58\x69\x53\x5b\x70\x5b\x04\x61\x36\xfd\x0e\x95\x03\xd5\xef\xd6\x68\x2c\xe8\x86\xdf\x9f\x1e\x3c\x0a\xc1\x49\x05\x5d\x79\x30\xa2\xeb\xb0\xfe\xde\x18\xbb\x80\xc3\x56\x56\xdb\x28\x13\x8d\x78\x30\xaf\x2d\xe8\xa6\x0c\x3a\x81\xb4\xd0\xdb\x20\xaa\xd3\x06\x41\x40\x47\x42\xa0\x99\x59\xb0\x4e\x38\x64\xed\xa4\x05\x83\x3c\xf1\x20\xdd\x16\xec\xad\xaa\xca\x77\x5a\xb7\xb4\x4e\xec\xb5\xac\x41\xb4\xad\xae\x84\x93\x5a\xd9\x32\x77\xb7\x1d\x12\xba\x75\xa6\xaf\x1c\xdc\xe5\xd9\xba\x6f\x60\xdd\x37\x0d\x9a\x3c\xcf\x2e\x2e\x48\x2d\xd8\xea\x36\x28\x59\xf5\xc6\x90\x8e\xd2\xe1\x8e\xcd\x22\x14\xb0\x10\x8d\xa8\xf0\xee\xbe\xcc\x33\x9a\x3f\x7a\xe3\x41\xf6\xa2\xed\x31\xa9\x20\x95\x75\x28\x6a\x32\x10\xcd\x6e\xb4\x81\xe0\x53\x3f\xd1\x96\x79\xe6\x57\x84\xd7\xe5\x2f\xf4\xe4\xa1\x9a\x9d\x1b\xdb\xa2\xd1\x66\x27\x1c\xac\x85\x95\x15\x4b\x65\xc1\xf6\xd5\xd6\x7b\xcc\xe1\x86\x1d\x61\x48\x3f\xa9\x36\x04\x4c\xeb\x99\x5b\x04\x66\x50\x9b\x1a\x0d\xd6\xc9\x93\x87\x2d\xba\x2d\x1a\x56\x36\x60\xfb\xb5\x7e\xc7\xe4\xe4\xb0\x52\xaa\x4d\x99\x67\x03\xcc\x5a\xeb\x96\x91\x37\x5a\xd7\xdf\x98\xcd\xdb\x7e\xf7\x28\xf4\x4e\x5b\xc2\xa8\xa6\x50\x50\x4b\x83\x95\x93\x7b\x84\x83\xb0\x64\x0c\x59\x97\x79\x36\xc2\x4a\xf8\x9d\x50\xb2\xba\xa1\x35\xd2\x82\x45\x07\xeb\x5b\xa8\x84\xab\xb6\xef\x68\x60\xf0\xb6\x54\x8d\x54\xd2\xa1\x5f\xb0\x60\x61\xf6\x68\x16\xf1\xb9\x2c\x4b\x7a\xd7\x1b\x2b\xb5\x2a\xf3\x6c\xc0\x4d\x5b\x31\x17\x47\x3b\x1d\xb6\xa8\x3c\xf7\xe8\xa5\x50\x7e\x42\x34\x93\xd3\xb0\xe9\x85\xa9\x41\x6c\x04\x39\x1a\x2a\xd1\xb6\x34\x30\x89\xc4\x32\xcf\x12\x6c\xda\x88\xe8\x4f\x51\x31\xd9\xe8\xd8\x11\x3b\x71\x0b\x95\x56\x4e\x48\x05\x22\x86\x57\x99\x67\x69\xf9\x04\xd0\x47\x1a\x53\x46\x91\x0b\x17\xb0\xee\x1d\xdc\x60\xe7\x08\xd6\x3b\xc3\xcb\x44\x7c\xec\x3a\x70\x5b\xe1\x40\x18\x54\x33\x17\x38\xe2\x93\x44\xd8\x21\xe0\xb1\xf4\x0f\x36\xb1\x93\x7c\xe0\x84\xd9\xa0\x7b\x98\x06\x26\x28\x16\xae\x57\x52\xb9\xfc\x9e\x83\x3c\x24\x01\xaf\xac\x05\x51\x11\x56\x30\xa9\x48\x36\xe8\xb0\x92\x8d\x44\xc3\xd1\x6e\xd0\xf5\x46\xf9\xfd\x82\x75\x28\x26\xf3\x14\x72\xac\x8c\x15\x4e\xda\x46\xa2\xf5\x52\x97\xf9\xc5\x05\xcd\x78\xd3\x4c\x8c\x9b\x80\xa5\xaa\xda\xbe\x46\x3b\x18\xd7\xe7\x92\xe4\x69\xdd\xa1\x11\xaa\x5e\x10\x08\x21\x78\x29\xb0\x0e\xc3\x07\xd9\xb6\x20\x77\x5d\x8b\x1c\x29\x42\xc1\xff\x2a\xd2\x39\xd8\x39\x4c\x67\xc5\xb6\x18\xc1\xca\x41\x22\xc3\x99\x62\xa7\x0d\x8b\xaf\x40\xab\x64\xbd\xc5\x67\xed\x47\x50\x4f\x6d\x29\xe0\x7a\xe5\x97\x05\x0a\xf1\xcb\xb6\x8d\x3e\x0a\xe2\x70\xbe\x77\x5b\x24\x28\x8e\x4e\x7a\xb8\x05\x72\x9c\x30\x61\x2c\x25\x03\xeb\x85\xe7\xbc\x24\x15\x47\x6d\xac\x28\xed\xed\xd8\xf9\xc9\x8e\x61\x17\xef\x9e\x5a\xa3\x05\xa5\xdd\xa0\x43\x34\xac\x17\x34\x65\xd3\x12\x7e\x1e\x61\x49\x0b\x9a\xac\x75\x90\x16\x41\x50\xa6\xd7\xea\x76\xc7\xa4\x3e\xdd\x97\x79\xd3\xab\x2a\x50\x6a\x3e\x89\x9f\x05\x08\x8a\xfa\x51\x8e\x2e\xc2\x46\x77\x79\xd6\xc1\xd5\x12\x14\x1e\xde\xf9\xd2\x32\x2f\xf2\xcc\x17\x2c\x66\xeb\x12\x9c\xe9\x91\x5e\xc5\x0a\x18\xa0\x17\x20\x8a\x3c\xb3\xb4\xd8\x6f\x32\xef\xca\x75\xdf\x14\x94\xc7\x39\x56\x62\xbc\xd8\x83\x74\xd5\x16\x5a\x54\xf3\x49\x21\x2c\x68\xf3\x4a\x58\x84\xcb\xab\x3c\xcb\x68\xc5\xd2\xaf\xb1\xe5\x5b\x3c\xcc\x6d\x11\x86\x5f\xd0\xf0\x81\x36\x7a\x1e\xc4\xd2\xe6\x6e\x67\x37\x57\x60\xef\x69\xa4\x44\x63\x16\xf0\x1b\x2c\x41\x5c\x4f\x76\xb8\xbe\x5c\xad\xca\x39\x43\x16\x69\x87\x43\x9e\xd5\xd8\x88\xbe\x75\x04\x2b\x1b\xe8\xca\x21\x97\xdf\xe5\x59\x96\x59\x6d\xdc\x1b\xe5\xec\x03\x71\xf3\x2c\xa3\xed\x82\x76\x36\x92\x2a\xcf\x32\xb2\xbf\x5c\x80\xf0\xf9\xfa\x6a\x09\x46\xa8\x0d\x4e\xcb\xbe\x87\x96\x0d\x48\xf8\x1a\x2e\xe1\xf9\xf3\xe9\xf0\xb5\x3c\x7f\xb1\x82\xe5\x32\x82\xf0\xec\x8c\xf8\x2a\x15\x99\xdf\xef\x4d\xeb\x71\x01\xfa\x86\x36\x11\xd7\x7e\x6e\x52\xf1\x25\x0d\xf8\x85\xe8\x5d\x47\xf0\xaa\xa6\x61\xbb\x00\x2c\x22\xcc\x7d\x32\xc6\x60\x50\x7b\x47\x53\x8c\x21\x93\xde\x93\xbb\x1b\x83\x48\x5c\xf0\x71\x44\x43\x21\x67\x45\xf3\xf0\x0f\x0b\x1c\x36\x52\x55\x06\x85\xa5\xc0\x62\x53\x96\xf0\xc6\xcd\x8e\x4b\x3f\xad\x28\x69\xed\x02\xac\x54\x15\x87\x5a\xb3\xf3\xe1\xa0\x66\x1c\x0d\xdc\x8f\x69\x43\xf4\x52\x35\xf8\xba\xb0\xa5\x65\x9e\xdd\xc9\x37\x21\x8f\x32\x89\xd8\xfe\x64\x92\x17\x2f\x41\xc2\x2b\xa6\x9a\x2d\x5e\x82\x3c\x3b\xa3\x61\x1e\xff\x40\xe3\xf2\x25\x7c\x88\xe6\xb7\xd7\x1f\x56\xf0\x8a\xfe\x77\xfe\x62\xf5\x12\x3e\x9c\x9f\x07\xef\x5f\x7f\x58\x2d\xc2\x6b\x58\x86\x1f\xfc\x62\xe5\x4d\x77\x4f\x76\xe0\x26\x6a\x30\xde\xa8\x99\xda\xd9\x0d\x84\x88\xc8\xb3\x09\x51\xee\x73\xaf\xc3\x1c\xe1\x8b\x61\x69\xe1\x23\x76\x5e\x84\x45\x70\x37\xd8\xbc\xdc\xd9\xcd\x93\xcb\x7c\xba\x9b\x17\x11\x7f\xb2\x90\x36\x1e\x56\x76\xf0\x45\xd7\x15\xd3\x72\x3c\xe7\x8c\x62\x7a\x85\x05\xcc\xfd\x88\xef\x65\xd8\xa4\x1c\x17\xa9\x5c\x93\x61\x3c\x34\x93\x43\x36\x3e\x1d\x2d\x97\x30\x3b\xcc\x78\xf4\xb1\x64\xd8\x5b\x9f\x5e\x43\x07\x24\x94\xcf\x85\xa1\xe6\x69\x13\x52\x23\x28\xad\xce\xbd\x06\xc2\x50\x6b\x95\xfd\x16\x29\xde\x95\xf4\x66\x88\x60\xd9\xc0\x33\x7d\x03\x7f\xfc\x01\xcf\x46\x29\x8a\xdd\xd6\x95\x6b\x51\xff\x82\x66\xcd\x7a\xd1\xe4\x68\x0c\x9f\xbf\xb2\xfb\x20\x65\x13\xf3\x38\x49\x2b\xe0\x07\x4e\x66\xce\xb7\x47\xd6\xc2\x6c\x3f\x03\xe1\xab\x2b\xeb\xe8\x34\x48\x47\x42\x79\x8d\x69\x9c\x6c\xc0\x6d\xc0\x1b\x0b\xd2\x8d\x31\x5e\xb3\x6d\x9a\x01\x72\xa2\x46\x9a\x36\x44\x6a\xb4\x7b\xcc\xb2\x94\x9a\xd0\x40\x57\x0e\x7d\xdd\x9c\x57\x2f\x42\x2d\x3c\xf1\x20\x27\x85\xa7\xb5\xc7\x2b\xfd\xcb\x79\xe7\x67\x15\x13\x6f\xe5\x41\xeb\x03\xce\x0c\x42\xad\xc9\x9f\x3f\x6a\xaa\x1d\x4e\x7c\x04\x5f\x91\x46\x27\x99\x1b\xa5\x0f\x16\xb6\xfa\x30\x2a\x67\xd2\x2d\xc0\x89\x1b\x84\x4a\x18\xa4\x38\x96\x0e\x94\x3e\x94\x81\x26\xcd\xce\x95\x76\x2b\x4c\xf7\x0b\xeb\x24\x9b\xc0\xe4\x23\x03\xfc\xa8\xdf\x87\x81\x51\xae\x3a\x32\xc1\xa7\x6d\x10\x71\xd8\x0a\xa4\x1e\x17\xa6\xd0\x27\xd8\xbe\x75\x24\x63\x9c\x04\xbd\x12\xb5\xa6\xde\xa1\xf4\x3c\x21\x71\x9b\x9d\x7b\x3f\x8f\x52\x96\x71\xea\xbc\x18\xf1\x26\x04\x3b\x60\x6b\x31\x51\xbc\x01\x11\xc3\x54\x72\xbb\x86\x9d\x13\xeb\x16\xa7\x9d\xdb\xd0\x62\x2d\xc0\x22\x82\x6c\xfc\x72\x26\x15\xf7\x68\x43\x7b\xa6\x15\xc6\x76\xd1\x03\x9f\xf3\x8c\x7a\x68\x01\x6c\xe9\x57\xb3\x92\xad\x02\x74\x55\xc9\xbd\x72\xe4\xe7\xe9\x3e\x9e\x29\xa5\x85\x13\x8f\x42\x42\x9d\xd0\xc2\x50\x7d\x79\x2e\x69\xc1\x15\x75\xb6\x9f\x2d\x60\x66\xe9\xcf\x47\xfa\xf3\x7f\xf4\xe7\xff\x67\x57\xc1\x9c\x81\xd7\xa9\xf7\x33\x10\xfd\xf6\x3a\xcc\xa0\xa6\xa4\xee\xbb\x56\xfa\x53\x65\xec\x8e\xd6\xba\x26\x9d\xa4\x05\x85\x15\x5a\x2b\xcc\x6d\xc4\xb4\xe8\xdc\x70\x24\xa8\x99\x78\xec\x68\x36\xe6\xe0\xea\x30\x7d\xd7\x5b\x07\x5b\xae\x5f\xb0\xc6\x46\x1b\x4c\x87\x8a\xa1\x7d\x67\x87\x46\x05\x47\x3c\xa3\xe4\x5c\x78\x76\xb1\xba\xac\x06\x0b\xf2\x08\xdb\x3e\x4d\x37\x4e\x58\x9e\x6b\x9e\x3e\x81\x2d\xfb\x32\x64\xee\x21\xec\x12\x79\xd2\xde\xd1\x72\xff\xf8\xf6\x63\xae\x3f\xd8\x3f\xd2\xf6\x11\x01\x86\x52\x7f\x9f\xca\x42\x23\x5a\x8b\x47\x55\xe1\x41\x5f\x37\x6a\x19\xaf\x57\x89\x84\xd4\x31\xde\xe5\x19\xaa\x9a\xec\x4c\x05\xd6\xcf\x2e\xf2\x2c\x74\x2c\x57\x4b\xb8\x84\xf8\x1f\x9d\x91\x10\x3a\xa3\x89\x05\xcc\xf1\x94\x60\x3a\x34\x9c\xf1\x9d\x91\x7b\x29\xda\x10\x28\x79\x26\x1a\x87\xe6\x8d\xaa\xf1\x23\x41\xb1\xa8\x04\xd3\x19\xdc\x4b\xdd\x5b\x3e\xe8\x13\xcf\x82\x94\x87\x78\x11\x41\x0b\x5a\x79\x83\x70\xfd\xd5\x8a\x0e\xb3\xa3\x6e\x2e\xc0\xe4\xd9\xa4\xd5\x82\xe5\x83\xd6\xeb\xea\x72\x95\x7b\xd4\xff\xd2\xba\xbb\x1a\xf5\x13\x97\xbe\x9f\x40\x55\xbf\x64\x3a\x75\xe5\xe8\x5c\x9e\x7c\xd8\x0a\xeb\x78\xba\x8c\xbd\xa0\x5f\x43\x5d\x86\xc7\xbd\x96\x2b\x78\xb6\x84\xd9\xe9\x2c\xb4\x81\x67\x67\xa1\x22\x85\x86\xd0\x43\xc4\x42\xd6\x37\xe5\xc1\x48\x87\xc1\xbd\x01\x83\xe7\x5c\xc9\x55\x31\x59\xba\xe4\x9d\xee\x42\xd8\xd4\x5a\x25\xb3\x53\xac\x4c\x5c\x4a\x73\xd6\x06\xc5\x0d\x03\xc4\xa4\x32\xb8\x88\x38\x94\x7b\xe1\xfc\xe0\x77\x9a\xbc\xb8\x15\x7b\x84\xa6\x15\x1b\xfb\x3a\x8f\xf9\xb3\x6a\x51\x18\x7e\x47\x2d\xa2\xe5\x53\x8c\x2f\x42\x57\xc1\x06\x23\xcb\xc5\x36\x2c\xab\xd8\xb3\xd1\x22\xa3\xe0\xad\x46\xc1\x3a\xfb\x37\x9f\x87\xb2\x51\x61\x19\xc5\x8b\x9f\x73\x39\x99\xf3\x3b\x1a\x0d\x4b\x78\xe6\x9f\x76\x52\xf5\x96\xa8\xf3\x93\x6a\x6f\xf9\xea\xeb\x00\x3c\xa3\x13\xf5\x38\x43\xb7\xd8\xb8\x72\x80\x3c\x9b\x40\x76\x6d\x6f\x8f\x76\x3d\x9f\x4c\xf1\xfb\x0c\x73\xa6\xc2\x24\x02\x7f\xa7\xf9\xa0\xd7\x89\x70\x3d\x47\xe3\x36\xca\x60\xe4\x66\x3b\x16\x02\xa6\xba\x77\xa2\xc2\x69\x61\x8c\xe7\x16\xf6\xcf\x0f\xc2\x12\xb0\xdb\x92\x51\xa1\xd2\xbb\x9d\x56\xc0\x40\xba\x01\x61\x2b\x29\xa1\xd5\x07\x34\xfe\x9d\xf7\x52\xb8\x98\x0c\x08\x24\x91\xee\x1d\x45\x59\x25\xe9\x3a\xc8\xb7\x65\xb5\xdb\x82\x36\x43\xcc\x4a\x55\xcb\x50\x88\x98\x77\x33\x31\x83\x57\x4b\xa8\x88\xe1\x15\xfd\x9a\xfd\x3e\xa3\xdf\x21\x15\xf8\x0e\x5c\x84\x14\xfc\xd0\xcb\x51\xd7\x43\xd0\x35\x3b\x0a\xcf\x70\x6c\x79\x70\x6d\xea\xc1\x8b\xb0\xa6\x11\x6d\xeb\xb6\x46\xf7\x9b\xed\x18\x74\x9f\x40\x2f\x2e\x86\x36\x27\xed\x33\x6a\x55\x96\xe3\xce\xe5\x78\xc2\x90\x3c\x22\xda\x7b\x6e\xf1\xcf\x1b\x89\x6d\xfd\x18\x2e\x51\x66\x80\xa5\xa7\xa3\xe1\x29\xe8\x7d\x1e\x86\xf9\x62\xed\x1b\xb3\x99\xa7\x23\xdd\x82\x1b\xf3\x79\x55\x04\x75\xfd\x6b\xce\x1a\x29\x7d\x8c\xce\x87\x30\xa4\xaf\x7c\x40\x26\x86\xf0\xfb\x74\xb7\x52\x69\xa2\xc0\x47\xdf\x8c\x07\x3e\x70\x14\x73\x39\x16\xbe\x4b\xd0\x7c\x73\xb6\x13\x2d\x81\x86\xae\xc9\xe7\x0d\x18\x07\xfa\x50\x61\x8e\x92\x85\x50\x80\x1f\xa9\x39\x90\x6e\xc2\x21\xfc\xf8\x3a\x8f\xba\x2c\xf8\xc4\x3c\xe4\xfc\x50\xbc\xdf\xf2\x45\xfa\x3c\xce\x89\x5d\x94\x5c\x04\x4a\x15\xc7\xbb\x31\x5d\x5f\xc7\x84\xf8\x48\xe6\xa5\x63\xca\x17\x93\xcc\x1b\x5c\x72\x90\xf5\x02\xd2\xcf\x77\x06\x2d\x2a\x97\xce\xf1\x4b\x90\xca\xfd\x60\xf4\x8e\x3d\x33\xf0\x2f\x9c\xc3\x9f\x3d\x5c\x18\xd8\x7d\x9c\xbf\xd7\xa2\xfe\x95\x64\xf4\x8f\xe1\x14\x1e\x12\xf6\xaf\xd1\x64\xa0\x70\x23\xfc\x35\x30\xcd\x5d\x80\xd5\xbe\xe7\x96\xce\x86\xa6\x91\x7c\x84\xca\xf6\x06\xc3\x62\xbe\x4a\xe2\x76\x88\x53\x11\x79\x32\xdc\xa3\x06\x19\x93\x88\xf0\x0a\x2e\x93\x7c\xf1\xdd\x12\xce\xd3\xc3\x5f\x96\xd7\x98\x8a\x13\x3f\xc7\x08\x18\xb5\xd6\x7f\xee\x0f\x49\xe4\x10\xc6\xa2\xea\x77\xf3\x11\x23\x50\xd5\x45\x50\x74\xb4\xc1\xf3\xe7\x47\x10\x70\x47\x02\x9f\x9c\x5e\x7f\xb5\xfa\xb2\x3e\x09\x3a\x4d\x2a\x79\x8a\xca\x27\x18\x9d\xf2\x63\xe4\xd9\xd9\x8b\xa7\x98\x56\x4e\x99\x36\x15\x6e\x10\xa4\xfc\x1c\x49\xfe\xd9\x58\xc9\x3e\x2f\x28\x52\x42\x09\x99\xca\x60\xb5\x80\xe1\xf7\xe7\x06\x46\xc8\x39\x6f\x23\x91\x87\xa2\x92\x6e\x4d\xa9\x41\x99\x39\xd8\x11\xb1\x2d\xaa\x90\x09\x13\x53\x69\xc5\x88\xaa\x23\x79\x60\x09\x97\x0f\xdf\x45\xef\x8e\x93\xea\x7d\x9e\x4d\xc2\x73\x3c\x2f\x81\x3e\x12\xa0\xef\x0c\x56\xa3\xf8\x0c\x38\x8f\xf2\x76\x42\xdc\x4f\x99\xec\x53\xdc\xfd\x94\xa8\x9f\xa7\x7f\x0a\xd5\xfb\x09\x87\x09\x7b\x4c\xbe\x7f\x09\xa3\xee\xf3\xc7\x7a\xcf\x63\xa3\x2a\x4d\xb7\x31\x23\x9b\x4e\x5a\x4f\x7f\xb6\xb1\xf2\x77\xe4\xfb\x52\x2a\x76\x89\x9b\xc5\x02\x5e\xf8\x3d\x68\x16\x6d\x43\xdf\x7a\xcb\xff\xe9\x15\xbe\xc7\xb6\xf1\x1b\x8e\x00\xc2\xf8\x77\x48\xdf\x85\x69\xd6\x1b\x35\xed\x9c\xe5\xd5\xd0\x34\xc3\xd9\x92\x57\xe5\xc3\xd9\x38\x1d\x8b\xd3\xa5\xd6\xe9\xec\x8a\x02\xf5\x1d\x1a\xfe\x5a\x97\x6e\xeb\xc5\xda\x6a\xb3\x8e\x57\xf9\xbe\x6a\xca\x8d\xd2\x06\x2d\x34\x9c\x53\xe9\x4d\xc3\xde\x29\x1f\x98\xe5\xdb\x5b\x87\xf3\xd9\xe9\xac\x88\xbb\x3d\x1b\x47\xfe\xd5\xe3\x46\xec\xbc\x04\xdf\x0a\xb5\x19\x59\x72\x34\x8f\xd4\x1d\x6e\xbc\x1e\xa5\x36\x7b\x78\x58\xcd\x7b\x87\x48\xfe\x7a\x19\x1c\xcb\xea\xbe\xd5\x43\xb5\xa6\xe6\x18\xe8\xab\x21\x38\xed\x3f\xfc\xa5\x4f\x67\xf1\x7b\x70\xf8\xb4\xf5\x97\x88\xbd\x93\x7c\x7e\x79\x20\xf5\xe8\xd2\x31\xd8\xeb\xef\xec\x1b\xa7\x4d\xe3\x14\x73\x9f\xae\x3f\x26\x6d\xe3\x9f\xf7\x8c\x4f\x34\x8c\x4f\x77\x8b\x7f\xd6\x2a\x3e\xde\x27\x4e\x65\x1e\x1f\x01\x1e\x6f\x1c\x93\x55\x47\x3d\xe3\x7d\xba\x08\xfc\x8f\x2d\x56\x37\xec\x4a\xfc\xe8\x8c\x18\x65\xe6\x5e\xb5\x68\xc3\x17\x7f\xfa\x38\x46\x97\x5b\xd6\xe2\xf4\x7a\xd0\x32\x08\x9d\x18\x74\xe3\x6f\xfa\x17\x20\x55\xb8\x7d\x62\x7b\x4a\xba\xf7\x77\x5a\x53\x07\x88\xca\x52\x1d\x70\x1a\x6a\x74\x58\x39\x90\x7c\xd1\x75\x3b\xa3\x86\xa7\xf5\xdf\x6b\xd7\x88\x2a\x7c\x67\x57\xfe\x5b\xbb\x58\xb7\xb7\xf0\xd3\x7f\xa6\xc9\x06\x29\xf6\xfc\x65\xe3\xb3\xf1\xf1\xfe\x89\x03\xc7\x63\x27\xd4\x47\x28\xc6\xfa\x0f\x04\x1b\xbe\xe1\x0c\x1f\x70\xa2\x55\xaf\x56\x0f\xbe\xde\x3c\xd5\xe9\xd1\x11\x4c\xbc\xa7\x53\xdb\xa4\xd7\xf3\x4b\x09\x7a\xb9\x04\x25\xdb\x27\xd7\x2b\xd9\x7e\xa3\x36\xed\x64\xf5\xb4\xd8\x3c\x5c\x11\xff\xb5\xc4\xcf\xb7\x1d\xfe\xd4\x50\xe6\x2e\xca\xc9\x65\xe6\x71\x0a\x5a\xce\xe2\xc0\x40\x1f\xba\x6e\x9a\xed\x67\x93\x2f\x44\x47\x0b\x8b\x59\xc1\x5f\x3f\xf2\xbf\x0d\x00\x87\x7a\xd6\x58\xbe\x23\x00\x00"),
+		},
+		"/src/fmt/fmt_test.go": &vfsgen۰CompressedFileInfo{
+			name:             "fmt_test.go",
+			modTime:          time.Date(2026, 10, 15, 21, 43, 27, 603449205, time.UTC),
+			uncompressedSize: 3070,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x4d\x6f\xe3\x36\x10\x3d\x8b\xbf\x62\x2c\xc0\x80\xd4\x6a\xe5\xc8\xbe\x79\xeb\x5e\xda\x6d\xb1\x97\x5e\x9a\xf6\x92\x06\x0b\xc6\x1a\x39\x6c\x64\xd2\x21\x29\xab\x40\xa0\xff\x5e\x8c\x28\x59\x1f\xb1\x15\x7b\x73\x90\x13\x86\xf3\xde\x9b\xc7\xe7\xa1\x16\x0b\xf8\xf1\xa9\x10\x79\x0a\xff\x1a\xc6\x0e\x7c\xfb\xc2\x77\x08\xd9\xde\x7e\xb3\x68\x2c\x63\x62\x7f\x50\xda\x42\xc0\x3c\x1f\xb5\x56\xda\xf8\xcc\xf3\xb3\xbd\xa5\x0f\x8d\x59\x8e\xdb\xfa\x57\xda\x2c\xe4\xce\x67\x21\x63\x5b\x25\x8d\x05\x21\xed\x2f\xaa\x90\x16\x36\x90\xdc\xdd\x31\xb6\x58\xc0\x3d\x1a\xfb\x85\x40\x32\x10\x06\x34\x1e\x72\xbe\xc5\x14\x9e\x70\xcb\x0b\x83\x60\xf0\x88\x9a\xe7\x30\x2f\xe1\x88\xfa\xc9\x00\xd7\x08\xa6\x38\x90\x00\x4c\x23\xe0\x06\x84\x84\xdf\x15\x41\x25\xf1\xf2\x2e\x66\x59\x21\xb7\x3d\xd4\xc0\xc2\x0f\x8d\x90\xf8\x3e\x84\x37\xe6\x2d\x16\x20\xd5\xdf\xd8\x63\xe5\x12\x78\x2e\xb8\x81\x4c\x69\x6a\x33\x6e\xfe\x65\x9f\xb9\x85\x54\xa1\x01\xa9\x2c\x58\x2d\x76\x3b\xd4\x70\x44\x5b\x83\x94\x5c\x4b\x21\x77\xae\x6a\x5e\xd2\xc7\x9e\x5b\x30\x56\xd3\x6a\xcc\xbc\x3e\xcb\x7a\xd3\x03\x66\xcc\x2b\x35\x3f\x1c\x30\xa5\x75\x67\x61\xfc\x07\x96\x81\x2f\xa4\x44\xed\x56\xfc\x90\x79\xa8\x75\x12\xd1\x9f\xcb\xfa\xb9\x1a\x6f\x4f\xfc\x30\x1a\x2c\x2c\xc7\x0b\x2b\x82\x21\x81\xdf\x22\x20\x1b\x08\x41\x73\xb9\x43\x78\x78\x34\x56\x17\x5b\x4b\x96\x10\x11\x34\x3f\x75\x35\xf3\xbc\x92\x4b\x7b\x8f\xff\x59\x80\xa6\xa5\x66\xed\x2f\x49\xda\x07\xdb\xfe\x3c\xe4\xc2\x02\x3c\x3c\x36\x8b\xd5\x5b\x83\xb9\x6e\x41\xbb\xde\x03\x7f\x5e\xfa\x11\x34\xfd\x87\x51\x8f\x89\x76\x0f\x1c\x88\x06\x94\xeb\xb6\x28\x62\x5e\x15\xc1\x14\x05\x4f\x53\x4c\x61\xab\xa4\xad\x61\xa7\x19\x47\x9b\xbf\x53\x40\xef\xb0\xa9\x45\x10\x2e\x34\x5c\xb6\x50\xe0\x4b\x65\x3f\x71\xf9\xa9\x39\xdd\xa1\x0e\x7f\x3e\x2b\x03\xe7\xf3\xa6\xbf\x2f\x7c\x0f\x74\xae\xfb\x01\x7b\x9b\x2d\x5b\xaa\x26\x0b\x64\x81\x73\xa1\x4b\xd4\xd8\x88\xb3\x65\x09\x2c\xfd\xa8\x7f\xca\xeb\xf6\x94\xdf\x3a\xa4\xea\x06\x49\xcf\x1a\x71\x28\x6a\xac\xcb\x25\xfd\xb2\xba\x01\x42\x02\x4b\x58\x7d\xac\xd0\x61\xde\xa0\x93\x83\x14\xb9\x63\x01\x2e\xd3\x93\xfb\x9d\x91\x52\xe4\x4e\xf5\x45\xa5\x13\x18\xb3\x32\xf8\x49\x8a\xfc\xe7\x10\x92\x29\xf1\x37\x08\x56\x12\x41\x2a\xf9\xa9\xaf\xb2\xef\xec\x30\x7c\x1f\x78\x3c\x02\x4b\xe0\x62\x38\x3f\xf6\xfe\x16\xd7\xdd\xa9\x82\x2a\x2c\xa8\x0c\x94\x4e\x91\x5a\x79\x58\x3d\x96\x30\x7f\x58\xd6\xcf\xe4\xf1\xa6\xb4\x9c\x45\x5c\xc1\x72\xda\xf7\xef\x0c\x4d\x7b\x57\x59\xb1\x47\xb3\x76\x62\x4f\xcf\xf3\xf2\x2f\x0a\x1f\x61\x25\x90\x5c\x27\xba\xba\x62\x36\xb9\xf4\x9e\x1b\x40\x2e\x94\x67\x47\xcc\x60\x82\x1f\x2f\xce\xd3\xf1\xfc\x9e\xc4\x19\x8f\xe9\x09\xd8\xe9\x21\x5d\x55\x35\x8b\xc8\x60\xa7\x6c\x04\x54\xd7\xbb\x2a\xdd\xf4\x0e\xe8\xfa\x8b\x51\xeb\xd0\xdd\x84\x71\x37\xd8\x3f\x53\x19\xcc\x36\xae\x90\x90\xbc\x4e\xe4\x6f\xf5\xb5\x6e\xdb\x30\x91\xcc\x7f\xe4\x10\x39\x84\x0d\xcc\x8f\x0d\x6f\xdd\x45\xcb\x15\x75\x82\x42\xe6\x79\x95\x13\x79\xa2\x77\x17\xe7\x6c\x53\x4f\x8a\x9a\xb7\x90\xce\x00\x1d\x81\x7a\xa1\x1e\x5a\xa4\x38\x10\xd2\xa2\xce\xf8\x16\xdf\xe0\xc4\xdb\x04\x00\x2a\x42\x27\xe8\x99\x7a\x71\x40\x1f\x75\xf0\xcc\xe9\x5e\x79\x8f\xb4\x47\xfb\xac\xd2\x5e\x0b\x35\x72\x05\x98\x1b\x84\xb1\xc1\x27\xb5\x27\x27\xa2\x51\x73\x9f\x61\xd6\xbc\x11\xc6\xbf\x22\x1e\xbe\xbc\x16\x3c\x0f\x3a\x4f\xae\xd3\xfa\x4e\xe4\x95\x76\xd7\x7e\xb7\x6f\x3f\xa8\x75\xf7\xf2\x33\x3a\x01\xa7\x82\xec\x6b\x0e\xf6\xab\x09\x3a\x4c\x32\xa1\xd9\x72\x65\x2e\xbe\x9a\xa0\xae\x9c\x1f\x29\x1a\x19\xcf\x0d\x36\x72\xad\x2e\x70\x20\xb8\x75\xd8\x69\xad\xba\x90\x0c\x8c\x3e\xa5\xa0\x66\x1f\xd8\x4c\x5f\x90\xc9\x00\xf7\xca\xc8\xb8\xd7\xd6\xb8\x57\xff\x7d\x3a\x2b\x56\xb1\xff\x07\x00\xfb\x62\x85\x91\xfe\x0b\x00\x00"),
 		},
 		"/src/go": &vfsgen۰DirInfo{
 			name:    "go",
-			modTime: time.Date(2021, 4, 24, 15, 1, 6, 342651800, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/go/token": &vfsgen۰DirInfo{
 			name:    "token",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/go/token/token_test.go": &vfsgen۰FileInfo{
 			name:    "token_test.go",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x74\x6f\x6b\x65\x6e\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0a\x09\x22\x74\x65\x73\x74\x69\x6e\x67\x22\x0a\x29\x0a\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x46\x69\x6c\x65\x53\x65\x74\x52\x61\x63\x65\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x20\x7b\x0a\x09\x74\x2e\x53\x6b\x69\x70\x28\x29\x0a\x7d\x0a"),
 		},
 		"/src/golang.org": &vfsgen۰DirInfo{
 			name:    "golang.org",
-			modTime: time.Date(2021, 5, 23, 13, 5, 43, 160000000, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/golang.org/x": &vfsgen۰DirInfo{
 			name:    "x",
-			modTime: time.Date(2021, 5, 23, 13, 5, 43, 160000000, time.UTC),
+			modTime: time.Date(2026, 10, 15, 20, 0, 31, 641153156, time.UTC),
 		},
 		"/src/golang.org/x/crypto": &vfsgen۰DirInfo{
 			name:    "crypto",
-			modTime: time.Date(2021, 5, 23, 13, 5, 43, 160000000, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/golang.org/x/crypto/internal": &vfsgen۰DirInfo{
 			name:    "internal",
-			modTime: time.Date(2021, 5, 23, 13, 5, 43, 160000000, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/golang.org/x/crypto/internal/subtle": &vfsgen۰DirInfo{
 			name:    "subtle",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/golang.org/x/crypto/internal/subtle/aliasing.go": &vfsgen۰CompressedFileInfo{
 			name:             "aliasing.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 782,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x90\x4b\x6b\xdc\x3e\x14\xc5\xd7\xa3\x4f\x71\x18\xfe\xe4\x6f\x93\x8c\xd5\x6c\x43\x53\xe8\x2a\xb4\x9b\x2c\x1a\xe8\xa2\x74\x21\xcb\x77\x6c\x4d\x65\x5d\x73\x75\xdd\x58\x94\x7e\xf7\xe2\xc9\xab\x0f\x92\xd2\x9d\x2e\xfc\xce\x43\xc7\x5a\x9c\xb6\x73\x88\x1d\x0e\xd9\x98\xc9\xf9\x2f\xae\x27\xe4\xb9\xd5\x48\xc6\x58\x8b\x9b\x21\x64\xec\x43\x24\x74\xf3\x14\x83\x77\x4a\x1d\x42\x86\x0e\x94\x09\x7a\xcb\x88\xec\x9d\x06\x4e\xf9\x62\xe5\x77\xc8\xe2\xad\x97\x32\x29\xdb\x90\x94\x24\xb9\x68\xef\x0c\xed\x13\xd0\x73\x74\xa9\x6f\x58\x7a\xbb\x3c\x4b\x9b\x30\x4e\x2c\x8a\x6d\x1f\x74\x98\xdb\xc6\xf3\x68\x7b\x9e\x06\x92\x43\x7e\x7a\x1c\xf2\xf6\xd8\xf4\x6d\x2a\xd7\x5f\x49\xa2\x9b\x20\xb4\xea\x32\x6e\x07\xd2\x81\x04\x0b\x5c\xea\x50\x90\x07\x27\x84\x91\x46\x96\x02\xa7\x70\xa9\xa0\x4a\xac\x48\xe4\x29\x67\x27\x21\x96\xd5\xca\xb3\x08\xe5\x89\x53\x17\x52\x5f\x23\xa4\x8e\x96\x06\x37\xc3\xa3\xb6\xa5\xc2\xa9\x5b\x47\x40\x8e\xc1\x13\x22\xa5\x5e\x87\x75\x98\xd0\x27\x16\xea\x1a\xb3\x9f\x93\xff\xa9\x54\xb5\x9c\xa1\xe0\xd3\xe7\xb6\x28\xd5\x68\x99\x23\xbe\x99\x8d\xb5\xb8\x3a\x7e\xe4\xfd\x87\x0b\x7c\x24\x78\x97\xfe\x57\x08\xc5\x02\x4e\x98\xf8\xb8\x09\x9c\x04\x1d\x46\xd2\xe0\xcf\x90\x19\x73\xa6\x47\xd5\x7d\xfe\xc3\x76\xb9\x31\x1b\x21\x9d\x25\xad\x95\xaa\xa5\xc6\x1b\xbc\xc2\xc9\xc9\xf1\x2a\x0f\x97\xd9\x6c\x0e\xb9\x79\x77\xaf\xb9\x6e\x0f\xe4\xb5\x5a\xea\xe6\x8a\xb4\xda\xfe\xe7\x44\x5c\xd9\xd6\xb8\xbc\xc4\x9f\x54\xf9\x9d\xfa\x9b\x1b\xef\xf7\x99\x74\x5b\xaf\x40\x55\xe3\xf5\x8b\xa6\xbf\xc2\xa7\x77\xa5\x77\xe7\xcf\x85\x94\x7f\x09\x59\x5e\x08\x59\xea\xdd\xb9\xf9\x6e\x7e\x04\x00\x00\xff\xff\x19\x84\xbe\x4e\x0e\x03\x00\x00"),
 		},
+		"/src/golang.org/x/sys": &vfsgen۰DirInfo{
+			name:    "sys",
+			modTime: time.Date(2026, 10, 15, 20, 0, 31, 647110819, time.UTC),
+		},
+		"/src/golang.org/x/sys/cpu": &vfsgen۰DirInfo{
+			name:    "cpu",
+			modTime: time.Date(2026, 10, 15, 20, 0, 46, 453154037, time.UTC),
+		},
+		"/src/golang.org/x/sys/cpu/cpu.go": &vfsgen۰CompressedFileInfo{
+			name:             "cpu.go",
+			modTime:          time.Date(2026, 10, 15, 20, 0, 46, 458619890, time.UTC),
+			uncompressedSize: 184,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x3c\xcc\x41\x4a\x03\x41\x10\x46\xe1\x7d\x9d\xe2\xdf\xa9\x28\xb6\x17\x70\xe5\x42\x14\x41\x61\xf0\x00\x6d\x4d\x8d\x53\xda\x54\x37\x5d\xd5\x82\x09\xb9\x7b\x48\x06\xb2\x7d\xf0\xbe\x94\x70\xfb\x35\xb4\xcc\xf8\x71\xa2\x96\xf9\x37\x7f\x0b\xb8\x0d\x22\xae\xe6\x01\xce\xbc\xca\x9b\x9a\x4c\xba\x13\x3c\xe2\x81\x28\x25\xe4\xce\xeb\x8b\x69\xa0\x48\xfe\x13\x47\x2e\x05\x8b\xe4\x18\x5d\x1c\xc3\x5c\xe2\x0e\xcf\xb5\xad\xd2\x5f\x27\xcc\x55\xdc\xae\x02\xc3\x05\x4f\x1f\x9f\x50\xf3\xe8\x83\x43\xab\xf9\x09\x9b\xb5\x0b\x47\xf9\xbf\xa7\x65\x18\x5f\xec\xeb\x1b\xec\x0f\xb4\x35\x35\x8d\xf7\x76\x3e\xb6\x7c\x1c\x00\xf3\x4b\x6a\x6a\xb8\x00\x00\x00"),
+		},
+		"/src/golang.org/x/sys/unix": &vfsgen۰DirInfo{
+			name:    "unix",
+			modTime: time.Date(2026, 10, 15, 20, 0, 46, 449154036, time.UTC),
+		},
+		"/src/golang.org/x/sys/unix/unix.go": &vfsgen۰CompressedFileInfo{
+			name:             "unix.go",
+			modTime:          time.Date(2026, 10, 15, 20, 0, 31, 648204509, time.UTC),
+			uncompressedSize: 1460,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x94\x4f\x4f\xdb\x5a\x10\xc5\xd7\xdc\x4f\x71\x94\xcd\x4b\xde\xf3\x4b\x04\x6d\x59\x74\xc7\x02\xa1\x76\x91\x20\xc2\xbe\xba\xb1\xc7\xf1\x25\x37\x33\xd6\xdc\x31\x2e\xaa\xf8\xee\x95\xff\x00\x41\x14\x54\xa9\x65\x67\xcd\x1d\x9f\x33\xf3\xd3\xd1\x2c\x16\xf8\x6f\xd3\x84\x58\xe0\x26\x39\x57\xfb\x7c\xe7\xb7\x84\x86\xc3\x77\xe7\xc2\xbe\x16\x35\x4c\xdd\xd1\x24\xdd\xa5\xdc\xc7\x38\x71\x47\x93\x86\x93\x2f\x69\xe2\x66\xce\x2d\x16\xb8\x90\xba\x22\xfd\xba\xfe\x8c\xf5\x5d\x32\xda\xa3\x6b\x4b\xf0\x4a\x28\x45\x5b\xaf\x05\x15\x30\x81\x55\x84\x51\x04\xa3\x4b\x86\xb6\x0a\x79\x85\xbd\xdf\x51\xea\xb4\xac\xa2\x3d\xac\x52\x69\xb6\x15\x96\x52\xd0\xfc\x26\x21\x94\x08\x86\xdc\x73\x86\x44\x84\x42\xf2\xc5\xa8\x93\xe6\xfb\x62\xee\x5c\xd9\x70\x8e\xf5\x50\x9a\x9a\xfa\x3a\x83\x3f\xce\xe0\x4f\x32\xf8\x0f\x68\x02\x5b\x6d\x3a\xc3\x54\x8f\x33\xe8\xc9\x43\x21\x03\xa9\x3e\x4c\x34\x3f\x57\x65\x99\xe1\x87\x3b\x52\xb2\x46\xf9\xf1\xe1\x35\xdd\x99\xbb\x7f\xee\x7c\xfa\xa2\x25\x83\xff\x98\xc1\x7f\xca\xe0\x4f\xff\xd2\x18\x6f\x9b\x3c\xcd\x74\xe5\xdb\xf7\x02\xf2\x86\xf4\xaf\xfc\xdf\x17\xcb\xef\xfa\xf4\x93\x2d\x16\x58\x93\x2d\x85\x37\x51\xf2\x1d\x22\xf9\x5b\x4a\x28\x43\x24\x14\x94\x72\x0d\xb5\x89\x26\x04\x46\xdf\x10\x78\x8b\x7d\x97\xc1\xc7\x88\xa3\xf2\x09\x2c\xa8\x25\x46\xd2\x3e\xb0\x82\xd6\x07\x83\xf0\x33\x09\xab\xbc\xc1\x2b\xf1\x3f\x06\x25\x5f\xdc\x65\x48\x02\x16\xfe\xff\x51\xb9\x2b\x27\x78\x2e\xd0\x6a\xb0\x21\xfe\xad\x34\xb1\x40\xe9\x43\x44\x1b\xac\xc2\xf9\xd9\xc5\xd9\x97\x25\x02\x27\x23\x5f\x40\xca\xde\x2c\xf0\x76\x3e\x26\xef\x69\x9b\x69\x59\x20\xb0\x65\xe0\xb1\xd0\x79\x6c\x44\xe2\x0c\xd3\x8e\x24\xa9\x8a\x1e\x12\xe4\x10\x47\x26\x89\x2c\x49\xbe\x93\xda\xe0\xf3\x9c\x6a\x1b\xc6\x0a\x5b\x16\xa5\x04\x1f\x23\xba\x77\x32\x48\x6d\x41\x38\x1d\x00\x29\x84\x52\xb7\x64\x93\x68\x6c\xea\x14\x5f\x20\x2d\x45\xc1\x64\xad\xe8\x0e\xb9\x30\x53\xde\x0b\xf5\x54\x62\xd8\xa8\xd7\x40\x09\xd6\x70\x37\xb5\x55\x0f\x5a\x09\x52\x8e\x67\x21\xe8\xe1\x8f\xd8\x92\x81\xc5\xaa\xbe\x5f\xba\x15\x86\xdb\x21\x3c\xa2\x79\x5a\x6a\x9a\x06\x30\x91\x6e\x29\x0e\x9f\xec\xf7\x34\x7c\xdd\xfa\x88\xe1\x9c\xcd\x2f\x25\xb0\x91\xf6\xb5\x48\x7c\x90\xcc\xb7\xf8\xf5\x66\xdb\x3f\x36\xfb\xf7\xdb\x5a\xf2\x5d\x24\x7e\xcd\xee\x7c\xb9\xba\xbc\x5a\x5d\xaf\x56\x97\xd7\xee\xde\xfd\x1c\x00\x2d\xbf\xba\xed\xb4\x05\x00\x00"),
+		},
+		"/src/golang.org/x/sys/unix/unix_darwin.go": &vfsgen۰CompressedFileInfo{
+			name:             "unix_darwin.go",
+			modTime:          time.Date(2026, 10, 15, 20, 10, 27, 634007548, time.UTC),
+			uncompressedSize: 1385,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x93\x41\x6f\xd4\x30\x10\x85\xcf\xf5\xaf\x78\xca\x85\x44\x58\xbb\xb4\x40\x61\xcf\x08\x21\x71\x69\xd5\x5c\x38\x81\xa6\x59\xef\xc6\xd4\x1e\x5b\x63\xa7\x4b\x85\xf8\xef\x28\x69\xd2\x56\x40\x96\x56\x6d\x4f\x89\x46\x99\xf7\xbe\x79\x33\x59\x2e\xf1\xf2\xbc\xb3\x6e\x8d\xef\x49\xa9\x48\xcd\x05\x6d\x0d\x3a\xb6\x3f\x94\xb2\x3e\x06\xc9\x28\xd2\x55\x6a\xc8\xb9\x42\xa9\xe5\x12\x9b\x8e\x9b\xd3\x0f\xe8\x0b\x09\xce\x9e\x37\xc8\x42\x3e\x06\x67\xd9\x24\x8d\x5d\x6b\x9b\x16\x9f\x42\x6c\x8d\x7c\xae\xb1\x0e\x26\xf1\x8b\x8c\x96\x2e\x8d\x46\x0a\xc8\x2d\x65\xe4\xd6\xf4\x52\x05\x53\xb6\x97\x66\x90\xcc\x36\x30\x38\x64\x58\x1f\x9d\xf1\x86\xb3\x59\x17\x88\xc4\xb6\x01\x93\x37\xa9\x6f\x82\xb7\x29\x59\xde\xde\x74\x2c\x70\xc2\xee\x6a\xd2\x9b\xaa\x09\x62\xa2\xa3\xc6\xac\x61\x79\x98\x65\xb1\x0d\xd8\x05\xb9\x40\x60\x78\x6a\x4e\x6a\xb4\x21\xe5\xb4\x50\x7d\xcb\x38\x52\xb9\x19\x5e\xca\xaa\x42\x67\x39\xc7\x2c\xf8\xa9\x0e\x36\x65\xa5\x0e\xc4\xe4\x4e\x18\x5f\xc7\x7a\xf9\xaa\x52\xbf\xd4\x75\x6f\x7d\x9d\xcd\xaa\xe4\xce\x6b\xd0\xa1\x06\x1d\x69\xd0\x6b\x0d\x7a\xa3\x41\x6f\x35\xe8\x58\x83\xde\x69\xd0\x7b\x0d\x5a\x4d\xda\x15\x4a\x39\xd4\x90\xa3\xa9\xa0\x61\x44\x30\x46\xbd\xf8\x28\xc2\xa1\xea\x01\x86\x08\xca\x62\xf2\x81\x4d\x7f\xc5\x74\x4b\x33\xb6\x7f\x1b\x9f\xe5\x86\xef\x32\xfd\xc7\xfb\xd6\x73\x9c\x77\x82\xa9\xff\xa9\x36\xeb\x7a\xfc\xc7\x87\x77\xa3\x78\x24\xc2\x3e\xe9\x79\x9e\x2f\x4f\x01\x34\xee\xe1\x46\xf3\x41\x8b\x58\xed\x21\x78\xc0\x75\xcc\xd1\xdc\xf3\x2a\x84\x76\xf5\x93\x1e\xc6\xd9\x9c\xe0\x3e\xef\xe7\x38\x8f\xb3\x7b\xa9\xcf\xae\xe7\x34\xcb\xb3\xff\x2a\xbf\x07\x00\x0c\x80\xc2\x61\x69\x05\x00\x00"),
+		},
+		"/src/golang.org/x/sys/unix/unix_linux.go": &vfsgen۰CompressedFileInfo{
+			name:             "unix_linux.go",
+			modTime:          time.Date(2026, 10, 15, 20, 0, 31, 649380656, time.UTC),
+			uncompressedSize: 297,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\xce\x31\xcb\xc2\x30\x10\xc6\xf1\xb9\xf7\x29\x1e\x3a\xb5\xbc\xe1\x2d\xad\xb3\xa3\xab\x83\x7e\x00\x39\x63\x95\x68\x4c\xc2\x25\x41\x45\xfc\xee\x0e\x0d\x74\x10\x9c\x1c\xef\x19\x7e\xff\xeb\x3a\xfc\xed\xb3\xb1\x07\x9c\x23\x51\x60\x7d\xe1\xd3\x88\xec\xcc\x9d\xc8\x5c\x83\x97\x84\x3a\x3e\xa2\x66\x6b\x6b\xa2\x63\x76\x1a\xdb\xe9\x5c\xfb\x95\x88\x97\x26\x09\x07\x05\xee\x15\x78\x50\xe0\x05\xb2\x71\x29\x24\x69\xd1\x48\xaf\x20\xc3\x3c\x3c\xa9\x9a\x26\x85\x1d\x96\x28\xee\x7f\x01\x3f\xa4\x96\x2a\x19\x53\x16\x47\xaf\x92\xde\xf0\xed\xe7\xf5\xd9\xfc\xfa\xc0\x7b\x00\xc9\x8c\xa3\xa5\x29\x01\x00\x00"),
+		},
+		"/src/golang.org/x/sys/unix/unix_linux_amd64.go": &vfsgen۰CompressedFileInfo{
+			name:             "unix_linux_amd64.go",
+			modTime:          time.Date(2026, 10, 15, 20, 0, 31, 651582451, time.UTC),
+			uncompressedSize: 247,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x44\xcc\xcd\x4a\xc4\x30\x14\x47\xf1\x75\xef\x53\xfc\x09\x08\xc9\x08\x69\x07\x64\x16\xc2\xac\x54\x06\xd7\xea\x03\xa4\x9d\x3b\x9d\xd4\x34\x29\xc9\x4d\x75\x10\xdf\x5d\xf0\x03\x77\x87\xdf\xe2\xb4\x2d\xae\xfb\xea\xc3\x11\x53\x21\x5a\xdc\xf0\xea\x46\x46\x8d\xfe\x9d\xc8\xcf\x4b\xca\x02\x4d\x8d\x2a\x97\x32\xb8\x10\x14\x51\xa3\x46\x2f\xe7\xda\xdb\x21\xcd\xed\x98\x96\x33\xe7\xa9\xfc\xc7\x54\x14\x19\xa2\x53\x8d\x03\x46\x16\xf1\x33\xa7\xd3\xd1\x5d\xb4\xac\xd8\x3c\xfb\x99\x57\x17\x0c\x34\xe7\x8c\xdf\xa7\x7d\xc8\x39\x26\x83\x0f\x6a\x6a\xc1\xed\x1e\x53\xb1\x87\x90\x7a\x17\xec\x81\x45\xab\x7b\x27\xac\x8c\xbd\x73\x21\x68\x15\xd3\x9b\x32\xf6\x31\xca\xee\x46\x1b\x6c\xb0\xed\xba\x8e\x1a\x59\xed\x13\x0f\xd8\xa3\x16\xb4\xd8\xf2\xee\x9b\x5e\xca\x9f\x5d\xfd\x58\x66\xa9\x39\xa2\xa3\x4f\xfa\x1a\x00\xe0\x61\x4d\xbc\xf7\x00\x00\x00"),
+		},
 		"/src/hash": &vfsgen۰DirInfo{
 			name:    "hash",
-			modTime: time.Date(2021, 4, 11, 12, 5, 46, 971374400, time.UTC),
+			modTime: time.Date(2026, 10, 15, 17, 50, 7, 647593136, time.UTC),
+		},
+		"/src/hash/adler32": &vfsgen۰DirInfo{
+			name:    "adler32",
+			modTime: time.Date(2026, 10, 15, 17, 50, 7, 711593140, time.UTC),
+		},
+		"/src/hash/adler32/adler32.go": &vfsgen۰CompressedFileInfo{
+			name:             "adler32.go",
+			modTime:          time.Date(2026, 10, 15, 17, 50, 7, 716118781, time.UTC),
+			uncompressedSize: 4920,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\x6d\x6f\xdb\x38\x12\xfe\x6c\xfd\x8a\x59\x03\x77\x90\x76\x05\x45\x92\x65\x67\x37\xa9\x0b\xec\x2d\x16\x45\x16\xe8\xdd\x01\xd9\xe2\x3e\x14\x45\x41\x49\xa3\x98\x09\x4d\xaa\x24\x95\x97\xed\xf5\xbf\x1f\x48\xca\x16\x25\x39\x69\x7b\x01\x22\x50\x33\xc3\x67\x66\x9e\x19\xd1\xc3\xb3\x33\xf8\xa9\xec\x28\xab\xe1\x56\x05\x41\x4b\xaa\x3b\x72\x83\x40\x6a\x86\x72\x95\x07\x01\xdd\xb7\x42\x6a\x58\xde\x50\xbd\xeb\xca\xa4\x12\xfb\xb3\x1b\xd1\xee\x50\xde\xaa\x61\x71\xab\x96\x41\x70\x76\x06\x7f\xee\x10\xaa\x1d\x56\x77\xaa\xdb\x03\x13\xa2\x05\xd1\x40\xd7\xd6\x44\x23\x50\x05\xb2\xe3\x50\x3e\x01\x01\x4d\xf9\x13\xfc\x07\xcb\x5f\x95\xc2\x7d\xc9\x9e\x60\x2f\xea\x8e\x21\x3c\xec\x90\x1b\x1c\x5f\x45\x15\x90\x7b\x42\x19\x29\x19\xc6\xf0\xb0\xa3\xd5\xce\xc8\x14\xde\xa3\x24\x0c\x34\xdd\xa3\x82\x86\x28\x8d\x12\xf4\x8e\x70\xa0\x1c\xfe\x20\xf7\xe4\xba\x92\xb4\xd5\x89\x81\xbb\xd2\x0a\x94\xe8\x64\x65\xa2\xb8\x08\xce\xce\x8c\x10\x42\xe7\xd4\xae\xcd\x1b\xee\x85\x7c\x82\x10\x1f\x5d\xc2\xee\x7d\x19\x41\x16\xf5\x26\x97\x97\x87\x5c\x24\xea\x4e\x72\x05\xda\xcf\x57\x34\xf6\x9d\xa1\x49\x52\xa3\x02\xa2\xa1\xd5\x12\x1a\x21\xad\x82\x72\xaa\x29\x61\x03\xd8\x71\xa7\x25\x3b\x06\x46\xef\xb0\xf7\xf0\x06\x39\x4a\x5a\x25\x87\xe0\x9a\x8e\x57\x43\x68\xce\x66\x19\x41\xd8\x12\x49\xf6\x40\x57\xf9\xe1\x3f\x82\x50\xa2\xea\x98\xee\x5f\x98\xa8\x08\xf3\x0d\xac\xbc\x87\x05\xb0\xea\xe4\x06\x35\xa4\x47\x19\x5d\xe5\x49\x25\xb8\xd2\xb0\x59\xaf\x57\xeb\x91\x9c\xf0\x7a\xb2\x57\xa1\x86\xd5\x37\xe2\x65\x9b\x91\x50\xed\xe4\xc7\xee\x04\x5c\x71\x94\x95\x4c\x54\x77\xc7\x37\xb0\x2d\xe5\xbd\xfa\xfe\xf2\x91\xdc\xc0\xe3\xa7\xbf\x46\xb2\x52\x7e\xa4\x0d\x64\xdf\xbc\xdf\xc5\xbc\x5e\xaf\x73\x53\x2c\xbe\x27\x8f\xff\xc7\xd6\x99\x8a\x69\x2f\x67\xf3\xa7\x90\x61\xa5\x4f\x40\x6b\x44\x58\x3f\xe3\x32\x9b\xe1\x92\xba\x3e\x61\x6b\xe8\xdc\x7c\x53\xd8\x83\x7c\x3d\xc3\x56\x5d\xf9\x0c\xf6\x14\x63\x52\x1e\x1f\x76\xf5\xac\x26\x9b\x68\x2c\x4d\x82\xd4\x3f\x4f\x98\x3a\x9d\xa8\x4f\xd7\xf3\x3e\x8a\xef\x40\x52\x27\xec\x5f\x8e\xb6\x6f\xef\xef\x8c\x36\x7b\xd6\xc7\xe6\x14\x23\x7a\x46\x87\xeb\xe8\x74\x24\x45\x5e\x3f\x53\xd7\xd5\x33\x6d\xba\x59\xaf\xf3\xcc\xb4\xf8\x5e\xd4\x33\x13\x89\xfb\x89\xdb\x53\x9f\xfd\x4b\x5c\x4f\x1c\x7d\x97\x87\x62\xf2\x01\x8f\x72\xf5\x33\xc5\xd9\xb9\x34\x0e\xe4\x85\x33\x88\x9d\xd8\xb8\x1a\xd9\x08\x19\x45\xc1\x3d\x91\xf0\x40\xd4\xfe\xad\xfb\xa5\xda\xc2\xfb\x0f\xe6\x94\xff\x1c\x2c\xd2\xc7\x34\x8d\x21\x7d\xdc\x64\xe6\x79\xbe\xb2\xeb\xda\x3c\x53\x2b\x71\xda\xd1\xd3\xc9\x7f\x1e\xd6\x9b\x34\xb6\x40\x76\xf3\x79\x33\x7d\x3a\xa3\x7e\x6d\x6d\xd2\x7c\xe6\x60\xdd\x6b\x2d\x50\x36\xf3\x76\x6e\x9e\x99\xbf\x79\x63\x41\xed\xb6\x73\x97\x41\xe1\xe5\x51\x58\xa0\xcd\x7a\x16\xfc\x66\x48\xd0\x69\xfb\xb5\x8b\xd7\x42\x9f\xff\xe2\xb9\xe9\x53\x23\x83\xb7\xd4\x63\xaa\x8f\xae\x18\x12\xcc\x3d\x67\x85\xd5\x36\x4d\xff\xf4\x38\xb2\x8a\x3c\x1b\x18\x99\x6f\xcb\xec\xfa\x7c\xe3\x59\x16\x7d\x5c\x06\xa8\x48\x87\xcd\x6e\xdd\x43\xd8\xb0\x0b\x17\xa9\x57\xc7\x91\xd6\x4a\x4a\x97\x5a\x5e\x3e\xab\x86\x83\xb6\xb0\x8c\x64\xce\x32\x1f\xea\x95\x1f\x4a\x64\xc9\x26\x5e\xa8\x9b\x29\x68\xee\x95\x79\x53\x7a\x96\xf9\x28\x0f\x1b\x51\x3a\xe3\xc5\xa5\x50\x4f\xab\xd9\xbb\xcc\x67\xf6\xc5\x3c\xa2\x62\x0a\xe7\xd2\xec\x3b\xd8\x07\xf2\xf9\xda\x38\xb2\x7f\xf1\xe8\x74\x0a\x9f\xb5\x95\x57\x6c\xaf\xe4\x7d\xad\xd3\x3e\x88\xa1\xfc\xc7\x20\xbf\x65\xdb\x50\xf8\xca\x6f\xc8\x72\x16\x45\x31\xeb\x9d\x62\x1a\xa3\xeb\xef\xb4\x8c\x83\x2f\x76\xe2\x35\x47\xc2\x3f\xba\xa6\x41\x79\x4d\xff\xb2\x43\xae\x19\xf5\x94\x59\xf7\xf3\x60\x3f\x57\x1e\xde\xec\xf1\x71\x98\x63\x8d\xa4\x26\x9a\x00\x55\x06\xac\x12\x2d\xc5\x1a\xb4\x48\x02\x77\x58\x4d\xd0\xb7\xb0\x29\xe0\x47\xc8\xd2\xbc\x38\x3a\x7f\x4b\xb9\xef\x99\x21\xbf\xd1\x3b\x28\x91\x89\x07\xcf\x49\x45\x18\x03\xca\xb5\x18\x8d\xd6\x95\x50\x5a\xc1\x5e\x48\x3b\x04\xbb\xe1\x59\x83\x22\xf7\xa8\xfc\x08\x0e\x2e\x8c\xfb\xc0\x1e\x84\x61\xb0\x30\x8a\x3f\xa5\x09\x17\x4a\x21\x98\x13\xbc\x73\xe3\xf1\x8f\xb7\x2a\xf9\x57\x79\x8b\x95\x86\xfe\x52\xd0\xcf\xcd\x66\x8c\xd5\x54\xf0\x29\x1b\xb4\x19\x66\xfc\xc4\x41\xbd\x75\xb4\x8d\xa1\xde\x51\xae\x7f\xfe\x55\x4a\xf2\xf4\x12\xbb\x49\x10\x1d\xe9\xb9\xe2\x54\x03\xe5\x4a\x13\xae\x29\x31\x53\xf9\x60\x07\x82\x43\x43\xa5\xd2\xd0\x29\x4c\xe0\x4a\x03\x43\x93\x3b\x78\xa9\x70\xca\x80\x36\x27\xee\x24\x5c\x68\xff\x5e\x82\xc9\x4d\x02\x75\x87\xa0\x05\x10\xf8\x4d\x70\x8d\x5c\xc3\x35\x56\x9d\xa4\xfa\x09\xfe\x2d\x18\xad\x9e\x92\xc0\xce\xf1\x87\xb8\xc2\x08\x3e\x07\x0b\xda\xc0\xc0\xe5\xe7\x60\xb1\x70\x97\x8b\x60\xf1\xc5\x27\x79\x0b\x5a\x76\x68\x24\x70\xb1\x85\x5b\x95\xbc\x61\xa2\x24\x2c\x79\x83\x3a\x5c\x7a\xa1\x2d\xa3\x1e\x11\xb6\xd6\xec\x1d\xaf\xb1\xa1\x7c\x0e\x5d\x63\x83\xd2\x16\xc4\x85\x61\x76\xa1\xc1\x96\x58\x89\x7b\x94\x61\x74\x09\x08\x3f\x6c\x2d\x03\x46\x6f\x0c\x3e\xc6\x40\xd5\x1f\xd7\xbf\x4b\x69\x2c\x31\x09\x4d\x79\x7e\x97\x52\xc8\xe8\x12\x7e\x38\xa8\xac\xf5\xa2\x25\x9c\x56\x21\x46\xe6\xe5\x8b\x79\x0c\xac\xc6\xe0\x55\xd8\x7a\x88\xcd\x23\xb0\x86\x5f\xc2\x28\x58\x9c\x9d\xc1\xf5\x13\xaf\x76\x52\x70\xd1\x29\xa8\xc4\xbe\xa5\x8c\xd8\xd6\x31\xf7\x41\xc6\xc4\x03\xd6\xf6\x66\xa5\xf6\xa6\xb5\x5d\x41\x55\x0c\x78\x8f\xdc\xd4\xd5\x56\x99\x50\x6e\xa1\xf4\x4e\x22\xa9\x4d\x93\x94\x52\x3c\x28\x94\x2a\x09\x16\xee\x32\xa5\x4c\x22\x0f\xc4\xf1\x78\x65\xfb\xa4\xc2\x65\x94\xfc\x13\x1f\xc2\x83\xd8\xfd\xe4\x1f\x85\x87\x19\x20\x8a\x9c\xba\x07\x32\xcc\x7b\x8d\xb3\x85\x5e\xee\x8c\x0e\x57\xb6\x51\x73\xcf\x0a\x39\xf4\x77\xef\x6d\x84\x71\xb8\x91\xba\xb7\xd2\x9e\x0b\xcb\x28\x32\x27\x91\xed\x2b\xe7\x23\xac\xa1\xa6\x37\xa8\x74\x0c\x6d\x3f\xa4\x44\xbd\xa4\x6f\x37\x86\x3c\x6c\x23\x78\x35\xfa\xb8\x87\xf6\x18\x5f\x41\xc3\x3a\x86\x36\xb2\x2d\xd3\x77\xaa\x6b\xdd\x4b\xff\x2b\xd9\x0e\x6d\xf2\x15\x0c\x53\xb2\xde\xff\x6b\x48\xed\x0e\x6e\x4a\xe0\x64\xae\x0d\x39\xbc\x9e\x9e\x7c\xb6\xa5\x38\x6c\x27\x72\xd7\x31\x1e\xa5\xc9\x6f\x84\xb1\x70\xa9\x50\x2f\x63\x68\xdf\x5f\xf0\x0f\x06\xd3\x7c\x3e\x8e\x81\x70\x08\x3a\xb9\xe2\xf7\xe2\x0e\xc3\x8e\x72\xbd\xca\xc3\x3a\x8a\x21\x8d\x81\x47\xc9\x15\xd7\x61\x64\xb6\xb5\xb0\x85\xf6\x3d\xbf\xf8\x60\x23\xef\x13\xab\xfb\x83\x7f\x94\xe0\xe1\xf4\x15\x92\xde\x50\x4e\x58\xaf\x4d\xfc\xba\x1c\xb9\x78\xb1\x3c\x2a\x8b\x41\xe5\x86\x91\x43\x5c\x7f\x37\xbf\x6a\x4d\x13\xc5\x47\xc9\xeb\xd7\xd9\x26\x3a\x49\xa5\x39\x9b\x3f\xf5\xa8\x8e\xcb\xa3\x81\xb9\xbf\x3a\x1a\xdb\x18\x3e\xd9\xcc\x2e\x8c\xec\x83\xe1\xc9\x2c\x4c\x9a\x96\x4d\x1f\x77\x0b\x85\xdb\xa4\x32\xf8\xe9\x18\x53\xfb\x3e\xb5\xbc\x2e\x54\x6e\xa4\x2a\x3b\x61\x91\x7d\xd5\x22\xff\xaa\xc5\x6a\x6e\xe1\x6a\x52\x8c\x82\xfd\x18\xc3\xa3\x3d\xb9\x08\xbf\x41\x68\x4f\x04\xfc\x38\xc5\x31\x5b\x55\x06\x7f\xdb\xda\x4b\x8f\x55\x1d\xd7\xc6\xc5\xa7\x51\xc9\x5d\xeb\xa8\xfc\xd5\xab\x6c\x03\xff\x05\x95\x99\x6f\xee\x7f\x01\x00\x00\xff\xff\xda\x46\x61\xf4\x38\x13\x00\x00"),
+		},
+		"/src/hash/crc32": &vfsgen۰DirInfo{
+			name:    "crc32",
+			modTime: time.Date(2026, 10, 15, 17, 48, 40, 353125491, time.UTC),
+		},
+		"/src/hash/crc32/crc32.go": &vfsgen۰CompressedFileInfo{
+			name:             "crc32.go",
+			modTime:          time.Date(2026, 10, 15, 17, 48, 40, 353125491, time.UTC),
+			uncompressedSize: 4294,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x57\x5f\x6f\xdb\x38\x12\x7f\xb6\x3e\xc5\xd4\x4f\x52\x4e\x91\x15\xd9\x71\xd2\xe4\x5c\xa0\x17\x04\x45\x8a\xeb\xdd\x62\xd3\x62\x1f\x82\x6c\x41\x49\xb4\xcd\x84\x26\x05\x92\xb2\xe3\x0d\xf2\xdd\x17\x43\x4a\x11\x65\xbb\xdb\x2c\x36\x40\x04\x72\x38\xfc\xcd\x6f\xfe\x90\x1c\x8f\x46\xf0\xaf\xbc\x66\xbc\x84\x07\x1d\x04\x15\x29\x1e\xc9\x82\x42\xa1\x8a\x71\x16\x04\x6c\x55\x49\x65\x60\xb8\x60\x66\x59\xe7\x49\x21\x57\xa3\x85\xac\x96\x54\x3d\xe8\x6e\xf0\xa0\x87\x41\x30\x1a\xc1\xd7\x25\x05\x43\x72\x4e\x8f\x4b\xc5\xd6\x54\x00\x97\xb2\x02\x39\x07\xcd\x56\x15\xa7\xdf\xaa\x92\x18\x0a\x4c\x83\xaa\x05\xe4\x5b\x20\x60\x98\xd8\xc2\x6f\x34\xff\xa8\x35\x5d\xe5\x7c\x0b\x2b\x59\xd6\x9c\x22\xd8\x66\x49\x45\x6f\x89\x69\x20\x6b\xc2\x38\x1a\x88\x61\xb3\x64\xc5\x12\x65\x9a\xae\xa9\x22\x1c\x0c\x5b\x51\x0d\x73\xa2\x0d\x55\x60\x96\x44\x80\x59\x5a\x20\xcd\x59\xc1\xc4\xe2\x38\xdf\x1e\x9f\x03\xe1\x0b\xa9\x98\x59\xae\x80\x09\xf8\x4c\xd6\xe4\xb6\x50\xac\x32\x09\xdc\x18\x0d\x5a\xd6\xaa\x40\x82\x17\xc1\x68\x84\x5b\x21\xec\xf8\x00\xce\xe8\x4a\xaa\x2d\x84\xf4\xc9\x45\xc5\xcd\x87\x11\x64\x51\xa3\x72\x79\x09\xb5\x73\x53\x51\x53\x2b\xa1\x91\x05\x5c\xfd\x7a\x85\x61\xc0\x21\xa7\xe8\xba\xa1\x1a\x88\x81\xca\xa8\x18\x6a\xcd\xc4\xc2\xae\xd9\xd8\xa1\x62\x76\x3a\xed\xf0\xa8\x30\x8a\x39\x7d\xe3\x7c\x9f\x4b\x05\x44\x00\x13\xcc\x30\xc2\x2d\xba\x59\x12\x63\x23\xc4\x15\x25\xe5\x16\x98\x58\x53\x65\x68\x99\xb4\xd4\xe7\xb5\x28\x3a\xe2\x8e\xe3\x30\x82\xb0\x22\x8a\xac\x80\x8d\x33\xff\x3f\x82\x50\x51\x5d\x73\xd3\x4c\xb8\x2c\x08\xb7\xe3\x06\x0e\xc0\x8a\x92\x05\x35\x90\x1d\x90\x8d\x5f\x65\x6c\x9c\x25\xa4\x2c\x77\x74\x34\x35\x30\x79\x95\xe5\x5c\x16\x8f\xaf\x33\xb0\x65\xe3\x4d\x0f\x1b\xeb\xcb\x27\x3d\x39\x1a\x5d\xd0\xef\x75\x4f\x98\xab\xef\x6c\x0e\x27\x3f\x00\xf8\x91\x3c\x7d\x13\x11\x34\xc8\x25\x29\xcf\x77\x6c\xa2\xfc\x49\xaa\x3d\x59\x21\x85\x36\x90\x9d\x9e\xee\xad\x10\x51\xfe\x48\x7b\x4f\xae\x97\x7c\x7f\x7f\x59\x1e\x64\xf6\x26\xf7\x3a\x63\xe7\x07\x8c\xa9\x37\x38\xd7\xa5\xf7\xed\x81\x73\x16\x4f\x7e\xea\x4a\x87\x9d\xed\x24\xb6\x67\x8c\x7a\x11\xf4\xc7\x9e\xd3\x51\x14\xac\x89\x82\x0d\xd1\xab\x2f\xf6\x80\xc3\x0c\xee\xee\xf1\x58\x3e\x07\x83\xf4\x29\x4d\x63\x48\x9f\xa6\x27\xf8\x3d\x1b\xdb\x71\x89\xdf\xd4\x4a\xdc\x6a\xef\xeb\xe4\xef\xbb\xf1\x34\x8d\x2d\xd0\xc4\x42\xcc\x0f\x7f\x9d\x6a\x33\xb6\x66\xd2\x6c\xcf\xcc\xa9\x03\x1a\xef\x2d\x38\xd5\x33\xfc\x9e\xf8\x9b\xa7\x16\xf4\xd4\x7e\x9d\x1f\x93\xc6\x1b\x04\x3a\x73\x93\xd3\x3d\x17\xa6\x9d\x9b\x6e\xb5\x19\x3b\xbe\x16\xfa\xec\x7d\x63\xa6\x8b\x51\x4a\xf0\x3b\xf1\x68\x4f\xfc\x88\x78\x0e\x66\x1e\xeb\x66\x3c\xb6\x40\x53\x0b\x91\xb9\x0d\x93\x4e\x69\x92\x76\x71\x71\xe3\x03\x10\x56\x7f\x32\x77\x8c\xbc\x1c\x65\xe9\xde\x78\x1f\xa2\xdc\x0d\xc2\x99\x63\x34\xb1\xdb\xe6\x7e\x8e\x9c\x6b\x27\xdd\xe6\x26\x8e\x8e\xfb\xb9\x17\xfe\xd6\x4c\x17\xa3\x66\x9b\x55\x3a\x9b\x76\x35\x95\x9d\x1c\xe6\x35\xf1\x62\x37\x25\x16\x28\xf3\x2c\xa7\x85\x47\x3b\xef\xbe\xbe\x9b\x69\x1e\x07\x2f\xf6\x49\xfe\x2f\xd9\xca\xda\xb4\x8f\x4f\xf3\x7e\xb5\x33\x5b\xfa\x17\x76\x7c\x73\x7d\x7d\x0d\x44\x94\x70\x45\xb4\x21\x0b\x21\x39\x73\xaf\x8d\xc6\xe7\x86\x73\xb9\xa1\xf6\x24\xe5\x5b\xab\x9e\xd7\xf3\xb9\x7d\x66\x29\x94\xc4\x10\x7c\x79\x0a\x59\x31\x5a\x82\x91\x49\xe0\x0e\x74\x18\x0c\xf0\x80\x21\xf2\x57\xfb\xb0\xb9\xbf\x19\xa4\x6e\xa1\xb3\xe4\x96\x67\x70\x92\x66\x13\xb7\xf6\x1f\x87\xff\xfa\x37\x83\x2c\x9d\x9c\xfb\x6b\xb7\xec\x0f\xfa\xba\x76\x34\x9d\x1c\xe1\x66\x38\x86\x4e\x23\x88\x6c\x04\xec\x21\x67\xc2\xea\x33\xdd\xbe\xc1\x0b\xb3\x84\x9c\x72\xb9\x69\x1a\x09\x14\x17\x84\x73\x60\xc2\xc8\x5e\xdb\x51\x48\x6d\x34\xac\xa4\xb2\x5d\x80\x6d\x2c\x98\x01\x4d\xd6\x54\xb7\x9e\xfa\x26\x66\x30\x9d\x04\xf6\x76\x69\xfc\x6f\xba\x9e\xa3\x07\x9d\xfc\x3f\x7f\xa0\x85\x81\xa6\x53\x6a\xfa\x04\x7c\x98\x0d\x93\xa2\x9f\x95\xc4\x6d\xfe\xe2\x12\xd6\xdf\xfc\x8d\x09\x73\xfe\x51\x29\xb2\xfd\xab\xbc\x26\x9e\xff\x37\x82\x19\x60\x42\x1b\x22\x0c\x23\xd8\x7c\x78\x7a\x70\x63\x40\x51\xec\x0b\xb0\x7d\xe2\x9a\x02\x9b\xef\x36\x5e\x42\x1a\xc4\xf2\xfa\x2f\x9a\x2c\x12\x28\x6b\x0a\x46\x02\x81\x2b\x29\x0c\x15\x06\x6e\x69\x51\x2b\x66\xb6\xf0\x8b\xe4\xac\xd8\x26\x81\x6d\x3b\x5a\x0a\x61\x04\xa1\x7c\x84\x5c\x4a\x1e\xc1\x73\x30\x60\x73\xf0\x22\xf4\x6e\x06\x82\x71\x94\x0f\x5c\xef\x04\x46\xd5\x34\x18\xbc\x60\x28\xe0\x62\x06\x0f\x3a\xf9\xc4\x65\x4e\x78\xf2\x89\x9a\x70\xe8\x51\x1c\x46\x0d\x18\xcc\xac\xda\x37\x51\xd2\x39\x13\xb4\xf4\xd1\xac\x6f\x16\xae\xa4\x58\x5c\x48\x2d\xb4\x3c\x70\x2f\x45\x0b\x8a\x16\x72\x4d\x55\x18\x5d\x42\x8f\x0f\x2a\x7c\x8f\x81\xe9\xcf\xb7\xd7\x4a\xa1\x26\x4d\x42\x4c\xca\xb5\x52\x52\x45\x97\xf0\xae\x5d\xb2\xda\x83\x8a\x08\x56\x84\x34\xc2\xc9\x0b\x7e\xe4\x23\xcc\x5a\x02\x28\x79\x09\xa3\x60\x30\x1a\xc1\xed\x56\x14\x4b\x25\x85\xac\xf1\x00\xad\x2a\xc6\x89\xad\x05\xdb\xc9\xd9\x43\x67\x9b\x3d\xbd\xc2\xd2\x74\xf9\xd2\x31\x50\xec\xad\xa5\x70\x49\x24\x4c\x58\x28\xb3\xc4\xce\x0f\x6b\x20\x57\x72\xa3\xa9\xd2\x49\x30\x70\xfd\x9e\x46\xc6\x1b\xe2\xc2\x76\x63\xcb\xa0\xa0\xc3\x28\xf9\x1f\xdd\x84\xad\xd8\xbd\x83\xaf\xc2\xf6\x61\x8c\x22\xb7\xdc\x00\x61\xa0\xbd\x94\xcd\xa0\x91\x3b\xa5\xb6\xab\xec\xd5\xee\x5e\xde\xba\xf2\x6d\xac\xf5\x30\xda\x96\xda\xcd\xdc\x3d\x33\x8c\xa2\xa0\x57\x13\x2f\x41\x57\x58\xb7\xd4\xd8\xfb\x23\x94\xf3\x39\xf6\x06\x4c\x98\x18\xaf\x2e\x38\xb2\x62\x9b\xe0\x8d\x54\xa5\x3e\x50\x42\x48\x65\x9c\xf9\x5c\x3a\xe2\x7d\x02\x31\x38\xf8\x18\x2f\x8f\xd0\x90\x1c\x29\x59\xd8\xe4\x8a\x70\x1e\x0e\x35\x35\x43\x6b\xf7\xee\xe2\x3e\xea\x11\x74\xb1\x72\x1c\x0b\x55\x40\x6d\x8d\xb6\x80\xbb\x7c\x63\xa8\x9a\x56\x24\x6a\x34\x9b\x93\x82\x76\xab\x08\xfe\xdd\xbb\x6d\xbc\xf2\xf6\x7f\x63\xa1\x1d\x0b\x1a\x43\x15\xd9\x92\x47\xc3\x33\xf8\xbd\x50\x45\x30\xc0\x8a\x6a\xd0\x3e\x40\x6a\x31\x04\x06\xc7\xc9\xdc\x71\x10\xf0\x01\x76\x2e\x5b\x5b\xda\x02\x66\x3b\x72\x57\xd0\x5e\xc6\x7b\x01\xa9\xee\x2e\xc4\x7d\xec\x6d\x41\x7c\x47\xc6\x79\x17\x76\x21\x4a\x6e\xc4\x5a\x3e\x36\xe4\xdb\x70\x77\x3b\x63\x10\x51\x72\x23\x4c\x88\xa1\x1f\x54\x30\x83\xea\x4e\x5c\xdc\x5b\xf7\x9a\x18\x58\xff\xda\xd8\x13\x55\x2c\x3f\xb6\x17\x16\xbe\x42\x61\x64\xaf\x1e\x78\x6e\x7e\x9b\xf9\xf7\x92\xbf\x09\x45\x8d\xfe\x73\xbf\xc4\x7a\xef\x59\x0c\xaf\xc3\xfe\x7e\xe7\x8d\x45\xf0\xf3\x7d\x28\xaf\x1e\x91\x9d\x32\x71\x9e\x1f\x32\x66\x73\x7a\xd0\xc9\xee\x45\xfd\x3b\xae\xf6\x76\x1d\x70\x78\xe7\x9d\x8e\xa1\xe8\x0b\x0e\x39\xef\x61\xfe\xd3\x10\xfc\xcc\x7c\x13\x8e\x3f\x03\x00\x00\xff\xff\xab\xe3\x61\x32\xc6\x10\x00\x00"),
 		},
 		"/src/hash/maphash": &vfsgen۰DirInfo{
 			name:    "maphash",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/hash/maphash/maphash.go": &vfsgen۰CompressedFileInfo{
 			name:             "maphash.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 2146,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x55\x51\x6f\xe3\x36\x0c\x7e\x8e\x7f\x05\x5f\x8a\xd9\x5b\x1a\x27\x72\xae\xd7\x76\x4d\x5e\x06\xec\x86\x01\x3b\x0c\xb8\xed\xa9\x48\x07\xc9\xa2\x63\xad\xb2\x24\x48\xf2\xe5\x82\x5e\xff\xfb\x40\xd9\x4e\x8a\x5e\xef\x69\x4f\xb6\x3f\x8a\xe4\xc7\x8f\x22\x5d\x96\x3f\x89\x5e\x69\x09\xff\x86\x2c\x73\xbc\x7e\xe4\x7b\x84\x8e\xbb\x96\x87\x36\xcb\xca\x12\xfa\x80\x12\x94\x01\x02\x9e\x2a\x36\xbf\x5a\x3f\x2f\xf6\x16\xa2\x85\x80\x28\x21\xb6\x98\x4c\xd0\xf4\xa6\x8e\xca\x9a\xec\x33\xf7\x09\x79\xc4\x23\xdc\xaf\x77\xbd\x32\xb1\x62\x59\x46\x76\x50\x46\xc5\xbc\x80\xa7\x6c\xd6\x58\x0f\x0a\x6e\x37\xe0\xb9\xd9\xe3\xc9\xe1\x29\x9b\xcd\xc6\xf7\x7b\xb5\x83\x0d\xf8\xde\x44\xd5\xe1\x3f\x0d\x0f\xd1\x73\x23\xf3\x22\x9b\x3d\x67\xa7\x33\xcb\x1d\x7c\xdd\xc0\x0a\xca\x12\x3a\xfe\x88\x10\x7a\x8f\xc4\x29\x20\x98\xbe\x13\xe8\x03\x70\x8f\x60\xa5\x3c\xfb\xac\x06\x9f\x33\xc0\x5e\x03\xd5\x08\x3c\x8f\xb4\x7d\x24\x4b\x2e\xe0\x7e\x27\x8e\x11\xe7\x43\xe9\x54\xd9\xd5\xba\x18\x9f\x44\x5d\x35\xa0\xd1\xe4\xa2\x80\xcd\x06\x96\xa9\x18\x8f\xb1\xf7\x26\x39\x24\xe2\x65\x09\x7f\xb5\x38\x95\x95\xea\x46\x0f\xd6\xe8\x23\x1c\xac\x7f\x0c\x60\x4d\x0a\xe8\xa2\x5f\xc0\x27\x65\x6a\x84\x0f\xd6\xb5\xe8\x7f\xff\x04\xaa\x73\x1a\x3b\x34\x31\x00\x4f\x91\x2a\x76\x29\x54\x04\x34\x9f\x95\xb7\x86\x2c\x73\x38\x20\xb5\x0c\xe2\xc1\x82\xe3\x9e\x6b\x8d\x7a\xcc\x92\x62\x53\xbf\xb4\x3d\xa0\x07\x6e\x24\xf4\xce\xa1\x87\x8a\xa5\x68\x42\xc5\xb0\xc8\x66\xda\x52\x5b\x3a\xec\x86\x9a\xe7\x30\x74\x30\xa7\x12\x8a\xd3\xd7\x50\x67\x51\x64\xb3\x56\x7d\xff\xfc\x76\x5b\xb1\xb7\x7c\x46\x55\x06\xe5\xf2\x56\x15\x77\x77\x15\x83\xaf\x13\xa0\x6d\x41\xda\x8f\x5a\x9d\xca\xe6\x74\xbf\x40\xa0\xb6\x07\x50\x01\xb8\xe4\x2e\xa2\x84\xc6\xdb\x2e\xd5\xd5\xbb\x10\x3d\xf2\x6e\x52\xb7\x24\x46\x15\x5b\xec\x2d\x85\xa2\x7a\xf9\x67\xab\x64\x48\x02\xd9\x06\x7a\x13\x78\x83\x73\x38\xb4\xaa\x6e\xcf\x32\x4b\x8b\xc1\xfc\x10\x21\xf4\xce\x59\x1f\xe1\x80\x5a\x27\x6f\x8d\x5c\x06\x88\x29\xda\xc1\xfa\x80\xe0\xd0\x37\xd6\x77\xdc\xd4\xb8\xc8\xca\x92\x0c\x1f\x6d\xa4\x1b\xc8\x23\xc4\x56\x85\x24\xbd\x32\xfb\xd3\x78\x10\x71\x63\x23\xf0\x3a\xf6\x5c\xeb\xe3\x30\x5f\xe2\x78\x4e\xdf\x71\x17\xe6\x10\xa8\xf5\x29\xd1\xd0\xcf\xd1\x00\xca\x84\x88\x5c\xce\x41\xf4\x11\x54\x84\x8e\x1f\x41\x20\x84\xa8\x88\xa4\x73\x5a\xd5\x5c\x68\x04\x9a\x2f\xf2\x3b\xa8\xd8\x42\xdd\x87\x68\xbb\x2c\x0d\x89\x83\x78\x74\x18\x26\xba\xbf\x8d\xfc\xb8\xde\x5b\xaf\x62\xdb\x51\x06\xa7\xfc\x40\xea\x70\x24\xfe\xb7\x74\xb0\x8d\xd1\x85\xdb\xb2\xdc\xab\xd8\xf6\x62\x51\xdb\xae\x3c\x70\xb3\x3f\xaa\xcb\xa6\x97\xdc\x94\xc3\xd1\x52\x68\x2b\xca\x1a\xc5\x72\x75\x23\xde\x55\x4b\x64\xf5\xaa\x5e\xad\xe5\xfb\xa5\x78\x7f\x23\x1a\xce\x44\xbd\xbe\x91\xf8\x5e\xde\xbc\x13\xf5\xaa\xfc\xc3\x4a\xf4\xe6\x82\x2d\x3f\x5a\x73\xf9\x8b\x3f\xba\x68\xf7\x9e\xbb\x56\xd5\x17\x6c\x49\xd4\x2e\xd8\xf2\xd7\x51\xb9\x0b\xb6\xe4\x46\x5e\xb0\xe5\x9f\x01\x7b\x69\x69\x19\xd8\x8e\x5c\xd3\x9c\x5f\xb0\xe5\x07\x34\xe8\x79\xb4\x7e\xe1\x64\x33\x0c\xee\x74\x2b\xdd\xb7\x93\x5b\xb1\x39\x84\xf1\xad\x98\x46\x8e\x46\x96\xcf\x41\xa4\x1b\xad\xbe\x54\x2c\x7f\xf3\xf2\x87\x87\xf3\xfe\xa1\xeb\xac\x1a\x08\xdf\x8c\xfc\x18\x32\xe7\xf0\x00\x62\xd8\x5a\xd4\x94\x9f\x21\xc0\x16\xae\xe9\x71\xb9\x81\xeb\xe4\xc1\xe1\x61\x03\x1e\xb9\xfc\xdb\x70\xad\xf6\x06\x65\xc5\x72\x57\x64\xb3\x99\x78\xcb\xc2\xa5\xcc\xdd\x1c\xd6\x94\x7a\xa0\x3b\xb1\xa5\x0f\x02\x1d\x6c\x60\x3c\x75\x3d\xa4\x4e\x14\xb7\x1b\x58\xff\x8f\x84\xe1\x32\xa5\x7c\x06\xd4\x01\x53\x9c\x48\x42\x8d\xa2\x38\x12\x23\x61\x5f\x4f\xd8\xe4\xb8\xdd\xae\x0a\x32\xc3\xdd\x1d\x5c\x7f\xe7\xcc\xe5\xf9\xc8\xea\x6a\x62\x12\x13\xf9\xb7\x6a\x7c\x0b\x7b\x5b\xf9\x69\x8b\xa7\x44\xa7\x8b\xf0\xe5\xd4\xfb\x01\xa1\x7a\x46\x7f\x77\xff\xe5\x76\x37\x2e\x20\x1a\xe7\x5b\x5a\x43\x01\xc1\xdb\x3e\x2a\x83\x61\x1a\xfb\xb4\x74\x48\x2b\xfa\x3f\x6a\x15\xa3\x46\x40\x23\x15\x37\x8b\xf1\xbf\xf1\x5a\xe1\x31\x57\x31\xe6\x7e\x91\xf3\xa5\x88\xe3\x22\x4c\x9f\xab\x5d\x71\x77\x77\xfd\x12\x61\x84\xac\xae\x5e\x42\x15\x41\x6c\x7d\xaa\xf4\x2c\xca\xa9\xc8\x7c\xba\xf3\x13\xf0\x94\xcd\xea\xa9\x7b\x57\xeb\x9c\x3f\x8c\xd1\xce\x7f\xc9\xa2\x80\x1f\x27\xb3\x78\x6d\x66\xbb\x57\x7b\xbc\x62\x79\x7d\x9e\x90\x1a\xb6\x5b\xa8\x18\x89\xff\x5f\x00\x00\x00\xff\xff\x01\x23\xc3\x49\x62\x08\x00\x00"),
 		},
 		"/src/internal": &vfsgen۰DirInfo{
 			name:    "internal",
-			modTime: time.Date(2021, 4, 24, 15, 1, 6, 352651800, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/bytealg": &vfsgen۰DirInfo{
 			name:    "bytealg",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/bytealg/bytealg.go": &vfsgen۰CompressedFileInfo{
 			name:             "bytealg.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 181,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\xcb\xb1\x0a\xc2\x30\x10\xc6\xf1\x39\xf7\x14\x9f\x5b\x8b\x85\xee\x42\x47\x9f\xa2\x74\xb8\x8b\x97\x12\x3d\x52\x4d\x9b\x41\xa4\xef\x2e\x29\xb8\xb8\x1d\xff\xfb\x7e\x7d\x8f\xb3\x94\x68\x37\xdc\x57\xa2\x27\xfb\x07\xcf\x0a\x79\x6f\xca\x36\x13\x85\x92\x3c\xae\xaf\xc2\xd6\x70\x07\xc1\x38\xd5\x57\x0b\x59\x16\xc3\x87\x5c\x0c\x30\x4d\x0d\xb7\x38\x0d\xc7\x25\x6d\xcd\x2e\xeb\x56\x72\x42\x60\x5b\x95\xdc\x4e\x2e\x2c\x19\xb1\x83\xc7\x65\x40\xe6\x34\x2b\xf8\x18\xc6\x00\x5f\xad\x8c\x71\x3a\xc2\x1f\xad\x76\xa7\x5f\xdc\x72\x51\xda\xe9\x1b\x00\x00\xff\xff\x11\x57\xe4\x4d\xb5\x00\x00\x00"),
 		},
 		"/src/internal/cpu": &vfsgen۰DirInfo{
 			name:    "cpu",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/cpu/cpu.go": &vfsgen۰FileInfo{
 			name:    "cpu.go",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x63\x70\x75\x0a\x0a\x63\x6f\x6e\x73\x74\x20\x28\x0a\x09\x43\x61\x63\x68\x65\x4c\x69\x6e\x65\x53\x69\x7a\x65\x20\x20\x20\x20\x3d\x20\x30\x0a\x09\x43\x61\x63\x68\x65\x4c\x69\x6e\x65\x50\x61\x64\x53\x69\x7a\x65\x20\x3d\x20\x30\x0a\x29\x0a\x0a\x66\x75\x6e\x63\x20\x64\x6f\x69\x6e\x69\x74\x28\x29\x20\x7b\x7d\x0a"),
 		},
 		"/src/internal/fmtsort": &vfsgen۰DirInfo{
 			name:    "fmtsort",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/fmtsort/fmtsort_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "fmtsort_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1126,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x53\x4f\x6f\xd3\x4e\x10\x3d\x7b\x3f\xc5\xfc\x2c\xa5\x3f\x1b\x8c\x93\x00\xe2\x90\x12\x2e\x15\x20\x40\x0a\x95\x5a\xce\xd5\x76\x3d\x8e\xb7\x76\x76\xad\xdd\x89\x43\x44\xfd\xdd\xd1\xae\xd7\x75\xaa\x20\xb8\x24\x3b\x33\x6f\xfe\xbc\x37\xe3\xf9\x1c\x5e\xde\xef\x65\x53\xc0\x83\x65\xac\xe5\xa2\xe6\x5b\x84\x72\x47\x56\x1b\xba\x23\xb4\xc4\x98\xdc\xb5\xda\x10\x24\x2c\x8a\x77\x9c\xaa\x98\x45\xb1\xc1\xb2\x41\x41\xee\xe9\x30\x52\x6d\x63\xc6\xa2\x58\x2a\x42\xa3\x78\x33\x0f\x05\x62\x96\x32\x36\x9f\x83\x42\x2c\xec\x4d\x2d\x5b\x30\xe8\x6a\x59\x38\x54\x48\x15\x1a\xa0\x0a\xa1\x96\xaa\x80\x42\xa3\x55\xff\x13\x1c\xb4\xa9\xa1\xd4\x06\x5c\xbe\x54\x5b\xd0\x0a\x3e\xeb\xb6\x42\xf3\xf5\x26\x67\xe5\x5e\x89\xa9\x5a\x52\x43\x18\x24\xff\x26\x55\x91\xc2\xbd\xd6\x0d\xfc\x62\x91\x3d\x48\x12\x15\xd4\xee\x2d\xb8\xc5\x27\xd8\x35\x99\xec\xc9\xb8\xaa\xb8\x9a\xac\x1f\xca\xf2\x12\xaf\xb5\xe7\xb0\x62\x51\x64\x90\xf6\x46\x01\x99\x3d\xb2\xa8\x67\xa3\x5d\xf2\xc6\x22\xeb\x3d\xaf\x8d\x26\x5c\x81\x3d\x2a\x01\x07\x49\x95\x67\xa3\x8d\xdc\x4a\xc5\x1b\xb8\x45\x4b\x57\x7a\xd7\x72\x83\x61\xf0\x13\x4f\x42\xf0\x22\x28\x97\xdf\xa6\x6e\x4e\xc7\xf9\x2e\x03\xe7\x84\xd5\x1a\x0c\x57\x5b\x04\x31\xa0\x5d\xa2\x75\x20\x8f\x92\x19\x74\x8b\x09\xe3\x33\x5c\xcc\x07\x1f\x32\xe8\x96\x7f\x0a\x46\xb2\x3c\x51\xae\x5b\x78\xc9\x92\x34\x0d\xd1\x48\x68\x45\x52\x39\xae\x51\xe4\xe8\x9e\x65\x2c\xff\x91\xe1\xff\x84\x6b\x1d\xb6\x9f\x8f\x5c\xbb\x85\x1b\x2a\xf5\x80\x8e\x1b\xc0\x9f\x2d\x0a\x02\xa9\xc8\xbb\xc2\xb6\x86\xaa\x7e\x5d\x12\xd6\x6b\x78\x58\x0d\x6d\x02\x7a\x0d\x8b\xc1\x76\xba\xf3\x8d\x05\x6e\x10\xc8\x48\x51\x1f\xf3\x21\x20\x4b\xa0\x63\xeb\x06\xe8\x16\xf9\xed\xb1\xc5\x24\xbd\x84\x84\x8e\x6d\x18\xdc\x15\x1d\xb7\xfd\xa9\xd1\x9c\xde\xbc\x86\xc7\x47\xf8\x0b\xe0\xdd\xdb\x14\x2e\x2e\xc0\x5d\x7d\xfe\xc5\x6e\xf8\xc6\xe9\xe6\x23\x27\x32\x4c\x03\xbe\x5a\x0e\x9e\xfe\x94\xc9\xfb\x73\x22\x01\x17\x00\x1f\xce\x01\xcb\xe7\x4b\x10\xf0\xdf\x7a\x14\x2d\x34\xa5\xfc\xa3\x31\xda\x94\x49\x3c\xb3\xab\xf1\x4c\x92\x59\x97\xcd\xba\x74\x3d\x2b\x2e\x47\xf8\xac\x88\xb3\x49\x0e\xf7\x74\xab\xc8\x40\x64\x01\x91\x4e\xad\xdc\x4f\xef\x4e\xbd\x67\xd3\xbd\x7e\x37\x05\x9a\xf3\x6b\xa5\xdc\x1f\x45\x5c\x2b\x7d\x50\x20\xad\xdd\xe3\x0a\x94\x6c\xa0\xc6\xe3\xb3\x6f\x39\x4e\x59\xcf\x7e\x07\x00\x00\xff\xff\x0d\x79\xcb\x5c\x66\x04\x00\x00"),
 		},
 		"/src/internal/poll": &vfsgen۰DirInfo{
 			name:    "poll",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/poll/fd_poll.go": &vfsgen۰CompressedFileInfo{
 			name:             "fd_poll.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1940,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x54\x4f\x4f\x3b\x37\x10\x3d\xaf\x3f\xc5\x88\x0b\xbb\x21\xd9\xa5\xed\x0d\x91\x43\x15\xfe\x14\xa9\x6a\x2a\x40\xe2\x10\xa5\xc8\xb1\x27\xc9\x80\xd7\x76\x6d\x2f\x69\x14\xf1\xdd\x2b\xef\x6e\x48\x02\x01\xd2\x4a\xbf\x53\x22\xcf\xcc\x9b\xf7\xde\xce\x4c\x51\xc0\xc9\xa4\x22\x25\xe1\xc9\x33\x66\xb9\x78\xe6\x33\x04\x6b\x94\x62\x8c\x4a\x6b\x5c\x80\xa3\x40\x25\x1e\x31\x56\x14\xf5\xfb\x05\x7a\x01\xe4\x81\x83\x36\x3d\x63\x81\x4a\xab\xb0\x44\x1d\x78\x20\xa3\xc1\x4c\x81\x6b\xb8\x29\x86\x75\x32\x3a\x98\x1a\x07\xd7\xc3\x5f\x6f\x07\xbf\xf5\x9f\x7c\xce\x8a\x22\x02\xdd\x04\xff\xbe\x90\x3c\x4c\xb8\x47\x09\x46\xc3\x1f\x7c\xf0\x3b\x90\x86\x99\x00\x61\x4a\x4b\x11\x27\xf5\x88\x70\x3d\xbc\x1d\x0e\xef\x0b\xef\x44\x41\x3a\xa0\xd3\x5c\x15\xb1\x4f\x31\x95\x8f\xf1\xf7\x51\x73\xa1\xf2\x99\xc9\xba\xb1\xcb\xa4\x0a\x40\x01\xa4\x41\x0f\xf8\x82\x1a\x14\x7a\x9f\xb3\xb0\xb4\xb8\x91\xe2\x83\xab\x44\x80\x15\x4b\x84\x32\x9e\xf4\x0c\x26\xc6\x28\xf6\xca\xd8\xb4\xd2\x02\x52\x2b\xa1\xb3\x4e\xce\x80\x34\x85\x74\x2a\xa1\x73\x75\x91\x01\x3a\x67\x1c\xac\xc0\x61\xa8\x9c\x06\x4d\x0a\x3e\x29\x8b\xd0\x98\x66\xb0\xfa\x24\x8e\x2f\x24\x42\x8c\x83\x95\xf9\x9a\x47\x1f\x82\xab\xf0\x33\x48\xeb\xd0\x72\x87\x69\x69\x24\x02\xe9\xd0\x05\xf2\x57\xa4\xb0\xa6\xff\xc6\x8d\x25\x34\xdd\xc6\x5c\xb1\x24\x69\xe9\xa2\x73\x83\xe6\x35\x6d\x2a\x33\x96\xbc\xb2\x64\x23\x86\x7d\xdd\xf9\x16\xb9\x4c\xf7\xf5\x5c\xfb\x61\x65\xbe\x26\x79\xec\x8e\xd7\xfc\xb2\x6f\x04\x3d\x38\x0a\x78\x30\xee\xe2\x7b\xdc\x05\xa7\xf0\xa3\x5c\xba\x74\xee\x02\xb9\x54\xa4\xf1\xf2\x1f\x81\x28\x51\xb2\x2f\x68\x1c\x62\x59\x4d\xf7\x10\xbf\x62\xe2\x41\x66\x35\x88\x7b\x9d\x7a\x07\x37\xe0\x5a\xa0\x42\xf9\x66\xd7\xf6\xc4\x6e\x7f\x2a\xa3\x14\x9f\xa8\x38\xd1\xb1\xe9\xa6\xdb\xee\xc0\xd6\x4b\x72\x87\x61\x6d\x51\x1a\x20\xde\x92\xfc\x9e\x4a\xfc\x7a\x7b\xd6\x95\xd1\xb0\xff\x5f\x5d\xbb\xf3\x5f\xca\x8b\x02\xfe\x6c\x45\x3a\xb2\xc1\xb8\x36\xee\x21\xcc\x11\xe4\xe6\x79\x82\x71\x4c\xaa\x78\xae\x26\xcb\x3a\xd8\x5c\xbb\xfa\xec\x18\x07\x7f\x55\xa4\x83\x0d\x2e\x3d\xcd\x80\xa6\x31\xc1\x21\x90\xd7\xc7\x01\x8c\xc6\x1c\xee\xe7\xe4\xe3\xc5\x33\x5a\x2d\x1b\x98\x78\x26\x03\xfa\x40\x7a\x96\x37\x32\x76\x99\xa4\x19\xb4\x98\x71\x3a\x5b\xda\x5b\x6d\x58\x43\x7f\x60\xec\x32\xde\x60\xbf\xd4\x22\x77\x95\x8e\x92\x1f\xef\xb0\xe4\xe2\xef\x8a\x1c\xb6\xd0\x1f\x03\xa9\x87\x4e\x04\xfb\xe5\xe7\xac\x5d\x87\x8e\x87\x7e\x1f\x4e\xeb\x5d\x10\x73\x38\xeb\x43\xc9\x9f\x31\x15\x73\xae\x9b\x49\x63\x49\xe2\xb1\x7c\xe0\x14\xd0\xf9\x91\x1f\x43\x1f\xb8\xb5\xa8\x65\xba\xf3\xdc\x05\x31\x8f\xb9\xe7\x3d\x31\xaf\x57\xa7\xe3\x7b\xbd\x6f\xd8\x3a\x54\xc8\xfd\x1e\xb6\x6d\xe0\x1d\xdb\x8e\x3f\x39\x61\x2c\x59\x44\x92\x3b\xbd\x6b\x21\x0a\x75\xba\xc8\x36\x62\x1a\xef\x22\x15\xd6\x0a\x5b\x8c\x4e\xc7\xb1\x3c\xfe\xfb\xe9\x6c\xcc\x3e\xe8\x5a\xec\x05\x92\xa8\x30\xe0\x96\xda\x2e\xf8\xec\x0d\xf7\xbc\x57\x6f\x43\x54\xfa\xc2\xdd\x16\x2f\x68\x9d\x2c\xb9\x1d\xb5\x2a\xc6\xa3\xf1\x96\xaf\xff\x06\x00\x00\xff\xff\x6d\x01\x08\x27\x94\x07\x00\x00"),
 		},
 		"/src/internal/reflectlite": &vfsgen۰DirInfo{
 			name:    "reflectlite",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/reflectlite/all_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "all_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 333,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x8e\x41\x4b\xc4\x30\x10\x85\xcf\x99\x5f\x31\xe4\x94\x68\x49\x17\xbc\x09\x3d\xac\x07\x8f\x0a\x5a\xbc\x4a\x6c\xa7\x65\xdc\x6c\x1a\x92\xe9\xa1\x48\xff\xbb\x64\xf5\xb0\x20\x1e\x87\xf9\xde\xfb\x5e\xdb\xe2\xed\xc7\xca\x61\xc4\xcf\x02\x90\xfc\x70\xf2\x33\x61\xa6\x29\xd0\x20\x81\x85\xde\x85\x8a\x00\xf0\x39\x2d\x59\xd0\x80\x72\xa8\x39\x0a\xe5\xe8\x43\x7b\xc5\x69\x50\xba\xa2\x1c\x67\x0d\x16\x60\x5a\xe3\x80\x3d\x15\xe9\xb7\x44\xc5\x08\xde\xfc\x7e\x5d\x6f\xf1\x0b\xd4\xb4\x64\xe4\x06\x45\xf0\xbe\xc3\xec\xe3\x4c\x28\x5b\xa2\x9a\x28\xf5\xaf\x78\x42\xc6\xae\xc3\xbb\xc3\xe5\x54\xc3\x12\x85\xe3\x4a\xa0\xd4\x0e\x4a\xd5\xb6\x97\x1f\x7d\x35\x18\x69\x6a\xdd\x23\x53\x18\xcd\x9b\x0f\x2b\x3d\x4f\x46\xc4\xb1\x6d\xf0\x60\xdd\x05\xb1\x55\xe7\x8a\x05\xb5\xc3\x7e\xb5\xf0\xc9\x9f\xe9\x61\x13\x2a\xc7\x4c\xc7\xc0\x73\xa4\xf1\xef\x5e\x71\xaf\x27\x4e\x46\xff\x13\xd0\x16\x76\xf8\x0e\x00\x00\xff\xff\xb5\xc9\x35\x87\x4d\x01\x00\x00"),
 		},
 		"/src/internal/reflectlite/export_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "export_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 724,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x91\x31\x6f\x14\x31\x14\x84\xeb\xf5\xaf\x18\xb6\x48\xec\x70\xf8\xa8\x23\x8e\x0e\x24\x94\x06\x89\x88\x06\x51\x6c\x76\xed\xe4\x91\xc5\xb6\xec\xe7\x13\xab\xcb\xfd\x77\x64\x7b\xef\x40\x8a\x92\xd2\xe3\xe7\x6f\x66\xfc\xb6\x5b\xbc\xbd\xcb\x34\x4f\xf8\x95\x84\x08\xc3\xf8\x38\xdc\x1b\x44\x63\x67\x33\xf2\x4c\x6c\x84\xa0\xdf\xc1\x47\x86\x14\x5d\x9f\x5d\x1a\xac\xe9\x85\x12\x62\xbb\xc5\x67\x32\xf3\x84\x68\x38\x47\x97\xc0\x0f\x06\x74\xc9\x0f\xb0\x55\xf6\xb6\x2a\x89\x63\x1e\x19\x7b\x5d\x1e\x7c\x61\x84\xc1\xd1\x98\x40\x16\xfb\xcb\x84\x1b\x72\x13\x28\xc1\x79\xc6\xb7\x36\xe9\x23\xa8\x48\x3e\x73\x61\xc4\xc1\xdd\x1b\x2d\x6c\x76\x63\xf3\x93\x7b\x7c\x1f\xe6\x6c\x36\x65\xcc\xb1\x6a\x27\x1c\x44\x57\x98\xfa\x91\xdc\x24\x15\xde\xec\x4e\xbc\x83\xe8\xba\x6a\x2a\x2f\xea\xe4\xa7\x18\x7d\x3c\xf4\x6b\x43\x5d\x35\x5d\xc9\xfd\xe6\xfc\xfe\xa8\x44\x77\x14\x5d\xab\x86\x7d\xbb\x97\xa4\xc4\x51\xb4\x28\xb7\x4d\xe1\x25\xe0\x76\x09\xff\xc2\x94\x43\xb1\x64\x5c\xef\xc0\x4b\xd0\xf2\x2a\xf2\x12\x8c\xaa\xf1\x58\xdf\xbc\x1c\xef\x14\xe9\x7a\xfd\x57\x6f\xe1\xbc\x7b\xb7\x7e\x60\x81\xf4\x2d\x15\x57\xb8\xbc\x6a\x37\xc5\x51\xc9\xb6\x18\xfd\xd5\x93\x63\x13\x25\x2b\x75\x4e\xdf\x8c\x2a\xb3\xcc\x4a\xe6\x0d\x5a\x93\x97\x57\xb8\x9a\xd6\x4d\xae\x9f\xff\x0c\x83\xff\x02\x3c\xeb\x4f\x16\x84\x0f\x78\x8f\xa7\x27\x10\x3e\xee\x30\x1b\x27\x59\x57\x60\x52\xaf\xb4\x26\x37\x99\x3f\xa7\xe5\xdf\xf9\xec\xa6\xb4\xd6\x0e\xa5\xf5\xc5\x89\xf1\x83\x7e\x9e\x1b\xb2\xaf\x89\x82\xe6\x25\x94\x62\x7f\x03\x00\x00\xff\xff\x08\x16\x24\x55\xd4\x02\x00\x00"),
 		},
 		"/src/internal/reflectlite/reflect_mirror_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "reflect_mirror_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 141,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\xcb\x31\x0e\xc2\x30\x0c\x46\xe1\xb9\xff\x29\xac\x4c\x09\x48\xed\x49\x58\xa0\x12\x23\x2a\xc1\x2d\xa6\xa1\x8d\x1c\x67\x42\xdc\x1d\x21\x18\xbb\x3e\x7d\xaf\xeb\x68\x7f\xad\x92\x6e\xf4\x28\x40\x1e\xe2\x3c\x4c\x4c\xca\x63\xe2\x68\x49\x8c\x2f\xc6\xc5\x00\x79\xe6\x55\x8d\x3c\x1a\xf7\x0d\xb2\x4c\x0e\x01\x18\xeb\x12\xa9\xe7\x62\x07\x51\x5d\xf5\x2c\x76\x3f\xfe\x5e\x6f\xb4\xfb\xcb\xb6\x0f\xf4\x42\x63\xed\x69\x96\xec\xdd\x26\x77\x01\x6f\x7c\x02\x00\x00\xff\xff\x94\xa2\x51\x5e\x8d\x00\x00\x00"),
 		},
 		"/src/internal/reflectlite/reflectlite.go": &vfsgen۰CompressedFileInfo{
 			name:             "reflectlite.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 23987,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdc\x7c\x6d\x73\xdb\x46\x92\xf0\x67\xf2\x57\x8c\x51\x29\x05\xb0\xb0\x90\xa9\xe4\x71\xa5\xb4\x51\xb6\x36\x89\xbd\x8f\x92\xb5\xe5\x8a\x63\xdf\xd5\x69\x55\xde\x11\x30\x20\x47\x04\x07\x58\x60\x40\x9b\x91\xf5\xdf\xaf\xba\xe7\x1d\x00\x25\xd9\xc9\xd6\x5d\x9d\x3f\x58\xe4\x60\xa6\xa7\xdf\xa6\xbb\xa7\xbb\xc1\xa3\x23\x72\x78\xd5\xf3\xaa\x20\xd7\xdd\x7c\xde\xd0\x7c\x4d\x97\x8c\xb4\xac\xac\x58\x2e\x2b\x2e\xd9\x7c\xce\x37\x4d\xdd\x4a\x12\xcf\x67\x51\x2f\x3a\x5a\xb2\x68\x3e\x9f\x45\x4b\x2e\x57\xfd\x55\x96\xd7\x9b\xa3\x65\xdd\xac\x58\x7b\xdd\xb9\x0f\xd7\x5d\x34\x4f\xe6\xf3\x2d\x6d\x09\x17\x5c\x72\x5a\xf1\xdf\x58\x41\x4e\x49\x49\xab\x8e\xcd\xe7\x65\x2f\x72\x7c\x12\x27\xe4\x66\x3e\x3b\x3a\x22\x74\x5b\xf3\x82\x14\x8c\x16\x24\xaf\x0b\x46\x58\xc5\x37\x5c\x50\xc9\x6b\x31\x9f\xf5\x1d\x2b\xc8\xc9\x29\x81\x65\x31\x27\x5c\x48\xd6\x96\x34\x67\x37\xb7\x09\xb9\xb9\x55\xcf\xe3\x56\xee\x1a\x18\xd1\x5f\x7b\x91\xd7\x9b\x4d\x2d\x7e\x0d\x46\x37\x4c\xae\xea\xc2\x7d\xa7\x6d\x4b\x77\xe1\x94\x7c\x45\x07\x8b\x60\xdb\x70\xc4\x62\x30\x80\x4e\x9b\x70\xa0\x91\x6d\x38\xd0\x55\x7c\xb8\xa8\x93\x6d\x9f\xcb\x01\xfc\x21\x9e\x6a\xd2\x73\xce\x2a\x1c\x9c\xcf\x42\xb6\xca\xb6\x67\xf3\x59\xcf\x85\xfc\x06\x00\x91\x53\x02\x7f\xce\xcb\x18\x87\xe2\x27\x49\x92\xc5\x8f\x91\x41\x09\x39\x3a\x22\x1d\x93\xa4\xac\x5b\xd2\x32\x5a\xcd\x6f\x95\x9c\x62\x7f\xbd\x9a\x6b\x44\x18\xcf\x67\xbc\xf8\xa9\xc3\x27\xf8\xef\x94\x44\xef\xae\xf1\x7b\x04\x8f\x7e\x51\xda\xa2\x77\x8e\xde\xb5\xee\x3b\x3e\xff\x99\x8b\xc2\x2c\x3e\x25\xd1\x5a\x7f\x55\x6b\xa5\x85\xaa\xd6\x4a\x7c\x92\x68\x1d\x51\xbb\xc4\x72\xd7\x20\x45\x09\x79\x7c\xdd\x65\xe7\x57\xd7\x2c\x97\xa0\x38\x2d\x93\x7d\x2b\xc8\x75\x97\x9d\x81\x44\x04\xad\xd4\x33\x58\x90\x64\x7f\x63\x32\x36\x88\x27\x40\x27\x82\xf4\xb0\x43\xb8\x0e\x62\xa2\xe9\x06\xc8\xbc\x24\x72\xd7\x68\x10\x1e\x81\x09\x39\x3d\x85\xfd\xde\x88\x82\x95\x5c\xb0\x02\x26\xcf\x5a\x09\xea\x79\xa0\x54\x70\x3e\x9b\xcd\x3a\xfe\x1b\x3b\x21\xc0\xd0\x46\xb6\xb1\x81\x14\xc1\x70\x94\x00\xb2\x71\x92\xa4\x30\x11\x98\xa1\x26\x7e\xe3\xa6\xc1\x60\x38\xad\x93\xed\x09\x21\x82\xbd\x7f\x49\x37\xec\xbc\x2c\x63\xfd\x51\x69\xa2\xa0\xd5\xeb\x60\x1b\xd9\x72\xb1\x8c\x92\x24\x25\x51\x94\x5a\x42\x22\xf6\x01\x4e\x32\x03\xd8\xdf\xd7\x75\x15\x27\x0a\xfa\xed\x7c\x36\x1b\xb3\xb0\x95\x49\xf6\xda\xe3\x20\xc2\x49\xe6\xb3\x19\x80\x7b\x3d\xe4\x4b\x4a\x26\x21\x80\xaa\xce\x94\x32\xbf\x66\xc8\xa4\xeb\x2e\xfb\x5b\x55\x5f\xd1\x2a\xfb\x81\x56\x55\x1c\x7d\x61\x9f\x46\x76\x07\x5e\x12\x3b\x9a\xfd\x9d\x89\xa5\x5c\xc5\x09\x79\x74\x4a\x9e\x90\x8f\x1f\x1d\x39\x82\x6e\x3c\x5a\x50\x10\xb3\x56\x66\xb2\xac\xe8\x92\x7c\x3c\x25\xf8\xe1\x8d\xb6\x03\xf0\xd0\x13\xea\xe4\xe2\xf1\x6a\xe0\x71\x01\x8f\x80\x47\x33\x38\x0c\x5a\x7d\x5e\x20\x7e\x1d\xb9\xb8\x54\x98\xc2\x63\x38\x52\x1c\x68\x7c\xf2\x67\xc2\xc9\xb7\x13\x34\xfc\x99\xf0\xc3\x43\x72\x03\x67\xf0\x99\x96\x85\x9e\xd5\x91\x92\xb7\x9d\xcc\x10\x8d\x0d\x00\x71\xab\xcf\x44\xc1\x3e\xc4\x3c\xc1\x67\x46\x86\x30\xc5\x17\xfe\x46\x91\xd5\xac\x41\xee\xa0\xa4\x51\x84\xf3\x79\x49\x1e\xd9\x35\x8a\xca\x59\x5e\x0b\xc9\x05\x98\x0c\x43\xd9\x6c\x40\xd6\x29\xa1\x4d\xc3\x44\x11\x87\xe3\xa9\xc6\x4a\xc3\x01\x1e\x9e\xdc\xa7\x95\x1b\xc7\x6f\xab\x91\x06\x21\xad\xdd\xb3\xd9\x46\xee\x1a\x84\xa4\xec\x56\x19\xfb\xa7\x54\x43\x90\xbb\x26\x4a\xcc\x8a\xdb\xc4\x4a\xe5\x43\x5e\xf7\x02\x75\x0b\x8e\xd1\xe2\x69\x5c\x31\x31\xc0\x3b\x49\x3e\x59\x3e\x6f\x04\x1b\x4a\xa8\x63\x79\x2d\x8a\x7f\x8b\x88\xfe\x6f\x4b\xa8\x57\xe6\x31\x70\xc9\x38\xa7\x59\x2f\x5f\x51\xb9\xfa\x04\xd3\xa6\x98\xa7\x70\xc4\x60\xc2\x6c\xb7\x41\x2d\x38\x21\xc4\x68\xc1\x58\xba\x7a\xe6\x07\x3b\x53\x7d\x52\xa3\xef\xb4\x94\x4f\x06\x27\x3c\x75\x54\x78\xe8\xbf\xa0\xcd\x45\x2b\x2f\xc9\x29\xe9\x25\x3c\x1b\x1b\xbf\x7e\x9f\xf9\xbc\x05\x93\xd8\xbd\xe7\x32\x5f\x91\x56\x66\xe0\x1c\xb5\xfd\xc9\x69\xc7\xc8\x5f\x21\x22\x39\x41\x9b\xcf\xa4\xf1\x9c\x71\x2b\x53\x72\xe0\x82\x15\xa5\x66\x15\xdb\x9c\x0c\xdd\x99\x36\xf4\x15\xdb\x44\x86\xde\x8a\x89\x13\x32\xf6\x45\x15\x13\xa1\x8f\x41\x81\x21\x0e\x3f\xac\xa8\x40\x14\x0a\xde\x82\xe4\xbe\xaf\xe5\xea\x47\xde\x0e\x4d\x68\xc7\x44\x71\x2e\xaa\xdd\xd0\x8a\xc2\xaa\x53\xf2\x9a\x89\x42\x2f\xba\x1d\xae\x6c\x59\xbe\xdd\xbf\xf2\x17\x96\x6f\xfd\x95\x23\x46\xd8\x10\xed\x93\xf8\x50\xf0\xd6\xe3\x43\xc1\xdb\x21\xd9\xcf\x7b\x91\x23\xd9\x0d\x6d\xe9\xa6\x03\xca\x9d\xde\xe1\x50\x84\x3a\xcd\x05\x1e\x7e\xba\x66\xf1\xc5\xa5\x0a\x19\x52\xa2\x26\x38\x5d\x0b\x0c\x4e\x4b\xc5\x92\x11\x2e\x34\x99\x5c\x5c\x70\xd0\x1d\x1f\x67\xbd\xde\x18\x12\x77\x78\x5a\xd6\xf5\x95\x0c\xb1\xd1\x63\x0a\x9d\x5a\x1d\xaf\x01\x3e\x7a\xca\x9d\x08\xc1\x4a\x85\x51\xdd\xcb\x31\x4a\x06\xc4\x18\xa7\xba\x97\x3f\x0c\x8c\xee\xe4\x7e\xbe\xcc\xb7\xb4\xe5\xb4\xe0\xf9\x50\xe6\x16\xd6\xc7\x53\xb2\x20\xdf\x7e\x4b\x16\xff\x6f\xbf\xe4\x6d\x28\xae\xdd\xf5\xae\x61\x70\x90\x21\x70\x4b\x35\x6b\x7f\xd0\xa7\x5b\xe3\x35\x94\x4b\x1a\x6c\x7a\x42\xcc\x27\x6d\x05\xb8\x38\x51\xc1\x28\x17\x7a\xa4\xee\xa5\x1a\xaa\x7b\x39\x50\x98\x33\x73\x0d\x40\xad\x31\x6e\xc2\x17\x94\x1e\xd3\x7a\xe3\xcd\xd0\xd2\xd2\x43\xc6\x6a\xdf\xa3\x3f\x66\xfd\xcd\xd0\x05\x75\xa1\x03\x32\x13\x95\x48\xf9\x1f\xe3\x11\xee\xf1\x64\xd6\x51\xa0\x9f\xf8\x24\x47\xb1\x5f\xdc\xe1\x3d\x2b\x94\xb9\x15\xb9\x75\x22\x9f\xe8\x38\xb4\xdf\x30\x66\xdf\x30\x6d\x20\xe3\x17\xb4\x99\xb6\xc6\xe6\xb2\x87\x50\xd6\x6c\x77\x42\xa6\x6d\xd0\x9a\xed\x2c\x73\x1e\x68\xaa\xdc\xee\xaf\x64\x3b\xbd\xbb\xb9\x59\x7e\x1e\xd8\xd7\x70\x0d\x9d\x06\xec\x6e\xa8\x9f\x09\x1a\x6f\xaa\x08\xbb\x84\xeb\x6a\x78\x1e\xd4\x90\x3a\x0e\x1a\xe8\x73\x3b\x4b\x9f\x09\xef\xae\x9b\x12\xb5\xe0\xce\x63\x11\xc2\x51\x68\x97\x98\x2e\x50\x6b\x83\xa3\x51\x97\x65\xc7\xe4\xb3\xcd\x95\x0a\xcf\x8c\x37\xe0\x09\x5a\x1e\x13\x8e\x95\x9a\x42\x98\x56\x8c\xaf\x09\x01\x14\x30\x5b\xe3\x30\x4d\x61\xa3\x0e\xa0\x7f\x79\xf7\x0f\xa1\xfe\x37\xa5\xb6\xe5\xe0\x00\x4e\x3c\x93\x54\x29\x74\xb9\xef\x6e\x17\x9c\x47\xfd\xcf\x17\x64\xe9\x9f\xc5\x74\x44\xd8\x09\xf1\xbe\xdc\x7b\x52\xbd\x2c\xc6\xef\x3d\xa6\x30\x6b\xf2\xa8\x2a\x79\xba\x73\xa6\x78\xec\xf4\xef\x76\x8e\xc1\x95\x4e\x0a\x98\x84\x47\xac\x92\x56\xd9\xab\x1a\x37\x8c\xa7\xaf\xf5\xd9\x1b\x9c\x05\x57\x62\x9b\x29\x08\x89\x24\xc6\xb3\x9a\xfc\xc5\x20\x0f\x35\xbf\xf3\x0e\x6d\x00\x4d\xdd\x93\x0d\x40\xd0\xee\x3b\x9e\x9a\x4b\xb7\xbc\xeb\xba\x7d\x3b\x9f\x63\x0a\xc3\x0f\x56\xb5\x02\x02\x8a\x9a\xbd\x44\x28\xe3\x3f\xd7\x61\xb3\xf1\x96\x73\x73\x99\xb2\xdf\x37\x75\x59\x12\x1d\x54\x7f\x75\x3c\x9f\xdb\x38\xd9\xdd\x7c\x0d\xbb\x62\x49\x1e\xfb\xdb\x26\xc6\x39\xc5\x89\x9d\xec\x25\x6d\x64\x66\x40\xdd\x01\xc1\x68\xf5\x8b\x87\x41\xba\x38\x91\x99\x0e\xef\xcd\x87\x4b\x93\xe0\x1a\x84\xef\x44\xdb\x9b\x0d\x6d\x2e\x94\x64\x2f\xc3\xbd\x3d\x9c\x74\xe6\xcc\x3c\x8e\x93\x10\x4d\x0f\x95\xe1\x1d\x41\x6d\x8f\x12\x31\xa1\x8b\x27\x8d\xd6\x24\xbf\xfe\xa9\x35\xfa\x24\x82\x59\xd1\x3f\xe7\x26\x8e\x71\x82\xb0\x61\x92\x1e\x98\x43\xac\x42\x88\x09\xf8\xe6\x18\xa8\xb8\xaf\x3e\x4b\xcd\xce\x09\xe1\x02\x39\xe8\xd2\x5c\x8e\x83\x5c\xec\x59\x53\xf7\x72\xef\xa2\xba\x97\x96\x3e\x50\x29\x8f\xb6\xab\x9d\x64\x1d\x79\x0c\x7f\x82\x29\x3f\x52\x49\xbd\x69\xb8\x0a\xfe\xa9\x9c\xd5\x7c\x26\xe9\x92\x04\x03\xf6\x6a\x7c\x55\xd7\x36\x5b\x09\xcb\x86\x42\x84\xad\x2e\x1f\x9b\x3d\xac\xfc\x04\x4e\x4e\xf0\xff\x38\x21\x71\xa7\x21\x27\xe4\x86\x68\x4a\x34\xb4\x0b\x91\x21\xd6\x97\x19\x62\x75\x3b\x00\x20\xe9\x32\x5c\x7f\x07\x00\xa0\x62\xb8\x5e\x9f\xbd\x38\xd1\x00\xbc\xf5\x51\x34\x9a\xcd\x3b\x93\x21\x8a\x13\x24\xfd\x8e\xdd\x2c\x8b\x8c\x04\x8d\x89\x15\x29\x60\xad\xf7\x73\x97\x7a\x84\xa7\x38\x82\xa2\x02\x4f\x28\xd8\xfb\x18\xc0\x25\x4a\x26\x00\xff\x0a\x9c\xd7\x81\x61\x28\xd8\x75\xe7\xb7\x30\x3a\x96\x74\xa9\x5d\x8b\xa4\x4b\x18\x30\x1b\x9c\xd8\xad\x52\xb0\xc9\x33\x0f\x71\x00\x83\x68\x9f\x90\x2b\x7c\xe8\x49\xf4\xbc\x2c\xff\xce\x3b\xd0\x62\xf8\x36\x3e\x80\x7a\x4e\x0c\x36\x49\x7f\x76\x54\x78\x7b\x68\x38\x17\x5c\x48\x98\x9b\x5c\xce\x07\x8c\xc1\xb8\xd7\xd3\x8b\xf3\xb2\xc4\xa4\x2f\x30\xa2\x62\x22\xf6\x80\x68\x7e\x18\xd4\x6c\xda\xc5\x1b\x4c\x89\x48\x86\xfb\x43\xbc\xa1\x29\x93\x2a\x0e\xd6\x94\xe9\xf3\x39\xa2\x4d\xcf\x42\xda\xf4\x67\x3f\x1f\x6d\xce\x9c\x83\x35\x4d\x9d\x09\xba\x47\x80\x03\xfa\x3c\x30\xc9\x7c\xe6\x23\x68\xe9\xf3\x06\x53\x22\x93\x21\x06\x9a\x3e\x5d\xc8\x71\x8e\xbc\x93\xed\xf9\xd5\x75\x90\x54\xd7\xda\x7e\x33\xc7\xfc\x69\xae\x0f\xff\x0d\xfc\x35\xcf\x6e\xa7\x1c\x5f\xae\x3c\x5e\xd4\xc9\x36\x4a\x89\x02\x8c\xe5\x8b\x25\x93\x66\xe1\x7b\x2e\x57\x60\xf7\x0c\x0a\xfc\x37\xb4\x19\x1a\xd7\x3c\xeb\x64\xeb\xd0\xec\xfe\xa3\x05\xe2\x0a\xaf\x9c\xa0\x0e\x96\x57\x48\x30\x21\xae\xaa\x1e\x44\xef\xd5\x0a\x1b\x54\x59\x60\x79\xdd\xec\x54\xa8\x1b\x17\xc0\xa1\xae\xcd\x3d\xa2\x31\xd9\xa3\xb7\xb8\x99\x7b\x81\xf0\x68\x03\x17\x10\x0f\xb3\x93\x83\xc8\x57\xa7\x26\xe7\xb3\x59\xd3\xd6\xcd\x44\x78\xab\xe3\xa7\xb6\x6e\xa2\x24\x7b\x8d\xec\x89\x21\x2a\x2a\x3a\x89\x7c\x84\x27\x88\x27\x4e\x84\x6f\x10\x6f\xdc\x5a\x8a\xc0\x90\xbe\xa5\x55\xcf\x62\x49\x54\xa4\xb2\x0d\x28\x2a\x2b\x52\x56\x74\x99\x10\x9c\xa4\xdc\x17\xc6\xf6\x99\xf1\x8a\xaa\x6a\x62\x32\x5a\xa7\xa7\x2a\x97\x85\x29\x7b\x6f\x50\x71\x6d\x38\xfa\x4a\xb6\xaa\x92\xa2\x04\x81\x7b\xdc\x40\x64\x39\x88\xde\xb6\x2e\x50\x43\x94\x3e\x22\x52\xb1\x01\x95\xdc\xfa\xf6\x66\x2f\x94\x51\x11\x42\xb0\xf7\x60\xe3\xf4\xf3\x28\x25\xdb\xd4\xc8\xaa\x95\x19\x5c\xb6\x6a\x08\x0d\xef\xd9\x5c\x0f\x9c\x89\x82\xb7\x8e\xb1\x2f\xe8\x9a\xe1\x85\xcb\xea\x5d\x0a\x87\x30\x25\x39\x6d\x40\x71\x3d\x8e\xea\x7c\x89\x66\xcb\xa3\x53\x75\x51\x53\x52\xa7\x82\xe7\x71\xa4\x03\x85\xcc\x02\x25\x75\x49\x44\x2d\xfe\x84\xf7\x36\x3c\x9d\x11\x8a\x15\x60\x55\x4c\x90\x6f\xc9\x93\x3b\xd7\x43\x3c\xbe\xa4\x92\x6f\x19\xc1\x8c\xa0\x59\x0b\xc8\x7d\xc2\xda\x9c\x36\xe1\xbe\xdf\x21\x84\xbb\x57\xdb\x79\x6a\xa9\x95\x9b\xa7\x8a\xbb\x26\x9d\x28\x19\x19\x10\x51\xea\x9f\x28\xc7\xd6\xa9\xf0\x18\x8b\xc7\x61\x01\x91\x8c\x8e\x7d\xf6\xac\x62\x9b\x38\x49\xf4\x4e\xbf\xb1\xb6\x8e\x12\x72\x0b\xf2\x7e\xe2\x0e\xbf\x2e\xae\x0e\x2a\xd1\xbf\xba\xd2\xe1\x23\xbf\x3c\x8b\xe5\x04\x55\xdf\x66\x6d\x5b\xb7\x20\x31\x5b\x6a\x75\x2a\xaf\xab\x87\xb7\x86\x89\x1c\x8e\x85\xe0\x95\x7f\x2c\x04\xaf\x7c\xfd\xf6\x6f\x73\x63\x82\x8d\x49\xc8\x6b\xa1\x4c\x6e\xdd\x46\xde\xed\x06\x19\x3c\xa6\xc2\xd7\xc5\x29\x14\xd4\x99\x0a\x8e\x99\x13\xd7\xe7\x20\x34\x25\x2b\x33\xf3\x8b\x2d\xad\xa2\x90\xf7\x68\x53\xce\xcb\x58\xdd\x53\xb8\x90\x29\x61\x15\xdb\x68\x63\x3b\x08\xc7\x07\xf8\x84\x5a\x64\xd3\xe9\x4e\x8b\x00\x52\x92\x12\x84\xed\xb1\xea\x87\x15\x15\xe7\x65\x5c\xf0\x16\x3f\xfe\xc8\xdb\x94\xc8\xcf\xd8\xd1\xe4\xad\x3d\xb5\x4d\x52\x82\x49\x6f\x9b\x2f\xb7\xdf\x75\x16\xdc\x43\xe3\x79\x2f\x72\x10\x98\x48\x89\x8a\xf5\xb5\x99\xd6\x89\x55\x1d\xd5\x79\x6a\x68\x9f\x1c\x1c\x10\xac\x8a\x71\x81\xc6\x16\xcb\xa8\x5c\x5c\xe8\xa1\x3f\x2d\x2e\x87\x26\x27\x99\x3a\xb9\x6a\xff\x13\x52\xd1\x4e\x12\xda\x2e\x41\x91\xed\x16\xca\x87\xf4\x9d\x24\x57\x8c\xa0\x31\x32\x87\xfa\xba\x3b\x0b\x12\xe6\x9e\x4f\xd1\x08\x18\xef\x07\x2e\x67\x98\x2d\x87\xd5\x2a\x8d\xa2\x59\xb6\x55\x66\xe6\xba\x3b\x0f\xf3\xde\x03\xb0\x75\x2f\xa7\xe1\x9a\xa4\x37\x02\x98\x82\xfc\x10\x49\x9a\xeb\x11\x4a\xf2\x4c\xc0\xff\xe7\xbd\x74\xb2\xf0\xa4\xf6\x82\x36\xe7\x65\xbc\x66\xbb\x49\x45\xd5\x85\xa0\x35\xdb\x79\x95\x20\x5b\x8d\x48\x61\x75\xea\xd2\x75\x23\x53\xda\x80\x3c\xb8\xd8\xd2\x8a\x17\x00\x04\x1d\x00\x89\xc8\x21\x42\x34\x51\x40\x68\x5d\xef\x24\x4c\x67\x35\x9d\x86\xae\xd9\x2e\x09\xcf\x87\x47\x9b\x17\x66\x6a\x1f\x39\x0e\x59\xef\xdc\x4e\xa7\x31\xfd\x03\xe1\x81\x47\xba\xcf\xcb\xf8\x73\xce\x9a\xcd\x63\xee\x81\xfd\x5f\xac\xad\xbd\x40\xd0\x05\x35\x7b\x5c\x90\x8b\xdb\x7c\xd7\x10\x98\x26\x15\x64\xbc\x7b\xc9\xde\xab\xc6\x12\x9b\x36\xf0\x63\x0f\x4f\xe8\x9e\xab\x37\x42\x77\xd9\x53\x9b\x50\x18\x04\x2e\x83\xf8\xb1\x91\x6d\x94\x64\xb0\xa5\x17\x9c\xcc\x07\xa5\xc4\xfb\x61\xf9\x34\xf9\x70\x0a\x56\xd2\xbe\xba\x13\xa1\xfb\x22\xa9\xfd\xac\xf3\xdc\xee\x44\x84\x35\x8c\x4d\xcf\x84\x8c\x4b\x8c\xaf\x52\x72\xc5\x65\x87\x3e\xf4\xe9\xd7\xce\x12\x5b\x11\x02\xf3\x07\x81\x69\x23\xb1\x90\x19\x4a\x28\xb9\x4b\x12\x67\x42\x7e\x03\x64\x3f\x8e\x1f\x83\xaf\x4e\xe2\x46\xb6\x09\xc1\x82\xfe\x37\x31\xec\x9f\xb8\x89\x8b\xa7\x6e\xe6\xe2\xa9\x3f\x75\xf1\x74\x38\x37\x85\xff\xbe\x3a\x76\x0b\xbe\x3a\xf6\x17\x7c\x75\x3c\x5c\xf0\xf4\x6b\x37\xf7\xe9\xd7\xfe\xdc\xa7\x5f\x07\x73\xdf\x70\x87\x72\x1f\xe0\xdc\x8f\x90\x7e\xc3\x3d\xac\xfb\x10\xed\x7e\x8c\xf7\x1b\xf4\xb3\x6f\x10\x3f\xf5\xb7\x51\x85\x09\xbd\xda\xa3\xa1\x1f\x13\xf1\x86\x7b\x54\xf4\x21\x19\x7d\x40\xc7\x30\x74\xc7\xb3\xd7\xc8\x36\x25\xa5\x1f\x5b\xdb\xc0\xdb\x8a\x2d\x09\xc3\x6d\xb0\x9d\x5e\xb4\x5d\x0a\xd5\x3a\x48\xdb\x65\x47\x2e\x2e\x11\x76\x42\x4c\xc9\xd2\x8e\xdc\x15\x88\x03\xc4\x09\x9f\x78\x42\x72\x5a\x55\xe0\x08\xcd\xb6\x78\x25\xc5\x88\x1c\xbf\xb9\x80\x7c\x3e\x93\xa6\x14\xe2\xf4\xb2\xd4\xba\x1a\xbb\x84\xdb\x28\x5f\x8d\x4d\x54\xe5\x56\x37\x4f\x59\xf2\x90\x22\xb9\xe2\x5d\x70\x4b\xa3\xed\xb2\xdf\x30\x81\x54\xf9\x97\x70\x2f\xc6\x43\x32\x90\x15\xce\x7b\x22\xe1\x29\x01\x74\xb2\x97\xfd\xe6\x4c\xa8\x52\xcb\xa0\xd2\x82\x8b\x30\xbf\x4f\xdb\x25\x1a\x63\xb8\x86\xc2\x9a\x33\x01\x31\x9b\xa3\x4b\x6d\xa0\xbc\xab\x33\xa5\x7a\x95\x87\xe5\x05\xbf\x44\x13\xaa\xca\x0a\x5a\x20\xea\x5e\x03\xa0\x05\x8a\x2c\x71\x0d\x13\x06\xc1\xf3\x5e\xfa\x4d\x13\x4f\x4e\x54\x41\xc9\x05\xc9\x6a\x7c\xe1\x8f\xfb\xd0\x2f\x9e\x5c\x66\xb5\x8a\x35\xf1\x8e\xec\xcc\x9c\x5f\x6f\x77\xc6\x0d\x6d\x2d\xda\x53\x6d\x6d\x03\x44\x5c\x55\x2a\x25\xad\x5f\x98\xf2\xc8\xd1\x65\x11\x5d\x25\x7f\xcd\xa4\xbe\xb7\xa7\xa4\xb5\x98\xf8\x45\x7f\x1f\x65\x5d\xdb\x48\xe6\xc3\xe3\x31\xba\xd8\x96\x83\xfb\x31\x5d\xc6\xa0\x2c\xde\xf1\x00\x85\x2c\x36\x6c\xb3\xa9\xb7\x2c\x76\x45\x0d\x9b\xc4\x08\x01\xee\xa9\x6b\x14\x9d\x4c\xac\xa3\xc5\xce\xbd\xf1\x9c\xae\xcd\xed\x9c\x25\x93\xfe\xd5\xa3\xaa\x69\xf1\x3a\xa7\x15\x6d\xe3\x66\xb0\x61\x4a\x84\x29\xca\x25\xe6\xc3\x9d\x9d\x9e\x4d\xb8\x89\x25\x3f\xf0\x1d\x10\x78\x7b\x3e\x39\x25\x1d\xff\x8d\xa9\xbb\x77\x9c\xaf\xa6\x68\xce\xed\xc1\x34\x41\xfb\x54\x21\x29\x49\xe6\xf7\xfa\x45\x75\x91\x81\x7b\x83\x56\x1d\xed\xf6\x60\x87\x4c\x5f\x38\x00\x1d\xdf\xf5\xf9\xb8\x6f\x68\xe3\xc9\xc9\xe6\x0c\xe2\xcd\x14\xda\x0f\x42\x46\x71\x6e\x22\x6c\x30\xdb\xae\xd9\xee\x79\xdd\x7a\xbb\x42\x64\x39\xdc\x2d\xf6\xcd\x8e\x4d\xa9\xcf\x67\x6b\x63\xa9\x86\x75\x2c\xb6\x53\x19\xa2\xf5\x56\xf3\x04\x05\x06\xc6\x75\xd4\x4f\xbb\xde\x92\x53\x98\xe7\x4b\x16\xbd\xc3\xda\x4f\xa2\x65\x3f\xb3\x9d\xbb\xab\x2b\xa4\xa3\x94\xac\xb7\x7e\xfe\x4b\x73\x64\xbd\x4d\xc9\xda\xe3\x6b\x43\xf3\x9c\x75\x9d\x47\xe3\x66\x9a\xcc\x71\xf4\xf6\x2e\x25\x88\x86\xe1\x12\xae\x4b\xe6\x33\x26\x64\xbb\x9b\xa6\x7d\xa3\xa2\xb5\xb5\x62\x80\x9a\x38\xd9\x47\x3c\x79\xcd\xff\xe4\x90\x0b\x37\xd0\x5d\x37\x5e\xa0\xf5\x0a\x83\x2c\x69\x72\x1c\xc9\xb4\xc6\x35\xb4\xeb\xf8\x52\x8c\x38\x03\x97\x9b\x6a\x4a\xe7\x90\xb5\x53\x0c\xb9\xee\xde\xd2\x6a\x9a\x21\x5b\x5a\x25\x03\xe9\x32\x9d\x4d\x54\xd8\x29\x46\x4d\xe4\x0d\xb1\x0c\xc1\xde\x5b\xc8\xea\x5e\x22\xc3\xd8\x12\xec\xbf\x4b\xd0\xaa\xe9\xc0\x06\xfc\xc3\x64\x82\xd7\x3f\x00\x81\x75\x8f\xb7\x54\xb1\xdb\x17\xe0\xfe\xf3\xa2\xe7\xa9\xdc\xf4\x5a\xe9\x5b\x30\xb6\x8d\xf4\x56\x93\xe5\xdc\x8d\xca\x6a\xaf\xb5\x94\x02\xce\x17\xac\x62\xd2\xb7\xca\x9b\x91\x75\x9c\x52\xd1\x3b\x74\x72\x72\xff\x1f\xd5\x36\x6b\x57\x2d\xde\xd0\xe6\x0c\xb4\xdb\xd5\xe5\x24\x21\x84\xa8\x04\xd5\x06\x1b\xac\xec\x61\x9f\xcf\xd6\x6c\xd7\x05\x03\x5c\x35\x4c\xc9\x39\xbe\xca\x81\xe9\x01\xde\x11\xb9\x62\xea\xb3\x72\x6f\xf8\x9d\x4b\xd6\x52\x09\x9e\x52\x14\x3c\xa7\x92\x75\x19\x39\x2b\x09\x86\x31\x7a\x1a\xfb\xc0\x3b\xd9\xa5\x38\x1d\x18\x23\x79\x2d\x00\x18\x95\x26\x5d\x27\x57\x0c\x37\xca\xfb\xb6\x65\x42\x22\x4f\xea\x16\xd4\xb3\x67\x7a\x4e\xe7\x83\x4c\x49\xcb\x96\xb4\x2d\x2a\xd6\x75\x10\xaa\x01\x64\xb3\xd6\x20\x94\x91\x33\x44\xfa\x8a\xe5\xb4\xef\x98\x3f\x07\xf7\xb2\x88\x6f\xf8\x72\xa5\x72\x1c\x92\x56\x8c\x14\x3d\x23\xb2\x46\x14\x50\x7a\xbc\x16\x84\x0b\x42\x49\x55\xd7\x4d\x36\x9f\x21\x03\x3c\x5e\xd9\x9b\x33\x00\x24\x8f\x35\xe3\x13\xd2\xad\x79\xf3\x46\x48\x5e\xbd\x85\xab\x3c\x1a\x36\xac\x1c\x00\xab\x24\x6b\x33\x4e\xbe\x55\x1f\x80\xf9\xae\x27\x1e\x8d\x25\xf6\x19\xdb\x67\x3a\xae\xc0\x45\xba\x99\x1e\xbf\xa8\xd6\xab\xb5\x4b\x0a\x4c\x5a\xde\xd9\x55\xcb\xe8\x5a\xc7\x63\x47\x47\xe4\xd7\x15\x43\xe2\x78\x47\x68\xd5\x32\x5a\x68\x3a\x59\x91\x91\x17\xf5\x96\x91\x1a\xe5\x41\x04\xfb\x80\xcc\xdc\x64\xb0\x25\x6e\x7e\x78\x18\x5e\xe1\x1a\x18\xc6\x97\x7e\xf6\x2b\xf8\x94\xbd\x9d\xb6\x82\x07\x9a\x75\x10\x04\x4d\x69\xf9\x44\xda\x18\xd8\x13\x4d\xcf\x86\x8b\x7c\x0a\x76\xf7\xd6\x1d\x0a\xd0\xfe\x67\x1f\x5c\xe4\x0c\xb8\xa8\x13\xa1\xc4\xf3\xab\x5f\x67\xd7\xe4\xad\xd9\x2e\xe6\xf2\x01\x44\xa1\xf8\x31\xbe\x30\x2a\x10\x73\xb0\x4b\x5b\xda\x92\xf5\x36\x3c\x5d\x5a\x80\xa8\x4a\x8f\x5c\x42\x16\x9d\xa4\x7d\x32\x9f\xdd\x12\x56\x75\x2a\xd0\xc4\xd1\x09\x95\xf2\xd4\x01\x73\xbb\x7b\x34\x2a\x8c\xa4\x6f\xef\xd7\x31\x87\xca\x48\xcb\xe6\x4a\x8f\x7e\x61\x79\xdd\x16\xa8\x2a\x6b\xb6\xfb\x93\x3a\xab\x0d\xe5\x2d\xbe\x88\x54\x51\x60\x87\x72\xc9\xac\xb3\x2a\x84\x14\x43\x20\xf0\xbb\xbc\xa1\x89\x37\xd6\x23\x57\x88\x9b\xc8\x2c\x56\x92\x4e\x74\x3c\xb1\xcf\x2f\xc2\x6c\x50\xf3\x29\x01\xdf\x21\x51\x9f\x12\x64\xa8\x3d\x1d\x1e\xec\x8a\x89\x89\x80\x8e\x8b\xc1\x5b\x4e\x0f\xd7\x67\x2b\x50\x57\xb1\xdc\xca\x1f\x79\x8b\xce\x97\xe8\xeb\xde\x44\xfa\x0b\xf4\xaf\x6b\x73\xe5\x1b\xb7\xde\x1d\x89\x97\x76\xdc\x25\x4c\x33\x97\x88\x12\xbc\x8a\x12\x3f\x88\xb9\x23\x83\xe6\x16\xa4\x64\x9b\x61\x55\x51\xdd\x90\x61\x77\x88\x32\x7c\xf5\x37\x19\x52\x73\x79\x56\x11\xc1\x9f\xc9\xda\x25\xcd\x4c\x7a\xb4\x33\x17\x47\x7f\x33\x70\xda\x0a\x73\x1d\x76\x52\x75\x8d\x4b\xcc\x02\xe5\xb5\xbf\x50\xdd\x6e\x51\x4a\x82\xc9\x7a\x74\x34\xbb\x42\xf6\x0e\x67\xeb\xd1\xd1\xec\x1c\xe2\x4d\x2e\x77\xc3\xf9\x76\x1c\x57\x6c\x91\xe9\xf7\x2b\x34\x42\x1e\x46\x75\x70\x19\x31\x09\x17\xdd\x35\xaa\x93\x18\x2a\xa0\x9a\x8e\xa4\xc2\x39\xf0\x10\x65\x6a\xbe\xab\x4b\xab\xc2\x4b\x21\x8e\x03\xc6\x47\x98\xb7\xa2\x2a\x32\x66\x39\xde\x65\xbd\x20\x6c\x0b\xa1\x97\x82\x91\x7a\x5b\x26\x43\x9f\x33\x0d\x2d\xe0\x1a\x06\x8c\x03\x4e\x1a\x21\x0d\xb2\xa8\x63\x68\xc3\xac\xe9\xfc\x4e\x2c\x83\x54\x6a\x4a\xbe\xaf\xeb\x2a\xc5\x1a\x50\xaa\xf3\xf3\xb6\x05\xdc\xa4\xea\xd1\xee\xf9\x5b\x8f\x42\xdf\x0c\xee\xb6\x41\x6a\x55\xe5\x94\x0e\xf0\xb4\x3c\x6b\xdb\xba\xbd\xb1\x29\xfe\x1f\x6a\xb1\x65\x2d\xa8\xe5\xfa\x76\x3a\x41\x66\xb3\x2e\xe3\x5a\x39\xad\xfc\x6c\x80\x3a\x69\x59\x5b\xc7\x09\xf9\xa8\xbf\x1d\x3c\x2c\xa7\xf6\x43\xdd\xec\x5c\x9f\x83\xce\x9f\x69\xeb\x54\xe0\xc9\x2c\x3a\x99\xad\x71\x19\x9a\x8a\x62\x0d\x9e\x4a\xd5\xff\x0f\x0e\xf4\xd7\x61\x31\x7b\x0f\xc1\x0d\x1c\x93\xc2\x90\xab\x80\xd9\x66\x82\x1b\xdd\xd1\xb0\xe9\x3b\xf9\x3d\xfb\x2b\x5e\x55\xe8\x55\x05\x17\x7e\x98\xed\x1e\xb9\xee\xa9\xf9\x7c\xd6\x21\x8e\x5d\x9b\x5b\x1c\xd1\xce\xa1\xac\x60\x43\xd5\x5b\x86\x36\x2e\x44\xbc\x1b\x20\xee\x2d\x39\x85\x87\xea\x34\x71\xb1\x44\x2a\x3b\x99\x4d\x1e\x38\xcc\xcc\xaa\x03\xf9\xc8\x83\x70\xa3\xde\x35\xb9\x8f\x15\xdd\xda\x75\xb7\xce\x80\x86\x09\x02\x27\x20\x43\x0c\xd3\xbd\xe8\x3b\xf9\x82\xca\x7c\x15\x8f\x18\x1c\x20\xab\x1a\x43\x82\x63\x09\xf6\xb8\xe8\xa4\xbe\x68\xc1\xf4\xc0\x19\x4c\x08\xe5\xad\x7f\xd8\x4c\xed\x26\xdc\x27\x51\xa7\x4e\x4d\xd6\x9b\x68\xb7\xa2\x05\x14\x7a\x9c\xc1\x26\xd6\x33\x0d\x36\x19\x20\xef\xdb\x0c\xbd\x09\x00\x0b\xf9\xb3\xcf\xab\x6a\x6b\xc0\xc5\x52\x71\xe9\xad\x33\x09\xfa\x75\x29\xff\x18\x4e\x2f\xd7\xbd\x09\xd3\xab\xad\xdb\xc7\x9e\xd5\x5f\x58\xce\xf8\x96\xb5\x71\xdd\xd8\x3e\x3d\xeb\xa0\xb9\xce\xf5\xbc\xb3\x01\xb3\xd7\x9a\x89\x79\xed\x89\x40\x04\x54\x1b\x7b\x84\x4c\x07\x25\x2f\xb5\x55\x77\x1a\x79\xe6\x07\xb5\x33\x29\x55\xe0\x12\xbc\x6e\x31\xca\x77\x29\x6f\x6f\x62\x48\x6c\x0e\xf9\xf8\x91\x70\xf2\x9d\xee\x29\x93\x99\xee\xc2\x4d\x7c\xcd\x76\x99\x72\xd3\xa3\xa5\xba\x20\x5c\xd9\x52\xf7\xf3\x72\x88\x29\x23\x93\x0a\xc6\x97\x5b\x0e\x1c\xcc\x0b\x7e\xa9\x0f\x90\x94\x99\xe9\xb1\xdb\xe0\xa7\x24\x0b\x7a\x25\x27\xf7\x8e\xc8\x21\xa9\x1b\x72\x48\x22\xec\xbe\x18\xbe\xdb\x69\xb7\x85\x20\xed\xae\x5c\x3c\xea\xb2\xde\xdb\xb8\x5c\xd5\x90\x75\x4a\x26\x10\x53\x3d\xa7\x41\x68\xae\xde\x2b\x53\xf2\x18\x75\x37\x2b\x12\x7b\x2e\x64\xcc\x13\x60\x2c\x7e\xc4\xe0\xb0\x4b\xfe\x30\xb6\x6e\x3c\x6e\x2a\x44\xfe\xc7\x18\xaa\xb6\x77\x3c\xdd\x0c\x99\x7a\xe7\xeb\xe2\x41\x18\x9a\xdc\xd7\x09\x07\x87\x36\xdf\xb6\x8a\xfd\x81\x99\x71\x9d\x81\x0a\x94\xb2\x0f\x30\x77\x18\xea\x82\x5d\x81\x07\x0a\x5c\x29\xc8\xe9\xd0\xe9\xc2\x53\xd7\x61\xe7\x97\x33\x95\xc5\xb0\xc7\x1f\xaf\x40\xf6\x1c\x9a\xa0\x7c\x54\xa9\xc1\xc3\x8b\xef\xa4\x63\xe3\xc6\x3d\xde\x13\xc7\x32\x0b\x35\x4a\xc9\x93\x5b\x67\x01\x3d\x9f\xaf\x54\x4e\xbd\x53\x0f\x30\xb7\xba\x50\xa3\xc6\x55\xdc\x1e\xf9\x70\xb6\x0e\xcc\x34\xbb\x94\x3d\xf4\xb0\x8f\xa7\xeb\xcd\x1e\x27\x9d\x18\x82\xf7\x2f\x3c\xf3\x7a\x07\x38\xb7\x78\xea\xdd\x0d\x0e\x8b\x9e\x1d\x9f\x79\xb9\x06\x08\x5d\x3c\x78\x68\x9e\xff\xd8\x72\xc7\xd0\xb6\xbf\x54\x2d\xe7\xae\x01\xd6\xb4\x7b\xff\xe5\xf9\xd9\x7f\xbe\x78\xf6\x97\x28\x48\xf4\xfb\xac\x1f\x3b\x83\xb0\x38\x39\x96\xe4\x40\x3b\xee\xb7\x0f\x7d\x87\xbd\x83\xb0\xf3\x2b\xda\x4a\x4e\x2b\x88\x68\x4d\xad\xf2\x5d\x4a\xde\xa1\x83\xb1\xef\x18\x7a\x8e\x0a\xdb\x23\xc1\x32\xe9\xcb\xdb\x77\xdf\x39\x44\x5e\xaf\x78\x89\xed\xc2\x7f\xf0\x51\xfb\x83\xeb\x9f\x7b\xeb\x49\xa5\x30\xa2\xa6\x4d\x53\x41\xa4\x04\x48\x78\x80\x13\xac\xc4\x85\x61\xf8\x36\x43\xcc\x93\xfd\xb1\x78\x58\x98\x0b\x43\xf1\x41\x99\x0e\xfc\xf7\x75\xa7\xd0\x79\x25\xdb\xc1\x4b\xb9\xc3\xc2\x92\x37\x13\x2e\x40\x4a\x9d\xde\xb7\xb4\xf9\xa9\x73\xbf\x85\x62\x1a\x7a\x83\xab\xf5\xf0\xc7\x54\xd4\x55\x50\x5d\xef\xdd\xee\x01\xb3\x34\x06\xf6\xa9\x3e\xc6\x3a\xca\x32\xf3\xb6\xea\x57\x65\x74\x4f\xcc\xbf\x07\x97\xad\x61\x40\xad\x93\xf3\xfb\x10\x58\x32\xf9\x53\xf7\x2b\x5c\x6c\xec\x8b\x10\xfe\x89\x2c\xeb\x16\x5f\x91\x78\x74\x4a\xa2\x08\x37\x38\x3a\xc2\x64\x2c\xa9\x18\x2d\x60\x52\xd7\xd0\x9c\x81\xb7\xc4\xde\x6c\x5b\x14\xff\x56\x05\x3d\x74\x99\x40\xe8\x2f\xe9\x12\x8b\xdd\xa7\xe4\x4b\xf2\xa5\xbe\x59\x1f\x1e\x1a\x1f\x08\xc6\x5b\x4d\x39\xd1\x7e\x57\x2a\x7b\xae\xb7\xf4\x2e\xc0\x1a\x81\x9c\x0a\x22\x6b\x92\xd7\x55\x2d\x32\x35\x46\x15\x26\xa4\x6e\x09\x25\xff\xea\x6b\xc9\x30\x29\x4b\xba\x9d\x90\xf4\x83\x3a\xdd\x88\xe6\xbd\x58\x3e\x52\x58\x86\x03\x27\xc3\x81\x68\x44\x07\x1c\xdf\xc3\x85\x8d\xf7\x00\xe8\xc7\x8f\x03\x18\x66\xe0\x70\x11\x42\xf1\xaf\xf8\xf8\xc6\xc6\xc9\xa9\x96\x02\x00\xba\x38\xe1\x97\x49\xc8\xa9\xc3\xc5\xc9\xa5\xcf\x0d\xa4\xb8\x30\x92\x93\x35\x29\xb9\x28\x94\x13\xd5\x54\x2f\xee\xa7\xda\xd2\x54\xfa\x12\xfb\xc7\x3f\xbe\x34\x2f\xe6\x23\xad\xfa\xf7\x0a\x02\xba\x03\xaa\x47\x14\xfd\x4b\xe5\x33\x87\x34\x1d\x2e\xf6\x51\xc5\xd5\x0b\x2c\xa8\x03\xd7\x9d\xd6\x82\xad\x0a\xfa\xdf\xa9\x4e\x25\x24\x38\x56\x90\x13\x2f\x29\x6b\x48\x0e\x5a\x70\x23\x74\x25\x47\x47\x04\xb3\x41\x5e\x11\x84\x91\x46\x27\x9d\x31\xa7\x8d\xcd\x29\xac\x62\x60\xc9\x88\xcc\x60\xc5\xf3\xba\x25\xec\x03\xdd\x34\x15\x5c\x38\x4a\x22\x49\xcb\x9a\x96\x75\x68\x44\x71\xd1\xf3\xba\x4e\x89\x4e\x33\x25\xfe\xd3\xc7\xcf\xeb\x3a\x53\xc7\x4c\x3f\x9e\x6e\xd4\x93\xf6\xd7\xa7\x4c\xa3\x97\xc6\x16\x2e\x4b\x70\xa1\x33\xf8\x52\xed\xe4\xf2\x5a\x48\xca\x05\x4a\x7a\x85\xe5\xa9\xb0\xc8\x43\x25\x76\x05\x01\x08\x5a\x55\x75\x4e\x25\x4c\xa5\x44\xb0\xf7\xaa\x05\xf3\xaa\x62\x84\x76\x44\x30\x56\xb0\x22\x73\xaf\x6c\xbc\xa5\x55\xd0\x07\xa0\x5f\x6a\xc0\x26\xa3\x51\x2c\x10\xb4\x42\x83\xef\xc0\x44\x49\x6c\xdd\xd6\xd1\x11\x26\x46\x74\x97\x06\xe9\x6a\x52\xf6\xb2\x6f\x19\xc9\x57\x54\x2c\x59\x07\x5a\xaa\xd1\x57\xb3\xdf\xd7\xe2\x4b\xa9\x9f\xe2\x93\x5e\x14\xac\xad\x76\x80\x3c\x12\x06\x47\x3d\x9f\x6c\x54\x9b\x85\x7d\x1b\xbb\x26\x25\x39\x62\x9d\x0c\x5b\xb3\xcd\x33\xfb\x7e\x82\x7e\x1d\x61\xba\xb9\xea\x71\xfc\x78\x40\x36\x76\x66\xc1\x72\xeb\x8c\x3a\x06\xde\xe7\xff\xb3\xaa\x61\x2d\x19\x55\x47\xbf\x50\x8f\xd5\x6f\x89\xe8\x60\x36\xc9\x94\x7b\xce\xb2\x2c\x68\x2e\xf7\x0c\xbe\xc9\x4a\xaf\xa8\x68\x59\xbe\x1d\xb7\x61\xa4\x44\x5c\x61\x5e\x66\xba\xf0\x1c\xab\x6d\x59\x91\x92\x56\x45\x26\xe6\xb5\xb6\x9b\xf9\x0c\xdc\x30\xde\xb3\x2e\x2e\xfd\x30\xe0\xe6\x66\xe2\x2d\xa3\x55\x72\xab\xd2\x4c\xe2\x4a\x35\x14\xe1\x5a\xfb\x1e\x14\x7e\x4d\x83\x68\xe2\x46\xa7\xa6\x14\x06\xbf\x30\xdc\xc9\x67\x92\x5a\x94\x18\xa8\x07\x07\xc4\x4e\xd5\x97\x94\x27\x3a\x19\x00\x06\x60\xe1\xfb\x35\x7c\xdf\x59\xbf\xf6\xac\x45\x96\x6f\x83\x2d\x1c\x90\xc5\x64\x81\xd7\x2f\xad\xab\x60\x55\x83\xb0\x5b\x7b\x2f\x73\xb5\x3d\x1b\x3e\x5f\x8c\xdf\x75\x5a\x51\xd1\x21\x2f\xc6\x32\x1a\x8b\xc6\xca\xcd\xbd\x5d\xf5\x69\xe2\x48\x1f\xd2\x2f\xf0\xbf\x4e\x66\xfe\xf9\xc2\x9f\xe3\xb3\xbf\x37\xa7\xe0\xc4\xfa\x2f\x04\xa6\x6d\x2f\x24\xdf\xb0\xd7\x38\x80\x2d\x48\x75\xc7\x84\x7a\x99\x01\x7f\x1a\xe7\xe7\x09\x55\xd6\x9d\x7a\xe3\x4e\x77\x03\xd8\x6b\x77\xef\xbc\x26\x34\xb3\xed\x8d\xeb\xa2\x53\x1b\xff\xc8\xdb\xb8\xcb\x0a\xde\x7a\x8d\x74\xfa\x89\xd7\x0e\x87\xfb\xab\x46\xbe\x90\x9f\xe1\x92\x5f\x58\xbe\x55\xf3\x57\x13\x1d\x14\xf8\xe6\xc3\x4b\x5e\x45\xe6\x57\x61\x26\xee\x4f\x59\xbe\x32\x25\xe9\xc1\xa3\x27\xa6\x10\x91\xaf\x26\x33\xea\xb8\xd4\xfa\xed\x7d\x08\xe7\xab\x01\xca\xaf\x99\x28\x1e\x8a\xf2\x54\x61\xea\xdf\x48\xc8\xde\xe2\x41\x97\x4d\x74\xce\xdc\x4b\x38\x1e\xd3\x5b\x97\x43\xbe\xf7\x0c\xe4\x53\xe6\xe6\x89\x4d\x7f\xf2\xd2\x53\x21\xa3\x60\x17\xf9\xa5\x52\x26\x7c\x97\xc5\xe8\x84\x3e\x27\x77\xda\xb0\xa9\x1f\x4e\xf0\x80\x3e\xc8\xa0\xd9\x57\x3e\xf7\x9b\x33\xef\x80\xe6\xc6\xc2\x9a\x43\xfa\x23\x63\xcd\xb3\x7f\xf5\xb4\x8a\xe9\x22\x25\xf4\x38\x7c\x27\xca\xd8\x31\xbe\x98\xee\x66\xa2\x40\x05\x3f\xde\xf3\xf0\x58\xdf\x7c\x17\x58\x71\x3f\xf6\x2d\x87\xfa\xdd\xce\x5b\xef\xb9\xe0\x15\x66\x55\x8f\xfd\x2f\x8b\x89\xf7\xa6\x40\xc3\xf8\xf1\xd4\x83\xbb\x2c\x53\xc1\x58\xa3\xf2\x46\x40\xec\x4f\x5d\x6c\xde\x02\xa3\x8b\x24\xb5\xaf\x84\xd1\xe3\x04\x9b\x21\x9c\x0b\x18\xad\xdb\x2e\x52\xb2\x3d\x36\x79\xea\x2d\xef\x38\x44\xe7\x17\x97\x17\xc7\x97\x43\x4f\x6d\xb9\x57\x92\x47\xdb\x45\x76\xd6\x61\x3f\x42\x8c\x97\x87\x47\xdb\x63\x6f\xc0\xc3\x3c\x9c\x79\x70\x10\xce\x34\x3c\xdb\x2e\xf4\xc5\x1b\xb8\xb1\x3d\x36\x5f\x26\x39\x10\x4c\xdf\x7f\xb1\x1c\x5c\x58\xbd\x59\x29\xac\xb7\x09\x2b\x00\x71\xe7\xdc\x63\xbf\xad\x17\xeb\x1c\xca\xf8\x6e\x17\xc3\x57\x0d\x74\x71\xd1\xbd\xea\x93\x7a\x15\x4c\xb0\xe8\xef\x74\xb3\x98\xb3\xea\x86\xe1\xe6\x36\xb3\x5d\x40\x64\x0d\x38\xe1\xc4\x8b\x27\x97\xc0\xb3\xed\x71\x38\xba\xb8\xb4\x6d\xc8\x9e\xfa\x29\xf3\x81\xb5\x57\x0d\xd5\x3a\x52\x3d\x90\x92\x91\x58\x6f\xd4\x8e\xa9\xde\xe3\xf6\x81\x34\xda\x52\xbd\xc2\xd9\xab\x49\xbb\x26\x69\xf5\xe8\xac\x7b\xc9\x2b\x2b\x58\xf3\x2d\x40\x5f\xcb\xd6\xfd\xbe\x9c\x27\x1f\x2c\x65\x3b\x11\xdc\x43\x37\x6d\x89\x20\xa7\xb0\xfe\xef\x4c\x98\x34\xbc\xd0\x7b\xe3\x50\xd0\x17\x63\x36\xbe\x9d\x8f\x7f\x54\x52\xb8\x17\xb5\x51\xe3\x27\x4e\x8e\x4d\x54\x23\xf7\xbc\x2f\x8a\xdb\x77\x51\x79\x3b\xb4\x1d\xe3\xdf\x21\x0b\xd9\xf7\xf1\xe3\x88\x7d\xe6\x1e\xe9\x26\x29\x55\xd1\xdf\xc2\x5d\xa6\xd0\x37\x25\xc3\xed\xb1\xfb\xa8\x51\x0f\x1b\x10\x7e\x17\x0c\xbf\x88\x6f\xc5\xf3\xb2\xdf\xe0\xaf\xfe\xc4\xc9\x67\xb2\x5e\xad\xd6\xac\xf7\xbe\x7c\x2e\xeb\xf5\xcf\x83\xdd\xab\xb3\x13\x9a\xf3\x00\x85\x0d\xf5\xd5\xa8\x2a\xf6\x5f\x22\x3b\x5e\xd0\xe6\x67\xb6\xb3\x85\x23\x88\x06\xe1\x61\xf2\x60\xcd\x35\x7d\xa3\xca\xaa\x20\x60\x93\x8a\x40\x5f\xa7\xf6\x50\x2a\xba\xd6\x91\x50\x85\x8e\x6e\x7b\x3c\x7c\x82\xf6\x9d\x56\x23\x0b\x4f\xab\xe3\xc1\xd0\x58\x30\xb4\x5a\x60\x90\x72\xfc\x3b\x44\x61\x7e\xbe\xf1\x5e\xfd\x56\x2f\x25\xa1\x39\xd3\xd6\x2c\x5c\xb6\x47\x24\xc1\x4b\x94\xa3\xba\x94\x0d\x18\xce\x3a\xa4\x2a\xda\x73\x8d\x09\x6a\x3e\x8b\x24\x79\xc8\xb4\xe3\x24\xf1\x2e\x65\xff\x1d\x00\x00\xff\xff\x19\x70\x39\x8f\xb3\x5d\x00\x00"),
 		},
 		"/src/internal/reflectlite/swapper.go": &vfsgen۰CompressedFileInfo{
 			name:             "swapper.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 838,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x52\x4d\x8f\xd3\x30\x10\x3d\x7b\x7e\xc5\x23\x42\x28\xd6\x56\x69\xf7\x5a\xa9\xdc\x00\xad\x58\xd8\x43\x25\xee\x6e\x3a\x6e\x6c\x52\x3b\xb2\x9d\x16\x68\xf3\xdf\x91\x93\xb0\x95\x40\x5a\x2d\x87\x48\x93\x79\x6f\x3e\xde\x1b\x2f\x97\xb8\xdb\xf5\xa6\xdd\xc3\x46\xa2\x4e\xd5\xdf\xd5\x81\x11\x58\xb7\x5c\xa7\xd6\x24\x26\x32\xc7\xce\x87\x84\xe2\x60\x52\xd3\xef\xaa\xda\x1f\x97\x07\xdf\x35\x1c\x6c\xbc\x05\x36\x16\x44\xba\x77\x35\xb6\x67\xd5\x75\x1c\xca\xd8\x9a\x9a\x61\x5c\xe2\xa0\x55\xcd\x97\x41\x22\xe3\xa5\x59\xc0\xe6\xb4\xc4\x85\xc4\x09\xeb\x0d\xbe\xa9\xb6\xe7\x27\x3d\x55\x48\x12\x46\xe3\x54\x7d\x36\x6e\x5f\x4a\xbc\xd9\x60\x3b\x36\xba\x90\x10\x9d\x72\xa6\x2e\xdf\x8d\xfc\x0f\x21\xf8\x70\xf9\xc2\xa9\xf1\xfb\x35\x8a\x79\x6a\xb1\x40\x2e\x5c\x3f\x37\x18\x24\x89\x81\xc4\x72\x89\x8f\x2a\x26\x74\x2a\x35\xd0\x3e\x60\x9c\x15\xe1\x35\xa2\xf9\xc5\x58\x41\xb9\x3d\xee\x2b\x7c\xf5\xa9\x31\xee\x80\xe4\x11\xcf\xaa\xab\x48\x9c\x1e\xd9\xe5\x2d\x7b\xe3\x52\x79\xaa\x1e\xd9\x95\x52\x92\x88\x67\x93\xea\x06\x23\x7a\x21\x51\xab\xc8\x58\xad\x49\x88\xc0\xa9\x0f\xee\x1f\xad\x98\x96\x2f\x66\x6b\xd7\xf8\xe3\xcf\x9e\x7f\xc0\xf7\x29\xaf\x12\x94\x3b\x70\x21\x31\xcc\xfd\xee\x5f\xe8\x47\x42\x64\xa3\x4c\x76\x68\x85\xeb\x15\x76\x8a\x46\x40\xbc\x7e\x58\xa6\x0f\x34\x7e\x03\x09\x95\x95\xda\x58\x3d\xe4\xb3\x39\xd5\x3e\xed\x2c\xd7\x69\xbe\x4c\xf5\x89\x53\x59\xbc\x55\x21\xa8\x9f\xb9\xd0\x6b\xfd\x0a\xba\xd7\x3a\x72\x2a\x64\x26\x95\x92\x5e\xd0\x63\xf4\x64\xb2\x91\x78\xbf\x99\x9c\xbd\x5e\xa7\x94\xbd\xa5\x46\x81\xff\xa5\x2f\xcb\x33\xb8\xdb\xc0\x6b\x4d\x42\xd8\x5b\x98\x8e\x5d\x56\xa0\xaa\x87\x5c\x59\x9a\xcc\x56\xd5\x96\xd3\xfc\xbf\x78\x86\xac\xfc\x0b\xb3\x0b\xa4\x63\x37\xbe\xae\x81\x7e\x07\x00\x00\xff\xff\x9b\xd0\xc3\xec\x46\x03\x00\x00"),
 		},
 		"/src/internal/reflectlite/type.go": &vfsgen۰CompressedFileInfo{
 			name:             "type.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 2300,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x55\x4d\x6f\x1b\x37\x10\x3d\xef\xfe\x8a\xc9\xa2\x28\x48\x5b\xa5\xed\x43\x2f\x8e\x55\xa0\x30\x9a\xc0\x35\x1c\x17\x70\x9a\x8b\x61\x14\xd4\x8a\x94\x29\xef\x92\x0b\xee\x6c\x1b\xc1\xd1\x7f\x2f\x66\x48\x7d\x58\x5d\x27\x28\x72\x30\x40\x0f\x67\xde\x7b\xf3\x66\x96\x3a\x39\x81\xe3\xd9\xe0\x9a\x39\x2c\xfb\xb2\xec\x74\xfd\xa4\x17\x06\xa2\xb1\x8d\xa9\xb1\x71\x68\xca\xd2\xb5\x5d\x88\x08\xa2\x2c\xaa\xc1\xf7\xda\x9a\xaa\x2c\x8b\x6a\xe1\xf0\x71\x98\xa9\x3a\xb4\x27\x8b\xd0\x3d\x9a\xb8\xec\x77\x87\x65\x5f\x95\xb2\x2c\xed\xe0\x6b\x10\x08\x47\x11\x57\x9d\x91\x70\x19\xda\x4e\x47\x3d\x6b\x8c\x90\x30\x0b\xa1\x81\xe7\xb2\xe8\xff\x71\x58\x3f\x02\xaa\x6b\xe7\xe7\x42\x52\xa8\xd6\xbd\x81\x77\x83\xaf\x27\x70\xd7\xb8\xda\x4c\xe0\x46\x77\xe7\x65\x51\x44\x83\x43\xf4\x60\x75\xd3\x9b\x9c\xf6\x6b\x8c\x7a\xb5\x77\x87\xea\xb7\xc6\xb4\x42\xaa\x7d\xb2\x9c\x7b\x87\x71\xa8\x91\x92\x6d\x88\xe0\xe0\x7c\x0a\xa7\x6f\xc1\xc1\x05\xa0\xfa\x30\xb4\xef\x9c\x69\xe6\x42\xbe\x05\x77\x7c\x4c\x32\x8a\xc2\x22\xe5\xa0\x4a\x37\x4e\x52\xcc\x59\x78\x63\x51\xe1\xaa\x7b\x41\x91\x0a\x0e\x14\x16\xc5\xba\xe4\xbf\x75\xb9\xd5\x17\x07\x53\xae\xff\xeb\xcd\x55\xff\x49\x47\xa7\xe7\xae\xde\xf3\xc6\xd9\x9d\x2f\x6f\xa6\x6c\x09\xf3\x74\xda\xbb\x5a\x54\x79\x4c\xe7\x7b\xc5\x10\x2c\xf8\xe0\x7f\x62\x78\x42\xae\x24\xb3\x23\x77\x22\x8e\x28\xfe\x91\x08\x45\x9a\xa5\xfa\x23\x38\x8f\x26\x0a\x94\x72\xa7\x11\x55\x18\xf0\x32\x0c\x1e\x7f\x14\x67\x17\x17\x67\x3f\x33\xfd\xe9\x98\xee\x27\xe7\xe7\x04\x28\x64\x0e\x91\xc0\x8c\x23\x72\xd2\x21\xd7\xb2\x57\x57\x74\xf0\xba\xb9\x9d\x2d\x4d\x8d\x02\xa5\x7a\x6f\x50\xb8\xf9\x75\x86\x93\x52\x8e\xb1\xe5\x41\x80\xf3\x28\xa1\xe7\x71\x72\x68\xc4\xac\x34\xec\x51\xbb\x52\x49\x76\x2a\xa1\x8c\x79\x95\x6e\x5e\x77\xcb\x59\xde\x9d\x53\xf8\xf2\x05\x1c\xfc\x32\x85\xc6\x78\x81\xa8\x2c\xc1\xf7\xf2\x2b\xd4\xce\xcf\xcd\x67\x08\x03\x92\x88\x59\x18\xfc\xbc\xcf\xdc\xbb\x09\x24\x94\x7b\xf7\x30\xe6\xc3\xb5\x59\x09\x09\x1f\xb3\xdd\x07\x9d\xdf\xe8\x6e\x94\xfb\xda\xac\x36\x4d\xb7\xba\x1b\xeb\xb8\xd5\xdd\xb7\x97\x23\xf0\xb8\x11\xd5\x93\x59\x8d\x0e\x69\xf7\x29\xd1\x9c\xfe\xdf\x68\x36\xb5\xdf\x3f\x9d\x2c\xf7\xe5\x4c\xc6\xe4\xde\x18\x7c\x0c\xdb\xa5\x12\x6d\x0e\xc8\x43\xe1\xd3\x29\xf0\xd6\x5a\x5d\xb3\xeb\x5b\x25\x6e\x13\x7d\x5d\xcc\xde\x5c\x37\x74\xa9\x9b\x96\xff\xeb\xd3\x33\x63\x3e\xd3\x4b\x6b\xe6\x29\xa5\x17\xaf\xed\x58\x2e\x1a\xdf\xb0\x54\xfc\x72\xc5\xa2\xf6\x8b\x8d\x7f\x1d\x71\x65\x04\xda\xae\xa2\xf3\xba\x35\x49\x00\x9d\x6e\xad\x15\x1d\x9f\x64\x59\xb4\xea\x03\x5d\x4e\x81\x93\x38\x4a\xaa\x6c\x43\xf9\xb6\xd1\x0b\x41\x6f\x12\x25\xe2\xaa\x4b\x18\x64\x6a\xc2\xa0\x18\x25\x7f\xeb\xe9\xe1\x3c\xea\xd5\xb3\x34\xfd\x64\xc4\xfd\x03\x65\x4e\xe0\x74\x02\x67\xc7\xd4\xb2\x45\xe5\xbc\x90\x39\x6d\x0a\xba\xeb\x8c\x9f\x0b\xe7\x27\x80\xc4\x11\x22\xfc\x35\x01\x1d\x17\x04\xc1\xed\x42\x2e\x61\x93\x0e\x6b\x74\x5c\x24\x37\xc8\xa0\x11\xd2\x4c\x19\x06\x4c\x9c\x19\x3f\x1a\x7c\x81\xcf\xf7\x4c\x40\x38\x5b\x86\x30\x20\xe7\xe6\x11\x73\x0d\xf9\x74\x6b\x99\x9c\xaf\x2d\xaa\xfd\x27\x9f\xbd\xe6\xef\x79\x0a\x2d\x96\x45\x17\x03\xfb\xb9\xec\xd5\xfb\x26\xcc\x74\xa3\x2e\x75\xd3\x88\xea\x87\x34\xb9\x3b\x83\xd5\x04\xbe\xf2\x8e\xfe\xde\xa7\x57\x54\x5d\xd1\x1e\x08\x97\xe2\x15\xc1\x56\x52\xdd\x61\x74\x7e\xc1\x93\xf4\x99\xe5\x46\x3f\x19\xd2\x28\x68\x4c\x02\x1f\x5d\x0f\x47\xcb\x5e\x25\x5c\x36\x6c\x68\x8d\xc7\x1e\xee\x1f\x76\x71\xfe\xc0\xd3\xee\x3f\xaf\xd9\x87\x58\xff\x1d\x09\x71\x9b\x7f\x7f\xfa\xb0\x5b\x7f\xba\x65\x21\xa4\x43\xe6\x96\x74\xd7\x35\xab\x6a\xc2\x97\x7b\x44\xf7\x67\xe7\x0f\x64\x20\x3b\xc3\xbf\x7c\x53\xf8\xa4\x9b\xc1\x3c\xb7\xa8\x36\xbf\x2c\x13\x38\xd8\x25\xeb\xd5\x9f\x1c\x11\x52\x4e\xc0\x36\xeb\x92\xca\xd9\x04\x98\x82\xdb\x3e\x0b\x6d\xb9\x2e\xff\x0d\x00\x00\xff\xff\x8a\x4f\x28\x15\xfc\x08\x00\x00"),
 		},
 		"/src/internal/reflectlite/utils.go": &vfsgen۰CompressedFileInfo{
 			name:             "utils.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 2553,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x56\x4d\x6f\xdb\x46\x10\x3d\x8b\xbf\xe2\x55\x05\x22\x32\x96\x44\xdb\xbd\x09\xf6\x21\xa9\xdd\x22\x28\xdc\x04\xb5\xd3\x4b\x5a\x04\xeb\xe5\x90\xda\x9a\xdc\x65\x76\x87\x4a\x84\xc4\xff\xbd\x98\xe5\x87\xe4\xd4\x87\xfa\x60\x70\x77\x66\xde\xcc\xce\xc7\x1b\xe5\x39\x4e\xee\x3b\x53\x17\xf8\x27\x24\x49\xab\xf4\x83\xaa\x08\x9e\xca\x9a\x34\xd7\x86\x29\x49\x4c\xd3\x3a\xcf\x48\x93\xd9\xbc\xb3\x41\x95\x34\x4f\xb2\x24\xe1\x7d\x4b\xf8\x79\xab\xec\x95\xf1\x30\x96\x93\x44\x3b\x1b\xa2\xda\x1f\xa4\x77\x72\x3b\x4a\x8f\xff\x2e\x71\x86\x8b\x0b\x18\xc7\x0a\x79\x8e\x8b\x95\xde\x2a\x9b\xcc\x6e\xc9\x16\xdf\xab\x3e\xf7\x97\xe7\x10\x83\x8b\x55\x32\x7b\xed\x78\x2b\x26\x97\x18\xfd\x7d\xc3\x73\x30\x83\xc9\x14\x33\x79\xef\xfc\x2d\x7b\x63\x2b\x04\xf6\x9d\x66\x7c\x4d\x66\x41\xbe\x8d\xad\x92\xc7\x24\x29\x3b\xab\x91\x12\x5e\x1e\xa9\x66\xb8\x96\x43\x9a\x0d\x7a\x62\xe3\x89\x3b\x6f\x41\xeb\x20\x56\x3b\xe5\xe5\xf1\xd7\xde\xdf\xee\x2d\xab\x2f\xb8\xc4\x8b\x23\x80\xaf\x73\x63\x77\xaa\x36\x05\x42\x14\xcf\x1f\x25\xa2\xe8\xaa\xb3\x9f\x3a\xc7\x94\x8e\x31\x64\x48\xfb\x8f\x65\x1f\x6c\x26\xce\x4c\x89\x9a\x6c\x1a\x32\x5c\xe0\x5c\x2e\x46\xf7\x61\x09\x6b\xea\x64\xf6\x18\x75\xc2\x87\xd3\xbf\x71\x79\x89\xc5\x5f\x8b\x05\xbe\x7d\x3b\x9c\xe7\x8b\x68\x14\x55\x7a\xa0\xd5\x59\x94\x44\x0d\x11\x4d\x80\x1f\xce\xb0\xc1\xa4\x33\xc0\x0b\xfe\xa8\x31\x9f\x2f\x31\xbd\x33\x7a\x7e\x1a\xcb\x63\x92\xe4\x39\x6e\x88\xb7\xae\x80\xa7\xd6\x53\x20\xcb\x01\x0a\xc1\xd8\xaa\x26\x34\x51\xb4\xee\x0b\x32\xe8\x1d\x6a\x91\xe7\xf8\x5d\x35\x04\x13\xc0\xdb\x51\x19\x56\x35\xb4\x8e\xc2\x77\x0f\xd5\x3b\xc5\xdb\x51\x3e\x36\x6d\x2b\x77\xbc\x55\x8c\x4f\x9d\xaa\x4d\x69\x48\x3c\xd6\xee\x33\x79\x68\x15\x08\x69\x67\xe9\x8b\xf4\x32\x15\x59\x04\x3a\x46\xc6\x1b\x16\x40\x6a\x5a\xde\xa3\x74\x1e\x5d\xdb\x4e\x86\x93\xd9\xb1\x49\xe8\xa3\xb9\xdb\x12\xb4\x6b\xee\x8d\x55\x6c\x9c\x85\x2b\xa7\x00\x95\x2d\xfa\x97\x74\xd6\x7c\xea\xa8\xde\xc3\x14\x64\x79\x0c\xad\xc7\x8a\x20\xc6\x4e\x67\x04\xe2\x1e\xf9\x96\x08\x5b\xe6\x36\x6c\xf2\xbc\x72\xb5\xb2\xd5\xda\xf9\x2a\xf7\x54\xe6\xa1\x25\xfd\xe3\xfb\x88\x6a\x29\x84\x8f\xae\xfc\x38\x41\xfb\x90\xcc\xa2\x57\x60\x6c\xea\xd9\x18\xd1\x70\x4e\x66\x77\x92\x79\xf4\xff\x0f\x99\x90\x7a\x24\xb3\x5f\xa4\x29\xf1\xa7\xaa\x3b\x12\x59\xec\xd1\xcf\x86\xb7\xf0\xa4\xc9\xec\xc8\x43\x05\x94\xc6\x07\x86\xf2\x55\xd7\x90\xe5\x64\xf6\xc6\x16\xf4\x45\x88\xa0\x1f\x39\x13\x8f\x92\x47\xf1\xb1\xee\x6b\x3c\x34\xc6\x2b\xdc\x92\xd0\x8b\x4c\x6a\x41\x41\x7b\x73\x4f\x7d\x29\xb5\x6b\x9a\xce\x1a\xdd\x67\xb2\x30\x9e\xf4\x98\x53\x85\x10\x8d\x62\x45\x86\xce\x39\xc0\x44\x02\x92\xbe\x79\x7b\x77\xbd\x91\x92\x04\xc2\x4e\x1e\x10\xd0\x74\x81\xd1\x28\xd6\x5b\xac\xd7\xb9\xef\x2c\x9b\x86\xf2\x1e\x6c\x5d\xb9\xcd\xf0\x79\x65\xfc\xfa\x88\xc3\x3e\x3e\xe1\x90\x83\xa3\xcb\x48\x5d\x42\x58\x72\x23\x7c\xf3\x5f\x82\x92\x96\x11\xee\xc3\xc5\x2a\x32\xd2\xa8\x2d\x3c\xf5\xbc\xf6\xc5\x4a\xf4\x37\xa3\xe2\x15\x95\xaa\xab\xf9\xa9\x62\xd1\x5f\x0a\x6b\xc4\x1c\x0e\x2f\xe9\x2d\xa4\x75\xa7\xe9\x8a\x90\xad\x0a\x81\x0a\xb0\x83\x1f\xde\x9a\xc4\x66\x35\xff\x23\x23\x4f\xa0\xfb\x54\x3f\xf5\x76\x98\xd5\xc2\xf8\xa3\xec\x8c\xb1\x1e\xb2\xb3\xc4\xd1\xdb\xdd\xa4\x3b\xbc\x65\xc6\xfb\x16\x2f\x7d\xf4\xf0\x1d\x5f\x5b\xaa\x87\x76\xd4\x5b\xa0\x5f\x3c\xeb\x77\xce\x58\x26\x7f\xa4\x93\xcc\x76\xaa\x7e\x46\xdc\xb2\x97\xb7\x17\x8a\x15\xd2\x61\x2d\x64\x12\xc0\x20\x18\x5a\x19\xf7\x5d\x59\x92\x47\x3a\xec\x90\xec\xc0\xff\x25\xca\x5a\x55\x59\xcc\xd6\x6b\x12\x0a\x20\xcd\x54\xe0\x37\x63\x8b\x6c\xa0\xa9\xbb\xb7\x57\x6f\xd3\x66\x57\x28\x9b\x6d\xd0\x05\x42\xb9\x7e\x30\xb6\x48\x33\xa8\x4a\x19\x0b\x67\x35\xa1\x31\xc5\x2a\xb0\xd2\x0f\x30\xb6\x36\x56\x96\x47\x45\x1c\x70\x4f\xcc\xe4\x23\x6b\x0b\x66\x5a\xbe\x10\x87\xf2\x79\xa3\xc2\x43\x86\x1f\x2e\x31\x39\x15\x7e\x6e\x95\x35\x3a\x7d\x11\xe7\x32\x2e\xa3\xaf\xfd\xd4\xca\xac\xa7\xd9\x72\xf2\xfd\x98\x09\x25\x4f\xa3\x16\x4b\x75\xa7\xaa\x91\x2e\x59\x55\xe3\x0e\x8b\xac\x33\xd4\xb2\x34\x54\x17\xd2\x23\x62\xf6\x7a\x0f\xed\xec\x4e\x08\xc5\xd9\xe5\x91\x49\x80\xf2\x04\x25\x52\xad\x98\x26\xca\x13\x23\xd7\xca\x41\xd5\xf5\x1e\xa1\x55\x9a\x56\x81\x5a\xe5\x95\x84\xff\x40\xfb\xcd\x3c\xce\xe3\x1c\xad\x32\x3e\xc4\x66\xbc\x56\x7a\x2b\xa2\xbe\x79\xad\xb3\xab\x9e\x7d\x87\xe8\x64\x16\x4d\x60\xf9\x74\x65\x14\x6b\x67\xd9\xbb\x3a\xe9\xcb\xef\x95\x66\xf2\x01\x8e\xb7\xe4\x85\xf8\x6d\xef\x17\xe9\xfb\x93\xd3\xd3\xf3\x53\x2c\xb0\xc8\x96\x88\xbb\x75\xb8\x3b\x97\x3d\x98\x2d\x05\x40\xb8\x59\xbb\xda\xd9\x5e\xf4\xd3\x2b\x2c\x36\x8b\x6c\x8d\x3e\xaa\x18\xab\xc4\x15\xad\x0b\x74\x32\x5a\x38\x60\x7c\x17\x82\x80\xfd\xea\xc6\xc0\xe5\x67\x93\x57\xf5\xb0\xe8\x47\xae\x9a\xea\x30\x72\x70\x6c\x33\x91\x85\x9b\x2e\xf0\x8d\xcc\x63\xfa\x59\xd6\xd7\xb8\xfc\xf9\x6c\x09\x3e\x8f\x04\x3a\xfe\x04\xe0\x33\x69\x0b\x3e\x3f\x6a\x88\x68\x72\x82\xf9\x06\x73\x9c\x80\xcf\xd6\xfd\xef\x8d\x34\x93\x4b\xd1\x8e\xd7\xe7\xd3\xf5\xd0\x1d\xff\x06\x00\x00\xff\xff\xbf\x8f\xe3\xe4\xf9\x09\x00\x00"),
 		},
 		"/src/internal/reflectlite/value.go": &vfsgen۰CompressedFileInfo{
 			name:             "value.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 15476,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x3b\xdb\x72\xdc\x36\x96\xcf\xcd\xaf\x38\xee\x4a\x29\xa4\xcd\x50\xca\x64\x6b\x6b\x4a\x49\xbb\xca\xe3\x38\x59\x65\x62\x3b\x15\x39\xd9\x07\x95\x2a\x85\x26\xc1\x6e\xa8\x49\x80\x03\x80\xad\xee\x91\xf4\xef\x5b\x07\x17\xde\x9a\x7d\xd1\x78\x76\x77\x1e\x26\x0f\x8e\x04\x02\x07\xe7\x7e\xc3\xd1\xf9\x39\xbc\x9a\xd7\xac\xc8\xe0\x4e\x05\x41\x45\xd2\x15\x59\x50\x90\x34\x2f\x68\xaa\x0b\xa6\x69\x10\xb0\xb2\x12\x52\x43\x18\x4c\xa6\x35\x57\x24\xa7\xd3\x20\x98\x4c\x17\x4c\x2f\xeb\x79\x92\x8a\xf2\x7c\x21\xaa\x25\x95\x77\xaa\xfd\xe1\x4e\x4d\x83\x28\x08\xf2\x9a\xa7\x10\xae\xe1\x77\x52\xd4\x34\x02\x31\xbf\xa3\xa9\x0e\x23\x78\x79\xa7\x92\x8f\xe6\x17\x78\x08\x26\x2c\x87\x75\xa2\xb7\x55\xf2\x57\xc6\xb3\x30\x82\xd9\x0c\xde\x48\x49\xb6\xf0\xf8\xb8\xf3\xe1\x5a\xcb\xda\x9e\x9a\x48\xaa\x6b\xc9\xe1\x4e\x25\x57\x5c\x53\xc9\x49\x61\x41\x86\xeb\xa4\xd2\x32\x0a\x26\x4f\x0e\x74\x5e\x90\xc5\x19\xfe\x73\xc5\x33\x26\xe1\xc5\x0c\x2e\x0c\x80\x35\x29\xe0\x72\xb6\x17\x40\xf2\x96\x14\x45\x38\xfd\x62\x41\xf5\x34\x0a\x26\x06\x16\x29\xf0\xf8\x9d\x4a\x7e\x2c\xc4\x9c\x14\xc9\x8f\x54\x87\xd3\x2f\x58\x4e\x52\xfa\x81\x15\xd3\x08\xce\xce\x70\x93\x5d\x4f\x05\x57\x06\x5d\x21\xa7\x91\x3d\xf7\x69\x5b\xd1\xd0\xd0\x14\x19\x14\x26\xea\x9e\xe9\x74\xd9\x27\xd3\x7c\x48\x89\xa2\xf0\x1b\xe3\xfa\x3f\xff\x23\x86\x2b\xfc\xdf\x25\x2e\x1b\xa4\x07\x90\x92\x0f\xf4\x3e\x6c\x6e\xfd\x62\xc9\x16\xcb\x69\x14\xb7\x78\x7c\x51\x88\xfb\x69\x14\x35\x50\xdf\x8a\xb2\x2a\xe8\x06\x01\xbb\x1f\xbf\xfe\xd3\x9f\x4f\x85\x2e\x29\x29\xfa\xd0\x59\x49\x16\x5d\xf0\xd7\x05\x4b\xa9\x05\xe7\x58\x36\x9b\xed\x61\x8a\x5d\xe2\x86\x73\x86\xea\x71\x0c\xda\x5d\x76\xcf\x5c\x52\xb2\x32\x3f\x3e\x99\x7f\x39\xbd\xff\xdd\xcb\x72\x3f\xe6\x04\x75\xca\x21\xea\x8e\x24\xd7\xe6\x8b\xc8\x73\x45\xf5\xb4\x4b\x94\x5b\x1a\xdb\x5d\x50\xbe\xd0\xcb\xde\x6e\xb7\x34\xb6\x3b\x25\x15\x49\x99\xde\xf6\xf6\x37\x8b\xee\x84\x25\xda\x9e\x0b\x1c\x59\x4f\x07\x55\x9c\x14\xc9\x6f\xc6\x16\xc3\xc8\x6a\xfa\x31\x6b\x78\xda\xb1\x46\xa2\x14\x5b\xf0\x4f\x22\x4c\x05\xd7\x74\xa3\x41\x69\xc9\xf8\x22\x86\x4c\x69\x78\x29\xf5\xb6\xa2\x31\x68\x22\x17\x54\x83\xb5\xfb\xe4\x17\xc1\x10\x78\x64\x41\x34\xb6\xdb\x18\xd8\x7b\xaa\x97\x22\xeb\x58\x18\xcc\xa0\x24\x2b\x6a\xd7\xcd\x21\x7f\x5b\x0c\x6b\x8b\xb8\xb3\x80\x87\xc0\x6a\x4f\xc6\x24\x3a\x9e\xed\x1b\x83\x1d\x99\x17\x34\xcc\x14\xee\x36\x22\x45\xb5\x3a\x3f\x87\x8f\x6b\x2a\xef\x25\xd3\x14\x10\x4b\x50\x02\xf4\x92\x68\xd0\x4b\xba\x85\x92\xe8\x74\x99\xd8\x7d\xd7\xa4\xa4\x50\xd2\x52\xc8\x2d\x14\x64\x2b\x6a\x1d\xe3\x66\x2e\x60\x49\x64\x09\x99\xe0\x14\x77\xe6\x46\x77\x1c\x1d\x21\xfe\xfb\x26\xcb\xe4\x63\xe3\x32\x22\x78\x74\x5f\x13\x29\xc2\xc8\x9e\x78\x9c\x01\xae\x20\x76\xce\x70\xa3\x56\x62\x86\xd4\x07\x87\x78\xa5\x65\x0c\x79\xf1\x14\x38\x12\x19\xda\x5c\x49\xb9\x56\x43\xd2\x58\xee\x19\x3e\x9b\x01\x67\x85\x35\x0a\xbf\xe4\xa4\xf0\x07\xaa\x75\xa6\x74\xe4\x94\xe4\xfc\x1c\x7e\x34\x7e\xf7\xa7\xeb\x4b\xb8\x5e\xb1\x0a\xf9\x00\xeb\x8e\xd3\x34\x1a\x81\x3e\xca\xb8\xa7\xe4\x4a\x7d\x60\x45\x18\x01\xcb\x41\x69\xa2\x0d\x2a\x16\x4e\xfb\x5f\x2e\x45\x09\x75\xa5\xb4\xa4\xa4\x4c\xc0\x78\xb8\x77\x7f\xba\x82\x39\x2d\xc4\x3d\x64\x82\x2a\xe0\x42\x43\x45\x38\x4b\x63\x20\x3c\x03\xa6\x81\x53\x9a\xa9\x21\x24\x2d\x40\xd6\x3c\x86\x05\x5b\x53\x0e\x4c\x2b\x48\x6b\xa5\x45\xd9\xb2\x81\x68\x26\x38\xca\x61\x63\xc4\x80\xac\x6b\x30\x0e\xd7\xce\xf5\x22\x9b\x3f\xd4\xa5\xd5\x24\x4b\x96\xd5\xb1\xc9\xcb\xf0\x25\xf3\xdb\x1f\x9e\xa2\xd0\xb2\x2b\x82\x19\x6c\x90\x43\x40\x0b\x45\xed\x4e\x4f\x85\x65\xfb\xc6\x6b\x77\xd4\xb7\xb6\x8e\xec\xec\xf7\x18\xda\xe0\xf1\x68\x85\xde\xe0\x17\x3d\xa1\x12\x07\x48\xf2\x0f\x84\x15\x34\x4b\x82\x89\x61\x4a\x63\x55\xaf\x60\x7a\x69\x89\x02\x91\x5b\x7d\x9d\xc2\x2b\xe7\xf1\xaf\x8d\xc9\x85\x11\xee\x02\x66\x79\x4a\x1a\xcd\x47\xde\x35\x07\x90\x01\x7e\xbb\x31\xe7\x35\x91\x90\x92\xa2\xf8\x2f\x5a\x54\x54\xc2\x6e\x58\xc2\x8f\xd3\x28\x69\x79\x19\x25\x21\xfa\x80\x30\x49\x92\x2e\xc7\x3a\xe1\x78\x37\x66\x23\x90\x50\x54\x8d\x73\x60\x1c\x6e\x6e\xdd\x37\xf7\x03\x32\x17\x91\x09\x83\xc9\x44\xa3\xc8\x5f\x22\x0c\x74\xc4\x68\x29\x1c\x60\xe0\x3e\x90\xd5\xe9\x5a\x76\xae\x0d\x26\xd1\x31\x57\xf2\x47\x0c\x28\x08\x8e\x1e\xc5\x7c\xfa\x95\xa6\x94\xad\xa9\x0c\x45\x15\xc3\x1a\x11\x43\x5f\x87\x47\xa3\xd7\xaf\x5b\x08\xd7\x4b\x96\x1b\x09\x9b\x2b\xd1\xca\x7d\x16\x62\xf5\x8a\xa9\xff\x96\xa4\xaa\x68\xd6\x0b\xcb\x6e\xf3\x6e\x38\xc1\x0f\x4e\x5f\x3a\x9a\x85\xc6\x19\x36\x54\x47\x61\x9f\x5e\x77\x3e\xb2\xdc\x98\xc1\xce\x57\x8f\x51\xd7\xa5\xb7\x28\x24\xbf\xf1\x8c\xe6\x8c\xd3\xcc\xaa\x1a\xcb\x0d\x1b\x5a\x07\x61\xf5\x6d\xea\x72\xb6\xc4\xc8\xc4\x24\x2f\x97\x46\x7a\xa8\x76\xb8\x15\xd1\x43\x4b\x9b\x46\x0e\x8e\x32\x91\x1a\x6d\x4e\x54\x08\x6f\x8a\x67\xcc\xda\x34\x98\x70\x5c\x37\x26\x77\xc5\x43\x2b\x1d\x7f\xe0\xc1\x72\xee\x85\x4e\xae\xd4\xef\x44\x32\x92\xb1\xd4\xa7\x2d\x7d\x5c\x2e\xa1\x01\x69\xb0\x10\xfc\xab\xb5\x3b\xd0\x43\xc7\x98\x1f\xcb\xa1\xa0\x3c\x64\x3c\x82\xef\x80\x1f\x03\x77\xcf\xf4\x12\xb4\x10\x90\xd3\x7b\x60\xbc\xaa\x35\x10\xb9\xa8\x8d\x5b\x1d\x03\xf9\xfa\x19\x20\x4b\xc2\xb7\xfb\x60\x76\xa4\x8e\xde\x7a\x84\x05\xfc\xab\xaf\x9e\x49\xd1\xc9\xc4\x0c\x59\x7e\x76\x76\x1a\x7d\x27\x92\x16\x4c\x72\x21\xe1\x8f\x18\x8c\x23\x96\x84\x2f\x28\xda\xbb\xa3\x75\xd3\x8b\x28\x6b\x52\xb0\x6c\xfc\x46\xf4\x56\xa2\x32\x2e\xad\x56\x8c\x2f\xe0\xef\x54\x0a\x97\x32\xf8\x4b\x07\x77\x32\xbc\xf0\xe2\x5b\x60\xc8\xa8\x6f\x81\xbd\x7a\xd5\xdc\xea\xdc\x30\x6e\x60\xfc\x86\xdd\x26\xc6\x24\xa3\x18\x79\xcf\x43\x16\x7d\x0b\x2f\x36\x3a\x69\xd3\x85\x4f\xc2\x44\x80\xe8\x44\xdc\x70\x61\xa3\xfb\x8e\x98\xa8\xd6\xed\x22\xac\x8e\xdf\xf5\x48\xa3\x30\xbc\x3d\x9c\x9d\x8d\xe9\xc1\xf9\x39\x54\x92\x56\x44\x52\x50\x66\x1b\xd2\x29\x69\x49\x18\xc7\x7b\x4d\x44\xc0\x60\x59\x22\x65\x5e\x8a\x5f\x01\x0f\x26\x13\xe5\xed\xf2\x3d\x59\x51\x73\x47\x68\x88\xe5\x51\x0c\x65\x0c\x25\xa2\x41\x0b\x5a\x5a\x13\x35\x1f\x92\x77\x05\x2d\x6d\x6a\x32\x60\x67\xd9\xb2\xd3\x06\x58\xc6\x6f\xf8\x2b\x76\x6b\x03\x22\x6c\x34\xae\x6d\x1c\x57\x47\x98\x89\x17\xf9\xec\x7c\xc8\xcd\x94\x70\x8c\x58\xb5\xa2\x47\xf9\x88\x60\x06\xe1\x8e\x3b\x69\x44\x3e\xe5\xb5\x84\x27\x57\x3c\xa3\x9b\x90\x45\x26\x83\xde\x78\xf5\x17\x92\x2d\xae\xb8\x25\x00\x55\x83\xbb\xdc\x32\x74\x51\x28\x06\xfe\xea\x6b\xdc\x9c\x8a\x6a\x1b\x32\x7e\x73\xc9\x6f\x63\xb0\xa7\x8c\xaf\xe7\x37\xfc\x16\x66\x56\x18\xd6\x03\x72\xc6\x3b\xcc\x37\x42\xc5\xa5\x17\x1d\xc7\x77\xcc\xc1\xde\x4b\xc1\x17\x8d\x56\x43\x2a\x6a\xab\xdb\x4f\xc1\x84\x8b\x5a\x37\x4e\xf4\x63\x8d\x11\x27\x98\x10\xb9\x50\xb6\xb8\xbd\xdc\x09\xd8\x6f\x6c\x81\x62\xe2\x4c\x83\x40\xe4\x0c\x24\x06\x67\x04\x3d\xb3\x6c\xc0\x21\xaf\x1c\xdf\x62\xa8\xf9\xbd\x24\xd5\x4f\xca\x55\x00\xce\x50\x0c\x84\xa4\xc9\xfa\x47\xc8\x99\x36\x46\x85\x65\x7d\x29\x38\x9a\x19\x67\x45\xd4\x44\xa8\xa6\xd8\x50\x75\xa1\x15\xa2\xd3\x66\x20\xe1\x6e\xed\x91\xa3\xc6\x62\x20\x33\x77\x5b\x4c\x91\x0b\x2e\xe7\x37\x1c\xf2\x89\xff\xc5\x65\x9b\x82\x71\x56\xb8\xd5\xaf\x3b\xab\x4e\xd0\x0f\x28\x75\x5b\x4b\xe8\x04\xf9\x7a\x11\xc5\x30\x20\xd8\x2f\x3b\x44\xa3\x18\x2e\x30\x53\xcb\x68\x4e\xea\x42\x3b\x98\x88\xfe\x40\x83\x44\xad\x7b\x36\x64\x99\x8d\x7b\x6d\x5a\x40\xf5\x0d\xbb\x75\x8a\xd7\x45\x81\x8d\xa3\xc0\x5a\x14\x1a\xad\x36\xb8\xf4\x33\x4e\x49\x35\xb2\x75\xb7\x44\x7b\x4b\x2a\xcc\xd3\xb9\xb9\x7e\x65\x8b\x94\x95\x71\xc2\x0d\x0f\x57\x0d\x03\x0d\x77\x3b\xec\xb2\x19\xe6\xcf\xd4\x84\x6f\x5b\xf8\x2f\x09\x8f\xdb\xfa\xbc\xd9\xd7\xe4\x1f\xc3\xea\x14\xe5\x19\x5a\x91\x5b\x1b\x38\x33\x88\xbd\x93\x52\xc8\x87\x1d\x05\xaa\xa6\x31\xac\x9e\xc6\x4a\x4d\x47\x3b\x52\xd2\xa9\x1d\x1b\x0a\x3a\x74\x7d\x3b\x46\x90\x36\xa2\x0a\x5f\x9a\x0a\xfe\x48\x86\x85\x79\x0a\x7c\x07\x17\xf0\xf8\x08\x0c\x5e\x9b\xb4\x50\xeb\xa4\xa0\x7c\x4f\x44\x30\x40\x81\x21\x86\x80\xfa\x28\x72\x2b\xf5\x26\xee\xea\x6d\x65\xcc\x58\x27\xe8\xc3\x46\xcb\x45\x53\x1b\x3c\xfa\xc2\x71\x50\x2e\xfa\x9a\xa1\xed\xf0\xa0\x09\x4c\xc8\xa1\xde\x93\x25\x24\x2f\x86\x6d\x2b\x0c\x35\x6d\xa3\xe8\x85\x6f\x94\xed\x2c\x77\xda\x64\xfd\xb2\x46\x6f\xab\x78\x98\x80\xba\x2c\xf7\x17\x2d\x31\x76\x22\x1f\x8d\x0b\x32\x1e\x7f\xc4\xa6\xb1\x82\xe8\xb7\xf0\xc0\x5d\xd1\xb7\x00\xbc\x89\xb4\x6a\x0f\x4f\x51\x7c\x08\xe4\xa6\x5b\x86\xc0\x03\x90\x83\x2e\x0d\x81\x6f\x5a\xa0\x9d\xd4\xd9\x96\xda\x3d\xfb\xea\x58\x2b\x9e\x3b\x88\x26\x1e\x8f\x7c\xa5\xde\x98\x8a\xb2\x12\x1f\x94\x0e\x1d\x3d\x9b\x81\x1a\xf4\x82\xac\xed\x8c\xeb\x9c\x0d\xf0\x87\x74\xce\x69\xbc\xd9\x78\x44\xe3\xf7\xe8\xa7\xd7\x46\xa7\x7e\xbe\x7c\x3d\xae\x98\x0c\x5e\xb5\xd4\xf8\x3e\x98\xf7\x04\x56\x6d\x55\xbf\xa5\xf6\x6f\x6d\xfd\xd7\xd0\x56\x93\x5d\x19\x75\xd5\x12\xc5\xf4\x32\x7c\x69\xcb\xf6\xa8\xe7\x56\xfa\x7a\x8b\xd9\x8f\xd2\x72\x9f\xa6\x9a\xf3\x87\x54\xb5\xeb\x0d\x7b\x6a\xf5\x1b\xe3\xfa\xcf\x51\x57\xfd\x30\x39\x33\xea\xa3\xe5\x8d\x49\x40\x7b\xc2\xae\x71\xff\x27\xd3\x75\x1c\x88\xfc\x2c\x8d\x7c\x03\xad\x13\xc1\x8f\x46\x24\xc3\x25\x17\x93\x46\xc3\x6b\xd3\x19\xf9\x9e\x68\x12\x46\x70\xf3\xa7\x5b\x44\xa2\xd2\x12\x99\xe1\x58\xd1\xdb\xe4\x7b\x34\xaa\xae\x2a\x21\x35\xcd\x60\xbe\x6d\xba\x6f\xd3\xd1\xd0\xe7\x9a\x6d\x73\x21\x8a\x53\x82\xde\x2f\x5a\x1e\x0a\xd1\x58\x7c\xed\x6f\x8e\x37\x51\xfe\xc0\xd9\x41\x8f\x68\x49\xf8\x87\xce\xe1\x1f\x6a\x9e\x9e\x7c\x58\x2f\xa5\xb8\xff\xc0\x0a\x27\x27\x23\x84\x06\xd2\x7b\x52\x1d\x02\x34\x34\x2a\x52\x28\xea\x8f\x36\x2c\x3f\x19\x93\xf6\x05\xc6\x81\xb0\x06\xe6\x10\x1b\x4f\x76\xbc\x0d\x9a\x56\xe2\x33\x35\x0b\x85\x7a\x48\xb3\x4c\xd6\xe5\x13\xb7\x93\xf2\x9c\xb8\x63\xbe\xbb\xb8\xfe\x6c\x82\x4a\x93\xc8\x1d\x4d\xe1\xfa\x41\xe8\x98\x62\xb8\x43\xf3\x3a\xcf\x69\xf3\x2a\x33\x0a\xa2\x2f\xd4\x56\x0a\xee\xa9\x6c\x45\xb7\x6a\x1a\x77\x20\x77\x31\x7f\x0e\x83\x7f\xa6\xfc\x10\x7b\xbd\x63\x88\xa0\x63\xaf\xc7\xd8\x6c\xb3\xdf\xf7\xa4\x8a\xad\x91\xed\xa8\x88\x69\x40\x7a\x7b\xed\x06\xa3\x8b\xbe\x83\x1e\xd1\xa1\x81\xf5\x9c\x0a\xe9\xeb\xa1\x3c\xff\x01\x14\x7a\x91\xb8\x83\xd0\x73\xd8\xed\x98\x70\x88\xe5\xa6\x16\xf7\xbf\x3c\x04\x93\x75\x52\xd6\x4a\xff\x85\x76\xde\x69\xa2\x60\xb2\x71\xab\xef\x36\xd6\x3d\x9a\x35\x98\xc1\x66\xa4\xee\xbc\xb6\x4f\x6e\x89\x89\x69\x58\x65\x1e\x79\xae\xdd\xf7\x54\xda\xaf\x15\x26\x7d\xef\x68\x15\x33\x15\xd5\x76\x1a\xef\xcd\xb6\xc7\xbe\x6c\xcc\x97\xc8\xc3\xef\xb9\xa4\xc9\xb1\x27\x63\xfb\x9a\x38\xfa\x6c\xd7\x7d\xdb\xd8\x44\xed\x05\x36\x07\x32\xd0\x11\x5b\xfb\x6b\xf8\x7c\x8c\xfd\x73\x52\x30\xe9\x6a\xc0\x89\x18\x6f\x5a\xc3\xed\xe9\x9b\xa9\x00\xcd\x01\x23\xcb\x4a\xcb\x71\x0d\xf9\xcb\x56\x53\x15\x6e\xe0\xe6\x76\xbe\xd5\x87\xf4\xc4\xaf\x86\x46\xf3\xa3\xce\x0c\x80\xed\x63\x75\x92\x43\x93\x46\xec\x6f\xc3\xf8\x5b\x7d\x7f\x19\x2f\xb6\xf9\xb5\x6b\xc3\x34\xcd\xb4\x11\x8e\x75\x2f\xfe\x40\x4a\x6a\x6f\x9c\x4e\xdb\xc9\x03\x87\x4e\xef\xe3\x83\x4d\xba\x69\x76\xdd\x82\x1e\xbe\x13\xd8\x4e\xd6\xce\xc3\x73\x7b\x6c\xf8\xf4\xdc\x3d\xd0\x7d\x7c\xde\x39\xd1\x3c\x3f\x77\x4f\x74\x1f\xa0\x77\x4e\x74\x9e\xa0\xbb\x67\xfa\x8f\xd0\x96\x4d\x33\x68\x4f\x1b\xee\x9d\xa6\x37\xca\x4a\x71\x54\x27\xde\x92\x2a\xe4\xb6\xf2\x3f\x5d\x1d\xd4\x33\x06\x33\x58\x0e\x1c\xbe\xdb\x57\x7f\x3d\x3e\x02\x87\xd7\xcd\xd7\x61\x6f\x63\x44\xb1\x7c\x79\xe6\xb7\xf6\xd2\x5e\x60\xdc\x11\xe5\xbb\x7c\xf4\xfe\x90\x1a\xec\xa8\x80\xdf\xbf\x23\xff\x5d\xd9\x0f\xb6\xb6\x82\xdf\x15\xfa\x60\x6b\x47\xe2\x3c\x3a\x55\x88\x1e\xc6\x1e\x39\x62\x4a\xf3\x7f\x21\xc7\x8b\xcf\x10\x99\xe5\xc8\x98\xc0\x30\xa1\xf8\x7f\x13\x18\x3f\x28\x21\x35\x66\x8f\xff\x04\x91\x99\x77\x03\x16\xc3\xdd\xa0\xed\xe6\x9f\x6a\x53\x52\xe1\x17\xd7\x41\x70\xcf\xb5\x0a\x60\xf8\x2e\xeb\xf3\x2a\xc6\xb3\x41\x6a\x85\x2b\x3b\xcd\xba\x7e\x0c\x37\x1d\x88\xf6\xad\x7e\xdc\x85\x9b\xec\xc7\x89\x50\xe4\x50\x73\x92\x65\x92\x2a\x65\xde\xc0\xdb\x1e\xc3\xd3\x33\x5b\x81\x48\xe0\xac\xdb\x00\x74\xa4\xce\x2c\x6f\x3e\xe6\xa1\xeb\x99\x18\xff\xd7\x3e\xf7\xb6\xb3\x43\x9d\x70\x38\xcc\xd4\x2c\x20\x73\x99\x3b\xdd\x6b\x0f\xd9\xbb\xf7\xa9\xf0\x3f\x5c\xb1\xdf\xc1\x77\xc0\xec\x0f\xaf\x0f\x56\xee\x03\xd6\xda\x2a\x7e\xa4\xed\x34\x17\x35\xcf\xda\x37\xc6\x6e\x41\xfe\x31\x0f\x4d\xa1\x7e\x79\x77\x1b\x3d\xb3\xf2\xb6\x8f\xc8\xb1\xd1\x90\xa7\xa8\x79\xb6\x1e\x27\x03\x59\xb5\x3f\xbc\x77\x75\x63\x0f\xe6\x08\x7d\xbc\x77\xb2\x53\xa0\xa8\x7a\xae\x1c\x6e\x2a\x06\x34\x0e\x93\x30\x35\xbd\x8b\xbd\x86\xf4\x8d\xb1\xa4\x18\x56\xff\x36\xa6\x7f\x41\x63\x7a\xb6\x6e\x7e\x73\x8a\x72\xae\xe0\x3b\xb8\xb3\x3f\x9c\xa2\xa5\xdf\xfc\x6f\xaa\x69\x0c\xab\xe3\x9a\xfa\xb6\x10\x8a\x86\xbd\xf8\x1c\x62\xd5\xdb\x89\xcc\xdd\xc2\x6c\xe7\xda\x14\xcf\xf7\xeb\xf7\x91\x5b\x6c\x4a\x7c\xfa\x33\x4e\xaf\x74\x72\x33\xb7\xc3\x56\xba\x9b\x12\x3d\x30\x58\xbb\xdb\x1c\x7e\xea\xbf\xcf\x38\x89\xd8\x80\x3e\x3a\x6d\x1a\xed\xed\xb1\xb6\x93\x99\x6b\x37\xdd\xda\x65\x74\xdb\x99\x3b\x58\xa2\xf7\xb1\x1a\x23\xd4\xdb\x5b\xa5\xe5\xb1\x39\xa1\xee\x13\x13\xfe\xf3\xeb\xc7\x41\x1f\xdf\xfb\x83\xfe\x30\xa2\xb3\xc1\x7d\x03\x89\xee\xf3\x4e\x83\xb5\xdf\x63\xf6\x9b\xd6\xa4\xd8\xe9\x54\x3f\xcf\xd6\x50\x55\x7a\x4d\x85\xf3\x73\xf8\x50\x97\x3f\x30\x5a\x64\xae\x0d\xaf\xcc\xb4\x22\xaf\xcb\x39\x95\x68\x2f\x39\x7e\x53\x98\xb5\xe1\xba\x15\x1e\xac\x13\x3c\x79\xe5\xe6\x0d\x15\xa0\x0c\xbe\x54\x80\x54\xfa\x8e\xac\x2d\x98\x93\xa1\xb2\xfa\xdb\xda\x6e\x5c\x9b\xa3\x9a\x13\x51\xd0\x3e\xb6\x98\x85\xc3\x92\x71\xec\xc4\xd0\xab\x75\x62\x91\x8d\x1c\x65\xef\x49\xf5\x57\xba\x55\x0d\x61\xc4\x17\x12\x82\x6b\x37\xf5\x41\x8a\xc2\xd0\xb5\xc2\x7d\x95\xa4\x8a\x72\xed\x69\x2d\x49\x15\x23\x18\xc6\x51\x3c\x15\x4d\x59\xce\x68\x06\x42\x66\x54\x1e\xa7\xff\x3d\xa9\xfc\xa6\xe6\x7e\x0e\xb4\xac\xf4\xd6\xbb\xa5\x1c\xd6\x20\xa9\xbb\x15\xd1\xe3\xac\xc0\x5b\x77\x98\xe6\x08\x09\xfb\x13\x7e\x9e\x6f\xef\x49\xd5\x61\x5a\x49\xaa\xc3\x1c\x5b\x51\x13\x5a\xdc\x13\xd5\x8a\x6e\x83\x60\xff\x9b\x81\xdb\xdc\x79\x8e\x2a\xed\xce\xca\x77\xfc\x82\x49\x59\x50\x37\x06\xa2\xc3\x0b\x5b\x37\x94\x58\x99\xfb\x71\x38\xf3\x7d\x86\x84\xa1\x94\x4a\xf7\x87\x00\xee\xb5\xbf\x62\x9a\x4a\xc6\x99\x0e\x5d\xe3\x09\xbf\x93\xdd\x49\x80\xd2\x86\x38\x0c\xef\xcc\x06\x76\x3b\x13\xd0\x8c\xd5\x20\x6c\x12\xb5\xb3\x35\x2b\xba\xed\xdc\xb0\xa2\xdb\x90\x69\xe7\xdc\xf0\x53\x77\x9e\xf7\xfc\x1c\xae\x45\x49\x05\xa7\x90\xd1\x82\x6a\x9a\x19\x51\x71\x2d\xb7\x76\xee\xd6\x69\x03\x28\xc6\x53\x0a\xf7\xd4\x1d\x4a\x49\x51\xd0\xcc\x11\x06\x64\x2e\xd6\x34\x81\x2b\xfd\x25\x8a\x32\x23\x9a\x80\x24\x29\x8d\x61\x5e\x6b\xd4\x88\x25\xe3\x0b\x77\xf0\x1e\x8b\x59\x0e\x99\xc0\x43\xb5\x06\xa6\x93\xa0\x33\x46\x8f\xee\x8a\xd8\xb9\x86\x54\x54\xdb\xdf\x49\xe1\xe5\x80\x36\x1f\x23\xfe\x48\x89\x23\x8d\xd3\x8d\xb6\xb4\xb5\x53\xe7\xe4\xe6\x92\xdd\xb6\x56\x60\x1e\x5e\x7a\xf6\x6d\xe7\x5f\x89\x52\x22\x65\x04\x09\x36\x03\x69\xc8\x98\x56\xf9\x4f\xb1\xf2\x11\x2d\xc7\xd3\x9d\x01\x33\xc7\x6f\xb7\x3f\xc7\xe8\xdb\xbd\x03\x85\xb8\xdf\x0e\xce\xcf\xe1\x8d\xf1\x3d\x3f\x8a\xd8\xdb\xe9\x97\xca\x61\x8f\xea\x0f\x73\x3a\x9c\xcf\xb5\x80\xbf\x54\xe6\x5a\x8d\xca\x3b\x62\x4e\xf6\xc1\x0e\x77\xb8\xb5\xcf\x35\x2b\x33\x71\xfc\xbd\x30\x44\x4a\xfa\xb7\x9a\x49\x6a\x11\x10\x88\x22\x75\x51\x3e\x6e\x46\xe3\xbf\xa7\xb4\x7a\xf7\xb7\x9a\x14\xe6\x20\xe1\x19\x08\xbd\xa4\x12\x2a\x29\x16\x92\x94\xca\x28\x48\xad\x68\xdf\x43\x59\x1e\x9b\x57\x2e\x73\xce\x7b\x38\xa2\xda\xe9\x41\xbc\xd2\x53\x98\xc0\x55\x0e\x94\x19\xc8\x8e\x31\xe6\x9c\x90\x1e\x26\x0a\xa6\xe6\x2d\x7e\x7a\x29\xea\xc5\xd2\x32\xdb\x4e\xca\xc0\x3d\x2b\x0a\x98\x53\x73\x10\xe3\x37\xcb\xa8\xa4\x59\xe7\x54\x02\x9f\x96\x4c\x21\x24\xf3\x59\x69\x74\xa2\x76\xc2\x71\x69\x8f\xcd\xe9\x92\xac\x99\x90\x66\xe6\xce\xba\x75\x15\xc3\xfd\x92\xa5\x4b\x24\x50\xdc\x83\xa4\x24\xf3\x96\x02\xe6\x4f\x09\x2c\xa2\x79\xe7\x1e\x17\x8b\x12\xe3\xc3\x60\x86\xe8\xef\x1d\x9f\xf2\x1c\x98\xc6\xce\xcb\xb9\x96\xb6\x75\x21\xab\x9d\x09\x68\xab\xa6\x7b\x7b\xdd\x2b\x77\x5d\xa5\x65\x6f\xe4\x74\xb5\x3b\x3e\x7c\xe6\xf6\x59\x83\xa4\xce\x09\x91\x34\xa5\x4a\x79\x27\xd7\xf1\x9f\x98\x48\x9a\xeb\x69\xd7\x27\x0d\x53\x98\xa7\x60\x67\xac\xc0\xfa\x6c\x37\x62\x0d\x8f\x0d\xfa\x91\xfb\x9b\x88\x6e\x16\xd2\x19\x28\xf0\xa0\xbd\x67\x31\xf8\xa0\x57\x19\x6d\x5a\xd8\x58\x3d\x1c\x14\x32\x29\xd7\x6a\x6c\x5c\xe0\x68\x06\x62\x00\x9a\x94\xd6\x9e\xb7\x99\xc8\xb3\x42\x3e\xcb\xcd\x2b\x53\xc8\x22\x78\x3d\xb3\x3f\xf6\xc3\xff\x78\x47\xca\x26\x39\xe3\x0f\xe7\x98\x47\x55\x52\x54\xbb\x3d\x28\x93\x85\x5a\xb8\xa6\xbe\x71\x93\x90\x66\x19\x4f\x4c\xa3\x66\x88\x32\x98\x98\x7d\x08\xe3\xac\x41\xc6\xbc\xab\x3b\xd1\x99\x15\x53\x53\x05\x23\x33\x4b\xd7\x9a\xa5\xab\xed\xaf\x1f\x1f\xc7\x07\x98\x76\x05\xc9\x72\x78\x61\x41\x72\x52\xd2\x84\xa9\xb6\x96\xf0\xc3\xba\xf6\x33\x2d\xe7\x34\xcb\x9a\xf5\x8e\x66\xbc\xc3\x2f\xbf\x7e\x1c\xfc\x59\x46\xfb\xdd\xe3\xe4\xc7\x6c\x03\xfb\x17\x31\x0b\xa7\x88\x0d\x89\x16\x03\x4d\x16\x58\x69\xe0\x77\xdb\x98\x3f\x3b\x03\xd6\xda\x10\xcb\x91\xb7\xf6\xf0\x82\xea\x9f\xf0\xe7\x50\x93\x45\xf4\xad\x5b\x6f\xbb\xf9\x26\xb8\xdb\x11\xd7\xb5\x29\x3e\xad\x1e\x5e\x44\xcd\x5f\xb1\x25\xa6\x44\x45\x69\xd9\x2c\xf9\x17\xed\x0f\x4c\x44\x3f\xcf\xb7\xb2\xb2\xbf\xf9\x3f\x58\xfb\xac\xa1\x96\x67\x8e\xb5\xec\x54\x75\xcc\x1d\x65\x7f\xc7\xda\x4e\x18\xfc\x0c\x03\xcc\x2b\x52\x53\xa4\xb7\x23\x2f\x27\x0f\xbd\x08\xd3\xcc\x34\xb0\x46\x8a\x58\xba\xe9\xde\xbb\xe9\x5f\xd6\xb9\x6d\x64\x1a\xc6\xff\x61\xdf\xc8\x5f\x86\x76\x18\x6f\x45\xd5\x0c\x3e\xbb\x43\x4f\xad\xf2\xa8\xc3\x23\x76\xff\xac\x99\xa5\xcf\x91\xee\x67\x4e\x2c\xd9\x9e\x08\x3a\x86\x96\xa3\x27\x0a\x4f\x19\xe1\xe1\xd1\x63\xf3\x4a\xbb\x02\x7a\x0a\x4e\x1e\x56\xea\x62\x68\xa7\x95\x9e\x82\xff\x09\x00\x00\xff\xff\x08\xc8\x91\xa5\x74\x3c\x00\x00"),
 		},
 		"/src/internal/syscall": &vfsgen۰DirInfo{
 			name:    "syscall",
-			modTime: time.Date(2021, 3, 28, 16, 13, 10, 863783400, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/syscall/unix": &vfsgen۰DirInfo{
 			name:    "unix",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/syscall/unix/unix.go": &vfsgen۰CompressedFileInfo{
 			name:             "unix.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 459,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\x91\x41\x6b\x2a\x31\x14\x85\xd7\xe6\x57\x1c\xdc\xbc\x19\xde\xe0\x3c\xdf\xbe\x8b\x42\x69\x91\x16\x17\x55\x17\x5d\x95\x38\x93\x19\xa3\x99\x9b\x70\x73\x83\x95\xe2\x7f\x2f\x33\x0e\xa2\x0d\x64\x91\x7c\x39\x5f\x4e\x48\x59\xe2\xef\x36\x59\x57\x63\x1f\x95\x0a\xba\x3a\xe8\xd6\x20\x91\xfd\x52\xca\x76\xc1\xb3\x60\x1a\x4f\xb1\xd2\xce\x4d\x95\xaa\x3c\x45\x41\xa6\x26\xac\xa9\xf6\xdd\x9a\x75\xc0\x38\x92\x25\x09\xc2\x78\xc0\x3f\x35\x69\xa2\x68\xd1\x72\xc3\xef\x70\x6b\xe4\x97\xe0\x0e\x57\x3e\x9c\x9e\xad\x33\xef\x9a\x5a\x33\x1c\xb9\xc5\xb9\x52\x4d\xa2\x0a\x8b\xb8\xf4\xb4\x75\xbe\x3a\x64\x4d\x0d\x4b\x92\x23\xa3\x71\xc7\x52\x8b\xad\xf7\xae\x80\x61\xee\xa7\xe7\x1c\xdf\x6a\xc2\x46\x12\x13\x1a\xed\xa2\x29\x40\xd6\xa9\xf3\x68\x4b\xe4\x2c\x1d\xb4\x64\xb5\xe5\x8b\xae\x40\xd0\xb2\x43\x14\xb6\xd4\x16\x68\x9c\x6e\xe3\xe5\x9a\xc1\xd7\xeb\xca\x12\xeb\x9d\x61\xf3\x27\x82\x3c\x56\x1f\xab\xcf\xcd\xf2\x6d\xb1\x7c\x7d\x5c\xa3\x36\x8d\x25\xd3\x8b\xf0\xe2\x31\x9f\xcd\xff\xa3\xf1\x8c\x27\xcd\x47\x4b\xc5\x10\x8d\x1e\xfb\x14\x05\xb6\x0b\xce\x74\x86\xe4\x5a\x02\x29\xf6\x2f\xb8\x2c\x87\x1c\xf9\xe3\xec\x5a\x7f\xfc\x8f\xd9\x66\xe0\x59\x5f\x33\x57\x67\xf5\x13\x00\x00\xff\xff\x18\xd0\xfc\x18\xcb\x01\x00\x00"),
 		},
 		"/src/internal/testenv": &vfsgen۰DirInfo{
 			name:    "testenv",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/testenv/testenv.go": &vfsgen۰CompressedFileInfo{
 			name:             "testenv.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 424,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x8f\xc1\x6a\xc3\x30\x0c\x86\xcf\xd1\x53\x08\x9f\x12\x36\x92\xfb\x6e\xa3\x8c\xf5\xd6\xb2\x3e\x81\xeb\x2a\x8d\xbb\x58\x2e\x92\xb2\xb4\x8c\xbe\xfb\xf0\xd6\x52\xd8\x06\x3e\xfd\x9f\xfd\xf1\xb9\xeb\xf0\x61\x3b\xc5\x71\x87\x07\x05\x38\xfa\xf0\xee\xf7\x84\x46\x6a\xc4\x1f\x00\x31\x1d\xb3\x18\xd6\x50\x39\x99\xd8\x62\x22\x07\x95\x53\x93\xc8\x7b\x75\xd0\x00\x74\x1d\x2e\xbd\xbe\x9c\x28\xa0\x50\xb9\xac\x38\x0f\x64\x03\x09\xda\x40\x18\x26\x11\x62\x43\x3d\xab\x51\xc2\xe0\x19\xd5\xbc\x18\x32\xcd\x78\x94\x1c\x48\x95\xb4\x58\x26\x8d\xbc\xc7\xac\xed\xa6\xf0\xf5\x0f\xc2\x2c\x58\xa7\x2c\x84\x21\xa7\x94\x79\x3c\x37\x48\x27\x0a\xed\x22\xa7\xe4\x79\xd7\x42\x3f\x71\xb8\x15\xd4\x0d\x6e\x73\x1e\xf1\x13\x2a\x9d\xa3\x85\x01\xaf\xd1\xed\xeb\x6a\xb5\x29\x73\xf0\x4a\xe8\xd8\x87\xd1\x3d\x41\x55\x09\xd9\x24\x8c\xbd\x1f\x95\x6e\x70\xe7\x65\x8e\xfc\x8d\x63\x8f\xd7\xaf\xb6\x4b\xaf\x6b\xa1\x3e\x9e\xea\xbb\xf2\xf9\x6d\xb1\x7c\x44\xe7\x25\xb9\xa6\xc8\x7f\xfb\xaa\x0b\x94\xf3\x27\xa5\xbc\xbb\xc7\x1c\xf4\x9f\x94\x0b\xdc\x06\x93\x89\xe0\x02\x5f\x01\x00\x00\xff\xff\xdc\xf8\xeb\x9e\xa8\x01\x00\x00"),
 		},
 		"/src/internal/unsafeheader": &vfsgen۰DirInfo{
 			name:    "unsafeheader",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/internal/unsafeheader/unsafeheader_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "unsafeheader_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 199,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x4c\x8e\xc1\x4a\xc4\x40\x0c\x40\xcf\xe6\x2b\x42\x4f\xbb\x0a\xed\x67\x28\x5e\xb7\xe0\x51\x62\x9b\x99\x89\x9d\x66\x86\x24\x73\x12\xff\x5d\x14\x0f\x7b\x7c\xf0\x1e\xbc\x65\x79\xfa\x18\x52\x77\xfc\x74\x80\x4e\xdb\x41\x99\x71\xa8\x53\xe2\xc2\xb4\xb3\xbd\x07\x7b\x00\xc8\xd9\x9b\x05\x4e\xbf\x24\x9a\x27\x80\x34\x74\xc3\x95\x3d\xde\x4c\x82\xd7\x62\x6d\xe4\xf2\xf2\xd7\x5c\x02\x1f\xff\xc5\x79\xbd\xe2\x17\x3c\xc4\x7c\x3b\xa4\x5f\xa6\xe7\xd6\x0b\xdb\xeb\x0d\x87\xb3\xe3\x2e\x29\xb1\xb1\x06\x7a\x95\x8d\x91\x74\x47\x0f\x13\xcd\x28\x67\xaf\x7c\xb2\x06\x85\x34\xc5\x28\xa4\x28\x1a\x6c\x4a\x75\xb9\xff\x9b\xa7\x2b\x7c\xc3\x4f\x00\x00\x00\xff\xff\x3a\x91\xfb\x4a\xc7\x00\x00\x00"),
 		},
 		"/src/io": &vfsgen۰DirInfo{
 			name:    "io",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/io/io_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "io_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 547,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x90\x41\x8b\x14\x31\x10\x85\xcf\x9b\x5f\xf1\x98\xd3\x8c\x2e\xdd\x2b\xde\xbc\x89\xb8\x82\xe8\xc5\x1e\xf0\xb8\xa4\xd3\x35\x49\xed\xa6\x93\x50\x55\x71\x1d\xc5\xff\x2e\xf6\x0a\x83\x8a\xa7\x2a\x1e\xd4\xf7\x5e\xbd\x71\xc4\xf3\xb9\x73\x5e\x70\xaf\xce\x35\x1f\x1e\x7c\x24\x70\xbd\x33\x52\x73\x8e\xd7\x56\xc5\xb0\x77\x57\xbb\x5f\x02\x97\xb8\x73\x07\xe7\x4e\xbd\x04\x1c\x49\xed\x63\xcf\xc6\x9f\x85\x8d\xe4\x6e\x1b\x93\x09\x97\x38\x71\x89\x99\x5e\xe7\x5c\xc3\xde\xf0\xec\xf7\xe9\x70\x3c\xe0\xbb\xbb\xb2\x61\x7a\xe0\xb6\x3f\xb8\x1f\x7f\x83\x3e\x91\x5f\x48\x6e\x85\x48\xdf\x7e\x4d\xbe\xab\xd1\xf2\xa4\xe9\x7f\x31\x5b\x2e\x08\x65\x26\x45\x2d\x90\x5e\x8c\x57\x1a\x26\xb2\x5b\x2e\x3e\xf3\x37\x92\x6b\x3c\x26\x0e\x09\xef\x6a\x4b\x24\xef\x27\x2c\x95\x14\xa5\x1a\x78\x6d\x99\x56\x2a\xb6\xfb\x33\xce\x9b\xda\xce\x1f\xbc\x44\x7a\xfa\xed\x5f\xf7\x71\xc4\x31\xb1\x62\x73\xf7\xc1\xba\xcf\xf9\x8c\x99\x92\xff\x42\x8a\xb5\x0a\xa1\x0a\x32\xa9\x22\x54\x11\x0a\x96\xcf\xd7\x98\xbb\x81\x0d\x26\x1c\x23\x89\xc2\x6f\xa0\x85\x4f\x27\x12\x2a\x86\x50\x17\x42\xf3\x96\x60\xc9\x1b\x9a\x2f\x1c\x14\x5c\xd4\xc8\x2f\xa8\x27\x08\x59\x97\xc2\x25\xc2\x17\x90\x48\x15\x2c\x9d\x60\x15\x1e\x73\x8f\x1b\x4e\x68\xa3\x05\x5a\x30\x53\xae\x8f\xc3\xa5\xab\x64\xd6\xf4\xd5\x38\x46\xb6\xd4\xe7\x21\xd4\x75\x8c\x5b\x27\xf7\x7a\x59\x58\xb5\x93\x8e\x2f\x6e\x6e\x5e\x6e\xad\xfc\x0c\x00\x00\xff\xff\x03\x6d\x1a\xaf\x23\x02\x00\x00"),
 		},
 		"/src/math": &vfsgen۰DirInfo{
 			name:    "math",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 17, 56, 39, 391616422, time.UTC),
 		},
 		"/src/math/big": &vfsgen۰DirInfo{
 			name:    "big",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 12, 6, 55, 839329668, time.UTC),
 		},
 		"/src/math/big/big.go": &vfsgen۰CompressedFileInfo{
 			name:             "big.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 174,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x44\x8d\xbd\xaa\xc3\x30\x0c\x46\x77\x3f\x85\xf6\x0b\x11\x5c\x68\x87\xcc\xdd\x03\x25\xd0\xd9\x89\x15\xdb\xf9\x93\x91\xe4\x94\xbe\x7d\x49\x3b\xf4\x9b\xbe\xe1\x70\x0e\x22\xfc\x0d\x35\xaf\x01\x66\x75\xae\xf8\x71\xf1\x91\x60\xc8\xd1\x39\x44\xe8\xbb\x5b\xd7\x42\x9f\xb2\x42\x56\xf0\xf0\x64\x59\xbc\x70\xdd\x03\x4c\x2c\x90\xcc\x8a\xb6\x88\x31\x5b\xaa\x43\x33\xf2\x86\x91\x4b\x22\x99\xf5\x77\xb2\x6a\x25\xc5\xeb\xe5\xbf\x39\x95\xdf\xdd\x69\xe3\x83\xc0\x4f\x46\x02\x96\xbc\xc1\x07\x3b\x2b\x42\xca\xeb\x41\xa1\x71\xf6\x2a\x04\x0f\x96\x00\x35\xef\x56\x4c\xdc\x3b\x00\x00\xff\xff\x55\xc0\x14\x01\xae\x00\x00\x00"),
 		},
 		"/src/math/big/big_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "big_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
-			uncompressedSize: 148,
+			modTime:          time.Date(2026, 10, 15, 22, 4, 20, 643721422, time.UTC),
+			uncompressedSize: 965,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd2\xd7\x57\xd0\x4e\x2a\xcd\xcc\x49\x51\xc8\x2a\xe6\xe2\x2a\x48\x4c\xce\x4e\x4c\x4f\x55\x48\xca\x4c\xe7\xe2\xca\xcc\x2d\xc8\x2f\x2a\x51\x50\x2a\x49\x2d\x2e\xc9\xcc\x4b\x57\xe2\xe2\x4a\x2b\xcd\x4b\x56\x08\x49\x2d\x2e\x71\xaa\x2c\x49\x2d\xd6\x28\x51\xd0\x82\xca\xe9\x85\x68\x2a\x54\x73\x71\x96\xe8\x05\x67\x67\x16\x68\x28\x25\x15\xe5\x67\xa7\xe6\x29\x69\x72\xd5\x22\xe9\xf1\xcd\x4f\x09\x2e\x2c\x2a\xc1\xad\xab\x38\x27\xbf\x1c\xac\x07\x10\x00\x00\xff\xff\x9b\x59\x2d\xf0\x94\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x52\x4d\x6f\xd3\x40\x10\x3d\xef\xfe\x8a\xc1\x52\x60\x97\x58\x4e\xd3\x02\x07\xc0\x97\x4a\x3d\xf4\xd0\x72\x68\x11\x87\xaa\x48\x6e\x3c\x76\x86\x78\x77\xcd\xee\xb8\x69\x52\xe5\xbf\xa3\x8d\xdd\xe0\x16\x10\x07\xcb\xf3\xf5\x66\xde\x9b\x9d\xd9\x0c\xa6\x77\x1d\x35\x25\xfc\x08\x52\xb6\xc5\x62\x55\xd4\x08\x77\x54\x4b\x49\xa6\x75\x9e\x41\x49\x91\x98\x82\x97\x33\x5f\xd8\x32\x91\x22\x61\x0c\x4c\xb6\x4e\xa4\x96\xb2\xea\xec\x02\xae\x31\xf0\xe9\x86\x31\x28\x86\xb7\x43\x36\xbb\xd6\xf0\x28\x05\x67\x57\x2b\x6a\x55\x72\xe7\xdd\x0a\x6d\xa2\xe5\x6e\x84\xb9\x70\xe5\xd5\x4f\xcf\xff\x46\x85\xc6\xad\x5f\x60\xce\x1e\xda\xcb\xcb\x53\xaa\xcf\xed\x5f\x70\x54\x45\xe6\xe7\x96\xbf\xb4\x01\xf2\x1c\x2c\x35\x31\x7c\xe8\xd7\xe3\x80\x82\x7d\xc3\x10\xba\x36\xea\xc3\x32\xd1\x52\xec\xa4\xf0\xf0\x31\x87\xa8\x31\xbb\xc4\xb5\x7a\x32\xae\x5c\xe7\x17\xa8\xe6\x5a\x4b\x11\x63\xce\xc4\xb2\x48\x47\xad\x9d\x2f\x03\x90\x65\x0d\xb6\xe0\xfd\xa0\x6d\x4c\xda\x82\x95\xa5\x46\x67\xa6\x58\x61\x5f\xa5\xa5\x10\x95\xf3\x40\xc3\x8c\x1a\x61\xbb\x07\x88\xed\x0d\xdd\x42\x0e\xdf\x9c\x2f\x95\xcf\xbe\x92\xe5\x93\x63\x15\x87\x45\x4a\xc2\x23\x77\xde\xc2\x36\xb3\xce\x1b\xd5\xf3\x0c\xc5\x3d\x96\xb1\xcf\x41\xaa\x14\x25\x56\xe8\x7b\x56\x1a\x1e\xc7\x4b\x80\xbe\x7c\x17\xc1\x07\x06\x47\x9f\x80\xe0\x33\x1c\xc7\xff\x74\xba\x27\xf2\x90\xc2\x26\x05\xf3\xb4\x03\x67\xd4\x7c\x4a\x93\xf7\x3a\x1d\xbb\x27\xcf\xdd\x77\x91\x26\x55\xd0\xa0\x55\x46\xc7\x85\x1f\xf5\xa2\x16\xce\x32\xd9\x0e\x07\x15\x54\x01\x4d\x8e\x47\x79\x73\x73\x74\x0b\xaf\xbf\xe7\x30\x87\xd9\x0c\xce\xee\xd1\x82\x71\x65\xd7\x10\x94\x2e\x3e\x4d\x17\x10\x78\x89\x70\xe1\x2c\xd7\xce\xa0\xdf\x80\x41\x5e\xba\x12\xba\x36\xb0\xc7\xc2\x64\x43\xeb\x3f\x94\x4a\x21\x6a\xc7\xcf\x9e\x01\xe3\xc9\xa8\x41\xa0\x7e\x01\xb2\xd4\x48\x21\xd6\x85\xfd\x0f\x86\x2a\xa8\x1d\x67\x0b\xd3\xaa\x58\xac\xe1\xd5\x41\x0d\x67\x67\xde\x3b\x5f\xa9\xa4\x47\x4d\x42\x0a\xfd\xa7\x61\x4d\xbc\x84\xe1\xec\xf2\x7d\x74\x3f\x6a\x12\x92\x14\x1e\xb2\x8e\x5d\xa1\xe6\x1f\x74\x0a\x9b\x91\x6d\x46\x76\x9c\xf9\xdb\x8b\xd8\x83\x3b\xdc\xc8\x4e\xee\xe4\xaf\x01\x00\x16\xc1\x7e\xf0\xc5\x03\x00\x00"),
+		},
+		"/src/math/big/nat.go": &vfsgen۰CompressedFileInfo{
+			name:             "nat.go",
+			modTime:          time.Date(2026, 10, 15, 22, 4, 20, 642357480, time.UTC),
+			uncompressedSize: 4517,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x57\xef\x73\xdb\x36\x12\xfd\x2c\xfe\x15\xaf\x9e\x9e\x4b\xc6\x14\x65\x25\xbd\x9b\x4c\x14\x79\xa6\x77\xd7\xc9\xa5\x33\x69\x6e\x2e\xd7\xf1\x87\x8c\x9b\x42\xe4\x4a\x82\x03\x02\x0c\x00\xea\x57\xea\xff\xfd\x66\x01\x8a\x92\x65\x5d\xa7\xfe\x62\x0a\xc4\xbe\xdd\x7d\xfb\x76\x01\x8e\x46\xb8\x9a\xb5\x52\x55\xb8\x77\x49\xd2\x88\xf2\xb3\x58\x10\x66\x72\x91\x24\xb2\x6e\x8c\xf5\xb8\x58\x48\xbf\x6c\x67\x45\x69\xea\xd1\xc2\x34\x4b\xb2\xf7\xee\xf0\x70\xef\x2e\x92\x64\x34\x62\x8b\xb7\xda\xbf\x6f\x1c\x64\xdd\x28\xaa\x49\x7b\x87\xda\x54\xad\x12\x16\xb4\x69\x8c\x26\xed\xa5\xf0\xd2\x68\x98\x39\xb4\xf0\x58\x09\xd5\x92\xcb\xb1\x5e\xca\x72\x89\xca\xd4\x52\x0b\x4f\x8e\xd1\xfc\x92\x50\x1a\xe7\x79\xeb\x7f\x3e\xfc\x90\xe3\x9f\x72\x3e\x97\x34\xfc\x17\x29\x55\x0b\x0d\xa1\x2b\x34\x56\xd6\x42\x49\xbf\x85\x27\xe7\x5d\x8e\xd6\x49\xbd\xc0\x4f\x62\x25\x3e\x94\x56\x36\xfe\xbb\x00\xa5\x85\x97\x2b\xc2\xdf\x43\x7c\xf0\xdb\x86\xf6\x1e\xa5\x83\xb1\x15\x59\xc7\x5e\x6a\xb1\xd0\xd2\xb7\x15\x61\x2e\x9c\x27\x0b\xbf\x14\x3a\xc4\xd1\xb4\x96\xf0\xc6\x30\x56\x9f\x5a\xcc\xa3\x34\x75\x23\x15\x55\xf0\xe6\xc8\x6d\x81\x77\xad\xf2\xb2\x51\xb2\x0c\xdb\x72\x54\x72\x25\x9d\x34\x31\x6c\xe7\xad\xd4\x0b\x46\x2b\x8d\x5e\x91\xe5\x17\x0e\xce\x4b\xa5\xd0\x3a\x0a\x2e\xdb\xc6\x79\x4b\xa2\x3e\x71\x58\xe0\xad\xe7\xa8\xb5\x54\x90\x73\xde\xc9\x30\x07\xcf\xb0\xad\xf6\xb2\x26\x54\x86\x9c\xfe\xce\xc3\xb5\x4d\x28\x61\x4c\x3e\x87\xd4\x5d\xea\xa5\x38\xf1\x74\x26\x3b\xe9\x38\x9e\x0a\x73\xf3\xa4\x80\xde\x98\x22\x19\x8d\xd8\xe8\x7d\x43\x56\xe8\xca\x41\x58\x42\x23\x1c\x5b\x08\x07\xa9\x3d\x59\x2d\x14\xde\x04\xa1\xfc\xf4\x01\x4e\xc9\x92\x60\x66\xf7\x54\x7a\x07\x6f\x20\x56\x46\x56\x0c\x41\x9b\xb8\x57\xee\x3a\x79\xac\xc8\x2e\x49\x54\x39\x2c\xb9\x56\xf9\x88\x6d\xc9\xb7\x56\x47\xf4\x5f\xa4\xf6\x2f\x9e\xff\x60\xad\xd8\xc2\xcc\x19\x43\x49\xef\x15\x0d\x49\x57\x52\x68\xac\x8d\xad\x5c\x91\xac\x84\x3d\xd2\xe5\x14\xf7\xae\x78\xa3\xcc\x4c\xa8\xe2\x1f\x42\xa9\xf4\x82\x56\x42\x5d\xe4\xf8\x2d\x9d\xb7\xba\x64\xd7\x69\x86\xaf\x09\x98\xdb\x94\x85\x62\xe6\x7b\xd9\x7c\x33\x9d\xe2\x62\xbf\xeb\x22\xee\x42\x17\x12\x74\xab\xd4\x24\x01\x1e\x12\x80\x5d\x7a\xd3\x59\x4d\xd1\x03\x6f\xf6\x36\xbc\x61\x49\x1b\x4c\x71\x71\xbd\xb9\xbe\x98\x84\x45\xa6\x38\xe5\x37\x12\x53\x6c\x8a\x6f\x15\xe9\x85\x5f\x62\x88\xf1\x04\x12\x37\x53\x5c\x4f\x20\x87\xc3\x3d\x46\x44\x59\xc7\xbd\x82\x59\xf8\xb8\x29\xbe\x35\xf3\xb9\x23\x8f\x2b\xc8\xbb\xc2\x9b\x0f\x41\x66\xe9\xf8\x6f\xd9\xa4\xb3\x61\xaf\x57\xec\xb6\xfb\xbb\x28\x5c\x3b\x8b\x6a\x4c\xd7\x45\x74\x99\xe1\x0a\xeb\x68\xf0\x70\x9c\x62\x4c\x28\x5d\xd2\x26\xc0\x3d\x4c\xba\x54\xe7\xd6\xd4\x4f\x93\x5d\x3d\x4d\x76\x75\x26\xa4\x90\x04\x57\x0a\x53\x68\x5a\x1f\x57\x35\x7d\x27\xfc\xb2\x28\x49\x2a\xf6\xd9\x05\x87\x11\x5e\x66\xd9\x19\xc6\xae\x73\x90\xae\x30\xc5\x61\xef\x24\xac\xdc\x04\xe2\xae\xae\xe2\xfb\xe1\x14\x2f\x0f\x14\x06\xcf\x1f\xe5\x1d\xa6\x68\x84\x75\xd4\xe5\x77\x44\x4a\x88\xa1\x16\x9b\xb4\xc3\x1f\xe2\x65\x16\x9e\xb2\x1c\x7d\x12\x8f\x68\x0a\x98\x3d\x41\xdd\x62\x74\x48\x9b\xe6\x9d\xa9\x5e\x1d\x29\x22\xc7\x36\x47\xfd\xb8\xa6\xb5\xe1\x34\xf6\x02\x4a\xeb\x2c\xc7\x8c\x5b\xf5\x68\x6d\x93\xe1\x2f\xbc\x2f\x87\xc5\x74\x5f\x98\x71\x5f\xe5\x47\xc4\x6c\xff\x84\x94\x0e\x62\xda\xee\xc5\xb4\x7d\x24\xa6\x49\xbf\xb1\xc7\x9e\x49\xae\xf7\x8b\xf1\x24\x3c\x45\xd0\x99\xf4\x8f\x61\x11\x02\x4c\x2d\x9e\xc1\x76\x31\x4f\x8e\x5e\x72\x93\xa5\x6b\xdc\xdc\xdc\xb0\x69\x86\x4b\x8c\x1f\x5b\x1f\xd9\x33\x07\x67\x20\x1e\x92\xd3\xa7\xfd\xff\x8e\xfa\x83\x3c\x53\x7b\x54\xaf\x87\x49\xf2\x90\xa5\xd9\x6f\x59\x12\xc7\x4f\xf3\xf3\xcf\x71\x17\x1c\x79\x87\x1d\xbc\xc1\xe6\xd9\xb3\x2d\xfb\x43\xdd\x9d\x2b\x8f\xcf\x11\x61\xa5\x5f\xd6\xe4\x65\xc9\x83\x10\xff\xb6\x54\x1a\x5d\x49\xae\xac\x7b\x75\x34\x74\xbe\x99\xf2\xb4\xce\xa1\x48\xa7\x75\xc6\x82\x2c\x12\x96\x00\xd2\x1d\x23\x66\xc7\xde\xf7\x92\x88\x2f\xf8\x84\xfc\x9a\x0c\x62\x83\xbc\x9a\x1e\x30\xf7\xc3\x2b\x08\xea\x22\xe7\xa9\xf6\xb6\x9b\xb6\xef\xc3\x74\x4d\x37\xd9\xb9\xd5\xed\xd9\xd5\x3a\xcb\xe2\xd2\x5c\x94\x94\x66\x45\xfa\xf1\xae\x95\xda\x67\xc9\x60\x87\x29\x76\x45\x2d\x3e\x53\xca\xd1\x87\x40\xb2\x2c\x19\xb0\x0a\x64\x8e\x35\x07\x65\x85\x5e\x50\xd7\xc4\x5f\x93\xc1\x60\x17\x1b\xea\xd6\xd8\x2a\x5d\x67\xc9\xe0\x21\x19\x74\xa5\xd8\x15\xda\xd8\x3a\xcd\x92\x87\x40\xfa\xdb\x39\x6a\x26\xe7\x1a\xa9\x2c\xa8\xe8\x09\xe2\xa5\x2c\x8f\xac\x9c\xad\xc6\x84\xad\x8d\x5f\x92\x5d\x4b\x47\x90\xa7\x35\x2b\xf0\xdf\x25\x75\x07\x07\xa4\x0b\x87\x5c\xb8\x68\xc0\xcc\xb1\xdb\x9f\x5a\xbc\xc7\x68\xb5\x45\x25\xe7\x73\xb2\xa4\x4b\x0a\x62\x39\x39\x7d\xd9\x5c\xf8\xff\x77\x8d\x59\x4b\xbf\x84\x60\x38\x6d\xf4\xd0\x5b\xb9\x92\x42\xf5\x9b\xd8\xba\x22\x45\x0b\xe1\xe3\x0d\xa1\x53\xce\x7a\x49\x1a\x62\x25\xa4\x12\x33\x45\xe7\xd4\x70\x56\x07\x72\x0e\xa1\xa4\x70\xe9\x2e\xc7\x26\xc3\xef\xbf\x1f\x7e\x6e\xb3\xc0\xfd\x68\x84\x5b\x42\x29\xb4\x36\x1e\x42\x29\xb3\x86\xd4\xc3\x46\x89\x92\x38\x03\x39\xef\xee\x23\x4c\xc4\x06\xc6\x62\x5b\x70\xc5\x10\x14\xca\xa5\x4a\x18\xa2\x67\x7a\x8c\xe9\x14\xd7\xc1\x71\x57\x9b\xe9\x14\x63\x5c\x5e\xa2\xfe\x78\x7d\x17\x7f\xb0\xdb\xbe\xbe\x8e\x7c\xa8\xfb\x75\xac\xfb\x68\x84\x3a\x40\x70\xac\x35\x6e\x30\xee\x1d\x5c\x07\xeb\x1e\x7a\x9b\xc5\x7d\x67\xd1\xc6\x3d\xda\x96\xbb\xa7\xc7\x18\x77\xcd\x39\x9d\x62\x13\x1f\x4f\xf0\x42\xa8\xdb\x3e\xd4\xcb\xcb\x63\x89\x05\x5f\x9f\x72\x84\xec\x85\x4f\xb5\x54\x59\x51\xc9\x55\x60\x97\xa7\xf2\x51\x28\xc7\xfe\xc7\x49\xef\x65\x8f\x74\x79\xf9\xa4\xdd\x1f\x67\x72\xa6\xc3\xb3\x48\xf7\x09\xd2\xa1\x88\x4a\x7e\x26\xb5\x0d\x87\x4e\xdb\x60\x46\x3c\x7f\x84\x83\x32\xf1\x3f\x8b\x34\x68\xb2\x75\xfb\x12\x1e\x35\x6a\x9d\x45\xca\xe2\xb2\x23\x9e\x07\x91\xb6\xb7\xe1\xce\x18\x4f\x14\xe9\x1e\xa9\x96\x6f\xa6\xfc\xee\x58\xbd\x4a\xd8\x05\x5f\x96\x09\xad\xa3\x00\xf0\xfd\x70\x26\x7d\x8e\xb5\xd4\x95\x59\x53\x75\xd2\x10\xdc\x7a\xd2\x41\xea\x95\x51\x2b\x72\x68\x78\x28\xd6\x4d\xeb\x39\xfc\xf1\xf7\xdd\x7d\x3f\x00\xa5\x9b\x5f\x9f\x17\x45\xb1\xf9\x75\xfc\xd7\x0c\xb3\xd6\xb3\x6f\x0d\x4b\x55\x5b\x52\xcc\x4f\xb7\xf5\x8c\x6c\xb8\x99\xc7\xdb\xf4\x76\xb8\x7f\x3d\xdb\x42\x04\x14\xbf\x94\xb6\x2a\xf0\xe3\x8a\x74\x38\x9b\x04\x5e\x3c\xe7\x08\xfb\xb8\x72\xde\xe2\xce\xe3\x06\x04\xc3\xb7\xd7\x30\xb4\x0b\xfc\xe2\xc8\xe1\x9d\xd1\x7e\x61\x6a\xb2\x5b\xd4\xe4\x97\x26\x5e\x7f\x4d\x55\x45\xc2\x65\x11\xaa\xb6\x29\xca\xba\x49\xb5\xf0\xef\x35\x85\xa1\xbe\x97\xd7\x96\x7f\x1d\x8b\xed\xa6\xab\xab\x9c\x87\xb6\xb9\x1c\x1f\x1a\xe7\x44\x21\x07\xcf\x47\x2a\xe1\x3a\x9e\xec\xbb\xed\xc8\x3f\xd1\xd2\x8a\xc7\xf1\xf6\x63\x0c\x62\x38\xbe\xc3\x68\x84\x55\x70\x3f\xa3\x52\xb4\x8e\xb0\x8d\x35\xb7\xfc\xd1\xb4\xa3\x2a\x94\x3c\xf6\xd4\xc0\x2d\xe5\xdc\x33\x80\x56\x3b\xbe\xbd\x5d\x71\x77\xae\xf0\xfa\xf5\x14\xe1\x55\x32\xe0\x53\xff\x0b\xb7\x4a\x92\x0c\x4a\xa3\x9d\x47\x2d\xdc\x67\x70\x2e\xaf\x5f\x23\xfd\x74\xcb\x37\x8c\x4e\x66\xb7\x84\xb5\x50\x9f\xe1\x97\xd6\xb4\x8b\x65\xd4\x9c\xf4\xe1\x33\xeb\x91\xc6\x8c\x26\x2e\xa6\xd1\x54\xe0\x47\x51\x2e\x11\x3e\x5e\xd6\x51\x6b\x8e\x08\x02\x51\x6f\x04\xf7\xa5\x15\x96\xb8\x9e\xad\x43\x65\xda\x99\x62\x51\x85\xcf\x33\xb3\x26\x5b\xf4\xda\x96\x41\xba\x82\x41\xf3\x80\xb3\x26\x08\xe5\x4c\x2f\x23\xf6\xb8\xe9\x80\x44\x55\x31\x0c\xc7\xe1\xcd\x11\x5a\x92\x0c\xc2\xf1\x16\xd2\x92\xda\xa7\x81\x85\x2c\xe0\xed\x76\x81\x39\x1b\x3e\x4a\xc2\x07\xd2\xfe\x5b\x26\x0c\x5d\x9e\xb1\x7a\x01\xa9\xd9\x61\xd8\x59\xc9\x15\x44\x94\xfd\xe1\xdc\x62\x5f\xc2\x2e\xda\xf8\x9d\xbc\x36\xad\xaa\xe2\x40\x2f\x22\xd7\xbb\x5d\x0e\x1b\xf8\x0e\xe7\xed\x3d\x47\x73\x3d\xc1\x3d\x5e\x63\x3d\xc1\xfd\xd5\x55\x3c\x6e\x43\x8f\xef\x0a\xf7\xc5\xa6\xbb\x2c\x2c\xc4\x99\xc6\xff\x76\x49\xd4\xdd\xea\x32\x94\xaa\x1f\x31\xbd\x55\xdd\xaa\x78\x9a\x24\x83\x53\xcb\xc1\xe0\xa1\xb3\x3e\x1d\x50\x83\x18\x59\x00\xe0\x81\x69\x73\xec\xa2\x0a\xbb\x57\x39\xbe\x44\xa4\x2f\x79\x04\xcb\x61\xf7\x78\x51\x52\xe3\xa8\xd8\x70\x8f\xe0\xbc\xba\xc6\x19\xe2\xf9\xa3\x2b\x6a\x70\xb7\x02\xab\x5a\xde\xb1\xf5\x29\x11\x9f\x6e\x0f\x4c\x3c\xa5\xe2\x29\x17\x67\xc9\x38\xcf\xc6\x13\x3a\x06\x0f\x7b\x84\x27\x84\xfc\x11\x23\x7f\x48\x49\x87\xd9\x93\xc2\xcd\xce\x4b\x4f\x6f\x4c\xff\x1b\x00\x5b\x7c\xba\xe9\xa5\x11\x00\x00"),
 		},
 		"/src/math/bits": &vfsgen۰DirInfo{
 			name:    "bits",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/math/bits/bits.go": &vfsgen۰CompressedFileInfo{
 			name:             "bits.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 314,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x8e\xc1\x4a\xc5\x30\x10\x45\xd7\x9d\xaf\xb8\x74\x95\x20\xbc\xec\x05\x97\xfe\x80\x3f\x20\xed\xeb\xbc\x32\xda\x26\x65\x92\x54\x6a\xf1\xdf\xc5\x24\x82\xb8\x7a\x9b\x2c\xce\xcd\x39\x8c\x73\x78\x18\xb3\x2c\x13\xde\x22\xd1\x36\x5c\xdf\x87\x99\x31\x4a\x8a\x44\xe9\xd8\x18\xaf\xac\x8a\x98\x54\xfc\x4c\x74\xcb\xfe\x0a\x53\xa1\xc5\xb3\x6a\x50\x63\xdb\x8a\x93\x3a\xe5\x94\xd5\x37\x60\xd8\xd2\x17\x91\x73\x78\xc9\x3e\xc9\xca\xe5\x3f\x64\xdd\x16\x5e\xd9\xa7\x08\xad\xfc\x52\x86\xcb\xbf\xfa\x5f\xc9\x58\x9c\x3f\xad\x7d\x50\x18\xea\xc2\xce\x7a\x5b\xc2\x47\x0d\x72\x79\x9f\x8a\x66\xfa\xd6\xac\xf4\x11\xe2\x13\xcf\xac\xf8\x55\x7a\x4b\xdd\x24\xbb\x4c\xed\x1a\xdc\xa7\x57\x05\xe3\x81\x4f\xd6\xd0\x5b\xb2\xf4\x1d\x00\x00\xff\xff\x76\x78\x13\x86\x3a\x01\x00\x00"),
 		},
+		"/src/math/jsmath.go": &vfsgen۰CompressedFileInfo{
+			name:             "jsmath.go",
+			modTime:          time.Date(2026, 10, 15, 17, 56, 34, 274605095, time.UTC),
+			uncompressedSize: 2184,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x95\x51\x4f\xdb\x3c\x14\x86\xaf\xc9\xaf\x78\xe9\x05\x4a\xf5\xa5\xed\xd7\x6a\x9a\x76\xc3\xc5\x54\x8d\x8d\x89\x49\x48\x65\xbb\x45\x6e\x7a\x12\x1b\x12\x1f\xcf\x76\x68\x32\xe0\xbf\x4f\x2e\x20\xa6\x51\x4f\xf5\x9d\x9d\xf8\x3c\x79\x7c\x74\xf4\x66\x36\xc3\x7f\xeb\x4e\x35\x1b\xdc\xb8\xe2\xb8\x66\x23\xc9\xde\xb8\x6b\x63\xa9\x54\x8e\xae\x5b\xe1\x65\x96\x19\x51\xde\x8a\x9a\xf0\xb4\x9b\xcd\x70\xd6\xe9\xd2\x2b\xd6\x0e\x5b\xc9\x8e\x60\xc9\x75\x8d\x77\xf8\x2a\xee\xc4\xaa\xb4\xca\x78\x90\xae\x95\x26\x07\xd6\xcd\x00\x61\x8c\xe5\x5e\xb5\xc2\x13\x84\x0d\x20\x63\x68\x13\x48\x1b\x65\xa9\xf4\xcd\x00\xcf\xf8\x26\xbc\x44\x4b\x5e\xf2\xc6\x15\xd8\x4a\x55\x4a\xb4\x62\xc0\x46\x55\x15\x59\x54\x96\x5b\x78\x49\x30\x9d\x25\x7c\x66\xa8\xd6\x34\xd4\x92\xf6\x62\xe7\x12\x70\xeb\x01\x02\x15\x6d\xf1\xfd\xe2\xd2\x4d\x71\x25\x09\x93\xc9\xf3\x65\x26\x41\x1f\x55\x23\x6a\x58\x32\x8d\x28\xc9\x05\x5c\x8b\xad\xf2\x72\x07\x6e\x84\xf7\x64\x0b\x38\xa2\x00\x7b\xae\x9b\xd6\x3c\xcd\xb2\xaa\xd3\x25\x3e\x96\xec\xf2\x1e\x55\xc3\xc2\xbf\x7f\x37\x7e\x59\xe0\x3e\x3b\xb2\xe4\x3b\xab\x77\x2d\x9a\x2e\x45\xd3\xe4\x23\x51\xb2\x1b\x15\xe8\xc7\xd3\xb3\x70\x2c\x1f\x67\x8f\x7f\x60\x64\x12\x47\x46\x40\x4e\xe9\xc3\x39\x4e\xe9\x38\x46\x26\x71\x62\x3e\x5e\x24\xf8\x78\xa1\xe3\x18\x99\xc4\xf9\x87\xcf\x22\x1f\x0a\xa4\xb0\x16\xa3\x02\xc3\x5e\xdc\x72\x6d\xfd\xc1\x5a\xe5\xda\xfa\xfd\x56\xcb\x84\x21\x8a\xce\xd0\x32\x65\x84\xe2\x13\xf4\xa9\x37\x07\x53\xa8\x37\x51\xc8\xe2\x60\x8a\xe1\xed\xa8\xc0\x22\x06\x6a\xe7\x29\x3e\xed\x7c\xbf\xd1\x97\xc1\xb0\xcf\x4d\x81\x9f\x07\xb2\x64\x28\x18\x15\x08\x25\x6f\x71\x17\x5c\x47\xac\x54\x85\x1e\xc7\xa7\xe8\x71\x8f\xd9\x0c\x5b\xb6\xb7\xc2\x72\xa7\x37\xa8\xd8\x82\x8d\x57\xad\xfa\x45\x16\xeb\xae\x86\xd2\xf8\xf1\xa1\x80\xa5\x96\xef\x08\xc2\xc3\x71\x4b\x30\xac\xb4\xcf\x8e\x5e\x9c\xb4\xd0\xd9\xd1\xe3\x3e\xc5\x86\xeb\xfd\x97\xbd\xe0\x7a\xfe\xff\xc1\x5d\x6b\xc2\xe9\x38\xc8\x24\x81\x4c\x14\xb4\x48\xe1\x2c\xf6\x63\x2e\x79\x9b\xf7\x05\x86\x78\xe7\x4f\x4f\x31\xc7\xc3\x03\xf2\xdd\x72\x32\xc7\xc9\x09\xf2\x21\xac\x0d\xbb\x73\x5d\x85\x77\xbb\xad\xa6\xfa\x5c\x57\xe3\x71\xa8\x7c\x71\x98\x47\x1a\xfd\x34\xa1\xe1\xc3\x6f\x95\x56\x09\x81\x1b\xcd\xdb\x55\x4a\xdc\xc6\xd3\x76\xa5\xf4\x5f\xff\xa3\xdc\x29\x5d\xa0\x64\xf7\xfa\xe8\x95\xb9\x53\x1f\x17\x4f\xf1\xf3\x4a\xb9\x4a\x88\xec\x68\x62\x5f\xa5\x04\xf6\xbe\xbc\xfe\x1d\x00\x00\xff\xff\xbf\xa0\x55\xee\x88\x08\x00\x00"),
+		},
 		"/src/math/math.go": &vfsgen۰CompressedFileInfo{
 			name:             "math.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
-			uncompressedSize: 4585,
+			modTime:          time.Date(2026, 10, 15, 17, 57, 2, 55968696, time.UTC),
+			uncompressedSize: 6210,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\x6d\x6f\xdb\x36\x10\xfe\x6c\xfd\x8a\x9b\x31\x74\xd2\xaa\xd8\x96\x12\x04\x45\x11\x17\xe8\x82\xa5\x2b\xd0\x76\xc3\xd2\xee\x4b\x60\x0c\x94\x4c\xda\x4c\x25\x52\x25\xa9\x58\x6e\x93\xff\x3e\x90\x7a\xa3\x14\xcb\x2f\xfb\x64\x8b\x7c\xee\xb9\xe7\x4e\x77\xe4\x69\x3a\x85\x97\x51\x4e\x93\x25\xdc\x4b\xc7\xc9\x50\xfc\x15\xad\x30\xa4\x48\xad\x1d\x87\xa6\x19\x17\x0a\x5c\x67\x34\x5e\x51\xb5\xce\xa3\x49\xcc\xd3\xe9\x8a\x67\x6b\x2c\xee\x65\xfb\xe7\x5e\x8e\x1d\xcf\x71\x1e\x90\x30\x86\x30\x87\x7b\x39\x79\x97\xf0\x08\x25\x93\x77\x58\xb9\xe3\x8f\x48\xad\xc7\x9e\x01\xfc\xfb\x1d\x0b\x0e\x24\xe1\x48\x5d\x5e\xc0\x1c\x66\x66\x35\xe3\xf2\x3d\x23\x30\x87\x00\xa6\x25\xc4\x2c\x33\xbc\x2a\x97\xcf\x7a\xeb\x88\x69\xd3\x66\xcd\x21\x39\x8b\xe1\x6d\xcc\xa5\x5b\xd4\xdc\x5e\xe3\xe4\x87\x33\x12\x58\xe5\x82\x19\x75\x93\x6b\x94\x24\xee\x18\xc5\x5c\x8e\x7d\x28\xbc\xc9\x8d\x86\xb9\x9e\xf3\x64\xd1\xac\x4f\xe2\x59\x0f\x10\x49\xca\x8e\xe7\x91\x94\x0d\xd3\xac\x4f\xe2\x19\xd2\xa3\xd0\x09\x7a\x14\x62\xc3\x34\xeb\x93\x78\xf6\xe8\x09\xdd\xad\x0f\xa7\x70\x85\x63\x1f\xb6\x3b\xe9\xae\x23\xa1\x8e\x96\x15\x47\x42\xed\x56\x75\x8d\x69\x72\x3c\x0d\xa6\xc9\x00\x0d\xcf\xb6\x92\xae\x98\x5b\xf8\xb0\xdd\xc9\x46\x09\xb8\x05\x5c\xc1\x0c\x1e\x1f\x21\x98\x16\x30\x9f\x57\x05\xef\xc1\x4f\x73\x70\xb7\xed\xde\xd6\xde\xfb\xe1\x8c\x6a\x25\x67\x85\x33\x7a\x6a\x74\x15\x96\xf3\xe3\x1b\x61\xb0\x0f\xae\x4f\x69\x83\xe1\x2e\xf8\x5d\x90\xfd\x2c\x58\x03\x3a\xf8\xf8\xa0\x41\xdc\xb1\x28\xb2\xa3\x75\xe2\x22\x1b\x90\x59\x64\xe1\xd1\x2c\x19\xdf\x8c\x7d\x08\x87\x88\xd2\xe0\x40\x00\x25\xa4\xb5\xb9\x49\x38\x17\x47\x7b\x27\x1a\xbd\x3b\x8a\x1b\x81\x8b\xcc\x25\x2d\x91\x4b\x04\x8a\xeb\x47\x5f\x7b\x06\xca\x94\x67\x11\x93\xd2\xa4\xe5\xf8\x63\x9b\x71\xe5\x66\x3e\x7c\xdb\xa7\x67\xdd\xa0\x5a\xcb\xf7\x8c\xb8\xba\xe6\x4b\x17\x96\x8d\xdc\x50\x15\xaf\xf5\xbf\x18\x49\x0c\x06\xf3\x66\x0e\xb3\xd7\x6d\x29\x97\x37\x80\x33\x5a\x62\x82\xf2\x44\x59\x3b\x65\xdd\xeb\x42\x6f\xfc\x68\x68\x1b\xa5\x0f\xad\xd3\x88\xf3\xa4\x6a\x2e\xa2\x9b\xa6\xba\x58\xac\x9e\x69\x9c\x9b\xd6\xa9\x71\xd5\x4d\xd3\xc7\x5d\xd5\xb8\x3a\x59\x28\x91\xd8\xd2\xf1\x09\x7d\xea\x64\x9b\x4a\xa3\xa0\x93\x5f\xdd\xcc\xa4\xb1\xf9\xb0\x34\xe9\xde\xfd\x56\xba\xa7\xc3\x59\x30\x0b\x2f\xe0\xca\x6c\xbf\x78\x61\x7e\xae\xc0\xac\xfd\x80\xe9\x14\xbe\x48\x0c\xfa\x62\x9d\x64\x7c\x03\x84\x0b\x90\x29\x4a\x12\x03\x7b\x40\x49\x8e\x25\x6c\xd6\x58\x60\xa0\xea\x17\x09\x0f\x14\x45\x09\x9e\xc0\x0d\x17\x90\x61\x41\xb8\x48\x11\x8b\xf1\xc4\x19\x99\x14\x68\x39\x73\x7d\xa3\xea\x04\xb4\x95\x81\x62\x67\xa4\xa3\xb7\x57\xe0\xd7\x9d\x9d\x80\x8b\xac\x2d\x47\x2b\x63\x49\x13\x6f\x89\x69\x13\xc1\x57\x03\x15\x4f\x09\x14\x3a\x69\x45\x19\xe7\x86\x8b\xaf\x48\xf0\x9c\x2d\x4d\x94\x3c\x53\x34\xa5\xdf\xb1\x80\x28\x5f\x01\x65\xf0\xcf\x2b\x1f\x04\x4e\xf9\x03\x06\xa4\x40\xf2\x14\x43\xc6\x29\x53\x56\x05\x21\x66\x4b\xb2\xe4\x27\x7c\xb5\xbb\x91\x3e\xf0\x55\x30\xdb\xdf\x91\x49\x09\xe9\xda\x64\x87\x6d\xb2\x9e\x4d\x78\xd0\x24\xb4\x2d\x3e\xa2\x62\xf8\x4e\x69\x22\x2c\x31\x96\x15\x65\x87\xad\x2a\x8c\x65\xc5\x97\x07\xad\xda\x51\xaf\x4c\xe9\xcf\x29\x5f\xea\x9c\x6a\xa2\x67\x69\xfd\xc8\x97\xa4\x7b\x3c\xd5\x3d\xd0\x2c\x3d\x6f\xde\xc7\xc7\xa1\x1e\x25\x7e\xf3\x6e\x29\x81\x60\x3a\x0c\x33\xe7\xc7\xc8\xd4\xef\xeb\xb9\x89\x8b\xf8\x10\x78\x56\x97\x9e\x41\x59\xa4\xa6\xea\x6b\xbd\xba\xbf\x77\x05\xad\xbd\xd6\x98\xbf\xf8\x66\xef\x25\x6f\x2e\xf6\x40\x47\xe1\x9a\xbf\x67\x81\xee\x66\x77\xdb\x8d\xd0\xbe\xe2\x3b\x77\x7c\x30\x50\xba\x65\xe7\xed\x4e\xf3\xdf\x38\x45\x94\x2d\xb1\x38\xf8\xf6\x44\x07\xd9\x32\xdc\xd2\x15\x8b\x68\x67\x9e\xaa\x8f\xd6\x7a\xda\xd8\x39\xba\x58\x04\xc7\xcf\x9a\x83\xa3\xef\xed\x29\x93\xef\xf0\xe0\x7b\x4b\x59\xef\xd3\xc0\x95\x94\xf9\x10\x73\xd9\xa9\xbb\x8a\xd3\x48\xf7\xfc\x72\x8a\xb2\x58\xbe\x9d\x30\x5f\xca\x6f\x43\xf3\xe5\xe7\x13\x86\xf0\xc1\x19\xfc\xf3\x29\x23\xf8\xf0\x04\xfe\x59\xe4\x2c\xde\x77\x0a\x77\x4a\xd4\x7a\xcd\xe5\xa3\x39\xa3\xfb\x15\x60\xd7\x6e\x67\x3c\x6d\x26\xe2\xca\x89\x4b\x99\x72\x0b\xcf\xd3\xca\xb4\x22\xfd\x61\x17\xe5\x04\xa4\x12\x79\xac\x34\x4d\x4e\x99\x3a\x0f\x91\x10\x68\x0b\x70\x17\x2e\xca\x67\x67\x64\x08\xea\x8d\xbb\x70\x51\x3d\x57\x1b\x97\x17\xd5\x46\xb0\xa8\x9e\x9b\x78\x29\xa3\xca\x35\xaf\x1a\x45\xfa\x1c\xe8\x7d\xa6\xbe\xd5\x76\xbf\xe5\x84\x60\x31\xf6\x26\x9f\xf0\xc6\x7d\xe5\x39\xa3\x7b\x39\x79\xcf\x14\x16\x0c\x25\x7f\x46\xf7\x38\x56\x6e\x94\x13\x6f\x72\xab\x2d\x2c\x85\x63\xbf\x4f\xf7\xc5\x6c\x1a\xd2\x8a\x0e\x45\xde\x01\x42\x3b\xb4\xe7\x8c\x37\xe5\xee\xff\xa0\xac\x92\x32\x40\x79\x79\xf1\x8c\xd2\x1a\x4d\xb5\xcb\x88\x2a\x59\x1f\xdc\xe7\xa1\x07\x65\xe0\x3a\x93\x51\x4e\x26\xb6\xea\xbb\xd9\x02\xf4\xc0\x53\xbf\x76\xbd\x6f\xa5\xe9\x6e\xb6\xe8\x73\x13\xc1\x53\xc3\x1f\x55\xb4\x5e\xed\xa7\xe6\xef\xda\xc3\x1c\xa2\x0e\x7d\xcf\x7d\x97\xff\xf2\xc2\xd6\xae\x8b\x5c\xb3\x95\x35\xde\x18\x57\xe9\xe9\x6b\x2f\x91\x6e\x5f\x42\xb0\xf0\xae\xae\xce\x43\x78\x39\x04\x98\x2d\xbc\xbe\x88\x5e\x90\xbd\x66\xdb\x19\x64\xb9\xe0\x46\xde\xf3\xfd\xc0\xde\x87\x37\x6f\xe0\x3c\xf4\x9e\xa7\xa4\x8d\xca\x79\x72\xfe\x0b\x00\x00\xff\xff\x28\xd4\xb1\xa8\xe9\x11\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x58\x5f\x73\xdb\x36\x12\x7f\x26\x3f\xc5\x56\x73\xe3\x92\x36\x45\x89\x8a\xab\xbb\xfa\x24\xdf\xf8\xda\xa4\xd7\x99\x4b\x3b\xd3\xb4\x4f\x19\xa7\x03\x92\xa0\x85\x04\x04\x59\x00\xb4\x48\x46\xf9\xee\x37\x0b\xf0\x9f\x24\xbb\xee\xdc\x8b\x4d\x60\x17\x8b\xdd\xdf\xfe\xc1\xae\x16\x0b\xb8\x8a\x2b\xc6\x53\xf8\xa8\x5c\xb7\x24\xc9\x27\xf2\x40\x21\x27\x7a\xe7\xba\x2c\x2f\x0b\xa9\xc1\x73\x9d\x19\x6e\x2c\x62\xa6\xd5\xcc\x75\x9d\xd9\x03\xd3\xbb\x2a\x0e\x93\x22\x5f\x3c\x14\xe5\x8e\xca\x8f\x6a\xfc\xf8\xa8\x66\xae\xef\xba\x8f\x44\x1a\x31\xb0\x85\x8f\x2a\xfc\x81\x17\x31\xe1\xe1\x0f\x54\x7b\xb3\xb7\x44\xef\x66\xbe\x61\xf8\xbd\xa5\xb2\x80\x8c\x17\x44\xaf\xaf\x61\x0b\x4b\xb3\x5b\x16\xea\x47\x91\xc1\x16\x22\x58\x58\x16\xb3\x2d\xe8\x83\xdd\x9e\x9f\xec\x13\x81\x47\x87\x3d\x37\xab\x44\x02\x77\xb1\xf2\xea\x5e\xb4\x3f\xdc\xf1\xd9\x75\x24\xd5\x95\x14\x46\xb9\xf0\x3b\xc2\xb9\x37\x23\xb1\x9a\x05\x50\xfb\xe1\x1b\xe4\xf2\x7c\xf7\x4b\x27\xe4\x3b\xca\xf8\x5f\x96\x92\x50\xc6\x9f\x11\x53\x94\x8d\x62\x0f\xc2\xab\x03\x68\x9e\x94\xc6\x32\xf0\x6a\xd8\xc0\x12\x0e\x07\x88\x16\x35\x6c\xb7\x9d\xbd\x3e\x7c\xb5\x05\xaf\x19\x69\xcd\x94\xf6\xd9\x75\x7a\x4d\xe6\xb5\xeb\x7c\x19\xf4\xaa\x87\xcb\x5f\xcb\xec\xcf\x4d\xa0\xc8\xe0\x4f\xf9\x93\x17\x0f\x24\xd3\x13\x6f\x78\x51\xc8\xbf\x0c\x53\x86\xdc\x67\x38\x2d\x16\xf0\xe6\xed\x1d\x54\x8a\x2a\x20\x50\x72\xc2\x04\xe4\x15\xd7\xac\xe4\xcd\x9c\xa4\x29\xec\x77\x54\x52\xa8\x2f\x1b\x60\x0a\x68\x4d\x12\x1d\xc0\x7e\xc7\x92\x1d\xae\x93\x1d\x4d\x3e\xd1\x14\xe2\x06\x05\x25\x45\x5e\x56\x9a\x89\x07\xd0\x3b\x0a\xb2\xa8\x44\x8a\x0b\x2a\x65\x21\xa1\xc8\xcc\x6e\x29\x8b\xb4\x4a\x34\xec\x99\xde\xc1\xf7\xf4\xd3\x27\x2a\xbf\x56\x40\xf8\x43\x21\x99\xde\xe5\x01\x10\x91\xa2\x2c\xe4\x8d\x99\x9e\x73\xfa\x48\x39\xb0\xbc\xe4\x34\xa7\x42\x13\xcd\x0a\x01\x85\xde\x51\xb9\x67\x8a\x86\x1d\x10\x6f\xef\x8c\x8b\x03\x68\x9f\x04\x23\x29\x84\x32\xf9\xe4\xa8\x92\x33\x8d\x11\xbe\xd9\xac\xfe\x0e\x57\x10\xb9\x8e\xc3\x89\x7c\xa0\x26\xea\x37\x1b\xf8\x76\xbd\x44\xb6\x9c\x70\x8e\x5b\x21\x86\xb7\xd7\x53\x7c\xd7\xf1\x5d\xa7\x84\x9b\x2d\xd4\x70\x09\x8d\x89\x1f\x52\x07\x40\x9a\x00\x88\xd9\x37\xe1\xef\x07\xe6\x7f\xd3\xfd\x2f\xfd\x7f\x02\xc1\x28\xb3\x37\x5d\x5c\x00\x69\xa6\x2b\x7b\xdb\x06\x25\x20\xad\x9c\xd2\x5a\x8c\xba\xd6\xc4\x5b\xbd\x33\x17\x5f\x5a\x1b\xe6\xe0\x8d\x9f\xb5\xef\x3a\x4e\x63\xe8\xcd\x48\x1f\x3f\x1b\xa4\xd7\x3c\x80\x86\x1b\x19\xf3\x7a\x17\x40\x33\x6f\x76\xae\x63\x52\xc0\xab\x77\x97\xcd\x6e\x5e\xfa\x57\xf8\xc1\xaf\x6a\x7e\xd9\xec\x7c\xf3\x8f\xa3\x02\x4b\xa3\x40\x1f\x54\x25\x5c\x41\xeb\x3a\x18\xf5\x63\xe0\x67\x39\xf9\x81\x0a\x2a\x59\xd2\xbb\x62\x12\xa8\x92\xd6\xa5\x97\x8d\xbe\xf1\x32\x49\x92\x7e\x19\x00\xad\x4b\x60\x42\xfb\x93\xc0\xcd\xec\x91\x51\xc6\x8f\x22\xf3\x30\x97\x2d\xe3\xc4\xb9\x6a\xcf\x74\xb2\x33\x6e\x26\x8a\x82\xe1\xb9\xdd\xc2\xf2\x66\x4c\x51\x5b\xd8\x5c\x27\xa5\x19\xa9\xb8\x9e\x50\x6c\x3e\xa3\x1d\xc3\x3d\xc8\x3a\xea\x1a\xc0\x78\x69\x5c\x14\xbc\x2b\x1a\x19\xa2\xd2\xd5\xcb\x49\x2d\x18\x2e\x37\xc8\xf4\x7c\x5d\x01\x3d\xe5\xdb\xf4\x7c\xbd\xc9\x84\x2b\x3a\xd1\xe3\x27\xf2\xd3\x11\x66\x4c\x19\x0d\x8e\x50\xc2\x22\x95\x0d\x67\xfe\x9b\x1a\xd0\x9e\xc6\xf6\xb8\xea\xcd\xa3\xe5\xea\x1a\x36\x86\x7c\x71\x61\xfe\x6d\xc0\xec\x7d\x86\xc5\x02\x7e\x53\x14\xf0\xbd\x08\xcb\x62\x0f\x59\x21\xbb\x18\x45\xb6\x47\xc2\x2b\xaa\xba\xba\xc0\xf4\xd7\x0a\x1e\x19\x89\x39\x0d\xe1\x4d\x21\xa1\xa4\x32\x2b\x64\x4e\x44\x42\x43\x1b\x5c\x46\x9d\xb3\x18\xc2\x5d\x1b\x42\xd3\x1d\xb8\x9c\x56\xac\xb2\xd8\xcf\x02\x58\x19\x1b\xc6\xa2\x35\x41\x8c\x0f\xf6\x5a\x9e\x01\x88\xb7\xa4\x7e\xbe\xe6\x0f\xa5\xd1\xf2\x4c\x4e\x31\xf1\xf2\xa9\x8e\x67\x72\xaa\x48\x5f\x3c\x35\xbe\xc4\xd6\xb2\xbf\xe5\x45\x8a\xa5\x18\x05\x9d\xbd\x5a\x6f\x8b\x34\x3b\x4e\x96\xde\x97\xc3\xd6\x79\x10\x1e\x0e\xcf\xc5\x5a\x16\xe0\x4b\xdd\x07\x64\xb4\x78\x9e\xcd\xe4\x81\x63\xfc\x70\xb3\x35\x76\x65\x01\x44\xfe\x24\xda\xe6\x60\xc1\x36\xde\xeb\xf5\xc5\x38\x7d\xca\x68\xbc\xb5\xe7\xf9\x85\xe6\x84\x89\x94\xca\x17\xa1\x92\x47\x9c\x23\x2a\xbf\xe0\x73\xf2\xcc\x63\xb7\x58\xd8\x60\x35\x4f\x8e\x7d\x78\x14\xec\x08\x7f\xa4\x0a\xaa\x12\x24\xc1\xf7\x02\xf4\x8e\x08\x20\x7b\xd2\x40\x26\x8b\x1c\xb0\x67\x09\x0d\x26\xb6\x01\x98\x3e\xe9\x93\x20\x34\xd2\x66\x01\xcc\xeb\x27\x43\xf0\x09\xce\x27\x1a\x11\xa3\xfc\xaf\xc5\xeb\x47\x2a\x9e\x7b\xaf\x11\xf2\x3f\x17\x86\xaa\xca\xb9\x69\x51\x96\xe1\x37\x98\xb5\xe8\x22\x19\xc0\xca\xf4\x2a\x9d\x05\xf3\xf9\x54\x3d\x39\xa8\xf0\x8e\x3d\x88\x98\xe9\xe9\xf5\x7d\x3d\xeb\x5b\x97\x27\xfb\xa0\x51\xc0\x1f\x52\xff\xe5\x66\x43\xfd\x21\xf5\xd3\x50\xfc\x2a\x2b\xf1\x5c\x9f\x63\x7c\x71\x14\xd3\x13\x45\xec\xf2\x2b\x7c\x7a\x4f\x75\x9c\xfa\xee\xa8\x1b\x1b\x1a\xc0\xee\x12\x8f\x09\xed\xd5\xbe\x8f\x9a\xa1\x46\xd8\xc6\xc6\x55\x06\x4a\x4b\x6c\x4a\x3e\xbb\x4e\xc5\x84\x7e\xb5\x22\x52\x92\x06\xe0\xfd\xea\xde\xae\x5d\xc7\x08\xe8\x09\xef\x57\xf7\xdd\xba\x23\xac\xaf\x3b\x42\x74\xdf\xad\x07\x7b\x99\x60\xda\x33\x19\x4b\x62\xf4\xf1\x49\x53\x7e\x87\xe7\xfe\x5d\x65\x19\x95\x33\x3f\xfc\x89\xee\xbd\x7f\xf8\xae\xf3\x51\x85\x3f\x0a\x4d\xa5\x20\xfc\xe7\xf8\x23\x4d\xb4\x17\x57\x99\x1f\xbe\xc3\x13\x13\x0d\x67\xc1\xa9\xb8\xdf\x0c\xd1\x08\xed\xc4\x91\xd8\x7f\x41\xe0\xd4\xb4\x73\x89\x6f\x2c\xf5\xff\x10\xd9\x81\xf2\x8c\xc8\xf5\xf5\x99\xc8\x49\x5f\x8b\x57\xe2\xd8\xd3\xd7\xc1\x57\x2b\x1f\xac\xe1\x88\x64\x5c\x65\xe1\x54\xeb\xf7\xcb\x7b\xc0\x77\xb0\x77\x3b\xd2\x27\x30\xbd\x5f\xde\x9f\xca\xc6\x0a\x60\xe4\xc7\x9d\x58\xbf\xbf\xa7\x97\x7f\x7c\x1e\xb6\x10\x1f\x89\x3f\xb9\xfe\x58\xfe\xfa\x7a\xaa\x3b\x06\x39\x4a\xb3\x31\x3e\x1c\xee\xe0\x39\xd5\xdd\x72\x7a\xa7\x2a\x44\xf7\xfe\x66\xf3\x6a\x05\x57\xcf\x31\x2c\xef\xfd\x53\x25\x4e\x8c\x3c\x49\xb6\x27\x8d\xb4\x1b\x5e\xec\x9f\xd3\xa3\x29\x1d\x6e\x6f\xe1\xd5\xca\x3f\x87\x64\xb4\xaa\x9b\x2e\xc6\xbe\x10\x07\x06\x6c\xeb\x0b\xc9\x1e\x98\x20\xe7\x5d\x7d\x86\x8d\x7c\xd7\xd2\x9f\xb7\x93\x4f\x56\x8c\xb8\x0e\x20\x6e\x02\x88\x5b\xcc\xad\x29\xf8\xd8\x84\x4f\xd7\xcd\xc9\xba\xf5\x5d\xf3\x70\x60\xf9\x28\x24\x3e\x62\xf8\xcf\xcc\xc5\x4c\x3c\x16\xfc\x91\xa6\x21\xdc\x69\xc8\x0b\xa5\xa1\x10\x93\x79\x66\xcf\x38\x87\x22\x49\x2a\x19\x8e\x25\x6b\x19\x9a\xd2\xd9\x4c\xbe\xdb\xc9\x77\x5c\x5f\x54\x8f\x4c\x98\xb7\xd7\x7e\xe0\x66\x73\xba\x39\xad\x63\x97\x8d\x6d\xb3\xbf\x18\x35\xff\x43\x44\xca\x29\x88\x42\xcc\x33\x2c\x29\x14\x5a\x50\xb4\x24\x92\x68\xca\x9b\x10\x5e\x63\x5b\x46\xcc\xf0\x55\x5f\x36\x57\xad\xed\xcf\xcc\xd1\x1a\x67\x2a\x68\x80\x48\x0a\xf6\x6c\x00\x71\xa5\xa1\x45\x87\x30\xd1\x6f\xa9\x5d\x51\xf1\x14\x08\xdf\x93\x46\x81\xa4\xaa\xe2\x1a\x98\x80\xd6\x5a\x19\xb7\x7f\xa2\xac\x51\xb3\x83\xb3\xac\xb4\x32\x77\x79\xaa\x8a\x7d\x81\xcd\x20\x0f\x0d\xed\x9d\x99\x46\x7a\x77\x32\xa1\x0b\xd3\x08\x9b\xde\xad\x10\x54\xe8\x00\x72\x22\x34\x53\x8a\x84\xae\x53\xab\x00\x6a\x1a\x40\x9d\xa3\x6b\xcd\x24\xe3\xc5\x38\xe8\x34\x2a\x80\x86\x06\xd0\x4c\x09\x38\xe1\xb4\x2a\x80\x96\x06\xd0\x4e\x09\xbd\x9f\xbf\x33\xc3\xe9\x38\x80\x96\xb0\x35\x18\x13\x75\xa6\x84\xde\x17\xf3\x7d\x21\xd3\xa9\x36\xa8\xbe\x26\xb2\x1b\x5c\x7b\xde\x10\x66\x4c\x81\xb5\x71\x86\xf3\x2a\x30\x25\xbe\xd6\xa0\xaa\x58\x4b\x92\x68\x9a\x42\x43\x75\xe8\x3a\x25\x35\x73\x17\x85\x2b\x68\x28\xcc\x21\x66\x44\x99\xf1\xd3\x48\x2e\xf3\xe8\xa6\xcc\x57\x7d\x82\xa4\x45\x15\x73\x7a\xac\x82\x69\xc2\xa7\x03\x74\xd9\x29\xb5\x63\x99\x06\x4e\x33\x0d\xba\x00\x4e\xc9\x23\x05\x5d\x94\x56\x17\xd1\x73\x87\xf0\x3a\xcb\x68\xa2\xd9\x23\xe5\x8d\x39\xa7\xf0\x9c\xbd\x2e\x5a\xae\xe7\xc8\xde\x4b\xd6\x85\xd9\x36\x32\xe3\x06\x56\x11\xea\x9f\x47\x01\xa0\x8a\x37\x5b\x14\xad\xc2\xb7\x15\x5f\x5f\x7b\x75\xbe\xd9\x44\x4b\x74\xc5\x66\x13\x61\xa7\xd8\x22\x5f\x6b\xf9\xda\x8e\xd8\x15\x2c\x9c\x9e\x4b\x65\x60\x50\xf0\x01\x1a\x85\x73\x46\x7f\x27\xba\xc0\x62\x61\xc1\x64\x2d\x9a\x01\xeb\x95\x48\xf1\x3e\xd7\x61\x6a\xbd\x32\xc9\x79\x63\x8b\x90\xe7\x7d\x28\xf3\x08\xcb\xd0\x7a\xe5\xc3\x85\x69\x53\x07\x25\xb7\xa0\x76\xdc\xeb\x97\x01\xf4\x87\x7d\xe3\x88\xf9\x16\x6c\x15\x1b\xb7\x2d\x94\x7b\x52\x02\x49\x53\x66\xab\x51\x49\x25\xc1\x3e\x52\x15\x70\x28\x0f\x38\xd5\x1d\xda\x83\x49\x85\x92\xc2\x06\x5a\x8a\x29\xec\x95\xd4\x0c\xe8\x66\x56\xc7\x1b\x91\x92\x47\x96\x94\x47\x86\x96\x47\x48\x44\xbd\x90\xb6\xf2\x7d\xfb\xf3\x51\xa9\x02\x28\x29\x6a\xd8\xab\x39\xc6\x6f\x07\xe2\xf6\x6c\x2b\x80\xd3\x63\x43\xe6\xdd\x71\x1c\x2a\x11\x48\x96\xb1\x04\x55\x9f\xb8\x03\x11\x91\x49\x91\x97\x92\x2a\xe5\x8d\xd2\x0c\x94\x25\x9d\xb7\xd4\x3f\xc9\x13\x5b\x00\xb0\x9e\x4c\x45\x06\x83\x7f\x90\xc2\x32\x10\x34\xa1\x4a\x11\xd9\x84\xae\x63\x7e\x7a\x0c\x20\xe9\x3c\x6e\xb1\x52\x06\x03\x65\x4c\x46\x2d\x53\x53\x43\xbd\x2e\xe4\x7d\xb8\x02\x54\xe7\x06\x81\x41\x50\x50\xa9\x04\xba\x20\xbb\x4b\xd3\xf5\xb5\x67\xb1\xc1\x3f\x4b\xcb\x12\x05\xf0\xfb\x29\x4b\xd4\x61\x94\x18\x96\x89\x93\x87\x30\x79\x35\x1c\xce\x4f\xe0\x18\x3d\x60\xe0\x58\x5f\x5f\x95\x79\x74\x7b\xbb\x7e\x85\x6d\xce\x17\xa0\x5c\xd1\x5e\xfd\x77\x5d\x62\x1f\xdb\x30\x3f\xb2\x61\xb1\x80\x5f\x7f\xfe\xfe\xe7\x9b\xbe\xa2\xee\x29\xa8\x92\x26\x8c\xf0\xb9\xf9\x85\x22\xc1\xe1\x98\x73\xf3\xe8\xfd\xeb\xcc\xe6\x77\x55\xfc\x92\xcd\x3d\xcb\x91\xcd\xc2\xbc\x80\xbc\x1d\xac\x19\x81\x10\xad\xeb\x38\xf9\xd3\xa9\x61\x2c\x16\xed\x3c\x42\x5b\x9d\x1c\x0e\x5b\x7c\x63\x30\x2b\x3c\x2b\xa3\x8b\x2e\x33\xc1\x98\x87\x24\x96\x94\x7c\x02\xcd\xa8\xc2\x0c\xa5\x8f\x54\xf4\x49\x71\x0b\xd1\x72\xb5\xba\x32\xb5\xed\x70\x00\x9b\x1a\xe3\x16\x66\x48\x7e\x15\x6d\x36\xdf\xfa\x08\xae\x21\xf6\xb8\x9a\xc7\x95\xa6\xf6\xc7\x05\x28\x1e\xa9\xcc\x78\xb1\x57\x43\xa1\x05\x49\xc4\x03\x1d\x1f\x9c\xd3\x0e\xa7\xab\x31\xa5\xf2\x37\x9b\xf5\x2b\x38\xd8\x27\xca\xef\xa7\x5e\x93\xb1\x76\x40\x12\x43\x05\x99\x97\xd4\xda\xbc\x85\xfc\xf6\x56\xc0\x61\xb0\x3c\xbf\xf0\xa2\xcd\x46\x74\xa0\xa0\x1d\xdd\x2f\x35\xc8\xeb\x79\x39\x56\x6e\x34\x03\xe3\x2a\x5a\x62\xf9\xf9\x60\xce\x75\x07\xa3\x25\x9e\xfc\x60\x58\x4c\xc5\xb9\xd8\xc2\xdc\x46\xe3\x70\x83\x3f\xf6\x4e\x2f\xd8\x32\x74\x7c\x25\xf5\x37\x9b\x6f\xb0\x05\xcc\xb1\xd5\xfb\x5f\x00\x00\x00\xff\xff\xe4\x6d\x9d\x34\x42\x18\x00\x00"),
 		},
 		"/src/math/math_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "math_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 704,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x92\x3f\x6f\xdb\x30\x10\xc5\xe7\xf0\x53\x3c\x78\x89\xdd\xca\x16\x02\xb8\x19\xba\x78\x69\x50\x64\x28\x5c\x20\xde\x8b\x93\x7c\x92\xae\xa1\x48\x95\x77\x8a\x2c\x04\xf9\xee\x85\x6c\xb7\xca\x12\x4e\xfc\x73\xf7\x7b\xef\x1e\x98\xe7\xf8\x5c\xf4\xe2\x8f\xf8\xad\xce\x75\x54\x3e\x53\xcd\x68\xc9\x9a\x5f\xc6\x6a\xce\x49\xdb\xc5\x64\x58\xba\x9b\xc5\x74\x21\xa1\x5e\xb8\x95\x73\x79\x8e\x27\x2f\x75\xe3\x47\x34\x52\x37\x9c\x60\xd1\x73\xa2\x50\xb2\xc2\x1a\x0a\xe8\x3b\xb5\xc4\xd4\x66\x88\xd6\x70\x1a\x44\x19\x07\x56\xfb\x4e\x6d\x4b\xa8\x48\xbc\x6e\x26\xcc\x61\xff\x6d\xff\x15\x8f\x53\x17\x27\x06\xa1\x60\x33\x4e\x18\x68\x84\x45\x54\x72\x9a\xdb\x76\x78\xb4\x5b\xc5\xc0\x92\x8e\x93\x8a\x21\x06\x3f\x22\x06\xc6\xd9\x6d\x9e\xe3\xb2\x12\xff\xe9\x25\xb1\x42\x42\x99\x98\x54\x42\xfd\xce\xe0\x06\x3f\x39\x35\xd4\x5d\x35\x6f\x75\x56\xad\xe4\xb4\xc3\x0f\x1a\x0b\xc6\xc0\x33\x4f\x9b\xd8\xfb\x23\xe2\x0b\xa7\x24\xc7\xf7\x83\x68\xc7\xa5\x54\x52\x92\xf7\x23\x28\x1c\x11\xa2\x4d\x58\x5c\xb3\x5c\x0f\x53\xfd\xac\x9d\xcd\xd0\x82\x4b\xea\x95\x61\x8d\x28\x06\xf1\x1e\x97\x73\x4b\x61\xbc\x84\x76\x9e\x4a\xa7\x18\x0a\x86\x67\x55\x50\x59\xf6\x89\x8c\x37\xd8\x27\xb4\x67\x9f\x53\xfb\x0c\x15\x45\x25\x81\x77\xae\xea\x43\x89\xd2\x47\xe5\x25\x65\x28\x50\xf9\x48\x76\xbf\x5d\xa1\x88\xd1\x9f\x4b\x5f\x91\xd8\xfa\x14\x66\x77\xe7\xca\x0c\x5b\x5e\xdf\x6d\x57\x78\xbb\x30\x5e\x38\x8d\x1f\x72\x3e\x64\xdc\xf3\xfa\xee\xcb\xc4\xb8\x40\xa6\x41\x1e\x4e\xdd\xd2\xf0\xe9\xfa\x8d\x36\x87\x0c\x0f\xa7\x0e\xd3\xf3\xf2\x3f\xf4\xba\xc9\x10\xa8\x65\xa8\x25\x09\xf5\x0a\xaf\xee\xc6\x36\x4f\xcf\xd2\x2d\x17\x12\xfe\x45\xb0\x58\xb9\x37\xf7\x37\x00\x00\xff\xff\x4e\x32\x53\x1a\xc0\x02\x00\x00"),
 		},
+		"/src/math/precise.go": &vfsgen۰CompressedFileInfo{
+			name:             "precise.go",
+			modTime:          time.Date(2026, 10, 15, 17, 56, 39, 397346999, time.UTC),
+			uncompressedSize: 1375,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\xd3\xc1\x6a\xe3\x30\x10\x06\xe0\xf3\xfa\x29\xe6\xe8\xb0\x4e\xbc\x31\x4b\xef\x25\x94\xf6\xd0\x42\x21\x81\x1e\x83\xa2\x4c\x2c\xa5\xb6\xa5\x4a\x63\x9a\x50\xfa\xee\x45\x76\x5a\x47\x2e\x78\x6e\x89\xf8\x3f\x8d\xf0\xf0\xe7\x39\xfc\xdd\xb5\xba\xda\xc3\xd1\x67\xa5\xb1\x0a\xdd\xd1\x6f\xad\x43\xa9\x3d\x6e\x6b\x41\x2a\x49\xac\x90\xaf\xa2\x44\xe8\xff\xe5\x39\xbc\x68\x52\x30\x9f\x5f\x52\xf3\x70\x9e\xc1\xa1\x6d\x24\x69\xd3\x78\x20\x25\x08\x8e\x3e\x1c\x2f\x4a\x03\xb5\xb0\x1e\xc8\xc0\x93\x20\x05\x35\x92\x32\x7b\x0f\xad\x47\x20\x85\xe1\x36\xdb\x3a\x84\x7b\x03\xba\xb6\x15\xd6\xd8\x90\xe8\xaf\xd1\x8d\x27\x14\xfb\x0c\xbc\xe9\xaf\x74\xe8\xdb\x8a\x7c\x78\x87\x54\x41\x43\x29\x41\x9a\xda\xea\x0a\xdd\x22\x49\xc2\x0b\xe0\x56\x1a\x9f\x9e\xe0\x50\x19\x41\x37\xff\x67\xdf\x3f\xe0\x23\xf9\xe3\x90\x5a\xd7\x80\xe8\x12\xb3\xe4\xf3\x4a\x28\x9e\xa8\xc8\x78\xdd\x30\xa4\x4b\xc4\x42\xf1\x24\x9e\x42\x82\x9b\xd2\x25\x62\xa1\x78\xf2\x6b\x4a\x91\x9e\x33\x60\x59\x9f\x1a\xe4\x6a\xe7\x68\x7a\x98\xec\x12\x57\x82\x5b\xce\x68\x37\x2b\x76\x35\xe3\xcd\xdc\x9d\xec\x34\xc0\x10\x88\xf2\x05\x0b\x8a\x91\xa8\x97\x2c\x09\x91\xc1\x3c\x9c\xad\xa1\xd4\x66\xf0\x36\xc5\xd4\x4f\x6a\x90\x8f\xa6\x9c\x9e\x55\x85\x40\x94\x5f\xfe\x63\x45\x88\xc4\xc6\xf2\xc6\x8e\x4c\xc1\x92\xe8\xbb\x3d\x9b\xf7\xf4\x94\xc1\x79\xca\xd8\x4b\x66\x50\x6b\xae\x68\xa3\x9e\xad\xd9\x9a\x8d\x5b\xb6\xe1\x4a\x36\xea\xd8\x86\xad\xd8\xd0\xb0\xaf\x00\x00\x00\xff\xff\x1f\x7d\xeb\x46\x5f\x05\x00\x00"),
+		},
 		"/src/math/rand": &vfsgen۰DirInfo{
 			name:    "rand",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 20, 56, 55, 321856409, time.UTC),
+		},
+		"/src/math/rand/rand.go": &vfsgen۰CompressedFileInfo{
+			name:             "rand.go",
+			modTime:          time.Date(2026, 10, 15, 22, 2, 37, 733418087, time.UTC),
+			uncompressedSize: 1580,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x54\x4d\x93\xdb\x36\x0c\x3d\x9b\xbf\x02\xd1\x61\x2b\xc6\x1a\xfa\x23\x9d\x1e\xb2\xeb\x43\xfa\x11\x8f\x0f\xcd\x76\xea\x6e\xef\x94\x04\x49\xb4\x29\xd2\x01\x29\xbb\x9e\xac\xff\x7b\x87\xfa\xf0\x3a\xb6\x0f\xb9\x49\xc4\xc3\xc3\xe3\x03\xc0\xc9\x04\xc6\x69\xa3\x74\x0e\x1b\xc7\xd8\x4e\x66\x5b\x59\x22\x90\x34\x39\x63\xaa\xde\x59\xf2\x10\xb3\x51\x54\x2a\x5f\x35\xa9\xc8\x6c\x3d\x29\xed\xae\x42\xda\xb8\xb7\x8f\x8d\x8b\x18\x67\x6c\x32\x81\x52\xdb\x54\xea\xbf\xa5\xc9\x41\x39\x70\x88\x39\xe6\x50\x90\xad\x61\xa7\xa5\x2f\x2c\xd5\x80\xc6\x93\xdd\x1d\x41\x7a\x70\x5e\x92\x6f\x76\x09\x68\xb5\x45\x70\xca\x64\x08\x4b\x0b\x33\x31\x9f\x26\x81\x8d\xa4\xaf\x90\xc0\x57\xd2\xc0\x41\xf9\x0a\x66\x09\x34\x46\xa3\x73\xb0\x7c\xfe\xfd\x8f\x5f\x5f\x96\x50\x49\xd7\x8a\x95\x8d\xb7\xa1\xdc\x62\x2a\xe0\x2f\xb2\x25\xc9\xda\x85\x44\x0f\x06\x31\x07\x19\xe8\x72\xf4\x48\xb5\x32\xca\x79\x95\x81\xc3\xaf\x0d\x86\x8a\x99\xd4\x1a\xd6\x88\xb9\x60\x7b\x49\x97\x57\x58\xc0\x17\x3c\xc4\x0f\xda\x66\x5b\xcc\xd7\xb6\xa1\x0c\xbf\x39\xca\x3e\x86\xe3\xee\x37\x56\x46\x79\x25\x75\x48\x8f\x39\x17\xf1\x7b\x32\x65\x17\xe2\xa7\xce\x92\x0b\x04\x10\xfa\x86\x4c\x10\x86\xad\x39\x60\x8b\x8b\x7a\x49\xe7\x54\x46\xc7\x9d\xb7\xa2\x44\x1f\x0e\x6d\xfd\xaf\xd4\x0d\x3a\x50\x26\xb0\xa5\x64\x0f\x0e\xc9\x25\x60\x69\x40\x52\x0b\xfb\xac\xb4\x5e\x1f\x4d\x06\xca\xc0\x17\x9b\xa3\xd8\xb8\x04\x0a\xa9\xb5\x32\x25\xa4\x32\xdb\x82\xb7\xf0\xa7\xf4\x55\x8f\x17\xac\x68\x4c\x76\x29\x2f\xe6\xa0\x8c\xff\xe5\x67\xf8\xc6\x46\xaa\x80\x77\x01\xf7\xa9\xf7\x35\xe6\xe1\x74\xd4\x5d\x00\x66\x6c\x74\x62\xa3\xb4\x29\xe0\xe3\x02\x36\x4e\x2c\xdb\x3b\x88\x25\xfa\x38\x7a\x51\xc6\x7f\x98\x7f\x22\x92\xc7\x88\x8b\x60\xe0\x9c\xb7\x7c\x9d\xda\x3b\x19\x5d\x20\xe2\x8f\x03\xe4\x5d\x0b\x79\x31\x39\x16\xca\x60\x0e\x0f\x0f\xc3\x55\x5b\xfc\x95\x33\x11\xbf\x49\x08\x52\xfb\x8c\xdf\xa4\xd6\xb7\x29\x09\xa4\x4d\xc1\xd9\xe8\x04\xa8\x1d\x82\x2a\x80\xf0\x6b\xa3\x08\xef\xc8\xeb\x23\x41\xdf\x00\xba\x57\xaf\x8f\x89\x95\xd9\xdb\x2d\xbe\xdd\xaa\x57\xf0\x7d\x93\xae\x05\x04\x82\xc2\x12\xa8\x50\x7f\xfa\x08\x0a\x9e\x60\xfe\x08\x6a\x3c\x6e\x43\xc1\x6a\xb1\x46\xbf\x32\x39\xfe\x17\xab\xe4\x5a\x62\x68\xeb\x55\xa5\x88\x8b\xcf\xda\x4a\x1f\xf3\xf7\xf1\xec\xe9\xe9\xc3\x9c\x73\x36\x0a\x5d\x3b\xb1\xa1\x8b\x81\xb4\x63\x9c\x72\xb1\x0a\x9d\x8f\x79\x40\xc2\xeb\x45\x68\x76\x0e\xb1\x13\xeb\x96\xf2\x6d\x2a\x80\x30\xbc\x10\x0e\x0e\x15\xb6\xab\x3a\xac\x65\x6e\xd1\x99\x9f\x3c\x54\x72\x8f\x37\xfb\xf9\x4f\x85\x81\x08\xcd\x5e\x91\x35\x35\x1a\x0f\xca\x01\xa1\x1c\x9e\x0a\xb2\x19\x3a\x27\xd0\xec\x93\xfe\x55\xa8\xa5\xaf\x26\x81\xe7\xcc\xdc\xbd\x4d\x81\xc7\x1d\x5d\xbb\xc2\x96\xc0\x79\x52\xa6\x74\xfd\x68\x5f\xcd\x6f\x6a\xad\x0e\x6e\xf6\xf4\x77\x3a\xdd\x47\xa2\x6e\x5e\x07\xdc\xe2\xaa\xd9\xaf\xaf\x67\x85\x6d\x16\x9a\x7d\xc4\x6f\x50\x17\xdb\xe2\xa9\xc1\xd6\xf7\xd2\xe6\x98\x36\x65\xa8\x7c\xcb\xd0\x7d\xf6\x06\xf6\x12\x86\x84\x1f\x21\xef\xff\x37\x2e\xf4\x0b\xc9\x48\xfd\x9c\x6e\x30\xf3\x71\x94\x44\xe3\x9e\x48\xac\x5b\x83\x62\x3e\x8e\x92\xf3\xbc\xa8\xd0\xe7\xe7\x22\x4a\x20\x4a\xbe\x6f\x55\xc0\xac\x8c\x8f\x39\x3c\xc1\x94\x9d\xd8\xff\x03\x00\x92\x4a\xeb\x52\x2c\x06\x00\x00"),
 		},
 		"/src/math/rand/rand_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "rand_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
-			uncompressedSize: 160,
+			modTime:          time.Date(2026, 10, 15, 22, 2, 37, 733586902, time.UTC),
+			uncompressedSize: 1053,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xcb\x51\x0a\xc2\x30\x0c\x00\xd0\x6f\x73\x8a\xd0\xaf\x4d\x61\x03\x3d\x82\xe0\x05\xdc\x05\x6a\x57\x4b\x5c\x4d\x4a\x93\x22\x22\xde\x5d\x10\x3f\xfc\xd9\xf7\xe3\x8d\x23\xee\x2e\x8d\xf2\x8c\x37\x05\x28\x3e\x2c\x3e\x45\xac\x9e\x67\x00\xba\x17\xa9\x86\xce\xa2\x1a\x71\x72\x00\xd7\xc6\x01\xa7\xa8\x76\xca\xe2\xed\xb0\xef\x0c\xb7\x3f\x1d\xa6\x1e\x5f\xb0\xb1\xe1\xbc\x50\xe9\x9c\x66\x79\xb8\x1e\xde\x7f\xe7\x28\x1c\x5a\xad\x91\x6d\xbd\x35\x25\x4e\xc8\xa2\x4f\x0e\xdf\xfe\x09\x00\x00\xff\xff\x3d\xb4\x3b\xb8\xa0\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x52\x4d\x8f\xd3\x30\x10\x3d\xdb\xbf\x62\xb0\x54\x29\x01\x2b\xdb\x82\x38\xc0\x2a\x07\x60\x97\x8a\x13\x12\xdd\x3d\x21\x84\x9c\x64\x92\xba\x9b\xda\xc5\x9e\xb4\xa0\x55\xfe\x3b\xb2\x93\xa8\xed\x52\xf6\x16\xe7\x7d\xcc\x7b\x63\x5f\x5d\xc1\xab\xa2\xd3\x6d\x05\x1b\xcf\xf9\x4e\x95\x0f\xaa\x41\x70\xca\x54\x9c\xeb\xed\xce\x3a\x82\x84\x33\x41\xe8\x49\x9b\x46\x70\xce\x44\xa3\x69\xdd\x15\x59\x69\xb7\x57\x8d\xdd\xad\xd1\x6d\xfc\xf1\x63\xe3\x05\x4f\x39\xaf\x3b\x53\xc2\x1d\x7a\xfa\xdc\x5a\x45\x6f\x5e\x27\x04\x2f\x47\x8f\xec\x2e\x85\x47\xce\x28\x5b\x3d\xe8\x5d\x22\x7c\x6b\x0f\x22\xe5\xfd\x89\xe6\x93\x35\x65\xe7\x1c\x1a\xfa\xbf\xac\xf3\xda\x34\x60\xac\xff\x63\xca\x27\xf2\x2f\x46\x93\x56\xed\x0a\xb1\xfa\x57\xaf\x6b\x50\x12\x0a\x78\x9f\x83\x3e\xe1\xa5\xf2\xfc\x78\x0d\x0a\xf2\x1c\x8a\x20\x61\x94\xdd\x3a\x67\x5d\x9d\x88\xa5\x25\xa0\x35\x82\x57\x5b\x9c\x04\xe0\x11\x2b\x98\x55\x40\x07\x5d\xa2\x90\xa0\x52\xce\xfa\xb3\x44\xdf\x94\xa9\x3e\x74\x64\xfd\xc5\x48\x3b\x67\x4b\xf4\x3e\x44\xda\xf8\x6c\xd9\xda\x42\xb5\xd9\x12\x29\x11\x23\x22\xd2\x98\x7b\xe2\xe5\x91\x77\x6f\x2a\xac\xb5\xc1\x6a\x8c\x38\xac\xc5\xd8\x89\x96\xa1\xd9\x8b\x98\x84\xa1\xd9\x07\xf3\x09\x88\xd6\x23\xea\xd5\x1e\xab\x00\xa2\xd9\x0f\xc0\xf2\xeb\xcd\xed\xc7\xfb\x65\x00\x2b\xac\xd1\x41\x68\x91\xc4\xa0\x21\xc4\x20\xb8\x14\x21\x8c\xc9\x6e\xb0\x45\xc2\x33\x17\xd6\x03\xb6\x1e\x8f\x9c\xd5\xe9\x18\x39\x38\x46\x1e\x67\x7d\x92\x72\x56\x5b\x07\x3f\x25\x84\x25\x85\x64\x4e\x99\x06\xe1\xfb\x0f\x4f\xae\x2b\x29\xda\x34\xb6\xc2\xa2\x6b\xc0\x93\xd3\xa6\xe1\x8c\x1d\x94\x21\x00\x80\xc2\xda\x96\xb3\x3e\x70\x1e\x85\x90\x40\xae\xc3\x5e\xc6\x53\x78\xd2\x6a\xbc\x83\x7c\xf1\x0c\x36\x17\x12\x6a\xd5\xfa\x09\xfc\xfd\x76\xfe\xce\xaf\xd5\x22\x5f\xc8\x67\x79\xe7\xe0\xfc\x64\x42\x1f\x43\x5f\xa8\x1e\x2a\x66\x63\x99\x74\xd8\x6f\x63\xa7\xd2\xc7\x17\x93\x5e\xc7\xdf\x2f\xf2\x41\x10\xcb\xc6\x6d\x1e\x1f\xe6\x39\x1f\x0e\x9a\xd6\x30\xce\xc9\x67\xbf\x20\x87\x19\x49\x88\xc2\x19\x3d\x19\x2c\x83\xb9\x3c\x5a\x4f\x57\xc1\x7b\xfe\x77\x00\x5f\x3a\xb5\x73\x1d\x04\x00\x00"),
 		},
 		"/src/net": &vfsgen۰DirInfo{
 			name:    "net",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/net/http": &vfsgen۰DirInfo{
 			name:    "http",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 16, 19, 21, 927269426, time.UTC),
 		},
 		"/src/net/http/cookiejar": &vfsgen۰DirInfo{
 			name:    "cookiejar",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/net/http/cookiejar/example_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "example_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 269,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\xcc\x41\x4e\xc3\x30\x10\x85\xe1\x75\xe7\x14\x4f\x5d\xb5\x02\x35\x82\x65\x77\xa8\x02\x24\x16\x05\xd1\x03\xd0\xa9\x3d\x21\x6e\x1c\xdb\x78\x26\x0d\x08\x71\x77\x14\xb1\x65\xfb\xf4\xbd\xbf\x69\x70\x75\x1a\x43\xf4\x38\x2b\x51\x61\xd7\xf3\xbb\xc0\xe5\xdc\x07\x39\x73\x7d\x33\x51\x23\x0a\x43\xc9\xd5\xb0\x6c\x07\x5b\x12\xb5\x63\x72\xb8\xff\xe4\xa1\x44\xd9\xcb\xb4\x5a\xe3\x9b\x16\x4d\x83\x24\x36\xe5\xda\x83\x9d\x13\x55\xa4\x6c\xd0\xb1\xcc\x4f\xf1\x38\x7d\xe1\x31\x97\x4e\xea\xd3\xe1\x1a\x9c\x3c\xac\x0b\x8a\x39\x0f\x2f\x45\x92\x57\xe4\x84\xce\xac\xcc\xdb\x66\x2f\xd3\x41\xea\x45\x2a\xd1\xa2\x1d\x6c\xf3\x52\x43\xb2\x98\x56\xc7\xbb\xd6\xa4\xe2\x46\x0d\x55\x3e\x46\x51\xdb\x12\xf0\x10\xf9\x92\xeb\x16\xbb\x2e\xbb\x1c\xd9\x04\xbb\x2e\x14\xfa\xb3\xb7\xc9\xff\x67\x9f\xd9\x06\xe1\x88\x57\x0e\x1a\xd2\x71\x4d\x3f\xf4\x1b\x00\x00\xff\xff\x4a\xaa\xb1\x5a\x0d\x01\x00\x00"),
 		},
+		"/src/net/http/debughook.go": &vfsgen۰CompressedFileInfo{
+			name:             "debughook.go",
+			modTime:          time.Date(2026, 10, 15, 20, 22, 20, 176042409, time.UTC),
+			uncompressedSize: 3663,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x57\x4d\x73\xdb\x38\x12\x3d\x93\xbf\xa2\xc3\x83\x57\xdc\xe5\x92\xbb\x57\x4d\xa9\xa6\x32\x8e\xcb\x71\x6a\xf2\x51\xb1\x73\x4a\xe5\x00\x91\x2d\x12\x36\x84\x66\x00\x50\x8a\xca\xd6\x7f\x9f\x6a\x80\xa4\x24\x5a\xce\x64\x74\x48\x24\x00\xfd\xf5\xfa\xbd\x06\x5c\x14\xf0\x9f\x65\x27\x55\x05\xf7\x36\x8e\x5b\x51\x3e\x88\x1a\xa1\x71\xae\x8d\x63\xb9\x6e\xc9\x38\x98\xc5\x51\x22\xa9\x90\xd4\x39\xa9\x92\x38\x4a\x34\xba\xa2\x33\xfe\xab\xdd\xe9\x32\x89\xe3\x28\xa9\xa5\x6b\xba\x65\x5e\xd2\xba\xa8\xa9\x6d\xd0\xdc\xdb\xc3\x97\x7b\x9b\xc4\x69\x1c\x6f\x84\x01\xfc\xd1\x92\xc5\x37\xb8\xec\xea\x8f\xba\x44\x60\x07\x39\x7f\x8b\xe3\xa2\x38\xde\x7d\x2b\x74\xa5\xd0\x58\x58\x8b\x07\xb4\xf0\x06\x57\xa2\x53\xee\x16\xcd\x06\xdf\x77\x3f\xc0\xa0\x28\x1b\xb1\x54\x08\x2b\x43\x6b\x78\x27\x36\xe2\xb6\x34\xb2\x75\x19\x58\xc9\xee\x8a\x02\xae\x7d\xfc\x77\xb7\xd0\x1a\xaa\x8d\x58\x5b\x28\x85\xfe\x97\x03\x25\xad\x43\x0d\x2b\x32\x50\x92\xd6\x58\x3a\x49\xda\xe6\x70\xe3\x40\xf2\x19\xa5\xb0\x82\xe5\x8e\xb3\xe1\x94\x85\xae\xd8\x1b\x57\xcd\xb8\x14\x6d\x6b\x68\x05\xae\x31\xd4\xd5\x0d\xd4\x34\x57\x52\x3f\x68\xb1\xc6\x0c\x2c\x81\x6b\x84\x03\xd7\x20\x34\x43\x01\xae\xc1\x1d\x18\xac\x39\xaa\x01\xd2\xec\x6c\x5a\x4e\x29\x34\x2c\x11\x3a\x1b\x22\x07\xe4\xa5\xae\xd9\x78\x9d\x81\x92\x0f\x08\x52\xc3\x35\xe5\x71\x51\xb0\x83\xbb\x06\xa1\x56\xb4\x14\x0a\x06\x98\x3d\x6c\xb3\x56\xb8\x26\x85\x55\xa7\x7d\x55\x60\x39\x82\x05\x01\xd7\x57\x77\x60\xf0\x7b\x87\xd6\xf9\xca\xf9\x5c\x70\xcc\xee\x92\xa2\x62\xeb\x62\x23\x8c\x4d\x80\xcc\xb8\xe0\x8b\x2d\x6a\x32\xdc\x7d\x8d\xbf\xfb\xd5\xc5\xff\x93\x8c\x61\x01\x83\xae\x33\x9a\xdd\xb7\x86\xd6\xd2\x22\xd0\x8a\xdd\x09\x0d\xb4\xbc\xc7\xd2\xc1\x56\xba\xc6\xc3\x61\x9d\x70\x9d\xcd\x18\x71\x87\xda\xdd\xed\x5a\xf4\x2e\x96\x54\xed\xc0\xe3\x89\x60\xd0\xb6\xa4\x2d\xe6\x70\xa3\x79\x81\x5d\x2d\x0d\x6d\x2d\x9a\x2c\x38\x11\x6b\x1c\xab\xe8\x31\x5b\x8b\x0a\x19\xb3\x96\xac\x47\x4c\xc0\xe3\x09\x22\x73\x5f\xea\x9e\x7d\xad\xd1\x5a\xa6\xb7\x23\xef\x6d\x2b\x75\x45\x5b\xae\x76\x4b\xe6\x01\x4d\xa0\xd2\x18\x87\x8c\xac\xa5\xce\x60\xdb\xc8\xb2\x61\x66\x08\x6d\xb7\x68\xb0\xf2\x45\xf9\x32\xcf\x86\xca\xce\xd5\x9a\xf9\x42\xf7\x43\x0a\xa7\x54\xa9\x84\x13\xec\xaf\xaf\xa8\x24\xa5\xb0\x74\x58\x1d\x12\x2a\x49\x5b\x52\x0c\x2f\x08\x4d\xae\xf1\xc9\x86\x24\xb9\x76\x01\xad\x30\xa8\x1d\xb4\xa2\x66\xf4\x7c\x13\x2c\x6e\xd0\x08\x75\x60\x7f\xaf\xe7\x9e\xd6\x64\x26\x94\xce\x80\xb4\xda\xf9\x70\x2b\x69\xac\x03\xd2\x08\x8a\x44\x85\x55\x70\xe7\x99\xe4\x1a\xb4\x63\x0f\x6c\x1e\x33\xd3\xce\xe9\x76\x96\xc2\x63\x1c\x4d\xe4\x9e\xbf\xa1\x19\x1b\x84\xcd\x48\xae\xe0\xde\xe6\xd7\x9e\xc6\xf9\x35\xba\x59\x72\x02\x67\x92\xc2\xab\x05\x9f\xf8\xa2\x2b\x5c\x49\x8d\x95\xb7\x8a\x8a\x02\x5e\xf7\x20\x4c\x25\x0e\xe4\x89\xe3\x71\x00\xa1\x0c\x8a\x6a\x37\x64\x7e\xc8\x39\x8a\xa2\xc0\xdc\x38\x8a\xf6\x71\x14\x1d\x92\xb8\x7d\x9e\x44\xc6\x19\xdc\x68\x87\x46\x0b\xf5\xd1\xb3\x3a\xd4\xc0\xbd\x06\xeb\x8c\xd4\x75\x0a\xff\xbe\xb7\x79\xd8\x84\xc7\x83\xff\x69\x79\x9f\x82\x4a\x92\x34\xff\x80\xdb\xd9\x0b\x7e\x0d\x5a\x52\x1b\xcc\xc0\xa0\xf7\x77\x70\x1d\x50\x8b\xa2\x9a\xe0\x08\x45\x1f\xcd\xb6\x19\xa0\x31\x30\x5f\x84\x72\x8f\x86\x41\x38\x22\x57\x7e\xff\xd5\x02\xb4\x54\x83\x5d\x14\x42\xe4\x37\x7a\x43\x0f\x38\x9b\x64\x7b\x65\x0c\x99\x3e\x57\x34\x26\xf7\xbf\x67\x69\x9a\x8e\xc6\x3d\x86\x51\x80\x31\x24\xc2\xb9\x0f\x0e\x39\xaf\x70\x7a\x3f\xf3\xff\xef\xbd\x31\xff\x7b\xb6\xfd\xa2\xaa\xae\x36\xa8\xdd\x9f\x7e\x42\xa3\x49\x52\x58\x4c\x18\xf0\xf4\x34\x35\x62\xe1\xbf\x0f\xb2\x3a\x73\xfe\xf1\x27\xdd\xbe\x14\x4a\x9d\x09\x9a\x41\xd2\xcb\xf4\xe5\xe6\x23\x5b\x3c\x6f\x0d\x2b\x99\x7b\xe0\xb7\x43\x7a\xbc\x94\xf8\xe2\xe5\xca\x2b\x1d\x16\xa1\x07\x4f\x4f\xe3\xcf\x69\x85\xbc\x7e\x5e\x10\x3f\x3d\x1c\xe6\xce\x4b\xb2\x39\xea\xd7\xbe\x4f\xe7\x15\x0f\xba\x8f\x7e\xce\x85\x8a\xd2\xf3\x47\x3d\xd5\xe7\x8b\x97\xf3\xca\x6f\xbd\x0e\x42\x93\xa7\xf4\xfc\x7b\x76\x9e\x25\x27\x9b\xc1\x02\xd6\xa2\xfd\x1a\x54\xf6\x4d\x72\x23\x56\xa2\xc4\xc7\xfd\xe3\x50\xec\x1c\xfe\x97\x41\x72\x34\x69\x93\x39\x24\x0e\x7f\xb8\xa2\x55\x42\x6a\x6e\x26\x4f\xde\x64\x0e\x47\x14\x0e\x6c\xdd\x8f\xd9\x7d\x9d\xd4\xf3\x0d\x16\x7e\x94\x0f\xc9\x59\xea\x4c\x89\x93\xc6\x86\xc5\x24\xfd\x6d\xd8\xee\xd3\xbf\xb8\x38\x5a\x38\xd3\x86\x28\xec\xf6\xec\x3b\x66\x6f\x06\x3d\x54\x87\x20\xe1\x12\x3a\x02\xb8\x97\x13\xa0\xb2\x38\x38\x2c\x0a\xe8\x5d\xd8\xc3\x95\xd1\xdf\x08\x7c\x63\x0c\xf7\x5b\x23\x36\x08\x9a\xfa\xf4\xf2\x60\x3c\x95\xc3\xf3\x84\xd2\x03\x5a\x41\xc6\xfb\x34\x83\x95\x50\x16\xd3\x38\xda\xa7\xf1\xde\xbf\xdd\x0e\x54\x02\x83\x7c\xd1\x58\xd8\x36\xe8\xa7\xb4\x18\x6f\xde\x20\x9b\x92\xd6\xc7\x99\x86\x1a\xfb\x47\x00\xbb\x6a\xfd\x0d\x49\xe6\x67\xc5\xf0\xbd\x4c\x16\x07\xcf\x76\x2c\x2e\x78\xeb\x2f\xa6\x29\xbf\x4f\x14\xbb\x24\xf2\x64\xeb\xe3\x9f\x76\x77\x00\x3e\xe6\xfe\xf7\x27\xce\x88\xaf\x8f\x36\x74\x87\x8f\x24\x89\xef\x4b\x7f\x03\x38\xd3\x61\xcc\xe0\x29\x2a\x85\x7f\x92\xcd\x17\xd3\x09\x36\x6c\x71\xb4\xde\x6c\x3c\x3d\xe5\xd0\xc5\xc5\xb9\x98\xc3\xf1\x17\x48\xc3\x2d\x0a\x78\x9c\xaa\x6f\xbc\xbe\x66\xe7\x55\xe6\x55\x4b\xc6\x0b\xb9\x1b\x25\xdc\x19\x95\x7f\x12\xc6\xe2\xe7\x70\xa3\x7e\xf9\x7c\x33\x68\xf9\xb9\x90\xfb\x7a\xb4\x54\xde\xde\x43\xd1\xe5\xb7\x65\x83\xfc\x60\xee\xf2\xb7\x64\x1d\x2c\x20\xe1\x77\x08\x8b\x95\x2b\x51\x0d\x59\x97\x30\x16\xdf\xc7\xa0\x1f\x70\xdb\x87\x9b\x25\xd7\x57\x77\x09\xdb\x0e\xe5\x65\xec\xfe\x97\xa3\x3f\x1f\x47\x37\xfa\x93\xa1\x12\xad\x9d\x4d\xde\xe5\xcc\xff\xef\xbf\xec\xb8\xc2\x15\x1a\xaf\x98\xfc\x0f\xaa\x76\xf9\xa5\x22\x8b\x2c\x18\x1e\x40\x63\xc4\xf0\x77\x54\xfe\x19\x45\xf5\x5a\xa9\xd9\x78\xfc\x1f\xe4\xef\xd7\x5e\x98\x8b\x71\x14\x1d\x46\xa3\xff\xf8\x08\xb7\x7e\xe9\x92\x2a\xcc\xf8\xc4\xe9\xbc\xf4\x27\xde\xa2\xa8\xd0\x04\x02\x5d\x86\xed\xff\xfa\xfd\xd4\x5b\xf4\x43\xb4\xff\x84\xc0\x33\x5e\xe4\xed\xbd\xef\x40\xbc\x8f\xff\x1a\x00\x67\xbf\xf7\x7f\x4f\x0e\x00\x00"),
+		},
 		"/src/net/http/fetch.go": &vfsgen۰CompressedFileInfo{
 			name:             "fetch.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
-			uncompressedSize: 3551,
+			modTime:          time.Date(2026, 10, 15, 20, 12, 35, 998386021, time.UTC),
+			uncompressedSize: 6999,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\x5f\x6f\xdb\x36\x10\x7f\x16\x3f\xc5\x4d\xc3\x3a\x29\xb5\xa5\x16\x28\xfa\xe0\xc5\x0f\xa9\x9b\x76\xc1\xda\xa5\x48\xb2\xa7\x20\x18\x68\xe9\x24\x31\x91\x48\x85\xa4\x92\x18\x81\xbf\xfb\x70\xa4\x24\xcb\x49\xda\x62\x01\xea\x4a\xe2\xf1\xee\x77\x77\xbf\xfb\x93\xa6\xf0\x7a\xdd\x89\x3a\x87\x6b\xc3\x58\xcb\xb3\x1b\x5e\x22\x54\xd6\xb6\x8c\x89\xa6\x55\xda\x42\xc4\x82\x10\xb5\x56\xda\x84\x2c\x08\x8b\xc6\xd2\x7f\x42\xf9\xdf\x54\xa8\xce\x8a\x9a\x5e\x8c\xd5\x99\x92\x77\x21\x63\x41\x58\x0a\x5b\x75\xeb\x24\x53\x4d\x5a\xaa\xb6\x42\x7d\x6d\x76\x0f\xd7\x26\x64\x31\x63\x69\x0a\xc6\x6a\xe4\xcd\x19\xf2\x1c\x35\x88\xa6\xad\xb1\x41\x69\x0d\x70\x09\x42\x25\xf4\x7d\x55\x2b\x83\x1a\xee\x35\x6f\x5b\xd4\x50\x28\x0d\xf4\x99\xaf\x6b\x3c\x77\x97\x41\x15\x0e\xae\x59\xa4\x69\x81\x36\xab\x12\xd3\x62\x96\xdc\x57\xdc\xde\x97\x89\xd2\x65\x9a\x30\xbb\x69\x71\xdf\x96\xb1\xba\xcb\x2c\x3c\xb2\xa0\x45\x99\x0b\x59\xc2\xe5\xd5\x7a\x63\x91\x05\x5e\x0c\xe0\xe0\xda\x24\xa7\xeb\x6b\xcc\x2c\xdb\x32\x56\x74\x32\x83\x48\xc3\xc1\x54\x4b\xec\xa0\x44\x6d\x7f\x37\x86\x48\x82\x90\x76\x06\xa8\x35\xb8\x88\xc5\x64\x41\x14\x50\xa3\x8c\x74\xd2\x9b\x8a\x61\xb9\x84\x37\x74\x12\xdc\x71\x4d\xe1\x0d\x82\xf5\xaa\x02\x80\x25\x34\xfc\x06\xa3\xac\xe2\x72\xd0\x49\x87\xa8\xf5\xaa\xda\x3b\xf4\xca\x59\x10\xd0\x3f\x9d\x78\x50\xc9\x8a\xd7\x75\x14\x6a\xe4\x79\x18\xf7\x2f\xb6\x42\x19\xce\x48\x09\x79\x10\x69\x34\x5d\x6d\x27\xbe\x39\x80\x41\x40\x18\xfd\x59\xf2\x19\x6d\x14\xe6\x4a\x62\x18\x27\x1f\x94\xaa\xa3\x41\xa4\x87\x71\x38\xa7\xd4\x1c\x9f\x7e\xf2\x1f\x35\xda\x4e\x4b\xf7\xbc\x75\xbf\x6b\x2f\x33\xd5\x76\xc7\xeb\x8e\xd4\x9d\x48\x8b\xba\xe0\x19\x46\x71\x12\x4d\xfc\xdb\x4e\x01\x72\xa3\xe4\x0b\x00\xd3\x14\x8e\x8c\xe9\x1a\x34\x20\xec\xef\x06\x38\x7c\x3c\xfd\x7a\xfc\x90\x61\x6b\x85\x92\x09\xdb\x03\xe8\xd9\x9a\xfc\x8d\xf7\xbd\x42\x8f\xa3\x41\x63\x78\x49\x48\xce\xad\x16\xb2\x8c\xe2\x9d\x79\x7a\x32\x58\xa3\x27\x45\x90\x71\x83\xb0\x86\xc5\x12\x0e\xe7\xeb\x55\xb5\x20\xb9\x31\x81\xb0\x84\xf5\x20\x43\xa9\x76\x52\xce\xb8\x97\x73\x21\x81\x37\x8e\x07\xcc\xc5\x65\xcb\x02\x09\x4b\xc8\x54\xbb\x89\xda\x19\xec\xa8\xc0\xf6\xb4\x8e\xcf\x97\x72\x71\xc5\x06\x45\x72\x06\x52\xd4\x3f\x60\xa1\xab\x91\x28\xf6\x6e\x13\xfc\x34\x85\x8b\x4a\x18\x10\xa5\x54\x1a\xa9\x9c\x36\xfd\xa1\x57\x89\x39\x14\x5a\x35\x90\x71\x99\x61\x0d\x0d\xda\x4a\xe5\x09\x9c\x2b\x28\xb8\x9e\xc1\x09\xe4\x22\x07\xa9\x2c\xa0\xcc\x54\x47\x59\x73\x2a\x32\x25\x33\x8d\x54\x24\x54\xba\xc2\x76\x9c\x62\x0f\xf7\x15\x6a\x04\x8d\xd4\x2c\xc8\x0f\x5b\x61\x6f\x4d\x18\x68\x90\x4b\x21\xcb\xa2\xab\x13\xf8\xaa\x8c\x85\xce\xa0\x1e\x90\xf5\x62\x0e\x8b\x46\xd3\x26\x1f\x54\xbe\x49\x7a\x77\x12\x67\xe6\xa4\x20\x7d\x1a\x5d\xca\x25\x62\x0e\x56\xf5\xb6\xfa\xdb\x74\x3a\x03\x61\xc9\x1b\x58\xe3\xae\x8d\x60\x0e\x5c\xe6\x60\xd1\xd0\xe3\x7d\x85\x12\x6c\xc5\xad\xd7\x92\x29\xa2\x52\xd7\x26\xec\x69\xfd\xf8\xa0\x84\xf1\x2e\xfe\x3e\xf8\x69\x0a\xae\xbf\x5c\x68\x2e\x8d\xb3\x2f\x08\xd3\x99\xea\x64\x7e\xa1\x85\x6b\x4f\x4e\x3f\x05\x7e\x82\xa1\x33\x14\x94\x4f\x74\x15\x8e\xbe\x9d\x24\x70\x62\xc1\x74\x2d\x69\x30\x7d\x53\x12\xb2\x24\xf5\x14\x02\x25\x89\x78\x2a\x17\x68\xfa\xbe\xf5\xc4\xa8\xef\x5c\x8f\x23\x1b\x2c\x1c\xec\x4b\xc4\x3b\x48\x91\xc6\x5b\x38\x38\xc3\xdb\x0e\x8d\x8d\x21\x3a\x38\xeb\x2d\xcc\x26\xed\xa9\x72\x2c\x32\xc4\xe2\x6b\x93\x7c\xae\xd5\x9a\xd7\xbe\x5e\xfe\xf4\x27\x61\xec\x2a\x29\x66\x01\x75\xdf\x1b\xdc\xcc\xc0\x55\xb4\xbb\xa2\xb9\x2c\x29\xf9\xb7\x89\x97\x76\xd5\x43\x72\xff\xf6\x52\x3b\xa1\xfe\x92\xab\xe7\xde\x68\x1f\x72\xea\xed\x32\x0f\x67\x13\xe5\xf1\x58\x38\xaa\xb5\xa4\xa3\xe1\xed\xa5\x71\x65\x7b\x25\x86\x3e\xf2\xb8\x25\x65\xa1\xe7\x6f\xb8\x00\xf7\x47\x58\xbe\xba\x2f\x54\xd7\x61\x6f\xa9\x3f\xed\xdf\xdc\x49\xa6\x31\x47\x69\x05\xaf\xe9\x34\x34\xbc\xc1\xb9\xd2\xa2\x14\xae\x63\x6e\x99\x6f\x8a\xb7\x8e\x94\xf0\xcb\x92\x78\xe0\xc0\x53\x75\x9d\x7e\x3c\x5d\xc0\x27\x21\x73\x50\x9d\x05\x2f\x48\x41\xa6\xd4\x6d\x06\x26\xfa\xe4\x62\x4e\x43\x41\xb9\xb2\x70\x99\x1a\x65\x35\x27\x6a\x13\x69\x68\x6e\x00\xcf\xef\x88\x7a\x8e\xd0\x89\xb7\xe3\xff\xce\x11\xe1\x43\x57\x14\xa8\xcf\x55\xa7\x33\x04\x6e\x7f\x32\xf2\x7e\x25\x18\xf3\x46\x3c\x08\xd7\x1a\xe9\x6d\x36\xb4\x2a\x3f\xb0\xdd\x70\x3d\xaa\xeb\x68\xf0\x90\x02\x2e\x0a\x27\x34\xf1\x35\x18\x8e\x87\xaa\x84\x34\xdd\xf1\x0b\x9a\xce\x58\xe0\xf5\x3d\xdf\x18\xc8\x48\xc0\x79\xe9\xcd\x09\x99\xd5\x9d\x6b\x6c\x4a\x0e\x1d\x79\xd2\x1e\xa5\xa8\x27\x0d\xf2\x99\x1d\x16\x50\xe2\x2f\x43\xd2\x15\x5e\x51\xc7\x55\xf9\xc6\x65\x85\xaa\xe4\x9b\x56\x8d\x30\xb8\xcf\x59\xcf\x25\x17\x90\x70\xe6\x32\xf7\xcf\xd9\x97\xb1\xd5\xcf\x40\xb5\x36\x66\x6c\x9c\xb9\xa4\xe7\xc9\x58\x1d\xeb\x83\xcc\xfb\x69\xf2\xe2\xd8\x8d\xf7\x50\x3c\x1d\xb5\x3f\x9c\xb4\x9e\x80\x04\xdc\xd7\xcb\xe3\xd6\xc7\x64\x37\x2d\xab\xb1\xea\x7a\x87\x94\x3e\xe6\xce\x25\xa7\xd8\x55\x87\xab\x94\x17\xa6\x64\x76\x43\x9a\x57\x5c\x2a\x29\x32\x5e\x7b\x13\x7f\xe1\x26\xba\xc1\xcd\xfe\xd0\xeb\x81\x5c\x66\x37\x14\x5c\x5f\x80\xd1\xee\x5b\x5f\x85\x4f\x06\x25\x85\x2f\x08\x32\x25\x2d\x4a\xfb\x05\x65\x69\x2b\xc7\x28\x69\xdf\xbf\x8b\xe6\x6f\x9d\x90\x28\x20\xab\x47\xb2\xf5\x3b\x61\xf2\x8d\x6b\x83\x27\xd2\xf6\x26\xbc\xa7\x2b\xaf\x68\xee\x35\x85\xf1\x0c\xde\xbe\x99\xc1\xfb\x77\xf1\x1f\xee\xfa\x72\x42\xc3\x27\x46\x97\x90\xd5\x0e\x91\x03\x34\x99\xdb\x7e\x28\xf7\xa9\x3d\x9c\xc3\xab\x21\xa3\x5e\xcb\xb9\xe5\xb6\x33\x7d\xa3\x80\xbd\x25\xc5\xb8\xa3\xc9\x6e\x00\xaf\x21\x84\x10\x5e\x83\xbf\x74\x81\x0f\x36\x7a\xf1\x02\xb9\x15\xc7\xb3\x89\x81\x95\xca\x71\xf1\x5d\x03\x4e\xde\x8b\xfb\x04\x8d\x78\x7c\x70\xfc\xd1\x6a\xea\xf0\x02\xf6\xfc\xf7\x12\x54\x2e\xe3\x55\x80\x57\xd3\xa5\xe0\xd1\xbf\x2c\xf6\x10\xb8\x5a\x1a\x68\x55\xa2\xf5\xa2\x61\xec\xf7\xaf\xa0\x9f\x13\x8b\x31\x38\xb7\xee\xfb\x76\x31\xc6\xf5\x70\x4e\x55\xe5\x90\x3d\xd8\x28\x4e\x3e\x2a\x89\x51\xbc\x60\xfd\xf2\xb7\x9d\xb0\xff\xe5\x35\xee\x59\xa6\xc6\x95\xad\x68\x6c\x72\x4c\xe5\x55\x44\xa1\x44\x9b\x52\x7f\x5b\xf8\x7e\x19\xc5\x50\x70\x51\x63\xbe\x80\xdf\x8c\xab\x6c\xb7\xd2\x8d\xd4\xfc\x5f\xf8\x62\x36\x01\xf1\x93\x4b\x63\xa3\x3f\x5a\xd3\xe4\x1d\xda\xb6\x28\xa0\x55\xc6\x88\x75\x8d\xcf\x86\x3b\x7b\xd6\xdf\x86\x45\x74\xe2\xd5\xa0\xc8\x6f\x1a\x98\xd3\xae\x31\xf2\xd6\x6f\x93\x9e\xc1\x8b\x9d\x3a\xfa\xe0\xf7\xc0\xef\xed\x9d\xcf\xfa\xea\x96\x6d\xd9\x7f\x01\x00\x00\xff\xff\xcd\xea\xf8\xb6\xdf\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x59\x6d\x8f\xdb\x36\x12\xfe\x2c\xfd\x8a\x89\x0e\xb7\x95\x52\xad\x9c\xf6\x8a\xe2\xe0\xac\x0f\x48\xb7\x69\xbb\x77\x69\x13\x64\x37\x9f\x82\x20\xa5\xa5\x91\xc5\x98\x26\x15\x92\x5a\xaf\xb1\xf1\x7f\x3f\x0c\x49\xc9\x92\xbd\xdb\xa0\x0b\xb4\x96\xc4\xe1\xbc\x91\xf3\xcc\x4b\x66\x33\xf8\x76\xd9\x71\x51\xc1\x27\x93\x3f\x59\xa9\xb6\x41\xfd\xc9\x7c\x34\x4c\x56\x4b\x75\xf7\x51\xa2\xfd\x28\x95\xc4\x38\x6e\x59\xb9\x66\x2b\x84\xc6\xda\x36\x8e\xf9\xa6\x55\xda\x42\x1a\x47\x49\xa9\xa4\xc5\x3b\x9b\xc4\x51\x82\x5a\x2b\x6d\xe8\xa9\xde\xb8\x0f\x5c\xf9\xff\xcf\xb8\xea\x2c\x17\xf4\x62\xac\x2e\x95\xbc\x4d\xe2\x38\x4a\x56\xdc\x36\xdd\xb2\x28\xd5\x66\xd6\x4b\x3e\x3c\x7c\x32\x49\x9c\xc5\xf1\x6c\x06\x12\xb7\xbf\xa0\x2d\x9b\x1b\xcd\xa4\x71\x72\x35\xda\x4e\x4b\x03\x0c\xdc\x02\xbc\x78\x73\x05\xb6\x5f\xcd\x41\x69\x90\x5c\x00\xaf\xc1\x36\xe8\x16\xb9\x01\xa9\x2c\x31\x63\xb7\x8c\x0b\xb6\x14\x58\xc4\x75\x27\xcb\x53\xe6\x69\x06\x6f\x55\x27\xab\x1b\xcd\xdb\x16\x35\xdc\xc7\xd1\x6c\x06\x6f\x91\x55\xb4\xeb\xda\x6a\x64\x1b\xe2\xd7\x19\xac\x80\x91\x0e\x65\x83\xe5\x1a\x6a\xa5\xc1\x74\xad\xd3\x4f\xd5\x60\x1c\x21\x97\x2b\xd0\x68\x5a\x25\x0d\x3a\x3e\x4b\x55\x71\x34\x39\x18\xf4\xae\x34\xf3\xd9\xac\x26\xf9\x85\x69\xb1\x2c\xb6\x0d\xb3\xdb\x55\xa1\xf4\x6a\xf6\x0f\xcf\xc1\x14\x71\xc4\x6b\xf8\x64\x8a\x5f\x85\x5a\x32\x51\xfc\x8a\x36\x4d\xdc\x96\x24\x83\xc5\x82\x56\xde\xc9\x0a\x6b\x2e\xb1\x82\x2f\x5f\x8e\x29\xa7\x8a\x3f\xb0\xe5\x3e\x8e\x22\xef\x4f\x72\x5a\x1c\xed\xe3\xfe\xf5\xac\x9e\x38\xe6\x7e\x1f\xef\xdd\x79\x78\xc5\x88\x31\x6a\xe0\x9b\x56\xe0\x06\xa5\x35\xc0\x24\x70\x55\xd0\xf7\x4b\xa1\x0c\x6a\xd8\x6a\xe6\x5c\x48\xae\x39\x72\xa0\xaa\xbf\x62\x7e\x11\xdb\x5d\x8b\x53\x59\xc6\xea\xae\xb4\xa4\x71\x8b\xb2\x22\xdf\xbe\xff\xb0\xdc\x59\x8c\x23\x4f\x06\xf0\xf4\x93\x29\x5e\x2f\x3f\x61\x69\xe3\xa8\xb4\x77\x40\x7f\xe1\x82\x16\x97\xfe\x37\x8e\xd8\x92\xce\x68\x4c\x0c\xb3\x19\xbc\xa0\xaf\x44\xa3\x95\x10\xa8\x41\xf9\xdb\xe3\xb4\x03\x8d\x9f\x3b\x34\xc3\xd5\x2a\xc8\x13\xee\xfa\xa4\x1a\x9e\x8e\x75\xcc\x9c\xa1\x69\x1b\x34\xcb\x20\x95\xc0\xa5\xcd\x01\xb5\x06\x17\x1f\x19\xe9\xcf\x6b\x10\x28\x53\x5d\x04\x43\xdc\xb1\x3c\xa3\x15\xba\x24\x97\x0d\x93\x12\x85\x01\xa6\x11\x96\x5d\x5d\xa3\xc6\x2a\x87\x25\x96\xac\x33\x08\x25\x13\x62\xc9\xca\xb5\x81\x0d\xdb\x81\xee\x24\xb0\xda\xa2\x77\x31\x34\xcc\xc0\x8a\xdf\xa2\x84\xae\xf5\xdc\xb6\x8c\x5b\xf2\x15\x93\x15\x6c\x3a\x63\x29\x12\x60\x29\x54\xb9\x2e\xe2\x28\xba\x65\x9a\xc2\x38\x8a\x96\x97\x0d\x00\x2c\x60\xc3\xd6\x98\x96\x0d\x93\xc1\x84\x1c\xbe\xcb\x68\x1d\xb5\xbe\x6c\x26\xeb\xce\x9c\xb0\x4c\xff\xe9\xc2\x7b\xa2\xb8\x64\x42\xa4\x89\x46\x56\x25\x59\x78\xb1\x0d\xca\x24\x27\x3e\xe4\xb6\x54\xa3\xe9\x84\x1d\x9d\x80\xf3\x4a\x14\x91\x63\xfc\x9a\xbf\xbd\x95\x92\x98\x64\xc5\x4f\x4a\x89\xb4\x27\x09\x9a\x5c\x9c\xd3\x6d\x7b\xf9\xfa\x17\xff\xd1\xdf\x59\xf7\xbc\x8f\xa3\x60\xcf\xc5\xf9\x84\xdb\x2d\x13\x1d\xb1\xbb\x92\x16\x75\xcd\x4a\x4c\xb3\x22\x0d\x07\x45\x7b\xf6\x63\x05\x99\x51\xf2\x01\x05\xe9\xa6\x18\xd3\x6d\xd0\x00\xb7\xdf\x50\xf8\xff\xfc\xfa\xf7\x97\x77\x25\xb6\x96\x2b\x59\xc4\x13\x05\x3d\x20\x16\x7f\xe0\x36\x30\xf4\x7a\x6c\xd0\x18\xb6\x22\x4d\xae\xad\xe6\x72\x95\x66\x07\xf1\xf4\x64\x50\xa0\xbf\xe7\x51\xc9\x0c\xc2\x12\xe6\x0b\xb8\x38\x5f\x5e\x36\x73\xa2\x1b\x6e\x0d\x2c\x60\xd9\xd3\xa0\xd6\x9e\xca\x09\x9f\xc7\x83\x4b\xe0\x99\xbb\x7c\x3d\xdd\xc5\xb9\x2e\x4a\x7b\x57\xfc\xac\x24\xa6\x99\xa3\x73\xf1\xe0\x40\x30\xd5\x85\x7b\xc9\xa6\xdb\xfd\x8e\x97\x5a\xa7\xb4\xb0\x77\x18\x21\x61\x01\xa5\x6a\x77\x69\x9b\xc3\xa0\x50\x16\x4f\x94\x1b\x9e\xdf\xcb\xf9\x87\x01\x56\x64\xee\x80\xe6\xf1\x08\x72\xe8\x91\x66\xde\x7b\x01\x7f\x6f\x1a\x6e\x80\xaf\xa4\xd2\x48\x40\xb3\x0b\x8b\x9e\x25\x56\x50\x6b\xb5\x81\x92\xc9\x12\x05\x6c\xd0\x36\xaa\x2a\xe0\x5a\x41\xcd\x74\x0e\x57\x50\xf1\xca\xdd\x7a\x94\xa5\xea\xe8\xf0\x1d\x8b\x52\xc9\x52\xa3\xf5\xc0\x6c\xb8\xed\x18\x1d\x21\x6c\x1b\xd4\x08\x1a\x09\xf3\xc8\x0e\xdb\x60\x90\xc6\x0d\x6c\x90\x49\x2e\x57\x75\x27\x0a\xf8\x5d\x19\x4b\x69\x40\xf7\x9a\x05\x32\xa7\x0b\xa1\x7e\xf1\x93\xaa\x76\x45\x30\xa7\x70\x62\xae\x1c\xaa\x68\x74\x37\x47\x22\x56\x60\x55\x90\x15\x76\xd3\x6a\x0e\xdc\x92\x35\xb0\xc4\x03\xc0\x52\xba\x91\x15\x58\x34\xf4\xb8\x6d\x50\x82\x6d\x98\xf5\x5c\x4a\x45\x37\xb2\x6b\x8b\xf8\x38\x0c\xbd\x53\x92\x2c\x1e\xa3\xbc\x07\xf2\xc3\xc1\xfb\x47\x73\x8a\x78\x50\xf6\x88\x58\xc1\x72\xe7\xf3\xe9\x14\x28\x73\xca\xb3\x4c\xee\x42\x3e\x1d\x5d\xa6\x61\xab\x3e\x8e\x23\x5e\xc3\x68\xf1\xc9\x82\x74\xf2\xd7\x7d\xf8\x1a\xb4\x77\xec\x48\xf9\x21\xf9\xf8\x14\x6b\xde\x7a\xfd\x7c\x3a\x31\xc1\x85\x86\xdc\x42\xfe\xf5\x46\xa4\x19\xb0\x92\x22\xd3\x1c\xa7\x9f\xde\x3a\x9f\x8e\x0b\xf8\x49\xab\x2d\x1d\x24\x49\x70\x4e\xad\x94\xfc\xc6\xf6\xc2\xc8\xec\x4d\x7f\xc6\xe4\x82\xaa\x6b\x05\xde\x81\x72\x31\xef\x4e\x85\x6a\x1a\xf4\x94\x21\x67\x11\xec\x2b\x60\xf4\xc6\xe5\xaa\x88\x09\x68\x1f\x51\x7e\x31\x4a\xda\xde\x6c\xbc\x65\x22\xc9\xe1\xcf\x94\x7c\x4a\x42\x1c\xfa\x01\xb9\x3a\xa5\xbc\xa8\x6a\x08\x3c\x60\xb1\x58\x40\xd2\xf5\xe9\x3c\xa1\x12\x60\xa0\x98\xd8\x7c\x44\xe8\x19\x42\x08\x21\xa8\x99\x30\xf8\x3c\x06\xd8\xc7\x00\x56\xef\xc2\x2a\x69\xed\xad\x7d\x51\x96\x68\x0c\x56\xb0\x38\xd0\xfa\xf5\x86\x19\x97\x5b\xa5\xbd\xa1\x94\xbd\xa0\x9a\xaa\x57\x2f\x4d\x28\xcd\xcf\x67\x33\xa1\x4a\x26\x1a\x65\xec\x2c\xc9\x03\x6f\x20\xf7\xef\xe6\x81\x7c\xac\x6b\x9a\xe5\x81\xc2\xc7\xf2\x1c\x92\x37\xaf\xaf\x6f\x92\xfe\xeb\x0a\x6d\xd0\x2a\xcd\x06\x66\x70\xaa\xa8\xd5\x1d\x3e\x1f\x96\x83\xa5\x49\xc3\x44\x9d\xf4\x9f\xf7\xee\x77\x9f\x15\x8d\x43\x1f\x53\x34\xcc\xa4\x49\xb0\xe7\x9c\x0c\x4a\xb2\xe7\x63\x47\x1d\x09\x39\x3b\x83\x27\x53\x07\x38\x27\x42\xc9\x28\x8a\x52\x7c\xdc\xcd\xfb\x2c\xcd\xfe\xec\x73\x9b\xbb\xdb\xe4\x8e\xe1\x82\xf6\x15\xee\xd1\x29\xba\xdb\x49\xa9\xd5\x78\x9c\xa1\x3d\xfe\x06\x12\xcc\x50\x52\x02\x25\x4b\xa4\x0b\xdc\x97\xb8\x07\xb6\xa9\xa3\x9e\x14\x69\xd9\xb8\x0c\xba\x1f\x40\xe2\x2b\x55\xa4\x4b\x69\x1b\xd6\xbe\xf7\xb7\xfb\x03\xef\x33\xea\xfd\x9e\xc2\x38\x69\x3b\x21\x92\x39\xb8\x54\xfa\x08\x0c\x4c\xc5\x46\x8f\x08\x7e\xa3\xd5\x86\x1b\x0c\x12\xfb\xda\x41\x89\x5b\xcc\x41\xa3\xdb\x7d\x9a\xa3\x57\xca\x4b\xee\xdf\xa3\x65\x57\xc3\x3c\x94\x2e\x7d\x55\xf3\xaf\xef\x9f\x7e\xf7\xec\xfb\x1f\x32\x4f\x21\xf3\x3e\x83\x92\x8b\x9c\x7f\xd2\x65\x57\x87\x55\x5e\x83\x84\xff\x84\x02\x2d\x54\x01\x97\xaa\xf5\x78\x58\x31\xcb\x72\x30\xca\x1f\xcd\x08\x00\xd4\x56\xd2\x79\x18\x28\x9b\x4e\xae\x4d\x11\xf6\x9e\x60\x1c\xca\xcf\x1d\x76\x98\xe4\xc7\xc6\xbf\xe3\xd2\xfe\xfb\x85\xd6\x6c\x17\xec\x5f\x76\xf5\xfb\xb9\xfc\x90\x05\xb5\x7c\xa1\x13\x99\x2d\xa7\xbb\x16\x74\x1b\xaa\x81\xc5\x22\x14\x48\xf3\x20\x78\x39\xca\x46\x8f\xe9\xe2\x6e\x51\x92\x1d\xb1\xf2\x08\xfd\x77\xf8\xb8\x5c\x76\x6a\xd1\x4b\xf7\xd9\x1b\x83\x5a\x17\xee\x3d\xcd\x8e\x0c\x0a\x27\x5c\x5c\xc9\x5b\xb5\xee\x85\xec\xfd\xef\x3e\x8b\x43\xa5\xd4\x67\xb6\xf9\xe4\xb0\x8f\x94\x23\xca\x7d\x16\x72\xc7\x5a\xaa\xad\x1c\x37\x77\x57\x9b\x56\x9c\xa4\x0e\x6d\x43\x21\x61\xfa\x34\x61\xfa\x6c\x8b\x5c\xf7\xed\x04\xf1\xe3\xc6\x85\x59\x0e\x82\xaf\x7d\x62\x78\xa0\x1d\x85\x4a\x51\x86\xb9\x39\xb4\xa7\xdb\x86\x97\x0d\xc9\xf8\xc6\x42\xc5\x99\x20\x56\xa5\x92\x12\x1d\xd2\x1b\x20\x8c\xd6\xf0\xab\x6b\x84\xff\x7b\x9d\x03\x37\x44\xe9\xba\x4c\xac\x0e\x37\xad\xd5\x6a\xa5\x29\x7d\x8c\x12\x56\x67\x10\xb8\xd3\x4d\x20\xbb\xa5\x67\x5f\x33\x34\x08\xa5\xe0\x28\x2d\x10\xf8\x81\xaa\xa1\xd4\xbb\xd6\xaa\x99\x15\x06\x54\x67\x03\x52\x3c\xe8\xa0\x54\xdb\x49\x47\x4c\x81\xf7\x19\x9e\x06\x80\xcf\x60\xa9\x94\x4b\xde\x1f\x73\x50\x6b\x8a\x20\x6d\x8b\xf4\xe9\xb4\x77\x3c\x54\x1f\x6a\x1d\x0e\x63\x4a\x40\xbe\x64\x13\x31\xde\x2c\x6e\x26\x05\x50\x67\xa8\x22\x1b\xbc\x5c\xc0\xd5\x90\xa4\xcd\xa1\xe5\x26\xf6\x7d\xd7\x3d\x74\xdc\xe4\x87\x69\xda\x27\x37\x74\xd2\xd9\x0c\x02\xe5\xca\x36\xa1\xf6\x23\x77\xa1\xbc\xe5\x5a\x49\x92\x7b\x90\xc0\x6d\x11\xcf\x66\xc4\xfe\x0f\x65\xd1\x6b\xb8\x0c\xa5\x83\x6b\xc6\x94\x14\x3b\x60\x42\xa8\xed\x64\x00\x30\x95\x7a\x8b\x1a\x7e\xbb\xb9\x79\x33\xfb\xde\xb5\x93\xb8\x45\x1d\xba\xdd\x23\x9f\xf8\x7e\xf7\x7e\xa8\x94\x2d\x1c\xbb\xf5\xe0\xb1\x74\x7a\x28\xe9\xd3\xb7\xc1\x01\xf9\xb4\xed\x24\xbf\xd0\x41\x0d\x78\xa7\x7b\x0e\x57\xf2\x8d\x56\x94\xd5\x88\x55\xf6\x9c\x0e\x73\x34\x1b\xf0\xfb\x5c\x23\xb1\x8f\xa3\x90\x2c\x69\xff\x51\x84\xff\xe6\x57\x42\x8c\x67\x71\x44\xcd\xff\x1a\x77\x39\xb8\xee\xcb\x6d\xd1\x4c\xae\x90\xdc\x52\x78\x6a\x27\x87\xe8\x3e\x06\xaa\x03\x51\xd8\x44\x04\xbd\xd0\xbe\x32\x6c\xa9\xb9\x48\xf2\x11\xf3\x43\x77\xa2\x5a\xeb\x01\xff\xd1\x0c\xe5\x0b\x8b\x64\xde\x97\x06\x9f\x8b\xdf\xdd\x17\x87\x2c\x41\x52\x58\x0d\x6f\x6e\xa5\xd4\x58\xa1\xb4\x9c\x09\x5a\x4d\x0c\xdb\xe0\xb9\xd2\x7c\xc5\x5d\x77\xbb\x8f\x5d\x3f\xed\x07\x0c\xe3\x59\xc4\xe9\x04\xe7\xa8\x8a\x4e\x32\x78\xf2\xc0\x60\xc6\x73\x5a\x7c\x75\x73\xef\x6c\x32\xfc\x7d\x62\xf8\x4a\x32\x91\x7c\x80\x85\x57\xc5\x6f\x0a\x5f\x5d\x2d\x3d\xca\x19\x0e\xe4\xc9\x7e\x6a\x56\x60\xe1\x6b\xf1\x2f\x5f\x26\x9f\xfe\x50\xf4\x34\x1f\x11\x87\x7a\xe7\x95\x0f\x9b\x0b\x1a\x5e\x9c\x9d\x3d\x52\xdf\xce\xfd\x1c\xe2\xa6\xc1\x3e\xcc\xb8\xe9\x03\xaf\x47\x43\x17\xfe\xa4\xe1\x21\x8b\xfa\x5a\xc5\x10\xce\x55\x5d\x89\x95\xe7\xc2\x65\xa9\x1d\x1a\x30\x21\x76\x0e\x0c\x5d\x1b\xee\xa3\x0d\x2b\xd0\xcc\x81\xb8\xa5\x01\x05\x95\x49\xc0\x25\xb0\xea\x96\x00\xbd\xe8\xfd\x43\x9c\x9d\x77\x46\x95\x51\x6f\xee\xe0\x44\x5f\xe7\x39\x32\x5f\x31\xc6\x51\x85\x35\xeb\x84\x25\x7b\x68\xe7\x10\x42\x7e\xba\xe9\x8a\x86\x17\x42\x4c\x58\xf1\x7a\x94\x40\xfb\x42\xe7\xf3\xa4\x31\xa4\xe1\xd3\x10\xc6\x7e\x42\xc3\xc4\x96\xed\x8c\x2f\xe9\x06\x5f\xe4\x64\xbb\xe8\x5c\x6f\xad\x64\x3f\x5b\x18\x95\x4e\x92\x8b\xbe\xd5\xdf\xc7\xa7\x72\x1e\xb4\x3e\xcc\xfb\x4c\x1b\x0a\xad\x69\x44\xfb\x48\xf3\xc3\x46\x07\xfb\xc5\xbb\xb7\xaf\x86\xa1\x45\x4e\x0d\x50\x16\xc7\xc3\x0c\x89\xf8\x1c\xcd\x88\x06\x18\x22\xf1\x7e\x2e\x72\x3a\x43\xca\xe2\x28\x9b\x68\x71\x3c\x34\xfa\xcb\x99\x91\x0f\x4f\x52\xdc\xa3\xc9\xfd\xde\xfb\xe4\x30\xf7\x69\x06\x4c\x0a\x06\x29\xfd\x92\x39\x93\x1c\x63\x87\x1d\x0e\x47\x4e\x99\x47\xa5\x4b\x69\x97\x4c\x2a\xc9\x4b\x26\xbc\x88\xff\xe1\x2e\x5d\xe3\x6e\x3a\xbe\x09\x8a\xbc\x2f\xd7\x2e\xf0\x1c\x3c\xa5\x87\x6f\x01\xa3\xa6\x7b\xf6\xe4\x3e\x5f\x3e\x1d\xa2\x89\x6e\x94\xb4\x3f\xfe\x90\x9e\xfb\xc9\x1b\x75\xcb\x62\xb8\x6c\x61\x80\x5e\xbc\x61\xda\xe0\x95\xb4\x41\x84\xb7\xb4\xef\x5b\x3c\xa7\x24\xcb\xe1\xbb\x67\x39\xfc\xf8\x43\xf6\xbc\x2f\x09\x87\x6b\x78\x24\x74\x01\xa5\x70\x1a\x39\x85\x46\x13\xa8\x3e\xe6\xdd\xd1\x5e\x9c\xc3\x59\x7f\xa2\x9e\xcb\xb5\x65\xb6\x33\xf3\x43\x8f\x75\x70\xbb\x71\x4b\xa3\x29\x17\x7c\x0b\x09\x24\xf0\x2d\xf8\x4d\x37\x78\x67\xd3\x07\x37\x90\x59\x59\x96\x8f\x04\x5c\xaa\x0a\xe7\x8f\x0a\x70\xf4\x9e\xdc\x1f\xd0\xa0\x8f\x77\x8e\x5f\x9a\x60\xd6\x1c\x26\xf6\x7b\x0a\x87\x72\x30\xfc\x9d\x8d\xe7\x52\xf7\xfe\x65\x3e\xd1\xc0\xc5\x52\x7f\xad\x56\x68\x3d\x29\xf9\xbd\xb4\x77\xf3\x03\x52\xde\x91\x7e\x1e\x8c\xe7\xfe\xc7\xcf\x1a\xa3\x80\x94\xf3\xc1\x7d\x9f\xfd\xf7\x9b\x57\xd7\x23\x45\xa0\x15\xcc\xd6\x4a\x6f\x6e\x5e\x5d\xbb\xec\xec\x88\xf6\xf3\xe1\x78\x2e\xce\x27\xa2\xc6\xd3\xbd\x7d\x5f\x2f\xff\xe5\x5c\xf3\xe4\xc0\x87\x19\x66\xbd\xb1\xbe\x4c\xaf\xd3\x44\xa2\x9d\xb9\x7e\x7e\x98\xaf\xd4\x8c\x0b\xac\xe6\xf0\x4f\xe3\x00\x82\x98\x1f\x6e\xf8\xdf\xd2\x2f\x8b\x47\x4a\x7c\x65\xd3\x68\xc8\x34\xcc\x2b\x8f\x40\xb0\x9f\xbb\x8e\x74\x1e\x46\x5a\xae\xba\xa7\x11\x48\x7c\xb8\xdc\x7e\x78\xea\xaf\xf9\xfc\xb8\xec\x71\xff\x30\xf2\xc8\x98\xf5\x04\x7c\x69\x52\xf5\xff\x01\x00\xaf\x3e\x9a\x37\x57\x1b\x00\x00"),
 		},
 		"/src/net/http/http.go": &vfsgen۰CompressedFileInfo{
 			name:             "http.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
-			uncompressedSize: 2998,
+			modTime:          time.Date(2026, 10, 15, 20, 12, 35, 998056791, time.UTC),
+			uncompressedSize: 1327,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x93\xc1\x6f\xea\x38\x10\xc6\xcf\xf8\xaf\x98\xcd\x61\x37\xe9\xd2\x70\xa7\xea\x61\xd5\xdd\x15\x95\xba\x55\x05\xac\xd4\xab\x49\x26\xc4\xc5\xb1\xdd\x99\x49\x29\xaa\xf8\xdf\x9f\x9c\x04\x1a\xfa\x7a\x78\x17\x24\x6c\xcf\x37\xf3\xfd\xbe\xc9\x6c\x06\x7f\x6e\x5a\x63\x4b\x78\x61\xa5\x82\x2e\x76\x7a\x8b\x50\x8b\x04\xa5\x4c\x13\x3c\x09\xa4\x6a\x92\x14\x74\x08\xe2\x67\x62\x39\x51\x93\x04\x89\x3c\x71\xa2\x32\xa5\x66\x33\xf8\x1b\x2b\xdd\x5a\x59\x93\x76\xdc\x15\xb4\x8c\x0c\x52\x23\xfc\x8b\x52\xd4\xf0\xd7\xd3\x3d\x98\x0a\xf4\x9b\x36\x56\x6f\x2c\x4e\xa1\xd2\xd6\x1a\xb7\x85\x8d\x2e\x76\x20\x3e\x8a\x3c\xff\xf7\xb0\x10\x09\x4b\x7c\x6d\x91\x25\x87\x7f\x8c\xd4\x48\xe0\xab\x28\xd4\x40\xa3\x0f\xb0\x41\x28\x7c\x13\x8c\xc5\x12\x7c\x2b\xb0\x37\x52\x77\x6d\xae\x59\xbb\x72\xe3\xdf\xa1\xb2\x7a\x9b\xab\x37\x4d\x3f\xcf\x74\x0b\x55\xeb\x8a\x34\x83\xa5\x6f\x5d\xb9\x26\x13\x02\x12\x7c\xa8\x89\xa9\x40\x60\x7e\x0b\x0e\xf7\xdd\xb8\xe7\x92\x34\xbb\x01\x81\xdf\x6e\xc1\x19\x1b\x1f\x4e\x08\xa5\x25\x07\xa2\x26\xc7\x8b\xb2\xe7\xc5\xf2\x17\x8b\x86\x3f\xce\x9f\xdf\x7f\x1c\xd5\x31\xed\x31\x8e\x4e\xc1\x70\x84\x58\xc2\xbe\x46\x07\x0e\x7b\x16\x9f\x34\x9d\xa7\x2f\xc0\xba\x63\x4d\x38\xa2\x1c\x35\x3d\x45\x40\x87\xee\xe6\x82\xdd\xe6\xf0\x0d\x39\xb8\x17\xf0\xce\x1e\x80\x91\xde\x90\x81\x7a\x6d\x1e\x22\x32\xee\x3a\x90\x2f\x90\xb9\x7f\x40\x1c\xe3\x71\x28\xb3\xb8\x2e\xdd\x8f\xc4\xec\x94\x1c\x02\x5e\xd8\x61\xa1\xb6\x88\x5e\x55\x0c\x01\xd2\xd1\xdd\x28\x90\x94\xf0\x15\xae\x06\x43\x19\xa4\x57\x4b\xe4\xe0\x1d\xe3\x14\xba\x85\xcb\x86\xb8\x08\x39\x4c\xc1\xef\xba\xe3\x98\x01\x9d\x14\xee\xdd\x53\x3f\x60\x94\xca\x6e\xc0\xef\xc6\x19\xf4\x75\x48\x74\x91\x85\xb1\x83\x3c\xe7\x8f\xb8\x4f\x93\x93\x9f\xf9\x99\xbb\xaf\x06\xf4\xdf\x63\xe7\x98\xd6\x19\x7b\x92\xa9\x63\x17\x67\xb0\x5a\x2a\x4f\xcd\xfa\x61\x05\x7d\xaf\xfe\x9b\x28\xbc\x73\x58\x88\xf1\x0e\x58\xb4\x60\x94\xa7\xc1\x68\x24\x0d\x8b\xf5\xfa\x69\xf5\xc9\xbe\xd1\x25\x46\xbd\x21\xb1\xcf\x25\xd0\xae\xfc\x3a\x8d\x9c\xa8\x72\x0e\xb1\xaf\x61\x70\xb8\xf5\x62\xb4\x60\x39\x28\x74\x5a\xe4\xf7\x1c\x9d\x11\x3c\xfa\x12\xf3\x17\x9e\xc2\xbe\x36\x45\x0d\xa5\x77\x7f\x08\xe0\x7b\xf0\x8c\x60\x84\x21\x68\xd2\x0d\x0a\x12\x4f\x81\x7d\xbf\x1e\xbd\x8d\x26\x58\x14\x2c\xa3\x5e\xad\x5d\xc9\xb5\xde\x61\x37\x54\xbc\xee\x17\x04\x9c\x6e\xb0\x5b\x3e\xc2\x38\x16\x96\x79\xbf\x01\x23\x38\x5f\x52\xbf\x12\xcb\xf9\xdd\x99\xd1\xaa\x43\x74\xca\xfd\x35\xff\x7f\xf9\x90\xaf\x8a\x1a\x1b\x8c\x1f\x59\x12\x83\xe2\x64\x1c\xb2\x33\x76\x1c\xef\xef\xdf\xc8\x7d\x2c\x4e\xe3\xde\x0d\x26\xe6\x20\xd4\xe2\x14\x56\xdd\xd4\x8f\xba\xc1\xf9\xb9\xdb\xc2\xb3\x44\x1b\x69\x76\x54\x47\xf5\x63\x00\x71\x04\x12\x1d\x2f\x05\x00\x00"),
+		},
+		"/src/net/http/httptest": &vfsgen۰DirInfo{
+			name:    "httptest",
+			modTime: time.Date(2026, 10, 15, 14, 14, 56, 652514187, time.UTC),
+		},
+		"/src/net/http/httptest/server.go": &vfsgen۰CompressedFileInfo{
+			name:             "server.go",
+			modTime:          time.Date(2026, 10, 15, 14, 14, 56, 652514187, time.UTC),
+			uncompressedSize: 2962,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x56\x6f\x8b\xdb\xc6\x13\x7e\xad\xfd\x14\x13\xc1\x2f\x48\x77\xca\xda\xf9\xd1\x50\xe2\xd6\x2f\x82\xd3\x90\x6b\xdc\xd6\xc4\x2e\x2d\x84\x50\x36\xd2\xc8\xda\x5a\xb7\xab\xee\xae\xec\x18\xe3\xef\x5e\x66\xd7\x92\xe5\xf8\x8e\x40\xe9\x8b\x1e\x07\x96\x56\x33\xcf\x3c\xf3\xcc\x1f\x69\x34\x82\xdb\x4f\xad\xac\x0b\xf8\xd3\x32\xd6\x88\x7c\x23\xd6\x08\x95\x73\x8d\x43\xeb\x18\x93\xf7\x8d\x36\x0e\x12\x16\xc5\xb9\xd9\x37\x4e\x8f\x5c\x6d\xe3\xf3\xdd\xe7\x17\xe3\x97\x74\x5b\xde\x3b\xfa\x51\xd8\xfd\x8c\x08\x63\x78\x3d\x92\xca\xa1\x51\xa2\xa6\x43\xbb\x57\x79\xcc\xa2\x3f\x20\x6e\x95\x15\x25\xc6\x30\x1a\xc1\x1b\x6d\x60\xad\x27\xb5\x54\x1b\x25\xee\x91\xb3\x94\xb1\xd1\x08\x56\x15\x1a\x04\x61\x10\x94\x86\x5a\x5a\x87\x4a\xaa\x35\x58\x9d\x6f\xd0\x59\x90\x0a\x5c\x85\xf0\xc9\xe8\x9d\x45\x93\x81\xd5\x60\xd1\x6c\xd1\x58\xef\x63\x70\x4d\x2e\x06\x0b\xc2\xda\x49\x57\x79\xf3\x1f\xc5\x56\x2c\x73\x23\x1b\x07\xce\x08\x65\x29\x4b\x0b\xba\x84\x8e\x6e\x06\xbb\x4a\xe6\x55\xc0\x02\x83\x7f\xb5\x68\x9d\x05\xa7\xc9\x5d\x1a\x02\x13\x45\x61\xd0\x12\x83\x67\x8d\xd1\x79\xb8\xb4\x0e\x45\x41\x40\x6b\x4d\x2c\x83\x3d\xa1\xee\xb4\xd9\x70\x4a\x68\x90\x62\xcf\xee\x4e\x2d\x02\xc2\xd2\x53\xef\x59\xf0\x47\x0c\x58\xd9\xaa\xfc\x31\xef\x84\x88\x81\x75\x46\xaa\x75\x06\x95\xaf\x26\x7f\x2b\x54\x51\xa3\x49\x21\x69\x55\xe7\x07\x84\x92\xa4\x41\x66\xd9\x81\xcc\xbd\xc4\x68\x40\x5a\xcf\xbd\xee\xee\x75\x09\x42\x0d\xb3\x0d\x3a\x73\xb8\x73\xa0\x90\x68\x8b\x3c\xc7\xc6\x59\x2f\x8e\xda\x43\xae\x95\xc2\xdc\x49\xad\x6c\x06\x42\x15\x70\x0e\x1d\xa0\x03\x00\xec\x2a\x54\x90\xd7\xda\x62\xc1\x99\xdb\x37\xf8\x00\x19\xeb\x4c\x9b\x3b\x38\xb0\xc8\x67\x17\xfe\x6e\x14\x3a\xbe\x9a\x2d\x5e\x15\x85\x61\x51\x40\xf0\x0f\xf2\x4a\xa8\x93\xcb\xe1\x78\x7a\xf2\x8b\xca\x11\x80\x3a\x8f\xd3\x25\x8b\xae\x84\x60\x47\xaf\x44\x2d\xac\xeb\x25\x5d\x50\xfb\x9f\x94\xf0\xa3\xa0\xcb\xa0\x8a\xb0\xee\x5a\x8b\x8c\x0a\xbe\x96\x5b\x04\x14\x79\x45\x60\xa7\x14\x05\x14\xd2\x3a\xa9\x72\xd7\xb5\x0d\x67\x5b\x61\x1e\x88\x35\x85\x31\x0b\xe5\x55\xb8\x9b\xeb\x5c\xd4\x9d\x06\x49\x4a\x7d\xc1\x7b\x49\x0e\x2c\xba\x72\xbf\xbd\x65\x91\x41\xd7\x1a\x05\x4f\xaf\x44\x3c\xb0\xc8\xab\x37\x01\x80\xa7\x03\xe9\x0e\x77\x8b\x89\x87\xbe\x5b\x6c\xbf\x49\x9e\xff\xff\xdb\x0c\xc6\xfe\xff\x79\x9a\x01\xa1\x4e\xae\x79\x1e\x33\x16\x9d\x14\x9f\xc0\xbd\xd8\x60\x72\xa1\x79\x9a\xb1\xe8\x48\x7a\xfa\x4c\x92\x1a\x6e\xae\xd8\xa4\xf0\xca\xb7\x4b\x92\x42\x42\xc1\x67\x5a\xa9\x0c\xd0\x18\x6d\x52\xca\xed\xfb\x67\x35\x0f\x01\xfa\x94\x94\xac\x33\x4f\xf4\x07\x63\x66\xe1\xd1\x57\x42\x78\xab\x24\x0d\xb0\x5e\x31\xde\x37\x03\x7f\xad\x93\x50\x78\x7a\x10\x92\x49\xba\x98\x29\x8b\x22\x59\x42\xcd\x07\x5d\xf2\x64\x4a\x0c\xbc\x71\x34\x7c\x90\x90\xf1\x91\x45\xc7\x74\xc8\xf4\xab\xd9\x17\x45\x57\x52\xba\x24\xd8\x93\x73\xcd\xa9\x4a\x67\x7f\x0b\x37\x61\xb2\x53\x58\x3a\x61\x5c\x20\x2c\x4b\xb0\xfc\xd7\xf7\x73\xa2\x15\xc7\x9e\x55\x23\x94\xcc\x93\xf8\xb4\x44\x44\x6d\x50\x14\x7b\xb0\xe4\x83\x45\x9c\x52\x49\x82\x5b\x5e\x4b\x54\x0e\xa6\xe7\x84\xce\x67\xf0\xd4\x2f\x8c\x99\xbf\x3d\xac\xba\xed\x38\x09\x7b\xe4\x35\x96\xa2\xad\x5d\x7f\x7c\xf4\xa0\x81\xc8\x14\x62\xb2\x99\x8c\x46\x31\xdc\x82\xed\x3b\x95\x87\x54\xf9\xd2\xaf\x24\x52\xcb\x72\x4f\xaa\xef\xa8\x7e\xf6\x7c\x7e\xab\xf9\x12\x2c\xed\xf6\xb6\xf1\xb3\x96\xa3\x71\xb2\x94\xb9\x70\xd8\x8d\xdf\x69\xac\x6a\xb9\x41\x68\x1b\xeb\x0c\x8a\xfb\x0c\x3e\xb5\xae\x5f\xd5\x84\xe6\x34\x48\xe7\x5f\x03\xde\xbe\x18\x0e\xac\x77\x2d\xb5\x09\x21\x33\xff\x6a\xd0\xad\x03\x01\x14\xbe\x12\xaa\xb0\x95\xd8\x20\x7f\xa4\x06\xab\xf9\xf2\xbf\x57\x06\x12\xca\x8f\x10\x4c\xa6\xe0\x6a\xcb\x7f\x7f\x31\x7e\xf9\x0e\xf7\x0b\x21\x4d\xd2\xbd\x7f\xb9\x5f\x2a\x95\xb6\x6e\xe6\xcd\xaf\xcf\xdf\xe1\x3e\xf5\x0c\x09\x69\xd0\xf4\x21\xaf\xf2\xde\xf1\x65\x63\xa4\x72\x65\x12\x77\x5f\x0a\x13\xf8\x19\x77\xab\xf9\x32\xe4\x3c\x81\xff\x6d\x63\x4f\x24\xf5\xd9\xb2\x08\x3f\xfb\xf5\xb7\x9e\x69\x55\xca\x35\xd1\xb3\x7c\x35\x5f\x86\x28\x97\xcf\x9e\x0c\xd5\xa0\x5a\x4c\xbf\xb0\xe0\xb3\x5a\x2b\xa4\x36\x3a\x02\xd6\x16\x2f\x4c\x15\xee\x12\xca\x3c\x98\xf6\x5a\xd7\xa8\x12\x6f\xc2\x67\xe7\x66\xb2\x29\x49\x3f\x3e\xfb\x5f\x3c\x84\x29\x7c\xf8\xe8\xa1\xce\x87\x07\x52\xb8\x6b\xf9\x41\x5b\x06\xd1\xa7\x40\x9f\x43\x7c\x21\x8c\xc5\x81\xd3\x03\x81\x3f\x8c\x3f\x0e\xef\x3f\x8c\x3f\xfe\xbb\x82\x5f\x0e\xa4\xfd\xc7\x13\x79\xd5\xfb\x5f\x1a\x11\xcd\xc1\x86\x9c\x4c\x1f\xfd\x28\x79\x3c\x78\x76\xf1\x85\xf2\x86\x36\xb2\x5f\xcb\xbb\x70\xfe\x1e\x6d\xa3\x95\xc5\xdf\x8c\x74\xf4\x86\x35\x70\x73\x3a\xf7\x83\x1e\x96\x77\x15\x5a\xea\xd4\x20\x27\xa8\xb0\xc2\xab\xe1\x7c\x45\x15\x4c\x2f\x26\xc8\xb3\xfb\xa9\xfd\x1c\x36\x78\x54\x71\x7f\xf0\x76\xb5\x5a\x24\xbb\x0c\x0c\xa9\x99\x86\xda\xd4\x19\xe8\x4d\x08\xd3\x67\x92\x3c\xb0\xd8\xbf\x23\x33\x8a\x75\xf1\xee\x98\x0e\x3e\x7e\x4e\x15\xda\x19\xd1\x04\xe9\xd7\xda\x47\xf5\x9a\xff\x1d\x00\x00\xff\xff\x32\xcd\x3a\xfe\x92\x0b\x00\x00"),
+		},
+		"/src/net/http/inprocess.go": &vfsgen۰CompressedFileInfo{
+			name:             "inprocess.go",
+			modTime:          time.Date(2026, 10, 15, 14, 15, 21, 581647989, time.UTC),
+			uncompressedSize: 6242,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x58\xdf\x6f\xdb\x38\xf2\x7f\x96\xfe\x8a\x59\x03\x9b\xaf\xb4\x55\xe4\xfa\x8b\xc5\x1e\xe0\xab\x1f\xfa\xf3\xd2\xbb\x76\xb7\x48\x52\xf4\xa1\x28\x0e\xb4\x34\xb6\xb8\x51\x48\x97\xa4\xa2\x1a\xd9\xfc\xef\x87\x19\x92\x92\xec\x34\x6d\x0f\x97\x87\xd6\x26\x87\xc3\x99\xcf\xcc\x7c\x66\xe8\xf9\x1c\x1e\xad\x3b\xd9\xd6\xf0\xa7\x4d\xd3\x9d\xa8\xae\xc4\x16\xa1\x71\x6e\x97\xa6\xf2\x7a\xa7\x8d\x83\x2c\x4d\x66\xeb\xbd\x43\x3b\x4b\x93\x59\xa5\x95\xc3\x2f\x8e\x3e\xa2\x31\xda\xf0\xa2\xd4\xf4\x6f\xab\xb7\xf4\x9f\x42\x37\xef\x4c\x4b\x1f\xad\x33\x95\x56\x37\xfc\x71\xaf\xaa\x59\x9a\xa7\xe9\x7c\x0e\x52\xbd\x33\xba\x42\x6b\x2f\xd0\xdc\xa0\xb1\x70\x2d\x76\x16\x1a\x6d\xdd\x92\x2f\x14\x75\x6d\xd0\x5a\xb4\xe0\x34\x34\x42\xd5\x2d\x09\x59\x34\x37\x52\x6d\xc1\xe0\xe7\x0e\xad\xa3\x4d\x52\xe6\x1a\xbc\x86\x5e\xba\x46\x77\x0e\x84\xda\x83\x42\xd7\x6b\x73\x05\xa2\xa2\x2b\x4a\xb8\x6c\x70\x0f\xc2\x20\x18\xdc\x4a\xeb\xd0\x60\x0d\xeb\x3d\x1d\x25\x4b\xc9\x53\xfe\xc7\xa1\x75\xa5\x37\xa8\x00\x2b\x55\x85\xa4\xda\x20\x1f\x55\x1a\x5a\x3a\xab\xc8\x00\xab\xab\x2b\x74\x16\xa4\x22\x09\x52\xb4\x36\xba\xb7\x68\xca\xf4\x46\x98\xfb\xde\xad\xc8\xbf\x8f\xd6\x19\xa9\xb6\x9f\xce\xbc\x3b\xb7\x77\x8c\x44\x34\xe9\xf5\xe1\x19\xb8\x16\x57\xe4\x7d\x83\xf0\x4f\x71\x23\x2e\x2a\x23\x77\x0e\x9c\x11\xca\x12\x40\x1e\x0b\x3c\x46\x82\x60\x63\x24\xa0\x81\x4e\x39\xd9\x42\xa7\xe2\x05\x20\x2d\x54\xa2\x6d\xb1\x2e\xe1\xb5\xa3\x6f\x9d\x65\x1c\xee\x83\xe0\x41\x35\xba\xdb\x36\xb0\xd5\xcb\x56\xaa\x2b\x25\xae\xb1\x4c\x37\x9d\xaa\x1e\xb2\x38\xe3\xcb\xbd\x8f\x05\x34\x10\xdc\xcc\x21\x9b\xd8\x40\x0a\xb2\x3c\x87\xdb\x34\x39\x06\xe9\x23\x9d\xff\x04\x2b\x68\xd2\xc4\xa0\xeb\x8c\x0a\xd2\x70\x0b\x35\xb6\xe8\x30\x3b\x3e\x52\xb0\xc3\x39\xdc\xa5\x01\x4b\xdd\xa9\xfa\xd2\xc8\xdd\x60\x9a\x87\xc9\x12\x4e\x1e\x17\x02\x54\xaa\xd3\xdd\x74\xdb\x4c\xf3\x62\xa3\x0d\x27\xa8\xb3\x31\x09\x19\x2e\x83\x1e\xf6\x8d\x68\x2d\x82\xdc\x84\xcc\x90\x96\x12\xc3\x76\x55\x13\x54\x15\x94\x13\x7d\x23\xab\x06\x2a\x61\x31\xa6\x47\x88\x13\x5c\x77\xd6\xc1\x56\x53\x56\x93\x25\x21\x51\x23\xb0\xf7\xcc\xcf\xc8\xee\x5f\xce\xfd\xe1\x1c\xb2\x5f\xce\xd1\xee\xb4\xb2\x58\xc0\x5a\xeb\xb6\x00\x2e\x40\x86\xb3\x29\x40\x5f\xc1\x72\x75\x2f\xf9\x3e\x1a\xfc\x5c\xbe\x3f\x7f\x53\x9e\x69\xeb\x3e\xa5\x89\xdc\xc0\x4f\xfa\x8a\x8e\x44\x98\x95\x6c\x0b\xef\x58\x41\x9f\xd3\xe4\x8e\x22\x60\x77\xac\x9e\x54\xb2\x6b\xa3\x51\x4d\x41\xfe\xe4\x43\x98\xbc\xac\x33\x1d\xf2\x09\x0a\x06\xfb\x73\x7c\x2c\xa6\x04\x1f\x7f\xc0\xad\xd1\xa1\xf9\x1c\x9e\x31\x2b\x11\x50\x11\x3f\xe1\x4b\x22\x84\xad\xd7\x5d\x5b\x43\x23\x6e\x10\x76\xc2\x50\x36\x4b\x07\x7a\xc3\xc1\x81\x5e\x1a\x2c\xd3\xa4\x72\x5f\x0a\xa8\x84\xaa\xb0\x25\x57\x02\x79\x95\x1f\xa4\x6b\x9e\xf3\x2a\x61\x5c\x3e\xf7\xcb\x59\x9e\xa7\x89\x25\xeb\x96\x2b\xe0\xf5\x56\x2b\xcc\x2a\xf7\x25\xac\x97\xc1\xe8\xf7\xe7\xaf\xc1\x4b\x10\xb2\xe3\x62\x96\xa7\x49\x37\xe0\xd6\x99\xb6\x7c\x47\x86\x4d\x04\x8e\xb4\xe4\x1c\x10\x92\xff\x69\x45\xe0\x73\x5c\xbc\xb9\xa4\x8b\x36\xe9\xc0\x33\x5d\xef\xa7\x12\x49\x5c\x24\x0b\x2d\x66\x39\xcc\xe7\x70\x1e\xf3\xc7\xa7\x99\x68\x7b\xb1\xb7\x50\x91\x00\x23\xb2\xd6\xf5\x9e\xf2\xb3\x6a\xbb\x9a\x58\x4c\x2b\x0f\xb7\x2d\xd3\x84\x62\x7e\x90\x0f\x14\x48\x5a\xb4\xc1\x49\x58\x41\xc7\xb6\xf2\x02\xe5\x12\xac\x56\x30\x9b\xb1\x39\x93\x35\x98\xa6\xdb\xa8\xe1\x9d\xd1\x4e\x17\x30\x7e\x7e\x2b\xfe\xd4\xe6\x60\x41\x2a\x6d\x60\x05\xb3\xb3\xcb\xcb\x77\xf3\x45\xb9\x98\x15\xb0\x28\x60\x31\x00\x7f\xad\x1d\x3e\x25\x8e\x59\xc1\x6c\xf1\xff\x7f\x2b\x1f\x97\x8f\xcb\xc5\x72\x31\x0b\x02\x0c\x04\xac\x7c\x22\x8f\x96\x32\x72\xab\x11\xb9\xc9\x22\xfc\xae\xe9\x03\x19\x99\x26\x3d\x05\xec\x64\x28\x9e\x98\x91\x1f\x8c\x74\x68\x7c\xb1\x7c\x5e\x02\xfd\x19\xfc\x5c\xa4\x49\xd2\xa0\xa8\xd1\x2c\x81\x99\x3a\x3b\xe3\x6f\x39\x6d\x10\xcc\x2c\x39\x6a\xa3\x6b\x6e\x7b\x71\x85\x4b\x2f\x5d\x35\x42\x11\x55\x76\x95\xbb\xbd\x2b\x60\x91\xdf\x15\x7c\x03\xdd\x59\x4f\x65\x26\x95\xb1\x60\xe5\x1b\x21\x5b\xac\xe3\xad\x2c\xc3\x31\x0c\xfb\x77\x69\xb2\xd5\x03\x6f\xa6\x49\x52\xe3\x06\x0d\x4c\x12\xca\x2f\x4c\x24\x62\xfe\x71\xc6\x57\x9a\x98\x3c\xff\xfb\x71\x46\x26\x93\x2c\x7d\x69\xcc\xd3\xb5\x36\x2e\x54\x73\xd8\x4f\x5a\xbd\x2d\xdf\x19\xa9\xdc\x26\x9b\x51\x23\x59\xc2\x4e\x28\x59\x0d\x4d\x7b\xc2\xba\xb1\x9a\x9d\x86\x9f\xed\x12\x7e\xbe\x99\x15\x07\x69\xc3\xd9\x97\xb3\xda\x3b\xfe\x77\x3e\xa7\x1e\x7e\x58\xf6\x63\x5e\x57\x5a\x29\xac\x9c\xd4\xaa\x08\xd4\xcb\xab\xad\x44\xe5\xc0\x22\xda\xa8\x43\x58\x10\x0a\x3a\x85\x5f\x76\x58\x39\xac\x01\x55\x0d\x7a\x13\x18\xc6\x03\x5d\x46\x6f\x7f\xea\xcb\x10\x10\xac\xa3\x8f\x7d\xe9\xf1\x87\x27\xa7\x20\x75\xf9\xd2\x98\xf7\x83\xb2\x97\x7f\xbc\x9a\x58\xdc\x97\x94\x06\xe5\x46\x2a\x69\x9b\xec\x2b\xb2\xde\x3d\x5f\x71\x69\x3c\xd6\xc7\x03\xb4\x7b\xf7\x4d\x02\xf0\x71\x3c\xa6\x81\x50\xc8\x8d\x1f\x63\xa8\x92\xb2\xde\x17\x59\x9e\xb2\xc2\x34\xb1\xd8\x62\xe5\x48\x09\x37\x28\xf2\x91\x62\xff\xe4\x74\xf0\x77\x39\x52\x81\xa7\x76\x6e\x0a\x2c\x1d\x12\x85\x84\x43\x26\x7e\x8d\x36\x58\xf4\xc9\xe9\x01\xb1\x96\x2f\x88\x49\xf3\x63\xf9\x43\x99\x97\xc6\x90\x0f\xb1\x9f\x3f\x50\x8d\xb0\xee\x36\x1b\x1a\xab\xa6\x71\xa3\x38\x0a\x35\xcd\xb2\x30\x36\xf2\xf8\xe7\x7b\x70\x90\x94\x7e\x0f\xeb\xd8\x86\x43\xaa\xe8\x30\xf1\xc5\x93\xe0\x0d\xb5\xa0\x0d\x6c\xda\xce\x36\x68\x41\xba\x32\x75\xfb\x1d\x3e\x68\x9b\xaf\x6a\x82\x97\xda\x48\xfc\x8b\xcd\x2e\x0d\xb4\xe1\x57\x3d\x69\xa4\x49\x6f\xb4\x43\xff\x85\xfb\x7a\x9a\x58\x27\x5c\x67\xbd\x94\x54\x8e\xa2\xa6\x5c\x90\x08\xc7\x88\xf1\x2f\x94\xd8\xd9\x46\x53\xd3\x83\xa0\xb8\x6f\x50\x01\xdb\x12\xc4\x7a\x31\xcc\x7d\x29\x73\xd3\x60\xd3\x01\x3d\xa5\xc9\x98\xed\x10\xac\x08\x2b\x2c\x7d\x48\x47\x69\x20\x22\x18\xf7\x98\x86\x86\xde\x9f\xf5\x13\xfd\x87\x10\xe5\xc1\x81\x2c\x7e\xf0\x60\x71\x4e\xf4\xa5\x77\xe3\xc7\xf4\x4c\xdc\xcc\x2a\x5d\x53\x50\x9c\x9f\x2d\x37\xd0\x97\x53\x54\xc7\x69\x87\x39\xf2\x70\x73\xc5\xb3\x0b\xad\x06\xd8\x69\x4c\xa8\xfd\xc2\x08\xfb\x6a\x30\x2e\x4c\x05\xf9\x7f\x61\x64\xb6\x83\x8f\x9f\xe8\x05\x95\x43\x26\x95\x9b\xce\x39\x9e\x6a\x8e\x8d\x65\x0f\xc2\x75\xff\x40\x97\xcd\xb8\x48\x94\x3b\xbd\xdc\xef\x70\x96\x4f\x7a\x6f\x32\xc8\x5d\xdc\x93\x2b\xe0\x05\x3a\xac\x5c\x58\xa4\xb5\x6c\x97\x47\x96\xe8\xcb\x29\x80\x17\xec\xfb\x1f\xff\xe2\xfa\xf3\xf7\x53\x71\xbe\x45\xd7\xe8\x9a\xef\x3b\x7b\xf9\xf4\xc5\x6c\x3a\x38\xb6\xa8\xb2\x5d\x3e\x1d\x1a\x43\x10\x99\xfb\x7a\xef\xf8\x0f\xc2\xf4\x8a\x0a\xcc\xb7\xa4\x07\xed\xe2\x78\xd4\xf1\x64\x76\xba\xc8\x03\x51\x78\xd6\x84\x4a\x5f\xef\xe8\xa1\x70\xcc\x0c\xc7\x75\xdd\x08\x1b\x6a\x9b\x8a\xe2\xfb\xb6\x45\x52\xfe\xa6\x71\xc7\x2d\xe3\xe4\x04\xa6\xf9\x73\x18\xc5\x37\xa8\xb6\xae\x19\xe2\x78\x72\xc2\xc3\xd9\xd3\xb6\xd5\x3d\xd6\xaf\xb4\xf1\x7a\xb3\x98\x91\xbe\x53\xcf\xe7\xf0\x46\x5e\xe1\x64\x02\xe6\x07\x10\x7f\x6f\x59\x21\xf1\x40\x74\x9b\x40\x10\x8e\xdf\xae\x14\x09\x87\x44\x8e\x5e\xcb\xa6\x6b\x5b\x58\xe3\x46\xd3\xdb\x56\xed\xe9\x94\xe4\x17\x21\x99\x5b\x72\x66\x4c\x0c\xbf\xf8\x8a\xe1\x05\x84\x87\x7d\xf9\xda\x69\x91\x85\x88\xaf\xbb\x4d\xf9\x06\x55\x96\xe7\x79\x28\xb3\x83\x78\x49\xe5\x7e\xfb\xf5\xab\xb2\xf7\xbb\x25\x35\xc8\x1f\xca\x9b\x83\x1b\x2a\x6f\xa5\x37\x12\xf8\xbe\x91\x0f\x0e\xbb\xf9\x01\x1b\x8c\x5b\x91\x0b\xe4\x06\xaa\x76\x7c\x00\x05\x67\x79\x98\x7f\xad\x5c\xf6\xfd\xc8\x16\xb0\x78\x5c\xc0\x6f\xbf\x86\x59\x6a\x32\x81\x1e\x1a\xb9\x82\xca\x17\xcf\x8d\x30\x9c\x04\x34\x58\x9c\xa3\xa8\xb9\xa3\x7b\xda\x59\x33\x3f\x3f\x5c\x92\x7f\xfd\x05\x47\x4a\x57\xf0\x98\xef\x5a\x1f\x0f\xba\xa3\xb3\x34\xc3\x9c\x44\xe4\x48\xd6\xe7\xdc\x32\xb6\x87\xa3\x00\xc7\x44\x7c\x04\x33\x98\xc1\x23\xf0\xd2\x97\xd4\xb7\x87\xcd\x62\xd0\xf2\x5c\xd7\xc8\x9a\xe2\x1e\x6d\xf1\x98\x3f\xe8\x9f\x0e\xfa\x71\x93\x1f\x05\x2c\xb1\x18\xd7\xe8\x5d\x30\xae\x9d\xc5\xb1\xdb\xff\x4d\x03\x41\xdb\xcf\xe2\xf0\xed\xff\xf8\xc9\x93\x26\xc9\xf3\x29\x3c\xcb\x43\xb4\x68\x3f\xb4\xe7\xe5\xa0\xd5\x0f\xf9\xf7\x06\x11\x1e\xc3\xa4\x1d\x9e\x53\xf7\xa7\x8e\x61\x8e\xf4\xc4\x6f\xb9\x00\xfd\xc0\x82\x75\x41\xca\xac\xf6\x95\x39\xfc\xac\x55\x6b\xf5\x7f\x0e\xd6\xad\xae\xae\xe8\x4d\xe6\xa7\x91\x50\xbe\x7e\xcf\xa0\xa8\x87\x3b\x8f\x07\x10\xb6\x69\x1c\x3b\xae\x3b\x1f\xbe\xbd\xaa\xca\xb7\x9d\xc3\x2f\x69\xb2\xee\x36\x0c\xc6\xde\xa1\x2d\x9f\xb1\x2d\x69\x42\x59\x09\xe0\x1b\xd1\x80\x17\xbd\x22\x03\x31\x7a\xda\xa4\xa3\xd2\x42\x6d\x84\x54\xf4\xee\xf0\xb5\xe9\xa7\x8c\x29\xa5\x4a\x76\x83\x1f\xdc\x94\xb7\x35\x4f\x10\x30\xd5\xfb\xa1\x41\xd7\xa0\x99\x0e\x5c\x41\x74\x74\x2c\xa1\x37\x52\x1c\x28\xe2\xfb\x88\x07\x1d\xb9\x55\x82\xe6\x0d\xbe\x98\x8c\xd2\x86\xeb\x8a\x24\xb7\x68\x4b\x0a\x14\x55\x10\x9a\xf1\x77\x29\x02\xe6\xb9\xbf\x62\x15\x5f\xba\xbf\x63\x1f\x9f\x27\x0c\x2a\xe3\xcd\x22\x43\xbb\x20\x53\x66\x79\xe4\x9e\xf5\xd1\x9c\x94\x43\xff\x9d\x86\xbe\x2e\xaf\xbb\xf2\x8d\xae\xae\x68\x94\xf5\x63\x3a\x2f\xbd\x57\x6d\x58\xa4\x56\xb1\x2e\xc3\xbd\x0f\x31\x7b\x2d\x6d\x25\x0c\x79\x2c\xdc\x14\xb4\x7e\xcc\x08\xa1\xf6\xd7\x9a\x7f\xe5\x48\xd6\x4c\xa8\x1f\x62\xcb\xa5\x4a\x5f\x97\x96\x51\xcb\xc6\x5f\x6a\xa6\x0d\xfb\xee\x61\x0f\x03\x0f\x13\xbe\xff\x93\x5b\xeb\x92\xa9\x6f\xf8\x2d\x61\x62\xd1\x37\x6e\x8f\x32\xa4\xe2\xe8\xb1\xb2\x2e\x39\x41\x9e\x9c\x0e\xc9\x71\x7b\xb7\x64\x6b\x44\xd7\xba\x65\x28\xd8\x87\x14\x13\xab\x7e\x23\x6e\x1b\x6d\x82\xd5\x13\x47\xc9\xa9\xf5\xd8\xac\xe8\x09\xe6\x99\x35\x51\x05\xfc\x9b\x7a\x83\xdf\xf5\xba\xf9\x51\x77\x84\xca\xf8\xde\x09\x83\x12\xcf\x5e\xa1\xb1\x30\x42\xe9\xfd\x33\xf7\x7f\x13\x8a\x5a\x1e\x87\x37\x16\x6b\x79\x72\xea\x11\xf9\x8e\xe3\xf1\x37\x22\x5f\xeb\x3f\x14\xcb\x21\x8e\xb1\x21\x46\x37\x2d\x3a\xbf\x3f\x84\xf6\x2b\xf5\x96\x4e\xde\x78\xe9\x5d\xfa\x9f\x00\x00\x00\xff\xff\xee\x2b\xc7\xcf\x62\x18\x00\x00"),
+		},
+		"/src/net/http/pprof": &vfsgen۰DirInfo{
+			name:    "pprof",
+			modTime: time.Date(2026, 10, 15, 16, 19, 21, 936513801, time.UTC),
+		},
+		"/src/net/http/pprof/pprof.go": &vfsgen۰CompressedFileInfo{
+			name:             "pprof.go",
+			modTime:          time.Date(2026, 10, 15, 16, 19, 21, 940136348, time.UTC),
+			uncompressedSize: 369,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\xce\xb1\x4e\xf4\x40\x0c\x04\xe0\xfa\xfc\x14\xa3\x6b\xfe\x8b\xfe\x53\xb6\xa7\x46\x80\xae\xbd\x07\x40\xce\xc6\x49\x96\x4b\xec\x95\x77\x83\x90\x10\xef\x8e\x02\x14\x14\x11\xb5\x67\x3e\x4f\x08\xf8\xdf\xad\x69\xee\xf1\x52\x88\x32\xc7\x1b\x8f\x82\x9c\xdd\x06\xa2\xb4\x64\xf3\x8a\x13\x1d\x9e\x71\x5c\xb5\xf0\x20\x47\x84\x80\x07\x73\x8c\x76\x37\x27\xbd\x29\x2f\xd2\x52\x43\x14\x02\x1e\x2d\x4f\xe2\x97\x2b\xb2\xdb\xe8\xbc\x14\x44\xd6\x7f\x15\x73\x2a\x55\x14\x83\x39\xa2\xa9\x4a\xac\xc9\xb4\x9c\x51\x0c\x75\x12\x84\x5e\xba\x75\x0c\x5f\x3f\xc3\xe6\x4c\xac\xfd\x2c\x5e\xc0\x2e\x58\xb8\x17\xb8\x70\x9c\xb8\x9b\x05\x83\xdb\x82\x0b\xbf\xf2\x35\x7a\xca\x15\x49\x4b\x15\xee\xcf\x28\x22\x5b\x57\xa5\x86\xa9\xd6\xdc\xca\x5b\xb6\x22\xf7\x1b\xfd\xf4\xe3\xb5\xdb\xca\x5f\xbb\xb1\x93\xf9\x13\xa0\x61\xd5\xb8\xd7\x3a\x35\xf4\x7d\x4b\x9a\xea\xa9\xc1\x3b\x1d\xf6\x53\x1f\xf4\x19\x00\x00\xff\xff\x61\x34\x53\x3f\x71\x01\x00\x00"),
+		},
+		"/src/net/http/sandbox_fetch.go": &vfsgen۰CompressedFileInfo{
+			name:             "sandbox_fetch.go",
+			modTime:          time.Date(2026, 10, 15, 20, 12, 35, 998637966, time.UTC),
+			uncompressedSize: 317,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\xce\xcd\x6a\xeb\x30\x10\xc5\xf1\xbd\x9e\xe2\xec\x6e\x72\xeb\xc6\xfb\x42\x17\xdd\x14\xb2\x2b\xc1\x7b\x63\x4b\x63\x4b\xb1\x32\xa3\x48\x23\xd2\x52\xf2\xee\x25\x21\xfd\x82\xee\x67\x7e\xff\xd3\xb6\xb8\x1b\x6b\x88\x0e\xfb\xd2\xcc\x92\x3c\xe5\x7d\xe9\xcb\xc0\x6e\x94\xd7\x9e\x49\x7b\x16\x26\x63\xd2\x60\x97\x61\x26\x78\xd5\x64\x4c\xdb\xa2\xf3\x84\x67\x52\xeb\xf1\xf4\xb2\x85\xe6\x81\x4b\x92\xac\x08\x05\x56\x0e\x29\x44\x72\x90\xaa\x18\xdf\x70\x7f\xe3\x1e\x99\xf4\xe1\xc2\x6d\xcc\x54\xd9\x82\xe9\x74\x15\xba\xcf\xe7\xd5\x1a\x3b\xa9\xec\xba\x1c\x52\xa2\x8c\x77\x64\xd2\x9a\x19\x1c\x22\xce\xd7\xec\xc2\x72\xe2\x9f\x47\xdb\x43\x8a\x70\x42\x85\xff\x29\xac\x27\xbb\xe0\xcb\x6b\x50\x88\x30\x5d\x1a\x9b\x59\x6e\xd5\x3f\x85\x55\xd6\x5f\xe9\x06\x99\x8e\xf8\xbf\xa3\x63\xa5\xa2\x6b\x8c\x22\xf1\x7b\xce\x34\xc4\x42\x38\x9b\x8f\x01\x00\x8d\x6a\xaf\x13\x3d\x01\x00\x00"),
+		},
+		"/src/net/http/sandbox_xhr.go": &vfsgen۰CompressedFileInfo{
+			name:             "sandbox_xhr.go",
+			modTime:          time.Date(2026, 10, 15, 13, 49, 2, 531989811, time.UTC),
+			uncompressedSize: 658,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x91\x31\x6f\xdb\x30\x10\x85\x67\xf1\x57\xbc\x7a\x92\x5c\x45\xda\x03\x64\x69\x96\x0c\x6d\x07\xc3\x43\x36\x83\x92\x4e\x16\x13\xfa\x48\x93\x47\xb8\x82\xe0\xff\x5e\x48\xb1\x13\x27\x40\xd6\xbb\xe3\x7b\x1f\xdf\xab\x6b\xfc\x6c\x92\xb1\x1d\x5e\x62\xb9\x77\x7e\xa0\xf0\x12\x77\x51\x73\xd7\xb8\x7f\x3b\x26\xd9\xb1\x63\xfa\x76\xd9\x93\xb4\xc3\xce\xb1\x1d\x95\xf2\xba\x7d\xd5\x7b\xc2\x20\xe2\x95\x32\x07\xef\x82\x60\x45\x21\xb8\x10\x57\x4a\xd5\x35\xb6\x03\xe1\xf9\xcf\xef\x27\x11\xbf\xa1\x63\xa2\x28\x90\xa0\x39\x2e\x97\x26\xa2\x75\x07\x6f\x2c\x75\x70\x49\xd0\x8c\xb8\xbb\x58\x3d\x30\xc9\xfd\xc2\xa1\xb9\x9b\x85\x3e\x2d\x16\x86\xbb\x99\xa1\x52\x7d\xe2\x16\x4c\xa7\xe7\xa7\xcd\xf6\xaa\x9c\x17\xd8\xb8\xc4\xdd\x36\x18\xef\x29\x60\x42\x20\x49\x81\xc1\xc6\xe2\xbc\x80\xdd\x9e\xcf\x20\xaf\xe4\x05\xbd\x0b\x0b\x91\x16\xd3\x18\x6b\x64\x2c\xd1\x24\x81\xb6\x27\x3d\x46\xf4\xda\xd8\x58\x29\x19\x3d\x7d\x7e\x1e\x25\xa4\x56\xa6\xb3\x7a\x83\xc9\x05\xeb\xdb\xfd\x0d\x4c\x1e\xe8\x88\xf5\x25\x89\x02\xf9\x7a\x43\xd1\x3b\x8e\x54\x62\x49\xad\xc0\xa4\x32\xd3\x23\xd0\xb1\xfa\xe5\xba\x11\x3f\x1e\x16\xe6\x49\x65\xd9\x75\x56\x3d\x5a\x17\x29\x2f\x50\xd7\x1f\xc2\x38\xa4\xf8\x0e\xda\xce\x07\x90\x81\xd0\xb8\x6e\x2c\x61\xb8\xb5\xa9\x33\xbc\x87\xe3\x37\x9f\x58\xa9\xec\xac\xb2\x8f\x54\xca\xeb\xfc\x2f\x9d\xf2\x15\x93\xd4\x73\xa7\xf7\x5f\xbb\x33\x11\x9d\x89\xba\x99\x1b\x6b\xc6\xc5\xe2\xd2\xcb\xaa\x50\xdf\xff\xff\x51\x73\x4b\xf6\x22\xf2\x25\x83\xe9\xac\xfe\x07\x00\x00\xff\xff\x6a\x0c\x16\x3d\x92\x02\x00\x00"),
+		},
+		"/src/net/http/xhr.go": &vfsgen۰CompressedFileInfo{
+			name:             "xhr.go",
+			modTime:          time.Date(2026, 10, 15, 20, 12, 39, 173814921, time.UTC),
+			uncompressedSize: 2688,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x61\x6f\xdb\x36\x10\xfd\x2c\xfe\x8a\xab\x06\x04\x52\xaa\xc8\x0d\x50\x74\x43\x1a\x63\xc8\xd2\xae\x09\xd0\x74\x85\x93\x02\x05\xba\xa2\xa0\xa5\x93\xc4\x84\x26\x15\x92\x8a\xe3\x15\xfe\xef\xc3\x91\xb2\x22\x3b\xe9\x86\x2d\x5f\x42\x93\xc7\xbb\x7b\x8f\xef\xee\x34\x99\xc0\xf3\x79\x27\x64\x09\xd7\x96\xb1\x96\x17\x37\xbc\x46\x68\x9c\x6b\x19\x13\x8b\x56\x1b\x07\x09\x8b\xe2\x79\x57\x09\x1d\xd3\x62\xe5\xd0\xd2\x02\x8d\xd1\xc6\xaf\x84\x9e\x08\xdd\x39\x21\xe9\x87\x42\x37\x71\x78\xef\x5a\xa3\x9d\xbf\x60\x9d\x29\xb4\xba\x8b\x19\x8b\xe2\x5a\xb8\xa6\x9b\xe7\x85\x5e\x4c\x6a\xdd\x36\x68\xae\xed\xc3\xe2\xda\xc6\x2c\x65\xec\x8e\x1b\x78\x83\x15\xef\xa4\xbb\x32\x5c\x59\x9f\xc2\x14\xaa\x4e\x15\x49\x0a\x33\xdd\xa9\xf2\xca\x88\xb6\x45\x03\xdf\x59\x64\x97\xc2\x15\x0d\xad\x0a\x6e\x11\xae\x6d\xfe\x4e\xea\x39\x97\xf9\x3b\x74\x49\x5c\xa1\x2b\x9a\x38\x85\x67\x53\x3a\xf9\xa4\x4a\xac\x84\xc2\x12\xf6\xf6\x76\x2d\x67\xc8\x4b\x3e\x97\x78\xe9\x0c\xf2\xc5\xe3\x2b\x47\x30\x99\xc0\xb6\x11\x08\x0b\x9d\xc5\x12\xb8\x05\x0e\x45\x83\xc5\x0d\x54\xda\x80\xed\x5a\x9f\xb3\xae\xc0\x7a\x43\xa1\x6a\x30\x68\x5b\xad\x2c\xc2\x5c\x97\x02\x6d\x06\x16\x03\xcb\xf6\x68\x32\xf1\x69\xe6\xb6\xc5\x22\x5f\x36\xdc\x2d\xeb\x5c\x9b\x7a\xf2\x53\xb8\x6d\x73\x16\x45\x06\x5d\x67\x14\xec\x79\xcb\x81\x96\xef\xeb\xa7\x61\x7f\xbe\x78\x7f\xe6\x5c\x3b\xc3\xdb\x0e\xad\x7b\x02\xcc\xc8\xe3\xe7\xb3\xd9\x96\xbf\x32\x50\x3f\x32\x51\x7a\xcb\x60\xcd\xd6\x49\xca\xd8\x64\x32\x3e\x18\xb8\x58\x36\xa8\x40\xa1\x70\x0d\x1a\xf8\x9d\xb2\x85\x93\x8f\xe7\xa0\xb4\x81\xed\xac\xfc\x36\x37\x08\xfc\x8e\x0b\x49\xac\xe6\x70\xee\x80\xcb\x25\x5f\x59\xa8\xb8\x90\x36\x67\x6e\xd5\xe2\x56\x18\xeb\x4c\x57\x50\x1a\x8c\xf4\x00\xc9\xe8\x6c\xa4\x8d\xc4\xe0\x2d\xec\xf7\x81\x52\x48\xf6\x67\x3d\xfb\x19\x78\xd5\xa6\xa4\x97\x0d\x3a\x21\xfb\x5d\x9b\x7f\xc0\x65\xe2\x05\x4c\x0f\x73\x34\xc0\xd0\x55\x8f\xe4\x69\x14\x96\xc0\x0f\x28\xe2\x94\xad\x59\x48\x7c\x4c\x6d\x9f\x39\x05\x16\xaa\x92\xa2\x6e\x1c\x2c\x78\xfb\x65\x93\xe5\xd7\xfd\x6b\x9b\xff\x31\xbf\xc6\xc2\xb1\x01\x9d\x83\xfd\xb1\x8f\xff\x8a\xf0\xbe\x31\x70\x34\xfd\x37\x71\x78\xd4\x29\x63\x91\xa8\xc0\xe5\x43\x72\xd3\x29\x51\x43\x6e\xa2\xf1\xee\x8f\x92\x0e\xca\x18\x99\x7e\x31\x78\xfb\x15\xa6\x70\xdf\x18\x2f\x2a\x34\x50\xa2\x44\x87\xc9\x83\x4d\x06\x06\x6f\x29\x34\x55\xc7\x69\x43\xc9\x2e\xf8\x0d\x26\x45\xc3\x15\x0c\x90\x52\x16\xa1\x31\xbb\xc7\x01\x26\xf3\x28\xf3\x4b\x02\xa6\x95\xd4\xbc\x8c\xb3\x4d\xab\xa0\xd4\x1b\xe4\x25\x9a\x0c\xbe\xd1\xe5\xa1\x2d\x11\xe4\x99\x3f\x49\x7c\x5f\x1b\xff\xa6\xf6\x36\xfa\xfd\xe5\x2b\xed\x24\x14\xe4\x94\x4b\x99\xc4\x35\xba\x13\x29\x37\xb9\x9d\x79\x2b\x1b\xa7\xf9\xa5\x33\x42\xd5\x49\x0a\xcf\x21\xfe\x53\xc5\x69\x9a\xa6\x39\xf9\xb8\x38\xbf\x78\x1b\xac\x92\x94\x45\xd1\x5c\x97\xab\x27\x1e\xe5\x93\x50\xee\x97\x13\x63\xf8\xaa\x7f\x10\x0a\xe8\x4f\x36\x8d\x23\x4e\xd3\xfc\x5c\x39\x34\x15\x2f\x30\x49\xf3\x3e\x33\x62\x20\x2a\xb4\x72\xa8\xdc\x7b\x54\xb5\xf3\x34\x09\xe5\x5e\xbd\x4c\x0e\x0e\x29\x62\xdf\x21\x0d\xde\xe6\x17\xe8\x1a\x5d\x7a\x62\x7c\xdb\x88\xcf\xde\x9e\xbc\x89\xa9\xd4\xe9\xf1\x43\x1d\xd0\xf5\xbe\x65\xe7\x1f\xb9\xb1\x78\xae\x5c\x12\x68\x0c\x09\x9d\x86\x60\x07\x21\x5a\x9c\x66\x70\xf8\x22\x83\x57\x2f\xd3\xd7\xfe\xfa\x48\x37\xbb\x89\x4d\x41\xd2\xee\x9a\x45\xe3\x2e\xf3\xc8\x28\x24\x2f\x51\x25\x44\x56\x4a\x18\xd6\xcc\xb7\x23\x2f\x92\xe3\x03\xd8\xdb\xd0\xef\xa3\x5c\x3a\xee\x3a\x7b\x04\xfd\xdf\xc0\x9c\xf5\xfb\x3b\x4f\x03\x31\x3c\xdf\x35\xb9\xc2\x7b\x37\x32\xcb\x1e\x9c\x9e\xea\x12\x8f\x9e\x76\x4a\xb4\x04\xd3\xf0\xba\x43\xfc\xfe\xb1\x03\x65\xc1\xe2\x74\x8c\xf0\x08\xb6\x00\x7b\x83\xdf\x74\xb9\x1a\x1c\x00\x84\x69\x9a\x7f\xd0\xed\xa9\xd4\xf6\x09\x55\x06\x62\xfc\xd5\xbe\x14\x37\xb7\x0d\xde\x66\x9e\xb0\x68\xbd\x53\x1c\xbe\x60\x36\xd5\x81\xf0\x50\xba\xa1\x52\x42\x89\x1d\x1f\xfc\xa0\x17\xee\xb4\x3d\xea\xcf\x58\xc6\xe9\xe3\x30\x7c\xae\x8d\xfb\xdf\x61\x4c\xef\xbf\xe0\xaa\xc0\xdd\x08\xa1\x00\x75\x8b\x2a\xce\x46\x7a\x0e\xeb\x4f\xb3\xf7\xc3\x0b\xa6\xa3\x8c\x36\xf5\x73\xb5\x6a\x31\xce\x20\xe6\x54\x64\xf3\xae\xaa\xd0\xc4\x29\x0d\xf5\x86\x5b\x70\x1a\xe6\x08\xbc\x72\x68\x20\x04\x80\x4e\x39\x21\x87\x09\x3d\xef\xea\xbf\x84\x94\x3c\x5f\xe8\xf0\x9f\x06\xb4\x6d\xf4\xf2\xdb\xbc\xab\xf3\xa2\x16\xbf\x8a\x72\x7a\x78\x78\xf8\xe2\xe7\x57\x87\x34\x0e\x0c\x5a\x2d\xef\xb0\x64\x11\x7d\x11\xdc\xe0\x2a\x83\x3b\x2e\x3b\xb4\x54\x5e\x86\xab\x1a\x7d\xd2\x41\x2b\x9e\x18\xb2\xfb\xd6\x5b\x3d\x18\xf5\x97\xbc\xce\x1f\x28\xb0\xe8\xfa\x87\x08\x0e\xe2\x6c\x14\x22\xed\x9f\xdf\x37\x74\x0a\x42\xe2\x1a\x97\xe5\xd8\x8f\x0a\x0c\x03\x4a\x8b\xfe\x90\x94\x35\xf4\x81\x5e\x87\x24\xba\x13\x29\x93\x8d\x33\x8a\x20\x2a\x6f\xf4\x6c\x54\xed\x9b\xe3\xdc\x8b\x36\xf1\xe4\x0e\x03\x0b\x16\x9d\x1d\xa6\x7b\x41\x06\xe0\x1a\xff\x35\xb4\xca\x40\xa8\x42\x76\x25\x7d\x26\x69\xb5\x11\x46\xf0\xb8\x35\xa2\x03\xb0\x47\x71\x1e\x43\xca\xbc\x5f\x02\xc6\x58\x64\x51\x62\x18\xbc\xbe\xe7\x91\x1e\x08\xdb\xf1\x41\xe8\x27\xa3\x0f\x1d\xda\xc8\x28\x5a\x6f\xda\xb3\x70\x7c\xe0\x45\x3b\xfe\x22\x1a\x12\x5a\xff\xc3\xb0\x3e\xf5\x1a\xee\x1f\x6a\x67\x60\x7f\xf7\xaf\x73\xdf\x98\x0c\xf4\x8d\x9f\x4d\xdb\x83\xf3\x35\x6d\x6f\x3f\x56\x28\xac\x34\xc4\xfc\x3b\x00\x00\xff\xff\x05\x0b\xbb\x60\xb6\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x51\x6f\xdb\x36\x10\x7e\x16\x7f\xc5\x55\x0f\x85\x94\x2a\x72\x03\x14\xdd\xe0\xc6\x18\x32\xaf\x68\x02\x24\x5d\x91\xa4\x40\x81\xae\x30\x68\xe9\x24\x31\xa1\x49\x85\x3c\x25\xf1\x02\xff\xf7\x81\xa4\x64\xab\x6e\xba\x01\xf3\x8b\x29\xf2\xee\xbe\xbb\xef\xee\x78\x9c\x4c\xe0\xd5\xb2\x13\xb2\x84\x1b\x9b\xbd\xa8\x75\xdb\xa0\xb9\xb1\x0b\xcb\x55\xb9\xd4\x8f\x0b\x85\xb4\x50\x5a\xe1\x4f\x8e\x2a\xa4\xa2\x59\x68\x25\xd7\x8c\xb5\xbc\xb8\xe5\x35\x42\x43\xd4\x32\x26\x56\xad\x36\x04\x09\x8b\xe2\x65\x57\x09\x1d\xbb\xc5\x9a\xd0\xba\x05\x1a\xa3\x8d\x5f\x09\x3d\x11\xba\x23\x21\xdd\x87\x42\x9a\x10\x3e\x52\x6b\x34\x79\x05\x4b\xa6\xd0\xea\x3e\x66\x2c\x8a\x6b\x41\x4d\xb7\xcc\x0b\xbd\x9a\x0c\xae\xec\x16\x37\x36\x66\x29\x63\x93\x09\x28\x7c\xf8\x72\x7a\x79\x6d\xb8\xb2\xde\x01\x83\xd4\x19\x65\x81\x2b\xf8\x72\x71\x7e\x4a\xd4\x5e\xe2\x5d\x87\x96\x80\x06\x99\x0c\xb4\x01\x25\x24\x88\x0a\xa8\x41\x38\xf9\x74\x06\xc2\x7a\x63\x9a\x80\xdf\x73\x21\xf9\x52\x62\xce\xaa\x4e\x15\xfb\x00\x49\x0a\x97\xba\x53\xe5\xb5\x11\x6d\x8b\x06\x9e\x58\x24\x2a\xb8\xb1\xf9\x07\xa9\x97\x5c\xe6\x1f\x90\x92\xf8\x7b\xe4\x38\x85\xd9\xcc\x89\x7c\x56\x25\x56\x42\x61\xe9\xb4\xa2\xe0\xa9\x73\x84\x45\x1b\x36\x7c\xbe\x1c\x83\x3d\x6d\xd8\x86\x31\x5a\xb7\x08\xe3\x6d\xb0\x64\xba\x82\x3c\xb6\xaa\xa4\xa8\x1b\x82\x15\x6f\xbf\x1e\xf4\x80\xdf\x0e\x6e\x6c\xfe\xe7\xf2\x06\x0b\x72\xfa\x3e\x8c\x84\xe0\x60\x6c\x63\x14\x46\x62\xf0\x0e\x06\xdd\x14\x92\x83\x4b\xb4\xad\x56\x16\x33\xf0\x89\x4b\xfb\x20\x0d\xda\x36\x03\x7d\xeb\xb7\x61\x3a\x03\x33\x58\x38\x53\x9f\x8c\x2e\xd0\x5a\x67\x2a\x7d\x07\xfa\x76\x1c\x61\xd0\x43\x63\x7c\x9c\x8f\x8d\xd7\xfd\x0f\xc6\xf2\x8f\xf8\x90\xa4\xcc\xe3\x52\xbe\x8d\x72\x36\xf3\x89\x73\xc6\xc7\xbb\x3f\x8b\xfe\x69\xe3\x21\x77\xa2\x5f\x0d\xde\x7d\x83\x19\x3c\x36\x86\x45\x25\x56\x68\xa0\x44\x89\x84\xc9\x4e\x26\x03\x17\x04\x73\x19\xb1\xed\xbc\x71\xce\xae\xf8\x2d\x26\x45\xc3\x15\x6c\xb9\x49\x59\x84\xc6\xec\x1f\x07\xbe\x98\x8f\x32\xbf\x72\x81\x69\x25\x35\x2f\xe3\x0c\x5c\x16\x12\x4f\x65\xd4\x20\x2f\xd1\x64\xb0\x70\xca\xdb\x06\x70\x21\x5f\xfa\x93\xc4\x77\xd0\xf8\xdb\x35\xd2\xe8\xfb\xeb\x37\xb7\x93\x38\x90\x39\x97\x32\x89\x6b\xa4\x13\x29\x07\xdf\x4e\xbd\x94\x8d\xd3\xfc\x8a\x8c\x50\x75\x92\xc2\x2b\x88\xff\x52\x71\x9a\xa6\x69\xee\x6c\x5c\x9c\x5d\xbc\x0f\x52\x49\xca\xa2\x68\xa9\xcb\xf5\x33\x49\xf9\x2c\x14\xfd\x7a\x62\x0c\x5f\xf7\x09\x71\x80\xfe\xc4\xf4\x48\x71\x9a\xe6\x67\x8a\xd0\x54\xbc\xc0\x24\xcd\x7b\xcf\x1c\x03\x51\xa1\x15\xa1\xa2\x73\x54\x35\x79\x9a\x84\xa2\xb7\x6f\x92\xc3\x23\x87\x68\x1f\x04\x15\x8d\x63\x3a\xbf\x40\x6a\x74\x68\x89\x82\x5b\x84\xf8\xf4\xfd\xc9\x1f\xf1\x94\x45\x91\x4b\xbe\xdc\x56\x5b\x7f\x39\xe4\x9f\xb8\xb1\x78\xa6\x28\x09\x34\x06\x87\xe6\x01\xec\x30\xa0\xc5\x69\x06\x47\xaf\x33\x78\xfb\x26\x7d\xe7\xd5\x47\x75\xb3\xef\xd8\x0c\xa4\xdb\xdd\xb0\xc8\x15\x04\xef\x24\x4d\xd9\x33\x42\xc1\x79\x89\x2a\x71\x64\xa5\x2e\x86\x0d\x63\xd1\x50\x24\xc7\x87\xf0\x72\xa0\xdf\xa3\x5c\x11\xa7\xce\x4e\xa1\xff\x6d\x99\xb3\x7e\x7f\x2f\x35\x10\xc3\xab\x7d\x91\x6b\x7c\xa4\x91\x58\xb6\x33\x3a\xd7\x25\x4e\x9f\x37\xea\x68\x09\xa2\x21\xbb\x5b\xfc\x3e\xd9\x81\xb2\x20\x31\x1f\x47\x38\x85\xef\x02\xf6\x02\xbf\xeb\x72\xbd\x35\x00\x10\xee\xed\xfc\xa3\x6e\xe7\x52\xdb\x67\xaa\x32\x10\xe3\x55\xfb\x56\x1c\xb4\x0d\xde\xf9\xed\xeb\xf3\xab\x91\x41\x68\x25\xa7\x4a\x9b\xd5\xf5\xf9\x95\xbf\x38\x32\x4f\x6a\xb4\xd9\x6b\x20\xdf\x54\x43\x07\x21\xec\xda\x3b\x74\x53\x68\xc3\xe3\xc3\xd0\x7c\xde\xa1\xc4\x8f\x16\x37\x98\xa6\xfb\x53\xa0\xe2\x42\x62\x19\xa7\x3f\xc2\xf0\xa5\x36\xf4\xbf\x61\x4c\x6f\xbf\xe0\xaa\xc0\x7d\x84\xd0\xa4\xba\x45\x15\x67\xa3\x9a\x0f\xeb\xcf\x97\xe7\xdb\x2c\xa7\x23\x8f\x86\x1e\xbb\x5e\xb7\x18\x67\x10\x73\xd7\x88\xcb\xae\xaa\xd0\xc4\x29\x4c\x26\xd0\x70\x0b\xa4\x61\x89\xc0\x2b\x42\x03\x01\x00\x3a\x45\x42\xfa\xa9\x6c\xa7\x93\xc9\xb2\xab\xff\x16\x52\xf2\x7c\xa5\xc3\xbf\x36\xf5\xc4\x36\xfa\x61\xb1\xec\xea\xbc\xa8\xc5\x6f\xa2\x9c\x1d\x1d\x1d\xbd\xfe\xe5\xed\x11\x08\x0b\x06\xad\x96\xf7\x58\xb2\xa8\xd2\x06\x6e\x71\x9d\xc1\x3d\x97\x1d\x5a\x7f\xe1\x73\x55\xa3\x77\x3a\xd4\x93\x27\xc6\xc9\x2d\x7a\xa9\x9d\x50\xaf\xe4\x04\x46\x14\x58\xa4\x3e\x11\xc1\x40\x9c\x8d\x20\xd2\x3e\xfd\xfd\xb0\xb9\xcb\x5d\x01\x8e\x5b\x77\x6c\x47\x05\x86\x01\xa5\x45\x7f\xe8\xaa\x6f\x7b\x57\xf4\xb5\xea\x0a\xf3\x44\xca\x64\x30\xe6\x10\x44\xe5\x85\x5e\xec\xcc\x46\xc3\x71\xee\x0b\x3b\xf1\xe4\x6e\xa7\x23\xac\x3a\x4b\xc0\xe5\x03\x5f\x5b\x28\x9c\x80\x7f\x34\x04\x38\xa1\x0a\xd9\x95\x42\xd5\xa0\xd5\x50\x18\x2c\xda\x4e\x3e\x25\x64\x3f\xf8\xfc\xfd\xb2\x8f\xf3\x63\x48\x99\xb7\xeb\x02\x63\x2c\xb2\x28\x31\x4c\x79\x7f\x2f\xba\x7a\x70\xb1\x1d\x1f\x86\x3b\x67\xba\x3f\x61\xfd\x4b\xc2\x8b\xf6\x2c\x1c\x1f\xfa\xa2\x9d\xb2\x67\x1c\xda\xfc\xcb\xcb\x60\xee\x6b\xb8\x4f\xd4\xde\xeb\x20\x3c\x05\x1e\x1b\xe3\x5e\x02\x0e\x64\x6f\xb8\x6e\x87\xff\x2e\xb2\xd0\x58\x69\xc0\xfc\x67\x00\x61\xba\x2b\x10\x80\x0a\x00\x00"),
 		},
 		"/src/net/net.go": &vfsgen۰CompressedFileInfo{
 			name:             "net.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1122,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x92\x41\x6f\x1a\x3d\x10\x86\xcf\xf8\x57\xcc\xe7\x93\xfd\x75\xbb\xa8\x52\xd4\x43\x25\x0e\x0d\xad\x22\xaa\x36\x44\x42\x6a\x2b\x45\x39\x78\xbd\xb3\x1b\x83\xb1\xb7\x1e\x6f\xc3\xaa\xe2\xbf\x57\x5e\x76\x49\x02\x5c\x7b\xc2\x0c\x33\xcf\xfb\x68\x86\xe9\x14\xde\x14\xad\xb1\x25\xac\x89\xb1\x46\xe9\x8d\xaa\x11\x1c\x46\xc6\xcc\xb6\xf1\x21\x82\x60\x13\x8e\x21\xf8\x40\x9c\x4d\x38\x75\xa4\x95\xb5\x9c\xb1\x09\xaf\x4d\x7c\x6c\x8b\x5c\xfb\xed\xb4\xf6\xcd\x23\x86\x35\x3d\x3f\xd6\xc4\x99\x64\xac\x6a\x9d\x86\xaf\x86\x22\x3a\xe1\x30\x66\x60\x55\x59\x06\xa0\x18\x8c\xab\x25\x88\xc3\x4f\x18\x32\xe8\x33\x24\xfc\x61\x93\x46\x39\xa3\xc5\x21\x33\xbf\xc5\x27\xc1\x1d\xc6\x27\x1f\x36\xa0\xb4\x46\x22\x30\x04\xce\x47\xa0\xb6\x49\x86\x58\x42\xd1\xc1\x4d\x1f\xfc\x65\xc5\xa5\x64\xfb\x21\x57\x94\xf0\xff\x27\xa3\x2c\x06\x09\xe9\x53\x0c\x9c\x0c\x92\x44\x22\x1d\x3d\xe6\xde\xb9\x7f\xe2\x40\x1d\x2d\x9c\x89\x22\x51\xc7\x5a\x13\x7c\x81\x8b\xbb\xdf\x57\xab\xa8\xf4\x46\x48\x28\xbc\xb7\x29\x35\x60\x6c\x83\x83\x4a\x59\xc2\xb3\xee\xf7\x63\xb7\x18\x42\x29\x15\x33\x78\xf1\xed\x6a\xab\x9a\x1e\x26\x4f\x69\xd9\x25\xe8\x0f\xe3\x4a\xff\x44\x8b\xbb\x33\xf2\x77\x43\x51\x2d\xee\x2e\xb3\x8e\x90\xad\xda\x8d\xf7\xbb\x56\x7a\x63\x7d\x2d\x24\x18\x17\x5f\x0c\x0c\xff\x97\x7c\xb5\xfc\xf6\xf1\xe7\x7c\x79\x7b\x9b\x86\xa7\x53\x98\xfb\xa6\x03\x5f\x0d\x07\xa0\x7c\xe1\x4a\xdc\x5d\x77\x11\xf3\x03\xba\xe8\x22\xf6\x35\x31\x1e\x29\x83\x43\xf5\x34\x61\x9d\x86\x23\x06\xa7\xec\xb2\x58\xa3\x8e\x82\x64\x3e\x57\xd6\x0a\x6e\x12\x60\x59\xf1\x2c\x35\xdd\x58\x5f\x28\x9b\xdf\x60\x14\x7c\xd5\x13\xf9\xd8\x57\x05\xbf\x9d\x3f\xaa\x30\xf7\x25\xf2\x0c\xb4\x94\x09\x29\xe4\x89\x6b\x4a\xa7\xfc\xf3\xaf\x56\xd9\x17\x96\xd4\x17\xc4\x2e\x83\x0e\xee\x1f\x0e\x86\xe3\x3d\x4d\x05\x16\x9d\xd8\x49\xf8\x6f\xd6\xbf\xba\x7e\x99\xaf\xb7\x39\xd9\xb3\x49\xe5\x03\x98\x0c\x0a\xf8\x30\x83\xa0\x5c\x8d\xb0\xeb\x1b\x4d\x05\x45\x9a\xed\xee\xcd\x43\x5f\x38\x19\x4d\xb3\xfb\xe3\x2a\x62\x68\xf1\xa2\xf3\xa5\xed\xd2\xb1\x28\x68\x10\x3f\x5b\xf1\xb9\x16\x3d\x6b\xcd\x66\xa0\x5f\x39\x99\x53\x9f\xb7\xef\xd8\x9e\xfd\x0d\x00\x00\xff\xff\x93\x28\xa9\x7f\x62\x04\x00\x00"),
 		},
 		"/src/os": &vfsgen۰DirInfo{
 			name:    "os",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/os/os.go": &vfsgen۰CompressedFileInfo{
 			name:             "os.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
-			uncompressedSize: 703,
+			modTime:          time.Date(2026, 10, 15, 20, 20, 46, 419884475, time.UTC),
+			uncompressedSize: 1041,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\x52\xc1\x6a\xdc\x30\x10\x3d\x5b\x5f\x31\xd5\x49\x62\x5b\x3b\x69\x6f\x49\x4d\x49\xcb\xb2\x2d\x94\x16\x5a\x4a\x0f\x21\x04\xad\x3d\xf6\xce\xae\x3c\x32\x92\xdc\x2c\x2c\xfb\xef\x45\x5a\x3b\x2d\x01\x1f\xac\x99\x37\xef\x3d\x3d\x4d\x55\xc1\x6a\x3b\x91\x6d\x61\x1f\x84\x18\x4d\x73\x30\x3d\x82\x0b\x42\xd0\x30\x3a\x1f\x41\x89\x42\xa2\xf7\xce\x07\x29\x8a\x47\x90\x13\x07\xd3\xa1\x84\xaa\x82\xce\x79\xe8\xdd\x8d\x25\x3e\xb0\x19\x50\x88\x42\xf6\x14\x77\xd3\xb6\x6c\xdc\x50\xf5\x6e\xdc\xa1\xdf\x87\x7f\x3f\xfb\x20\x85\x16\xa2\x71\x1c\x22\x50\xf8\x48\xfd\x9a\x5b\x32\x0c\x35\x74\xc6\x06\x14\xa2\x9b\xb8\x01\x3f\x71\xa4\x01\x1f\x8d\xef\x83\xd2\x70\xff\x10\xa2\x27\xee\xe1\x94\x34\xd9\x45\x68\x8c\xb5\xd8\x82\x63\xf8\x4d\xdc\xba\xa7\x20\x0a\x8f\x71\xf2\x0c\x77\xbe\x0f\xe2\x3c\xf3\x10\x53\x54\x1a\x4e\xa2\xa0\x0e\x46\xef\x1a\x0c\x01\x6e\x6a\xd8\x87\x72\x63\xdd\xd6\xd8\x72\x83\x51\xc9\xb9\x23\xf5\xed\x33\xe8\x55\x06\xfd\xe2\x16\x3b\x62\x6c\x13\x45\x61\x7c\xff\x27\x4d\xcf\x98\xcb\x6c\x2a\x4a\x2d\x8a\x22\x09\x43\x0d\x83\x39\xa0\x5a\x0c\xbf\x86\xd4\x2e\xbf\x22\xf7\x71\xa7\xf4\x9b\xeb\x04\x4c\x99\x51\xe2\xb9\xba\x05\x82\xf7\x2f\x21\xb7\x40\xab\x55\xd6\xcb\x94\xf7\xf4\x00\xf5\x05\xf3\x85\x5b\x3c\x2a\x82\x15\x5c\xeb\xf2\x67\x16\x50\x89\xf0\x2c\xd2\x47\x1d\x58\x64\x95\x66\x34\xd4\x35\x5c\x65\x8e\xd9\xd5\x62\xe8\x24\x3f\xc8\x0c\x3f\xbf\x48\x7a\x8b\x9d\xf3\xb8\x3e\x5e\xf2\x5a\xba\x78\xc4\x66\x8a\x66\x6b\x51\x69\x50\xcb\x9d\xf2\x2e\xe4\x54\xe7\xcc\xa5\x9c\x8b\xa1\xfc\x86\x4f\x4a\xae\x9f\xc7\xf2\x63\xd1\x30\x5a\x1c\x90\x23\xb6\x79\x61\x36\xdf\xef\x7e\x7c\xfa\x5c\xef\x83\xd4\xc9\x47\x55\xfd\xb7\x41\xd0\x99\x10\xbd\xe1\x76\x71\x56\x2e\x85\x8b\xa3\xe5\xa4\x34\x4c\xc4\xf1\xdd\x5b\xf1\x37\x00\x00\xff\xff\x31\x02\xed\x7a\xbf\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x53\x4f\x6f\xd4\x3e\x10\x3d\xc7\x9f\x62\x6a\xfd\x0e\x8e\xf6\x47\xd2\x85\x5b\x4b\x84\x0a\xaa\x0a\x12\x02\x04\x42\x1c\xaa\xaa\xf2\x26\x13\xef\x6c\x1d\x7b\xe5\x71\xb6\xad\xaa\xfd\xee\xc8\xf9\xb3\xb4\x14\xb8\x25\xf6\x9b\x37\xef\x8d\xdf\x94\x25\x2c\x56\x3d\xd9\x06\x36\x2c\xc4\x56\xd7\x37\xda\x20\x78\x16\x82\xba\xad\x0f\x11\x94\xc8\x24\x86\xe0\x03\x4b\x91\x5d\x83\xec\x1d\xeb\x16\x25\x94\x25\xb4\x3e\x80\xf1\x27\x96\xdc\x8d\xd3\x1d\x0a\x91\x49\x43\x71\xdd\xaf\x8a\xda\x77\xa5\xf1\xdb\x35\x86\x0d\xff\xfa\xd8\xb0\x14\xb9\x10\xb5\x77\x1c\x81\xf8\x2d\x99\x73\xd7\x90\x76\x50\x41\xab\x2d\xa3\x10\x6d\xef\x6a\x08\xbd\x8b\xd4\xe1\xb5\x0e\x86\x55\x0e\x97\x57\x1c\x03\x39\x03\x0f\xa9\xa7\xf3\x11\x6a\x6d\x2d\x36\xe0\x1d\xfc\x20\xd7\xf8\x5b\x16\x59\xc0\xd8\x07\x07\x67\xc1\xb0\xd8\x4f\x3c\xe4\x28\xaa\x1c\x1e\x44\x46\x2d\x58\x6a\xb1\xbe\xaf\x2d\xc2\x49\x05\x1b\x2e\x2e\xac\x5f\x69\x5b\x5c\x60\x54\xf2\xbf\xc3\xa5\xcc\x4f\x1f\x21\x8f\x2a\x70\x64\x13\x41\x56\x96\xf0\x25\x78\x13\x74\xc7\xc0\x51\x87\x88\x0d\xac\xee\x21\xae\x11\xd6\x9e\x23\x18\x8c\xc3\x8f\x0e\xa6\xef\xd0\x45\x86\xad\x66\xc6\x06\xa2\x1f\xf1\x85\xc8\xb2\x64\x28\xb5\x3f\x74\x18\xdb\xa7\x63\x99\x8b\x2c\x4b\xea\xa1\x82\x4e\xdf\xa0\x9a\x5d\xff\x9f\x28\xb9\xf8\x88\xce\xc4\xb5\xca\x17\xcb\x19\x78\x79\x7c\x05\xcf\xa8\xd2\x33\xc8\xbc\xf8\x36\x94\xaa\x04\x4d\x6f\x44\xa9\xe9\xf1\x29\x10\xbc\x7e\xca\x76\x0a\xb4\x58\x0c\xfe\x46\x4a\x5a\x2c\x13\xe9\x80\xf9\xe0\x1a\xbc\x53\xf4\x84\x6c\x2f\xb2\x3d\xa0\x65\x04\x6a\x61\x1b\x7c\x8d\xcc\x7f\x98\xe7\x74\x93\x86\x39\x83\x8e\x06\xd0\x77\xd7\x60\x4b\x0e\x9b\xa1\xa7\x0e\x66\x97\xaa\x27\xcc\x61\x18\xbb\x7f\x0f\x63\x77\x90\xff\x62\xf9\x17\x87\x8f\x21\xcf\x3c\x4e\x0e\x77\xb3\x43\x58\xc0\xf2\x99\xcb\x31\x33\xe8\x54\xaa\xc9\xa1\xaa\xe0\x78\xe0\x98\x54\xcd\x82\x1e\xe4\x1b\x39\xc0\xf7\xbf\x65\x77\x85\xad\x0f\x78\x7e\x37\x26\x70\xbe\xc5\x3b\xac\xfb\xa8\x57\x16\x55\x0e\x6a\xf6\x34\x6c\xd7\x90\xd3\x29\xc5\x52\x4e\x87\x5c\x7c\xc2\x5b\x25\xcf\x0f\x65\x43\xfc\xa9\xdb\x5a\x4c\x21\xc3\x66\x58\xc1\x8b\xcf\x67\x5f\xdf\xbd\xaf\x36\x29\x44\x7b\x21\xca\xf2\xd1\x4e\x42\xab\x39\x06\xed\x9a\x59\x59\x31\x1f\x8c\x8a\xe6\x3f\x95\x43\x4f\x2e\xbe\x7a\x29\x7e\x0e\x00\x47\x51\x8b\xe9\x11\x04\x00\x00"),
 		},
 		"/src/os/removeall_noat.go": &vfsgen۰CompressedFileInfo{
 			name:             "removeall_noat.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 3388,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x56\xdf\x73\xdb\xb8\x11\x7e\x16\xff\x8a\x8d\x1e\x12\xa9\x56\x28\x39\x77\x0f\x89\x53\x4f\xc7\xb5\x75\x19\x77\x52\x3b\x13\x5d\x9b\x87\x4e\xa7\x03\x91\x4b\x11\x31\x08\xb0\xbb\xa0\x74\xec\xd9\xff\x7b\x67\x01\x50\x3f\x7c\xbe\x99\x7b\x93\xc0\xdd\xc5\xf7\x7d\xfb\x0b\xf3\x39\x5c\xbb\xb6\x27\xbd\xa9\x3d\xbc\x5b\x9c\xbf\x87\x9f\x6b\x84\x4f\x0e\xae\x3a\x5f\x3b\xe2\x1c\xae\x8c\x81\xf0\x99\x81\x90\x91\xb6\x58\xe6\xd9\x7c\x0e\xff\x60\x04\x57\x81\xaf\x35\x03\xbb\x8e\x0a\x84\xc2\x95\x08\x9a\x61\xe3\xb6\x48\x16\x4b\x58\xf7\xa0\xe0\xaf\xab\x9b\xb7\xec\x7b\x83\xe2\x65\x74\x81\x96\x11\x7c\xad\x3c\x14\xca\xc2\x1a\xa1\x72\x9d\x2d\x41\x5b\xf0\x35\xc2\xe7\xdb\xeb\xe5\xdd\x6a\x09\x95\x36\x98\x67\xe2\xf2\xc9\xb5\x35\xd2\xdf\x56\xd0\x31\x32\x8c\xad\x53\x7e\x0c\xba\x69\x0d\x36\x68\xbd\xf2\xda\x59\x01\xe2\x38\xff\x8a\x8d\xdb\xe2\x95\x31\x93\x29\xac\xb1\x50\x9d\x40\xec\x08\xac\x2b\xf1\x2d\xf7\x5c\x28\x63\x24\x62\xe3\xca\xce\xa0\x5c\xff\xc6\x43\xad\x6c\x69\x10\x5a\xc5\xac\xed\x06\x14\xb0\xa7\xae\xf0\x82\xa7\x70\x44\x58\x78\xd3\x07\xc2\xb5\xf7\x2d\x5f\xcc\xe7\x1b\xed\xeb\x6e\x9d\x17\xae\x99\x6f\x02\xb4\xef\x7c\xf8\xa1\x99\x3b\xe4\xf9\x87\x0f\x3f\x04\xec\x67\xeb\x4e\x9b\x12\xbe\x73\x96\xb5\xaa\x78\x50\x1b\x04\xc7\x59\xa6\x9b\xd6\x91\x87\x49\x36\x1a\x6b\x37\xce\x46\x63\xea\xac\xd7\x0d\xca\xcf\x84\x73\x9c\x4d\xb3\xac\xea\x6c\x01\xb4\x67\xd5\x2a\x5f\x0b\x3c\x6d\x37\x53\x40\x22\x47\xf0\x6b\x36\xd2\x15\x84\x0f\x97\x97\x30\x1e\xcb\xc1\x68\x3e\x87\x4a\x69\x03\xac\x0d\x5a\x6f\x7a\xf0\x0e\x08\xbd\x0a\x94\x9a\x56\x79\xbd\xd6\x46\xfb\x1e\x76\xda\xd7\xd0\x12\x6e\xb5\xeb\x18\xd6\x58\xab\xad\x76\x14\x23\xb8\x0a\xf6\x7a\xe6\xb0\x42\xc9\x2c\x77\x08\xef\xde\xbf\xff\x61\x91\x67\xa3\x11\xa1\xef\xc8\x82\xd5\x26\x1b\x3d\x65\x99\xf8\x48\xed\x50\x53\x6a\x02\xee\xd9\x63\x03\xc2\x04\x5a\xa4\x46\x87\xf2\x69\xdc\x56\x34\x1e\xe7\x63\x70\x16\xbe\x18\x65\xe1\xc3\x2c\x78\xb2\x83\x1d\x42\xe9\x24\x23\xd1\x1e\xb4\x8f\xb8\x9b\x88\xdb\xb2\x66\x8f\xd6\x47\xd0\xbe\xc6\xe0\x37\x7e\xb9\x18\x0e\xc8\x83\x3e\x68\x4b\xfe\xa6\x7d\x7d\xe3\x7c\x10\x71\x1a\x64\x4a\x04\x5e\x7f\x51\xbe\x5e\x8a\x9a\xbf\xde\xb7\x17\x30\xde\xfb\x8e\x67\x20\x9f\x2e\x82\xbc\x33\x58\x12\x5d\x40\xca\x4e\xbe\xbc\xbd\xfb\xe7\xd5\xe7\xa7\x3d\xf3\x55\xc0\x00\x85\x62\xbc\x00\x3d\x00\x80\x9d\xa3\x07\x9e\xc1\x0e\xdf\x50\x60\x87\x79\x36\x42\x22\xb8\xb8\x4c\x16\x11\x4e\x04\x49\x24\x39\xb4\xda\xc0\xe3\x23\xdc\xf2\x9d\xf3\xcb\x5f\x34\xfb\x09\x12\x9d\x00\x3e\x56\xfc\xde\xd7\x48\x3b\xcd\x38\x93\xc6\x0b\xcd\xa8\xa0\xd4\x52\xb6\x8e\x7a\xd1\xd4\x22\x96\x51\xc8\xa2\x23\x46\xd0\xd6\xbb\xbf\x64\xa3\x52\xd3\x0c\x38\x61\xf9\xcc\x5e\xf9\x23\x28\xe1\xfc\x55\xc4\x22\x17\xa7\xa3\x19\xb8\x07\x31\x97\xdf\xf9\xe4\x4f\x7b\xdd\xa6\x1f\xe5\xc3\xeb\xd7\x30\x39\x42\x1d\x8c\x96\x02\xfd\xf1\x11\x86\x3f\x42\x70\x2f\xe1\xdd\xfd\xcf\x37\xb7\x5f\x23\xb5\x13\x6e\xa3\xa7\x03\x59\xf1\x14\xb6\x82\xe1\x55\xa9\x29\xbf\xe5\x1b\x4d\x93\xe9\x50\xe8\x77\xce\x1f\x33\xfe\x08\xc9\x4f\x66\x49\x6c\x91\x8a\x5c\x93\xd4\x3e\x2a\xdb\x14\x36\x88\x98\x92\x55\x38\x2b\x05\xc6\xf0\x7a\x08\x52\x69\x62\x1f\xc3\xa4\xc4\x5d\x46\x84\x55\x6c\xbd\x51\x55\xce\x20\x69\x78\xdf\xa2\x1d\x24\x1c\xd2\x79\x24\xa1\x1c\xbd\x94\xd3\x40\xe2\xca\x10\xaa\xb2\x87\x12\x0d\xfa\x38\x37\xd9\x35\xe8\x2c\x02\x1a\x0e\xb0\x9f\x29\x14\x24\x3a\xe1\x12\xc8\x8c\xa4\x4f\x3c\x10\xfe\x77\xa5\xff\x87\x70\x09\xe7\x8b\x77\x3f\x66\xa3\xd1\x56\x11\x58\xd5\x20\xc3\xbf\xfe\x1d\x07\x48\x3a\x94\x7b\x25\x2f\x81\xa3\x04\x18\x98\x8d\x6c\xd7\x2c\x23\xb3\x45\xf8\x2b\xde\xb3\xbd\xfd\x25\x54\x65\xfe\x15\x55\x59\x6a\x0a\x9f\x26\xe9\xce\xa9\x04\x09\x51\xfe\x33\x0b\x57\x4a\x04\x52\x76\x83\x09\x40\x24\x8d\x44\xe7\x87\x2e\xd8\x0f\xb7\xb3\x34\xde\x26\x52\x5b\x2b\x6c\x15\x29\xef\x68\x0a\x67\xc1\x79\x1a\x5c\x4f\x5b\x25\x86\x4b\xb9\x91\xa8\xe1\xff\xd3\x91\xe5\xf9\x49\x1a\x06\x62\x67\x67\x07\xc3\xa0\x9c\xe4\xe1\xb6\x92\x8e\x91\xb5\x14\x33\x01\xca\xf6\x80\xd6\x53\x3f\x83\x35\xa1\x7a\x90\x46\x62\xaf\xc8\x83\xc5\x1d\x68\x8f\x14\x46\x4e\x9e\xfc\x8f\xba\x51\xa6\x99\xe6\x42\x51\x09\x45\x47\x24\x83\x2b\x49\xb8\x41\xf1\xfe\xc5\x87\xc0\x1a\x19\x94\x2d\xc1\x53\xca\xbe\xcc\x47\x5f\x63\x93\xa7\x9a\x49\x69\x78\x75\xb9\x4f\x6a\xa4\x11\xe0\x0c\x85\x10\x08\x0c\x85\x2c\x11\x64\x7b\x72\xac\x7c\x69\x84\xc3\x40\x68\x54\x0f\xb5\x92\x62\x97\xed\x58\x46\x37\x31\xb9\x5f\xc5\x21\xc1\x75\x57\x55\x06\x41\xfb\x3c\x0e\xb5\x3e\x0c\x71\x09\x7a\x9c\xee\xe8\xa8\x36\x32\x9b\x25\x26\x3f\xe8\x36\xd4\xec\xc0\x2a\x0f\xcb\xc0\x59\xd3\x03\xa1\xd1\x6a\x6d\x10\x76\xaa\x4f\x17\x3a\x50\x5b\xa7\xcb\x38\xb0\x64\x70\x39\x28\x8c\x63\x0c\x5a\x10\xbe\x75\x2d\xda\x38\xe3\xc5\x7c\x0f\xff\x64\x0f\x2d\xde\xff\x78\x9e\x87\x1e\xcc\xaf\xc5\x77\x12\x4a\x4f\x57\x87\x1a\xbd\x04\xed\xf2\xe5\xfd\x4f\x51\xb2\x41\xb1\xa7\x6c\xc8\xf5\x31\xa1\xd4\xf2\x58\x82\xb2\xb1\x1b\x66\xf2\xe0\x10\x1d\xb2\x17\x6b\x2e\x56\x5c\xba\x2b\x85\xd5\x15\x18\xb4\x93\x10\x70\x2a\xd6\x8b\xe7\x57\xc7\xbb\xbf\x0d\xab\x6e\xa7\x6c\xda\x72\x91\x72\x67\x2d\x16\xc8\xac\x48\x9b\x7e\x26\x5b\x51\x4b\x49\x46\xaf\x8d\xf3\x50\xe1\x0e\x49\x5e\x4f\x56\xea\xa1\x43\x4e\x65\x35\x4c\xb9\x03\xa1\x99\xd4\x54\x74\xe4\x98\xc7\xfd\xfe\x3d\x2d\x09\xeb\x76\xb9\xa8\x21\x4f\xb2\x64\xdf\x15\x05\x62\x19\x16\x17\xa8\xc3\xe6\x7a\xc6\xef\xcf\xa7\x25\x79\xda\xd2\xfb\x51\xb8\xef\xc2\xdf\xdb\x6d\xe7\xc3\x20\x7c\x3e\xe0\x0e\xce\xa7\x1d\x1c\x05\x14\x35\x62\xc1\x85\x29\x7f\x4c\x6e\xb0\x3a\x70\x1c\x46\xfb\x2c\x14\x18\x6b\x5b\x60\x94\x35\xd8\x49\x12\x93\xb2\x51\xcc\xa0\xef\x0e\x07\x89\x43\x9f\x0c\x9d\x42\x08\x2d\xb9\xb5\x5a\x9b\x5e\xb4\x91\x2c\x36\x8e\x30\xb5\x9c\x77\x87\xa0\x61\xe3\xc0\x4d\x48\xb4\x71\xae\x05\x45\xe1\xa5\x1b\xf2\xad\x8e\x63\x1e\x21\x0d\x2d\x95\xc3\x37\x7c\x23\x2f\xa7\x74\xd1\x60\xfa\xbd\x63\x1f\xe6\x87\xf8\xb0\x1a\xc8\x9f\xec\x87\xb8\x0c\xd2\x58\x78\xb6\xe1\x0e\x8d\x94\xfd\x4e\xba\xfe\x60\xb2\x4e\x5f\x22\xa1\xe9\xe2\x0b\x36\xff\x74\x7f\xbf\x0a\x4f\xd1\x9d\xb6\xa5\xdb\xf1\x58\xde\x05\xb7\xfc\x45\xde\x74\xcc\xda\xd9\xa3\x28\xba\x82\x8a\xf7\x0b\x74\xb5\x7f\x83\x7c\xfc\x4d\xb3\x0d\xfd\x07\xd7\x75\xe3\xca\x49\x7c\x90\xfd\xa4\x0d\xfe\xdd\x95\x38\x59\xbc\x5b\x2c\x1e\xb5\xf5\x93\x8a\xf3\x70\x30\x9d\x4e\x5f\x08\x12\x29\xff\xb6\x40\xf7\x52\xbd\xd0\xe6\xc7\x7b\xe5\x29\x3b\xd6\xf8\x29\xfb\x7f\x00\x00\x00\xff\xff\x03\x2a\xba\x77\x3c\x0d\x00\x00"),
 		},
 		"/src/os/signal": &vfsgen۰DirInfo{
 			name:    "signal",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 13, 33, 47, 237659288, time.UTC),
 		},
 		"/src/os/signal/signal.go": &vfsgen۰CompressedFileInfo{
 			name:             "signal.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 946780500, time.UTC),
-			uncompressedSize: 233,
+			modTime:          time.Date(2026, 10, 15, 20, 20, 46, 420538188, time.UTC),
+			uncompressedSize: 5425,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xce\xbf\xca\xc2\x40\x10\x04\xf0\x7e\x9f\x62\xca\x84\x0f\xbe\x13\xad\x2d\xc4\x42\x3b\xc5\x17\x90\x4b\xb2\x09\x1b\x2f\x7b\xe1\xfe\xd8\x84\xbc\xbb\xa0\x69\x22\xd8\xce\x6f\x60\xc6\x18\xfc\x55\x59\x5c\x83\x3e\x12\x8d\xb6\x7e\xd8\x8e\x11\xa5\x53\xeb\x88\x8c\xc1\x75\x15\x41\x22\xd4\x27\xc8\x30\x3a\x1e\x58\x13\x37\x68\x7d\xc0\xe9\x72\xb8\x1d\xcf\xfb\x3e\xfe\x13\xb5\x59\xeb\xa5\x7e\x6f\x24\xda\xca\x71\x91\x45\xd3\x6e\x5b\x62\x9a\x57\xcc\xba\xd2\x6f\x96\x4e\x7d\xf8\xcd\x81\xeb\x67\x51\xe2\xc3\x00\x26\x04\x4e\x39\x28\x36\x98\x97\x1b\xce\xfb\xb1\x78\xcf\xbe\x02\x00\x00\xff\xff\x29\x0b\xd3\x08\xe9\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x58\x4f\x8f\xe3\x36\xf2\x3d\x4b\x9f\xa2\x22\xfc\x90\x91\x7e\xd1\xa8\x67\x66\x17\x7b\x70\xd2\x87\xec\x60\x32\xf1\x62\xa7\xd3\x1b\xf7\x20\x87\xc1\x20\xa0\xc5\x92\xc5\x36\x4d\x3a\x24\xe5\x4e\xa7\xe1\xef\xbe\x28\x52\xd4\x1f\xdb\x1d\x24\xd8\x05\xb6\x2f\x2d\x8b\xc5\xc7\xaa\xe2\xe3\xab\xa2\xae\xae\xe0\xab\x75\x27\x24\x87\x7b\x9b\xa6\x7b\x56\x6f\xd9\x06\xc1\x8a\x8d\x62\x32\x4d\xc5\x6e\xaf\x8d\x83\x3c\x4d\x32\xfb\x68\x6b\x26\x65\x96\xa6\x49\xb6\x11\xae\xed\xd6\x55\xad\x77\x57\x1b\xbd\x6f\xd1\xdc\xdb\xf1\xe1\xde\x66\x69\x91\xa6\x57\x57\xf0\x51\x71\x34\x70\xa3\x39\x56\xf7\xb6\xec\x41\x2d\x30\x83\xc0\x51\x8a\x03\x1a\xe4\xb0\x7e\x04\xd7\x22\xec\x8d\xae\xd1\x5a\xd0\xeb\x7b\xac\x5d\x05\x4b\x05\x6b\xa3\x1f\x2c\x1a\x5b\xc2\x9e\x6d\x90\x00\xa5\x68\xb0\x7e\xac\x25\x02\x1e\x50\xb9\x00\xb5\x63\xfb\x3d\x72\x70\x1a\xf6\x16\x3b\xae\x5f\xf6\xeb\x2c\xd2\xab\x2b\x9a\x04\xab\xe5\xfb\xbb\x77\x3f\x7e\x00\x58\x63\xa3\x0d\x76\x4a\x6a\xc6\x17\x61\x55\x0a\x56\x58\x60\x6b\xdd\x39\x82\x58\x23\x84\x71\xe4\xd5\x30\x79\x75\x77\x0b\xa3\xf9\x1a\x6b\xb6\x43\x68\x05\xe7\xa8\x20\x3f\x08\x2b\xd6\x42\x0a\xf7\x58\xb7\x4c\x6d\xb0\x00\x6d\xe0\x81\x59\x68\x8c\xfe\x8d\x0c\x1a\x83\xf8\x1b\x16\x03\xdc\xdb\x1f\x6e\xee\xce\xe1\x3c\x8c\xc4\xdf\xc1\x33\x68\xbb\x1d\x72\xc8\xc3\x83\x07\x24\xcc\x5b\xa3\x37\x86\xed\x2c\x48\xa1\xb6\xc8\xe1\x41\xb8\x16\xac\x63\xc6\x01\x53\x1c\xac\xd3\x7b\x68\x3a\x55\x3b\xa1\x95\x05\x26\xad\x06\x83\x35\x8a\x03\x0e\x99\x79\x68\x51\x91\x47\x04\xd7\x6a\xeb\x80\x76\xda\xfa\xa9\x71\x95\xb7\x9a\x23\x98\x4e\x81\x50\xe4\xc9\x5e\x2b\x8b\x94\xb0\x08\xb1\xeb\xac\x03\xa5\x1d\xac\xa5\xae\xb7\xa0\x15\x30\xf5\xe8\x5a\xa1\x36\xc0\xec\xa3\xaa\x5b\xa3\x95\xee\x6c\x49\x58\x56\xa8\x1a\x67\xf9\x8f\x39\x07\x1d\x47\xfc\x06\x43\xcb\x14\x97\x68\xc0\xa0\xeb\x8c\xb2\x95\xa7\x95\xd2\x1c\x57\x7e\x8f\x6f\xd8\x0e\x2d\x11\xc0\x0e\xe4\x72\x2d\x23\xf7\x15\xed\x64\x98\xcd\xa1\x9b\xf2\x90\x9c\x76\x2d\x0a\x03\x8a\x66\x57\xe9\x81\x99\x33\xc8\x6b\x02\xfd\xd4\x09\xe5\xfe\xf2\xe6\xb3\x75\x46\xa8\xcd\x53\x9a\x84\xdf\x79\x7f\x12\xaa\xd5\xf2\xfd\xf7\x1f\x6f\x8b\x05\x00\x64\xe1\x39\x2b\x2f\x19\x2d\x6f\xee\x06\xa3\xe5\xcd\xdd\x65\xa3\x7f\x7d\x5c\x7a\xab\xac\x7f\xbe\x6c\xf5\x71\xf5\xe3\xeb\x68\x45\xcf\xcf\x5a\xbd\x99\x58\xbd\xb9\x6c\x75\xbb\xbc\x7d\x17\xad\xe8\xf9\xb2\x15\x6d\x6e\xb4\xa2\xe7\xcb\x56\xc4\xe9\x68\x45\xcf\xcf\x60\xad\xee\x6e\x07\xac\xd5\xdd\x33\xe9\xfa\x69\x79\xf3\xf6\xfb\x62\x01\x59\x7c\xce\xca\xf4\xe8\x37\x7e\x8f\x8a\x13\xa1\x7e\xe9\xb0\x43\x1b\x59\xcc\x87\xbd\xef\x94\x13\x12\xa4\xd6\x7b\xd8\x8b\x7a\x4b\x64\xc0\x1d\x74\xfb\x0a\x56\xa3\xf4\x10\x10\x37\xda\x6b\x86\x68\x40\xb8\x17\x16\x9a\x4e\x4a\xd2\xa7\x9d\x90\xcc\xf4\x04\x21\xb2\x3b\xb1\xc3\x17\x91\x5b\x61\xd9\xc0\x97\xe8\x09\xf1\x64\x8b\x39\x9d\x54\x08\x91\x94\xf0\xb7\xbf\x06\xf5\x13\x1b\xa5\x49\xe0\x5a\x2d\xf9\xc8\xcf\xf8\x36\x9c\x52\xff\xb2\x5a\xfa\x77\x01\x39\x8e\xcf\x18\xb8\xd6\x5a\x3e\x85\x1c\xdc\xdb\x7f\x0a\xeb\x50\xa1\xf1\xba\xa5\xfa\x83\x22\x87\x97\xca\x3a\x26\xa5\x3f\x4a\xc0\xe0\x1f\xec\xc0\x56\xb5\x11\x7b\x17\x95\x35\x75\x8f\x7b\x9c\xc2\x58\x67\xba\xda\xc1\x53\x9a\x38\x66\x36\xe8\xe0\xff\xef\x6d\xf5\x83\x37\x4e\x93\x80\x0e\xe1\x08\xa4\x89\xc1\x9d\x3e\x60\xff\x13\xae\xae\x80\x8e\x0b\xe8\xc6\x27\x6c\x87\xae\xd5\x3c\x9c\xc0\x60\xe8\x77\x60\xf0\xad\x4a\x93\x46\x01\xfd\x4d\x56\x08\x51\x8d\x5e\x87\x6c\x4d\xdc\x8e\xb3\xed\xc4\xa8\xd1\x06\x90\xd5\x43\x02\x43\xe6\x86\xe1\x59\xee\x3e\x7d\x1e\x63\xed\x73\xb8\x45\xdc\x7f\x4b\xf5\xa7\x4f\xa1\x50\x0e\xcd\x81\x49\xa0\xfd\x36\x21\x00\xb2\x09\xee\x47\xdd\x88\x15\xca\x74\x4a\x51\xf4\x0f\xad\x90\x9e\x4d\x3b\x26\x14\x21\x3d\x30\xe1\x68\x80\xbc\xeb\xb7\xbb\xec\xb5\x2e\xfc\x9c\xc4\xc2\xa4\x56\x08\x5c\xab\x17\x2e\x78\x3f\xfa\x34\x49\x0e\xa1\x33\x82\x8d\xfc\x25\xb6\xd9\x58\x2f\x49\xf5\xfd\xa2\xd3\x15\x83\x5b\x24\xbe\xd3\xd5\x02\xed\x87\x0c\x95\x20\xc5\x16\x81\xc1\x7b\x3d\x02\xe9\x4e\xf2\xd2\xdb\x20\xe3\xb4\xa7\x06\xa9\xf8\x7b\x05\x07\x8e\x8c\x07\x61\x37\x84\x84\xbf\x86\x50\xa3\x5c\x1b\x9f\x4a\xa5\x83\xe0\xa3\x0c\xb5\x81\xeb\x2a\xa5\xca\x33\x8b\x21\xa7\x67\x20\x46\x17\xc4\x39\xd1\x84\x10\xae\xaf\x21\x1f\x73\xf0\xc5\x35\x28\x11\x0c\x92\xa0\xfd\x69\x72\x1c\x8d\xe9\xf5\xbd\xad\xde\x4b\xbd\x66\xb2\x5a\xa1\xcb\xb3\xff\xc3\x5f\xc9\x5b\xe4\xdf\xc5\x52\x97\x95\x30\xda\xbc\x7f\xc6\xa6\xa8\x96\xca\xe5\xc5\x57\xaf\x8b\x34\x49\xc6\xf5\xaf\x27\x53\xdf\x32\x29\xf3\xcc\xa2\x5b\xf6\x34\xc9\x4a\x5f\x4f\xf3\xe0\x1e\x39\x75\xba\x0e\x31\xe2\x3b\xa1\x84\x6d\x91\x67\x45\xf5\x77\xad\x65\xb4\x4e\x66\xb9\x68\x98\xb4\x48\x4b\x53\x74\xc9\xb1\x84\xd7\xaf\x5e\xbd\x2a\xe6\x41\xff\x57\x03\x7d\x49\x81\x9e\xc6\x56\x4b\x64\x66\x12\xdd\x90\x87\x22\x9d\xe5\x44\x09\x49\xe7\xd5\x6f\x69\xdf\xc0\xe5\x56\x6c\x7a\xe5\xf3\x01\x5a\x94\x18\xc4\xa4\x66\x16\x07\x9d\xfc\xe6\x25\xf1\x73\x91\x26\x1c\x1b\xd6\x49\xb7\xa0\xc8\x66\x47\x9f\xd8\x26\x59\x8d\x76\x42\xdb\x91\xd6\xf4\x2f\x48\xa6\x56\xd8\x17\x79\xa1\x0e\x9a\x48\xec\x03\xf4\xdd\xd5\x6a\xda\x03\xb4\xec\x80\xa0\x34\xe0\x2f\x9d\x38\x30\x89\x8a\x66\xf8\xa3\x33\x11\x17\x54\x07\x61\xb4\xda\xd1\x28\x33\x08\x56\x90\xa1\x7c\x24\x34\x85\x07\x34\x63\xa3\xda\x53\xb9\x77\x77\x12\x77\xd9\xbb\x10\x48\x11\x04\x73\x3c\xc3\x85\x4f\x4b\xa7\x26\xf3\x8a\x34\x61\x9c\xc3\xe2\x3a\xcc\x38\x53\xdd\x32\x68\x7a\x09\x8c\xf3\x0f\x5e\x53\xcb\x5e\x4f\xc3\xaf\x5e\x7e\x03\xa1\x1a\x45\x40\xf7\xb6\xfa\xc0\xb6\x48\xdb\x9d\x07\xd0\x56\xd8\x19\x24\x33\x9b\x6e\xe7\x7b\xe6\x4f\x9f\x27\xde\x05\xed\x6b\x58\x8d\x4f\xc7\x40\xd0\x10\x4c\x3e\xd8\x7f\x7a\xf5\xd9\x13\x34\x30\xd2\x73\x20\x49\x8e\xf4\x2a\x38\x1e\x38\x34\x71\xb5\x77\xbe\x51\x64\x33\x48\xce\x27\x2b\x36\x9f\xe1\x1a\xa8\x59\x57\x3c\x9f\xbf\x2f\x27\xf5\xe8\x29\xc0\x2e\x20\xfc\xef\xf1\x16\x11\x36\x24\x62\x31\x4b\x08\x2d\xb6\x80\x46\x79\xaf\x66\xe7\xcb\x99\x8e\x48\x7c\x4c\xbd\x76\x8c\xd7\x87\xc5\xf5\xd9\x91\x19\x06\xb3\xe2\xeb\x89\x65\x50\x22\xf8\xf2\x4b\x18\x5a\x14\x8f\xed\x37\x92\x54\xeb\xa4\x57\xf2\x49\x9c\x76\xe5\xbe\x13\x1f\xef\x39\xbe\xbd\x26\xaa\x31\xbb\x0d\xb7\x16\x87\x66\x27\x14\x73\x48\x26\xa1\xe5\x4e\x88\x20\xf9\xe0\x44\x09\x19\xbd\xcf\x4a\xc8\x18\xe7\xef\x28\x11\x31\x5b\xf4\x2e\xa4\x62\xfe\xba\x88\x7a\x19\xab\xd6\x79\xc4\xfd\x08\x85\x1b\x8d\xbe\xf0\x46\x74\x6d\x6b\x84\x42\x4e\x51\xf7\x43\x61\x8a\x56\x59\x71\x66\x44\x01\x8b\xc6\x77\xd3\x25\xe8\x2d\xad\x74\xd2\x4e\xfb\x3d\xfe\x9a\xc6\x02\xc3\x38\xcf\x7b\xd8\xb2\x9f\x46\xc8\x43\x24\xd3\x20\xbc\x2e\x8e\x62\x98\x26\x5c\xd7\x9e\x97\x17\x02\x8a\x43\x34\x4f\x34\x30\x58\x5e\x5f\x70\x78\x84\x4c\xec\x83\x70\x75\x3b\xee\xe3\x64\x7b\xa3\x8c\x9d\xec\xf1\xa2\xdf\xa0\x61\xfd\x12\xb2\xe9\xdd\xf2\x4f\x6e\xd4\xd9\x12\xab\xbb\xdb\xb8\x44\x0c\xa2\x84\x2c\xdc\x23\xff\x24\xf6\x29\xc8\xe9\xed\xf2\x3f\x74\x95\x7a\xfd\x0b\xae\x86\x1b\xea\xff\xd6\xd5\xe3\x50\xaa\x3a\x75\x2e\xda\x7e\x6f\xa9\xbe\xfc\x5c\x82\x24\x2e\x19\x5a\x02\x4e\xe4\x8a\x98\x22\xab\xa9\xce\xc9\x2a\x2c\x57\x82\xac\x7a\x45\x92\x95\x97\xba\x23\x15\x37\x89\x0e\xf3\x49\x9b\x15\xd4\x9e\xa4\x07\xd5\xf8\xde\xeb\xc6\x2b\x78\x3a\x55\xab\xd8\x0d\x8c\xae\x87\xea\xf7\x33\x2a\xb6\x96\x78\xea\x7e\x5c\x2e\xdc\x18\x86\xc5\xc6\x58\xcb\x67\x4a\x52\x3c\xb2\x61\xe0\xc2\xa1\xf7\x03\xe1\x50\xd1\x6d\x21\x2b\xaa\x95\x2f\x39\xb9\xf7\xfc\x7c\x67\xc2\xb9\xee\xbf\x88\xfc\xde\xc1\x0c\xbf\xc7\xf9\x2b\xc7\xdc\x19\x7c\xc0\xc9\xfa\xde\x2a\xfc\x22\x2f\xf3\x3f\xa0\xc0\x74\xc3\xec\x1b\xad\x78\xc4\xfb\xde\x2a\x4d\x92\x49\xcf\x42\x59\x2e\x4e\xd3\xcc\x85\xfd\xe3\x79\x3e\x29\xe9\x27\x50\xc1\xf8\x14\xa9\x87\x88\xb5\x90\xaa\x53\x4a\xc5\xe2\xdb\xf1\xfe\xe6\x7b\x17\xae\x71\xec\xa6\xf7\xa6\xff\xd8\x45\xe5\xa3\xef\x9f\x62\xcb\xa1\x9b\xf1\xf3\xda\x43\x2b\xea\xd6\xc3\x11\xaf\x77\xda\xba\xf1\xd2\x69\xe7\x85\x66\xf2\xc1\xad\xba\xc4\x98\x19\x57\x8e\xcf\x04\xc7\x67\xd1\x51\x43\x0f\x4f\xfd\x87\x1a\x98\x05\x7a\x32\xdb\x60\x7d\xc8\x8b\x7e\xe2\x38\xe5\x9b\x97\xb1\x59\x0c\x7d\x61\xb0\xfe\x89\x09\xf7\x51\x39\x21\x97\x5c\x22\xb4\x6c\x4c\x8b\xd3\xc3\xdd\x67\x7e\xcb\x3a\xf9\xc0\xe8\xb1\xc6\x0f\x50\xf2\x91\x3e\xcc\xed\x66\x4d\xe0\xf4\x53\x93\xad\xa6\xce\xce\x96\xa7\x16\xfe\x98\xfe\x7b\x00\x14\x70\x7d\xf4\x31\x15\x00\x00"),
 		},
 		"/src/reflect": &vfsgen۰DirInfo{
 			name:    "reflect",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/reflect/example_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "example_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 311,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\x8d\xcf\x4a\x33\x31\x14\xc5\xd7\xbd\x4f\x71\xc9\xe2\xa3\xe5\x93\xa4\x95\xba\xe8\xec\x5c\x88\xe2\xa6\x62\x1f\xc0\xa6\x93\x9b\x3f\x75\x92\x0c\xc9\x8d\x08\xa5\xef\x2e\x33\x22\x82\xbb\x03\xbf\x73\xce\x4f\x29\xfc\x7f\x6a\x61\x30\x78\xae\x00\xa3\xee\xdf\xb5\x23\x2c\x64\x07\xea\xf9\x8d\xa9\x32\x40\x88\x63\x2e\x8c\xc2\x46\x16\x00\xb6\xa5\x1e\x1f\x3e\x75\x1c\x07\x3a\x70\x69\x3d\xef\xed\x72\x85\x17\x58\x28\x85\x8f\x79\xf4\x54\x9e\x0f\x68\x32\x55\x4c\x99\x31\x4c\xbd\x48\x89\x7f\x4e\xa5\x36\xe6\xf5\x3b\xee\xad\xc5\x44\x64\xc8\xa0\xcd\x05\xd9\x87\x8a\x93\x52\xce\x5f\x07\x22\xf4\xcc\x63\xed\x94\x72\x81\x7d\x3b\xc9\x3e\x47\xe5\x66\xc5\xb9\xfe\x86\x50\x6b\xa3\xaa\xb6\xbb\x1d\xc0\xc2\x46\x96\x2f\x25\x24\x1e\xd2\xf2\xf8\xa1\x87\x46\x1d\xfe\xbb\x3c\x51\x70\x9e\xbb\xb5\xdc\xe2\xbd\xa3\xee\xf6\x0a\xe7\x9a\x53\x87\x78\x11\x7e\x46\x62\x62\x37\x42\x3b\x12\x13\xfd\x3b\xdc\xc8\xbb\x79\xb8\x59\x5f\x8f\x2b\xb8\xc2\x57\x00\x00\x00\xff\xff\x0d\x48\xa9\x1a\x37\x01\x00\x00"),
 		},
 		"/src/reflect/reflect.go": &vfsgen۰CompressedFileInfo{
 			name:             "reflect.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 42358,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\x7f\x73\xdc\x36\xb2\xe0\xdf\x33\x9f\x02\x9e\xda\xd2\x92\x36\x43\x59\xca\xbe\x54\x4e\x89\x52\xb5\xeb\x24\x7b\xda\x5d\xdb\xa9\x38\xce\x55\x9d\x9e\xca\x0f\xe2\x80\x33\xf0\x70\x40\x2e\x89\x19\x69\x56\xd6\x77\xbf\x42\x77\xe3\x17\xc9\x19\xc9\x76\xf6\xde\xd6\xab\xcd\x1f\xb1\x86\x04\x1a\x8d\xee\x46\xa3\x7f\x01\x3c\x3e\x66\xcf\xae\x37\xb2\x9a\xb3\xf7\xdd\x74\xda\xf0\x62\xc5\x17\x82\xb5\xa2\xac\x44\xa1\xa7\x53\xb9\x6e\xea\x56\xb3\x64\x3a\x99\x89\xb6\xad\xdb\x6e\x36\x9d\xcc\x3a\xdd\x16\xb5\xda\x9a\x3f\x37\xaa\xe3\xa5\x98\x4d\xa7\x93\xd9\x42\xea\xe5\xe6\x3a\x2f\xea\xf5\xf1\xa2\x6e\x96\xa2\x7d\xdf\xf9\x3f\xde\x77\xb3\x69\x3a\x9d\x6e\x79\xcb\xa4\x92\x5a\xf2\x4a\xfe\x43\xcc\xd9\x39\x2b\x79\xd5\x89\xe9\xb4\xdc\xa8\x02\xde\x24\x29\xbb\x9b\x4e\x8e\x8f\x19\xdf\xd6\x72\xce\xe6\x82\xcf\x59\x51\xcf\x05\x13\x95\x5c\x4b\xc5\xb5\xac\xd5\x74\xb2\xe9\xc4\x9c\x9d\x9d\x33\xd3\x2d\x91\x4c\x2a\x2d\xda\x92\x17\xe2\xee\x3e\x65\x77\xf7\xf8\x3e\x69\xf5\xae\x31\x4f\xe8\xe7\x46\x15\xf5\x7a\x5d\xab\x5f\xa2\xa7\x6b\xa1\x97\xf5\xdc\xff\xe6\x6d\xcb\x77\x71\x93\x62\xc9\x7b\x9d\xcc\xb0\xf1\x13\x87\x41\x0f\x3a\x6f\xe2\x07\x8d\x6e\xe3\x07\x5d\x25\xfb\x9d\x3a\xdd\x6e\x0a\xdd\x83\xdf\xc7\x13\x1b\xfd\x28\x45\x05\x0f\xa7\x93\x98\xac\xba\xdd\x88\xe9\x64\x23\x95\xfe\xda\x00\x62\xe7\xcc\xfc\xf3\xba\x4c\xe0\x51\xf2\x3c\x4d\xf3\xe4\x29\x10\x28\x65\xc7\xc7\xac\x13\x9a\x95\x75\xcb\x5a\xc1\xab\xe9\x3d\xb1\xe3\x7d\x67\xfa\x24\x7a\xd7\x40\xe7\x94\x3d\x7d\xdf\xe5\xaf\xaf\xdf\x8b\x42\x1b\x1e\xb5\x42\x6f\x5a\xc5\xde\x77\xf9\x85\x99\xbc\xe2\x15\xbe\x33\x1d\xd2\xfc\xcf\x42\x27\x33\x84\x30\x4b\x1d\x48\x92\x2b\x07\xd7\x43\x4c\x19\xa2\x63\x20\xcb\x92\xe9\x5d\x83\x20\x82\x1e\xb3\x94\x9d\x9f\x9b\xf1\xde\xaa\xb9\x28\xa5\x12\x73\xd3\x78\xd2\x6a\x23\x09\x47\xc8\xed\xe9\x64\x32\xe9\xe4\x3f\xc4\x19\x33\x13\x6d\x74\x9b\x38\x48\xe6\xf1\x2c\x35\xc8\x26\x69\x9a\x99\x86\x2b\xa9\xe6\xd8\xf0\x6b\xdf\xcc\x3c\x8c\x9b\x75\xba\x3d\x63\x4c\x89\x9b\x57\x7c\x2d\x5e\x97\x65\x42\x7f\x22\xd3\x15\xaf\xde\x44\xc3\xe8\x56\xaa\xc5\x2c\x4d\x33\x36\x9b\x65\x7e\x22\xe2\xd6\xac\x24\x61\x60\xff\xa9\xae\xab\x24\x45\xe8\xf7\xd3\xc9\x64\x48\xc2\x56\xa7\xf9\x9b\x80\x82\x00\x27\x9d\x4e\x26\x06\xdc\x9b\x3e\x5d\x32\x36\x0a\xc1\x48\xc5\x04\xe5\xe6\x8d\x00\x22\xbd\xef\xf2\x3f\x57\xf5\x35\xaf\xf2\x17\xbc\xaa\x92\xd9\xef\xdc\x5b\x3f\x82\x2c\x99\x7b\x9a\xff\x4d\xa8\x85\x5e\x26\x29\x7b\x72\xce\x9e\xb3\x0f\x1f\xfc\x74\x14\x5f\x07\x73\x01\x46\x4c\x5a\x9d\xeb\xb2\xe2\x0b\xf6\xe1\x9c\xc1\x1f\x6f\x69\xc9\x99\x97\x21\x53\xc7\x3a\x0f\x7b\x1b\x1a\xcf\xcd\x2b\x43\xa3\x89\x51\x1d\x34\xe9\x97\x80\x5f\xc7\x2e\xaf\x10\x53\xf3\xda\x48\xaf\x34\x73\x7c\xfe\x0d\x93\xec\xdb\x91\x39\x7c\xc3\xe4\xb3\x67\xec\xce\x88\xfb\x0f\xc4\x0b\x6a\xd5\xb1\x52\xb6\x9d\xce\x01\x8d\xb5\x01\xe2\x7b\x5f\xa8\xb9\xb8\x4d\x64\x0a\xef\x2c\x0f\x4d\x93\x90\xf9\x6b\x9c\x56\xb3\x32\x7c\x37\x42\x3a\x9b\x41\x7b\x59\xb2\x27\xae\x0f\xce\x72\x52\xd4\x4a\x4b\x65\x56\xa7\x9d\xd9\xa4\x37\xad\x73\xc6\x9b\x46\xa8\x79\x12\x3f\xcf\x08\x2b\x82\x63\x68\x78\xf6\x90\x54\xae\x3d\xbd\x9d\x44\x5a\x84\x48\xba\x27\x93\xb5\xde\x35\x00\x09\x55\x44\x99\x84\xab\x94\x20\xe8\x5d\x33\x4b\x6d\x8f\xfb\xd4\x71\xe5\xb6\xa8\x37\x0a\x64\xcb\x2c\xa3\x93\xaf\x92\x4a\xa8\x1e\xde\x69\xfa\xd1\xfc\x79\xab\x44\x9f\x43\x9d\x28\x6a\x35\xff\xa7\xb0\xe8\x7f\x36\x87\x36\xa8\x1e\xa3\xdd\x0f\xda\x34\xab\xc5\x4f\x5c\x2f\x3f\x42\xb5\x21\xf1\x10\x47\xd8\xb7\xed\x70\x6b\x90\x82\x33\xc6\xac\x14\x0c\xb9\x4b\x2d\x6f\x5d\x4b\xfc\x0b\x9f\xbe\x23\x2e\x9f\xf5\x56\x78\xe6\x67\x11\xa0\xff\x92\x37\x97\xad\xbe\x62\xe7\x6c\xa3\xcd\xbb\xa1\xf2\xdb\xec\x53\x9f\xf7\x46\x25\x76\x37\x52\x17\x4b\xd6\xea\xfc\xaf\x52\xcd\x49\xff\x14\xbc\x13\xec\x8f\x66\xf3\x3f\x03\x9d\x2f\xb4\x79\x09\x04\x6e\x75\xc6\x8e\xbc\x5d\x80\x62\x56\x89\xf5\x59\x7f\x3b\x23\x45\x5f\x89\xf5\xcc\xce\xb7\x12\xea\x8c\x0d\xf7\xa2\x4a\xa8\x78\x8f\x01\x86\x01\x0e\x2f\x96\x5c\x01\x0a\x73\xd9\x1a\xce\xfd\xa9\xd6\xcb\xef\x65\xdb\x57\xa1\x9d\x50\xf3\xd7\xaa\xda\xf5\xb5\xa8\xe9\x75\xce\xde\x08\x35\xa7\x4e\xf7\xfd\x9e\xad\x28\xb6\xfb\x7b\xfe\x2c\x8a\x6d\xd8\x73\x40\x08\x67\x0d\x7d\x14\x1d\xe6\xb2\x0d\xe8\x30\x97\x6d\x7f\xda\x3f\x6e\x54\x01\xd3\x6e\x78\xcb\xd7\x9d\x99\xb9\x97\x3b\x78\x34\x03\x99\x96\x0a\x16\x3f\x5f\x89\xe4\xf2\x0a\x4d\x86\x8c\x61\x03\x2f\x6b\x91\xc2\x69\xb9\x5a\x08\x26\x15\x4d\x53\xaa\x4b\x69\x64\x27\xc4\x99\xfa\x5b\x45\xe2\x17\x4f\x2b\xba\x4d\xa5\x63\x6c\xe8\x19\xa2\x53\xe3\xf2\xea\xe1\x43\x4d\x0e\x22\x64\x7a\x22\x46\xf5\x46\x0f\x51\xb2\x20\x86\x38\xd5\x1b\xfd\xa2\xa7\x74\x47\xc7\x0b\x79\xbe\xe5\xad\xe4\x73\x59\xf4\x79\xee\x60\x7d\x38\x67\x27\xec\xdb\x6f\xd9\xc9\x7f\xec\xe7\xbc\xb3\x7a\x69\xbb\xde\x35\xc2\x2c\x64\x63\xb8\x65\x44\xda\x17\xb4\xba\x09\xaf\x3e\x5f\xb2\x68\xd0\x33\x66\xff\x22\x2d\x20\x15\xc0\x63\x4c\x2a\x7a\x52\x6f\x34\x3e\xaa\x37\xba\x27\x30\x17\xd6\xe2\x06\xa9\xb1\xdb\x44\xc8\x28\x7a\x46\x72\x13\xb4\x20\x6e\xd1\x23\xab\xb5\x1f\x90\x1f\xdb\xff\xae\xbf\x05\x75\xf1\x06\x64\x1b\x22\x4b\xe5\x6f\xb3\x23\x3c\xb0\x93\xb9\x8d\x02\xf6\x89\x8f\xda\x28\xf6\xb3\x3b\x76\x69\x62\x9e\x3b\x96\xbb\x4d\xe4\x23\x37\x0e\xda\x37\xac\xda\xb7\x44\xeb\xf1\xf8\x25\x6f\xc6\xb5\xb1\xf5\xab\x00\xca\x4a\xec\xce\xd8\xb8\x0e\x5a\x89\x9d\x23\xce\x23\x55\x95\x1f\xfd\x27\xdd\x8e\x8f\x6e\x9d\xb8\x4f\x03\xfb\xc6\x78\x7c\xe3\x80\xbd\x33\xf8\x89\xa0\xc1\x29\x04\xd8\xa5\xf1\x0c\xe3\xf5\x80\x8f\x70\x39\x10\xd0\x1f\x5d\x2b\x5a\x13\x81\x5b\x99\x31\xec\x70\x70\x59\xc4\x70\x10\xed\x12\x3c\x73\xec\x1b\x2d\x8d\xba\x2c\x3b\xa1\x7f\x58\x5f\xa3\x79\x66\x77\x03\x99\x82\xe6\xb1\xe6\x58\x49\x33\x34\xcd\xe6\x43\x37\x21\x82\x62\xd4\xd6\xd0\x4c\x43\x6c\x70\x01\x86\x7e\x72\xb8\x08\xe9\xbf\x31\xb1\x2d\x7b\x0b\x70\xe4\x9d\xe6\x28\xd0\xe5\x3e\xdf\x2e\x5a\x8f\xf4\x5f\xc8\xc8\x32\x5c\x8b\xd9\x60\x62\x67\x2c\xf8\xf1\xe0\x4a\x0d\x02\x06\x9f\xbb\x4c\x4d\xab\xd1\xa5\x8a\xfc\xf4\xeb\x0c\x69\xec\xe5\xef\x7e\x0a\xc6\x15\x05\x05\x6c\x6c\x21\xc1\xf8\x50\xfe\x53\x0d\x03\x26\xe3\x6e\x7d\xfe\x16\x5a\x19\x97\xd8\x45\x0a\xe2\x49\x32\xbb\xb3\xae\xe8\x59\x2f\xe4\x33\x3d\xe4\x43\xdb\x3e\xa3\x7e\xb2\x7d\x69\xa4\xfb\xc0\x5b\x72\xba\xf5\x41\x77\xfb\x7e\x3a\x85\x10\x46\x68\xac\x92\x00\x1a\x14\x89\xbc\x4c\xa1\xf2\x9f\x92\xd9\x6c\x77\xcb\xa9\x75\xa6\xdc\xef\x75\x5d\x96\x8c\x8c\xea\x2f\x4f\xa7\x53\x67\x27\x7b\xcf\xd7\x92\x2b\xd1\xec\x69\x38\x6c\x6a\x37\xa7\x24\x75\x8d\x83\xa0\x8d\xce\x2d\xa8\x03\x10\xac\x54\xbf\x7c\x1c\xa4\xcb\x33\x9d\x93\x79\x6f\xff\xb8\x32\xd0\x8d\xe3\xde\x33\xdf\x19\xe9\x9b\x35\x6f\x2e\x91\xb3\x57\xf1\xd8\x01\x4e\x14\xa4\xb2\xaf\x93\x34\x46\x33\x40\xa5\xef\x23\xe0\xf0\xc0\x11\x6b\xba\x04\xdc\xc0\x68\x13\x63\xec\xbf\x48\x16\xcf\x66\xa6\xd5\xec\xbf\xa6\xd6\x8e\xf1\x8c\x70\x66\x12\x3d\x98\x1a\x5b\x85\x31\x6b\xf0\x4d\xc1\x50\xf1\x3f\x43\x92\xda\x91\x53\x26\x15\x50\xd0\x87\xb9\x3c\x05\xa5\xda\xd3\xa7\xde\xe8\xbd\x9d\xea\x8d\x76\xf3\x33\x22\x15\xcc\xed\x7a\xa7\x45\xc7\x9e\x9a\x7f\xa2\x26\xdf\x73\xcd\x83\x66\xd0\xcb\xfc\x87\x31\xab\xe9\x44\xf3\x05\x8b\x1e\x38\xd7\xf8\xba\xae\x2b\xcb\x4c\xd3\xad\xcf\x44\x33\xd4\xd5\x53\x3b\x86\xe3\x9f\x82\xc6\x29\xfc\x3f\x49\x59\xd2\x11\xe4\x94\xdd\x31\x9a\x09\x41\xbb\x54\x39\x60\x7d\x95\x03\x56\xf7\x3d\x00\x9a\x2f\xe2\xfe\x07\x00\x98\x59\xf4\xfb\xd3\xda\x4b\x52\x02\x10\xf4\x9f\xcd\x06\xad\x65\x67\x23\x44\x49\x0a\x53\x3f\x30\x9a\x23\x91\xe5\xa0\x55\xb1\x2a\x33\x58\xd3\x78\xde\xa9\x07\x78\x48\x11\x60\x95\xd9\x09\x95\xb8\x49\x0c\xb8\x14\x79\x62\xe0\x5f\x9b\xcd\xeb\xc8\x12\xd4\xe8\x75\xbf\x6f\x81\x75\xac\xf9\x82\xb6\x16\xcd\x17\xe6\x81\x1d\xe0\xcc\x0d\x95\x19\x9d\x3c\x09\x10\x37\x60\x00\xed\x33\x76\x0d\x2f\x03\x8e\xbe\x2e\xcb\xbf\xc9\xce\x48\xb1\xf9\x35\x5c\x80\xd4\x26\x31\x3a\x89\xfe\xf6\xb3\x08\xc6\x20\x38\x97\x52\x69\xd3\x36\xbd\x9a\xf6\x08\x03\x76\x6f\x20\x17\xaf\xcb\x12\x82\xbe\x86\x10\x95\x50\x49\x00\x84\xe8\x61\x51\x73\x61\x97\xe0\x61\xc6\x54\xda\x1f\xdf\xd8\x1b\x34\x33\x8d\x76\x30\xcd\x8c\xd6\xe7\x60\x6e\xd4\x0a\xe6\x46\x7f\x87\xf1\x68\xbb\xe6\x3c\xac\xf1\xd9\x59\xa3\x7b\x00\x38\x9a\x5f\x00\x26\x9d\x4e\x42\x04\xdd\xfc\x82\x87\x19\xd3\x69\x1f\x03\x9a\xdf\xf1\x31\xe3\xf3\xf9\xcf\xa8\xbd\xcc\x28\x7c\x3e\xef\x18\x67\x0d\x6e\xb6\x4c\xd7\x4c\x2f\x9d\x89\x26\x6b\xc5\xaa\xba\x5e\x6d\x1a\xb6\xe6\x8d\xf1\x87\xe1\xe5\x46\x69\xb9\x16\xb9\x01\x76\xa1\x49\xc8\x0d\x10\x25\x6e\xd8\xc5\xf7\x4c\x2f\xb9\x66\x05\x57\xec\x5a\x30\x48\xba\x70\xf3\xd2\x4e\xab\x6e\x99\x16\xb7\x66\xec\x8c\x71\x35\x67\x37\xb2\xaa\x0c\xa4\x6b\x33\x6a\x57\x57\x5b\x31\x67\x45\xdd\xb6\xa2\xd0\xd5\x2e\x67\x17\xeb\xa6\x12\x6b\xa1\xcc\x2a\x88\xc7\x67\x94\x78\xca\x91\x96\xd1\xb4\x92\x46\x9b\x0d\x24\xb4\x23\x8c\x32\xd5\x5f\x9e\x7e\x16\x59\x9d\x89\xd2\xe8\x36\xf5\x24\x06\xc0\x44\x60\x4a\x4a\x79\x4b\xa9\xd3\xed\xeb\xeb\xf7\x51\xd6\x82\xd4\xc9\xdd\x14\x02\xd4\x05\x69\xd7\x3b\xf3\xaf\x7d\x77\x3f\x66\x59\x14\x64\x52\x74\xba\x9d\x65\x0c\x01\x43\x2a\x66\x21\xb4\xed\x78\x23\xf5\xd2\x6c\x2c\x16\x05\xf9\x0f\x50\xca\x84\x69\x91\x77\xba\xf5\x68\x76\xff\xa7\x35\xd3\x9c\x07\xf9\x1a\xd4\x5c\x41\xa6\xc6\xfa\x10\x94\x9e\xb9\xc1\x1e\xce\x6a\x75\xc0\x8a\xba\xd9\xa1\x2f\x91\xcc\x0d\xad\xba\xb6\x08\x26\x0d\xd1\x34\x1a\xe2\x6e\x1a\x78\x1a\x83\x01\xbc\xc7\xd1\x0f\xff\xf6\x5c\x0b\x8a\xfd\x4e\x27\x93\xa6\xad\x9b\x11\xff\x81\x0c\xd4\xb6\x6e\x66\x69\xfe\x06\xc8\x93\x18\xb3\x73\xde\x69\xa0\xa3\x79\x03\x78\x42\x43\xf3\xcb\xf0\xf4\xde\xcd\xc8\xec\x54\xbf\xf2\x6a\x23\x12\x0d\x98\x67\x6c\x1b\xcd\xa8\xac\x58\x59\xf1\x45\xca\xa0\x11\xda\x07\xe0\x3c\xe5\xd6\xec\xc0\xb4\x94\x0d\x19\x9e\x9f\x63\xb0\x10\x72\x22\xc1\x43\xa4\x5a\xff\xe9\x4f\xba\xc5\x54\x15\x32\x02\xc6\xb8\x33\xa6\x7b\xcf\x3c\xde\x7a\x4b\x18\x50\xfa\x00\x48\x25\x16\x54\x7a\x1f\x2a\xf4\xbd\x50\x06\x59\x1e\x25\x6e\xcc\x26\x42\xef\x67\x19\xdb\x66\x96\x57\xad\xce\x8d\x37\x5b\x1b\xdb\xfb\x81\xc1\xe9\xc1\x85\x9a\xcb\xd6\x13\xf6\x25\x5f\x09\xf0\x68\x9d\xdc\x65\x66\x39\x66\xac\x00\x25\xa3\x03\x8a\x52\x40\x8a\xc8\xf2\xe4\x1c\x3d\x61\xe4\x3a\x57\xb2\x70\x5e\x41\xee\x80\xb2\xba\x64\xaa\x56\x5f\x80\x63\x0c\x6a\x67\x06\x6c\x35\xb0\x2a\xa1\xd8\xb7\xec\xf9\xc1\xfe\xc6\xe1\x59\x70\x2d\xb7\x82\x41\xc8\xd5\xf6\x35\xc8\x7d\x44\xdf\x82\x37\xf1\xb8\xdf\x01\x84\xc3\xbd\x5d\x3b\xec\xea\xf8\x16\x88\xe2\xae\xc9\x46\x72\x72\x16\xc4\x2c\x0b\x57\x94\x27\xeb\x98\xff\x01\x89\xf0\x38\x43\xcb\x06\xcb\x3e\xff\xa1\x12\xeb\x24\x4d\x69\xa4\x7f\x88\xb6\x9e\xa5\xec\xde\xf0\xfb\xb9\x5f\xfc\x94\x28\xee\x65\xd5\x7f\xf1\xb9\xd9\x27\x61\xaa\x19\xf2\x35\x98\xab\x87\x02\x01\xc3\x31\x97\x76\xf6\x22\x4f\xe9\xd9\x7b\x4b\x44\x69\x96\x85\x92\x55\xb8\x2c\x94\xac\x42\xf9\x0e\xdd\xe5\xe1\x84\xad\x4a\x28\x6a\x85\x2a\xb7\x6e\x67\x81\xfb\x08\x04\x1e\xce\x22\x94\xc5\x31\x14\x70\x4d\x45\xcb\xcc\xb3\xeb\x53\x10\x1a\xe3\x95\x6d\xf9\xbb\x2d\xaf\x66\x31\xed\x41\xa7\xbc\x2e\x13\x74\x04\xa5\xd2\x19\x13\x95\x58\x93\xb2\xed\xf9\x3b\x3d\x7c\x62\x29\x72\xf9\x0a\x2f\x45\x06\x52\x9a\x31\x80\x1d\x90\xea\xc5\x92\xab\xd7\x65\x32\x97\x2d\xfc\xf9\xbd\x6c\x33\xa6\x3f\x61\x44\x9b\x18\x08\xc4\x36\xcd\x18\x64\x15\x5c\x42\xc2\xfd\xa6\x34\x43\x80\xc6\x8f\x1b\x55\x18\x86\xa9\x8c\xa1\x33\x45\x6a\x9a\x22\xd7\x64\x36\x07\x62\xe8\xde\x1c\x1d\x31\x48\x3b\x4a\x05\xca\x16\xf2\xd4\x52\x5d\xd2\xa3\x2f\x4e\xae\xfa\x2a\x27\x1d\x5b\xb9\x38\xfe\x19\xab\x78\xa7\x19\x6f\x17\x46\x90\xdd\x10\xb8\x87\x6c\x3a\x6d\x4c\x1b\x50\x46\x76\x51\xbf\xef\x2e\xa2\x8c\x44\xb0\xa7\x10\x02\x76\xf7\x33\x5b\x4e\x3f\x1d\x61\x7a\x63\x9c\x8a\x48\xb6\x45\x35\xf3\xbe\x7b\x1d\x27\x16\x7a\x60\xeb\x8d\x1e\x87\x6b\xb3\x0a\x00\x60\x0c\xf2\x63\x38\x69\xfd\x4f\xe0\xe4\x85\x32\xff\x7f\xbd\xd1\x9e\x17\x01\xd7\x5e\xf2\xe6\x75\x99\xac\xc4\x6e\x54\x50\x29\xd3\xb6\x12\xbb\x20\xd5\xe6\xd2\x3d\x99\xe9\x9d\xf9\x78\xe8\x40\x95\x36\x86\x1f\x52\x6d\x79\x25\xe7\x06\x08\x6c\x00\x6c\xc6\x9e\x01\x44\x6b\x05\xc4\xda\xf5\xe0\xc4\x28\x6c\xec\x25\x74\x25\x76\x69\xbc\x3e\x82\xb9\x05\x76\x3c\xed\x91\x43\x9f\xe0\xe0\x70\x14\x27\x0e\x17\x44\x00\x1e\xe6\xfd\xba\x4c\x3e\x65\xad\xb9\x40\xf1\x3e\xd8\xa0\x81\x5e\x97\x09\x19\x67\x97\x57\x6f\x7c\x1c\xd4\x0f\x65\x4c\xd6\x04\xa4\x85\x02\xb8\x6c\xaf\xc4\x21\x20\x88\x01\x97\x9d\xd0\xe8\x79\x9a\xd6\xcd\x25\x5a\xab\x14\x3a\xbe\xbb\x37\xea\x73\xd2\xac\x16\x0d\xd7\xcb\x20\x94\x30\x59\xf2\xee\xcf\x2f\x7e\x6a\xeb\x05\x06\x13\x26\x5e\x7e\x01\xb6\x97\xe1\xd2\x07\x93\x65\x89\xbf\x72\xe3\x38\x62\xae\x03\xc3\xc0\x3d\x59\xb1\xf3\x3d\x23\x58\x46\x46\xa8\x4a\x2d\xbf\xd0\x35\x4f\x64\xca\x9e\xb1\x19\x5b\xf2\x8e\xa9\x9a\x61\x68\x97\xaa\x6f\x60\x47\xeb\x7e\x35\x42\x06\x54\x00\xe7\xdd\x8f\x9a\x7e\xf6\x80\x56\x82\xfb\xa3\xe2\x18\x58\x9e\xe5\x77\xa2\xcf\x9c\x9a\xb5\x91\x60\x90\x32\x63\xa5\xe5\x84\x21\x2f\x3a\x5b\x81\x28\xe0\x3c\x81\xa9\xa0\x6e\xca\x5c\xef\x1a\xc2\x4e\xe7\x2b\xa9\xe6\x47\xe6\x7f\xc4\x37\x28\x02\x02\x1c\x3d\x2f\x6d\xa9\x99\x9b\x94\x1d\xef\x89\x67\x96\x2c\x99\x7d\x1a\xb0\xd0\xc9\xc8\xb9\xeb\x04\xd1\x64\x26\xaa\x4e\xb0\xa0\xcf\x13\xdf\xc0\xf6\x1c\x23\xd1\x99\x15\x1c\xe3\x36\xb1\xb9\x2c\x4b\xd1\x0a\xa5\xd9\x4f\x14\x76\x35\x84\xb3\x60\x0c\xc1\x8c\xc3\x6a\x9e\x59\xd8\x2e\xc3\x7a\x4f\xc1\x16\xe7\x86\x80\x1c\xd0\xf4\x72\x9b\x97\xb0\x09\x89\xe3\x63\xf6\x03\x3d\xc2\xd6\x34\x63\xcf\xdd\x11\x47\x20\xee\xf6\xf4\x29\x20\xf3\x34\x30\x55\x18\x6f\x05\x93\x55\x25\x16\xbc\x72\xb9\x20\x8f\x10\x80\x45\x6b\xce\xa6\x4d\x56\xe6\xad\x69\x45\xc3\x7d\xc3\x56\x76\xc4\x0f\x1f\xf0\x6f\x97\x32\xb5\xa9\x94\xbd\xa2\x46\x23\x33\xae\x6a\xb5\x5b\xd7\x9b\x8e\x84\xcf\x29\xe0\x00\x8d\x40\x0f\xc7\x69\x0a\x54\xfe\x43\x3a\xc0\xe0\x23\x39\xdc\x28\xe9\x36\x31\x5e\xff\xd9\x39\x4b\x9e\x92\x16\x1d\xe4\x12\x4a\x9d\xba\xc9\x53\x3a\xbc\xd1\x6d\xee\x03\xc5\xdf\xc0\xe3\x27\xc1\xd2\x9a\xa0\xdd\xf7\x1d\x7b\x6e\x8c\x86\x8d\xd2\x39\x85\xe0\xbf\xb3\x82\x8d\x9c\xb9\xe8\xba\x8d\x60\x27\xff\xf1\xbf\x4e\xff\x90\xd3\xd3\x98\x54\x67\xcc\x8a\x01\x92\x04\x44\xce\x06\xe7\x55\xad\x99\x0c\x43\x1d\x18\x54\x62\x12\x5f\x41\xad\x19\x92\x05\x73\x71\x36\x7b\x45\xce\x85\x55\xb5\xec\x3b\x76\xe2\x90\xfa\xcc\xe1\x97\xa2\x85\xf1\xd7\x75\x2b\x98\x5e\x72\xc5\x6a\x25\xc6\x70\x80\xff\xcf\x45\xc9\x37\x15\xe6\x11\x03\xea\x96\xfa\x7f\x18\x71\x8f\x8e\x22\x2d\xf7\xbd\x6c\x45\xa1\x2f\x60\x81\x78\x55\xf7\x79\xe8\x99\x1d\xce\x38\xb0\x2e\x26\x67\xd5\x73\x4c\xf1\x7b\x5b\x9b\x24\x4b\xf6\x2e\x63\xf3\x0d\xc6\x40\x3a\xa1\x2f\x8d\x26\xba\xfa\x06\x1e\x1d\xde\x1e\xe6\x9b\xa6\x92\x05\xd7\x22\xd8\x28\x20\xca\x6a\x37\x03\x07\xcd\xa5\x45\x69\xb3\x3e\x3e\x66\xbf\xd4\xc6\xb2\x35\xbe\x8b\xec\xb4\xd1\x9a\x30\xab\x17\xf5\xba\x91\x95\x68\x7f\xdf\xb1\x6b\xb1\xe4\x5b\x59\xb7\xec\x46\x30\x25\xcc\xdc\x6b\xeb\xf6\xdd\x46\xd1\x29\x03\x4d\x2f\x05\xc3\xfc\x29\x6b\xda\xba\x11\xad\xde\xe5\xec\x97\xa5\x60\x95\x54\x82\x5d\x8b\xaa\xbe\x31\x0c\x13\x65\x29\x0a\xe3\x60\x57\x3b\xc6\x95\xd9\x27\x45\xdb\x81\xcf\xaf\x97\x02\x21\x85\xd1\xb7\x14\xcc\x70\x2d\x6b\x95\x83\xcd\x52\x52\x49\x6b\xcf\xbd\xb2\x11\x38\x9b\x13\x81\x10\xdc\x9d\xf9\x05\x89\x4a\x33\x59\x88\x8a\x76\xda\xe0\x60\x6c\x19\xbd\x6c\xeb\xcd\x62\x09\x68\x3b\xb3\x27\x49\xbd\xeb\x98\xb1\x9b\xa5\x2c\xb0\x41\x41\x34\xc1\x60\x27\xc0\xf3\x14\x10\xc0\xf0\x4d\x47\x08\x62\x88\x0f\xa2\x56\x99\xe3\x85\x7b\xee\xb2\xc6\x19\x2b\x21\xeb\x91\x87\x79\x87\xa8\xa9\xde\x35\xde\xd2\xf3\x1a\xb5\xd7\x88\x2f\x66\x99\xd5\xb7\x7c\x11\x8f\x65\xb3\xe9\xb6\xc1\x1f\xad\x66\x4f\x03\xfb\xcf\x3a\x0c\x25\xb8\x0a\xef\xd8\x39\x73\x1b\x3d\x84\x54\x47\x6b\x88\x7d\xf6\x79\x86\x69\x63\x0b\x0d\x43\x66\x43\x7b\xc0\xd5\x30\xdb\x7c\x73\xc6\xfc\x16\x3c\xee\xa2\x40\xf9\x9e\x35\x6e\xff\xaf\x68\xeb\x20\xca\xe9\x23\x76\x7b\xe2\x2b\x3e\x28\x19\xc6\x3d\x22\xbf\x1b\xb7\x96\x77\xaf\xc4\x0d\x96\xa5\xbb\xa4\x63\xb8\xe3\x04\x1e\x4d\x10\xc7\xb2\x1e\x8d\xaf\xbd\x70\xe9\xc8\x5e\x54\xae\x17\x1c\x6d\x74\x3b\x4b\x73\x33\x64\x10\x79\x9b\xf6\x0a\x11\x1f\x86\x15\xce\x29\x84\x13\x28\xf1\x7d\x40\x1e\x0a\x13\xee\x27\x5d\x10\x53\x1a\x09\x1f\xf6\x03\xaf\x17\x4a\x27\x25\x04\x0f\x33\x76\x2d\x75\x07\x01\xa2\xaf\xfe\xe0\xc3\x0c\x8e\x85\x24\x63\x61\xd4\x95\xec\x80\x98\x43\xe9\x21\x4e\x5c\x28\xfd\xb5\x99\xf6\xd3\xc4\x58\x54\x5f\x63\x84\x9f\x41\x39\xf0\xd7\x89\x19\x3f\xf5\x0d\x4f\xbe\xf2\x2d\x4f\xbe\x0a\x9b\x9e\x7c\xd5\x6f\x9b\x99\xff\x7d\x79\xea\x3b\x7c\x79\x1a\x76\xf8\xf2\xb4\xdf\xe1\xab\x3f\xf8\xb6\x5f\xfd\x21\x6c\xfb\xd5\x1f\xa2\xb6\x6f\xa5\x47\x79\x13\xe1\xbc\x19\x20\xfd\x56\x06\x58\x6f\x62\xb4\x37\x43\xbc\xdf\x42\x10\xe9\x2d\xe0\x87\xff\x36\x68\x61\x51\xef\x60\x0e\x9b\xe1\x24\xde\xca\x60\x16\x9b\x78\x1a\x9b\x68\x1e\xfd\xb8\x34\xac\xbd\x46\xb7\x19\x2b\xc3\xc0\xb1\x8b\x2a\x3b\xb6\xa5\x71\x2c\xf9\xc7\x8d\x2a\x82\x50\x72\xa9\xf0\x8c\x0f\x6f\x17\xc6\x8b\x05\xd8\x29\xb3\x05\x8f\xee\xc9\xa1\x28\xb3\x81\x38\x12\xf0\x39\x63\x05\xaf\x2a\xb3\xd9\xd8\x61\x71\xcf\x33\xbb\x35\xfc\xf2\xd1\xe6\xe9\x44\xdb\x42\x2a\x2f\x97\x25\xc9\x6a\xe2\xd3\xf5\x83\x6a\x17\x38\x82\x51\x6e\x49\x6d\xba\xe9\xc1\x8c\xf4\x52\x76\x51\x0a\x82\xb7\x8b\x8d\xb1\x1a\xcc\xac\xc2\x0c\x53\xe8\x15\xdc\xe1\x86\xf3\xa2\x36\x5b\xa5\x66\x2d\xbf\x61\x7f\x79\x13\xf4\x94\x4a\xd7\x96\x28\xb0\x5b\x6d\x3a\xd1\x7e\xd1\x6d\x9a\xa6\x92\xc6\x1a\xa1\xfd\x93\x89\xdb\x46\x14\x1a\xb6\x29\xa0\xac\x8f\x34\x41\xd7\x8c\x99\xd9\xe5\xaf\x36\xeb\x0b\x85\x3b\x51\xaf\xec\x0b\x3a\x81\x39\xc2\xdb\x05\x78\xb0\x60\x1f\xee\x9a\xfc\x42\x25\x32\x0d\xc8\x84\x03\xe0\xc6\xe2\x35\x33\xf5\x0a\x26\x7d\x29\xaf\x40\x23\x93\x1d\x64\x26\x69\xd8\xb3\x7f\x0e\xf9\xd4\x95\xe7\x62\xaa\xc0\x60\xa0\x40\x50\x52\x82\xf0\xab\x68\x65\xb9\xc3\x1c\x26\x0a\xa7\x98\xb3\x2d\xd2\x66\xd7\x88\x0e\x9c\x2c\xb3\x9f\x73\x2d\xaf\x2b\xb2\xe4\xcc\x88\x8e\x4e\x60\xe0\x75\x8d\x28\x64\x69\xc6\xbe\xde\xa1\x09\xc0\xab\x4a\xb4\x39\x9a\x6b\x37\xdc\x2c\xb0\x45\xad\x1d\x09\x5e\x6d\xd6\xaf\x37\x3a\xc1\x88\x7d\x12\xe2\x98\x7e\x03\xcd\x8d\x54\x9a\x0e\x23\xf6\xdc\x19\xb1\x46\x8c\x38\xfa\xa6\x2b\xfa\xfa\xb4\xd2\x60\x2a\x1d\x0e\x3e\x68\xbd\xa8\xd1\x3f\xba\xb7\xdc\xcb\x58\x4b\x22\x4b\x61\x16\x83\x2b\x16\x98\x58\x2f\xfd\x49\x88\xec\xa5\xbc\x02\x23\x23\x49\xf3\x3f\x76\x9d\x5c\x28\x7e\x5d\x89\x5f\x6a\x38\x56\x97\x8e\x3a\xe2\x67\x7b\x83\x13\x21\xc2\x91\xbd\x7e\x90\xfa\x73\x51\x54\xbc\x85\x23\x7f\xb3\x34\x32\x93\x8f\x8f\xd9\xcf\x82\xb7\xb6\x06\x31\xa0\x06\xe3\x45\x51\xb7\x73\x63\xf4\x51\xfe\xdb\x11\xd4\xc1\x85\xc9\xe8\x4d\x2b\x72\x7f\x1a\x20\xe2\x9c\x3f\x11\xf0\xfc\x0c\xab\x25\x7d\x82\x02\x9f\x9f\x84\xcf\x23\xaa\x3d\xbf\xca\x6b\x32\x20\xa7\xb1\x2b\x15\x14\x93\xfb\xbd\x17\x4c\x01\xd8\xee\xc9\x18\x88\x10\xf1\x25\x97\x19\x6b\xc3\xaa\xcb\x40\xee\xa9\xe6\x8f\x4a\xc0\xdf\x08\x4d\x39\xd3\x8c\xb5\x0e\x93\xb0\xa2\x3d\x44\x99\x0a\xf7\xd2\x69\x5f\x7b\x0f\x92\x8a\x65\x2f\x37\xc9\x17\x89\xd1\x65\x81\xf6\x36\x6c\x9d\xaf\xc5\x7a\x5d\x6f\x45\xe2\x2b\xf6\x5c\x02\xb9\x9f\xc2\x1f\x2d\xda\x9b\x77\x3a\x75\x86\x25\x1c\x4b\x1b\x31\xf0\xdb\xc2\xb5\x59\x08\x1d\xa6\x7d\xaa\x9a\xcf\xdf\x14\xbc\xe2\x6d\xd2\xf4\x06\xcc\x98\xb2\x15\xa7\xa9\xfd\xe3\xe0\x31\xc6\x26\x1e\xc4\x4d\x3f\x32\x6d\x8a\x25\x57\x81\xc9\x98\xb1\xce\x38\x01\x90\xf7\x4c\x8a\xe5\xd8\x9c\x0b\xb7\x6f\xd8\x84\xc9\x58\x95\x64\x50\x91\xb0\xd7\x6c\xc3\x24\xd2\x8b\x25\x57\x24\x3a\x64\x95\x99\x11\x72\x4a\xf6\x18\x74\x42\xcb\x2c\xc4\x7d\xcd\x9b\x80\x4f\x2e\x5f\x9b\xac\xc7\xd0\x7e\x14\x32\x48\xb9\x11\xab\xd6\x0e\xbb\x12\xbb\x1f\xeb\x36\x18\x75\x25\x76\x83\xd1\x92\x70\x57\x74\xf5\x62\xd3\xc9\x6a\x3b\xee\xf0\xad\xc4\x0e\x5d\x8d\xd5\x96\x68\x02\x0c\x33\x5a\x76\x70\x58\x74\xb5\x65\xe7\xa6\x5d\xc8\x59\x30\x5e\x56\x61\x01\x43\xfe\x57\xb1\xf3\x79\x52\x44\x7a\x96\xb1\xd5\x36\xac\x3d\x20\x8a\xac\xb6\x19\x5b\x05\x74\x6d\x78\x51\x88\xae\x0b\xe6\xb8\x1e\x9f\xe6\xd0\xb9\x78\x97\x61\x14\xcf\x52\x09\xfa\xa5\xd3\x89\x50\xba\xdd\x8d\xcf\x7d\x8d\xce\xc4\x0a\x09\x80\x0d\x47\x0f\xc9\x8e\xa6\x58\x3f\xda\x23\x80\x01\xe8\x48\x49\xe0\x07\xfc\x04\x3e\x80\xb6\xf9\xe5\x74\x5c\xe2\x1a\x0e\xdb\xc8\x80\x32\x99\x51\xdd\x63\x32\x07\xa4\x1d\x23\xc8\xfb\xee\x57\x5e\x8d\x13\x64\xcb\xab\xb4\xc7\x5d\x41\x95\x1c\x36\x5e\x6a\x08\x35\x52\xb3\x01\x35\x76\xe2\xc6\x41\xc6\x9c\x90\x8e\x5d\x1f\xa3\xff\x7d\x71\x0c\x36\x37\x64\x80\x7f\x84\x46\x67\xda\x80\x80\xa2\xbe\x5f\x39\x92\x3b\x64\xe0\xfe\xf5\x42\xed\xa8\x68\x19\xe5\x2d\x7a\xb6\x9d\xd1\x50\xa3\xb5\xca\x6b\xac\x28\x5a\x11\x97\x22\xca\xcf\x45\x25\x74\xa8\x95\xd7\x03\xed\x38\x26\xa2\x07\x64\x72\x74\xfc\xef\x71\x98\x95\x2f\x85\x5e\xf3\xe6\xc2\x48\xb7\x2f\x3a\x85\xd4\x11\x16\x07\xac\xe1\xf4\x90\x5b\xec\xd3\xc9\x4a\xec\xba\xe8\x81\xc4\xd3\x40\x7a\x0a\x57\x02\x40\x6a\x56\x76\xb0\xab\xc3\xdf\xb8\xbd\xc1\x6f\xa9\x45\xcb\xb5\xd9\x29\xd5\x1c\xa2\x60\x5d\xce\x2e\x4a\x06\x56\x36\x35\x13\xb7\xb2\xd3\x5d\x16\xd9\x18\x5d\x68\x1d\x62\xd8\xe9\xf8\x98\x15\x9b\x16\x52\x07\x86\x26\x75\x4b\x66\x8b\xad\x8d\x0b\x40\x66\xac\x15\x0b\xde\xce\x2b\xd1\x75\x14\xb6\x72\x7d\x2d\x42\x39\xbb\x00\xa4\xaf\x45\xc1\x37\x9d\x08\xdb\xc0\x58\x0e\xf1\xb5\x5c\x2c\x31\xbf\xac\x79\x25\xd8\xdc\x58\x4a\x35\xa0\x00\xdc\x33\x86\x8b\x54\x8c\xb3\xaa\xae\x9b\x7c\x3a\x01\x02\x04\xb4\x72\x59\x4b\x03\x90\x3d\x25\xc2\xa7\xac\x5b\xc9\xe6\xad\xd2\xb2\x82\x0c\x17\x28\x36\xa8\xda\x32\xa4\xd2\xa2\xcd\x25\xfb\x16\xff\x30\xc4\xf7\x07\xbe\x41\x59\xc2\x21\x5a\xf7\x8e\xec\x0a\xe8\x44\x27\xc5\xe1\x07\x9e\x2b\x5a\xf9\x44\xc0\xa8\xe6\x9d\x5c\xb7\x82\xaf\xc8\x20\xa5\x20\x9c\x99\x9c\xec\x18\xaf\x5a\xc1\xe7\x34\x4f\x31\xcf\xd9\xcb\x7a\x2b\x58\x8d\x15\x82\x4a\xdc\x02\x31\xd7\x60\x6f\xc3\xe0\xcf\x9e\xc5\x11\x86\xc6\x3c\x86\xcb\x23\xf6\x0b\xf8\x98\xbe\x1d\xd7\x82\x47\x44\x3a\x63\x04\x8d\x49\xf9\x48\xc9\x8e\x21\xcf\x6c\xbc\x75\x9a\xb1\xe7\x99\xd1\xbb\xf7\x69\x1f\xe3\x95\xd8\x25\x52\x3f\x02\x4f\xe0\x28\x98\x0c\x96\xab\x89\x34\xaa\x66\xcb\x5b\xb6\xda\xc6\x0b\x86\x78\x02\xd2\x11\x44\xe7\x61\xdf\x73\x6f\xa6\x36\xcb\x76\x67\x69\x3a\x22\x25\x01\x87\xa1\x54\x66\x8f\x90\xc4\xc6\xf1\xfd\xc3\x62\xe3\x51\x19\x08\x8e\x33\xed\x8d\x09\x0f\xdc\x5f\x89\xdd\x17\xb8\xfc\x1a\x2e\x5b\x88\xae\x56\xdc\x90\x03\x77\x59\xd1\x39\xa9\x80\x19\x9b\xbd\xfd\xb3\x36\x38\x6b\x42\xac\x06\xbb\x1b\x0c\x62\x2d\x83\x7d\x3b\x9c\x69\x64\x2c\xaf\x7f\xf3\x35\xe6\xeb\x3f\x85\x47\xdb\x7d\x3c\x7a\xc0\x0c\x31\xad\x8c\x56\x19\x63\xd2\x01\xae\x84\x33\x00\xa2\x38\x65\x14\xc0\x36\x1e\xff\x7a\xac\x5a\x39\x76\x35\x1e\xaf\x3e\x1c\x53\x7c\x71\xee\x56\x63\xa6\x2a\xd9\x32\x8a\xd6\x8c\x04\xc3\x8d\x0c\x75\x6d\x81\xa6\xc8\x36\x70\x49\x65\xe9\x9e\xfb\xda\xa0\xdc\x87\xa5\x95\xac\x66\x69\x68\x33\x1e\x88\xa7\xfb\x0e\x19\xdb\xe6\x50\x40\x8b\xf1\x32\x33\xba\x31\xea\x42\x11\xb6\xc5\x40\x36\x94\xe6\xd3\xd4\x2e\x84\x6e\x2b\x81\x3a\x1b\xd0\x09\x07\x33\x36\x12\x62\x4e\x56\x3e\x47\xaf\x39\xb5\x1d\xd0\x48\xfa\x1d\x9e\x9c\x9b\x65\x2c\x6a\x4c\x4f\x07\xad\x2b\x20\x6f\xbf\x35\x3d\x1d\xb4\x2e\x8c\x79\x2f\xf5\xae\xdf\xde\x3d\x87\x1e\x5b\x20\xfa\xc3\x82\x0c\x90\xfb\x46\xb4\xf1\xfd\x6c\xf8\x95\x92\xe1\x14\xd2\x44\xb1\x1e\x37\x5c\xe3\x36\xe6\x25\xf0\xd4\xfe\xc6\x18\x01\xe2\x85\x88\xc3\x03\xbb\x25\xdb\x1b\x56\x2a\x36\x24\x39\x84\x0e\x02\x9b\x77\x6b\x2c\x5d\x84\x91\x05\x43\xa6\xfd\x2d\x7e\x1c\x5a\x44\x35\xb0\xcf\x7b\x94\xb4\x4c\xea\xe5\x54\x86\xd0\xfa\x39\x94\xe9\x41\x2c\xa3\xc4\x4a\xc6\xfe\x54\xd7\x55\x06\xe5\x8e\x19\x95\xa2\x5d\xf8\x5c\x1f\x56\xa5\xd1\xb1\x1d\xd4\x20\xc4\xb3\x10\x93\x81\xe3\x91\x37\xba\x8d\xf3\x2e\x18\x1d\x3b\x82\xc5\xf3\x43\xdb\xd6\xed\x9d\x4b\xdb\x52\x04\xd7\x68\xb3\xfb\xf1\xe8\xb9\x8b\xa1\x0e\xab\xc4\x79\x15\xc6\x62\x70\xe1\xe5\x6d\x9d\xa4\xec\x03\xfd\x3a\x7a\x5c\xc0\xfd\x45\xdd\xec\x7c\x85\x3f\x05\xd7\x49\x59\xcd\x61\xa1\xce\x3b\x4c\x90\x93\xe6\x98\xaf\xcc\xe6\x83\x95\xef\x47\x47\xf4\xb3\x5f\xc6\xbd\x67\xc2\x8d\x59\x35\x73\x3b\x5d\x04\xe6\xca\xe8\xef\xa8\x96\x7f\xbd\xe9\xf4\x9f\x84\x8f\x37\x26\xd8\xda\xbf\xf2\x09\xd2\xe9\x74\xd2\x01\x8e\x5d\x5b\x38\x1c\x41\xed\x01\xeb\xcc\x80\x54\x69\x66\x54\x5e\x8c\x78\xd7\x43\x3c\xe8\x72\x6e\x5e\xe2\xe2\x92\x6a\x01\xb3\xec\x74\x3e\xba\xfe\x20\x6d\x43\x15\x64\x01\x84\x20\xac\x7b\x88\x14\xdd\xca\x1f\x9c\x9d\x98\x39\x8c\x4c\x70\x04\x32\x44\xae\x5f\x6e\x3a\xfd\x92\xeb\x62\x99\x0c\x08\x1c\x21\x8b\x47\x22\xa2\x55\x6a\xd4\xf3\xbc\xd3\xe4\xe6\x9a\xe6\xd1\xde\x30\xc2\x94\x5f\xc3\xb5\x67\xab\x16\xe3\x71\x52\x5c\x84\xd8\x98\x06\xa1\x5d\x86\x18\x14\x6f\x40\xbd\x41\xdc\x46\xd5\x1b\xa4\x87\x7c\xa8\x42\x68\x10\x03\x2c\xa6\xcf\xbe\x4d\x96\x94\x83\x54\x0b\xa4\xd2\xaf\x5e\x43\xd0\x4d\x2c\xe1\x32\x1c\xef\x4e\x55\xf9\xe3\xbd\x9d\x15\x00\xa5\x20\x3f\x8b\x42\xc8\xad\x68\x93\xba\x71\x47\x00\xdd\x7e\x2d\x29\xd2\xf6\xce\xb9\x2b\xc1\xa9\x4f\x48\x7a\x8d\xd8\x25\x46\xb4\xe1\x74\x8c\xad\xa8\x94\x25\x29\x79\x2f\x91\x71\x85\x97\xd6\x68\xc7\x44\x37\x39\x0c\xa2\x8d\xb8\xf9\x5b\xb3\x10\x8e\x45\x7c\xf8\xc0\x24\xfb\x8e\xce\x55\xe9\x9c\x8a\x5b\xd2\xf1\x84\x85\x2d\xd1\xc0\xfa\x7f\x5f\xb0\x4b\x47\x85\xa5\x31\x13\x5d\x45\x22\xd4\xb0\x1d\x79\x98\x97\xf2\x8a\x16\x90\xd6\xb9\x3d\xbe\xb7\x86\xbf\xd2\xa8\x1c\x62\x7c\xec\x19\x7b\xc6\xea\x06\x52\x0c\x75\xc9\x36\xfd\x6b\xa3\xdc\xb0\xc6\x66\x3b\x94\xa8\x03\x59\xa6\xb1\xed\x0e\x8c\x47\x91\xce\xd9\x08\x62\x78\x9c\x35\xb2\xb6\xf1\xca\x1a\xe4\xc7\xe0\xe0\x34\x4e\x71\x23\x95\x4e\x64\x6a\x08\x0b\x7f\x82\xad\xd8\xa5\xbf\x19\x59\xd7\x01\x35\x11\x91\xff\x36\x82\xe2\xf0\x9e\xa6\xeb\x3e\x51\x0f\xde\x44\x17\x59\xa5\xe9\x43\x67\xc0\xcc\xa2\x2d\xb6\x2d\x92\x3f\x52\x33\xfe\x4c\x1c\x82\x42\xfd\x60\xda\xf6\x2d\x5f\xa3\x57\xcc\x0b\x04\x57\x2a\x76\xde\xdf\x74\xcd\x5b\x7f\xb6\x2c\xac\x75\x40\x8d\xe1\x96\x3f\x78\xab\x6e\x1d\x7a\x1b\xdd\xb4\xa7\x43\x0c\xbd\x8c\x2e\xac\x63\xb8\xf9\xee\xfc\x3c\x3a\x93\x34\xba\x7b\xc0\xb3\xdc\x0d\x30\xcb\xd8\x73\xbf\xa5\xc2\x20\x47\x47\xa1\x15\xf0\xf3\x6b\x5f\xcc\xd6\xab\x1d\xeb\x81\x3a\x63\x05\x57\xaa\xd6\x71\xb6\xae\xbe\xd6\x1c\x82\x38\x65\x5b\xaf\x43\x89\xc0\x2a\xb3\xba\x0d\x44\xe3\x3e\x98\x0c\x0c\x8e\x2b\xc0\x23\xb0\xa5\x2c\x30\x3e\x47\xaf\x62\x16\xce\x65\xeb\xf5\xfa\x38\xf7\xdc\x29\x4d\x4b\xc1\x64\xbc\x36\x26\x60\xac\x97\x8a\xe8\xa6\x89\x40\xdb\x1f\x00\xe7\x3b\x8f\xdd\x52\x21\x4d\xa7\x1f\x4e\x2f\x82\xc0\x93\xb1\xa4\x02\x78\xb0\x5b\xfc\xb6\xb9\xaf\x38\x8b\x13\x92\x72\xb8\xd7\xc4\x85\x11\x43\xce\x9c\x8f\x8b\xc6\x7e\xf5\xb3\xc1\x02\x3d\x33\xf2\x4f\xbc\xd5\x92\x57\xc6\x7e\xb6\x75\x12\xef\x32\xf6\x0e\xf6\x2f\x77\x3b\x52\xb0\x0f\xc2\xb9\x43\xa3\xf8\xc8\x55\xfc\xee\x3b\x8f\xc8\x9b\xa5\x2c\xe1\xa0\xf3\x6f\xbc\x92\x7f\xe3\xda\x8b\xbd\xc9\xc2\x52\x59\xd6\xf1\xa6\xa9\x8c\x21\x66\x90\x08\x00\xa7\x90\x66\x8d\xad\xfc\xad\xcd\xaf\xef\x35\xf5\xe3\xac\x6b\x6c\xe9\x8f\xe5\x60\xc3\x23\x2b\x08\xa2\x4b\xfc\x39\x60\x5b\x32\xd5\x2f\x98\xfa\x49\xb7\xe4\xf5\x84\x1e\x11\x7a\x52\xd9\xa0\x16\x0d\xeb\xfd\x87\xe5\x65\x78\x93\xef\x64\x14\x99\x17\xf5\xba\xe1\x2d\x1a\xf4\x0f\xa2\x43\xc3\xa3\x73\x4c\x57\x40\xc5\x63\x8c\xd6\xc8\xd9\xb8\x4f\x1e\x0e\x36\x70\x24\xfb\x07\x91\x75\xfe\x6a\xb3\xc6\xb3\x10\xc1\x29\x64\xb4\x48\x72\x7c\x2e\x53\x2c\x5f\x8f\x26\x61\xb3\xee\x21\x5a\xee\xf8\x80\xd7\x2c\x40\xac\x11\x82\xa0\xd4\x27\xd2\xa5\x5c\xf1\x41\x6a\x2b\x98\x3e\xd3\xa6\xc3\xd2\x0f\x8b\x83\xce\xed\x70\xb8\x2a\xc2\xcb\xd2\xc6\x8c\x95\x51\x3b\x30\x32\x02\xfb\xda\xe2\x65\x60\x94\xc0\x19\xb4\xba\xc4\x52\x05\xda\x15\x9a\xe0\xba\x34\x30\x52\x1a\x7b\xc0\xc2\x1b\x57\x68\xae\xa4\xd3\xc9\x9a\x4e\xfb\x30\x68\xe4\x8c\xad\x12\x7c\x09\x2f\xf5\x53\xb8\x16\x13\x61\x58\x4b\xa3\x41\x4b\x63\x4a\xc7\x59\x0e\x58\x28\x6b\x32\x7a\xa3\xfb\x04\xd1\xfc\x7e\x9e\xb1\x93\x67\x50\x2b\xae\x73\xa9\x70\xaf\x90\xca\x5f\x23\x20\x15\x5e\xca\x60\x44\xe9\x1d\x2c\xf1\xb0\xa8\x06\xba\x60\x00\xb6\xd7\x87\xb7\x18\x1e\xeb\x5d\x1a\xe8\x06\xa5\x21\xa1\x24\x27\xf5\xf0\x5b\xcc\x5f\x3a\xf8\xbe\x64\xc7\xc0\x71\x23\xd4\x1b\x48\x47\x69\x62\x31\xf4\x89\xcf\x54\x66\xa6\xf7\x45\xf7\x2b\x9d\xe2\x03\xe3\x65\x4d\xe7\x8f\xd8\x5a\x4f\xdd\xd9\xfb\x07\x8c\xb3\xc1\x7d\xcf\xbd\xdb\x9e\x1f\xb4\xd8\x70\x7f\xf8\x0d\xb5\x32\x6d\x1a\xbe\x98\xec\xf9\x95\x17\xff\x9e\xe5\x76\x50\x4b\x5f\x9e\x9c\x5d\x91\xa6\x5e\xc3\x89\x50\x76\x4e\xba\x7a\xad\xdd\x85\xd9\x43\x2d\xad\xe2\xda\x18\xb3\x13\xae\x91\x08\xec\x9c\x49\x5f\x99\xec\x35\x81\xdb\x9e\xed\x36\xd7\xbb\x5c\x7b\xc4\xb7\x73\xf7\x0d\xf4\x5f\x04\x61\xc0\xbd\xfb\x93\x8d\x4e\x0d\x2c\x34\x0c\x12\x79\x03\x6d\x6f\x5e\x1d\x00\xf4\x32\xeb\x78\x0c\xb7\xa2\x7c\x5f\x54\x96\x02\x96\xd1\x2b\x88\x25\x1b\x7b\xd4\x3e\x8f\x4e\x47\x63\xbf\x60\xf7\x46\xad\x4a\xfb\x42\x34\x4d\x7f\x66\xe8\x2d\xd5\x0e\xbb\xfa\xda\x5e\x70\x30\x34\xfc\x1c\x36\x4b\xb9\x58\x42\x90\xda\x47\x78\xeb\x1b\x0c\xd6\xd2\xad\xab\xf5\xba\xa9\xc4\xad\x01\x4c\x7f\x9e\x9c\x7e\xfd\x58\xe8\xad\xc0\x83\xdc\xfe\x89\x5c\xc3\x05\x71\x0e\xbc\xbf\xf3\xcf\x92\xec\xfc\x7c\x0f\x51\xfa\x51\xf8\x3d\x18\xf8\x56\xd8\xc6\x85\x72\xe9\x58\xc9\xa0\x92\x61\x14\xf3\x20\x84\x6e\xbb\xf4\xa3\xe8\xdb\xd1\x10\x7a\xaf\xb5\x8b\xa2\x6f\x47\x43\xe8\xbd\xd6\x41\x14\x7d\xbb\x27\x84\x6e\x27\x6d\x8b\x28\xfc\xc9\xbc\xfd\x22\x1e\x86\x45\x7b\xb1\x9c\xf1\xd5\x30\x5c\x8d\x58\xa1\xf2\x4b\x9d\x14\xb5\xd2\xe2\x56\x3b\x73\xda\x18\xf1\x2e\x56\xc3\xdb\x85\x18\xda\xf4\x87\x0d\xed\x83\x2e\x10\x8d\xe6\xdd\x1f\x5a\x02\xd6\x22\x9a\x4b\xbc\x42\x27\x88\x8b\x42\xd4\x16\x79\x7a\x86\x59\xd3\xd7\x5b\xd1\xde\xb4\x52\x53\x81\x65\x57\x63\x69\x83\x5e\x8a\x1d\x5b\x73\x5d\x2c\x73\x6c\xf7\xc6\x6c\xae\x6b\xb1\xae\xdb\x1d\xab\xf8\x0e\x36\x86\xae\x66\xaa\x66\x4b\xde\xae\xd9\xbc\x56\x50\x17\x89\xdb\x2d\x4d\x24\x31\xff\xff\xe3\x7c\xde\x7e\x70\x3a\xc3\x07\x9b\xc1\x20\xc5\x1e\x1f\x68\x83\x9e\x77\xee\xda\x90\xfe\xe5\x0a\x84\x38\x96\x86\x83\xaa\x84\x29\xba\x43\x53\x5d\x7f\x6a\xc6\x1c\x42\x8a\x87\xa7\x64\xed\xa3\xf0\x60\xc0\x1c\xae\xfe\xb1\x05\x06\x7f\x86\x6f\x4f\xfc\xe5\xcd\x19\x7b\xb3\x92\x0d\x64\x93\xb7\xa3\x66\x15\xf8\xcb\x17\xdd\x2b\x59\x25\x29\x83\x80\x22\xd7\x80\x0a\xc2\xf1\xff\xa1\x07\xdc\x74\xba\x15\x7c\x9d\x3b\xe7\x8f\x0e\x34\xcd\x6b\x81\x35\xad\x60\x1c\xe1\x85\x48\x52\xc3\x61\xa9\xae\x0f\x49\xd7\xac\xdd\xa8\x8c\x2d\xe4\x56\x28\x26\x75\xc7\x8a\x4d\xa7\xeb\xb5\x27\x03\xb7\x35\xce\xb7\xc0\x86\x5e\x50\xc1\xde\xcd\x88\xe4\x31\xd4\x7e\xb5\x59\x93\x91\x97\x7a\xa7\x8e\xce\x1e\xb8\xfb\x2f\x12\xa4\x5a\xca\xce\xd9\xed\x74\x12\x86\xaf\x26\xce\x93\x05\xea\xdf\x5a\x29\x4f\xe3\x55\x17\xb0\x10\xdf\x67\xc3\xd2\x7e\x87\x66\x4a\x77\x42\x1e\x1f\xb3\x1f\xb9\xac\xc4\x3c\x9f\x92\xe1\x68\x57\xd7\x33\x36\x3b\xb3\x61\x86\xd2\x1f\x2e\x45\xcd\x6f\xed\x05\x08\x46\x51\xb9\x30\x77\x0b\x00\xaa\x7b\x6d\x07\xb8\x05\xc8\x25\x9b\xe9\xea\xaf\x82\x57\xd5\xff\x16\x55\x23\x5a\x36\xdc\x9e\xcc\x4b\xbc\x81\x9b\x48\x9a\xe6\x68\x84\xe4\x79\x1e\xdd\x18\x12\xd8\x1d\x03\x6d\x61\x80\x84\x3e\xb7\x54\xfe\x88\x82\x2d\xc2\x0f\x4e\xd9\x43\xe5\x93\xb3\x48\xcd\x82\x51\x8c\xf5\xd4\x88\xb5\x66\xc2\xc4\x69\xfa\x90\x4a\x79\x97\x31\x0d\x5e\xf7\x27\x3a\xdd\xd6\x93\x0e\x9d\xee\xbd\x5e\xf7\x83\x6e\x37\x38\x40\x5e\xb2\x1e\x13\x29\xc4\x23\x06\x23\x51\xb7\xb1\xe8\x4b\xe8\xf9\xfb\x1a\x23\x17\x36\x32\x60\xbc\x9e\x18\x8d\x78\x19\x23\xc6\x1f\xff\x30\x4d\x6d\x39\x98\x8d\x63\x48\x7f\xa6\xa0\x6e\xe0\xd0\xba\xe9\x83\xf1\xff\xe9\x44\xa1\xd3\x41\xc7\x23\x28\x40\xe1\x93\x49\xe8\x3b\x86\x86\xf6\x78\xac\xd5\x81\xb4\xb7\x1c\x45\xd7\x8d\xb8\xaa\x77\x3a\x58\x6f\x6f\x38\xf9\x96\xa9\x87\xc0\x61\x25\x7d\x5d\xb3\x52\xdc\x30\xa9\x9a\x8d\xf6\x16\xee\x18\xc8\xef\x3e\x02\xe4\x9a\xab\xdd\x3e\x98\x61\xf1\x89\xf1\x61\x87\x24\x50\x5f\x7c\xf1\x91\x33\x7a\xf4\x64\xfa\x24\x3f\x3a\x7a\xdc\xfc\x1e\x39\x35\xe7\x8e\xdd\x0e\x2e\x71\x91\x25\xbb\x8d\x36\x16\x8c\x94\x3d\x14\x5f\xdf\x74\x52\x2d\xd8\x3f\x44\x5b\x93\xe9\x60\x07\xed\x8d\x19\x46\x2b\x94\x0f\x51\x98\x51\x49\x0d\xe3\xb7\x2e\xfc\x79\x8d\xcc\xd0\x5e\x25\x32\xfd\x86\x3d\xb9\xd5\xf1\xe9\x0d\xd3\x3e\x7d\x24\x6e\xe6\xc1\xad\x8e\x15\x31\xef\xbc\xda\x35\xb0\xa2\x22\x1f\x77\xbd\xd3\x13\xbb\x1e\x8e\x8e\xc6\xe4\xe0\xf8\x98\x35\xad\x68\x78\x4b\x97\xe9\xd0\xb7\x87\xd6\x5c\x2a\x33\x2e\x9e\xe4\xb0\x69\x0d\xcb\xc5\x2f\x98\x0a\x4b\x43\x82\x8b\xc7\xcc\x64\x55\x0a\xe5\xc4\x6b\x83\x86\xbd\x2b\x81\x5e\xf8\x8b\x12\x06\x1f\x21\x09\x22\x3e\xb7\x44\x45\xf5\x0c\x92\x28\x48\x5f\xf3\xec\x96\xa8\x3a\x42\x4c\x28\xb2\xdf\x73\x14\x86\x62\xe9\x9b\x4e\x3c\x48\x47\xb8\xb5\x21\xde\xee\x14\x71\xc3\x1f\xdc\xc0\x32\x14\xe7\x59\x1b\x4b\xfa\xd6\x8a\x7f\xdd\xca\x05\x5e\x43\x24\x95\x0d\x3c\xc4\xe7\xb9\xd4\xb3\x13\x5b\x21\x91\x48\x75\x79\xa6\xae\x32\x86\xbd\x40\xd7\xab\x4b\x05\xa7\xc2\xcd\x18\xa8\x01\x15\x06\x46\x88\xf8\xc0\x54\xf3\xe8\x49\xa0\xf8\x1e\x52\xb0\x37\x6d\xad\x16\x4e\xaa\xf1\xde\x29\x8a\x07\x29\x0a\x81\x68\x77\xd2\x65\x3a\x85\x83\x62\xe8\xe4\x1e\x3e\x21\xa3\x83\x83\x69\x74\x36\x26\x8a\xc1\xd0\xb2\x74\xe0\xa2\x33\x31\x1b\x75\xd3\xf2\xe6\x2f\x9d\x8d\x5d\xe0\x42\x01\x08\xb9\xb3\xfe\x47\xa6\x33\x73\x8b\x2a\x88\xd6\x2a\x59\xa5\x3e\xb9\x60\x9d\x0e\x77\xca\xc7\x5b\x20\xc9\x68\xc4\x38\x08\x3f\x20\xa6\xa9\x37\xfd\x15\xdd\xe4\xe4\x4f\x21\x85\xf5\x78\xfe\x0c\x12\x3d\x25\x46\xdf\x05\xc5\x5a\xb9\xa1\xeb\xf3\x34\x63\xbd\x09\xdb\xc7\x84\x28\x1c\x84\xbe\xef\x07\x74\x87\x27\x02\x0d\x42\x23\x27\x01\x4d\x5b\x5b\x2e\xd8\x3f\xe5\x87\x63\xc9\x71\x14\xa4\x47\xc1\x7f\xe4\xc2\x1d\x01\x0c\x0e\x2a\xe9\x28\xa6\xec\x8c\xaf\x17\xbc\x49\x5c\xb1\xca\x0a\x7d\x15\x5b\x05\xe2\x4a\xcd\xee\xf6\xc4\x8a\xd1\xc2\xfc\x9b\x50\x2e\x42\x8c\x91\x6f\xe7\xa7\xbb\x76\xce\xfe\xe8\x7b\xa9\x41\xcd\xc0\x83\xd9\xba\x17\xbc\xa1\x4a\x1f\xb2\x4d\xdf\x13\x2d\x7e\xd2\x6d\xef\xbb\x1f\x7d\x43\x35\x68\x69\x3c\x63\xa4\x42\x4c\x4e\x77\x58\x36\xae\xb8\x1b\x09\x29\x99\xa6\x50\xf5\xe7\x47\x8f\xa2\x46\x84\x81\x7b\xeb\xc2\x05\x91\x3f\xbd\x0d\xbe\x11\xd7\x5f\x4e\xbf\x15\x2e\x2e\x2e\x50\xd3\x11\x89\x7d\x08\x78\x81\xa0\x52\x37\x67\x76\x87\xf5\x86\x56\x34\xc2\x6a\xc3\xe8\xf2\x19\x8a\x7b\xf5\x2d\xe0\xad\x2d\x93\xdc\x1b\xdc\x0a\x4b\x65\xdd\xed\x81\x98\x22\xa7\xc3\x96\x01\x73\xc7\x23\x3e\xe9\xde\x5a\x4b\x1f\x1d\xa1\xab\x02\x03\x87\x3b\x9d\x0e\x8a\x04\xbd\x17\xbb\x1f\xab\xb1\x89\xda\x9c\xc2\xbe\x9b\x76\x02\x1b\x3d\x0c\x0a\x50\x76\x79\x78\xba\xfb\x8f\xf3\x79\x1b\xc7\x03\xb4\xce\x83\xab\x89\x06\x31\x01\x7a\x3d\x08\xac\xc6\xb2\x65\x1b\xc1\x11\x9f\x41\xc0\xf5\x71\x75\x77\xb8\x1e\x8d\xa8\xf8\xd2\xbb\xa1\x28\x51\xde\x67\x78\x7f\xa9\x95\x23\xa8\x1e\xf3\x61\xd7\x07\x07\x04\x80\xb3\xcc\xf5\xa7\x8c\xbd\x25\xbc\xbf\x42\x63\x3f\xed\xf7\x14\x90\x68\x9d\xdb\xab\xd9\x46\x33\x33\x30\xf2\xde\xc4\x4c\x18\xf3\x1f\x44\x17\xed\xed\xbd\x0f\x86\xf3\xed\xf5\x6d\x47\x0e\x19\xc8\xf1\xd0\x02\xc0\xfb\x46\xf4\xae\x99\x4e\x47\x82\x4a\x6f\xb4\x2c\x56\xbb\x9f\x5f\xfb\xc0\xd2\x07\x2b\x42\xe9\x48\xed\x22\x5a\x97\x08\x72\x70\x65\x4a\x7c\x65\x5c\xff\xa2\x2e\x2f\x8e\x70\xf1\xd6\xcf\xaf\x7b\x11\x10\xff\xde\xe2\xe4\x3f\x6b\x01\x31\x28\x30\x31\xc2\x29\x22\x06\x70\x35\xfd\x37\xf0\x1e\xef\x38\x39\x3a\x62\xd2\x3b\xe7\xb2\x34\xb4\xc5\xce\x0b\xa1\xff\x62\xfe\x4e\x34\x5f\xa4\xdf\xd0\xf3\xe0\xa2\x34\xb3\xb7\x52\xa9\x2e\xb8\xe3\x28\x87\xcf\xdd\x35\x57\xc0\x9d\x31\xad\x39\x99\x4c\xea\x78\x59\xf7\xb5\xe7\xa4\xaf\x10\x40\xc1\x8c\xd7\x4e\x04\x95\xc8\xb0\x01\xd0\x35\x48\x1f\x79\xeb\x6c\x2f\x87\xe4\x2f\xb1\x16\xb3\x8c\xd5\x80\x1f\x10\x20\xba\x4e\x24\x4d\xd9\xbd\xfd\x1e\xca\xbe\x01\x6f\xa3\x8d\xe5\x8e\xd5\x60\x0c\x03\xac\x91\xb3\x39\xc1\xe5\x3c\x33\x08\x6c\x85\x83\x05\xa3\x0d\x54\x8a\x8f\xa5\x8f\x24\x63\x02\xc2\x23\xab\x82\xcb\xd8\xee\xa3\x4c\xf0\x74\xd2\x1d\xca\xa8\x60\xcc\xa2\xea\xe7\x62\x8c\xdf\x14\xdd\x62\xe1\x4a\x57\x7b\x57\x28\x0f\x72\x3f\x9f\xc4\xdd\x8f\x62\x6d\x7f\xc7\xcf\x58\x17\xdc\xba\x6d\x29\xfa\x48\xe6\x75\xc1\xf5\xdd\x43\x63\x22\x63\xb7\x0e\xe2\x90\x41\xf7\xfb\xee\xfc\x39\x8c\xa1\xe9\xed\x83\xff\xe1\x9a\x74\xa7\x8d\xfd\xad\xee\x66\x49\xea\x68\x95\x1e\x1f\xc3\x99\x3a\x56\x09\x0e\xd7\x0c\x74\x0d\x2f\xe0\x76\x40\x70\x2c\x9d\x85\xfc\x2d\x56\x4f\xf2\x05\x84\x22\x34\x5f\x80\x75\x7c\xce\x7e\xcf\x7e\x4f\x11\xd7\x67\xcf\xac\xa5\xc0\xe1\x1e\x45\xd3\xe4\xec\xca\x46\xbc\x17\xe1\x5d\x89\xbe\xb0\x9e\x10\x28\xb8\x62\xba\x66\x45\x5d\x61\x94\xf8\xf8\x98\x71\xc4\x84\xd5\x2d\xe3\xec\xef\x9b\x5a\xc3\x25\x0b\x9c\x75\x3b\xa5\xf9\x2d\xd6\xf1\x00\x9a\x0f\x62\xf9\x04\xb1\x8c\x1f\x9c\xf5\x1f\xcc\x06\xf3\x90\x25\x93\xcf\x4e\x5c\xe1\xa8\x01\xfa\xe1\x43\x0f\x86\x7d\xf0\xec\x24\x86\x12\x1e\x1d\xb0\xb5\x01\xc8\x05\x03\xe8\xf2\x4c\x5e\xa5\x31\xa5\x9e\x9d\x9c\x5d\x85\xd4\x80\x19\xcf\x2d\xe7\x74\xcd\x4a\xa9\xe8\xb6\x0f\x9a\xf5\xc9\xc3\xb3\x76\x73\x2a\x43\x8e\xfd\xe7\x7f\xfe\xde\x7e\x3c\x10\xe6\x4a\xdf\x54\x8c\xe6\x1d\xcd\x7a\x30\xa3\xbf\x63\x90\xbb\x3f\xa7\x67\x27\xfb\x66\x25\xf1\x23\x1b\x20\x03\xef\x3b\x92\x82\x2d\x7a\x62\xef\x08\x0e\xdc\xb2\xf1\x56\xc1\xc4\x13\x1c\x21\x0d\xec\x3e\x3b\xf5\x68\xa1\xcc\x66\x23\xe6\x0e\xed\xef\x3d\x73\xe7\x21\xfb\xd9\xf9\x54\xd6\x8a\x71\x57\x4e\x3f\xbe\xc4\x18\x22\xd3\x5a\xe7\x95\x50\x7b\x82\x52\x00\x74\x8f\xfd\x12\x9a\xd9\x64\x1d\x8e\x26\xae\x86\x66\xc5\x48\x25\x55\x68\x64\x4c\x27\x13\x7e\x58\x69\xff\x66\x5a\xfb\xf3\x36\xe5\xcf\xd4\xdb\xdc\x7b\xde\x6e\x23\x7c\xa4\xde\xe6\x07\xa3\x2a\xb1\xe6\x1e\xdb\x5b\xef\xf7\x3a\x3d\x07\xd1\x44\xdd\x3d\x38\x2f\x36\xe6\xbb\xc5\x25\x4c\x5d\x2f\x2d\x8d\xee\xfb\xb8\xcc\x61\x8c\xf1\x90\xcc\x59\xbb\xdd\x5e\xc3\x7c\x40\xe2\xf7\xc8\xa7\x95\xc6\x9e\xfb\xf4\xb0\x60\x4a\xf6\xcc\xcf\xc6\xa6\xe4\x6d\x30\x02\xc5\xb6\x8b\xb3\xfb\xff\x96\xd6\x7f\x0d\x69\x05\xcd\x7f\x86\xa7\x8d\x0c\x9b\x9e\x82\xe3\x67\xec\x8d\x48\xad\x0c\x4b\xef\x3a\xdd\xee\x93\x54\xdc\xed\x0e\x88\x6a\xa8\x0d\x23\xb1\x82\xc3\x4b\xd1\x47\x3d\xa6\x93\x49\x41\x5b\x0b\x1e\x24\x88\x98\xed\x3e\xea\x30\x60\xf9\x51\xf1\x49\x4e\x38\x50\xe9\x90\x17\xee\x02\x34\xdf\x73\xcd\x93\x94\x5d\x9e\x5e\x05\xf7\xf6\x20\x7c\xb0\x6a\x3a\x10\xb1\x59\xd4\xde\x66\x8c\xbb\x4d\x63\xbf\xbb\xb5\x73\x25\x01\xe1\x95\x41\xc1\x78\x14\x3c\xe9\xd5\xa7\xee\xdd\x00\xa1\x6c\x76\x7f\xc4\xf0\xd0\xf9\xda\x69\xfc\xad\xe7\x3d\x7d\x7b\x29\xeb\x25\x57\xaf\x82\xce\xf6\x8b\xc9\x8f\xea\xac\x97\x6d\x7d\xf3\x4a\x56\xc4\x33\x60\x88\x83\x14\xd7\xd8\x0e\x00\xf5\x17\x18\x55\x1e\x0c\x83\x68\x8f\xc2\xc4\xc7\xce\xec\x1d\x83\xfd\x13\x96\xc3\xd8\xab\x5d\x8f\x50\xd9\xf0\x91\x52\x66\x98\x7a\x48\xca\x20\x08\x6c\xe3\xc8\x8f\xb2\x79\xb2\x60\x29\x0f\x71\x75\xe7\xb5\x7b\x7b\xd4\xbe\x88\x72\xbc\x21\x3d\x24\x18\xd4\xe9\x7a\x53\x96\xc2\x15\x8b\x8d\x82\x88\x99\xba\xef\xcc\x79\x78\x36\xc2\x63\xfe\x31\x04\xfe\x9b\x50\x87\xc8\x6b\x95\x44\x74\xe7\xd6\x43\x64\xc6\x60\x3c\x54\xa4\xc3\x22\x1b\x88\xc8\xde\x60\xe7\xf3\x58\x59\x8f\xc8\x50\x6f\xf5\x3c\x16\xd2\x49\x9f\x9f\x9f\x80\x42\xb4\x2b\x07\x08\x7d\x0c\xb9\x83\x6b\x10\xf6\x91\x1c\x52\x83\xf6\xc7\xdd\x74\xb2\x1d\x3d\x55\x7b\x3b\x3c\x6f\x3a\xb9\x65\xe7\xec\x76\x24\x0d\x86\x95\xbf\xa0\xc5\x30\xe9\xf5\x40\x15\xe9\xbe\x0a\xce\xde\x47\xf6\x63\xed\x88\x82\x59\xe0\x31\xd6\x7d\x96\xf7\xd8\x9b\xdb\x9c\x3e\xe1\x36\x76\xa9\xfc\x43\x95\xac\xfb\x0e\xda\xf4\x2a\xae\x6e\x6d\xc5\x55\x3a\xf6\xb1\xe5\xe0\xe0\xf9\xc7\x23\x6e\x6b\xdd\x7a\xb7\x05\x3e\x0e\xf1\xdb\xe8\x8a\x3f\x2f\x76\xe0\xf3\x41\x07\x60\x69\x13\x7c\x29\x2e\x12\x94\x3f\xed\xb4\xe8\x92\x5b\x76\x79\x05\xdf\x9f\xdc\x2f\x2e\xf6\x29\x9e\xcd\x4d\x83\x0a\xe5\xf8\x58\xf4\x13\x3a\x16\xbd\x3f\x39\x6c\x47\xb5\x55\x2f\x66\xe0\xf0\x9b\x3a\xe1\xed\x0f\x03\x8a\x85\x03\xbf\xc2\x8f\x8a\x62\x64\xc6\xd5\x45\x13\x3a\xd1\x4b\x7b\x6e\x7a\xfe\xa6\x77\xb1\x44\x50\xbd\x84\xf9\xf5\x41\x59\xac\xef\x36\xb8\x5e\x22\xe8\x10\x96\xc6\x0e\x7a\xf8\x2b\x26\x82\x1e\x61\x79\xec\xa0\x47\x78\xcd\x44\xd0\x27\x2e\x91\x45\x32\x9d\x33\xdf\x9b\x3e\x1d\xf4\x18\xb9\xe9\x90\x8b\xa3\x32\xf1\x82\x37\x89\xc2\x60\xc0\xe3\xc5\xa1\xfb\x88\xb2\x71\x59\x32\xc5\xbe\xdd\xe7\x92\x7d\xf8\xc0\x14\xfb\xce\xbd\xed\x67\x5c\x47\xb3\x1c\x48\x0b\xdb\x34\xb2\x84\x99\x54\x34\x29\x5b\x7b\x20\x6e\x0e\x89\xc1\x40\x04\x6c\xfb\x01\xff\x87\xbc\xef\x35\xf5\x8c\x1f\x32\xbd\xd7\x34\xe0\xb8\x4a\x1f\xcb\x44\x0b\x63\x0f\x1f\x8d\x65\xf3\xff\x83\x8f\xcf\x3f\x83\x65\x48\x91\x31\x86\xfd\xcd\x7d\xaf\xef\xbf\x81\x61\xea\x20\x87\xba\xb1\xf5\xf8\x1b\xb0\x0c\xaa\x99\x64\xc6\xde\xf7\x22\x71\xb6\x80\x94\xae\xe8\xa4\xa0\x02\x15\x91\x76\xbd\x3b\xf4\x82\xf2\x07\xa9\xe6\x3d\x0b\xcb\x3c\x19\xc4\xef\xe2\xad\x1c\x82\x12\xbe\x82\x78\x5c\x85\xe3\x17\x0e\x3b\x5b\xbc\xb8\x51\x7c\x3e\x6f\x45\xd7\x41\x65\xae\x0f\x3b\xdc\x7f\x64\x74\xb0\x80\xaf\x4a\x07\x31\x41\x9a\xea\xb9\xff\x58\x16\x86\x51\x40\xff\x8d\x5c\x2f\x13\x98\xb3\x83\x20\x11\x02\xda\xd2\x17\x8e\xba\x7e\xbd\x2b\x8e\xbd\x4f\x84\x3f\xd9\x89\x7f\xcf\xbe\x65\x12\xff\xf8\xee\xa0\x33\xdf\x23\x2d\x3a\xf6\x23\x91\xa8\xeb\x7a\xa3\xe6\xbe\xf2\x31\xf4\xd1\x5f\x97\x09\xf8\xee\x67\xef\xaf\xd2\x8f\x74\xc6\xed\xd5\x16\x46\x42\xee\x83\x33\xd8\xa3\xd3\xd8\xf3\xed\xcb\x11\xd9\xd8\x83\xf9\x47\x7c\x0d\xb3\xdb\x5c\x77\x84\x5b\x97\x31\xb3\x38\xfa\x65\x10\x7b\x16\xd2\x97\xb0\x92\x32\xb6\xfa\xf7\x62\xfa\x17\x5c\x4c\x1f\x2d\x9b\x5f\x3e\x46\x38\x57\xec\x5b\xf6\x1e\xff\x78\x8c\x94\x7e\xf9\xcf\x14\xd3\x8c\xad\x1e\x96\xd4\x17\x55\xdd\xd1\x69\x62\xb7\x13\x1b\xe7\x37\xd8\x99\x43\xff\x6c\x78\x2b\x8d\xe9\x1f\xbb\xf1\xb6\xc4\xac\x13\x66\xba\x7b\x0f\x40\xe0\xeb\x4f\x3c\x02\x51\x2c\xb9\x6a\x45\xb1\x1d\x5e\x71\x9d\x31\x75\x0d\x01\xb4\xf1\x4b\x7d\x13\x1c\x56\xcc\x33\xd6\xe2\x19\x05\xfb\x3d\x7c\xb3\x90\xea\x35\xde\xa2\x72\x79\x15\x9e\xf7\xbc\xbb\x1b\xf9\x7a\xf6\x32\xbd\xc7\x4a\x63\x75\x8d\x9e\x25\xf4\x75\x87\x61\xe1\x67\x16\x1d\x1b\xbd\xa3\x9a\x1b\xc4\xe0\x67\x01\x23\x85\x44\xc2\x4e\xa9\x85\x7a\x74\xc4\x5c\x53\x8a\xe8\x3e\xb7\xf6\xcc\xf9\x39\x7d\x9a\x2b\x3c\xff\x9d\xf9\x13\xf0\x13\x43\x9c\x68\x08\x0f\xe4\x64\xdc\x56\x08\xae\x2d\x46\x4b\x81\x40\xb8\xa1\xd3\xe8\x4c\x79\xff\xfd\xc9\xf0\x1b\xde\x4b\xae\x3a\xa0\xc5\x90\x47\x43\xd6\x38\xbe\xf9\xf0\xe7\xc7\xb1\x23\x7b\xcc\x5d\xcc\xff\x72\x3c\xdb\x7b\x54\xbf\x45\x38\x09\xfd\xdb\xb1\xcb\x2b\xfb\xf5\x44\x78\x00\xd7\xbb\xd7\x9d\x50\xf8\x91\x5e\xc3\x8c\xd7\x7f\x1d\x11\x65\xaa\xa1\x1d\x7e\x4f\xd3\x02\x0e\x8a\x98\xbb\xa0\xaa\xd6\x0e\x1b\x44\x53\x70\xe0\xef\x65\x9b\x74\x39\x9c\xbf\x73\x11\x15\x7a\x13\x04\x0f\x60\x7c\x2c\xc7\x8d\xe9\x19\x77\xf9\x59\x14\x5b\x6c\xbf\x1c\xa9\xb9\x0e\x23\xce\x54\xc7\x34\xb8\x8e\x24\x2f\x96\xf6\xba\xdf\xde\xab\xe7\xb6\x30\xbe\x58\x8e\xde\x97\x07\x5d\x5d\x32\x7d\x1f\xc2\xc5\xb2\x87\xf2\x1b\xa1\xe6\x8f\x45\x79\xec\x16\xca\x7f\xe2\x44\xf6\x5e\x0d\xd8\xe5\x23\xb7\x92\x3f\x38\x71\x58\xa6\xfe\x42\x89\x87\xd7\x40\x31\xa6\x6e\x9e\xbb\xa8\xb0\x2c\x03\x11\xb2\x02\x76\x59\x5c\xa1\x30\xc1\x37\x9a\xad\x4c\xd0\x3a\x39\xa8\xc3\x46\x94\x58\x08\xf4\x51\x0a\xcd\x2e\xbd\x62\xbf\x3a\x0b\x16\x68\x61\x35\xac\x5d\xa4\xdf\x0b\xd1\xfc\xf0\xf7\x0d\xaf\x12\x7e\x92\x31\x7e\x1a\x7f\xeb\xdb\xea\x31\x79\x32\xee\xd2\x72\x33\x0b\x79\xba\xe7\xe5\x29\x9d\xeb\x3a\x81\x2b\x72\x4f\x43\xcd\x81\x17\xa0\xdc\x07\xef\x95\xac\x20\x61\x77\x1a\xfe\x38\xd9\x73\xe2\x5d\x9e\x8e\xbd\x38\xa4\x99\xe6\x42\x34\x68\x1e\x99\xc9\xfe\xa5\x4b\xac\xb5\xcf\x4f\xd2\xcc\x99\xfe\xfc\x94\x4e\x24\x38\xfa\x0c\xfa\x6d\x4f\x32\xb6\x3d\xb5\x37\x52\x6d\x65\x27\xb5\x98\x1b\xfd\x7e\x7a\xd5\xdf\xa9\x1d\xf5\x4a\xf6\x64\x7b\x02\x47\x78\x2a\x39\xc7\xf0\xcc\x93\xed\x69\xf0\x20\xc0\x3c\x6e\x79\x74\x14\xb7\x74\xb7\x0f\x9c\xd0\x89\x1a\x43\x8d\xed\xa9\xfd\x31\x4a\x81\xa8\xf9\xfe\x72\xf1\x5e\x46\x37\x68\x95\x99\xfe\xce\x38\x32\x20\x0e\xb6\x3d\x0d\xe3\xa9\xc1\x49\xec\xed\x49\xff\x96\x1a\x4a\x05\xf9\x4f\x58\x67\xbd\x5b\x66\xde\xd1\x45\xfc\x5e\xab\x5b\x82\xdb\x12\xa3\xed\x09\x06\x68\xcf\xb1\xe1\xe5\xf3\x2b\x38\x8b\x7c\x1a\x3f\x3d\xb9\x8a\x2f\x9b\xa1\xef\xed\xba\x03\xf1\x16\xaa\xdb\x48\xe9\x41\xc6\x06\x6c\xbd\xc3\x11\x33\x1a\xe3\xfe\x91\x73\x8c\x72\x1e\x27\xe1\xcd\x13\xfe\x03\x34\xf8\xca\xe6\x43\x90\xb1\x51\x76\x64\xf4\xae\x1c\xea\x16\xe6\x0b\x03\x16\x3c\x30\x6f\xde\x32\x65\x1c\x8f\x13\x7b\x90\x03\x03\x52\x38\x36\xa6\xf5\xc2\xbc\x8c\x1d\xf8\x7e\xe4\x20\x98\xea\x5d\xfd\x33\xb2\x72\x5c\x56\x1f\xa8\x17\xfc\x40\x6a\x3f\x70\x23\x50\x3c\x89\x61\x9e\x22\x26\xdf\x87\x0f\x03\xf2\xd9\x6c\x92\x6f\x84\xa2\x42\xbf\xe2\x51\xc6\xd0\xb7\x17\x82\x6e\x4f\xfd\x9f\x84\x7a\x7c\x90\xe0\xb3\x60\x84\x37\xf6\x3a\xf6\xf8\x1b\x96\x3e\x91\xf4\xf6\x1e\x26\x18\x39\xf8\xf1\xa9\xa4\xa7\xdc\xe8\x83\x32\x3b\x22\x39\x8f\x10\xd8\x58\x5e\xad\xa8\xc2\xb7\x2d\x80\x1c\x2f\x79\xf3\x57\xb1\x73\xd7\x42\x1a\x6b\xd0\xbc\x4c\x1f\x2d\xb9\xf6\x9b\x1c\xa8\x55\x00\xb0\xad\x0f\x84\xbd\x0e\xc7\x40\x11\x5d\x91\x25\x54\xc1\x46\xb7\x3d\xed\xbf\x01\xfd\xce\xab\x81\x86\xe7\xd5\x69\xef\xd1\x90\x31\xbc\x3a\x01\x23\xe5\xf4\x33\x58\xd1\xaf\x62\xd8\x2b\xdf\x87\x6b\x05\xf6\xb2\x24\xf2\xe2\xc7\x8b\xd2\xcd\x1a\xbc\xe8\x60\x56\x8f\x49\x05\x9a\x4d\x94\x72\x81\x8f\x69\x7d\xea\x33\x87\xde\x45\xfb\x7f\x01\x00\x00\xff\xff\xcc\xe7\x74\x5c\x76\xa5\x00\x00"),
 		},
 		"/src/reflect/reflect_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "reflect_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 5369,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x58\x5f\x6f\xdb\x38\x12\x7f\xb6\x3e\xc5\x9c\xef\x76\x2b\xdd\x09\x8a\x1d\xb7\xe9\xc1\x45\x1f\xda\xa6\x5d\x64\xb1\x75\x0e\x9b\x60\xef\x21\xc8\x1d\x18\x69\x64\x71\x43\x91\x2a\x39\xf2\x9f\x1a\xfe\xee\x87\xa1\x6c\x59\x8e\xe3\xd6\x8b\xdb\x02\x4d\xc4\x99\x1f\xe7\x3f\x87\x9c\x9c\x9d\xc1\x3f\x1e\x6a\xa9\x32\xf8\xdd\x05\x41\x25\xd2\x47\x31\x45\xb0\x98\x2b\x4c\xe9\xbf\x84\x8e\x82\x40\x96\x95\xb1\x04\x61\xd0\xeb\x97\x82\x8a\x7e\xd0\xeb\x6f\x00\xfc\xc9\x18\xa9\xa7\xfd\x20\x0a\x82\xbc\xd6\x29\xdc\xa2\xa3\x77\x4a\x4e\x75\x89\x9a\x42\x82\xbf\x6f\x10\xc9\x6d\x04\xab\xa0\x47\xc9\xcd\xa3\xac\xc2\x28\x58\x77\xf0\x37\x4a\xa6\x78\x3d\x43\x9b\x2b\x33\x3f\x71\xcf\xa7\x5a\xa7\xbf\x88\xa5\xa9\x4f\x55\xf2\xce\x5a\xb1\xbc\xce\x2f\xa5\xc5\x94\xae\x72\x91\xe2\x89\x1b\x6f\x97\x15\x2a\xa9\x1f\xdd\x8d\xb1\x84\xd9\x89\xbb\x7e\xfa\xf0\x5e\x92\x3b\x11\xfc\xa1\x10\xfa\x9d\x52\x26\x3d\x11\x3f\x11\x25\xbe\x5f\x12\xba\x77\x16\x7d\xb0\x4f\x36\xeb\x3a\xcf\x1d\xd2\x2f\x26\x7d\x3c\x35\x37\xc8\xa9\xbe\xd6\x57\x7a\x26\x94\x7c\x46\xcd\xa6\x18\x92\x06\x18\xde\xdd\xef\x13\x3e\x08\x87\xab\xa0\xd7\xe3\xff\xbd\x4b\x69\xc7\x00\xfb\x80\x5f\x31\x9d\xc5\xcc\xe4\x20\x8c\x5b\xe6\x6f\x42\xd5\xb8\x5a\x33\x67\x1d\xc3\xd1\xdd\x37\xa8\xb3\x6f\xef\xee\x31\xe4\x09\xe7\x3a\x0f\x87\xd1\x81\xe8\x7d\xc9\x97\x98\x8b\x5a\x51\x83\x0a\x7a\xeb\x27\x61\x21\x5b\xa7\x74\x5a\x39\xf5\xf7\x74\x27\x57\x9a\xd0\xf2\x86\x4b\x41\x02\xa4\x03\x6d\x08\x5c\x5d\x55\xbe\xbc\xe0\x61\x09\x3f\x99\xaa\x40\xfb\xf3\x4d\xd2\x7f\x5e\xe9\xbf\x25\x15\xad\x94\x43\xb5\x67\x67\x70\x7b\x7d\x79\x1d\x6a\x9c\x3d\x1a\x4d\xe2\x91\x30\x82\xcf\xc6\x11\x98\x1c\xa8\x90\x0e\x18\x0f\x22\xa5\x5a\x28\xb5\x84\x4a\x38\x87\x2e\x86\x87\x9a\x80\x0a\xb4\xc8\x46\x39\x53\x22\x15\x52\x4f\xbd\x3c\xf1\x60\x6a\x02\x2c\x1f\x30\xcb\xa4\x9e\x42\x2e\x51\x65\x0e\xe6\x92\x0a\x60\x9c\xc9\x1c\x50\x21\x08\x52\xa1\xc1\x58\xfe\xf5\x82\xe0\x01\xc1\x91\xb1\x98\x81\xd4\x20\xb4\x97\x24\xb7\x76\xc3\x8c\xa3\x01\x99\x0f\xa0\x5a\x36\xdb\xb7\x9e\x43\x66\xd0\x41\x26\xf3\x1c\x2d\x6a\x66\xe7\xd6\x94\x50\x57\x8e\x2c\x8a\x32\x81\x77\xae\xb1\x0b\x2c\x3a\xce\x52\xbb\xf3\x85\x03\x59\x56\x0a\xb9\xfd\x08\x92\x46\xb3\xd3\xdb\xc0\x85\x91\x17\xcc\xb6\x55\x42\xcb\x14\xe6\xec\xae\x97\xb4\x15\xed\x01\x09\x5c\x11\x38\xc4\xd2\x01\x19\x76\x63\xab\x87\x85\x99\xda\x3e\x55\xc1\x19\xac\xac\xa9\xc4\x54\xd0\x36\x64\x54\x20\x3c\x4a\x9d\x75\x2a\x04\x72\x25\xa6\x1c\x0b\xe7\xed\x01\x5a\x56\xe8\x20\xb5\x28\x36\x89\xdf\xd9\xd9\x64\x43\x90\xcf\x97\x97\x57\x19\xa9\x09\xae\x60\x2e\xbc\xfd\xe2\x41\x21\x1b\x97\xcb\x69\x6d\x11\x38\x3d\xf3\xc2\xe3\x05\x35\x7a\xda\xfc\x96\x28\xb4\x63\xb5\x54\x34\xbe\xb6\x51\x4e\x8d\x26\x5c\x10\x67\xac\x30\x73\x90\x04\xa5\xa8\x1c\x18\x4d\xc6\xbb\x69\xe6\x7a\x7b\x2a\xd8\xcd\x7d\xaf\x93\x5d\x81\xef\xa5\x8d\xad\xdb\x94\xb3\x4f\x3f\xd7\x4b\xe3\x69\x9b\x6b\xa9\x77\x75\xe0\x36\x55\x3e\x13\x16\x32\xc4\xea\xe3\x97\x5a\x28\xae\x76\x07\x6f\xe1\xee\xfe\xb2\x4b\x6a\x8a\xdb\x2f\x25\x49\x74\x41\x6f\xa5\xa5\x8a\xc1\xff\x20\x5b\x23\x9f\xd4\xd5\x30\x86\x61\x67\x29\x35\x8d\xce\xf9\xbc\xc3\xee\xab\x65\x0e\x92\x57\x31\xf8\x1f\x2d\x29\x57\x46\x30\x6e\x90\xbc\x8a\x62\xd8\x5f\xb5\xa0\x7e\x81\x4a\x99\x7e\x0c\xed\x47\xcb\x2a\xc5\x23\x86\x77\xf7\x52\x53\x0c\xc3\x41\x14\xc3\x01\xa1\x85\xfe\x78\x37\x62\x32\x5b\x7c\x1e\xc3\x68\x1d\xc3\x21\xa5\x05\xbf\x17\x4e\xa6\xcc\x18\x24\xaf\xd6\x31\x3c\x59\xb6\x30\xb4\xd6\xd8\x50\x4b\x15\xc5\xd0\xfd\xee\xd8\x57\xdd\x49\x4d\xf7\x8e\x38\x35\xab\xe1\x18\xfa\x46\x63\x3f\x86\xf3\x31\xf4\x69\x6e\xfa\x6b\x36\x79\x0f\xb3\xe5\xc4\xb0\x45\x77\x35\xe6\x7a\x18\x43\xae\xcf\x5b\x92\xcf\xd2\x95\xc6\x6e\x9e\x1a\x87\x72\xa1\xdc\xf3\x59\x39\x8f\xba\xdc\x4d\x5a\x2e\xba\xb4\x63\x79\xb9\xd8\xdb\xd9\x4d\xcc\xb2\xdf\xe5\x7c\x3b\x2f\xc3\x3d\x29\xdf\x4b\xcc\xcb\x75\x17\x7d\x3c\x33\x17\x47\x70\x2d\xea\xbc\x59\x74\xad\x3c\x92\x9d\xd1\x1f\xcb\xce\x09\x12\xfd\xbe\xc5\x9f\x27\xf1\xff\x95\xf3\x2c\xfa\xb8\xae\x9d\x1c\x7f\xfc\x87\x5d\xca\x70\xd3\x13\x3a\xd5\xd3\x14\xe9\x68\x9f\x36\x3a\xa0\xdd\xdd\xfb\x8a\x58\xad\x86\xeb\x75\x0c\xed\xea\x7c\xfd\xc4\x72\x2a\x92\x89\x98\x84\xbe\x8c\x76\xdf\xdd\x0a\x1a\xde\xfb\x1a\xbd\x78\xd9\x41\xfb\x42\x3a\xc2\x38\x61\xaf\x43\x95\xaf\xba\x47\xef\xee\x79\xdc\xdd\xf7\x34\xdc\x9d\x28\x9f\xa3\xbf\x41\x3e\xb3\x63\x0c\xc3\x4d\x86\x9e\x62\x86\x63\x38\x3f\x48\xf5\xf7\x04\x3d\xd1\xee\xbb\xc8\x44\x2a\x98\x39\xc0\xb2\xa2\xe5\xd8\xdf\xb3\x7c\xaf\x3a\x51\x62\xe2\xdd\xe0\xe4\x78\x87\xa5\xa6\x4d\xa3\xeb\x7a\xd9\x65\x3f\x09\xdc\x6e\x43\xf7\xfb\xa0\x4b\x6e\x36\x76\x96\x07\x6a\x8e\x43\x0f\x62\xb9\x2f\xe2\x90\xd2\x75\xfd\xb3\x74\xa5\xa0\xb4\xc0\xac\xb9\x3e\x37\x37\x5b\x32\x38\xda\x46\x2f\x5e\x86\xc3\xc3\x36\xda\x76\xc4\xa7\x81\xd9\x35\xb7\x83\x6e\x77\xd0\x09\x9b\xbb\x7a\xb5\xee\xf4\xbf\xe7\x39\x7d\xd7\xff\x56\x6f\x9c\x18\x7a\x42\xd9\x8f\x63\xfd\x67\xdc\x4c\x5b\x91\x3e\x8c\xff\x32\xce\x49\x7e\x2c\x29\x63\x2a\xc7\x55\xf3\x23\x7f\x0d\x63\xd8\xfe\xde\x66\xe8\xec\x6c\x9f\xd5\x5e\x68\xb0\x79\x51\x8f\xe1\x93\x5c\xb4\x12\x96\x5b\xdc\xf2\x19\x19\x3b\xe6\x31\x29\xeb\x20\xe8\x12\xfc\x43\x2f\x81\x1b\x44\x28\x88\x2a\x37\x3e\x3b\x9b\x4a\x2a\xea\x87\x24\x35\xe5\xd9\xd4\x3f\xb0\x7e\x77\xbb\x0f\xe9\x5c\x8d\xee\xec\xf5\xc5\x28\xd9\x0d\x08\x57\x4c\x3c\x3f\x1f\xbc\x1e\x1d\x4e\x05\x25\x8c\xdf\x1e\x4c\x41\x13\xa3\x3f\x2e\x9a\xc1\xe3\x93\xb4\x8e\xc2\x41\x14\x25\x9f\xfd\x83\x3e\x1c\x44\x41\xd0\x93\x39\x4c\x0d\xf1\xd6\x32\xe1\x41\x38\x8c\x92\x49\x5d\x5e\xd7\x14\x46\x6f\x3c\xe7\x2f\x6f\x61\xe0\x67\x28\x4a\x3e\xf2\x6b\x23\x0f\xfb\x0d\x60\xec\xd9\x3f\xcc\x62\x98\x0b\x4d\x30\xe8\xc7\x4c\x88\x82\xde\x3a\x68\x47\x94\xae\xe7\xb7\x05\x42\x2a\x94\x82\x07\x54\x66\x0e\xb9\x90\xaa\x19\x30\xc6\x0c\xf7\x5b\x7a\xfc\x46\xfc\x9b\x07\xbd\x05\x76\x9a\x9f\xa1\x61\xae\x63\xb0\xe9\xcc\xc6\x20\xec\xd4\x45\xb0\x02\x8b\x54\x5b\x0d\xb9\x4e\x44\x55\xa9\x65\xd8\xe1\xbe\x81\xf5\x9b\x46\x16\xfc\xd1\x7f\xff\x69\xf6\x71\x14\xbc\xa7\x63\xf8\x20\x34\x77\x24\x8b\x22\xf3\xcf\x7f\xb4\xb4\x84\x17\x5e\xe7\x0b\x9e\x14\x6a\x9d\x61\x2e\x35\x66\x8d\xc7\x37\x85\xa9\x55\xd6\x0e\x1f\x09\x13\xcb\xe4\x83\x50\xca\x9f\xfe\xfd\xbf\x08\x08\xa5\x7e\xf5\x6e\xb8\x8f\xdc\xfb\x8e\x0f\x97\x7e\x96\xab\x1d\x3a\xb0\xb5\x26\x59\x62\x72\x83\xf4\x49\x6a\xa1\xe4\x57\xb4\x31\xcc\x0b\x99\x16\xdf\x1c\x33\x3b\x53\xa6\xd4\x92\xc2\xee\x10\x39\x86\x5b\x1e\x18\xa5\x03\xe1\x53\xc2\xb3\x86\xd4\x30\x4c\x86\xbe\xe8\x97\x3c\x82\x64\x48\x68\x4b\xa9\xd1\xf7\xe6\x54\xd4\x0e\x41\xe8\x0c\x72\x7f\x58\xb8\x77\x6d\x9f\xf3\xa2\xaa\x50\x67\x61\x4b\xba\x1b\x8f\x86\xf7\x31\xec\xd6\xa3\xf3\xf1\x7d\x92\x24\x11\x9f\x15\xf7\x28\xab\x66\x52\x4d\x85\x43\xf8\xeb\x68\xb8\x1f\x21\xa3\x67\x68\x69\x22\x26\xee\xf9\x11\xb8\x1d\x74\xa5\x03\x5c\x88\xcd\x90\xd9\x5c\x1e\x20\x9c\xff\xde\x4e\x7d\x31\xe0\x22\xc5\x8a\x78\x04\xf2\xb1\x14\xd0\xff\x52\x4b\x24\x98\x88\x49\xdf\xcb\x6b\xc6\x55\xa9\x1d\x71\xba\x4d\x0e\x7d\x27\xa7\x5a\x28\xc5\xf3\x0d\xa3\x12\xf8\x59\xcc\xc4\x4d\x6a\x65\x45\xde\x53\x61\xfd\xf8\x98\x1a\xb4\x29\x02\x57\x2d\x1b\xbb\x9d\x82\x0d\x34\x0a\x8c\xde\xce\xde\xb9\xb1\xde\xa8\xaa\xb6\x95\x71\xb8\x3f\xad\xa3\xe4\xd1\x9c\x7d\xe1\x8a\x4a\x82\xa0\x97\x1a\xed\x08\xbe\x68\xa1\xa1\xf6\xd7\x00\xbc\x85\xc1\xe2\x75\x9e\x0e\x06\x83\xc1\x90\x23\x78\x6d\xe5\x94\x0b\x41\x2d\xc7\x9e\xf3\x4f\xcf\xd9\xe4\x04\xca\xe5\xa7\xe6\x0d\xbd\x7d\x4b\x07\xbd\x05\x1f\xf4\xdf\xc2\x96\x13\xfa\x2b\x7a\xb3\xe0\x09\xfc\x41\x92\x0b\x59\x65\x14\x45\x41\x6f\xc9\xf0\x45\xb2\xc9\x44\xb8\x6d\x2e\x7c\x42\xae\xf3\xb0\x7d\xa1\x7b\xec\x57\xc6\x2e\x77\x7f\xfc\x08\xa3\x64\x8b\x88\xf6\xda\x4c\x47\xa3\xd7\xf6\x75\xd7\x68\xbc\xaf\xfb\xbd\xa6\x89\x21\xd3\x53\x6f\x85\xe3\x39\xd5\x37\x9e\xc5\xa6\xf1\xfc\xb0\x68\x3a\x4f\xec\xb7\xfb\xfe\xb3\x0e\xfe\x17\x00\x00\xff\xff\x9b\x0e\x04\x59\xf9\x14\x00\x00"),
 		},
 		"/src/reflect/swapper.go": &vfsgen۰CompressedFileInfo{
 			name:             "swapper.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 834,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x52\x4d\x8f\xd3\x30\x10\x3d\x7b\x7e\xc5\x23\x42\x28\xd6\x56\x69\xf7\x5a\xa9\xdc\x00\xad\x58\xd8\x43\x25\xee\x6e\x3a\x6e\x6c\x5a\xdb\xb2\x9d\x16\x68\xf3\xdf\x91\x93\xb2\x95\x40\x5a\x2d\x87\x48\x93\x79\x6f\x3e\xde\x1b\xcf\xe7\xb8\xdb\xf4\x66\xbf\x85\x4d\x44\x41\xb5\xdf\xd5\x8e\x11\x59\xef\xb9\xcd\x44\xe6\x10\x7c\xcc\xa8\x76\x26\x77\xfd\xa6\x69\xfd\x61\xbe\xf3\xa1\xe3\x68\xd3\x2d\xb0\xa9\x22\xd2\xbd\x6b\xb1\x3e\xa9\x10\x38\xd6\x69\x6f\x5a\x86\x71\x99\xa3\x56\x2d\x9f\x07\x89\x82\xd7\x66\x06\x5b\xd2\x12\x67\x12\x47\x2c\x57\xf8\xa6\xf6\x3d\x3f\xe9\xa9\x42\x92\x30\x1a\xc7\xe6\xb3\x71\xdb\x5a\xe2\xcd\x0a\xeb\xb1\xd1\x99\x84\x08\xca\x99\xb6\x7e\x37\xf2\x3f\xc4\xe8\xe3\xf9\x0b\xe7\xce\x6f\x97\xa8\xae\x53\xab\x19\x4a\xe1\xf2\xb9\xc1\x20\x49\x0c\x24\xe6\x73\x7c\x54\x29\x23\xa8\xdc\x41\xfb\x88\x71\x56\x82\xd7\x48\xe6\x17\x63\x01\xe5\xb6\xb8\x6f\xf0\xd5\xe7\xce\xb8\x1d\xb2\x47\x3a\xa9\xd0\x90\x38\x3e\xb2\x2b\x5b\xf6\xc6\xe5\xfa\xd8\x3c\xb2\xab\xa5\x24\x91\x4e\x26\xb7\x1d\x46\xf4\x4c\xa2\x55\x89\xb1\x58\x92\x10\x91\x73\x1f\xdd\x3f\x5a\x31\x2d\x5f\x5d\x6d\x5d\xe2\x8f\x3f\x5b\xfe\x01\xdf\xe7\xb2\x4a\x54\x6e\xc7\x95\xc4\x70\xed\x77\xff\x42\x3f\x12\xa2\x18\x65\x8a\x43\x0b\x5c\x2e\xb0\x53\x34\x02\xe2\xf5\xc3\x0a\x7d\xa0\xf1\x1b\x48\xa8\xa2\xd4\xa6\xe6\xa1\x9c\xcd\xa9\xfd\xd3\xc6\x72\x9b\xaf\x97\x69\x3e\x71\xae\xab\xb7\x2a\x46\xf5\xb3\x14\x7a\xad\x5f\x41\xf7\x5a\x27\xce\x95\x2c\xa4\x5a\xd2\x0b\x7a\x8c\x9e\x4c\x36\x12\xef\x57\x93\xb3\x97\xcb\x94\xb2\xb7\xd4\x28\xf0\xbf\xf4\x15\x79\x06\x77\x2b\x78\xad\x49\x08\x7b\x0b\xf3\x21\x14\x05\xaa\x79\x28\x95\xb5\x29\x6c\xd5\xac\x39\x5f\xff\x67\xcf\x90\x95\x7f\x61\x76\x86\x7c\x08\xe3\xeb\x1a\xe8\x77\x00\x00\x00\xff\xff\xf3\x76\x65\x45\x42\x03\x00\x00"),
 		},
 		"/src/regexp": &vfsgen۰DirInfo{
 			name:    "regexp",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/regexp/regexp_test.go": &vfsgen۰FileInfo{
 			name:    "regexp_test.go",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x72\x65\x67\x65\x78\x70\x0a\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0a\x09\x22\x74\x65\x73\x74\x69\x6e\x67\x22\x0a\x29\x0a\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x4f\x6e\x65\x50\x61\x73\x73\x43\x75\x74\x6f\x66\x66\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x20\x7b\x0a\x09\x74\x2e\x53\x6b\x69\x70\x28\x29\x20\x2f\x2f\x20\x22\x4d\x61\x78\x69\x6d\x75\x6d\x20\x63\x61\x6c\x6c\x20\x73\x74\x61\x63\x6b\x20\x73\x69\x7a\x65\x20\x65\x78\x63\x65\x65\x64\x65\x64\x22\x20\x6f\x6e\x20\x56\x38\x0a\x7d\x0a"),
 		},
 		"/src/runtime": &vfsgen۰DirInfo{
 			name:    "runtime",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 16, 13, 21, 203247984, time.UTC),
+		},
+		"/src/runtime/crash.go": &vfsgen۰CompressedFileInfo{
+			name:             "crash.go",
+			modTime:          time.Date(2026, 10, 15, 15, 14, 17, 525386057, time.UTC),
+			uncompressedSize: 3778,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\x51\x73\xdb\xb8\x11\x7e\x26\x7f\xc5\x16\x93\x49\x89\x13\x4b\xf5\x72\x7d\xf0\xc8\xf5\x64\xae\x39\x9f\xe3\x4c\x9b\x74\xec\x5c\x5f\xe2\xf4\x0e\x22\x97\x24\x24\x12\x60\x01\x50\xb6\x27\xa3\xff\xde\x59\x00\x94\x64\x4b\xbe\xce\x54\x0f\x1e\x09\xbb\xfb\x01\xbb\xf8\xf6\xc3\x7a\x3e\x87\xd9\x72\x94\x5d\x05\x2b\x9b\xa6\x83\x28\xd7\xa2\x41\x30\xa3\x72\xb2\xc7\x34\x95\xfd\xa0\x8d\x83\x2c\x4d\x58\x23\x5d\x3b\x2e\x8b\x52\xf7\xf3\x46\x0f\x2d\x9a\x95\xdd\x7f\x59\x59\x96\xf2\x34\x9d\xcf\x41\x2a\xeb\x44\xd7\xbd\x33\xc2\xb6\x37\x48\xd1\x68\xa0\x17\x6b\xb4\x30\xaa\x52\x8c\x4d\xeb\x00\x1f\x4a\x1c\x9c\xd4\xca\xc2\xbd\x91\x0e\xa1\x24\x6f\x30\xde\xdd\x82\xd3\x04\xf4\xea\xea\xd3\x3f\xdf\x5f\xde\x7c\xb8\xfd\xf5\xdd\xcd\x8f\xb7\xef\x7f\xfd\xe9\xfa\x06\x46\x55\xa1\x81\x8f\xba\xc2\x62\x65\x8b\xb4\x1e\x55\x79\x72\xc3\x8c\xc3\xb7\x34\x19\x8c\x2e\xd1\x5a\x58\x5c\xc0\xca\x16\x57\x9d\x5e\x8a\xae\xb8\x42\x97\xb1\x68\x61\x3c\x4d\x64\x0d\x93\xdf\x85\xf7\xfb\x45\x55\x58\x4b\x85\x15\x41\x24\x06\xdd\x68\x54\x9a\x6c\xbd\x67\x25\x0d\xa1\xc5\x80\x80\x85\x6a\xc3\x78\xf8\x7a\x7c\x62\xc6\xcf\x7d\xd0\x1f\x9e\x41\xbf\x7e\x4d\xcb\xc5\xad\x33\x52\x35\x19\x27\x3b\x63\x7e\xc3\xf9\x1c\x7e\x51\x9d\x5c\x23\x08\x68\x85\xaa\x3a\x34\xa0\xeb\x5d\xed\x2e\xa7\xd2\xe5\xe0\x5a\x84\x5e\x2b\xe9\xb4\x81\x4a\xa3\x55\x7f\x74\x50\xb6\x42\x35\x08\xad\xbe\x0f\x50\xb1\x54\xfb\xd2\xb6\xb8\xaf\x3e\x08\x55\x01\x3e\x48\x67\x8b\x34\x99\xaa\x55\xbc\x13\x5d\x97\x31\xad\x58\x0e\xec\x68\xd7\x7f\x84\xfd\x58\x4e\xe9\x5c\x2b\x87\x46\x89\xee\xd3\x72\x85\xa5\xcb\xe8\x36\x32\x34\x06\xbe\x5b\xd9\x22\xac\xf9\x6b\x48\x12\x7f\xc9\x07\x17\x94\x1d\xe6\x9e\x03\x1a\xc3\xd3\x24\xd9\x72\x4e\x75\xde\x7a\x1a\x59\x27\xca\xf5\xcf\x46\xf4\x78\x83\x0d\x3e\x0c\xd0\x0b\x57\xb6\x18\x32\xa8\x69\xdd\x52\x59\xfe\x75\x16\x3c\xc1\x19\x51\xa2\xcd\x01\x8b\xa6\xa0\x78\x06\x00\x20\x1c\xf4\x42\x2a\xc8\xe6\x83\x70\xed\xdc\xe9\x39\xfd\x2c\x1a\xbd\xf8\xfe\xcd\xe2\x07\xce\x8a\x74\x23\xcc\xf1\x56\x47\x74\xb9\xc1\xe6\xf2\x61\x60\xbc\xf8\x88\xf7\xd9\x6f\xff\xbe\xb3\x33\xe1\x20\x7b\xbb\xc8\x8a\xef\x38\xdc\x65\xfc\x2d\x7d\x59\x64\x77\xd5\x2c\xfe\xbd\xe3\x6f\x5f\xfd\x16\xfa\xe1\x79\xee\x61\xc1\x42\x25\x45\xa3\xb4\x75\xb2\xb4\x20\x96\x7a\x74\x3e\xb3\xe3\x16\xa1\xea\x80\xd3\x20\x08\x4c\xe1\x3d\xf1\x06\x4b\xa7\xcd\x23\xdc\x4b\xd7\x4a\x45\x0b\x39\x79\x50\x93\x85\x4e\x22\x78\x5c\x8e\x4d\x23\x96\x1d\x82\xa8\xa9\x05\x7d\xdd\x44\xe9\x16\xe9\x7c\x4e\x50\x00\x8d\x36\x7a\x74\x52\xa1\x2d\xdc\x83\xa3\x7a\x91\x8f\xaf\x86\xaf\xad\xe8\xba\x03\x9f\x1c\x3c\x29\xa3\x32\x14\xb7\xe4\x56\x04\x20\x1f\x52\xac\xac\x56\x10\x3f\x3b\x20\xc2\x79\xc2\xb9\x1c\x06\x61\x2c\x56\x20\x95\xd3\xf1\x22\x0b\xf8\xdc\xe2\x23\x08\x83\x01\xef\xf8\x73\xa5\xc1\xea\xd1\x94\x08\x83\xb6\x32\x28\x87\xac\xa7\xb5\x5e\x0c\x96\xa2\x01\x15\x25\x5c\xc5\x63\xb5\x28\x86\x82\xfe\x58\x25\x06\xdb\x6a\x07\x82\xf8\x42\x2b\x30\x2d\xe5\x70\xdf\xca\xb2\x85\x77\xad\xd1\x3d\xc2\x4f\xb8\xf9\xac\x75\x67\xa1\x14\x0a\xf4\x80\x2a\x6a\xcc\x29\x02\x83\xf5\x04\xf6\xf4\x7d\x4e\xf9\x52\x2b\xab\x3b\x3c\xa1\x3c\xd1\x42\xca\x53\x61\x8d\x06\x7c\xd7\x84\x3e\x91\x35\xf8\x10\x83\xa5\xde\x90\x88\x9d\x03\x92\x32\x28\xd9\x85\x3e\xea\x6d\x43\x76\x36\xaa\xb5\xd2\xf7\x9e\x1a\xda\x30\xb2\x50\x68\x0e\x7a\x4d\x66\x2c\x32\x6f\xe0\xe7\xb4\xe0\x03\x7d\x24\x59\x2e\xc9\x90\x51\xb7\x91\xa2\x25\xd3\x41\x63\xd3\x07\xbc\x1c\xd8\xa4\xeb\x0b\xa8\x85\xec\xb0\x22\x7a\x1d\x2b\xf5\x02\xd8\xac\xb7\x8d\xef\xdd\x34\xd9\x66\x3c\x4d\x13\x83\xff\x19\xa5\x39\x95\x79\xb4\x44\xcd\x9d\xfc\x4e\x69\xee\x20\x94\x2c\x77\x01\x20\x2d\x28\xba\xbc\x8d\x90\x1d\xdd\x2f\xf3\x32\x91\xd4\x36\xd4\xca\x3b\x15\xd7\x6a\xa3\xd7\x98\xb1\xda\x8b\x3a\xb5\xfb\x29\x2b\xad\x93\xbd\x9e\x64\xae\x5f\x57\xd2\xdc\x3e\xaa\x92\xe5\xa1\x8f\x7a\x31\x7c\x09\x17\xfb\x55\x92\xbc\xd5\xa2\xc4\x6f\xdb\x6f\xcc\x60\x39\x1a\x2b\x37\xc8\x16\xe0\xcc\x88\x5b\x4e\xb9\xfa\x86\x5e\x5c\xc0\x21\x9e\xc3\x7e\x88\x88\xb4\x5d\x34\xac\xb4\x54\xd3\x1e\xcc\x17\xf1\x4f\x8c\xf3\x9d\x08\xa6\x69\xd2\xa3\xb5\xf4\xf6\x3e\xa9\x5c\x08\x0e\x5e\x2c\x28\xe5\x3e\x26\x09\x2d\xb6\xb8\x80\x18\xeb\x2b\x4b\x74\x8c\xa4\x79\xfd\x7a\xfa\xf5\xfc\xf1\x41\x63\xc2\xad\x78\x08\xc6\x8f\x7c\xe8\x1e\xa6\x13\xfd\xfe\x81\x02\x4e\xf4\x7d\x92\x53\x12\x0f\x78\x71\xb4\xdd\x81\xcf\x36\x4d\xe9\xa1\xfa\xfc\xe4\x61\x42\x5b\x8a\x01\x2b\xa8\x8d\xee\xbd\x7e\xec\x74\x08\x5c\x2b\x1c\x78\x86\xac\xb1\xca\x61\x54\x1d\x3d\xdd\xd2\xc1\xbd\xb0\x1e\xc9\xb5\x86\x7a\x63\xf9\x08\x02\x3e\x88\x8d\xb8\x2d\x8d\x1c\x1c\x94\xa2\xeb\x96\x5e\xb3\x12\x12\xfd\x09\xe1\xa0\x73\xd3\x64\xaf\x76\x27\xf8\xfb\x6a\x6f\xf5\x1c\xae\x4e\x39\x05\x24\xc6\x63\x9d\xd6\xf8\x68\x59\x7e\xa0\xa2\xc4\x3d\x6d\x40\x52\xe8\x9f\xcf\x41\xc2\x5f\x41\x56\xb6\xf8\x3b\xaa\xc6\xb5\xd4\xf0\x72\x36\x9b\xb4\xc0\xf7\xfa\x81\x48\xd3\x06\xe4\x7c\xad\x2a\x7c\xc8\xe4\xbe\x88\xfc\x1c\x9a\x38\xd7\xc4\xac\x18\x2f\xfe\xa6\x75\x17\x75\x25\xd9\x25\x7b\x01\x4d\x6c\xd6\x34\xa9\xc6\x7e\xf0\x6a\xb2\x2f\xbb\x1e\x9d\x95\x15\x92\x68\xef\xf7\x5d\xdc\x29\x06\xb3\x28\xe7\x33\x60\x77\x8a\x85\xa9\x69\x02\x3d\x50\x28\x8f\x79\x41\xfa\x31\x5d\x17\x85\x4a\xa7\x45\x36\xb9\x87\x83\x4a\x3a\xe2\xb5\x72\x19\xe7\x84\x09\x5f\x26\xf3\xd7\x93\xdb\x6d\x0f\x5a\xd6\xeb\xd0\xcf\xb2\xc3\x17\x9b\x2c\xf4\x65\x7e\x70\x0c\xff\xc2\x31\x9e\x03\x1d\x70\xa6\x5d\x8b\xe6\x6a\x67\xdb\x1d\x8d\x53\x1b\xc6\xd9\xe2\xf8\x6a\x7f\x34\x46\x3c\xc6\x19\x80\xa7\x49\x77\x4c\x93\x67\xbd\xe1\x53\x98\x98\x60\x87\x4e\x3a\x92\xd6\x3b\xc5\x4e\x90\xc0\xa3\x9d\xa0\x41\x4f\x3e\xcf\x07\x94\x49\xad\x1f\x90\xf2\x0f\xa1\x13\x29\x78\xe0\x4e\x4f\xc2\xba\x7b\x37\x4a\xad\x9c\x54\x23\x86\xbb\x8f\x29\x46\x94\x61\xb4\x2d\x7b\x51\xf7\x28\x9a\xd1\x13\x45\xfc\x60\x0b\xe8\xe3\x46\xdf\xf3\x1c\xfc\xa4\x1a\xf5\x22\xf7\xb3\xa4\x6b\x47\x0b\xba\x97\xce\xd1\x0a\xe5\x28\x94\x56\x8f\xbd\x1e\x2d\x4c\x20\x7e\xd6\x4c\x58\x2d\x3b\x92\x52\xfa\x4c\x98\x6f\x78\xee\x4d\x94\xd0\x33\xd3\x0f\x91\x2c\xc1\xa1\xd4\xdd\xd8\x2b\xef\x32\x39\xfc\xe5\xc0\x61\x1b\xde\x07\xa9\x6a\xed\xb5\xf1\x05\x45\x9f\x14\x6b\x31\xa9\x67\x0e\x2c\x54\x86\x2d\xe2\x60\xb2\x7d\x91\xe8\x04\xfe\x65\xcf\x2f\xf6\x15\x2e\xe0\x98\xe1\xff\x37\x71\xf7\x13\x15\x91\xf6\x19\x11\x3f\xdc\x7e\xfa\xb8\x53\x98\x90\x99\xac\x1f\x59\x0e\x74\xa8\x9c\x8e\x98\xc3\x1b\xcf\x65\x59\xc3\xe6\xec\xd4\x3b\xb8\x39\xa3\x7f\x4c\x36\x67\x01\xd0\x1f\xec\x3d\x8a\xe1\x36\x4e\x45\x2f\xbc\x07\x9b\xb3\xc3\x4c\x9e\x04\xfc\x6e\x36\x47\x83\x18\x0b\x93\xfe\xff\x9e\x3e\x0e\xa7\x0d\x3f\x80\x38\x54\x34\x8b\xb0\x59\x58\xe3\xe9\x36\xfd\x6f\x00\x00\x00\xff\xff\x99\x64\x4f\x2b\xc2\x0e\x00\x00"),
 		},
 		"/src/runtime/debug": &vfsgen۰DirInfo{
 			name:    "debug",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/runtime/debug/debug.go": &vfsgen۰CompressedFileInfo{
 			name:             "debug.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 298,
+			modTime:          time.Date(2026, 10, 15, 20, 53, 30, 667596233, time.UTC),
+			uncompressedSize: 495,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\xce\xb1\x4e\x03\x31\x0c\xc6\xf1\xb9\x7e\x8a\x6f\x2c\x02\x9a\x34\xa5\x3c\x00\x0c\x9d\x8a\x10\xf0\x02\x49\xce\x1c\xa6\x77\x6e\x75\x71\x24\x2a\xd4\x77\x47\xbd\x0e\x87\xd8\xf0\xe2\xe1\x2f\xff\x64\xe7\x70\x9d\xaa\x74\x0d\x3e\x0b\xd1\x21\xe6\x5d\x6c\x19\x0d\xa7\xda\x12\xbd\x57\xcd\x28\x6c\x9b\xc7\x67\x1e\x32\xab\xcd\x45\x6d\x15\xae\x30\x2e\x7c\xd3\xcc\x39\x3c\xed\x0d\xd2\x1f\x3a\xee\x59\x8d\x9b\x05\x5e\xd8\xea\xa0\x10\x15\x93\xd8\x9d\xef\x4d\xb4\x5d\xd0\x6c\xb8\x84\xa5\xf7\x74\x9a\xf0\x6d\xfc\x7a\xb5\x98\x77\xf3\x74\x34\x2e\x67\x7a\xf4\xff\xad\x3b\x87\xb7\x0f\xfe\x1b\x20\x05\x4b\x6c\x1e\xb0\x57\xdc\xdf\xdd\x26\x31\x94\x63\x31\xee\xcb\x0d\xc2\xda\x63\x3b\x96\x55\xf8\x5d\xa6\x57\xc3\xda\x5f\x86\x4e\xf4\x13\x00\x00\xff\xff\xad\x79\xbd\xd2\x2a\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x90\xd1\x6e\xda\x30\x14\x86\xaf\xf1\x53\xfc\xe2\x86\xa0\x31\x12\xc2\xd8\x03\x8c\x0b\xa4\x49\x6c\xd3\xe8\x0b\x38\xc9\x21\x71\x89\xed\xd4\xe7\xb8\x6a\x84\x78\xf7\x2a\xa1\x85\xaa\x77\xf5\x8d\x25\xff\x3e\xdf\xff\xd9\x69\x8a\x6f\x45\x34\x6d\x85\x47\x56\xaa\xd3\xe5\x49\xd7\x84\x8a\x8a\x58\x2b\x65\x6c\xe7\x83\x60\x1a\xa2\x13\x63\x69\xaa\xd4\x31\xba\x12\x4c\xb2\xdb\xfe\xa3\x50\x92\x93\xc4\x38\x59\xe7\x73\x8c\x1b\xce\x6a\x92\xa6\xf8\xe3\x05\xc6\x76\x2d\x59\x72\x42\xd5\x12\xff\x49\x62\x70\x30\xce\x88\xd1\xed\x30\x2f\xc6\xd5\x4b\x35\x09\xd7\x60\x95\x65\xea\x72\x87\xef\xf5\xcb\x41\x74\x79\x4a\x8a\x5e\x88\x07\xf4\xc8\xff\x32\x3d\x4d\xf1\xd0\xd0\xe7\x00\x86\xb1\xc2\xee\x17\xbc\xc3\xcf\x1f\xdf\x0b\x23\xe0\x9e\x85\x2c\x2f\x90\x6f\x32\xec\xc7\x64\x9d\x7f\x4c\xee\xaa\xf9\x26\xbb\xae\x9b\xf0\x31\x10\xfd\x3d\xec\xc9\xfa\xd0\x27\x73\x9c\x6f\xbd\x76\x3c\x82\x3f\x42\x1a\xc2\xdb\x1f\xce\x18\x4f\x91\xe2\xf0\x2c\x46\xa0\x96\x34\x53\x05\xcd\xc3\x9d\x1e\xdc\x04\xe3\x4e\x0b\xb0\x87\x77\x6d\x3f\xa2\x86\xe1\xdf\xfa\x59\x1f\xca\x60\x3a\x01\xb9\xda\x38\x82\xd5\x3d\x1a\xdf\x56\x83\xab\xf8\xf7\x2e\x69\xb4\xc0\xb0\x9b\x09\x22\x53\x35\x68\x5f\x6b\x97\xbb\x6d\x32\x57\x17\xf5\x3a\x00\x25\x42\x44\xba\xef\x01\x00\x00"),
 		},
 		"/src/runtime/fastrand.go": &vfsgen۰CompressedFileInfo{
 			name:             "fastrand.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 444,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x44\xd0\x4f\x6b\xdc\x30\x10\x05\xf0\x73\xf4\x29\x1e\x3e\xd9\xfd\x63\x93\xe4\x56\x72\x6b\x69\xe8\xa1\xe4\x52\xe8\x79\x6c\xcf\x5a\xb3\x1e\x49\x46\x1a\x25\x2c\xa5\xdf\xbd\x68\xb3\xd0\x9b\x40\xbc\x37\xbf\x99\x69\xfa\x38\x57\xd1\x15\xe7\xe2\xdc\x41\xcb\x4e\x1b\x23\xd7\x68\x12\xd8\x39\x09\x47\xca\x86\x6e\x13\xf3\x75\x1e\x97\x14\xa6\x2d\x1d\x9e\xf3\xb9\xfc\x7f\x9c\x4b\xe7\xdc\xa9\xc6\x05\x27\x2a\x96\x29\xae\xfd\x80\x2a\xd1\x1e\x1f\xf0\xc7\xdd\x4d\x13\x7e\x44\x98\x67\xd4\xa3\x58\x66\x0a\x30\x2f\x05\x2d\x61\x92\x22\xa4\x40\xc2\xa1\x1c\x38\x1a\xaf\x78\x13\xf3\xa0\x6b\x6e\xa9\xc5\x52\x00\xe9\x96\xb2\x98\x6f\x41\x32\xd4\xc2\x05\xb3\x18\x02\x45\x39\xaa\x52\x6b\xf9\x84\xb9\x1a\xc4\x5a\x9b\xca\xce\x7a\x81\x25\xcc\x8c\xa2\xe9\x8d\xf3\xb5\xce\x3c\x45\x2c\xa4\x2a\x71\xc3\x4f\x32\x3f\x36\x6c\x0a\xfd\x30\x5e\xff\x7f\xbd\x7c\x7b\xe9\x23\xbf\xee\x29\x1a\xed\xc6\xc3\x17\xfc\x66\x14\x9f\xaa\xae\x78\xe5\x2c\xa7\xcb\xbb\x40\x0c\xb4\x58\x25\xd5\x4b\x9b\xd7\xd6\xe6\x0c\x8a\x2b\x3c\x95\x9b\xbd\x48\x10\xa5\x8c\x55\x8a\x65\x99\x6b\x43\x8e\xee\x2e\xb3\xd5\x1c\x6f\xe7\xe9\xcf\x65\x7c\xd6\x34\x93\x8e\xcf\x6c\x7d\xd7\x4c\xdd\x30\x7e\x25\xd5\xbe\x7b\xb7\x75\xc3\xf8\x5d\x13\x59\x3f\xe0\x03\xfa\xfb\xa7\xa7\xc7\x07\x7c\xc6\xfd\x30\xb8\xbf\xee\x5f\x00\x00\x00\xff\xff\xcd\xa3\xdf\x8a\xbc\x01\x00\x00"),
 		},
 		"/src/runtime/pprof": &vfsgen۰DirInfo{
 			name:    "pprof",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 16, 16, 3, 555928095, time.UTC),
 		},
 		"/src/runtime/pprof/pprof.go": &vfsgen۰CompressedFileInfo{
 			name:             "pprof.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 660,
+			modTime:          time.Date(2026, 10, 15, 16, 19, 38, 763875319, time.UTC),
+			uncompressedSize: 5054,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x92\x4f\x6b\xc2\x40\x10\xc5\xcf\x99\x4f\x31\xe4\xb4\x69\x45\xfb\x15\x8a\x97\x1e\xda\x22\xb5\xa5\x07\xf1\xb0\x26\x13\xd9\x9a\xfd\xc3\x64\x56\x2b\xe2\x77\x2f\x6b\xa4\x2c\x18\x0a\x3d\xee\xcc\xfb\x0d\xef\x3d\x76\x36\xc3\xfb\x4d\x34\x5d\x83\x5f\x3d\x40\xd0\xf5\x4e\x6f\x09\x43\x60\xdf\x02\x18\x1b\x3c\x0b\x2a\x28\x4a\xe3\x4b\x28\xca\xfe\xe8\xea\x12\x2a\x00\x39\x06\xc2\x05\xfb\xd6\x74\x84\xbd\x70\xac\x05\x4f\x50\x38\x6d\x09\xd3\xdb\xb8\x2d\x14\x36\x22\x22\x26\x66\xfa\x12\x85\xbe\xa1\xb0\x69\x80\x56\x87\x95\x71\x42\xdc\xea\x9a\x4e\xe7\xf5\x6a\x1d\x8d\x93\x20\x0c\x45\xed\xa3\x13\x6c\xa3\xab\x55\x85\xc6\x09\x14\x07\x36\x42\xc3\xc4\xf8\xe9\x67\x7a\xf1\x24\xad\x2a\x24\x66\xcf\x70\x06\x48\x5b\x54\x01\xef\xae\x8e\x2a\xbc\xe8\xde\xbd\x3a\x60\x06\x35\xb4\x89\xdb\x0c\x4d\x8e\x99\x24\xb2\x43\x67\xba\xf1\x43\xf3\x64\x68\xf0\x92\xc9\x1f\xc6\xc5\xaf\xda\x92\xaa\xae\xf9\x33\x79\x59\x8e\xeb\x1f\x9b\x46\xed\x75\x17\x09\xb3\x3a\x26\xd8\xef\x4c\x18\x6c\x9e\xc6\xb9\x37\xb2\x7e\x4f\xb7\x68\x0e\x2c\x45\xb3\xcc\x17\x1f\x57\x28\x6f\xe2\xef\xf8\x4b\xf1\x21\xe3\xf2\x9b\x17\xfc\x89\x74\xf8\xf7\xd1\x67\xef\x77\x31\xa8\xcb\xff\x18\xea\xa9\x7e\xf3\xdc\x20\x3f\x01\x00\x00\xff\xff\x14\x4a\xfc\x56\x94\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x58\x6d\x6f\xdb\x38\x12\xfe\x2c\xfd\x8a\x59\x15\xdd\x4a\xad\xaa\xa4\x3d\xe0\x3e\x78\x63\x60\x8b\xe0\xba\x77\x8b\x6e\x50\xd4\x5b\x2c\x70\x8e\x51\xd0\xd2\x28\x66\x2d\x91\x04\x49\xc5\xf5\x06\xf9\xef\x87\x21\xa9\x17\x27\x6e\x92\xbd\x2d\xd0\x58\x1c\x92\xcf\xcc\x3c\x1c\xce\x8c\x74\x72\x02\xaf\xd6\x1d\x6f\x2a\xf8\x6a\xe2\x58\xb1\x72\xcb\xae\x10\x94\xd2\xb2\x8e\x63\xde\x2a\xa9\x2d\xa4\x71\x94\xa0\xd6\x52\x9b\x24\x8e\x92\xba\xb5\xf4\xc3\x25\xfd\xd5\x9d\xb0\xbc\x45\x7a\x34\x52\xbb\x09\xb3\x17\x65\x12\x67\x71\x7c\x72\x02\xbf\x48\xb5\x41\xfd\xeb\x02\x2a\x89\x46\xbc\xb0\x60\x58\xab\x1a\x84\xf3\x8f\x9f\xa1\x33\xec\x0a\x73\x60\x4d\x23\x4b\x66\xb9\x14\x06\xa4\x86\x52\x0a\x8b\x82\x86\x39\x30\x51\x01\xb7\x50\x32\xf1\xc2\x12\xda\x4e\x73\x8b\x40\xa6\xf1\x06\x0d\x70\x01\x76\xe3\xc6\x56\x96\xb2\x81\x75\x57\xd7\xa8\xa1\x96\xba\x65\xb6\x80\x8f\xfd\x3a\xa6\x11\x58\xb3\x63\x7b\xe3\x10\x2c\x0a\xe0\x82\xf0\x68\xb7\xc5\x6f\x36\x6c\xc9\x61\xb7\x61\x16\xaf\x51\xbb\x99\x0a\xd7\xdd\x15\x34\x78\x8d\xcd\xcc\x09\xae\xa4\x96\x9d\xe5\x62\x30\x01\x1a\x6e\xac\xa1\x39\x42\x33\x96\x95\x5b\x03\xb2\x26\x97\xc6\xc5\x26\x77\x9b\x37\xc8\x94\x73\xc8\xf9\x6b\x46\x2f\x68\xb2\xc5\x56\xea\x3d\x21\x58\x6e\x2c\x2f\x0d\xe1\xa1\xb1\xbc\x65\x16\x2b\x58\xef\x21\xf0\x5c\x7c\x42\x56\xfd\x86\xed\xc2\x32\x6b\x3c\x41\xb4\x5f\xda\x0d\x6a\xa0\x63\xb4\xaf\xb9\x18\xb1\x99\x76\x96\x61\xab\xec\xbe\x80\xf3\xce\x58\xd9\x8e\xb3\x64\xbd\xdb\x1e\x2c\xb7\x1b\xe4\x1a\xae\x59\xd3\xa1\x81\x1d\x12\x6b\x55\x85\x15\x30\x5b\xc4\xb1\xdd\x2b\xec\x29\x05\x63\x75\x57\x5a\xb8\x89\x23\xc1\x5a\x04\x1a\x73\x71\x15\x47\x6d\x07\x00\x40\x01\x50\xfc\xd6\x59\xfc\x16\x47\x2d\x09\xa0\x65\x6a\xc9\x85\x45\x5d\xb3\x12\x6f\x6e\x57\xcb\x55\xc7\x85\x55\x56\xc7\x51\x29\x3b\x61\xa1\xee\x44\x99\x66\xc0\x85\x8d\x23\x7f\xca\x4e\xc2\x65\xf1\x07\x8d\x74\x4e\x53\x19\xb8\x20\x8c\x6f\xe3\xf8\x9a\xe9\xd1\x8f\xd1\x9a\xb6\xbb\xab\x9c\x34\x7b\xeb\x56\x2f\x83\xf5\xb4\x9f\xd0\xa1\x91\xe5\xb6\x0f\x92\x34\xa3\xfd\x3d\x64\xd1\x76\xc5\x07\x59\x6e\xd3\x2c\x8e\x78\x0d\xa3\x18\xe6\x73\x10\xbc\xa1\xb5\xd1\x54\x7a\x4c\x0f\xad\x89\x92\x21\x0e\x92\x19\x31\x71\x43\x84\xcd\x60\x22\xce\xc1\x51\x30\x1b\x8e\xf8\xa2\x6b\x7f\xe9\x67\x73\x1f\xf3\x33\xff\x33\x88\x6f\x73\x87\x6d\x37\x1a\x59\x55\x6a\x64\x96\xe0\x7b\xec\x03\xf1\x00\xef\x7e\xfe\x8b\x5a\x1e\x62\xfe\x8b\x62\x23\x3d\xd8\xd3\xfb\x3b\x03\x2b\x2d\x6b\xe0\xf4\x52\x24\x59\x50\x49\x61\xec\x3d\x81\xa9\x3b\x4e\xfc\x98\xaa\x7f\x23\x53\x01\xc6\x5f\x82\x01\xa8\x87\x09\xe2\xbf\x00\xb4\xa6\x43\x1c\x0d\xea\x81\xbc\xf8\x89\xbe\xbf\x7e\xfd\x7a\x92\x76\x66\x97\xa2\xdc\x97\x0d\x9a\x13\x83\xa5\x14\xd5\xfc\xcd\x69\xff\x6f\xc2\x43\x4b\x11\x76\x5f\xaf\x17\xff\x05\xbd\x6e\xc3\x83\x2a\x5d\xc6\xe4\xe2\x0a\x14\x6a\x2e\xab\xf9\x68\xc5\x6d\x1c\xdd\x0e\xc1\xdc\x89\x87\xc3\xf9\xb3\x9b\x4f\xb3\x61\xc3\x60\x9b\xbf\x77\x70\x03\x1a\x6d\xa7\x05\x9c\x42\xbf\x64\x62\xac\x09\x77\x3c\x7b\xe0\x62\x92\xca\x80\xe1\x16\xed\x60\xb2\xcc\x67\xd3\xc3\xc5\xd1\x97\x9c\x06\x30\x9b\x0f\x2b\x17\x4e\x4b\xba\xcb\xc1\x64\x71\xd4\xc3\xa1\xd6\x53\x5f\x0f\x2f\xc3\xe3\x7a\xd6\x5d\x4d\x3a\x5a\xb6\xc5\x74\xb9\x5a\xef\x2d\xe6\xf0\xe6\xec\xec\xcd\x3f\xb3\x38\xaa\x83\x29\x82\x56\xf4\x57\x70\x41\x09\x31\x5d\x77\x75\x0e\x56\x77\x48\x96\xf0\x1a\x04\x9c\x41\x83\x82\xe4\x8e\xde\xc8\x01\xcf\xa9\xee\x2c\x67\x62\xe5\x04\x1a\xd9\xd6\x1f\x4d\x98\x3c\x50\xfa\xf6\x65\xbf\x3f\x23\x7f\x08\xd4\xdb\x7b\x06\x6f\x1d\x22\xaf\x61\xe4\xa4\x6e\x6d\xf1\x5e\x69\x2e\x6c\x4d\x84\x24\xf7\xea\x4f\x7f\x41\x9f\x57\x97\xe2\x52\x24\xf9\xd1\x14\x92\x66\xd9\x4f\x0e\xf1\x87\x31\x75\x1d\xf0\xea\xe3\x68\x72\x16\x3b\x4f\xa6\xb3\x33\x9e\x2e\x3d\xe0\x9f\x2e\xe1\xe3\xd4\x53\xa2\x36\x83\x5d\x7d\xe5\x8a\xa3\x63\xf5\x2c\xfd\x91\xce\xfc\xfb\xfe\xbb\x12\x3a\xb8\x7e\x3a\x83\x53\x58\xd2\xdf\x15\xfc\xec\xca\xeb\x89\xe7\xe1\xd9\x3d\x75\x24\x7b\x47\xa9\x05\xe6\x8e\xab\x67\xb0\xd8\x9b\xe1\x99\x1c\x39\x9c\x25\xc9\xdd\x15\xff\xa9\x1a\x3c\x14\x88\xce\xf4\x92\x84\xae\xe3\xdb\x97\x13\xbd\xe1\x22\x7e\x62\x74\xec\xa6\x70\xf8\xf4\xb0\xd8\x1b\xfa\x19\x74\xf6\x83\x89\x9c\x34\x0d\xcf\xa4\xe4\xf8\x21\x5c\xe0\x2e\x28\x49\x5d\x11\xee\xef\x67\x5f\x7e\x88\xfd\xc3\x94\x10\x47\x15\x52\x83\x74\x37\x55\xb8\x38\x74\x18\xf3\x39\x24\x89\x2f\x6e\x4c\xf0\x32\x4d\x5c\x1f\x38\x9b\xe8\x82\x1d\xb7\x1b\xdf\x50\xb8\x2d\xc9\x10\xc8\x63\xb2\x59\xd2\xc4\x6a\x1a\x6f\xdf\x45\x73\x5a\x59\x43\xb5\x67\x4f\xfd\x5c\x67\x28\x8b\xc2\x2b\x37\xe1\xa1\x15\x85\xc2\x8f\x93\x9a\xea\x53\x2d\xfd\x25\xd6\xdb\xd9\x83\x0d\xc6\x0d\x65\xca\xdb\x69\x2a\x0c\xd6\xcd\x41\x0d\xb4\xaa\x81\xd4\x0f\x52\x6e\x3b\xf5\xf7\x09\xed\x81\xef\x68\x1d\xf4\x4c\xf2\xf4\x72\xf5\x7f\x28\x88\x23\xea\x31\xc7\x9c\xd6\x43\xe4\x70\x9a\xbb\x1c\x35\x6a\xce\x42\x92\xfb\x92\x83\xa3\x52\x33\x71\x85\xd3\x96\x86\x38\x25\xb4\x39\x30\xa5\x50\x54\x29\x6b\x9a\x1c\x94\x67\x9f\x9a\xfa\x62\xd1\xf0\x12\xbd\xd8\x67\xff\x1c\xbe\xfa\x7b\xbe\x96\xb2\x19\x2b\x07\x6b\x9a\x25\x5f\x15\x8e\xbd\x33\x37\xfa\x1a\x46\xb7\x23\x27\xac\x69\x06\x1a\x52\x35\xb0\x9b\xc1\x05\x6b\x31\xcd\x02\xed\x93\x5a\xa2\x1c\xc4\xf1\x3d\xe7\x54\xc4\xfa\x02\x16\x47\x6a\xda\xb8\x79\xea\xd4\x41\xf1\x73\x81\x5a\xf8\x9e\x73\x12\x9f\x83\xa6\xd2\xe3\x39\xd7\x83\xd0\xb1\x59\xb4\xd9\x71\x03\xde\x55\x55\xea\x3a\x66\x98\x84\x5f\x0e\x66\xcb\x95\x67\xe8\x26\xe8\x7c\xf0\x7e\x51\x26\x91\x35\x74\x82\x0b\x6e\x39\x6b\xf8\x9f\x58\xf5\x31\x32\xb9\x61\x85\x6f\x8d\xbf\x7b\xb1\xde\x55\x15\x94\xac\x69\xb0\x02\x29\xc6\xd7\x81\x3e\xbc\xe8\x5e\x79\x43\x1c\x64\x1c\x19\xbb\x9d\xc4\x50\xb8\x31\x39\xfc\xe3\x6d\x16\x1f\xd6\xc3\x73\x02\xd5\x26\x25\xb7\x5e\xbd\xc9\xc1\xd8\xed\x72\xb6\xca\x3c\xc2\xdc\x0f\xc5\x2a\x7e\xe2\x01\x7c\xc9\x41\x3a\xc5\xaa\x68\x97\x8e\xbc\xd5\x4f\x24\xb9\xef\x50\xb0\xbc\x20\xc7\x64\x0d\x55\xa7\x1a\x5e\x52\x87\xea\x76\x05\x66\x46\x14\x6f\xca\xf1\x83\xfa\x84\xad\xbc\xc6\xfb\x67\x95\x3d\x2d\x6e\x2a\x6c\xd0\x22\x05\x42\xee\x95\x7f\x27\x1e\x5c\x2d\xfc\x5d\x3e\x5e\x18\x9f\x1c\x14\x7f\xa2\x96\x4f\x8a\x85\x21\x88\xdd\x0c\x55\x4c\xa7\x37\x9c\xf5\x81\x8b\xe1\x85\x6f\x3c\xfb\xc9\xe9\xf7\x09\xe4\x20\x73\x84\x48\x09\xb9\x23\x24\x8d\x80\x32\xe4\x0d\x3f\x76\x8b\x87\x93\x99\x70\x18\x3f\xdc\xdd\x3c\x37\xc7\xda\x9a\x24\x0f\x34\x79\xab\xbc\x8a\x23\x1d\xcd\x61\xa3\x78\xd4\xec\x60\xee\xa3\x6d\xd6\xa5\x78\x03\x3f\x27\x4f\x68\x9a\x86\xb4\x5a\x4e\x95\xf8\x38\x8e\xee\xa2\xc2\xf3\x67\xf4\x62\xa0\xca\xac\xdf\x7c\x4f\x6d\x12\xfa\x4c\xef\xe8\x36\xa3\xc8\x38\xf5\x68\x27\x27\xf0\xfb\x26\x84\x3d\x90\x5a\x97\x60\x76\xcc\x80\x95\x12\x1a\xa6\xdd\x97\x14\xff\x35\x40\xe3\x0b\x03\x42\x7a\x7f\xc1\x6a\x56\x62\x41\x18\xf4\x8a\xc3\x45\x87\xbd\x7e\xcd\x5a\x34\x47\x6e\xf9\x7b\x37\x91\xfa\x53\x1c\xfa\x63\xbf\x3e\x87\x56\x6a\x74\xac\xb9\x55\xc5\x05\x7e\x73\xf9\xf2\xbe\x3f\xcf\x2e\xed\x73\x43\xff\x67\xe1\x1c\xdd\x8e\xe2\x7d\x27\x4a\xff\x75\x27\x8c\x5d\xd5\xf2\xcf\x1f\xb8\x70\xbd\x36\x91\xf0\x83\x53\xe4\x34\x0f\x5d\xb5\xb3\xfb\x76\x9a\x9c\x05\x1f\x8b\xc9\xc2\x32\x6d\xcf\x3f\x7e\xee\xbb\xa2\xc9\x15\xbc\xff\x82\xe2\x3f\x67\x15\x17\xb8\x4b\x93\x52\x75\x21\xf4\xa8\xf0\x70\x22\xcf\x82\xe9\x94\x92\x3a\x7c\x7d\xe9\x3f\x63\xe5\xee\x52\x86\x8f\x4e\xa4\x44\xd3\x0d\xa5\xf1\xaf\xec\x9a\x2d\x4a\xcd\x95\x05\x14\x57\xf4\x5e\x9f\x4d\x0c\x93\x6a\x62\x17\x65\x9c\x7e\xea\x8f\xbe\x93\x7e\x92\xd1\x93\xbe\x3b\x87\x53\x52\xf0\xbf\x00\x00\x00\xff\xff\x6b\xaa\x7a\x1f\xbe\x13\x00\x00"),
 		},
 		"/src/runtime/runtime.go": &vfsgen۰CompressedFileInfo{
 			name:             "runtime.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 10606,
+			modTime:          time.Date(2026, 10, 15, 21, 4, 6, 119801772, time.UTC),
+			uncompressedSize: 16375,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x7a\xeb\x72\x1b\x37\x96\xf0\xef\xee\xa7\x38\xe9\xca\x97\x34\x2d\x9a\x94\x32\x89\xbf\x5a\x25\x9a\x2a\x87\x89\x15\x67\x6d\x4b\x65\x39\x3b\x53\xa5\x55\x79\x40\xf4\x69\x12\x16\x1a\xe8\x05\xd0\x94\x18\x8f\x1e\x60\x1f\x64\x5f\x6c\x9f\x64\xeb\xe0\xd2\xdd\xa4\xe8\x5c\xf6\xd7\xaa\x2a\x31\x09\x9c\x3b\xce\x15\xe0\x7c\x0e\x47\xcb\x4e\xc8\x0a\x3e\xd8\x3c\x6f\x19\xbf\x65\x2b\x04\xd3\x29\x27\x1a\xcc\x73\xd1\xb4\xda\x38\x28\xf3\xac\x88\x6b\x73\xa1\x1c\x1a\xc5\xe4\xdc\x6e\x6d\x91\xe7\x59\xb1\x12\x6e\xdd\x2d\x67\x5c\x37\xf3\x95\x6e\xd7\x68\x3e\xd8\xe1\xc3\x07\x5b\xe4\x93\x3c\xe7\x5a\x59\x07\xe7\x17\x17\x57\x70\x06\x76\x6b\x67\xf4\xb1\x5f\x7d\xfe\x76\xf1\x13\x9c\x41\x41\xc0\x61\x6d\xa1\x9b\x56\x48\x34\xb4\x9a\x68\x15\x79\x3e\x9f\xc3\xbb\x35\xc2\x8f\xc6\x68\x03\x5e\x90\x9a\x71\x04\x51\xa1\x72\xa2\x16\x68\x81\x91\xec\x40\x82\x02\x12\xd4\x2c\x77\xdb\xf6\x31\xc6\xc7\x3c\xf3\xdb\x79\x9e\xcd\xe7\xf0\x36\xa8\x16\x81\x88\x88\xd2\x4f\x75\x0b\x75\xa7\xb8\x13\x5a\xc1\xb2\x73\x1e\xd0\xa2\xd9\xa0\x05\xa7\xa1\x12\xd6\x09\xb5\xea\x84\x5d\x03\x71\xb0\xe0\xd6\xcc\x01\x33\xd8\x0b\xe0\x31\x3c\x17\x0b\xb5\xd1\x0d\x68\x53\x09\xc5\xcc\x36\x2e\x9e\x02\xf3\xa8\x9e\xa3\x07\xde\x15\x1d\x44\x0d\xc2\xc1\x9a\x91\x40\x3b\x22\x36\xe8\xd6\xba\x9a\xe5\xd9\x78\xb5\x9c\xe4\x0f\xc1\x42\x17\x3f\x5c\x94\x0a\x37\xb7\x5a\x39\x76\xeb\x70\x72\x0a\x2f\x15\xb8\x35\x42\xd7\x5a\x67\x90\x35\x53\x70\x6b\x61\xc1\x3a\xd3\x71\x47\xec\x1b\x64\xca\x91\x5a\x4b\x04\xae\x9b\x96\x39\xb1\x94\x48\xc4\xee\x84\x5b\x83\xc1\x5a\x22\x77\x33\x43\xe2\x4e\xc9\x1a\xb0\x46\x83\x70\x87\xd0\x59\x04\x06\x8d\x50\xa2\x61\x12\xac\xeb\x96\xc1\x10\x96\x39\x61\xfd\x89\x10\xe3\xe7\x97\x2f\xbd\x64\xdb\x16\x9f\x5b\x8b\x86\x8c\x1a\x54\xc1\xfb\x16\xb9\xb3\x53\xb8\x5b\x0b\xbe\x26\x8a\xd5\x56\xb1\x46\x70\x26\xe5\x16\x84\xb2\x8e\x29\x27\x98\x43\x10\x0a\x3e\x67\x1e\x99\xc8\x94\x93\x78\xb2\xef\xfd\xff\x83\x2a\x1f\xe9\x5f\xfa\x4f\xa8\x15\x3c\xe4\x39\x9d\x1f\x94\x0e\x9e\x78\xa0\x49\xdc\x29\xd3\x07\x80\x8f\x60\xd0\x75\x46\x81\x9b\x11\xe6\xc3\x23\x8c\xf6\x76\xd5\x32\xb7\x1e\x50\x7a\x8c\xa2\x80\x60\xee\xe7\x9f\x50\x4b\x32\xa1\xe8\xe4\x6a\x26\x24\x56\xe1\xa4\x59\x82\x8a\xc2\x1f\xc0\x8c\x87\xf2\x31\xcf\xde\x0f\xee\x0a\x10\x25\xca\x33\xae\x15\x37\xe8\xfc\xda\xb0\x1a\x08\x63\xb5\xbb\xda\x08\x6b\x85\x5a\xbd\xf6\xee\x92\x34\x98\xcf\x41\x2b\x8c\x3e\x04\x0a\xb1\xc2\x0a\x96\x5b\x78\x99\xb8\x4d\x21\xe2\x05\xaf\x5d\x44\x86\x79\x6f\xd0\x27\x8f\xc5\x9e\xc0\xae\x2b\xc2\xc7\x1e\x1a\xe1\x20\x7c\x02\x4c\x76\xcd\x33\xaf\x2e\x9c\x9e\x41\xd1\x2b\x5e\xe4\x99\xa8\x01\x67\x23\x53\x7c\x76\x06\x4a\x48\x82\x8f\x08\x67\x3b\xfb\xb3\x74\xc6\x79\xf6\x40\x66\x21\x7a\x38\x4b\xe6\x19\xed\x7a\xba\xbd\x31\xcf\x06\xaa\xe9\x7c\x07\x96\x5c\xab\x0d\x1a\x2b\xb4\x3a\x85\x02\x8e\x42\x1a\x81\x23\x28\x28\x74\x94\x90\x53\x50\xda\xf9\x1d\x66\x3d\x5b\x1e\xd9\x26\xf2\xfb\x6c\x77\xcf\xe5\xec\x8c\x9c\x89\x58\x37\x76\xb5\xab\xff\x6f\xb3\xa6\x05\x6e\xe9\xdb\xae\x04\xc4\x84\x5b\xa2\xcb\xac\xa7\x4b\xb9\xa5\x35\x7a\x23\x2a\x04\x2b\xc5\x6a\xed\xe4\x16\xb8\x44\x66\xd0\xc4\x5c\xd3\xa0\xb5\x6c\x85\x04\xbc\x63\x99\xd9\x10\x01\x9f\xed\x58\x72\x58\xf7\x1c\xbc\xec\x47\x67\x50\x40\x19\xd2\xa1\xf7\x9d\x4a\xd4\x35\x1a\x54\x0e\x62\x65\xb1\x93\x82\xa0\x1f\x00\xa5\xc5\x3f\x86\x69\xb9\x6e\x7b\xbc\x3c\xfc\x17\xcf\xa8\xb1\x2b\x6f\xef\xdf\x3f\xb2\x60\x26\x7f\x5e\xbd\xa1\xe0\x28\xcf\xb2\xe2\xb4\xf7\xf6\x18\x11\xb4\xb9\x77\x44\xbd\xeb\x0b\x25\x5c\xd0\xf8\x83\xbd\xbc\xf5\x87\xf5\xc1\xce\xce\xa5\x5e\x32\x39\x3b\x47\x57\x16\x9f\x27\x45\x8b\x49\x58\xf8\xbd\xea\x38\x21\x5a\x89\xc4\x95\x27\xf1\xc1\x5e\x2c\x3f\x20\x77\x97\xce\x14\x53\xf0\x9c\x02\xad\xb0\x9c\x28\xb7\xce\x14\x93\x83\xe8\x3e\xb6\x1e\x61\xfb\xd5\xdf\x43\x76\x6b\xa3\xef\xc6\xb1\xec\x69\xcc\x5e\xc6\xa2\x1f\x24\x28\x3d\x14\xa1\xcf\xe7\xc0\x36\x5a\x54\x50\x21\xab\x80\xeb\x0a\x01\xa5\x68\x84\x62\x14\xea\x79\xb6\x61\x06\x62\x39\xcb\x33\x84\x33\xf8\xe2\x71\x2e\xf8\xf8\x90\x67\xef\x29\x8c\x7b\x33\x9f\x5f\xbc\xbd\xb8\x78\xb7\x93\x1c\x5a\xa3\x39\x5a\x7b\xc0\xe2\x71\xa7\x08\xc1\x95\xe0\xce\x3c\xdc\x2f\xaa\xc2\x5a\x28\xac\x76\x22\x7b\x5e\x78\xaf\x11\x35\x6c\x88\x5e\x44\x09\xd4\x50\x6d\x92\x89\xce\x2f\x2e\x7f\xfa\xf1\xed\xcf\x57\xef\x83\x38\xc5\xe4\x5b\xd8\x50\x10\xec\xd0\xfd\xe2\x0b\xd8\xcc\xae\x52\x5d\xf9\xac\x0f\xe5\xf9\x1c\xce\xfd\x29\xff\x7c\xf5\xd4\xb6\xc8\x45\x2d\x92\x5e\xb0\x61\xb2\x43\x70\xec\x16\x2d\xb4\x06\x39\x56\xa8\x38\xce\x06\x09\x07\x8a\x79\x0a\x95\xdf\x17\xf6\xcf\xcb\x78\x88\x5b\x68\x73\xb6\x76\xf6\x03\xd6\xac\x93\xee\x5c\x1b\xad\x5d\x08\x9c\x3b\x58\x69\x85\x53\xe0\x4c\x7d\xe9\x7c\xe5\x17\x8e\xe2\xa8\x66\x52\x2e\x19\xbf\x05\xa6\xb6\x8d\x36\xa4\x49\x6c\x43\x4e\xe1\x0a\xbd\xec\x0c\x96\xe8\x28\x75\x59\x2d\x3b\xdf\x52\x11\x45\x5f\x7b\x66\x43\xfc\xce\x3b\x6b\xe6\x52\x73\x26\xe7\x2b\x5d\xf4\xee\xf0\xbd\x41\x76\xdb\x6a\xa1\x7c\xec\x91\x6e\x3f\xe0\xb2\x5b\xad\x90\xea\xc7\x43\x9e\x93\x93\x95\x9e\xe7\xcf\x6c\xc3\xae\xb8\x11\xad\x4b\x2d\x2c\x54\x1a\x2d\x89\x9b\xf2\x1f\xe3\xde\x3f\x9c\x06\xa9\xef\x9e\x4a\xdc\xa0\x04\xbc\x47\x1e\xa4\x6a\xb5\x15\xc1\x73\xe7\x73\xe0\xba\x23\xb7\xb7\x53\xb0\x9a\x3a\x13\x6c\x3a\x49\x9d\x88\x5b\x63\x43\x15\xd3\x20\xf7\x2d\xdd\xaa\x47\xb3\x70\x87\x5f\x6e\x10\x50\x45\x5c\xac\x40\x04\x62\x0b\x26\xa5\x17\x98\xa9\x2a\x7e\xb1\xe5\xa4\x6f\x31\xad\x5f\x67\xd6\x8a\x95\x22\x8a\x9e\x07\x33\x4b\xe1\x0c\x75\x8c\x94\xd9\x56\x68\x82\xeb\x58\x6f\x60\x4f\xf5\x6f\xa1\x03\xa3\x1e\xab\x61\xad\xa7\x41\x9f\xad\x14\x1c\x61\x89\x52\xdf\x91\xa6\x21\x1b\x3a\x60\x50\xd4\x42\xe2\xa9\x14\x0a\x8b\x5d\x5d\x85\x72\x1a\x98\xea\x19\xa5\xcd\x64\x84\x44\x5a\x11\x3d\x06\x2f\x42\x36\xa4\xee\xcc\x7b\xee\xad\xd2\x77\xea\xb2\xb7\x02\xc0\x19\xc9\x73\x1d\xe2\xf7\xa6\x13\xca\xb5\xce\x07\x7a\xa2\xbb\x88\xb6\x85\x33\xb8\xbe\x79\x42\xe4\x3e\x3e\xd0\xa0\xe0\x0f\xdc\xe0\x4a\x58\x87\x26\x11\x2c\x69\xf5\x0d\x6b\x30\x26\x84\x29\x90\x1a\xfd\x17\x52\x87\x04\x9f\x40\x64\x44\xde\x7d\x8b\x5b\x8a\x17\x0f\x78\x04\xc5\xa9\xaf\x9e\x4e\xb3\x92\xa0\x63\xae\xe0\x53\xa8\x75\xa7\x2a\x02\xdc\xd5\xe0\xfa\x16\xb7\x37\xdf\xc6\xdd\x51\xac\xb4\xdc\xc7\x48\x4d\x18\x5f\x78\xa9\xf3\x2c\x53\xac\xc1\x53\x48\x32\x4e\xf3\x2c\xf3\x56\xf6\xbc\xe9\x1b\x71\x3c\xf5\x52\x4e\x3d\x76\xcb\x09\x3d\xca\x5a\x4a\x54\xe5\xbe\x55\x28\xb5\x1e\xb0\x14\x6b\x5b\x54\xd5\x23\xe8\x29\xd4\x93\xfd\x23\xf0\x0a\xc0\x99\x17\x78\x90\x3d\x74\xac\x64\x86\xe4\x13\x76\x7c\xe8\xfe\x68\x83\x55\x67\xf9\x7c\x9e\x7b\xb7\x4d\xb1\x6e\x9d\x21\x9c\xd9\x4b\x32\xe2\x84\xda\x71\xf2\xb4\x7f\xc4\x38\xfb\x47\xaa\xf0\x50\x51\x6e\x23\x42\x7c\xcb\xa5\xe0\x50\x21\x09\x8d\x8a\x6f\x67\xb1\x88\x12\x01\x11\x0e\x6c\x48\xf0\x51\xc8\xbd\xe4\x1e\x32\x53\x31\x99\xbd\xc1\xbb\x52\x4c\x86\x4c\x15\x34\x59\x32\x2b\xf8\x0b\x43\x9e\xc1\x69\xda\xa1\x8e\xdb\x3a\x4a\x45\xce\xf8\xc1\x50\xd5\xda\x34\xbe\x16\x01\xde\xd3\x1a\xf5\xc8\xbe\xc1\xf8\xf9\x6a\x0c\x19\xfb\xf1\x11\xbd\xa1\x0f\x7f\xb1\xeb\x7c\x79\xf6\x82\x7c\x8a\xfe\xd2\xc2\x2b\x72\x40\xfa\x13\xca\xf5\x59\x8b\x26\x18\xcf\xa1\xb4\xb7\xa2\x25\x2f\x6d\x84\x0b\x5a\x5f\xdf\x8c\x18\x7d\xcc\x33\x02\xa0\xb9\x98\xfe\x39\x82\x13\x98\x3f\xf1\x1f\x77\x3a\xb3\x27\xf3\xf1\x56\x4f\xfc\x4b\x0b\xfa\x4e\x41\x4d\xa4\x9e\xcc\x73\xef\x6b\x87\xaa\x64\x2a\xfe\x64\xc7\x58\x32\x3c\x7e\x31\x99\x51\x32\x2a\x0b\xdb\x4a\xe1\x8a\x29\x14\xff\xae\x86\x35\x4a\x23\xc5\xd4\x0b\x36\xc9\x33\xcf\xc4\x13\x1f\x2b\x40\x51\x2d\x69\xd1\xb3\x0e\xa4\x25\xaa\x95\x5b\x17\x13\xea\x1b\xa8\xac\xd4\x34\xcd\x12\xcc\xf1\xb7\x20\xe0\x3b\x90\x54\x93\xfc\x07\x32\xca\xb7\x20\x8e\x8e\x62\x47\x5f\xeb\x81\xd4\x4b\x55\xe1\x7d\x29\x26\x79\x46\xc1\x40\xeb\xb4\x9f\x64\xeb\x96\xc1\xfc\xc5\x74\xbc\x2c\x08\xe7\xa2\x26\x45\xca\xc4\xff\xe8\xe4\x53\x20\x93\x04\xe2\x79\x30\x0a\x07\xaa\xb1\xda\xee\x1b\xe5\xb4\x98\xe4\x14\xd7\xc1\x02\x7d\x24\x86\xef\xd3\x91\xdf\xf8\x96\xf6\x85\x0f\x7f\xfa\xf3\x34\xa3\x22\xc7\x83\xfb\x52\x56\xf0\x5e\xf3\x18\xea\x24\x4a\xe4\x41\x92\xeb\x9d\xfe\x39\xcd\x99\x83\x5e\xf7\xbf\x7c\x0a\x08\x7a\xfb\xec\xca\xf5\x30\x19\xf7\xd4\x41\xc3\xde\xa9\x63\x15\xf3\x3e\xe8\x5d\xb9\x6c\x79\xca\x64\x9f\xc8\xca\x53\xd0\xb7\xb0\xd4\x5a\x4e\x7e\xc3\xd5\x03\xdd\x7d\x67\x1e\x1c\x6e\x3f\x98\x4e\x42\x06\xa7\xdc\x19\x80\x7c\x5f\x73\x32\x4e\xd5\xc7\x53\x28\x8a\x29\xfd\x53\x33\x69\x31\x65\xde\xb3\x03\xd5\xc5\x53\xb8\x3e\xbe\x99\x25\x7b\x4f\x61\xb4\x46\x59\x7c\xf4\xfd\x55\xa8\x1f\x7d\x52\xfd\x3d\xd8\x29\x38\xd3\xe1\x9e\x05\x6d\x6f\xc2\x29\xb4\x1c\xae\x53\x89\xa4\xbc\xea\x93\xce\xa7\x55\xf7\xf5\x82\x4f\x52\x54\x45\x76\x04\x69\x98\x5a\x61\xe4\xee\x2d\xd1\xf2\x6b\x71\xf3\x49\x8d\xf7\xb5\x1d\x4b\x9f\xb4\x1c\x1c\x61\x64\xea\x7d\x5d\xbc\xe3\xdb\x92\x87\x6f\x63\x65\x9e\xbc\xe8\x85\x31\x68\x3b\xe9\x48\xcc\xb0\x46\x69\x83\x14\x78\xef\x0d\xd0\x4b\x9f\x88\x90\xf8\x75\xa7\x3c\x7c\xa7\xf8\x0b\x6d\x2e\x17\xa4\xb6\x3f\x5f\xa2\x34\xdb\x8f\xc5\x9d\xe5\x29\x0c\xd1\x78\xb9\x08\x51\x06\x74\x58\x29\xaa\xc2\x52\xdd\xa9\x7e\xc5\xf9\x61\xb1\xee\xd4\x4c\xc5\x2a\x3e\x8a\x63\x5a\x4e\xe5\x7c\x14\xb8\xb4\x1c\xeb\x7a\x96\xfd\xa8\x9c\xd9\x9e\xa6\x65\xff\xed\x50\x44\x7d\x11\x04\x25\x23\xfa\x9a\x13\x4d\x34\xd4\x9b\xa8\x18\x5c\xdf\xf8\xad\x3c\xe3\x9d\xf1\x93\xf0\xb8\xba\x94\x5c\x24\xeb\x4e\xe0\x0d\xde\x53\x6b\x1c\xce\x27\x10\x9c\x02\x75\xe2\x43\xdc\x89\x1a\xb8\x98\x25\x4a\x7f\x3d\xf3\xe7\xc9\xc5\x2c\x45\xcf\x28\x70\x62\x56\x1f\xc7\x8d\xef\x77\x7a\xe8\xeb\x81\xd2\x4d\x9e\x0d\x5f\x8e\x8e\x86\xb4\x31\x1d\xb3\xfb\x6e\x8f\xdb\xae\xee\x23\xd5\x2f\x17\xf1\xa4\xa2\x07\x85\xe2\x1b\xee\xb4\xe8\x53\xde\x9f\xd4\x1f\x2c\xc6\xe1\x50\xc6\x14\xfb\x19\x73\x31\xbe\xa5\x3a\xd7\x78\x3f\x8c\xf6\xbb\x13\x3d\xef\x0c\x4d\x41\x9d\xa3\xae\x79\x12\xe6\x64\x82\x2e\x42\x64\xef\x0c\xd1\x21\xcb\x86\x29\xba\x98\x82\x12\x72\x32\x9a\x6a\x5f\x3f\xff\xfb\xe5\xdb\x8b\xc5\x55\xe9\x53\xa7\x8f\xf4\x74\x9d\x78\x02\x83\x28\x96\xaf\xb1\x0a\xb2\xf8\xc8\x68\xd8\x2d\x96\x7c\xcd\x54\xba\xe6\x7c\x38\xc4\xd3\xa2\x7b\x27\x1a\xd4\x9d\x3b\x38\xb2\x13\x6d\x3f\x3e\x71\xa9\x2d\x96\x7c\x02\x0f\x93\x29\x1c\x4f\xf2\xec\xbb\xa7\xbc\x97\xf1\x4d\xd7\x2c\x2e\x7f\x29\x3f\x29\xdc\x9b\xae\xe9\x6d\x51\xf6\xc9\xea\x70\xef\xf6\xb9\xd3\x8e\xc9\x1e\xdc\xf6\xed\x40\x3a\xfd\xd7\xd8\x5c\x39\xe6\xc6\xbe\x4f\x63\x33\x2a\x34\xfe\x2e\x99\x39\x61\x9d\xe0\x34\xee\x3c\x97\x52\xf3\xc1\x35\x9e\x7d\x0d\xd4\xfd\x6d\x1d\x5a\x60\xb4\xc5\xa8\xaf\xa3\x11\xc5\x3a\x21\x25\x35\xa7\x1d\xb9\xee\x3b\x92\x20\xe0\x7e\x1a\xad\xc4\x0d\x2a\x1a\x52\x6b\x83\x58\x4d\xf2\xec\x6a\x6b\x01\x0e\x33\xd3\x4b\x6a\x32\x53\x0f\x69\xb7\xd6\x61\x03\xa5\xed\x1a\xd0\x35\xfc\xfd\xfe\x9e\x50\xfd\xd8\x35\xc9\xb3\x57\x5a\xdf\x76\xad\xdd\x25\xa3\xba\x66\x89\x86\xa0\xfd\x40\x8b\x06\x64\x00\xcb\xb3\xd7\x5e\xa4\x4f\xc2\x37\x61\x3b\xcf\x5e\x18\x44\xbb\x2f\xde\x00\x47\x5a\xd8\xf0\xae\xf1\x9a\x09\x95\x14\xa5\x98\x59\x23\x6b\x77\xed\xfa\x13\xb2\xb6\xb7\xed\x9f\xb1\x2c\x21\xf6\x76\xfa\x23\x56\x0a\x28\x2f\xab\x18\xad\xfb\x28\x42\x81\xa0\x3d\xdb\x32\x65\x23\xac\xa2\xb1\xe3\x30\xac\xd2\xea\x69\x0f\x1f\xc0\xdf\xa2\x44\x66\xb1\x7a\x04\x6e\xd2\x86\xd3\x7e\x64\xb9\xb8\x0a\x08\x21\x30\xec\x98\xbe\xf7\xd8\x91\x2d\x07\x0b\xe8\x00\x1c\xec\xfa\xaa\xbf\x39\xa8\xc5\x3d\x56\x4f\xad\xf8\x35\x65\xb1\xce\x60\xc2\xf2\x97\xf9\x23\x5b\xcf\xe7\x59\x50\x49\xd8\x28\x59\x47\x52\x29\x7d\x17\x36\xc9\x9c\xfd\xd6\x21\x13\xce\xf2\xec\x8a\x1a\x81\x68\x98\x7d\x3d\x3d\xb5\xe5\x36\x8e\x35\xbd\x10\x11\x29\x1e\x56\x40\xca\xb3\xd7\x57\x2d\x53\x8f\x08\x35\x64\xce\x41\x13\x1b\xe1\xf6\x71\x17\x8c\xaf\x31\x20\x8f\x70\x39\xad\xee\x22\x7b\xc0\x80\x9d\x90\xbf\xef\xf8\xed\x4f\xcc\xae\x69\x75\x40\x6e\x8d\xae\x85\xa4\x51\x70\xd9\xf1\x5b\xf4\xaf\x5e\x6b\x70\x6c\x29\x31\xcf\xce\x17\x43\x44\x0e\x28\xe7\x0b\x68\xd0\xb1\x8a\x39\x96\x67\x17\x6e\x8d\x66\x47\x4c\xff\xce\x41\xab\x29\x4a\x87\x38\x88\xa7\x78\xce\xcc\x92\x06\x56\xae\xa5\x44\xfe\xe8\xb8\xa8\xa8\x9e\x2f\x1e\x27\x02\x85\xf7\x2e\xe1\x50\x50\xdd\x51\x58\xac\x7d\x13\x02\x77\x6b\x54\x30\xc4\xd4\x7f\xff\xe7\x7f\x85\x97\x36\xd6\xd0\xa8\x9e\x67\xaf\x98\x3d\x48\x13\x55\x15\x1e\xfe\x74\x0d\x92\xd9\x1d\xfa\xa5\x62\x4a\x5b\xe4\x5a\x55\x16\xac\x50\x1c\xe1\xe4\x5f\xfe\x3f\x25\xee\x4b\xd6\x59\xf4\x29\xee\x8d\x1d\x0c\xec\x57\xdf\x24\x7b\x5d\x7f\xf5\xcd\xb3\x9b\x81\x11\x17\x86\x77\x92\x19\x58\x76\x75\x1d\x7c\xdc\x20\xa7\x1a\x7d\xbe\x80\x96\x30\xa1\xea\x4c\xb0\x12\xb5\x10\xd6\xa5\x7d\xe6\xe0\xba\xa4\xf4\xbf\x38\xfa\xea\x9b\x6f\x26\xff\x8f\xe8\x46\x66\x3f\xaa\xea\x7f\xcb\x2c\x29\x6e\xf3\xcc\xd3\x86\xb1\x6d\xfe\xf2\x15\x9d\xfd\xe2\xf2\x97\x17\x34\xb8\x93\x2d\x6a\xa9\x59\x24\x5e\xa7\x35\x5d\xc3\xe2\xf2\x97\x60\xbe\x14\x02\xe7\x0b\xaa\xfc\xe4\x3d\x89\x24\x35\x42\x79\xe6\xef\x0d\x7b\x2e\x7e\xcd\xbb\xc2\x25\x9a\x10\xc4\xa3\x64\xb9\x17\xbb\xf0\xec\x84\xa2\xf3\x4d\xd7\x5c\x89\x5f\x71\x21\x99\xb5\x21\x15\x51\x4a\x59\xf8\xab\xef\x59\x9e\x7d\xbf\xa5\x5d\xb8\x7e\x76\x72\x33\x14\xb5\xcc\xaf\x8d\x94\xea\x53\x7d\x3a\xb3\x3e\xa7\xa7\x85\x87\xbe\x22\xbf\x45\x56\xa5\x42\x59\x36\xf0\x24\x7d\x9e\xc4\x72\x79\xe0\xb5\xf7\x1d\xb9\x5c\xff\x76\x2d\x2c\x60\x5d\x93\x33\x6d\x50\x6e\xa1\x53\xa2\x69\x25\x36\xa8\x52\x62\x6f\xd8\xd6\x53\x92\xc8\x7c\x8e\xb4\x42\xd2\x19\x75\x2a\xbc\xcd\x92\x45\x71\xcd\x36\x42\x1b\x3b\x83\x85\x56\x56\x54\x68\xa0\x65\x4a\x70\x0a\x58\xbc\x6f\xa5\xe0\xc2\xc9\xed\xac\x17\xfa\x0a\xdd\x0b\xa1\x98\x14\xbf\xa2\x29\xef\xa7\x50\x0f\x4f\xef\x1f\x1f\xfe\xaf\x4a\x1e\x3a\x52\x12\x7f\x38\x3a\x35\xbe\xf7\x19\x8d\xb7\xe1\xa2\xc5\xb7\x98\x79\xa6\x5b\xf6\x1f\x5d\xff\x06\xfd\x40\xde\xe9\x45\xd0\xfe\x45\xb6\x16\x28\xab\xf8\x93\x01\x3a\xf6\xbb\xd1\xe3\xd4\x30\x58\x97\xef\x43\x87\x3b\x81\x38\x38\x0c\x77\x99\xa9\x0b\x3b\x1e\x9e\xb4\xeb\x04\x4c\xdd\x2f\x35\xbc\xa3\x31\x9c\xe6\x80\xc3\xb7\xa3\x61\x0c\xa8\x0f\x3d\x76\xd2\xa0\xbc\x33\xf6\x87\x69\x07\x6a\x3f\xde\xe4\x0f\xfb\x7c\x69\x6c\xdc\x7d\xbc\x1d\x11\xfe\xe7\x3f\xa1\xf6\x43\xd4\xe8\x69\x33\x31\xfa\xae\x53\xfe\xa2\xf2\xaf\xc5\x2e\x3b\x02\xef\x8d\x31\x9e\xf8\x60\x34\x4c\xd2\x1e\xf1\x0a\x03\xa3\x50\x2e\x4c\x84\xa2\x06\x5a\x8a\x43\xcd\xa3\xbb\xd4\xf4\x1e\x73\xe5\x73\xe7\x1d\xfa\x1f\x69\xd4\xec\x76\x7c\x71\x3f\xba\xeb\xa7\x78\xd6\x4a\x6e\x61\xc3\xa4\xa8\xe0\x8e\x6d\xe9\xf0\x42\x3d\x06\xad\x30\x10\x13\x16\xa8\xc9\xef\x56\x6b\x60\xc3\xdd\xbe\x36\x07\xae\xf6\x67\xf0\xb2\xa6\x19\x57\x58\xd0\x9d\x0b\xad\xdf\xae\x88\x81\xe4\x52\x77\x94\xe2\x85\x83\xa6\xb3\x54\x01\x37\x08\x4b\x44\x35\xf4\x02\x42\x81\xd5\x54\x25\x7c\x5d\xbb\x63\xdb\xf4\xb3\x09\x61\x47\x4e\x3f\x0b\xe4\x5e\xd6\xc0\x82\xaf\xfb\xb7\x0f\xff\xd6\xa4\x97\x12\x1b\xe6\x04\x9f\x92\x1d\x38\x53\xc9\xb7\x98\x3f\x38\x6f\xe1\xfe\xa7\x18\x42\xca\x3c\x3e\x1d\xa3\xf5\xf3\xa7\xb3\x28\x6b\xf0\xbf\x47\x59\x51\x97\x2e\x38\x14\xf1\x3c\x8b\x41\x5d\x7f\x95\xa6\x04\x2f\x8b\xf4\x02\x76\x0a\x2d\x3f\xeb\x2f\xe0\x45\xcb\x27\xe9\x35\x36\x1a\x24\xcc\xfe\xba\x0e\xb7\xf0\x8f\x4f\xa5\xd8\x99\xa0\xf7\xcd\x77\x2d\x5a\x7e\x93\xc7\x87\xa0\xd7\xd8\x5c\xfa\x66\x02\xdf\x86\x5f\x8d\x38\x38\x83\x6f\x4e\xbe\x82\x27\x70\x72\xfc\xd5\xd7\x43\x82\xfa\x5e\x6a\x7e\x3b\x02\x2d\x4d\x84\x27\x87\x19\x25\xb2\xd7\x9d\xc3\xfb\x08\x97\x0a\xd1\x08\x36\x8e\x40\xfd\x83\xd7\x4b\xb5\x41\xeb\xc4\x2a\x3c\x14\x09\xeb\x4f\x5f\xb8\x2f\x2d\x89\x6d\xc5\x52\xfa\xdb\xf1\x3e\x93\x4d\x29\x1b\x84\xbc\x54\x69\xf2\x48\xab\xa7\xe1\x7c\xef\x84\x45\x30\xd8\xe8\x4d\x20\x04\x5c\x37\x84\x31\xbc\x97\x1d\x0f\x62\xfa\xfb\xa1\x65\x57\xc3\xf5\x0d\x35\x83\x53\x2a\x64\x71\xf8\x8f\x02\xfe\xb9\x4b\x61\x1f\x54\xbf\xf9\x8a\xba\x93\x2e\xb8\x6e\xb7\xc4\x7e\x0a\x76\xe7\x92\xb2\x18\x16\x46\x37\x8f\xfe\x86\x39\xde\xcc\x0e\x77\x8f\xc3\xa0\xfc\x4a\xf3\xdb\x8b\xab\x77\x6b\x83\xac\x1a\x0f\xe9\xbf\x28\xf9\x89\x9d\x7f\x0b\xe9\xb4\x3c\xf0\xa0\x60\xb7\x76\xf6\x6e\x8d\x11\x62\x6c\x31\xe3\xde\x19\xc6\x29\x8d\x85\x8b\xf6\x3e\xd1\x52\x28\x3c\x24\x30\xdd\x26\xa8\xf8\xf7\xf1\x61\x28\xcc\x69\x2b\x58\xdd\x3f\x49\xfc\xcd\xe7\x16\x04\x06\x7c\xa5\x01\xd5\x46\x18\xad\xe8\xdc\xfc\x43\x1c\x73\x7c\x1d\x7f\xfe\x35\x83\x77\x6b\x34\x58\x6b\x43\xb1\xe8\xa3\x7d\xec\x18\xb1\x71\x54\x15\x30\x79\xc7\xb6\xb6\xaf\x02\xc3\xa0\xbe\xd2\xde\xb4\xfe\x88\x9f\x7d\x3d\xd2\x79\x70\x8c\x7f\x45\x6c\x9f\x4b\xb1\xc1\x72\xb7\x00\xc7\x9f\x2e\xa9\x20\x4b\x38\x02\x30\x18\x23\x3d\xfe\x8c\x6e\xf4\x53\xb4\x0a\x2d\x37\x62\x19\xba\x2b\x46\x6d\xe8\xaa\x2f\x31\xf1\xed\x64\x4c\x29\x16\xc9\xfe\x17\x40\xa3\xbd\xdf\xfc\xa5\xd0\x0e\xdc\xe3\x5f\x08\xa5\x22\xb2\x23\x5b\xf8\x81\x47\xfc\x85\x0d\x0e\x5e\xe4\xef\x60\x4a\x1b\x77\x7c\x15\x08\x69\x69\xc4\xa4\xb4\x23\xb7\xa3\x3e\x9b\xc8\x1e\x30\xe8\x5e\xdc\xfc\xc0\x1c\xf6\x61\x13\xdc\x7b\x15\x6e\x5f\x82\x63\x3f\xfb\xba\x9c\xc0\x93\x40\xa5\x3c\x39\x3e\x3e\x7e\x7f\x7c\x7c\x4c\x8c\xfe\x27\x00\x00\xff\xff\x57\xdf\x5d\x0f\x6e\x29\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x7b\xff\x72\x1c\x37\x8e\xf0\xdf\xd3\x4f\x81\x74\xf9\x4b\xa6\xad\xf1\x8c\x9d\xdd\xe4\xab\x93\xa3\xad\x72\x94\x58\x71\xce\xb6\x54\x56\x7c\xbb\x57\x8a\x2b\xcb\xe9\x46\xcf\x50\xea\x26\xfb\x48\xf6\x48\x13\x45\x0f\x70\x0f\x72\x2f\x76\x4f\x72\x05\x90\xec\x1f\xa3\x91\xed\x6c\xaa\x76\xad\x21\x01\x10\x00\x01\x10\x24\xd0\x8b\x05\x1c\x2c\x5b\x59\x15\x70\x69\x93\xa4\x11\xf9\x95\x58\x21\x98\x56\x39\x59\x63\x92\xc8\xba\xd1\xc6\xc1\x34\x99\xa4\x61\x6c\x21\x95\x43\xa3\x44\xb5\xb0\x5b\x9b\x26\xc9\x24\x5d\x49\xb7\x6e\x97\xf3\x5c\xd7\x8b\x95\x6e\xd6\x68\x2e\x6d\xff\xc7\xa5\x4d\x93\x2c\x49\x72\xad\xac\x83\x93\xd3\xd3\x73\x38\x02\xbb\xb5\x73\xfa\xb3\x1b\x7d\xf1\xee\xf8\x27\x38\x82\x94\x80\xfd\xd8\xb1\xae\x1b\x59\xa1\xa1\xd1\x48\x2b\x4d\x92\xc5\x02\x7e\x59\x23\xfc\x68\x8c\x36\xc0\x8c\x94\x22\x47\x90\x05\x2a\x27\x4b\x89\x16\x04\xf1\x0e\xc4\x28\x20\x41\xcd\x13\xb7\x6d\xee\x63\xdc\x26\x13\x9e\x4e\x92\xc9\x62\x01\xef\xbc\x68\x01\x88\x88\x28\xfd\x44\x37\x50\xb6\x2a\x77\x52\x2b\x58\xb6\x8e\x01\x2d\x9a\x0d\x5a\x70\x1a\x0a\x69\x9d\x54\xab\x56\xda\x35\xd0\x0a\x16\xdc\x5a\x38\x10\x06\x3b\x06\x18\x83\x57\xb1\x50\x1a\x5d\x83\x36\x85\x54\xc2\x6c\xc3\xe0\x21\x08\x46\xe5\x15\x19\x78\xcc\x3a\xc8\x12\xa4\x83\xb5\x20\x86\x46\x2c\xd6\xe8\xd6\xba\x98\x27\x93\xe1\xe8\x34\x4b\xee\xbc\x86\x4e\x7f\x38\x9d\x2a\xdc\x5c\x69\xe5\xc4\x95\xc3\xec\x10\x5e\x29\x70\x6b\x84\xb6\xb1\xce\xa0\xa8\x67\xe0\xd6\xd2\x82\x75\xa6\xcd\x1d\x2d\x5f\xa3\x50\x8e\xc4\x5a\x22\xe4\xba\x6e\x84\x93\xcb\x0a\x89\xd8\xb5\x74\x6b\x30\x58\x56\x98\xbb\xb9\x21\x76\x67\xa4\x0d\x58\xa3\x41\xb8\x46\x68\x2d\x82\x80\x5a\x2a\x59\x8b\x0a\xac\x6b\x97\x5e\x11\x56\x38\x69\x79\x47\x68\xe1\x17\x67\xaf\x98\xb3\x6d\x83\x2f\xac\x45\x43\x4a\xf5\xa2\xe0\x4d\x83\xb9\xb3\x33\xb8\x5e\xcb\x7c\x4d\x14\x8b\xad\x12\xb5\xcc\x45\x55\x6d\x41\x2a\xeb\x84\x72\x52\x38\x04\xa9\xe0\x91\x60\x64\x22\x33\xcd\xe6\x91\xa2\x65\xad\x2b\x51\x63\xe1\xd9\xa5\x15\xcb\xb6\xaa\xa0\x11\x6e\x6d\x41\x97\x34\x22\x0d\x04\xeb\xb6\x33\xb0\x88\xf0\x88\x84\x79\xd9\x56\xd5\x5b\x51\x63\x30\x93\xdf\xf8\xff\xbd\x5e\x6e\xe9\xdf\x19\x34\x57\xab\x33\xe1\xd6\xf4\x43\xaa\x15\xdc\x25\x09\x59\x05\x4c\x1d\x3c\x66\xe8\x2c\xcc\x4c\xe3\x1f\x00\xb7\x60\xd0\xb5\x46\x81\x9b\x5b\x67\xe0\xee\x1e\x46\x73\xb5\x22\xd6\x7a\x94\x01\x46\x5c\xcf\xef\xe5\x8b\x07\x74\x56\x09\xa9\xc8\x2c\x4a\x21\x2b\x2c\xbc\x19\x89\x08\x15\x84\xd9\x83\x19\x76\xfc\x36\x99\xfc\xd6\xfb\x02\x40\x60\x2c\x99\xe4\x5a\xe5\x06\x1d\x8f\xf5\xa3\x9e\x30\x16\xe3\xd1\x5a\x5a\x2b\xd5\xea\x0d\xdb\x62\x14\x64\xb1\x00\xad\x30\x18\x28\x28\xc4\x02\x0b\x58\x6e\xe1\x55\x5c\x6d\x06\x01\xcf\xbb\xc4\x71\x58\x30\x99\x34\xda\x4a\xf6\x35\xfe\xaf\x27\x77\xa2\xc1\xea\xd6\xe4\x08\x1d\x84\xdf\xd0\x5e\xde\x19\x79\xca\x95\xd2\xd7\x2a\xe9\xb6\xe7\xf1\x7d\xe9\x33\x18\xbb\x0b\xdc\x76\xd0\x08\x7b\xe1\x23\x60\xdc\xa5\x64\x22\x4b\xc0\x79\xc7\xc8\x17\x47\x90\xa6\x34\x3c\x09\xdb\x87\xf3\x1a\xad\x15\x2b\x9c\x66\x70\x00\x29\x08\x07\x29\x1c\x0c\x50\x92\xc9\x5d\xb2\x07\x38\xf9\x14\x27\x3d\xd9\x01\x2f\xa4\x53\x38\x3c\x82\xb4\xdb\xcb\x34\x70\x38\xd8\xdd\x2f\x8e\x40\xc9\x8a\x99\xf4\x08\x47\xa3\xf9\x79\xb4\x5e\xe6\x4c\x58\xa2\x87\xf3\xb8\xe3\x83\x59\xa6\xdb\xd9\xc7\x51\x4f\x35\x48\xd3\x33\x01\xb9\x56\x1b\x34\x56\x6a\x75\xc8\xe2\xfb\x75\x49\x1f\xd2\x12\xda\x0c\x94\xf6\x8a\x11\x96\x97\xcd\xc3\xb2\x91\xfc\xee\xb2\x63\x53\x3b\xea\xb4\x5e\xdb\xd5\x58\xfe\x8f\x2f\x4d\x03\xb9\xa5\x5f\x63\x0e\x68\x91\xdc\x12\x5d\x61\x99\x2e\xc5\xe2\xc6\xe8\x8d\x2c\x10\x6c\x25\x57\x6b\x57\x6d\x21\xaf\x50\x18\x34\x21\x36\x87\x0d\x21\xe0\x91\x66\xe6\xbd\x6f\x7f\x31\xd2\x64\x3f\xce\x2b\x30\xef\x07\x47\x90\xc2\xd4\x1f\x1f\xec\x0e\x85\x2c\x4b\x34\xa8\x5c\x17\xab\xb2\x94\xa0\xef\x00\x2b\x8b\x9f\x87\x69\x73\xdd\x74\x78\x89\xff\x5f\xd8\xa3\xda\xae\x86\x06\xf8\x11\xbd\xe5\x36\x2a\xad\x57\x14\x1c\x24\x93\x49\x7a\xd8\x39\x70\x70\x72\x6f\xe0\xa3\x2d\xea\xcc\x59\x2a\xe9\xbc\xc4\x97\xf6\xec\x8a\x37\xeb\xd2\xce\x4f\x2a\xbd\x14\xd5\xfc\x04\xdd\x34\x7d\x14\x05\x4d\x33\x3f\xf0\xa9\x6c\x22\x4b\x26\x3d\x89\x73\x26\x71\x69\x4f\x97\x97\x98\xbb\x33\x67\xd2\x19\xf0\x4a\x9e\x96\x1f\x8e\x94\x1b\x67\xd2\x6c\x2f\x3a\x3b\xd9\x3d\x6c\x1e\xfd\x14\xb2\x5b\x1b\x7d\x3d\x8c\x2b\x4c\x63\xfe\x2a\x24\x49\x9e\x83\x29\x43\x11\x3a\x1f\x68\x55\x75\x6c\x84\x5d\xbf\x43\xca\xad\x90\x0e\x6e\x32\x38\xb1\xd1\xb2\x80\x02\x45\x01\xb9\x2e\x10\xb0\x92\xb5\x54\xc2\x47\x8d\x8d\x30\x10\xd2\x82\x64\x82\x70\x04\x5f\xde\x8f\x12\xb7\x77\xc9\xe4\x37\x72\xef\x4e\xfd\x27\xa7\xef\x4e\x4f\x7f\x19\x05\x8d\xc6\xe8\x1c\xad\xdd\xb3\x13\x61\x26\xf5\x4e\x17\xe1\x8e\x18\xee\xbd\x2a\xb0\x94\x0a\x8b\x91\xc7\x2f\x52\xb6\x26\x59\xc2\x86\xe8\x05\x14\x4f\x0d\xd5\x26\xaa\xee\xe4\xf4\xec\xa7\x1f\xdf\xfd\x7c\xfe\x9b\x67\x27\xcd\x9e\xc3\x06\xbe\xd8\xa1\xfb\xe5\x97\xb0\x99\x9f\xc7\x93\xb4\x0f\xac\x7c\x02\xd0\xee\xff\x7c\xfe\xc4\x36\x98\xcb\x52\x46\xb9\x60\x23\xaa\x16\xc1\x89\x2b\xb4\xd0\x18\xcc\xb1\x40\x95\xe3\xbc\xe7\xb0\xa7\x98\x44\x17\xfa\x34\xb3\x7f\x9e\xc7\x7d\xab\xf9\x74\x71\x6b\xe7\x3f\x60\x29\xda\xca\x9d\x68\xa3\xb5\xf3\x0e\x75\x0d\x2b\xad\x70\x06\xb9\x50\x5f\x39\xce\xa0\xa4\x23\xff\x2a\x45\x55\x2d\x45\x7e\x05\x42\x6d\x6b\x6d\x48\x92\x90\xce\x1d\xc2\x39\x32\xef\x02\x96\xe8\x1c\x1a\xb0\xba\x6a\xf9\x0c\x92\x36\x1c\xb3\xf3\xde\xaf\x17\xad\x35\x8b\x4a\xe7\xa2\x5a\xac\x74\xda\x99\xc3\xf7\x06\xc5\x55\xa3\xa5\x62\x9f\x24\xd9\x7e\xc0\x65\xbb\x5a\x91\x09\x52\xbe\x41\x46\x36\xe5\x35\x7f\x16\x1b\x71\x9e\x1b\xd9\xb8\x78\x15\x80\x42\xa3\x25\x76\x63\x5c\x14\x39\xdb\x87\xd3\x50\xe9\xeb\x27\x15\x6e\xb0\x02\xbc\xc1\xdc\x73\xd5\x9f\x77\x8b\x05\xe4\xba\x25\x77\xa0\xbc\x4b\x53\x86\x87\x75\x5b\x09\x87\x74\x88\xd7\x94\x1c\x18\xcc\x39\x35\x5e\x75\x68\x16\xae\xf1\xab\x0d\x02\xaa\x80\x8b\x05\x48\x4f\xec\x58\x54\x15\x33\x2c\x54\x11\x7e\xd8\x69\xd6\xa5\xea\x96\xc7\x85\xb5\x72\xa5\x88\x22\xaf\x21\xcc\x52\x3a\x43\x99\xb7\x54\x0e\x57\x68\xbc\xe9\x58\x56\x30\xfd\x0f\xfe\xee\x33\x59\x4a\x2b\x6a\xd1\x30\x0d\xfa\xdb\x56\x32\x47\x58\x62\xa5\xaf\x49\x52\x1f\x25\x1d\x08\x48\x4b\x59\xe1\x61\x25\x15\xa6\x63\x59\xa5\x72\x1a\x84\xea\x16\x8a\x93\x51\x09\x91\xb4\x22\x7a\x02\x5e\xfa\x28\x49\x59\x2e\x5b\x2e\x67\x31\x67\x9d\x16\x00\x8e\x88\x9f\x0b\xef\xbf\x1f\x5a\xa9\x5c\xe3\xd8\xd1\x23\xdd\xe3\xa0\x5b\x38\x82\x8b\x0f\x8f\x89\xdc\xed\x1d\x5d\xb8\x78\xc3\x0d\xae\xa4\x75\x68\x22\xc1\x29\x8d\x52\xba\x1b\x02\xc2\x0c\x48\x8c\xee\x07\x89\x43\x8c\x67\x10\x16\x22\xeb\xbe\xc2\x2d\xf9\x0b\x03\x1e\x40\x7a\xc8\xa7\xaa\xd3\x62\x4a\xd0\x21\x56\xe4\x33\x28\x75\xab\x0a\x02\x1c\x4b\x70\x71\x85\xdb\x0f\xcf\xc3\xec\xc0\x57\x9a\x9c\x7d\xa4\x24\x8c\x2f\x99\xeb\x64\x32\xa1\x14\xfe\x10\x22\x8f\xb3\x64\x32\x61\x2d\xf3\xda\xf4\x8b\x56\x3c\x64\x2e\x67\x8c\xdd\xe4\x84\x1e\x78\x9d\x56\xa8\xa6\xbb\x5a\xa1\x90\xbb\x47\x53\xa2\x69\x50\x15\xf7\xa0\x67\x50\x66\xc9\x64\x8f\x00\x70\xc4\x0c\xf7\xbc\xfb\xe4\x9c\xd4\x10\x6d\xc2\x0e\x37\x9d\xb7\xd6\x6b\x95\x6e\x29\x09\x9b\x6d\xf4\x75\xeb\x0c\xe1\xcc\x5f\x91\x12\x33\x90\xfe\x6a\xf6\xcf\xe0\x67\xff\x8c\x27\x3f\x14\x2d\x7a\x42\xf9\x36\xaf\x64\x0e\x05\x12\xd3\xa8\xf2\xed\x3c\x1c\xae\x44\x40\xfa\x0d\xeb\x03\x7c\x60\x72\x27\xb8\xfb\xc8\x94\x66\xf3\xb7\x78\x3d\x95\x59\x1f\xa9\xbc\x24\x4b\x61\x65\xfe\xd2\x90\x65\xe4\x74\x6b\x94\xca\x82\x75\x14\x8a\x9c\xe1\x0b\xb6\x2a\xb5\xa9\xf9\x2c\x02\xbc\xa1\x31\x87\x85\x4f\x3c\x7e\x3e\x1f\x42\x86\xab\xc7\x80\x5e\x7f\xe5\x78\x39\x36\xbe\x64\xf2\x92\x6c\xaa\xcf\xf6\x93\xc9\x6b\xa9\xfc\x80\x54\xae\x8b\x5a\x74\x13\xe4\x15\xa6\xf6\x4a\x36\x64\xa5\xb5\x74\x5e\xea\x8b\x0f\x83\x85\x6e\x93\x09\x01\xc0\x11\xf0\x3f\x07\xf0\x0c\x16\x8f\xf9\xcf\x51\xc6\xf6\x78\x31\x9c\xea\x88\x7f\x65\x41\x5f\x2b\x28\x89\xd4\xe3\x45\xc2\xb6\xb6\xef\x94\x8c\x49\x01\xe9\x31\x1c\x19\x8c\x9f\x66\x73\x0a\x46\xd3\xd4\x36\x95\x74\xe9\x0c\xd2\x5f\x55\x3f\x46\x61\x24\x9d\x31\x63\x59\x32\xe1\x45\x98\xf8\x50\x00\xf2\xea\x8a\x06\x79\x69\x4f\xba\x42\xb5\x72\xeb\x34\xa3\x7c\x82\x8e\x95\x52\x1b\x90\x04\xf3\xf4\x39\x48\xf8\x0e\x2a\x3a\x93\xf8\x0f\x52\xca\x73\x90\x07\x07\x21\xd3\x2f\x75\x4f\xea\x95\x2a\xf0\x66\x2a\xb3\x64\x42\xce\x40\xe3\x34\x1f\x79\x6b\x97\x5e\xfd\xe9\x6c\x38\x2c\x09\xe7\xb4\x24\x41\xa6\x71\xfd\x83\x67\x0f\x81\x64\x11\x84\xd7\x10\xe4\x0e\x74\xc6\x6a\xbb\xab\x94\xc3\x34\x4b\xc8\xaf\xbd\x06\x3a\x4f\xf4\xbf\x67\x03\xbb\xe1\x54\xf7\x25\xbb\x3f\xfd\xc7\x34\x83\x20\x4f\x7b\xf3\xa5\xa8\xc0\x56\x73\x1f\xea\x59\xe0\x88\x41\xa2\xe9\x1d\xfe\x39\xc9\xe9\xfa\x16\x65\xff\xcb\x43\x40\xd0\xe9\x67\xcc\xd7\x5d\x36\xcc\xb5\xbd\x84\x9d\x51\x87\x53\x8c\x6d\x90\x4d\x79\xda\xe4\x31\x92\x3d\x10\x95\x67\xa0\xaf\x60\xa9\x75\x95\x7d\xc4\xd4\x3d\xdd\x5d\x63\xee\x0d\x6e\xd7\x99\x9e\xf9\x08\x4e\xb1\xd3\x03\x71\x5e\xf3\x6c\x18\xaa\x9f\xce\x20\x4d\x67\xf4\x4f\x29\x2a\x8b\x31\xf2\x1e\xed\x39\x5d\x98\xc2\xc5\xd3\x0f\xf3\xa8\xef\x19\x0c\xc6\x64\x35\xfa\xfd\xda\x9f\x1f\x5d\x50\xfd\x14\xec\x0c\x9c\x69\x71\x47\x83\xb6\x53\xe1\x0c\x9a\x1c\x2e\xe2\x11\x49\x71\x95\x83\xce\xc3\xa2\xf3\x79\x91\x67\xd1\xab\xc2\x72\x04\x69\x84\x5a\x61\x58\x9d\x35\xd1\xe4\x17\xf2\xc3\x83\x12\xef\x4a\x3b\xe4\x3e\x4a\xd9\x1b\xc2\x40\xd5\xbb\xb2\xb0\xe1\xdb\x69\xee\x7f\x0d\x85\x79\xfc\xb2\x63\xc6\xa0\x6d\x2b\x47\x6c\xfa\xb1\xdb\x3b\x2f\xc0\x6f\xac\x80\x8e\xfb\x48\x84\xd8\x2f\x5b\xc5\xf0\xad\xca\x5f\x6a\x73\x76\x4c\x62\x27\x93\x40\x69\xbe\xeb\x8b\xa3\xe1\x19\xf4\xde\x78\x76\xec\xbd\x0c\x68\xb3\xa2\x57\xf9\xa1\xb2\x55\xdd\x88\xe3\x4b\x64\xd9\xaa\xb9\x0a\xa7\xf8\xc0\x8f\x69\x38\x1e\xe7\x03\xc7\xa5\xe1\x70\xae\x4f\x26\x3f\x2a\x67\xb6\x87\x71\x98\x7f\xed\xf3\xa8\x2f\x3d\xa3\xa4\x44\x3e\x73\x82\x8a\xfa\xf3\x26\x08\x06\x17\x1f\x78\x2a\x99\xe4\xad\xe1\x1b\xf2\xf0\x74\x99\xe6\x32\x6a\x37\x83\xb7\x78\x43\xa9\xb1\xdf\x1f\x4f\x70\x06\x94\x89\xf7\x7e\x27\x4b\xc8\xe5\x3c\x52\xfa\xdb\x11\xef\x67\x2e\xe7\xd1\x7b\x06\x8e\x13\xa2\xfa\xd0\x6f\x38\xdf\xe9\xa0\x2f\x7a\x4a\x1f\x92\x49\xff\xe3\xe0\xa0\x0f\x1b\xb3\xe1\x72\xdf\xed\xac\x36\x96\x7d\x20\xfa\xd9\x71\xd8\xa9\x60\x41\xfe\xf0\xf5\xcf\x77\xf4\x57\xd2\xed\xd4\x67\x1e\xc6\x7e\x53\x86\x14\x7d\xe2\x70\x72\x0c\xa6\xe5\x97\xc8\x95\x30\x4b\x4a\x5b\x72\x5d\x55\xe8\x49\x4b\xff\x4e\x37\xb8\x4c\xa0\x5a\x11\x55\xbc\x69\xb4\x45\x0b\xd2\xd9\x88\x97\x2c\x16\x11\x55\x1b\x0a\x7a\x57\x08\x6f\x75\x81\xf3\x4b\x4b\x2b\xf8\x87\xdd\x27\x4f\x3c\xe6\x93\x55\x3e\xe3\x44\x9a\x2e\x25\xa0\xb4\x5b\x4b\xb5\x02\xed\xd6\x68\xae\xa5\xc5\x90\x1f\x9d\x1c\x4f\xe3\x96\xad\xf2\x3d\x47\xf9\x2a\xa7\x6b\xde\x2a\xbf\x77\xcf\xa3\x3d\x5c\xe5\xf3\x57\x6a\xa3\xaf\xd0\xdf\xe6\xba\x1b\xb5\xc6\x9b\xfe\x49\x63\xfc\x92\x91\xb7\x86\x6e\x79\xad\xa3\x5b\x41\xe6\xdf\x07\x08\x3a\xf5\x91\x6b\xf4\x78\xe0\x4f\x11\xff\x7a\x40\xaf\x51\xb2\xca\x06\xb7\xf6\x37\x2f\xfe\x71\xf6\xee\xf4\xf8\x7c\xca\x47\x03\x47\xb2\xf8\x40\xfc\x0c\x7a\x56\x6c\xbe\xc6\xc2\xf3\xb2\x58\xc0\x7f\x4a\xac\x0a\xbe\x90\x91\xd2\xd7\xda\xf2\x9d\xd2\xa2\xe3\x2b\x56\x28\xde\x5c\xda\xf0\x17\xb1\xc7\x18\x6f\x48\xc9\xc9\x84\x15\x54\x8b\x2b\x9c\xe6\x6b\xa1\xe2\x13\xf8\xdd\x3e\xa6\xb7\x84\xb6\xf7\x99\x83\xf8\x22\x7e\x20\xaf\xb4\xc5\x69\x9e\xc1\x1d\xc5\xd7\xef\x9e\xe4\x9d\x70\x6f\xdb\xfa\xf8\xec\xfd\xf4\x41\xa9\xde\xb6\x75\xa7\xc4\x69\x17\xc5\xf7\x27\xb5\x8f\x9c\x76\xa2\xea\xc0\x6d\x97\x27\x45\xb7\x78\x83\xf5\xb9\x13\x6e\x18\x14\xc8\x66\x51\xa1\xe1\x62\x85\x70\xd2\x3a\x99\xd3\x3d\xf0\x45\x55\xe9\xbc\xf7\x99\x6f\xff\x0a\x94\x16\x6f\x1d\x5a\x10\x34\x25\x1c\x16\x6c\x72\xd6\xc9\xaa\x02\xa9\xa0\x25\x9f\xfe\x85\x38\xf0\xb8\x0f\xa3\x4d\x71\x83\xec\x0d\xa5\x41\x2c\xb2\x64\x72\xbe\xb5\x00\xfb\x17\xd3\x4b\x27\xa4\x8a\xc9\xb5\xdd\x5a\x87\x35\x4c\x6d\x5b\x83\x2e\xe1\x1f\x37\x37\x84\xca\xf7\xd1\x2c\x99\xbc\xd6\xfa\xaa\x6d\xec\x98\x8c\x6a\xeb\x25\x1a\x82\xe6\x9b\x3e\x1a\xa8\x3c\x58\x32\x79\xc3\x2c\x3d\x08\x5f\xfb\xe9\x64\xf2\xd2\x20\x5a\x80\x87\xe0\x48\x0a\xeb\x0b\x67\x6f\x84\x54\x51\x50\xf2\xf8\x35\x8a\x66\xac\xd7\x9f\x50\x34\x9d\x6e\xff\x8c\x66\x09\xb1\xd3\xd3\xe7\x68\xc9\xa3\xbc\x2a\x2a\xdc\x8b\x22\x15\x48\x9a\xb3\x8d\x50\x36\xc0\xaa\xd6\xe2\x03\xb0\x4a\xab\x27\x1d\xbc\x07\x7f\x87\x15\x0a\x8b\xc5\x3d\x70\x13\x27\x82\xef\x9d\x9e\x7b\x04\xef\x15\x76\x48\x9f\x2d\x76\xa0\xcb\x5e\x03\xda\x03\x7b\xbd\xbe\xee\x9e\x54\x4a\x79\x83\xc5\x13\x2b\x7f\x8f\xe1\xbd\x35\x18\xb1\xb4\x19\xeb\x7a\xb1\x98\x78\x91\xa4\x0d\x9c\xb5\xc4\x95\xd2\xd7\x7e\x92\xd4\x29\xed\x47\x54\x38\x4f\x26\xe7\x94\x21\x05\xc5\xec\xca\xc9\xd4\x96\xdb\x70\xdf\xeb\x98\x08\x48\x61\xb3\x3c\x52\x32\x79\x73\xde\x08\x75\x8f\x50\x4d\xea\xec\x25\xb1\x01\x6e\x17\xf7\x58\xe4\x6b\xf4\xc8\x03\xdc\x9c\x46\xc7\xc8\x0c\xe8\xb1\x23\xf2\xf7\x6d\x7e\xf5\x93\xb0\x6b\x1a\xed\x91\x1b\xa3\x4b\x59\xd1\x31\xb1\x6c\xf3\x2b\xe4\xb2\xea\x1a\x9c\xa0\x32\xe7\xe4\xe4\xb8\xf7\xc8\x1e\xe5\xe4\x18\x6a\x74\xa2\x10\x4e\x24\x93\x53\x3a\x5c\x46\x6c\x12\x08\x1f\x39\xd1\x4b\x7b\x3f\x08\xbb\x78\x32\x3e\x12\x77\xb7\x8b\xb2\x8d\x93\xe3\xfb\x81\x40\xe1\x8d\x1b\x1e\xa3\xd7\xe4\x16\x6b\xce\xce\xe0\x7a\x8d\x0a\x7a\x9f\xfa\xdf\xff\xfe\x1f\x5f\xca\x15\xb5\x6e\xe9\x98\x7e\x2d\xec\x5e\x9a\xa8\x0a\x5f\x59\xd6\x25\x54\xc2\x8e\xe8\x4f\x95\x50\xda\x62\xae\x55\x61\xc1\x4a\x95\x23\x3c\xfb\xb7\xff\xff\x34\x4b\x26\x67\xa2\xb5\xc8\x21\xee\xad\xed\x15\xcc\xa3\x6f\xa3\xbe\x2e\xbe\xfe\xe6\xdb\x0f\xfd\x42\xb9\x34\x79\x5b\x09\x03\xcb\x96\x0a\x12\xb4\x9e\xc1\x1c\x95\x23\x75\x36\x84\x09\x45\x6b\xbc\x96\x28\xb7\xb2\x2e\xce\x0b\x07\x17\x53\x0a\xff\xc7\x07\x5f\x7f\xf3\x4d\xf6\xff\x88\x6e\x58\xec\x47\x55\xfc\xab\x8b\x45\xc1\x6d\x32\x61\xda\x30\xd4\xcd\x5f\xbe\xa6\xbd\x3f\x3e\x7b\xff\xd2\x08\xaf\x8b\xb2\xd2\x22\x10\x2f\xe3\x98\x2e\xe1\xf8\xec\xbd\x57\x5f\x74\x81\x93\x63\x4a\x89\xc8\x7a\x22\x49\xca\x10\x93\x09\x3f\xa8\x76\xab\xf0\x18\x9b\xc2\x19\x1a\xef\xc4\x83\x60\xb9\xe3\xbb\xf0\xed\x33\x90\x96\x0e\xc0\x73\xf9\x3b\x1e\x57\x54\x39\xb2\xf1\x79\xe8\x98\x6b\x02\xf3\x64\xf2\xfd\x96\x66\xe1\xe2\xdb\x67\x1f\xfa\x43\x6d\xc2\x63\x03\xa1\xba\x50\x1f\xf7\xac\x8b\xe9\x71\xe0\x2e\x24\x70\xef\x50\x14\xdd\x31\x89\xd6\xc9\x5a\x90\xab\xd7\x58\x6b\xb3\x1d\xb0\xe8\xc3\x04\xb1\xc2\x62\xe8\xdd\xd4\x8e\x68\x51\xf4\x9f\x81\xb0\x60\x7c\x65\x83\x35\x15\x1f\xda\x3d\xc5\xf7\xa1\x8a\xd9\xaa\x02\x4d\x97\xe0\x51\xf4\x5f\x6e\x89\x44\x83\x86\x5f\x9a\xe8\x35\x34\xf0\x20\x15\x1c\xaf\x8d\xae\x71\x0e\xa7\xde\xdd\x3a\xa6\x42\x9e\xe8\xd6\xda\xe2\x20\x9a\xb2\x07\x52\x45\x45\x15\x7b\xd2\x52\x3b\x03\x61\x10\x5a\x25\x36\x42\x56\xb4\x85\x0c\x58\x61\xe9\xe0\x77\x34\x3a\x64\x8f\x43\xc5\x4c\x6b\x78\x1c\xff\xe6\x74\xeb\x71\x0d\x47\x5d\x76\x71\x1b\x0d\xe1\x90\xf3\xbc\x3b\x5f\xad\x21\x4b\x99\xf9\x78\x3f\xa3\x08\x11\x4d\x6b\x54\x5d\xf9\x48\x15\xe6\x79\x07\xb4\xa7\x0c\x31\xaa\x5e\x0c\x34\x9b\x66\x7b\x93\xd9\x96\xe6\x86\x55\x0f\x9f\xc9\x8d\x10\x19\x6c\x87\xe5\x23\x60\x4c\xbf\x0c\xed\xee\x7b\x8b\x45\x9a\xcd\x5f\x92\x28\xd3\x6c\xb6\x3b\xcd\xa1\xe2\x81\x79\x63\x6d\x3f\x33\x2c\xc7\x0c\xb6\x7c\x9f\x3e\xfa\x59\xd6\x49\xff\x73\xaf\x5e\xfa\xe9\xa1\x6e\x1e\x50\x8b\x9f\x64\xbd\x3c\x84\x37\xd6\x0a\xbd\xc6\xf3\x84\x07\xa2\x99\x9f\xcf\x39\x51\x91\xbf\xe3\x50\xee\x21\x14\x63\xee\x03\x4b\x26\x13\xaf\x64\x86\xe0\xfb\x61\x3d\xf7\x71\xfd\x28\xf8\xe9\x94\x96\xc8\x68\xbc\x8f\xf9\xfb\xe7\xfc\x61\xb9\x7f\xee\x7c\x6b\xfb\x19\x5e\xac\x47\xa3\x14\x67\x3c\x07\x4f\xa0\xc3\x1e\x61\xda\xad\x8d\xef\xc5\xa5\x54\xa2\x92\xbf\xa3\xe1\x84\x82\x22\xc1\x4b\x3f\xc2\xce\xf7\x8e\x9f\x49\xcc\x36\x46\x89\x01\xb4\x45\xc7\x57\x39\x22\x72\x8e\xee\x65\x9c\x99\x41\x6e\xd0\xe7\x41\x0a\x4a\x69\x2c\xbf\x95\xcf\xb9\x24\x35\x40\x7f\x7c\x69\xe7\x3e\xab\x4a\x76\x29\x10\xbc\xa5\x8a\xca\x3e\x46\xae\xb9\x5d\xa9\xbb\x17\x51\x4b\x15\x57\xe0\xc8\xf1\xb9\xfb\x6a\xb1\x08\x2d\x5f\xdd\x25\x92\x03\xd9\x60\x69\x61\x90\x5e\xf0\x57\xad\x30\x42\x39\xf4\xd9\x9e\x69\x15\x15\xeb\xae\xc5\x36\xbe\xf3\x0f\x2e\xbb\xbe\xbc\x67\xd0\xb6\xc6\x60\xee\x40\xa8\x90\xe4\x81\x26\xf3\x95\xee\x2b\x1b\xc3\x12\x59\x98\xd5\x3b\xcb\x11\x39\x7e\xc6\x09\x6d\x4d\xa2\xcb\xe8\x7d\x7d\x61\x4d\xe1\xee\x1a\x72\xdd\xb0\xa6\x1f\xeb\xe5\x25\xd4\xa2\x40\x9f\x24\x8c\x74\x73\x2d\x6c\x4f\x8d\x0a\x2a\xfe\xaa\xb8\x16\xc4\x5f\xe9\x29\xb2\xe4\xc1\x72\x43\x60\x0d\xeb\x51\xe1\xae\x92\x39\xfa\x40\x5d\x8b\xc6\xce\x88\x9a\xcf\xd7\x3b\x7c\x3e\x1b\x44\x8d\x1d\x09\xab\x43\xdf\x15\xdd\x28\x57\xa1\x4d\x6b\x23\x2d\xb5\x96\x05\x84\x91\x2d\xf9\xc6\xb2\x08\xdc\x71\x14\x98\xf5\xfa\x9f\xc3\x8f\x22\x5f\xf7\x38\xfe\xf5\x41\x2a\x10\xa0\xf0\x9a\xc8\xad\xe2\x75\x30\xc4\xf1\xa1\x1e\xa6\xa4\xa2\xae\xe9\x81\x9f\x66\x3a\x42\x83\xe1\xf8\x6a\x40\xd0\x83\x46\x17\xbe\xac\x4f\xd3\xc1\x05\xba\x23\x7c\x18\x6c\x56\x98\x55\x5b\xf3\x13\x13\x37\xba\xa4\xfe\xc1\x4a\x87\xb8\xb6\x73\x5b\xd6\xcb\xcb\x2c\x99\xb8\x6d\x43\xd3\xda\x07\x0b\xee\x7e\xa4\x63\x9d\x8a\x0c\xcc\x84\xdb\x36\x7e\xea\x4a\xaa\x22\xde\x70\xe1\x8b\x7b\x81\xf2\x11\xcd\x53\x0f\x43\x04\xf9\x17\x58\xa6\xda\x5e\xb7\x9e\x8d\x25\xa3\xae\xe2\xdd\x35\xce\x04\xbb\x08\xe2\x91\xa6\x48\x4f\x1d\x26\x4b\xfe\xaf\xac\x2f\x58\xd7\x23\xea\xfe\x30\x2d\x87\x9e\x4f\x0b\xf6\xfb\x36\xe8\x6f\x2a\x61\x9f\x9a\x3b\x50\x0a\xb8\x65\x50\x77\xb9\x57\xdd\x4c\xf9\x4f\x29\x9c\x9e\xd1\x46\x1a\xff\xb8\xc8\x3e\xcb\xbe\xa7\xf3\xf2\x73\x94\x2e\xba\x1a\x77\x9a\x85\xb6\x9e\x46\x18\x51\x73\x16\xd1\x53\xf0\x63\x51\x1a\xff\x6b\xfe\x9a\xeb\x48\xd3\xf0\xb8\xff\xc7\x1f\x03\xf8\x8d\x30\x52\x14\x92\xa4\xf8\x5e\xeb\x6a\x9a\xd1\xf4\x34\xe0\xc5\x82\x0b\xe1\x91\xe6\xbe\xfc\x92\x44\xdc\x9d\xfd\x7c\x75\x75\x6d\x80\x1d\xe0\x1f\x7f\xc0\x17\xf7\x5e\x98\xfa\x36\xcf\x74\x06\x7a\x06\x3b\xeb\x85\xf7\xb4\xbe\xd0\xe3\x19\xcf\x3e\x67\x07\x72\xa1\xd8\x82\x85\xfd\x1c\x73\x07\x37\x08\xca\x9f\xda\xaa\xb0\x2b\x64\xb3\x43\x0b\xb5\xc3\x18\x22\xcb\x5d\xbd\xec\x3b\xaf\xd2\x6c\x6f\xdf\x4d\x78\x06\x0b\x9b\x3f\x5c\xe0\xf3\x88\x72\xd1\xf2\xbe\x83\x98\x56\x75\x0a\xca\xbc\x4b\xf7\xb4\xc3\x96\xb4\x2a\xd6\x3e\x68\x47\x7c\x64\x2a\xef\x77\x01\x32\x32\x39\xac\x5e\x5e\x1e\xd3\xb9\xb4\xe3\xb6\x58\x61\x4d\xe6\xda\x29\x91\x06\x28\xaf\xa3\x7f\xf7\x98\xd1\xd1\x7e\x33\x3a\x67\x9f\x1d\xf9\x5d\x5c\x70\x88\x10\xac\x29\xaf\xb4\x0a\x86\x44\xcb\xf4\xc9\xe7\x27\xf0\x14\x5e\xff\x20\x9c\x38\x0b\xe1\x68\x06\xb1\xf8\xf7\x68\x85\x2e\x25\x2b\xdc\x36\x5e\x5d\x6b\xac\x8a\x3d\xb9\x6b\xd7\x7e\xc6\xc5\x62\x0f\xe6\x9f\x85\xa9\x70\x18\x42\xd0\xa3\x0d\xa5\xcc\xa3\x59\xbd\xbc\xa4\xd5\x3c\x67\xd9\x9e\xdd\x18\xee\xc5\x0c\x08\x91\x37\xc5\xe7\x66\xc3\xed\x04\x69\x63\x0a\xb1\xdc\x3e\x98\xa7\xcd\x40\xb7\xce\xca\x82\xef\x50\xdd\xf9\xe9\x0f\xf9\xbe\xa1\xba\xa3\x49\x17\xad\x3e\x71\x89\x39\x4d\x6c\x99\xe1\x74\xc4\x69\xef\x61\x4e\x83\x74\xe1\x20\x1e\xf2\x35\x65\x8d\xf5\xc6\xb1\xf3\x9e\x1e\xd5\xac\x53\x2f\x9d\xd7\x54\x49\x3a\xbf\xf8\x30\x38\xa8\x6f\xfb\x49\xd2\x59\x76\x37\xa8\x88\xd0\x92\xfd\x0d\x59\x0d\xfb\x0e\x06\xe5\x55\x5f\xe8\xe7\x12\x47\x32\xd1\x8d\xf8\xaf\xb6\x6b\xff\xbe\x83\xc5\x02\x5a\x85\x37\xe1\x2e\xcb\x79\x48\x68\xfd\x8f\x99\x57\x6c\x9a\xec\x0b\xbb\xd3\xdf\x7c\x85\x25\x83\x50\xb8\xea\x7b\x69\xe2\x63\xf7\xd3\xbe\x89\xbc\x8c\xc0\x54\x7d\xa1\x82\xcb\xa0\x0c\x4c\x75\xa8\xfd\xdd\x39\xb7\x0f\xb9\x9f\x2f\xd4\x8e\xca\xce\xbe\xda\x06\x25\x97\xd7\x92\xbb\xdd\x75\xa9\x6c\xb9\xdb\xe0\xdc\x11\xa6\x73\x82\x8b\x78\x83\x96\xdb\xb8\xd0\x77\xad\xe2\x46\x99\xbf\xa5\xe3\xe5\x08\xbc\x53\xc6\xb0\xe2\x08\x83\x62\x26\xcd\xd1\x5a\xbe\x60\x29\x95\xf3\x15\x49\x59\x02\x0d\x85\xa2\xda\xbd\x5e\x9e\xd8\x0f\x78\xce\x4f\x54\xd7\xc8\xf9\x64\x29\xae\x86\x8d\x63\x83\x5e\x33\x32\x46\xad\xaa\x2d\xf5\x7a\xc9\x02\xae\x05\x9b\xa5\x7f\xf6\x04\xad\xd0\x13\xe3\xeb\x8b\xd1\xed\x8a\xf2\xeb\xae\xb7\x4c\x9b\x3d\xad\x65\x73\x78\x45\xbd\x4e\x84\xa2\x5b\xe7\x5f\xd8\xc7\x2c\x7a\x92\x4b\x6a\x76\xb2\x20\x1d\xd4\x2d\x5f\x36\x36\x08\x4b\x44\xd5\x3f\xb9\x4a\x05\x56\xd7\x18\x12\xdc\x6b\xb1\x8d\x9f\x3f\x48\xeb\x2d\x8e\x3d\x6b\xee\xc9\xbd\x22\x77\x6b\x84\x92\x39\xf7\xde\x71\xaf\xa3\x5e\x56\x58\x0b\x27\xf3\x19\xe9\x21\x17\x2a\xda\x96\xcf\xa0\x58\xc3\xdd\x27\x15\xb2\xaa\x92\xd0\xd2\x8c\x96\xb3\x0e\x67\xb1\x2a\x81\xbf\x2b\x59\xa1\x42\x23\x73\x48\xc3\x7e\xa6\xbd\xb8\x9c\x60\x28\x99\x4f\xd3\xd8\x81\x79\x08\x4d\x7e\xd4\x35\x80\xc9\x26\xcf\x62\x97\x70\x50\x88\xaf\x3d\xeb\xd0\x8d\x7f\x7f\x57\xd2\x51\x05\x77\x57\x7d\x17\xb2\xc9\x3f\x24\xa1\x11\xf1\x0d\xd6\x67\xfc\x66\x8b\xef\xfc\xd7\x1f\x0e\x8e\xe0\x9b\x67\x5f\xc3\x63\x78\xf6\xf4\xeb\xbf\x26\x5d\x76\xff\x7d\xa5\xf3\xab\x01\xe8\xd4\x04\x78\x32\x98\xbb\x1e\xee\x4d\xeb\xf0\x26\xc0\xc5\xf7\xbe\x01\x6c\xa8\x34\x75\x0d\x97\xaf\xd4\x06\xad\x93\x2b\xdf\xa8\x28\x2d\xef\x3e\xdf\xd9\x1a\x6d\xbb\x3b\x8c\xac\x9b\x0a\x29\x95\x9b\x51\x34\xa0\x18\x6a\xa0\xd0\x64\x91\x56\xcf\xfa\xcb\x24\x18\xac\xf5\xc6\x13\x82\x5c\xd7\x84\xd1\xf7\x6b\x3e\xed\xd9\xe4\xfe\x84\x65\x5b\x52\x67\xd0\xd6\xd1\x25\xb4\xaa\x42\xf1\x39\x30\xf8\xe7\x9a\x92\xd8\xa9\x3e\xda\xc5\xeb\xc3\x85\x6f\xf3\x3a\x3c\x02\x3b\x6a\x8e\x49\x67\xdd\xc0\xa0\xe3\xe5\x57\x15\x8f\xde\x83\x67\x83\x56\x32\x5a\xea\x0b\xe2\x77\x40\x9d\x4e\x03\x92\x67\xe6\xdb\xc3\x42\x4a\x9f\xb7\x66\x5f\x2b\xf8\xb8\x80\x1a\x99\xe2\x2f\xc0\xc2\x28\x74\xc6\x97\xb7\xc6\x63\xc9\x2e\x5b\xf0\xc6\x78\x61\x5a\xa5\xa8\x59\xf2\xf0\x57\x45\xd0\x9e\xc8\x01\x73\x9d\x3c\xc0\xd6\x01\x6f\x54\xb7\xb6\x25\xea\x59\x3c\x4f\x77\xe6\xa0\x40\x9b\x1b\xb9\xf4\xe5\xab\xc1\x71\xe9\xaf\xd3\xe4\xed\x74\xed\xa7\xc2\x2f\x16\xb0\x45\x37\x03\xbc\xc9\xd1\xbf\x90\x96\xda\x00\x71\xee\x77\x7b\xcf\xaa\xa3\x33\xb1\x8f\xca\xe4\x10\xb6\x3b\xb2\x06\x6b\xee\xd1\xe2\x6a\x50\x0f\x4d\x26\xb2\xb0\x1f\xcb\x4c\xfc\xde\x5e\xe1\xd6\xa6\xb3\x81\x2c\x7b\x5a\xcd\x64\xd1\x5f\x23\xfa\x46\x33\x6e\xe9\xef\xf1\x98\x3a\x41\xc6\x96\xb3\x51\x72\x4c\x85\x78\x32\x45\x92\x93\x90\x27\xb9\x56\x4e\xaa\x16\x43\x46\x6b\x1d\x39\x1b\x7d\xd0\x41\x7b\x48\x6f\xaa\x69\xc0\xf2\x5c\x0b\x5b\x21\x36\xfd\x45\x85\x69\x78\xa4\x23\x48\x97\x14\x07\xb0\x48\x23\x31\xfe\x46\xe2\x57\xb5\x63\x3b\xfb\x78\xf3\x76\x43\xd3\x9e\xd8\x01\xa4\xd1\x7a\x02\xd1\xd0\x99\x13\xf8\xe0\xee\x8b\x34\x1b\xc5\x32\x1b\x3b\x29\x87\x08\x03\x5b\xe1\xd4\x88\x3a\x90\x7c\xfa\x14\xc0\x7a\xd5\xcd\x68\x6d\xe3\x68\xbf\x63\xca\x95\x70\x3b\xb1\x42\xc3\x55\x12\xad\x70\x4e\x1f\x3f\x1a\x7f\xee\xa9\x7b\x9f\x45\x31\xe5\x48\x97\x97\x9a\xf9\xf3\x2f\x90\xea\x3b\xa4\xdd\x1a\xb7\x4c\x44\xaa\x60\x89\x63\x31\x59\xbe\x8f\x58\x22\xa1\x50\x7b\x52\x97\x4f\x69\x13\x9a\xa7\xf6\xbd\xb2\xda\x6b\xe9\xf2\x35\x7f\x9f\xc7\x37\x55\x02\x0c\xa6\xba\xac\xae\x62\xdf\xbd\x62\x95\x76\x5b\xf2\xdc\xc3\x13\x7e\x2e\x2c\x82\x87\x3d\x0c\xdf\xe6\x70\xc8\x27\x86\x74\x83\x46\x74\xc2\x93\x8e\x1b\x83\x55\x5b\xe0\x0c\x70\xbe\x9a\xf3\x63\x92\xc2\x8a\xab\x42\x72\xc3\x0d\xdf\x81\x1e\xc5\xb1\x47\x4b\x4f\xd1\xcb\xd3\x37\x23\xd2\x4f\xea\x7b\x14\x4a\x53\x23\x7e\x6b\x3b\xdd\x65\x7c\xaf\x2e\x7c\x4b\xff\x47\x70\x89\x79\x7f\x1d\xf4\x27\x2f\x7d\xb4\xea\x35\x14\xd8\x1c\xee\x13\x48\x0b\x56\x6c\xb0\xf0\x8f\x6e\x02\xb8\x73\x1f\xf8\x62\xbe\xac\xf8\x7b\x06\x36\x03\x38\x1c\x6b\x37\x99\x50\x73\xf6\xe7\xbb\x77\x69\x02\x57\xbb\xae\x4d\xf3\x7b\x7c\x3b\xb6\x7e\xf3\xf4\x3d\xa7\x09\x5f\x3e\x6d\xc6\x7b\x7a\x85\xdb\xec\x39\x61\xf0\xe7\x11\xbc\x69\xfc\xd9\x44\x7c\xa6\x89\x7f\xdf\xff\xae\x62\x60\x11\x7b\xcd\x28\x2a\xe1\x08\x36\xc3\x2f\x9b\xbc\x56\x8f\xbc\xa3\x74\xd7\xcf\x3e\x56\x76\xb2\x72\xdf\x1d\xed\x4e\x06\x4f\xe0\x19\x09\xfe\x37\xaf\x80\x27\x4f\xe0\x36\xc6\x0b\x06\xa0\x5e\xbf\x03\x48\xa7\xf3\xf9\x3c\xe3\x43\x63\xc7\xcb\x09\x08\x5e\xeb\xfc\xea\xf4\xfc\x97\xb5\x41\x51\x0c\x3f\x25\x7c\xaf\xaa\x07\x66\xfe\xc3\x5f\x15\xa6\x7b\x9a\xb5\xe9\x3b\x91\x5f\xd6\x18\x20\x86\xd9\x80\x71\xbf\xd0\x01\x35\xcd\x42\x13\x73\x77\x89\x20\x65\xde\x45\x30\xdd\x44\xa8\xf0\xdf\xed\x5d\x5f\xc4\x8a\x53\x3e\xa3\xe0\x20\xf5\x77\xce\x9b\x11\x04\xe4\x2b\x0d\xa8\x36\xd2\x68\xc5\x0f\x52\x4e\x43\x2e\xc8\x5d\x79\x39\x1b\x22\x0e\x29\xf1\x1a\x7d\x26\x3b\x4c\x7a\x42\xed\x59\x15\x20\xaa\x6b\xb1\xb5\xdd\x0d\xa7\xef\xf5\x59\x69\xb6\x41\x4e\x5f\xbe\xfd\x2b\xdc\xee\x49\x7a\xfe\x1d\xb1\x79\x51\xc9\x0d\x4e\xc7\x6f\xb0\xe1\x0b\x58\xe5\x79\xf1\x76\x07\x06\x43\x16\x1b\x3e\xf5\x1e\x7c\x2e\x1d\x83\x2d\xdf\x75\x05\x58\xa9\x56\xdd\xf5\x29\xf4\xa5\x0f\x29\xf9\x89\xfe\xbb\xcb\xc1\xdc\x47\xbf\x14\x1d\xc1\xdd\xff\x42\x34\x5e\x90\x46\xbc\xf9\x8f\xea\x3c\xd0\x14\xfb\x56\x2f\xff\x54\x15\xad\x95\x4f\x34\x9f\x72\x0f\x16\x99\xda\xac\x47\x50\x42\x69\x22\xbb\x47\xa1\xfc\x7b\x7a\xff\x15\x23\x60\xf4\x75\x27\xa2\xf6\x7f\x03\x00\x90\xb7\x23\x88\xf7\x3f\x00\x00"),
+		},
+		"/src/runtime/trace": &vfsgen۰DirInfo{
+			name:    "trace",
+			modTime: time.Date(2026, 10, 15, 16, 13, 21, 209127823, time.UTC),
+		},
+		"/src/runtime/trace/trace.go": &vfsgen۰CompressedFileInfo{
+			name:             "trace.go",
+			modTime:          time.Date(2026, 10, 15, 16, 13, 26, 155290678, time.UTC),
+			uncompressedSize: 6695,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x58\xdf\x73\xdb\x36\xf2\x7f\x16\xff\x8a\x0d\xa6\x93\x21\x1b\x96\x72\xbe\xdf\xb6\x0f\xf2\xe9\x21\xe7\xa4\xae\x7b\x6d\xd2\xab\x9d\xb9\xeb\x78\x3c\x19\x88\x5c\x89\xb0\x29\x80\x07\x80\x56\x35\x1e\xff\xef\x37\xbb\x00\x25\x4a\x96\x63\xa7\xd7\x3c\xc4\x14\x7e\x2c\x76\x3f\xbb\xfb\xd9\x05\xc6\x63\x78\x35\xeb\x54\x53\xc1\xb5\x4b\x92\x56\x96\x37\x72\x81\xe0\xad\x2c\x31\x49\xd4\xb2\x35\xd6\x43\x9a\x8c\x04\x5a\x6b\xac\x13\xc9\x48\x28\x43\xff\x3b\x6f\x4b\xa3\x6f\xf9\x73\xad\xcb\xb1\xf4\x66\xa9\x4a\x91\x24\x23\xb1\x50\xbe\xee\x66\x45\x69\x96\xe3\x85\x69\x6b\xb4\xd7\x6e\xfb\x71\xed\x44\x92\x25\xc9\x78\x0c\xa7\x3c\xf4\xd3\x39\xb8\xb2\xc6\xaa\x6b\xd0\xc1\xc2\x58\xd3\x79\xa5\xd1\x81\xf2\x0e\x9b\x79\x0e\xce\x80\xd2\xce\xa3\xac\xc0\xcc\xc1\xd7\x08\x73\x63\x97\xd2\xf7\xbf\x4e\x0d\xc9\xb2\x9d\xf6\x6a\x89\x39\xac\x6a\x55\xd6\xb0\x30\xe0\x8d\x69\x82\x19\x60\x51\x56\x2e\x0f\x3f\x1c\x48\x8b\xb0\xb2\xca\x7b\xd4\xa0\x34\xcb\xb8\xe0\x65\xef\x6e\x51\x7b\x12\xf6\x43\x38\xc0\xd7\xd2\x43\x59\x5b\xb3\xc4\xc9\x78\x4c\xbb\x95\x5e\x80\xd4\x15\xd4\xde\xb7\x6e\x32\x1e\x77\xaa\x68\xd1\xce\xd1\x7b\x53\x54\x78\x0b\xa6\x45\x3d\x21\x09\xfd\x82\xca\x94\xae\x58\x18\xb3\x68\x90\xe1\xa8\x4c\xd9\x2d\x51\xfb\x71\x35\x7e\x7d\x72\xfb\xe6\xa4\xb9\xfd\x61\xbe\x7e\xf3\xdd\x6f\xdf\xfc\x5a\xff\xfe\x71\xa9\xbf\xfb\xf0\xe1\x9f\xfe\xf7\x5f\x7e\xfc\xb6\xfe\xfe\xec\x48\x9f\xbb\x7f\x94\xf5\xfb\x37\xeb\xf3\x8f\xc9\x78\x4c\x52\xdf\x49\x36\x2d\x42\x04\xca\x81\xab\xcd\x4a\x83\x74\x20\xc1\xd7\x64\x66\x0e\x2b\xe5\x6b\x70\x8d\x22\x53\xe7\xc6\xb2\x7d\x04\x0d\x28\x4f\x28\x39\x32\x80\x84\xed\xcf\x29\x07\xb3\xc6\x94\x37\x58\x11\x2a\x33\xf4\x2b\x44\x5d\xc0\x4f\xf2\x56\x9e\x97\x56\xb5\x1e\x4a\xd9\x34\x33\x59\xde\xb8\x80\x8c\xed\x34\x98\xce\x3b\x55\x21\xc9\x33\xf3\xa1\xf7\x08\xe4\x8d\x72\x41\x35\x38\x2a\xe0\x42\xba\x9b\xbd\x49\x49\xd1\x03\x48\xd8\xbb\x1c\x2c\x2e\x94\xd1\x8e\x04\x4a\xd7\x9b\x11\x3d\xbd\xb5\xdc\xd7\xb8\x0e\xe7\xeb\x9c\x1d\xd2\x98\x05\x2c\xd1\x39\xb9\xa0\xb3\x1d\x47\x8c\xd4\x3e\x8a\x2d\x48\xdc\x47\x5d\xa1\x85\xf7\xa6\xc2\xe2\xda\xe5\xb0\x90\x76\x46\x81\x5e\x9a\xa6\xc1\xd2\xd3\xa1\xbb\x7a\xed\x9c\xad\x2c\xd0\x70\x30\xa4\x88\xee\xb8\xa8\x63\x9e\x10\x76\x16\x4b\x63\xab\x00\xde\x12\x97\xc6\xae\x59\xb1\x3e\xd0\xbc\x81\x15\xcc\xd6\x70\xee\x4d\x5b\x24\x7e\xdd\xc6\xad\x16\x9c\xb7\x5d\xe9\xe1\x2e\x19\xad\x20\xfc\x53\xa6\xf8\x97\x55\x1e\x6d\x32\x0a\xfa\x03\x7c\x7d\xed\x8a\x0f\xb3\x6b\x2c\x3d\x8c\xc7\xf0\xc6\x5a\xb9\x66\xcd\xf8\xf4\xde\xc8\x51\x50\xcf\xc1\x52\xb6\x97\x4a\xfb\xab\x99\x31\x4d\x32\xb2\x9d\xd6\x14\xb8\xfd\xe8\xbc\x31\xd2\x7f\xff\x2d\x09\x3a\xf7\xd2\x6e\x12\xa9\x5f\xc7\x86\x93\xae\x1b\xbc\x8b\x64\xd4\x87\xc6\x53\x42\xfa\x75\x07\x85\x78\xf6\x3e\xb0\x90\x4e\x69\xff\xfd\xb7\x57\xce\x5b\xa5\x17\xc9\x68\x51\x06\xdb\x77\x0d\xfd\x15\x2d\x27\xbb\x2e\xf1\xc3\xcc\xa1\xbd\x45\xcb\x71\xf6\xd0\x77\x39\xa8\x39\x48\xbd\x2e\x92\x7b\xe6\x96\x45\x79\x11\x82\x4e\xb9\x10\xe5\xe1\xd7\xe7\xbd\x6e\x74\x01\xa7\x9b\x20\x3b\x7b\xcb\x73\x24\xad\x35\x4e\x79\x75\x8b\x21\xd8\xfa\x70\x26\xd9\x83\xf4\x88\xb9\xb0\x9b\x08\x45\x52\x1a\xed\xfc\x56\x9f\x29\x7c\xf3\x3a\x49\x6e\xa5\x05\x59\x92\x48\xf8\x3a\xc4\x01\x6b\xad\xcd\x0a\x2c\xfa\xce\xea\xa0\x75\xd9\x59\x8b\xda\xc7\x1c\xd5\xb0\x54\xa5\x35\x0e\x4b\xa3\x99\xcf\x6a\x84\x4e\xab\x0d\xf6\x2c\xa8\x48\xe6\x9d\x2e\x49\x52\x9a\x41\xef\xa5\xbb\x64\xa4\xe6\xd0\x6e\xd1\x84\xc9\x14\xae\x5d\x71\xda\x98\x99\x6c\x8a\x53\xf4\xa9\x18\xcc\x8a\xec\x78\x67\xf1\x0b\x5e\x4c\x09\x34\x57\x1a\x2b\x12\x37\x0a\x6a\x0e\x97\x15\x27\xb2\x69\x52\xa1\xcd\x4a\x64\xc5\x0f\x74\x72\x9a\xc1\xd7\xf0\xfa\xe8\xe8\x28\x19\xdd\x27\xfd\x8e\xbd\x63\xdf\x4a\x8f\x22\xfb\xdc\xde\xe0\xd0\xb2\xb3\x5b\xd7\x0c\x31\x3a\x7b\xbb\x1f\xc0\x1b\xf4\x73\x30\x16\x8e\x06\x8e\xe1\xc8\x18\xf8\x86\xa1\x1a\x4a\x4e\x33\x50\x9a\x93\x51\x55\x07\x30\xfa\x6a\xb8\x56\x64\x61\x50\x55\x22\x63\x7c\x55\x05\xd3\xc7\x91\xda\x01\x41\x55\xc5\x99\xf6\x69\x46\xc6\xb1\x12\xa9\xef\x03\x21\x03\x5c\x2a\x9f\x22\x67\x49\x48\x8f\x2b\xa5\x3d\xda\xb9\x2c\xf1\xee\x3e\x23\x99\x78\x29\x5a\x55\x89\x2b\x98\xc2\x6b\x3e\xfa\x53\x0e\xe6\x86\x14\xc6\x4b\xe1\x9d\xb8\x3a\x86\x17\xe6\x86\x4f\x8f\x03\x30\x0d\x21\xc1\x4a\xf8\x60\x1c\x4d\x91\x94\x22\x55\xda\x07\x13\x5e\xf8\x22\x92\xc8\xa5\x57\xd5\x15\x4b\xd8\x1b\x9a\x82\xb7\x1d\x26\xa3\x91\x96\x4b\x8e\x23\xb1\xe5\x65\x01\xaf\x20\xf6\x05\xc5\x99\x37\x32\xf5\xaa\xca\x92\xd1\xc8\xad\x94\x2f\x6b\xa0\x73\x49\x62\x29\x1d\xc2\xd1\x24\x19\x45\x21\x53\x10\xdb\x3c\x12\xfd\x82\x3e\x65\x76\xd6\x9d\x9e\xd0\xfc\x3d\xab\x15\x79\x2f\x84\x4e\xdb\xb9\x5a\xe4\x8f\x80\x76\x27\x68\xbf\x98\x80\x08\xa6\x7c\xe2\x9f\x39\x88\xb6\xa6\xc1\x5f\xf8\x53\x55\x62\x02\xaf\x73\x60\x50\x26\xa4\x6c\x0e\x42\xda\x85\x13\x93\xa7\xc4\xd2\x9f\xfb\xfb\xec\x7f\xd5\xca\x19\xeb\x3f\x29\x5d\xe1\x1f\x7f\x85\x6e\x03\x69\xbc\x83\x15\x24\xef\x1f\x54\x11\xb3\x98\x68\x81\xb9\x29\x06\xa9\xab\x28\xcd\xb2\x6d\xd0\xc7\x22\x03\x73\x6b\x96\xe0\x98\xf0\xa9\xe1\x6a\x28\xa8\x8a\x87\x11\xcc\x32\x52\xf6\x59\xd0\x2c\x67\xdf\x2b\xed\xf3\xb8\x3b\x32\x13\x47\xb3\x2f\x38\xe2\x9f\x81\xf1\x06\x95\x7f\x8b\x3d\x2c\x3c\x21\xc1\xa2\x73\x10\x55\x67\x69\x03\x53\xe0\x37\x61\xf4\xbe\x37\x8f\x8a\xf6\x12\x7d\x6d\x2a\x07\x33\x6c\xcc\x8a\xf9\x9f\x5a\x1b\xac\xa8\x5e\x11\x91\xb4\x16\x9b\xae\xe2\xa2\x61\xba\x45\x0d\x5f\x45\x66\x7d\x68\x68\x69\x51\x7a\x4c\x83\x6d\x5f\x60\x8c\x38\x35\x27\xbc\x75\xeb\x68\x45\x9f\x8e\xa3\x61\x6b\xdc\x2e\x37\x3d\xed\xf3\x4d\x2a\x8a\x09\x04\x8f\x1f\x22\x18\x46\x64\xa8\xb4\x9a\xf7\xd8\x05\x16\xf1\x45\xac\xe3\x97\xaa\xba\x3a\x86\x48\x25\x15\x52\x24\xa4\x9b\xc9\x1c\x42\x76\xfb\x22\xf8\x5b\xc4\x71\x41\x13\xd1\xd1\x7d\xc8\x45\x6e\xbe\x0c\x0c\x12\xa8\xe8\xb0\x6a\xa6\x4d\xfb\x50\xc1\x3f\x94\xcf\x41\xba\x06\xb1\x05\x6a\x66\x1e\x51\x76\x20\xfc\xa1\xb2\x71\xf2\x81\xb2\x71\xfc\xa1\xb2\xd4\x48\x84\x23\x5f\xbe\x84\x17\xa4\x43\xa4\xc1\x01\x26\x43\x3a\x3d\x64\x86\x09\xdd\xca\xe9\x49\xca\x2a\x5b\xfc\x4f\xa7\xec\xa1\xaa\x1b\x67\x62\x05\xe9\xd7\x3d\x5e\x46\x58\x43\x2a\xba\x3f\x1a\x73\xe3\x48\x60\xdc\x53\x9c\xe9\x5b\x73\x83\xa1\x8e\x7f\xaa\x69\x36\x0a\xdd\xac\x0e\x27\x1e\xe8\xa9\x44\xf6\xd4\x89\xbe\x58\x94\x30\x7d\x96\xa8\xe2\x3d\xae\xd2\x6b\x47\xf5\x0d\xad\x96\x4d\xe8\xe6\x52\x82\x28\x6d\x94\xf3\x83\x16\x8f\xb1\x61\xbc\x43\x2b\xf4\x62\x0a\x01\xeb\xcd\xd1\xcc\xf4\xa8\xbd\x55\xc8\xc6\x92\x80\xc8\x5b\x0b\xf4\xef\xc2\x04\xd9\x39\xa2\xeb\x8c\xa2\x25\x47\xc7\xa0\xe0\x6f\x10\x37\x15\x3f\xa3\x5e\xf8\x3a\xcd\x8e\x41\xbd\x7a\x15\x84\xb3\x1f\xfa\xf9\x33\x22\xc8\x54\x91\x88\x67\xe7\xee\x89\x38\x44\x44\x7d\xbd\xea\xd9\x08\x03\x48\x1c\x59\x17\x6a\x89\x0f\x3a\x9b\x0d\x53\xc5\x95\x55\x67\x25\xb5\xa4\x0f\x16\x72\x65\xb9\x4f\x46\xf7\x59\x16\x5c\x11\x21\x88\x51\xf6\x99\x02\x43\x56\xae\x2f\xd6\x2d\x92\x42\x97\xb1\xdb\xbe\x13\x8b\x52\x0c\xb9\x81\x3b\xf8\x74\xb5\xbd\x78\x64\xc0\xb7\x7d\xe6\xb3\x70\xed\x2d\x7e\x36\xe5\x0d\xc5\x7b\x85\x73\xb4\xd0\x8f\x7e\xd4\x4d\x1c\xdf\xf1\xa2\x56\xcd\xb0\xf5\x09\x4f\x07\x1c\x18\xa2\xbf\x46\x2b\x07\xb2\x21\xb8\xd6\x80\x5a\xce\x1a\xe4\x2e\x8a\x42\x8d\xbc\xf3\x32\x24\x12\xc9\x58\x4d\xe2\xb5\x68\x95\x53\x2c\x70\xe9\x9a\xc0\x7e\x1e\xf1\x95\x28\x06\x5f\x46\x0b\x63\xcf\x32\xd9\xb9\x0c\xdd\xdd\xd3\x54\xcc\xfc\xc9\xfe\x65\x26\xcc\xc6\x2c\x7f\x64\x96\xaf\x30\x93\x43\x77\x18\x9e\x7f\xb4\xbc\x3e\x15\x53\xad\x35\x25\x3a\xf7\xf9\xc6\xe4\x79\xcd\x88\xe8\x9f\x5a\xd8\xc7\xa3\x58\xf3\xb6\x4d\x1a\xd5\x3a\xf2\x40\xb8\xb7\x0d\xfc\x10\x71\x29\xfa\x66\x76\x32\xdd\xab\x3f\xc7\x34\xfa\x62\x0a\x47\x91\x10\x0f\x91\x7a\x80\x60\xc0\x7f\x49\x32\xaa\x7b\xae\xda\x73\x5a\x60\x81\xde\x6b\x71\x5d\x71\x4e\x53\x65\x5f\x1d\x1f\x32\x89\x2f\xc2\x64\xb6\xbb\x83\xf3\xec\x91\x0d\x81\xdd\xf7\xd7\x9b\xf6\xd1\xe5\xa6\xa5\xd5\x5b\x75\x79\x47\x68\x03\x44\x0e\x2c\x25\x4b\x46\x31\xe0\xa7\xe0\x93\x51\x78\xfe\x2a\xce\xbd\xb1\x78\xa6\xfd\xff\xff\x5f\xfa\xb2\x4f\x92\x18\xdf\x39\xbc\xce\x36\x17\x00\xad\x9a\x41\xfa\x99\x36\xd4\x89\xe7\x26\xdb\x73\x0e\x3b\xca\x62\x2e\x05\x2d\xd9\xa9\x9e\x78\x7e\x37\x39\xd9\x61\x1b\x43\x48\xad\x47\xcd\xd6\xaa\x09\x89\xce\xd5\x60\x90\xe6\x03\x4a\xaa\x94\x2b\x8d\xd6\xec\x56\x16\x3d\x1e\xc3\x49\x63\x1c\xbf\xd9\xc4\xde\xf2\xc1\x73\xce\x36\x22\x4d\xfb\xfc\xe8\x8b\xad\x42\x0e\x73\xd9\x38\x8c\x7f\xf8\xd0\x00\x24\x3e\x23\xe2\x42\x63\xc7\x66\xf2\x27\x3f\x01\x3a\x91\x43\x9f\xc5\xbb\x6b\x2a\xe5\xda\x46\xae\x89\xcd\x3f\x6a\xc5\x7d\xda\x92\xcb\x8f\x2f\x56\x81\x3a\xd3\xcb\xab\xd9\xda\x63\xba\x77\xee\x4f\xe7\x1f\xde\x6f\x6e\xb9\x21\x77\xd5\x7c\x2d\xe2\x9b\x64\x56\x9c\xf3\x50\x9a\x65\x5b\x52\xee\x1c\xda\x0b\xe9\x6e\x4e\xfa\x16\x33\x87\x56\x5a\xd4\xfe\xec\x2d\x04\xe2\xc9\x81\xd8\x88\xd8\x3d\x76\xd9\x7d\x7b\x34\x70\xfb\x31\xf8\x5d\x4f\x31\x81\xc5\x8c\xed\xb7\x27\xcf\x2d\x7d\xfd\x86\x1c\x44\x29\x3d\xf7\xaa\xd2\xdd\x6c\xf9\x6a\x46\x9f\x4c\x57\x91\x34\x53\x55\x65\x7f\xba\x99\x0d\xe6\x0e\x84\xf5\xf6\x67\xf1\x2a\xb3\x0f\xd5\x3b\x5d\x51\xef\x18\xd0\x79\x1a\x8c\x70\xa3\xe8\x9b\xc8\x0d\x30\xa1\x25\xe9\xef\xcf\xbb\xad\xc8\xa6\xab\xe4\xd5\xdb\x9e\xf2\x0b\x2e\x30\x07\x81\xc3\x2f\x00\xee\xa1\xed\xbf\xf1\xa3\x29\x87\xc8\xd2\x54\xb8\x09\x8f\xf0\x98\xfa\x45\x01\xd2\xd6\x7c\xa5\xff\xbb\x08\x28\xb0\xb8\xe9\x34\x4a\x7a\xa7\xab\x13\x1a\x60\x5c\xda\x9a\xee\xe3\xef\x06\xd7\xf1\x67\x60\xb0\xd5\x68\x8b\x44\x18\xdb\x60\xd1\xd6\x7f\x3a\x60\x18\xd3\x1d\x08\x0f\x04\xca\xcf\x66\xb1\x0d\x92\x1c\x4a\xe9\x71\x61\xec\x3a\xef\xdf\x8f\x9f\x0d\x55\xff\xfe\xd1\x4b\x08\x88\x85\x87\x8a\x29\x08\x11\x60\xea\x1f\x2e\x1a\xb3\xf8\x32\xa8\x76\xc3\x85\xb6\xff\xb5\x57\xc5\x68\x2e\xad\x08\x5f\x39\x7c\x0e\xc0\xff\x06\x00\x00\xff\xff\xa0\xbd\x71\x1e\x27\x1a\x00\x00"),
 		},
 		"/src/strings": &vfsgen۰DirInfo{
 			name:    "strings",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/strings/strings.go": &vfsgen۰CompressedFileInfo{
 			name:             "strings.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1759,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x94\xd1\x6f\xe3\x44\x10\xc6\x9f\xbd\x7f\xc5\x60\x1e\xce\xa6\xa9\x9d\xb4\x4d\x49\x83\x82\x74\x0d\x52\x29\x42\xea\xe9\x0a\xe2\xe1\x74\x0f\xeb\xf5\x38\x9e\x64\xbd\x6b\xed\xac\xdb\x0b\xa8\xff\x3b\x5a\x3b\x6e\x73\xe5\x10\x02\xfa\xe4\xc6\xb3\xbf\xf9\xe6\xf3\x37\x9b\xe7\x70\x52\x74\xa4\x4b\xd8\xb2\x10\xad\x54\x3b\xb9\x41\x60\xef\xc8\x6c\x58\x08\x6a\x5a\xeb\x3c\x24\x22\x8a\x3b\x43\xca\x96\x98\x77\xbe\x5a\xc4\x42\x44\xf1\x86\x7c\xdd\x15\x99\xb2\x4d\xbe\xb1\x6d\x8d\x6e\xcb\x2f\x0f\x5b\x8e\x45\x2a\x44\xd5\x19\x05\xb7\xa6\xc4\x4f\xd7\x7b\x8f\x09\x1f\xc8\x13\x50\x50\xec\x3d\xa6\x40\xc6\xc3\x1f\x22\x72\xe8\x3b\x67\x60\xcb\xd9\xad\xf1\xe8\x8c\xd4\x77\xc5\x16\x95\x4f\x38\xcd\xd6\x52\xeb\x24\xa6\x00\xb9\xab\xe2\x49\x28\xba\xd1\xb6\x90\x3a\xbb\x41\x9f\xc4\xf7\x3d\x31\x1e\xeb\x2a\x67\x9b\x75\x2d\xdd\xda\x96\x18\x4f\x40\xa5\x69\x40\x26\xa9\x78\x3a\x56\x93\xf0\x04\x18\xdb\x83\x9c\xff\x2a\xe3\x75\x11\xb6\x7f\xe9\xf6\xb3\x64\xff\xff\x3a\xea\x91\xf0\x2f\xba\xae\x6d\x67\xfc\xdf\x74\x34\xb0\x5c\xc1\x54\x44\x79\x0e\xdc\xa2\x22\xa9\x41\x49\x46\x16\x11\x3f\x92\x57\x75\xa8\x09\x3f\x80\x46\xd3\xc3\x61\xb5\x82\xe9\x52\x44\xa3\xd6\x10\x80\xec\x7d\x67\xb0\xef\x72\x6b\x86\x0f\x90\x70\x0a\x27\x30\x7b\x7d\xf6\xfb\xe1\x31\x3d\x3a\x3f\xfd\x02\xff\xa5\x88\xaa\x5e\xf4\x6a\x05\x1c\x94\x3c\x9f\x9a\x89\x28\x7a\xfa\x0c\xf2\x24\x44\x54\x59\xd7\x57\xb5\x96\xc3\x58\xc7\x4e\xa7\x03\x2c\xbc\x59\xad\xe0\x74\x36\xd0\x0a\x87\x72\x77\x40\x99\x93\x13\x11\x45\x0c\x2b\xe0\x0f\xad\xe5\x93\x51\xd0\xf2\x63\x80\x8f\x9d\xcc\xb3\xab\x49\x01\xdf\x5c\x87\x5d\x41\x97\xc2\x61\xea\xf4\x60\x6f\xa0\xe7\x39\xfc\xda\xb2\x77\x28\x1b\x38\xd4\x65\x43\x19\x38\xd4\x84\x0c\xd6\xc0\xb8\x62\x9d\x61\x59\x61\x06\xbf\x21\x28\x69\xde\x78\x28\x2d\xf8\x5a\xfa\xac\xe7\xfc\x72\xf7\xc3\xdd\x12\x6e\xfd\x1b\x0e\x03\x30\x15\x1a\xfb\xb7\xe0\x6b\x04\x34\x9e\xdc\xf3\x92\x66\x87\x56\xf0\xf6\xdd\x6d\x40\x41\x81\x40\x4d\xab\xb1\x41\xe3\xb1\xec\x71\xc3\x5f\x63\x1d\x02\x56\x15\x29\x42\xe3\xf5\x1e\x82\x7b\x37\x77\x6f\xdf\xaf\x7f\x5c\x6d\x79\x48\x43\x45\x4a\x6a\xbd\x87\x44\x3e\x58\x2a\xa1\xe3\xa0\xfe\xc3\xc7\xb0\xac\x13\x20\xc3\x1e\xe5\x31\xb2\x63\x04\x79\xf0\x02\x4a\x72\xa8\xbc\xde\x7f\x07\xd6\x01\xdb\x06\xe1\x27\xf9\x20\xef\x95\xa3\xd6\x8f\x36\x15\x47\x62\xa9\x02\x6b\x10\xf0\x13\xb1\xe7\x34\x3b\xc2\x5e\x77\x61\x52\x62\x20\x1e\x54\x3f\x5a\xb7\x9b\x40\x89\x15\x3a\x28\x6d\x00\x91\x87\xce\x78\xd2\xc1\x11\x87\x6f\x18\x24\x18\xc4\x12\xb8\xb6\x8f\x06\x1e\x48\x42\xeb\x6c\x45\x3a\xdc\x36\x47\x64\x69\xca\xe1\x04\x48\x87\x50\xa0\x51\x75\x23\xdd\x8e\x41\x3e\x48\xd2\x32\xf8\x9c\x30\x22\xd4\xde\xb7\xbc\xcc\xf3\xcf\x2e\x39\x2d\xcd\x26\xdf\xd8\x9c\x98\x3b\xe4\x7c\xb6\xb8\xba\x9a\x7e\xdd\xff\xa3\x6c\x13\xec\x3e\x3d\x9f\x9f\x4d\x2f\x17\xf3\xf3\xf3\x30\xce\x21\x40\xc3\xe4\x49\x91\x15\x5d\x95\x7e\x39\x4c\xca\xb6\xfb\x75\x8d\x6a\x97\xa4\x21\x48\x54\x41\x91\xc9\xb2\x74\x21\xb9\x86\x74\x1f\xdd\xe3\x74\x3d\xd7\x87\x0f\xc0\x60\x2c\xb2\x92\x2d\x4e\xe0\xb1\x26\x55\x43\x8b\xae\xb2\xae\xe1\x31\x64\xef\x2c\x85\x3b\x03\x1a\x69\xa8\xed\xb4\xf4\x64\x4d\x36\x20\x5f\xc7\x6f\x02\x6c\x81\x77\xd4\x02\xf9\x0c\xee\xff\xc9\x89\x30\x37\xf9\xfc\x62\x71\x31\x5f\x5c\xaa\xc5\x4c\x4e\x67\x57\x97\x78\x71\x26\xd5\xfc\xac\xba\x9c\xcf\x0a\x35\xbf\x9c\xce\xbe\x55\xf2\x62\x7e\x71\xb6\x98\x86\xa6\xe3\x64\x50\x88\xe8\x09\x50\x33\xc2\xcb\xbc\x5f\xad\xa0\x18\x16\x5a\x1a\x52\x49\x7c\xc8\xf8\x12\x48\x6b\xdc\x48\xdd\x07\xce\x56\x60\xac\x39\xfd\x1d\x9d\x1d\xf7\x2c\x38\x42\x58\x42\xb1\x87\x07\xa9\x3b\x8c\xd3\xb0\xc2\x4f\xe2\xcf\x00\x00\x00\xff\xff\x3c\x43\xb4\x54\xdf\x06\x00\x00"),
 		},
 		"/src/strings/strings_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "strings_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 388,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x90\xc1\x4a\xc4\x40\x0c\x86\xcf\xe6\x29\xc2\x9c\x76\x55\xba\xcf\xa0\x1e\x16\x04\x41\x3a\xbd\xcb\xd8\xa6\x75\x6c\x27\x33\x24\x19\x3c\x88\xef\x2e\xa5\xf5\x24\xe2\x6d\x8f\x81\xef\xcb\x07\xff\xe9\x84\x37\xaf\x35\x2e\x03\xbe\x2b\x40\x09\xfd\x1c\x26\x42\x35\x89\x3c\xe9\x8b\x91\x1a\x40\x4c\x25\x8b\xa1\x5b\xaf\xc8\x93\x03\x18\x2b\xf7\xd8\x91\xda\xfd\xaa\x92\xdc\x2d\x4b\xee\xf5\x60\x78\xbd\x33\x4d\x77\xc4\x4f\xb8\xb2\xc6\xcf\xb1\x1c\x9c\x54\xb6\x98\xa8\x69\x29\x0c\x4f\x94\xbc\x05\xd3\x5b\xfc\x61\x37\xfb\x99\xa4\xad\x8c\x9c\x0d\xb5\x96\xb5\x48\x03\x46\xc6\x73\x2e\x6f\x24\x8f\xde\x1d\xe1\xeb\x77\xf9\x2c\xf9\xe3\xa2\xdd\x87\x9c\x4a\x10\xf2\xdb\x42\x7f\xa7\x2b\x6b\x18\x77\xec\x9f\xe7\xdf\x01\x00\x00\xff\xff\xe9\xc8\x01\xe4\x84\x01\x00\x00"),
 		},
 		"/src/sync": &vfsgen۰DirInfo{
 			name:    "sync",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 16, 29, 40, 903374165, time.UTC),
 		},
 		"/src/sync/atomic": &vfsgen۰DirInfo{
 			name:    "atomic",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/sync/atomic/atomic.go": &vfsgen۰CompressedFileInfo{
 			name:             "atomic.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 3060,
+			modTime:          time.Date(2026, 10, 15, 16, 35, 40, 917690580, time.UTC),
+			uncompressedSize: 3193,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x56\xcf\x6f\x9b\x3e\x14\x3f\xe3\xbf\xe2\x7d\x39\x54\xd0\x7e\x45\xa4\xad\xea\xa1\x52\x0e\xd5\x0e\x53\xa5\x49\x9b\x54\x75\x77\x07\x4c\xea\xcc\xb1\x91\xb1\x69\xa2\x28\xff\xfb\x64\x03\xc1\x80\x61\x5d\xb2\xf6\x84\x8b\xfc\xf9\xc1\x7b\x9f\xf7\x9a\xc5\x02\x6e\x56\x9a\xb2\x0c\x36\x25\x42\x05\x4e\x7f\xe1\x35\x01\xac\xc4\x96\xa6\x08\xd1\x6d\x21\xa4\x82\x08\x05\xa1\xe6\x25\xce\x49\x88\x50\x10\xae\xa9\x7a\xd1\xab\x24\x15\xdb\xc5\x5a\x14\x2f\x44\x6e\xca\xee\xb0\x29\x43\x14\x23\x94\x6b\x9e\xc2\xd3\x2b\x2e\x1e\xb9\xfa\xfc\x29\xc2\x59\x26\xe1\x9a\x9a\xf3\xff\xc0\xc9\x2b\xd8\x63\x5c\x3f\xe0\x80\x02\xc1\x32\xb8\x5f\xc2\xb5\xb9\x88\x02\xfb\x80\xa5\xb9\x89\x02\x49\x94\x96\x1c\x04\xcb\xd0\xb1\x4f\x7c\x77\xdb\x11\xdf\xdd\x9e\x88\xef\x6e\xe3\xfa\x71\x1e\xf1\x33\x75\x2c\x6b\xc7\xb3\x6e\x4c\xeb\x0b\x5c\x3f\x53\xc7\xb6\x76\x7c\xeb\xc6\xb8\xbe\xd0\x79\xa1\xa4\xc3\x5e\x28\xd9\xd1\x17\x4a\xc6\xed\xe1\x3c\x81\x1f\x82\x72\x45\x4e\x02\x36\x12\x49\xf3\xb2\xd1\xe9\xbd\x8b\x07\x7f\xff\xbd\xea\x17\xb1\x2d\xb0\x24\x0f\x3c\x9b\x08\x93\x60\x59\x2f\x51\x2b\x21\x98\x91\xa1\x39\x34\xdc\x4b\x73\xc7\xbc\xea\x8b\xb5\x6a\x4a\x6a\x82\x82\xe3\x49\x3d\xc7\xac\x24\xd3\xfa\xc3\xcc\xb9\xfa\xa6\x7f\xef\xaa\xef\x8d\xe6\xc9\x81\xfe\x88\x12\x78\x03\xdc\xb3\xf0\x21\x55\xf0\xc4\xbc\x67\xc2\x66\xfd\x5d\x5d\xcc\xcf\x42\x67\x66\x30\x10\xff\xd8\xd3\x43\x96\x79\x86\x22\x23\x4c\xe1\xd1\x8e\x35\x76\xda\xc9\x83\x9b\xfa\x92\x7f\x02\xcd\xd9\x51\xf0\xc6\xae\xd6\x18\xef\xc4\xb3\x55\x3c\xc3\x75\xfa\x8e\xde\x4a\xbf\xe8\x3b\x46\xd9\xed\xbe\xa3\xbf\x7e\x2f\x52\xf1\xc4\xb3\xd3\x19\xee\xe1\xf3\x94\xbe\x09\x3c\x6e\xbd\xd3\xed\x06\x52\xef\xd9\x01\xa8\x5f\x67\xa7\xb4\x93\x20\x4f\x04\xdc\xa6\xcf\xe2\x06\x25\x77\x8b\x3c\x8b\x1b\x15\xb1\x57\xb5\x49\xe8\xdc\x60\xfa\xfe\x21\x79\x89\x9e\x94\x90\xc4\x33\x59\x15\x66\xed\x5c\x1d\xba\x1e\x55\x98\x8d\x90\xc3\x2c\x37\x48\xf3\xfd\x73\x48\xef\xac\x19\xac\x7e\x83\xac\x37\xe0\x2d\xf8\x2d\xca\x9e\xdc\xb6\x70\x5b\xff\x39\xfc\xfc\x42\xb4\x34\x83\x5e\x4c\xb0\x45\x15\x5c\xff\xc4\x4c\x93\xd8\xf6\x33\x8a\x21\xda\x81\x85\xe4\x38\x25\x87\x63\xec\x74\xad\x4a\x2a\x1f\xce\x1a\xf2\xa0\x68\x0e\x3b\xb3\x71\x39\xb5\x4b\x38\x28\x30\xa7\x69\x14\x96\x7b\x9e\x2e\xea\x1f\xbd\xf7\x50\x1a\x2c\x88\xdc\x5e\xaa\x0c\x9f\xa1\x11\x60\xa9\xc3\xd8\xee\x62\x9a\x1b\x65\xf8\xaf\x66\xba\xba\x82\x4d\x99\x3c\x1a\x2d\x8e\xd9\xf7\xd5\x86\xa4\x2a\xda\xc5\xc9\x57\xa2\xa2\x30\x15\xbc\x54\x52\xa7\x4a\xc8\x30\x36\x88\xf1\xd5\x2a\xa9\xbc\x97\xff\xe8\x90\x72\x03\xa0\xa5\x22\x5c\xb1\x3d\xa8\x7d\x41\xb2\x29\xcb\xc6\xef\x12\x76\xe8\x88\x7e\x07\x00\x00\xff\xff\x2a\xf7\xf1\xfd\xf4\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x56\xcb\x6e\xdb\x30\x10\x3c\x9b\x5f\xb1\xd5\x21\x90\x92\x54\x06\xda\x20\x87\x00\x3e\x04\x3d\x14\x01\x0a\xb4\x40\x90\xde\x69\x92\xb2\xe8\xc8\xa4\xc0\x87\x62\x23\xf0\xbf\x17\xa4\x6c\xeb\x45\xab\xa9\xdd\xe4\x24\x5a\xd8\xd9\x19\xee\xce\xae\x3c\x9d\xc2\xd5\xdc\xf2\x82\xc2\x52\x23\x54\x62\xf2\x8c\x17\x0c\xb0\x91\x2b\x4e\x10\xe2\xab\x52\x2a\x03\x31\x9a\x44\x56\x68\x9c\xb1\x08\xa1\x49\xb4\xe0\x26\xb7\xf3\x94\xc8\xd5\x74\x21\xcb\x9c\xa9\xa5\x6e\x0e\x4b\x1d\xa1\x04\xa1\xcc\x0a\x02\x8f\x2f\xb8\x7c\x10\xe6\xeb\x97\x18\x53\xaa\xe0\x92\xbb\xf3\x35\x08\xf6\x02\xfe\x98\xd4\x0f\x78\x45\x13\x59\x50\xb8\x9b\xc1\xa5\x0b\x44\x13\xff\x80\x99\x8b\x44\x13\xc5\x8c\x55\x02\x64\x41\xd1\xb6\x9b\xf8\xf6\xa6\x49\x7c\x7b\x73\x48\x7c\x7b\x93\xd4\x8f\xd3\x12\x3f\xf1\x96\x64\xdb\xd2\x6c\x77\xa2\xed\x19\xaa\x9f\x78\x4b\xb6\x6d\xe9\xb6\x3b\xe1\xf6\x4c\xe5\xa5\x51\xad\xec\xa5\x51\x4d\xfa\xd2\xa8\x64\x7f\x38\x8d\xe0\x97\xe4\xc2\xb0\x03\x81\xb7\x44\xba\x7b\xb9\xe3\xe9\xbc\x4b\x7a\xbf\xff\x9d\xf5\x9b\x5c\x95\x58\xb1\x7b\x41\x8f\x98\x49\x16\xb4\xe3\xa8\xb9\x94\x85\xa3\xe1\x19\xec\x72\xcf\x5c\x8c\x7b\xd5\x25\xdb\xb3\x19\x65\x19\x9a\x6c\x0f\xec\x19\x2e\x34\x3b\xce\xdf\xf7\x5c\x9b\xdf\xf5\xef\x5d\xf9\x83\xd6\x3c\x28\xb0\x1f\x51\x82\xa0\x81\x3b\x12\x3e\xa4\x0a\x01\x9b\x77\x44\x78\xaf\xbf\xab\x8a\xf1\x59\x68\xc4\xf4\x06\xe2\x3f\x6b\xba\xa7\x34\x30\x14\x94\x15\x06\x0f\x76\xac\x93\xb3\x9f\x3c\xb8\xaa\x83\xc2\x13\xe8\xce\x2d\x86\xa0\xed\x6a\x8e\xe1\x4e\x3c\x99\x25\x30\x5c\x87\x7b\x74\x56\xfa\x59\xf7\x18\x78\xb7\xb9\x47\x77\xfd\x9e\xc5\x12\xb0\x67\xc3\xd3\xdf\xc3\xa7\x31\xfd\x90\x78\xd8\xfa\x56\xb7\x77\x90\x7a\xcf\xf6\x40\xdd\x3a\xb7\x4a\x7b\x14\x14\xb0\x40\xbb\xe9\xa3\xb8\x5e\xc9\xdb\x45\x1e\xc5\x0d\x8a\xd8\xa9\xda\x51\xe8\xd8\x60\x86\x3e\x48\xc1\x44\x8f\x46\x2a\x16\x98\xac\x0a\x17\xfb\xb9\x7a\x6d\x7a\x54\xe1\x62\x80\xec\x7b\x79\x87\x74\xf7\x1f\x43\x06\x67\xcd\x61\xed\x1b\x68\x83\x06\xdf\x83\xdf\xc2\x1c\xf0\xed\x1e\xee\xeb\x3f\x86\x1f\x5f\x88\x3e\x4d\xaf\x17\x47\xb2\xc5\x15\x5c\xfe\xc6\x85\x65\x89\xef\x67\x9c\x40\xbc\x06\x0f\xc9\x30\x61\xaf\xdb\xa4\xd5\xb5\x2a\xad\x42\x38\x2f\x28\x80\xe2\x19\xac\xdd\xc6\x15\xdc\x2f\xe1\x49\x89\x05\x27\x71\xa4\x37\x82\x4c\xeb\x3f\xbd\x77\xa0\x1d\x16\x64\xe6\x83\x2a\x97\xcf\xa5\x91\xe0\x53\x47\x89\xdf\xc5\xd3\x69\xfd\x53\xd7\xd1\x14\xb8\x68\xb8\x34\xe4\xb8\x62\x60\x72\x06\x44\x0a\x6d\x94\x25\x46\x2a\x97\xd1\xe4\x8c\x2b\xa0\x1b\x81\x57\x9c\x80\xd9\x94\xec\xda\xe7\x7a\xc9\x39\xc9\x61\x85\x9f\x99\x06\x6e\x00\x03\xc9\x19\x2e\x41\x1b\x2c\xe8\x67\x2e\x20\x93\x0a\x88\xff\xfa\x70\xb1\xf0\x38\x9d\xfa\xcb\x54\x69\x05\x9f\xea\xeb\x5c\x5c\xc0\x52\xa7\x0f\x4e\x84\xc0\xc5\xcf\xf9\x92\x11\x13\xaf\x93\xf4\x3b\x33\x71\xd4\xd2\x11\x25\x0e\x31\x0c\xad\xd2\x2a\x18\xfc\xd7\x32\x71\xe1\x00\x5c\x1b\x26\x4c\xb1\xf1\xea\xe8\xb1\xba\x39\xbd\x33\x58\xa3\x2d\xfa\x13\x00\x00\xff\xff\xf1\x92\x87\xda\x79\x0c\x00\x00"),
 		},
 		"/src/sync/atomic/atomic_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "atomic_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 233,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xce\x3d\x4e\xc4\x40\x0c\x05\xe0\x1a\x9f\xc2\x9a\x2a\x01\x94\x34\x88\x2b\x80\x10\x5d\x42\x8d\xcc\xc4\x49\x4c\xe6\x4f\x33\x1e\x28\x56\x7b\xf7\x55\x56\x29\xb6\xd9\xd2\xd6\xd3\xf7\x5e\xdf\xe3\xd3\x4f\x15\x37\xe1\x6f\x01\x48\x64\x37\x5a\x18\x49\xa3\x17\xfb\xad\x5c\x14\x40\x7c\x8a\x59\xd1\xec\x97\x84\xc5\x00\xcc\x35\x58\x1c\xb9\xe8\x3b\x79\xcf\x79\xd0\x98\xf9\x33\xd2\xd4\x28\x3e\x1e\xa9\x6e\x6c\xf1\x04\x0f\xda\x0d\x9b\xa4\xc6\xd4\xc2\x18\x67\xac\xa1\xd0\xcc\xa6\x85\xf3\x0d\xf2\x15\xc8\xc9\x12\x78\x7a\x7d\xb9\x0f\xbc\xc5\xb4\x72\xfe\x18\x90\x7d\x75\xa4\x5c\x8e\x8d\xe5\x19\xff\x57\xb1\x2b\x7a\xda\xf6\xe7\x2e\x79\x0e\x8a\x92\x33\x3b\xfe\xa3\xa0\xdd\xb5\xef\x12\x00\x00\xff\xff\x52\x1a\x3f\xba\xe9\x00\x00\x00"),
 		},
 		"/src/sync/cond.go": &vfsgen۰CompressedFileInfo{
 			name:             "cond.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 511,
+			modTime:          time.Date(2026, 10, 15, 20, 45, 4, 794754060, time.UTC),
+			uncompressedSize: 1192,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x8f\x31\x73\xab\x30\x10\x84\x6b\xdd\xaf\xd8\x12\x1e\x83\x71\xfd\x6c\x9a\xe7\x96\xee\x4d\x26\xb5\x2c\x84\x7d\x41\x3e\x31\x20\x92\x61\x32\xfc\xf7\x8c\x90\x93\x14\xb6\x9a\x93\x76\x75\xfb\xcd\x56\x15\x8a\xf3\xcc\xae\xc5\xdb\x44\x34\x68\xd3\xeb\x8b\xc5\xb4\x88\x21\x0a\xcb\x60\x71\xf2\xd2\x62\x0a\xe3\x6c\x02\x3e\x49\x55\x15\x3a\xb6\xae\x9d\x30\x4f\xb6\xc5\x79\xc1\xbb\x16\x76\x4e\x83\x6f\x83\xb3\x37\x2b\x41\x07\xf6\x42\x4a\xfc\xc9\x0f\x0b\x90\x26\xa9\x06\xe9\x34\xde\xf4\x76\x8c\x7e\xe0\x6e\xf3\xe3\x6c\x78\x0a\xa4\xcc\xd5\x46\x13\xc6\x0f\xcb\x29\xdd\xe9\x19\x53\xec\xc7\x23\x0f\x60\xd9\x32\x60\xae\x5a\x70\xf6\xde\xd1\x4a\xd4\xcd\x62\x90\x19\xfc\x89\x4d\x72\xbc\x6a\x0e\x59\x1e\xab\x98\x9d\x14\x05\x29\xee\x60\x76\xe6\x8a\xba\x86\xb0\x8b\x86\x4a\x6f\xdc\x74\x6f\xb3\x9f\xac\x9c\xd4\x1a\x97\x9a\xdd\x8b\x38\x6f\xfa\x2c\x27\x75\x2c\xe3\xd7\xa4\x36\x49\x7b\x24\xfe\xe7\x8b\x68\x97\x98\x1b\x4c\x22\x6b\xbf\x91\x46\x1b\xe6\x51\xee\xc9\x52\x96\x94\xd8\xc7\x12\x61\x9c\xed\x93\xb0\x7f\xa3\xd7\xad\xd1\xd3\xbd\x83\xe0\x6f\x1d\x13\xb7\x75\xd4\xd8\x93\xea\xfc\x08\x8e\xf2\xfe\x00\xc6\x11\x72\x00\x17\xc5\x6f\xaf\xef\x6c\xb5\xd2\x4a\x5f\x01\x00\x00\xff\xff\x2c\xcb\x53\xaf\xff\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x52\xd1\x6a\xdb\x30\x14\x7d\xb6\xbe\xe2\x10\x06\x4b\x36\xd7\xe9\x5e\xcb\xf2\xd2\xc0\x0a\x23\xb0\x87\x32\xf6\x50\xc2\xb8\x96\xaf\x6d\xc5\x8a\x64\x24\xb9\xc1\x94\xfc\xfb\x90\x9c\x26\x69\x3b\xbf\x58\xdc\x73\x75\xee\xb9\xe7\x68\xb9\xc4\xd7\x72\x50\xba\xc2\xce\x0b\xd1\x93\xec\xa8\x61\xf8\xd1\x48\x21\xd4\xbe\xb7\x2e\x60\xd6\xa8\xd0\x0e\x65\x21\xed\x7e\xd9\xd8\xbe\x65\xb7\xf3\x97\xc3\xce\xcf\x84\x08\x63\xcf\x58\x5b\x53\xc1\x07\x37\xc8\x80\x17\x91\x2d\x97\xa8\x15\xeb\xca\x63\xf0\x5c\xa1\x1c\xf1\x4c\x46\x69\x4d\x50\xfb\x5e\xf3\x9e\x4d\xa0\xa0\xac\x11\x99\xb1\x6b\xdb\x8f\xc0\xf4\x17\xd9\x06\xd3\xb7\xb1\xb2\x63\x17\xf1\xa0\xea\x84\xc7\xff\x46\xf9\x20\x32\xd9\x72\x04\x21\x6d\x3f\xae\xa7\xb3\xf8\xdf\x4c\xc3\x87\x0f\xf3\x0e\xa4\x02\x3b\x8f\xa7\xad\x6c\xc9\x9c\x24\xbf\x1c\xb1\x5c\xe2\x0f\x75\x7c\x33\xf4\x88\x80\x61\xed\x61\x6b\x84\x96\x11\xaf\x28\xd3\xa0\xb1\xce\x0e\x41\x19\xf6\x39\x94\x49\x90\x75\x15\xbb\x78\x1a\xe1\x03\xb9\xc0\xd5\x6b\x77\x21\x8e\x42\x24\x52\x15\xd0\x93\xeb\x7c\xba\x20\x49\xeb\x37\x5c\x18\x4c\x50\x1a\x2a\x40\x79\x1c\x6c\xc7\x26\x2a\x7f\x54\x8d\x21\x0d\xeb\x70\xef\x2c\x55\x92\x7c\x28\x22\xdb\x2f\xa3\xc7\x2b\x1d\x08\x2d\x05\x90\x63\x90\x76\x4c\xd5\x78\xd6\x2a\xc9\xa0\xe4\x89\x30\x87\x56\x1d\xe3\xa0\x42\x9b\x34\x4c\x56\x46\x3a\xad\x7c\x78\xdd\xf2\xc1\xc2\x45\x2d\x7b\x2e\x44\x3d\x18\x89\xb9\xc4\x97\x18\xeb\x22\xed\x30\x5f\x9c\x72\xfd\x41\x4a\xa3\xe4\xda\xba\xa8\x5d\x5b\xd9\xa5\x79\xc5\x06\x6a\x22\xba\xac\x26\xc9\x7c\x0e\x51\x46\xdc\x9f\xab\x42\x64\x3b\x5f\x3c\x68\x5b\x92\x2e\xd6\xa4\xf5\x7c\xf6\x29\x45\xb9\x26\x73\x1f\x89\x66\x39\x66\xf1\xf5\x15\x71\x6c\x11\xa7\xce\x16\x31\x6d\xdc\xad\xb0\xa7\x8e\xe7\x6f\x22\x8b\x50\xf1\x1a\xe7\x0a\xd4\xf7\x6c\xaa\xf9\xb9\x94\x43\xb6\xa9\x65\x53\xfc\x4e\x32\xe7\x0b\x91\x7d\xbf\x91\xed\x54\xdb\x4c\x95\x29\xa4\x93\xdd\x07\xea\xd8\xbf\xdb\x21\x39\xdc\x92\x47\xc9\x6c\xce\xf6\xc6\x1e\x6d\x4d\xc3\x3e\xe4\x71\x6f\x32\x63\x81\x8d\xea\x38\xb2\x9d\x23\xcb\xa1\x02\x2a\xcb\x3e\xd9\x10\x35\xe4\xf0\x36\x16\x4f\xf1\xc4\xd7\xc0\x15\xec\x10\xbc\xaa\x38\x26\x71\xfd\xc6\xb8\x68\x52\xe8\xb5\xb3\x7b\xfc\xa4\x67\x7a\x94\x4e\xf5\x21\xdd\x2a\x49\x76\xfe\x43\x50\xd3\x1e\x53\x54\xaa\x86\x66\x73\xf1\x63\x81\xd5\x0a\xb7\x11\xc9\x1c\x87\xc1\x19\x91\x1d\x45\x26\xb5\xf5\x7c\x69\x7a\xba\xdd\x5e\xdb\xfa\x74\xbb\xc5\x0a\x46\xe9\xb7\x56\x5f\xf0\x6f\x77\xdb\x68\xe1\x3b\x19\x67\x03\x26\x25\xb5\x75\xf8\x9b\x63\xca\xd1\x91\x69\xf8\xc2\x90\xf4\x9c\x44\xb4\x8b\x49\xd2\xd5\xa4\x38\xfa\x28\xfe\x0d\x00\xe6\x20\xcc\xe3\xa8\x04\x00\x00"),
 		},
 		"/src/sync/cond_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "cond_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 171,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x2c\xcd\xb1\x6e\x83\x30\x10\x87\xf1\xb9\xf7\x14\x7f\x79\x29\xb4\x15\x3c\x04\x43\xa5\xae\xb0\x57\xc4\x1c\xd8\x81\xd8\x8e\xef\x2c\x84\xa2\xbc\x7b\x84\x94\xf1\xd3\x6f\xf8\xda\xf6\xfb\x52\xfc\x36\xe1\x2a\x44\x69\xb4\xeb\xb8\x30\xe4\x08\xf6\x5f\x59\x94\xc8\xdf\x52\xcc\x0a\x73\x96\x0f\x8b\x21\x9a\x4b\xb0\x18\x58\xb4\x8b\x61\xea\x62\x3a\x2a\xc5\xd7\x9b\x9b\xa1\xc6\x83\x3e\xb4\xe9\x57\x9f\x2a\x73\x2a\xac\x63\xbb\x72\x46\xe6\x7b\xf1\x99\x05\x79\xdc\x91\xa2\x0f\xca\x59\x7e\xb0\x3b\x6f\x1d\x7e\x63\x72\x9c\xff\x7a\x4c\x91\x25\x7c\x2a\xe6\xb2\x6d\x07\xa4\xa4\x73\xdf\x98\x9a\x9e\xf4\x0a\x00\x00\xff\xff\x89\x06\xd7\xea\xab\x00\x00\x00"),
 		},
 		"/src/sync/map_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "map_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 170,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x2c\xcb\xb1\x6e\xc2\x30\x10\x80\xe1\xb9\xf7\x14\xa7\x4c\x49\x5b\x25\x1d\xba\xe4\x05\x5a\xb5\x6b\xb2\xa3\xe0\x1c\xc9\x11\xfb\x6c\xf9\xce\x42\x80\x78\x77\x04\x62\xfc\xa5\xff\xeb\xba\x8f\x7d\x61\x3f\xe3\x51\x01\xd2\xe4\xb6\x69\x21\xd4\xb3\xb8\x9d\x91\x1a\x00\x87\x14\xb3\x61\xf5\x28\x96\xa5\x02\x38\x14\x71\x38\x92\xda\x9f\x6a\xa1\xef\xaf\xbe\xef\x6b\xc3\xf7\xd7\xd0\x8e\x0d\x5e\xe1\xcd\xda\x61\xe3\x54\x3f\x19\x66\xf2\x4c\x8a\x51\x30\x17\x31\x0e\xd4\x0e\x64\x3f\x2c\x93\xe7\x0b\xe5\x4f\x3c\xad\xec\x56\xfc\x8d\x69\xa5\xfc\x3f\xe0\x1c\x49\x51\xa2\x21\x87\xe4\x29\x90\x58\xd5\xc0\x0d\xee\x01\x00\x00\xff\xff\x44\x24\xd3\x1f\xaa\x00\x00\x00"),
 		},
 		"/src/sync/pool.go": &vfsgen۰CompressedFileInfo{
 			name:             "pool.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1385,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x94\x4f\x8f\x1b\x37\x0c\xc5\xcf\x9e\x4f\xf1\x7a\xaa\xdd\x7a\xed\xa4\x47\x03\x7b\x28\x5a\x20\x40\x0e\x49\x80\xa4\xa7\x20\x28\x68\x89\xe3\xe1\x5a\x23\x0a\x12\xc7\x7f\xba\xf0\x77\x2f\x34\x63\xd7\x9b\x6c\x6f\x16\x45\xff\xde\xe3\x13\x31\xeb\x35\x7e\xdd\x0e\x12\x3c\x9e\x4a\xd3\x24\x72\x7b\xda\x31\xca\x39\xba\xa6\x59\xaf\xf1\x3b\x3e\xa9\x06\x48\x01\xa1\xb0\x41\x5b\x18\xf7\x49\x33\xe5\x33\x74\xfb\xc4\xce\x0a\xac\x23\x43\x4f\x67\x6c\x19\x12\xbd\x1c\xc4\x0f\x14\xc2\x19\x85\x0e\xec\x41\xd1\x57\x54\x66\xcb\xc2\x07\xf6\xab\x66\xbd\xae\x85\x77\x9a\x3a\xce\xef\x3f\x23\x65\x3d\x88\xe7\x51\x43\xfa\x14\x38\x2f\x11\x49\x0e\x8c\xf1\xd4\x73\x34\x32\xd1\x88\xa3\x58\x87\xa8\xa3\xbd\x2e\x6b\x94\x7f\xa6\x3a\x59\xe5\x51\x08\x2b\x7c\xe9\xa4\x54\xbb\xc5\x24\x04\x38\xcd\x99\x9d\xa1\xd5\x0c\xeb\xf8\x2e\x99\x87\x68\xd2\x33\xb6\xec\x68\x28\xbc\xb9\x5a\xc2\xdb\x15\xde\xd3\x81\x3e\xbb\x2c\xc9\x46\x8e\xc4\x5d\xe0\x07\xeb\x32\x93\x67\xbf\x44\x51\xc8\x78\x23\x7d\xd2\x52\x64\x1b\x78\xc2\x1f\x15\x53\x57\x81\x29\xb6\x3c\xf2\x00\x90\x73\x5c\x2a\x66\x74\x90\x6a\x9c\x64\xe3\xef\x42\x3d\xa3\xd7\x3a\x1f\x24\xa2\x1a\x5a\x8d\xff\xfa\x6d\x75\x77\xba\xd3\xac\x83\x49\x7c\x15\xc6\x50\xb8\xc0\xa9\x26\xce\x64\x35\xac\x7e\x08\x26\x0f\x46\x65\x5f\xc5\x7a\xf5\x1c\x96\x37\x13\xc7\x4e\x5c\x07\x8d\xe1\x5c\x63\xd2\x63\x41\xa2\xc9\x94\xd3\x68\x59\x43\xf5\xac\xd6\x71\xbe\x0b\x16\x1c\x3b\x8e\xa3\xd3\x76\x88\xae\x8a\xde\x70\xbd\xec\x3a\xc3\x36\xa8\xdb\xdf\x5e\xf3\xcb\xc7\x3f\x3f\xce\x23\x1f\xf6\x1a\x8d\xf6\xc6\x8b\x0d\xfe\xd0\x58\xc4\x73\x06\x79\x5f\xa5\x08\xfd\x60\x7c\xc2\xd3\x50\x6c\xca\x08\x85\x5a\x86\xb4\x35\x52\xaf\x5c\xe2\xcf\xe3\x4b\xba\xcc\x64\x0c\x42\xa0\xbc\x63\x24\xce\xad\xe6\x9e\xa2\x63\x74\x62\x37\xc5\x0f\x6a\xbc\xa9\xf6\x32\x5f\x17\x34\xb1\x13\x0a\xe8\x28\xfa\x50\x05\x65\x72\xbf\x1b\xb3\x7c\x2a\xeb\x69\xd1\x6f\x4b\x3e\xae\x6d\x2b\xc1\x38\x97\xca\xd3\xc1\x6a\x38\xd0\x2c\x3b\x89\x14\xae\xab\xff\x7d\xea\x12\xa1\xb9\xce\x64\x0a\x3a\xa8\x78\xd0\x71\x7f\xa4\xec\x31\xc4\xa1\xb0\x47\x2b\x1c\x7c\x99\x16\xbe\xe5\xcc\xd1\xb1\xc7\xf6\x0c\xcf\xe4\xe1\xd4\xf3\xaa\xb1\x73\xe2\x09\x5e\x2c\x0f\xce\xf0\xdc\xcc\x8a\x69\x66\x7c\xfd\x26\xd1\x38\xb7\xe4\xf8\xf9\xd2\xcc\x3e\xf0\x11\x18\xc3\x9f\x2f\xf0\xf2\xe6\xd2\x34\xb5\x8a\x79\xc2\x2f\x15\xb4\xc0\x3b\xb6\xef\x7b\x2a\x54\x5a\x04\x8e\xf3\xb4\x1a\xe9\x0b\x3c\x3e\xe2\x4d\xad\xd7\x8b\xb4\xaa\xf4\x9f\x1e\x11\x25\x8c\xb5\x59\x66\x1b\x72\x9c\x2e\xe6\x8b\x66\x36\xbb\x34\xff\x15\xa3\x84\xa6\x9e\x4f\xd8\x3c\xe2\xca\xfb\xfa\x92\xfd\xf0\xf6\x5b\x33\xbb\x1e\x70\x6f\xd9\xbc\xea\xb9\x02\x4f\xff\x33\xc3\xa7\xc1\xe6\xa7\x97\x33\x2c\xae\x43\x9c\xaa\xf3\x9b\xcf\x09\x30\xba\xb9\xeb\x51\x4a\x1c\xfd\x4d\x69\x89\xd3\xa2\xf2\xeb\x5a\x76\x5c\x18\x94\xf9\x87\xe7\x30\x2e\x56\x96\xd8\xd6\x37\xcf\x8c\xa8\x0f\x9a\x4a\x7d\xdd\x1f\x3f\x11\xab\xc9\xe5\xf5\xf4\x77\xca\xea\x3e\x49\x9c\xb2\xc6\x33\xae\xe3\xbc\xc1\xe5\x75\xdf\x5f\x31\x8d\x9d\xc0\xf3\xa5\xf9\x37\x00\x00\xff\xff\xee\x6d\x8d\xe1\x69\x05\x00\x00"),
 		},
 		"/src/sync/pool_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "pool_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 836,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x91\x41\x6b\xdc\x30\x10\x85\xcf\x9a\x5f\x31\x11\x94\xda\xad\x51\xee\x0b\x7b\xda\x52\x43\x4f\xa1\x59\xe8\x21\x84\x22\xdb\x63\x5b\x8d\x2d\xa9\xd2\xa8\xe9\xb2\xf8\xbf\x17\x89\x4d\x0e\x4d\x0f\x3d\xed\x4d\xd6\x7b\xf3\xde\xe7\xd1\xed\x2d\x7e\xec\x92\x59\x06\xfc\x11\x01\xbc\xee\x9f\xf4\x44\x18\x4f\xb6\xff\xce\x14\x19\xc0\xac\xde\x05\xc6\x0a\x84\x42\x99\xef\x25\x08\x99\x25\x63\x27\x09\x35\xc0\x98\x6c\x8f\x47\x8a\x7c\xe7\xdc\x52\x31\x7e\xb8\x88\xea\x58\xe3\x19\xc4\x2f\x1d\xd0\x63\xd6\x40\x98\x11\xbd\x6a\x89\xab\x1a\x6f\xf6\x68\xcd\x92\x0d\x82\xd5\x67\xcd\x7a\xa9\x24\xfd\xf6\xd4\x33\x0d\x48\xab\xe7\x93\xac\x41\x6c\x00\xc2\xab\xbb\xc4\x95\xd4\xf9\xfb\x72\xee\x64\x0d\x20\x9e\xb5\x65\xdc\xed\xf1\xe1\xd1\x58\xa6\x30\xea\x9e\xce\xdb\x59\x76\xb2\x41\xa9\x65\x93\xf3\x37\x10\xa3\x0b\x68\xb2\x2d\x68\x3b\x11\x96\xa1\xdc\x3a\xb9\x32\x7c\xe1\x01\x91\xe1\xf2\xdd\xcd\xbe\x78\x1e\xcc\x63\xb1\xbd\xd0\x8d\x95\x6c\x1d\xef\x5e\xf9\x03\x71\x0a\x96\x86\x1d\xbe\x8b\x0a\xbf\x69\xcb\xe5\x24\x9b\x1c\xd2\x94\x88\x1c\xba\x95\x7f\xd8\xfe\xda\x52\x7b\x78\xbb\x27\x56\xf7\x4f\xc6\x57\xf2\x38\x9b\x88\x59\xc2\x14\x29\x62\x48\x96\xcd\x4a\xaa\x3d\x54\x75\x83\xcf\xb3\xe9\x67\x6c\x9d\x9f\x29\x7c\xb9\xc7\xc1\x51\xb4\xef\x19\x63\xf2\xf9\x91\x94\xac\xdf\x54\x7d\xa5\x85\x74\xa4\xab\xf5\x7d\xa2\x9f\x89\xd2\x7f\xf5\xb1\x0e\x13\x71\xc4\xe4\x23\x07\xd2\x2b\x7a\xe7\x16\x34\xab\x5f\x68\x25\xcb\x9a\x8d\xb3\x2f\x08\x26\xa2\x75\x05\x71\xc0\xee\xf4\x4a\xf4\x2f\x82\xc3\xac\x8d\xbd\x6a\xff\x9f\x00\x00\x00\xff\xff\x4c\x8c\xfb\x16\x44\x03\x00\x00"),
 		},
 		"/src/sync/sync.go": &vfsgen۰CompressedFileInfo{
 			name:             "sync.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 2050,
+			modTime:          time.Date(2026, 10, 15, 22, 12, 33, 279116895, time.UTC),
+			uncompressedSize: 2278,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x55\x4d\x6f\xe3\x36\x10\x3d\x9b\xbf\x62\x60\x14\xa8\x94\xc8\x52\xd2\x2d\xb6\x40\xb0\x3e\x14\xd9\x62\x11\xa0\xdd\x05\x9a\x14\x3d\x04\x46\x43\x4b\x23\x93\x31\x45\xaa\x1c\xca\xaa\x1b\xe4\xbf\x2f\x86\x52\x1c\x3b\x89\xe3\x8b\x09\x72\xe6\xcd\x9b\x37\x1f\x2a\x0a\x38\x5d\x76\xda\x54\x70\x4f\x42\xb4\xb2\x5c\xcb\x15\x02\x6d\x6d\x29\x84\x6e\x5a\xe7\x03\x4c\x57\x3a\xa8\x6e\x99\x97\xae\x29\x56\xae\x55\xe8\xef\xe9\xf9\x70\x4f\x53\x21\x36\xd2\x03\x61\xf3\xb7\xd4\x01\x3d\xc1\x1c\x1a\xb9\xc6\xa4\x91\xed\xed\x49\xa7\x6d\xf8\xf0\xd3\xe2\x76\x51\x2a\x69\x61\xe9\x9c\x49\x85\x28\x0a\x36\xff\xb5\x77\x6b\xb4\x10\xbc\x2c\xd7\x04\x41\x21\xd8\xae\x59\xa2\x07\x57\x43\x3f\x42\xc9\xc1\x66\xb9\x05\xdf\xd9\xa0\x1b\xfc\xe7\x1a\x1b\x8f\x06\x25\x21\x24\x77\xa5\x82\x4f\x33\x08\xbe\xc3\xbb\x94\x51\x83\x92\x01\x94\xdc\x20\x58\x17\x60\x8b\x01\x64\xf9\x6f\xa7\x3d\x56\x11\x9f\xb0\x91\xad\x72\x9e\x5d\x3f\xcd\x4a\x75\x07\xda\xee\x03\x8f\xc6\x7f\x74\x01\xff\x4b\x73\x51\x14\x8c\x79\xa3\x34\x41\xeb\x71\x83\x36\x10\x48\xb0\xd8\x43\x29\x8d\x81\xe0\x8e\xf9\xf2\x53\xef\x9d\x5d\x99\xed\x13\x81\xc3\xf8\x8c\xab\x2d\x2c\x31\xf4\x88\x16\x92\x25\x96\xb2\x23\x7c\x2b\x49\x25\x09\xa4\xf1\x28\xab\x2d\x68\x5b\x7a\x6c\xd0\x86\x57\xf9\xf4\x4a\x9b\x88\x1a\x89\x29\x84\x16\x6d\xa5\xed\x2a\x32\xa5\xf7\xa8\x1e\xa8\xe5\xb1\x44\xbd\xc1\x0a\x6a\xef\x9a\x88\xc3\x65\xb3\x68\x22\xb4\xe5\xa8\x1d\x41\x85\x47\x68\xec\x34\xbb\x46\x04\x15\x42\x4b\x17\x45\xf1\x6e\xfb\x68\xa2\x0e\xa9\xf8\xe5\xc3\xc7\xfc\xa9\x8b\xc6\xb6\x78\xa3\x89\x86\xbf\x54\x88\xba\xb3\xe5\x1b\x09\x25\x04\xa3\x69\x0a\x0f\x62\x72\x24\xe3\x84\x32\xa8\xa5\x21\xcc\xe0\x3c\x15\x8f\x62\xe0\x7b\x28\x8a\x26\x30\x7a\x8d\x7b\xf7\x19\x2c\xbb\x00\xb5\xf3\xd0\x7a\x57\x6b\x13\xb5\x75\x36\xa0\xad\xb0\x82\xe8\x85\xc4\xe9\x0f\xe7\x3d\x2b\x4d\x51\x5e\xea\x5a\x1e\x27\xac\x32\x20\x07\xf7\x1d\x05\xe0\x8a\x47\xfd\x64\x83\xa0\x9b\xd6\x44\x51\x65\xd0\xce\x82\xa4\x37\x12\x8c\xf8\x37\xdf\x3e\x7f\xbb\x80\x2b\xbb\x41\x0a\x7a\x25\x03\x63\x68\xca\xe1\xaa\x06\x1d\x7e\x24\x68\x1d\x91\x5e\x1a\xe4\xa2\xef\x40\x33\x26\x4b\xba\x42\x0f\x95\x63\x56\xe4\x32\x70\x41\xa1\xef\x35\xf7\x1d\x36\x6e\x33\x00\x41\xe9\x1a\xf6\xc8\x8f\xa9\x3c\x8a\xf8\x24\x75\x06\x46\xd7\x2e\x4e\x76\x06\xb4\xd6\x6d\xed\x65\x83\x04\xda\x86\x58\x05\x5d\x43\x72\x42\x30\x7b\x2e\xed\x2d\x2d\x52\x98\xcf\xe1\x8c\x9f\x27\xa5\x82\x8b\xb1\xd6\x7b\x2b\x62\xc2\x7e\x11\x98\x6d\x26\xcf\xcb\xe5\x96\x16\x30\x07\xd9\x72\x7f\x27\x7b\x5b\xe5\xa1\x54\x8f\x19\x1c\xd8\xe5\x79\xce\x40\x8f\x80\x86\xf0\x5d\x9c\x83\xeb\x0c\x4a\x15\xfd\xc4\x64\xc2\x4b\x42\x44\xb7\x1d\x75\x98\xcd\xe1\x7c\xe0\x77\x70\xbd\x4b\x68\x52\xa1\xc1\x80\xc9\xee\x35\x03\x1a\xf1\x1e\xc5\xe4\x84\x66\x33\x6e\xba\x97\xe2\x8e\xe3\xbe\xaf\xab\x92\xb6\x72\x75\x7d\x5c\xda\x5d\x33\xfc\x15\xf7\xc4\x60\xad\x6b\xb0\x88\x15\x56\xc5\x53\x23\xe4\x1c\xf5\xf4\x54\x88\x49\xcf\x52\x1f\x24\x1b\xeb\x63\xd0\x26\xfd\x5e\x49\x3c\x86\xce\x5b\xa6\x2b\xc6\xf2\xf4\xb7\x67\x0b\x76\xe7\xd3\xf9\xc5\x42\xbc\x12\xb2\x7f\x13\xe8\x59\x89\xd1\x78\x90\x82\x71\x0f\xb4\x3b\x65\x49\x63\xac\x71\x9b\xbf\x52\xc8\xba\xa0\xeb\xed\xef\x9a\xc2\xa5\xc2\x72\x9d\x90\xfe\x1f\x81\x85\x6a\x83\x4f\xe1\xe1\xa5\x79\x29\xed\x75\xab\x6d\xa2\x07\xad\x58\xc1\xb8\x11\x62\x62\xc3\xf4\x8f\x93\x7f\xe9\xda\x2d\x7f\x70\xd8\x2d\x1f\xdd\xbf\x4a\xeb\x5e\xb4\xbf\x95\xcc\xa0\xc1\x24\x65\xc4\x8f\x3f\x33\x1a\x4f\x54\x80\x46\x1b\xa3\x09\x4b\x67\x2b\x98\xc3\xf9\x59\xfc\xed\x42\xdd\x53\xfe\xc5\xb8\xa5\x34\xf9\x17\x0c\xc9\xf4\xb3\x0c\x38\x4d\xf3\xaf\xd8\x27\x69\x7e\x29\x8d\x49\xa6\x2b\x0c\x37\xba\xe1\xdb\x2b\x06\x4e\x52\x38\xd9\xc7\x1c\x69\x5e\x3d\x0d\x32\x56\x7b\xdf\xac\x91\x64\x50\xde\xf5\x09\x01\x05\xaf\xed\x2a\xb6\xc6\x73\xdc\x21\xca\x0f\xd1\xe6\xcf\xc1\xed\x37\xef\x9d\x9f\xc6\x5a\x3c\x8a\xef\x01\x00\x00\xff\xff\x5e\x6c\xbf\x40\x02\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x55\x51\x6f\xdb\x36\x10\x7e\x16\x7f\xc5\xc1\x18\x50\x29\x91\xa5\x66\x1d\x3a\x20\x68\x1e\xd6\x6c\x2b\x02\x6c\x2b\xd0\xb4\xe8\x43\x60\x2c\x94\x74\xb2\x18\x53\xa4\xc6\xa3\xac\x79\x41\xfe\xfb\x70\x94\xec\xd8\x89\x93\xa7\x30\xe2\xdd\xc7\xbb\xef\xbb\xfb\x9c\xe7\x70\x5a\xf4\x4a\x57\x70\x47\x42\x74\xb2\x5c\xc9\x25\x02\x6d\x4c\x29\x84\x6a\x3b\xeb\x3c\xcc\x96\xca\x37\x7d\x91\x95\xb6\xcd\x97\xb6\x6b\xd0\xdd\xd1\xe3\xe1\x8e\x66\x42\xac\xa5\x03\xc2\xf6\xbb\x54\x1e\x1d\xc1\x05\xb4\x72\x85\x71\x2b\xbb\x9b\x93\x5e\x19\xff\xee\xc7\xc5\xcd\xa2\x6c\xa4\x81\xc2\x5a\x9d\x08\x91\xe7\x1c\xfe\xcb\x60\x57\x68\xc0\x3b\x59\xae\x08\x7c\x83\x60\xfa\xb6\x40\x07\xb6\x86\x61\x82\x92\x63\x4c\xb1\x01\xd7\x1b\xaf\x5a\xfc\xfb\x1a\x5b\x87\x1a\x25\x21\xc4\xb7\x65\x03\x1f\xe6\xe0\x5d\x8f\xb7\x09\xa3\xfa\x46\x7a\x68\xe4\x1a\xc1\x58\x0f\x1b\xf4\x20\xcb\x7f\x7a\xe5\xb0\x0a\xf8\x84\xad\xec\x1a\xeb\x38\xf5\xc3\xbc\x6c\x6e\x41\x99\x7d\xe0\x29\xf8\xcf\xde\xe3\xbf\x49\x26\xf2\x9c\x31\xbf\x36\x8a\xa0\x73\xb8\x46\xe3\x09\x24\x18\x1c\xa0\x94\x5a\x83\xb7\x2f\xe5\xf2\xd5\xe0\xac\x59\xea\xcd\xb6\x80\xc3\xf7\x19\x57\x19\x28\xd0\x0f\x88\x06\xe2\x02\x4b\xd9\x13\x1e\x6b\xb2\x91\x04\x52\x3b\x94\xd5\x06\x94\x29\x1d\xb6\x68\xfc\xb3\x7e\x86\x46\xe9\x80\x1a\x0a\x6b\x10\x3a\x34\x95\x32\xcb\x50\x29\xbd\x56\xea\x01\x5b\x0e\x4b\x54\x6b\xac\xa0\x76\xb6\x0d\x38\x2c\x9b\x41\x1d\xa0\x0d\xbf\xda\x13\x54\xf8\x42\x19\x3b\xce\xae\x11\xa1\xf1\xbe\xa3\xf3\x3c\x7f\x75\x7c\x14\x51\x8f\x94\xff\xfc\xee\x7d\xb6\x9d\xa2\x69\x2c\x8e\x0c\xd1\xf8\x27\x11\xa2\xee\x4d\x79\xa4\xa1\x98\x60\x0a\x4d\xe0\x5e\x44\x2f\x74\x1c\x53\x0a\xb5\xd4\x84\x29\x9c\x25\xe2\x41\x8c\xf5\x1e\x84\x80\x22\xd0\x6a\x85\x7b\xdf\x53\x28\x7a\x0f\xb5\x75\xd0\x39\x5b\x2b\x1d\xb8\xb5\xc6\xa3\xa9\xb0\x82\x90\x85\xc4\xed\x8f\xe7\xbd\x28\x45\x81\x5e\xea\x3b\x5e\x27\xac\x52\x20\x0b\x77\x3d\x79\x60\xc5\x03\x7f\xb2\x45\x50\x6d\xa7\x03\xa9\xd2\x2b\x6b\x40\xd2\x91\x06\x03\xfe\xd7\xcf\xbf\x7e\x3e\x87\x2b\xb3\x46\xf2\x6a\x29\x3d\x63\x28\xca\xe0\xaa\x06\xe5\xdf\x10\x74\x96\x48\x15\x1a\x59\xf4\x1d\x68\xca\xc5\x92\xaa\xd0\x41\x65\xb9\x2a\xb2\x29\x58\xdf\xa0\x1b\x14\xcf\x1d\xb6\x76\x3d\x02\x41\x69\x5b\xce\xc8\x5e\x62\x79\x22\x71\x4b\x75\x0a\x5a\xd5\x36\x6c\x76\x0a\xb4\x52\x5d\xed\x64\x8b\x04\xca\xf8\xa0\x82\xaa\x21\x3e\x21\x98\x3f\x4a\x7b\x43\x8b\x04\x2e\x2e\xe0\x2d\x5f\x47\x77\x94\x7d\xd2\xb6\x90\x3a\xbb\x94\x5a\xc7\xb3\x1f\xca\x06\xcb\xd5\xa5\x34\x1f\xb5\x2d\x57\xb3\x14\x66\xec\x05\x5c\x32\x93\x2f\x83\x37\x65\xa1\x86\x14\xbe\x7c\x0f\x07\xb0\x0e\xd8\x7b\x3e\x39\xdb\x77\xb3\x44\x44\x51\x9e\xc3\xc7\xbe\xae\xd1\x4d\x74\x07\x5f\x38\xb2\x5c\x95\x45\x32\x6f\x3c\x14\xfc\x16\xf0\xfd\xb8\x3d\xa3\xfd\x8c\x40\xae\x37\x74\x3e\xaa\x9a\x7d\x33\x21\x90\x37\xa1\x76\x0a\x4d\x45\xd0\xb2\x92\x2c\x70\x27\xdd\x6a\x5c\x19\xa9\x83\xf0\x4b\xeb\x6c\xef\x95\xc1\x74\x04\xe2\xac\x92\x4d\x70\x0c\xc1\x0a\x6c\xef\x59\x13\x36\xbc\x5d\x30\x65\x22\x8a\xca\x06\xce\xa7\x05\xd8\xf9\x66\x18\xd7\x88\xf9\x0c\x84\x33\x77\xd1\xa3\xe9\xde\xd0\x02\x2e\x40\x76\xbc\xf7\xf1\x9e\xdb\xde\x97\xcd\x43\x0a\x07\x71\x59\x96\x31\xd0\x03\xa0\x26\x7c\x15\xe7\xe0\x73\x0a\x65\x13\xf2\x44\x14\xb1\x79\x8a\x28\xda\x97\x14\xe6\x17\x70\x36\xd6\x77\xf0\x79\x27\x74\x54\xa1\x46\x8f\xf1\xee\x36\x05\x9a\xf0\x1e\x44\x74\x42\xf3\x39\x2f\xe3\xd3\xa1\x9b\x94\xda\x9f\xb7\x46\x9a\xca\xd6\xf5\xcb\x23\xb7\x5b\x92\x6f\x84\xbb\x68\x55\x83\x41\xac\xb0\xca\xb7\x0b\x92\xf1\xab\xa7\xa7\x42\x44\x03\xb3\x7d\xd0\x6c\x98\x5b\x8d\x26\x1e\xf6\x46\xd5\xa1\xef\x9d\xe1\x72\xc5\xa4\xd0\x70\xf3\x76\xc1\xe9\x7c\x3a\x3b\x5f\x88\x67\x44\x0e\x47\x81\x1e\x99\x98\x82\x47\x2a\x18\xf7\x80\xbb\x53\xa6\x54\x44\x8f\xbf\x72\xcf\x18\x32\xd6\xab\x7a\xf3\x87\x22\x7f\xc9\x6b\x13\x93\xfa\x0f\x81\x89\xea\xbc\x4b\xe0\xfe\x69\x78\x29\xcd\x75\xa7\x4c\xac\x46\xae\x98\xc1\xe0\x94\xa1\xb1\xd1\x15\x27\x47\xbc\xb4\xdd\x86\xe7\x92\xd3\xb2\x29\xfd\x2f\x69\xec\x13\x5b\x30\x92\x2b\x68\x31\x4e\x18\xf1\xfd\x4f\x7b\x68\xe1\xff\xf8\xd9\x7a\x6f\x33\x66\x49\xf6\xbb\xb6\xd2\xc7\xc9\xd6\x84\xaf\xb6\x66\x85\xd5\xde\xef\xf2\xf4\xa0\x6f\x9c\x1d\x62\x02\xf2\x4e\x99\x65\x90\xf9\x19\x74\x88\xf9\x32\xa6\xfd\xe6\x9c\x75\xb3\xc0\xeb\x83\xf8\x7f\x00\x92\x1b\x5b\x2c\xe6\x08\x00\x00"),
 		},
 		"/src/sync/waitgroup.go": &vfsgen۰CompressedFileInfo{
 			name:             "waitgroup.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 446,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xd0\x4d\x4e\xc3\x30\x10\x05\xe0\xb5\xe7\x14\x8f\x2e\x2a\x87\x0a\x5a\xe8\x0e\x35\x48\xac\x38\x02\x0b\xc4\xc2\x38\x6e\x62\x1a\x26\x51\x32\xa6\xaa\xaa\xdc\x1d\xd9\x04\x08\x3f\xcd\x2a\x7a\x1e\x7d\x7e\x9e\xe5\x12\x8b\xe7\xe0\xeb\x02\x2f\x3d\x51\x6b\xec\xce\x94\x0e\xfd\x81\x2d\x91\x1c\x5a\x87\x07\xe3\xe5\xbe\x6b\x42\x8b\x5e\xba\x60\x05\x47\x52\xb6\x09\x2c\xae\x83\x67\x21\x65\x2b\xa4\xcf\x56\x86\xc7\x99\xe3\x40\xa4\x7a\x31\xe2\xae\xf0\xb8\x7e\x0a\x9e\x65\x7d\x4d\x03\xd1\x36\xb0\x85\xde\x97\x38\xff\x62\x33\xdc\x15\x85\x2e\x5c\x2d\x26\x7a\x59\xf4\xf7\xe5\xe5\xe7\x15\x8b\x1c\xe9\x8c\x94\xdf\x62\x92\x6f\xb0\x8a\x93\xaa\x35\xec\xad\x9e\xc5\xc2\x37\x60\x57\x1a\xf1\x6f\xd3\xd2\xe3\xfc\x2c\x23\x35\xfc\x36\x6e\xb1\xc2\x7c\x9e\x92\x0a\x79\x0e\xf6\x75\x32\xc7\x00\xaf\x66\xe7\xf4\x8f\x67\xfd\xa7\xe4\xf9\x94\x39\xfb\x66\x6c\xdd\xf4\x4e\xa7\x38\x9b\xa8\xec\xeb\xa8\x9c\xda\x46\xfc\xd5\x69\x0b\x7f\xcb\x46\x75\x73\x91\xa0\x0f\xe2\x3d\x00\x00\xff\xff\x08\x4a\xda\xa3\xbe\x01\x00\x00"),
 		},
 		"/src/syscall": &vfsgen۰DirInfo{
 			name:    "syscall",
-			modTime: time.Date(2021, 6, 19, 20, 7, 25, 750000000, time.UTC),
+			modTime: time.Date(2026, 10, 15, 13, 49, 7, 481520075, time.UTC),
 		},
 		"/src/syscall/js": &vfsgen۰DirInfo{
 			name:    "js",
-			modTime: time.Date(2021, 6, 19, 21, 37, 39, 220000000, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/syscall/js/export_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "export_test.go",
-			modTime:          time.Date(2021, 6, 19, 20, 0, 23, 930000000, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 209,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x3c\xce\xb1\x4e\x03\x31\x10\x45\xd1\x7e\xbf\xe2\x75\x80\x80\x6c\x4f\x1b\xa4\x48\xb4\x20\xfa\x59\xfb\xe1\x4c\x70\x66\xac\xb1\x1d\x89\xbf\x47\x01\x29\xdd\xad\x8e\xee\xba\x3e\x6e\x53\x6b\xc6\xa9\x2f\x4b\x93\xf4\x2d\x85\x7f\xbd\xae\x78\xe5\x97\x1a\x33\x86\x43\x2e\xae\x19\x82\xe4\xe7\xa6\x95\x60\x84\x07\xd4\x30\x8e\x84\x87\x16\x35\xa9\xf8\x60\x1f\x07\x89\x4d\x0a\xf7\x5e\x2b\xd3\x50\xb7\xfb\x87\xab\xb5\x79\xfe\xd9\x61\x2f\x76\x37\x30\x3b\x51\xbc\x1d\x19\xa7\xfe\xd2\x62\x1a\x9f\x6f\x84\x1b\x74\x3c\xa1\xab\x25\x42\x07\x92\xcc\xce\x0e\x31\x4c\x9b\x9d\xf9\x6a\xe9\xb9\x79\x8c\xff\x87\xdd\x72\x91\xc0\xdb\xfb\xc1\xf1\x29\x75\x72\xf9\x0d\x00\x00\xff\xff\x1d\xb4\x64\x38\xd1\x00\x00\x00"),
 		},
 		"/src/syscall/js/js.go": &vfsgen۰CompressedFileInfo{
 			name:             "js.go",
-			modTime:          time.Date(2021, 6, 19, 21, 37, 39, 220000000, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 8555,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x39\x6d\x6f\xdc\x36\xd2\x9f\x57\xbf\x62\x22\x3c\x68\xa4\x46\xd5\xf6\xed\xe9\x15\x8e\xd7\x40\xdb\x4b\x83\x04\xd7\xe4\x70\x4e\x7b\x1f\x8c\xa0\xa1\xa5\xd1\x2e\x6d\x2d\xb5\x47\x52\xbb\xeb\x73\xfd\xdf\x0f\x33\xa4\x24\x6a\x5f\x6c\xe7\x2e\x87\x0b\x10\x2f\x35\x9c\x37\xce\x0c\x87\xc3\xe1\x74\x3a\x6f\x4e\x2e\x5b\x59\x97\x70\x65\xa2\xe9\x14\x9e\xf5\x1f\xd1\x4a\x14\xd7\x62\x8e\x3c\x96\xcb\x55\xa3\x2d\x24\xd1\x24\xd6\x58\xd5\x58\xd8\x38\x9a\xc4\xad\x32\xa2\xc2\x38\x8a\x26\xf1\x5c\xda\x45\x7b\x99\x17\xcd\x72\x3a\x6f\x56\x0b\xd4\x57\x66\x18\x5c\x99\x38\x4a\xa3\xc8\xde\xac\x10\xde\xd1\x1f\xa9\x6c\x14\x15\x8d\x32\xcc\x92\x40\xbf\xaa\x12\x2b\xa9\xb0\x74\x08\x33\x90\x8d\x15\x6e\xea\x4d\x5b\xd7\x6e\xf4\x63\xd3\xd4\x28\x54\x07\x5e\x5e\xa2\x76\xe3\x73\xab\xa5\x9a\xfb\xf1\xcd\xf2\xb2\xf1\x04\x6f\x2f\xaf\xb0\xb0\x6e\xfc\x73\xab\x0a\x2b\x1b\x45\x9a\x54\xad\x2a\x20\xb1\x2c\x2b\x05\x47\x9d\xa4\x60\x78\x00\xb7\xd1\xc4\x6c\xa4\x2d\x16\x60\x69\x5c\x08\xe3\xd4\xee\x75\x3c\x89\x26\x13\x8d\xb6\xd5\x0a\xe2\xb6\x03\xc6\x01\x26\xa9\x1c\x22\xa9\xb6\xae\xc3\x79\xbf\x90\x10\xe5\xd2\x81\xc6\x5c\x68\x85\x63\x3e\x04\x09\x71\x9c\xee\x21\x8e\x5b\xc4\x08\x87\x2d\x32\xc2\x61\x48\x88\xe3\x2c\x15\xe2\x34\x0c\x09\x71\x3a\x0b\x86\x58\x95\x87\xc5\xd1\xa4\xc4\x4a\xb4\x35\xf3\x58\x09\x25\x8b\x24\xbe\x14\x25\x90\xd3\xe3\x34\x9a\xdc\x45\x77\xbb\x76\x97\xc6\x49\x4d\x52\xa0\xd5\x93\xad\x3d\x5b\x0b\xb3\x59\xa0\x16\xfc\xf1\xc7\x00\xea\xfd\xd8\xf1\x7b\x59\x37\x97\xa2\x4e\x52\xf8\x4d\xd4\x2d\x06\x5c\xdc\x0a\xde\x35\x0c\x4f\xae\x4c\xee\x30\xd3\x9e\x92\xdc\xf4\x20\x9d\x92\x01\x45\x1f\x02\x8f\x11\xd7\x23\x33\x3d\x47\x3f\x29\x4f\x61\xd6\x16\x1c\x5a\x8c\x3a\x18\xa6\xe2\xf9\x14\xfe\x86\x35\x0a\x83\x49\x4a\x38\xbd\xde\xf9\x39\xda\x24\xfe\x3f\xdc\xd2\x56\xc4\xb2\xb3\x83\x89\x33\x18\x70\x5e\x1e\xc1\x49\xf3\x57\xca\x26\xe9\x17\x5f\xa5\xd1\xa4\xca\x9d\xea\x33\x6f\x80\x5e\x01\x42\x7f\x5b\x25\x95\x02\xfa\x4c\xec\x42\x1a\xb7\xca\x0c\x84\x9e\x1b\xb8\x78\xcf\x5f\x29\xed\x5f\xd4\x95\x28\xf0\xf6\x2e\x75\x6b\xba\x8d\x26\xd3\x29\xbc\xd8\x4a\x63\x51\x15\x08\x4d\x05\x02\x36\x5a\xac\x56\x58\x42\x17\x24\xb0\x44\xa1\x0c\xd8\x85\xb0\x20\x14\xe0\xd6\xa2\x56\xa2\x06\x5c\xa3\xb2\xb0\x14\x37\x20\x36\xe2\x1a\x15\xd8\x05\x32\xbf\x95\x6e\xe6\x5a\x2c\x41\xa8\x12\x36\x08\x0a\xb1\x04\xdb\x80\x69\x57\x2b\x8d\xc6\x40\x89\xa2\xac\x9b\xe2\x1a\x4a\xb4\xc8\x22\xf2\x4f\x6c\xb0\x67\x64\x30\xef\x60\x9a\xbc\x8d\x26\xce\x6b\x27\xfb\xfe\xfe\x45\x5c\x73\x74\x26\x83\xf5\x3e\xbf\x32\xb9\x8b\xe1\xde\x84\x03\x68\x64\x47\xb2\xe0\x64\xb2\x66\xa4\x93\x19\x2c\xc5\x35\x26\xde\xde\x19\xd4\xa8\x12\x9a\x49\x53\x42\xaa\x1a\x0d\x32\x03\x41\x78\x5a\xa8\x39\x3a\xd6\xcc\xc0\x71\xb8\x90\xef\x61\xb6\xa3\xa0\x60\xda\x3b\xfa\xe3\xd7\x53\xa9\x64\x8c\x42\x2a\xa7\x19\x30\x0b\xc2\xbe\x4b\xd3\xcc\xef\x5c\x8e\xde\x17\x5a\x37\xfa\x78\xf8\x7a\x84\xd4\xfd\x8c\xf2\x69\x97\x2e\x5e\x8b\xb5\x38\x2f\xb4\x5c\x59\x40\x42\x3a\x81\x18\x9e\x01\x3a\x2f\x2c\xd1\x18\x31\xc7\x38\xcd\xbb\x8c\xdc\x4b\x76\x01\x3b\x48\x5e\x07\x96\x8d\x38\x54\xa4\x92\x16\x4b\xd0\x48\x91\x81\xca\x1a\xd8\x2c\xd0\x2e\x50\x7b\x5a\x69\x40\x35\xea\x8b\x7f\xa2\x6e\x60\x4d\x90\x1c\xac\x6e\x31\x24\xb0\x0b\x74\x53\x0e\xd9\xc2\xd3\x3e\xb9\x3f\xcd\xa3\x89\x97\x40\xa9\x2a\x8a\x26\xbf\xc3\xc5\x97\xef\xd9\xd1\x29\x4c\xa7\xd0\xaa\xa2\x59\xae\x84\x16\x97\x35\x3e\xa7\x18\x25\x07\x52\xca\x22\x3e\x34\x25\xeb\xc1\x52\x63\xab\x37\x97\x57\x10\x06\x45\x9f\x57\x64\x45\x98\xc4\x24\x4c\x26\xec\x67\x6f\x4f\x46\xbd\xbd\x23\x1f\x8d\x41\x6b\x0e\xcf\xcc\x5b\xe5\x84\x97\xca\x7e\x5c\x0b\x4d\x47\xae\x2c\x61\xf8\x17\x98\x72\x22\x95\xb1\x42\x15\xf8\xb6\xda\x99\x98\xa3\x65\xd6\x7c\x3c\x07\x13\xdd\x69\x4a\x92\x5c\xc2\x92\xd5\xb0\xbd\xe0\xc9\x0c\x94\xe4\xd4\x4e\x32\x67\xc1\xc6\xfb\x49\xd4\x75\x12\xe3\x5a\xd4\x71\x06\x71\xd2\xe5\x88\x64\x9b\xc2\x2d\xf8\xc5\x6c\x9f\xc3\x5d\x4a\xa7\x47\xa8\xd7\xa3\x98\x64\x70\x13\xf2\x81\x8e\xbe\xa9\xe0\xa6\x67\x3a\x5a\xd3\x51\xb6\x1f\xc6\xba\x45\x00\xb2\x82\x84\xc2\xb2\xa9\x08\x32\x9b\xcd\xc2\x32\xc0\xa1\x40\x27\xfa\xcb\xe7\x14\x1e\xa3\xf2\x21\x02\xb8\xf3\x5c\xb6\x4c\x4d\xe5\xc1\x0e\xd9\x57\x3d\x19\x97\x3f\x03\xc5\x8e\xdc\xae\x6c\xd8\x21\xff\xba\x27\xef\x6a\xa6\xa3\x1c\x7c\x4d\xb1\xc3\xe0\x9b\x40\x3e\xd7\x59\x47\xe9\x7d\xbd\xb1\x43\xff\x6d\x4f\xef\x6b\xb3\xe3\xf4\xae\x16\xd9\xa1\xff\xff\x81\xde\xd5\x73\x47\xe9\xfb\x0a\x64\x87\xc3\x9f\x7a\x0e\x7d\xc5\xe0\x78\xf8\xf9\xef\xfa\x79\x1f\xc9\x77\xe9\x87\x51\x9d\xc2\xa1\xf1\xb6\x4a\xb6\xe3\xe3\xae\xdf\x9e\xbe\x46\xdc\x52\x1a\xde\xe6\xac\x56\xda\xd7\x8b\x7f\xe7\xa3\x2f\x2c\xde\xb6\xf9\xeb\x73\xb7\xe1\x53\x8f\xe3\xce\x91\x00\xc3\xc3\x49\xdf\x11\xa1\xcb\xb3\x6e\x52\xc9\xb0\x92\xf3\x07\xb8\x9b\xa2\x58\xa0\x2d\x6f\xf9\xcf\xf7\xfc\xf7\xab\xef\xf8\xe7\x9b\xaf\xf9\xe7\xbb\x6f\x33\x68\x19\xa1\x75\x18\xad\x47\x69\x3d\x4e\xeb\x91\xaa\xba\x11\x0c\xe0\x01\x93\x71\xad\x9f\xff\xb5\x61\x63\x64\x3e\xb7\x67\xb0\x14\xab\x0b\x37\x7e\x1f\x98\x29\x83\x8b\xf0\x33\xd0\x78\x9c\xfb\x64\x99\xbf\x52\xeb\xe6\x1a\x93\x2d\x9d\x6d\x7b\x25\xe4\x07\xa9\xd6\xa2\x96\x25\x9d\x70\x27\xf0\x01\x9e\x81\xbf\x7e\xe4\xec\x38\x8a\x82\xfe\xb0\x18\x17\x99\x6b\x08\x6b\x15\xc5\x05\xe2\x90\xb6\x7c\x9e\x7a\xb2\xce\x7d\x56\x0f\x92\x6a\x98\x6c\xc3\xcc\xba\xce\xd7\x07\xd8\xd3\xfe\x0a\x0a\x58\x59\xc1\x9a\xd3\xc9\xc9\x0c\xd6\xac\x64\x92\x3e\xf7\xa0\x27\xb3\x70\x47\xb2\x48\xb7\xca\xcf\x98\x17\x9f\x9a\xb7\x31\x8f\x73\x42\x8a\x33\x47\x78\x97\x8e\xd5\x18\x56\x94\x3b\xe9\xa4\xd6\x74\x0a\x45\xa3\xd6\xa8\xed\x0f\x54\x0c\xf8\xb1\x21\xc3\xb5\x4b\x3e\xde\xa4\xb2\xfe\xe8\x33\x40\x25\xc4\x4b\xbe\x9e\xbd\x3e\x1f\x50\x72\xb7\xb8\x80\x0f\x57\x1d\x90\xe7\xf9\x68\x0b\x8c\x7c\x4b\xeb\x50\xb8\xf9\xc1\x17\x2e\xa3\x39\x3a\x9a\x48\xd4\xef\x5c\xfd\x1c\xa8\x57\xd6\x04\xeb\x36\x9a\xd0\x73\xca\xca\x1d\xb3\x19\xd0\x16\x52\x65\xe2\x01\xd9\x68\xe9\x23\x9b\x78\x8c\x03\xee\xe1\x4c\xbe\xec\xa3\xf5\xe0\x72\xc2\x03\xf7\x21\xe7\xf9\xf0\xf9\xec\xb3\x31\xb8\x4b\x31\xf7\x3b\x95\x94\xd9\x71\xaa\xac\xa8\xc8\x5d\x0d\x52\xa9\x12\x5a\xa6\xbd\xf0\x7e\xf2\xb8\xa0\xf8\xca\x9c\xc0\x20\xe0\x84\x69\x50\xdb\x1b\xae\xad\x96\xf0\x0c\xe2\xae\xa0\x11\x7d\x29\x9e\xc1\xbc\xb1\x8c\xd0\x49\x18\xef\xa3\xc3\xdb\x75\x14\x7b\xce\xb4\xd9\x5e\xb8\xe4\x79\x9e\xd2\xff\xf4\x80\x3b\x7e\xa6\x74\x92\xa4\x5d\x5a\x79\xa4\xd1\xdd\x11\x74\xbf\x6d\x99\xf3\x23\x76\x8c\xd7\xe0\x80\x6e\x64\xf9\x95\x8f\x94\x07\x83\xe2\x09\xc3\xf2\xe0\x0a\x7b\xaf\x76\x2f\xf1\x88\x6e\xf7\xd8\x97\xf5\x39\x68\xc5\x57\xaa\xc4\x6d\x22\x69\x47\x7f\x6a\x45\x99\xf5\x47\xab\xea\x15\x3a\xa2\x2c\x09\x95\xca\x7e\x42\x67\xbf\x52\x8f\x71\x35\x4b\x3e\xa8\x51\x57\x4b\x26\xb6\x83\xed\x34\x20\x86\x72\xb3\x3b\x9f\x42\xce\x19\xd8\x30\x13\x05\x59\x78\x4f\x12\xd3\xfe\xc7\x59\xe7\x71\xe9\xc5\x49\xfb\x37\x9c\xc7\x4a\x7e\xcc\x36\xee\x2b\x99\xbd\x26\xc8\xa1\x23\xf2\x2f\xa8\xe6\x76\x31\x04\xc1\x21\x5f\x75\x38\x07\xc8\xdf\xe0\xe6\x01\x0b\x96\x58\xa1\x06\x7f\x19\x23\x13\xa1\xd6\x7c\xd8\x60\xd1\xac\x51\x27\x7c\x81\xa8\xe8\xc6\xc9\x37\x32\x7f\x1f\xf1\x7a\x44\xee\x52\xfc\x51\x5e\xa0\xca\xb1\x58\x60\x71\x0d\x0b\xd4\x48\xd7\x3d\xb1\x6e\x64\x09\x24\x6d\x81\xa2\x04\xa9\xc0\xb4\x45\x81\xc6\x00\x95\x66\x24\xed\xa8\xdb\xde\xe0\x26\xf4\x59\xa7\xcd\x95\x79\xa1\x75\x06\xcd\x35\x69\x84\x5a\xe7\x09\x95\x2f\xee\x86\xfd\x9c\xc0\xb7\x03\x57\xc7\x6f\xb7\x21\xf1\x42\xeb\xee\x52\xd9\x33\x76\xf8\xa8\x35\x45\x47\x92\x3e\x26\x3e\xc8\xfe\x1f\x13\x1c\xe7\x41\x1e\xcd\x60\xa7\x7a\xfe\x54\x79\xea\x7c\x2f\xa1\x8e\x74\x66\x1d\xc6\x47\xd3\x36\xbd\xf8\xf2\xfd\x11\x7d\x83\x84\xfa\xdf\xd4\xf8\x50\x72\xdd\x55\xdb\xab\x72\x4c\xf7\xe9\xd4\xb7\xab\xfd\x35\x26\xec\x5a\xac\x41\x18\x10\xde\xf2\x79\x80\x2a\x19\xbc\xc2\x42\x8a\x1a\xdc\x55\x01\x0b\xd1\x1a\x6e\xd3\xbd\x6c\x9e\x9a\x0e\x71\x89\x76\xd1\x94\x4e\xb4\xe2\x76\x1a\xfc\xaa\x6a\x79\x8d\x2c\xa5\xe1\x76\xca\x1c\xad\x45\x6d\x32\xe2\x2f\x2d\x94\x0d\xba\xda\x82\x17\x4e\x17\xb4\xf5\x53\xe3\xbb\xfc\x6e\x62\xb8\x04\xe6\x9c\x7a\x51\x94\x19\x51\x76\x0b\xe8\x34\x26\x65\x48\x4c\xd5\xe8\x25\xc4\xa7\xef\xce\x62\x12\xd1\x68\x1a\x9f\xc0\x6f\x67\x31\x6c\x78\xb7\xbd\x23\xc6\x24\x84\x3b\x43\x42\x95\xf0\x9b\x5f\x61\x67\x18\xdf\xd1\x11\xbc\x59\x1b\xa7\x91\xeb\xf9\xec\xf9\xfe\x68\xeb\xbf\xf3\xf3\xe8\x05\x60\xaf\xdb\x3e\xf6\x5e\xd7\xb5\x7a\xe0\xc9\xe0\xb4\x6f\x16\x9c\xdd\xf7\x68\x70\xaa\xda\xba\x3e\x7b\xe0\xd9\xe0\xd4\x37\x00\x5c\x23\xed\xa0\x3a\x54\x00\x9e\xdd\xff\xae\x70\xea\x9a\x00\x1f\xc3\x64\xff\x51\xe1\xd4\xdd\xe4\xcf\xee\x7f\x56\x38\x75\x99\xe6\xec\xa1\x87\x85\xd3\xae\x52\x3d\xfb\x98\xa7\x85\xde\xb1\xef\x74\x6b\x17\x37\xfb\x2f\x0b\x47\x6e\x4f\xbb\xd4\xce\xf5\x1c\xc5\x03\x2d\x43\xc3\x9e\xd1\xa1\xda\xc0\x97\x1d\x07\xab\x01\xe3\x1f\x1c\xf6\x74\xf2\xf2\x66\xb3\xa1\xe3\x73\x88\x3c\x7c\x7d\xd8\xe1\xd1\xdf\x64\x0f\xcb\x15\x6f\xf6\x49\x76\xdb\x5d\x92\xd0\xe2\x9d\x5b\xd6\xf8\x86\xf9\x67\xac\xd1\x22\x94\xfc\xe3\x52\x4f\xd0\xd1\xed\xef\x1d\x2b\xde\x74\x2e\x27\x71\x1e\x7a\xe5\xd3\x83\xe1\xfc\x30\xdc\x46\x02\x62\x17\x16\x7b\x1b\xd4\x49\x0c\xea\xf2\x4f\x95\x8e\x1d\xe3\xfb\x92\x71\x27\xfa\x90\x2b\x5f\xfc\xa3\x15\x75\xb2\x39\x52\x3d\x86\x6c\xc8\xa9\x9b\xe0\x7b\xdc\xd2\xde\xed\xa8\xff\xe2\x12\xb0\x09\xde\x33\x01\x38\x28\xc2\x36\xfb\xe7\x03\xed\x7d\xcd\x76\x73\x63\x0a\x51\xd7\x53\xba\x1f\xd2\x80\xbc\xe2\xda\xed\x5e\x0c\xdd\x0c\x1b\xe5\x61\xa3\x3b\x60\xaf\xa5\xef\x63\x0d\x47\x22\x09\xd8\x29\xff\x7c\x70\xfc\xd4\xac\x6e\x7e\xbc\xb1\x68\xde\x35\x2f\x1b\x28\x9a\x95\x44\x03\x97\x04\x80\x4a\x37\x4b\x8e\x96\x5f\xa5\xb2\xdf\xff\xa0\xb5\xb8\x01\xa3\x0b\x2a\x9c\x4a\x63\xbb\x10\x09\x4f\x34\x97\x90\x48\x63\xc7\x81\xd9\x95\x19\x6c\x16\xb2\x58\xc0\x46\xd6\x35\x5c\xba\x53\x69\x29\x95\x5c\xb6\xcb\xee\xf4\xa8\xb9\x90\x34\xf4\x49\x12\xe8\x78\xe8\x44\x8c\x15\x1c\x02\x92\xf0\xba\x90\x54\x81\x8a\x3e\x18\x47\x64\x49\x69\x2c\x5c\xbc\x27\xa5\x32\x26\x1c\xba\x4c\xfc\x2e\x51\xa3\xa2\xb0\x34\xba\xc8\xd7\x43\x51\x4b\x21\x5b\xfa\xa9\x1a\x15\x31\x49\x9f\x3b\xc8\x29\x30\x0d\x37\x43\x68\x30\x63\x30\x47\x63\xd1\xac\x6e\x08\x35\xf3\xec\x5e\x75\x3e\x48\xd2\x3c\x71\x3a\xa4\x43\x05\x47\xd4\xfb\x9e\x78\x7d\x7e\xc0\x13\xde\xf4\x3b\x0e\xf9\xdf\x78\xe2\xf5\x79\xe0\x09\x32\xee\xe3\x3c\xf1\xfa\x9c\x3d\xe1\xdf\xc7\x88\xbf\x37\x48\xe7\x89\xd2\x76\xb5\x33\x09\x3d\x6c\x3c\xd7\x03\xf4\xa5\xb4\x3f\x58\xc2\x4d\x33\x92\x77\x02\xb8\x5d\x61\x61\x91\x97\x41\xf6\xbb\xc4\xb1\x96\xf1\xe8\xc6\xe5\xbc\xe7\x9c\x47\xfb\xe9\x5f\x01\x00\x00\xff\xff\xbd\xa2\xb9\xfd\x6b\x21\x00\x00"),
 		},
 		"/src/syscall/js/js_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "js_test.go",
-			modTime:          time.Date(2021, 6, 19, 20, 0, 23, 930000000, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 419,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x90\x31\x6f\xfa\x40\x0c\x47\x67\xee\x53\xfc\x94\x09\xfe\x7f\xe0\x80\x6e\x15\x1b\x03\x6a\x57\xd8\x2b\x27\x98\xf4\x20\xf1\x9d\xce\x3e\x24\x54\xf5\xbb\x57\x69\x2b\x75\x68\x33\x5a\xcf\xcf\x96\x9e\xf7\xff\xeb\x12\xba\x13\x2e\xea\x5c\xa2\xe6\x4a\x2d\xe3\xa2\x2f\xc6\x6a\xce\x85\x3e\xc5\x6c\xa8\x86\x29\x48\x5b\x39\xe7\x7d\x1b\xd3\x2b\xe7\x8b\x3e\xa6\x5c\x84\x17\x31\x87\x36\x08\x75\xee\x5c\xa4\xc1\x91\xd5\x9e\xc4\x76\x51\x6e\x9c\x35\x44\x99\x1a\xfe\x7d\xdb\xcb\xe3\x0c\x6f\x6e\xe2\x3d\x0e\xd4\x33\x48\x51\x92\x5a\x66\xea\xe7\xa8\x8b\x21\x4a\x77\xc7\xb0\x8b\x86\x94\x15\x94\x52\x8e\x29\x07\x32\xc6\x39\x66\x10\x1e\x36\x8b\x3a\x18\x58\x6e\x21\x47\xe9\x59\x6c\xe9\x26\xf6\xfb\xe5\x1c\xab\xd9\x08\x58\x8f\x81\xc5\x28\x59\x6f\xb7\x9b\xd5\xb8\xf6\x45\xdf\xdd\x4f\x80\x3d\xe5\x9a\x5a\xde\xc5\xae\xe3\xc6\xfe\x8c\x60\xcb\xc3\x35\xa4\x69\xb5\xdf\x21\x28\x24\x1a\xb4\xa4\xa1\x35\x9f\x50\xdf\xb1\xff\x6c\xfc\x7c\xa8\x86\xc3\x1f\x01\x00\x00\xff\xff\x50\xa3\x99\xad\xa3\x01\x00\x00"),
 		},
+		"/src/syscall/node_linux.go": &vfsgen۰CompressedFileInfo{
+			name:             "node_linux.go",
+			modTime:          time.Date(2026, 10, 15, 13, 49, 7, 481261476, time.UTC),
+			uncompressedSize: 13696,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x5b\xff\x6f\xdb\x38\xb2\xff\xd9\xfa\x2b\xa6\xfe\x21\x6b\x6d\x54\x27\x6e\x73\xbd\x7d\xd9\x75\x81\x34\x76\xba\x7e\x4d\xec\x22\x4e\xb7\x28\x16\x7d\x01\x2d\x51\x31\x1b\x89\xf4\x23\x29\xa7\xbe\x34\xff\xfb\x03\xbf\x49\xb4\x2c\x27\xee\xde\xf5\x01\x7b\x38\xa0\x31\xc5\xf9\x70\x38\xdf\x38\x43\xce\x1e\x1c\xc0\xfe\xac\x20\x59\x02\x5f\x44\xf4\xec\x86\x2d\xe6\x98\x7f\x11\xd7\x02\xd1\x64\xc6\xbe\x5e\xa7\xe2\x9a\x32\x8a\x83\x60\x81\xe2\x5b\x74\x83\x41\xac\x44\x8c\xb2\x2c\x08\x48\xbe\x60\x5c\x42\x27\x68\xb5\x0b\x2a\x50\x8a\xdb\x41\xd0\x6a\xdf\x10\x39\x2f\x66\xdd\x98\xe5\x07\x0e\xab\xfa\xe3\x8b\x68\x07\x61\x10\x1c\x1c\xc0\xc7\x39\xa6\x20\xe7\x1a\x4e\xe2\x1c\x14\xa4\x80\x9c\x25\x45\x86\x81\x08\xa0\x4c\x02\xa1\x42\xa2\x2c\xc3\x49\xd4\x34\x53\x48\x85\x13\xb3\x3c\x67\x34\x5b\x41\x21\x70\x02\xb3\x95\x9e\xc9\x04\x38\x6e\x11\xc7\x40\xf2\x45\x86\x73\x4c\x25\x4e\x80\x51\x90\x6c\x01\x2c\xb5\x90\x34\x9e\x73\x46\x59\x21\x14\xd8\xc9\xfb\x91\x70\x9f\xc6\x2c\xc1\xdd\x2f\x02\xda\xa9\x68\x03\xa2\x09\xb4\x17\x9c\xc5\x58\x88\xb6\xe5\x52\x74\xe1\x6a\x8e\x21\x25\x19\x86\x04\x8b\x98\x93\x85\x64\x5c\xa8\x15\x15\x16\xc7\x28\x03\x46\xb1\x88\x40\x30\x85\xb8\x82\x18\x51\x98\x61\xc8\xc9\x57\x9c\xc0\x1d\x91\x73\xc3\x2c\xc5\xa2\xe4\xde\xad\x4a\xa4\xc0\x59\xda\xd5\xa2\xa2\x2c\xc1\x67\x6a\x95\x39\xcb\x12\x61\xf8\x96\x48\x62\xc5\x29\xaa\xaf\x0f\x6c\x81\xa9\x83\x57\x7f\x23\x09\x72\x8e\xb4\xac\x1c\xb8\xde\x66\xc2\xe8\x4f\x12\x6e\x31\x5e\x80\xe4\x28\xbe\x05\x96\x76\x03\xb9\x5a\xe0\x6a\x3d\x21\x79\x11\x4b\xb8\x0f\x5a\x0b\x24\xe7\xa0\xfe\xf7\xf3\x17\xd1\x9d\xcc\xbe\xe0\x58\x82\x92\xd7\x4c\xb0\xac\x90\x18\xf4\x77\x2b\x38\xcd\x10\x12\x80\xe0\x4d\x91\xa6\x98\x77\x83\x16\x4b\x53\x81\x25\x00\x10\x2a\x5f\x1d\x69\x20\x45\xae\x17\x31\xdf\x22\xb8\x9b\x93\x78\x5e\xb2\x18\x23\xc5\x9d\xc0\xf8\xb6\x1b\xb4\xd4\x3f\x68\x96\x61\x98\x31\x96\x05\x2d\xb4\x58\x60\x9a\x00\xd8\x9f\x98\x4a\x4e\xb0\xa8\xf3\x36\x20\x1c\xc7\x92\xf1\x15\xd8\x09\x91\x52\x89\x31\x00\xcd\x25\x17\x12\x6e\xb0\x4c\x30\x95\xe2\xd5\x91\x36\xab\x6e\xd0\xa2\xf8\xab\xd4\xfc\x11\x6a\xfe\x35\xac\x8e\x68\x82\xbf\xba\x1d\xea\x39\xc9\x1a\xfe\x0a\x24\x03\x8e\x65\xc1\x69\x37\x78\x08\x82\x25\xe2\xa5\x1c\x05\xf4\x21\x47\x8b\x3f\x0b\x42\xe5\x42\xf2\xcf\x3f\xbb\x0f\xf7\xde\xc4\x0b\x63\x53\x76\xaa\x90\x9c\xd0\x9b\xcf\xd5\x8e\xd4\x54\x6b\x0a\x66\xa6\x5d\x4c\xac\xd9\xaa\x75\x9f\xd2\xb4\x6e\xc8\x12\x53\xa0\x28\xc7\x11\x30\x0e\x94\x64\x40\x52\x20\xf2\x27\x61\xc0\x24\xa0\x25\x22\x99\x92\x6d\x37\x48\x0b\x1a\x7b\x0b\x74\x14\x19\x18\x46\x42\xe8\x58\xe8\x8a\xa3\x50\xd9\x05\x49\x21\x8f\x80\xdd\xc2\x71\xdf\xdf\xc5\x9f\x8a\xf6\xf3\xaf\xea\xc3\x7d\xd0\x6a\x19\x56\x21\x0f\x5a\x0f\x41\x2b\xc1\x29\xe6\xa0\x16\xeb\x68\x08\x85\xc1\x71\xcc\x96\x98\x77\x42\x78\xd6\xd7\x5c\xaa\xf1\x96\x5d\x52\x8f\x04\x2d\x45\xdb\xda\x58\x43\xc9\x4b\xff\x0e\x5a\x0f\x9d\x30\x68\x71\xfc\xbf\x05\xe1\x58\xf1\xf3\x45\x74\xdf\x66\x6c\x86\xb2\xee\x5b\x2c\x3b\x6d\xfb\xa5\x1d\x06\x66\x45\x33\xaf\xaf\xe7\x7d\xa0\x09\x4e\x89\x72\x1b\x8f\x5d\xbd\xea\x43\xe0\x7e\x5a\x8a\xee\x88\x2e\xd9\xad\x91\x4e\x18\x54\x5a\x99\x9a\xa8\x08\x0b\xcc\x53\xc6\x73\x51\x8f\x58\xca\xc9\x16\x50\x08\x42\x6f\xd6\xfc\xb0\x0b\x23\xe9\x74\xa9\xb0\x52\x94\x09\xac\xb4\x54\xa7\xb7\x31\x51\x14\x0b\x15\x75\x55\x4c\x64\x5c\xcd\x5b\x73\x6a\x15\xed\x28\xd3\xce\xde\xa4\x59\xcb\x64\x47\xf1\x12\x01\xea\x45\x80\x5e\x44\x80\x5e\x46\x80\x8e\x22\x40\xff\x88\x00\xbd\x02\x6b\xa6\x21\x74\x78\xcf\xfd\x88\x00\x73\x0e\x43\xce\x29\xd3\xea\x56\x9e\xa7\xb5\x97\x8a\x75\xd5\x77\x54\xb8\x34\x22\x4e\x85\x92\xae\xd3\xa6\x95\xe2\x61\xa4\xfe\xaf\x37\xd9\x6c\x0d\x5a\x75\xa5\x3d\x18\xf3\xc0\x3e\x90\x45\xb2\x06\xf1\x45\x0c\x39\x8f\x80\x88\xff\x9e\x0e\x39\x57\xb4\xb8\xdb\x51\x46\x3a\xe4\x9c\x71\x4b\xff\xcc\x7d\xd6\xf4\x0b\x44\x49\xdc\xc1\xa1\x45\xf0\xe2\x22\xc7\x4a\xb4\xc2\xfa\xf8\x0d\x52\xe7\x05\x56\x7b\x56\x8e\x9f\x22\x92\xe1\x64\xed\x10\xea\xaa\x7d\xf5\xb4\x6c\xb4\x54\xfa\x4e\x5c\x9d\x9c\xd0\x42\x4c\x28\x0e\x23\x18\x8e\x26\x11\x48\x5e\x60\xbb\x17\x8d\xa7\xcd\x73\xc8\xb9\x31\x4d\x3d\xd6\x0e\x7f\x35\x1f\xbb\x23\x2a\x3b\x21\xfc\x06\x87\x86\x5f\x25\xf9\xbe\x91\x7d\xe7\xb9\x37\xc3\x6e\xc0\x98\xbd\xe1\x02\x8c\x2a\x4e\x95\x8e\x53\x11\xc1\x63\x7a\x36\x4a\xd2\x44\x7d\x18\x8e\x27\xd3\x4f\xd3\xc7\x14\x65\xe7\x3e\xeb\x5b\xb6\x78\xaf\x69\xbb\x6b\x0e\xe3\x24\xa3\x37\x5f\x79\xca\xe9\xae\x6e\xd2\x10\xd9\x52\xed\x2f\xe6\x78\x35\xe1\xcf\x72\xbe\x83\xc3\x78\x7e\x60\x05\xe4\x45\xb3\xc7\x65\xe5\xf9\x44\xe9\x10\x5a\x21\xda\x66\xc5\x1d\x91\xf1\xdc\x70\x7e\x1f\xb4\x62\x24\x30\x4c\x3f\x4d\xaf\x2f\x87\x27\x83\x63\x2f\x9e\xb0\x04\x5f\x62\x94\x68\xcd\xf8\xeb\x3c\xef\x85\x1e\xd5\x7b\x45\xf6\xea\x68\x17\x42\x7d\x9a\x76\xd0\x51\xe8\xd3\x7f\xbc\x1c\x5d\x0d\x6b\xd4\x1f\x39\x91\xf8\xa9\x75\x35\xe1\xc6\xc2\xcd\xa4\x8d\x2b\x4f\xde\x0f\xc7\x27\x57\x8a\x5e\xa5\x03\xc6\x20\x6d\x70\x78\x8f\xe4\xbc\xa3\xfe\x18\x10\x9e\x26\x1d\xd4\x0b\x15\x56\x58\xba\x44\x65\x57\x9e\xfd\x61\xce\xad\x8f\xa6\x89\x02\x72\xd6\x96\x8a\xae\x56\x60\x5b\xa5\x37\xd3\x15\x8d\xdb\x11\x98\x15\x09\x95\x1d\xf4\x32\xb4\x7f\x1c\x85\x61\xe5\x29\x42\x2a\x88\x92\x34\x55\x19\x94\xa5\x4d\x93\xd0\x1e\x2d\xfa\xb4\xfe\x33\x4d\xd4\xb1\xb2\x57\x9e\xd2\x26\x68\xc8\xf9\xb1\x4e\x06\xf4\x4a\x6a\xc8\x65\x25\xc7\x20\xa4\x45\x25\x42\x11\xb4\xc3\xee\x1b\xc6\xb2\x4e\x08\xdf\xbe\xf9\xdf\xde\x64\x2c\xbe\x1d\xe0\x25\x89\xab\x29\x1a\xc9\x24\x34\x0a\x1e\xbd\xdc\x9b\x5c\x9f\xbc\x7f\x3f\x1c\x0f\xb4\x48\x22\x2b\x00\x2b\x94\x34\x89\xe0\xd0\x93\xf8\xe9\xf9\x64\xaa\x75\x5d\xee\x2b\xce\x98\xc0\x76\x5f\x48\xe9\xb7\x95\xe0\x0c\x4b\xdc\x29\xb7\xe7\xc6\x3d\x3f\xf7\x10\xcf\xa7\xc3\xe1\x3b\x8d\xe8\x54\x67\x64\x82\x7a\x9f\x8d\xb2\x52\x17\x8b\xbf\x7d\x83\x67\x69\xb7\x4c\xcd\x6a\xba\x1b\x4e\xdf\x8f\xde\x0f\x2d\xf7\x36\xfd\x3b\xee\x5b\xbb\x21\x54\xbe\x7c\xd1\x41\x2f\x8c\x62\x8c\xf7\xa0\x97\x1a\x42\x33\x72\x78\xec\xfe\xea\xa9\xbf\x1c\xfd\x7e\x1f\xd2\xae\xf9\xdb\x7d\x7f\x51\xff\xde\xa4\x5f\xd4\x0b\x4d\x9c\x15\xe4\x5f\x4a\xf2\x23\xcd\x84\x91\x4d\x8a\x8a\x4c\x1e\xd7\x78\x1f\x8d\xff\x38\x39\xb7\xbc\x93\xd4\x66\xa8\x55\x40\xde\x32\xd3\xf1\x06\x7d\x28\x99\xb4\x53\x9d\xe5\x9a\xf1\x70\x5d\xe4\x67\xd3\x2b\xe3\x35\xfa\x84\x96\x48\x76\x94\x97\x6d\xdb\xc9\x76\xd5\x8d\x87\x1f\x35\xd4\x8f\x71\x41\x92\x02\x3a\xda\xbb\x3e\xb9\xba\x9e\x7e\xba\x38\x1f\x8d\xdf\x5d\x8f\x27\x67\x93\xf3\xf3\xc9\x47\x8f\xb0\xda\xc1\x4b\x6f\x07\x99\xb7\x03\xc5\x96\x39\xb9\x00\xab\x44\xe7\x11\xb2\x46\xaa\xad\xbb\x7f\x3b\xbc\x1a\x0c\xc7\x57\x53\x13\xc0\x9e\xb4\xde\xba\x22\xdf\x9c\x0c\xce\xaa\x9d\xa6\x5d\x57\x54\xf8\xd3\xbd\xd1\x8a\x4b\x55\x53\x24\x84\xbb\x50\xd2\x35\x72\xff\x22\xba\x17\xf7\x6d\x95\x87\x2b\x06\xae\x56\x0b\x2c\xda\xc7\xfa\x20\x8c\xa0\x8d\x69\xcc\x12\x42\x6f\xda\xc7\xd0\x9e\xe9\x12\xa9\xfd\x50\xdb\x9c\xd5\x93\x2a\x4d\x3a\xa9\x86\x1b\x51\x89\x39\x45\x99\x39\xae\xac\xf3\x54\x36\x74\x72\x7a\x3a\x9c\x4e\x7f\x50\xf4\x75\x7b\x45\xb1\xaa\x7f\x9b\x22\xee\x76\xab\xbc\x78\x37\x18\x5d\xfe\x60\xbe\xf2\xdb\x4a\x03\x3b\xb2\xf5\x61\xac\x6c\xf8\xc7\xb9\xca\x4b\xed\x2a\x97\xc3\x8b\xc9\x1f\xc3\xc1\xe8\xd2\xa3\xa8\x2c\x27\x5f\xe7\xba\xee\x15\xe5\xc4\x82\x66\x84\xde\xd6\x67\x6e\xdd\xda\xe5\x70\x7c\x72\x31\x34\x5b\x4b\x39\xcb\xff\x93\x5b\x93\xec\x51\x34\x75\xf4\xa2\xa3\xef\x57\x20\xc7\xaa\x9c\x72\x3e\xa4\x79\x96\xec\x91\x43\xaa\x54\xdd\xdf\x64\x7f\x9e\xfe\x9e\xde\x9d\x8d\xaf\x3b\xda\xe6\x0b\xc5\xd2\xcb\x9d\x59\x3a\x38\xd0\x97\x56\x12\xf1\x1b\x2c\x55\x8a\x2c\x24\xe3\x38\x01\x24\x80\xe8\x1b\x92\x0c\x49\xb2\x34\x17\x3a\x65\x39\x09\x1c\x0b\x96\x2d\x55\x0a\xed\x6d\x4a\xac\x72\x6f\x5f\x3a\xad\x9e\xea\xdb\x02\x23\x75\x67\xa8\xdb\x8c\xf4\x64\xf0\xe3\x3c\xd0\x6e\xef\xb8\x16\xa7\x6b\x6e\xe4\xc2\xf4\xb6\x78\x3c\x2b\x52\x7b\x8f\x50\x0f\xbe\x4e\xde\x66\x9d\xee\x39\xa6\x37\x72\xde\x09\xe1\x35\xcc\x8a\xb4\xfa\xa9\xf9\xb3\xbc\xf4\xdd\x64\x2b\xbc\x62\x86\x38\x47\xab\xb6\x2e\xb3\x7c\x2a\xe7\xdc\x6a\xcc\xce\xc5\xb2\x1d\x59\xf2\x70\x33\xa9\xa8\x31\x51\xcf\x2e\x4e\x7f\xbf\x98\x0c\x7e\x70\x00\x8e\xe7\x39\x4b\xbe\x2f\x00\x1b\xbe\xd6\x72\xd7\xd4\x87\x51\xd5\x86\x06\x79\xf1\x38\xc8\xe4\xe3\xf8\xc7\x45\xf1\x7f\x3c\x95\xf0\x54\x1e\x1e\xcf\xd9\xdd\x46\x31\xa2\xb2\x5c\x5b\x8e\xa8\x3f\x8f\xc2\xad\x31\xfe\x3b\xc8\x9f\x90\x46\x5d\xa4\x15\xae\x15\xa9\x49\xbd\xbd\x05\xb6\xcb\xf7\xea\xf2\xc3\xf8\xf4\xc4\x94\x93\xcd\xf2\x55\xf2\x39\x1b\x9c\x7e\x1c\xb8\xb2\xe2\xfb\x0c\x47\xf2\x82\xc6\x48\xe2\xb5\x9d\x1b\x25\x6d\xd9\xa3\xcf\x52\xb5\xcd\x1a\x8e\x29\x55\x1f\x41\x99\x7e\x1a\x9f\xae\x23\x88\x15\x8d\xd7\xea\xa6\x2d\x94\x83\x93\xab\x93\x4d\xea\x04\x49\xb4\x2b\xc2\xe9\xf8\xea\xfc\xd8\x2b\x7c\x5e\x54\x85\xcf\x99\x4a\x63\xcf\x06\x11\x9c\x5d\x4f\xdd\x1f\x6a\xe4\xdc\x8d\x68\xc2\x96\xbb\x3a\xaf\xbd\x3a\x00\xca\xee\xd0\x4a\xc0\x4c\xd5\x99\x84\xde\xe8\x57\x0b\x8a\x97\x98\x03\xa1\x73\xac\x0a\xf9\x24\x02\x41\x68\x8c\x2d\x8a\x9c\x63\x6e\x5f\x5b\xe0\x0e\x99\x3b\xec\x82\x02\x53\xe3\xb0\xe0\xec\x86\xa3\x5c\xdf\x72\xad\xef\xa5\x66\x83\xae\x0a\x2a\xb7\x38\x9a\x9c\x9a\x2d\x7a\x73\xc6\x93\xab\xab\x4f\xeb\xf9\xfa\xe9\x47\xed\xff\xf1\x5d\xd2\x70\x5d\x6b\xde\x0e\xda\xa1\x93\x30\x67\x79\x3b\xaa\x4f\x72\x2f\x32\x6e\x56\x7c\x97\xb4\xc3\xc7\x43\xb7\x33\xd2\xf8\x2e\x29\x43\xe6\x7e\xaf\x31\x72\x7b\xdc\x5f\x9e\x8c\xdf\x0e\xb7\x04\xe6\xf8\x2e\xb1\x4b\x76\xa7\x58\xea\x87\x82\x8e\x8f\x1e\xc1\x61\x43\xd4\xf6\x67\xc0\x3e\xf4\x6a\x61\xfb\xf4\xf7\xc1\xe8\xf2\x58\x5f\x70\x3e\xbe\xe3\x79\x42\xf8\xe6\xf1\xeb\xdc\x8b\x99\xa1\xf6\x63\x99\xf0\xc5\xc9\xf4\xdd\xf1\x26\x83\x4f\x2c\x5c\xe4\x48\xdc\xb6\x6d\x8c\xee\x95\x37\x2d\x1b\x45\xd9\xfb\xd1\xe0\x7b\xc0\xcd\x4f\x92\xb4\xb7\x03\xfe\x25\xc4\x47\x21\x3f\x7c\x1f\xa2\x11\xc0\x0d\x96\xc5\x63\xa0\xc3\xbf\x8a\x8a\x1f\x85\x7d\xfb\x17\x51\x6f\x1e\xe5\xf5\xaf\xa2\xe2\x0d\xd8\xea\xfe\xd7\x78\xfd\xf4\xd3\x34\x78\x08\xaa\xab\x57\x7b\x91\xb9\x76\xf5\x9a\x26\x3a\x05\x8a\x80\x56\xef\x0d\x0b\x26\xcc\x6d\x51\xf3\x9d\x6b\xad\xc0\x4f\x93\xcf\x41\x6b\x89\xb8\x22\x23\x92\x30\xaa\x68\x31\x4f\x51\x8c\xef\xcd\xe5\xb5\xc2\x7b\xed\x0e\xa5\x72\x56\x5f\x8d\x07\xee\x48\xd6\x17\x04\xf6\x05\x6a\x6f\x0f\x6a\xb7\x5b\x1e\x51\x75\x0d\xa5\xb6\xbb\x91\x67\x96\xf7\x8a\x4d\x95\xfb\xac\x48\x43\x9d\xf4\xd1\xa8\xe4\xd6\x4a\xb0\x64\xf4\x37\x38\xd4\x0c\x6c\x67\xa6\xbc\x6c\xda\x77\x97\x6a\x7c\xed\xf2\xdd\xa9\x90\x6b\xad\xf8\x0a\x70\x17\xba\x7f\x33\x0d\xec\xed\xc1\xb3\xb4\x6b\x9f\x7e\x77\x57\xc7\x9d\xda\xed\xff\x83\x3e\xf4\xa5\x91\xc7\x9d\x7f\x1b\xb8\xed\xd2\x79\xcb\xa5\xe4\x5a\x7e\xd8\xa8\x66\xfd\xec\xb3\x5d\xd5\xee\x9d\xc5\xc4\xfe\xf2\xa5\xc4\xbd\xc7\xeb\x36\x82\xf2\x7d\xe5\xc3\xf9\x73\x89\x79\x4e\xa8\x7e\xed\x32\xcf\xbd\xb0\x40\x42\xe0\x44\xa5\x03\x48\xa1\x79\xaf\x2a\x91\x7e\x5c\x66\x85\xd4\xd4\x8e\x92\x71\xff\x71\xc5\x1e\x43\x8b\xea\xd5\xc4\x7b\x99\xbf\x0f\x5a\xba\xf0\x69\x3e\x9e\x17\x61\xd0\xa2\xea\x93\x9e\xe3\x6e\xce\xd5\x91\x3a\x49\x55\xa9\xe4\x2b\x86\x96\x37\xb2\xca\x06\x0c\x81\x3b\x51\x7d\xf9\xec\x94\x58\x18\x72\x3d\xc1\x96\x80\xe1\xfa\xe0\x4a\xe2\x89\x56\x85\xfa\x40\xfd\x97\x5f\x5d\x5e\x40\xcc\xe8\x12\x73\xa9\xc4\x5c\x75\x06\xd4\x7b\x33\x10\xbf\x29\x72\x4c\xcb\x6e\x87\x1c\xad\x60\x86\x95\x28\x35\x18\xbe\x31\xc5\xb7\x97\x54\x2b\x15\x68\x57\xf2\x04\x6c\xeb\x99\x4a\xbc\x84\x4a\xb8\xb7\x7a\xd6\x07\xb2\x4d\xed\xc3\x10\x2a\x36\x55\xba\xbe\xd6\x34\xa0\x7b\x36\x16\x5e\xcd\x2f\x75\x9b\x8a\xc7\x7e\xa2\x16\x8a\xd6\x7b\x39\x4a\x2e\x74\xfa\xaf\x67\xa8\x25\x23\xf0\xd4\xdd\xf1\x43\x4b\x15\x31\xf4\x82\x36\x68\x94\x46\x62\x74\x69\x70\xfa\xfd\x6a\xeb\xf0\xed\x9b\xe6\xb0\x6b\x12\x2a\xa7\x79\x35\xe7\xa7\x83\x9f\x9c\xcf\x35\xd0\xed\xed\x19\x3a\xaf\x28\x3f\x2c\x07\x6b\x60\xcf\x2a\x30\x95\x0e\xbf\xd3\xcd\x30\x4e\x34\xc8\x36\xb7\xd8\xfe\x1d\x24\x81\x48\x10\x05\x5f\x92\x25\x16\xa0\x13\x2f\xdb\x8b\x74\x37\xc7\xd4\x42\xe8\x3e\x9e\x94\xf1\xed\x46\x60\xf2\xe9\xff\x74\xd2\xdb\xb5\xf2\x0c\xf7\xdb\x07\xed\xd0\xcb\x5e\x1f\x5f\x21\x66\xaa\x6c\x6a\x47\xf0\xe7\x67\x2f\x5c\xdf\xc7\x77\x89\x29\xc6\x1e\xc2\x8d\x84\xdf\xd4\x68\xe6\xa4\x57\x22\x58\x3b\x05\x5c\x2c\xd2\x7a\x09\x3f\x3b\xdd\x36\x34\x02\x50\x92\x95\xd7\xee\x0f\x41\x4b\x64\x48\xcc\x77\x96\x88\xd9\xe3\xbf\xb3\xc3\x84\x70\x7b\x55\xaf\x57\xf6\x77\xeb\xf5\x74\x48\x24\x41\x9f\x20\xc6\x63\x38\x16\x45\x26\x4d\xdb\x95\x7b\x98\x56\x21\xdd\xbe\x37\x53\xeb\x40\x8a\xec\x5a\x56\x41\x54\x77\x63\xd4\x82\xa8\x76\x7f\xa2\x1c\x4b\x45\x16\x13\x69\xaa\xbe\x1d\x6a\x1c\x32\xc7\xb9\x32\xa0\x0c\xad\x58\xe1\x07\x00\xfd\x56\xb2\xa8\x4e\x6a\xc5\x83\xa8\xb7\xe6\x3c\x11\x65\x97\x04\xdf\x35\xc8\x7b\x80\x24\xfa\x83\xe0\xbb\x76\xd8\x1d\xe3\xbb\xce\xf7\x85\xc5\xf5\x18\x1c\x06\xad\x45\x61\x2e\xe1\x0a\x1a\x77\x5c\x9f\x97\x3a\xef\x2a\xd6\x97\x90\x66\x0c\xe9\x24\xe3\xbe\xaa\x89\xf5\x9c\xb2\x2a\xfe\x45\x17\xbd\x8a\xe1\xaa\xee\xfa\xa0\x43\x5c\x3b\x2a\xdb\xc7\x0a\x13\xf3\xcc\x21\xb9\x54\xc9\xa8\xe4\x85\x6e\xf2\x78\x9c\x72\xff\x68\x83\xf6\xf5\xeb\x97\x2f\x3c\x7a\xcd\xc3\xd1\xbf\xc7\x83\xf7\x58\xb9\x89\xd1\x7b\x55\xc3\xe8\xbd\x6a\xc2\x30\x87\xfe\xa2\x90\x57\x24\xc7\x35\xb1\x56\x02\xcd\xc5\xba\x44\x55\x4a\x26\x05\x28\x1a\xb1\xc0\xb1\x92\x31\x8e\xab\xa7\xd8\x5c\xc0\x01\xf4\x0e\x0f\x0f\x6d\x59\x6c\x69\x3b\x02\xc7\xe1\xcf\x6a\x1c\x5e\x2b\xc8\x7b\xf3\xe0\x1d\x3f\x7f\x6e\x83\xc1\xa2\x90\x76\xe9\x7d\xd3\xfb\xd9\x35\x66\xc0\xd2\x8e\x14\xdd\x29\x8e\xc3\x08\xec\x87\x29\xf9\x17\xf6\x87\xfd\x25\xc2\x27\xa1\xc6\xa2\x19\xcb\x8e\x77\x72\xf1\x7c\x83\xe7\xf0\xe7\x1e\x7e\xa5\x73\x00\x93\x92\x0a\x69\x7d\x52\x8b\xaf\x53\x5f\x45\xc8\xee\x00\x2f\x37\x16\x29\x87\xb5\x7b\x19\x7b\x4f\xf0\xb2\x1d\x76\xcf\xd4\x82\xce\xc0\x9b\xe0\x46\x94\x35\xc1\x99\x61\x0f\x8e\x50\xb6\x0b\xdc\x38\x23\xf4\xb6\x09\xd0\x7d\xf0\x20\xf5\x5b\xd1\x2e\xa0\x17\x2c\xc1\x4d\x98\x76\xdc\x83\xcc\x59\x82\x77\x41\xfc\x40\x92\x26\x40\x33\xec\xe1\x99\xfa\xf6\x49\xb8\xb7\xcd\x70\x6f\xeb\x70\x37\xbb\xc1\x5d\x26\xcd\x3a\xb6\xe3\x1e\x20\xdf\x51\xcb\x0a\xa3\x09\xd1\x8e\x7b\x88\x36\xc7\x7f\x12\xf1\x4d\x76\x2b\xb6\x80\x56\x9f\x3c\xdc\x99\x19\xdc\x0d\x9a\xc5\xb7\xa2\x19\xd9\x7e\x59\x03\x56\x63\x75\x5c\x15\x44\x9a\xb0\x4f\x24\xc9\xd7\xe9\x91\x24\x39\xbe\xd8\x19\xe0\x62\x03\x20\xff\x3e\x80\xd3\x0d\x80\x78\x13\x60\x2d\x65\xc7\x54\x0a\x77\xb6\x23\x01\x39\xa2\x2b\xd7\xde\xcb\x71\x8e\x08\x55\x75\x50\x52\xef\x21\xd6\x8d\x80\x6a\x7e\x4a\x74\x17\x9a\x3e\xf3\x67\x45\x0a\xc4\x74\x15\x67\x84\x16\x5f\xaf\x13\x0d\xff\xea\x48\x25\x82\x39\x92\x91\xbe\x88\xf5\x53\x6f\x5a\xe4\x33\xcc\x15\x98\x3a\x40\x75\xdf\xa7\x62\x45\x62\xba\x9e\xe6\x9b\xf7\x7f\x28\x1b\x86\x75\x91\xbe\x76\xd0\x37\x55\xe6\x31\xa3\x42\xb7\xe5\xb7\x58\x9a\x8e\x28\x03\x00\xe8\xeb\x34\x8e\xa5\xe9\x24\x4d\xcd\xef\x5f\xcc\xef\x4b\x1c\x67\x58\x15\x50\xbd\x57\x66\xe0\x6a\xb5\xc0\x7a\x42\xcf\xce\x18\xa3\xdc\x0e\xfc\x57\xd0\xda\x39\x73\x98\x15\x69\x3d\x6f\xa8\x86\xd6\xb2\x86\xda\xe3\x97\x2e\xff\x0e\x83\x96\x4a\xa2\x7f\x85\xb4\xab\x9b\xad\x7f\xab\x5a\x32\xca\xb9\xee\xe3\xfe\xbe\x69\x1c\xd5\x5d\xd8\xea\x58\x2c\x67\x9a\x6c\xdf\xcc\xd2\x2d\x5e\xc8\x1c\x9c\x7a\xaa\x0d\x97\x28\xd7\x2d\xc1\x2d\x6e\xe4\x70\xdc\x87\x8e\xdb\xf3\xbe\xee\x9c\x5e\xbb\xa5\x85\x7d\xf8\x67\x08\x7b\xff\x03\xff\x34\xc7\x25\xdd\xb7\x74\x4d\xf7\xc7\xba\x46\xed\x97\x0f\x21\x4d\x7d\x43\xfa\x2c\x6d\xcd\x38\x46\xb7\xeb\x0f\xb4\x44\xa9\xdc\xd9\x89\xed\x64\xbc\xa5\xec\x8e\x96\xf5\x37\xa2\x80\x92\x44\x5f\x57\xa0\xac\x4a\x43\x95\x3c\xa5\xc1\xe1\x18\x25\x98\x0b\x10\xb7\x64\x51\x1a\xb0\xce\x31\x75\x49\xe9\x2d\xc0\x52\x38\x54\x65\xc9\x96\xfc\x86\xee\x1b\x43\x8a\xa0\x57\xa5\x23\x4f\xcc\x55\x79\xd5\xe1\x6e\xb3\x27\x69\x1a\x39\x5d\xf6\x76\x26\x79\x72\x01\x9d\x56\xe9\xd9\xc6\xc6\x55\xa5\x6b\xfe\xdd\x4e\xf3\x8b\x23\x51\x5e\x10\x79\x6e\xa8\x7e\x77\xb4\xdd\xb8\xf7\x05\x57\x91\x90\x2c\x33\x8f\xb8\x9a\x6e\xac\x7b\xed\x9d\x59\x84\x0d\xcf\x05\xd4\xce\xb0\x93\xb5\x5d\xc2\x7e\xdf\x32\xd7\x74\xb1\x43\x37\xee\xf0\xea\x3c\xad\x85\x04\x7d\x1b\x50\x76\xa5\xba\x86\x54\x63\xf3\x8d\x6d\x8a\xde\xcd\xef\xe0\xea\xfa\x72\xf8\xb6\x91\xa4\xfc\xef\x29\xb6\xd0\x0d\x46\x97\x8d\x74\xd3\x55\x3e\x63\x19\x89\xcf\x4d\x5e\xd2\x44\x7a\x3e\x7e\xd7\xcc\xe5\xe8\x6c\xb2\x85\x44\x7d\x6a\x5e\x8e\xc5\xb7\x2a\xae\x34\x52\x4d\x27\xa7\xcd\x2b\x9d\xce\x11\x47\xb1\xc4\xbc\xd6\x9e\xb9\x4e\x7e\xfa\x7b\xf3\x16\x9b\x1a\x3b\xd7\x29\xdf\x9c\xbf\xf3\x35\x3b\xb8\xba\xfe\x30\x7e\x37\x9e\x7c\x1c\x07\x0f\xc1\xff\x05\x00\x00\xff\xff\xa8\x31\x0e\xa6\x80\x35\x00\x00"),
+		},
+		"/src/syscall/node_stub.go": &vfsgen۰CompressedFileInfo{
+			name:             "node_stub.go",
+			modTime:          time.Date(2026, 10, 15, 13, 49, 7, 481591170, time.UTC),
+			uncompressedSize: 342,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x4c\x8e\x3d\x4e\x03\x31\x10\x46\x6b\x7c\x8a\x8f\x8a\x44\x98\x2c\xe1\xaf\x40\xa2\xa4\x40\xa2\xe3\x00\xd1\xec\xee\x6c\xe2\xc4\x3b\x5e\x8d\xc7\x4a\x56\x88\xbb\x23\x07\x90\x90\x5c\xf8\x2b\xde\x9b\xd7\x34\xb8\x6e\x4b\x88\x3d\xf6\xd9\x5f\xc6\x20\xe5\x54\x7f\xdb\x34\xed\x58\xf7\x79\x93\x49\xfa\x36\x9d\x36\x43\xde\x48\x12\x76\x6e\xa2\xee\x40\x5b\x46\x9e\x73\x47\x31\x3a\xd7\x34\x90\xd4\xf3\xc7\xcf\x46\xc8\x48\x12\x67\x84\x71\x8a\x3c\xb2\x18\xf7\x48\x82\xf7\x6a\xf6\x38\xee\x58\x19\xb6\x3b\xf3\xc6\x23\xce\x8c\x94\xb1\x65\xcd\x20\xe9\xab\xae\x27\x23\x64\xd3\xd2\x59\x51\xce\x08\x06\xe5\x18\xb8\x9a\x41\xca\x38\x48\x3a\xca\x0a\x6f\x76\x95\xd1\xa5\x71\x0a\xb1\x1e\x29\x86\x76\xae\xfc\xcd\x6f\xf4\xcb\x90\x9f\x6b\xf4\xca\x0d\x45\xba\xff\x95\x0b\x53\x9a\x3c\x68\xed\x41\x77\x1e\x74\xef\x41\x0f\x1e\xf4\xe8\x41\x4f\x28\x41\x6c\x32\x5d\x62\xa1\xeb\xbf\xe1\xc1\xaa\x78\x55\x95\xe4\x91\x0e\x68\x53\x8a\x4b\x7c\xba\x0b\x65\x2b\x2a\xb8\xf5\xf5\x0d\x14\x33\xbb\x2f\xf7\x1d\x00\x00\xff\xff\x6c\x5d\x20\x9b\x56\x01\x00\x00"),
+		},
 		"/src/syscall/syscall.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall.go",
-			modTime:          time.Date(2021, 6, 19, 20, 7, 25, 750000000, time.UTC),
-			uncompressedSize: 1346,
+			modTime:          time.Date(2026, 10, 15, 13, 35, 32, 971690553, time.UTC),
+			uncompressedSize: 1776,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x94\x41\x6f\xf3\x36\x0c\x86\xcf\xd6\xaf\x60\x8d\x01\xb1\xf1\xb9\x76\x7b\x0d\x90\x4b\x8b\xa1\xe8\x69\x05\xda\x61\x87\xae\x07\xd9\xa6\x1d\xa6\x0a\x65\x48\x74\x96\x6e\xc8\x7f\x1f\x64\x39\x6d\x92\x02\x1b\xf0\xdd\x0c\x8b\xa4\xf8\xf2\x79\xc5\xaa\x82\x1f\xf5\x48\xa6\x85\x8d\x57\x6a\xd0\xcd\xbb\xee\x11\xfc\x87\x6f\xb4\x31\x4a\xd1\x76\xb0\x4e\x20\x53\x49\x3a\xb2\xd7\x1d\xa6\x4a\x25\x69\x4f\xb2\x1e\xeb\xb2\xb1\xdb\xaa\xb7\xc3\x1a\xdd\xc6\x7f\x7d\x6c\x7c\xaa\x72\xa5\x76\xda\xc1\x5f\xda\x31\x71\xff\xe4\x88\x05\x5b\x58\x41\xa7\x8d\xc7\xe9\xc8\x10\xe3\xdd\xd8\x75\xe8\xe0\xf5\xad\xfe\x10\x54\xaa\x1b\xb9\x01\x62\x92\x2c\x87\x7f\x54\xb2\xf1\xe5\x83\xb1\xb5\x36\xe5\x33\x4a\x96\xfe\xd2\x99\xd1\xaf\xef\x2d\x7b\x6b\x30\x2d\x60\xe3\xcb\x47\x16\x74\xac\xcd\x6f\xf5\x06\x1b\xc9\x42\x7e\x4c\x4d\xa8\x03\x83\x9c\x7d\x5d\x92\xc3\xd5\x0a\x6e\xa6\xb3\x93\xc2\x0f\xa1\x70\x33\x97\xcc\xcb\x7b\x6d\x4c\x96\x1a\xdb\xa7\x05\x78\x71\xc4\xfd\x69\x85\x3c\xe4\x9e\xb4\xbd\x02\x26\xa3\x92\xe4\xa0\x92\x43\x9e\xab\xc3\x2c\x60\x08\x62\xff\x88\xc2\x63\x37\xd4\xc1\xd5\xc5\x24\x42\x1f\xff\xd3\x06\x3a\x67\x5d\x5a\x40\x3a\xa7\x2e\x03\x14\xc1\x2d\x04\x30\x1e\xd8\x0a\xe8\x9d\x26\xa3\x6b\x83\x05\x78\x44\x58\x8b\x0c\x7e\x59\x55\xff\x49\xa7\x36\xb6\xae\xb6\xda\x0b\xba\xaa\xb5\x4d\x35\x93\xf6\xe5\xb6\x4d\x73\x15\xc4\x7c\x83\x26\x6e\xc4\x73\x79\x2f\x76\xe6\x90\xd5\x33\xbd\x49\x68\x6f\x9f\xce\x4e\x61\xb9\x82\x0b\x95\x97\x21\xe1\x4e\xea\xe0\x5b\xe6\xd5\x94\xf9\x3b\xb7\xd8\x11\xcf\x03\xbb\x0c\x2a\x1f\x79\x67\xdf\x31\xfb\xee\x84\x7a\x82\xe5\x50\x46\xc7\x41\x93\x3a\xe7\xa6\x87\x01\xb9\x3d\x61\x5b\x40\x5d\x96\x65\xae\x92\xce\xba\xe8\x9f\xd0\x3a\x71\x8b\xfb\xbb\x0f\xc1\xb3\xc8\xc5\x9f\xbc\xc8\xa3\xc5\x08\x56\x2b\xb8\xbe\x8d\xae\xaa\x1d\xea\xf7\x68\x87\x9f\x74\xd8\xeb\x92\xde\xf2\x1c\xaa\x0a\x5a\xcb\x0b\x81\xd1\x63\x1c\xb7\xe1\x02\x3c\x71\x83\x40\x02\xad\xc5\x48\x1f\xf7\x51\x33\xfd\x8d\xb0\x1d\x8d\x50\xe0\x00\xcd\x5a\x3b\xdd\x08\x3a\xaf\x2e\xdc\x7a\x72\x11\xfd\xb8\x5d\xbe\x85\xc1\x1c\xa9\x8e\x1e\xb3\x01\xe2\x0b\x2f\x9f\x6c\x20\xef\x26\xa4\x55\x05\x6c\xaf\xed\xf0\x19\xf9\xeb\x9e\x24\x6b\x6c\x8b\x40\x2c\x53\xc8\x73\x74\x50\x86\x7b\x92\x17\xa7\x87\x02\x46\x62\x19\xc4\x4d\x61\x79\x01\x37\x05\xdc\x4c\xef\xa3\xaa\xbe\x66\x0a\xe4\xa1\xb1\x03\x61\x0b\x9d\xb3\x5b\x08\xcd\x7b\x38\xee\x1f\xb1\xa0\x77\x96\x5a\x88\xfb\x87\xb8\x0f\xd2\xb3\x38\x04\x59\x23\x38\xd4\xe6\xb8\xa5\x3e\xb3\xc2\x68\x78\x21\x79\x79\x5c\x25\x47\x7e\x7e\x76\x69\x01\x0d\x44\xb7\x12\x4b\xe8\x3d\xf0\xa6\x02\xea\x80\xdb\x69\x0e\x9b\xef\xb8\x3f\xea\x00\xb7\x89\x6c\xa3\x93\x80\xe6\xd7\xae\x8e\x3f\xae\x6f\xd5\x41\xfd\x1b\x00\x00\xff\xff\xa9\xfc\xcd\x86\x42\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x55\x4d\x6f\xe3\x36\x13\x3e\x9b\xbf\x62\x22\xbc\xd8\x48\xef\x2a\x52\xb2\xc7\x14\xbe\xec\xa2\xd8\x6e\x2f\x0d\x90\x2d\x7a\xd8\xe6\x40\x89\x23\x7b\x1c\x7a\x28\x90\x23\x6f\xd2\x45\xfe\x7b\x41\x52\x76\x6c\x07\x68\x81\xde\x6c\xcd\x07\xf9\x7c\xcc\xb0\x6d\xe1\x7d\x37\x91\x35\xb0\x09\x4a\x8d\xba\x7f\xd4\x2b\x84\xf0\x1c\x7a\x6d\xad\x52\xb4\x1d\x9d\x17\x28\xd5\xa2\x98\x38\xe8\x01\x0b\xa5\x16\xc5\x8a\x64\x3d\x75\x4d\xef\xb6\xed\xca\x8d\x6b\xf4\x9b\xf0\xfa\x63\x13\x0a\x55\x29\xb5\xd3\x1e\xbe\x6b\xcf\xc4\xab\x3b\x4f\x2c\x68\x60\x09\x83\xb6\x01\x53\xc8\x12\xe3\xc7\x69\x18\xd0\xc3\xb7\x87\xee\x59\x50\xa9\x61\xe2\x1e\x88\x49\xca\x0a\x7e\xa8\xc5\x26\x34\x9f\xad\xeb\xb4\x6d\xee\x51\xca\xe2\x7f\x83\x9d\xc2\xfa\x93\xe3\xe0\x2c\x16\x35\x6c\x42\xf3\x85\x05\x3d\x6b\xfb\x5b\xb7\xc1\x5e\xca\x58\x9f\x4b\x17\x34\x80\x45\x2e\x5f\x0f\xa9\xe0\x62\x09\xd7\x29\x76\xd4\xf8\x73\x6c\xdc\xcf\x2d\xab\xe6\x93\xb6\xb6\x2c\xac\x5b\x15\x35\x04\xf1\xc4\xab\xe3\x0e\x55\xac\x3d\xba\xf6\x12\x98\xac\x5a\x2c\x5e\xd4\xe2\xa5\xaa\xd4\xcb\x0c\x60\x8c\x60\xff\xc8\xc0\xf3\x6d\x68\x80\x8b\x33\x26\xe2\x3d\xfe\xe5\x1a\xe8\xbd\xf3\x45\x0d\xc5\x5c\x7a\x1b\x45\x11\xdc\x42\x14\x26\x00\x3b\x01\xbd\xd3\x64\x75\x67\xb1\x86\x80\x08\x6b\x91\x31\xdc\xb6\xed\x3f\xaa\xd3\x59\xd7\xb5\x5b\x1d\x04\x7d\x6b\x5c\xdf\xce\x4a\x87\x66\x6b\x8a\x4a\x45\x30\x6f\x44\x13\x3f\xe1\x29\xbc\xaf\x6e\xd6\xa1\xec\x66\xf5\x12\xd0\x95\xbb\x3b\x89\xc2\xed\x12\xce\x50\x9e\xa7\xc4\x33\x69\x80\x37\x95\x17\xa9\xf2\x77\x36\x38\x10\xcf\x84\x9d\x27\x35\x5f\x78\xe7\x1e\xb1\x7c\xeb\x84\x2e\x89\xe5\x51\x26\xcf\x11\x93\x3a\xd5\x4d\x8f\x23\xb2\x39\xd2\xb6\x86\xae\x69\x9a\x4a\x2d\x06\xe7\xb3\x7f\xe2\xd5\x89\x0d\x3e\x7d\x7c\x16\x3c\xc9\xbc\xfc\x93\x2f\xab\x6c\x31\x82\xe5\x12\xae\x6e\xb2\xab\x3a\x8f\xfa\x31\xdb\xe1\x3f\x3a\xec\xdb\x2d\x3d\x54\x15\xb4\x2d\x18\xc7\x97\x02\x53\xc0\x4c\xb7\xe5\x1a\x02\x71\x8f\x40\x02\xc6\x61\x56\x1f\x9f\x32\x66\xfa\x0b\x61\x3b\x59\xa1\xa8\x03\xf4\x6b\xed\x75\x2f\xe8\x83\x3a\x73\xeb\xd1\x41\xf4\xfe\xe6\xf6\x21\x12\xf3\xa2\x54\xdb\x82\x9b\x64\x9c\xe4\x17\xe7\x1e\x21\x73\x16\x40\xd6\x08\xbf\xea\x9d\xbe\xef\x3d\x8d\x02\x51\x7a\x21\xc7\x40\x1c\x44\x5b\x8b\x06\xc4\x81\xc7\x1e\x69\x87\x73\x3d\x7c\xf7\x24\x82\x1c\x3b\x8a\x4b\x1d\x82\x68\x36\xda\x9b\x7d\x46\x39\x18\xb8\xa9\xc0\xf9\xd7\x48\xb2\x79\x0a\x7c\xa8\xea\x18\x61\xb2\x40\x43\x2c\xf7\x08\x14\x62\x37\x76\x8c\x0d\xc4\xfb\x05\x10\xfd\x18\x59\xc1\x1e\x0d\x46\x46\xdc\x0e\xfd\xe9\x64\x68\x36\x30\x33\x3e\x9f\xdb\x64\xeb\xbe\xc2\x8c\xc7\x4d\xc4\x32\x8a\xaf\xe0\xff\x9b\xd0\x64\xdf\x44\x21\x59\x6f\x93\x6f\x8b\x95\xbb\x17\xe3\x26\x29\x92\x41\x07\x13\xb5\xfe\x90\xa4\x4e\x29\xfb\x0c\xf4\xbe\x48\x63\x43\x03\xac\x23\x83\x6f\x3c\x1f\xd3\xab\x9f\x72\xf0\xdc\xd6\xef\xde\x1d\xbe\x47\xdc\x3f\x0e\xae\x4d\x9f\x53\xdf\xf9\x7f\xdc\x35\xfb\x19\x9c\x02\x96\x23\xe4\x7d\xdc\xdc\xb9\x38\xa7\x3e\x0d\x60\xe2\xea\xca\x8d\x87\xcc\x9f\x9f\x48\xca\xde\x19\x04\x62\x49\x29\xf7\x79\xde\x4b\x7c\x22\xf9\xea\xf5\x58\xef\x89\x48\x69\x55\x0d\xd7\x35\x5c\x57\xb3\x31\x0e\x13\x00\x14\xa0\x77\x23\xa1\x81\xc1\xbb\x2d\x44\xab\x05\xd8\xbf\x16\xe2\x40\xef\x1c\x19\xc8\xaf\x05\xf1\x2a\x1a\xb5\xcc\x96\x8d\x3e\xf0\xa8\xed\xfe\x4d\x39\x54\x45\x23\xf3\xa5\x54\xcd\x7e\xf1\xef\xa7\x2d\xcc\x3b\xa5\x86\x1e\xf2\x6e\x21\x4e\xda\xc4\xe9\xa4\x1a\xba\xc8\xb1\xd7\x1c\xdf\xa9\xfd\xb6\xef\xa2\x3c\x7d\x9e\xc4\x99\x31\x9a\x77\xf3\x81\xc2\xab\x1b\xf5\xa2\xfe\x0e\x00\x00\xff\xff\xd0\x4e\x5d\xdc\xf0\x06\x00\x00"),
 		},
 		"/src/syscall/syscall_darwin.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall_darwin.go",
-			modTime:          time.Date(2021, 6, 19, 20, 7, 25, 750000000, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 2515,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\xd5\x5d\x6f\xda\x3c\x14\x07\xf0\x6b\xf2\x29\xce\xc3\x55\xa2\x27\x03\xb5\x63\x5c\x70\x57\x51\xd6\xa1\x52\x40\x40\xb5\xf6\xaa\x32\xe6\x24\x31\xd8\x4e\x64\x1f\x8f\x55\x53\xbf\xfb\x14\x0a\xab\xfa\x66\xa3\xa9\xda\x0d\x8a\x70\xf2\x3b\x7f\xf9\x58\x3e\xed\x36\xfc\xbf\x74\x42\xae\x60\x6d\xd3\xff\x98\x51\xdd\x4e\x14\x55\x8c\x6f\x58\x8e\x60\xef\x2d\x67\x52\x46\x91\x50\x55\x69\x08\x9a\xb9\xa0\xc2\x2d\x5b\xbc\x54\xed\xbc\xac\x0a\x34\x6b\xfb\xf4\xb0\xb6\xcd\x28\xca\x9c\xe6\x50\xff\x4c\xfb\x71\xb6\x7b\x88\x93\x04\x9c\xd0\x54\x91\x81\x5f\x51\xc3\x6e\x05\xf1\x02\xd6\xb6\x35\xd4\x84\x46\x33\x39\x59\xae\x91\x53\x9c\x25\xf5\x32\x67\x16\xdf\x58\x94\x62\xc9\xef\xca\x0a\xf5\x1d\x19\xa6\xaa\x52\x0a\x8d\x49\x2f\x6a\x34\x0c\x92\x33\x1a\xe6\xb7\xf3\xbb\xc9\x74\x30\xf6\x03\x96\x18\x75\x3b\x1e\x62\xbe\x38\x5b\x74\x3b\x7e\x24\x0b\x2a\x5f\x8f\x61\x64\x90\x19\x1d\xc3\xa8\xcd\x4a\x18\x0f\x72\x75\x79\x3e\x9c\xf9\x09\x5e\xf8\x89\xfe\xb7\x20\x61\x94\x9f\x98\x5d\x05\x09\x7b\xaf\xa4\xd0\x1b\x5f\x73\x6e\xaf\x46\xc3\xf1\x65\x20\x09\xb2\x55\xc0\x99\x0d\xce\xce\xc3\x50\xc6\x35\x49\x5f\x93\xfb\xe3\xc5\x28\x9c\x25\x90\xc3\x0f\x54\x01\x61\x1a\x26\xb6\x46\x10\x7a\x88\xef\xb3\xe1\x62\x10\x3a\xa9\x88\x1b\xef\x39\x1d\x0c\x02\x9b\xc9\x65\x69\x7d\x29\xfa\xa3\xc9\x3c\x90\xc2\xe9\x40\x5b\xaf\xc7\xe1\xa6\xe6\x48\x95\xf0\xed\xe8\xc5\x60\x31\x1d\x9e\x07\x11\x17\x42\xae\x8f\x40\xf2\x10\x72\x51\x23\x2b\xcc\x98\x93\x54\xaf\xb6\xdb\x30\xcc\x60\x8b\xb0\x76\x96\x60\xff\xee\xa7\x93\x14\xa8\x40\xa8\x2f\x6a\x34\xc0\x99\x86\x52\xcb\x7b\xa8\x8c\xd0\x04\x4c\x83\xd3\x05\xca\x2a\x73\x12\x72\xd4\x68\x04\x07\x34\xa6\x34\xa0\xd0\x5a\x96\x63\x0a\x52\x6c\xf0\x51\x6f\x5a\x91\x6b\x26\x7b\xb0\x64\xab\xfa\xf2\x27\x54\x3b\xb7\xd9\x7a\x5c\x9f\x97\x29\xe0\x4f\xe4\x8e\x10\xb2\x38\x01\x2a\x21\x47\x02\x06\xaa\x34\x08\x87\x32\xcf\x78\xa0\x82\x11\x08\xcd\xa5\x5b\xa1\xdd\x25\xdd\x4f\x15\xd0\x4c\x3d\xaf\x6e\x9c\x26\xa1\xf0\x11\xe8\x81\x66\x24\x7e\xe0\x6e\x86\x90\x28\x35\xe8\x92\x40\xa8\x4a\xa2\x42\x4d\xb8\xea\x1d\xa0\xd6\xdb\xad\xdd\x85\xce\xe2\xe4\x69\x5b\xf7\x53\x28\x56\x42\x3b\x3b\xd1\x98\x44\x8d\x87\xe8\x61\x3f\xb3\xf6\x58\x4c\x86\x55\x29\xb0\x93\x14\xd8\x69\x0a\xec\xf3\xe1\xab\x04\x62\x73\x92\x82\x39\x3d\xfc\x91\xd6\x39\x61\x60\x8c\x2e\x77\x93\xeb\xd0\xbb\x77\x9c\xe4\x65\xa5\x9b\x7f\x57\xaa\xfb\xea\x95\x14\x58\x27\x05\xf6\x25\x05\xd6\xfd\xcb\xb2\x7e\xf4\x75\x86\x9b\x8f\x09\x51\x31\x2d\x78\xdc\xfc\xa3\x82\xb0\x2f\x4f\x46\xf3\xa9\xb8\x61\xdb\xf9\x07\x35\x76\xf6\x3e\xf5\x56\xbd\x8f\xdd\xf3\xd9\x91\x6e\x9d\xe4\x77\x00\x00\x00\xff\xff\x98\x10\x79\x49\xd3\x09\x00\x00"),
 		},
 		"/src/syscall/syscall_darwin_arm64.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall_darwin_arm64.go",
-			modTime:          time.Date(2021, 6, 19, 20, 7, 25, 750000000, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 2502,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\xd5\xdf\x6f\xda\x30\x10\x07\xf0\x67\xf2\x57\x9c\x78\x4a\xb4\x0c\xd4\xae\xeb\x03\x6f\x15\x65\x1d\x2a\x05\x04\x54\x6b\x9f\x2a\x63\x2e\xc1\xe0\x5c\x22\xfb\x3c\x56\x4d\xfd\xdf\xa7\x50\x58\xd5\x5f\x36\x9a\xaa\xbd\xa0\x08\x27\x9f\xfb\xca\x67\xf9\xda\x6d\xf8\x34\x77\x4a\x2f\x60\x65\xa3\xa8\x12\x72\x2d\x72\x04\x7b\x6f\xa5\xd0\x3a\x8a\x54\x51\x95\x86\xa1\x99\x2b\x5e\xba\x79\x4b\x96\x45\x3b\x2f\xab\x25\x9a\x95\x7d\x7a\x58\xd9\x66\x14\x65\x8e\x24\xd4\x3f\xe3\x6e\x9c\x6d\x1f\xe2\x24\x01\xa7\x88\x2b\x36\xf0\x3b\x6a\xd8\x8d\x62\xb9\x84\x95\x6d\xf5\x89\xd1\x90\xd0\xa3\xf9\x0a\x25\xc7\x59\x52\x2f\x4b\x61\xf1\x8d\x45\xad\xe6\xf2\xae\xac\x90\xee\xd8\x88\xa2\x2a\xb5\x22\x4c\x3a\x51\xa3\x61\x90\x9d\x21\x98\xde\x4e\xef\x46\xe3\xde\xd0\x0f\x58\x16\xec\x01\xa6\xb3\xb3\xd9\xe9\x89\x9f\xc8\x02\xc6\xb7\x43\x10\x1d\x40\x06\x87\x20\xc5\x7a\xa1\x8c\x07\xb9\xba\x3c\xef\x4f\xfc\x84\x5c\xfa\x89\xee\xf7\x20\x61\x0a\x3f\x31\xb9\x0a\x12\xf6\xbe\xd0\x8a\xd6\xbe\xc6\xdc\x5e\x0d\xfa\xc3\xcb\x40\x12\x14\x8b\x80\x33\xe9\x9d\x9d\x87\xa1\x4c\x12\x6b\x5f\x8b\xbb\xc3\xd9\x20\x9c\x25\x90\xc3\x0f\x54\x01\x61\x1c\x26\x36\x46\x31\x7a\x88\x1f\x93\xfe\xac\x17\x3a\xa7\x88\x6b\xef\x39\xed\xf5\x02\x9b\x29\x75\x69\x7d\x29\xba\x83\xd1\x34\x90\xc2\x51\xa0\xad\xd7\xc3\x70\x53\x73\xe4\x4a\xf9\x76\xf4\xa2\x37\x1b\xf7\xcf\x83\x88\x0b\x21\xd7\x07\x20\x79\x08\xb9\xa8\x91\x05\x66\xc2\x69\xae\x57\xdb\x6d\xe8\x67\xb0\x41\x58\x39\xcb\xb0\x7b\xf7\xf3\x51\x0a\xbc\x44\xa8\xaf\x68\x34\x20\x05\x41\x49\xfa\x1e\x2a\xa3\x88\x41\x10\x38\x5a\xa2\xae\x32\xa7\x21\x47\x42\xa3\x24\xa0\x31\xa5\x81\x02\xad\x15\x39\xa6\xa0\xd5\x1a\x1f\xf5\xa6\x55\x39\x09\xdd\x81\xb9\x58\xd4\xd7\x3e\x63\xb1\x75\x9b\xad\xc7\xf5\x69\x99\x02\xfe\x42\xe9\x18\x21\x8b\x13\xe0\x12\x72\x64\x10\x50\x94\x06\x61\x5f\xe6\x19\x0f\xbc\x14\x0c\x8a\xa4\x76\x0b\xb4\xdb\xa4\xbb\x79\x02\x24\x8a\xe7\xd5\x8d\x23\x56\x05\x3e\x02\x1d\x20\xc1\xea\x27\x6e\xa7\x07\xab\x92\x80\x4a\x06\x55\x54\x1a\x0b\x24\xc6\x45\x67\x0f\xb5\xde\x6e\xed\x36\x74\x16\x27\x4f\xdb\xba\x9b\x3f\x71\xa1\xc8\xd9\x11\x61\x12\x35\x1e\xa2\x87\xdd\xb4\xda\x61\x31\x1b\x51\xa5\x20\x8e\x52\x10\xc7\x29\x88\x2f\xfb\xaf\x12\x88\xcd\x51\x0a\xe6\x78\xff\x47\x5a\xe7\x84\x9e\x31\x54\x6e\x67\xd6\xbe\x77\xef\x38\xc9\xcb\x4a\x37\xff\xaf\xd4\xe9\xab\x57\x52\x10\x27\x29\x88\xaf\x29\x88\xd3\x7f\x2c\xeb\x47\x5f\x67\xb8\xf9\x98\x10\x95\x20\x25\xe3\xe6\x5f\x15\x94\x7d\x79\x32\x9a\x4f\xc5\x8d\xd8\x4c\x3f\xa8\xb1\x93\xf7\xa9\xb7\xea\x7d\xec\x9e\x4f\x0e\x74\xeb\x24\x7f\x02\x00\x00\xff\xff\x80\x98\xda\xf2\xc6\x09\x00\x00"),
 		},
 		"/src/syscall/syscall_linux.go": &vfsgen۰FileInfo{
 			name:    "syscall_linux.go",
-			modTime: time.Date(2021, 6, 19, 20, 7, 25, 750000000, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x73\x79\x73\x63\x61\x6c\x6c\x0a\x0a\x63\x6f\x6e\x73\x74\x20\x65\x78\x69\x74\x54\x72\x61\x70\x20\x3d\x20\x53\x59\x53\x5f\x45\x58\x49\x54\x5f\x47\x52\x4f\x55\x50\x0a"),
 		},
+		"/src/syscall/syscall_module.go": &vfsgen۰CompressedFileInfo{
+			name:             "syscall_module.go",
+			modTime:          time.Date(2026, 10, 15, 13, 49, 7, 480718523, time.UTC),
+			uncompressedSize: 607,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x90\xbb\xce\x9b\x30\x14\xc7\x67\x9f\xa7\x38\x61\x82\x36\x82\xbd\x12\x4b\x97\xa8\x52\x2f\x4b\x3a\x23\x63\x1f\x13\x13\x63\x53\xdb\x24\x45\x15\xef\x5e\x99\x82\xd2\x2f\x8a\xbe\xcd\xd6\xef\x7f\xb3\xab\x0a\x3f\xb6\x93\x36\x12\xfb\x70\x3c\xdc\xb5\x95\xee\x1e\x8e\x87\xce\x8d\x17\xf2\x7d\x68\x02\xb7\xb2\x75\xbf\x1b\x15\x1a\xeb\x2c\xbd\x20\x96\xe2\x7b\x48\x51\x14\x97\xc6\x59\x33\x03\x8c\x5c\x5c\x79\x47\x18\xe6\x20\xb8\x31\x00\x7a\x18\x9d\x8f\x98\x03\xcb\x3a\x1d\x2f\x53\x5b\x0a\x37\x54\x7b\xce\xe3\xd0\x87\x0c\x0a\x80\x1b\xf7\xbb\xf7\x9b\x93\x93\x21\xfc\xd0\x87\xf2\x47\xdb\x93\x88\x2b\xe4\xc6\x13\x97\xf3\xd9\x6b\x92\x67\xf7\xd5\x71\x89\x35\x2a\x6e\x02\x01\xa8\xc9\x8a\xdd\xfd\x79\xfe\xce\x07\xca\x2d\x1f\x08\x43\xf4\xda\x76\xc5\x7f\x51\xf8\x07\x98\x24\x45\x1e\x93\x27\x2f\xd2\x9d\x79\x12\xee\x46\x3e\x2f\x80\xb1\xaa\x42\x4f\x71\xf2\x16\xad\x36\xa8\x15\x6e\x90\x24\xb0\x25\x29\xb4\x7a\xda\x59\xd7\xab\x34\x05\x69\xf5\x6a\x66\x22\xec\x11\x0a\x8c\x2d\xc0\xd8\xcb\xf7\x44\x3f\xd1\x3a\xe8\xd7\xa4\x3d\xe1\xa7\x1a\xfb\x50\x9e\x8c\x6b\xb9\x29\x4f\x14\xf3\x6c\x23\x59\xf1\xaf\x6d\x17\xd6\xab\xf0\xa7\x95\xa4\xb4\xa5\xad\x72\xe4\x56\x8b\x3c\x5b\xb5\xa9\xf1\x69\xf6\x6e\x2e\xbf\xd8\x9b\xbb\x52\x9e\x6d\x3c\xe9\x17\xd8\x07\xbf\x31\xad\x1b\xd2\xcf\x16\xb0\xc0\xdf\x00\x00\x00\xff\xff\x6d\xed\xb9\xd5\x5f\x02\x00\x00"),
+		},
 		"/src/syscall/syscall_nonlinux.go": &vfsgen۰FileInfo{
 			name:    "syscall_nonlinux.go",
-			modTime: time.Date(2021, 6, 19, 20, 7, 25, 750000000, time.UTC),
+			modTime: time.Date(2026, 10, 15, 13, 49, 7, 481520075, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x2c\x21\x6c\x69\x6e\x75\x78\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x73\x79\x73\x63\x61\x6c\x6c\x0a\x0a\x63\x6f\x6e\x73\x74\x20\x65\x78\x69\x74\x54\x72\x61\x70\x20\x3d\x20\x53\x59\x53\x5f\x45\x58\x49\x54\x0a"),
 		},
+		"/src/syscall/syscall_sandbox.go": &vfsgen۰CompressedFileInfo{
+			name:             "syscall_sandbox.go",
+			modTime:          time.Date(2026, 10, 15, 13, 49, 7, 480952663, time.UTC),
+			uncompressedSize: 383,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x8f\x41\x4e\xc3\x30\x10\x45\xd7\xf8\x14\x9f\x6e\x68\xa1\x34\x77\xe0\x00\xb0\x61\x1f\x39\xce\xd4\x71\xb0\x67\x22\xcf\x98\x12\x21\xee\x8e\x8a\x5a\xb1\x43\xec\x66\xf1\xde\xd3\xfc\xae\xc3\xc3\xd0\x52\x1e\x31\xeb\xfe\xf6\x94\x78\x94\x93\xee\xa3\x2c\x13\xd5\x59\x7b\xf5\x3c\x0e\xf2\xd1\x1f\xb5\x67\x61\xfa\x1b\x62\xb2\x7f\x52\x47\xb2\x30\xf5\xc2\x79\x75\x6e\xf1\xe1\xcd\x47\x82\xae\x1a\x7c\xce\xce\xa5\xb2\x48\x35\x6c\xdd\xcd\x26\x26\x9b\xda\x70\x08\x52\xba\x6b\xe7\xf7\x98\x75\xe3\x76\xce\x75\x1d\x5e\xa7\x1f\xdd\xa8\xe0\x9c\x50\x14\x19\x5b\x26\xc4\xf4\x4e\x8a\xc6\x95\xd4\x6a\x0a\x46\x23\x7c\x08\xa4\x0a\x13\xd8\x44\x90\x85\xaa\xb7\xc4\xf1\xa2\xef\xcf\x35\x15\x24\xbb\x53\x04\x29\x4b\xca\x34\x42\x9a\x61\x58\xe1\x79\xc5\xe3\x65\x05\xae\xc9\x24\x7c\x70\xc7\xc6\xe1\xfa\xff\xd3\xfa\xec\x0b\x6d\xd9\x17\xc2\x19\xe1\xb8\xc3\xfd\xac\x87\x97\x61\xa6\x60\xf8\x44\x25\x6b\x95\xc1\x29\xe3\xcb\x7d\x07\x00\x00\xff\xff\x13\x0a\xb2\xf8\x7f\x01\x00\x00"),
+		},
 		"/src/syscall/syscall_unix.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall_unix.go",
-			modTime:          time.Date(2021, 6, 19, 20, 7, 25, 750000000, time.UTC),
-			uncompressedSize: 3370,
+			modTime:          time.Date(2026, 10, 15, 20, 23, 55, 805522851, time.UTC),
+			uncompressedSize: 5157,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe4\x57\x4d\x6f\x1b\x37\x10\x3d\xef\xfe\x8a\x09\x51\x04\xdc\x88\x5d\x7d\xb4\x0d\x8a\xba\x3a\x38\xae\x6a\x08\x70\xed\x20\xb2\x9b\x16\x41\x60\x50\xda\x59\x99\xd2\x8a\x54\x49\xae\x1c\x21\xd1\x7f\x2f\xf8\xb1\x96\x2c\xe9\x50\x23\x41\x50\x20\x37\x61\xe7\xcd\xcc\xe3\x9b\x21\x67\xd4\x6e\x43\x6b\x5c\x8b\xaa\x80\x99\x61\xcf\xee\x85\x2c\xd4\xbd\x49\xd3\x25\x9f\xcc\xf9\x14\xc1\xac\xcd\x84\x57\x55\x9a\x8a\xc5\x52\x69\x0b\x34\x4d\x88\xae\xa5\x15\x0b\x24\x69\x42\x6a\x69\x78\x89\x24\x4d\x13\x32\x15\xf6\xae\x1e\xe7\x13\xb5\x68\x4f\xd5\xf2\x0e\xf5\xcc\x6c\x7f\xcc\x0c\x49\xb3\x34\x2d\x6b\x39\x81\xe8\x7e\x8b\x72\x65\x68\x06\xef\xde\x1b\xab\x85\x9c\xc2\xc7\x34\x59\x6a\x35\x41\x63\xe0\x97\x3e\xcc\x4c\x7e\x5e\xa9\x31\xaf\xf2\x73\xb4\x94\x44\x0b\xc9\xd2\x44\x94\xd0\xe0\xfa\x1e\x77\x23\x0b\x2c\x85\xc4\xc2\x85\x48\x34\xda\x5a\x4b\x90\xa2\x4a\x93\x4d\x9a\xcc\xcc\x40\xae\x5c\xc0\xe8\x13\xc2\xa1\x5c\xb9\x50\x28\x57\x73\x5c\x1f\xcb\x77\x35\x9e\xe1\xc4\x92\x2c\x3f\xe3\x55\x45\x89\x43\x11\x06\x3e\x58\xf0\xf3\x4e\x0b\x3e\x47\xda\x1c\x80\x41\x0c\x97\x5f\xa0\x9c\xda\x3b\x9a\x65\x69\x52\x2a\x0d\xc2\x41\x3b\x27\x20\xe0\xd7\x03\xc8\x09\x88\x56\xcb\xf3\x9e\xe3\xda\xe1\x1a\xc0\x50\x16\xf8\x81\x8a\x2c\x1f\xf9\xe0\x34\x4b\x13\x9f\xf6\x9d\x78\x0f\x7d\x70\xe0\x16\x90\x3e\x81\x56\x20\xe5\x59\xcf\x71\xbd\x8b\xdf\xa4\x8d\x18\xce\x31\xdd\x44\xfd\x0d\x5a\x94\xab\xdb\x09\x9d\x33\x58\x41\xe0\x9e\x7d\x59\xf5\x7d\xee\x43\xc1\xf3\x91\x23\xc9\x60\x95\x3d\x90\xa9\xe5\x96\xce\xd7\xe5\xf2\x1b\x56\x68\x91\xce\x3d\x97\x15\xd7\x4d\xab\xff\xa1\x8a\xba\x42\x78\x31\x33\x79\x68\x02\x6f\xe4\x95\x46\x5e\xac\xaf\xb5\xc0\xe2\x5a\x5d\x28\x5e\x40\x1f\x4a\x5e\x19\xf4\xe6\x85\x90\xb5\xb9\x92\x08\x7d\xf8\xbe\xdb\xe8\x1c\xe2\xbd\x5a\x5f\xf2\x05\x52\xc9\x17\xf8\x70\xc0\x6d\x70\x47\xb4\xc0\x12\x35\x38\x1f\x9a\x45\xe2\x13\xb5\x42\xed\x6b\xde\x6e\xc3\xb6\xa3\x41\x94\x10\x8d\x58\xa4\xc9\x86\x06\x11\x1e\x33\xef\xf7\x3d\xd4\x05\x12\xe5\x31\xe2\xce\xf2\xe8\x9a\x38\x85\x92\xa3\x27\xb4\xba\x46\x4f\xe8\x9f\x5a\x68\x3c\x52\x8d\x68\x71\xd5\x48\x3c\xb9\x00\x3c\x56\x8e\x64\xc9\xa5\x98\x50\xe2\xb1\x2e\xe3\x1e\xed\xc6\x39\x1f\xca\x95\x9a\x23\x25\xd1\x4e\x1e\xb5\xf2\x23\x27\xcf\xc1\x29\xbb\x6d\xa8\x51\xb0\x53\xab\xf9\x92\x01\xef\x32\xe0\x3d\x06\xfc\x07\xa8\x85\xb4\x4b\xab\x33\xa0\xba\xcb\x40\xf7\x9a\x0f\x0c\x50\x6b\x18\x68\x2d\x95\x57\x5f\x94\x50\xba\x83\x3e\x2e\x1f\x19\x35\x64\x4e\xa0\x84\x67\x5b\x89\xb5\xc3\x96\x0d\xe7\xfd\xac\xd9\xf6\x41\x8a\xe9\xa8\x8e\x57\xbb\x93\xe5\x43\x69\x69\x96\xb1\x03\x53\x77\x6b\xf2\xbc\x1e\x0c\xbd\xc6\xe0\x15\x11\x25\xb8\x7c\x4e\xec\xd1\xdf\xa3\xdb\xb7\x6f\x86\xd7\x03\x78\xfe\x1c\x28\xef\xba\x6f\x5d\xf8\xf4\x09\xc2\xcf\x5e\xe8\x2b\xae\x35\x5f\xc7\x22\x0e\xa5\x45\x2d\x79\x15\xda\x90\xf2\x9e\xa3\x6a\x2a\x31\xc1\x9d\x87\x6d\xbc\xb6\xc8\xc0\xbb\xed\x3e\x6a\xc9\xa1\xbf\xf7\x0c\x17\x9c\x7c\xe7\x1d\x48\x74\x74\xf8\xa5\x16\xd2\x5e\xab\x33\x25\x8d\xaa\x30\x82\x0f\xa5\xd9\x4b\xc4\xa0\xc3\xa0\xb3\x7f\x54\xfc\x20\xec\xb5\xfb\xed\xd5\x0f\xb3\x24\x3f\x57\xee\x73\x7c\xf4\x7c\xb6\xb7\x5c\xcb\xf8\x0e\xee\x65\x69\xee\x6a\x88\x3f\x38\x3d\x3b\x1b\x8c\xf6\xdb\xe7\xe5\x41\x25\x19\xf0\x1f\x19\xf0\x9f\x18\xf0\x97\x5f\xa8\x97\x5e\x3e\xb1\x99\x76\x29\x7c\x95\xc6\x7a\xd6\x87\x5e\xa7\x07\x1f\xa1\xdd\x86\x39\x6a\x99\x2b\xa3\xb1\x42\x6e\x10\x94\x84\xab\x11\xfc\xc5\xe0\x8e\x2f\x97\x28\x0d\x08\x09\x42\x0a\x0b\xaa\x04\xa2\x0c\x81\xb8\x40\x34\xc5\xdf\x29\xc7\xe6\x69\x15\x79\xc3\xef\xbf\xa1\x3b\xfd\x39\xbd\xab\x1f\x94\xba\x54\x03\xad\x95\xfe\xef\x82\xfd\xef\x54\x7a\xaa\x18\x47\xda\xe5\xdb\xbe\xc3\x9f\xd3\x48\xaf\xd6\x16\x5f\x5b\xfd\xbb\x56\x8b\xb8\x4d\x9a\x87\xd5\x85\xbe\x08\x43\x01\x5d\x83\x79\x81\x76\xa7\xca\xee\x6a\x70\x23\xa4\xfd\xf9\xd4\x8f\x82\x2c\xbf\xc4\x7b\x5a\xa1\xa4\x26\x83\x16\x74\x9b\xc5\x98\xc1\xd8\x39\x6a\x2e\xa7\x08\x61\xdc\x38\x44\x5c\x5d\xc6\xee\xb9\xef\xec\xaf\x2b\x0c\x06\xc3\xcb\x3f\x4f\x2f\x9a\xb5\xc5\xcf\x8c\x11\xda\xb8\x30\x33\x18\x07\x01\xf6\x0c\x21\x39\x83\xce\x56\x8b\x70\x94\x8c\x86\x3f\x31\xf9\x6b\x25\xdc\x4c\x8b\x53\xe8\xc6\x7f\xa4\x99\xd3\xd9\x2d\x49\x9b\xf4\xdf\x00\x00\x00\xff\xff\x77\x4c\x60\x3e\x2a\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x58\x6d\x8f\xdb\xb8\x11\xfe\x6c\xfd\x8a\x89\x10\xa4\x52\x4f\x95\x5f\xae\x5d\x14\x49\xfd\x21\xd9\x73\x72\x0b\xa4\xbb\x41\xec\x5c\x1a\x04\xc1\x82\x96\x46\x16\xd7\x12\x29\x90\x23\xfb\xdc\xc4\xff\xbd\xe0\x8b\xfc\xb6\x9b\xeb\xee\xdd\xa5\x68\x81\x7e\xb3\xc9\x99\xe1\xcc\x33\xc3\x67\x86\xea\xf7\xe1\xbb\x79\xcb\xab\x1c\x6e\x74\xf2\x68\xcd\x45\x2e\xd7\x3a\x08\x1a\x96\x2d\xd9\x02\x41\x6f\x74\xc6\xaa\x2a\x08\x78\xdd\x48\x45\x10\x05\xbd\x50\xb5\x82\x78\x8d\x61\xd0\x0b\x5b\xa1\x59\x81\x61\x10\xf4\xc2\x05\xa7\xb2\x9d\xa7\x99\xac\xfb\x0b\xd9\x94\xa8\x6e\xf4\xfe\xc7\x8d\x0e\x83\x38\x08\xfa\x7d\xf0\xca\xd7\x28\x56\x1a\x14\x52\xab\x84\x06\x2a\x11\x50\xac\xb8\x92\xa2\x46\x41\xb0\x62\x8a\xb3\x79\x85\x1a\x32\x59\x55\x98\x11\xe6\x30\xdf\xc0\x63\x2f\x03\x85\x92\xb5\x31\x66\xf4\xb4\x6c\x55\x86\x1a\x34\x7a\x41\x46\x50\x71\xb1\x04\x73\x4c\x02\x1a\x11\x32\x59\x37\xbc\x42\x95\xbe\xe6\x62\x79\xd5\x10\x97\x42\xa7\x13\xb1\x9a\x3a\xd5\x34\x28\x5a\x91\x1d\x79\x16\xc5\xf0\xf1\x93\x26\xc5\xc5\x02\x3e\x07\xbd\x1b\x3d\x11\x2b\x78\x3a\x86\x1b\x9d\xbe\xaa\xe4\x9c\x55\xe9\x39\xab\xaa\x28\xec\x3c\x0a\xe3\xa0\x67\x23\x7a\x3a\x86\x9a\x2d\x31\xea\xb4\x13\xb0\xba\xe9\x6b\x14\x0b\x2a\xa3\x38\x0e\x7a\x85\x54\xc0\x8d\xa0\x62\x62\x61\xe3\xd6\xe6\x0c\xab\xff\x91\x7f\x82\xb1\x57\xb9\x10\x39\xfe\x1c\xf1\x38\x9d\x5a\x4b\x51\x1c\xf4\xb6\x41\xcf\x41\x66\xb5\x82\x6d\xe0\x3c\xd7\x48\x28\x56\xd7\x59\xb4\x4c\x60\x05\xee\xe0\xd8\xd8\x6c\x94\xcc\x50\xeb\x63\xcf\x5f\x21\x45\xa1\xdf\x31\x7e\xf3\x02\x3a\xb9\xb1\x95\x7b\x27\x72\x2c\xb8\xc0\xdc\xba\xe5\x0e\xb4\x67\x7b\x31\x67\x01\xc5\x2a\x8c\xd3\x29\x92\x3d\x35\xde\x39\xd3\x8a\xbd\x3b\xff\x59\x5f\x7e\xc0\x0a\x09\xa3\xa5\xf5\x65\xc5\x14\xd4\x5c\xb4\xfa\x4a\x20\x8c\xe1\x4f\x43\xef\xde\xd4\x15\x74\x44\x8a\x35\x09\xb0\x61\x02\x6c\x94\x00\xfb\x1e\x5a\x2e\xa8\x21\x15\x43\xa4\x86\x09\xa8\x51\xb7\x90\x00\x2a\x05\x13\xa5\x84\xb4\x71\xf0\x02\x8c\xae\xf1\x6f\xfa\x61\x7a\xfd\xfe\xed\xc5\x6c\x02\x4f\x9e\x40\xc4\x86\x66\x6d\x08\x5f\xbe\x80\xfb\x39\xb2\xf2\x46\xa1\x94\x72\x69\x02\x97\x2d\x35\x2d\xfd\x28\xe5\x32\x62\xc3\xf8\x99\x5b\x7f\x34\x06\xc1\x2b\x2b\xda\x63\x4a\xb1\x8d\x87\xe8\x42\x10\x2a\xc1\xaa\xab\xf9\x0d\x66\x14\xb1\x51\x6c\x24\x8c\x4a\x7a\x21\x56\x72\x89\xd1\x09\x8e\xef\xb8\xa0\xbf\x3e\x37\x16\xc2\x38\xbd\xc4\x75\x64\xad\xc5\x56\xcd\x97\x8d\x8f\xc9\xed\xec\x8b\x32\x81\x41\x02\x83\xa0\x67\x80\xdd\xda\x10\x0b\xe3\x84\xbf\xfc\x2f\x36\x97\xac\xc6\x28\xf4\xd0\x85\xf1\x33\x28\x0e\xbd\x56\x46\xb6\xe8\x9c\x3a\x05\x36\x0e\x6e\x9d\xae\x7c\x6d\x0f\x62\x13\xa4\x3d\xff\x74\x6b\xb8\xdf\xb2\xd0\xef\x36\x46\xdd\x46\xe7\xe9\xc3\x92\xf1\x6f\x01\xd6\x15\xcf\xf0\xe0\x1a\xcf\x37\x84\x09\x9c\xe0\x15\xf4\x7a\xb7\xf5\xad\xa6\xbb\x11\xe1\x63\xab\x10\x7a\x45\x23\xdf\x28\x2e\x68\x26\xcf\xa5\xd0\xb2\x42\x2f\x1c\xdc\x37\x31\xb7\x43\x7d\x39\x9d\x3d\x9f\x99\x50\xd9\x10\xfe\x36\x86\x91\x8d\xae\xdf\x87\x59\x89\x30\x25\x46\xd7\x04\x4c\x2d\x5a\x4b\xa7\x5c\x43\xc3\xb4\x36\xdc\xa8\x81\x81\x09\xc9\x39\x06\x6b\x4e\xa5\x65\x51\xc1\x88\xaf\x10\x6a\xac\xa5\xda\x38\x4b\x15\xdb\xc8\x96\x12\x58\x97\x3c\x73\x42\x1d\x8f\x42\x26\x1b\x8e\x1a\xe6\x2c\x5b\x02\x17\x24\xed\xae\x26\xd5\x66\x94\xde\x07\xe4\x15\xc7\xf5\x1d\x44\xf0\x03\x23\xf6\x13\xc7\xf5\x61\xf9\xba\x9d\x79\x5b\x14\xa8\xc2\x38\x81\xc3\xc5\x0d\xe1\x55\x51\x68\xa4\xd0\xa6\xc4\x5c\x79\x4d\x3e\x7a\x77\xf1\x5c\x7f\x4a\xa7\xfc\x9f\x28\x8b\x48\x53\xfa\x77\x99\x63\x0c\xe3\x0e\x30\xeb\x89\xe7\x72\x8d\x64\x6e\xd0\xf0\x2c\x4c\x3a\x3d\x67\xfd\x40\x33\x01\x4d\x39\x97\xe6\xb7\xb9\xc1\x09\x90\x6a\x6d\x1a\xb7\x80\x95\xc6\xaf\xd9\xfc\x7e\xf4\xab\x6c\xee\xcb\x63\x70\x5c\x08\x86\xa1\x50\xa9\x04\x1c\xad\x08\x99\xe3\xd7\x78\x2d\xe9\x74\xe3\x67\x46\x7a\xcf\xa2\xd6\xc8\xc0\xda\x39\xad\x2f\xfc\x99\xd3\xcc\xfc\xf6\x35\xf5\x46\xc9\x85\x62\xb5\x06\x4d\x4c\xf9\x5e\x6c\x32\x5e\x4a\x4d\xa0\xd0\x0e\x06\xe6\xbf\xd1\x83\x4c\xe6\x08\x24\x81\x53\x02\x8a\x51\x89\xca\x19\xa1\x92\x09\x2b\x61\x7a\x6a\xa7\x9d\xba\x34\x55\xbc\xc0\x6c\x93\x55\x78\x47\x51\x3c\xde\x6d\x1a\xe2\xd9\x4b\x1e\xd2\xe6\xad\xb6\x5c\x54\xad\x2e\xfd\x5d\x0b\x2d\x01\xee\x14\xbd\x88\xf1\x24\x4c\x4c\xf5\x1a\xd0\xad\x88\x1f\x00\xd2\x57\xd2\x6c\x46\x5d\x0a\xfa\x7d\x78\xcf\xa9\x94\x2d\x19\x42\x24\xac\xc1\x00\xad\x13\x17\xee\xa5\xcc\x31\xbd\xd1\x90\x73\x85\x19\x55\x9b\x04\xb4\xb9\x0e\xec\x04\x11\x67\x47\x16\x76\xb5\x71\x78\x02\xd7\xe2\x0f\x04\xd5\x1e\x87\x7b\xf4\xc8\x67\x3b\xa1\x47\x27\x0d\xf2\xc9\x13\x38\x6e\x8a\x26\xc2\xf8\x96\xd8\x7d\x01\xeb\x6c\x7d\x0d\xae\x6d\x70\x17\x62\xb6\x33\x73\x41\xef\x99\x12\x7e\x68\x39\x61\xb8\xae\x29\x3b\x6e\x9b\x3c\x3f\x3f\x9f\x4c\x4d\xc3\xee\xf7\xf7\xf7\xe0\x68\x30\x2c\x78\x85\x50\xbb\x55\x53\x6a\x98\x83\x19\xa1\x1c\xe5\x30\x91\x33\x95\x83\x26\x85\xac\x86\x22\x87\x75\x89\xc2\xda\x3a\x48\x15\x30\x85\x20\x24\x01\x5b\x31\x5e\x99\xc1\xf2\x29\x30\xc8\x4a\xa6\x58\x46\xa8\x20\xc7\x95\x21\x7d\x5e\xec\xb2\xe9\x4e\xb2\xe7\x3b\xc7\xac\x79\xcb\x9e\xb3\xd9\x87\x04\xa4\xa9\xeb\x35\xd7\x08\x0c\x1a\xde\xa0\x1f\x21\xf7\x17\xb9\xc8\xf7\x03\x45\x6b\x19\xc0\xcf\x0e\x45\x6e\x32\x32\x30\xd9\x3a\x98\x06\x8a\x3c\x3e\xea\xa8\x0e\xb2\xe9\xf5\xc5\xcb\x8b\x97\x57\xf0\x05\x06\x67\x83\xdd\xe5\xff\x4d\x45\x62\xac\xfb\x68\x9e\x8e\x8f\xea\xa5\x9b\x58\x3f\x87\x26\x0a\x11\x26\x60\x7e\xc8\x96\xfc\x2f\x54\x2a\xdc\x7e\x2c\xf2\x4f\xb1\xab\x55\x6f\xe5\x8e\x2a\x74\x3b\xce\x25\xae\x67\xb3\x0f\x61\x9c\xbe\x90\xb2\x8a\x5c\x0b\x3e\x8c\xee\xfc\xc7\xb7\x36\xba\xd1\x7e\xf8\xb8\x3b\xf6\xed\xf1\xf8\x76\x76\x07\xcf\xb1\x3f\x27\xc0\xfe\x92\x00\x3b\x7b\xc8\x2c\xf7\x0b\x83\xce\xd9\x03\x27\x9d\x43\x17\xbe\xf5\xd4\xf3\xa0\x0e\x70\xe0\xd6\x3d\x9b\xc0\xa3\x31\x8c\x06\x23\xf8\x0c\xfd\x3e\x2c\x51\x89\x54\x6a\x85\x15\x32\x8d\x20\x05\x5c\x4d\xe1\x1f\x09\x94\xac\x69\x50\x68\xe0\x02\xb8\xe0\x64\xc8\x2d\x94\x3a\x04\xff\x74\x0c\x7a\xb7\x98\x60\x7b\x6f\x32\xb0\xb9\x7e\xcb\xd6\xbf\xc7\xb4\xfe\xbf\x33\xca\x7e\x8b\xb6\xfe\x2b\xd9\xd8\xbd\x88\x77\x09\xb8\x94\x13\xa5\xa4\xba\x7f\x1e\xfe\xeb\xc0\x3f\xc4\xf8\xfa\x77\x40\xf8\xa1\xe0\xde\x51\xd5\xff\x27\xb1\x6f\x43\x62\xbf\xa5\xe4\x5f\x6c\x08\xdf\x90\x7a\xa9\x64\xed\x3f\xbb\xe8\xdd\x47\x8c\xe8\x8f\xee\x31\x88\xe6\x2a\x58\xe8\x0f\x1f\x3a\xbf\xf8\x12\xaf\x50\x44\x3a\x86\xef\x60\xd8\x7d\x00\x4a\x60\xbe\xff\x06\xe4\x9e\x99\x46\xc2\x7f\x30\x98\x9b\x31\x7c\x70\xd4\x2e\x05\xaf\x12\x98\x5c\x5c\xfe\xf4\xfc\xb5\x9f\xbe\xdc\x53\x68\x8a\xe4\xbf\x14\x25\x30\x77\xd0\x9e\x6c\xb8\xc3\x4d\x25\xef\xb0\x70\xa1\xc4\x91\x7f\x93\xbc\x91\x5c\x10\x76\xaf\xcf\x77\x76\x31\x8a\x4d\x06\x05\xaf\x82\x6d\xf0\xaf\x01\x00\xba\x92\x31\x0e\x25\x14\x00\x00"),
 		},
 		"/src/syscall/syscall_windows.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall_windows.go",
-			modTime:          time.Date(2021, 6, 19, 20, 7, 25, 750000000, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 2566,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x96\xdf\x6f\xdb\x36\x10\xc7\x9f\xad\xbf\xe2\xe0\x87\x81\xf4\xb8\x38\x72\x97\xcc\x29\xe0\x87\x20\x76\xd3\x01\xee\x52\x24\x2e\x0a\xac\x28\x0a\x4a\x3c\xc9\x6c\x29\x52\x20\x29\xa3\x4e\x9a\xff\x7d\xa0\x7e\xd8\xca\x92\x02\x1b\x1a\x18\x7d\x91\x45\xde\x97\x77\x9f\xfb\x21\xc2\xe3\x31\xfc\x9a\x54\x52\x09\xf8\xec\xa2\xa8\xe4\xe9\x17\x9e\x23\xb8\xad\x4b\xb9\x52\x51\x24\x8b\xd2\x58\x0f\x43\x5b\x69\x2f\x0b\x1c\x46\xd1\x86\x5b\x28\xa4\xae\xdc\x95\x46\x98\xc1\x6f\x71\x14\x65\x95\x4e\xe1\xa6\x39\x42\xbc\xe5\x25\x03\xcd\x6d\xee\x18\xf0\x98\x01\x9f\x30\xe0\x2f\xa0\x92\xda\x97\xde\x52\x20\x36\x66\x60\x27\xdd\x06\x03\xb4\x16\x16\xd6\x6a\x43\xe1\x2e\x1a\x94\x56\x6a\xff\x9e\x5b\x2d\x75\x4e\x68\x34\xb0\xe8\x2b\xab\x3b\x35\xe9\x42\x53\x06\xc7\x0c\x16\xe7\x17\x17\x8b\x9b\xe8\xfe\x21\xc3\xe9\xf7\x20\x18\xf0\xdf\x19\xf0\x13\x06\xfc\xf4\x90\x40\x67\xff\x05\x88\x01\xff\x83\x01\x9f\x32\xe0\x67\x87\x84\x8b\x27\xff\x97\x2e\x68\x8e\xc3\x23\x28\xe3\xc9\x41\x61\x4f\x7e\x10\x36\x3c\x82\x36\x0e\xe2\xf8\xe4\xa0\xec\xd3\xe7\x65\x0f\x8f\x20\x8f\x83\x3e\x9e\x1e\x24\x15\x65\xb8\x50\x32\xb1\xdc\x6e\x49\x26\x15\x6a\x5e\x20\x8c\xc2\xd1\xf8\x94\x02\x59\x73\x2d\x14\xfe\x78\xe0\x7f\x45\xcd\xd1\x97\xd6\xa4\x5c\x08\x8b\xce\x3d\x8a\x12\x6c\x7b\x90\x29\x05\x12\x76\x9e\x9d\x82\x08\x18\x2d\xf9\xed\x76\xbe\x5c\x52\x58\x1a\x2e\x08\x0d\xae\x8d\x0d\x5e\x5b\x2f\xbf\xcc\x97\xcb\x45\xd8\xbb\x7b\xe3\xf2\x97\x30\x74\x5b\xe7\xb1\x80\xd0\x7e\x07\xda\x78\xe0\x1b\x2e\x15\x4f\x14\x32\x70\x88\xb0\xf6\xbe\x74\x2f\xc7\xe3\x5c\xfa\x75\x95\x1c\xa5\xa6\x18\xe7\xa6\x5c\xa3\xfd\xec\xf6\x2f\x89\x32\xc9\xb8\xe0\xce\xa3\x1d\x0b\x93\x8e\xdb\xdb\xd9\x1d\x15\x62\x78\xbf\xc7\x2b\x1b\xbc\xb7\xd6\xa4\x14\x5e\x49\xfd\x93\xf1\xe5\xe8\x6f\xbc\x78\x5d\xf7\x8e\xac\x41\x6a\x4f\x81\x64\x02\x9a\x9d\xba\x35\x32\x83\x35\xcc\x66\x70\xb3\x9a\x7f\xba\x7a\xb7\x7a\xfb\x6e\xf5\xe9\xf5\xf9\x5f\xf3\xe5\x22\x18\xbb\x14\xe2\x68\x70\xff\x50\xba\xb8\xbe\xbe\xba\x7e\x42\x39\xa9\x95\xed\xe2\x78\x07\x72\x89\xfe\xc2\x68\x67\x14\xbe\x31\x02\x49\xda\xbc\xb7\x1c\x0c\x0a\x23\xda\x49\x7a\x31\xa1\x40\xc2\xf0\xd4\x55\xa4\xbd\x32\xce\xab\xa2\xd8\x36\x75\xdc\x27\xf8\xde\x4a\x8f\xaf\x64\xc8\xae\x19\xd0\xce\x63\x52\x65\xf0\xe1\x63\xb2\xf5\xc8\x40\x18\xbd\xf3\xce\xc0\x6c\xd0\x2a\x5e\x96\x28\x60\x74\xb5\x7b\x7f\x14\x35\x24\xdb\xb8\x9c\xcd\x20\x86\x6f\xdf\x7a\xcb\x49\x9d\x71\x3d\xd4\x2b\xd3\xe6\x45\x92\x2a\xa3\xd1\x60\x30\xaa\xa3\xcd\xa0\x09\x47\x14\xea\xda\x42\xf7\x25\xd2\x52\xd5\x45\xfa\xce\x47\x11\xcc\x5d\x7a\x8b\xaf\xd2\x87\xd9\x0a\x5f\x20\x7e\x95\x3e\x0d\x75\xea\xca\x14\x4a\xd3\xfc\x45\x38\xba\x34\xc1\x4a\xe8\xc3\x7a\x17\x05\xd7\x62\x29\x35\x12\x0a\x24\x2d\xc4\xfe\xd2\xd8\x55\x75\x77\xa0\xa7\x5e\x99\x73\x9b\x6f\xfa\x07\x18\x70\x9b\xa7\x30\xea\xfa\xc3\x6d\xbe\x81\xd1\x87\x69\x7c\x36\xf9\xd8\xfe\x74\xc2\x27\x5b\xa7\xa5\x62\x4f\xf7\xef\x12\x3d\xea\x0d\xf9\x82\x5b\x70\xde\x4a\x9d\x53\x20\x1b\xae\x2a\x6c\x97\x0c\x32\x53\x69\x01\x89\x31\xaa\xef\x71\x38\x64\x90\x71\xe5\xb0\xef\x69\x25\x0b\xfc\xdb\x68\xfc\x53\x67\xc6\x16\xdc\x4b\xa3\x89\xbf\x95\x30\x0a\x86\x5b\xa3\x51\xee\x0d\xe1\xc6\x4e\xa1\x9b\x89\x27\xa9\x8f\x1f\x33\xfb\x6d\x89\xbd\xcd\x00\x59\xa5\xfe\x6e\x77\x1d\xf4\x8d\x14\xea\x1f\x42\xdb\x54\x1e\xd0\x47\xf7\xd1\x3f\x01\x00\x00\xff\xff\x47\x14\x60\x60\x06\x0a\x00\x00"),
 		},
 		"/src/testing": &vfsgen۰DirInfo{
 			name:    "testing",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 14, 21, 57, 984123320, time.UTC),
+		},
+		"/src/testing/alarm.go": &vfsgen۰CompressedFileInfo{
+			name:             "alarm.go",
+			modTime:          time.Date(2026, 10, 15, 14, 27, 2, 694868826, time.UTC),
+			uncompressedSize: 2910,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x56\x41\x6f\xdb\xb8\x12\x3e\x8b\xbf\x62\x2a\xa0\x78\x52\xab\x47\xf7\xf5\xf4\x90\xd4\x0b\x14\xdb\x6d\xd1\x43\x5b\x60\x93\xdd\x1e\xda\x62\x4b\x4b\x23\x9b\x89\xc4\xd1\x92\x94\x95\x6c\xe0\xff\xbe\x18\x92\x92\xed\x20\x39\xc4\x34\x39\x1e\xce\x7c\xf3\xcd\x37\x5c\xad\xe0\xe5\x66\xd4\x5d\x03\x37\x4e\x88\x41\xd5\xb7\x6a\x8b\xe0\xd1\x79\x6d\xb6\x42\xe8\x7e\x20\xeb\xa1\x10\x59\xde\xf6\x3e\x17\x59\x4e\x8e\xff\xdb\xd1\x78\xdd\x23\x2f\xe3\xa7\xc8\xf2\xad\xf6\xbb\x71\x23\x6b\xea\x57\x5b\x1a\x76\x68\x6f\xdc\x71\x71\xe3\x72\x51\x0a\xb1\x5a\xc1\xa4\x7c\xbd\x6b\x68\xfb\xc1\xaa\x1a\x41\x3b\xd8\xd1\x04\x1d\x99\x2d\xa8\xd6\xa3\x05\xbf\x43\x60\x9f\x34\xfa\xb0\x9e\xed\x41\x1b\x8f\xd6\x8e\x83\x77\xbc\xcf\xae\x38\x4c\x57\x81\x6e\x83\xa1\xea\x94\xed\xa1\xd1\x8d\xf9\x8f\x87\x2d\x01\xb5\x2d\x6c\xee\xf9\xc8\x48\x51\x93\x71\xfe\xd1\xdd\xeb\x70\x8f\xbc\xc2\x9a\x4c\x73\x16\xdb\x15\x8d\x36\x06\xc7\x8e\x6b\x6a\x10\xa8\x3d\x8f\x66\x22\x7b\x1b\xa2\xb5\xa8\x1a\x09\x5f\x4c\x8d\xa7\xa1\xb3\xb7\x9d\x72\x30\x28\xe7\xb0\xa9\x40\x7b\xc0\xbd\xea\x46\xe5\xd1\x25\x87\x26\xd8\xf7\x4a\x9b\xe4\x85\x3f\x68\xdc\xee\xc2\xbe\x36\x6e\xc0\xda\x93\x65\x4f\x83\x25\x4f\x35\x75\x15\x4c\x3b\x5d\xef\xe0\xcf\xff\x43\x43\xe8\x60\x83\x7e\x42\x34\xa0\xcc\x3d\xf8\x89\x40\x7b\xb4\xca\x6b\x32\x8e\xe3\x55\xd0\x11\x0d\x15\x38\x82\xd1\x74\xfa\x16\x67\xdc\x22\x52\xda\x83\xea\x1c\x9d\xe2\xba\x25\x4b\xa3\xd7\x06\x39\x71\xe5\xc1\xe0\x1e\x2d\xdc\x6b\xec\x1a\xf0\x14\xe2\xc2\x3d\x1a\x1f\x1c\x3f\x06\x35\x81\xb6\x86\x9f\x62\xaf\xec\x31\x01\x58\x83\xc5\xbf\x47\x6d\xb1\xc8\x97\xcd\xbc\xac\x52\xd6\xee\xf4\x3c\xa2\xfa\x57\x3a\xc9\xcb\x4b\xe1\xd0\x5f\x47\x48\x8b\x76\x34\x35\x27\x57\x94\xf0\x20\x00\xf8\x12\x87\xce\x69\x32\xb0\x06\x83\xd3\xf1\x4a\x79\x15\xf7\x8b\xf2\x52\xc0\x6c\x24\x6b\x32\x06\x6b\x7f\x4d\x9f\x94\x36\xd7\xe1\x8a\x73\x83\x81\x9c\x2f\xf2\xdf\x23\xb7\xe5\x5c\xaf\xbc\x82\x07\xc0\xbb\xc1\x46\xab\x0b\xc8\x67\x56\x5f\xa3\x0b\xc1\x35\x5f\x46\x5f\x94\x39\x1c\xca\x4b\x71\x58\xf2\x92\x31\x99\x77\xca\xab\xf2\x52\xfc\x14\x01\x95\x85\x3f\x2f\x6e\x9c\xfc\xb2\xb9\xc1\xda\xc3\x6a\x05\x5f\x4f\xd9\x04\xce\x2b\xeb\xb1\x61\xf6\x86\xe5\xd7\xf4\xa3\x0a\xc8\x82\xd1\x9d\x14\x82\xb1\x80\xa2\x87\x17\x9f\xca\x68\xf3\x96\x6b\x5a\x94\x91\xd2\x1c\x14\x3c\x88\x4c\xb7\xf0\x62\xee\xa5\x37\x6b\x78\xc5\x7b\x99\x45\x3f\x5a\x73\x34\x7c\x38\x88\xec\x20\x44\xd6\xa0\x6a\x3a\x6d\x10\x2e\x52\x63\x7c\xa6\xa9\x28\xe5\xdb\xa6\x29\x66\x27\xa5\xc8\x7a\xc9\x6b\x3b\x37\xcf\x5b\xee\xd9\xf7\xa3\xa9\x17\x9b\x0a\x38\xb6\x50\xa3\x2c\xeb\x65\x68\xea\xa2\x14\x59\xe6\x17\xa4\x44\x76\x28\x45\xb6\x40\xb1\x3e\xcf\x72\xf1\x04\x2f\xcf\x1b\xb6\x14\x73\xec\x73\xa8\xe2\xf0\x18\x09\x1a\x66\x20\x1e\xa5\xff\x4b\xca\x3e\xc5\x2f\xaf\x3c\x0d\x21\x2c\xdd\x1e\x8b\xf2\x6c\xcd\xf0\x06\xbb\x25\x3c\xf9\xab\xea\xba\x22\xf7\x68\x7b\x6d\x98\x0e\xe5\xe9\x29\x84\x5f\x88\x2c\x0b\x20\x1e\x82\x8a\xcc\x89\x82\x45\x56\xcf\xd4\x4b\x41\x1c\x58\xb0\xc0\x2a\x03\x2d\xd9\x20\x7a\xa1\xe8\xca\xc0\x7f\xf9\x48\xce\xc1\x4e\xda\xef\xe6\x66\x75\x5e\xd5\xb7\xb1\x9f\xbb\xee\xa4\x43\x2b\x98\x5b\x1a\x06\x65\x74\xcd\x16\xe3\xe0\xbc\x45\xd5\x57\xa0\x4c\x03\x78\xa7\xbd\x93\x11\xa0\x23\xf8\x9c\xdd\x66\x6c\xb9\xca\xbd\xba\xc5\xe2\xdb\x8f\xcd\xbd\xc7\x0a\xfe\xf7\xe6\xcd\xeb\x57\xa5\xc8\x0c\x9f\x24\x7d\x97\x57\x7c\x79\xb1\x19\xdb\x0a\xbc\x1d\xb9\x02\x6d\xef\xe5\xfb\xc1\x6a\xe3\xdb\x82\x9c\xbc\xf2\x0d\x5a\x5b\x41\x1e\x62\xb8\x08\x19\xc6\xcb\x80\x13\x89\x92\xfe\x7c\xff\xdd\x7c\x37\xcf\xdd\x77\x93\x57\x70\xe4\xc9\x66\x6c\xbf\x5d\x98\x1f\x65\x28\xd4\x60\xa9\x46\xe7\xf8\xf2\x1b\x27\x3f\x74\xb4\x51\x9d\xfc\x80\xbe\xc8\xd3\x49\x5e\x5e\x2e\x46\xcf\x82\xd1\x1f\xa6\xc1\x56\x1b\x6c\x42\xc1\xb8\x8b\xb4\xdf\xcd\x63\xc3\xdd\x3b\x8f\x3d\xd4\xaa\xeb\x1c\xf4\xd4\x8c\x1d\x56\x40\x4e\xfe\x76\xa7\x3d\x90\xe9\xee\x23\x3c\xc1\x76\x01\xb5\x8a\x7e\xa2\xc8\xd6\x8a\x07\x09\x53\x0a\xc8\xef\xd0\x9e\xaa\x23\xd9\xb3\x69\x20\x45\x96\xa5\xd8\x12\x5d\xd8\x79\x5e\xc1\x6b\xe6\xba\xc8\xd2\xbd\xc5\xeb\x32\x51\xe4\x8c\xf0\xf1\x9b\x03\x75\x3e\x54\x22\x6d\x62\x02\x0b\xa5\xd2\xac\x8b\x44\x52\x36\x31\x44\x77\x1d\x97\xcc\xe8\x65\x8a\x36\x12\x3e\x53\x83\xf2\xc6\x41\x28\x16\x7b\x37\xe4\x11\xd4\x26\x10\x4c\x69\x1e\xf0\x81\x86\xec\xaf\xc1\xcd\xb8\xdd\x62\x18\x36\xd3\x0e\x4d\x00\x67\xf1\x36\x29\xdb\x38\x09\x1f\x99\xd1\xdc\x7f\x2e\x74\x09\x37\xce\x69\xbc\x0b\x2a\x67\xa3\x4b\x59\x64\x18\xd5\x5e\xe9\x4e\x6d\xb8\x08\x28\xb7\x12\xb4\x81\x8d\xa5\xc9\xa1\x9d\x19\x7a\xae\x01\x4d\x94\x97\x77\x63\x9c\x67\x25\x14\xd3\x89\x64\x06\x12\xa7\xa1\xf1\x04\x63\xd2\x49\x1e\x99\x35\xdb\xad\x9f\x20\x4d\xd2\x93\xd0\xc2\x07\x96\xc0\x16\xed\xa9\x7e\xe9\x16\xd0\xda\xd0\x11\x58\xd3\x9e\x95\xec\x32\xec\x9c\x29\x45\x14\x01\xd6\xf1\x19\xf3\x29\x31\xf1\x1c\x20\x99\x74\x82\x75\x67\xb5\x82\xf7\x69\x9e\x85\x42\xa6\x97\x02\x28\x17\x07\xb2\x51\x1d\x50\x48\xd6\x55\xe0\x34\x3f\x2f\xf0\x2e\xee\xeb\x7f\xb8\xbb\x0c\xba\xe0\xa5\xd1\x8e\x71\x4d\x65\xf4\x18\x5c\xb2\x18\x04\x91\xa4\xfa\xd6\xcd\xaf\x06\x34\x8d\x4b\xcc\x59\xa8\x35\xb7\x84\x14\xd9\x11\xc4\x2b\x06\xf1\xa9\x21\x97\x57\x0c\xe1\xc7\x14\x5f\xac\x45\x31\x73\xb3\x64\x4d\x3f\x0e\x73\xf9\xd1\xec\xe9\xf6\x89\x99\x1e\x6b\x14\x27\x5e\x5e\xca\xcf\x38\x15\xe7\x4f\x88\x0a\x7a\x35\x7c\x73\xde\x6a\xb3\xfd\x11\xc0\x68\x55\x8d\x0f\x07\x06\x3b\xe7\xa9\x9c\x5f\x40\xfc\x63\x4d\xe2\x9e\xcd\x8f\xa3\x36\xbf\x80\x46\x7e\xd2\x5d\xa7\x5d\x78\xd7\xb9\xa2\xac\xd2\xbc\x49\xad\x49\xac\x42\x39\x5a\x4b\xf6\xc9\x7c\xe6\xf2\x1f\xca\x92\x4b\x7a\x7d\xca\x68\xd0\xee\x8c\xcd\xf2\xe8\x76\x34\x16\xdb\xfc\x38\xa3\x26\x71\x10\xff\x06\x00\x00\xff\xff\xcb\xae\xb3\x75\x5e\x0b\x00\x00"),
 		},
 		"/src/testing/allocs_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "allocs_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 158,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x2c\x8c\x4d\x0b\x82\x40\x10\x40\xcf\xcd\xaf\x18\xf6\xa4\x05\xeb\x6f\xe8\x14\x04\x41\xa8\xf7\x58\x75\xb2\x49\xf7\x83\x9d\xd9\x43\x44\xff\x3d\x04\x4f\x0f\x1e\x8f\xd7\x34\x78\x1a\x0a\xaf\x13\xbe\x05\x20\xb9\x71\x71\x33\xa1\x92\x28\x87\xf9\xb1\x11\x80\x7d\x8a\x59\xd1\xec\xd6\x00\x3c\x4b\x18\xb1\x27\xd1\xf3\xba\xc6\x51\xee\x94\xdb\x12\x2a\xc5\xe3\x9e\xd8\xbe\xc6\x2f\x1c\xd4\x76\x0b\xa7\xca\xe4\x12\x94\x3d\xd9\x96\xdc\x74\x23\xdf\xa9\x53\xa9\x6a\x64\xc1\x10\x15\xa5\xa4\xed\x4f\x13\x0e\x1f\xbc\xc4\xf4\xa2\x7c\xed\xac\xa9\xe1\x07\xff\x00\x00\x00\xff\xff\x50\xd0\xa8\x29\x9e\x00\x00\x00"),
 		},
 		"/src/testing/example.go": &vfsgen۰CompressedFileInfo{
 			name:             "example.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1424,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x54\x5d\x6b\xf3\x36\x14\xbe\x96\x7e\xc5\xa9\x20\x45\x5a\x5d\x85\xdd\x06\x7c\x51\xb6\x06\x0a\xa5\x2b\xcd\x7a\x57\x18\xaa\x73\xec\x6a\xb5\x25\x23\xc9\x49\xc7\x9a\xff\x3e\x74\xec\x7c\x8e\xf7\xe6\xbd\x09\x91\x2c\x3d\xe7\xf9\x38\x47\xf3\x39\xdc\xbc\x0f\xb6\x5d\xc3\xdf\x91\xf3\xde\x54\x9f\xa6\x41\x48\x18\x93\x75\x0d\xe7\xb6\xeb\x7d\x48\x20\x39\x13\x75\x97\x04\x67\xc2\xc7\xfc\x1b\x53\xb0\xae\xa1\xbf\xc9\x76\x28\xb8\xe2\xbc\x1e\x5c\x05\x61\x70\xf7\x5f\xa6\xeb\x5b\x94\xd8\xc0\x83\x4b\x18\x9c\x69\xa7\x2d\x05\xd2\x7f\xc2\xbb\xf7\xad\x82\x7f\x39\xb3\x35\xfc\x52\x7d\x98\x94\xfe\xc9\x2b\x56\x77\x49\x3f\x07\xeb\x52\x2d\x45\x59\x96\xf0\xf2\xfa\x04\x00\xb3\xf8\xe6\x44\x01\xd8\xe8\x27\xd3\xa1\xe2\x6c\xc7\x39\x9b\xcf\xe1\x37\xd3\xa7\x21\x20\xc4\xb4\xf6\x43\xd2\x9c\x8d\x7f\x60\x51\x82\x8f\x7a\x45\x0b\xce\xb6\x05\x60\x08\x79\x33\x61\xd7\x2f\x6d\x8b\x52\x68\x01\x37\x7b\x3c\xb8\x01\xa1\x27\x08\xa1\x88\x52\x3e\x7f\x55\x82\xb3\xed\x81\xd5\xb2\xcf\xb4\x5a\x27\x47\x64\x0c\x81\x60\x15\x67\xcc\x47\x7d\xff\x65\x93\xfc\x95\x98\xb1\x43\x69\x28\x61\xcb\x33\x29\x13\x88\x53\x76\x49\x3f\xf9\xad\x54\x9c\xf9\x4f\x28\x21\x85\x01\x27\x25\x2d\x1a\x07\x43\x0f\xd6\x81\x81\x35\xd6\x18\x02\xae\xa1\x32\x6d\x0b\xd1\xc3\x16\xa1\x32\x0e\x02\x56\x7e\x83\x01\x6c\x0d\xe9\x03\x01\x47\x47\xa1\x37\xce\x56\x51\x73\x46\xf7\x20\x67\x20\xc9\x5c\xb6\x8e\x89\x84\xd7\x5d\xfa\x7d\x08\x26\x59\xef\xe4\x91\x85\x5e\x0d\xef\x92\xd8\x29\xc5\x39\x1b\x79\xf8\x88\x50\xdb\x16\x0b\x08\x18\x93\x3f\xb8\x5b\x40\x83\x09\xfc\x90\x7a\x72\x9a\x6d\x35\x9d\x95\x93\x01\x07\xc5\x71\x72\x9d\xd1\x9d\x80\x66\x9d\x1d\xbf\x1f\x03\xd8\x2f\xe5\x96\x9c\x97\x2a\xdf\xfe\x0b\x28\xae\x17\xec\xfc\xe6\xfc\x8b\xad\xcf\x00\x4e\x12\x39\x89\xa4\x3e\x4d\x44\x4c\x5d\xbb\xa0\x8b\xd6\x35\x13\x1f\x92\xb4\x80\xd9\x86\x1a\xe9\x04\x34\x97\x39\x0b\x90\x7a\x8b\x6d\x4c\x80\xda\xd8\x16\xc6\x26\xe7\x8c\xe1\x5e\x01\x45\x40\xb2\x1b\x4f\xb1\x4e\x73\xa0\xff\x0c\xb6\x5b\xf5\xa6\x42\xe9\x87\x94\xbf\x6f\x8d\xfb\xc1\x01\x6c\xf4\x1f\xe4\xe4\xa4\x12\x1b\xfd\xea\x7c\x58\x63\x0e\x9d\xf4\xd9\x1a\xa2\x0f\xe9\xd1\x3a\x8c\xb2\xf1\x49\x65\xf5\xc7\x9d\x0c\xad\xe0\xfa\x9a\x3a\xb5\x3c\xf1\x85\x11\x6b\x4a\x5c\xaf\x26\x7f\x44\xe3\xd3\xe2\xcd\xe5\x29\x22\x4a\x72\xd8\xd7\x52\xd3\xb6\x28\x80\xe2\x3a\xe3\x95\x7b\x99\xed\x00\xdb\x88\x07\x4e\x59\xf2\x55\x09\x04\xf3\x73\xd5\x8f\x15\x1b\x9f\x0a\x42\x3a\x16\x1b\xdd\x20\x90\xab\x12\x84\x80\xef\xef\xcb\x59\x3c\x7b\x22\x6e\x6f\x6f\x61\x79\xf7\xf0\xb8\x80\x59\x04\x39\x8b\x2a\x83\x1f\x5f\x8a\x02\xf2\x00\x14\x04\x38\x06\x9d\xa7\xae\x36\x6d\xc4\xa3\xb4\x8b\x17\xe8\x7f\xf8\xcf\x77\xab\xd5\x09\xfe\x25\xba\x3a\xf2\xbe\x64\x4a\x73\x29\xa7\x47\x62\xc7\xd9\x4e\xaa\x71\xda\x5f\x06\xb7\x1f\x5e\xcd\x19\x36\x7a\x99\xfb\x29\x60\x1a\x82\xe3\x3b\xfe\x5f\x00\x00\x00\xff\xff\x6d\xa8\x39\x72\x90\x05\x00\x00"),
 		},
 		"/src/testing/helper_test.go": &vfsgen۰FileInfo{
 			name:    "helper_test.go",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x74\x65\x73\x74\x69\x6e\x67\x0a"),
 		},
 		"/src/testing/ioutil.go": &vfsgen۰CompressedFileInfo{
 			name:             "ioutil.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 1163,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x53\x61\x6f\x23\x35\x10\xfd\x6c\xff\x8a\x21\x12\xc8\xbe\x8d\x36\xbb\x69\x2f\x52\x7b\x04\xe9\xc8\x05\x74\x52\x29\x28\x6d\x05\x12\x42\x95\xb3\x3b\x2e\x43\x37\xf6\xca\xf6\x96\x44\xd0\xff\x8e\x6c\x6f\xb7\x94\x4f\x7c\x48\x76\x3c\x9e\x7d\xf3\xe6\xcd\xdb\xc5\x02\x8a\xfd\x40\x5d\x0b\x7f\x78\xce\x7b\xd5\x3c\xaa\x07\x84\x80\x3e\x90\x79\xe0\x9c\x0e\xbd\x75\x01\x04\x67\xb3\xfd\x29\xa0\x9f\x71\x36\x23\x1b\xff\x6d\x8a\x7d\x70\x8d\x35\x4f\x29\x3c\x99\x26\x3e\x03\x1d\x70\xc6\x25\xe7\x4f\xca\x81\x53\xa6\x85\x81\x4c\x38\x5b\x4e\xe7\xc3\x00\xb1\xb6\xfc\x61\x08\x78\xe4\x5c\x0f\xa6\x01\x87\x1e\xb1\x15\x72\xac\x85\xbf\x38\x73\x18\x06\x67\xc6\x84\x88\xa8\xe5\xb5\xfd\x53\xc8\xf2\xce\xd0\xf1\x5a\x19\x2b\x24\x14\x40\x26\xac\xce\x85\xf5\xe5\xf7\x18\x7a\x6a\x85\x94\x92\x3f\x8f\xa0\x06\x8f\xe1\x66\xd0\x9a\x8e\x42\x82\x0f\x8e\xcc\x43\x02\x4e\x1c\xca\x2b\xdb\x3c\x0a\xc9\x99\x83\xcb\x75\xe2\xc5\x19\x69\x70\xb0\x5e\x43\x15\xcb\x98\x83\xf5\xc4\x8b\xb3\x67\x9e\x13\xef\xea\xd5\xea\xfc\xfd\xf2\x3d\x14\x50\x57\xf5\xd9\x45\x75\xbe\x5c\x9e\xc1\x62\x01\x8d\x35\x3e\x28\x13\x3c\x68\x67\x0f\x70\x3d\x1c\xd0\x51\xa3\x3a\xd8\x61\x43\x3d\xfa\xdc\x38\x42\x4c\x14\xee\x4c\xf7\x42\x22\x0f\x3b\xca\x59\x7e\x0e\x56\x09\x32\x41\xd4\x78\x01\x05\xb8\x2f\x6b\xbc\x90\xf2\xd7\xfa\xf2\xb7\x38\xdc\x62\x01\x1f\x21\x4e\x18\xc8\x1a\xd5\x41\x63\xfb\x13\x58\x0d\x64\x87\x40\x5d\x79\x8b\x87\xfe\x3b\xea\x70\x0e\xc1\x82\x7a\xb2\xd4\x02\x1e\x83\x53\x90\x97\xe9\xcb\xac\x4e\x18\xcb\x44\xef\x50\xd3\x71\x14\x48\x82\xd0\xf0\xce\xfa\x32\x23\xa0\x73\xf1\x67\x9d\x8c\x92\xb4\x94\xc4\xb2\x3e\xf5\xf8\x44\x4e\x48\xce\x99\x69\xac\xd1\x1d\x35\x21\xde\x55\x9c\x69\xeb\x80\x52\xfc\x01\x08\xbe\x86\xba\xaa\xaa\x18\x16\x45\x92\xd5\xa8\x03\xc6\xdb\x08\x56\x8c\x5d\xe3\x02\x7f\x52\xe1\xf7\x1b\xec\x95\x53\x21\xb6\x2b\x60\xe4\x55\xbc\xd9\x23\x67\x4c\x67\x5a\x89\xc7\x8f\x3d\x9a\x34\x44\x44\x9d\xa7\xcc\xfd\xee\xd3\xcf\xbb\xbf\x53\xb4\xd9\x6d\x3f\xde\x6e\x73\xbc\xfd\x65\x73\x35\x87\x6a\x55\x55\x11\x83\x74\xac\xfd\xec\xb7\x47\xf2\x41\xa0\xcb\xf3\xa5\xfc\x34\x4e\x51\x7c\x78\x3d\xc0\x37\x50\x67\x5b\xb0\xff\x1a\x68\xcc\xbc\x71\xcb\x6b\xd5\xeb\x8e\x59\xf4\x10\x63\x8d\x35\x81\xcc\x80\x3c\x9f\xf7\x0e\xd5\x63\xb6\x57\xf2\xc0\xe4\x5e\x87\xaa\x4d\xa3\x69\xea\x30\x89\x36\x6d\x28\x07\xf3\x7f\x6d\x66\xd4\xe4\x72\x12\x65\x7a\x4b\x26\x5b\xc7\xcb\x2f\xd6\x60\xa8\xcb\xd6\xce\x76\x9b\xcd\xd2\x6b\xa9\x7b\x8b\x1a\x1d\xe8\x72\xd3\x59\x8f\x91\x6e\xfc\x5c\xf7\x83\x86\xf4\xdd\x97\xdf\x0e\x5a\xa3\xe3\xec\xfe\x45\x7c\xb2\xe5\xc6\xf6\x27\xf1\xd5\x7e\xd0\x73\xd0\xff\xb7\xcd\x98\xda\x0f\xba\xbc\xc9\xab\x97\xf3\x58\xcf\x9f\xf9\x3f\x01\x00\x00\xff\xff\x2f\x92\x73\x9b\x8b\x04\x00\x00"),
 		},
 		"/src/testing/sub_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "sub_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 792,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x90\xc1\xaa\xd4\x40\x10\x45\xd7\xe6\x2b\x2e\xb3\x71\x46\x25\xb3\x17\x71\x31\x88\x82\x20\x0f\xde\x64\xff\xe8\x74\x2a\x93\x32\xe9\xea\xa6\xaa\x5a\x1c\xc4\x7f\x97\x04\x1d\x47\x97\xae\xfd\x80\x7b\xea\xd4\x39\x1e\xf1\xb2\xaf\xbc\x0c\xf8\x6c\x4d\x53\x42\x9c\xc3\x85\xe0\x64\xce\x72\x69\x9a\xb1\x4a\x44\x47\xe6\x27\x92\x38\xa5\xa0\xf3\x23\x85\xe1\x13\xa5\xb3\x07\xb7\x13\x8d\x59\xe9\x3d\xab\xf9\x63\x95\xbd\xe3\x45\x77\xc0\xb7\xe6\x99\xb7\xe7\x99\xcb\x7e\xa7\x55\x9c\x13\xb5\xf7\x9b\xfd\x01\x6c\x90\xec\xb0\x5a\x4a\x56\xa7\x01\xfd\x15\x1f\x72\x99\x48\x3f\x9e\xdb\xdd\xa1\xf9\x7e\x77\xb7\xfb\x03\x7c\x3c\xa2\x7b\x78\xf7\xb0\x17\xfa\x32\x67\xf1\x30\x3b\x1d\x5e\xa3\x9b\xd8\x36\x65\x14\xd2\x31\x6b\x32\x98\x2b\xcb\x05\x31\xa7\x12\x94\x2d\x8b\x81\xbe\x16\x8a\xeb\x57\xf0\x8c\x91\x65\xd8\x70\x56\xfb\xa7\x75\xda\x5e\x32\x58\xe0\x13\x21\x57\x2f\xd5\x5f\xa1\xaf\x7e\xd3\x42\xac\xaa\x24\xbe\x5c\xa1\xb4\x5a\x1b\x62\x58\x16\xd2\x0d\xb2\xe4\x18\x9c\xd7\x23\xc1\xb0\xdb\x70\x6f\x34\xc8\x90\xd3\x93\xd4\xd4\x93\xbe\xdd\x61\xa8\xb4\x1e\x4e\x2c\x9c\xc2\xf2\x73\x8d\x20\x03\x2c\x57\x8d\x84\x14\xca\xaf\x24\xed\xef\x84\x37\x81\x21\x93\xc9\xf3\x5b\xb5\xbb\x95\xc1\xea\x38\x72\xe4\xcd\xef\xef\x80\xa7\xff\x01\xff\x25\xe0\x8f\x00\x00\x00\xff\xff\x4f\xfa\xa1\x7c\x18\x03\x00\x00"),
 		},
 		"/src/text": &vfsgen۰DirInfo{
 			name:    "text",
-			modTime: time.Date(2021, 3, 28, 16, 13, 12, 16782000, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/text/template": &vfsgen۰DirInfo{
 			name:    "template",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/text/template/template.go": &vfsgen۰FileInfo{
 			name:    "template.go",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x0a\x0a\x63\x6f\x6e\x73\x74\x20\x6d\x61\x78\x45\x78\x65\x63\x44\x65\x70\x74\x68\x20\x3d\x20\x33\x30\x30\x30\x0a"),
 		},
 		"/src/time": &vfsgen۰DirInfo{
 			name:    "time",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2026, 10, 15, 22, 7, 6, 437604613, time.UTC),
+		},
+		"/src/time/sandbox_zoneinfo_fetch.go": &vfsgen۰CompressedFileInfo{
+			name:             "sandbox_zoneinfo_fetch.go",
+			modTime:          time.Date(2026, 10, 15, 22, 6, 58, 824912511, time.UTC),
+			uncompressedSize: 312,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x8f\x41\x4b\xf3\x40\x10\x86\xcf\xdf\xfe\x8a\x97\x9e\x5a\xbe\xb4\xb9\x0b\x1e\x2b\x1e\xb4\x82\xf4\x20\x88\x84\xcd\xee\x24\x9d\xba\x99\x8d\xbb\xb3\x60\x2a\xfe\x77\x49\xe8\xc5\x83\xe7\xf7\xe1\x79\x66\xea\x1a\xff\xdb\xc2\xc1\xe3\x9c\xab\x3e\x8e\x27\x4a\xe7\xdc\x64\x2b\xbe\x8d\x9f\x8d\x90\x36\x12\x85\xfe\x1c\x3b\x52\x77\x6a\xa2\x84\xc9\x98\xd1\xba\x77\xdb\x13\x94\x07\x32\x86\x87\x31\x26\xc5\x2a\x4f\xd9\xd9\x10\x56\xc6\xd4\x35\xee\x66\x9c\xa5\x5f\x18\x5c\x66\xb3\xb7\x6a\xc1\x19\x2e\x0e\x23\x07\xf2\x88\x45\xd1\x4e\xd8\x5e\x33\xb7\x42\x7a\xb3\xdc\x60\xc5\xcf\x8e\x5f\xc3\xd2\xdf\xce\xfd\x0a\x99\xc5\x11\x58\x21\x44\x3e\xe3\xe5\xf1\xe1\x5e\x75\x7c\xa6\x8f\x42\x59\x77\xa6\x2b\xe2\xb0\xf0\xc7\x0b\x4b\x17\xd7\x62\x07\x42\xd6\xc4\xd2\x6f\xb0\x7e\x7d\x6b\x27\xa5\x0a\x94\x52\x4c\x1b\x7c\x99\x7f\x89\xb4\x24\x81\x70\xa8\x70\xfd\x62\xb7\x3f\x3c\xed\x0f\x47\xf3\x6d\x7e\x06\x00\x03\x0a\x00\xd7\x38\x01\x00\x00"),
+		},
+		"/src/time/synctest.go": &vfsgen۰CompressedFileInfo{
+			name:             "synctest.go",
+			modTime:          time.Date(2026, 10, 15, 14, 12, 49, 668917590, time.UTC),
+			uncompressedSize: 4868,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\x5f\x6f\xe3\xb8\x11\x7f\x96\x3e\xc5\xac\x71\xd8\x95\x36\x8e\xec\x14\x8b\xc5\xd5\xa8\x0f\xb8\xee\xb5\x8b\xf4\xa1\x05\x9a\xbd\xde\x43\x90\x07\x4a\x1a\x59\x4c\x64\x52\x20\x29\xfb\x8c\x45\xbe\x7b\x31\x43\xea\x9f\xe3\xdc\x2e\x2e\x0f\x81\x45\x72\x86\xf3\xef\xf7\x9b\xe1\x6a\x05\x57\x79\x27\x9b\x12\x1e\x6d\x1c\xb7\xa2\x78\x12\x3b\x04\x27\xf7\x18\xc7\x72\xdf\x6a\xe3\x20\x89\xa3\xc5\x4e\xba\xba\xcb\xb3\x42\xef\x57\x3b\xdd\xd6\x68\x1e\xed\xf8\xe3\xd1\x2e\xe2\x34\x8e\x57\x2b\xf8\x19\xf2\x2e\xcf\x1b\x04\x69\x41\xc0\xce\xe8\xae\x05\x5d\xc1\x4e\x1b\xdd\x39\xa9\xd0\x42\x61\x50\x38\x2c\x21\x3f\x81\x3d\xa9\xc2\xa1\x75\x77\x4e\x18\x07\xae\x16\x0e\x74\x6e\xd1\x1c\x10\x04\x29\xab\xc4\x13\x42\xd1\xe8\xe2\x29\x83\x2f\x75\xf8\x09\x5a\x35\x27\x10\xe5\x41\xa8\x02\x2d\x1c\x6b\x54\x80\x07\x34\xa7\xf1\x12\x90\x0a\x5c\x8d\xa3\x29\xa4\x2c\x27\x61\x2c\x97\xe0\x34\x6f\x92\x87\xfc\x43\xe1\xef\x8e\xbf\x0c\x59\x3a\x91\xab\xa4\x41\x9b\xc5\xab\x15\x89\xd3\xfd\xad\xc1\xa6\x2b\x11\x9e\x10\x5b\x0b\xce\x08\xb2\xc6\x8b\x4c\x1c\xd4\x15\x88\xe1\x6a\x05\xd2\x59\xb0\x4e\x38\x04\x9d\x3f\x62\xe1\x36\xa4\x6d\x72\xfc\x51\xcf\xad\x3d\x57\xe8\xe3\x62\x29\x44\x58\xd2\xd6\x7e\x09\x42\xf1\x2f\xd2\xc4\xaa\xdf\x59\x90\x25\x59\xdc\xa9\xc2\x49\xad\x28\xfa\x85\x68\x1a\x2c\x41\xab\x02\x41\x34\x4d\xd0\xbb\x07\x61\xb0\x8f\x05\x68\x03\xf8\xbb\x74\x58\x66\xb1\x3b\xb5\x83\x09\xd6\x99\xae\x70\xf0\x35\x8e\xbc\xe1\xf4\xf7\xfe\xd1\x66\xff\x61\x07\xe2\x48\xe9\x23\xf8\x3f\xa9\xdc\xc7\x0f\x71\xc4\xd1\xb3\xb4\x70\xff\xf0\xde\x74\x8a\xbe\xbf\x70\x44\x57\x2b\xb8\xd3\x26\xe4\x9b\x72\x95\xc5\xd1\x51\x48\x87\x86\x4e\x17\xb5\x50\xe1\xb6\xaf\xcf\x00\x74\xfa\x53\xa3\x6d\x6f\xf6\x2c\x87\xec\xe1\x12\x2a\x6d\xe6\x75\xf3\x9b\x90\x2e\x8b\xa3\x52\x2b\x6f\xe8\xb7\x94\x52\x2c\x26\xe1\xaf\xc5\x01\x43\x10\x96\x14\x8f\x12\x45\xe9\xa3\x43\x4a\xc3\x07\x40\xae\x75\x13\x47\xb6\xa8\xb1\xec\x28\xac\xf4\x4d\x9a\x7f\xab\xd1\xd5\x68\xc0\x3a\x6c\xc9\xc8\xf1\x84\xd3\x60\x3a\x95\xc5\xcf\x8c\x8b\xde\xe4\x7f\xb4\xba\xa8\xe9\x20\xf9\x26\x95\x74\x52\x34\xbe\x14\x27\x65\xf3\xce\xfa\x52\x5f\xc2\x5e\x96\x4a\xee\x6a\x07\xbf\x7e\xf9\x44\x6a\xfe\xb2\x5e\xaf\xaf\xd7\x37\xd7\xeb\x9b\x25\x08\x4b\xe5\xd5\xb5\xd6\x19\x14\xfb\x2c\x2e\xb4\xb2\xee\xec\xa2\x2d\xfc\xf5\xc3\xc7\x8f\x3f\x7e\xf8\x71\xbd\x86\xf7\x3e\x5b\xc9\x1d\x16\x5a\x95\x69\x1c\x1f\x84\x21\x68\xfb\x3b\xad\x0f\xde\x16\xf6\xa2\xbd\x97\xca\x3d\xbc\xf7\xeb\x5f\x9f\xe3\xa8\x11\xd6\xfd\x9d\xbf\x6e\x7f\x81\x2d\xac\x03\xd6\x8b\xce\x18\x54\x61\x07\x0c\xba\xce\x28\x7b\xa1\x94\xc3\xb9\x31\xea\x1c\x68\x25\x9b\x2c\xa6\x82\x9d\xeb\x49\x52\x08\x37\x8f\xf5\xb7\xd9\xc2\xa3\xcd\x3e\x37\x3a\x17\x4d\xf6\x19\x5d\xb2\xf8\xa1\xe8\xcc\xe7\x5e\xdd\x22\xf5\x8b\x5e\x6c\x91\xc6\x91\xac\x02\xe4\xb6\x2c\xf9\xab\x2a\xb1\x92\x0a\x4b\x52\x19\x79\x43\xe9\xfe\x38\x7a\x8e\xfb\xcf\x10\x85\x7b\x96\xf3\xfa\x64\xb9\x48\xb3\x5b\xe5\x92\xf4\xe1\x2c\x8b\x9e\xb0\x18\x93\x76\x04\xbb\xe9\x94\x92\x6a\x07\xd5\xbc\x4a\xb3\xff\x76\x2a\x84\x82\xa1\xff\x47\x44\xda\xcb\x40\xe0\xe1\x0c\x6e\xdd\x10\x59\xc1\xa5\xad\xb0\xf1\x94\xe0\x69\xad\x78\x15\x2f\x84\x08\x4f\x15\x62\x24\x06\x96\x6c\x85\x92\x85\x05\x39\x65\x3b\xd2\x35\xd6\x3e\x25\xa8\xf2\xe7\x08\x09\xf0\xcf\x20\x6f\xa1\x91\x8a\xf6\x8f\xd2\xd5\xb0\xd3\x1b\xfa\x54\x62\x8f\x50\x08\xf5\xce\x79\x62\x59\x82\xd5\xd3\x68\x71\x00\x08\xf4\x96\xe3\xc2\x25\x11\x1c\x91\xce\x62\x53\x85\x3a\x98\x45\x37\xa9\xd8\xe8\x24\x4d\x21\x61\x6c\xff\xed\x7a\x06\xec\x25\x54\x52\x49\x5b\x0f\xa7\xbe\xc6\xd1\xee\xdb\x95\xc2\xb5\xb1\x9b\xd7\x0b\xbc\xb9\x50\x25\xec\x7b\xb2\x98\xf9\x10\x28\xb5\x32\x7a\xcf\x01\x90\x0a\xc4\x60\x35\x8c\xe5\xf7\x1c\xcf\x31\x73\x75\x15\x47\xb2\x24\xe3\xa6\xab\x71\x94\xd3\xd2\xdb\x80\x33\xa5\x8f\x9b\x39\x7a\x97\x9c\xc2\x0d\xec\xc5\x13\x26\x33\xe7\xd3\xe7\x38\xca\xb3\x50\xe2\xe7\x1e\x7b\x96\x5e\xa4\xd9\xbf\xf1\x98\xa4\xc3\xc1\xec\x2e\xd4\xf4\x12\x64\x79\xbe\xec\xb4\x13\xcd\x62\x09\xeb\xf3\x0d\x71\x14\x4f\x78\x69\x83\xd8\x78\xb1\xa4\xbb\x6f\x95\x43\xa3\x44\xe3\xef\x4d\xf2\x8c\xb6\xd2\x74\x20\x96\x7b\x59\x3e\xc0\x16\xf2\x38\x8e\x88\x71\x38\xb0\xff\x13\x4d\x47\xfc\xe7\xd0\x54\xa2\x60\x92\xd9\x79\xbd\x21\x8a\x4b\x08\xb7\xa5\x71\xb4\xd3\x21\xc9\x9c\x98\x12\x2b\x34\xc3\xc2\x54\xdd\x16\x0c\x16\xfa\x80\x26\x49\xe1\x99\x3c\x8f\x2a\xfa\xcf\x3f\x77\xd9\x2f\xd8\xa0\xc3\x09\x4b\x8c\xd0\xcf\x3c\x54\x66\x97\xf0\xe1\xe0\x41\x88\x18\x95\xce\xe4\xba\x37\x5b\xe2\x10\x3e\x1e\xaa\x65\xdc\xa4\xd3\xcf\x5e\x22\xcf\x86\x2e\x32\x39\xba\xe8\x17\x37\xe7\x0d\x49\xf6\x5c\x34\xed\xd4\x8b\xa0\xf0\xf9\x12\x11\x51\x07\x7c\x9d\x24\x66\x0c\xf1\xe2\x2e\x52\xf6\x1d\x8c\x3d\xb5\xe5\x8c\xdd\xf8\xf6\x3f\x4b\x6f\x17\x80\x4f\xfa\x92\xf4\x1c\xee\x14\x3b\x86\xcb\x59\xb3\x60\x3c\xe7\xc4\xf3\x7d\x2e\x42\x7c\x27\xc3\xa0\x05\xa5\x1d\x30\x58\xa7\x18\xf5\xc9\x09\xc3\xc8\x9b\x17\x0a\x68\x03\x44\x63\x50\x94\x27\x12\x6e\x8d\xde\x19\xb4\x36\x08\x0f\x92\xdb\x4b\xf8\x9c\xd4\x96\x3f\x16\xf2\xc6\x53\xda\x38\x9c\xe5\x27\x0e\x77\x3f\x57\xf2\x2c\x7b\x96\xa2\xf9\x48\x3a\x49\x03\x0d\xa6\xdc\x1d\x3a\x65\x41\x77\xce\xca\xd2\x0f\x11\xea\x34\xed\xb4\x56\x83\x74\x53\x76\x9e\x73\x6e\x92\xf7\xed\x36\x65\xe3\x7c\xfd\x73\x64\xc6\x51\x66\xec\x9a\xc1\xf5\x71\x6b\x0b\xce\x74\x18\x47\x23\x07\x7d\x12\x4d\x93\x2c\x7e\xb0\xe8\x68\x02\xd4\x9d\x7b\x85\x25\x68\x62\x4a\x99\x58\x42\x49\xd3\x04\x65\xd0\x76\x7b\xe4\xa7\xc3\x90\x3f\xf6\xc9\x47\xce\x5b\xba\xe1\x88\x50\x4f\xa0\xd8\x72\xc7\x0d\x6d\x65\x6c\x65\x4e\x43\x8e\x61\x64\x0c\x9b\x24\xd0\x8f\xd1\x21\xf0\x93\xd1\x5f\x54\x94\x4c\xff\xac\x20\x95\xa1\x9c\xc3\xeb\xe3\x96\xb3\x60\x7c\x02\x14\xb3\xc5\xcb\x66\x7b\x21\xa4\xe4\x94\x0f\xe9\x3c\x68\x95\x68\x2c\xf6\x71\x1e\x27\x0e\xcf\xb6\x61\xe8\xa0\x9a\x5c\x4f\x27\x16\x1a\x37\xf5\x13\xd2\xcc\xc7\xaf\x27\xbd\x47\xea\x41\xbb\x69\xf6\x27\x56\xed\x51\xa8\x63\x2d\x1b\xcc\x38\x6b\xf6\x28\x5d\x51\x93\xbe\x42\x58\x9c\xdf\xeb\xe9\xbf\xbf\x77\xbb\x85\xf5\x26\x8e\x22\x26\x8e\xc4\xb3\x63\x3a\x88\xcd\x00\x33\x3d\xe6\x37\x88\xa8\x26\xd8\xe0\x29\x8b\x25\x1b\x54\x49\x9e\xf9\x97\x82\xf7\x8d\x84\x1d\x61\xba\x5f\xbe\x5f\x3f\xb0\x74\x78\x4e\x4c\x36\x6e\x36\x0f\x9e\x4d\x5d\xc6\x20\xf9\x09\xf2\x8c\x5e\x21\xcc\xa8\xfe\xe7\x36\xec\x05\xe6\x75\x99\x28\x9c\x3c\xe0\x18\x6c\x2f\xde\xa2\x91\xba\x1c\x63\x1b\x05\x8d\x57\xdb\x61\x93\x56\xb9\x50\xf8\x09\x93\xb8\x9e\xcc\xe9\x15\xc8\xb5\x52\x0d\xd3\x50\xa9\x27\x53\x4f\xde\x39\xd8\x8b\x13\x15\x5e\xa1\xf7\xad\xa4\x54\x0b\xeb\x77\x39\x4d\x8a\x9e\x93\xac\x87\x40\x42\xb8\x74\xb5\xd1\xdd\xae\x86\x7f\x89\x83\xb8\x2b\x8c\x6c\x1d\x23\x96\xa9\x9b\x52\xe9\x33\xcf\x33\x07\x0d\x59\xd2\xd2\x75\x4e\x3c\xb1\x9b\xab\x15\xa9\x67\xdc\xe4\x48\x17\x0c\x04\x6d\x04\x3f\x4a\x1c\x71\x12\xf3\xde\xd9\x6b\xf2\xa8\xbb\xa6\x04\x45\xcf\x66\xaf\x27\x47\x10\x45\xa1\x3b\xe5\xbc\xcd\xe1\x55\x08\x9f\x27\xbd\xa2\x1f\x78\xf9\xe5\xf1\x33\xc1\x85\xa6\xc2\x77\x14\x04\xb4\x4b\xaf\xe7\xec\x31\x4b\xde\x7e\xd7\x50\xf6\x07\xdd\x3f\x7a\x49\x1e\x2e\xab\xa8\x56\x0f\xfa\x09\x93\x4b\xbb\xc2\xec\x3c\xb3\x44\x97\xfa\x7e\xf4\x0d\xd8\x6d\x87\xd2\xf0\xa3\x4c\x92\x42\x78\xff\x7b\xa6\x28\x65\x49\x59\x20\x19\x42\xe2\x8c\x6f\x33\x5f\x2a\x25\x56\xa2\x6b\xdc\x86\xab\x79\x68\xff\x3d\x57\x9e\x03\x8b\x9b\xfa\x0b\xea\x10\x65\x19\xea\x0f\x66\x4f\x6a\x4f\xd0\x3c\x4c\x4e\x11\x15\x47\x44\x82\x12\x7e\x82\x35\xbc\x7d\x3b\x22\x47\x5e\xdf\x3c\xf4\x98\x09\xa5\x4e\xbe\xc9\xeb\xeb\xc0\xe5\x03\xd8\x44\xdb\xa2\x2a\x07\x8d\x4b\xc2\x2e\xc1\x5e\xb7\xa7\x64\x54\x77\x75\xb3\x79\x58\x4e\xd4\x6f\x1e\xd2\x51\xcb\xbd\xa4\x49\xcf\x5d\xf4\xc7\xe0\x5e\x1f\xf0\x75\x97\xd8\xfc\x25\x68\xae\xdc\xcd\x16\x8c\x50\x3b\x1c\x2e\xf2\x46\x57\x61\x7b\xbb\x05\xd7\xa7\xe8\x35\xfb\xef\x37\x72\x66\x28\x19\x9e\x65\x19\xe5\x7f\x68\x66\xfd\x48\xf5\xff\x00\x00\x00\xff\xff\x1b\xae\xda\xe3\x04\x13\x00\x00"),
 		},
 		"/src/time/time.go": &vfsgen۰CompressedFileInfo{
 			name:             "time.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 2120,
+			modTime:          time.Date(2026, 10, 15, 21, 48, 56, 19277537, time.UTC),
+			uncompressedSize: 7147,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x95\xdf\x6f\xdb\x36\x10\xc7\x9f\xc9\xbf\xe2\x66\x6c\x28\xd9\xa8\x52\xd2\x0e\x1b\x56\x44\x03\xb6\x74\x2d\xfa\xd0\x19\x58\xb2\x97\x0d\xc3\x40\x53\x27\x9b\xae\x4c\x6a\x24\xd5\xc4\x31\xfc\xbf\x0f\x47\x51\xb6\xd3\x35\x79\x58\x1e\x02\xf1\xd7\xdd\xf7\xfb\x21\xcf\x57\x55\x70\xb6\x18\x4c\xd7\xc0\x3a\x70\xde\x2b\xfd\x51\x2d\x11\xa2\xd9\x20\xe7\x66\xd3\x3b\x1f\x41\x70\x36\xf3\x83\xa5\xb9\x19\xe7\x6c\xb6\x34\x71\x35\x2c\x4a\xed\x36\xd5\xd2\xf5\x2b\xf4\xeb\x70\xfc\x58\x87\x19\x97\x9c\x57\x15\x7c\x50\x1f\x11\xc2\xe0\xc7\x68\xe5\xef\xd6\xdc\x41\x3b\x58\x0d\xca\x36\xe3\xd4\x8d\xd9\x20\x84\xe8\x07\x1d\xc1\x44\xf0\x18\x07\x6f\x03\x28\x8f\xa0\xba\x5b\xb5\x0d\x60\xac\xee\x86\x06\x1b\xb8\x35\x71\x05\x71\x65\x02\x4c\x12\x45\x83\xa1\x37\x11\xe1\xcd\xd5\x2f\xb2\xa0\x84\x0b\xd4\x6a\x08\x08\x71\x85\xdb\x67\x1e\xc1\x22\xd2\xd1\xd6\x79\x30\x36\xa2\xb7\xaa\x33\xf7\x2a\x1a\x67\x2b\xbc\x7b\x30\x06\xd7\x1e\x15\x55\x6f\x54\xc4\x12\xae\x11\xc1\x84\x30\x20\xac\x62\xec\xc3\xeb\xaa\x7a\xd2\x77\xda\x1a\xaa\x97\xdf\xff\x50\xf2\xe4\xd2\x58\x13\x85\x84\x1d\x67\x55\x05\xea\x93\x33\x0d\x34\xa8\x1a\xd0\xae\x41\xc0\xce\x6c\x8c\x4d\xb9\x39\xfb\xa4\x3c\xfc\x0d\x09\x46\x0d\x84\x49\x9c\x17\x70\x2e\xf9\x9e\xf3\xb8\xed\x11\x32\x7b\xda\xe0\x27\x5c\x3b\xce\x0c\x8c\x7f\xc6\xc6\x57\x2f\x39\xbb\x5d\xa1\xcd\xc3\xef\xbe\xe5\xac\x47\x6f\x5c\x73\x18\xb6\x79\x33\x49\x13\x89\x46\xab\x34\xee\xf6\x05\x0c\xc6\xc6\x3e\x7a\xc9\x99\xf2\xcb\x29\xe0\xb4\xcc\x59\xc0\x7f\xd2\x64\xde\xc6\x19\x49\x71\x43\x84\xe7\xeb\x50\xce\x17\x6b\xd4\x91\x33\xa5\xa3\xf9\x84\x00\x0b\xe7\x3a\x92\x9d\x00\x58\x77\x2b\x24\x88\x80\x7a\x14\x51\x80\xcd\xdf\xaf\x5e\x16\xb0\x71\xd6\x8d\xf3\x89\x91\x85\xd7\xf5\x64\xf4\x57\x65\x9d\x90\x9c\x8d\xef\x01\x2c\x54\xe3\x46\x71\x8d\xda\xd9\x46\x16\x63\x0c\x61\xe1\x9b\x87\x0b\xb2\x00\x7b\x48\x7f\xdd\x21\xf6\xa2\x81\x37\x83\x4f\x9c\x53\x1a\x4d\x69\x36\xea\x23\x0a\xbd\x52\x36\xc3\xdc\xed\x25\x67\xeb\x50\xbe\xeb\xdc\x42\x75\xe5\x95\xea\x3a\x31\xfb\x3a\x60\xbc\x19\xad\xce\x0a\x58\x87\xf2\x7d\x7e\x42\xa3\x67\x91\x40\x4a\xd8\x81\xee\x5c\x40\xa1\x25\xec\x47\x61\xa2\xa9\x3e\x98\xae\x33\x21\x6b\xe2\xec\xf2\x85\x3e\xa8\x0a\x51\xf9\x14\xd7\x8b\x08\xcf\x4f\x6f\x36\xe9\x8b\x65\x46\x59\x43\xf4\x03\x72\xd6\x98\xb6\x25\xcd\x22\x96\xe9\x82\x5f\x3c\x84\x24\x0f\x6c\x4e\x73\x72\x66\x5a\x48\x27\x7f\x84\x8b\xcb\xcb\x57\x17\x2f\x2e\x60\x07\x55\x05\x1b\x15\x57\xe5\x07\x75\xf7\x7e\x7c\x32\x99\x30\x67\xfb\xe3\x89\x4b\x38\x27\x21\x63\xe2\x1a\xce\xd3\x62\x2c\xa7\x5b\xaf\xe1\xff\x82\xe2\xec\xd4\x5d\xab\xba\x80\x9c\x51\xda\x58\xe6\xb7\xfa\x55\x9d\x73\xb3\x6c\xf6\xac\x3e\x2c\xd2\xec\x29\x3b\xc9\x19\x09\x63\x4b\x07\xb1\x6c\x45\x2c\x95\x5f\xa6\xa2\x61\x74\x0d\x24\xfe\xec\x42\x9e\x50\x77\xfd\x23\xd0\xe9\xc9\x52\xd2\xcf\x6d\xe9\x0e\x95\x3f\xfa\x3a\x10\x90\x9c\xdd\xaa\xf0\xd3\xe8\xe3\x35\x09\x1c\x3d\xf1\x2f\xb8\xcb\x0f\xf8\xb0\xff\xa0\x67\xe3\x9a\x2f\xca\x29\x80\x7c\x17\x90\x81\xe4\xb2\x69\x9f\xa8\xda\x02\xa8\x6a\x1f\x2c\x51\xc5\x4e\xcb\xe4\xec\xc4\xbc\xe4\x13\xda\x3a\x65\xa2\x61\xce\x55\xc3\x04\x3a\x96\x74\xf1\x6d\x32\xe4\x97\x50\x53\x06\x1a\x50\xdc\x9a\xa2\xf3\xcf\x6e\x62\x72\xe5\x31\x3f\x85\x47\x7c\x4d\xe5\x3e\x21\x7f\x84\xe3\x11\xce\x84\x63\x12\x49\x5f\x2d\xfd\x4b\x97\x9d\x14\xc9\x27\x28\xb7\xce\x6b\xfc\xc3\xf4\x6f\x4d\x87\x6f\x9d\xbf\xc1\x10\x8d\x5d\x8a\x7b\xd3\xcf\x6d\xb7\x4d\x32\x08\xd0\x9e\x73\xfa\x05\xbe\x77\x16\xaf\xdd\xe0\x35\x06\xa8\xe1\xcf\xbf\x42\xf4\xc6\x2e\x77\x9c\x65\x23\xe5\xbb\xf9\x6f\xf3\xf9\x8d\x90\x70\x06\xb3\xaa\x33\x8b\x8a\x66\x2b\x3a\x66\x6c\xeb\xca\x7b\xd3\xcf\x0a\x0a\x56\x51\x49\x36\x78\xf7\xf3\x36\x52\x07\x01\xed\x7a\x43\x6d\xc8\xbb\x0d\x8c\x41\x8f\x4d\x2c\xba\xdc\x1a\xc6\x56\x6b\xec\x92\x1a\xa1\x08\xc6\xea\xd4\xc7\xc0\xa3\xea\x52\x6b\x3a\x1c\x69\x1c\x06\xfb\x2c\xca\x43\x9b\xc9\xa9\x44\xc8\xd1\x0b\xd0\xb0\xd8\x46\x94\xc4\x9b\x38\x67\x40\xff\x2d\xcd\x20\xf3\x63\x4f\x41\xe6\xed\x58\xbf\xb9\x0c\xde\x61\x14\xb3\xeb\x14\x71\x36\xed\x23\x0f\x57\x2b\xe5\xaf\x5c\x83\xb3\x02\xb4\x94\x14\x52\xd0\x13\xf8\x37\x00\x00\xff\xff\xa0\x20\x9e\x50\x48\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x59\x6d\x8f\xdb\x36\xf2\x7f\x2d\x7d\x8a\x89\xf1\x6f\x23\x25\x5a\x79\x37\x29\xfa\xc7\xe5\xd6\x01\xd2\xe4\x52\xe4\x80\x76\x81\x6e\x8a\x03\x2e\x08\x0a\x5a\x1a\xd9\xdc\x95\x48\x95\xa4\xe2\x38\x8b\xfd\xee\x87\x19\x92\x92\xec\x75\x52\xdc\xf9\x95\xf8\x34\x9c\xc7\xdf\xcc\xd0\xcb\x25\x3c\x5d\x0f\xb2\xad\xe1\xc6\xa6\x69\x2f\xaa\x5b\xb1\x41\x70\xb2\xc3\x34\x95\x5d\xaf\x8d\x83\x2c\x4d\x16\x66\x50\x34\xb7\x48\xd3\x64\xb1\x91\x6e\x3b\xac\xcb\x4a\x77\xcb\x8d\xee\xb7\x68\x6e\xec\xf4\x71\x63\x17\x69\x9e\xa6\xcb\x25\xfc\x22\x6e\x11\xec\x60\x3c\xb5\xf2\x77\x25\x3f\x43\x33\xa8\x0a\x84\xaa\xfd\xd4\x7b\xd9\x21\x58\x67\x86\xca\x81\x74\x60\xd0\x0d\x46\x59\x10\x06\x41\xb4\x3b\xb1\xb7\x20\x55\xd5\x0e\x35\xd6\xb0\x93\x6e\x0b\x6e\x2b\x2d\x44\x16\xb3\x1a\x6d\x2f\x1d\xc2\x9b\xd7\xff\xc8\x0b\xba\x70\x8d\x95\x18\x2c\x82\xdb\xe2\xfe\xb1\x41\x50\x88\x74\xb4\xd1\x06\xa4\x72\x68\x94\x68\xe5\x17\xe1\xa4\x56\x4b\xfc\x7c\x30\x06\xdd\x4c\x1c\x2d\xdf\x08\x87\x25\x5c\x23\x82\xb4\x76\x40\xd8\x3a\xd7\xdb\x17\xcb\xe5\x37\xe5\xe6\xad\x76\xf9\xec\xff\xff\x56\xa6\x2c\xa5\x54\xd2\x65\x39\xdc\xa5\xc9\x72\x09\xe2\x93\x96\x35\xd4\x28\x6a\xa8\x74\x8d\x80\xad\xec\xa4\xe2\xbb\xd3\xe4\x93\x30\xf0\x07\xb0\x32\x56\x40\x6a\xca\xce\x0b\x38\xcf\xd3\xfb\x34\x75\xfb\x1e\x21\xe8\x9e\x36\x98\xa8\xae\xbb\x34\x91\xc0\x3f\xa9\xdc\xf3\x67\x69\xb2\xdb\xa2\xf2\xa3\x1f\x7f\x48\x93\x1e\x8d\xd4\x75\x1c\x35\x7e\x27\xb1\x95\xb1\x26\x1a\x51\xe1\xdd\x7d\x01\x83\x54\xae\x77\x26\x4f\x13\x61\x36\x81\x58\x5c\x4d\x13\x8b\x7f\xd2\x5c\xd8\x94\x26\xa2\x72\xf2\x13\xc2\x5a\xeb\x96\x85\xea\x51\xd5\x52\x6d\x40\x5a\xb0\xe8\x60\xb7\x95\x2d\x33\xcb\x8c\x5a\x9a\xde\xa3\x03\xa7\xa1\x12\x6d\x0b\x0d\x1b\x42\x40\x3d\x78\x87\x30\x25\x13\xb9\x76\xba\xef\x89\x8a\x36\x60\xd0\xa2\x73\x34\x70\xdb\xb0\x09\xaa\x16\x05\x11\x73\x05\x58\x0d\x6e\x2b\x1c\x34\x20\xad\x7a\xec\x98\x2c\xd6\x65\x9a\x44\x46\x3c\x67\xeb\x61\xbd\x6e\x11\xe0\x49\xf8\xa0\x4b\xd0\x81\x6c\x66\x54\x07\x8b\x96\x87\x0d\x39\x69\xd5\xea\xea\x96\x7c\x40\x80\xdd\xab\xca\xa1\x75\xe0\x0f\x97\x64\x84\xe5\x32\x9a\xe0\x57\xa1\xf4\xe8\xa6\x74\xbc\xd3\x4a\x3b\xad\x64\xe5\x69\x14\xa4\x84\x6a\x4b\xa2\xaf\x85\xc5\x1a\xb4\x4a\x59\x51\xa6\xd1\xa6\x13\xaa\xc2\x52\xe9\x5d\x96\x4f\xb2\xd4\x83\x61\x2f\xb0\xd0\xa1\xa0\x60\x89\xae\x4e\xde\x78\x2d\x55\x85\x63\xb8\x18\x4b\xb4\x84\x41\x92\x5d\x34\x0d\x56\x0e\x6b\x58\xef\xa1\xda\x0a\xb5\x41\xcb\x4e\xbc\x45\xb0\x7b\xeb\xb0\xf3\x0c\x05\x5f\x9c\xb1\x9f\xe5\xde\x2b\xd8\x83\x1a\x58\xc3\x8b\x15\x54\x83\x31\xa8\xdc\x4f\x2c\x72\x96\xff\x1d\xd6\xf0\x68\x05\x4a\xb6\xb4\x29\xf1\xf2\xc2\x9a\x58\x4f\x93\xfb\x34\x4e\x30\x99\xec\xc6\x96\x3f\xb7\x7a\x2d\xda\xf2\xb5\x68\xdb\x6c\xf1\x7f\x4a\x28\xcd\x50\x91\x97\x6f\x5b\x2d\x5c\x96\xe7\x41\x89\x4a\xef\x0e\x94\xb7\x23\xb7\xf0\xba\x6f\x8c\xee\x80\xe3\x2e\xe8\xc7\xeb\xb1\xd1\x6d\xab\x77\xf6\x81\x58\x1c\xee\xac\x97\x87\x36\xf0\xb4\x66\x12\x17\xd0\xca\x5b\x84\xa1\xb7\xce\xa0\xe8\x82\x4a\xf8\x1e\xc8\x2c\x56\x5e\x90\x02\x54\xf8\x7e\xfe\xac\x60\x9a\x7e\x3e\xff\xdf\x14\x05\xcb\xa0\x9f\x6b\xac\xb4\xaa\xf3\xc2\x53\xce\xfc\xe2\x77\x87\x8b\x79\x31\xd3\x2e\x5f\xbd\x3a\x34\x59\x9a\x74\x96\xee\x9f\x94\xfd\x33\xba\x6c\x41\x0a\x5b\xe4\x41\xf1\x4a\xef\x16\x79\xf9\x8e\xe9\xe6\xa3\x91\x3a\x0b\x4b\xb8\x38\x3f\x3f\x8f\x0c\x74\xf6\x3b\x1a\xe6\xf0\x24\x4c\xfc\x22\xdb\x56\xda\xc8\x25\xdd\x4e\xe6\x62\x1d\x5d\xb7\x88\x7d\x56\xc3\x9b\xe0\xa4\x51\x17\x47\x6a\x98\xeb\x40\x36\x50\xc3\x4b\x38\xe7\x41\x72\x79\xf6\x2b\xee\x18\x0e\xb2\x3a\x2f\x5f\xa7\x09\x09\x18\x58\x63\x61\x97\x4b\x78\x2b\x64\x0b\x6b\x6c\xb4\xc1\x31\x40\xf5\xe0\xe0\x16\xb1\xf7\x96\xef\x8d\xde\x18\xd1\x81\x68\x09\x7e\x42\x1c\x6f\xb4\xd1\x83\x93\x0a\xa1\x12\xea\xb1\x63\x52\x6b\x84\x5e\x98\x5b\xc6\x84\x07\x7e\x59\x6d\xb1\xba\x7d\x2d\xd4\x4f\xe4\x24\x8b\x02\x16\x3e\xc4\x48\xc4\x45\x9e\x26\x15\xe9\xb7\x13\xb7\x98\x51\x38\x05\x98\xbd\xbb\xcf\x99\xf0\x6f\x7a\x50\x35\x0c\xfd\x14\xb7\xa7\x1c\xaf\xd6\xc8\xb8\x64\x90\xd3\x66\x8b\x96\xf8\x17\x0a\x4e\xf2\x63\xd1\xbd\xf7\xa2\x2e\x0a\x32\xec\xbb\x90\xa2\xae\xd6\x37\x58\xb9\x8c\xc1\x3a\x87\x3b\xa2\x6d\x31\xab\x72\xb8\xf7\x4e\x94\x65\xf5\xd3\x99\xcd\xce\x2e\xf2\xe5\xdc\x84\x79\x9a\x5c\x9e\x55\x21\xe2\xdc\x88\xc4\x02\x3a\xa9\xce\xb6\x28\xfa\x08\x13\x01\xcd\xc3\x16\x3d\x38\x2b\x6b\xa4\xc5\x23\x08\xb4\x1c\x6c\xda\xd4\x68\x3c\xdc\x50\xa6\x29\x3c\x4a\xcd\x33\x53\x29\x61\x8d\x11\xbb\xa5\xaa\xf1\x33\x48\xc5\x03\xba\xb5\x84\x2b\xd5\xee\x69\x48\xd4\x50\x98\x56\xd2\x15\x7c\x3b\x6c\x05\x31\xf8\x4f\xf1\x49\x5c\x57\x46\xf6\x2e\xfa\xc0\xa4\x6e\xeb\x84\xe1\xc4\x40\x41\x6f\x63\xca\xf0\xbc\x33\x7f\x1c\xe2\x6e\xab\x2d\x8e\xb9\xfc\x55\xe3\xd0\x10\x0f\x02\x2c\xb6\x58\x39\x68\xb5\xee\x41\x1b\xda\x51\x69\xe5\xf0\xb3\xe3\x94\xdc\x4a\x85\xb6\x20\x2d\x55\xcc\x6a\x4a\x09\x39\x4b\x93\xa0\x9a\xf0\xfb\xf0\xf1\xc9\x5c\xdc\xb8\x1c\x6c\x08\x4f\x6e\x6c\xe9\x4d\x47\x09\xe7\x3d\xb9\x6d\xc8\x4a\xa7\xe4\x92\x0d\x08\xb5\x2f\x23\x91\x7f\xf9\xe4\x1d\x90\x99\x7f\xcb\x25\xf0\xec\xe1\x2d\x8d\x34\x68\x4b\x2a\xb0\x38\x40\x59\x2d\x3e\xbe\x1c\x1c\xb0\xc7\xa1\xea\xca\x60\xe4\x15\x38\x33\x20\xc7\xae\x2b\x43\x5a\x5c\x4d\x41\x3b\xcd\x1d\x87\x36\x07\xe9\xfc\xd4\xa3\x87\xa7\x4a\x51\xd7\x81\x87\xfc\x30\xba\x5d\x29\x61\x15\x60\xa6\x45\x95\x79\x59\xc8\x45\xfd\x17\xac\x40\xf4\xa4\xa6\xb0\x52\x00\x51\xb0\xb2\x71\xbf\xf7\x9e\x22\xf9\xbb\x2b\x65\xce\x15\x4a\xc7\x73\x36\xcb\x47\x80\x22\x4f\xf8\x8a\xf8\x54\x0b\x10\x9b\x3b\x61\x5f\x79\x25\xbc\x58\x41\x6d\xc6\xfd\x47\x14\x03\xdb\x30\x6e\x0f\x11\x34\x9e\xe0\x2a\x27\x5e\xc7\xde\x4f\xf6\x18\xfa\x5a\x8c\x05\xcb\x29\x43\x53\xcd\x33\x25\x6f\xf6\x65\x61\x10\x0c\xb2\xe9\xb0\x06\x23\x37\x5b\x07\x62\x27\xf6\x21\x31\xd5\xe6\x2f\x65\xfa\x2b\x83\x18\xec\xf4\x27\x9c\x04\xbd\x07\x6c\xad\x47\xce\xe8\x11\xdf\x7f\x0f\x64\x9d\x97\xab\x00\xd5\xf3\x23\x33\xa5\xdf\x1f\x29\x30\x9e\x3f\xf0\xad\x46\xb4\x96\x67\xa2\xc7\x8f\x53\x5f\x51\xea\xa8\x78\x06\x5c\x1b\x9a\x84\x08\xab\x0f\xd5\xe8\xdd\x9e\xb0\x27\x26\x88\xaf\x00\x89\xb4\x54\x5e\x96\xf0\x6a\x3c\xe9\x0b\x46\x3e\xee\xf7\xf2\xa6\x5b\xec\x5d\x01\xc2\x4e\xd5\x2a\x11\xb3\xe8\x7c\xc2\x51\x84\x0c\x5a\x61\x2c\x43\x3c\x97\xfd\x60\xb7\x5c\x65\x8a\xea\x76\xc2\x8d\x80\x19\xf0\x2a\xc6\x7b\xc4\x5d\xba\xbd\xd2\x83\x72\x96\x2e\x12\x8a\x6c\x7c\x3b\xcf\x5b\xec\x1a\x5b\xf4\xa4\x38\x7f\xa0\xc3\x8a\xf2\x6c\xc0\x3d\x34\x2c\x63\x37\x10\x16\x23\x28\xad\x70\x74\xbc\x03\xf4\x0e\x9e\x33\xf3\xe7\xe0\x25\xb3\xa0\x23\x47\x39\x67\xb3\x1f\x00\x4a\x70\x9f\x71\x9e\x31\xe7\x72\x15\x46\x1f\xce\x3f\x96\xdc\x58\xdc\x1d\x06\xb6\x6c\x4e\x93\xa1\x6d\x0f\x13\x2f\x55\xf0\x53\xaa\x3b\x38\x48\x80\x71\x48\x89\x09\xc5\x4b\xe6\xfc\xaf\x26\x57\x1d\xf9\x60\xde\x5e\x1c\x73\x9b\x26\xb5\x6c\x1a\x9a\xcf\x78\xc3\xd9\x61\x31\x95\x8f\xb5\xd9\x3c\x6f\xf2\x7d\x7c\xee\x25\x5c\x5c\x5e\x3e\xbf\x38\xbb\x80\x3b\x42\xe1\x4e\xb8\x6d\xf9\x8b\xf8\xfc\xce\xf7\x59\x47\x6a\xe0\x13\x97\x81\x33\x1e\xac\xe0\x9c\x17\x67\xfa\x5c\x81\x67\xeb\x58\xd2\xff\xb6\x26\x18\xbd\x35\x2f\xf8\xe2\xa7\x17\xf9\xd4\x9f\xf8\x15\xfa\x0a\x9d\xcd\xa0\x2a\xdf\x59\x84\x84\x3f\x36\x5e\xb6\x84\x77\x8e\xd3\x1d\x37\x50\x21\xae\x88\xcc\x89\xd0\x9b\x15\x06\x42\xed\x27\xf7\x2d\x39\xc7\x8d\x01\xaa\xcd\x14\x39\x7c\x09\x91\x0b\x8d\x61\x28\xee\xcc\xa0\x54\x80\xca\xae\x88\xd5\xfb\x9e\xa6\x43\x92\x96\x6a\xd3\xce\x02\xe4\xa0\xd8\x22\x72\xe3\x8a\xf5\x27\x39\x9e\x86\x1e\x6a\xcd\x55\xd7\xa0\x3c\xd5\x5e\x5b\x2b\xd7\xed\x1e\xd6\xbe\x25\x0b\xd7\x53\xb3\xe1\x35\xd1\xb1\xc6\x84\x8a\xe1\x5d\xe9\xa1\xad\x39\xc8\x79\x95\x73\x92\x30\x63\x5c\x52\x39\xb9\x5c\xd2\x99\x2b\x8a\xc9\x39\x1b\x9d\xf0\xfc\x8f\xe5\xab\x70\xd3\x32\xd7\x83\x2c\xc4\xa4\x79\x10\x21\xa6\xb9\x4c\x05\x61\x23\x6a\x8c\x0a\x91\x66\x66\x39\x61\x10\x34\xd5\x4d\xc1\x52\xbe\xf6\xdd\xc3\x0e\xb9\x0b\x64\xd0\xa2\x62\x08\xeb\xb1\x7d\x26\x35\x56\x58\xc2\xef\xca\xc9\x96\x76\xab\x18\x72\xa1\xe1\xd4\xa1\x44\x45\x5f\x56\xed\x03\x94\x10\xad\x99\xad\x63\xdd\x16\x0a\x3d\xa1\x42\x49\xa7\x1b\x38\xbb\x98\xd7\x65\xb1\x14\x23\xc5\xf9\xc2\xd7\x5f\xe4\xd3\x1d\xee\xa1\x96\xb5\x7a\xec\x31\xf8\x74\x27\x36\xfa\xae\x87\xad\x93\x70\x40\x4d\xd3\x8b\x07\x4d\x11\xd5\x69\xa4\xdb\x07\xa5\x19\xb9\xe3\xd7\xb1\x6f\x44\xb5\xcb\x15\xf7\xa4\x9c\x3b\x0f\x60\xe4\x28\x21\x9e\x33\x4c\x71\x31\x73\x76\xc1\x9f\x53\xa6\xf3\x85\x55\x42\x6c\x8c\xd5\x4c\x3d\xa0\x2f\x65\xee\x3d\x2b\x7f\x14\xc0\xf4\x0d\xf5\xe9\xcc\x71\x68\x95\x5c\x19\x1e\x6a\x1e\x45\x70\x4b\x9c\x67\xed\xe9\x6a\x5c\x4c\x93\xb0\xd7\xf3\x0c\xe7\x1e\x97\xae\x3e\xa1\x69\x5a\xbd\x2b\xd3\x64\x3a\xb6\x22\xec\xfa\xf1\x39\x9c\x01\xf1\xc9\xdd\x56\x32\x2f\x12\x73\xdf\x82\xdd\x1f\x15\x3f\x01\x6a\xeb\x01\x4f\xe3\xec\x46\x43\xec\x47\xd2\xe4\x1b\x22\x11\xa1\x47\x93\x76\x78\x2a\xa1\x52\x5b\xaa\xc1\x3f\xc4\x9c\xf4\x56\x90\x8e\x6a\x04\xce\xdd\x23\xdb\x27\xca\x89\x43\x9d\x8d\x8c\x26\x27\x8a\x91\x91\x48\x93\xb9\x52\x98\x4d\x01\xae\xb4\xf8\x67\x94\x3f\x1b\x81\x73\x32\x73\xf8\xb6\xb3\x67\x22\xe1\x62\x27\x33\x8f\x89\xe8\xb7\xf3\x92\x09\xa4\x72\xac\x9d\x56\x58\x56\xcc\xdc\xfd\xd8\x1a\xb2\x01\x49\x76\xe6\x0d\x77\x63\xde\xfb\x20\x3f\xc2\xe8\x79\xb4\xf6\x71\xbe\x34\x2b\xa0\x65\x3e\x4b\x2b\x7e\x67\x8c\x8e\xb1\x9a\x0e\x8b\x2f\x02\x9d\x07\x57\x1e\x94\xd6\x79\x98\x78\xa3\x77\x6a\x9a\xf2\x4d\xf9\x6f\xd8\xa2\xf0\x4f\xa9\xd0\x61\xa7\xcd\xde\x3f\x8f\x91\xf8\x3e\xf2\xc9\x5e\xdd\x50\x6d\xa1\x15\x66\x83\x06\x34\xa1\x0e\x5f\x59\x89\x7e\x94\xfc\x25\xfc\xf8\x03\x05\xde\x5c\x1b\x97\xab\xf9\x96\xe5\x0f\x33\x65\x4c\x21\xc4\x9d\xf8\x51\x5c\x17\x70\x5e\x1c\x1c\x7d\x96\x47\x6c\x2b\xcb\x92\x79\x1f\x1b\x83\xb9\xa0\xa3\x6d\xf8\x25\x78\x7c\x9d\xe8\x85\x41\xc5\xb6\xca\x24\x99\x88\xea\x82\x67\x21\x2e\xbd\x1e\xfd\x8e\x09\x2c\x26\xb3\x8c\x45\x51\xb2\x36\x28\x6e\xc3\xc3\x86\xdd\x89\x3e\x04\x95\x2c\xc0\x9f\x26\x2d\x93\x11\xfd\xe8\x98\xc7\x99\xee\x0f\xb8\x64\x63\x75\x04\xfa\xde\x9b\xe4\x14\x76\xd5\x56\xb6\xf5\x14\x7a\x1f\xca\xb2\xfc\x28\x95\xbb\x7b\xf6\x44\xc2\x53\xb8\x28\xc0\x7f\x3c\xbb\x1f\x23\xd2\x9f\xb8\x3c\x30\xc2\x04\x86\xbc\x1a\x65\x8c\x93\xf1\xee\xb9\xa4\x13\x43\x2b\x4f\x32\x46\xd9\xbd\x57\xda\xb4\xbc\x02\xe9\x8f\x44\x08\x39\xa1\x9d\xb8\x3b\xea\x27\x8e\x0f\x34\x74\x70\xe2\x66\xd4\xd0\x68\x87\x68\xfe\x0f\x37\xb3\x20\xba\x99\xa6\xe5\xc7\xf4\xab\xb1\x34\xee\x9e\xcd\xdf\x4c\xad\x65\xa7\xeb\x93\x5d\x58\x11\xde\x3f\xe6\x2f\xec\x05\x34\xdf\x78\x5c\x2f\x80\x1e\xd7\x0f\x96\xe8\x65\x3d\x2e\x93\x40\x87\x7d\xe9\x88\xe5\xa1\x6a\x1c\x01\x0f\x62\x42\x70\x25\xd5\x99\x0d\x7d\x10\xed\x15\xdd\x40\x03\xa2\xbb\x22\xea\xe9\x11\xea\x47\xa9\x18\x74\xbf\x21\x57\x7c\xf3\x3c\xd9\x3d\x4f\xcd\xdf\xa4\x9c\xa8\x8e\xc8\x24\x7d\x35\x05\x1c\x61\xee\xa9\x3e\x90\xf9\x69\xb4\xa9\xf0\xdf\xb2\x7f\x2b\x5b\x7c\xab\xcd\x7b\xb4\xd4\x4d\x67\x5f\x64\xcf\xcf\x45\xc4\x06\x29\xe8\x3e\xe5\x37\x99\x2f\x5a\xe1\xb5\x1e\x4c\x85\x84\x14\x1f\x3e\x5a\x67\xa4\xda\xdc\xa5\x49\x10\xa4\xfc\xf9\xea\xb7\xab\xab\xf7\x59\x0e\x4f\x61\xb1\x6c\xe5\x7a\x49\xb3\x4b\x3a\x26\x55\xa3\xcb\x2f\xb2\x5f\x14\x01\xf8\x19\xd7\x7f\xda\x3b\xe4\x42\x58\xf7\x12\x6b\xff\x72\xec\x89\x4e\xff\x33\x39\x1d\xfe\xbd\xf1\xff\x86\xf1\x1f\x1e\x0e\x32\x9f\xb8\x08\x1f\x0d\x8a\xd6\x37\xa5\xf1\x48\x78\x08\xcc\xc7\x7f\x82\xc2\x55\x99\x0d\xd4\x0b\xa8\x60\xbd\x77\xc8\x6f\xf1\xa4\xe7\xa0\xa0\x87\x25\xbf\x8d\xcf\xbb\x4c\xe4\xaa\x59\x14\xb3\xae\x81\x1f\x81\xaf\x99\xe2\xf8\x0c\x4c\x32\xbc\xde\x0a\xf3\x5a\xd7\xb8\x28\xa0\xca\xf9\x4d\x98\xf3\xdd\x7f\x06\x00\x1f\xca\x1c\xce\xeb\x1b\x00\x00"),
 		},
 		"/src/time/time_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "time_test.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 263,
+			modTime:          time.Date(2026, 10, 15, 21, 49, 25, 1541519, time.UTC),
+			uncompressedSize: 1537,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xcd\x3d\x4e\xc4\x40\x0c\xc5\xf1\x7a\x7d\x8a\xa7\x54\x09\x48\xd9\x53\xd0\xd2\x6c\x68\xb6\x41\x93\xc1\x9b\x35\x9b\xd8\xa3\x19\x27\x41\x42\xdc\x1d\x0d\x1f\x12\x0d\xed\xb3\xfc\xff\x1d\x8f\xb8\x1f\x57\x99\x5f\xf0\x5a\x88\x52\x88\xb7\x30\x31\x5c\x16\x7e\x76\x2e\x4e\x24\x4b\xb2\xec\x68\xe9\xd0\xd4\x41\x74\x6a\xa8\x23\xba\xac\x1a\x31\x70\xf1\xd3\xcc\x9c\x5a\xc7\xdd\xcf\xb5\x1f\x3a\xbc\xd3\xc1\xfb\xd3\x4d\x52\xdb\xd4\x52\xff\x68\x7b\xdb\x41\x0a\xd4\x1c\x21\xc6\x35\x07\x67\xb0\xda\x3a\x5d\x71\xb1\x0c\xbf\x32\xea\x7f\xd3\xd1\xc7\x9f\xf6\x83\x6e\xc3\xf9\xa9\x84\x89\xff\x07\x86\x33\x58\x37\xc9\xa6\x0b\xab\x63\x0b\x59\xc2\x38\x33\x44\xbf\xb5\x94\x66\x89\xbf\x4b\x75\xc6\x6c\x7b\xe1\x8c\x68\xea\xfc\xe6\xfd\x97\xf9\x19\x00\x00\xff\xff\xe2\x6d\x05\x22\x07\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x53\x4d\x6f\xe3\x36\x10\x3d\x8b\xbf\xe2\x55\x40\x00\x29\xd5\x2a\x71\x81\x5e\xdc\xa4\x40\x81\xdd\x6d\x2f\xdb\xc3\xc6\xbd\x6c\x51\x2c\x68\x69\x64\xb3\x91\x48\x81\x33\xb2\x0b\x2c\xfc\xdf\x0b\x92\x8a\xec\xe6\xa3\x27\x1b\xc3\x99\xf7\xde\xbc\x37\xba\xb9\xc1\xf7\xdb\xc9\xf4\x2d\xfe\x66\xa5\x46\xdd\x3c\xea\x1d\x41\xcc\x40\x5f\x85\x58\x94\x32\xc3\xe8\xbc\xa0\x50\x59\x1e\x0a\xc6\xee\xf2\xf0\xd7\x0c\x94\xab\x52\xa9\x6e\xb2\x0d\x36\xc4\xf2\xd0\x13\x8d\x85\xe0\x7a\xee\xaa\x37\x25\xbe\xa9\x4c\xea\x87\x47\x33\x16\x71\xa0\xfe\xdd\x1d\x8b\x12\x86\x61\x9d\x40\x37\xcd\xe4\xb5\x10\xc8\xba\x69\xb7\x47\xe7\x3c\x64\x4f\x08\xf3\x79\xa9\x4e\x17\xd8\x1f\xec\x61\xf3\xe5\x0f\xd6\x3b\x7a\x9b\x60\xf3\x05\x64\x0f\xc6\x3b\x3b\x90\x15\x1c\xb4\x37\x7a\xdb\x13\x8c\x4d\x6c\xe3\xd8\x9b\xe6\xa9\x12\x78\xb6\xde\x1d\x99\x3c\x1a\x67\x85\xfe\x91\xfa\x19\xe7\x27\x67\x9d\x38\x6b\x9a\xcf\xc4\xae\x9f\xc4\x38\xfb\x92\x9c\x45\x7b\xc1\xfa\x1e\xe7\xf5\x54\x76\xd0\x1e\xd4\xeb\x91\xa9\x4d\xf5\xf7\x61\x4f\xe3\xac\xca\x3a\x77\x7e\xba\xbf\xc7\x6d\xc0\xc8\x96\x42\xea\x7e\x30\xb6\xa1\x22\x22\x97\x2a\x3b\xa9\xcc\x74\xcb\xcc\xcf\x73\xcf\x27\xd3\xf7\x86\xa9\x71\xb6\x8d\x10\x52\x7f\xf0\xde\xf9\xae\xc8\x7f\x75\x02\x1e\x74\xdf\x13\x0b\x06\xd2\x3c\xf9\xb8\x74\x3b\x6b\xc0\xd5\xa1\xc2\x51\x5b\x41\x4f\xcc\x90\xbd\xb6\x58\x0d\x9c\x57\x4f\x1c\x91\xf3\xf4\x3c\xd8\xc5\x8d\x97\x1e\x34\xce\xb2\x20\xc8\x5f\xfd\x78\x7b\x8b\xeb\x27\x85\x8d\x77\x49\xe1\x1b\x36\xa5\x65\xe3\xd5\x04\xd2\x8b\x2d\xd7\xaf\x38\xf1\xd3\xf2\x7a\x87\xe7\x3b\x5f\x20\x5d\x1d\x4a\x78\x92\xc9\x5b\x6a\xa1\x3b\x21\x8f\xab\x43\x5e\xa1\xfd\x9f\xfd\xc4\x8d\x9f\x89\x49\xde\x4f\xb4\x31\x03\xf9\x97\x2b\x86\xd8\xbe\x56\xf0\xa1\x2b\xa8\xf3\xda\xee\x08\x7f\xfe\xb5\x75\xae\xff\xd6\xe9\x9e\xa9\x82\xf8\x89\x4e\x51\xd8\xcd\x0d\x36\x7b\x02\x07\x41\x30\x0c\x6e\xf6\xd4\x4e\x3d\xb5\xe8\x8c\x67\xa9\xc0\x0e\x83\x36\x16\x47\xfd\x48\x8c\x69\xc4\x96\x3a\xe7\x29\x5e\x65\x90\x15\x73\x72\x5d\x82\x0a\xc5\xb0\xa0\xaf\xd0\x4e\x04\x2d\xb1\xc2\x7a\x48\xe5\x2a\x30\x34\x21\xef\xb6\x82\xb6\x2d\x58\xdc\xc8\x70\x3e\xa9\x65\x18\xa9\x55\x96\x75\xc6\x27\x63\x07\xfd\x48\x45\x13\x52\x0f\xe2\x2b\xac\x4a\x95\xc5\x8b\x8d\x1c\xb8\x0e\x3f\x75\xb4\x41\x65\xd9\xce\x45\x3d\x45\x34\x21\xcb\x52\xcb\x1c\xce\x2f\xc1\xdc\x8f\xe1\x75\x75\x7b\xfd\xfc\x28\xab\x65\x0e\x89\xf9\xee\x5d\x34\x08\xa7\x40\x77\x0a\xf1\x5f\xe6\xbf\xba\x38\x9b\x05\x23\xf4\x98\x6e\x36\x3d\xf2\x9b\x0e\xdf\x45\x0d\x75\xcc\xab\x88\x13\xbf\xb9\xc9\xcf\xfa\x2e\x4e\x22\x35\x94\xb8\xc7\x1c\x4f\x3c\xf9\x20\x21\x0f\xb8\xe1\xb3\xca\xb2\x96\x3a\x9a\x17\xaf\xc3\x15\x44\x59\x27\x50\xcf\x84\x33\x57\x7a\x99\x1d\x58\x08\xe6\xea\x1b\xf8\x01\x9e\xa9\xa7\x26\x29\x6f\x34\x13\xee\xde\x45\x2b\xd6\xff\xc5\xf9\x78\xce\x7b\x8e\x20\x85\x39\x1f\xaf\x11\x1c\x35\xc7\x50\x47\x6a\x97\x58\x51\xc4\x9f\x35\xae\xa4\xcc\xe7\xc3\x2c\xcf\x44\xe7\x80\x8a\x1f\x5e\xb5\x76\x9d\x34\x86\x0f\xe1\xdf\x01\x00\x2f\x78\x05\xc0\x01\x06\x00\x00"),
+		},
+		"/src/time/zoneinfo_fetch.go": &vfsgen۰CompressedFileInfo{
+			name:             "zoneinfo_fetch.go",
+			modTime:          time.Date(2026, 10, 15, 22, 6, 58, 824028310, time.UTC),
+			uncompressedSize: 1705,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x54\x51\x6f\xdb\x46\x0c\x7e\xd6\xfd\x0a\xe6\x5e\x2a\x2d\xaa\x54\x0c\x7d\x4a\xa1\x87\xa2\x30\xb2\x00\x6e\x36\xb4\x2e\x30\xb4\x28\x82\xb3\x44\x59\x67\xcb\x77\xda\xf1\x94\xd8\x49\xfd\xdf\x07\xde\x59\x59\xec\x6d\x18\xf6\x62\x48\x22\xf9\x91\xfc\xbe\x8f\x2e\x4b\xb8\x5c\x8e\xba\x6f\x60\x4d\xf9\xc5\xca\x0e\x1d\xba\x35\xdd\x91\x32\xcd\xd2\xee\xee\x0c\xfa\x3b\x63\x0d\xfe\x4b\xa8\x45\x5f\x77\x77\xd6\xf4\x7b\x21\x06\x55\x6f\xd4\x0a\xc1\xeb\x2d\x0a\xa1\xb7\x83\x75\x1e\x52\x91\x48\x74\xce\x3a\x92\x22\x91\xb4\xa7\x5a\xf5\xbd\x14\x22\x91\x2b\xed\xbb\x71\x59\xd4\x76\x5b\x4e\xd0\x7f\x3d\xac\x49\x8a\x4c\x88\xb2\x84\xd0\x61\xf1\xa8\x4d\x6b\xc1\xa1\x1f\x9d\x21\xf0\x1d\xc2\xe2\xab\x6e\xa1\x51\x5e\x81\x6d\xc3\x07\xa3\xb6\xd8\x84\xe6\xf0\x68\x0d\x42\xeb\xec\x96\x03\x0c\xd2\x68\x87\xb5\xb7\x6e\x0f\xca\x87\xe4\x2f\x9f\xe6\xe0\xbb\xe3\xcb\xc0\x53\x13\x7a\x02\x15\xb1\x57\xf6\xab\x35\xc8\x2d\x39\x6f\xd5\xdb\xa5\xea\x19\xe6\x5e\x39\xad\x96\x3d\xe6\xd0\xeb\x0d\x82\xec\xbc\x1f\xe8\xaa\x2c\x71\xa7\xb6\x43\x8f\x61\x97\xc7\x63\x65\x29\x73\xe8\x95\x6e\xc0\x8e\x3e\xa4\x33\x40\x39\x92\x2b\xa9\x53\x0e\x9f\xf3\x0a\xb8\xf1\xcf\x8b\x1d\xf9\x29\x66\xb7\xbf\xce\x6e\x17\xa0\xe3\x66\x53\x5b\xd0\x64\x5e\x79\x9e\x34\x07\xeb\x18\x8f\xa3\x61\xd9\x18\x69\xed\x68\x9a\x42\x94\x25\xc7\x16\x1d\x82\xc3\x3f\x46\x24\x0f\x9a\x40\x01\xed\x4d\xdd\x39\x6b\xec\x48\xf0\xfb\xc7\xf9\x2f\xde\x0f\x9f\x62\x3c\x07\xd2\xa6\x46\x98\x5b\xd5\xcc\x6d\xad\xbc\xb6\x06\x6a\x65\x5e\x79\x06\x5a\xf6\xb6\xde\xe4\x40\x16\x74\x40\x62\xb5\x61\x24\x6c\xa6\xf9\x6e\x8c\xef\xe1\xfd\x6f\x37\xd0\x58\x0c\x73\x6c\x8c\x7d\x78\x9e\xad\x10\xed\x68\xea\x97\x3a\xa6\x2c\x15\x90\x77\xda\xac\x32\x48\x83\x88\xdf\xbe\x2f\xf7\x1e\x73\x40\xe7\x20\xf8\x25\x83\x27\x91\x2c\x15\x21\x5c\x55\xb0\xa6\xe2\x3a\xa8\x50\x5c\xa3\x4f\xe5\x89\x3e\x32\x13\x89\x6e\x21\xa4\x56\x21\xf5\x8b\x69\xb0\xd5\x06\x1b\xf8\xf1\xe3\xbc\xf4\x74\x71\x99\xfd\xad\xe4\x49\x24\x49\x94\x03\x8c\xee\xf3\x33\x49\x44\x72\x10\x49\x83\x2d\x3a\xe0\xad\xd2\x30\x25\xb7\x0f\x63\x3a\xac\xed\x3d\xba\x34\x7b\x07\x08\x17\x15\x03\x84\x78\xb2\xa6\x99\x73\x39\xd8\x0d\x67\x61\x91\xfe\xb4\xa6\x62\x16\xb6\xe4\xa8\x6e\xe1\xc2\x6e\x62\x66\x32\x28\xa3\xeb\x14\x43\xe0\xc0\x3f\x4c\x4f\xe4\xa5\x8a\x13\xc5\x73\x2a\x6e\xf1\x21\x95\x6c\xf7\xab\xc8\xad\x36\x2b\x90\x70\x19\xee\x00\x2e\x41\x5e\x85\xb7\xd0\x39\xf6\x4a\x33\x06\x3d\x88\xe4\x90\x66\x22\xd9\x75\xee\x1f\x98\x3d\xa7\x27\x74\x89\xd9\xc5\x07\xd5\xf7\xa9\xb4\x03\x1a\x99\x83\xbc\x9e\x2d\x64\x1e\x58\x2f\x3e\x07\x25\xd3\xec\x92\x5b\xe7\xd0\xaa\x9e\x78\xfe\xb2\x84\xcf\x2f\x2c\x77\xf4\x22\x45\x63\x41\xa7\xee\x11\x94\x01\xe5\x9c\xda\x2f\xc7\x96\x29\x75\x48\x83\x35\x84\xc1\x6c\xec\x1f\xf6\x04\x05\x24\xe5\x10\x1c\xaa\x06\x14\x41\xdd\x29\xa7\x6a\x8f\x8e\xa6\xdb\x1f\x09\xdd\xeb\x49\x41\x0e\x13\xfa\xe2\x64\xea\x7b\x74\x4e\x37\xf8\x51\x6f\x71\xb1\x1f\x90\x37\xf0\xb8\xf3\xe5\xd0\x2b\x6d\xde\x4d\x35\xd5\xee\xf5\x4b\x28\x79\xb2\x39\xa1\x09\x5f\xe8\x41\xfb\xba\x03\xf2\xca\x8f\xc4\x1c\x72\x4a\x60\x2f\x7e\x92\x59\x71\x63\x3c\x9b\xe0\x49\x24\xb5\x22\x9c\x52\xab\x0a\xde\xbe\x79\x7b\xf5\x1f\x06\x7b\x59\x71\x51\xc1\xcf\x6f\xde\x9c\x57\xfc\x2f\x03\x9c\x0d\xb7\xc0\x1d\xcb\x3a\x49\x96\x05\x43\x33\x13\x27\x8b\x4c\x42\xc4\x6c\x11\x3c\x08\x15\x6c\xd5\x06\xd3\xe9\x52\xb9\xa8\x98\xa3\x59\xf9\x2e\xe0\xb4\xd6\x81\x0e\x57\xa0\xcc\x0a\xe3\x5f\x33\x5b\x9a\x1f\xbe\xe9\xef\x50\x05\x35\xd3\x50\x16\x09\x65\xd2\x3f\xd8\x06\xdf\x7b\x99\x83\x3e\xb2\x16\x27\x3a\xae\x1b\xbd\x6f\x74\x2f\x0e\xe2\xcf\x01\x00\x40\x2f\xae\xe8\xa9\x06\x00\x00"),
 		},
 		"/src/time/zoneinfo_js.go": &vfsgen۰CompressedFileInfo{
 			name:             "zoneinfo_js.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
-			uncompressedSize: 1382,
+			modTime:          time.Date(2026, 10, 15, 22, 6, 58, 820749589, time.UTC),
+			uncompressedSize: 8833,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x53\x4b\x6f\xe4\x36\x13\x3c\x0f\x7f\x45\x7f\xfa\x10\x60\xe4\xb1\x25\x3e\x24\x4a\x32\x76\x0e\x8b\x0d\xb0\x31\xb0\x48\x0e\x71\x90\x83\x61\x04\x94\xd4\xd2\xd0\x96\xc8\x81\xc8\xb1\x63\x2f\xfc\xdf\x03\x72\xe4\xdd\x3c\x6e\x54\x57\xf5\xab\xaa\x95\xe7\xbb\xf6\xa4\xa7\x1e\x1e\x1c\x21\x47\xd5\x3d\xaa\x11\xc1\xeb\x19\x09\xd1\xf3\xd1\x2e\x1e\x92\x51\xfb\xc3\xa9\xcd\x3a\x3b\xe7\xa3\x3d\x1e\x70\x79\x70\xdf\x1f\x0f\x2e\x21\x24\xcf\xe1\xf6\x80\xd0\xd9\x1e\xa1\xc5\xc9\x3e\x83\x76\xd0\x2a\x87\x3d\x58\x03\xfe\x80\x70\x3a\x3a\xbf\xa0\x9a\xe1\xd5\x1a\xd4\x66\xb0\x7f\x3c\xb8\x6c\xb4\xe0\x2d\x74\x93\x75\xb8\xc0\xac\x7c\x77\x08\x95\x7e\xc7\xf6\xa3\x73\x38\xb7\xd3\x0b\xb4\x78\x50\x4f\xda\x2e\x19\x21\xc3\xc9\x74\xa0\x8d\xf6\x5f\x6c\xa7\xa6\x6d\x0a\x5f\xc9\x66\x0a\xcf\x2f\xb6\xcb\x8c\x9a\x11\xf6\x90\x44\x2c\x21\x64\xf3\x0a\xd7\xfb\xd8\xeb\xeb\x1b\xd9\xf4\xe1\xe3\xc1\x65\x9f\x27\xdb\xaa\x29\xfb\x8c\x7e\x9b\xfc\xa8\x3c\x26\x69\xf6\x33\x3e\x6f\x53\xb2\xb1\xc3\xe0\xd0\x07\x5a\x9f\x7d\x52\xd3\xb4\x4d\x46\xf4\xb7\x7a\xc6\x50\xe2\x97\x08\x26\x69\x76\x63\xfc\x36\x85\x0b\xb8\x62\x64\xf3\x9a\xad\x39\x7b\x58\x1f\x17\x20\x29\xd9\xe4\x39\x7c\xec\x3a\xbb\xf4\xda\x8c\x61\xbb\x83\xf7\x47\x77\x9d\xe7\xbe\x13\x4d\xb6\x2a\xa9\x6d\x8e\xdd\xac\xb8\xe4\xf9\xff\x1d\x76\x57\x7e\x6d\x84\xce\x2f\xda\x8c\x97\xb1\x4a\x50\xed\x1d\x80\xb8\xdf\xb0\xd8\x19\xb6\x06\x9f\x21\x0c\xbf\x4d\xd3\xcc\xdb\x30\xe3\xaf\x31\x6b\x9b\x06\xd1\x95\x01\x3d\x1f\x27\x9c\xd1\x78\xe5\xb5\x35\x57\x3d\x1e\xd1\xf4\x68\x7c\xac\xba\xa0\x3b\x4d\xfe\x12\x94\xe9\x41\x1b\xf8\x6c\xed\x38\x21\x7c\x3a\x2c\x76\xc6\x4b\xd0\x1e\x46\xfd\x84\x2e\x36\x1f\x4e\xd3\xf4\x02\xf8\xe7\x51\x99\x1e\xfb\xf3\x08\x8b\xf2\x07\x5c\xc0\x1f\x94\xf9\x36\xa4\x6a\xdb\x05\x9f\x74\xec\x96\xc5\xe8\x4f\x68\x3a\xbc\x84\xe7\x70\x11\xc6\xf9\xe5\xd4\xf9\xc8\xfc\xbe\x45\xf8\x3a\xcb\x96\x05\x29\xdf\xed\xfb\xed\xf6\x53\x42\x36\x7a\x78\x97\xf4\x03\xd0\x60\xf3\x3b\x63\xb7\x87\xe4\x2a\x21\x9b\x77\xbb\x2e\xf6\xd1\x8a\x37\xc0\xc9\xe1\xbf\x89\xbb\x84\x6c\xde\xc8\xdf\x22\xda\x5b\xb5\x5d\x33\x73\x90\x34\x25\x9b\x59\x9b\xe0\xf9\x1a\xfc\x21\x1a\xa8\x07\x08\xe1\xff\xed\xff\xdb\xfb\x3a\x81\xdd\xb9\xcc\xac\x4d\x1a\xcb\x7f\xbb\xc0\x68\xd3\x1e\xee\xee\xe3\xd1\xbd\xbe\x91\xb7\xf8\x5b\x04\x76\xf0\x65\xd2\x8f\x08\xce\x2f\x9d\x35\x4f\xd9\x4d\x08\xb6\x27\x0f\xd6\x4c\x2f\xf0\x6c\x97\x47\x07\x83\x5d\xe0\x49\x4d\x27\x74\x60\x07\xd0\xc1\x9c\x45\x99\x11\xe1\x8e\x5e\x36\xcd\x7d\x16\x8a\xdd\x78\x38\x2a\xa3\x3b\x07\x3a\x52\x1c\xd8\x50\x64\x38\x33\xb3\xf5\x17\x09\xf3\x85\x7c\x9f\xc2\xf9\x9e\xc2\x1a\x31\xe1\x03\xb0\xf3\x4e\x0b\xfa\xd3\x62\xa0\xd7\xa3\xf6\xee\x4e\xc3\x35\xe8\x1d\xbb\x8f\x0b\xad\x90\x9b\xd5\x34\xb9\xf3\x65\xdd\xe9\x0b\x1e\x28\x17\x7c\xc7\xef\xc3\x5e\xd1\xd5\x7f\x50\x82\x79\x94\x52\x46\x39\x15\xb4\xa0\x25\x95\xb4\xa2\x35\x6d\x12\xd8\x91\x4d\xc2\x28\x63\x8c\x33\xc1\x0a\x56\x32\xc9\x2a\x56\xb3\x15\xe1\x94\x33\xce\xb9\xe0\x05\x2f\xb9\xe4\x15\xaf\xf9\x8a\x08\x2a\x98\xe0\x42\x88\x42\x94\x42\x8a\x4a\xd4\x62\x45\x0a\x5a\xb0\x82\x17\xa2\x28\x8a\xb2\x90\x45\x55\xd4\xc5\x8a\x94\xb4\x64\x25\x2f\x45\x59\x94\x65\x29\xcb\xaa\xac\xcb\x15\x91\x54\x32\xc9\xa5\x90\x85\x2c\xa5\x94\x95\xac\xe5\x8a\x54\xb4\x62\x15\xaf\x44\x55\x54\x65\x25\xab\xaa\xaa\xab\x15\xa9\x69\xcd\x6a\x5e\x8b\xba\xa8\xcb\x5a\xd6\x55\x5d\xd7\x2b\xd2\xd0\x86\x35\xbc\x11\x4d\xd1\x94\x8d\x6c\xaa\xa6\x6e\x9a\x64\x55\xe5\xac\x69\xd4\x83\x71\x51\x94\xb2\xaa\x9b\x84\xfc\x15\x00\x00\xff\xff\xfa\x0d\x01\xc1\x66\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x3a\x6b\x73\xdb\x38\x92\x9f\xc5\x5f\xd1\xe1\xd5\xe4\x48\x9b\xa6\xa8\xb7\x2c\x8f\x32\x95\xcb\x3c\xd6\x53\x99\x64\xea\xec\xd4\xdd\x8d\xcb\xb5\x0b\x91\x90\x89\x98\x04\x54\x04\x24\x4b\xce\xfa\xbf\x5f\x75\x03\xa4\x48\xc7\xd9\xbd\xbb\xf9\x70\x5b\xb5\xb1\x08\x34\xfa\xfd\x42\x63\xfa\xfd\xd3\xd5\x56\x14\x19\x7c\xd6\x9e\xb7\x61\xe9\x3d\xbb\xe3\x60\x44\xc9\x3d\x4f\x94\x1b\x55\x19\x08\xbc\x9e\xcf\xab\x4a\x55\xda\xf7\x7a\xbe\x3e\xe8\x94\x15\x85\xef\x79\x3d\xff\x4e\x98\x7c\xbb\x8a\x53\x55\xf6\xef\xd4\x26\xe7\xd5\x67\x7d\xfc\xf1\x59\xfb\x5e\xe8\x79\xfd\x3e\x5c\xe7\x1c\x52\x95\x71\x58\xf1\x42\x3d\x80\xd0\xb0\x62\x9a\x67\xa0\x24\x98\x9c\xc3\x76\xa3\x4d\xc5\x59\x09\x8f\x4a\x72\x21\xd7\xea\xaf\x9f\x75\x7c\xa7\xc0\x28\x48\x0b\xa5\x79\x05\x25\x33\x69\x8e\x98\xfe\x83\xaf\xde\x6a\xcd\xcb\x55\x71\x80\x15\xcf\xd9\x4e\xa8\x2a\xf6\xbc\xf5\x56\xa6\x20\xa4\x30\xef\x55\xca\x8a\x20\x84\x2f\x5e\xaf\xc0\x9f\xef\x55\x1a\x4b\x56\x72\x58\x82\x4f\x7b\xc8\xf6\x23\x2c\x96\x44\xeb\xcb\x93\xd7\xcb\xf0\xe3\xb3\x8e\x7f\x29\xd4\x8a\x15\xf1\x2f\xdc\x04\xfe\x8f\xcc\x70\x3f\x8c\x3f\xf0\x87\x20\xf4\x7a\x6a\xbd\xd6\xdc\x20\x58\x16\xbf\x63\x45\x11\xf8\x77\xdc\x5c\x8b\x92\x23\x8a\x8f\xb4\xe9\x87\xf1\xa5\x34\x41\x08\x27\x70\x36\xf0\x7a\x8f\xb1\x3b\xb3\x04\xf7\xe3\x04\xa6\x89\xd7\xeb\xf7\xe1\x6d\x9a\xaa\x2a\x13\xf2\x0e\xa5\xcb\x8d\xd9\xe8\x45\xbf\x6f\xd2\xd1\x79\xec\x74\x29\x54\x9f\xa7\x25\x1b\x4e\x87\xfd\x7f\xd1\x3c\x3d\x33\x8e\x10\xd7\xa6\x12\xf2\x2e\x22\x2c\xa8\xb5\x7a\x03\x48\xbe\x75\xa5\x4a\x08\x24\x7f\x00\x64\x3e\x08\xc3\xd8\x28\xe4\xf1\x8a\x4e\x05\x21\x2a\x9d\x49\x10\xe5\xa6\xe0\x25\x97\x86\x19\xa1\xe4\x59\xc6\x37\x5c\x66\x5c\x1a\xc2\x5a\x71\xbd\x2d\x4c\x04\x4c\x66\x20\x24\xfc\xa2\xd4\x5d\xc1\xe1\x5d\x5e\xa9\x92\x47\x20\x0c\xdc\x89\x1d\xd7\x44\x7c\xbd\x2d\x8a\x03\xf0\xfd\x86\xc9\x8c\x67\x96\x85\x8a\x99\x9c\x57\x60\x72\x26\x1b\x26\xd9\x6a\x55\xf1\x9d\x20\x6a\x31\xad\xfe\x85\xcb\x94\x47\xf0\x80\x1e\x21\xb5\xa9\xb6\xa9\x21\xc8\xa3\x14\xf8\x65\xd5\x16\xa3\x2a\x6b\xf3\x7d\xba\x7e\xe7\x7b\x3d\xb1\x76\x7b\xf0\x3d\x24\x68\xe6\x1a\xe2\x74\x09\xfe\x99\xef\xf5\x6a\x73\x9d\x2c\xc9\x14\x4f\xc0\x0b\xcd\x9f\x03\x9e\xfa\x5e\xef\xc9\x6b\xad\x08\xa3\x58\xe0\x4e\xf6\x61\x9a\x84\x5e\xaf\x14\x12\x6d\xee\x16\xbf\x23\x03\x8a\x35\xe0\xf2\xab\xe5\xd7\xb4\x17\x3e\x9c\x5a\x34\xa5\x90\x21\xa1\x6f\x3c\x90\xcc\xb4\x84\x9b\x5b\x72\xba\xc7\x27\xef\x89\xc2\x02\xa1\xd1\x2e\x85\xb8\xe7\xa0\x4d\x95\x2a\xb9\x8b\x2f\x71\x71\xb5\x35\xa0\x64\x71\x80\x07\x55\xdd\x6b\x58\xab\x0a\x76\xac\xd8\x72\x0d\x6a\x0d\x02\x8d\x53\x31\x79\xc7\xe1\x26\x89\xce\xcf\x6f\x63\x44\x76\x69\x60\xc3\xa4\x48\x35\x08\x02\xd1\xa0\x10\xc9\xda\x42\xc6\x2e\x44\x90\x3f\x3c\x6f\x42\xb0\xfe\x84\x62\xd0\x81\xef\x61\x60\x65\xaa\xb8\xd9\x56\x12\x32\x71\x27\x8c\xbe\x11\xb0\x00\x71\x3a\xb8\x25\x81\xdc\x96\x2e\x59\x51\x68\xeb\x59\x37\xe2\x64\x88\x20\x27\xc3\xd3\xe1\x2d\xca\x45\x56\xed\x80\xa0\xf1\x92\x24\x19\x24\xc3\x64\x94\x8c\x93\x49\x32\x4d\x66\xc9\x3c\x39\xf7\xe1\xd4\xeb\xf9\x83\x64\x30\x18\x0c\x07\xa3\xc1\x78\x30\x19\x4c\x07\xb3\xc1\x7c\xe0\x76\x86\xc9\x70\x30\x1c\x0e\x47\xc3\xf1\x70\x32\x9c\x0e\x67\xc3\xf9\xd0\xed\x8c\x92\xd1\x60\x34\x1c\x8d\x46\xe3\xd1\x64\x34\x1d\xcd\x46\xf3\x91\xdb\x19\x27\xe3\xc1\x78\x38\x1e\x8d\xc7\xe3\xc9\x78\x3a\x9e\x8d\xe7\x63\xb7\x33\x49\x26\x83\xc9\x70\x32\x9a\x8c\x27\x93\xc9\x74\x32\x9b\xcc\x27\x6e\x67\x9a\x4c\x07\xd3\xe1\x74\x34\x1d\x4f\x27\xd3\xe9\x74\x36\x9d\x4f\xdd\xce\x2c\x99\x0d\x66\xc3\xd9\x68\x36\x9e\x4d\x66\xd3\xd9\x6c\x36\x9f\xb9\x9d\x79\x32\x1f\xcc\x87\xf3\xd1\x7c\x3c\x9f\xcc\xa7\xf3\xd9\x7c\x3e\x77\x3b\xe7\xc9\xf9\xe0\x7c\x78\x3e\x3a\x1f\x9f\x4f\xce\xa7\xe7\xb3\xf3\xf9\xf9\xb9\xef\xb4\x62\x75\x4a\xfa\x18\x0c\x47\xe3\xc9\x74\x36\x3f\xf7\xad\x2b\x48\x53\xfc\xa1\x24\xff\x91\x19\x06\x15\x6f\x42\x43\x53\x98\x53\xb2\x02\x53\x31\xa9\x05\x46\x92\xf5\x08\x46\xf1\x28\xe1\xf2\xed\x87\xb7\x47\x30\xc4\x46\x4e\xb9\xd5\x94\x65\x72\x0e\xbf\xb2\x1d\xbb\x4a\x2b\xb1\x31\x70\x29\x4d\x01\x6f\x7f\xbf\x8c\xe0\x21\x17\x69\x0e\x3a\x17\x9b\x36\x8d\x0c\xe9\x3f\x08\x93\x03\xdf\xf1\xea\x80\xc8\x4a\x95\xf1\x4a\xc2\xaa\x52\x0f\x98\x8a\x31\x39\x7c\x50\x19\x8f\x3f\xeb\x18\x9d\xce\xfa\x84\x06\xb9\x2d\x0a\x74\x3d\xa2\x20\x34\x48\x65\x80\xed\x98\x28\xd8\xaa\xe0\xa0\x2a\x44\x95\x29\xae\xe5\xbf\x1a\x12\xf0\x4e\x8a\x47\xde\x24\x32\xa2\x8e\x7e\x5c\x97\x0b\x17\x76\x42\x02\x5f\xaf\x79\x6a\x10\xa5\x66\x98\xbd\x32\x78\xe0\xfc\x9e\xf2\xbf\x79\xe0\x5c\xc2\xe0\x7c\x96\x10\x5b\xc3\x64\x34\xa7\x1f\x9c\xa5\x39\xa4\x39\x3a\x3e\x29\x57\xc3\x46\xc8\x8d\x12\xd2\xf0\xcc\x4a\xc7\x60\x25\x24\xab\x0e\xa0\x39\xab\xd2\xbc\x56\x87\xd0\xc0\xa5\xda\xde\xe5\x54\x7b\xb0\xe8\x00\x2b\x0a\xa8\x38\x2b\xce\x1e\x54\x55\x64\x88\xae\x65\x87\x18\x30\xc7\x6a\x58\xf1\xb5\xaa\xac\x34\x36\x30\xb7\xda\x7e\x71\x56\x15\x82\x6b\x03\xf7\x52\x3d\x48\x92\xd2\xe6\xd7\x6a\x5b\x70\x8d\xd8\xd4\x4a\xf3\x6a\xc7\x29\xe3\xe2\x89\x82\x69\x03\x07\xce\x2a\x50\xeb\x16\x42\x56\x71\xe0\x7b\x53\xb1\x8d\x2a\x98\x21\x70\xa3\x5c\x2a\x36\xdb\x8a\xc7\xde\x8e\x55\x5d\x37\x6a\x97\x35\x5b\xb7\xf8\x8e\x15\x7e\x04\x7f\x0b\x30\x19\xa0\x00\x01\x7a\x0a\x56\x4b\x40\xd3\x05\xe6\xb0\xe1\xca\x99\x70\xb9\x5c\x82\xbf\x95\x19\x5f\x0b\xc9\x33\x1f\xfe\xfe\x77\x68\x6d\xc7\x58\x65\x50\xf6\x9f\x55\x55\x32\x03\xaf\x10\xba\xc6\xea\x5b\x8c\xe0\x7c\x83\x5c\xe3\xc2\x03\x78\xf2\x00\x90\xcb\x75\x69\xf0\xd3\x54\x07\x07\xb7\x2e\x0d\x2c\x01\x8b\xd7\x0b\xb8\x03\x9f\xcb\xb3\x4f\x57\x7e\xe4\x80\x81\x1c\x06\xa5\x5c\x90\xa3\x47\x90\xab\x6d\xf5\xee\x90\x16\x7c\x01\x7e\x3e\x1c\xf9\x51\x03\xf1\x81\x95\xb8\xa8\x73\x55\x19\x3f\x72\xc7\x51\xb9\x0b\xf0\xe5\xb6\xe4\x95\x48\xfd\x08\x4a\x25\x4d\xde\x59\xc9\xd8\xa1\xf3\x8d\x14\xba\x47\x84\xdc\x1a\xde\x59\xd2\x18\xb5\x59\x6b\x89\xc8\x3d\x85\x24\xb9\x73\xa6\x80\xff\x13\xd5\x60\xf7\x03\x4b\x68\xec\x53\xea\xfa\x00\xee\x6e\x58\x45\xe9\x63\x5d\x9a\x78\x4d\xca\xb9\x56\xbf\xe3\xda\xb1\xf0\x97\x3a\x0c\x23\xd8\xc1\x12\xbe\x3c\x5d\x58\xe5\xaa\x0a\x02\xc2\x0d\x4b\x48\x2e\x28\xd5\x13\xa2\xb8\xe0\xf2\xce\xe4\x17\x20\x4e\x4f\xc3\x46\xb9\xbb\x1b\xda\xbc\x11\xb7\x31\xda\xfb\x16\x96\xd0\x2c\x50\x0d\xb2\x58\x9f\x1a\xa6\xa8\xca\xc1\x92\xc8\xc7\x9f\xae\xdf\x05\xa7\xbb\x18\x55\x1c\xc1\xe9\x2e\x26\xd5\xc2\x19\x0c\xe8\x2b\x63\x07\xfa\x8b\xea\x84\xef\x60\x38\xb6\x30\xa4\x4b\xfa\x69\x75\x18\x5e\xb4\x55\xf4\x85\xac\xbc\x80\x5d\xdc\xb6\x6a\xe4\x32\xc4\x02\x7e\x63\x26\x8f\x2b\xb5\x95\x59\x10\x58\x5e\xce\x00\xd5\xd6\x87\x41\x92\x24\x21\x90\x1a\xe8\x1f\xe4\x56\xdb\x6e\xa2\x51\x30\x8b\x60\x15\xc2\x97\x9a\x18\x73\xed\xc6\x72\x09\x2b\xfb\xf3\xf5\x6b\x60\x4d\x3b\x47\xcb\xf6\xe3\xe2\x88\x13\xa3\x5a\x53\x7d\x8f\xc0\xec\xdd\x0f\x21\x33\xbe\x6f\xcc\x80\x60\x2c\xcb\xae\xf7\x5d\xe3\x46\xf0\xd8\xb6\xef\x3d\x3f\xc0\x12\xea\xa6\x02\x7c\xc0\x9e\xa2\x6e\x26\xad\x52\x30\x4c\x09\xf5\xcd\x3d\x3f\xdc\x12\x47\x4d\x90\x1e\x8d\xd8\x86\xb0\xec\xd5\xc6\x76\x00\x76\x6d\xb3\xd5\x79\x50\xeb\xd7\x92\x3d\xea\xb5\xa6\x1b\x81\xd0\x3f\x5e\x5d\x2f\x60\xcd\x0a\xcd\x9d\x43\xd7\x0e\x60\xf6\x35\x92\x87\x9c\xcb\x05\x94\xda\xe9\xdd\x29\x60\xd1\x66\xc5\xc5\x42\x63\x0a\xc3\x37\xb0\x84\x19\x9c\xc0\x70\x0c\x27\x30\x9a\x26\x09\x9c\xb8\xd3\x5c\x66\x6d\x9f\xc2\xd4\x1e\x41\x12\xc1\x20\xac\x8f\x9b\xf6\x3e\xd6\x00\xb7\x1f\xc1\xa6\xe2\x18\x00\x18\x4b\x81\x21\x78\x52\x7d\x60\xec\x16\xad\x50\x54\x18\xec\xdc\x90\x8d\x0b\xc0\x7e\x92\xcb\xec\x02\x9a\xb5\xb6\x61\xd2\x6d\x75\x44\x18\x41\x81\x31\x6a\xe0\xcc\x9e\x25\xa8\x87\x5c\x14\x1c\x82\x57\xe8\x5f\x01\x52\x89\xf0\x50\x78\x34\x49\xff\x84\xea\x1a\x55\x59\x5b\x9a\x34\x08\x09\x41\xa1\x22\x30\xb7\x11\xac\x85\xcc\x6c\xc9\xd8\xb3\xd4\xb8\x6c\x12\xc3\x49\xbf\x8e\x4b\x56\x41\x8e\x21\x6c\x6a\x1b\x3a\x92\xb9\x80\x33\xe4\xe8\x8d\xf3\xf7\x9a\xa0\x3d\x52\x0a\xd4\x63\xa1\xe0\xd4\x06\xca\xba\x50\xaa\x0a\xea\x43\x18\x25\x43\x3a\x65\xf5\x7e\xd1\x9c\x45\x3f\x23\x59\x48\xe8\x52\x64\x4e\xaf\x28\x91\x95\xbf\x14\xd9\x05\xd4\xed\xb5\x65\xcd\x2e\x39\x1c\xf5\xdf\xb6\x35\x72\x11\xd6\x24\xac\x49\x72\xd1\xb2\x09\xfe\x8f\x50\xe7\xe2\xe8\x63\x4f\xae\x36\xb5\xbd\x18\xde\xc0\x70\x32\xf9\x07\xa9\xb4\x7f\x62\x8b\x58\xdd\x70\xf0\xfd\x46\x69\x8e\x3e\x4a\x57\x14\x66\xcd\x20\x34\x26\xfa\x42\xdc\xe5\x06\x34\xdb\x51\xbb\x24\x4a\x1e\xc3\x5b\xad\xb7\x25\x07\x61\x40\x68\xaf\x56\x87\x69\x75\x25\x1a\x0a\x56\xdd\xb9\xcb\x0e\xac\x94\xc9\x69\x1b\x25\x11\x6a\xab\xa9\xc2\xe3\xc2\x5a\x15\x85\x7a\x40\xc4\xd8\xde\x58\x63\x76\xf2\xf1\xc0\xe6\x63\xb3\xaf\x25\x3b\x83\x41\x27\x21\x23\xa0\xaa\x83\xf8\xc6\xec\x31\x0d\x53\x48\xdd\x7e\x95\x16\xd0\x05\x8e\x60\x88\xe9\x19\x28\xe6\xb2\x67\x40\xa7\x5f\x01\x1d\x1d\xe8\x05\x92\x94\x09\xd0\x07\xab\x76\x1d\x70\x1a\xff\x91\xeb\xb4\x12\x2b\xd7\x06\x61\x7f\xf3\x72\x73\x53\xb7\x71\xd4\xe4\x30\x0d\x0c\x7e\xff\x78\x75\xf9\x9f\x70\xfd\x07\xd4\x77\x5c\xa2\xaf\x15\xaa\xd7\xc0\x2f\xd8\x8a\xc9\x76\xf7\x83\xb8\x4a\xd8\x20\x32\xc4\x5a\x23\xb4\x37\x1d\xab\x65\x2a\x97\x4a\x8b\xfd\xc7\xfa\x26\xde\xa4\x5d\xd5\xd6\xad\x16\x77\x12\x96\xa4\x96\x04\x7e\xc0\x2b\x24\x2c\xf0\x7e\x68\xa5\x43\xcd\x53\xd8\xb0\x95\x0e\x54\xb7\x2e\xd1\xc9\x4e\x54\x29\xe8\x53\x12\x0b\xe1\xd4\xdd\x07\x3b\x9b\xdf\xd1\xa6\xbd\x5e\x36\x10\xb4\x3e\x4d\xba\x99\x91\x18\xff\xf7\x6d\xd1\xa9\x54\x98\x60\x6d\xb0\x7c\xec\x18\xaa\x5d\x83\x9b\x36\x80\xa0\xe1\xb4\x03\x6e\x43\x3c\xbc\x68\x4e\x65\xec\x40\xb9\x21\x65\x45\x7c\xc7\xcd\xa7\xeb\x77\x74\x36\x8c\xa8\xc3\xae\x45\x4f\xb9\x28\x02\x04\xed\xc3\xac\x7b\x58\x5f\xca\xdf\xa8\xbe\xb7\x08\x37\x19\xb9\x8d\xf6\xe7\x6d\x51\xfc\x17\x67\x55\x40\xb9\xf3\xb8\x4e\xa7\x03\x54\xc6\x20\x82\x24\x0c\x3b\x5c\x1c\x1d\x1b\x89\x9f\xc2\x0c\xde\xb4\x89\x1e\xfd\xd4\x31\x3b\x69\xd7\x25\x67\x23\xff\x37\xd2\xf1\x37\x68\xe2\x3f\x7e\x8c\x10\x84\xa2\xfe\xe8\x2a\xe4\x40\xb0\x7e\x1f\x6f\x77\x75\x8a\x3a\xee\xff\x45\x6d\x2b\x1d\x1c\xcd\xd9\xa1\x44\x7d\xcd\xb7\x76\xaf\x28\xc1\xeb\xa0\x6b\x79\x0c\x10\xd4\xd4\xb3\xda\x37\x73\xb5\xad\x29\xae\x15\x4f\xb9\x34\xd4\x6a\x5c\xfc\xb3\x9c\xd2\xc9\x27\xd4\xe6\x53\x48\x93\x87\xbc\x59\x36\x24\x8f\x0a\xb5\xc8\x6d\x61\x17\x61\x37\xce\x91\x08\xdf\x1b\x5b\x9e\x7d\x3f\xa2\xe3\xed\xfc\xd4\x49\x65\x75\xe2\xb8\x70\x49\xdc\x61\x76\x00\xd8\xbc\x34\x05\xeb\x88\xf4\x7b\x52\x15\xd3\xa6\xe9\x83\xde\xe0\x4a\x2b\x9a\x03\xda\x75\xf9\x8a\xf4\x67\xcb\xd0\xcb\x24\x86\xed\x48\x61\xb0\x04\xb3\xbf\xb1\x50\x37\xc9\xed\x6d\x04\xab\xce\xd2\x00\x97\x1e\x59\x23\x12\x73\x22\x44\xf0\xb8\x6a\x16\x57\x2d\xb9\x5c\x38\x68\x43\xcd\xdd\x63\xd3\x2c\xbe\x81\xc7\xba\x57\x84\x1f\x80\xc1\x02\x56\x11\x68\x63\x7b\x40\x07\xbe\x5c\x02\x83\x1f\x60\x05\x0b\x60\x1d\x5c\x0d\x25\x02\x6c\x58\xd0\x26\x6b\x76\x08\x55\x87\x0f\x8a\x95\x46\x31\x74\x27\xd3\x26\xfb\x2a\xaf\x3f\xd3\x34\x82\x7c\x53\xd1\xed\xf3\xa7\xee\x40\xf6\x8f\x2c\x73\xa4\x1f\x36\x01\x03\xe0\x47\x0d\x1c\xa6\xb5\xc0\x4a\x65\x33\xda\x33\x12\xcf\x20\xad\x94\x16\x32\xeb\x1a\xfd\xe8\x94\xcd\x4d\x81\x34\xb3\xb0\x7f\xb0\x17\x5f\x80\xd9\x47\x4e\xe0\x85\xfb\x8b\xc1\xf6\x14\xfe\xcd\x4e\xa6\x0b\xc5\x32\x9c\x0b\x63\x86\xfd\xb9\x52\x25\x75\x0d\xf5\x48\x83\x41\xbd\x45\x01\x56\x4f\x29\xb3\x67\x13\x17\xc0\xf1\xb9\x41\x6c\x34\xbe\xa4\x11\xca\xa6\x52\x3b\x81\x93\xd1\xd5\xe1\x5b\x23\x18\x37\x92\x7b\x89\x03\xba\x96\xbb\x62\x18\x42\x70\x52\xef\x47\xb0\x52\xaa\xa0\xe9\x36\x51\x59\x2c\x3b\x37\xfe\xf8\x52\xee\xd4\x3d\xa7\xd3\x21\x8d\xf5\x08\x6a\xb9\x04\x29\x8a\xf6\x6c\x4f\x8a\x22\xb2\xed\xbc\x9d\x53\x22\xa2\xd7\x35\x91\x2f\xf6\x3a\x20\xe9\x32\x50\xab\x0e\x11\xd9\xd9\xb8\x5d\xf1\xc3\xb8\x9e\x2c\xe3\x1c\x15\xf5\x8d\x48\x8e\x60\xb4\xe4\x87\x5e\x0f\x35\x27\x60\xd1\x5c\x3d\x69\x23\x7e\x4f\xe1\x19\x84\x94\x9b\xec\x28\xb5\x1e\xca\xeb\xf8\x12\x7d\x3a\x10\xa1\xd7\xeb\x15\xf5\xec\x94\x6d\x70\x52\x1d\xd8\xef\x88\x00\xf1\x54\xcf\x32\x0b\xf0\x68\xc9\xe2\x67\x8b\xb7\x08\x41\x8e\x17\x1a\x02\x51\x9d\x89\x3d\x41\xb8\x0b\x4e\x0d\x41\x9f\x7e\x18\xff\x9b\x52\x85\x85\x78\xb2\x03\x5d\xb3\xef\x0a\x69\xf6\x2f\x49\x68\xf6\x2f\x88\x47\xef\x07\x66\xdf\x95\xcd\xec\xdb\x92\x99\xbd\x95\xeb\x1a\xe7\x4b\x24\x9c\xbd\x53\x81\xb1\xd4\xf0\xcb\xb2\x3d\x1d\xd7\x8c\xdb\x3b\xd6\x56\x48\x33\x0f\x1c\x18\xad\xd5\xe2\xb5\xb9\x77\xc6\x2f\x22\x6a\xdf\xdc\x0c\xfa\xb3\xae\xed\xae\x69\xc6\x84\xce\x7a\x5c\x41\xe7\xb4\x4e\xfc\xdc\x4d\x7f\xbd\x8a\x70\xd9\x7a\x89\x16\x32\xa5\x49\x1b\x3d\x24\xb9\xb1\x63\x49\xd3\xb4\xfd\x86\x4b\x2d\x76\x6e\x3e\xd5\xa6\xb6\x84\x92\x6d\x6e\xac\x93\xdf\x36\x2e\xfe\xe5\xe9\xc5\xb8\xfc\xf5\xea\x4f\x46\x25\x82\x51\x34\xa8\xf5\xb7\x02\x32\x02\x55\xd9\x3a\xd2\x9c\xb8\xfe\xc3\x05\x11\xe1\xe1\x26\xcd\xeb\x01\x22\x7d\x5c\x3f\xe2\xad\xa6\x33\x06\x75\xaf\x63\xf1\x4f\x1f\x3e\xfe\xf4\xe1\x1a\xc4\x1a\x24\x17\x74\xe9\xc8\x19\x3d\x9d\x20\x26\x3b\xec\x7c\x31\xfc\x7f\xbd\xfa\x76\xf0\xd3\x1b\x9c\x7d\xdb\x8a\x40\xdd\xdb\x87\xab\x46\xa1\x37\x78\xee\x96\xc2\xfe\x95\xba\x27\xa7\x13\x6b\xb0\x90\xcb\x6f\xa7\x99\xf0\xa2\x01\xef\x3d\xba\x44\x42\xa4\x10\x7d\x4b\xca\x3a\xab\x10\x56\xdc\x7e\x75\x4c\x2b\xdd\xc4\xc2\xab\x0a\xd7\x9e\x1c\xac\x5d\x81\x25\xbc\x7f\xc6\xc2\xf5\x1f\x48\x2b\xb0\x2e\x54\x93\x0e\x2f\xfe\xc7\xc8\xf1\xff\x5f\xc9\x8f\xa2\x92\xb7\xf7\xfb\xf0\x5e\xdc\x1f\x1f\x17\x23\x37\x15\xb6\xf3\xdc\xda\x95\xb0\x6f\x3d\x2a\xb8\x9e\x89\xb3\xca\x3e\x73\x79\xbd\xee\xb8\xd7\x9a\x9e\x2e\x82\x68\x52\x1d\x7b\xbd\x14\xd5\x74\x52\x34\xd1\xf5\x3a\x8d\x90\x51\xef\x6b\x37\x6e\xa8\xb6\x43\xec\x88\xd1\xce\xf2\x8f\xaf\x60\x4a\x72\x50\x6b\xcf\xbd\xa3\xe9\x0d\x4f\xc5\x5a\xf0\x0c\xb4\xda\x56\x29\xd7\x31\x5c\x71\x4e\xf8\xad\x79\xdc\x8b\x40\x21\x34\x3d\xfa\xe8\xed\x06\x9f\x6e\x5b\xf0\xf5\x60\x7d\x2d\x2a\x6d\x8e\x8f\x87\x14\x13\xf4\xbc\xea\xc2\xb6\xcd\x08\x5d\xbf\x84\x06\xbd\x4d\x53\xae\xb5\x7d\xf4\xb3\x39\x01\xd1\xe1\x2d\x77\xc3\x2a\x8d\xf7\x3b\xed\xc4\xe3\x99\xbd\xd2\xd5\x02\xd6\x33\xfd\x4f\xb2\xe8\x5a\x03\x23\xc3\xca\x68\x05\xb4\x6c\x42\xce\x76\xbc\x09\xd6\xc8\x5e\xc1\x1d\x49\xd2\xd5\xcb\xc9\xa1\x36\x5d\xc9\xee\xb9\xee\x38\x1a\xbd\xa0\x81\x68\xde\x2f\xac\x0d\xd5\x96\x32\x03\x2f\x57\x3c\xab\xf3\xd5\xb3\x57\x10\x7c\xa1\x7e\x21\x46\xdb\xd1\x19\x35\x5c\xdf\xdc\x36\xf1\xfa\x08\xad\x88\x25\x65\xff\x54\x55\xad\xd0\x45\x3b\xfd\xb5\x3e\x89\xce\x63\xe7\xfb\x35\x26\xf4\xf8\x7a\xa6\x78\x0c\xc5\x65\xcb\xd2\x2e\x60\xec\x81\xd0\x6b\xe2\xb1\x55\xe6\x71\xe9\xf1\xff\x12\x76\xcb\x17\xc2\xee\xd1\x3a\x74\x2b\xe8\xc4\xfa\x28\x98\x3b\xf1\xfa\x75\x1d\xb6\xcf\x12\x20\xa1\x3a\x42\xbb\xf8\x7d\xa2\x10\xc5\xe4\xa0\x58\x86\x4c\xfd\x44\x96\xe0\x99\x65\xae\x1d\xfe\xc8\x61\xd6\xce\x49\x2f\x1f\x69\xd2\xd3\x9f\xd1\xc6\xcd\xed\xea\x60\x78\x50\x93\x0c\xff\x5f\xb4\xf2\xd8\x91\xf4\x85\xda\x60\x5b\xbb\x67\x8c\x3d\x63\xcb\x6a\xf7\x7f\xc5\xcf\x73\x76\x9e\xa1\x78\xf5\x8d\x2e\xd2\xed\xb7\x3b\x8c\x3a\x51\xab\x4a\xd3\x7f\x43\xe1\x6f\xa5\x7d\xfe\x3a\x46\x18\x36\xf9\x56\x96\x27\xef\xbf\x07\x00\x2a\x65\x06\x01\x81\x22\x00\x00"),
+		},
+		"/src/time/zoneinfo_js_test.go": &vfsgen۰CompressedFileInfo{
+			name:             "zoneinfo_js_test.go",
+			modTime:          time.Date(2026, 10, 15, 22, 7, 12, 893604997, time.UTC),
+			uncompressedSize: 1438,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x54\x5d\x6f\xdb\x36\x14\x7d\x16\x7f\xc5\xa9\x80\x74\x52\xc7\x49\x4a\xd6\xa4\x58\x0a\x3f\x04\x75\x0a\xa4\xe8\x82\x01\x71\x1f\xb6\xa2\x30\xae\x25\x4a\xe6\x2c\x93\x06\x75\x65\xb7\x4b\xfd\xdf\x07\x52\x76\x3e\xb0\xc4\xc0\x9e\xfa\xe0\x87\x4b\x9f\x73\xcf\xe1\xb9\xd4\xcd\x73\xfc\x3c\xeb\x75\x5b\xe1\xef\x4e\x88\x15\x95\x0b\x6a\x14\x58\x2f\x95\x10\x7a\xb9\xb2\x8e\x11\xb3\xea\x58\x9b\x26\x16\xa2\xee\x4d\x89\x89\xea\xf8\xa3\xa5\xea\xa3\x2d\x89\xb5\x35\xef\x9d\x5d\x7e\xb8\x49\x18\xaf\x76\xc0\x6c\x92\xe2\x56\x44\xba\x86\x36\xdc\xfe\x65\x8d\x1a\x13\x53\x76\x65\xd6\x76\xa1\x92\xf8\xd3\xe4\x5d\x9c\x62\x34\x82\xd1\xad\xc7\x45\x9c\xdd\x2c\xf4\x2a\x89\xaf\x0c\xb7\xb8\xf8\xe3\x0a\xba\x33\x3f\x31\x68\x4d\xba\xa5\x59\xab\xe2\x54\x44\x5b\x11\xb5\xb6\x94\x50\xce\xe1\x7c\x84\xf6\xbf\xfa\xf1\xc5\x52\x39\x5d\x52\x7e\xad\x36\xd3\x3f\xad\x5b\x78\x9a\xae\x03\xe3\xc5\x43\xb1\xf7\xc4\xd4\xd6\x49\xfc\x44\x8f\x14\x4e\x71\xef\x8c\xaa\x3c\xcd\xba\x73\x1c\xad\xe3\x20\x3a\x58\xc8\x73\x8c\xe9\x5b\xab\x9b\x39\xa3\xa3\xb5\x36\x4d\x88\x0a\x33\xd5\x90\x01\x31\x4e\xce\x8b\x02\x97\x37\x13\x58\x83\xdf\xc9\x95\x73\x1c\xbf\x96\x38\x29\x4e\x8e\x25\xc8\x54\x50\xa6\x52\x15\x88\x43\xab\x01\x3c\x0e\xe0\x6b\xbb\x56\xcb\x99\x72\x78\x33\xc0\x33\x11\xd5\xd6\x61\x2a\xe1\x43\xf5\x57\x76\x64\x1a\x85\xcf\x5f\x3a\x76\x7d\xc9\xe1\x2e\x3d\x97\x00\x30\xf1\xd3\x8a\x22\x43\x4b\x05\xa0\x63\xa7\x4d\x23\xa2\xc8\xd6\x75\xa7\xd8\xcf\x40\x44\x5b\x0f\xbf\x1d\x13\xab\x64\x30\x13\xcc\xc9\xe0\xee\x4c\xe2\xf4\xb7\xe1\x57\x48\x7c\x9a\xbc\x4b\x25\xe2\xcb\x9b\x49\x2c\xf1\xcb\x29\x5e\xe1\xd7\xb3\xa2\xd8\xca\xe7\xf9\x6f\x02\xaf\x78\xc4\x1e\x07\xf6\xeb\xe7\xd8\xfb\xdb\x06\xf2\xe9\x93\xfa\xff\xa7\xc3\xd9\x13\x0e\x0e\xf8\x3f\x2d\x24\x3e\xf4\xed\x37\x89\xe3\x07\xc4\x03\xda\xc8\x73\x5c\x7e\x65\x47\x2b\xdb\x12\xab\x2a\x13\xd1\x36\x0c\x40\xd7\xf0\xa9\x4b\xec\xb2\x3e\x1f\x85\x71\x65\x3d\x97\xd9\x95\x49\x5a\x5b\xa6\x99\x7f\xff\x49\xfa\x36\x00\xf1\x62\x07\x08\xc5\xf7\xef\x7b\xde\xfe\x78\x57\xfa\xd6\x11\x67\x97\xfe\x09\xd6\x49\x3c\x74\x00\x31\x8e\xd6\x18\xe1\xa8\x93\x38\xaa\x24\x36\x64\x78\x57\xc4\xf2\x4e\x57\x3e\x72\x24\xef\xe5\xe4\x43\x89\x54\x44\xfe\x3d\x6f\x85\x88\xa8\x21\x6d\x7e\xf4\x87\xa5\x6b\x04\x1f\x7e\x2d\xb4\xb6\xf4\xd1\xbc\x0c\x07\x19\x7f\xfd\x5c\x7c\xf1\x3a\x2f\x5b\x5b\xee\xaa\x5b\x71\x17\xcf\x33\x6a\x95\xae\xfc\x0a\x19\x44\x41\x30\x6a\x83\x3d\x08\x1b\xcd\x73\xf0\x5c\xa1\xa4\x72\xae\x2a\xb0\x23\xd3\x69\xff\x57\x17\xdf\xd9\x99\x1e\x8c\xe4\xda\x72\x7e\x31\xf5\x83\x89\xd3\xb7\x01\xf8\x68\x9f\x1d\xb2\x66\x6b\x90\x41\x6f\x16\xc6\x6e\x0c\xfe\xb1\x46\xdd\x67\x63\xec\x10\xcf\x60\x63\x2b\xfe\x1d\x00\x0a\x83\xdb\x80\x9e\x05\x00\x00"),
 		},
 		"/src/unicode": &vfsgen۰DirInfo{
 			name:    "unicode",
-			modTime: time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime: time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 		},
 		"/src/unicode/unicode.go": &vfsgen۰CompressedFileInfo{
 			name:             "unicode.go",
-			modTime:          time.Date(2021, 6, 12, 14, 56, 38, 956780500, time.UTC),
+			modTime:          time.Date(2021, 7, 7, 9, 48, 41, 0, time.UTC),
 			uncompressedSize: 658,
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x54\x91\x41\x8f\xd3\x30\x10\x85\xcf\xf6\xaf\x78\xa7\x28\x51\xba\x64\xcb\x71\xd5\x72\x29\x12\x08\xb1\x97\x72\xac\x0a\xf2\x3a\x93\xc6\xe0\xd8\xd6\xc4\x91\x40\xdb\xfe\x77\x64\x27\x0d\xcb\xcd\x9e\x79\xf3\x66\xe6\x9b\xa6\x41\xfd\x32\x19\xdb\xe2\xe7\x28\x65\x50\xfa\x97\xba\x10\x26\x67\xb4\x6f\x49\xca\x6e\x72\x1a\xd1\x97\x3f\xb4\x1a\x09\xc6\xc5\x0d\x18\x3c\x39\xda\x20\x45\x8e\xca\x5d\x08\xa7\xf3\xe1\xfe\xae\x50\x0e\x2a\x04\x6a\x8f\x93\xa3\x45\xd8\xf9\xc9\xb5\xcf\x2a\x04\xe3\x2e\x78\xf1\xde\x56\x78\x95\xc2\x74\x98\x4d\x77\x78\xc4\xf5\x8a\x67\xf5\xfb\x90\xbf\xfb\x25\xfe\x2a\x85\x60\x8a\x13\x3b\x1c\x29\x58\xa5\x69\x20\x17\x0f\xbd\xe2\x0d\x3a\x65\x47\x92\xe2\x26\x85\xf5\x78\xda\xe3\x51\x8a\xde\xa4\x87\x25\x57\xae\x83\x55\x52\x74\x9e\x61\x3d\x76\xe8\x4d\x36\x1c\xb2\xc8\xa3\x46\xd9\x9b\x07\xeb\xab\xe6\xbd\x14\x42\x73\x0a\x17\x6b\xe1\x69\x38\xa3\x69\x10\x88\x3b\xcf\x83\x72\x9a\xa0\xd9\x44\xa3\x95\x45\x72\xfc\xe4\x43\x4f\xfc\xe5\xdb\x13\x2e\x14\xa1\xda\x96\x69\x1c\xd1\x13\x27\x44\x63\x24\xd5\xc2\x77\xd0\x3e\xfc\x49\x2b\xc7\x9e\xb0\x02\x92\x22\x6d\x9e\xc0\x94\x9a\xdf\x7d\xf5\x55\x5a\x98\x51\x14\xe0\xfc\x5a\x12\x9f\x4d\x86\x24\x44\x4b\x36\xaa\x34\xdd\x3d\xf3\x31\x05\x4e\x19\xd1\xb9\x4a\x0a\xd3\x61\x16\x7d\x48\x0c\x33\xf7\x5c\x79\x87\xf7\xb6\x57\x8d\xb2\xe4\x87\x37\x91\xaa\xf8\xbe\xc5\x75\xd6\x64\xcf\x62\x5b\x55\x1b\x44\x9e\xd2\xa4\x09\xf0\x3f\x1f\xd4\x73\xa3\x35\x7d\x5b\x96\xc1\xee\xbf\x26\xb9\x7b\x6f\xb0\xc7\x90\x44\x20\xbb\x5c\x33\x1d\x6b\x8f\x01\x35\xb6\x73\xf5\x4d\xae\xe6\xf7\x9b\xde\xe4\xdf\x00\x00\x00\xff\xff\x20\xe3\x22\xd1\x92\x02\x00\x00"),
@@ -846,6 +1137,8 @@ var FS = func() http.FileSystem {
 		fs["/src/database"].(os.FileInfo),
 		fs["/src/debug"].(os.FileInfo),
 		fs["/src/encoding"].(os.FileInfo),
+		fs["/src/errors"].(os.FileInfo),
+		fs["/src/expvar"].(os.FileInfo),
 		fs["/src/fmt"].(os.FileInfo),
 		fs["/src/go"].(os.FileInfo),
 		fs["/src/golang.org"].(os.FileInfo),
@@ -876,6 +1169,7 @@ var FS = func() http.FileSystem {
 	fs["/src/crypto"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/crypto/internal"].(os.FileInfo),
 		fs["/src/crypto/rand"].(os.FileInfo),
+		fs["/src/crypto/tls"].(os.FileInfo),
 		fs["/src/crypto/x509"].(os.FileInfo),
 	}
 	fs["/src/crypto/internal"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -887,6 +1181,9 @@ var FS = func() http.FileSystem {
 	fs["/src/crypto/rand"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/crypto/rand/rand.go"].(os.FileInfo),
 	}
+	fs["/src/crypto/tls"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/crypto/tls/tls.go"].(os.FileInfo),
+	}
 	fs["/src/crypto/x509"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/crypto/x509/x509.go"].(os.FileInfo),
 		fs["/src/crypto/x509/x509_test.go"].(os.FileInfo),
@@ -914,9 +1211,18 @@ var FS = func() http.FileSystem {
 		fs["/src/encoding/gob/gob_test.go"].(os.FileInfo),
 	}
 	fs["/src/encoding/json"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/encoding/json/json.go"].(os.FileInfo),
 		fs["/src/encoding/json/stream_test.go"].(os.FileInfo),
 	}
+	fs["/src/errors"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/errors/errors.go"].(os.FileInfo),
+		fs["/src/errors/errors_test.go"].(os.FileInfo),
+	}
+	fs["/src/expvar"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/expvar/expvar.go"].(os.FileInfo),
+	}
 	fs["/src/fmt"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/fmt/errors.go"].(os.FileInfo),
 		fs["/src/fmt/fmt_test.go"].(os.FileInfo),
 	}
 	fs["/src/go"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -930,6 +1236,7 @@ var FS = func() http.FileSystem {
 	}
 	fs["/src/golang.org/x"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/golang.org/x/crypto"].(os.FileInfo),
+		fs["/src/golang.org/x/sys"].(os.FileInfo),
 	}
 	fs["/src/golang.org/x/crypto"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/golang.org/x/crypto/internal"].(os.FileInfo),
@@ -940,9 +1247,30 @@ var FS = func() http.FileSystem {
 	fs["/src/golang.org/x/crypto/internal/subtle"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/golang.org/x/crypto/internal/subtle/aliasing.go"].(os.FileInfo),
 	}
+	fs["/src/golang.org/x/sys"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/golang.org/x/sys/cpu"].(os.FileInfo),
+		fs["/src/golang.org/x/sys/unix"].(os.FileInfo),
+	}
+	fs["/src/golang.org/x/sys/cpu"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/golang.org/x/sys/cpu/cpu.go"].(os.FileInfo),
+	}
+	fs["/src/golang.org/x/sys/unix"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/golang.org/x/sys/unix/unix.go"].(os.FileInfo),
+		fs["/src/golang.org/x/sys/unix/unix_darwin.go"].(os.FileInfo),
+		fs["/src/golang.org/x/sys/unix/unix_linux.go"].(os.FileInfo),
+		fs["/src/golang.org/x/sys/unix/unix_linux_amd64.go"].(os.FileInfo),
+	}
 	fs["/src/hash"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/hash/adler32"].(os.FileInfo),
+		fs["/src/hash/crc32"].(os.FileInfo),
 		fs["/src/hash/maphash"].(os.FileInfo),
 	}
+	fs["/src/hash/adler32"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/hash/adler32/adler32.go"].(os.FileInfo),
+	}
+	fs["/src/hash/crc32"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/hash/crc32/crc32.go"].(os.FileInfo),
+	}
 	fs["/src/hash/maphash"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/hash/maphash/maphash.go"].(os.FileInfo),
 	}
@@ -996,18 +1324,22 @@ var FS = func() http.FileSystem {
 	fs["/src/math"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/math/big"].(os.FileInfo),
 		fs["/src/math/bits"].(os.FileInfo),
+		fs["/src/math/jsmath.go"].(os.FileInfo),
 		fs["/src/math/math.go"].(os.FileInfo),
 		fs["/src/math/math_test.go"].(os.FileInfo),
+		fs["/src/math/precise.go"].(os.FileInfo),
 		fs["/src/math/rand"].(os.FileInfo),
 	}
 	fs["/src/math/big"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/math/big/big.go"].(os.FileInfo),
 		fs["/src/math/big/big_test.go"].(os.FileInfo),
+		fs["/src/math/big/nat.go"].(os.FileInfo),
 	}
 	fs["/src/math/bits"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/math/bits/bits.go"].(os.FileInfo),
 	}
 	fs["/src/math/rand"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/math/rand/rand.go"].(os.FileInfo),
 		fs["/src/math/rand/rand_test.go"].(os.FileInfo),
 	}
 	fs["/src/net"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -1016,12 +1348,25 @@ var FS = func() http.FileSystem {
 	}
 	fs["/src/net/http"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/net/http/cookiejar"].(os.FileInfo),
+		fs["/src/net/http/debughook.go"].(os.FileInfo),
 		fs["/src/net/http/fetch.go"].(os.FileInfo),
 		fs["/src/net/http/http.go"].(os.FileInfo),
+		fs["/src/net/http/httptest"].(os.FileInfo),
+		fs["/src/net/http/inprocess.go"].(os.FileInfo),
+		fs["/src/net/http/pprof"].(os.FileInfo),
+		fs["/src/net/http/sandbox_fetch.go"].(os.FileInfo),
+		fs["/src/net/http/sandbox_xhr.go"].(os.FileInfo),
+		fs["/src/net/http/xhr.go"].(os.FileInfo),
 	}
 	fs["/src/net/http/cookiejar"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/net/http/cookiejar/example_test.go"].(os.FileInfo),
 	}
+	fs["/src/net/http/httptest"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/net/http/httptest/server.go"].(os.FileInfo),
+	}
+	fs["/src/net/http/pprof"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/net/http/pprof/pprof.go"].(os.FileInfo),
+	}
 	fs["/src/os"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/os/os.go"].(os.FileInfo),
 		fs["/src/os/removeall_noat.go"].(os.FileInfo),
@@ -1040,10 +1385,12 @@ var FS = func() http.FileSystem {
 		fs["/src/regexp/regexp_test.go"].(os.FileInfo),
 	}
 	fs["/src/runtime"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/runtime/crash.go"].(os.FileInfo),
 		fs["/src/runtime/debug"].(os.FileInfo),
 		fs["/src/runtime/fastrand.go"].(os.FileInfo),
 		fs["/src/runtime/pprof"].(os.FileInfo),
 		fs["/src/runtime/runtime.go"].(os.FileInfo),
+		fs["/src/runtime/trace"].(os.FileInfo),
 	}
 	fs["/src/runtime/debug"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/runtime/debug/debug.go"].(os.FileInfo),
@@ -1051,6 +1398,9 @@ var FS = func() http.FileSystem {
 	fs["/src/runtime/pprof"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/runtime/pprof/pprof.go"].(os.FileInfo),
 	}
+	fs["/src/runtime/trace"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/runtime/trace/trace.go"].(os.FileInfo),
+	}
 	fs["/src/strings"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/strings/strings.go"].(os.FileInfo),
 		fs["/src/strings/strings_test.go"].(os.FileInfo),
@@ -1071,11 +1421,15 @@ var FS = func() http.FileSystem {
 	}
 	fs["/src/syscall"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/syscall/js"].(os.FileInfo),
+		fs["/src/syscall/node_linux.go"].(os.FileInfo),
+		fs["/src/syscall/node_stub.go"].(os.FileInfo),
 		fs["/src/syscall/syscall.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_darwin.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_darwin_arm64.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_linux.go"].(os.FileInfo),
+		fs["/src/syscall/syscall_module.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_nonlinux.go"].(os.FileInfo),
+		fs["/src/syscall/syscall_sandbox.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_unix.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_windows.go"].(os.FileInfo),
 	}
@@ -1085,6 +1439,7 @@ var FS = func() http.FileSystem {
 		fs["/src/syscall/js/js_test.go"].(os.FileInfo),
 	}
 	fs["/src/testing"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/testing/alarm.go"].(os.FileInfo),
 		fs["/src/testing/allocs_test.go"].(os.FileInfo),
 		fs["/src/testing/example.go"].(os.FileInfo),
 		fs["/src/testing/helper_test.go"].(os.FileInfo),
@@ -1098,9 +1453,13 @@ var FS = func() http.FileSystem {
 		fs["/src/text/template/template.go"].(os.FileInfo),
 	}
 	fs["/src/time"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/time/sandbox_zoneinfo_fetch.go"].(os.FileInfo),
+		fs["/src/time/synctest.go"].(os.FileInfo),
 		fs["/src/time/time.go"].(os.FileInfo),
 		fs["/src/time/time_test.go"].(os.FileInfo),
+		fs["/src/time/zoneinfo_fetch.go"].(os.FileInfo),
 		fs["/src/time/zoneinfo_js.go"].(os.FileInfo),
+		fs["/src/time/zoneinfo_js_test.go"].(os.FileInfo),
 	}
 	fs["/src/unicode"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/unicode/unicode.go"].(os.FileInfo),
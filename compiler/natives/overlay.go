@@ -0,0 +1,35 @@
+package natives
+
+import "fmt"
+
+// Roots returns the roots of the overlay sets that apply to the Go 1.x release
+// goVersion, in the order natives are looked up in: the sets of goVersion and
+// older releases, newest first, then the base set. Each root is laid out like
+// a GOROOT, with natives of a package in its src/<import path> directory.
+//
+// The base set, in the src directory, has the natives shared by all supported
+// releases. When the standard library changes in a way that requires
+// different natives for a package, those for newer releases go to a go1.N/src
+// directory, which replaces the natives of the package from go1.N on. The
+// natives of a package always come from a single set, the first one in which
+// the package exists.
+func Roots(goVersion int) []string {
+	var roots []string
+	for v := goVersion; v > 0; v-- {
+		root := fmt.Sprintf("/go1.%d", v)
+		if isDir(root + "/src") {
+			roots = append(roots, root)
+		}
+	}
+	return append(roots, "/")
+}
+
+func isDir(name string) bool {
+	f, err := FS.Open(name)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	return err == nil && fi.IsDir()
+}
@@ -0,0 +1,36 @@
+package natives
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestRoots(t *testing.T) {
+	dir := t.TempDir()
+	for _, d := range []string{"src/sync", "go1.15/src/sync", "go1.17/src/net", "go1.18"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func(fs http.FileSystem) { FS = fs }(FS)
+	FS = http.Dir(dir)
+
+	tests := []struct {
+		goVersion int
+		want      []string
+	}{
+		{goVersion: 14, want: []string{"/"}},
+		{goVersion: 16, want: []string{"/go1.15", "/"}},
+		{goVersion: 17, want: []string{"/go1.17", "/go1.15", "/"}},
+		// go1.18 has no src directory, so it isn't an overlay set.
+		{goVersion: 18, want: []string{"/go1.17", "/go1.15", "/"}},
+	}
+	for _, test := range tests {
+		if got := Roots(test.goVersion); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Roots(%d) = %q, want %q", test.goVersion, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// +build js
+
+package tls
+
+import "errors"
+
+// serverHandshake fails, since GopherJS programs can't accept connections that
+// a TLS server would run on. Browsers and Node.js terminate TLS themselves.
+// Leaving the server half of the handshake out of the package keeps it out of
+// programs that only use crypto/tls for client connections or its types.
+func (c *Conn) serverHandshake() error {
+	return errors.New("tls: server handshakes are not supported by GopherJS")
+}
@@ -0,0 +1,488 @@
+// +build js
+
+package json
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Marshal and Unmarshal go through JSON.stringify and JSON.parse for the data
+// shapes whose encoding is the same with the native functions, which are much
+// faster than the scanner and encoder running on emulated byte slices. Anything
+// else, like values with custom marshalers, float32 and integers beyond 2^53 or
+// invalid UTF-8, falls back to the encoder and the decoder.
+
+func Marshal(v interface{}) ([]byte, error) {
+	if b, ok := marshalNative(v); ok {
+		return b, nil
+	}
+
+	e := newEncodeState()
+
+	err := e.marshal(v, encOpts{escapeHTML: true})
+	if err != nil {
+		return nil, err
+	}
+	buf := append([]byte(nil), e.Bytes()...)
+
+	encodeStatePool.Put(e)
+
+	return buf, nil
+}
+
+func Unmarshal(data []byte, v interface{}) error {
+	if unmarshalNative(data, v) {
+		return nil
+	}
+
+	// Check for well-formedness.
+	// Avoids filling out half a data structure
+	// before discovering a JSON syntax error.
+	var d decodeState
+	err := checkValid(data, &d.scan)
+	if err != nil {
+		return err
+	}
+
+	d.init(data)
+	return d.unmarshal(v)
+}
+
+var (
+	nativeJSON           = js.Global.Get("JSON")
+	nativeObjectToString = js.Global.Get("Object").Get("prototype").Get("toString")
+
+	// nativeEscapes matches the escape sequences and characters that
+	// JSON.stringify writes differently from encodeState.string.
+	nativeEscapes = js.Global.Get("RegExp").New(`\\.|[<>&\u2028\u2029]`, "g")
+
+	// nativeUnsafeInput matches input that JSON.parse decodes differently from
+	// the decoder: escaped surrogates, which may be unpaired, and numbers that
+	// may be out of the float64 range.
+	nativeUnsafeInput = js.Global.Get("RegExp").New(`\\u[dD][89a-fA-F]|[\d.][eE][-+]?\d{3}|\d{309}`)
+
+	unmarshalerType    = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	emptyInterfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// maxNativeDepth bounds the nesting of values encoded by marshalNative, so that
+// cycles are left to the encoder, which reports them.
+const maxNativeDepth = startDetectingCyclesAfter
+
+func marshalNative(v interface{}) ([]byte, bool) {
+	o, ok := nativeValue(v, 0)
+	if !ok {
+		return nil, false
+	}
+	s := nativeJSON.Call("stringify", o).Call("replace", nativeEscapes, nativeEscape).String()
+	return []byte(s), true
+}
+
+func nativeEscape(s string) string {
+	switch s {
+	case `\b`:
+		return `\u0008`
+	case `\f`:
+		return `\u000c`
+	case "<":
+		return `\u003c`
+	case ">":
+		return `\u003e`
+	case "&":
+		return `\u0026`
+	case "\u2028":
+		return `\u2028`
+	case "\u2029":
+		return `\u2029`
+	}
+	return s
+}
+
+// nativeValue returns a value that externalizes to the JavaScript value for v,
+// or false if v can't be encoded with JSON.stringify. The common dynamic types
+// are handled without reflection.
+func nativeValue(v interface{}, depth int) (interface{}, bool) {
+	if depth > maxNativeDepth {
+		return nil, false
+	}
+	switch v := v.(type) {
+	case nil:
+		return nil, true
+	case string:
+		return v, utf8.ValidString(v)
+	case float64:
+		return v, nativeFloat(v)
+	case bool:
+		return v, true
+	case map[string]interface{}:
+		if v == nil {
+			return nil, true
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			if !nativeKey(k) {
+				return nil, false
+			}
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		o := js.Global.Get("Object").Call("create", nil)
+		for _, k := range keys {
+			e, ok := nativeValue(v[k], depth+1)
+			if !ok {
+				return nil, false
+			}
+			o.Set(k, e)
+		}
+		return o, true
+	case []interface{}:
+		if v == nil {
+			return nil, true
+		}
+		a := js.Global.Get("Array").New(len(v))
+		for i, x := range v {
+			e, ok := nativeValue(x, depth+1)
+			if !ok {
+				return nil, false
+			}
+			a.SetIndex(i, e)
+		}
+		return a, true
+	}
+	return nativeReflectValue(reflect.ValueOf(v), depth)
+}
+
+func nativeReflectValue(v reflect.Value, depth int) (interface{}, bool) {
+	if depth > maxNativeDepth {
+		return nil, false
+	}
+	t := v.Type()
+	if t == numberType {
+		return nil, false
+	}
+	if t.Implements(marshalerType) || t.Implements(textMarshalerType) {
+		return nil, false
+	}
+	if t.Kind() != reflect.Ptr && (reflect.PtrTo(t).Implements(marshalerType) || reflect.PtrTo(t).Implements(textMarshalerType)) {
+		return nil, false
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return v.Bool(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := v.Int()
+		return float64(i), -1<<53 <= i && i <= 1<<53
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		i := v.Uint()
+		return float64(i), i <= 1<<53
+	case reflect.Float64:
+		f := v.Float()
+		return f, nativeFloat(f)
+	case reflect.String:
+		s := v.String()
+		return s, utf8.ValidString(s)
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, true
+		}
+		if e := v.Elem(); e.CanInterface() {
+			return nativeValue(e.Interface(), depth+1)
+		}
+		return nativeReflectValue(v.Elem(), depth+1)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, true
+		}
+		return nativeReflectValue(v.Elem(), depth+1)
+	case reflect.Struct:
+		o := js.Global.Get("Object").Call("create", nil)
+	fields:
+		for _, f := range cachedTypeFields(t).list {
+			if f.quoted || !nativeKey(f.name) {
+				return nil, false
+			}
+			fv := v
+			for _, i := range f.index {
+				if fv.Kind() == reflect.Ptr {
+					if fv.IsNil() {
+						continue fields
+					}
+					fv = fv.Elem()
+				}
+				fv = fv.Field(i)
+			}
+			if f.omitEmpty && isEmptyValue(fv) {
+				continue
+			}
+			e, ok := nativeReflectValue(fv, depth+1)
+			if !ok {
+				return nil, false
+			}
+			o.Set(f.name, e)
+		}
+		return o, true
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, false
+		}
+		if v.IsNil() {
+			return nil, true
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		o := js.Global.Get("Object").Call("create", nil)
+		for _, k := range keys {
+			if !nativeKey(k.String()) {
+				return nil, false
+			}
+			e, ok := nativeReflectValue(v.MapIndex(k), depth+1)
+			if !ok {
+				return nil, false
+			}
+			o.Set(k.String(), e)
+		}
+		return o, true
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			return nil, false // Encoded with base64.
+		}
+		if t.Kind() == reflect.Slice && v.IsNil() {
+			return nil, true
+		}
+		a := js.Global.Get("Array").New(v.Len())
+		for i := 0; i < v.Len(); i++ {
+			e, ok := nativeReflectValue(v.Index(i), depth+1)
+			if !ok {
+				return nil, false
+			}
+			a.SetIndex(i, e)
+		}
+		return a, true
+	}
+	return nil, false
+}
+
+// nativeFloat reports whether JSON.stringify formats f like floatEncoder,
+// which is the case for finite numbers except negative zero.
+func nativeFloat(f float64) bool {
+	return !math.IsInf(f, 0) && !math.IsNaN(f) && (f != 0 || !math.Signbit(f))
+}
+
+// nativeKey reports whether k can be an object key for JSON.stringify. Integer
+// keys are excluded, because JavaScript objects list them first.
+func nativeKey(k string) bool {
+	if !utf8.ValidString(k) {
+		return false
+	}
+	for i := 0; i < len(k); i++ {
+		if k[i] < '0' || k[i] > '9' {
+			return true
+		}
+	}
+	return k == ""
+}
+
+// unmarshalNative decodes data into v with JSON.parse. It returns false without
+// modifying v if the result could differ from the decoder's, which includes
+// all errors.
+func unmarshalNative(data []byte, v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return false
+	}
+	rv = rv.Elem()
+	t := rv.Type()
+	if !nativeDecodable(t) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		if t.NumMethod() != 0 || !rv.IsNil() && rv.Elem().Kind() == reflect.Ptr {
+			return false
+		}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String || reflect.PtrTo(t.Key()).Implements(textUnmarshalerType) || t.Elem() != emptyInterfaceType {
+			return false
+		}
+	case reflect.Slice:
+		if !rv.IsNil() || t.Elem() != emptyInterfaceType {
+			return false
+		}
+	case reflect.Struct:
+	default:
+		return false
+	}
+
+	if len(data) > maxNestingDepth && bytes.Count(data, []byte("["))+bytes.Count(data, []byte("{")) > maxNestingDepth {
+		return false // The scanner reports too deep nesting.
+	}
+	s := string(data)
+	if nativeUnsafeInput.Call("test", s).Bool() {
+		return false
+	}
+	o, ok := parseNative(s)
+	if !ok {
+		return false
+	}
+
+	if t.Kind() == reflect.Struct {
+		return unmarshalNativeStruct(o, rv)
+	}
+	x := o.Interface()
+	if x == nil {
+		rv.Set(reflect.Zero(t))
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		rv.Set(reflect.ValueOf(x))
+	case reflect.Map:
+		m, ok := x.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMapWithSize(t, len(m)))
+		}
+		for k, e := range m {
+			ev := reflect.Zero(t.Elem())
+			if e != nil {
+				ev = reflect.ValueOf(e)
+			}
+			rv.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), ev)
+		}
+	case reflect.Slice:
+		a, ok := x.([]interface{})
+		if !ok {
+			return false
+		}
+		rv.Set(reflect.ValueOf(a).Convert(t))
+	}
+	return true
+}
+
+// unmarshalNativeStruct decodes the JavaScript object o into the struct v, if
+// all the fields its keys refer to are booleans, strings, float64 or empty
+// interfaces that can be set without allocating anything.
+func unmarshalNativeStruct(o *js.Object, v reflect.Value) bool {
+	switch nativeObjectToString.Call("call", o).String() {
+	case "[object Null]":
+		return true
+	case "[object Object]":
+	default:
+		return false
+	}
+
+	// Check all the keys first, so that v is not modified if the decoder has to
+	// be used.
+	type assignment struct {
+		v reflect.Value
+		x interface{}
+	}
+	fields := cachedTypeFields(v.Type())
+	keys := js.Global.Get("Object").Call("keys", o)
+	assignments := make([]assignment, 0, keys.Length())
+	for i := 0; i < keys.Length(); i++ {
+		key := keys.Index(i).String()
+		f := nativeField(fields, key)
+		if f == nil {
+			continue
+		}
+		if f.quoted || !nativeDecodable(f.typ) {
+			return false
+		}
+		fv := v
+		for _, i := range f.index {
+			if fv.Kind() == reflect.Ptr {
+				return false
+			}
+			fv = fv.Field(i)
+		}
+		if !fv.CanSet() {
+			return false
+		}
+		e := o.Get(key).Interface()
+		switch fv.Kind() {
+		case reflect.Bool:
+			if _, ok := e.(bool); !ok && e != nil {
+				return false
+			}
+		case reflect.String:
+			if _, ok := e.(string); !ok && e != nil {
+				return false
+			}
+		case reflect.Float64:
+			if _, ok := e.(float64); !ok && e != nil {
+				return false
+			}
+		case reflect.Interface:
+			if fv.NumMethod() != 0 || !fv.IsNil() && fv.Elem().Kind() == reflect.Ptr {
+				return false
+			}
+		default:
+			return false
+		}
+		assignments = append(assignments, assignment{fv, e})
+	}
+
+	for _, a := range assignments {
+		switch {
+		case a.v.Kind() == reflect.Interface && a.x == nil:
+			a.v.Set(reflect.Zero(a.v.Type()))
+		case a.x == nil:
+			// Null leaves other values unchanged.
+		case a.v.Kind() == reflect.Interface:
+			a.v.Set(reflect.ValueOf(a.x))
+		default:
+			a.v.Set(reflect.ValueOf(a.x).Convert(a.v.Type()))
+		}
+	}
+	return true
+}
+
+// nativeField looks up the field for an object key like decodeState.object.
+func nativeField(fields structFields, key string) *field {
+	if i, ok := fields.nameIndex[key]; ok {
+		return &fields.list[i]
+	}
+	kb := []byte(key)
+	for i := range fields.list {
+		if f := &fields.list[i]; f.equalFold(f.nameBytes, kb) {
+			return f
+		}
+	}
+	return nil
+}
+
+// nativeDecodable reports whether values of type t are decoded without custom
+// unmarshalers.
+func nativeDecodable(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr || t == numberType {
+		return false
+	}
+	for _, t := range []reflect.Type{t, reflect.PtrTo(t)} {
+		if t.Implements(unmarshalerType) || t.Implements(textUnmarshalerType) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseNative(s string) (o *js.Object, ok bool) {
+	defer func() {
+		if e := recover(); e != nil {
+			if _, isJSErr := e.(*js.Error); !isJSErr {
+				panic(e)
+			}
+			o, ok = nil, false
+		}
+	}()
+	return nativeJSON.Call("parse", s), true
+}
@@ -0,0 +1,51 @@
+// +build js
+
+package errors_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	if err := errors.Join(); err != nil {
+		t.Errorf("errors.Join() = %v, want nil", err)
+	}
+	if err := errors.Join(nil, nil); err != nil {
+		t.Errorf("errors.Join(nil, nil) = %v, want nil", err)
+	}
+
+	err := errors.Join(err1, nil, err2)
+	if got, want := err.Error(), "err1\nerr2"; got != want {
+		t.Errorf("Got Error() %q, want %q", got, want)
+	}
+	errs := err.(interface{ Unwrap() []error }).Unwrap()
+	if len(errs) != 2 || errs[0] != err1 || errs[1] != err2 {
+		t.Errorf("Got Unwrap() %v, want [err1 err2]", errs)
+	}
+}
+
+func TestIsJoined(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	err := errors.Join(err1, &fs.PathError{Op: "open", Path: "x", Err: err2})
+	for _, target := range []error{err1, err2, err} {
+		if !errors.Is(err, target) {
+			t.Errorf("errors.Is(err, %v) = false, want true", target)
+		}
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		t.Error("errors.Is(err, os.ErrNotExist) = true, want false")
+	}
+}
+
+func TestAsJoined(t *testing.T) {
+	want := &fs.PathError{Op: "open", Path: "x", Err: os.ErrNotExist}
+	err := errors.Join(errors.New("err1"), errors.Join(nil, want))
+	var got *fs.PathError
+	if !errors.As(err, &got) || got != want {
+		t.Errorf("Got errors.As() %v, want %v", got, want)
+	}
+}
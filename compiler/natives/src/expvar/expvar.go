@@ -0,0 +1,17 @@
+// +build js
+
+package expvar
+
+import (
+	_ "unsafe" // For go:linkname.
+)
+
+// GopherJS programs can't listen for connections, so the /debug/vars handler
+// is made reachable from JavaScript instead, see net/http.exposeDebugHandlers.
+
+//go:linkname exposeDebugHandlers net/http.exposeDebugHandlers
+func exposeDebugHandlers()
+
+func init() {
+	exposeDebugHandlers()
+}
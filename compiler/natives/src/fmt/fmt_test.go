@@ -2,4 +2,92 @@
 
 package fmt_test
 
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
 const intCount = 100
+
+// TestErrorf is replaced because several %w verbs are supported, as in Go
+// 1.20.
+func TestErrorf(t *testing.T) {
+	// noVetErrorf is an alias for fmt.Errorf that does not trigger vet
+	// warnings for %w format strings.
+	noVetErrorf := fmt.Errorf
+
+	wrapped := errors.New("inner error")
+	err1, err2, err3 := errors.New("1"), errors.New("2"), errors.New("3")
+	for _, test := range []struct {
+		err        error
+		wantText   string
+		wantUnwrap error
+		wantSplit  []error
+	}{{
+		err:        fmt.Errorf("%w", wrapped),
+		wantText:   "inner error",
+		wantUnwrap: wrapped,
+	}, {
+		err:        fmt.Errorf("added context: %w", wrapped),
+		wantText:   "added context: inner error",
+		wantUnwrap: wrapped,
+	}, {
+		err:      noVetErrorf("%w is not an error", "not-an-error"),
+		wantText: "%!w(string=not-an-error) is not an error",
+	}, {
+		err:       noVetErrorf("wrapped two errors: %w %w", err1, err2),
+		wantText:  "wrapped two errors: 1 2",
+		wantSplit: []error{err1, err2},
+	}, {
+		err:       noVetErrorf("wrapped three errors: %w %w %w", err1, err2, err3),
+		wantText:  "wrapped three errors: 1 2 3",
+		wantSplit: []error{err1, err2, err3},
+	}, {
+		err:       noVetErrorf("wrapped a nil error and an error: %w %w", nil, err1),
+		wantText:  "wrapped a nil error and an error: %!w(<nil>) 1",
+		wantSplit: []error{err1},
+	}, {
+		err:       noVetErrorf("wrapped one non-error: %w %w %w", err1, "not-an-error", err3),
+		wantText:  "wrapped one non-error: 1 %!w(string=not-an-error) 3",
+		wantSplit: []error{err1, err3},
+	}, {
+		err:       noVetErrorf("wrapped errors out of order: %[3]w %[2]w %[1]w", err1, err2, err3),
+		wantText:  "wrapped errors out of order: 3 2 1",
+		wantSplit: []error{err1, err2, err3},
+	}, {
+		err:       noVetErrorf("wrapped several times: %[1]w %[1]w %[2]w %[1]w", err1, err2),
+		wantText:  "wrapped several times: 1 1 2 1",
+		wantSplit: []error{err1, err2},
+	}, {
+		err:      noVetErrorf("%w", nil),
+		wantText: "%!w(<nil>)",
+	}, {
+		err:      fmt.Errorf("%v", wrapped),
+		wantText: "inner error",
+	}, {
+		err:      fmt.Errorf("added context: %v", wrapped),
+		wantText: "added context: inner error",
+	}} {
+		if got, want := errors.Unwrap(test.err), test.wantUnwrap; got != want {
+			t.Errorf("Formatted error: %v\nerrors.Unwrap() = %v, want %v", test.err, got, want)
+		}
+		if test.wantSplit != nil {
+			unwrapper, ok := test.err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Errorf("Formatted error: %v\nhas no Unwrap() []error method", test.err)
+			} else if got, want := unwrapper.Unwrap(), test.wantSplit; !reflect.DeepEqual(got, want) {
+				t.Errorf("Formatted error: %v\nUnwrap() []error = %v, want %v", test.err, got, want)
+			}
+			for _, err := range test.wantSplit {
+				if !errors.Is(test.err, err) {
+					t.Errorf("Formatted error: %v\nerrors.Is(err, %v) = false, want true", test.err, err)
+				}
+			}
+		}
+		if got, want := test.err.Error(), test.wantText; got != want {
+			t.Errorf("err.Error() = %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// +build js
+
+package cpu
+
+const cacheLineSize = 0
+
+// archInit leaves all features unset, GopherJS doesn't use CPU instructions
+// directly.
+func archInit() {}
+
+func initOptions() {}
@@ -0,0 +1,45 @@
+// +build js
+
+package unix
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// GopherJS: System calls are forwarded to the syscall package, which makes
+// them through Node.js if it can, see doc/syscalls.md.
+
+func Syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	return syscall.Syscall(trap, a1, a2, a3)
+}
+
+func Syscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	return syscall.Syscall6(trap, a1, a2, a3, a4, a5, a6)
+}
+
+func RawSyscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	return syscall.RawSyscall(trap, a1, a2, a3)
+}
+
+func RawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	return syscall.RawSyscall6(trap, a1, a2, a3, a4, a5, a6)
+}
+
+// SetNonblock leaves file descriptors in blocking mode. GopherJS has no poller
+// to wait on descriptors that aren't ready, so non-blocking reads and writes
+// would fail with EAGAIN instead of waiting.
+func SetNonblock(fd int, nonblocking bool) (err error) {
+	return nil
+}
+
+// setsockopt accepts and ignores all socket options. GopherJS doesn't use socket
+// file descriptors for network connections, so libraries tuning the sockets of
+// their connections get nothing to set them on.
+func setsockopt(s int, level int, name int, val unsafe.Pointer, vallen uintptr) (err error) {
+	return nil
+}
+
+func getsockopt(s int, level int, name int, val unsafe.Pointer, vallen *_Socklen) (err error) {
+	return ENOPROTOOPT
+}
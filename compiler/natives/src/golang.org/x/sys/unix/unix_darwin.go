@@ -0,0 +1,45 @@
+// +build js
+
+package unix
+
+import "syscall"
+
+// funcPC calls libc trampolines, which GopherJS doesn't have, so that the
+// "native function not implemented" panic names the missing function. Only the
+// functions replaced in unix.go work on macOS hosts.
+func funcPC(f func()) uintptr {
+	f()
+	return ^uintptr(0)
+}
+
+func Syscall9(num, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	panic("Syscall9 is not implemented")
+}
+
+func syscall_syscall(fn, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	return syscall.Syscall(fn, a1, a2, a3)
+}
+
+func syscall_syscall6(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
+	return syscall.Syscall6(fn, a1, a2, a3, a4, a5, a6)
+}
+
+func syscall_syscall6X(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
+	panic("syscall6X is not implemented")
+}
+
+func syscall_syscall9(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2 uintptr, err Errno) {
+	panic("syscall9 is not implemented")
+}
+
+func syscall_rawSyscall(fn, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	return syscall.RawSyscall(fn, a1, a2, a3)
+}
+
+func syscall_rawSyscall6(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
+	return syscall.RawSyscall6(fn, a1, a2, a3, a4, a5, a6)
+}
+
+func syscall_syscallPtr(fn, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	return syscall.Syscall(fn, a1, a2, a3)
+}
@@ -0,0 +1,15 @@
+// +build js
+
+package unix
+
+import "syscall"
+
+func SyscallNoError(trap, a1, a2, a3 uintptr) (r1, r2 uintptr) {
+	r1, r2, _ = syscall.Syscall(trap, a1, a2, a3)
+	return
+}
+
+func RawSyscallNoError(trap, a1, a2, a3 uintptr) (r1, r2 uintptr) {
+	r1, r2, _ = syscall.RawSyscall(trap, a1, a2, a3)
+	return
+}
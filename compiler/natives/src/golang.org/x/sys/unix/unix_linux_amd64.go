@@ -0,0 +1,16 @@
+// +build js
+
+package unix
+
+import (
+	"syscall"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+func gettimeofday(tv *Timeval) (err syscall.Errno) {
+	us := js.Global.Get("Date").Call("now").Int64() * 1000
+	tv.Sec = us / 1e6
+	tv.Usec = us % 1e6
+	return 0
+}
@@ -0,0 +1,180 @@
+// +build js
+
+package adler32
+
+import "github.com/gopherjs/gopherjs/js"
+
+// The checksum loop of update is run by a tiny WebAssembly module when
+// WebAssembly is available, which is several times faster than in JavaScript.
+// Its source is:
+//
+//  (module
+//    (memory (export "memory") 1)
+//    ;; update returns the checksum of the len bytes at ptr for the initial
+//    ;; checksum adler, like updateGeneric.
+//    (func (export "update") (param i32 i32 i32) (result i32) (local i32 i32 i32 i32)
+//      local.get 0
+//      i32.const 65535
+//      i32.and
+//      local.set 3
+//      local.get 0
+//      i32.const 16
+//      i32.shr_u
+//      local.set 4
+//      block
+//        loop
+//          local.get 2
+//          i32.eqz
+//          br_if 1
+//          local.get 2
+//          i32.const 5552 ;; nmax
+//          local.get 2
+//          i32.const 5552
+//          i32.lt_u
+//          select
+//          local.tee 5
+//          local.get 1
+//          i32.add
+//          local.set 6
+//          local.get 2
+//          local.get 5
+//          i32.sub
+//          local.set 2
+//          loop
+//            local.get 3
+//            local.get 1
+//            i32.load8_u
+//            i32.add
+//            local.tee 3
+//            local.get 4
+//            i32.add
+//            local.set 4
+//            local.get 1
+//            i32.const 1
+//            i32.add
+//            local.tee 1
+//            local.get 6
+//            i32.lt_u
+//            br_if 0
+//          end
+//          local.get 3
+//          i32.const 65521 ;; mod
+//          i32.rem_u
+//          local.set 3
+//          local.get 4
+//          i32.const 65521
+//          i32.rem_u
+//          local.set 4
+//          br 0
+//        end
+//      end
+//      local.get 4
+//      i32.const 16
+//      i32.shl
+//      local.get 3
+//      i32.or))
+var wasmModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x08, 0x01, 0x60,
+	0x03, 0x7f, 0x7f, 0x7f, 0x01, 0x7f, 0x03, 0x02, 0x01, 0x00, 0x05, 0x03,
+	0x01, 0x00, 0x01, 0x07, 0x13, 0x02, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x00, 0x00, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00,
+	0x0a, 0x75, 0x01, 0x73, 0x01, 0x04, 0x7f, 0x20, 0x00, 0x41, 0xff, 0xff,
+	0x03, 0x71, 0x21, 0x03, 0x20, 0x00, 0x41, 0x10, 0x76, 0x21, 0x04, 0x02,
+	0x40, 0x03, 0x40, 0x20, 0x02, 0x45, 0x0d, 0x01, 0x20, 0x02, 0x41, 0xb0,
+	0x2b, 0x20, 0x02, 0x41, 0xb0, 0x2b, 0x49, 0x1b, 0x22, 0x05, 0x20, 0x01,
+	0x6a, 0x21, 0x06, 0x20, 0x02, 0x20, 0x05, 0x6b, 0x21, 0x02, 0x03, 0x40,
+	0x20, 0x03, 0x20, 0x01, 0x2d, 0x00, 0x00, 0x6a, 0x22, 0x03, 0x20, 0x04,
+	0x6a, 0x21, 0x04, 0x20, 0x01, 0x41, 0x01, 0x6a, 0x22, 0x01, 0x20, 0x06,
+	0x49, 0x0d, 0x00, 0x0b, 0x20, 0x03, 0x41, 0xf1, 0xff, 0x03, 0x70, 0x21,
+	0x03, 0x20, 0x04, 0x41, 0xf1, 0xff, 0x03, 0x70, 0x21, 0x04, 0x0c, 0x00,
+	0x0b, 0x0b, 0x20, 0x04, 0x41, 0x10, 0x74, 0x20, 0x03, 0x72, 0x0b,
+}
+
+// wasmBufferSize is the size of the memory of the module, which the data is
+// copied to.
+const wasmBufferSize = 64 * 1024
+
+// wasmMinSize is the length below which the call into WebAssembly costs more
+// than it saves.
+const wasmMinSize = 64
+
+var (
+	wasmTried  bool
+	wasmUpdate *js.Object // The update function of the module, if available.
+	wasmMemory *js.Object // Uint8Array of the memory of the module.
+)
+
+// wasmInit instantiates the module on first use. It leaves wasmUpdate nil if
+// WebAssembly is not available, e.g. due to a Content Security Policy.
+func wasmInit() {
+	if wasmTried {
+		return
+	}
+	wasmTried = true
+	wa := js.Global.Get("WebAssembly")
+	if wa == js.Undefined {
+		return
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if _, isJSErr := e.(*js.Error); !isJSErr {
+				panic(e)
+			}
+			wasmUpdate, wasmMemory = nil, nil
+		}
+	}()
+	// Synchronous compilation is allowed for small modules, even on the main
+	// thread of browsers.
+	exports := wa.Get("Instance").New(wa.Get("Module").New(wasmModule)).Get("exports")
+	wasmUpdate = exports.Get("update")
+	wasmMemory = js.Global.Get("Uint8Array").New(exports.Get("memory").Get("buffer"))
+}
+
+func update(d digest, p []byte) digest {
+	if len(p) < wasmMinSize {
+		return updateGeneric(d, p)
+	}
+	if wasmInit(); wasmUpdate == nil {
+		return updateGeneric(d, p)
+	}
+	for len(p) > 0 {
+		n := len(p)
+		if n > wasmBufferSize {
+			n = wasmBufferSize
+		}
+		wasmMemory.Call("set", p[:n])
+		d = digest(wasmUpdate.Invoke(uint32(d), 0, n).Int())
+		p = p[n:]
+	}
+	return d
+}
+
+// updateGeneric is the original update.
+func updateGeneric(d digest, p []byte) digest {
+	s1, s2 := uint32(d&0xffff), uint32(d>>16)
+	for len(p) > 0 {
+		var q []byte
+		if len(p) > nmax {
+			p, q = p[:nmax], p[nmax:]
+		}
+		for len(p) >= 4 {
+			s1 += uint32(p[0])
+			s2 += s1
+			s1 += uint32(p[1])
+			s2 += s1
+			s1 += uint32(p[2])
+			s2 += s1
+			s1 += uint32(p[3])
+			s2 += s1
+			p = p[4:]
+		}
+		for _, x := range p {
+			s1 += uint32(x)
+			s2 += s1
+		}
+		s1 %= mod
+		s2 %= mod
+		p = q
+	}
+	return digest(s2<<16 | s1)
+}
@@ -0,0 +1,143 @@
+// +build js
+
+package crc32
+
+import "github.com/gopherjs/gopherjs/js"
+
+// The table-driven loop of simpleUpdate is run by a tiny WebAssembly module
+// when WebAssembly is available, which is several times faster than the
+// slicing-by-8 algorithm in JavaScript. Its source is:
+//
+//  (module
+//    (memory (export "memory") 2)
+//    ;; update returns the CRC of the len bytes at ptr, using the table of 256
+//    ;; entries at table, for an initial CRC that is already inverted.
+//    (func (export "update") (param i32 i32 i32 i32) (result i32) (local i32)
+//      local.get 2
+//      local.get 3
+//      i32.add
+//      local.set 4
+//      block
+//        loop
+//          local.get 2
+//          local.get 4
+//          i32.ge_u
+//          br_if 1
+//          local.get 1
+//          local.get 0
+//          local.get 2
+//          i32.load8_u
+//          i32.xor
+//          i32.const 255
+//          i32.and
+//          i32.const 2
+//          i32.shl
+//          i32.add
+//          i32.load
+//          local.get 0
+//          i32.const 8
+//          i32.shr_u
+//          i32.xor
+//          local.set 0
+//          local.get 2
+//          i32.const 1
+//          i32.add
+//          local.set 2
+//          br 0
+//        end
+//      end
+//      local.get 0))
+var wasmModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x09, 0x01, 0x60,
+	0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x01, 0x7f, 0x03, 0x02, 0x01, 0x00, 0x05,
+	0x03, 0x01, 0x00, 0x02, 0x07, 0x13, 0x02, 0x06, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x00, 0x00, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02,
+	0x00, 0x0a, 0x42, 0x01, 0x40, 0x01, 0x01, 0x7f, 0x20, 0x02, 0x20, 0x03,
+	0x6a, 0x21, 0x04, 0x02, 0x40, 0x03, 0x40, 0x20, 0x02, 0x20, 0x04, 0x4f,
+	0x0d, 0x01, 0x20, 0x01, 0x20, 0x00, 0x20, 0x02, 0x2d, 0x00, 0x00, 0x73,
+	0x41, 0xff, 0x01, 0x71, 0x41, 0x02, 0x74, 0x6a, 0x28, 0x02, 0x00, 0x20,
+	0x00, 0x41, 0x08, 0x76, 0x73, 0x21, 0x00, 0x20, 0x02, 0x41, 0x01, 0x6a,
+	0x21, 0x02, 0x0c, 0x00, 0x0b, 0x0b, 0x20, 0x00, 0x0b,
+}
+
+// Layout of the memory of the module: the IEEE and Castagnoli tables, followed
+// by the buffer the data is copied to.
+const (
+	wasmIEEETable       = 0
+	wasmCastagnoliTable = 1024
+	wasmBuffer          = 2048
+	wasmBufferSize      = 2*64*1024 - wasmBuffer
+)
+
+// wasmMinSize is the length below which the call into WebAssembly costs more
+// than it saves.
+const wasmMinSize = 64
+
+var (
+	wasmUpdate *js.Object // The update function of the module.
+	wasmMemory *js.Object // Uint8Array of the memory of the module.
+)
+
+// wasmInit instantiates the module. It reports false if WebAssembly is not
+// available, e.g. due to a Content Security Policy.
+func wasmInit() (ok bool) {
+	if wasmUpdate != nil {
+		return true
+	}
+	wa := js.Global.Get("WebAssembly")
+	if wa == js.Undefined {
+		return false
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if _, isJSErr := e.(*js.Error); !isJSErr {
+				panic(e)
+			}
+			ok = false
+		}
+	}()
+	// Synchronous compilation is allowed for small modules, even on the main
+	// thread of browsers.
+	exports := wa.Get("Instance").New(wa.Get("Module").New(wasmModule)).Get("exports")
+	wasmUpdate = exports.Get("update")
+	wasmMemory = js.Global.Get("Uint8Array").New(exports.Get("memory").Get("buffer"))
+	return true
+}
+
+func wasmSetTable(offset int, tab *Table) {
+	words := js.Global.Get("Uint32Array").New(wasmMemory.Get("buffer"), offset, len(tab))
+	words.Call("set", tab[:])
+}
+
+func wasmUpdateTable(crc uint32, offset int, tab *Table, p []byte) uint32 {
+	if len(p) < wasmMinSize {
+		return simpleUpdate(crc, tab, p)
+	}
+	crc = ^crc
+	for len(p) > 0 {
+		n := len(p)
+		if n > wasmBufferSize {
+			n = wasmBufferSize
+		}
+		wasmMemory.Call("set", p[:n], wasmBuffer)
+		crc = uint32(wasmUpdate.Invoke(crc, offset, wasmBuffer, n).Int())
+		p = p[n:]
+	}
+	return ^crc
+}
+
+func archAvailableIEEE() bool { return wasmInit() }
+
+func archInitIEEE() { wasmSetTable(wasmIEEETable, IEEETable) }
+
+func archUpdateIEEE(crc uint32, p []byte) uint32 {
+	return wasmUpdateTable(crc, wasmIEEETable, IEEETable, p)
+}
+
+func archAvailableCastagnoli() bool { return wasmInit() }
+
+func archInitCastagnoli() { wasmSetTable(wasmCastagnoliTable, castagnoliTable) }
+
+func archUpdateCastagnoli(crc uint32, p []byte) uint32 {
+	return wasmUpdateTable(crc, wasmCastagnoliTable, castagnoliTable, p)
+}
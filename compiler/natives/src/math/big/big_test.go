@@ -2,7 +2,10 @@
 
 package big
 
-import "testing"
+import (
+	"math/rand"
+	"testing"
+)
 
 func TestBytes(t *testing.T) {
 	t.Skip("broken")
@@ -11,3 +14,35 @@ func TestBytes(t *testing.T) {
 func TestModSqrt(t *testing.T) {
 	t.Skip("slow")
 }
+
+func TestExpNNBigInt(t *testing.T) {
+	if bigIntOps == nil {
+		t.Skip("BigInt isn't supported")
+	}
+	r := rand.New(rand.NewSource(1))
+	random := func(words int) nat {
+		z := nat(nil).make(words)
+		for i := range z {
+			z[i] = Word(r.Uint32())
+		}
+		return z.norm()
+	}
+	saved := bigIntOps
+	defer func() { bigIntOps = saved }()
+	for i := 0; i < 20; i++ {
+		x, y, m := random(1+i%5), random(1+i%3), random(1+i%4)
+		if len(m) == 0 {
+			continue
+		}
+		if i%2 == 0 {
+			m[0] &^= 1 // Even moduli don't use the Montgomery method upstream.
+		}
+		bigIntOps = saved
+		got := nat(nil).expNN(x, y, m)
+		bigIntOps = nil
+		want := nat(nil).expNN(x, y, m)
+		if got.cmp(want) != 0 {
+			t.Errorf("expNN(%s, %s, %s) with BigInt = %s, want %s", x.utoa(16), y.utoa(16), m.utoa(16), got.utoa(16), want.utoa(16))
+		}
+	}
+}
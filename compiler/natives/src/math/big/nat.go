@@ -0,0 +1,172 @@
+// +build js
+
+package big
+
+import "github.com/gopherjs/gopherjs/js"
+
+// bigIntOps implements modular exponentiation of nat values, which dominates
+// the cost of RSA, Diffie-Hellman and primality tests, using JavaScript's
+// native BigInt type, which is orders of magnitude faster than the pure Go
+// implementation compiled to JavaScript. Multiplication, division and string
+// conversions still use the upstream implementation. It is nil if the
+// JavaScript runtime doesn't support BigInt, in which case the upstream
+// implementation is used for exponentiation too.
+//
+// Operands are passed as internal GopherJS slice objects to avoid
+// externalization overhead, results are returned as Uint32Array of
+// little-endian words.
+var bigIntOps = js.Global.Call("eval", `(function() {
+  if (typeof BigInt !== "function") {
+    return null;
+  }
+  var toBigInt = function(x) {
+    var hex = "0x0";
+    for (var i = x.$length - 1; i >= 0; i--) {
+      var w = x.$array[x.$offset + i].toString(16);
+      hex += "00000000".substring(w.length) + w;
+    }
+    return BigInt(hex);
+  };
+  var fromBigInt = function(v) {
+    var hex = v.toString(16);
+    var words = new Uint32Array(Math.ceil(hex.length / 8));
+    for (var i = 0, end = hex.length; end > 0; i++, end -= 8) {
+      words[i] = parseInt(hex.substring(Math.max(0, end - 8), end), 16);
+    }
+    return words;
+  };
+  return {
+    expMod: function(x, y, m) {
+      var mod = toBigInt(m), base = toBigInt(x) % mod, r = BigInt(1);
+      for (var i = y.$length - 1; i >= 0; i--) {
+        var w = y.$array[y.$offset + i];
+        for (var bit = 31; bit >= 0; bit--) {
+          r = (r * r) % mod;
+          if ((w >>> bit) & 1) {
+            r = (r * base) % mod;
+          }
+        }
+      }
+      return fromBigInt(r);
+    }
+  };
+})()`)
+
+// expNNBigInt sets z to x**y mod m using native BigInt arithmetic.
+// Preconditions: bigIntOps != nil, len(m) > 0.
+func (z nat) expNNBigInt(x, y, m nat) nat {
+	words := bigIntOps.Call("expMod", js.InternalObject(x), js.InternalObject(y), js.InternalObject(m)).Interface().([]uint)
+	z = z.make(len(words))
+	for i, w := range words {
+		z[i] = Word(w)
+	}
+	return z.norm()
+}
+
+// If m != 0 (i.e., len(m) != 0), expNN sets z to x**y mod m;
+// otherwise it sets z to x**y. The result is the value of z.
+//
+// The only difference from the upstream is that modular exponentiation with a
+// non-trivial exponent is delegated to BigInt when available.
+func (z nat) expNN(x, y, m nat) nat {
+	if alias(z, x) || alias(z, y) {
+		// We cannot allow in-place modification of x or y.
+		z = nil
+	}
+
+	// x**y mod 1 == 0
+	if len(m) == 1 && m[0] == 1 {
+		return z.setWord(0)
+	}
+	// m == 0 || m > 1
+
+	// x**0 == 1
+	if len(y) == 0 {
+		return z.setWord(1)
+	}
+	// y > 0
+
+	// x**1 mod m == x mod m
+	if len(y) == 1 && y[0] == 1 && len(m) != 0 {
+		_, z = nat(nil).div(z, x, m)
+		return z
+	}
+	// y > 1
+
+	if len(m) != 0 && bigIntOps != nil {
+		return z.expNNBigInt(x, y, m)
+	}
+
+	if len(m) != 0 {
+		// We likely end up being as long as the modulus.
+		z = z.make(len(m))
+	}
+	z = z.set(x)
+
+	// If the base is non-trivial and the exponent is large, we use
+	// 4-bit, windowed exponentiation. This involves precomputing 14 values
+	// (x^2...x^15) but then reduces the number of multiply-reduces by a
+	// third. Even for a 32-bit exponent, this reduces the number of
+	// operations. Uses Montgomery method for odd moduli.
+	if x.cmp(natOne) > 0 && len(y) > 1 && len(m) > 0 {
+		if m[0]&1 == 1 {
+			return z.expNNMontgomery(x, y, m)
+		}
+		return z.expNNWindowed(x, y, m)
+	}
+
+	v := y[len(y)-1] // v > 0 because y is normalized and y > 0
+	shift := nlz(v) + 1
+	v <<= shift
+	var q nat
+
+	const mask = 1 << (_W - 1)
+
+	// We walk through the bits of the exponent one by one. Each time we
+	// see a bit, we square, thus doubling the power. If the bit is a one,
+	// we also multiply by x, thus adding one to the power.
+
+	w := _W - int(shift)
+	// zz and r are used to avoid allocating in mul and div as
+	// otherwise the arguments would alias.
+	var zz, r nat
+	for j := 0; j < w; j++ {
+		zz = zz.sqr(z)
+		zz, z = z, zz
+
+		if v&mask != 0 {
+			zz = zz.mul(z, x)
+			zz, z = z, zz
+		}
+
+		if len(m) != 0 {
+			zz, r = zz.div(r, z, m)
+			zz, r, q, z = q, z, zz, r
+		}
+
+		v <<= 1
+	}
+
+	for i := len(y) - 2; i >= 0; i-- {
+		v = y[i]
+
+		for j := 0; j < _W; j++ {
+			zz = zz.sqr(z)
+			zz, z = z, zz
+
+			if v&mask != 0 {
+				zz = zz.mul(z, x)
+				zz, z = z, zz
+			}
+
+			if len(m) != 0 {
+				zz, r = zz.div(r, z, m)
+				zz, r, q, z = q, z, zz, r
+			}
+
+			v <<= 1
+		}
+	}
+
+	return z.norm()
+}
@@ -0,0 +1,110 @@
+// +build js,!gopherjs_precise_math
+
+package math
+
+// Functions whose results JavaScript engines only approximate are mapped
+// directly to Math methods, which may differ from the pure Go implementations
+// by a few ULPs. The --precise-math flag replaces them with the latter, see
+// precise.go.
+
+func Acos(x float64) float64 {
+	return math.Call("acos", x).Float()
+}
+
+func Acosh(x float64) float64 {
+	return math.Call("acosh", x).Float()
+}
+
+func Asin(x float64) float64 {
+	return math.Call("asin", x).Float()
+}
+
+func Asinh(x float64) float64 {
+	return math.Call("asinh", x).Float()
+}
+
+func Atan(x float64) float64 {
+	return math.Call("atan", x).Float()
+}
+
+func Atanh(x float64) float64 {
+	return math.Call("atanh", x).Float()
+}
+
+func Atan2(y, x float64) float64 {
+	return math.Call("atan2", y, x).Float()
+}
+
+func Cbrt(x float64) float64 {
+	return math.Call("cbrt", x).Float()
+}
+
+func Cos(x float64) float64 {
+	return math.Call("cos", x).Float()
+}
+
+func Cosh(x float64) float64 {
+	return math.Call("cosh", x).Float()
+}
+
+func Exp(x float64) float64 {
+	return math.Call("exp", x).Float()
+}
+
+func Exp2(x float64) float64 {
+	return math.Call("pow", 2, x).Float()
+}
+
+func Expm1(x float64) float64 {
+	return math.Call("expm1", x).Float()
+}
+
+func Hypot(p, q float64) float64 {
+	return math.Call("hypot", p, q).Float()
+}
+
+func Log(x float64) float64 {
+	if x != x { // workaround for optimizer bug in V8, remove at some point
+		return nan
+	}
+	return math.Call("log", x).Float()
+}
+
+func Log10(x float64) float64 {
+	return math.Call("log10", x).Float()
+}
+
+func Log1p(x float64) float64 {
+	return math.Call("log1p", x).Float()
+}
+
+func Log2(x float64) float64 {
+	return math.Call("log2", x).Float()
+}
+
+func Pow(x, y float64) float64 {
+	if x == 1 || (x == -1 && (y == posInf || y == negInf)) {
+		return 1
+	}
+	return math.Call("pow", x, y).Float()
+}
+
+func Sin(x float64) float64 {
+	return math.Call("sin", x).Float()
+}
+
+func Sinh(x float64) float64 {
+	return math.Call("sinh", x).Float()
+}
+
+func Sincos(x float64) (sin, cos float64) {
+	return Sin(x), Cos(x)
+}
+
+func Tan(x float64) float64 {
+	return math.Call("tan", x).Float()
+}
+
+func Tanh(x float64) float64 {
+	return math.Call("tanh", x).Float()
+}
@@ -3,6 +3,8 @@
 package math
 
 import (
+	"math/bits"
+
 	"github.com/gopherjs/gopherjs/js"
 )
 
@@ -12,36 +14,8 @@ var posInf = 1 / _zero
 var negInf = -1 / _zero
 var nan = 0 / _zero
 
-func Acos(x float64) float64 {
-	return math.Call("acos", x).Float()
-}
-
-func Acosh(x float64) float64 {
-	return math.Call("acosh", x).Float()
-}
-
-func Asin(x float64) float64 {
-	return math.Call("asin", x).Float()
-}
-
-func Asinh(x float64) float64 {
-	return math.Call("asinh", x).Float()
-}
-
-func Atan(x float64) float64 {
-	return math.Call("atan", x).Float()
-}
-
-func Atanh(x float64) float64 {
-	return math.Call("atanh", x).Float()
-}
-
-func Atan2(y, x float64) float64 {
-	return math.Call("atan2", y, x).Float()
-}
-
-func Cbrt(x float64) float64 {
-	return math.Call("cbrt", x).Float()
+func Abs(x float64) float64 {
+	return math.Call("abs", x).Float()
 }
 
 func Ceil(x float64) float64 {
@@ -55,14 +29,6 @@ func Copysign(x, y float64) float64 {
 	return x
 }
 
-func Cos(x float64) float64 {
-	return math.Call("cos", x).Float()
-}
-
-func Cosh(x float64) float64 {
-	return math.Call("cosh", x).Float()
-}
-
 func Erf(x float64) float64 {
 	return erf(x)
 }
@@ -71,28 +37,33 @@ func Erfc(x float64) float64 {
 	return erfc(x)
 }
 
-func Exp(x float64) float64 {
-	return math.Call("exp", x).Float()
-}
-
-func Exp2(x float64) float64 {
-	return math.Call("pow", 2, x).Float()
-}
-
-func Expm1(x float64) float64 {
-	return expm1(x)
-}
-
 func Floor(x float64) float64 {
 	return math.Call("floor", x).Float()
 }
 
-func Frexp(f float64) (frac float64, exp int) {
-	return frexp(f)
+// FMA uses a plain multiply-add where x*y is exact, which is checked by
+// computing the rounding error of the product with Dekker's algorithm, and
+// the bit-level implementation otherwise.
+func FMA(x, y, z float64) float64 {
+	const (
+		split = 1<<27 + 1
+		large = 1 << 960
+		small = 1.0 / (1 << 960)
+	)
+	p := x * y
+	if ax, ay, ap := Abs(x), Abs(y), Abs(p); ax < large && ay < large && small < ap && ap < large && z == z {
+		xh := x*split - (x*split - x)
+		yh := y*split - (y*split - y)
+		xl, yl := x-xh, y-yh
+		if ((xh*yh-p)+xh*yl+xl*yh)+xl*yl == 0 {
+			return p + z
+		}
+	}
+	return fmaGeneric(x, y, z)
 }
 
-func Hypot(p, q float64) float64 {
-	return hypot(p, q)
+func Frexp(f float64) (frac float64, exp int) {
+	return frexp(f)
 }
 
 func Inf(sign int) float64 {
@@ -128,25 +99,6 @@ func Ldexp(frac float64, exp int) float64 {
 	return ldexp(frac, exp)
 }
 
-func Log(x float64) float64 {
-	if x != x { // workaround for optimizer bug in V8, remove at some point
-		return nan
-	}
-	return math.Call("log", x).Float()
-}
-
-func Log10(x float64) float64 {
-	return log10(x)
-}
-
-func Log1p(x float64) float64 {
-	return log1p(x)
-}
-
-func Log2(x float64) float64 {
-	return log2(x)
-}
-
 func Max(x, y float64) float64 {
 	return max(x, y)
 }
@@ -174,45 +126,34 @@ func NaN() float64 {
 	return nan
 }
 
-func Pow(x, y float64) float64 {
-	if x == 1 || (x == -1 && (y == posInf || y == negInf)) {
-		return 1
-	}
-	return math.Call("pow", x, y).Float()
-}
-
 func Remainder(x, y float64) float64 {
 	return remainder(x, y)
 }
 
-func Signbit(x float64) bool {
-	return x < 0 || 1/x == negInf
-}
-
-func Sin(x float64) float64 {
-	return math.Call("sin", x).Float()
+func Round(x float64) float64 {
+	// Math.round rounds halves up rather than away from zero.
+	if x < 0 {
+		return -math.Call("round", -x).Float()
+	}
+	return math.Call("round", x).Float()
 }
 
-func Sinh(x float64) float64 {
-	return math.Call("sinh", x).Float()
+func RoundToEven(x float64) float64 {
+	r := math.Call("round", x).Float()
+	if r-x == 0.5 && Mod(r, 2) != 0 {
+		r--
+	}
+	return r
 }
 
-func Sincos(x float64) (sin, cos float64) {
-	return Sin(x), Cos(x)
+func Signbit(x float64) bool {
+	return x < 0 || 1/x == negInf
 }
 
 func Sqrt(x float64) float64 {
 	return math.Call("sqrt", x).Float()
 }
 
-func Tan(x float64) float64 {
-	return math.Call("tan", x).Float()
-}
-
-func Tanh(x float64) float64 {
-	return math.Call("tanh", x).Float()
-}
-
 func Trunc(x float64) float64 {
 	if x == posInf || x == negInf || x != x || 1/x == negInf {
 		return x
@@ -253,3 +194,81 @@ func Float64frombits(b uint64) float64 {
 	buf.uint32array[1] = uint32(b >> 32)
 	return buf.float64array[0]
 }
+
+// fmaGeneric is the original implementation of FMA.
+func fmaGeneric(x, y, z float64) float64 {
+	bx, by, bz := Float64bits(x), Float64bits(y), Float64bits(z)
+
+	// Inf or NaN or zero involved. At most one rounding will occur.
+	if x == 0.0 || y == 0.0 || z == 0.0 || bx&uvinf == uvinf || by&uvinf == uvinf {
+		return x*y + z
+	}
+	// Handle non-finite z separately. Evaluating x*y+z where
+	// x and y are finite, but z is infinite, should always result in z.
+	if bz&uvinf == uvinf {
+		return z
+	}
+
+	// Inputs are (sub)normal.
+	// Split x, y, z into sign, exponent, mantissa.
+	xs, xe, xm := split(bx)
+	ys, ye, ym := split(by)
+	zs, ze, zm := split(bz)
+
+	// Compute product p = x*y as sign, exponent, two-word mantissa.
+	// Start with exponent. "is normal" bit isn't subtracted yet.
+	pe := xe + ye - bias + 1
+
+	// pm1:pm2 is the double-word mantissa for the product p.
+	// Shift left to leave top bit in product. Effectively
+	// shifts the 106-bit product to the left by 21.
+	pm1, pm2 := bits.Mul64(xm<<10, ym<<11)
+	zm1, zm2 := zm<<10, uint64(0)
+	ps := xs ^ ys // product sign
+
+	// normalize to 62nd bit
+	is62zero := uint((^pm1 >> 62) & 1)
+	pm1, pm2 = shl(pm1, pm2, is62zero)
+	pe -= int32(is62zero)
+
+	// Swap addition operands so |p| >= |z|
+	if pe < ze || (pe == ze && (pm1 < zm1 || (pm1 == zm1 && pm2 < zm2))) {
+		ps, pe, pm1, pm2, zs, ze, zm1, zm2 = zs, ze, zm1, zm2, ps, pe, pm1, pm2
+	}
+
+	// Align significands
+	zm1, zm2 = shrcompress(zm1, zm2, uint(pe-ze))
+
+	// Compute resulting significands, normalizing if necessary.
+	var m, c uint64
+	if ps == zs {
+		// Adding (pm1:pm2) + (zm1:zm2)
+		pm2, c = bits.Add64(pm2, zm2, 0)
+		pm1, _ = bits.Add64(pm1, zm1, c)
+		pe -= int32(^pm1 >> 63)
+		pm1, m = shrcompress(pm1, pm2, uint(64+pm1>>63))
+	} else {
+		// Subtracting (pm1:pm2) - (zm1:zm2)
+		// TODO: should we special-case cancellation?
+		pm2, c = bits.Sub64(pm2, zm2, 0)
+		pm1, _ = bits.Sub64(pm1, zm1, c)
+		nz := lz(pm1, pm2)
+		pe -= nz
+		m, pm2 = shl(pm1, pm2, uint(nz-1))
+		m |= nonzero(pm2)
+	}
+
+	// Round and break ties to even
+	if pe > 1022+bias || pe == 1022+bias && (m+1<<9)>>63 == 1 {
+		// rounded value overflows exponent range
+		return Float64frombits(uint64(ps)<<63 | uvinf)
+	}
+	if pe < 0 {
+		n := uint(-pe)
+		m = m>>n | nonzero(m&(1<<n-1))
+		pe = 0
+	}
+	m = ((m + 1<<9) >> 10) & ^zero((m&(1<<10-1))^1<<9)
+	pe &= -int32(nonzero(m))
+	return Float64frombits(uint64(ps)<<63 + uint64(pe)<<52 + m)
+}
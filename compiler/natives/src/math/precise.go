@@ -0,0 +1,98 @@
+// +build js,gopherjs_precise_math
+
+package math
+
+// With --precise-math, functions that jsmath.go maps to Math methods use the
+// pure Go implementations instead, so that results match the gc compiler.
+
+func Acos(x float64) float64 {
+	return acos(x)
+}
+
+func Acosh(x float64) float64 {
+	return acosh(x)
+}
+
+func Asin(x float64) float64 {
+	return asin(x)
+}
+
+func Asinh(x float64) float64 {
+	return asinh(x)
+}
+
+func Atan(x float64) float64 {
+	return atan(x)
+}
+
+func Atanh(x float64) float64 {
+	return atanh(x)
+}
+
+func Atan2(y, x float64) float64 {
+	return atan2(y, x)
+}
+
+func Cbrt(x float64) float64 {
+	return cbrt(x)
+}
+
+func Cos(x float64) float64 {
+	return cos(x)
+}
+
+func Cosh(x float64) float64 {
+	return cosh(x)
+}
+
+func Exp(x float64) float64 {
+	return exp(x)
+}
+
+func Exp2(x float64) float64 {
+	return exp2(x)
+}
+
+func Expm1(x float64) float64 {
+	return expm1(x)
+}
+
+func Hypot(p, q float64) float64 {
+	return hypot(p, q)
+}
+
+func Log(x float64) float64 {
+	return log(x)
+}
+
+func Log10(x float64) float64 {
+	return log10(x)
+}
+
+func Log1p(x float64) float64 {
+	return log1p(x)
+}
+
+func Log2(x float64) float64 {
+	return log2(x)
+}
+
+func Pow(x, y float64) float64 {
+	return pow(x, y)
+}
+
+func Sin(x float64) float64 {
+	return sin(x)
+}
+
+func Sinh(x float64) float64 {
+	return sinh(x)
+}
+
+func Tan(x float64) float64 {
+	return tan(x)
+}
+
+func Tanh(x float64) float64 {
+	return tanh(x)
+}
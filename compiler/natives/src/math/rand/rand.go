@@ -0,0 +1,46 @@
+// +build js
+
+package rand
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// globalRand is seeded from platform entropy at startup, like since Go 1.20,
+// rather than with 1, unless GODEBUG has randautoseed=0. Programs that need a
+// deterministic sequence call Seed.
+var globalRand = New(&lockedSource{src: NewSource(initialSeed()).(*rngSource)})
+
+// initialSeed returns the seed of globalRand, from crypto.getRandomValues in
+// browsers, or crypto.randomFillSync in Node.js, falling back to Math.random.
+func initialSeed() int64 {
+	if !randAutoseed() {
+		return 1
+	}
+	buf := js.Global.Get("Uint32Array").New(2)
+	if crypto := js.Global.Get("crypto"); crypto != js.Undefined && crypto.Get("getRandomValues") != js.Undefined {
+		crypto.Call("getRandomValues", buf)
+	} else if require := js.Global.Get("require"); require != js.Undefined {
+		require.Invoke("crypto").Call("randomFillSync", buf)
+	} else {
+		for i := 0; i < 2; i++ {
+			buf.SetIndex(i, js.Global.Get("Math").Call("random").Float()*(1<<32))
+		}
+	}
+	return buf.Index(0).Int64()<<32 | buf.Index(1).Int64()
+}
+
+// randAutoseed reports whether GODEBUG doesn't have randautoseed=0. The
+// environment is read from process.env, since math/rand doesn't import
+// syscall or strings.
+func randAutoseed() bool {
+	process := js.Global.Get("process")
+	if process == js.Undefined || process.Get("env") == js.Undefined {
+		return true
+	}
+	godebug := process.Get("env").Get("GODEBUG")
+	if godebug == js.Undefined {
+		return true
+	}
+	return js.InternalObject(","+godebug.String()+",").Call("indexOf", ",randautoseed=0,").Int() < 0
+}
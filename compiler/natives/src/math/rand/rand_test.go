@@ -2,7 +2,11 @@
 
 package rand
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
 
 func TestFloat32(t *testing.T) {
 	t.Skip("slow")
@@ -11,3 +15,40 @@ func TestFloat32(t *testing.T) {
 func TestConcurrent(t *testing.T) {
 	t.Skip("using nosync")
 }
+
+func TestInitialSeed(t *testing.T) {
+	if a, b := initialSeed(), initialSeed(); a == b {
+		t.Errorf("Got the same initial seed %d twice", a)
+	}
+}
+
+func TestRandAutoseed(t *testing.T) {
+	process := js.Global.Get("process")
+	if process == js.Undefined {
+		t.Skip("no process.env")
+	}
+	env := process.Get("env")
+	saved := env.Get("GODEBUG")
+	defer func() {
+		if saved == js.Undefined {
+			env.Delete("GODEBUG")
+		} else {
+			env.Set("GODEBUG", saved)
+		}
+	}()
+	for _, test := range []struct {
+		godebug string
+		want    bool
+	}{
+		{"", true},
+		{"randautoseed=1", true},
+		{"randautoseed=0", false},
+		{"x509sha1=1,randautoseed=0", false},
+		{"randautoseed=00", true},
+	} {
+		env.Set("GODEBUG", test.godebug)
+		if got := randAutoseed(); got != test.want {
+			t.Errorf("randAutoseed() with GODEBUG=%q = %t, want %t", test.godebug, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// +build js
+
+package http
+
+import (
+	"io/ioutil"
+	"net/url"
+	"sync"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var exposeDebugOnce sync.Once
+
+// exposeDebugHandlers makes DefaultServeMux reachable from JavaScript, since
+// GopherJS programs can't listen for connections. It is called by expvar and
+// net/http/pprof through go:linkname, so that the handlers they register on
+// DefaultServeMux can be used by importing them, like in Go.
+//
+// The global gopherjsDebug(path) function serves a GET request for path, like
+// "/debug/vars" or "/debug/pprof/goroutine?debug=1", and returns a promise of
+// an object with the status, contentType and body of the response. In the
+// browser, the same request can be made by posting a {gopherjsDebug: path}
+// message to the window or worker from the same origin, which is answered with
+// a {gopherjsDebug: path, status, contentType, body} message, so that the data
+// can be collected from the console of another frame or by a parent page. If
+// several programs import expvar or net/http/pprof, only the first one loaded
+// serves these requests.
+func exposeDebugHandlers() {
+	exposeDebugOnce.Do(func() {
+		if js.Global.Get("gopherjsDebug") != js.Undefined {
+			// Another GopherJS program on the page already serves requests.
+			return
+		}
+		js.Global.Set("gopherjsDebug", js.InternalObject(func(path string) *js.Object {
+			return js.Global.Get("Promise").New(js.InternalObject(func(resolve, reject *js.Object) {
+				go func() {
+					resp, err := serveDebug(path)
+					if err != nil {
+						reject.Invoke(js.Global.Get("Error").New(err.Error()))
+						return
+					}
+					resolve.Invoke(resp)
+				}()
+			}))
+		}))
+
+		if js.Global.Get("addEventListener") == js.Undefined || js.Global.Get("postMessage") == js.Undefined {
+			return
+		}
+		js.Global.Call("addEventListener", "message", js.InternalObject(func(event *js.Object) {
+			data := event.Get("data")
+			if data == nil || data == js.Undefined || data.Get("gopherjsDebug") == js.Undefined || data.Get("status") != js.Undefined {
+				return
+			}
+			if !sameOrigin(event) {
+				return
+			}
+			path := data.Get("gopherjsDebug").String()
+			go func() {
+				resp, err := serveDebug(path)
+				if err != nil {
+					resp = map[string]interface{}{"status": 0, "contentType": "text/plain", "body": err.Error()}
+				}
+				resp["gopherjsDebug"] = path
+				if source := event.Get("source"); source != nil && source != js.Undefined {
+					source.Call("postMessage", resp, event.Get("origin").String())
+				} else {
+					// Messages from the parent of a worker have no source.
+					js.Global.Call("postMessage", resp)
+				}
+			}()
+		}), false)
+	})
+}
+
+// sameOrigin reports whether a message event comes from the origin of the
+// page, or from the parent of a worker, whose messages have no origin.
+func sameOrigin(event *js.Object) bool {
+	origin := event.Get("origin")
+	if origin == js.Undefined || origin.String() == "" {
+		return true
+	}
+	location := js.Global.Get("location")
+	return location != js.Undefined && origin.String() == location.Get("origin").String()
+}
+
+func serveDebug(path string) (map[string]interface{}, error) {
+	u, err := url.ParseRequestURI(path)
+	if err != nil {
+		return nil, err
+	}
+	u.Scheme, u.Host = "http", "localhost"
+	req, err := NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := serveInProcess(DefaultServeMux, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"status":      resp.StatusCode,
+		"contentType": resp.Header.Get("Content-Type"),
+		"body":        string(body),
+	}, nil
+}
@@ -1,8 +1,9 @@
-// +build js
+// +build js,!gopherjs_sandbox_net_none
 
 package http
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -12,17 +13,32 @@ import (
 	"github.com/gopherjs/gopherjs/js"
 )
 
+// newFetchTransport returns a Fetch API transport, or nil if the API is not
+// available.
+func newFetchTransport() RoundTripper {
+	// ReadableStream is used as a check for support of streaming response
+	// bodies, see https://fetch.spec.whatwg.org/#streams.
+	if js.Global.Get("fetch") == js.Undefined || js.Global.Get("ReadableStream") == js.Undefined {
+		return nil
+	}
+	return &fetchTransport{}
+}
+
 // streamReader implements an io.ReadCloser wrapper for ReadableStream of https://fetch.spec.whatwg.org/.
 type streamReader struct {
 	pending []byte
 	stream  *js.Object
+	ctx     context.Context
+	abort   *js.Object // AbortController of the fetch request, or nil.
 }
 
 func (r *streamReader) Read(p []byte) (n int, err error) {
 	if len(r.pending) == 0 {
+		// Channels are buffered, because callbacks may run after Read has given up
+		// waiting and must not block.
 		var (
-			bCh   = make(chan []byte)
-			errCh = make(chan error)
+			bCh   = make(chan []byte, 1)
+			errCh = make(chan error, 1)
 		)
 		r.stream.Call("read").Call("then",
 			func(result *js.Object) {
@@ -42,6 +58,9 @@ func (r *streamReader) Read(p []byte) (n int, err error) {
 			r.pending = b
 		case err := <-errCh:
 			return 0, err
+		case <-r.ctx.Done():
+			abortFetch(r.abort)
+			return 0, r.ctx.Err()
 		}
 	}
 	n = copy(p, r.pending)
@@ -57,11 +76,84 @@ func (r *streamReader) Close() error {
 	return nil
 }
 
+// abortFetch aborts the fetch request controlled by the AbortController, if any.
+func abortFetch(controller *js.Object) {
+	if controller != nil {
+		controller.Call("abort")
+	}
+}
+
+// supportsRequestStreams reports whether fetch() accepts ReadableStream request bodies. Browsers
+// that don't support them ignore the duplex option and convert the stream into a string.
+var supportsRequestStreams = js.Global.Call("eval", `(function() {
+  if (typeof Request === "undefined" || typeof ReadableStream === "undefined") {
+    return false;
+  }
+  try {
+    var duplexAccessed = false;
+    var hasContentType = new Request("http://localhost/", {
+      body: new ReadableStream(),
+      method: "POST",
+      get duplex() {
+        duplexAccessed = true;
+        return "half";
+      }
+    }).headers.has("Content-Type");
+    return duplexAccessed && !hasContentType;
+  } catch (e) {
+    return false;
+  }
+})()`).Bool()
+
+// bodyStream returns a ReadableStream that reads from body and closes it once done.
+func bodyStream(body io.ReadCloser) *js.Object {
+	return js.Global.Get("ReadableStream").New(map[string]interface{}{
+		"pull": func(controller *js.Object) *js.Object {
+			return js.Global.Get("Promise").New(func(resolve, reject *js.Object) {
+				go func() {
+					buf := make([]byte, 32*1024)
+					n, err := body.Read(buf)
+					if n > 0 {
+						// Copy the data, so that the stream owns its chunks.
+						controller.Call("enqueue", js.Global.Get("Uint8Array").New(buf[:n]))
+					}
+					switch {
+					case err == io.EOF:
+						body.Close()
+						controller.Call("close")
+					case err != nil:
+						body.Close()
+						controller.Call("error", js.Global.Get("Error").New(err.Error()))
+					}
+					resolve.Invoke()
+				}()
+			})
+		},
+		"cancel": func() {
+			body.Close()
+		},
+	})
+}
+
+// knownRoundTripperImpl reports whether rt cancels requests when their context
+// is done, like the Fetch API transport does. Transport, which can't dial
+// connections under GopherJS, isn't checked, so that programs that don't use it
+// leave it and the client half of crypto/tls out.
+func knownRoundTripperImpl(rt RoundTripper, req *Request) bool {
+	_, ok := rt.(*fetchTransport)
+	return ok
+}
+
 // fetchTransport is a RoundTripper that is implemented using Fetch API. It supports streaming
-// response bodies.
+// response bodies, and request bodies of unknown length where the environment supports it.
+//
+// Note that browsers may only allow streaming request bodies over HTTP/2 or newer.
 type fetchTransport struct{}
 
 func (t *fetchTransport) RoundTrip(req *Request) (*Response, error) {
+	if resp, ok, err := roundTripInProcess(req); ok {
+		return resp, err
+	}
 	headers := js.Global.Get("Headers").New()
 	for key, values := range req.Header {
 		for _, value := range values {
@@ -73,9 +165,19 @@ func (t *fetchTransport) RoundTrip(req *Request) (*Response, error) {
 		"headers":     headers,
 		"credentials": "same-origin",
 	}
-	if req.Body != nil {
-		// TODO: Find out if request body can be streamed into the fetch request rather than in advance here.
-		//       See BufferSource at https://fetch.spec.whatwg.org/#body-mixin.
+	var abort *js.Object
+	if js.Global.Get("AbortController") != js.Undefined {
+		abort = js.Global.Get("AbortController").New()
+		opt["signal"] = abort.Get("signal")
+	}
+	switch {
+	case req.Body == nil || req.Body == NoBody:
+	case req.ContentLength <= 0 && supportsRequestStreams:
+		// The length is unknown, which is a sign that the body is produced
+		// incrementally, so it's streamed rather than read in advance.
+		opt["body"] = bodyStream(req.Body)
+		opt["duplex"] = "half"
+	default:
 		body, err := ioutil.ReadAll(req.Body)
 		if err != nil {
 			req.Body.Close() // RoundTrip must always close the body, including on errors.
@@ -109,8 +211,9 @@ func (t *fetchTransport) RoundTrip(req *Request) (*Response, error) {
 				StatusCode:    result.Get("status").Int(),
 				Header:        header,
 				ContentLength: contentLength,
-				Body:          &streamReader{stream: result.Get("body").Call("getReader")},
+				Body:          &streamReader{stream: result.Get("body").Call("getReader"), ctx: req.Context(), abort: abort},
 				Request:       req,
+				TLS:           platformTLS(req),
 			}:
 			case <-req.Context().Done():
 			}
@@ -124,7 +227,7 @@ func (t *fetchTransport) RoundTrip(req *Request) (*Response, error) {
 	)
 	select {
 	case <-req.Context().Done():
-		// TODO: Abort request if possible using Fetch API.
+		abortFetch(abort)
 		return nil, errors.New("net/http: request canceled")
 	case resp := <-respCh:
 		return resp, nil
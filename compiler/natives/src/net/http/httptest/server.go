@@ -0,0 +1,118 @@
+// +build js
+
+package httptest
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/internal"
+	"sync"
+	_ "unsafe" // For go:linkname.
+)
+
+// There are no listening sockets in the browser, so servers are registered
+// with the JavaScript transports of net/http, which serve requests to their
+// address in-process instead of going to the network.
+
+//go:linkname registerInProcessServer net/http.registerInProcessServer
+func registerInProcessServer(addr string, h http.Handler) (unregister func())
+
+// inProcessListener is the listener of an in-process server. It never accepts
+// any connections, and unregisters the server when closed.
+type inProcessListener struct {
+	addr       *net.TCPAddr
+	closed     chan struct{}
+	closeOnce  sync.Once
+	unregister func()
+}
+
+// lastInProcessPort is the port of the last in-process server, to give each
+// server a distinct address.
+var lastInProcessPort = 0
+
+func newLocalListener() net.Listener {
+	lastInProcessPort++
+	return &inProcessListener{
+		addr:   &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: lastInProcessPort},
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *inProcessListener) Accept() (net.Conn, error) {
+	<-l.closed
+	return nil, net.ErrClosed
+}
+
+func (l *inProcessListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		if l.unregister != nil {
+			l.unregister()
+		}
+	})
+	return nil
+}
+
+func (l *inProcessListener) Addr() net.Addr {
+	return l.addr
+}
+
+func (s *Server) Start() {
+	if s.URL != "" {
+		panic("Server already started")
+	}
+	if s.client == nil {
+		s.client = &http.Client{Transport: http.DefaultTransport}
+	}
+	s.URL = "http://" + s.Listener.Addr().String()
+	s.startInProcess()
+}
+
+// StartTLS sets up the certificate of the server like upstream, but requests
+// to it are served in-process like for Start, without a TLS handshake.
+func (s *Server) StartTLS() {
+	if s.URL != "" {
+		panic("Server already started")
+	}
+	if s.client == nil {
+		s.client = &http.Client{Transport: http.DefaultTransport}
+	}
+	cert, err := tls.X509KeyPair(internal.LocalhostCert, internal.LocalhostKey)
+	if err != nil {
+		panic(fmt.Sprintf("httptest: NewTLSServer: %v", err))
+	}
+
+	existingConfig := s.TLS
+	if existingConfig != nil {
+		s.TLS = existingConfig.Clone()
+	} else {
+		s.TLS = new(tls.Config)
+	}
+	if len(s.TLS.Certificates) == 0 {
+		s.TLS.Certificates = []tls.Certificate{cert}
+	}
+	s.certificate, err = x509.ParseCertificate(s.TLS.Certificates[0].Certificate[0])
+	if err != nil {
+		panic(fmt.Sprintf("httptest: NewTLSServer: %v", err))
+	}
+	s.URL = "https://" + s.Listener.Addr().String()
+	s.startInProcess()
+}
+
+func (s *Server) startInProcess() {
+	unregister := registerInProcessServer(s.Listener.Addr().String(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := s.Config.Handler
+		if h == nil {
+			h = http.DefaultServeMux
+		}
+		h.ServeHTTP(w, r)
+	}))
+	if l, ok := s.Listener.(*inProcessListener); ok {
+		l.unregister = unregister
+	}
+	s.wrap()
+	s.goServe()
+}
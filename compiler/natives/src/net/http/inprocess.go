@@ -0,0 +1,251 @@
+// +build js
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// inProcessServers maps host:port addresses to handlers serving requests to
+// them without any network access. They are registered by
+// net/http/httptest.Server, since there are no listening sockets in the
+// browser.
+var inProcessServers = map[string]Handler{}
+
+// registerInProcessServer makes the JavaScript transports serve requests to
+// addr with h until unregister is called. It is used by net/http/httptest
+// through go:linkname.
+func registerInProcessServer(addr string, h Handler) (unregister func()) {
+	inProcessServers[addr] = h
+	return func() { delete(inProcessServers, addr) }
+}
+
+// roundTripInProcess serves req with the in-process server registered for
+// its address. It reports false if there is no such server, in which case the
+// request must go to the network.
+func roundTripInProcess(req *Request) (*Response, bool, error) {
+	h, ok := inProcessServers[req.URL.Host]
+	if !ok {
+		return nil, false, nil
+	}
+	resp, err := serveInProcess(h, req)
+	return resp, true, err
+}
+
+func serveInProcess(h Handler, req *Request) (*Response, error) {
+	// Build the request as the server would have parsed it off the wire.
+	ctx, cancel := context.WithCancel(req.Context())
+	sreq := req.Clone(ctx)
+	sreq.RequestURI = req.URL.RequestURI()
+	u, err := url.ParseRequestURI(sreq.RequestURI)
+	if err != nil {
+		cancel()
+		if req.Body != nil {
+			req.Body.Close() // RoundTrip must always close the body, including on errors.
+		}
+		return nil, err
+	}
+	sreq.URL = u
+	if sreq.Host == "" {
+		sreq.Host = req.URL.Host
+	}
+	sreq.Proto, sreq.ProtoMajor, sreq.ProtoMinor = "HTTP/1.1", 1, 1
+	sreq.RemoteAddr = "127.0.0.1:1"
+	sreq.Close = false
+	if sreq.Body == nil {
+		sreq.Body = NoBody
+	}
+
+	w := &inProcessResponseWriter{
+		req:     req,
+		header:  make(Header),
+		body:    &inProcessBody{wake: make(chan struct{}, 1)},
+		respond: make(chan *Response, 1),
+		failed:  make(chan error, 1),
+	}
+	go func() {
+		defer cancel()
+		defer func() {
+			if err := recover(); err != nil {
+				if err != ErrAbortHandler {
+					log.Printf("http: panic serving in-process request to %s: %v", req.URL.Host, err)
+				}
+				// The server would close the connection, which the client sees
+				// as an unexpected end of the response.
+				if !w.responded {
+					w.failed <- io.ErrUnexpectedEOF
+				}
+				w.body.finish(io.ErrUnexpectedEOF)
+				return
+			}
+			w.finish()
+		}()
+		if req.Body != nil {
+			defer req.Body.Close()
+		}
+		h.ServeHTTP(w, sreq)
+	}()
+
+	select {
+	case resp := <-w.respond:
+		return resp, nil
+	case err := <-w.failed:
+		return nil, err
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+// inProcessResponseWriter buffers the response of an in-process handler. The
+// response is handed to the client once the handler returns or flushes it.
+type inProcessResponseWriter struct {
+	req         *Request
+	header      Header
+	wroteHeader bool
+	status      int
+	sentHeader  Header // Snapshot of header when WriteHeader was called.
+	body        *inProcessBody
+	responded   bool
+	respond     chan *Response
+	failed      chan error
+}
+
+func (w *inProcessResponseWriter) Header() Header {
+	return w.header
+}
+
+func (w *inProcessResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = code
+	w.sentHeader = w.header.Clone()
+}
+
+func (w *inProcessResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		if w.header.Get("Content-Type") == "" {
+			w.header.Set("Content-Type", DetectContentType(p))
+		}
+		w.WriteHeader(StatusOK)
+	}
+	if w.req.Method == "HEAD" {
+		return len(p), nil
+	}
+	return w.body.write(p)
+}
+
+func (w *inProcessResponseWriter) Flush() {
+	w.WriteHeader(StatusOK)
+	w.sendResponse(-1)
+}
+
+// finish completes the response once the handler has returned.
+func (w *inProcessResponseWriter) finish() {
+	w.WriteHeader(StatusOK)
+	if !w.responded && w.sentHeader.Get("Content-Length") == "" && bodyAllowedForStatus(w.status) {
+		// Like the server, add the length of responses that are written in
+		// full before any of it is sent.
+		w.sentHeader.Set("Content-Length", strconv.Itoa(w.body.buf.Len()))
+	}
+	w.sendResponse(int64(w.body.buf.Len()))
+	w.body.finish(io.EOF)
+}
+
+func (w *inProcessResponseWriter) sendResponse(contentLength int64) {
+	if w.responded {
+		return
+	}
+	w.responded = true
+	if cl, err := strconv.ParseInt(w.sentHeader.Get("Content-Length"), 10, 64); err == nil {
+		contentLength = cl
+	}
+	var body io.ReadCloser = w.body
+	if w.req.Method == "HEAD" || contentLength == 0 {
+		body = NoBody
+	}
+	w.respond <- &Response{
+		Status:        strconv.Itoa(w.status) + " " + StatusText(w.status),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.sentHeader,
+		Body:          body,
+		ContentLength: contentLength,
+		Request:       w.req,
+	}
+}
+
+// inProcessBody is the body of an in-process response. Writes are buffered,
+// so that handlers don't block on clients that don't read the body.
+type inProcessBody struct {
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	err    error         // Returned once buf is drained: io.EOF when the handler is done.
+	closed bool          // Whether the client closed the body.
+	wake   chan struct{} // Signaled when buf or err changes.
+}
+
+var errInProcessBodyClosed = errors.New("http: read on closed response body")
+
+func (b *inProcessBody) write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.closed {
+		// Like the server, discard what the client won't read anymore.
+		b.buf.Write(p)
+	}
+	b.signal()
+	return len(p), nil
+}
+
+func (b *inProcessBody) finish(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.closed {
+		b.err = err
+	}
+	b.signal()
+}
+
+func (b *inProcessBody) signal() {
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (b *inProcessBody) Read(p []byte) (int, error) {
+	for {
+		b.mu.Lock()
+		if b.buf.Len() != 0 {
+			n, _ := b.buf.Read(p)
+			b.mu.Unlock()
+			return n, nil
+		}
+		err := b.err
+		b.mu.Unlock()
+		if err != nil {
+			return 0, err
+		}
+		<-b.wake
+	}
+}
+
+func (b *inProcessBody) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.buf.Reset()
+	b.err = errInProcessBodyClosed
+	return nil
+}
@@ -0,0 +1,18 @@
+// +build js
+
+package pprof
+
+import (
+	_ "unsafe" // For go:linkname.
+)
+
+// GopherJS programs can't listen for connections, so the /debug/pprof/
+// handlers are made reachable from JavaScript instead, see
+// net/http.exposeDebugHandlers.
+
+//go:linkname exposeDebugHandlers net/http.exposeDebugHandlers
+func exposeDebugHandlers()
+
+func init() {
+	exposeDebugHandlers()
+}
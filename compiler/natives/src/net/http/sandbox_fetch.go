@@ -0,0 +1,9 @@
+// +build js,gopherjs_sandbox_net_none
+
+package http
+
+// The Fetch API transport is compiled out by -sandbox=net:none.
+func newFetchTransport() RoundTripper { return nil }
+
+// knownRoundTripperImpl doesn't check Transport, see fetch.go.
+func knownRoundTripperImpl(rt RoundTripper, req *Request) bool { return false }
@@ -0,0 +1,21 @@
+// +build js,gopherjs_sandbox_net_none js,gopherjs_sandbox_net_fetch_only
+
+package http
+
+import "errors"
+
+// The XMLHttpRequest transport is compiled out by -sandbox=net:none and
+// -sandbox=net:fetch-only.
+func newXHRTransport() RoundTripper { return nil }
+
+// XHRTransport is kept for compatibility, but always fails.
+type XHRTransport struct{}
+
+func (t *XHRTransport) RoundTrip(req *Request) (*Response, error) {
+	if req.Body != nil {
+		req.Body.Close() // RoundTrip must always close the body, including on errors.
+	}
+	return nil, errors.New("net/http: XMLHttpRequest is disabled by the sandbox")
+}
+
+func (t *XHRTransport) CancelRequest(req *Request) {}
@@ -16,7 +16,15 @@ func runtime_args() []string { // not called on Windows
 }
 
 func init() {
-	if process := js.Global.Get("process"); process != js.Undefined {
+	if lifecycle := js.Global.Get("$lifecycle"); lifecycle != nil {
+		// Programs started by the host get the arguments passed to start.
+		args := lifecycle.Get("args")
+		Args = make([]string, args.Length()+1)
+		Args[0] = lifecycle.Get("name").String()
+		for i := 0; i < args.Length(); i++ {
+			Args[i+1] = args.Index(i).String()
+		}
+	} else if process := js.Global.Get("process"); process != js.Undefined {
 		argv := process.Get("argv")
 		Args = make([]string, argv.Length()-1)
 		for i := 0; i < argv.Length()-1; i++ {
@@ -2,11 +2,170 @@
 
 package signal
 
-// Package signal is not implemented for GOARCH=js.
+import (
+	"syscall"
 
-func signal_disable(uint32) {}
-func signal_enable(uint32)  {}
-func signal_ignore(uint32)  {}
-func signal_recv() uint32   { return 0 }
+	"github.com/gopherjs/gopherjs/js"
+)
 
-func loop() {}
+// Under Node.js, signals are delivered by the process object. In browsers, page
+// lifecycle events are mapped to pseudo-signals:
+//
+//  SIGTERM  beforeunload: the page is about to be unloaded.
+//  SIGTSTP  the page became hidden (visibilitychange) or was frozen (freeze).
+//  SIGCONT  the page became visible (visibilitychange) or was resumed (resume).
+//
+// Programs linked with start and stop functions also receive SIGTERM when the
+// host calls stop.
+//
+// Code run in response to SIGTERM must not block on anything asynchronous,
+// since the page is unloaded once the event handler returns.
+
+// nodeSignalNames maps signals that can be handled under Node.js to their names.
+var nodeSignalNames = map[uint32]string{
+	uint32(syscall.SIGHUP):   "SIGHUP",
+	uint32(syscall.SIGINT):   "SIGINT",
+	uint32(syscall.SIGQUIT):  "SIGQUIT",
+	uint32(syscall.SIGUSR1):  "SIGUSR1",
+	uint32(syscall.SIGUSR2):  "SIGUSR2",
+	uint32(syscall.SIGPIPE):  "SIGPIPE",
+	uint32(syscall.SIGTERM):  "SIGTERM",
+	uint32(syscall.SIGCONT):  "SIGCONT",
+	uint32(syscall.SIGTSTP):  "SIGTSTP",
+	uint32(syscall.SIGWINCH): "SIGWINCH",
+}
+
+// pending queues received signals until loop picks them up. Signals are
+// dropped if it's full, similar to the runtime's signal queue.
+var pending = make(chan uint32, 64)
+
+// ignored holds signals ignored with signal.Ignore.
+var ignored = map[uint32]bool{}
+
+// jsListener is an event listener installed on a JavaScript object.
+type jsListener struct {
+	target *js.Object
+	event  string
+	remove string // Name of the method that removes the listener.
+	fn     *js.Object
+}
+
+// installed holds JavaScript listeners installed for each signal.
+var installed = map[uint32][]jsListener{}
+
+// keepAlive is an interval timer that keeps the Node.js process running while
+// main is waiting for signals, since signal listeners alone don't.
+var keepAlive *js.Object
+
+// awaitSignals makes the program wait for signals while any listeners are
+// installed, like a Go program would, instead of reporting a deadlock or
+// exiting once there is nothing else to do.
+func awaitSignals(wait bool) {
+	if wait == (keepAlive != nil) {
+		return
+	}
+	if wait {
+		js.Global.Set("$exportedFunctions", js.Global.Get("$exportedFunctions").Int()+1)
+		keepAlive = js.Global.Call("setInterval", func() {
+			if js.Global.Get("$mainFinished").Bool() {
+				awaitSignals(false)
+			}
+		}, 1000)
+		return
+	}
+	js.Global.Set("$exportedFunctions", js.Global.Get("$exportedFunctions").Int()-1)
+	js.Global.Call("clearInterval", keepAlive)
+	keepAlive = nil
+}
+
+func deliver(sig uint32) {
+	select {
+	case pending <- sig:
+	default:
+	}
+}
+
+// install replaces listeners for signal sig with ones that invoke action.
+// Signals that have no equivalent in the JavaScript environment are silently
+// never delivered.
+func install(sig uint32, action func(event *js.Object)) {
+	uninstall(sig)
+	add := func(target *js.Object, event, addMethod, removeMethod string) {
+		fn := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			action(arguments[0])
+			return nil
+		})
+		target.Call(addMethod, event, fn)
+		installed[sig] = append(installed[sig], jsListener{target: target, event: event, remove: removeMethod, fn: fn})
+		awaitSignals(true)
+	}
+
+	if lifecycle := js.Global.Get("$lifecycle"); lifecycle != nil && syscall.Signal(sig) == syscall.SIGTERM {
+		// Programs started by the host are asked to terminate by stop.
+		add(lifecycle, "stop", "addEventListener", "removeEventListener")
+	}
+	if process := js.Global.Get("process"); process != js.Undefined && process.Get("on") != js.Undefined {
+		if name, ok := nodeSignalNames[sig]; ok {
+			add(process, name, "on", "removeListener")
+		}
+		return
+	}
+
+	document := js.Global.Get("document")
+	if document == js.Undefined {
+		return
+	}
+	switch syscall.Signal(sig) {
+	case syscall.SIGTERM:
+		add(js.Global, "beforeunload", "addEventListener", "removeEventListener")
+	case syscall.SIGTSTP:
+		add(document, "freeze", "addEventListener", "removeEventListener")
+		add(document, "visibilitychange", "addEventListener", "removeEventListener")
+	case syscall.SIGCONT:
+		add(document, "resume", "addEventListener", "removeEventListener")
+		add(document, "visibilitychange", "addEventListener", "removeEventListener")
+	}
+}
+
+func uninstall(sig uint32) {
+	for _, l := range installed[sig] {
+		l.target.Call(l.remove, l.event, l.fn)
+	}
+	delete(installed, sig)
+	if len(installed) == 0 {
+		awaitSignals(false)
+	}
+}
+
+func signal_enable(sig uint32) {
+	delete(ignored, sig)
+	install(sig, func(event *js.Object) {
+		if event != js.Undefined && event.Get("type").String() == "visibilitychange" {
+			hidden := js.Global.Get("document").Get("visibilityState").String() == "hidden"
+			if hidden != (syscall.Signal(sig) == syscall.SIGTSTP) {
+				return
+			}
+		}
+		deliver(sig)
+	})
+}
+
+func signal_disable(sig uint32) {
+	delete(ignored, sig)
+	uninstall(sig)
+}
+
+func signal_ignore(sig uint32) {
+	ignored[sig] = true
+	// A listener that does nothing prevents the default action of Node.js, which
+	// for most signals is to terminate the process.
+	install(sig, func(*js.Object) {})
+}
+
+func signal_ignored(sig uint32) bool { return ignored[sig] }
+
+func signal_recv() uint32 { return <-pending }
+
+// signalWaitUntilIdle has nothing to wait for, since signals are delivered
+// synchronously from JavaScript event handlers.
+func signalWaitUntilIdle() {}
@@ -0,0 +1,104 @@
+// +build js
+
+package runtime
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// installCrashReporter makes uncaught exceptions write crash reports to
+// $GOPHERJS_CRASH_DIR under Node.js.
+func installCrashReporter() {
+	process := js.Global.Get("process")
+	if process == js.Undefined {
+		return
+	}
+	if dir := process.Get("env").Get("GOPHERJS_CRASH_DIR"); dir != js.Undefined && dir.String() != "" {
+		// Unlike a handler of uncaughtException, the monitor doesn't change how
+		// Node.js reports the exception and exits.
+		process.Call("on", "uncaughtExceptionMonitor", js.InternalObject(func(err *js.Object) {
+			writeCrashReport(dir.String(), err)
+		}))
+	}
+}
+
+// stackFrameRegexp matches the frames of V8 stack traces, e.g.
+// "    at main (/path/to/main.go:12:3)".
+var stackFrameRegexp = js.Global.Get("RegExp").New(`^\s+at (?:(.*) \()?(.*):(\d+):(\d+)\)?$`)
+
+// writeCrashReport writes diagnostics about the uncaught exception err to a
+// new directory within dir, to make crashes debuggable after the fact:
+//
+//   goroutines.txt    the stacks of all goroutines, like runtime.Stack.
+//   stack.json        the stack of the exception, parsed into frames. They are
+//                     Go source positions if source maps are enabled.
+//   heap.heapsnapshot a V8 heap snapshot, which Chrome DevTools can open.
+func writeCrashReport(dir string, err *js.Object) {
+	console := js.Global.Get("console")
+	defer func() {
+		if e := recover(); e != nil {
+			msg := "unknown error"
+			if e, ok := e.(error); ok {
+				msg = e.Error()
+			}
+			console.Call("error", "gopherjs: failed to write crash report: "+msg)
+		}
+	}()
+
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		panic("require is not available")
+	}
+	fs := require.Invoke("fs")
+	path := require.Invoke("path")
+	fs.Call("mkdirSync", dir, map[string]interface{}{"recursive": true})
+	report := fs.Call("mkdtempSync", path.Call("join", dir, "crash-")).String()
+
+	message := js.Global.Call("String", err).String()
+	stack := message
+	if err != nil && err != js.Undefined && err.Get("stack") != js.Undefined {
+		message = js.Global.Call("String", err.Get("message")).String()
+		stack = err.Get("stack").String()
+	}
+
+	// The exception escaped from the goroutine that panicked, unless it was
+	// thrown by a JavaScript callback.
+	var panicked *js.Object
+	goroutines := js.Global.Get("$goroutines")
+	ids := js.Global.Get("Object").Call("keys", goroutines)
+	for i := 0; i < ids.Length(); i++ {
+		if g := goroutines.Get(ids.Index(i).String()); g.Get("panicked").Bool() {
+			panicked = g
+		}
+	}
+	dump := "exception outside of goroutines:\n" + stack + "\n"
+	if panicked != nil {
+		dump = "goroutine " + itoa(panicked.Get("id").Int()) + " [panicked]:\n" + stack + "\n"
+	}
+	fs.Call("writeFileSync", path.Call("join", report, "goroutines.txt"), dump+otherGoroutines(panicked))
+
+	frames := js.Global.Get("Array").New()
+	lines := js.Global.Call("String", stack).Call("split", "\n")
+	for i := 0; i < lines.Length(); i++ {
+		m := stackFrameRegexp.Call("exec", lines.Index(i))
+		if m == nil {
+			continue
+		}
+		frames.Call("push", map[string]interface{}{
+			"function": m.Index(1), // Undefined, and thus omitted, for anonymous functions.
+			"file":     m.Index(2),
+			"line":     m.Index(3).Int(),
+			"column":   m.Index(4).Int(),
+		})
+	}
+	info := map[string]interface{}{"message": message, "frames": frames}
+	if panicked != nil {
+		info["goroutine"] = panicked.Get("id")
+	}
+	fs.Call("writeFileSync", path.Call("join", report, "stack.json"), js.Global.Get("JSON").Call("stringify", info, nil, 2))
+
+	if v8 := require.Invoke("v8"); v8.Get("writeHeapSnapshot") != js.Undefined {
+		v8.Call("writeHeapSnapshot", path.Call("join", report, "heap.heapsnapshot"))
+	}
+	console.Call("error", "gopherjs: crash report written to "+report)
+}
@@ -2,6 +2,8 @@
 
 package debug
 
+import "runtime"
+
 func setGCPercent(int32) int32 {
 	// Not implemented. Return initial setting.
 	return 100
@@ -12,3 +14,9 @@ func setMaxStack(bytes int) int {
 	// The initial setting is 1 GB on 64-bit systems, 250 MB on 32-bit systems.
 	return 250000000
 }
+
+func freeOSMemory() {
+	// The memory of the runtime's queues is released as they shrink, so only
+	// the JavaScript engine may hold on to memory that isn't used.
+	runtime.GC()
+}
@@ -3,10 +3,21 @@
 package pprof
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"runtime"
+	"sort"
 	"sync"
 )
 
+// GopherJS doesn't sample CPU usage, allocations or contention, and it can't
+// write profiles in the protocol buffer format. Profiles are always written in
+// the text format, whatever the debug level: the goroutine profile lists the
+// stacks of all goroutines, the heap and allocs profiles the memory statistics
+// estimated by runtime.ReadMemStats, and the other built-in profiles are
+// empty. Custom profiles list the stacks their values were added at.
+
 type Profile struct {
 	name  string
 	mu    sync.Mutex
@@ -15,35 +26,188 @@ type Profile struct {
 	write func(io.Writer, int) error
 }
 
-func (p *Profile) WriteTo(w io.Writer, debug int) error {
-	return nil
+var profiles struct {
+	mu sync.Mutex
+	m  map[string]*Profile
 }
 
-func (p *Profile) Count() int {
-	return 0
+func lockProfiles() {
+	profiles.mu.Lock()
+	if profiles.m == nil {
+		profiles.m = map[string]*Profile{
+			"goroutine":    {name: "goroutine", count: runtime.NumGoroutine, write: writeGoroutine},
+			"threadcreate": {name: "threadcreate", count: countZero, write: writeEmpty("threadcreate profile: total 0\n")},
+			"heap":         {name: "heap", count: countZero, write: writeHeap},
+			"allocs":       {name: "allocs", count: countZero, write: writeHeap},
+			"block":        {name: "block", count: countZero, write: writeEmpty("--- contention:\ncycles/second=1000000000\n")},
+			"mutex":        {name: "mutex", count: countZero, write: writeEmpty("--- mutex:\ncycles/second=1000000000\nsampling period=0\n")},
+		}
+	}
+}
+
+func unlockProfiles() {
+	profiles.mu.Unlock()
+}
+
+func countZero() int { return 0 }
+
+func writeEmpty(s string) func(io.Writer, int) error {
+	return func(w io.Writer, debug int) error {
+		_, err := io.WriteString(w, s)
+		return err
+	}
+}
+
+func writeGoroutine(w io.Writer, debug int) error {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	if debug < 2 {
+		if _, err := fmt.Fprintf(w, "goroutine profile: total %d\n\n", runtime.NumGoroutine()); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func writeHeap(w io.Writer, debug int) error {
+	var s runtime.MemStats
+	runtime.ReadMemStats(&s)
+	_, err := fmt.Fprintf(w, "heap profile: 0: 0 [0: 0] @ heap/%d\n\n# runtime.MemStats\n# Alloc = %d\n# Sys = %d\n# HeapAlloc = %d\n# HeapSys = %d\n# HeapIdle = %d\n# HeapInuse = %d\n",
+		2*runtime.MemProfileRate, s.Alloc, s.Sys, s.HeapAlloc, s.HeapSys, s.HeapIdle, s.HeapInuse)
+	return err
+}
+
+func NewProfile(name string) *Profile {
+	lockProfiles()
+	defer unlockProfiles()
+	if name == "" {
+		panic("pprof: NewProfile with empty name")
+	}
+	if profiles.m[name] != nil {
+		panic("pprof: NewProfile name already in use: " + name)
+	}
+	p := &Profile{
+		name: name,
+		m:    map[interface{}][]uintptr{},
+	}
+	profiles.m[name] = p
+	return p
+}
+
+func Lookup(name string) *Profile {
+	lockProfiles()
+	defer unlockProfiles()
+	return profiles.m[name]
+}
+
+func Profiles() []*Profile {
+	lockProfiles()
+	defer unlockProfiles()
+
+	all := make([]*Profile, 0, len(profiles.m))
+	for _, p := range profiles.m {
+		all = append(all, p)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].name < all[j].name })
+	return all
 }
 
 func (p *Profile) Name() string {
-	return ""
+	return p.name
+}
+
+func (p *Profile) Count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.count != nil {
+		return p.count()
+	}
+	return len(p.m)
 }
 
 func (p *Profile) Add(value interface{}, skip int) {
+	if p.name == "" {
+		panic("pprof: use of uninitialized Profile")
+	}
+	if p.write != nil {
+		panic("pprof: Add called on built-in Profile " + p.name)
+	}
+
+	stk := make([]uintptr, 32)
+	n := runtime.Callers(skip+1, stk[:])
+	stk = stk[:n]
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.m[value]; ok {
+		panic("pprof: Profile.Add of duplicate value")
+	}
+	p.m[value] = stk
 }
 
 func (p *Profile) Remove(value interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.m, value)
 }
 
-func StartCPUProfile(w io.Writer) error {
+func (p *Profile) WriteTo(w io.Writer, debug int) error {
+	if p.name == "" {
+		panic("pprof: use of zero Profile")
+	}
+	if p.write != nil {
+		return p.write(w, debug)
+	}
+
+	p.mu.Lock()
+	stacks := make([][]uintptr, 0, len(p.m))
+	for _, stk := range p.m {
+		stacks = append(stacks, stk)
+	}
+	p.mu.Unlock()
+
+	if _, err := fmt.Fprintf(w, "%s profile: total %d\n", p.name, len(stacks)); err != nil {
+		return err
+	}
+	for _, stk := range stacks {
+		if _, err := fmt.Fprintf(w, "\n1 @"); err != nil {
+			return err
+		}
+		for _, pc := range stk {
+			fmt.Fprintf(w, " %#x", pc)
+		}
+		fmt.Fprintf(w, "\n")
+		if len(stk) == 0 {
+			// The value for skip was too large, and there's no stack trace.
+			continue
+		}
+		frames := runtime.CallersFrames(stk)
+		for {
+			frame, more := frames.Next()
+			fmt.Fprintf(w, "#\t%s\t%s:%d\n", frame.Function, frame.File, frame.Line)
+			if !more {
+				break
+			}
+		}
+	}
 	return nil
 }
 
-func StopCPUProfile() {
+func StartCPUProfile(w io.Writer) error {
+	return errors.New("cpu profiling is not supported by GopherJS, use the profiler of the JavaScript engine")
 }
 
-func WriteHeapProfile(w io.Writer) error {
-	return nil
+func StopCPUProfile() {
 }
 
-func Lookup(name string) *Profile {
-	return nil
+func WriteHeapProfile(w io.Writer) error {
+	return writeHeap(w, 0)
 }
@@ -26,10 +26,11 @@ type Error interface {
 // TODO(nevkontakte): In the upstream, this struct is meant to be compatible
 // with reflect.rtype, but here we use a minimal stub that satisfies the API
 // TypeAssertionError expects, which we dynamically instantiate in $assertType().
-type _type struct{ str string }
+// Types are named with the full paths of their packages, see $typeFullName.
+type _type struct{ str, pkgPath string }
 
 func (t *_type) string() string  { return t.str }
-func (t *_type) pkgpath() string { return "" }
+func (t *_type) pkgpath() string { return t.pkgPath }
 
 // A TypeAssertionError explains a failed type assertion.
 type TypeAssertionError struct {
@@ -37,11 +38,19 @@ type TypeAssertionError struct {
 	concrete      *_type
 	asserted      *_type
 	missingMethod string // one method needed by Interface, missing from Concrete
+	position      string // Go source position of the assertion, if known
 }
 
 func (*TypeAssertionError) RuntimeError() {}
 
 func (e *TypeAssertionError) Error() string {
+	if e.position != "" {
+		return e.message() + " at " + e.position
+	}
+	return e.message()
+}
+
+func (e *TypeAssertionError) message() string {
 	inter := "interface"
 	if e._interface != nil {
 		inter = e._interface.string()
@@ -72,6 +81,7 @@ func init() {
 	js.Global.Set("$jsObjectPtr", jsPkg.Get("Object").Get("ptr"))
 	js.Global.Set("$jsErrorPtr", jsPkg.Get("Error").Get("ptr"))
 	js.Global.Set("$throwRuntimeError", js.InternalObject(throw))
+	installCrashReporter()
 	// avoid dead code elimination
 	var e error
 	e = &TypeAssertionError{}
@@ -213,7 +223,13 @@ type Frame struct {
 	Entry    uintptr
 }
 
-func GC() {}
+// GC runs a garbage collection if the JavaScript engine exposes its garbage
+// collector, like Node.js run with --expose-gc, and does nothing otherwise.
+func GC() {
+	if gc := js.Global.Get("gc"); gc != js.Undefined {
+		gc.Invoke()
+	}
+}
 
 func Goexit() {
 	js.Global.Get("$curGoroutine").Set("exit", true)
@@ -223,8 +239,9 @@ func Goexit() {
 func GOMAXPROCS(int) int { return 1 }
 
 func Gosched() {
+	// Yields to the host as set by runtime/jsruntime.SetYieldMode.
 	c := make(chan struct{})
-	js.Global.Call("$setTimeout", js.InternalObject(func() { close(c) }), 0)
+	js.Global.Call("$yield", js.InternalObject(func() { close(c) }))
 	<-c
 }
 
@@ -284,14 +301,95 @@ type MemStats struct {
 	}
 }
 
+// ReadMemStats estimates memory statistics from the size of the JavaScript
+// heap, as reported by process.memoryUsage() under Node.js and by
+// performance.memory in Chrome. Other statistics, like those of allocations
+// and garbage collections, are unavailable and left zero.
 func ReadMemStats(m *MemStats) {
-	// TODO(nevkontakte): This function is effectively unimplemented and may
-	// lead to silent unexpected behaviors. Consider panicing explicitly.
+	*m = MemStats{EnableGC: true}
+	var used, total, sys float64
+	if process := js.Global.Get("process"); process != js.Undefined && process.Get("memoryUsage") != js.Undefined {
+		usage := process.Call("memoryUsage")
+		used, total, sys = usage.Get("heapUsed").Float(), usage.Get("heapTotal").Float(), usage.Get("rss").Float()
+	} else if performance := js.Global.Get("performance"); performance != js.Undefined && performance.Get("memory") != js.Undefined {
+		memory := performance.Get("memory")
+		used, total = memory.Get("usedJSHeapSize").Float(), memory.Get("totalJSHeapSize").Float()
+		sys = total
+	}
+	m.Alloc = uint64(used)
+	m.HeapAlloc = uint64(used)
+	m.HeapInuse = uint64(used)
+	m.HeapSys = uint64(total)
+	m.HeapIdle = uint64(total - used)
+	m.Sys = uint64(sys)
+}
+
+// finalizers is the FinalizationRegistry of the finalizers set with
+// SetFinalizer, created on first use.
+var finalizers *js.Object
+
+// SetFinalizer uses a FinalizationRegistry where the host has one, and is a
+// no-op otherwise, as finalizers aren't guaranteed to run anyway.
+//
+// JavaScript can't resurrect an object once it's collected, so finalizers are
+// called with a pointer to a shallow copy of *obj made when SetFinalizer was
+// called: fields that refer to other memory, like pointers, slices and maps,
+// still refer to the same memory, so their changes are visible to the
+// finalizer, but changes to other fields aren't. Each finalizer runs in a new
+// goroutine.
+func SetFinalizer(obj interface{}, finalizer interface{}) {
+	if obj == nil {
+		throw("runtime.SetFinalizer: first argument is nil")
+	}
+	o := js.InternalObject(obj)
+	typ := o.Get("constructor")
+	if typ.Get("kind").Int() != js.Global.Get("$kindPtr").Int() {
+		throw("runtime.SetFinalizer: first argument is " + typ.Get("string").String() + ", not pointer")
+	}
+	if o == typ.Get("nil") {
+		throw("runtime.SetFinalizer: first argument is a nil pointer")
+	}
+
+	var f *js.Object
+	if finalizer != nil {
+		f = js.InternalObject(finalizer)
+		ftyp := f.Get("constructor")
+		if ftyp.Get("kind").Int() != js.Global.Get("$kindFunc").Int() {
+			throw("runtime.SetFinalizer: second argument is " + ftyp.Get("string").String() + ", not a function")
+		}
+		params := ftyp.Get("params")
+		if params.Length() != 1 || ftyp.Get("variadic").Bool() || (params.Index(0) != typ &&
+			(params.Index(0).Get("kind").Int() != js.Global.Get("$kindInterface").Int() || !js.Global.Call("$assertType", o, params.Index(0), true).Index(1).Bool())) {
+			throw("runtime.SetFinalizer: cannot pass " + typ.Get("string").String() + " to finalizer " + ftyp.Get("string").String())
+		}
+	}
+
+	if finalizers == nil {
+		if js.Global.Get("FinalizationRegistry") == js.Undefined {
+			return
+		}
+		finalizers = js.Global.Get("FinalizationRegistry").New(js.InternalObject(runFinalizer))
+	}
+	finalizers.Call("unregister", o)
+	if f == nil {
+		return
+	}
+	var objCopy *js.Object
+	if elem := typ.Get("elem"); elem.Get("kind").Int() == js.Global.Get("$kindStruct").Int() {
+		objCopy = js.Global.Call("$clone", o, elem)
+	} else {
+		objCopy = js.Global.Call("$newDataPointer", o.Call("$get"), typ)
+	}
+	held := js.Global.Get("Object").New()
+	held.Set("f", f.Get("$val"))
+	held.Set("obj", objCopy)
+	finalizers.Call("register", o, held, o)
 }
 
-func SetFinalizer(x, f interface{}) {
-	// TODO(nevkontakte): This function is effectively unimplemented and may
-	// lead to silent unexpected behaviors. Consider panicing explicitly.
+// runFinalizer is called by the FinalizationRegistry, outside of goroutines,
+// with the finalizer of a collected object and the copy to pass to it.
+func runFinalizer(held *js.Object) {
+	js.Global.Call("$go", held.Get("f"), []interface{}{held.Get("obj")})
 }
 
 type Func struct {
@@ -345,7 +443,67 @@ func Stack(buf []byte, all bool) int {
 	if s == js.Undefined {
 		return 0
 	}
-	return copy(buf, s.Call("substr", s.Call("indexOf", "\n").Int()+1).String())
+	trace := s.Call("substr", s.Call("indexOf", "\n").Int()+1).String()
+	if !all {
+		return copy(buf, trace)
+	}
+
+	cur := js.Global.Get("$curGoroutine")
+	trace = "goroutine " + itoa(cur.Get("id").Int()) + " [running]:\n" + trace + "\n"
+	return copy(buf, trace+otherGoroutines(cur))
+}
+
+// otherGoroutines describes all goroutines that haven't exited yet, except
+// for cur.
+func otherGoroutines(cur *js.Object) string {
+	var s string
+	goroutines := js.Global.Get("$goroutines")
+	ids := js.Global.Get("Object").Call("keys", goroutines)
+	for i := 0; i < ids.Length(); i++ {
+		g := goroutines.Get(ids.Index(i).String())
+		if g == cur {
+			continue
+		}
+		state := "runnable"
+		if g.Get("asleep").Bool() {
+			state = "blocked"
+		}
+		s += "\ngoroutine " + ids.Index(i).String() + " [" + state + "]:\n" + blockedFrames(g.Get("frame"))
+	}
+	return s
+}
+
+// blockedFrames describes the calls of a blocked goroutine, starting with the
+// innermost one. There are no source positions of blocked calls, only the
+// functions they are in.
+func blockedFrames(frame *js.Object) string {
+	var funcs []string
+	for frame != js.Undefined {
+		switch name := frame.Get("$blk").Get("name").String(); name {
+		case "$blk":
+			// Blocking operations of the prelude, e.g. channel receives.
+		case "", "$b":
+			funcs = append(funcs, "(anonymous function)")
+		default:
+			funcs = append(funcs, name)
+		}
+
+		// The frame of the blocked call is saved like a local variable.
+		inner := js.Undefined
+		keys := js.Global.Get("Object").Call("keys", frame)
+		for i := 0; i < keys.Length(); i++ {
+			key := keys.Index(i).String()
+			if v := frame.Get(key); key != "$blk" && v != nil && v != js.Undefined && v.Get("$blk") != js.Undefined {
+				inner = v
+			}
+		}
+		frame = inner
+	}
+	var s string
+	for i := len(funcs) - 1; i >= 0; i-- {
+		s += funcs[i] + "(...)\n"
+	}
+	return s
 }
 
 func LockOSThread() {}
@@ -381,5 +539,5 @@ func throw(s string) {
 }
 
 func nanotime() int64 {
-	return js.Global.Get("Date").New().Call("getTime").Int64() * int64(1000_000)
+	return int64(js.Global.Call("$nanotime").Float())
 }
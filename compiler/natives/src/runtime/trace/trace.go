@@ -0,0 +1,223 @@
+// +build js
+
+package trace
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// GopherJS schedules goroutines itself, so instead of the format of the Go
+// runtime, which go tool trace reads, traces are written in the Trace Event
+// Format that chrome://tracing and https://ui.perfetto.dev open:
+// https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU
+//
+// Each goroutine is shown as a thread, with slices for the time it runs and
+// for the time it is blocked in between. JavaScript callbacks that run outside
+// of goroutines are shown as thread 0. Tasks are shown as async events, regions
+// as slices of the goroutine they run on, and log messages as instant events.
+// Under Node.js, garbage collections are shown as slices of their own thread.
+//
+// The trace is recorded in memory and written to w by Stop.
+type tracer struct {
+	w       io.Writer
+	events  *js.Object // Array of trace events.
+	threads map[int]bool
+	running map[int]float64 // Start of the running slice by goroutine.
+	blocked map[int]float64 // Start of the blocked slice by goroutine.
+	tasks   map[uint64]string
+	gc      *js.Object // PerformanceObserver of garbage collections, if any.
+}
+
+// gcThread is the thread garbage collections are shown on. Goroutine IDs are
+// positive, and thread 0 is JavaScript outside of goroutines.
+const gcThread = -1
+
+var active *tracer
+
+// now returns the current time in microseconds, the unit of the trace.
+func now() float64 {
+	if performance := js.Global.Get("performance"); performance != js.Undefined {
+		return performance.Call("now").Float() * 1000
+	}
+	return js.Global.Get("Date").Call("now").Float() * 1000
+}
+
+// curGoroutine returns the ID of the running goroutine, or 0 outside of
+// goroutines.
+func curGoroutine() int {
+	id := js.Global.Get("$curGoroutine").Get("id")
+	if id == js.Undefined {
+		return 0
+	}
+	return id.Int()
+}
+
+func (t *tracer) emit(e map[string]interface{}) {
+	e["pid"] = 1
+	if _, ok := e["ts"]; !ok {
+		e["ts"] = now()
+	}
+	tid := e["tid"].(int)
+	if !t.threads[tid] {
+		t.threads[tid] = true
+		name := "goroutine " + strconv.Itoa(tid)
+		switch tid {
+		case 0:
+			name = "JavaScript"
+		case gcThread:
+			name = "GC"
+		}
+		t.events.Call("push", map[string]interface{}{"name": "thread_name", "ph": "M", "pid": 1, "tid": tid, "args": map[string]interface{}{"name": name}})
+		t.events.Call("push", map[string]interface{}{"name": "thread_sort_index", "ph": "M", "pid": 1, "tid": tid, "args": map[string]interface{}{"sort_index": tid}})
+	}
+	t.events.Call("push", e)
+}
+
+// slice emits a complete event from start until now.
+func (t *tracer) slice(name string, tid int, start float64) {
+	t.emit(map[string]interface{}{"name": name, "ph": "X", "tid": tid, "ts": start, "dur": now() - start})
+}
+
+// The methods below are called by the prelude through $trace.
+
+func (t *tracer) create(id int) {
+	t.emit(map[string]interface{}{"name": "GoCreate", "ph": "i", "s": "t", "tid": curGoroutine(), "args": map[string]interface{}{"goroutine": id}})
+}
+
+func (t *tracer) start(id int) {
+	if start, ok := t.blocked[id]; ok {
+		delete(t.blocked, id)
+		t.slice("blocked", id, start)
+	}
+	t.running[id] = now()
+}
+
+func (t *tracer) stop(id int, exit, asleep bool) {
+	if start, ok := t.running[id]; ok {
+		delete(t.running, id)
+		t.slice("running", id, start)
+	}
+	if asleep && !exit {
+		t.blocked[id] = now()
+	}
+}
+
+func (t *tracer) observeGC() {
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return
+	}
+	perfHooks := require.Invoke("perf_hooks")
+	if perfHooks.Get("PerformanceObserver") == js.Undefined {
+		return
+	}
+	t.gc = perfHooks.Get("PerformanceObserver").New(js.InternalObject(func(list *js.Object) {
+		if active != t {
+			return
+		}
+		entries := list.Call("getEntries")
+		for i := 0; i < entries.Length(); i++ {
+			e := entries.Index(i)
+			t.emit(map[string]interface{}{"name": "GC", "ph": "X", "tid": gcThread, "ts": e.Get("startTime").Float() * 1000, "dur": e.Get("duration").Float() * 1000})
+		}
+	}))
+	t.gc.Call("observe", map[string]interface{}{"entryTypes": []string{"gc"}})
+}
+
+func Start(w io.Writer) error {
+	tracing.Lock()
+	defer tracing.Unlock()
+	if active != nil {
+		return errors.New("tracing is already enabled")
+	}
+	t := &tracer{
+		w:       w,
+		events:  js.Global.Get("Array").New(),
+		threads: map[int]bool{},
+		running: map[int]float64{},
+		blocked: map[int]float64{},
+		tasks:   map[uint64]string{},
+	}
+	t.events.Call("push", map[string]interface{}{"name": "process_name", "ph": "M", "pid": 1, "args": map[string]interface{}{"name": "gopherjs"}})
+	// The goroutine calling Start is already running.
+	if id := curGoroutine(); id != 0 {
+		t.running[id] = now()
+	}
+	t.observeGC()
+
+	hooks := js.Global.Get("Object").New()
+	hooks.Set("create", js.InternalObject(t.create))
+	hooks.Set("start", js.InternalObject(t.start))
+	hooks.Set("stop", js.InternalObject(t.stop))
+	js.Global.Set("$trace", hooks)
+	active = t
+	atomic.StoreInt32(&tracing.enabled, 1)
+	return nil
+}
+
+func Stop() {
+	tracing.Lock()
+	defer tracing.Unlock()
+	atomic.StoreInt32(&tracing.enabled, 0)
+	t := active
+	if t == nil {
+		return
+	}
+	active = nil
+	js.Global.Set("$trace", nil)
+	if t.gc != nil {
+		t.gc.Call("disconnect")
+	}
+	// Close the slice of the goroutine calling Stop.
+	if id := curGoroutine(); id != 0 {
+		t.stop(id, false, false)
+	}
+
+	trace := js.Global.Get("Object").New()
+	trace.Set("traceEvents", t.events)
+	trace.Set("displayTimeUnit", "ms")
+	t.w.Write([]byte(js.Global.Get("JSON").Call("stringify", trace).String()))
+}
+
+func userTaskCreate(id, parentID uint64, taskType string) {
+	if t := active; t != nil {
+		t.tasks[id] = taskType
+		t.emit(map[string]interface{}{"name": taskType, "cat": "task", "ph": "b", "id": float64(id), "tid": curGoroutine(), "args": map[string]interface{}{"parent": float64(parentID)}})
+	}
+}
+
+func userTaskEnd(id uint64) {
+	if t := active; t != nil {
+		name, ok := t.tasks[id]
+		if !ok {
+			return
+		}
+		delete(t.tasks, id)
+		t.emit(map[string]interface{}{"name": name, "cat": "task", "ph": "e", "id": float64(id), "tid": curGoroutine()})
+	}
+}
+
+func userRegion(id, mode uint64, regionType string) {
+	if t := active; t != nil {
+		ph := "B"
+		if mode == regionEndCode {
+			ph = "E"
+		}
+		t.emit(map[string]interface{}{"name": regionType, "cat": "region", "ph": ph, "tid": curGoroutine(), "args": map[string]interface{}{"task": float64(id)}})
+	}
+}
+
+func userLog(id uint64, category, message string) {
+	if t := active; t != nil {
+		name := category
+		if name == "" {
+			name = "log"
+		}
+		t.emit(map[string]interface{}{"name": name, "cat": "log", "ph": "i", "s": "t", "tid": curGoroutine(), "args": map[string]interface{}{"message": message, "task": float64(id)}})
+	}
+}
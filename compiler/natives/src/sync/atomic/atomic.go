@@ -178,6 +178,8 @@ func (v *Value) Store(x interface{}) {
 	if x == nil {
 		panic("sync/atomic: store of nil value into Value")
 	}
+	// Values stored in interfaces have the constructor of their dynamic type,
+	// which makes it a cheap stand-in for comparing types.
 	if v.v != nil && js.InternalObject(x).Get("constructor") != js.InternalObject(v.v).Get("constructor") {
 		panic("sync/atomic: store of inconsistently typed value into Value")
 	}
@@ -2,6 +2,8 @@
 
 package sync
 
+import "github.com/gopherjs/gopherjs/js"
+
 type Cond struct {
 	// fields used by vanilla implementation
 	noCopy  noCopy
@@ -10,32 +12,37 @@ type Cond struct {
 	checker copyChecker
 
 	// fields used by new implementation
-	n  int
-	ch chan bool
+	waiters []chan struct{} // Wake-up channels of the waiting goroutines, in the order they started waiting.
 }
 
+// Wait parks the calling goroutine until it is woken by Signal or Broadcast.
+// Only goroutines that are already waiting can be woken, like with the notify
+// list of the Go runtime.
 func (c *Cond) Wait() {
-	c.n++
-	if c.ch == nil {
-		c.ch = make(chan bool)
-	}
+	// Fail before unlocking c.L if the goroutine can't be parked.
+	js.Global.Call("$checkCanBlock", "sync.Cond.Wait")
+	ch := make(chan struct{})
+	c.waiters = append(c.waiters, ch)
 	c.L.Unlock()
-	<-c.ch
+	<-ch
 	c.L.Lock()
 }
 
+// Signal wakes the goroutine that has been waiting the longest, if any. Like
+// Broadcast, it doesn't block, so it can be called outside of goroutines, e.g.
+// from JavaScript callbacks.
 func (c *Cond) Signal() {
-	if c.n == 0 {
+	if len(c.waiters) == 0 {
 		return
 	}
-	c.n--
-	c.ch <- true
+	close(c.waiters[0])
+	c.waiters[0] = nil
+	c.waiters = c.waiters[1:]
 }
 
 func (c *Cond) Broadcast() {
-	n := c.n
-	c.n = 0
-	for i := 0; i < n; i++ {
-		c.ch <- true
+	for _, ch := range c.waiters {
+		close(ch)
 	}
+	c.waiters = nil
 }
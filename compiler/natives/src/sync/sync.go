@@ -26,7 +26,11 @@ func runtime_Semacquire(s *uint32) {
 // TODO: Investigate this. If it's possible to implement, consider doing so, otherwise remove this comment.
 func runtime_SemacquireMutex(s *uint32, lifo bool, skipframes int) {
 	if (*s - semAwoken[s]) == 0 {
-		ch := make(chan bool)
+		js.Global.Call("$checkCanBlock", "waiting for a sync.Mutex, RWMutex or WaitGroup")
+		// Buffered, so that runtime_Semrelease doesn't block until the waiter
+		// runs: Mutex.Unlock and friends must not park the calling goroutine,
+		// and can be called outside of goroutines.
+		ch := make(chan bool, 1)
 		if lifo {
 			semWaiters[s] = append([]chan bool{ch}, semWaiters[s]...)
 		} else {
@@ -70,8 +74,7 @@ func runtime_canSpin(i int) bool {
 
 // Copy of time.runtimeNano.
 func runtime_nanotime() int64 {
-	const millisecond = 1000000
-	return js.Global.Get("Date").New().Call("getTime").Int64() * millisecond
+	return int64(js.Global.Call("$nanotime").Float())
 }
 
 // Implemented in runtime.
@@ -0,0 +1,469 @@
+// +build js,!gopherjs_sandbox_fs_none
+
+package syscall
+
+import (
+	"unsafe"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// When the system calls module is not installed, the system calls most
+// commonly used by the os package are implemented on top of the synchronous
+// APIs of the Node.js "fs" and "process" modules. The file descriptors are
+// real ones, so they can be mixed with the ones used by Node.js itself.
+
+// nodeFile holds the state of a file descriptor opened with openat that
+// Node.js APIs don't keep track of.
+type nodeFile struct {
+	path     *js.Object // Absolute path of the file as a Buffer.
+	offset   int64      // File offset, which Node.js can't seek.
+	seekable bool
+	append   bool
+	entries  *js.Object // Directory entries, read on the first getdents64 call.
+	next     int        // Index of the next directory entry to return.
+}
+
+var nodeFiles = map[uintptr]*nodeFile{}
+
+var nodeModules = map[string]*js.Object{}
+
+// nodeModule returns the Node.js module with the given name, or nil if it's
+// not available.
+func nodeModule(name string) (module *js.Object) {
+	if m, ok := nodeModules[name]; ok {
+		return m
+	}
+	defer func() {
+		if recover() != nil {
+			module = nil
+		}
+		nodeModules[name] = module
+	}()
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return nil
+	}
+	return require.Invoke(name)
+}
+
+// nodeSyscall performs the system call trap using Node.js APIs. It returns
+// false if the system call is not supported, or if Node.js APIs are not
+// available.
+func nodeSyscall(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1 uintptr, err Errno, ok bool) {
+	fs := nodeModule("fs")
+	if fs == nil {
+		return 0, 0, false
+	}
+	defer func() {
+		e := recover()
+		if e == nil {
+			return
+		}
+		jsErr, isJSErr := e.(*js.Error)
+		if !isJSErr {
+			panic(e)
+		}
+		// Node.js reports the negated errno of failed system calls.
+		r1, err, ok = uintptr(minusOne), EIO, true
+		if errno := jsErr.Get("errno"); errno.Int() < 0 {
+			err = Errno(-errno.Int())
+		}
+	}()
+	r1, err = nodeCall(fs, trap, a1, a2, a3, a4, a5, a6)
+	if err == ENOSYS {
+		return 0, 0, false
+	}
+	if err != 0 {
+		r1 = uintptr(minusOne)
+	}
+	return r1, err, true
+}
+
+// nodeCall performs the system call trap using the Node.js module fs. It
+// returns ENOSYS if the system call is not supported.
+func nodeCall(fs *js.Object, trap, a1, a2, a3, a4, a5, a6 uintptr) (uintptr, Errno) {
+	switch trap {
+	case SYS_READ:
+		return nodeRead(fs, a1, a2, a3, -1)
+	case SYS_PREAD64:
+		return nodeRead(fs, a1, a2, a3, int64(a4))
+	case SYS_WRITE:
+		return nodeWrite(fs, a1, a2, a3, -1)
+	case SYS_PWRITE64:
+		return nodeWrite(fs, a1, a2, a3, int64(a4))
+	case SYS_OPENAT:
+		path, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		fd := uintptr(fs.Call("openSync", path, int(a3), int(a4)).Int())
+		st := fs.Call("fstatSync", fd)
+		nodeFiles[fd] = &nodeFile{
+			path:     path,
+			seekable: st.Call("isFile").Bool() || st.Call("isBlockDevice").Bool(),
+			append:   a3&O_APPEND != 0,
+		}
+		return fd, 0
+	case SYS_CLOSE:
+		fs.Call("closeSync", a1)
+		delete(nodeFiles, a1)
+		return 0, 0
+	case SYS_LSEEK:
+		f := nodeFiles[a1]
+		if f == nil || !f.seekable {
+			return 0, ESPIPE
+		}
+		offset := int64(int32(a2))
+		switch a3 {
+		case 0:
+		case 1:
+			offset += f.offset
+		case 2:
+			offset += fs.Call("fstatSync", a1).Get("size").Int64()
+		default:
+			return 0, EINVAL
+		}
+		if offset < 0 {
+			return 0, EINVAL
+		}
+		f.offset = offset
+		return uintptr(offset), 0
+	case SYS_FSTAT:
+		nodeStat(a2, fs.Call("fstatSync", a1))
+		return 0, 0
+	case SYS_NEWFSTATAT:
+		path, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		if a4&_AT_SYMLINK_NOFOLLOW != 0 {
+			nodeStat(a3, fs.Call("lstatSync", path))
+		} else {
+			nodeStat(a3, fs.Call("statSync", path))
+		}
+		return 0, 0
+	case SYS_GETDENTS64:
+		f := nodeFiles[a1]
+		if f == nil {
+			return 0, EBADF
+		}
+		if f.entries == nil {
+			f.entries = fs.Call("readdirSync", f.path, js.M{"withFileTypes": true, "encoding": "buffer"})
+		}
+		return nodeDirents(f, js.InternalObject(a2))
+	case SYS_FACCESSAT:
+		path, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		fs.Call("accessSync", path, int(a3))
+		return 0, 0
+	case SYS_MKDIRAT:
+		path, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		fs.Call("mkdirSync", path, int(a3))
+		return 0, 0
+	case SYS_UNLINKAT:
+		path, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		if a3&_AT_REMOVEDIR != 0 {
+			fs.Call("rmdirSync", path)
+		} else {
+			fs.Call("unlinkSync", path)
+		}
+		return 0, 0
+	case SYS_RENAMEAT:
+		from, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		to, err := nodePath(nodeDirfd(a3), a4)
+		if err != 0 {
+			return 0, err
+		}
+		fs.Call("renameSync", from, to)
+		return 0, 0
+	case SYS_LINKAT:
+		from, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		to, err := nodePath(nodeDirfd(a3), a4)
+		if err != 0 {
+			return 0, err
+		}
+		fs.Call("linkSync", from, to)
+		return 0, 0
+	case SYS_SYMLINKAT:
+		path, err := nodePath(nodeDirfd(a2), a3)
+		if err != 0 {
+			return 0, err
+		}
+		// The target is stored as is, relative paths are not resolved.
+		fs.Call("symlinkSync", nodeCString(a1), path)
+		return 0, 0
+	case SYS_READLINKAT:
+		path, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		target := fs.Call("readlinkSync", path, js.M{"encoding": "buffer"})
+		buf := js.InternalObject(a3)
+		if target.Length() > buf.Length() {
+			target = target.Call("subarray", 0, buf.Length())
+		}
+		buf.Call("set", target)
+		return uintptr(target.Length()), 0
+	case SYS_FCHMODAT:
+		path, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		fs.Call("chmodSync", path, int(a3))
+		return 0, 0
+	case SYS_FCHMOD:
+		fs.Call("fchmodSync", a1, int(a2))
+		return 0, 0
+	case SYS_FCHOWNAT:
+		path, err := nodePath(nodeDirfd(a1), a2)
+		if err != 0 {
+			return 0, err
+		}
+		if a5&_AT_SYMLINK_NOFOLLOW != 0 {
+			fs.Call("lchownSync", path, int32(a3), int32(a4))
+		} else {
+			fs.Call("chownSync", path, int32(a3), int32(a4))
+		}
+		return 0, 0
+	case SYS_FCHOWN:
+		fs.Call("fchownSync", a1, int32(a2), int32(a3))
+		return 0, 0
+	case SYS_TRUNCATE:
+		path, err := nodePath(_AT_FDCWD, a1)
+		if err != 0 {
+			return 0, err
+		}
+		fs.Call("truncateSync", path, a2)
+		return 0, 0
+	case SYS_FTRUNCATE:
+		fs.Call("ftruncateSync", a1, a2)
+		return 0, 0
+	case SYS_FSYNC:
+		fs.Call("fsyncSync", a1)
+		return 0, 0
+	case SYS_FDATASYNC:
+		fs.Call("fdatasyncSync", a1)
+		return 0, 0
+	case SYS_FCNTL:
+		switch a2 {
+		case F_GETFD, F_SETFD, F_GETFL, F_SETFL:
+			// File descriptors are always blocking and never inherited, since
+			// there is no way to run other programs.
+			return 0, 0
+		}
+		return 0, EINVAL
+	case SYS_IOCTL:
+		return 0, ENOTTY
+	case SYS_GETCWD:
+		cwd := js.Global.Get("Buffer").Call("from", js.Global.Get("process").Call("cwd"))
+		buf := js.InternalObject(a1)
+		if cwd.Length()+1 > buf.Length() {
+			return 0, ERANGE
+		}
+		buf.Call("set", cwd)
+		buf.SetIndex(cwd.Length(), 0)
+		return uintptr(cwd.Length() + 1), 0
+	case SYS_CHDIR:
+		js.Global.Get("process").Call("chdir", nodeCString(a1).Call("toString"))
+		return 0, 0
+	case SYS_UMASK:
+		return uintptr(js.Global.Get("process").Call("umask", int(a1)).Int()), 0
+	case SYS_GETPID:
+		return uintptr(js.Global.Get("process").Get("pid").Int()), 0
+	case SYS_GETPPID:
+		return uintptr(js.Global.Get("process").Get("ppid").Int()), 0
+	case SYS_GETUID:
+		return uintptr(js.Global.Get("process").Call("getuid").Int()), 0
+	case SYS_GETEUID:
+		return uintptr(js.Global.Get("process").Call("geteuid").Int()), 0
+	case SYS_GETGID:
+		return uintptr(js.Global.Get("process").Call("getgid").Int()), 0
+	case SYS_GETEGID:
+		return uintptr(js.Global.Get("process").Call("getegid").Int()), 0
+	}
+	return 0, ENOSYS
+}
+
+func nodeRead(fs *js.Object, fd, buf, n uintptr, pos int64) (uintptr, Errno) {
+	f := nodeFiles[fd]
+	var position interface{}
+	if pos >= 0 {
+		position = pos
+	} else if f != nil && f.seekable {
+		position = f.offset
+	}
+	r := fs.Call("readSync", fd, js.InternalObject(buf), 0, n, position).Int()
+	if pos < 0 && f != nil && f.seekable {
+		f.offset += int64(r)
+	}
+	return uintptr(r), 0
+}
+
+func nodeWrite(fs *js.Object, fd, buf, n uintptr, pos int64) (uintptr, Errno) {
+	f := nodeFiles[fd]
+	var position interface{}
+	if pos >= 0 {
+		position = pos
+	} else if f != nil && f.seekable && !f.append {
+		position = f.offset
+	}
+	r := fs.Call("writeSync", fd, js.InternalObject(buf), 0, n, position).Int()
+	if pos < 0 && f != nil && f.seekable {
+		if f.append {
+			f.offset = fs.Call("fstatSync", fd).Get("size").Int64()
+		} else {
+			f.offset += int64(r)
+		}
+	}
+	return uintptr(r), 0
+}
+
+// nodeCString returns a Buffer holding the NUL-terminated string passed to a
+// system call, without the terminator.
+func nodeCString(p uintptr) *js.Object {
+	array := js.InternalObject(p)
+	n := array.Call("indexOf", 0).Int()
+	if n < 0 {
+		n = array.Length()
+	}
+	return js.Global.Get("Buffer").Call("from", array.Get("buffer"), array.Get("byteOffset"), n)
+}
+
+// nodeDirfd converts a directory file descriptor argument, which may be the
+// negative _AT_FDCWD, to an int.
+func nodeDirfd(a uintptr) int { return int(int32(a)) }
+
+// nodePath returns the path p, relative to the directory dirfd, as a Buffer.
+func nodePath(dirfd int, p uintptr) (*js.Object, Errno) {
+	path := nodeCString(p)
+	if dirfd == _AT_FDCWD || path.Index(0).Int() == '/' {
+		if dirfd == _AT_FDCWD && path.Length() > 0 && path.Index(0).Int() != '/' {
+			// Keep the path absolute, so that it survives chdir calls when
+			// used for directory file descriptors.
+			cwd := js.Global.Get("Buffer").Call("from", js.Global.Get("process").Call("cwd").String()+"/")
+			return js.Global.Get("Buffer").Call("concat", []interface{}{cwd, path}), 0
+		}
+		return path, 0
+	}
+	dir := nodeFiles[uintptr(dirfd)]
+	if dir == nil {
+		return nil, EBADF
+	}
+	slash := js.Global.Get("Buffer").Call("from", "/")
+	return js.Global.Get("Buffer").Call("concat", []interface{}{dir.path, slash, path}), 0
+}
+
+// nodeStat writes the result of a Node.js stat call into the Stat_t passed to
+// a system call, which is a byte array with the native memory layout.
+func nodeStat(p uintptr, stats *js.Object) {
+	array := js.InternalObject(p)
+	view := js.Global.Get("DataView").New(array.Get("buffer"), array.Get("byteOffset"), array.Length())
+	put := func(offset, size uintptr, v float64) {
+		switch size {
+		case 8:
+			view.Call("setUint32", offset, uint32(int64(v)), true)
+			view.Call("setUint32", offset+4, uint32(int64(v)>>32), true)
+		case 4:
+			view.Call("setUint32", offset, uint32(int64(v)), true)
+		case 2:
+			view.Call("setUint16", offset, uint16(int64(v)), true)
+		}
+	}
+	putTime := func(offset uintptr, ms float64) {
+		var ts Timespec
+		sec := int64(ms / 1000)
+		if float64(sec)*1000 > ms {
+			sec--
+		}
+		put(offset+unsafe.Offsetof(ts.Sec), unsafe.Sizeof(ts.Sec), float64(sec))
+		put(offset+unsafe.Offsetof(ts.Nsec), unsafe.Sizeof(ts.Nsec), (ms-float64(sec)*1000)*1e6)
+	}
+
+	var st Stat_t
+	put(unsafe.Offsetof(st.Dev), unsafe.Sizeof(st.Dev), stats.Get("dev").Float())
+	put(unsafe.Offsetof(st.Ino), unsafe.Sizeof(st.Ino), stats.Get("ino").Float())
+	put(unsafe.Offsetof(st.Nlink), unsafe.Sizeof(st.Nlink), stats.Get("nlink").Float())
+	put(unsafe.Offsetof(st.Mode), unsafe.Sizeof(st.Mode), stats.Get("mode").Float())
+	put(unsafe.Offsetof(st.Uid), unsafe.Sizeof(st.Uid), stats.Get("uid").Float())
+	put(unsafe.Offsetof(st.Gid), unsafe.Sizeof(st.Gid), stats.Get("gid").Float())
+	put(unsafe.Offsetof(st.Rdev), unsafe.Sizeof(st.Rdev), stats.Get("rdev").Float())
+	put(unsafe.Offsetof(st.Size), unsafe.Sizeof(st.Size), stats.Get("size").Float())
+	put(unsafe.Offsetof(st.Blksize), unsafe.Sizeof(st.Blksize), stats.Get("blksize").Float())
+	put(unsafe.Offsetof(st.Blocks), unsafe.Sizeof(st.Blocks), stats.Get("blocks").Float())
+	putTime(unsafe.Offsetof(st.Atim), stats.Get("atimeMs").Float())
+	putTime(unsafe.Offsetof(st.Mtim), stats.Get("mtimeMs").Float())
+	putTime(unsafe.Offsetof(st.Ctim), stats.Get("ctimeMs").Float())
+}
+
+// nodeDirents writes as many of the remaining directory entries of f as fit
+// into buf in the linux_dirent64 format, and returns the number of bytes
+// written.
+func nodeDirents(f *nodeFile, buf *js.Object) (uintptr, Errno) {
+	const (
+		offIno    = 0
+		offOff    = 8
+		offReclen = 16
+		offType   = 18
+		offName   = 19
+	)
+	view := js.Global.Get("DataView").New(buf.Get("buffer"), buf.Get("byteOffset"), buf.Length())
+	n := 0
+	for ; f.next < f.entries.Length(); f.next++ {
+		entry := f.entries.Index(f.next)
+		name := entry.Get("name")
+		reclen := (offName + name.Length() + 1 + 7) &^ 7
+		if n+reclen > buf.Length() {
+			if n == 0 {
+				return 0, EINVAL
+			}
+			break
+		}
+		// The inode number is not known without an additional stat call, but
+		// readers skip entries with an inode number of 0.
+		view.Call("setUint32", n+offIno, 1, true)
+		view.Call("setUint32", n+offIno+4, 0, true)
+		view.Call("setUint32", n+offOff, f.next+1, true)
+		view.Call("setUint32", n+offOff+4, 0, true)
+		view.Call("setUint16", n+offReclen, reclen, true)
+		view.Call("setUint8", n+offType, nodeDirentType(entry))
+		buf.Call("fill", 0, n+offName, n+reclen)
+		buf.Call("set", name, n+offName)
+		n += reclen
+	}
+	return uintptr(n), 0
+}
+
+func nodeDirentType(entry *js.Object) int {
+	switch {
+	case entry.Call("isFile").Bool():
+		return DT_REG
+	case entry.Call("isDirectory").Bool():
+		return DT_DIR
+	case entry.Call("isSymbolicLink").Bool():
+		return DT_LNK
+	case entry.Call("isFIFO").Bool():
+		return DT_FIFO
+	case entry.Call("isSocket").Bool():
+		return DT_SOCK
+	case entry.Call("isCharacterDevice").Bool():
+		return DT_CHR
+	case entry.Call("isBlockDevice").Bool():
+		return DT_BLK
+	}
+	return DT_UNKNOWN
+}
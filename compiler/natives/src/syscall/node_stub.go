@@ -0,0 +1,10 @@
+// +build js,!linux js,gopherjs_sandbox_fs_none
+
+package syscall
+
+// nodeSyscall is only implemented on Linux, where the system call numbers and
+// data structures it relies on are known. It's compiled out by
+// -sandbox=fs:none.
+func nodeSyscall(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1 uintptr, err Errno, ok bool) {
+	return 0, 0, false
+}
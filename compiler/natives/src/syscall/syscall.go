@@ -45,6 +45,20 @@ func printToConsole(b []byte) {
 	}
 }
 
+// outputHook returns the JavaScript function installed to receive output written
+// to the standard output (fd 1) or standard error (fd 2), or nil if there is
+// none. Hooks take precedence over system calls and console output.
+func outputHook(fd uintptr) *js.Object {
+	name := "goStdout"
+	if fd == 2 {
+		name = "goStderr"
+	}
+	if hook := js.Global.Get(name); hook != js.Undefined && hook != nil {
+		return hook
+	}
+	return nil
+}
+
 func use(p unsafe.Pointer) {
 	// no-op
 }
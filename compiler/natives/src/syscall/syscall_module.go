@@ -0,0 +1,29 @@
+// +build js,!windows,!gopherjs_sandbox_fs_none,!gopherjs_sandbox_net_none,!gopherjs_sandbox_net_fetch_only
+
+package syscall
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var syscallModule *js.Object
+var alreadyTriedToLoad = false
+
+func syscallByName(name string) *js.Object {
+	defer func() {
+		recover()
+		// return nil if recovered
+	}()
+	if syscallModule == nil {
+		if alreadyTriedToLoad {
+			return nil
+		}
+		alreadyTriedToLoad = true
+		require := js.Global.Get("require")
+		if require == js.Undefined {
+			panic("")
+		}
+		syscallModule = require.Invoke("syscall")
+	}
+	return syscallModule.Get(name)
+}
@@ -9,17 +9,13 @@ import (
 	"github.com/gopherjs/gopherjs/js"
 )
 
+// runtime_envs returns the environment variables collected by $environ from
+// the sources selected at link time, see compiler.LinkOptions.EnvSources.
 func runtime_envs() []string {
-	process := js.Global.Get("process")
-	if process == js.Undefined {
-		return nil
-	}
-	jsEnv := process.Get("env")
-	envkeys := js.Global.Get("Object").Call("keys", jsEnv)
-	envs := make([]string, envkeys.Length())
-	for i := 0; i < envkeys.Length(); i++ {
-		key := envkeys.Index(i).String()
-		envs[i] = key + "=" + jsEnv.Get(key).String()
+	jsEnv := js.Global.Call("$environ")
+	envs := make([]string, jsEnv.Length())
+	for i := range envs {
+		envs[i] = jsEnv.Index(i).String()
 	}
 	return envs
 }
@@ -40,30 +36,16 @@ func unsetenv_c(k string) {
 	process.Get("env").Delete(k)
 }
 
-var syscallModule *js.Object
-var alreadyTriedToLoad = false
 var minusOne = -1
 
-func syscallByName(name string) *js.Object {
-	defer func() {
-		recover()
-		// return nil if recovered
-	}()
-	if syscallModule == nil {
-		if alreadyTriedToLoad {
-			return nil
-		}
-		alreadyTriedToLoad = true
-		require := js.Global.Get("require")
-		if require == js.Undefined {
-			panic("")
+func Syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	if trap == SYS_WRITE && (a1 == 1 || a1 == 2) {
+		if hook := outputHook(a1); hook != nil {
+			array := js.InternalObject(a2)
+			hook.Invoke(js.Global.Get("Uint8Array").New(array))
+			return uintptr(array.Length()), 0, 0
 		}
-		syscallModule = require.Invoke("syscall")
 	}
-	return syscallModule.Get(name)
-}
-
-func Syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
 	if f := syscallByName("Syscall"); f != nil {
 		r := f.Invoke(trap, a1, a2, a3)
 		return uintptr(r.Index(0).Int()), uintptr(r.Index(1).Int()), Errno(r.Index(2).Int())
@@ -75,18 +57,66 @@ func Syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
 		printToConsole(slice)
 		return uintptr(array.Length()), 0, 0
 	}
+	if trap == SYS_FSTAT && a1 <= 2 {
+		// The Stat_t argument is passed as a byte array with the native memory
+		// layout, which the compiler copies back into the struct.
+		array := js.InternalObject(a2)
+		view := js.Global.Get("DataView").New(array.Get("buffer"), array.Get("byteOffset"))
+		var st Stat_t
+		if unsafe.Sizeof(st.Mode) == 2 {
+			view.Call("setUint16", unsafe.Offsetof(st.Mode), stdioMode(a1), true)
+		} else {
+			view.Call("setUint32", unsafe.Offsetof(st.Mode), stdioMode(a1), true)
+		}
+		return 0, 0, 0
+	}
+	if r1, err, ok := nodeSyscall(trap, a1, a2, a3, 0, 0, 0); ok {
+		return r1, 0, err
+	}
 	if trap == exitTrap {
+		// Programs started by the host report the exit code to it, rather
+		// than exiting the host.
+		if lifecycle := js.Global.Get("$lifecycle"); lifecycle != nil {
+			js.Global.Call("$flushConsole")
+			lifecycle.Call("exit", int(a1))
+			runtime.Goexit()
+		}
+		// Without system calls, exit Node.js directly, so that the exit code
+		// of the program isn't lost.
+		if process := js.Global.Get("process"); process != js.Undefined && process.Get("exit") != js.Undefined {
+			js.Global.Call("$flushConsole")
+			process.Call("exit", int(a1))
+		}
 		runtime.Goexit()
 	}
 	printWarning()
 	return uintptr(minusOne), 0, EACCES
 }
 
+// stdioMode returns the file mode reported for the standard stream fd when
+// system calls are not available: a character device if Node.js reports the
+// stream as a TTY, otherwise a pipe.
+func stdioMode(fd uintptr) uint32 {
+	if fd != 0 && outputHook(fd) != nil {
+		return S_IFIFO | 0600
+	}
+	if process := js.Global.Get("process"); process != js.Undefined {
+		stream := process.Get([]string{"stdin", "stdout", "stderr"}[fd])
+		if stream != js.Undefined && stream.Get("isTTY").Bool() {
+			return S_IFCHR | 0620
+		}
+	}
+	return S_IFIFO | 0600
+}
+
 func Syscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
 	if f := syscallByName("Syscall6"); f != nil {
 		r := f.Invoke(trap, a1, a2, a3, a4, a5, a6)
 		return uintptr(r.Index(0).Int()), uintptr(r.Index(1).Int()), Errno(r.Index(2).Int())
 	}
+	if r1, err, ok := nodeSyscall(trap, a1, a2, a3, a4, a5, a6); ok {
+		return r1, 0, err
+	}
 	if trap != 202 { // kern.osrelease on OS X, happens in init of "os" package
 		printWarning()
 	}
@@ -98,6 +128,9 @@ func RawSyscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
 		r := f.Invoke(trap, a1, a2, a3)
 		return uintptr(r.Index(0).Int()), uintptr(r.Index(1).Int()), Errno(r.Index(2).Int())
 	}
+	if r1, err, ok := nodeSyscall(trap, a1, a2, a3, 0, 0, 0); ok {
+		return r1, 0, err
+	}
 	printWarning()
 	return uintptr(minusOne), 0, EACCES
 }
@@ -107,6 +140,9 @@ func rawSyscallNoError(trap, a1, a2, a3 uintptr) (r1, r2 uintptr) {
 		r := f.Invoke(trap, a1, a2, a3)
 		return uintptr(r.Index(0).Int()), uintptr(r.Index(1).Int())
 	}
+	if r1, _, ok := nodeSyscall(trap, a1, a2, a3, 0, 0, 0); ok {
+		return r1, 0
+	}
 	printWarning()
 	return uintptr(minusOne), 0
 }
@@ -116,6 +152,9 @@ func RawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errn
 		r := f.Invoke(trap, a1, a2, a3, a4, a5, a6)
 		return uintptr(r.Index(0).Int()), uintptr(r.Index(1).Int()), Errno(r.Index(2).Int())
 	}
+	if r1, err, ok := nodeSyscall(trap, a1, a2, a3, a4, a5, a6); ok {
+		return r1, 0, err
+	}
 	printWarning()
 	return uintptr(minusOne), 0, EACCES
 }
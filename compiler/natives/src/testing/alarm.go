@@ -0,0 +1,95 @@
+// +build js
+
+package testing
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// watchdogGrace is how long after the timeout the watchdog interrupts the
+// tests, if the alarm didn't go off by then.
+const watchdogGrace = time.Second
+
+// watchdogSource is the code of the watchdog worker thread. Once the timeout
+// has passed, it evaluates code on the main thread through the inspector
+// protocol, which V8 does between any two iterations of a loop, so unlike the
+// alarm it also interrupts goroutines that never yield to the event loop.
+const watchdogSource = `
+var inspector = require("inspector"), threads = require("worker_threads");
+setTimeout(function() {
+  var session = new inspector.Session();
+  session.connectToMainThread();
+  session.post("Runtime.evaluate", { expression: "gopherjsTestTimedOut()" });
+}, threads.workerData);
+`
+
+var watchdog *js.Object // Worker thread started by startWatchdog, or nil.
+
+func (m *M) startAlarm() time.Time {
+	if *timeout <= 0 {
+		return time.Time{}
+	}
+
+	deadline := time.Now().Add(*timeout)
+	m.timer = time.AfterFunc(*timeout, func() {
+		m.after()
+		timedOut()
+	})
+	watchdog = startWatchdog(*timeout + watchdogGrace)
+	return deadline
+}
+
+func (m *M) stopAlarm() {
+	if *timeout > 0 {
+		m.timer.Stop()
+		if watchdog != nil {
+			watchdog.Call("terminate")
+			watchdog = nil
+		}
+	}
+}
+
+// timedOut reports that the tests ran for longer than -test.timeout with the
+// stacks of all goroutines, like the panic of upstream, and exits.
+func timedOut() {
+	buf := make([]byte, 1<<20)
+	n := runtime.Stack(buf, true)
+	fmt.Fprintf(os.Stderr, "panic: test timed out after %v\n\n%s\n", *timeout, buf[:n])
+	if process := js.Global.Get("process"); process != js.Undefined {
+		// Without the system calls module, os.Exit only exits the goroutine,
+		// which can't stop other goroutines or the watchdog.
+		process.Call("exit", 2)
+	}
+	os.Exit(2)
+}
+
+// startWatchdog starts a worker thread that calls timedOut if the tests are
+// still running after d. Node.js prints a note about waiting for the debugger
+// when exiting afterwards. It returns nil if worker threads or the inspector
+// aren't available, e.g. in browsers.
+func startWatchdog(d time.Duration) (w *js.Object) {
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return nil
+	}
+	defer func() {
+		if err := recover(); err != nil {
+			w = nil // Node.js without worker threads.
+		}
+	}()
+	// Functions are passed as internal objects, since externalized ones
+	// disable the detection of deadlocks, which ends tests that call os.Exit.
+	js.Global.Set("gopherjsTestTimedOut", js.InternalObject(timedOut))
+	w = require.Invoke("worker_threads").Get("Worker").New(watchdogSource, map[string]interface{}{
+		"eval":       true,
+		"workerData": d.Milliseconds(),
+	})
+	w.Call("on", "error", js.InternalObject(func() {})) // The inspector isn't available.
+	w.Call("unref")
+	return w
+}
@@ -0,0 +1,11 @@
+// +build js,gopherjs_sandbox_net_none js,gopherjs_sandbox_net_fetch_only
+
+package time
+
+import "syscall"
+
+// Fetching time zone data is compiled out by -sandbox=net:none and
+// -sandbox=net:fetch-only, since it needs XMLHttpRequest.
+func fetchTzinfo(name string) ([]byte, error) {
+	return nil, syscall.ENOENT
+}
@@ -0,0 +1,169 @@
+// +build js
+
+package time
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// A bubble is a group of goroutines created by synctestStart that observe a
+// fake clock. The clock only advances when every goroutine in the bubble is
+// blocked, to the time the next timer of the bubble fires.
+//
+// The prelude keeps track of the goroutines of a bubble in its state object:
+// goroutines join the bubble of the goroutine that started them, and the
+// state's idle function is called once all of them are blocked or exited.
+type bubble struct {
+	state     *js.Object
+	now       int64
+	timers    []*runtimeTimer // Sorted by when.
+	waiter    chan struct{}   // Closed once the bubble is idle, for synctestStartWait.
+	done      chan struct{}   // Closed once all goroutines have exited, or deadlocked.
+	deadlock  bool
+	scheduled bool // Whether step is scheduled to run.
+}
+
+// synctestEpoch is the initial time of a bubble's clock, midnight UTC
+// 2000-01-01, as in upstream.
+const synctestEpoch = 946684800 * int64(Second)
+
+var (
+	bubbles      = map[int]*bubble{}
+	lastBubbleID = 0
+)
+
+// currentBubble returns the bubble of the current goroutine, or nil.
+func currentBubble() *bubble {
+	state := js.Global.Get("$curGoroutine").Get("bubble")
+	if state == js.Undefined {
+		return nil
+	}
+	return bubbles[state.Get("id").Int()]
+}
+
+// synctestStart starts a bubble running f, for synctest.Run of the
+// github.com/gopherjs/gopherjs/synctest package. It returns a channel that is
+// closed once the bubble is done, and a function that panics if the bubble
+// deadlocked or f panicked. Functions linked with go:linkname can't block, so
+// synctest.Run waits for the channel itself.
+func synctestStart(f func()) (done <-chan struct{}, finish func()) {
+	g := js.Global.Get("$curGoroutine")
+	if g.Get("bubble") != js.Undefined {
+		panic("synctest.Run called from within a synctest bubble")
+	}
+
+	lastBubbleID++
+	id := lastBubbleID
+	b := &bubble{now: synctestEpoch, done: make(chan struct{})}
+	b.state = js.Global.Get("Object").New()
+	b.state.Set("id", id)
+	b.state.Set("total", 0)
+	b.state.Set("awake", 0)
+	b.state.Set("idle", js.InternalObject(b.idle))
+	bubbles[id] = b
+
+	var panicValue interface{}
+	g.Set("bubble", b.state)
+	go func() {
+		defer func() { panicValue = recover() }()
+		f()
+	}()
+	g.Delete("bubble")
+
+	return b.done, func() {
+		delete(bubbles, id)
+		if panicValue != nil {
+			panic(panicValue)
+		}
+		if b.deadlock {
+			panic("deadlock: all goroutines in bubble are blocked")
+		}
+	}
+}
+
+// synctestStartWait returns a channel that is closed once all goroutines in
+// the bubble of the current goroutine are blocked, for synctest.Wait of the
+// github.com/gopherjs/gopherjs/synctest package.
+func synctestStartWait() <-chan struct{} {
+	b := currentBubble()
+	if b == nil {
+		panic("goroutine is not in a bubble")
+	}
+	if b.waiter != nil {
+		panic("wait already in progress")
+	}
+	b.waiter = make(chan struct{})
+	return b.waiter
+}
+
+// idle is called by the prelude when all goroutines of the bubble are blocked.
+// It runs outside of any goroutine, so it can't block itself.
+func (b *bubble) idle() {
+	if b.scheduled {
+		return
+	}
+	b.scheduled = true
+	js.Global.Call("$setTimeout", js.InternalObject(b.step), 0)
+}
+
+// step resumes a goroutine of an idle bubble: the one waiting for the bubble
+// to be idle, or the one started by the next timer after advancing the
+// clock. If there are none, the bubble is done.
+func (b *bubble) step() {
+	b.scheduled = false
+	if b.state.Get("awake").Int() != 0 {
+		return // Woken up by something outside of the bubble meanwhile.
+	}
+	switch {
+	case b.state.Get("total").Int() == 0:
+		close(b.done)
+	case b.waiter != nil:
+		close(b.waiter)
+		b.waiter = nil
+	case len(b.timers) != 0:
+		t := b.timers[0]
+		b.timers = b.timers[1:]
+		if t.when > b.now {
+			b.now = t.when
+		}
+		t.active = false
+		if t.period != 0 {
+			t.when += t.period
+			startTimer(t)
+		}
+		// Timer functions don't block, but may be compiled as blocking ones.
+		// Call it through JavaScript, so that the returned frame isn't taken
+		// as step being blocked, rather than in a goroutine that would never
+		// be accounted as exited. Goroutines it starts, as AfterFunc's does,
+		// join the bubble.
+		g := js.Global.Get("$curGoroutine")
+		g.Set("bubble", b.state)
+		js.InternalObject(t.f).Invoke(js.InternalObject(t.arg), 0)
+		g.Delete("bubble")
+		if b.state.Get("awake").Int() == 0 {
+			b.idle() // The timer didn't wake up any goroutine.
+		}
+	default:
+		b.deadlock = true
+		close(b.done)
+	}
+}
+
+func (b *bubble) addTimer(t *runtimeTimer) {
+	i := len(b.timers)
+	for i > 0 && b.timers[i-1].when > t.when {
+		i--
+	}
+	b.timers = append(b.timers, nil)
+	copy(b.timers[i+1:], b.timers[i:])
+	b.timers[i] = t
+}
+
+func (b *bubble) removeTimer(t *runtimeTimer) {
+	for i, other := range b.timers {
+		if other == t {
+			b.timers = append(b.timers[:i], b.timers[i+1:]...)
+			return
+		}
+	}
+}
@@ -16,55 +16,232 @@ func init() {
 }
 
 type runtimeTimer struct {
-	i       int32
-	when    int64
-	period  int64
-	f       func(interface{}, uintptr)
-	arg     interface{}
-	seq     uintptr
-	timeout *js.Object
-	active  bool
+	i      int32
+	when   int64
+	period int64
+	f      func(interface{}, uintptr)
+	arg    interface{}
+	seq    uintptr
+	active bool
+	// pending is set while runTimers is yet to call f for a due timer.
+	// Stopping or resetting the timer clears it, so that f isn't called.
+	pending bool
+	bubble  *bubble // Set if the timer uses the fake clock of a synctest bubble.
 }
 
+// runtimeNano returns the monotonic clock, which is based on
+// performance.now(), so that durations measured with time.Since and timers
+// aren't affected by changes of the system clock.
+func runtimeNano() int64 {
+	if b := currentBubble(); b != nil {
+		return b.now
+	}
+	return int64(js.Global.Call("$nanotime").Float())
+}
+
+// now returns the wall clock from Date.now(), which follows the system clock,
+// and the monotonic clock from runtimeNano, like upstream.
 func now() (sec int64, nsec int32, mono int64) {
-	n := runtimeNano()
-	return n / int64(Second), int32(n % int64(Second)), n
+	if b := currentBubble(); b != nil {
+		return b.now / int64(Second), int32(b.now % int64(Second)), b.now
+	}
+	mono = runtimeNano()
+	ms := js.Global.Get("Date").Call("now").Int64()
+	return ms / 1000, int32(ms%1000) * int32(Millisecond), mono
 }
 
 func Sleep(d Duration) {
+	if currentBubble() != nil {
+		if d > 0 {
+			<-NewTimer(d).C
+		}
+		return
+	}
+	// Fail before the timeout keeps the program alive if the goroutine can't
+	// be parked.
+	js.Global.Call("$checkCanBlock", "time.Sleep")
 	c := make(chan struct{})
-	js.Global.Call("$setTimeout", js.InternalObject(func() { close(c) }), int(d/Millisecond))
+	// Round up, so that the monotonic clock doesn't report less than d.
+	js.Global.Call("$setTimeout", js.InternalObject(func() { close(c) }), int((d+Millisecond-1)/Millisecond))
 	<-c
 }
 
+// timers is a min-heap of the active timers outside of synctest bubbles,
+// ordered by when, with runtimeTimer.i being the index in the heap. Only the
+// earliest timer has a JavaScript timeout, so that starting and stopping timers,
+// like those of time.After in a select loop or of context deadlines, is cheap.
+var (
+	timers        []*runtimeTimer
+	timersTimeout *js.Object // The pending JavaScript timeout, if any.
+	timersWhen    int64      // When timersTimeout fires.
+)
+
 func startTimer(t *runtimeTimer) {
 	t.active = true
-	diff := (t.when - runtimeNano()) / int64(Millisecond)
+	if t.bubble == nil {
+		t.bubble = currentBubble()
+	}
+	if t.bubble != nil {
+		t.bubble.addTimer(t)
+		return
+	}
+	t.i = int32(len(timers))
+	timers = append(timers, t)
+	siftUpTimer(int(t.i))
+	armTimers()
+}
+
+func stopTimer(t *runtimeTimer) bool {
+	wasActive := dropTimer(t)
+	armTimers()
+	return wasActive
+}
+
+// dropTimer is stopTimer without updating the JavaScript timeout, for timers
+// that are restarted right away.
+func dropTimer(t *runtimeTimer) bool {
+	if t.bubble != nil {
+		t.bubble.removeTimer(t)
+	} else if t.active && t.i >= 0 {
+		removeTimer(int(t.i))
+	}
+	wasActive := t.active
+	t.active = false
+	t.pending = false
+	return wasActive
+}
+
+// armTimers makes sure that the JavaScript timeout fires by the time the
+// earliest timer is due. A timeout that fires earlier is kept, as runTimers
+// sets the next one, which makes pushing back deadlines cheap. A pending
+// timeout counts as an awake goroutine for the deadlock detection, so there
+// must be none without active timers.
+func armTimers() {
+	if len(timers) != 0 && timersTimeout != nil && timersWhen <= timers[0].when {
+		return
+	}
+	if timersTimeout != nil {
+		js.Global.Call("$clearTimeout", timersTimeout)
+		timersTimeout = nil
+	}
+	if len(timers) == 0 {
+		return
+	}
+	when := timers[0].when
+	diff := (when - runtimeNano()) / int64(Millisecond)
 	if diff > 1<<31-1 { // math.MaxInt32
 		return
 	}
 	if diff < 0 {
 		diff = 0
 	}
-	t.timeout = js.Global.Call("$setTimeout", js.InternalObject(func() {
-		t.active = false
+	timersWhen = when
+	timersTimeout = js.Global.Call("$setTimeout", js.InternalObject(runTimers), diff+1)
+}
+
+// runTimers runs the functions of the due timers. It is called by the
+// JavaScript timeout outside of any goroutine. The timeout for the next timer
+// is set before running them, and they run in a single goroutine, so that the
+// goroutines they wake up don't run, and possibly block, before all of them
+// ran, which could make them appear deadlocked.
+//
+// Other goroutines may run before that goroutine does, so due timers are
+// marked as pending, and their functions are only called if they weren't
+// stopped or reset since. Until then, timers that don't repeat stay active
+// outside of the heap, with an index of -1, so that stopping them reports that
+// they didn't fire, like upstream.
+func runTimers() {
+	timersTimeout = nil
+	now := runtimeNano()
+	var due []*runtimeTimer
+	for len(timers) != 0 && timers[0].when <= now {
+		t := timers[0]
+		removeTimer(0)
+		t.i = -1
+		t.pending = true
+		due = append(due, t)
+	}
+	for _, t := range due {
 		if t.period != 0 {
 			t.when += t.period
+			if t.when < 0 { // Overflow.
+				t.when = 1<<63 - 1
+			}
 			startTimer(t)
 		}
-		go t.f(t.arg, 0)
-	}), diff+1)
+	}
+	armTimers()
+	if len(due) == 0 {
+		return
+	}
+	go func() {
+		for _, t := range due {
+			if !t.pending {
+				continue // Stopped or reset since it was due.
+			}
+			t.pending = false
+			if t.period == 0 {
+				t.active = false
+			}
+			t.f(t.arg, t.seq)
+		}
+	}()
 }
 
-func stopTimer(t *runtimeTimer) bool {
-	js.Global.Call("clearTimeout", t.timeout)
-	wasActive := t.active
-	t.active = false
-	return wasActive
+// removeTimer removes the timer at index i of the heap.
+func removeTimer(i int) {
+	last := len(timers) - 1
+	if i != last {
+		timers[i] = timers[last]
+		timers[i].i = int32(i)
+	}
+	timers[last] = nil
+	timers = timers[:last]
+	if i != last {
+		siftUpTimer(i)
+		siftDownTimer(i)
+	}
+	// Release the memory of a heap that was much larger once.
+	if cap(timers) > 64 && len(timers) <= cap(timers)/4 {
+		timers = append(make([]*runtimeTimer, 0, cap(timers)/2), timers...)
+	}
+}
+
+func siftUpTimer(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if timers[parent].when <= timers[i].when {
+			break
+		}
+		swapTimers(i, parent)
+		i = parent
+	}
+}
+
+func siftDownTimer(i int) {
+	for {
+		smallest := i
+		for _, child := range [...]int{2*i + 1, 2*i + 2} {
+			if child < len(timers) && timers[child].when < timers[smallest].when {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		swapTimers(i, smallest)
+		i = smallest
+	}
+}
+
+func swapTimers(i, j int) {
+	timers[i], timers[j] = timers[j], timers[i]
+	timers[i].i = int32(i)
+	timers[j].i = int32(j)
 }
 
 func modTimer(t *runtimeTimer, when, period int64, f func(interface{}, uintptr), arg interface{}, seq uintptr) {
-	stopTimer(t)
+	dropTimer(t)
 	t.when = when
 	t.period = period
 	t.f = f
@@ -4,6 +4,7 @@ package time_test
 
 import (
 	"testing"
+	"time"
 )
 
 func TestSleep(t *testing.T) {
@@ -13,3 +14,49 @@ func TestSleep(t *testing.T) {
 func TestEnvTZUsage(t *testing.T) {
 	t.Skip("TZ environment variable in not applicable in the browser context.")
 }
+
+func TestMonotonicResolution(t *testing.T) {
+	start := time.Now()
+	var elapsed time.Duration
+	for elapsed == 0 {
+		elapsed = time.Since(start)
+	}
+	if elapsed >= time.Millisecond {
+		t.Errorf("Got smallest measurable duration %v, want less than 1ms", elapsed)
+	}
+}
+
+func TestSleepMonotonic(t *testing.T) {
+	const d = 1500 * time.Microsecond
+	start := time.Now()
+	time.Sleep(d)
+	if elapsed := time.Since(start); elapsed < d {
+		t.Errorf("time.Sleep(%v) returned after %v", d, elapsed)
+	}
+}
+
+func TestStopResetDueTimer(t *testing.T) {
+	for _, reset := range []bool{false, true} {
+		// The sleep is scheduled first, so main wakes up before the function of
+		// the timer, due at the same time, is called, and stops or resets it.
+		fired := make(chan bool, 1)
+		var timer *time.Timer
+		go func() {
+			timer = time.AfterFunc(10*time.Millisecond, func() { fired <- true })
+		}()
+		time.Sleep(10 * time.Millisecond)
+		if reset {
+			if !timer.Reset(time.Hour) {
+				t.Errorf("Reset() = false, want true")
+			}
+			defer timer.Stop()
+		} else if !timer.Stop() {
+			t.Errorf("Stop() = false, want true")
+		}
+		select {
+		case <-fired:
+			t.Errorf("Function of timer called after it was stopped or reset (reset: %t)", reset)
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// +build js,!gopherjs_sandbox_net_none,!gopherjs_sandbox_net_fetch_only
+
+package time
+
+import (
+	"errors"
+	"syscall"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// fetchTzinfo returns the TZif data of the named time zone from the
+// directory at the URL that the page sets as the goZoneinfoURL global
+// variable, like "https://example.com/zoneinfo/", laid out like
+// /usr/share/zoneinfo. It returns syscall.ENOENT if the variable isn't set, or
+// the zone isn't found.
+//
+// The request is a synchronous XMLHttpRequest, since LoadLocation can't
+// block, so it is only used if the Intl API doesn't know the zone.
+func fetchTzinfo(name string) (data []byte, err error) {
+	base := js.Global.Get("goZoneinfoURL")
+	if base == js.Undefined || js.Global.Get("XMLHttpRequest") == js.Undefined {
+		return nil, syscall.ENOENT
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			jsErr, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			data, err = nil, errors.New("time: fetching " + name + ": " + jsErr.Error())
+		}
+	}()
+	xhr := js.Global.Get("XMLHttpRequest").New()
+	xhr.Call("open", "GET", base.String()+name, false)
+	// Synchronous requests can't have an arraybuffer response, so the bytes
+	// are read as characters of the user-defined charset.
+	xhr.Call("overrideMimeType", "text/plain; charset=x-user-defined")
+	xhr.Call("send")
+	switch status := xhr.Get("status").Int(); {
+	case status == 404:
+		return nil, syscall.ENOENT
+	case status != 200:
+		return nil, errors.New("time: fetching " + name + ": " + xhr.Get("statusText").String())
+	}
+	text := xhr.Get("responseText")
+	data = make([]byte, text.Length())
+	for i := range data {
+		data[i] = byte(text.Call("charCodeAt", i).Int())
+	}
+	return data, nil
+}
@@ -2,7 +2,12 @@
 
 package time
 
-import "github.com/gopherjs/gopherjs/js"
+import (
+	"errors"
+	"syscall"
+
+	"github.com/gopherjs/gopherjs/js"
+)
 
 // The code below is based on the upstream zoneinfo_js.go to closer match
 // WebAssembly behavior.
@@ -54,3 +59,209 @@ const smallsString = "00010203040506070809" +
 	"80818283848586878889" +
 	"90919293949596979899"
 const digits = "0123456789"
+
+// intlZoneData reconstructs time zone transitions for a given IANA time zone
+// name using the JavaScript Intl API, which ships time zone data with every
+// modern browser and Node.js. It returns null if Intl is not available or
+// doesn't recognize the time zone.
+//
+// The offset in effect is sampled weekly between 1970 and 2038 and each change
+// is pinpointed with a binary search, which is enough to catch all real-world
+// transitions. Times before the range use the earliest known zone, and rules
+// observed in the last year of the range are extrapolated into the future.
+var intlZoneData = js.Global.Call("eval", `(function(name) {
+  if (typeof Intl === "undefined" || typeof Intl.DateTimeFormat !== "function") {
+    return null;
+  }
+  var fmt;
+  try {
+    fmt = new Intl.DateTimeFormat("en-US", {
+      timeZone: name, hourCycle: "h23", timeZoneName: "short",
+      year: "numeric", month: "numeric", day: "numeric", hour: "numeric", minute: "numeric", second: "numeric"
+    });
+  } catch (e) {
+    return null;
+  }
+  var info = function(ms) {
+    var parts = fmt.formatToParts(new Date(ms)), v = {};
+    for (var i = 0; i < parts.length; i++) {
+      v[parts[i].type] = parts[i].value;
+    }
+    var local = Date.UTC(+v.year, +v.month - 1, +v.day, +v.hour % 24, +v.minute, +v.second);
+    return { name: v.timeZoneName, offset: Math.round((local - ms) / 1000) };
+  };
+  var same = function(a, b) { return a.name === b.name && a.offset === b.offset; };
+  var zones = [], tx = [], index = {};
+  var addTx = function(ms, z) {
+    var key = z.name + " " + z.offset;
+    if (index[key] === undefined) {
+      index[key] = zones.length;
+      zones.push({ name: z.name, offset: z.offset, isDST: false });
+    }
+    tx.push({ when: ms / 1000, index: index[key] });
+  };
+  var step = 7 * 24 * 3600 * 1000, end = Date.UTC(2038, 0, 1);
+  var t = Date.UTC(1970, 0, 1), prev = info(t);
+  addTx(t, prev);
+  for (t += step; t < end; t += step) {
+    var cur = info(t), lo = t - step;
+    while (!same(prev, cur)) {
+      /* The zone changes in (lo, t], find the exact second. */
+      var hi = t;
+      while (hi - lo > 1000) {
+        var mid = lo + Math.floor((hi - lo) / 2000) * 1000;
+        if (same(info(mid), prev)) { lo = mid; } else { hi = mid; }
+      }
+      prev = info(hi);
+      addTx(hi, prev);
+      lo = hi;
+    }
+  }
+  if (zones.length > 255) {
+    return null;
+  }
+  /* Intl doesn't expose whether a zone is daylight saving time. Assume it is
+     if the offset is larger than both the previous and the following one. */
+  for (var i = 1; i < tx.length - 1; i++) {
+    var o = zones[tx[i].index].offset;
+    if (o > zones[tx[i - 1].index].offset && o > zones[tx[i + 1].index].offset) {
+      zones[tx[i].index].isDST = true;
+    }
+  }
+  /* Describe the rules observed in the last sampled year as a POSIX TZ string,
+     so that Go can extrapolate them past the sampled range. */
+  var posixOffset = function(o) {
+    var sign = o > 0 ? "-" : "+";
+    o = Math.abs(o);
+    return sign + Math.floor(o / 3600) + ":" + Math.floor(o % 3600 / 60) + ":" + (o % 60);
+  };
+  var posixRule = function(when, prevOffset) {
+    var local = new Date((when + prevOffset) * 1000);
+    var day = local.getUTCDate(), week = Math.ceil(day / 7);
+    var daysInMonth = new Date(Date.UTC(local.getUTCFullYear(), local.getUTCMonth() + 1, 0)).getUTCDate();
+    if (day + 7 > daysInMonth) {
+      week = 5;
+    }
+    return "M" + (local.getUTCMonth() + 1) + "." + week + "." + local.getUTCDay() + "/" +
+      local.getUTCHours() + ":" + local.getUTCMinutes() + ":" + local.getUTCSeconds();
+  };
+  var lastYear = Date.UTC(2037, 0, 1) / 1000, recent = [];
+  for (var i = 1; i < tx.length; i++) {
+    if (tx[i].when >= lastYear) {
+      recent.push(i);
+    }
+  }
+  var extend = "", last = zones[tx[tx.length - 1].index];
+  if (recent.length === 0) {
+    extend = "<" + last.name + ">" + posixOffset(last.offset);
+  } else if (recent.length === 2) {
+    var a = tx[recent[0]], b = tx[recent[1]], za = zones[a.index], zb = zones[b.index];
+    var dstTx = za.offset > zb.offset ? a : b, stdTx = dstTx === a ? b : a;
+    var dst = zones[dstTx.index], std = zones[stdTx.index];
+    if (dst.offset !== std.offset) {
+      extend = "<" + std.name + ">" + posixOffset(std.offset) + "<" + dst.name + ">" + posixOffset(dst.offset) +
+        "," + posixRule(dstTx.when, std.offset) + "," + posixRule(stdTx.when, dst.offset);
+    }
+  }
+  return { zones: zones, tx: tx, extend: extend };
+})`)
+
+// loadLocationFromIntl returns a Location for the named IANA time zone built
+// from data provided by the JavaScript Intl API.
+func loadLocationFromIntl(name string) (*Location, bool) {
+	data := intlZoneData.Invoke(name)
+	if data == nil {
+		return nil, false
+	}
+	l := &Location{name: name, extend: data.Get("extend").String()}
+	zones := data.Get("zones")
+	for i := 0; i < zones.Length(); i++ {
+		z := zones.Index(i)
+		l.zone = append(l.zone, zone{
+			name:   z.Get("name").String(),
+			offset: z.Get("offset").Int(),
+			isDST:  z.Get("isDST").Bool(),
+		})
+	}
+	tx := data.Get("tx")
+	for i := 0; i < tx.Length(); i++ {
+		t := tx.Index(i)
+		l.tx = append(l.tx, zoneTrans{
+			when:  t.Get("when").Int64(),
+			index: uint8(t.Get("index").Int()),
+		})
+	}
+	return l, true
+}
+
+// jsLocations are the Locations loaded by loadLocationFromJS, by name, since
+// building them is expensive.
+var jsLocations = map[string]*Location{}
+
+// loadLocationFromJS returns a Location for the named IANA time zone built
+// from the data of the JavaScript Intl API, or else from the TZif data
+// fetched with fetchTzinfo. It returns syscall.ENOENT if neither has the
+// zone.
+func loadLocationFromJS(name string) (*Location, error) {
+	l, ok := jsLocations[name]
+	if !ok {
+		if l, ok = loadLocationFromIntl(name); !ok {
+			zoneData, err := fetchTzinfo(name)
+			if err != nil {
+				return nil, err
+			}
+			if l, err = LoadLocationFromTZData(name, zoneData); err != nil {
+				return nil, err
+			}
+		}
+		jsLocations[name] = l
+	}
+	// Like upstream, each call returns a new Location, which shares the
+	// transitions with the others.
+	c := *l
+	return &c, nil
+}
+
+// loadLocation returns the Location with the given name from one of
+// the specified sources. See loadTzinfo for a list of supported sources.
+// The first timezone data matching the given name that is successfully loaded
+// and parsed is returned as a Location.
+//
+// Unlike upstream, if none of the sources have the data, it is loaded with
+// loadLocationFromJS, which makes LoadLocation work in browsers without
+// embedding the time zone database.
+func loadLocation(name string, sources []string) (z *Location, firstErr error) {
+	for _, source := range sources {
+		var zoneData, err = loadTzinfo(name, source)
+		if err == nil {
+			if z, err = LoadLocationFromTZData(name, zoneData); err == nil {
+				return z, nil
+			}
+		}
+		if firstErr == nil && err != syscall.ENOENT {
+			firstErr = err
+		}
+	}
+	if loadFromEmbeddedTZData != nil {
+		zonedata, err := loadFromEmbeddedTZData(name)
+		if err == nil {
+			if z, err = LoadLocationFromTZData(name, []byte(zonedata)); err == nil {
+				return z, nil
+			}
+		}
+		if firstErr == nil && err != syscall.ENOENT {
+			firstErr = err
+		}
+	}
+	z, err := loadLocationFromJS(name)
+	if err == nil {
+		return z, nil
+	}
+	if firstErr == nil && err != syscall.ENOENT {
+		firstErr = err
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return nil, errors.New("unknown time zone " + name)
+}
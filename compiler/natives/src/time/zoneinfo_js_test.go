@@ -0,0 +1,43 @@
+// +build js
+
+package time
+
+import "testing"
+
+func TestLoadLocationFromJS(t *testing.T) {
+	if intlZoneData.Invoke("UTC") == nil {
+		t.Skip("Intl API isn't available")
+	}
+	loc, err := loadLocationFromJS("America/New_York")
+	if err != nil {
+		t.Fatalf("loadLocationFromJS() returned error: %v", err)
+	}
+	// Daylight saving time began at 2:00 EST on March 14, 2021, and ended at
+	// 2:00 EDT on November 7, 2021.
+	for _, test := range []struct {
+		utc    Time
+		name   string
+		offset int
+	}{
+		{Date(2021, March, 14, 6, 59, 59, 0, UTC), "EST", -5 * 3600},
+		{Date(2021, March, 14, 7, 0, 0, 0, UTC), "EDT", -4 * 3600},
+		{Date(2021, November, 7, 5, 59, 59, 0, UTC), "EDT", -4 * 3600},
+		{Date(2021, November, 7, 6, 0, 0, 0, UTC), "EST", -5 * 3600},
+		{Date(2050, July, 1, 0, 0, 0, 0, UTC), "EDT", -4 * 3600}, // Extrapolated.
+	} {
+		if name, offset := test.utc.In(loc).Zone(); name != test.name || offset != test.offset {
+			t.Errorf("Zone() at %v = %s, %d, want %s, %d", test.utc, name, offset, test.name, test.offset)
+		}
+	}
+
+	again, err := loadLocationFromJS("America/New_York")
+	if err != nil {
+		t.Fatalf("loadLocationFromJS() returned error: %v", err)
+	}
+	if again == loc || &again.tx[0] != &loc.tx[0] {
+		t.Error("loadLocationFromJS() didn't return a new Location with the cached transitions")
+	}
+	if _, err := loadLocationFromJS("Not/A_Zone"); err == nil {
+		t.Error("loadLocationFromJS() of an unknown zone returned no error")
+	}
+}
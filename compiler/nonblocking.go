@@ -0,0 +1,70 @@
+package compiler
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/analysis"
+)
+
+// nonblockingDirective asserts that a function doesn't block, for example
+// because it is called synchronously from JavaScript, where blocking isn't
+// possible:
+//
+//  //gopherjs:nonblocking
+//  func onClick(event *js.Object) { ... }
+//
+// Compilation fails if the blocking analysis concludes that the function may
+// block, instead of the function failing at run time when it does.
+const nonblockingDirective = "//gopherjs:nonblocking"
+
+// nonblockingDirectiveOf returns the nonblocking directive of fun, or nil if
+// it has none.
+func nonblockingDirectiveOf(fun *ast.FuncDecl) *ast.Comment {
+	if fun.Doc == nil {
+		return nil
+	}
+	for _, c := range fun.Doc.List {
+		if c.Text == nonblockingDirective || strings.HasPrefix(c.Text, nonblockingDirective+" ") {
+			return c
+		}
+	}
+	return nil
+}
+
+// checkNonblocking returns an error if the function o, declared by fun, has a
+// nonblocking directive, but is blocking. The error explains why, following
+// the calls to blocking functions of the package, and to the first blocking
+// function of another package, whose declaration is returned by
+// importedDecl.
+func checkNonblocking(fset *token.FileSet, fun *ast.FuncDecl, o *types.Func, info *analysis.Info, importedDecl func(*types.Func) *Decl) error {
+	directive := nonblockingDirectiveOf(fun)
+	if directive == nil || !info.IsBlocking(o) {
+		return nil
+	}
+	var steps []string
+	seen := map[*types.Func]bool{}
+	for f := o; f != nil && !seen[f]; {
+		seen[f] = true
+		if f.Pkg() != info.Pkg {
+			if d := importedDecl(f); d != nil && d.BlockingReason != "" {
+				steps = append(steps, fmt.Sprintf("%s %s", f.FullName(), d.BlockingReason))
+			}
+			break
+		}
+		r := info.FuncDeclInfos[f].BlockingReason
+		if r == nil {
+			break
+		}
+		steps = append(steps, fmt.Sprintf("%s %s at %s", f.FullName(), r.What, fset.Position(r.Pos)))
+		f = r.Callee
+	}
+	msg := fmt.Sprintf("%s is marked %s, but it may block", o.FullName(), nonblockingDirective)
+	if len(steps) != 0 {
+		msg += ": " + strings.Join(steps, "; ")
+	}
+	return types.Error{Fset: fset, Pos: directive.Pos(), Msg: msg}
+}
@@ -0,0 +1,41 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+)
+
+func TestNonblockingDirective(t *testing.T) {
+	file, fset := parseSource(t, `package testcase
+
+	var ready = make(chan bool)
+
+	func wait() { <-ready }
+
+	//gopherjs:nonblocking
+	func Pure() int { return 42 }
+
+	//gopherjs:nonblocking
+	func Handle() { wait() }
+
+	type T struct{}
+
+	//gopherjs:nonblocking
+	func (T) Send() { ready <- true }
+	`)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}}
+	_, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+	errs, ok := err.(ErrorList)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Compile() returned error %v, want errors for Handle and Send", err)
+	}
+	for i, want := range []string{
+		"<src>:10:2: testcase.Handle is marked //gopherjs:nonblocking, but it may block: testcase.Handle calls testcase.wait at <src>:11:18; testcase.wait receives from a channel at <src>:5:16",
+		"<src>:15:2: (testcase.T).Send is marked //gopherjs:nonblocking, but it may block: (testcase.T).Send sends to a channel at <src>:16:20",
+	} {
+		if got := errs[i].Error(); got != want {
+			t.Errorf("Compile() returned error %q, want %q", got, want)
+		}
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"strings"
 
 	"github.com/gopherjs/gopherjs/compiler/analysis"
+	"github.com/gopherjs/gopherjs/compiler/filter"
+	"github.com/gopherjs/gopherjs/compiler/linebuf"
 	"github.com/neelance/astrewrite"
 	"golang.org/x/tools/go/gcexportdata"
 	"golang.org/x/tools/go/types/typeutil"
@@ -32,8 +34,18 @@ type pkgContext struct {
 	indentation  int
 	dependencies map[types.Object]bool
 	minify       bool
-	fileSet      *token.FileSet
-	errList      ErrorList
+	csp          bool
+	targetES     int
+	// readable adds comments with the positions of the Go code to the
+	// generated code.
+	readable bool
+	cspEvals []string // Code of eval calls replaced in CSP mode.
+	fileSet  *token.FileSet
+	errList  ErrorList
+	// importedDecl returns the declaration of a function of another package,
+	// whose inline template calls of it are replaced with, see inlineCall. It
+	// is nil unless ImportContext.Inline is set.
+	importedDecl func(*types.Func) *Decl
 }
 
 func (p *pkgContext) SelectionOf(e *ast.SelectorExpr) (selection, bool) {
@@ -80,10 +92,13 @@ type funcContext struct {
 	flowDatas     map[*types.Label]*flowData
 	caseCounter   int
 	labelCases    map[*types.Label]int
-	output        []byte
-	delayedOutput []byte
+	output        []linebuf.Entry
+	delayedOutput []linebuf.Entry
 	posAvailable  bool
 	pos           token.Pos
+	// lastPosComment is the text of the last position comment of the code
+	// of the function in readable mode, see positionComment.
+	lastPosComment string
 }
 
 type flowData struct {
@@ -95,6 +110,22 @@ type flowData struct {
 type ImportContext struct {
 	Packages map[string]*types.Package
 	Import   func(string) (*Archive, error)
+	// Inline replaces calls of small functions of imported packages with
+	// their code, recorded in the archives of the packages, see
+	// Decl.Inline. The code compiled this way depends on the code of the
+	// imported packages, not just their types.
+	Inline bool
+	// TargetES is the version of ECMAScript that the generated code may use,
+	// ES5 if zero, see CheckTargetES.
+	TargetES int
+	// Readable makes the generated code easier to review: it is annotated
+	// with comments with the file and line of the Go code it was generated
+	// from, and anonymous types are named after their types, see
+	// positionComment and stableTypeName. It can't be combined with minify.
+	Readable bool
+	// CSP makes the generated code not use eval, so that it runs under a
+	// Content-Security-Policy without 'unsafe-eval', see translateCSPEval.
+	CSP bool
 }
 
 // packageImporter implements go/types.Importer interface.
@@ -193,6 +224,7 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		return nil, err
 	}
 	importContext.Packages[importPath] = typesPkg
+	unavailable := unavailableUses(typesInfo, fileSet)
 
 	exportData := new(bytes.Buffer)
 	if err := gcexportdata.Write(exportData, nil, typesPkg); err != nil {
@@ -206,21 +238,47 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 	simplifiedFiles := make([]*ast.File, len(files))
 	for i, file := range files {
 		simplifiedFiles[i] = astrewrite.Simplify(file, typesInfo, false)
+		filter.ConstSwitchTags(simplifiedFiles[i], typesInfo)
 	}
 
-	isBlocking := func(f *types.Func) bool {
+	// findImportedDecl returns the declaration of the function f of another
+	// package, or nil if it has none. The declarations of each archive are
+	// indexed by their full names on first use.
+	importedDecls := make(map[*Archive]map[string]*Decl)
+	findImportedDecl := func(f *types.Func) *Decl {
 		archive, err := importContext.Import(f.Pkg().Path())
 		if err != nil {
-			panic(err)
-		}
-		fullName := f.FullName()
-		for _, d := range archive.Declarations {
-			if string(d.FullName) == fullName {
-				return d.Blocking
+			return nil
+		}
+		decls, ok := importedDecls[archive]
+		if !ok {
+			decls = make(map[string]*Decl, len(archive.Declarations))
+			for _, d := range archive.Declarations {
+				if d.FullName != "" {
+					decls[d.FullName] = d
+				}
 			}
+			importedDecls[archive] = decls
+		}
+		return decls[f.FullName()]
+	}
+	importedDecl := func(f *types.Func) *Decl {
+		if d := findImportedDecl(f); d != nil {
+			return d
+		}
+		if _, err := importContext.Import(f.Pkg().Path()); err != nil {
+			panic(err)
 		}
-		panic(fullName)
+		panic(f.FullName())
 	}
+	isBlocking := func(f *types.Func) bool {
+		return importedDecl(f).Blocking
+	}
+	isPure := func(f *types.Func) bool {
+		d := findImportedDecl(f)
+		return d != nil && d.Pure
+	}
+	purity := newPurity(files, typesInfo, typesPkg, isPure)
 	pkgInfo := analysis.AnalyzePkg(simplifiedFiles, fileSet, typesInfo, typesPkg, isBlocking)
 	funcCtx := &funcContext{
 		FuncInfo: pkgInfo.InitFuncInfo,
@@ -235,6 +293,9 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 			indentation:  1,
 			dependencies: make(map[types.Object]bool),
 			minify:       minify,
+			csp:          importContext.CSP,
+			targetES:     importContext.TargetES,
+			readable:     importContext.Readable,
 			fileSet:      fileSet,
 		},
 		allVars:     make(map[string]int),
@@ -245,6 +306,9 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 	for name := range reservedKeywords {
 		funcCtx.allVars[name] = 1
 	}
+	if importContext.Inline {
+		funcCtx.pkgCtx.importedDecl = findImportedDecl
+	}
 
 	// imports
 	var importDecls []*Decl
@@ -265,9 +329,10 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		funcCtx.Blocking[call] = true
 		funcCtx.Flattened[call] = true
 		importDecls = append(importDecls, &Decl{
-			Vars:     []string{funcCtx.pkgCtx.pkgVars[impPath]},
-			DeclCode: []byte(fmt.Sprintf("\t%s = $packages[\"%s\"];\n", funcCtx.pkgCtx.pkgVars[impPath], impPath)),
-			InitCode: funcCtx.CatchOutput(1, func() { funcCtx.translateStmt(&ast.ExprStmt{X: call}, nil) }),
+			ImportedPkg: impPath,
+			Vars:        []string{funcCtx.pkgCtx.pkgVars[impPath]},
+			DeclCode:    []byte(fmt.Sprintf("\t%s = $packages[\"%s\"];\n", funcCtx.pkgCtx.pkgVars[impPath], impPath)),
+			InitCode:    funcCtx.CatchOutput(1, func() { funcCtx.translateStmt(&ast.ExprStmt{X: call}, nil) }),
 		})
 	}
 
@@ -323,6 +388,12 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		for o := range funcCtx.pkgCtx.dependencies {
 			qualifiedName := o.Pkg().Path() + "." + o.Name()
 			if f, ok := o.(*types.Func); ok && f.Type().(*types.Signature).Recv() != nil {
+				if f.Exported() {
+					// Exported methods may be called through interfaces
+					// of any package, see exportedMethodFilter.
+					deps = append(deps, exportedMethodFilter(f.Name()))
+					continue
+				}
 				deps = append(deps, qualifiedName+"~")
 				continue
 			}
@@ -332,6 +403,10 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		return deps
 	}
 
+	// Whether initializing the package has no side effects, see
+	// Archive.PureInit.
+	pureInit := true
+
 	// variables
 	var varDecls []*Decl
 	varsWithInit := make(map[*types.Var]bool)
@@ -376,10 +451,10 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 			})
 			d.Vars = append(d.Vars, funcCtx.localVars...)
 		})
-		if len(init.Lhs) == 1 {
-			if !analysis.HasSideEffect(init.Rhs, funcCtx.pkgCtx.Info.Info) {
-				d.DceObjectFilter = init.Lhs[0].Name()
-			}
+		if !purity.pureInit(init) {
+			pureInit = false
+		} else if len(init.Lhs) == 1 {
+			d.DceObjectFilter = init.Lhs[0].Name()
 		}
 		varDecls = append(varDecls, &d)
 	}
@@ -387,12 +462,27 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 	// functions
 	var funcDecls []*Decl
 	var mainFunc *types.Func
+	var exports, exportSignatures []string
 	for _, fun := range functions {
 		o := funcCtx.pkgCtx.Defs[fun.Name].(*types.Func)
 		funcInfo := funcCtx.pkgCtx.FuncDeclInfos[o]
 		d := Decl{
 			FullName: o.FullName(),
 			Blocking: len(funcInfo.Blocking) != 0,
+			Pure:     purity.pureFunc(o),
+		}
+		if r := funcInfo.BlockingReason; r != nil {
+			d.BlockingReason = fmt.Sprintf("%s at %s", r.What, fileSet.Position(r.Pos))
+			if r.Callee != nil {
+				d.BlockingCallee = r.Callee.FullName()
+			}
+		}
+		exportName, err := parseExportDirective(fileSet, fun)
+		if err != nil {
+			funcCtx.pkgCtx.errList = append(funcCtx.pkgCtx.errList, err)
+		}
+		if err := checkNonblocking(fileSet, fun, o, funcCtx.pkgCtx.Info, importedDecl); err != nil {
+			funcCtx.pkgCtx.errList = append(funcCtx.pkgCtx.errList, err)
 		}
 		if fun.Recv == nil {
 			d.LinkingName = newSymName(o)
@@ -413,6 +503,9 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 					funcCtx.translateStmt(&ast.ExprStmt{X: call}, nil)
 				})
 				d.DceObjectFilter = ""
+				if !purity.pureInitFunc(o) {
+					pureInit = false
+				}
 			}
 		}
 		if fun.Recv != nil {
@@ -428,9 +521,31 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 			}
 		}
 
+		if exportName != "" {
+			if o.Name() == "init" {
+				funcCtx.pkgCtx.errList = append(funcCtx.pkgCtx.errList, types.Error{Fset: fileSet, Pos: fun.Pos(), Msg: "cannot export init function"})
+			}
+			for _, name := range exports {
+				if name == exportName {
+					funcCtx.pkgCtx.errList = append(funcCtx.pkgCtx.errList, types.Error{Fset: fileSet, Pos: fun.Pos(), Msg: fmt.Sprintf("function exported as %q more than once", exportName)})
+				}
+			}
+			exports = append(exports, exportName)
+			exportSignatures = append(exportSignatures, tsSignature(exportName, o.Type().(*types.Signature)))
+			d.DceObjectFilter = "" // Exported functions may be called from JavaScript.
+		}
+
 		d.DceDeps = collectDependencies(func() {
 			d.DeclCode = funcCtx.translateToplevelFunction(fun, funcInfo)
+			if exportName != "" {
+				d.InitCode = funcCtx.CatchOutput(1, func() {
+					funcCtx.Printf("$exportFunction(%s, $externalize(%s, %s));", encodeString(exportName), funcCtx.objectName(o), funcCtx.typeName(o.Type()))
+				})
+			}
 		})
+		if importContext.Inline && exportName == "" && o.Name() != "init" && o.Name() != "main" {
+			d.Inline = funcCtx.inlineTemplate(fun, funcInfo)
+		}
 		funcDecls = append(funcDecls, &d)
 	}
 	if typesPkg.Name() == "main" {
@@ -490,15 +605,15 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 					for i := 0; i < t.NumFields(); i++ {
 						params[i] = fieldName(t, i) + "_"
 					}
-					constructor = fmt.Sprintf("function(%s) {\n\t\tthis.$val = this;\n\t\tif (arguments.length === 0) {\n", strings.Join(params, ", "))
+					body := []string{"this.$val = this;", "if (arguments.length === 0) {"}
 					for i := 0; i < t.NumFields(); i++ {
-						constructor += fmt.Sprintf("\t\t\tthis.%s = %s;\n", fieldName(t, i), funcCtx.translateExpr(funcCtx.zeroValue(t.Field(i).Type())).String())
+						body = append(body, fmt.Sprintf("\tthis.%s = %s;", fieldName(t, i), funcCtx.translateExpr(funcCtx.zeroValue(t.Field(i).Type())).String()))
 					}
-					constructor += "\t\t\treturn;\n\t\t}\n"
+					body = append(body, "\treturn;", "}")
 					for i := 0; i < t.NumFields(); i++ {
-						constructor += fmt.Sprintf("\t\tthis.%[1]s = %[1]s_;\n", fieldName(t, i))
+						body = append(body, fmt.Sprintf("this.%[1]s = %[1]s_;", fieldName(t, i)))
 					}
-					constructor += "\t}"
+					constructor = funcCtx.pkgCtx.structConstructor(params, body)
 				case *types.Basic, *types.Array, *types.Slice, *types.Chan, *types.Signature, *types.Interface, *types.Pointer, *types.Map:
 					size = sizes32.Sizeof(t)
 				}
@@ -529,17 +644,25 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 					}
 					methods = append(methods, entry)
 				}
+				// Method lists are only needed for interface satisfaction checks and
+				// reflection, so we defer constructing them, and the function types of
+				// their methods, until first use to reduce program startup time.
 				if len(methods) > 0 {
-					funcCtx.Printf("%s.methods = [%s];", funcCtx.typeName(named), strings.Join(methods, ", "))
+					funcCtx.Printf("$lazyMethods(%s, function() { return [%s]; });", funcCtx.typeName(named), strings.Join(methods, ", "))
 				}
 				if len(ptrMethods) > 0 {
-					funcCtx.Printf("%s.methods = [%s];", funcCtx.typeName(types.NewPointer(named)), strings.Join(ptrMethods, ", "))
+					funcCtx.Printf("$lazyMethods(%s, function() { return [%s]; });", funcCtx.typeName(types.NewPointer(named)), strings.Join(ptrMethods, ", "))
 				}
 			})
 			switch t := o.Type().Underlying().(type) {
 			case *types.Array, *types.Chan, *types.Interface, *types.Map, *types.Pointer, *types.Slice, *types.Signature, *types.Struct:
 				d.TypeInitCode = funcCtx.CatchOutput(0, func() {
-					funcCtx.Printf("%s.init(%s);", funcCtx.objectName(o), funcCtx.initArgs(t))
+					initCode := fmt.Sprintf("%s.init(%s);", funcCtx.objectName(o), funcCtx.initArgs(t))
+					if lazyTypeInit(t) {
+						funcCtx.Printf("$lazyInit(%s, function() { %s });", funcCtx.objectName(o), initCode)
+					} else {
+						funcCtx.Printf("%s", initCode)
+					}
 				})
 			}
 		})
@@ -572,17 +695,41 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 	}
 
 	return &Archive{
-		ImportPath:   importPath,
-		Name:         typesPkg.Name(),
-		Imports:      importedPaths,
-		ExportData:   exportData.Bytes(),
-		Declarations: allDecls,
-		FileSet:      encodedFileSet.Bytes(),
-		Minified:     minify,
-		GoLinknames:  goLinknames,
+		ImportPath:       importPath,
+		Name:             typesPkg.Name(),
+		Imports:          importedPaths,
+		ExportData:       exportData.Bytes(),
+		Declarations:     allDecls,
+		FileSet:          encodedFileSet.Bytes(),
+		Minified:         minify,
+		GoLinknames:      goLinknames,
+		IncJSCode:        cspEvalCode(importPath, funcCtx.pkgCtx.cspEvals),
+		Exports:          exports,
+		ExportSignatures: exportSignatures,
+		Unavailable:      unavailable,
+		PureInit:         pureInit && len(exports) == 0,
 	}, nil
 }
 
+// lazyTypeInit reports whether the init of a named type with the given
+// underlying type can be deferred until its element types, fields or signature
+// are first used. Interfaces register the methods of nil interface values and
+// structs with embedded fields register synthesizers for the promoted methods,
+// both of which must happen at startup.
+func lazyTypeInit(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Interface:
+		return false
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if t.Field(i).Embedded() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (fc *funcContext) initArgs(ty types.Type) string {
 	switch t := ty.(type) {
 	case *types.Array:
@@ -646,12 +793,15 @@ func (fc *funcContext) translateToplevelFunction(fun *ast.FuncDecl, info *analys
 			return []byte(fmt.Sprintf("\t%s = function() {\n\t\t$throwRuntimeError(\"native function not implemented: %s\");\n\t};\n", funcRef, o.FullName()))
 		}
 
-		params, fun := translateFunction(fun.Type, recv, fun.Body, fc, sig, info, funcRef)
-		joinedParams = strings.Join(params, ", ")
-		return []byte(fmt.Sprintf("\t%s = %s;\n", funcRef, fun))
+		f := translateFunction(fun.Type, recv, fun.Body, fc, sig, info, funcRef)
+		joinedParams = strings.Join(f.Params, ", ")
+		return []byte(fmt.Sprintf("\t%s = %s;\n", funcRef, f.Print()))
 	}
 
 	code := bytes.NewBuffer(nil)
+	if text := fc.positionComment(fun.Pos()); text != "" {
+		fmt.Fprintf(code, "\t// %s\n", text)
+	}
 
 	if fun.Recv == nil {
 		funcRef := fc.objectName(o)
@@ -686,7 +836,8 @@ func (fc *funcContext) translateToplevelFunction(fun *ast.FuncDecl, info *analys
 			fmt.Fprintf(code, "\t$ptrType(%s).prototype.%s = function(%s) { return (new %s(this.$get())).%s(%s); };\n", typeName, funName, joinedParams, typeName, funName, joinedParams)
 			return code.Bytes()
 		}
-		return primaryFunction(fmt.Sprintf("$ptrType(%s).prototype.%s", typeName, funName))
+		code.Write(primaryFunction(fmt.Sprintf("$ptrType(%s).prototype.%s", typeName, funName)))
+		return code.Bytes()
 	}
 
 	value := "this.$get()"
@@ -698,7 +849,7 @@ func (fc *funcContext) translateToplevelFunction(fun *ast.FuncDecl, info *analys
 	return code.Bytes()
 }
 
-func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt, outerContext *funcContext, sig *types.Signature, info *analysis.FuncInfo, funcRef string) ([]string, string) {
+func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt, outerContext *funcContext, sig *types.Signature, info *analysis.FuncInfo, funcRef string) *linebuf.Func {
 	if info == nil {
 		panic("nil info")
 	}
@@ -713,6 +864,8 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 		flowDatas:   map[*types.Label]*flowData{nil: {}},
 		caseCounter: 1,
 		labelCases:  make(map[*types.Label]int),
+		// The code of the function follows that of the outer context.
+		lastPosComment: outerContext.lastPosComment,
 	}
 	for k, v := range outerContext.allVars {
 		c.allVars[k] = v
@@ -734,7 +887,7 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 		}
 	}
 
-	bodyOutput := string(c.CatchOutput(1, func() {
+	stmts := c.catchStmts(1, func() {
 		if len(c.Blocking) != 0 {
 			c.pkgCtx.Scopes[body] = c.pkgCtx.Scopes[typ]
 			c.handleEscapingVars(body)
@@ -763,79 +916,40 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 		if len(c.Flattened) != 0 && !endsWithReturn(body.List) {
 			c.translateStmt(&ast.ReturnStmt{}, nil)
 		}
-	}))
+	})
 
 	sort.Strings(c.localVars)
 
-	var prefix, suffix, functionName string
-
-	if len(c.Flattened) != 0 {
-		c.localVars = append(c.localVars, "$s")
-		prefix = prefix + " $s = 0;"
-	}
-
-	if c.HasDefer {
-		c.localVars = append(c.localVars, "$deferred")
-		suffix = " }" + suffix
-		if len(c.Blocking) != 0 {
-			suffix = " }" + suffix
-		}
-	}
-
-	if len(c.Blocking) != 0 {
-		c.localVars = append(c.localVars, "$r")
+	f := &linebuf.Func{
+		Params:    params,
+		Vars:      c.localVars,
+		Body:      stmts,
+		Indent:    c.pkgCtx.indentation,
+		Dialect:   c.pkgCtx.dialect(),
+		Flattened: len(c.Flattened) != 0,
+		Blocking:  len(c.Blocking) != 0,
+		Defer:     c.HasDefer,
+	}
+	// Only blocking functions refer to this, to resume from their frame,
+	// besides methods, which set funcRef.
+	f.Arrow = funcRef == "" && !f.Blocking
+	if f.Blocking {
+		f.FrameRef = funcRef
 		if funcRef == "" {
-			funcRef = "$b"
-			functionName = " $b"
-		}
-		var stores, loads string
-		for _, v := range c.localVars {
-			loads += fmt.Sprintf("%s = $f.%s; ", v, v)
-			stores += fmt.Sprintf("$f.%s = %s; ", v, v)
+			f.Name = "$b"
+			f.FrameRef = "$b"
 		}
-		prefix = prefix + " var $f, $c = false; if (this !== undefined && this.$blk !== undefined) { $f = this; $c = true; " + loads + "}"
-		suffix = " if ($f === undefined) { $f = { $blk: " + funcRef + " }; } " + stores + "return $f;" + suffix
 	}
-
-	if c.HasDefer {
-		prefix = prefix + " var $err = null; try {"
-		deferSuffix := " } catch(err) { $err = err;"
-		if len(c.Blocking) != 0 {
-			deferSuffix += " $s = -1;"
-		}
+	if f.Defer {
 		if c.resultNames == nil && c.sig.Results().Len() > 0 {
-			deferSuffix += fmt.Sprintf(" return%s;", c.translateResults(nil))
+			f.PanicResults = c.translateResults(nil)
 		}
-		deferSuffix += " } finally { $callDeferred($deferred, $err);"
 		if c.resultNames != nil {
-			deferSuffix += fmt.Sprintf(" if (!$curGoroutine.asleep) { return %s; }", c.translateResults(c.resultNames))
-		}
-		if len(c.Blocking) != 0 {
-			deferSuffix += " if($curGoroutine.asleep) {"
+			f.NamedResults = c.translateResults(c.resultNames)
 		}
-		suffix = deferSuffix + suffix
-	}
-
-	if len(c.Flattened) != 0 {
-		prefix = prefix + " s: while (true) { switch ($s) { case 0:"
-		suffix = " } return; }" + suffix
-	}
-
-	if c.HasDefer {
-		prefix = prefix + " $deferred = []; $deferred.index = $curGoroutine.deferStack.length; $curGoroutine.deferStack.push($deferred);"
-	}
-
-	if prefix != "" {
-		bodyOutput = strings.Repeat("\t", c.pkgCtx.indentation+1) + "/* */" + prefix + "\n" + bodyOutput
-	}
-	if suffix != "" {
-		bodyOutput = bodyOutput + strings.Repeat("\t", c.pkgCtx.indentation+1) + "/* */" + suffix + "\n"
-	}
-	if len(c.localVars) != 0 {
-		bodyOutput = fmt.Sprintf("%svar %s;\n", strings.Repeat("\t", c.pkgCtx.indentation+1), strings.Join(c.localVars, ", ")) + bodyOutput
 	}
 
 	c.pkgCtx.escapingVars = prevEV
 
-	return params, fmt.Sprintf("function%s(%s) {\n%s%s}", functionName, strings.Join(params, ", "), bodyOutput, strings.Repeat("\t", c.pkgCtx.indentation))
+	return f
 }
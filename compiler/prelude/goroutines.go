@@ -18,7 +18,7 @@ var $callDeferred = function(deferred, jsErr, fromPanic) {
   if (jsErr !== null) {
     var newErr = null;
     try {
-      $panic(new $jsErrorPtr(jsErr));
+      $panic($panicValueOf(jsErr));
     } catch (err) {
       newErr = err;
     }
@@ -46,9 +46,6 @@ var $callDeferred = function(deferred, jsErr, fromPanic) {
         if (deferred === undefined) {
           /* The panic reached the top of the stack. Clear it and throw it as a JavaScript error. */
           $panicStackDepth = null;
-          if (localPanicValue.Object instanceof Error) {
-            throw localPanicValue.Object;
-          }
           var msg;
           if (localPanicValue.constructor === $String) {
             msg = localPanicValue.$val;
@@ -59,7 +56,19 @@ var $callDeferred = function(deferred, jsErr, fromPanic) {
           } else {
             msg = localPanicValue;
           }
-          throw new Error(msg);
+          var hookMsg = $panicHook(localPanicValue, msg);
+          if (hookMsg === null) {
+            /* Handled by the hook. A goroutine exits, like with runtime.Goexit. A call from JavaScript
+               is unwound up to the function that JavaScript called, which returns normally. */
+            if ($curGoroutine === $noGoroutine) {
+              throw $panicHandled;
+            }
+            $curGoroutine.exit = true;
+            throw null;
+          }
+          var thrown = (localPanicValue.Object instanceof Error && hookMsg === msg) ? localPanicValue.Object : new Error(hookMsg);
+          $attachPanic(thrown, localPanicValue, msg);
+          throw thrown;
         }
       }
       var call = deferred.pop();
@@ -100,6 +109,68 @@ var $callDeferred = function(deferred, jsErr, fromPanic) {
   }
 };
 
+/* Describes the Go panic with the value value and the message msg for JavaScript. */
+var $panicInfo = function(value, msg) {
+  var info = {
+    message: String(msg),
+    type: value.constructor !== undefined ? value.constructor.string : "nil",
+    runtimeError: value.RuntimeError !== undefined,
+    goroutine: $curGoroutine.id,
+    value: undefined
+  };
+  try {
+    info.value = $externalize(value, $emptyInterface);
+  } catch (e) { /* not representable in JavaScript */ }
+  return info;
+};
+
+/* Passes a panic that reached the top of the stack of a goroutine, with the
+   message msg, to the global goPanic function, if the page defined one. The
+   hook returns true if it handled the panic, in which case null is returned,
+   or a string to replace msg with, e.g. a translation. */
+var $panicHook = function(value, msg) {
+  if (typeof $global.goPanic !== "function") {
+    return msg;
+  }
+  var r = $global.goPanic($panicInfo(value, msg));
+  if (r === true) {
+    return null;
+  }
+  return typeof r === "string" ? r : msg;
+};
+
+/* Unwinds the Go code called by JavaScript after a panic that the goPanic hook handled. */
+var $panicHandled = {};
+/* Rethrows err, caught in a function called by JavaScript, unless it unwinds a panic that the goPanic hook handled. */
+var $rethrowUnhandled = function(err) {
+  if (err !== $panicHandled) {
+    throw err;
+  }
+};
+
+/* Identifies the panics thrown to JavaScript by this program, whose Go values other programs can't use. */
+var $panicOwner = {};
+/* Attaches the Go panic with the value value and the message msg to the JavaScript error err it is thrown as: its
+   description as the goPanic property for JavaScript, and the value itself for $panicValueOf. */
+var $attachPanic = function(err, value, msg) {
+  if (value.constructor === $jsErrorPtr || err.goPanic !== undefined) {
+    return; /* Thrown by JavaScript in the first place, or already attached. */
+  }
+  try {
+    Object.defineProperty(err, "goPanic", { value: $panicInfo(value, msg), configurable: true });
+    Object.defineProperty(err, "$goPanicValue", { value: { owner: $panicOwner, value: value }, configurable: true });
+  } catch (e) { /* err is frozen */ }
+};
+/* Returns the Go value of a JavaScript exception caught by Go code: the original value if it was a panic of this
+   program that went through JavaScript, or a *js.Error otherwise. */
+var $panicValueOf = function(jsErr) {
+  var p = (jsErr !== undefined && jsErr !== null) ? jsErr.$goPanicValue : undefined;
+  if (p !== undefined && p.owner === $panicOwner) {
+    return p.value;
+  }
+  return new $jsErrorPtr(jsErr);
+};
+
 var $panic = function(value) {
   $curGoroutine.panicStack.push(value);
   $callDeferred(null, null, true);
@@ -116,14 +187,22 @@ var $throw = function(err) { throw err; };
 var $noGoroutine = { asleep: false, exit: false, deferStack: [], panicStack: [] };
 var $curGoroutine = $noGoroutine, $totalGoroutines = 0, $awakeGoroutines = 0, $checkForDeadlock = true, $exportedFunctions = 0;
 var $mainFinished = false;
+var $lifecycle = null; /* set by programs linked with start and stop functions, see compiler.startStopRuntime */
+/* Goroutines that haven't exited yet by ID, see runtime.Stack. */
+var $goroutines = {}, $lastGoroutineID = 0;
+var $trace = null; /* set while tracing, see runtime/trace.Start */
 var $go = function(fun, args) {
   $totalGoroutines++;
   $awakeGoroutines++;
   var $goroutine = function() {
+    if ($trace !== null) {
+      $trace.start($goroutine.id);
+    }
     try {
       $curGoroutine = $goroutine;
       var r = fun.apply(undefined, args);
       if (r && r.$blk !== undefined) {
+        $goroutine.frame = r;
         fun = function() { return r.$blk(); };
         args = [];
         return;
@@ -131,6 +210,7 @@ var $go = function(fun, args) {
       $goroutine.exit = true;
     } catch (err) {
       if (!$goroutine.exit) {
+        $goroutine.panicked = true; /* see runtime.writeCrashReport */
         throw err;
       }
     } finally {
@@ -138,8 +218,21 @@ var $go = function(fun, args) {
       if ($goroutine.exit) { /* also set by runtime.Goexit() */
         $totalGoroutines--;
         $goroutine.asleep = true;
+        delete $goroutines[$goroutine.id];
+        if ($goroutine.bubble !== undefined) {
+          $goroutine.bubble.total--;
+        }
+      }
+      if ($trace !== null) {
+        $trace.stop($goroutine.id, $goroutine.exit, $goroutine.asleep);
       }
       if ($goroutine.asleep) {
+        if ($goroutine.bubble !== undefined) {
+          $goroutine.bubble.awake--;
+          if ($goroutine.bubble.awake === 0) {
+            $goroutine.bubble.idle();
+          }
+        }
         $awakeGoroutines--;
         if (!$mainFinished && $awakeGoroutines === 0 && $checkForDeadlock && $exportedFunctions === 0) {
           console.error("fatal error: all goroutines are asleep - deadlock!");
@@ -154,6 +247,17 @@ var $go = function(fun, args) {
   $goroutine.exit = false;
   $goroutine.deferStack = [];
   $goroutine.panicStack = [];
+  $goroutine.id = ++$lastGoroutineID;
+  $goroutines[$goroutine.id] = $goroutine;
+  if ($trace !== null) {
+    $trace.create($goroutine.id);
+  }
+  /* Goroutines belong to the synctest bubble of the goroutine that started them, see time.synctestStart. */
+  $goroutine.bubble = $curGoroutine.bubble;
+  if ($goroutine.bubble !== undefined) {
+    $goroutine.bubble.total++;
+    $goroutine.bubble.awake++;
+  }
   $schedule($goroutine);
 };
 
@@ -166,7 +270,7 @@ var $runScheduled = function() {
     }
   } finally {
     if ($scheduled.length > 0) {
-      setTimeout($runScheduled, 0);
+      $yield($runScheduled);
     }
   }
 };
@@ -175,6 +279,9 @@ var $schedule = function(goroutine) {
   if (goroutine.asleep) {
     goroutine.asleep = false;
     $awakeGoroutines++;
+    if (goroutine.bubble !== undefined) {
+      goroutine.bubble.awake++;
+    }
   }
   $scheduled.push(goroutine);
   if ($curGoroutine === $noGoroutine) {
@@ -182,18 +289,163 @@ var $schedule = function(goroutine) {
   }
 };
 
+/* The monotonic clock in nanoseconds since its first reading, see time.runtimeNano. Unlike Date.now(), performance.now()
+   isn't affected by changes of the system clock, and has sub-millisecond resolution. Starting from the first reading
+   keeps the values small enough to be exact as numbers. */
+var $nanotimeOrigin = null, $nanotimeLast = 0;
+var $nanotime = function() {
+  var perf = $global.performance;
+  var ms = (perf !== undefined && typeof perf.now === "function") ? perf.now() : Date.now();
+  if ($nanotimeOrigin === null) {
+    $nanotimeOrigin = ms;
+  }
+  var ns = Math.round((ms - $nanotimeOrigin) * 1e6);
+  if (ns < $nanotimeLast) {
+    ns = $nanotimeLast; /* Date.now() goes back when the system clock is set back. */
+  }
+  $nanotimeLast = ns;
+  return ns;
+};
+
 var $setTimeout = function(f, t) {
   $awakeGoroutines++;
-  return setTimeout(function() {
+  var timeout = { id: null, done: false };
+  var fire = function() {
+    if (timeout.done) {
+      return; /* Cleared while waiting for the animation frame. */
+    }
+    timeout.done = true;
     $awakeGoroutines--;
     f();
+  };
+  timeout.id = setTimeout(function() {
+    if ($yieldMode === "frame") {
+      $onAnimationFrame(fire);
+      return;
+    }
+    fire();
   }, t);
+  return timeout;
+};
+var $clearTimeout = function(timeout) {
+  if (timeout.done) {
+    return;
+  }
+  timeout.done = true;
+  $awakeGoroutines--;
+  clearTimeout(timeout.id);
 };
 
-var $block = function() {
-  if ($curGoroutine === $noGoroutine) {
-    $throwRuntimeError("cannot block in JavaScript callback, fix by wrapping code in goroutine");
+/* Functions to call at the next animation frame, and whether to wait for it while the page is hidden,
+   see runtime/jsruntime.SetBackgroundPolicy. */
+var $frameBatch = null, $framePauseInBackground = false, $frameVisibilityListener = false;
+var $pageHidden = function() {
+  return typeof document !== "undefined" && document.hidden === true;
+};
+var $onAnimationFrame = function(f) {
+  if ($frameBatch === null) {
+    var batch = $frameBatch = [];
+    batch.run = function() {
+      if ($frameBatch !== batch) {
+        return; /* Already run. */
+      }
+      $frameBatch = null;
+      for (var i = 0; i < batch.length; i++) {
+        batch[i]();
+      }
+    };
+    if (typeof requestAnimationFrame !== "function" || ($pageHidden() && !$framePauseInBackground)) {
+      setTimeout(batch.run, 0);
+    } else {
+      requestAnimationFrame(batch.run);
+    }
+    if (!$frameVisibilityListener && typeof document !== "undefined" && typeof document.addEventListener === "function") {
+      /* Browsers don't run animation frames of hidden pages, so don't wait for one that was requested before. */
+      $frameVisibilityListener = true;
+      document.addEventListener("visibilitychange", function() {
+        if ($pageHidden() && !$framePauseInBackground && $frameBatch !== null) {
+          setTimeout($frameBatch.run, 0);
+        }
+      });
+    }
+  }
+  $frameBatch.push(f);
+};
+
+/* How goroutines yield to the host, see runtime/jsruntime.SetYieldMode: "timeout", "microtask", "message" or "frame". */
+var $yieldMode = "timeout", $yielded = [], $yieldChannel = null;
+/* Calls f after yielding to the host, unless $flushYielded calls it first. */
+var $yield = function(f) {
+  $awakeGoroutines++;
+  var resume = function() {
+    var i = $yielded.indexOf(resume);
+    if (i === -1) {
+      return; /* Already called by $flushYielded. */
+    }
+    $yielded.splice(i, 1);
+    $awakeGoroutines--;
+    f();
+  };
+  resume.f = f;
+  $yielded.push(resume);
+  if ($yieldMode === "microtask") {
+    if (typeof queueMicrotask === "function") {
+      queueMicrotask(resume);
+    } else {
+      Promise.resolve().then(resume);
+    }
+    return;
+  }
+  if ($yieldMode === "frame") {
+    $onAnimationFrame(resume);
+    return;
   }
+  if ($yieldMode === "message" && typeof MessageChannel === "function") {
+    /* Unlike setTimeout, messages aren't delayed by the 4ms clamping of nested timeouts. */
+    if ($yieldChannel === null) {
+      $yieldChannel = new MessageChannel();
+      $yieldChannel.queue = [];
+      $yieldChannel.port1.onmessage = function() {
+        var next = $yieldChannel.queue.shift();
+        if ($yieldChannel.queue.length === 0 && $yieldChannel.port1.unref !== undefined) {
+          $yieldChannel.port1.unref(); /* Let Node.js exit while there is nothing to resume. */
+        }
+        next();
+      };
+    }
+    if ($yieldChannel.port1.ref !== undefined) {
+      $yieldChannel.port1.ref();
+    }
+    $yieldChannel.queue.push(resume);
+    $yieldChannel.port2.postMessage(null);
+    return;
+  }
+  setTimeout(resume, 0);
+};
+/* Calls the functions passed to $yield so far right away, see runtime/jsruntime.Flush. */
+var $flushYielded = function() {
+  var pending = $yielded;
+  $yielded = [];
+  for (var i = 0; i < pending.length; i++) {
+    $awakeGoroutines--;
+    pending[i].f();
+  }
+};
+
+/* Operations that may block call $checkCanBlock with their description before changing any state, so that
+   blocking in code called synchronously by JavaScript fails right away and leaves channels and the scheduler intact. */
+var $checkCanBlock = function(op) {
+  if ($curGoroutine !== $noGoroutine) {
+    return;
+  }
+  var stack = new Error().stack;
+  stack = (stack === undefined) ? "" : "\n\ncallback stack, innermost call first:\n" + stack.split("\n").slice(2).join("\n");
+  $throwRuntimeError("cannot block in JavaScript callback: " + op + " would block, but the callback was called synchronously by JavaScript, so there is no goroutine to suspend.\n" +
+    "Fix by running the blocking code in a new goroutine, e.g. go func() { ... }(), and passing its results back through a channel or a JavaScript callback or promise, which js.FuncOf(fn, js.Async) does for you." + stack);
+};
+
+var $block = function() {
+  $checkCanBlock("an operation");
   $curGoroutine.asleep = true;
 };
 
@@ -211,6 +463,7 @@ var $send = function(chan, value) {
     return;
   }
 
+  $checkCanBlock("channel send");
   var thisGoroutine = $curGoroutine;
   var closedDuringSend;
   chan.$sendQueue.push(function(closed) {
@@ -230,6 +483,10 @@ var $send = function(chan, value) {
 var $recv = function(chan) {
   var queuedSend = chan.$sendQueue.shift();
   if (queuedSend !== undefined) {
+    if (chan.$buffer.length === 0) {
+      /* Unbuffered channel, take the value directly from the sender. */
+      return [queuedSend(false), true];
+    }
     chan.$buffer.push(queuedSend(false));
   }
   var bufferedValue = chan.$buffer.shift();
@@ -240,6 +497,7 @@ var $recv = function(chan) {
     return [chan.$elem.zero(), false];
   }
 
+  $checkCanBlock("channel receive");
   var thisGoroutine = $curGoroutine;
   var f = { $blk: function() { return this.value; } };
   var queueEntry = function(v) {
@@ -271,33 +529,37 @@ var $close = function(chan) {
   }
 };
 var $select = function(comms) {
-  var ready = [];
-  var selection = -1;
+  var ready = 0;
+  var selection = -1, defaultCase = -1;
   for (var i = 0; i < comms.length; i++) {
     var comm = comms[i];
     var chan = comm[0];
+    var isReady = false;
     switch (comm.length) {
     case 0: /* default */
-      selection = i;
+      defaultCase = i;
       break;
     case 1: /* recv */
-      if (chan.$sendQueue.length !== 0 || chan.$buffer.length !== 0 || chan.$closed) {
-        ready.push(i);
-      }
+      isReady = chan.$sendQueue.length !== 0 || chan.$buffer.length !== 0 || chan.$closed;
       break;
     case 2: /* send */
       if (chan.$closed) {
         $throwRuntimeError("send on closed channel");
       }
-      if (chan.$recvQueue.length !== 0 || chan.$buffer.length < chan.$capacity) {
-        ready.push(i);
-      }
+      isReady = chan.$recvQueue.length !== 0 || chan.$buffer.length < chan.$capacity;
       break;
     }
+    /* Pick a ready case uniformly at random without collecting them, the i-th ready one replaces the selection with probability 1/i. */
+    if (isReady) {
+      ready++;
+      if (ready === 1 || Math.random() * ready < 1) {
+        selection = i;
+      }
+    }
   }
 
-  if (ready.length !== 0) {
-    selection = ready[Math.floor(Math.random() * ready.length)];
+  if (selection === -1) {
+    selection = defaultCase;
   }
   if (selection !== -1) {
     var comm = comms[selection];
@@ -312,17 +574,13 @@ var $select = function(comms) {
     }
   }
 
+  $checkCanBlock("select");
   var entries = [];
   var thisGoroutine = $curGoroutine;
   var f = { $blk: function() { return this.selection; } };
   var removeFromQueues = function() {
     for (var i = 0; i < entries.length; i++) {
-      var entry = entries[i];
-      var queue = entry[0];
-      var index = queue.indexOf(entry[1]);
-      if (index !== -1) {
-        queue.splice(index, 1);
-      }
+      entries[i][0].remove(entries[i][1]);
     }
   };
   for (var i = 0; i < comms.length; i++) {
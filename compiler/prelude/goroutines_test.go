@@ -0,0 +1,79 @@
+package prelude
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestPanicHookHandledFromJS(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("Node.js is not installed")
+	}
+
+	// A panic handled by goPanic in Go code called by JavaScript returns to the
+	// caller, without marking the shared $noGoroutine as exited.
+	const script = `
+var panics = [];
+$global.goPanic = function(panic) {
+  panics.push(panic.message);
+  return panic.message !== "unhandled";
+};
+var deferredCalls = 0;
+var fail = function(msg) {
+  return function() {
+    var $deferred, $err = null;
+    try {
+      $deferred = []; $deferred.index = $curGoroutine.deferStack.length; $curGoroutine.deferStack.push($deferred);
+      $deferred.push([function() { deferredCalls++; }, []]);
+      $panic(new $String(msg));
+      return 1;
+    } catch (err) {
+      $err = err;
+      return 0;
+    } finally {
+      $callDeferred($deferred, $err);
+    }
+  };
+};
+var externalize = function(msg) {
+  return $externalize(fail(msg), $funcType([], [$Int], false));
+};
+if (externalize("exported")() !== undefined) {
+  throw new Error("exported function returned a result after a handled panic");
+}
+var $jsObjectPtr = $ptrType($newType(0, $kindStruct, "js.Object", true, "github.com/gopherjs/gopherjs/js", true, function() {})); /* Defined by the js package. */
+var made = $makeFunc(function(self, args) { return fail("made")(); });
+if (made() !== undefined) {
+  throw new Error("MakeFunc function returned a result after a handled panic");
+}
+if ($curGoroutine !== $noGoroutine || $noGoroutine.exit || $noGoroutine.deferStack.length !== 0) {
+  throw new Error("handled panic changed the state of $noGoroutine");
+}
+var thrown = null;
+try {
+  externalize("unhandled")();
+} catch (err) {
+  thrown = err;
+}
+if (!(thrown instanceof Error) || thrown.message !== "unhandled") {
+  throw new Error("unhandled panic wasn't thrown as an error: " + thrown);
+}
+if (deferredCalls !== 3) {
+  throw new Error("deferred function called " + deferredCalls + " times, want 3");
+}
+if (panics.join(",") !== "exported,made,unhandled") {
+  throw new Error("goPanic called with " + panics.join(","));
+}
+`
+
+	for name, prelude := range map[string]string{"Prelude": Prelude, "Minified": Minified} {
+		t.Run(name, func(t *testing.T) {
+			cmd := exec.Command("node")
+			cmd.Stdin = strings.NewReader(prelude + script)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("node: %v\n%s", err, out)
+			}
+		})
+	}
+}
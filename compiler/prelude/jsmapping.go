@@ -158,7 +158,13 @@ var $externalizeFunction = function(v, t, passThis) {
         }
         args.push($internalize(arguments[i], t.params[i]));
       }
-      var result = v.apply(passThis ? this : undefined, args);
+      var result;
+      try {
+        result = v.apply(passThis ? this : undefined, args);
+      } catch (err) {
+        $rethrowUnhandled(err);
+        return;
+      }
       switch (t.results.length) {
       case 0:
         return;
@@ -183,6 +189,9 @@ var $internalize = function(v, t, recv, seen) {
     $throwRuntimeError("cannot internalize js.Object, use *js.Object instead");
   }
   if (v && v.__internal_object__ !== undefined) {
+    if (v.__internal_runtime__ !== $runtime) {
+      $throwRuntimeError("cannot internalize " + t.string + " wrapped by js.MakeWrapper in another GopherJS program");
+    }
     return $assertType(v.__internal_object__, t, false);
   }
   var timePkg = $packages["time"];
@@ -223,6 +232,7 @@ var $internalize = function(v, t, recv, seen) {
   case $kindUint64:
     return new t(0, v);
   case $kindFloat32:
+    return $fround(parseFloat(v));
   case $kindFloat64:
     return parseFloat(v);
   case $kindArray:
@@ -37,6 +37,20 @@ var $flatten64 = function(x) {
   return x.$high * 4294967296 + x.$low;
 };
 
+var $fround64 = function(x) {
+  var high = x.$high, low = x.$low;
+  if (high < 0) {
+    return -$fround64(new $Uint64(-high - (low !== 0 ? 1 : 0), -low >>> 0));
+  }
+  if (high >= 0x200000) {
+    /* The value has more than 53 significant bits, so flattening it would
+       round it once before $fround does. Bits below bit 29 only break ties
+       in the float32 result, so they are collapsed into bit 28. */
+    low = ((low & 0xE0000000) | ((low & 0x1FFFFFFF) !== 0 ? 0x10000000 : 0)) >>> 0;
+  }
+  return $fround(high * 4294967296 + low);
+};
+
 var $shiftLeft64 = function(x, y) {
   if (y === 0) {
     return x;
@@ -163,34 +177,41 @@ var $div64 = function(x, y, returnRemainder) {
 };
 
 var $divComplex = function(n, d) {
-  var ninf = n.$real === Infinity || n.$real === -Infinity || n.$imag === Infinity || n.$imag === -Infinity;
-  var dinf = d.$real === Infinity || d.$real === -Infinity || d.$imag === Infinity || d.$imag === -Infinity;
-  var nnan = !ninf && (n.$real !== n.$real || n.$imag !== n.$imag);
-  var dnan = !dinf && (d.$real !== d.$real || d.$imag !== d.$imag);
-  if(nnan || dnan) {
-    return new n.constructor(NaN, NaN);
-  }
-  if (ninf && !dinf) {
-    return new n.constructor(Infinity, Infinity);
-  }
-  if (!ninf && dinf) {
-    return new n.constructor(0, 0);
-  }
-  if (d.$real === 0 && d.$imag === 0) {
-    if (n.$real === 0 && n.$imag === 0) {
-      return new n.constructor(NaN, NaN);
+  /* Smith's algorithm with the C99 corrections to infinities and zeros, like
+     complex128div of the gc runtime. Complex64 values are divided with
+     float64 precision too, and rounded by the constructor. */
+  var a = n.$real, b = n.$imag, c = d.$real, e = d.$imag, x, y, ratio, denom;
+  if (Math.abs(c) >= Math.abs(e)) {
+    ratio = e / c;
+    denom = c + ratio * e;
+    x = (a + b * ratio) / denom;
+    y = (b - a * ratio) / denom;
+  } else {
+    ratio = c / e;
+    denom = e + ratio * c;
+    x = (a * ratio + b) / denom;
+    y = (b * ratio - a) / denom;
+  }
+  if (x !== x && y !== y) {
+    var isInf = function(f) { return f === Infinity || f === -Infinity; };
+    var isFinite = function(f) { return f === f && !isInf(f); };
+    var inf2one = function(f) { return (f < 0 || 1 / f < 0 ? -1 : 1) * (isInf(f) ? 1 : 0); };
+    if (c === 0 && e === 0 && (a === a || b === b)) {
+      var inf = c < 0 || 1 / c < 0 ? -Infinity : Infinity;
+      x = inf * a;
+      y = inf * b;
+    } else if ((isInf(a) || isInf(b)) && isFinite(c) && isFinite(e)) {
+      a = inf2one(a);
+      b = inf2one(b);
+      x = Infinity * (a * c + b * e);
+      y = Infinity * (b * c - a * e);
+    } else if ((isInf(c) || isInf(e)) && isFinite(a) && isFinite(b)) {
+      c = inf2one(c);
+      e = inf2one(e);
+      x = 0 * (a * c + b * e);
+      y = 0 * (b * c - a * e);
     }
-    return new n.constructor(Infinity, Infinity);
-  }
-  var a = Math.abs(d.$real);
-  var b = Math.abs(d.$imag);
-  if (a <= b) {
-    var ratio = d.$real / d.$imag;
-    var denom = d.$real * ratio + d.$imag;
-    return new n.constructor((n.$real * ratio + n.$imag) / denom, (n.$imag * ratio - n.$real) / denom);
-  }
-  var ratio = d.$imag / d.$real;
-  var denom = d.$imag * ratio + d.$real;
-  return new n.constructor((n.$imag * ratio + n.$real) / denom, (n.$imag - n.$real * ratio) / denom);
+  }
+  return new n.constructor(x, y);
 };
 `
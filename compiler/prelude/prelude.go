@@ -27,12 +27,30 @@ if (typeof module !== "undefined") {
 }
 var $linknames = {} // Collection of functions referenced by a go:linkname directive.
 var $packages = {}, $idCounter = 0;
+/* Identity of this program. All runtime state is local to the program, so
+   several GopherJS programs can be loaded in the same JavaScript environment;
+   values wrapped by js.MakeWrapper are tagged with it, see $internalize. */
+var $runtime = {};
 var $keys = function(m) { return m ? Object.keys(m) : []; };
 var $flushConsole = function() {};
 var $throwRuntimeError; /* set by package "runtime" */
 var $throwNilPointerError = function() { $throwRuntimeError("invalid memory address or nil pointer dereference"); };
+/* Returns a function that throws a nil pointer dereference error which
+   describes the failed operation, like "accessing field Name of nil *main.T".
+   The description is only built when the error is thrown. */
+var $nilDereference = function(describe) {
+  return function() { $throwRuntimeError("invalid memory address or nil pointer dereference: " + describe()); };
+};
 var $call = function(fn, rcvr, args) { return fn.apply(rcvr, args); };
-var $makeFunc = function(fn) { return function() { return $externalize(fn(this, new ($sliceType($jsObjectPtr))($global.Array.prototype.slice.call(arguments, []))), $emptyInterface); }; };
+var $makeFunc = function(fn) {
+  return function() {
+    try {
+      return $externalize(fn(this, new ($sliceType($jsObjectPtr))($global.Array.prototype.slice.call(arguments, []))), $emptyInterface);
+    } catch (err) {
+      $rethrowUnhandled(err);
+    }
+  };
+};
 var $unused = function(v) {};
 var $print = console.log;
 // Under Node we can emulate print() more closely by avoiding a newline.
@@ -243,6 +261,25 @@ var $bytesToString = function(slice) {
   return str;
 };
 
+/* Compares strings, each of which may be given as a byte slice instead, see compiler.bytesToStringOperand. */
+var $bytesEqualString = function(a, b) {
+  if (typeof a === "string") {
+    var t = a; a = b; b = t;
+  }
+  if (typeof b !== "string") {
+    b = $bytesToString(b);
+  }
+  if (a.$length !== b.length) {
+    return false;
+  }
+  for (var i = 0; i < b.length; i++) {
+    if (a.$array[a.$offset + i] !== b.charCodeAt(i)) {
+      return false;
+    }
+  }
+  return true;
+};
+
 var $stringToRunes = function(str) {
   var array = new Int32Array(str.length);
   var rune, j = 0;
@@ -283,7 +320,8 @@ var $copyArray = function(dst, src, dstOffset, srcOffset, n, elem) {
     return;
   }
 
-  if (src.subarray) {
+  /* TypedArray.prototype.set has a fixed cost that small copies don't make up for, they use the loops below. */
+  if (src.subarray && n > 32) {
     dst.set(src.subarray(srcOffset, srcOffset + n), dstOffset);
     return;
   }
@@ -392,6 +430,51 @@ var $internalAppend = function(slice, array, offset, length) {
   return newSlice;
 };
 
+/* The functions below are specialized versions of $append and $appendSlice for byte slices, see compiler.translateBuiltin. */
+var $appendBytes = function(slice) {
+  var length = slice.$length, n = arguments.length - 1;
+  slice = $extendBytes(slice, n);
+  var array = slice.$array, offset = slice.$offset + length;
+  for (var i = 0; i < n; i++) {
+    array[offset + i] = arguments[i + 1];
+  }
+  return slice;
+};
+
+var $appendString = function(slice, str) {
+  if (str.length === 0) {
+    return slice;
+  }
+  var length = slice.$length;
+  slice = $extendBytes(slice, str.length);
+  var array = slice.$array, offset = slice.$offset + length;
+  for (var i = 0; i < str.length; i++) {
+    array[offset + i] = str.charCodeAt(i);
+  }
+  return slice;
+};
+
+/* Returns slice with its length increased by n, reallocating like $internalAppend if needed. Setting the new elements is left to the caller. */
+var $extendBytes = function(slice, n) {
+  var newArray = slice.$array;
+  var newOffset = slice.$offset;
+  var newLength = slice.$length + n;
+  var newCapacity = slice.$capacity;
+
+  if (newLength > newCapacity) {
+    newOffset = 0;
+    newCapacity = Math.max(newLength, slice.$capacity < 1024 ? slice.$capacity * 2 : Math.floor(slice.$capacity * 5 / 4));
+    newArray = new Uint8Array(newCapacity);
+    newArray.set(slice.$array.subarray(slice.$offset, slice.$offset + slice.$length));
+  }
+
+  var newSlice = new slice.constructor(newArray);
+  newSlice.$offset = newOffset;
+  newSlice.$length = newLength;
+  newSlice.$capacity = newCapacity;
+  return newSlice;
+};
+
 var $equal = function(a, b, type) {
   if (type === $jsObjectPtr) {
     return a === b;
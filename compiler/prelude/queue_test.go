@@ -0,0 +1,66 @@
+package prelude
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("Node.js is not installed")
+	}
+
+	// Compares $Queue against an array, with enough items to wrap around the
+	// ring buffer and grow it several times.
+	const script = `
+var q = new $Queue(), want = [], next = 0;
+var check = function(op) {
+  if (q.length !== want.length) {
+    throw new Error(op + ": length " + q.length + ", want " + want.length);
+  }
+};
+for (var round = 0; round < 50; round++) {
+  for (var i = 0; i < round % 7 + 1; i++) {
+    q.push(next);
+    want.push(next);
+    next++;
+    check("push");
+  }
+  if (round % 5 === 0 && want.length > 2) {
+    var item = want[Math.floor(want.length / 2)];
+    q.remove(item);
+    want.splice(want.indexOf(item), 1);
+    check("remove");
+  }
+  q.remove(-1);
+  check("remove of missing item");
+  for (var i = 0; i < round % 4; i++) {
+    var got = q.shift(), w = want.shift();
+    if (got !== w) {
+      throw new Error("shift: got " + got + ", want " + w);
+    }
+    check("shift");
+  }
+}
+while (want.length !== 0) {
+  var got = q.shift(), w = want.shift();
+  if (got !== w) {
+    throw new Error("final shift: got " + got + ", want " + w);
+  }
+}
+if (q.shift() !== undefined || q.length !== 0) {
+  throw new Error("shift of empty queue");
+}
+`
+
+	for name, prelude := range map[string]string{"Prelude": Prelude, "Minified": Minified} {
+		t.Run(name, func(t *testing.T) {
+			cmd := exec.Command("node")
+			cmd.Stdin = strings.NewReader(prelude + script)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("node: %v\n%s", err, out)
+			}
+		})
+	}
+}
@@ -41,6 +41,80 @@ var $synthesizeMethods = function() {
   $methodSynthesizers = null;
 };
 
+/* $lazyMethods defers construction of a named type's method list until it is first accessed. */
+var $lazyMethods = function(typ, getter) {
+  var define = function(methods) {
+    Object.defineProperty(typ, "methods", { configurable: true, enumerable: true, writable: true, value: methods });
+  };
+  Object.defineProperty(typ, "methods", {
+    configurable: true,
+    enumerable: true,
+    get: function() {
+      var methods = getter();
+      define(methods);
+      return methods;
+    },
+    set: define
+  });
+};
+
+/* $lazyInit defers init, which sets up the element types, fields or signature of a named type, until one of the properties that it sets is first accessed. */
+var $lazyInit = function(typ, init) {
+  var props;
+  switch (typ.kind) {
+  case $kindArray:
+    props = [[typ, "elem"], [typ, "len"], [typ, "comparable"], [typ, "keyFor"], [typ, "copy"], [typ.ptr, "elem"], [typ.ptr, "wrapped"], [typ.ptr, "nil"]];
+    break;
+  case $kindChan:
+    props = [[typ, "elem"], [typ, "sendOnly"], [typ, "recvOnly"]];
+    break;
+  case $kindFunc:
+    props = [[typ, "params"], [typ, "results"], [typ, "variadic"], [typ, "comparable"]];
+    break;
+  case $kindMap:
+    props = [[typ, "key"], [typ, "elem"], [typ, "comparable"]];
+    break;
+  case $kindPtr:
+    props = [[typ, "elem"], [typ, "wrapped"], [typ, "nil"]];
+    break;
+  case $kindSlice:
+    props = [[typ, "elem"], [typ, "comparable"], [typ, "nativeArray"], [typ, "nil"]];
+    break;
+  case $kindStruct:
+    props = [[typ, "pkgPath"], [typ, "fields"], [typ, "comparable"], [typ, "keyFor"], [typ, "copy"], [typ.ptr, "nil"]];
+    break;
+  default:
+    $panic(new $String("invalid kind for lazy init: " + typ.kind));
+  }
+  var pending = props.map(function(p) { return p.concat([p[0][p[1]]]); });
+  var run = function() {
+    if (pending === null) {
+      return;
+    }
+    var restore = pending;
+    pending = null;
+    restore.forEach(function(p) {
+      Object.defineProperty(p[0], p[1], { configurable: true, enumerable: true, writable: true, value: p[2] });
+    });
+    init();
+  };
+  props.forEach(function(p) {
+    var obj = p[0], name = p[1];
+    Object.defineProperty(obj, name, {
+      configurable: true,
+      enumerable: true,
+      get: function() {
+        run();
+        return obj[name];
+      },
+      set: function(v) {
+        run();
+        obj[name] = v;
+      }
+    });
+  });
+};
+
 var $ifaceKeyFor = function(x) {
   if (x === $ifaceNil) {
     return 'nil';
@@ -61,6 +135,8 @@ var $idKey = function(x) {
   return String(x.$id);
 };
 
+var $heapNamed = null; /* set in heap names mode, see compiler.heapNamesRuntime */
+
 var $newType = function(size, kind, string, named, pkg, exported, constructor) {
   var typ;
   switch(kind) {
@@ -117,7 +193,7 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
       this.$imag = $fround(imag);
       this.$val = this;
     };
-    typ.keyFor = function(x) { return x.$real + "$" + x.$imag; };
+    typ.keyFor = function(x) { return $floatKey(x.$real) + "$" + $floatKey(x.$imag); };
     break;
 
   case $kindComplex128:
@@ -126,7 +202,7 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
       this.$imag = imag;
       this.$val = this;
     };
-    typ.keyFor = function(x) { return x.$real + "$" + x.$imag; };
+    typ.keyFor = function(x) { return $floatKey(x.$real) + "$" + $floatKey(x.$imag); };
     break;
 
   case $kindArray:
@@ -150,7 +226,7 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
         $copyArray(dst, src, 0, 0, src.length, elem);
       };
       typ.ptr.init(typ);
-      Object.defineProperty(typ.ptr.nil, "nilCheck", { get: $throwNilPointerError });
+      Object.defineProperty(typ.ptr.nil, "nilCheck", { get: $nilDereference(function() { return "indexing nil " + $typeFullName(typ.ptr); }) });
     };
     break;
 
@@ -182,7 +258,9 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
     typ.init = function(methods) {
       typ.methods = methods;
       methods.forEach(function(m) {
-        $ifaceNil[m.prop] = $throwNilPointerError;
+        if ($ifaceNil[m.prop] === undefined) {
+          $ifaceNil[m.prop] = $nilDereference(function() { return "calling method " + m.name + " of nil interface"; });
+        }
       });
     };
     break;
@@ -208,7 +286,8 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
     typ.init = function(elem) {
       typ.elem = elem;
       typ.wrapped = (elem.kind === $kindArray);
-      typ.nil = new typ($throwNilPointerError, $throwNilPointerError);
+      var throwNil = $nilDereference(function() { return "using nil " + $typeFullName(typ); });
+      typ.nil = new typ(throwNil, throwNil);
     };
     break;
 
@@ -269,7 +348,8 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
       /* nil value */
       var properties = {};
       fields.forEach(function(f) {
-        properties[f.prop] = { get: $throwNilPointerError, set: $throwNilPointerError };
+        var throwNil = $nilDereference(function() { return "accessing field " + f.name + " of nil " + $typeFullName(typ.ptr); });
+        properties[f.prop] = { get: throwNil, set: throwNil };
       });
       typ.ptr.nil = Object.create(constructor.prototype, properties);
       typ.ptr.nil.$val = typ.ptr.nil;
@@ -307,6 +387,10 @@ var $newType = function(size, kind, string, named, pkg, exported, constructor) {
     $panic(new $String("invalid kind: " + kind));
   }
 
+  if ($heapNamed !== null && typeof typ === "function") {
+    typ = $heapNamed(typ, string);
+  }
+
   switch (kind) {
   case $kindBool:
   case $kindMap:
@@ -541,19 +625,70 @@ var $chanType = function(elem, sendOnly, recvOnly) {
   }
   return typ;
 };
+/* $Queue is a FIFO queue backed by a ring buffer that doubles in size when full. Unlike with Array.prototype.shift, taking the first item takes constant time, which matters for channels with large buffers. */
+var $Queue = function() {
+  this.$items = new Array(4); /* length is always a power of two */
+  this.$head = 0;
+  this.length = 0;
+};
+$Queue.prototype.$resize = function(size) {
+  var items = this.$items, resized = new Array(size);
+  for (var i = 0; i < this.length; i++) {
+    resized[i] = items[(this.$head + i) & (items.length - 1)];
+  }
+  this.$items = resized;
+  this.$head = 0;
+};
+$Queue.prototype.push = function(item) {
+  if (this.length === this.$items.length) {
+    this.$resize(this.$items.length * 2);
+  }
+  var items = this.$items;
+  items[(this.$head + this.length) & (items.length - 1)] = item;
+  this.length++;
+};
+$Queue.prototype.shift = function() {
+  if (this.length === 0) {
+    return undefined;
+  }
+  var items = this.$items;
+  var item = items[this.$head];
+  items[this.$head] = undefined;
+  this.$head = (this.$head + 1) & (items.length - 1);
+  this.length--;
+  /* Release the memory of queues that were much longer once, like channel queues after a burst. */
+  if (items.length > 64 && this.length <= items.length >> 2) {
+    this.$resize(items.length >> 1);
+  }
+  return item;
+};
+$Queue.prototype.remove = function(item) {
+  var items = this.$items, mask = items.length - 1;
+  for (var i = 0; i < this.length; i++) {
+    if (items[(this.$head + i) & mask] === item) {
+      for (; i < this.length - 1; i++) {
+        items[(this.$head + i) & mask] = items[(this.$head + i + 1) & mask];
+      }
+      items[(this.$head + i) & mask] = undefined;
+      this.length--;
+      return;
+    }
+  }
+};
+
 var $Chan = function(elem, capacity) {
   if (capacity < 0 || capacity > 2147483647) {
     $throwRuntimeError("makechan: size out of range");
   }
   this.$elem = elem;
   this.$capacity = capacity;
-  this.$buffer = [];
-  this.$sendQueue = [];
-  this.$recvQueue = [];
+  this.$buffer = new $Queue();
+  this.$sendQueue = new $Queue();
+  this.$recvQueue = new $Queue();
   this.$closed = false;
 };
 var $chanNil = new $Chan(null, 0);
-$chanNil.$sendQueue = $chanNil.$recvQueue = { length: 0, push: function() {}, shift: function() { return undefined; }, indexOf: function() { return -1; } };
+$chanNil.$sendQueue = $chanNil.$recvQueue = { length: 0, push: function() {}, shift: function() { return undefined; }, remove: function() {} };
 
 var $funcTypes = {};
 var $funcType = function(params, results, variadic) {
@@ -701,6 +836,63 @@ var $structType = function(pkgPath, fields) {
   return typ;
 };
 
+/* Returns the name of type with the full paths of the packages of named types,
+   like "*net/http.Client", rather than their names, like type.string. */
+var $typeFullName = function(type) {
+  if (type.named) {
+    return type.pkg === "" ? type.string : type.pkg + type.string.substr(type.string.indexOf("."));
+  }
+  switch (type.kind) {
+  case $kindPtr:
+    return "*" + $typeFullName(type.elem);
+  case $kindSlice:
+    return "[]" + $typeFullName(type.elem);
+  case $kindArray:
+    return "[" + type.len + "]" + $typeFullName(type.elem);
+  case $kindMap:
+    return "map[" + $typeFullName(type.key) + "]" + $typeFullName(type.elem);
+  case $kindChan:
+    var elem = $typeFullName(type.elem);
+    if (!type.sendOnly && !type.recvOnly && elem[0] == "<") {
+      elem = "(" + elem + ")";
+    }
+    return (type.recvOnly ? "<-" : "") + "chan" + (type.sendOnly ? "<- " : " ") + elem;
+  case $kindFunc:
+    var params = $mapArray(type.params, $typeFullName);
+    if (type.variadic) {
+      params[params.length - 1] = "..." + params[params.length - 1].substr(2);
+    }
+    var results = $mapArray(type.results, $typeFullName);
+    var string = "func(" + params.join(", ") + ")";
+    if (results.length === 1) {
+      string += " " + results[0];
+    } else if (results.length > 1) {
+      string += " (" + results.join(", ") + ")";
+    }
+    return string;
+  }
+  return type.string;
+};
+
+/* Returns the Go source position of the innermost frame of the current stack
+   that has one, like "/path/to/main.go:12:5", or "" if there is none. Frames
+   only have Go source positions if source maps are applied to stack traces,
+   like with node --enable-source-maps or the source-map-support package. */
+var $goSourcePosition = function() {
+  var stack = new Error().stack;
+  if (typeof stack !== "string") {
+    return "";
+  }
+  var lines = stack.split("\n");
+  for (var i = 1; i < lines.length; i++) {
+    var m = /(?:\(|at |@)([^()@]+\.go:\d+(?::\d+)?)\)?$/.exec(lines[i]);
+    if (m !== null) {
+      return m[1];
+    }
+  }
+  return "";
+};
+
 var $assertType = function(value, type, returnTuple) {
   var isInterface = (type.kind === $kindInterface), ok, missingMethod = "";
   if (value === $ifaceNil) {
@@ -741,11 +933,13 @@ var $assertType = function(value, type, returnTuple) {
     if (returnTuple) {
       return [type.zero(), false];
     }
+    var runtimeType = $packages["runtime"]._type.ptr;
     $panic(new $packages["runtime"].TypeAssertionError.ptr(
-      $packages["runtime"]._type.ptr.nil,
-      (value === $ifaceNil ? $packages["runtime"]._type.ptr.nil : new $packages["runtime"]._type.ptr(value.constructor.string)),
-      new $packages["runtime"]._type.ptr(type.string),
-      missingMethod));
+      runtimeType.nil,
+      (value === $ifaceNil ? runtimeType.nil : new runtimeType($typeFullName(value.constructor), value.constructor.pkg)),
+      new runtimeType($typeFullName(type), type.pkg),
+      missingMethod,
+      $goSourcePosition()));
   }
 
   if (!isInterface) {
@@ -0,0 +1,92 @@
+package prelude
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestLazyInit(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("Node.js is not installed")
+	}
+
+	// Each type counts the calls to its init, which must happen exactly once, on
+	// the first access to any property that the init sets.
+	const script = `
+var inits = {};
+var lazy = function(typ, init) {
+  inits[typ.string] = 0;
+  $lazyInit(typ, function() {
+    inits[typ.string]++;
+    init();
+  });
+};
+var check = function(typ, want) {
+  if (inits[typ.string] !== want) {
+    throw new Error(typ.string + ": " + inits[typ.string] + " inits, want " + want);
+  }
+};
+
+var S = $newType(12, $kindSlice, "main.S", true, "main", true, null);
+lazy(S, function() { S.init($Int); });
+check(S, 0);
+if (S.nil.$length !== 0 || S.elem !== $Int || S.comparable !== false) {
+  throw new Error("main.S isn't initialized");
+}
+check(S, 1);
+if (new S([1, 2]).$array.constructor !== Int32Array) {
+  throw new Error("main.S has the wrong native array");
+}
+check(S, 1);
+
+var P = $newType(0, $kindStruct, "main.P", true, "main", true, function(X_, S_) {
+  this.$val = this;
+  if (arguments.length === 0) { this.X = 0; this.S = S.nil; return; }
+  this.X = X_; this.S = S_;
+});
+lazy(P, function() { P.init("", [{prop: "X", name: "X", embedded: false, exported: true, typ: $Int, tag: ""}, {prop: "S", name: "S", embedded: false, exported: true, typ: S, tag: ""}]); });
+var p = new P.ptr(1, S.nil);
+check(P, 0);
+if (P.ptr.nil === undefined || P.ptr.nil.$val !== P.ptr.nil) {
+  throw new Error("main.P has no nil pointer");
+}
+check(P, 1);
+if (P.comparable !== false || P.fields.length !== 2) {
+  throw new Error("main.P isn't initialized");
+}
+var q = new P.ptr();
+P.copy(q, p);
+if (q.X !== 1) {
+  throw new Error("main.P isn't copied");
+}
+check(P, 1);
+
+var A = $newType(8, $kindArray, "main.A", true, "main", true, null);
+lazy(A, function() { A.init($Int, 2); });
+check(A, 0);
+if (A.zero().length !== 2 || $ptrType(A).elem !== A || A.keyFor([1, 2]) !== "1$2") {
+  throw new Error("main.A isn't initialized");
+}
+check(A, 1);
+
+var F = $newType(4, $kindFunc, "main.F", true, "main", true, null);
+lazy(F, function() { F.init([$Int], [$String], false); });
+F.params = [$String];
+check(F, 1);
+if (F.params[0] !== $String || F.results[0] !== $String || F.comparable !== false) {
+  throw new Error("main.F isn't initialized before an assignment");
+}
+check(F, 1);
+`
+
+	for name, prelude := range map[string]string{"Prelude": Prelude, "Minified": Minified} {
+		t.Run(name, func(t *testing.T) {
+			cmd := exec.Command("node")
+			cmd.Stdin = strings.NewReader(prelude + script)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("node: %v\n%s", err, out)
+			}
+		})
+	}
+}
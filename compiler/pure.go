@@ -0,0 +1,253 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+	"github.com/gopherjs/gopherjs/compiler/typesutil"
+)
+
+// pureDirective marks a function, or the initializer of a package-level
+// variable, as free of side effects:
+//
+//  //gopherjs:pure
+//  func NewTable() *Table { ... }
+//
+//  //gopherjs:pure
+//  var table = buildTable()
+//
+// A package-level variable that isn't used is removed by dead code elimination
+// along with its initializer, and the dependencies of the initializer, only if
+// the initializer has no side effects. Calls of functions are assumed to have
+// side effects, unless the function has the directive, or is simple enough
+// for its lack of side effects to be evident: its body is empty, or a single
+// return statement of expressions without side effects. The directive isn't
+// verified, and the code of a function that has side effects anyway may
+// silently not run.
+const pureDirective = "//gopherjs:pure"
+
+// hasPureDirective reports whether the comment group doc has a pure
+// directive.
+func hasPureDirective(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if c.Text == pureDirective || strings.HasPrefix(c.Text, pureDirective+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// purity determines which functions and package-level variable initializers
+// of a package are free of side effects, see pureDirective.
+type purity struct {
+	info *types.Info
+	pkg  *types.Package
+	// funcs are the declarations of the functions and methods of the
+	// package.
+	funcs map[*types.Func]*ast.FuncDecl
+	// vars are the package-level variables with a pure directive.
+	vars map[*types.Var]bool
+	// pure caches the results of pureFunc.
+	pure map[*types.Func]bool
+	// importedPure reports whether a function of another package is pure, as
+	// recorded in the Pure field of its declaration.
+	importedPure func(*types.Func) bool
+}
+
+func newPurity(files []*ast.File, info *types.Info, pkg *types.Package, importedPure func(*types.Func) bool) *purity {
+	p := &purity{
+		info:         info,
+		pkg:          pkg,
+		funcs:        map[*types.Func]*ast.FuncDecl{},
+		vars:         map[*types.Var]bool{},
+		pure:         map[*types.Func]bool{},
+		importedPure: importedPure,
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if f, ok := info.Defs[decl.Name].(*types.Func); ok {
+					p.funcs[f] = decl
+				}
+			case *ast.GenDecl:
+				if decl.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.ValueSpec)
+					// The documentation of a declaration applies to its
+					// variables only if it doesn't group several
+					// specifications.
+					if !hasPureDirective(spec.Doc) && (decl.Lparen.IsValid() || !hasPureDirective(decl.Doc)) {
+						continue
+					}
+					for _, name := range spec.Names {
+						if v, ok := info.Defs[name].(*types.Var); ok {
+							p.vars[v] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return p
+}
+
+// pureInit reports whether the initializer of the package-level variables
+// init.Lhs has no side effects.
+func (p *purity) pureInit(init *types.Initializer) bool {
+	for _, v := range init.Lhs {
+		if p.vars[v] {
+			return true
+		}
+	}
+	return p.pureExpr(init.Rhs)
+}
+
+// pureFunc reports whether calling the function or method f has no side
+// effects, other than those of evaluating its arguments.
+func (p *purity) pureFunc(f *types.Func) bool {
+	if typesutil.IsJsPackage(f.Pkg()) {
+		// The functions of the js package are implemented by the compiler,
+		// not by their bodies.
+		return false
+	}
+	if f.Pkg() != p.pkg {
+		return f.Pkg() != nil && p.importedPure(f)
+	}
+	if pure, ok := p.pure[f]; ok {
+		return pure
+	}
+	// Recursive calls are assumed to have side effects while f is analyzed.
+	p.pure[f] = false
+	pure := false
+	if decl := p.funcs[f]; decl != nil && decl.Body != nil {
+		switch body := decl.Body.List; {
+		case hasPureDirective(decl.Doc), len(body) == 0:
+			pure = true
+		case len(body) == 1:
+			if ret, ok := body[0].(*ast.ReturnStmt); ok {
+				pure = true
+				for _, e := range ret.Results {
+					pure = pure && p.pureExpr(e)
+				}
+			}
+		}
+	}
+	p.pure[f] = pure
+	return pure
+}
+
+// pureInitFunc reports whether the init function f has no side effects, other
+// than assigning package-level variables of the package, which don't matter if
+// none of the symbols of the package are used. This is the case if it has a
+// pure directive, or if its body only assigns results of expressions without
+// side effects to such variables.
+func (p *purity) pureInitFunc(f *types.Func) bool {
+	decl := p.funcs[f]
+	if decl == nil || decl.Body == nil {
+		return false
+	}
+	if hasPureDirective(decl.Doc) {
+		return true
+	}
+	for _, stmt := range decl.Body.List {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.ASSIGN {
+			return false
+		}
+		for _, lhs := range assign.Lhs {
+			id, ok := astutil.RemoveParens(lhs).(*ast.Ident)
+			if !ok {
+				return false
+			}
+			if id.Name == "_" {
+				continue
+			}
+			if v, ok := p.info.Uses[id].(*types.Var); !ok || v.Parent() != p.pkg.Scope() {
+				return false
+			}
+		}
+		for _, rhs := range assign.Rhs {
+			if !p.pureExpr(rhs) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// pureExpr reports whether evaluating e has no side effects. Panics, like
+// those of failed type assertions, don't count as side effects.
+func (p *purity) pureExpr(e ast.Expr) bool {
+	pure := true
+	ast.Inspect(e, func(n ast.Node) bool {
+		if !pure {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Function literals are only evaluated, not called.
+			return false
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				pure = false
+			}
+		case *ast.CallExpr:
+			pure = p.pureCall(n)
+		}
+		return pure
+	})
+	return pure
+}
+
+// pureCall reports whether the call or conversion call has no side effects,
+// other than those of evaluating its operands.
+func (p *purity) pureCall(call *ast.CallExpr) bool {
+	fun := astutil.RemoveParens(call.Fun)
+	tv := p.info.Types[fun]
+	switch {
+	case p.info.Types[call].Value != nil, tv.IsType():
+		return true
+	case tv.IsBuiltin():
+		// append isn't pure: it may write to an array shared by another slice.
+		switch p.info.Uses[builtinIdent(fun)].Name() {
+		case "cap", "complex", "imag", "len", "make", "new", "real":
+			return true
+		}
+		return false
+	}
+	var id *ast.Ident
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		if sel := p.info.Selections[fun]; sel != nil {
+			// Only methods of concrete types are called statically.
+			if sel.Kind() != types.MethodVal || types.IsInterface(sel.Recv()) {
+				return false
+			}
+		}
+		id = fun.Sel
+	default:
+		return false
+	}
+	f, ok := p.info.Uses[id].(*types.Func)
+	return ok && p.pureFunc(f)
+}
+
+// builtinIdent returns the identifier of the builtin function fun, which may
+// be qualified by unsafe.
+func builtinIdent(fun ast.Expr) *ast.Ident {
+	if sel, ok := fun.(*ast.SelectorExpr); ok {
+		return sel.Sel
+	}
+	return fun.(*ast.Ident)
+}
@@ -0,0 +1,138 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestPureInitializers(t *testing.T) {
+	file, fset := parseSource(t, `package testcase
+
+	type T struct{ s []string }
+
+	func (t *T) Name() string { return t.s[0] }
+
+	func newT(s ...string) *T { return &T{s: s} }
+
+	func register(t *T) *T {
+		registry = append(registry, t)
+		return t
+	}
+
+	//gopherjs:pure
+	func build() map[string]int {
+		m := map[string]int{}
+		m["x"] = 1
+		return m
+	}
+
+	func recursive(n int) int { return recursive(n - 1) }
+
+	type Namer interface{ Name() string }
+
+	var registry []*T
+
+	var (
+		literal   = []int{1, 2, 3}
+		closure   = func() { register(nil) }
+		simple    = newT("a", "b")
+		method    = simple.Name()
+		annotated = build()
+		effect    = register(newT())
+		loop      = recursive(1)
+		iface     = Namer(simple).Name()
+		builtin   = len(registry)
+		appended  = append(registry[:0], nil)
+	)
+
+	//gopherjs:pure
+	var forced = register(newT())
+
+	var (
+		//gopherjs:pure
+		grouped = register(newT())
+		notForced = register(newT())
+	)
+	`)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}}
+	archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+	if err != nil {
+		t.Fatalf("Compile() returned error: %v", err)
+	}
+
+	pure := map[string]bool{
+		"literal":   true,
+		"closure":   true,
+		"simple":    true,
+		"method":    true,
+		"annotated": true,
+		"effect":    false,
+		"loop":      false,
+		"iface":     false,
+		"builtin":   true,
+		"appended":  false,
+		"forced":    true,
+		"grouped":   true,
+		"notForced": false,
+	}
+	for _, d := range archive.Declarations {
+		for name, want := range pure {
+			if !strings.Contains(string(d.InitCode), "\t"+name+" = ") {
+				continue
+			}
+			if got := d.DceObjectFilter == name; got != want {
+				t.Errorf("Initializer of %s can be eliminated: %t, want %t", name, got, want)
+			}
+			delete(pure, name)
+		}
+	}
+	for name := range pure {
+		t.Errorf("No initializer of %s", name)
+	}
+
+	// Whether functions are pure is recorded for the packages that import
+	// them.
+	funcs := map[string]bool{
+		"testcase.newT":      true,
+		"(*testcase.T).Name": true,
+		"testcase.build":     true,
+		"testcase.register":  false,
+		"testcase.recursive": false,
+	}
+	for _, d := range archive.Declarations {
+		if want, ok := funcs[d.FullName]; ok && d.Pure != want {
+			t.Errorf("%s is pure: %t, want %t", d.FullName, d.Pure, want)
+		}
+	}
+}
+
+func TestPureInitPackages(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want bool
+	}{
+		{"types only", `type T struct{ x int }`, true},
+		{"pure initializers", `var x, y = f(), []int{1}; func f() *int { return new(int) }`, true},
+		{"impure initializer", `var x = f(); func f() int { println(); return 1 }`, false},
+		{"assigning init", `var x []int; func init() { x = make([]int, 2) }`, true},
+		{"impure init", `func init() { println() }`, false},
+		{"annotated init", "//gopherjs:pure\nfunc init() { println() }", true},
+		{"export", "//gopherjs:export\nfunc F() {}", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, fset := parseSource(t, "package testcase\n"+tt.src)
+			importContext := &ImportContext{Packages: map[string]*types.Package{}}
+			archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+			if err != nil {
+				t.Fatalf("Compile() returned error: %v", err)
+			}
+			if archive.PureInit != tt.want {
+				t.Errorf("Archive.PureInit = %t, want %t", archive.PureInit, tt.want)
+			}
+		})
+	}
+}
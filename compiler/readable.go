@@ -0,0 +1,69 @@
+package compiler
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+// maxStableTypeName is the maximum length of the part of the name of an
+// anonymous type that is derived from the type in readable mode.
+const maxStableTypeName = 48
+
+// positionComment returns the text of a comment with the file and line of the
+// Go code at pos in readable mode, or "" if there is none, or if it's the same
+// as the one of the code before.
+func (fc *funcContext) positionComment(pos token.Pos) string {
+	if !fc.pkgCtx.readable || !pos.IsValid() {
+		return ""
+	}
+	position := fc.pkgCtx.fileSet.Position(pos)
+	text := fmt.Sprintf("%s:%d", filepath.Base(position.Filename), position.Line)
+	if text == fc.lastPosComment {
+		return ""
+	}
+	fc.lastPosComment = text
+	return text
+}
+
+// stableTypeName returns the name of the variable of an anonymous type in
+// readable mode, which is derived from the type instead of the order the
+// anonymous types of the package are used in, like sliceType_int for []int,
+// so that it doesn't change when unrelated code does.
+func (fc *funcContext) stableTypeName(ty types.Type) string {
+	s := types.TypeString(ty, func(pkg *types.Package) string {
+		if pkg == fc.pkgCtx.Pkg {
+			return ""
+		}
+		return pkg.Name()
+	})
+	var b strings.Builder
+	separate := false
+	for _, r := range s {
+		if r < 128 && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			if separate && b.Len() != 0 {
+				b.WriteByte('_')
+			}
+			separate = false
+			b.WriteRune(r)
+			continue
+		}
+		separate = true
+	}
+	kind := strings.ToLower(typeKind(ty)[5:])
+	// Drop the keyword of map, func, struct, chan and interface types, which
+	// the kind already names.
+	name := b.String()
+	if name == kind || strings.HasPrefix(name, kind+"_") {
+		name = strings.TrimPrefix(name[len(kind):], "_")
+	}
+	if len(name) > maxStableTypeName {
+		name = name[:maxStableTypeName]
+	}
+	if name == "" {
+		return kind + "Type"
+	}
+	return kind + "Type_" + name
+}
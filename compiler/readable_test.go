@@ -0,0 +1,103 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler/analysis"
+)
+
+func TestReadable(t *testing.T) {
+	const src = `package testcase
+
+	type Point struct{ X, Y int }
+
+	func sum(ps []Point) int {
+		s := 0
+		for _, p := range ps {
+			s += p.X + p.Y
+		}
+		return s
+	}
+
+	func (p *Point) Scale(factor int) {
+		p.X *= factor
+		p.Y *= factor
+	}
+
+	func (p Point) Sum() int { return p.X + p.Y }
+	`
+	compile := func(readable bool) string {
+		file, fset := parseSource(t, src)
+		importContext := &ImportContext{Packages: map[string]*types.Package{}, Readable: readable}
+		archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+		if err != nil {
+			t.Fatalf("Compile() returned error: %s", err)
+		}
+		var code strings.Builder
+		for _, d := range archive.Declarations {
+			code.Write(d.DeclCode)
+			code.Write(d.TypeInitCode)
+		}
+		return code.String()
+	}
+
+	readable := []string{
+		"\t// <src>:5\n\tsum = function(ps) {",
+		"\t\t// <src>:6\n",
+		"\t\t\t// <src>:8\n",
+		"\t// <src>:13\n\tPoint.ptr.prototype.Scale = function(factor) {",
+		"ptrType_Point = $ptrType(Point)",
+	}
+	code := compile(true)
+	for _, want := range readable {
+		if !strings.Contains(code, want) {
+			t.Errorf("Readable code doesn't contain %q:\n%s", want, code)
+		}
+	}
+	if strings.Count(code, "// <src>:14\n") != 1 {
+		t.Errorf("Readable code doesn't contain a single comment for line 14:\n%s", code)
+	}
+	if strings.Count(code, "// <src>:18\n") != 1 {
+		t.Errorf("Readable code doesn't contain a single comment for the one-line function on line 18:\n%s", code)
+	}
+	code = compile(false)
+	for _, unwanted := range readable {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("Default code contains %q:\n%s", unwanted, code)
+		}
+	}
+}
+
+func TestStableTypeName(t *testing.T) {
+	pkg := makePackage(t, `package testcase
+
+	type Point struct{ X, Y int }
+
+	var (
+		a []int
+		b map[string]*Point
+		c func(error) [2]bool
+		d struct{ X int }
+		e []struct{ Name, Value, Description, Comment string }
+	)
+	`)
+	fc := &funcContext{pkgCtx: &pkgContext{Info: &analysis.Info{Pkg: pkg}}}
+	for _, tt := range []struct {
+		name string
+		want string
+	}{
+		{"a", "sliceType_int"},
+		{"b", "mapType_string_Point"},
+		{"c", "funcType_error_2_bool"},
+		{"d", "structType_X_int"},
+		{"e", "sliceType_struct_Name_string_Value_string_Description_stri"},
+	} {
+		ty := pkg.Scope().Lookup(tt.name).Type()
+		if got := fc.stableTypeName(ty); got != tt.want {
+			t.Errorf("stableTypeName(%s) returned %q, want %q", ty, got, tt.want)
+		}
+	}
+}
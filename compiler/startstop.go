@@ -0,0 +1,71 @@
+package compiler
+
+// startStopRuntime is a JavaScript snippet that defines the start and stop
+// functions exported by programs linked with LinkOptions.StartStop, and sets
+// $lifecycle, which the os, os/signal and syscall packages consult. It is
+// emitted after all packages are defined.
+//
+// start(args) initializes the program and runs main with os.Args set to the
+// program name, which is the last element of the import path of the main
+// package and fills in the %s verb, followed by args. It returns a promise of
+// the exit code: 0 once main returns, or the code passed to os.Exit. A program
+// can only be started once. stop() delivers SIGTERM to channels registered
+// with signal.Notify, and returns the same promise, so that the host can wait
+// for the program to shut down. Goroutines other than main keep running after
+// main returns.
+const startStopRuntime = `$lifecycle = (function() {
+  var listeners = [], exited = null, resolveExit;
+  var exitCode = new Promise(function(resolve) { resolveExit = resolve; });
+  /* Calls fun like $go would, and done once it returned, possibly after blocking. */
+  var withDone = function(fun, done) {
+    var step = function(r) {
+      if (r && r.$blk !== undefined) {
+        return { $blk: function() { return step(r.$blk()); } };
+      }
+      done();
+    };
+    return function() { return step(fun()); };
+  };
+  var lifecycle = {
+    name: %s,
+    args: null,
+    addEventListener: function(type, fn) { listeners.push(fn); },
+    removeEventListener: function(type, fn) {
+      var i = listeners.indexOf(fn);
+      if (i !== -1) { listeners.splice(i, 1); }
+    },
+    exit: function(code) {
+      if (exited === null) {
+        exited = code;
+        resolveExit(code);
+      }
+    },
+    start: function(args) {
+      if (lifecycle.args !== null) {
+        throw new Error("gopherjs: program already started");
+      }
+      lifecycle.args = [];
+      for (var i = 0; args !== undefined && i < args.length; i++) {
+        lifecycle.args.push(String(args[i]));
+      }
+      $packages["runtime"].$init();
+      $go(withDone($mainPkg.$init, function() { lifecycle.exit(0); }), []);
+      $flushConsole();
+      return exitCode;
+    },
+    stop: function() {
+      if (lifecycle.args === null) {
+        throw new Error("gopherjs: program not started");
+      }
+      var fns = listeners.slice();
+      for (var i = 0; i < fns.length && exited === null; i++) {
+        fns[i]({ type: "stop" });
+      }
+      return exitCode;
+    }
+  };
+  return lifecycle;
+})();
+$exportFunction("start", $lifecycle.start);
+$exportFunction("stop", $lifecycle.stop);
+`
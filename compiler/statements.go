@@ -54,21 +54,38 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 
 	case *ast.IfStmt:
 		var caseClauses []*ast.CaseClause
+		var defaultClause *ast.CaseClause
 		ifStmt := s
 		for {
 			if ifStmt.Init != nil {
 				panic("simplification error")
 			}
-			caseClauses = append(caseClauses, &ast.CaseClause{List: []ast.Expr{ifStmt.Cond}, Body: ifStmt.Body.List})
+			// Branches on conditions known at compile time, like
+			// runtime.GOOS == "js" or constant feature flags, are resolved
+			// here, so that the code of the branches that are never taken is
+			// left out.
+			if cond := fc.constValue(ifStmt.Cond); cond != nil {
+				if constant.BoolVal(cond) {
+					defaultClause = &ast.CaseClause{Body: ifStmt.Body.List}
+					break
+				}
+			} else {
+				caseClauses = append(caseClauses, &ast.CaseClause{List: []ast.Expr{ifStmt.Cond}, Body: ifStmt.Body.List})
+			}
 			elseStmt, ok := ifStmt.Else.(*ast.IfStmt)
 			if !ok {
+				if block, ok := ifStmt.Else.(*ast.BlockStmt); ok {
+					defaultClause = &ast.CaseClause{Body: block.List}
+				}
 				break
 			}
 			ifStmt = elseStmt
 		}
-		var defaultClause *ast.CaseClause
-		if block, ok := ifStmt.Else.(*ast.BlockStmt); ok {
-			defaultClause = &ast.CaseClause{Body: block.List}
+		if len(caseClauses) == 0 {
+			if defaultClause != nil {
+				fc.translateStmtList(defaultClause.Body)
+			}
+			break
 		}
 		fc.translateBranchingStmt(caseClauses, defaultClause, false, fc.translateExpr, nil, fc.Flattened[s])
 
@@ -185,6 +202,25 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 
 	case *ast.RangeStmt:
 		refVar := fc.newVariable("_ref")
+		if str, ok := fc.stringToBytesOperand(s.X); ok {
+			// Strings are immutable, so their bytes can be read directly instead of
+			// from a copy.
+			fc.Printf("%s = %s;", refVar, fc.translateExpr(str))
+			iVar := fc.newVariable("_i")
+			fc.Printf("%s = 0;", iVar)
+			fc.translateLoopingStmt(func() string { return iVar + " < " + refVar + ".length" }, s.Body, func() {
+				if !isBlank(s.Key) {
+					fc.Printf("%s", fc.translateAssign(s.Key, fc.newIdent(iVar, types.Typ[types.Int]), s.Tok == token.DEFINE))
+				}
+				if !isBlank(s.Value) {
+					elemType := fc.pkgCtx.TypeOf(s.X).Underlying().(*types.Slice).Elem()
+					fc.Printf("%s", fc.translateAssign(s.Value, fc.newIdent(refVar+".charCodeAt("+iVar+")", elemType), s.Tok == token.DEFINE))
+				}
+			}, func() {
+				fc.Printf("%s++;", iVar)
+			}, label, fc.Flattened[s])
+			return
+		}
 		fc.Printf("%s = %s;", refVar, fc.translateExpr(s.X))
 
 		switch t := fc.pkgCtx.TypeOf(s.X).Underlying().(type) {
@@ -247,10 +283,12 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 					fc.Printf("%s", fc.translateAssign(s.Key, fc.newIdent(iVar, types.Typ[types.Int]), s.Tok == token.DEFINE))
 				}
 				if !isBlank(s.Value) {
-					fc.Printf("%s", fc.translateAssign(s.Value, fc.setType(&ast.IndexExpr{
+					elem := &ast.IndexExpr{
 						X:     fc.newIdent(refVar, t),
 						Index: fc.newIdent(iVar, types.Typ[types.Int]),
-					}, elemType), s.Tok == token.DEFINE))
+					}
+					fc.pkgCtx.InBounds[elem] = true // The loop condition checks the index.
+					fc.Printf("%s", fc.translateAssign(s.Value, fc.setType(elem, elemType), s.Tok == token.DEFINE))
 				}
 			}, func() {
 				fc.Printf("%s++;", iVar)
@@ -555,7 +593,11 @@ func (fc *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 			Fun:  fc.newIdent("$select", types.NewSignature(nil, types.NewTuple(types.NewVar(0, nil, "", types.NewInterface(nil, nil))), types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int])), false)),
 			Args: []ast.Expr{fc.newIdent(fmt.Sprintf("[%s]", strings.Join(channels, ", ")), types.NewInterface(nil, nil))},
 		}, types.Typ[types.Int])
-		fc.Blocking[selectCall] = !hasDefault
+		if !hasDefault {
+			// Only mark the call when it is blocking, since any entry in Blocking
+			// makes the function resumable, see translateFunction.
+			fc.Blocking[selectCall] = true
+		}
 		fc.Printf("%s = %s;", selectionVar, fc.translateExpr(selectCall))
 
 		if len(caseClauses) != 0 {
@@ -766,13 +808,13 @@ func (fc *funcContext) translateAssign(lhs, rhs ast.Expr, define bool) string {
 	case *ast.IndexExpr:
 		switch t := fc.pkgCtx.TypeOf(l.X).Underlying().(type) {
 		case *types.Array, *types.Pointer:
-			pattern := rangeCheck("%1e[%2f] = %3s", fc.pkgCtx.Types[l.Index].Value != nil, true)
+			pattern := fc.rangeCheck(l, "%1e[%2f] = %3s", true)
 			if _, ok := t.(*types.Pointer); ok { // check pointer for nil (attribute getter causes a panic)
 				pattern = `%1e.nilCheck, ` + pattern
 			}
 			return fc.formatExpr(pattern, l.X, l.Index, rhsExpr).String() + ";"
 		case *types.Slice:
-			return fc.formatExpr(rangeCheck("%1e.$array[%1e.$offset + %2f] = %3s", fc.pkgCtx.Types[l.Index].Value != nil, false), l.X, l.Index, rhsExpr).String() + ";"
+			return fc.formatExpr(fc.rangeCheck(l, "%1e.$array[%1e.$offset + %2f] = %3s", false), l.X, l.Index, rhsExpr).String() + ";"
 		default:
 			panic(fmt.Sprintf("Unhandled lhs type: %T\n", t))
 		}
@@ -0,0 +1,179 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+)
+
+// SupportLevel describes how well a standard library symbol works when
+// compiled by GopherJS.
+type SupportLevel int
+
+const (
+	// Supported symbols work like with the gc compiler.
+	Supported SupportLevel = iota
+	// Stubbed symbols can be used, but do nothing, or less than with the gc
+	// compiler.
+	Stubbed
+	// Unavailable symbols always fail at run time, by panicking or returning
+	// an error.
+	Unavailable
+)
+
+func (l SupportLevel) String() string {
+	switch l {
+	case Supported:
+		return "supported"
+	case Stubbed:
+		return "stubbed"
+	case Unavailable:
+		return "unavailable"
+	default:
+		return "unknown"
+	}
+}
+
+type symbolSupport struct {
+	level  SupportLevel
+	reason string
+}
+
+const (
+	noNetwork = "network access is not supported by GopherJS"
+	noListen  = "listening for connections is not supported by GopherJS"
+)
+
+// supportMatrix lists the exported standard library symbols that don't fully
+// work when compiled by GopherJS, by import path and by name, with the name of
+// methods qualified by their receiver type, like "Dialer.Dial". Symbols not
+// listed are supported. It must be kept in sync with the natives, see
+// doc/packages.md for a summary by package.
+var supportMatrix = map[string]map[string]symbolSupport{
+	"net": {
+		"Dial":                      {Unavailable, noNetwork},
+		"DialIP":                    {Unavailable, noNetwork},
+		"DialTCP":                   {Unavailable, noNetwork},
+		"DialTimeout":               {Unavailable, noNetwork},
+		"DialUDP":                   {Unavailable, noNetwork},
+		"DialUnix":                  {Unavailable, noNetwork},
+		"Dialer.Dial":               {Unavailable, noNetwork},
+		"Dialer.DialContext":        {Unavailable, noNetwork},
+		"Listen":                    {Unavailable, noNetwork},
+		"ListenConfig.Listen":       {Unavailable, noNetwork},
+		"ListenConfig.ListenPacket": {Unavailable, noNetwork},
+		"ListenIP":                  {Unavailable, noNetwork},
+		"ListenMulticastUDP":        {Unavailable, noNetwork},
+		"ListenPacket":              {Unavailable, noNetwork},
+		"ListenTCP":                 {Unavailable, noNetwork},
+		"ListenUDP":                 {Unavailable, noNetwork},
+		"ListenUnix":                {Unavailable, noNetwork},
+		"ListenUnixgram":            {Unavailable, noNetwork},
+	},
+	"net/http": {
+		"ListenAndServe":           {Unavailable, noListen},
+		"ListenAndServeTLS":        {Unavailable, noListen},
+		"Server.ListenAndServe":    {Unavailable, noListen},
+		"Server.ListenAndServeTLS": {Unavailable, noListen},
+	},
+	"plugin": {
+		"Open": {Unavailable, "plugins are not supported by GopherJS"},
+	},
+	"reflect": {
+		"Value.InterfaceData": {Unavailable, "InterfaceData is not supported by GopherJS"},
+	},
+	"runtime": {
+		"SetFinalizer":            {Stubbed, "finalizers get a shallow copy of the object, and never run without FinalizationRegistry"},
+		"SetMutexProfileFraction": {Stubbed, "mutex contention isn't profiled"},
+		"SetBlockProfileRate":     {Stubbed, "blocking isn't profiled"},
+		"GC":                      {Stubbed, "garbage collection is left to the JavaScript engine, unless it exposes a gc function"},
+	},
+	"runtime/pprof": {
+		"StartCPUProfile": {Unavailable, "cpu profiling is not supported by GopherJS, use the profiler of the JavaScript engine"},
+	},
+	"testing": {
+		"AllocsPerRun": {Stubbed, "allocations aren't counted"},
+	},
+}
+
+// SymbolSupport reports how well the exported symbol name of the standard
+// library package importPath works when compiled by GopherJS, and why if it
+// doesn't fully. Methods are named after their receiver type, like
+// "Dialer.Dial".
+func SymbolSupport(importPath, name string) (SupportLevel, string) {
+	s, ok := supportMatrix[importPath][name]
+	if !ok {
+		return Supported, ""
+	}
+	return s.level, s.reason
+}
+
+// UnsupportedSymbols returns the symbols of the standard library package
+// importPath that don't fully work when compiled by GopherJS, sorted by name.
+func UnsupportedSymbols(importPath string) []string {
+	var names []string
+	for name := range supportMatrix[importPath] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UnsupportedUse is a use of an unavailable standard library symbol, see
+// SymbolSupport.
+type UnsupportedUse struct {
+	Pos    token.Position
+	Symbol string // Qualified by the import path, like "net.Listen".
+	Reason string
+}
+
+// symbolName returns the name obj is listed under in supportMatrix, or "" if
+// it isn't a package-level object or method.
+func symbolName(obj types.Object) string {
+	if f, ok := obj.(*types.Func); ok {
+		if recv := f.Type().(*types.Signature).Recv(); recv != nil {
+			t := recv.Type()
+			if p, ok := t.(*types.Pointer); ok {
+				t = p.Elem()
+			}
+			named, ok := t.(*types.Named)
+			if !ok {
+				return "" // Interface method.
+			}
+			return named.Obj().Name() + "." + f.Name()
+		}
+	}
+	if obj.Parent() != obj.Pkg().Scope() {
+		return ""
+	}
+	return obj.Name()
+}
+
+// unavailableUses returns the uses of unavailable standard library symbols
+// recorded in info, in source order.
+func unavailableUses(info *types.Info, fileSet *token.FileSet) []UnsupportedUse {
+	var idents []*ast.Ident
+	for ident, obj := range info.Uses {
+		if obj.Pkg() == nil || supportMatrix[obj.Pkg().Path()] == nil {
+			continue
+		}
+		if level, _ := SymbolSupport(obj.Pkg().Path(), symbolName(obj)); level == Unavailable {
+			idents = append(idents, ident)
+		}
+	}
+	sort.Slice(idents, func(i, j int) bool { return idents[i].Pos() < idents[j].Pos() })
+
+	var uses []UnsupportedUse
+	for _, ident := range idents {
+		obj := info.Uses[ident]
+		name := symbolName(obj)
+		_, reason := SymbolSupport(obj.Pkg().Path(), name)
+		uses = append(uses, UnsupportedUse{
+			Pos:    fileSet.Position(ident.Pos()),
+			Symbol: obj.Pkg().Path() + "." + name,
+			Reason: reason,
+		})
+	}
+	return uses
+}
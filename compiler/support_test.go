@@ -0,0 +1,70 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/types"
+	"testing"
+)
+
+func TestUnavailableUses(t *testing.T) {
+	file, fset := parseSource(t, `package testcase
+
+	import (
+		"net"
+		"net/http"
+		"runtime"
+	)
+
+	type dialer struct{ net.Dialer }
+
+	func main() {
+		net.Listen("tcp", ":80")
+		var d dialer
+		d.Dial("tcp", "example.com:80")
+		f := http.ListenAndServe
+		_ = f
+		runtime.SetFinalizer(&d, nil)
+		http.Get("http://example.com")
+	}
+	`)
+	info := &types.Info{Uses: make(map[*ast.Ident]types.Object)}
+	conf := types.Config{Importer: importer.Default()}
+	if _, err := conf.Check(file.Name.Name, fset, []*ast.File{file}, info); err != nil {
+		t.Fatalf("Failed to type check source code: %s", err)
+	}
+
+	got := unavailableUses(info, fset)
+	want := []string{
+		"<src>:12:7: net.Listen",
+		"<src>:14:5: net.Dialer.Dial",
+		"<src>:15:13: net/http.ListenAndServe",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unavailableUses() returned %v, want %v", got, want)
+	}
+	for i, use := range got {
+		if s := use.Pos.String() + ": " + use.Symbol; s != want[i] {
+			t.Errorf("Got use %q, want %q", s, want[i])
+		}
+		if use.Reason == "" {
+			t.Errorf("Use %q has no reason", use.Symbol)
+		}
+	}
+}
+
+func TestSymbolSupport(t *testing.T) {
+	tests := []struct {
+		pkg, name string
+		want      SupportLevel
+	}{
+		{pkg: "net", name: "Dialer.Dial", want: Unavailable},
+		{pkg: "runtime", name: "SetFinalizer", want: Stubbed},
+		{pkg: "fmt", name: "Println", want: Supported},
+	}
+	for _, test := range tests {
+		if got, _ := SymbolSupport(test.pkg, test.name); got != test.want {
+			t.Errorf("SymbolSupport(%q, %q) = %v, want %v", test.pkg, test.name, got, test.want)
+		}
+	}
+}
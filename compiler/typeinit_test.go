@@ -0,0 +1,49 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestLazyTypeInit(t *testing.T) {
+	file, fset := parseSource(t, `package testcase
+
+	type Slice []int
+	type Func func(int) string
+	type Plain struct{ s Slice }
+	type Embedding struct{ Plain }
+	type Namer interface{ Name() string }
+	`)
+	importContext := &ImportContext{Packages: map[string]*types.Package{}}
+	archive, err := Compile("testcase", []*ast.File{file}, fset, importContext, false)
+	if err != nil {
+		t.Fatalf("Compile() returned error: %s", err)
+	}
+
+	lazy := map[string]bool{
+		"Slice":     true,
+		"Func":      true,
+		"Plain":     true,
+		"Embedding": false,
+		"Namer":     false,
+	}
+	for _, d := range archive.Declarations {
+		want, ok := lazy[d.DceObjectFilter]
+		if !ok {
+			continue
+		}
+		delete(lazy, d.DceObjectFilter)
+		code := string(d.TypeInitCode)
+		if got := strings.HasPrefix(code, "\t$lazyInit("+d.DceObjectFilter+", "); got != want {
+			t.Errorf("Init of %s is lazy: %t, want %t:\n%s", d.DceObjectFilter, got, want, code)
+		}
+		if !strings.Contains(code, d.DceObjectFilter+".init(") {
+			t.Errorf("Init code of %s doesn't call init:\n%s", d.DceObjectFilter, code)
+		}
+	}
+	for name := range lazy {
+		t.Errorf("No declaration found for %s", name)
+	}
+}
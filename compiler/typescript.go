@@ -0,0 +1,142 @@
+package compiler
+
+import (
+	"fmt"
+	"go/types"
+	"io"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/typesutil"
+)
+
+// tsSignature returns the TypeScript declaration of a function with signature
+// sig exported as name, following the conversions of $externalizeFunction:
+// arguments are internalized from JavaScript values, and results are
+// externalized.
+func tsSignature(name string, sig *types.Signature) string {
+	return name + tsParams(sig) + ": " + tsResults(sig.Results())
+}
+
+// tsParams returns the parenthesized TypeScript parameter list of a function
+// with signature sig.
+func tsParams(sig *types.Signature) string {
+	var params []string
+	for i := 0; i < sig.Params().Len(); i++ {
+		p := sig.Params().At(i)
+		pName := p.Name()
+		if pName == "" || pName == "_" || reservedKeywords[pName] {
+			pName = fmt.Sprintf("p%d", i)
+		}
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			pName = "..." + pName // The type is already a slice.
+		}
+		params = append(params, pName+": "+tsType(p.Type(), nil))
+	}
+	return "(" + strings.Join(params, ", ") + ")"
+}
+
+// tsResults returns the TypeScript type of the value a function with results
+// returns to JavaScript: nothing, the single result, or an array of all of
+// them.
+func tsResults(results *types.Tuple) string {
+	switch results.Len() {
+	case 0:
+		return "void"
+	case 1:
+		return tsType(results.At(0).Type(), nil)
+	default:
+		types := make([]string, results.Len())
+		for i := range types {
+			types[i] = tsType(results.At(i).Type(), nil)
+		}
+		return "[" + strings.Join(types, ", ") + "]"
+	}
+}
+
+// tsType returns the TypeScript type of the JavaScript value that a Go value
+// of type t is converted to, or any if it can't be described. expanding holds
+// the named types whose fields are being described, to cut off recursion.
+func tsType(t types.Type, expanding map[*types.Named]bool) string {
+	if typesutil.IsJsObject(t) {
+		return "any"
+	}
+	if named, ok := t.(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return "Date"
+		}
+		if expanding[named] {
+			return "any"
+		}
+		if _, ok := named.Underlying().(*types.Struct); ok {
+			e := map[*types.Named]bool{named: true}
+			for n := range expanding {
+				e[n] = true
+			}
+			expanding = e
+		}
+	}
+
+	switch t := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return "boolean"
+		case t.Info()&types.IsString != 0:
+			return "string"
+		case t.Info()&(types.IsInteger|types.IsFloat) != 0:
+			return "number"
+		}
+	case *types.Array:
+		return tsArray(tsType(t.Elem(), expanding))
+	case *types.Slice:
+		return tsArray(tsType(t.Elem(), expanding))
+	case *types.Map:
+		return fmt.Sprintf("{ [key: string]: %s }", tsType(t.Elem(), expanding))
+	case *types.Pointer:
+		return tsType(t.Elem(), expanding) + " | null"
+	case *types.Signature:
+		return "(" + tsParams(t) + " => " + tsResults(t.Results()) + ")"
+	case *types.Struct:
+		var fields []string
+		for i := 0; i < t.NumFields(); i++ {
+			f := t.Field(i)
+			if i == 0 && typesutil.IsJsObject(f.Type()) {
+				return "any" // Externalized to the wrapped object.
+			}
+			if !f.Exported() {
+				continue
+			}
+			fields = append(fields, fmt.Sprintf("%s: %s;", f.Name(), tsType(f.Type(), expanding)))
+		}
+		if len(fields) == 0 {
+			return "{}"
+		}
+		return "{ " + strings.Join(fields, " ") + " }"
+	}
+	return "any"
+}
+
+func tsArray(elem string) string {
+	if strings.ContainsAny(elem, " |") {
+		return "Array<" + elem + ">"
+	}
+	return elem + "[]"
+}
+
+// WriteTypeDeclarations writes a TypeScript declaration file for the functions
+// that pkg exports with //gopherjs:export directives into w.
+func WriteTypeDeclarations(pkg *Archive, w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "// Code generated by GopherJS from package %s. DO NOT EDIT.\n\n", pkg.ImportPath); err != nil {
+		return err
+	}
+	for i, name := range pkg.Exports {
+		if reservedKeywords[name] {
+			continue // Not a named export of ES modules either.
+		}
+		if _, err := fmt.Fprintf(w, "export declare function %s;\n", pkg.ExportSignatures[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
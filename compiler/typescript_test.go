@@ -0,0 +1,55 @@
+package compiler
+
+import (
+	"go/types"
+	"testing"
+)
+
+func TestTSSignature(t *testing.T) {
+	pkg := makePackage(t, `package testcase
+
+	type Point struct {
+		X, Y   float64
+		hidden int
+	}
+
+	type Node struct {
+		Name     string
+		Children []*Node
+	}
+
+	func Add(a, b int) int { return 0 }
+	func Greet(name string, _ bool) {}
+	func Sum(values ...int64) uint64 { return 0 }
+	func Split(s string) (string, string) { return "", "" }
+	func Center(points []Point) *Point { return nil }
+	func Tree() Node { return Node{} }
+	func Index(m map[string][]byte) interface{} { return nil }
+	func Each(f func(int) bool) {}
+	func Keyword(function int) {}
+	`)
+
+	tests := []struct {
+		fun  string
+		want string
+	}{
+		{fun: "Add", want: "Add(a: number, b: number): number"},
+		{fun: "Greet", want: "Greet(name: string, p1: boolean): void"},
+		{fun: "Sum", want: "Sum(...values: number[]): number"},
+		{fun: "Split", want: "Split(s: string): [string, string]"},
+		{fun: "Center", want: "Center(points: Array<{ X: number; Y: number; }>): { X: number; Y: number; } | null"},
+		{fun: "Tree", want: "Tree(): { Name: string; Children: Array<any | null>; }"},
+		{fun: "Index", want: "Index(m: { [key: string]: number[] }): any"},
+		{fun: "Each", want: "Each(f: ((p0: number) => boolean)): void"},
+		{fun: "Keyword", want: "Keyword(p0: number): void"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fun, func(t *testing.T) {
+			sig := pkg.Scope().Lookup(test.fun).Type().(*types.Signature)
+			if got := tsSignature(test.fun, sig); got != test.want {
+				t.Errorf("tsSignature() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package compiler
 
 import (
 	"bytes"
-	"encoding/binary"
 	"errors"
 	"fmt"
 	"go/ast"
@@ -18,20 +17,20 @@ import (
 	"unicode"
 
 	"github.com/gopherjs/gopherjs/compiler/analysis"
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+	"github.com/gopherjs/gopherjs/compiler/linebuf"
 	"github.com/gopherjs/gopherjs/compiler/typesutil"
 )
 
-func (fc *funcContext) Write(b []byte) (int, error) {
-	fc.writePos()
-	fc.output = append(fc.output, b...)
-	return len(b), nil
-}
-
 func (fc *funcContext) Printf(format string, values ...interface{}) {
-	fc.Write([]byte(strings.Repeat("\t", fc.pkgCtx.indentation)))
-	fmt.Fprintf(fc, format, values...)
-	fc.Write([]byte{'\n'})
-	fc.Write(fc.delayedOutput)
+	if fc.posAvailable {
+		if text := fc.positionComment(fc.pos); text != "" {
+			fc.output = append(fc.output, &linebuf.Comment{Indent: fc.pkgCtx.indentation, Text: text})
+		}
+	}
+	fc.writePos()
+	fc.output = append(fc.output, &linebuf.Line{Indent: fc.pkgCtx.indentation, Code: fmt.Sprintf(format, values...)})
+	fc.output = append(fc.output, fc.delayedOutput...)
 	fc.delayedOutput = nil
 }
 
@@ -51,8 +50,7 @@ func (fc *funcContext) SetPos(pos token.Pos) {
 func (fc *funcContext) writePos() {
 	if fc.posAvailable {
 		fc.posAvailable = false
-		fc.Write([]byte{'\b'})
-		binary.Write(fc, binary.BigEndian, uint32(fc.pos))
+		fc.output = append(fc.output, &linebuf.Mark{Pos: fc.pos})
 	}
 }
 
@@ -63,6 +61,12 @@ func (fc *funcContext) Indent(f func()) {
 }
 
 func (fc *funcContext) CatchOutput(indent int, f func()) []byte {
+	return linebuf.Print(fc.catchStmts(indent, f))
+}
+
+// catchStmts returns the statements that f outputs, indented by indent more
+// levels, instead of outputting them.
+func (fc *funcContext) catchStmts(indent int, f func()) []linebuf.Entry {
 	origoutput := fc.output
 	fc.output = nil
 	fc.pkgCtx.indentation += indent
@@ -75,7 +79,7 @@ func (fc *funcContext) CatchOutput(indent int, f func()) []byte {
 }
 
 func (fc *funcContext) Delayed(f func()) {
-	fc.delayedOutput = fc.CatchOutput(0, f)
+	fc.delayedOutput = fc.catchStmts(0, f)
 }
 
 // expandTupleArgs converts a function call which argument is a tuple returned
@@ -351,7 +355,11 @@ func (fc *funcContext) typeName(ty types.Type) string {
 	anonType, ok := fc.pkgCtx.anonTypeMap.At(ty).(*types.TypeName)
 	if !ok {
 		fc.initArgs(ty) // cause all embedded types to be registered
-		varName := fc.newVariableWithLevel(strings.ToLower(typeKind(ty)[5:])+"Type", true)
+		name := strings.ToLower(typeKind(ty)[5:]) + "Type"
+		if fc.pkgCtx.readable {
+			name = fc.stableTypeName(ty)
+		}
+		varName := fc.newVariableWithLevel(name, true)
 		anonType = types.NewTypeName(token.NoPos, fc.pkgCtx.Pkg, varName, ty) // fake types.TypeName
 		fc.pkgCtx.anonTypes = append(fc.pkgCtx.anonTypes, anonType)
 		fc.pkgCtx.anonTypeMap.Set(ty, anonType)
@@ -454,6 +462,14 @@ func is64Bit(t *types.Basic) bool {
 	return t.Kind() == types.Int64 || t.Kind() == types.Uint64
 }
 
+func is32BitUnsigned(t *types.Basic) bool {
+	switch t.Kind() {
+	case types.Uint32, types.Uint, types.Uintptr:
+		return true
+	}
+	return false
+}
+
 func isBoolean(t *types.Basic) bool {
 	return t.Info()&types.IsBoolean != 0
 }
@@ -482,6 +498,42 @@ func isUnsigned(t *types.Basic) bool {
 	return t.Info()&types.IsUnsigned != 0
 }
 
+// bytesToStringOperand returns b if expr is a string(b) conversion of a byte
+// slice b.
+func (fc *funcContext) bytesToStringOperand(expr ast.Expr) (ast.Expr, bool) {
+	arg, ok := fc.conversionOperand(expr)
+	if !ok {
+		return nil, false
+	}
+	to, isBasic := fc.pkgCtx.TypeOf(expr).Underlying().(*types.Basic)
+	return arg, isBasic && isString(to) && isByteSlice(fc.pkgCtx.TypeOf(arg))
+}
+
+// stringToBytesOperand returns s if expr is a []byte(s) conversion of a string
+// s.
+func (fc *funcContext) stringToBytesOperand(expr ast.Expr) (ast.Expr, bool) {
+	arg, ok := fc.conversionOperand(expr)
+	if !ok {
+		return nil, false
+	}
+	from, isBasic := fc.pkgCtx.TypeOf(arg).Underlying().(*types.Basic)
+	return arg, isBasic && isString(from) && isByteSlice(fc.pkgCtx.TypeOf(expr))
+}
+
+// conversionOperand returns the operand of expr if it is a type conversion.
+func (fc *funcContext) conversionOperand(expr ast.Expr) (ast.Expr, bool) {
+	call, ok := astutil.RemoveParens(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !fc.pkgCtx.Types[call.Fun].IsType() {
+		return nil, false
+	}
+	return call.Args[0], true
+}
+
+func isByteSlice(t types.Type) bool {
+	s, ok := t.Underlying().(*types.Slice)
+	return ok && types.Identical(s.Elem().Underlying(), types.Typ[types.Byte])
+}
+
 func isBlank(expr ast.Expr) bool {
 	if expr == nil {
 		return true
@@ -506,6 +558,12 @@ func isWrapped(ty types.Type) bool {
 }
 
 func encodeString(s string) string {
+	return `"` + escapeString(s, '"') + `"`
+}
+
+// escapeString returns the content of a string literal of s, quoted with
+// quote, which is '"', or '`' for template literals.
+func escapeString(s string, quote byte) string {
 	buffer := bytes.NewBuffer(nil)
 	for _, r := range []byte(s) {
 		switch r {
@@ -521,8 +579,15 @@ func encodeString(s string) string {
 			buffer.WriteString(`\t`)
 		case '\v':
 			buffer.WriteString(`\v`)
-		case '"':
-			buffer.WriteString(`\"`)
+		case quote:
+			buffer.WriteByte('\\')
+			buffer.WriteByte(r)
+		case '$':
+			if quote == '`' {
+				buffer.WriteString(`\$`) // Could start a substitution.
+				continue
+			}
+			buffer.WriteByte(r)
 		case '\\':
 			buffer.WriteString(`\\`)
 		default:
@@ -533,7 +598,7 @@ func encodeString(s string) string {
 			buffer.WriteByte(r)
 		}
 	}
-	return `"` + buffer.String() + `"`
+	return buffer.String()
 }
 
 func getJsTag(tag string) string {
@@ -604,6 +669,12 @@ func removeWhitespace(b []byte, minify bool) []byte {
 				b = b[1:]
 				continue
 			}
+		case '`':
+			n := templateLen(b)
+			out = append(out, b[:n]...)
+			previous = '`'
+			b = b[n:]
+			continue
 		case '"':
 			out = append(out, '"')
 			b = b[1:]
@@ -632,8 +703,12 @@ func removeWhitespace(b []byte, minify bool) []byte {
 	return out
 }
 
-func rangeCheck(pattern string, constantIndex, array bool) string {
-	if constantIndex && array {
+// rangeCheck returns pattern, which translates the index expression e of a
+// slice, or of an array if array is set, guarded by a bounds check, unless the
+// index is known to be in bounds.
+func (fc *funcContext) rangeCheck(e *ast.IndexExpr, pattern string, array bool) string {
+	constantIndex := fc.pkgCtx.Types[e.Index].Value != nil
+	if (constantIndex && array) || fc.pkgCtx.InBounds[e] {
 		return pattern
 	}
 	lengthProp := "$length"
@@ -647,6 +722,39 @@ func rangeCheck(pattern string, constantIndex, array bool) string {
 	return "(" + check + ` ? ($throwRuntimeError("index out of range"), undefined) : ` + pattern + ")"
 }
 
+// constValue returns the value of e if it is known at compile time, or nil.
+// Besides the constant expressions of the type checker, it evaluates the
+// comparisons of constants and the && and || operators that the simplified
+// form of switch statements contains, and that aren't constant in Go.
+func (fc *funcContext) constValue(e ast.Expr) constant.Value {
+	if value := fc.pkgCtx.Types[e].Value; value != nil {
+		return value
+	}
+	switch e := e.(type) {
+	case *ast.ParenExpr:
+		return fc.constValue(e.X)
+	case *ast.BinaryExpr:
+		x := fc.constValue(e.X)
+		if x == nil || x.Kind() == constant.Unknown {
+			return nil
+		}
+		switch e.Op {
+		case token.LAND, token.LOR:
+			if constant.BoolVal(x) == (e.Op == token.LOR) {
+				return x
+			}
+			return fc.constValue(e.Y)
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			y := fc.constValue(e.Y)
+			if y == nil || y.Kind() == constant.Unknown {
+				return nil
+			}
+			return constant.MakeBool(constant.Compare(x, e.Op, y))
+		}
+	}
+	return nil
+}
+
 func endsWithReturn(stmts []ast.Stmt) bool {
 	if len(stmts) > 0 {
 		if _, ok := stmts[len(stmts)-1].(*ast.ReturnStmt); ok {
@@ -14,9 +14,43 @@ import (
 // Version is the GopherJS compiler version string.
 const Version = "1.16.3+go1.16.5"
 
-// GoVersion is the current Go 1.x version that GopherJS is compatible with.
+// GoVersion is the latest Go 1.x version that GopherJS is compatible with.
 const GoVersion = 16
 
+// MinGoVersion is the oldest Go 1.x version that GopherJS is compatible with.
+// Natives for versions older than GoVersion are kept in overlay sets keyed by
+// Go release, see github.com/gopherjs/gopherjs/compiler/natives.
+const MinGoVersion = 16
+
+// supportedGoVersions describes the Go distributions this version of the
+// GopherJS compiler is compatible with, for error messages.
+func supportedGoVersions() string {
+	if MinGoVersion == GoVersion {
+		return fmt.Sprintf("a Go 1.%d.x distribution", GoVersion)
+	}
+	return fmt.Sprintf("a Go 1.%d.x to 1.%d.x distribution", MinGoVersion, GoVersion)
+}
+
+// GoRootVersion returns the minor version of the Go distribution at goroot,
+// like 16 for go1.16.5.
+func GoRootVersion(goroot string) (int, error) {
+	v, err := ioutil.ReadFile(filepath.Join(goroot, "VERSION"))
+	if err != nil {
+		return 0, err
+	}
+	if fields := bytes.Fields(v); len(fields) > 0 {
+		v = bytes.TrimPrefix(fields[0], []byte("go1."))
+	}
+	if i := bytes.IndexAny(v, ".rb"); i != -1 {
+		v = v[:i] // Patch, release candidate or beta suffix.
+	}
+	minor, err := strconv.Atoi(string(v))
+	if err != nil {
+		return 0, fmt.Errorf("unrecognized Go version in %s", filepath.Join(goroot, "VERSION"))
+	}
+	return minor, nil
+}
+
 // CheckGoVersion checks the version of the Go distribution
 // at goroot, and reports an error if it's not compatible
 // with this version of the GopherJS compiler.
@@ -26,10 +60,11 @@ func CheckGoVersion(goroot string) error {
 	}
 	v, err := ioutil.ReadFile(filepath.Join(goroot, "VERSION"))
 	if err != nil {
-		return fmt.Errorf("GopherJS %s requires a Go 1.16.x distribution, but failed to read its VERSION file: %v", Version, err)
+		return fmt.Errorf("GopherJS %s requires %s, but failed to read its VERSION file: %v", Version, supportedGoVersions(), err)
 	}
-	if !bytes.HasPrefix(v, []byte("go1.16")) {
-		return fmt.Errorf("GopherJS %s requires a Go 1.16.x distribution, but found version %s", Version, v)
+	minor, err := GoRootVersion(goroot)
+	if err != nil || minor < MinGoVersion || minor > GoVersion {
+		return fmt.Errorf("GopherJS %s requires %s, but found version %s", Version, supportedGoVersions(), v)
 	}
 	return nil
 }
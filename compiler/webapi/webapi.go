@@ -0,0 +1,221 @@
+// Package webapi compiles Go programs from source strings in environments
+// without a file system or a Go distribution, like the GopherJS Playground or
+// educational sites that run the compiler in the browser.
+//
+// Packages that the compiled sources import are not compiled from source.
+// Instead, their archives, as written by compiler.WriteArchive, are obtained
+// from a Resolver, for example precompiled standard library archives fetched
+// over HTTP with HTTPResolver. A Session keeps the archives it has imported or
+// compiled, so that recompiling a program after an edit only compiles the
+// changed packages.
+package webapi
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// Resolver provides the archives of imported packages.
+type Resolver interface {
+	// Resolve returns the archive of the package importPath, as written by
+	// compiler.WriteArchive.
+	Resolve(importPath string) ([]byte, error)
+}
+
+// ResolverFunc is a function that implements Resolver.
+type ResolverFunc func(importPath string) ([]byte, error)
+
+// Resolve calls f(importPath).
+func (f ResolverFunc) Resolve(importPath string) ([]byte, error) {
+	return f(importPath)
+}
+
+// HTTPResolver fetches archives over HTTP from BaseURL followed by the import
+// path and ".a", like "https://example.com/pkg/fmt.a", and caches them for
+// the lifetime of the resolver. It is safe for concurrent use.
+type HTTPResolver struct {
+	BaseURL string
+	// Client is used for requests, or http.DefaultClient if nil. Under
+	// GopherJS in a browser, requests are made with the Fetch API.
+	Client *http.Client
+
+	mu    sync.Mutex
+	cache map[string][]byte
+}
+
+// Resolve fetches the archive of the package importPath, unless it is
+// cached.
+func (r *HTTPResolver) Resolve(importPath string) ([]byte, error) {
+	r.mu.Lock()
+	data, ok := r.cache[importPath]
+	r.mu.Unlock()
+	if ok {
+		return data, nil
+	}
+
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	url := strings.TrimSuffix(r.BaseURL, "/") + "/" + importPath + ".a"
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("cannot import package %q: %v", importPath, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot import package %q: %s returned %s", importPath, url, resp.Status)
+	}
+	data, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot import package %q: %v", importPath, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cache == nil {
+		r.cache = make(map[string][]byte)
+	}
+	r.cache[importPath] = data
+	return data, nil
+}
+
+// Session compiles packages from source, importing dependencies through a
+// Resolver. It keeps the archives of all packages it has compiled or imported
+// until they are recompiled, so that programs can be compiled incrementally.
+// A Session is not safe for concurrent use.
+type Session struct {
+	// Minify enables minification of the generated code.
+	Minify bool
+	// CSP makes the generated code avoid eval, for pages with a Content
+	// Security Policy that forbids it.
+	CSP bool
+
+	resolver Resolver
+	packages map[string]*types.Package
+	archives map[string]*compiler.Archive
+}
+
+// NewSession returns a session that imports packages with resolver.
+func NewSession(resolver Resolver) *Session {
+	return &Session{
+		resolver: resolver,
+		packages: make(map[string]*types.Package),
+		archives: make(map[string]*compiler.Archive),
+	}
+}
+
+// importPackage returns the archive of the package importPath, compiled in
+// the session or obtained from the resolver.
+func (s *Session) importPackage(importPath string) (*compiler.Archive, error) {
+	if a, ok := s.archives[importPath]; ok {
+		return a, nil
+	}
+	data, err := s.resolver.Resolve(importPath)
+	if err != nil {
+		return nil, err
+	}
+	a, err := compiler.ReadArchive(importPath+".a", importPath, bytes.NewReader(data), s.packages)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read archive of package %q: %v", importPath, err)
+	}
+	s.archives[importPath] = a
+	return a, nil
+}
+
+// Compile compiles the package importPath from files, which maps file names
+// to their contents, and keeps its archive for packages compiled later. The
+// archives of packages that import it, directly or indirectly, are discarded,
+// so that they are recompiled or imported again against the new version. On
+// failure, the error is a compiler.ErrorList of the syntax or type errors if
+// there were any.
+func (s *Session) Compile(importPath string, files map[string]string) (*compiler.Archive, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fileSet := token.NewFileSet()
+	var parsed []*ast.File
+	var errList compiler.ErrorList
+	for _, name := range names {
+		f, err := parser.ParseFile(fileSet, name, files[name], parser.ParseComments)
+		if err != nil {
+			errList = append(errList, err)
+			continue
+		}
+		parsed = append(parsed, f)
+	}
+	if errList != nil {
+		return nil, errList.Normalize()
+	}
+
+	s.forget(importPath)
+	importContext := &compiler.ImportContext{
+		Packages: s.packages,
+		Import:   s.importPackage,
+		CSP:      s.CSP,
+	}
+	archive, err := compiler.Compile(importPath, parsed, fileSet, importContext, s.Minify)
+	if err != nil {
+		return nil, err
+	}
+	s.archives[importPath] = archive
+	return archive, nil
+}
+
+// forget discards the archive of the package importPath and of the packages
+// that import it, directly or indirectly.
+func (s *Session) forget(importPath string) {
+	if _, ok := s.archives[importPath]; !ok {
+		return
+	}
+	delete(s.archives, importPath)
+	delete(s.packages, importPath)
+	for path, a := range s.archives {
+		for _, imp := range a.Imports {
+			if imp == importPath {
+				s.forget(path)
+				break
+			}
+		}
+	}
+}
+
+// Link writes the JavaScript program with the main package mainPkg and all
+// its dependencies to w. Dependencies that haven't been compiled in the
+// session are imported with its resolver, including the runtime package.
+func (s *Session) Link(mainPkg *compiler.Archive, w io.Writer, opts compiler.LinkOptions) error {
+	deps, err := compiler.ImportDependencies(mainPkg, s.importPackage)
+	if err != nil {
+		return err
+	}
+	return compiler.WriteProgramCode(deps, &compiler.SourceMapFilter{Writer: w}, opts)
+}
+
+// CompileProgram compiles the main package from files and returns the
+// JavaScript program linked with opts.
+func (s *Session) CompileProgram(files map[string]string, opts compiler.LinkOptions) (string, error) {
+	mainPkg, err := s.Compile("main", files)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := s.Link(mainPkg, &buf, opts); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,115 @@
+package webapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// archiveServer serves archives of the packages with the given sources,
+// compiled with a separate session, and counts the requests for each.
+func archiveServer(t *testing.T, sources map[string]string) (*httptest.Server, map[string]int) {
+	t.Helper()
+	precompiled := NewSession(ResolverFunc(func(path string) ([]byte, error) {
+		return nil, fmt.Errorf("unexpected import of %q", path)
+	}))
+	archives := map[string][]byte{}
+	for _, path := range []string{"runtime", "example.com/greet"} {
+		a, err := precompiled.Compile(path, map[string]string{"src.go": sources[path]})
+		if err != nil {
+			t.Fatalf("Failed to compile %s: %v", path, err)
+		}
+		var buf strings.Builder
+		if err := compiler.WriteArchive(a, &buf); err != nil {
+			t.Fatalf("Failed to write the archive of %s: %v", path, err)
+		}
+		archives[path] = []byte(buf.String())
+	}
+
+	requests := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/pkg/"), ".a")
+		requests[path]++
+		data, ok := archives[path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+	}))
+	return srv, requests
+}
+
+func TestSession(t *testing.T) {
+	srv, requests := archiveServer(t, map[string]string{
+		"runtime":           `package runtime`,
+		"example.com/greet": `package greet; func Hello(name string) string { return "Hello, " + name + "!" }`,
+	})
+	defer srv.Close()
+
+	mainFiles := map[string]string{
+		"main.go": `package main
+
+import (
+	"example.com/greet"
+	"example.com/user/util"
+)
+
+func main() { println(greet.Hello(util.Name)) }`,
+	}
+
+	s := NewSession(&HTTPResolver{BaseURL: srv.URL + "/pkg/"})
+	_, err := s.CompileProgram(mainFiles, compiler.LinkOptions{})
+	if err == nil || !strings.Contains(err.Error(), `cannot import package "example.com/user/util"`) {
+		t.Fatalf("CompileProgram() with a missing import returned error %v", err)
+	}
+
+	if _, err := s.Compile("example.com/user/util", map[string]string{"util.go": `package util; var Name = "gopher"`}); err != nil {
+		t.Fatalf("Failed to compile util: %v", err)
+	}
+	code, err := s.CompileProgram(mainFiles, compiler.LinkOptions{})
+	if err != nil {
+		t.Fatalf("CompileProgram() returned error: %v", err)
+	}
+	for _, want := range []string{`$packages["example.com/greet"]`, `$packages["example.com/user/util"]`, `$mainPkg`} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Linked program does not contain %s", want)
+		}
+	}
+
+	// Recompiling a package discards its dependents, and archives are only
+	// fetched once.
+	if _, err := s.Compile("example.com/user/util", map[string]string{"util.go": `package util; var Name = 42`}); err != nil {
+		t.Fatalf("Failed to recompile util: %v", err)
+	}
+	if _, ok := s.archives["main"]; ok {
+		t.Errorf("Archive of main was kept after recompiling a package it imports")
+	}
+	_, err = s.CompileProgram(mainFiles, compiler.LinkOptions{})
+	if err == nil {
+		t.Errorf("CompileProgram() against the changed util package returned no error")
+	}
+	for path, n := range requests {
+		if n != 1 {
+			t.Errorf("Archive of %s was fetched %d times, want once", path, n)
+		}
+	}
+}
+
+func TestSessionSyntaxError(t *testing.T) {
+	s := NewSession(ResolverFunc(func(path string) ([]byte, error) {
+		return nil, fmt.Errorf("unexpected import of %q", path)
+	}))
+	_, err := s.Compile("main", map[string]string{
+		"a.go": "package main\nfunc {",
+		"b.go": "package main\nvar = 1",
+	})
+	errList, ok := err.(compiler.ErrorList)
+	if !ok || len(errList) != 2 {
+		t.Errorf("Compile() of two files with syntax errors returned %v, want an ErrorList of both", err)
+	}
+}
@@ -0,0 +1,284 @@
+// Package compression provides gzip, zlib and raw DEFLATE readers and writers
+// that use the Compression Streams API of the JavaScript environment when it is
+// available, and the compress packages of the standard library otherwise.
+//
+// Compressing and decompressing data in native code is much faster than running
+// compress/flate compiled to JavaScript:
+//
+//	zw, err := compression.NewWriter(w, compression.Gzip)
+//	...
+//	_, err = io.Copy(zw, data)
+//	...
+//	err = zw.Close()
+//
+// The standard library packages themselves aren't replaced, because their API
+// can't be implemented on top of the streams: decompressors must not read past
+// the end of the compressed data, compressors support compression levels and
+// flushing, and gzip.Reader reports the header when it is created. Readers and
+// writers of this package provide none of these, and the compressed output may
+// differ between environments. They report corrupt input only when reading,
+// with an *Error rather than the errors of the compress packages.
+package compression
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Format is a compression format, named like in the Compression Streams API.
+type Format string
+
+const (
+	// Gzip is the gzip file format, see RFC 1952 and compress/gzip.
+	Gzip Format = "gzip"
+	// Deflate is the zlib format, see RFC 1950 and compress/zlib.
+	Deflate Format = "deflate"
+	// DeflateRaw is DEFLATE compressed data without a header or trailer, see
+	// RFC 1951 and compress/flate.
+	DeflateRaw Format = "deflate-raw"
+)
+
+// Error is an error reported by a compression stream, e.g. a TypeError for
+// corrupt or truncated input.
+type Error struct {
+	Name    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("compression: %s: %s", e.Name, e.Message)
+}
+
+// Available reports whether f is supported by the Compression Streams API of
+// the JavaScript environment. If not, readers and writers for f fall back to
+// the compress packages.
+func Available(f Format) (ok bool) {
+	for _, name := range []string{"CompressionStream", "DecompressionStream"} {
+		if js.Global.Get(name) == js.Undefined {
+			return false
+		}
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if _, isJSErr := e.(*js.Error); !isJSErr {
+				panic(e)
+			}
+			ok = false // The constructors throw a TypeError for unsupported formats.
+		}
+	}()
+	js.Global.Get("CompressionStream").New(string(f))
+	js.Global.Get("DecompressionStream").New(string(f))
+	return true
+}
+
+// NewReader returns a reader that decompresses the data in format f read from
+// r. The reader reads ahead, possibly beyond the end of the compressed data.
+// Closing it doesn't close r.
+func NewReader(r io.Reader, f Format) (io.ReadCloser, error) {
+	if Available(f) {
+		return newStreamReader(r, f), nil
+	}
+	switch f {
+	case Gzip:
+		return gzip.NewReader(r)
+	case Deflate:
+		return zlib.NewReader(r)
+	case DeflateRaw:
+		return flate.NewReader(r), nil
+	}
+	return nil, fmt.Errorf("compression: unsupported format %q", f)
+}
+
+// NewWriter returns a writer that compresses the data written to it in format
+// f and writes it to w. The compressed data is written asynchronously, it is
+// complete only after Close returns. Closing the writer doesn't close w.
+func NewWriter(w io.Writer, f Format) (io.WriteCloser, error) {
+	if Available(f) {
+		return newStreamWriter(w, f), nil
+	}
+	switch f {
+	case Gzip:
+		return gzip.NewWriter(w), nil
+	case Deflate:
+		return zlib.NewWriter(w), nil
+	case DeflateRaw:
+		return flate.NewWriter(w, flate.DefaultCompression)
+	}
+	return nil, fmt.Errorf("compression: unsupported format %q", f)
+}
+
+// streamReader reads from the readable side of a DecompressionStream, while a
+// separate goroutine feeds it the compressed data.
+type streamReader struct {
+	reader *js.Object // ReadableStreamDefaultReader
+	buf    []byte     // Unread part of the last chunk.
+	err    error      // Returned once buf is drained.
+	srcErr error      // Error reading the compressed data.
+}
+
+func newStreamReader(src io.Reader, f Format) *streamReader {
+	ds := js.Global.Get("DecompressionStream").New(string(f))
+	r := &streamReader{reader: ds.Get("readable").Call("getReader")}
+	go r.feed(src, ds.Get("writable").Call("getWriter"))
+	return r
+}
+
+func (r *streamReader) feed(src io.Reader, writer *js.Object) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			// The stream keeps the chunk, so it must not share memory with buf.
+			chunk := js.Global.Get("Uint8Array").New(buf[:n])
+			if _, err := await(writer.Call("write", chunk)); err != nil {
+				return // The reader was closed or the data is corrupt.
+			}
+		}
+		if err == io.EOF {
+			await(writer.Call("close")) // Reports corrupt data, which Read does too.
+			return
+		}
+		if err != nil {
+			r.srcErr = err
+			await(writer.Call("abort"))
+			return
+		}
+	}
+}
+
+func (r *streamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		result, err := await(r.reader.Call("read"))
+		switch {
+		case r.srcErr != nil:
+			r.err = r.srcErr
+		case err != nil:
+			r.err = err
+		case result.Get("done").Bool():
+			r.err = io.EOF
+		default:
+			r.buf = result.Get("value").Interface().([]byte)
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Close stops decompressing. It doesn't interrupt a pending read from the
+// underlying reader.
+func (r *streamReader) Close() error {
+	if r.err == nil || r.err == io.EOF {
+		r.reader.Call("cancel")
+	}
+	r.buf, r.err = nil, errClosed
+	return nil
+}
+
+var errClosed = errors.New("compression: closed")
+
+// streamWriter writes to the writable side of a CompressionStream, while a
+// separate goroutine writes the compressed data to the destination.
+type streamWriter struct {
+	writer *js.Object // WritableStreamDefaultWriter
+	done   chan error // Receives the result of pump.
+	err    error
+	dstErr error // Error writing the compressed data.
+}
+
+func newStreamWriter(dst io.Writer, f Format) *streamWriter {
+	cs := js.Global.Get("CompressionStream").New(string(f))
+	w := &streamWriter{writer: cs.Get("writable").Call("getWriter"), done: make(chan error, 1)}
+	go func() { w.done <- w.pump(cs.Get("readable").Call("getReader"), dst) }()
+	return w
+}
+
+func (w *streamWriter) pump(reader *js.Object, dst io.Writer) error {
+	for {
+		result, err := await(reader.Call("read"))
+		if err != nil {
+			return err
+		}
+		if result.Get("done").Bool() {
+			return nil
+		}
+		if _, err := dst.Write(result.Get("value").Interface().([]byte)); err != nil {
+			w.dstErr = err
+			reader.Call("cancel")
+			return err
+		}
+	}
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	// The stream keeps the chunk, so it must not share memory with p.
+	if _, err := await(w.writer.Call("write", js.Global.Get("Uint8Array").New(p))); err != nil {
+		w.err = err
+		if w.dstErr != nil {
+			w.err = w.dstErr
+		}
+		return 0, w.err
+	}
+	return len(p), nil
+}
+
+// Close flushes the compressed data and waits until it is written to the
+// destination.
+func (w *streamWriter) Close() error {
+	if w.err == errClosed {
+		return nil
+	}
+	if w.err == nil {
+		if _, err := await(w.writer.Call("close")); err != nil {
+			w.err = err
+		}
+	}
+	if err := <-w.done; err != nil && w.err == nil {
+		w.err = err
+	}
+	err := w.err
+	if w.dstErr != nil {
+		err = w.dstErr
+	}
+	w.err = errClosed
+	return err
+}
+
+// await blocks until the promise p settles, and returns its value or the reason
+// it was rejected for.
+func await(p *js.Object) (*js.Object, error) {
+	type result struct {
+		value *js.Object
+		err   error
+	}
+	c := make(chan result, 1)
+	p.Call("then", func(value *js.Object) {
+		c <- result{value: value}
+	}, func(reason *js.Object) {
+		c <- result{err: streamError(reason)}
+	})
+	res := <-c
+	return res.value, res.err
+}
+
+func streamError(reason *js.Object) error {
+	if reason == nil || reason == js.Undefined {
+		return &Error{Name: "AbortError", Message: "stream aborted"}
+	}
+	return &Error{Name: reason.Get("name").String(), Message: reason.Get("message").String()}
+}
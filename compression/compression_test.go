@@ -0,0 +1,164 @@
+//go:build js
+// +build js
+
+package compression
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+var data = []byte(strings.Repeat("Hello, GopherJS! ", 10000))
+
+// stdReaders and stdWriters use the compress packages, for checking that the
+// streams are compatible with them.
+var stdReaders = map[Format]func(io.Reader) (io.Reader, error){
+	Gzip:       func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) },
+	Deflate:    func(r io.Reader) (io.Reader, error) { return zlib.NewReader(r) },
+	DeflateRaw: func(r io.Reader) (io.Reader, error) { return flate.NewReader(r), nil },
+}
+
+var stdWriters = map[Format]func(io.Writer) io.WriteCloser{
+	Gzip:       func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) },
+	Deflate:    func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) },
+	DeflateRaw: func(w io.Writer) io.WriteCloser { zw, _ := flate.NewWriter(w, flate.BestSpeed); return zw },
+}
+
+func TestCompress(t *testing.T) {
+	for f, newStdReader := range stdReaders {
+		if !Available(f) {
+			t.Fatalf("Available(%q) = false", f)
+		}
+		var buf bytes.Buffer
+		zw, err := NewWriter(&buf, f)
+		if err != nil {
+			t.Fatalf("NewWriter(%q) returned error: %v", f, err)
+		}
+		for i := 0; i < len(data); i += 1000 {
+			if _, err := zw.Write(data[i : i+1000]); err != nil {
+				t.Fatalf("%s: Write returned error: %v", f, err)
+			}
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatalf("%s: Close returned error: %v", f, err)
+		}
+		if buf.Len() >= len(data)/10 {
+			t.Errorf("%s: compressed %d bytes to %d bytes", f, len(data), buf.Len())
+		}
+
+		zr, err := newStdReader(&buf)
+		if err != nil {
+			t.Fatalf("%s: reading the compressed data returned error: %v", f, err)
+		}
+		got, err := ioutil.ReadAll(zr)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("%s: decompressed %d bytes, %v; want %d bytes", f, len(got), err, len(data))
+		}
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	for f, newStdWriter := range stdWriters {
+		var buf bytes.Buffer
+		zw := newStdWriter(&buf)
+		zw.Write(data)
+		zw.Close()
+
+		// Read the compressed data in small pieces, and decompressed data in pieces
+		// smaller than the chunks of the stream.
+		zr, err := NewReader(&oneByteReader{&buf}, f)
+		if err != nil {
+			t.Fatalf("NewReader(%q) returned error: %v", f, err)
+		}
+		var got []byte
+		p := make([]byte, 100)
+		for {
+			n, err := zr.Read(p)
+			got = append(got, p[:n]...)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s: Read returned error: %v", f, err)
+			}
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%s: decompressed %d bytes, want %d bytes", f, len(got), len(data))
+		}
+		if err := zr.Close(); err != nil {
+			t.Errorf("%s: Close returned error: %v", f, err)
+		}
+	}
+}
+
+type oneByteReader struct{ r io.Reader }
+
+func (r *oneByteReader) Read(p []byte) (int, error) {
+	if len(p) > 1 {
+		p = p[:1]
+	}
+	return r.r.Read(p)
+}
+
+func TestDecompressErrors(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(data)
+	zw.Close()
+	compressed := buf.Bytes()
+
+	zr, _ := NewReader(bytes.NewReader(compressed[:len(compressed)/2]), Gzip)
+	if _, err := ioutil.ReadAll(zr); !errors.As(err, new(*Error)) {
+		t.Errorf("Reading truncated data returned %v, want an *Error", err)
+	}
+
+	zr, _ = NewReader(strings.NewReader("not gzip data"), Gzip)
+	if _, err := ioutil.ReadAll(zr); !errors.As(err, new(*Error)) {
+		t.Errorf("Reading corrupt data returned %v, want an *Error", err)
+	}
+
+	errRead := errors.New("read error")
+	zr, _ = NewReader(io.MultiReader(bytes.NewReader(compressed[:100]), &errReader{errRead}), Gzip)
+	if _, err := ioutil.ReadAll(zr); err != errRead {
+		t.Errorf("Reading from a failing reader returned %v, want %v", err, errRead)
+	}
+}
+
+type errReader struct{ err error }
+
+func (r *errReader) Read(p []byte) (int, error) { return 0, r.err }
+
+func TestCompressWriteError(t *testing.T) {
+	errWrite := errors.New("write error")
+	zw, _ := NewWriter(&errWriter{errWrite}, Gzip)
+	var err error
+	for i := 0; i < 100 && err == nil; i++ {
+		_, err = zw.Write(data)
+	}
+	if closeErr := zw.Close(); closeErr != errWrite || err != nil && err != errWrite {
+		t.Errorf("Writing to a failing writer returned %v and %v from Close, want %v", err, closeErr, errWrite)
+	}
+}
+
+type errWriter struct{ err error }
+
+func (w *errWriter) Write(p []byte) (int, error) { return 0, w.err }
+
+func TestUnsupportedFormat(t *testing.T) {
+	if Available("zstd-unknown") {
+		t.Errorf("Available(%q) = true", "zstd-unknown")
+	}
+	if _, err := NewReader(strings.NewReader(""), "zstd-unknown"); err == nil {
+		t.Errorf("NewReader with an unsupported format returned no error")
+	}
+	if _, err := NewWriter(ioutil.Discard, "zstd-unknown"); err == nil {
+		t.Errorf("NewWriter with an unsupported format returned no error")
+	}
+}
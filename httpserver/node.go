@@ -0,0 +1,97 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// listenNode serves requests using the Node.js "http" module.
+func (s *Server) listenNode() error {
+	addr := s.Addr
+	if addr == "" {
+		addr = DefaultAddr
+	}
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return fmt.Errorf("httpserver: invalid port in address %q", addr)
+	}
+
+	errCh := make(chan error, 1)
+	server := js.Global.Call("require", "http").Call("createServer", func(req, res *js.Object) {
+		s.serveNode(req, res)
+	})
+	server.Call("on", "error", func(err *js.Object) {
+		errCh <- errors.New(err.Get("message").String())
+	})
+	server.Call("on", "close", func() {
+		errCh <- http.ErrServerClosed
+	})
+	if host == "" {
+		server.Call("listen", port)
+	} else {
+		server.Call("listen", port, host)
+	}
+	return <-errCh
+}
+
+// serveNode handles a single request. It's called from a JavaScript callback,
+// so the handler itself is invoked on a new goroutine.
+func (s *Server) serveNode(req, res *js.Object) {
+	var body []byte
+	req.Call("on", "data", func(chunk *js.Object) {
+		body = append(body, js.Global.Get("Uint8Array").New(chunk).Interface().([]byte)...)
+	})
+	req.Call("on", "end", func() {
+		header := http.Header{}
+		raw := req.Get("rawHeaders")
+		for i := 0; i+1 < raw.Length(); i += 2 {
+			header.Add(raw.Index(i).String(), raw.Index(i+1).String())
+		}
+		r, err := newRequest(req.Get("method").String(), req.Get("url").String(), header, body)
+		if err != nil {
+			res.Call("writeHead", http.StatusBadRequest)
+			res.Call("end", err.Error())
+			return
+		}
+		r.ProtoMajor = req.Get("httpVersionMajor").Int()
+		r.ProtoMinor = req.Get("httpVersionMinor").Int()
+		r.Proto = fmt.Sprintf("HTTP/%d.%d", r.ProtoMajor, r.ProtoMinor)
+		socket := req.Get("socket")
+		r.RemoteAddr = net.JoinHostPort(socket.Get("remoteAddress").String(), socket.Get("remotePort").String())
+
+		// The request context is canceled when the client goes away, or the
+		// response has been sent.
+		ctx, cancel := context.WithCancel(context.Background())
+		res.Call("on", "close", func() { cancel() })
+		go s.serve(newResponseWriter(&nodeBackend{res: res}), r.WithContext(ctx))
+	})
+}
+
+// nodeBackend writes responses to a Node.js http.ServerResponse.
+type nodeBackend struct {
+	res *js.Object
+}
+
+func (b *nodeBackend) start(status int, header http.Header, body []byte, done bool) {
+	headers := js.Global.Get("Object").New()
+	for key, values := range header {
+		headers.Set(key, values)
+	}
+	b.res.Call("writeHead", status, headers)
+	if done {
+		b.res.Call("end", body)
+	}
+}
+
+func (b *nodeBackend) write(p []byte) { b.res.Call("write", p) }
+func (b *nodeBackend) end()           { b.res.Call("end") }
@@ -0,0 +1,230 @@
+// Package httpserver serves net/http handlers in JavaScript environments.
+//
+// Under Node.js, requests are accepted by a server created with the "http"
+// module. In a Service Worker, fetch events intercepted by the worker are
+// served, which allows the same handler code to run on the server and in the
+// browser:
+//
+//  func main() {
+//  	http.HandleFunc("/api/hello", hello)
+//  	log.Fatal(httpserver.ListenAndServe(nil))
+//  }
+//
+// In a Service Worker, ListenAndServe must be called before main blocks for the
+// first time, because fetch event listeners can only be added while the worker
+// script is being evaluated.
+//
+// Request bodies are read completely before the handler is called. Response
+// bodies are buffered until the handler returns or flushes the response using
+// http.Flusher, after which further writes are streamed to the client.
+package httpserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"runtime/debug"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// DefaultAddr is the address a Node.js server listens on if Server.Addr is empty.
+const DefaultAddr = ":8080"
+
+// ErrUnsupported is returned by ListenAndServe if the JavaScript environment is
+// neither Node.js nor a Service Worker.
+var ErrUnsupported = errors.New("httpserver: unsupported environment, expected Node.js or a Service Worker")
+
+var errFinished = errors.New("httpserver: write after the handler returned")
+
+// Server serves HTTP requests with a handler.
+type Server struct {
+	// Addr is the TCP address to listen on under Node.js, DefaultAddr if empty.
+	// It is ignored in a Service Worker.
+	Addr string
+	// Handler to invoke, http.DefaultServeMux if nil.
+	Handler http.Handler
+	// ErrorLog is used to log handler panics. If nil, the log package's
+	// standard logger is used.
+	ErrorLog *log.Logger
+}
+
+// ListenAndServe serves requests with handler, see Server.ListenAndServe.
+func ListenAndServe(handler http.Handler) error {
+	return (&Server{Handler: handler}).ListenAndServe()
+}
+
+// ListenAndServe starts serving requests in the current environment. It always
+// returns a non-nil error; in a Service Worker it blocks forever.
+func (s *Server) ListenAndServe() error {
+	switch {
+	case isServiceWorker():
+		s.serveFetchEvents()
+		select {}
+	case js.Global.Get("process") != js.Undefined && js.Global.Get("require") != js.Undefined:
+		return s.listenNode()
+	default:
+		return ErrUnsupported
+	}
+}
+
+func (s *Server) handler() http.Handler {
+	if s.Handler == nil {
+		return http.DefaultServeMux
+	}
+	return s.Handler
+}
+
+func (s *Server) logf(format string, args ...interface{}) {
+	if s.ErrorLog != nil {
+		s.ErrorLog.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
+
+// serve runs the handler and finalizes the response. It must be called on a
+// goroutine, since handlers may block.
+func (s *Server) serve(w *responseWriter, r *http.Request) {
+	defer func() {
+		if err := recover(); err != nil && err != http.ErrAbortHandler {
+			s.logf("httpserver: panic serving %s: %v\n%s", r.URL, err, debug.Stack())
+			if !w.started {
+				w.header = http.Header{}
+				w.status = 0
+				w.buf = nil
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		}
+		w.finish()
+	}()
+	s.handler().ServeHTTP(w, r)
+}
+
+// newRequest creates a server request from the parts common to all environments.
+func newRequest(method, requestURI string, header http.Header, body []byte) (*http.Request, error) {
+	u, err := url.ParseRequestURI(requestURI)
+	if err != nil {
+		return nil, err
+	}
+	r := &http.Request{
+		Method:        method,
+		URL:           u,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          http.NoBody,
+		ContentLength: int64(len(body)),
+		Host:          header.Get("Host"),
+		RequestURI:    requestURI,
+	}
+	if len(body) > 0 {
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	return r, nil
+}
+
+// backend delivers a response to the JavaScript environment.
+type backend interface {
+	// start sends the response status and headers. If done is true, body is the
+	// complete response body, otherwise the body follows in calls to write.
+	start(status int, header http.Header, body []byte, done bool)
+	write(p []byte)
+	end()
+}
+
+// responseWriter implements http.ResponseWriter and http.Flusher.
+type responseWriter struct {
+	backend backend
+	header  http.Header
+	status  int
+	buf     []byte
+	started bool
+	done    bool
+}
+
+func newResponseWriter(b backend) *responseWriter {
+	return &responseWriter{backend: b, header: http.Header{}}
+}
+
+func (w *responseWriter) Header() http.Header { return w.header }
+
+func (w *responseWriter) WriteHeader(status int) {
+	if w.status != 0 {
+		return
+	}
+	if status < 100 || status > 999 {
+		panic(fmt.Sprintf("invalid WriteHeader code %v", status))
+	}
+	w.status = status
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	if w.done {
+		return 0, errFinished
+	}
+	w.WriteHeader(http.StatusOK)
+	if !bodyAllowed(w.status) {
+		return 0, http.ErrBodyNotAllowed
+	}
+	if w.started {
+		w.backend.write(append([]byte(nil), p...))
+		return len(p), nil
+	}
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
+
+// Flush sends the headers and the buffered part of the body, and switches the
+// response to streaming mode.
+func (w *responseWriter) Flush() {
+	if w.done {
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if !w.started {
+		w.started = true
+		w.prepareHeader()
+		w.backend.start(w.status, w.header, nil, false)
+	}
+	if len(w.buf) > 0 {
+		w.backend.write(w.buf)
+		w.buf = nil
+	}
+}
+
+func (w *responseWriter) finish() {
+	if w.done {
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if !w.started {
+		w.started = true
+		w.prepareHeader()
+		if bodyAllowed(w.status) && w.header.Get("Content-Length") == "" {
+			w.header.Set("Content-Length", fmt.Sprint(len(w.buf)))
+		}
+		w.backend.start(w.status, w.header, w.buf, true)
+	} else {
+		w.backend.end()
+	}
+	w.buf = nil
+	w.done = true
+}
+
+// prepareHeader fills in the headers net/http would set implicitly.
+func (w *responseWriter) prepareHeader() {
+	if _, ok := w.header["Content-Type"]; !ok && bodyAllowed(w.status) && len(w.buf) > 0 {
+		w.header.Set("Content-Type", http.DetectContentType(w.buf))
+	}
+}
+
+// bodyAllowed reports whether a response with the given status may have a body.
+func bodyAllowed(status int) bool {
+	return !(status >= 100 && status <= 199) && status != http.StatusNoContent && status != http.StatusNotModified
+}
@@ -0,0 +1,115 @@
+package httpserver
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	stdlog "log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// recordingBackend records calls made by responseWriter.
+type recordingBackend struct {
+	events []string
+	header http.Header
+}
+
+func (b *recordingBackend) start(status int, header http.Header, body []byte, done bool) {
+	b.header = header.Clone()
+	b.events = append(b.events, fmt.Sprintf("start %d %q %v", status, body, done))
+}
+
+func (b *recordingBackend) write(p []byte) { b.events = append(b.events, fmt.Sprintf("write %q", p)) }
+func (b *recordingBackend) end()           { b.events = append(b.events, "end") }
+
+func TestServe(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		wantEvents []string
+		wantHeader http.Header
+	}{{
+		name: "buffered",
+		handler: func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, "<html>")
+			io.WriteString(w, "</html>")
+		},
+		wantEvents: []string{`start 200 "<html></html>" true`},
+		wantHeader: http.Header{"Content-Type": {"text/html; charset=utf-8"}, "Content-Length": {"13"}},
+	}, {
+		name: "streamed",
+		handler: func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			io.WriteString(w, "data: 1\n\n")
+			w.(http.Flusher).Flush()
+			io.WriteString(w, "data: 2\n\n")
+		},
+		wantEvents: []string{`start 200 "" false`, `write "data: 1\n\n"`, `write "data: 2\n\n"`, "end"},
+		wantHeader: http.Header{"Content-Type": {"text/event-stream"}},
+	}, {
+		name: "no content",
+		handler: func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			if _, err := io.WriteString(w, "ignored"); err != http.ErrBodyNotAllowed {
+				t.Errorf("Write() returned error %v, want %v", err, http.ErrBodyNotAllowed)
+			}
+		},
+		wantEvents: []string{`start 204 "" true`},
+		wantHeader: http.Header{},
+	}, {
+		name: "panic",
+		handler: func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Discarded", "1")
+			io.WriteString(w, "partial")
+			panic("boom")
+		},
+		wantEvents: []string{`start 500 "Internal Server Error\n" true`},
+		wantHeader: http.Header{
+			"Content-Type":           {"text/plain; charset=utf-8"},
+			"X-Content-Type-Options": {"nosniff"},
+			"Content-Length":         {"22"},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var log bytes.Buffer
+			s := &Server{Handler: test.handler}
+			s.ErrorLog = stdlog.New(&log, "", 0)
+			b := &recordingBackend{}
+			r, err := newRequest("GET", "/path?q=1", http.Header{"Host": {"example.com"}}, nil)
+			if err != nil {
+				t.Fatalf("newRequest() returned error: %s", err)
+			}
+			s.serve(newResponseWriter(b), r)
+			if !reflect.DeepEqual(b.events, test.wantEvents) {
+				t.Errorf("Got backend events %q, want %q", b.events, test.wantEvents)
+			}
+			if !reflect.DeepEqual(b.header, test.wantHeader) {
+				t.Errorf("Got response header %v, want %v", b.header, test.wantHeader)
+			}
+			if panicked := strings.Contains(log.String(), "panic serving /path?q=1: boom"); panicked != (test.name == "panic") {
+				t.Errorf("Got error log %q", log.String())
+			}
+		})
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	r, err := newRequest("POST", "/submit?a=b", http.Header{"Host": {"example.com"}}, []byte("body"))
+	if err != nil {
+		t.Fatalf("newRequest() returned error: %s", err)
+	}
+	if r.URL.Path != "/submit" || r.URL.Query().Get("a") != "b" || r.Host != "example.com" || r.ContentLength != 4 {
+		t.Errorf("Got request %+v", r)
+	}
+	if body, _ := io.ReadAll(r.Body); string(body) != "body" {
+		t.Errorf("Got body %q, want %q", body, "body")
+	}
+	if _, err := newRequest("GET", "not a uri", http.Header{}, nil); err == nil {
+		t.Errorf("newRequest() with invalid request URI returned no error")
+	}
+}
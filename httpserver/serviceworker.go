@@ -0,0 +1,88 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+func isServiceWorker() bool {
+	scope := js.Global.Get("ServiceWorkerGlobalScope")
+	return scope != js.Undefined && scope.Get("prototype").Call("isPrototypeOf", js.Global).Bool()
+}
+
+// serveFetchEvents serves fetch events intercepted by the Service Worker.
+// Requests to other origins are not intercepted and reach the network.
+func (s *Server) serveFetchEvents() {
+	origin := js.Global.Get("location").Get("origin").String()
+	js.Global.Call("addEventListener", "fetch", func(event *js.Object) {
+		req := event.Get("request")
+		u, err := url.Parse(req.Get("url").String())
+		if err != nil || u.Scheme+"://"+u.Host != origin {
+			return
+		}
+
+		// The response must be promised synchronously, before the event handler
+		// returns.
+		b := &fetchBackend{}
+		event.Call("respondWith", js.Global.Get("Promise").New(func(resolve, reject *js.Object) {
+			b.resolve = resolve
+		}))
+
+		req.Call("arrayBuffer").Call("then", func(buf *js.Object) {
+			header := http.Header{}
+			req.Get("headers").Call("forEach", func(value, key *js.Object) {
+				header.Add(key.String(), value.String())
+			})
+			body := js.Global.Get("Uint8Array").New(buf).Interface().([]byte)
+			r, err := newRequest(req.Get("method").String(), u.RequestURI(), header, body)
+			if err != nil {
+				b.start(http.StatusBadRequest, http.Header{}, []byte(err.Error()), true)
+				return
+			}
+			r.Host = u.Host
+			go s.serve(newResponseWriter(b), r)
+		})
+	})
+}
+
+// fetchBackend resolves a fetch event with a Response object.
+type fetchBackend struct {
+	resolve    *js.Object
+	controller *js.Object
+}
+
+func (b *fetchBackend) start(status int, header http.Header, body []byte, done bool) {
+	headers := js.Global.Get("Headers").New()
+	for key, values := range header {
+		for _, value := range values {
+			headers.Call("append", key, value)
+		}
+	}
+	init := js.M{
+		"status":     status,
+		"statusText": http.StatusText(status),
+		"headers":    headers,
+	}
+
+	var responseBody interface{}
+	switch {
+	case !done:
+		// The ReadableStream constructor calls start synchronously.
+		responseBody = js.Global.Get("ReadableStream").New(js.M{
+			"start": func(controller *js.Object) { b.controller = controller },
+		})
+	case len(body) > 0 && bodyAllowed(status):
+		responseBody = body
+	}
+	b.resolve.Invoke(js.Global.Get("Response").New(responseBody, init))
+}
+
+func (b *fetchBackend) write(p []byte) {
+	b.controller.Call("enqueue", js.Global.Get("Uint8Array").New(p))
+}
+
+func (b *fetchBackend) end() {
+	b.controller.Call("close")
+}
@@ -0,0 +1,201 @@
+package idb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FS returns a read-only file system backed by the object store with the given
+// name.
+//
+// Keys are treated as slash-separated file paths, as accepted by fs.ValidPath,
+// and values as file contents. Directories are implicit: a directory exists if
+// there are keys with its path as a prefix. Modification times are not tracked.
+func (db *DB) FS(store string) fs.FS {
+	return &storeFS{db: db, store: store}
+}
+
+type storeFS struct {
+	db    *DB
+	store string
+}
+
+var (
+	_ fs.ReadFileFS = (*storeFS)(nil)
+	_ fs.ReadDirFS  = (*storeFS)(nil)
+)
+
+func (f *storeFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	var (
+		data  []byte
+		keys  []string
+		found bool
+	)
+	err := f.db.View(context.Background(), func(tx *Tx) error {
+		var err error
+		if name != "." {
+			data, err = tx.Store(f.store).Get(name)
+			if err == nil {
+				found = true
+				return nil
+			}
+			if err != ErrNotFound {
+				return err
+			}
+		}
+		keys, err = listKeys(tx.Store(f.store), dirPrefix(name))
+		return err
+	}, f.store)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	if found {
+		return &file{Reader: bytes.NewReader(data), info: fileInfo{name: baseName(name), size: int64(len(data))}}, nil
+	}
+	if len(keys) == 0 && name != "." {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return &dir{info: fileInfo{name: baseName(name), dir: true}, entries: dirEntries(dirPrefix(name), keys)}, nil
+}
+
+func (f *storeFS) ReadFile(name string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+	var data []byte
+	err := f.db.View(context.Background(), func(tx *Tx) error {
+		var err error
+		data, err = tx.Store(f.store).Get(name)
+		return err
+	}, f.store)
+	if err == ErrNotFound {
+		err = fs.ErrNotExist
+	}
+	if err != nil {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: err}
+	}
+	return data, nil
+}
+
+func (f *storeFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	file, err := f.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	d, ok := file.(*dir)
+	if !ok {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errNotDir}
+	}
+	return d.entries, nil
+}
+
+var errNotDir = errors.New("not a directory")
+
+// listKeys returns all keys with the given prefix.
+func listKeys(s *Store, prefix string) ([]string, error) {
+	var keys []string
+	c := s.Cursor(prefix)
+	for c.Next() {
+		keys = append(keys, c.Key())
+	}
+	return keys, c.Err()
+}
+
+// dirPrefix returns the prefix shared by keys of files within the directory.
+func dirPrefix(name string) string {
+	if name == "." {
+		return ""
+	}
+	return name + "/"
+}
+
+func baseName(name string) string {
+	return name[strings.LastIndexByte(name, '/')+1:]
+}
+
+// dirEntries returns sorted entries of the directory with the given prefix,
+// which contains files with the given keys.
+func dirEntries(prefix string, keys []string) []fs.DirEntry {
+	seen := map[string]bool{}
+	var entries []fs.DirEntry
+	for _, key := range keys {
+		rest := strings.TrimPrefix(key, prefix)
+		isDir := false
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			rest, isDir = rest[:i], true
+		}
+		if seen[rest] {
+			continue
+		}
+		seen[rest] = true
+		// The size of a file isn't known without reading it, so it's reported as 0.
+		entries = append(entries, fileInfo{name: rest, dir: isDir})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries
+}
+
+// fileInfo implements both fs.FileInfo and fs.DirEntry.
+type fileInfo struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (i fileInfo) Name() string               { return i.name }
+func (i fileInfo) Size() int64                { return i.size }
+func (i fileInfo) ModTime() time.Time         { return time.Time{} }
+func (i fileInfo) IsDir() bool                { return i.dir }
+func (i fileInfo) Sys() interface{}           { return nil }
+func (i fileInfo) Type() fs.FileMode          { return i.Mode().Type() }
+func (i fileInfo) Info() (fs.FileInfo, error) { return i, nil }
+
+func (i fileInfo) Mode() fs.FileMode {
+	if i.dir {
+		return fs.ModeDir | 0555
+	}
+	return 0444
+}
+
+type file struct {
+	*bytes.Reader
+	info fileInfo
+}
+
+func (f *file) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *file) Close() error               { return nil }
+
+type dir struct {
+	info    fileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *dir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *dir) Close() error               { return nil }
+
+func (d *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	entries := d.entries[d.offset:]
+	if n > 0 && len(entries) == 0 {
+		return nil, io.EOF
+	}
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	d.offset += len(entries)
+	return entries, nil
+}
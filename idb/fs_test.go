@@ -0,0 +1,67 @@
+package idb
+
+import (
+	"io/fs"
+	"reflect"
+	"testing"
+)
+
+func TestDirEntries(t *testing.T) {
+	tests := []struct {
+		prefix string
+		keys   []string
+		want   []fileInfo
+	}{{
+		prefix: "",
+		keys:   nil,
+		want:   nil,
+	}, {
+		prefix: "",
+		keys:   []string{"a.txt", "b/c.txt", "b/d/e.txt", "b/f.txt", "c"},
+		want:   []fileInfo{{name: "a.txt"}, {name: "b", dir: true}, {name: "c"}},
+	}, {
+		prefix: "b/",
+		keys:   []string{"b/c.txt", "b/d/e.txt", "b/d/f.txt", "b/f.txt"},
+		want:   []fileInfo{{name: "c.txt"}, {name: "d", dir: true}, {name: "f.txt"}},
+	}, {
+		// Sorting of keys differs from sorting of names: '.' < '/'.
+		prefix: "",
+		keys:   []string{"x.y", "x/z"},
+		want:   []fileInfo{{name: "x", dir: true}, {name: "x.y"}},
+	}}
+
+	for _, test := range tests {
+		entries := dirEntries(test.prefix, test.keys)
+		var got []fileInfo
+		for _, e := range entries {
+			got = append(got, e.(fileInfo))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("dirEntries(%q, %q) returned %v, want %v", test.prefix, test.keys, got, test.want)
+		}
+	}
+}
+
+func TestDirReadDir(t *testing.T) {
+	d := &dir{entries: dirEntries("", []string{"a", "b", "c"})}
+	entries, err := d.ReadDir(2)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("ReadDir(2) returned %d entries, %v; want 2, nil", len(entries), err)
+	}
+	entries, err = d.ReadDir(2)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("ReadDir(2) returned %d entries, %v; want 1, nil", len(entries), err)
+	}
+	if _, err := d.ReadDir(1); err == nil {
+		t.Errorf("ReadDir(1) at the end of directory returned no error, want io.EOF")
+	}
+	if entries, err := d.ReadDir(-1); err != nil || len(entries) != 0 {
+		t.Errorf("ReadDir(-1) at the end of directory returned %d entries, %v; want 0, nil", len(entries), err)
+	}
+	if got := dirEntries("", []string{"a"})[0].Type(); got != 0 {
+		t.Errorf("Got file type %v, want regular file", got)
+	}
+	if got := dirEntries("", []string{"a/b"})[0].Type(); got != fs.ModeDir {
+		t.Errorf("Got file type %v, want directory", got)
+	}
+}
@@ -0,0 +1,316 @@
+// Package idb provides a Go API for the browser's IndexedDB key-value storage.
+//
+// Object stores map string keys to byte slice values, which makes them
+// suitable both as a key-value store and for storing blobs of data. All
+// operations are performed within a transaction:
+//
+//  db, err := idb.Open(ctx, "app", 1, func(u *idb.Upgrade) error {
+//  	return u.CreateStore("files")
+//  })
+//  ...
+//  err = db.Update(ctx, func(tx *idb.Tx) error {
+//  	return tx.Store("files").Put("hello.txt", []byte("Hello, world!"))
+//  }, "files")
+//
+// IndexedDB commits a transaction automatically as soon as control returns to
+// the JavaScript event loop with no requests pending. Operations of this
+// package block the calling goroutine until the request completes, and the
+// goroutine is resumed synchronously when it does. Therefore, within a
+// transaction, it is safe to make any number of requests one after another,
+// but blocking on anything else (channels, timers, network requests, etc.)
+// causes the transaction to be committed early, and subsequent requests fail
+// with a TransactionInactiveError.
+//
+// This package only works in a JavaScript environment that provides IndexedDB,
+// such as a web browser or a web worker.
+package idb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var (
+	// ErrUnsupported is returned by Open and DeleteDatabase if IndexedDB is not
+	// available in the current environment.
+	ErrUnsupported = errors.New("idb: IndexedDB is not supported")
+	// ErrNotFound is returned by Store.Get if the key doesn't exist.
+	ErrNotFound = errors.New("idb: key not found")
+	// ErrBlocked is returned by Open and DeleteDatabase if the operation can't
+	// proceed because other connections to the database remain open.
+	ErrBlocked = errors.New("idb: blocked by another open connection")
+)
+
+// Error is a DOMException reported by IndexedDB.
+type Error struct {
+	// Name of the exception, e.g. "ConstraintError" or "QuotaExceededError".
+	Name    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("idb: %s: %s", e.Name, e.Message)
+}
+
+func domError(err *js.Object) error {
+	if err == nil || err == js.Undefined {
+		return nil
+	}
+	return &Error{Name: err.Get("name").String(), Message: err.Get("message").String()}
+}
+
+// catch converts JavaScript exceptions thrown by IndexedDB methods, which
+// GopherJS turns into panics, into errors.
+func catch(err *error) {
+	e := recover()
+	if e == nil {
+		return
+	}
+	if jsErr, ok := e.(*js.Error); ok {
+		*err = domError(jsErr.Object)
+		return
+	}
+	panic(e)
+}
+
+func factory() *js.Object {
+	f := js.Global.Get("indexedDB")
+	if f == js.Undefined {
+		return nil
+	}
+	return f
+}
+
+// wait blocks until the IDBRequest req succeeds or fails, or ctx is done. The
+// onCancel callback is invoked if ctx is done first.
+func wait(ctx context.Context, req *js.Object, onCancel func()) (*js.Object, error) {
+	// Handlers run in JavaScript callbacks and must not block, hence the buffer.
+	done := make(chan struct{}, 1)
+	req.Set("onsuccess", func(*js.Object) { done <- struct{}{} })
+	req.Set("onerror", func(*js.Object) { done <- struct{}{} })
+	select {
+	case <-done:
+	case <-ctx.Done():
+		onCancel()
+		return nil, ctx.Err()
+	}
+	if err := domError(req.Get("error")); err != nil {
+		return nil, err
+	}
+	return req.Get("result"), nil
+}
+
+// DB is a connection to an IndexedDB database.
+type DB struct {
+	db *js.Object
+}
+
+// Upgrade provides access to the database schema while the database is being
+// created or upgraded to a new version.
+type Upgrade struct {
+	db *js.Object
+	// OldVersion is the version of the database before the upgrade, 0 if the
+	// database has just been created.
+	OldVersion int
+	// NewVersion is the version the database is being upgraded to.
+	NewVersion int
+}
+
+// CreateStore creates a new object store.
+func (u *Upgrade) CreateStore(name string) (err error) {
+	defer catch(&err)
+	u.db.Call("createObjectStore", name)
+	return nil
+}
+
+// DeleteStore deletes an object store along with all its data.
+func (u *Upgrade) DeleteStore(name string) (err error) {
+	defer catch(&err)
+	u.db.Call("deleteObjectStore", name)
+	return nil
+}
+
+// Stores returns names of all object stores in the database.
+func (u *Upgrade) Stores() []string {
+	return storeNames(u.db)
+}
+
+// Open opens the database with the given name, creating it if it doesn't exist.
+//
+// If version is greater than the current version of the database, upgrade is
+// called to bring the schema up to date; if upgrade returns an error, the
+// upgrade is rolled back and Open returns the error. Upgrade is called from a
+// JavaScript callback and must not block. If version is 0, the current version
+// is opened, or version 1 if the database doesn't exist.
+func Open(ctx context.Context, name string, version int, upgrade func(u *Upgrade) error) (db *DB, err error) {
+	defer catch(&err)
+	f := factory()
+	if f == nil {
+		return nil, ErrUnsupported
+	}
+
+	var req *js.Object
+	if version == 0 {
+		req = f.Call("open", name)
+	} else {
+		req = f.Call("open", name, version)
+	}
+
+	var upgradeErr error
+	req.Set("onupgradeneeded", func(event *js.Object) {
+		if upgrade == nil {
+			return
+		}
+		u := &Upgrade{
+			db:         req.Get("result"),
+			OldVersion: event.Get("oldVersion").Int(),
+			NewVersion: event.Get("newVersion").Int(),
+		}
+		if upgradeErr = upgrade(u); upgradeErr != nil {
+			req.Get("transaction").Call("abort")
+		}
+	})
+	blocked := make(chan struct{}, 1)
+	req.Set("onblocked", func(*js.Object) { blocked <- struct{}{} })
+
+	// If the caller gives up before the request completes, the connection is
+	// closed as soon as it's opened so that it doesn't block future upgrades.
+	abandoned := false
+	result := make(chan error, 1)
+	go func() {
+		conn, err := wait(ctx, req, func() {
+			req.Set("onsuccess", func(*js.Object) { req.Get("result").Call("close") })
+		})
+		if abandoned && err == nil {
+			conn.Call("close")
+			return
+		}
+		result <- err
+	}()
+
+	select {
+	case err = <-result:
+	case <-blocked:
+		// The request remains pending until other connections are closed.
+		abandoned = true
+		return nil, ErrBlocked
+	}
+	if upgradeErr != nil {
+		return nil, upgradeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &DB{db: req.Get("result")}, nil
+}
+
+// DeleteDatabase deletes the database with the given name. It is not an error
+// if the database doesn't exist.
+func DeleteDatabase(ctx context.Context, name string) (err error) {
+	defer catch(&err)
+	f := factory()
+	if f == nil {
+		return ErrUnsupported
+	}
+	req := f.Call("deleteDatabase", name)
+	blocked := make(chan struct{}, 1)
+	req.Set("onblocked", func(*js.Object) { blocked <- struct{}{} })
+	result := make(chan error, 1)
+	go func() {
+		_, err := wait(ctx, req, func() {})
+		result <- err
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-blocked:
+		return ErrBlocked
+	}
+}
+
+// Close closes the connection. It waits for all pending transactions to
+// complete.
+func (db *DB) Close() error {
+	db.db.Call("close")
+	return nil
+}
+
+// Name returns the name of the database.
+func (db *DB) Name() string {
+	return db.db.Get("name").String()
+}
+
+// Version returns the version of the database.
+func (db *DB) Version() int {
+	return db.db.Get("version").Int()
+}
+
+// Stores returns names of all object stores in the database.
+func (db *DB) Stores() []string {
+	return storeNames(db.db)
+}
+
+func storeNames(db *js.Object) []string {
+	list := db.Get("objectStoreNames")
+	names := make([]string, list.Length())
+	for i := range names {
+		names[i] = list.Call("item", i).String()
+	}
+	return names
+}
+
+// View executes fn within a read-only transaction on the given object stores,
+// or all object stores if none are given.
+func (db *DB) View(ctx context.Context, fn func(tx *Tx) error, stores ...string) error {
+	return db.run(ctx, "readonly", fn, stores)
+}
+
+// Update executes fn within a read-write transaction on the given object
+// stores, or all object stores if none are given. If fn returns an error, the
+// transaction is rolled back, otherwise it is committed.
+func (db *DB) Update(ctx context.Context, fn func(tx *Tx) error, stores ...string) error {
+	return db.run(ctx, "readwrite", fn, stores)
+}
+
+func (db *DB) run(ctx context.Context, mode string, fn func(tx *Tx) error, stores []string) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(stores) == 0 {
+		stores = db.Stores()
+	}
+
+	tx, err := db.begin(ctx, mode, stores)
+	if err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	tx.tx.Set("oncomplete", func(*js.Object) { done <- nil })
+	tx.tx.Set("onabort", func(*js.Object) {
+		err := domError(tx.tx.Get("error"))
+		if err == nil {
+			err = &Error{Name: "AbortError", Message: "transaction was aborted"}
+		}
+		done <- err
+	})
+
+	if err := fn(tx); err != nil {
+		tx.abort()
+		return err
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		tx.abort()
+		return ctx.Err()
+	}
+}
+
+func (db *DB) begin(ctx context.Context, mode string, stores []string) (tx *Tx, err error) {
+	defer catch(&err)
+	return &Tx{ctx: ctx, tx: db.db.Call("transaction", stores, mode)}, nil
+}
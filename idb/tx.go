@@ -0,0 +1,184 @@
+package idb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Tx is an IndexedDB transaction. It is only valid within the function passed
+// to DB.View or DB.Update.
+type Tx struct {
+	ctx context.Context
+	tx  *js.Object
+}
+
+// Store returns the object store with the given name. The store must be in
+// the scope of the transaction.
+func (tx *Tx) Store(name string) *Store {
+	return &Store{tx: tx, name: name}
+}
+
+func (tx *Tx) abort() {
+	// Aborting a transaction that has already finished throws, which is fine.
+	defer func() { recover() }()
+	tx.tx.Call("abort")
+}
+
+// start issues an IndexedDB request by calling method on the object store.
+func (tx *Tx) start(store string, method string, args ...interface{}) (req *js.Object, err error) {
+	defer catch(&err)
+	return tx.tx.Call("objectStore", store).Call(method, args...), nil
+}
+
+// request issues an IndexedDB request and waits for its result.
+func (tx *Tx) request(store string, method string, args ...interface{}) (*js.Object, error) {
+	req, err := tx.start(store, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	return wait(tx.ctx, req, tx.abort)
+}
+
+// Store is an object store accessed within a transaction.
+type Store struct {
+	tx   *Tx
+	name string
+}
+
+// Get returns the value stored under key, or ErrNotFound.
+func (s *Store) Get(key string) ([]byte, error) {
+	result, err := s.tx.request(s.name, "get", key)
+	if err != nil {
+		return nil, err
+	}
+	if result == js.Undefined {
+		return nil, ErrNotFound
+	}
+	return bytesValue(result)
+}
+
+// Put stores value under key, replacing the existing value if any.
+func (s *Store) Put(key string, value []byte) error {
+	// Copy the value, otherwise the whole underlying buffer of a subslice would
+	// be stored.
+	_, err := s.tx.request(s.name, "put", js.Global.Get("Uint8Array").New(value), key)
+	return err
+}
+
+// Delete removes the value stored under key. It is not an error if the key
+// doesn't exist.
+func (s *Store) Delete(key string) error {
+	_, err := s.tx.request(s.name, "delete", key)
+	return err
+}
+
+// Clear removes all values from the object store.
+func (s *Store) Clear() error {
+	_, err := s.tx.request(s.name, "clear")
+	return err
+}
+
+// Count returns the number of values in the object store.
+func (s *Store) Count() (int, error) {
+	result, err := s.tx.request(s.name, "count")
+	if err != nil {
+		return 0, err
+	}
+	return result.Int(), nil
+}
+
+// Cursor returns a cursor over the values with keys starting with prefix, in
+// ascending key order. An empty prefix iterates over the whole object store.
+func (s *Store) Cursor(prefix string) *Cursor {
+	c := &Cursor{tx: s.tx}
+	var args []interface{}
+	if prefix != "" {
+		// Any key starting with prefix is less than prefix followed by the
+		// largest UTF-16 code unit, except for that key itself.
+		args = append(args, js.Global.Get("IDBKeyRange").Call("bound", prefix, prefix+"\uffff"))
+	}
+	c.req, c.err = s.tx.start(s.name, "openCursor", args...)
+	return c
+}
+
+// Cursor iterates over values of an object store:
+//
+//  c := store.Cursor("")
+//  for c.Next() {
+//  	fmt.Println(c.Key(), len(c.Value()))
+//  }
+//  if err := c.Err(); err != nil {
+//  	...
+//  }
+type Cursor struct {
+	tx      *Tx
+	req     *js.Object
+	cursor  *js.Object
+	started bool
+	done    bool
+	err     error
+}
+
+// Next advances the cursor to the next value, which then becomes available
+// through Key and Value. It returns false when there are no more values or an
+// error occurred.
+func (c *Cursor) Next() bool {
+	if c.done || c.err != nil {
+		return false
+	}
+	if c.started {
+		if c.err = c.advance(); c.err != nil {
+			return false
+		}
+	}
+	c.started = true
+	result, err := wait(c.tx.ctx, c.req, c.tx.abort)
+	if err != nil {
+		c.err = err
+		return false
+	}
+	if result == nil {
+		c.done = true
+		c.cursor = nil
+		return false
+	}
+	c.cursor = result
+	return true
+}
+
+func (c *Cursor) advance() (err error) {
+	defer catch(&err)
+	c.cursor.Call("continue")
+	return nil
+}
+
+// Key returns the key of the current value.
+func (c *Cursor) Key() string {
+	return c.cursor.Get("key").String()
+}
+
+// Value returns the current value.
+func (c *Cursor) Value() []byte {
+	b, err := bytesValue(c.cursor.Get("value"))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (c *Cursor) Err() error {
+	return c.err
+}
+
+// bytesValue converts a stored value to a byte slice. Values written by other
+// code may have a different type.
+func bytesValue(v *js.Object) ([]byte, error) {
+	b, ok := v.Interface().([]byte)
+	if !ok {
+		return nil, errors.New("idb: stored value is not a Uint8Array")
+	}
+	return b, nil
+}
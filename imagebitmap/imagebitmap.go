@@ -0,0 +1,130 @@
+// Package imagebitmap decodes images with the image decoders of the browser,
+// using createImageBitmap and a canvas to read the pixels.
+//
+// Running the decoders of image/png and image/jpeg compiled to JavaScript is
+// slow, and including them increases the size of the program considerably.
+// Programs that only load images can decode them with this package instead:
+//
+//  img, format, err := imagebitmap.Decode(r)
+//
+// Decode supports all the formats the browser does, typically PNG, JPEG, GIF,
+// WebP and BMP. Animated images are decoded to their first frame. Color
+// profiles and gamma information are ignored. Browsers may store the pixels of
+// a canvas with premultiplied alpha, so color values of translucent pixels may
+// be slightly off.
+//
+// Where createImageBitmap or a canvas is not available, e.g. under Node.js,
+// Decode falls back to image.Decode, which requires the decoders to be
+// registered by importing their packages.
+package imagebitmap
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/ioutil"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Available reports whether images are decoded by the browser.
+func Available() bool {
+	if js.Global.Get("createImageBitmap") == js.Undefined {
+		return false
+	}
+	return js.Global.Get("OffscreenCanvas") != js.Undefined || js.Global.Get("document") != js.Undefined
+}
+
+// Decode decodes an image from r, returning the image as an *image.NRGBA and
+// the name of its format, like image.Decode.
+func Decode(r io.Reader) (image.Image, string, error) {
+	if !Available() {
+		return image.Decode(r)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, "", err
+	}
+	format := sniff(data)
+	if format == "" {
+		return nil, "", image.ErrFormat
+	}
+
+	blob := js.Global.Get("Blob").New([]interface{}{data}, js.M{"type": "image/" + format})
+	bitmap, err := await(js.Global.Call("createImageBitmap", blob, js.M{
+		"premultiplyAlpha":     "none",
+		"colorSpaceConversion": "none",
+	}))
+	if err != nil {
+		return nil, "", fmt.Errorf("imagebitmap: decoding %s image: %v", format, err)
+	}
+	defer bitmap.Call("close")
+
+	w, h := bitmap.Get("width").Int(), bitmap.Get("height").Int()
+	ctx := newCanvas(w, h).Call("getContext", "2d")
+	if ctx == nil {
+		return nil, "", errors.New("imagebitmap: no 2d canvas context")
+	}
+	ctx.Call("drawImage", bitmap, 0, 0)
+	pixels := ctx.Call("getImageData", 0, 0, w, h).Get("data")
+	return &image.NRGBA{
+		Pix:    js.Global.Get("Uint8Array").New(pixels.Get("buffer"), pixels.Get("byteOffset"), pixels.Get("length")).Interface().([]byte),
+		Stride: 4 * w,
+		Rect:   image.Rect(0, 0, w, h),
+	}, format, nil
+}
+
+func newCanvas(w, h int) *js.Object {
+	if c := js.Global.Get("OffscreenCanvas"); c != js.Undefined {
+		return c.New(w, h)
+	}
+	canvas := js.Global.Get("document").Call("createElement", "canvas")
+	canvas.Set("width", w)
+	canvas.Set("height", h)
+	return canvas
+}
+
+// formats maps the magic numbers of image formats to their names, which are
+// also the subtypes of their MIME types.
+var formats = []struct {
+	magic, name string
+}{
+	{"\x89PNG\r\n\x1a\n", "png"},
+	{"\xff\xd8", "jpeg"},
+	{"GIF87a", "gif"},
+	{"GIF89a", "gif"},
+	{"BM", "bmp"},
+}
+
+// sniff returns the name of the format of the image data, or an empty string
+// if it is unknown.
+func sniff(data []byte) string {
+	for _, f := range formats {
+		if bytes.HasPrefix(data, []byte(f.magic)) {
+			return f.name
+		}
+	}
+	if len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP" {
+		return "webp"
+	}
+	return ""
+}
+
+// await blocks until the promise p settles, and returns its value or the reason
+// it was rejected for.
+func await(p *js.Object) (*js.Object, error) {
+	type result struct {
+		value *js.Object
+		err   error
+	}
+	c := make(chan result, 1)
+	p.Call("then", func(value *js.Object) {
+		c <- result{value: value}
+	}, func(reason *js.Object) {
+		c <- result{err: &js.Error{Object: reason}}
+	})
+	res := <-c
+	return res.value, res.err
+}
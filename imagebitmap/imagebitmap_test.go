@@ -0,0 +1,103 @@
+// +build js
+
+package imagebitmap
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// fakeBrowser installs fake createImageBitmap and OffscreenCanvas functions,
+// which decode any image into two pixels, and returns a function that removes
+// them. The MIME type of the last decoded blob is stored in lastType.
+var fakeBrowser = js.Global.Call("eval", `(function() {
+  globalThis.createImageBitmap = function(blob, options) {
+    globalThis.lastType = blob.type;
+    if (blob.size < 4) { return Promise.reject(new Error("InvalidStateError")); }
+    return Promise.resolve({width: 2, height: 1, close: function() {}});
+  };
+  globalThis.OffscreenCanvas = function(w, h) {
+    this.getContext = function() {
+      return {
+        drawImage: function() {},
+        getImageData: function(x, y, w, h) {
+          return {data: new Uint8ClampedArray([255, 0, 0, 255, 0, 0, 255, 128])};
+        }
+      };
+    };
+  };
+  return function() {
+    delete globalThis.createImageBitmap;
+    delete globalThis.OffscreenCanvas;
+  };
+})`)
+
+func TestDecode(t *testing.T) {
+	cleanup := fakeBrowser.Invoke()
+	defer cleanup.Invoke()
+
+	if !Available() {
+		t.Fatal("Available() = false with createImageBitmap and OffscreenCanvas")
+	}
+	img, format, err := Decode(strings.NewReader("\x89PNG\r\n\x1a\nrest of the image"))
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if format != "png" || js.Global.Get("lastType").String() != "image/png" {
+		t.Errorf("Decode returned format %q for a blob of type %q, want png", format, js.Global.Get("lastType"))
+	}
+	want := &image.NRGBA{Pix: []byte{255, 0, 0, 255, 0, 0, 255, 128}, Stride: 8, Rect: image.Rect(0, 0, 2, 1)}
+	if !reflect.DeepEqual(img, want) {
+		t.Errorf("Decode returned %v, want %v", img, want)
+	}
+
+	if _, _, err := Decode(strings.NewReader("not an image")); err != image.ErrFormat {
+		t.Errorf("Decode of unknown data returned %v, want %v", err, image.ErrFormat)
+	}
+	if _, _, err := Decode(strings.NewReader("\xff\xd8")); err == nil {
+		t.Errorf("Decode of a broken JPEG image returned no error")
+	}
+}
+
+func TestSniff(t *testing.T) {
+	tests := map[string]string{
+		"\x89PNG\r\n\x1a\n...":         "png",
+		"\xff\xd8\xff\xe0":             "jpeg",
+		"GIF89a...":                    "gif",
+		"BM...":                        "bmp",
+		"RIFF\x00\x00\x00\x00WEBPVP8 ": "webp",
+		"RIFF\x00\x00\x00\x00WAVE":     "",
+		"":                             "",
+	}
+	for data, want := range tests {
+		if got := sniff([]byte(data)); got != want {
+			t.Errorf("sniff(%q) = %q, want %q", data, got, want)
+		}
+	}
+}
+
+func TestDecodeFallback(t *testing.T) {
+	if Available() {
+		t.Skip("images are decoded by the browser")
+	}
+	src := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	src.Set(1, 1, color.NRGBA{1, 2, 3, 4})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+	img, format, err := Decode(&buf)
+	if err != nil || format != "png" {
+		t.Fatalf("Decode returned %q, %v; want png", format, err)
+	}
+	if got := color.NRGBAModel.Convert(img.At(1, 1)); got != (color.NRGBA{1, 2, 3, 4}) {
+		t.Errorf("Decoded pixel is %v, want %v", got, color.NRGBA{1, 2, 3, 4})
+	}
+}
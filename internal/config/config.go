@@ -0,0 +1,404 @@
+// Package config reads gopherjs.toml and gopherjs.json project configuration
+// files, which set defaults for the command line flags of gopherjs commands,
+// so that long command lines don't have to be repeated across CI and developer
+// machines.
+//
+// Settings are named after flags, without dashes. Settings at the top level
+// apply to all commands that have the flag, and settings in a section named
+// after a command, like [build] or [serve], only apply to that command and
+// take precedence. Flags set on the command line take precedence over both:
+//
+//	minify = true
+//	tags = "dev"
+//
+//	[build]
+//	format = "esm"
+//	size-budget = ["all=300KB"]
+//
+//	[serve]
+//	http = ":3000"
+//
+// Only the subset of TOML needed for this is supported: comments, [section]
+// headers, and key = value pairs whose values are strings, booleans, numbers
+// or arrays of them. The JSON form is an object with the same keys, whose
+// sections are nested objects.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Names of configuration files, in order of precedence.
+var Names = []string{"gopherjs.toml", "gopherjs.json"}
+
+// Setting is a key = value pair of a configuration file.
+type Setting struct {
+	Key string
+	// Values are the values of the setting, formatted as command line flag
+	// values. Scalar settings have a single value.
+	Values []string
+	// List is set for array values.
+	List bool
+	// Line is the line of the setting in the file, or 0 for JSON files.
+	Line int
+}
+
+// Config is a parsed configuration file.
+type Config struct {
+	File string
+	// Sections are the settings of each section by name, with those at the top
+	// level in the "" section.
+	Sections map[string][]Setting
+}
+
+// Find returns the path of the configuration file that applies in dir: the
+// first one of Names in dir or its parent directories, up to the root of the
+// module dir belongs to, the first directory with a go.mod file. It returns ""
+// if there is none.
+func Find(dir string) (string, error) {
+	for {
+		for _, name := range Names {
+			file := filepath.Join(dir, name)
+			if _, err := os.Stat(file); err == nil {
+				return file, nil
+			} else if !os.IsNotExist(err) {
+				return "", err
+			}
+		}
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return "", nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Load reads the configuration file file, which is parsed as JSON if its
+// name ends with .json, and as TOML otherwise.
+func Load(file string) (*Config, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(file, ".json") {
+		return ParseJSON(file, data)
+	}
+	return ParseTOML(file, data)
+}
+
+// Lookup returns the setting key of the section, and whether there is one.
+func (c *Config) Lookup(section, key string) (Setting, bool) {
+	for _, s := range c.Sections[section] {
+		if s.Key == key {
+			return s, true
+		}
+	}
+	return Setting{}, false
+}
+
+// Error is an error in a configuration file.
+type Error struct {
+	File string
+	Line int // 0 if unknown.
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.File, e.Msg)
+	}
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Msg)
+}
+
+// ParseTOML parses the TOML configuration data of file.
+func ParseTOML(file string, data []byte) (*Config, error) {
+	c := &Config{File: file, Sections: map[string][]Setting{}}
+	section := ""
+	lines := strings.Split(string(data), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := i + 1
+		errorf := func(format string, args ...interface{}) error {
+			return &Error{File: file, Line: line, Msg: fmt.Sprintf(format, args...)}
+		}
+		text := strings.TrimSpace(stripComment(lines[i]))
+		if text == "" {
+			continue
+		}
+		if strings.HasPrefix(text, "[") {
+			if !strings.HasSuffix(text, "]") || strings.HasPrefix(text, "[[") {
+				return nil, errorf("invalid section header %s", text)
+			}
+			section = strings.TrimSpace(text[1 : len(text)-1])
+			if !isBareKey(section) {
+				return nil, errorf("invalid section name %q", section)
+			}
+			if _, ok := c.Sections[section]; ok {
+				return nil, errorf("duplicate section [%s]", section)
+			}
+			c.Sections[section] = nil
+			continue
+		}
+
+		eq := strings.Index(text, "=")
+		if eq == -1 {
+			return nil, errorf("expected key = value, found %s", text)
+		}
+		key, value := strings.TrimSpace(text[:eq]), strings.TrimSpace(text[eq+1:])
+		if !isBareKey(key) {
+			return nil, errorf("invalid key %q", key)
+		}
+		// Arrays may span several lines.
+		for strings.HasPrefix(value, "[") && !arrayClosed(value) && i+1 < len(lines) {
+			i++
+			value += " " + strings.TrimSpace(stripComment(lines[i]))
+		}
+		s, err := parseTOMLValue(value)
+		if err != nil {
+			return nil, errorf("invalid value of %s: %v", key, err)
+		}
+		s.Key, s.Line = key, line
+		if _, ok := c.Lookup(section, key); ok {
+			return nil, errorf("duplicate key %s", key)
+		}
+		c.Sections[section] = append(c.Sections[section], s)
+	}
+	return c, nil
+}
+
+func isBareKey(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// stripComment removes a # comment from a line, except in strings.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote == 0 && c == '#':
+			return line[:i]
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == '"' && c == '\\':
+			i++
+		case c == quote:
+			quote = 0
+		}
+	}
+	return line
+}
+
+// arrayClosed reports whether the brackets of the array value are balanced,
+// ignoring those in strings.
+func arrayClosed(value string) bool {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == '"' && c == '\\':
+			i++
+		case c == quote:
+			quote = 0
+		case quote == 0 && c == '[':
+			depth++
+		case quote == 0 && c == ']':
+			depth--
+		}
+	}
+	return depth == 0
+}
+
+func parseTOMLValue(value string) (Setting, error) {
+	if !strings.HasPrefix(value, "[") {
+		v, rest, err := parseTOMLScalar(value)
+		if err != nil {
+			return Setting{}, err
+		}
+		if rest != "" {
+			return Setting{}, fmt.Errorf("unexpected %s after value", rest)
+		}
+		return Setting{Values: []string{v}}, nil
+	}
+
+	s := Setting{List: true}
+	rest := strings.TrimSpace(value[1:])
+	for {
+		if strings.HasPrefix(rest, "]") {
+			rest = strings.TrimSpace(rest[1:])
+			break
+		}
+		v, r, err := parseTOMLScalar(rest)
+		if err != nil {
+			return Setting{}, err
+		}
+		s.Values = append(s.Values, v)
+		switch {
+		case strings.HasPrefix(r, ","):
+			rest = strings.TrimSpace(r[1:])
+		case strings.HasPrefix(r, "]"):
+			rest = r
+		default:
+			return Setting{}, fmt.Errorf("expected , or ] in array, found %q", r)
+		}
+	}
+	if rest != "" {
+		return Setting{}, fmt.Errorf("unexpected %s after array", rest)
+	}
+	return s, nil
+}
+
+// parseTOMLScalar parses the string, boolean or number at the start of value,
+// and returns it formatted as a flag value, and the rest of value.
+func parseTOMLScalar(value string) (string, string, error) {
+	switch {
+	case value == "":
+		return "", "", fmt.Errorf("missing value")
+	case value[0] == '"':
+		end := 1
+		for ; end < len(value) && value[end] != '"'; end++ {
+			if value[end] == '\\' {
+				end++
+			}
+		}
+		if end >= len(value) {
+			return "", "", fmt.Errorf("unterminated string")
+		}
+		s, err := strconv.Unquote(value[:end+1])
+		if err != nil {
+			return "", "", fmt.Errorf("invalid string %s", value[:end+1])
+		}
+		return s, strings.TrimSpace(value[end+1:]), nil
+	case value[0] == '\'':
+		end := strings.IndexByte(value[1:], '\'')
+		if end == -1 {
+			return "", "", fmt.Errorf("unterminated string")
+		}
+		return value[1 : end+1], strings.TrimSpace(value[end+2:]), nil
+	}
+	end := strings.IndexAny(value, ",] \t")
+	if end == -1 {
+		end = len(value)
+	}
+	word := value[:end]
+	if word != "true" && word != "false" {
+		if _, err := strconv.ParseFloat(strings.ReplaceAll(word, "_", ""), 64); err != nil {
+			return "", "", fmt.Errorf("%s is not a string, boolean or number", word)
+		}
+		word = strings.ReplaceAll(word, "_", "")
+	}
+	return word, strings.TrimSpace(value[end:]), nil
+}
+
+// ParseJSON parses the JSON configuration data of file.
+func ParseJSON(file string, data []byte) (*Config, error) {
+	var top map[string]interface{}
+	if err := json.Unmarshal(data, &top); err != nil {
+		return nil, &Error{File: file, Msg: err.Error()}
+	}
+	c := &Config{File: file, Sections: map[string][]Setting{}}
+	if err := c.addJSONSection("", top); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Config) addJSONSection(section string, values map[string]interface{}) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	c.Sections[section] = []Setting{}
+	for _, key := range keys {
+		if sub, ok := values[key].(map[string]interface{}); ok {
+			if section != "" {
+				return &Error{File: c.File, Msg: fmt.Sprintf("section %s can't be nested in section %s", key, section)}
+			}
+			if err := c.addJSONSection(key, sub); err != nil {
+				return err
+			}
+			continue
+		}
+		s := Setting{Key: key}
+		items, ok := values[key].([]interface{})
+		if ok {
+			s.List = true
+		} else {
+			items = []interface{}{values[key]}
+		}
+		for _, item := range items {
+			switch item := item.(type) {
+			case string:
+				s.Values = append(s.Values, item)
+			case bool, float64:
+				s.Values = append(s.Values, fmt.Sprint(item))
+			default:
+				return &Error{File: c.File, Msg: fmt.Sprintf("invalid value of %s: %v is not a string, boolean or number", key, item)}
+			}
+		}
+		c.Sections[section] = append(c.Sections[section], s)
+	}
+	return nil
+}
+
+// Apply sets the flags of flags that weren't set on the command line to the
+// settings of the section of the command, then to those of the top level.
+// Settings of the section must be flags of the command. Settings of the top
+// level are skipped if the command has no such flag, but must be flags of
+// some command, as reported by anyFlag, so that typos are caught.
+func (c *Config) Apply(command string, flags *pflag.FlagSet, anyFlag func(name string) bool) error {
+	for _, section := range []string{command, ""} {
+		for _, s := range c.Sections[section] {
+			errorf := func(format string, args ...interface{}) error {
+				return &Error{File: c.File, Line: s.Line, Msg: fmt.Sprintf(format, args...)}
+			}
+			f := flags.Lookup(s.Key)
+			if f == nil {
+				if section != "" {
+					return errorf("gopherjs %s has no flag --%s", command, s.Key)
+				}
+				if !anyFlag(s.Key) {
+					return errorf("unknown setting %s, no gopherjs command has such a flag", s.Key)
+				}
+				continue
+			}
+			if f.Changed {
+				continue // Set on the command line, or by the section.
+			}
+			if s.List && !isListFlag(f) {
+				return errorf("--%s takes a single value, not an array", s.Key)
+			}
+			for _, v := range s.Values {
+				if err := flags.Set(s.Key, v); err != nil {
+					return errorf("invalid value %q for --%s: %v", v, s.Key, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isListFlag(f *pflag.Flag) bool {
+	return strings.HasSuffix(f.Value.Type(), "Array") || strings.HasSuffix(f.Value.Type(), "Slice")
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+const testTOML = `# Project settings.
+minify = true
+tags = "dev # not a comment"
+
+[build]
+format = 'esm'
+size-budget = [
+	"all=300KB", # Whole output.
+	"fmt=20KB",
+]
+`
+
+func TestParse(t *testing.T) {
+	toml, err := ParseTOML("gopherjs.toml", []byte(testTOML))
+	if err != nil {
+		t.Fatalf("ParseTOML() returned error: %v", err)
+	}
+	want := map[string][]Setting{
+		"": {
+			{Key: "minify", Values: []string{"true"}, Line: 2},
+			{Key: "tags", Values: []string{"dev # not a comment"}, Line: 3},
+		},
+		"build": {
+			{Key: "format", Values: []string{"esm"}, Line: 6},
+			{Key: "size-budget", Values: []string{"all=300KB", "fmt=20KB"}, List: true, Line: 7},
+		},
+	}
+	if !reflect.DeepEqual(toml.Sections, want) {
+		t.Errorf("ParseTOML() = %+v, want %+v", toml.Sections, want)
+	}
+
+	json, err := ParseJSON("gopherjs.json", []byte(`{"minify": true, "tags": "dev # not a comment", "build": {"size-budget": ["all=300KB", "fmt=20KB"], "format": "esm"}}`))
+	if err != nil {
+		t.Fatalf("ParseJSON() returned error: %v", err)
+	}
+	for _, settings := range want {
+		for i := range settings {
+			settings[i].Line = 0
+		}
+	}
+	if !reflect.DeepEqual(json.Sections, want) {
+		t.Errorf("ParseJSON() = %+v, want %+v", json.Sections, want)
+	}
+
+	for _, bad := range []string{
+		"minify",
+		"minify = yes",
+		"minify = true true",
+		`tags = "dev`,
+		"[build\nformat = 'esm'",
+		"[build]\n[build]",
+		"a = 1\na = 2",
+		`b = ["x" "y"]`,
+	} {
+		if _, err := ParseTOML("gopherjs.toml", []byte(bad)); err == nil {
+			t.Errorf("ParseTOML(%q) returned no error", bad)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	c, err := ParseTOML("gopherjs.toml", []byte(testTOML+"[serve]\nhttp = \":3000\"\n"))
+	if err != nil {
+		t.Fatalf("ParseTOML() returned error: %v", err)
+	}
+	var minify bool
+	var tags, format string
+	var budgets []string
+	flags := pflag.NewFlagSet("build", pflag.ContinueOnError)
+	flags.BoolVarP(&minify, "minify", "m", false, "")
+	flags.StringVar(&tags, "tags", "", "")
+	flags.StringVar(&format, "format", "iife", "")
+	flags.StringArrayVar(&budgets, "size-budget", nil, "")
+	if err := flags.Parse([]string{"--tags=prod"}); err != nil {
+		t.Fatal(err)
+	}
+	anyFlag := func(name string) bool { return name == "http" || flags.Lookup(name) != nil }
+
+	if err := c.Apply("build", flags, anyFlag); err != nil {
+		t.Fatalf("Apply() returned error: %v", err)
+	}
+	if !minify || tags != "prod" || format != "esm" || !reflect.DeepEqual(budgets, []string{"all=300KB", "fmt=20KB"}) {
+		t.Errorf("Apply() set minify=%t, tags=%q, format=%q, size-budget=%q", minify, tags, format, budgets)
+	}
+	if err := c.Apply("serve", flags, anyFlag); err == nil || !strings.Contains(err.Error(), "--http") {
+		t.Errorf("Apply() of a section with a flag the command doesn't have returned error %v", err)
+	}
+
+	c, _ = ParseTOML("gopherjs.toml", []byte("format = [\"esm\"]\n"))
+	if err := c.Apply("build", pflag.NewFlagSet("build", pflag.ContinueOnError), anyFlag); err != nil {
+		t.Errorf("Apply() of a top-level setting the command doesn't have returned error %v", err)
+	}
+	flags = pflag.NewFlagSet("build", pflag.ContinueOnError)
+	flags.StringVar(&format, "format", "iife", "")
+	if err := c.Apply("build", flags, anyFlag); err == nil {
+		t.Errorf("Apply() of an array to a single-valued flag returned no error")
+	}
+}
+
+func TestFind(t *testing.T) {
+	root := t.TempDir()
+	mod := filepath.Join(root, "mod")
+	pkg := filepath.Join(mod, "pkg")
+	if err := os.MkdirAll(pkg, 0777); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{filepath.Join(root, "gopherjs.toml"), filepath.Join(mod, "go.mod")} {
+		if err := ioutil.WriteFile(file, nil, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, err := Find(pkg); err != nil || got != "" {
+		t.Errorf("Find() outside of the module = %q, %v, want none", got, err)
+	}
+	want := filepath.Join(mod, "gopherjs.json")
+	if err := ioutil.WriteFile(want, nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := Find(pkg); err != nil || got != want {
+		t.Errorf("Find() = %q, %v, want %q", got, err, want)
+	}
+}
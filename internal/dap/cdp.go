@@ -0,0 +1,268 @@
+package dap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// errCDPClosed is returned by calls on a closed connection.
+var errCDPClosed = errors.New("connection to the JavaScript debugger closed")
+
+// cdpClient is a client of the Chrome DevTools Protocol, which Node.js and
+// Chrome implement for debugging JavaScript.
+type cdpClient struct {
+	ws *wsConn
+
+	mu      sync.Mutex
+	nextID  int
+	pending map[int]chan cdpMessage
+	closed  bool
+
+	// Events are queued without limit, since event handlers call methods and
+	// would otherwise deadlock with the reader when the queue is full.
+	events  []cdpMessage
+	wake    chan struct{}
+	onEvent func(method string, params json.RawMessage)
+	onClose func()
+}
+
+// cdpMessage is a method call response or an event.
+type cdpMessage struct {
+	ID     int             `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *cdpError       `json:"error,omitempty"`
+}
+
+type cdpError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *cdpError) Error() string { return e.Message }
+
+// dialCDP connects to the debugger at the WebSocket URL wsURL. onEvent is
+// called for each event in order from a single goroutine, and onClose once
+// after the last event when the connection closes.
+func dialCDP(wsURL string, onEvent func(method string, params json.RawMessage), onClose func()) (*cdpClient, error) {
+	ws, err := dialWebSocket(wsURL)
+	if err != nil {
+		return nil, err
+	}
+	c := &cdpClient{
+		ws:      ws,
+		pending: map[int]chan cdpMessage{},
+		wake:    make(chan struct{}, 1),
+		onEvent: onEvent,
+		onClose: onClose,
+	}
+	go c.readLoop()
+	go c.eventLoop()
+	return c, nil
+}
+
+// cdpTarget is a debugging target listed by the /json/list HTTP endpoint of
+// Node.js and Chrome.
+type cdpTarget struct {
+	Type                 string `json:"type"`
+	Title                string `json:"title"`
+	URL                  string `json:"url"`
+	WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+}
+
+// findTarget returns the WebSocket URL of the first page or Node.js program
+// among the targets of the debugger listening on address (host:port).
+func findTarget(address string) (string, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get("http://" + address + "/json/list")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var targets []cdpTarget
+	if err := json.NewDecoder(resp.Body).Decode(&targets); err != nil {
+		return "", fmt.Errorf("listing debugging targets at %s: %v", address, err)
+	}
+	for _, t := range targets {
+		if (t.Type == "page" || t.Type == "node") && t.WebSocketDebuggerURL != "" {
+			return t.WebSocketDebuggerURL, nil
+		}
+	}
+	return "", fmt.Errorf("no page to debug at %s", address)
+}
+
+func (c *cdpClient) readLoop() {
+	for {
+		data, err := c.ws.ReadMessage()
+		if err != nil {
+			break
+		}
+		var msg cdpMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+		c.mu.Lock()
+		if msg.ID != 0 {
+			if ch, ok := c.pending[msg.ID]; ok {
+				delete(c.pending, msg.ID)
+				ch <- msg
+			}
+		} else {
+			c.events = append(c.events, msg)
+			c.signal()
+		}
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	c.closed = true
+	for id, ch := range c.pending {
+		delete(c.pending, id)
+		close(ch)
+	}
+	c.signal()
+	c.mu.Unlock()
+	c.ws.Close()
+}
+
+func (c *cdpClient) signal() {
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (c *cdpClient) eventLoop() {
+	for {
+		c.mu.Lock()
+		events, closed := c.events, c.closed
+		c.events = nil
+		c.mu.Unlock()
+
+		for _, ev := range events {
+			c.onEvent(ev.Method, ev.Params)
+		}
+		if closed && len(events) == 0 {
+			c.onClose()
+			return
+		}
+		if len(events) == 0 {
+			<-c.wake
+		}
+	}
+}
+
+// Call calls method with params, and decodes the result into result unless it
+// is nil.
+func (c *cdpClient) Call(method string, params interface{}, result interface{}) error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return errCDPClosed
+	}
+	c.nextID++
+	id := c.nextID
+	ch := make(chan cdpMessage, 1)
+	c.pending[id] = ch
+	c.mu.Unlock()
+
+	data, err := json.Marshal(struct {
+		ID     int         `json:"id"`
+		Method string      `json:"method"`
+		Params interface{} `json:"params,omitempty"`
+	}{id, method, params})
+	if err != nil {
+		return err
+	}
+	if err := c.ws.WriteMessage(data); err != nil {
+		return err
+	}
+
+	resp, ok := <-ch
+	if !ok {
+		return errCDPClosed
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s: %v", method, resp.Error)
+	}
+	if result == nil || len(resp.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+// Close closes the connection. onClose is called once pending events have
+// been handled.
+func (c *cdpClient) Close() error {
+	return c.ws.Close()
+}
+
+// Types of the Debugger and Runtime domains, as far as they are used.
+
+type cdpLocation struct {
+	ScriptID     string `json:"scriptId"`
+	LineNumber   int    `json:"lineNumber"`
+	ColumnNumber int    `json:"columnNumber"`
+}
+
+type cdpCallFrame struct {
+	CallFrameID  string      `json:"callFrameId"`
+	FunctionName string      `json:"functionName"`
+	Location     cdpLocation `json:"location"`
+	ScopeChain   []cdpScope  `json:"scopeChain"`
+}
+
+type cdpScope struct {
+	Type   string          `json:"type"`
+	Object cdpRemoteObject `json:"object"`
+}
+
+type cdpRemoteObject struct {
+	Type                string          `json:"type"`
+	Subtype             string          `json:"subtype,omitempty"`
+	Value               json.RawMessage `json:"value,omitempty"`
+	UnserializableValue string          `json:"unserializableValue,omitempty"`
+	Description         string          `json:"description,omitempty"`
+	ObjectID            string          `json:"objectId,omitempty"`
+}
+
+type cdpPropertyDescriptor struct {
+	Name  string           `json:"name"`
+	Value *cdpRemoteObject `json:"value,omitempty"`
+}
+
+type cdpExceptionDetails struct {
+	Text      string           `json:"text"`
+	Exception *cdpRemoteObject `json:"exception,omitempty"`
+}
+
+// message returns the best description of the exception.
+func (d *cdpExceptionDetails) message() string {
+	if d.Exception != nil && d.Exception.Description != "" {
+		return d.Exception.Description
+	}
+	return d.Text
+}
+
+type cdpScriptParsed struct {
+	ScriptID     string `json:"scriptId"`
+	URL          string `json:"url"`
+	SourceMapURL string `json:"sourceMapURL"`
+}
+
+type cdpPaused struct {
+	CallFrames     []cdpCallFrame   `json:"callFrames"`
+	Reason         string           `json:"reason"`
+	Data           *cdpRemoteObject `json:"data,omitempty"`
+	HitBreakpoints []string         `json:"hitBreakpoints"`
+}
+
+type cdpConsoleAPICalled struct {
+	Type string            `json:"type"`
+	Args []cdpRemoteObject `json:"args"`
+}
@@ -0,0 +1,943 @@
+// Package dap implements a Debug Adapter Protocol (DAP) server for GopherJS
+// programs, which is used by gopherjs debug.
+//
+// Editors like VS Code speak DAP to debuggers. The server translates it to the
+// Chrome DevTools Protocol (CDP) of the Node.js inspector, or of Chrome, and
+// maps breakpoints, stack frames and variables through the source maps of the
+// generated code, so that programs are debugged in terms of their Go source.
+//
+// JavaScript runs goroutines on a single thread, so the program is presented
+// as a single thread, and the stack trace is the one of the goroutine that is
+// running when the program pauses. Stepping moves between lines of Go source,
+// skipping over generated code without a Go source position, like the
+// prelude.
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// threadID is the ID of the only thread that is reported to clients.
+const threadID = 1
+
+// maxSteps limits how many times a step is repeated to get to the next line of
+// Go source, before the program is paused wherever it got to.
+const maxSteps = 1000
+
+// Config configures the debugging sessions of a server.
+type Config struct {
+	// Build compiles the main package program, an import path, directory or
+	// .go file, to the JavaScript file output, with a source map next to it.
+	// Source maps should contain absolute paths of Go source files.
+	Build func(program, output string) error
+	// Program is launched if the launch request doesn't name one.
+	Program string
+	// SourceDirs are searched for Go source files with relative paths in
+	// source maps, e.g. the src directories of GOROOT and GOPATH.
+	SourceDirs []string
+	// Node is the command that runs Node.js, "node" if empty.
+	Node string
+}
+
+// Serve runs a debugging session with the client on conn, until the client
+// disconnects.
+func Serve(conn io.ReadWriter, cfg Config) error {
+	s := &session{
+		cfg:         cfg,
+		w:           conn,
+		scripts:     map[string]*script{},
+		breakpoints: map[string][]*sessionBreakpoint{},
+	}
+	defer s.shutdown()
+
+	r := bufio.NewReader(conn)
+	for {
+		data, err := readMessage(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var req request
+		if err := json.Unmarshal(data, &req); err != nil {
+			return fmt.Errorf("invalid message: %v", err)
+		}
+		if req.Type != "request" {
+			continue
+		}
+		if s.handle(&req) {
+			return nil
+		}
+	}
+}
+
+// session is the state of a debugging session.
+type session struct {
+	cfg Config
+
+	wmu sync.Mutex // Guards w and seq.
+	w   io.Writer
+	seq int
+
+	// mu guards the fields below. It is held while handling each request and
+	// event, including the calls to the debugger they make.
+	mu                sync.Mutex
+	cdp               *cdpClient
+	node              *exec.Cmd
+	tmpDir            string
+	launched          bool // Whether the program was launched rather than attached to.
+	configured        bool // Whether the configurationDone request was received.
+	started           bool // Whether the launched program was told to run.
+	stopOnEntry       bool
+	pauseOnExceptions string
+	scripts           map[string]*script
+	breakpoints       map[string][]*sessionBreakpoint // By path of Go source file.
+	lastBreakpointID  int
+	frames            []cdpCallFrame // While paused.
+	handles           []handle       // Variable references while paused, minus one.
+	step              *step
+}
+
+// script is a script of the program that has a source map.
+type script struct {
+	id  string
+	url string
+	sm  *sourceMap
+}
+
+// sessionBreakpoint is a breakpoint set by the client, and where it was set in
+// the scripts that have code for its line.
+type sessionBreakpoint struct {
+	breakpoint
+	cdpIDs []string
+}
+
+// handle is what a variable reference refers to.
+type handle struct {
+	objectID string
+	scope    bool // Variables of a scope, rather than the contents of a value.
+	pkg      bool // Variables of a package scope.
+}
+
+// step is a step request that is in progress.
+type step struct {
+	method string // Debugger method that makes the step.
+	file   string // Go position when the step started.
+	line   int
+	depth  int // Number of call frames when the step started.
+	count  int
+}
+
+func (s *session) send(msg interface{}) {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	s.seq++
+	switch msg := msg.(type) {
+	case *response:
+		msg.Seq, msg.Type = s.seq, "response"
+	case *event:
+		msg.Seq, msg.Type = s.seq, "event"
+	}
+	writeMessage(s.w, msg)
+}
+
+func (s *session) sendEvent(name string, body interface{}) {
+	s.send(&event{Event: name, Body: body})
+}
+
+// output sends text to the client to show in its console.
+func (s *session) output(category, text string) {
+	s.sendEvent("output", map[string]string{"category": category, "output": text})
+}
+
+// handle handles req, and reports whether the session is over.
+func (s *session) handle(req *request) (done bool) {
+	body, err := s.dispatch(req)
+	resp := &response{RequestSeq: req.Seq, Command: req.Command, Success: err == nil, Body: body}
+	if err != nil {
+		resp.Message = err.Error()
+	}
+	s.send(resp)
+
+	switch req.Command {
+	case "initialize":
+		// Breakpoints are accepted right away, and set once the scripts with
+		// code for them are loaded.
+		s.sendEvent("initialized", nil)
+	case "disconnect":
+		return true
+	}
+	return false
+}
+
+func (s *session) dispatch(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch req.Command {
+	case "initialize":
+		return capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsEvaluateForHovers:        true,
+			SupportsTerminateRequest:         true,
+			ExceptionBreakpointFilters:       []exceptionBreakpointFilter{{Filter: "uncaught", Label: "Uncaught panics"}},
+		}, nil
+	case "launch":
+		var args launchArguments
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		return nil, s.launch(args)
+	case "attach":
+		var args attachArguments
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		return nil, s.attach(args)
+	case "setBreakpoints":
+		var args setBreakpointsArguments
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"breakpoints": s.setBreakpoints(args)}, nil
+	case "setExceptionBreakpoints":
+		var args setExceptionBreakpointsArguments
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		s.pauseOnExceptions = "none"
+		for _, f := range args.Filters {
+			if f == "uncaught" {
+				s.pauseOnExceptions = "uncaught"
+			}
+		}
+		if s.cdp != nil {
+			return nil, s.cdp.Call("Debugger.setPauseOnExceptions", map[string]string{"state": s.pauseOnExceptions}, nil)
+		}
+		return nil, nil
+	case "configurationDone":
+		s.configured = true
+		return nil, s.run()
+	case "threads":
+		return map[string]interface{}{"threads": []thread{{ID: threadID, Name: "main"}}}, nil
+	case "stackTrace":
+		var args stackTraceArguments
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		frames := s.stackTrace()
+		total := len(frames)
+		if args.StartFrame < len(frames) {
+			frames = frames[args.StartFrame:]
+		} else {
+			frames = nil
+		}
+		if args.Levels > 0 && args.Levels < len(frames) {
+			frames = frames[:args.Levels]
+		}
+		return map[string]interface{}{"stackFrames": frames, "totalFrames": total}, nil
+	case "scopes":
+		var args scopesArguments
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"scopes": s.scopes(args.FrameID)}, nil
+	case "variables":
+		var args variablesArguments
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		vars, err := s.variables(args.VariablesReference)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"variables": vars}, nil
+	case "evaluate":
+		var args evaluateArguments
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		v, err := s.evaluate(args)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"result": v.Value, "type": v.Type, "variablesReference": v.VariablesReference}, nil
+	case "continue":
+		return map[string]bool{"allThreadsContinued": true}, s.call("Debugger.resume", nil)
+	case "next":
+		return nil, s.startStep("Debugger.stepOver")
+	case "stepIn":
+		return nil, s.startStep("Debugger.stepInto")
+	case "stepOut":
+		return nil, s.startStep("Debugger.stepOut")
+	case "pause":
+		return nil, s.call("Debugger.pause", nil)
+	case "terminate", "disconnect":
+		s.shutdownLocked()
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported request %q", req.Command)
+}
+
+// call calls a method of the debugger, if it is connected.
+func (s *session) call(method string, params interface{}) error {
+	if s.cdp == nil {
+		return errors.New("the program isn't running")
+	}
+	return s.cdp.Call(method, params, nil)
+}
+
+// launch builds the program and starts it paused under the Node.js inspector.
+// It runs once the client is done with the configuration.
+func (s *session) launch(args launchArguments) error {
+	if s.cdp != nil {
+		return errors.New("already debugging a program")
+	}
+	program := args.Program
+	if program == "" {
+		program = s.cfg.Program
+	}
+	if program == "" {
+		return errors.New("no program to debug, set program in the launch configuration")
+	}
+	dir, err := ioutil.TempDir("", "gopherjs-debug")
+	if err != nil {
+		return err
+	}
+	s.tmpDir = dir
+	output := filepath.Join(dir, "main.js")
+	if err := s.cfg.Build(program, output); err != nil {
+		return err
+	}
+
+	node := s.cfg.Node
+	if node == "" {
+		node = "node"
+	}
+	cmd := exec.Command(node, append([]string{"--inspect-brk=127.0.0.1:0", output}, args.Args...)...)
+	cmd.Dir = args.Cwd
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("could not run Node.js: %v", err)
+	}
+	s.node = cmd
+
+	// The inspector prints its URL, and later whether it's waiting for the
+	// debugger to disconnect after the program finished.
+	wsURL := make(chan string, 1)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		s.forwardOutput("stdout", stdout)
+	}()
+	go func() {
+		defer wg.Done()
+		r := bufio.NewReader(stderr)
+		for {
+			line, err := r.ReadString('\n')
+			switch trimmed := strings.TrimSpace(line); {
+			case strings.HasPrefix(trimmed, "Debugger listening on "):
+				wsURL <- strings.TrimPrefix(trimmed, "Debugger listening on ")
+			case strings.HasPrefix(trimmed, "Waiting for the debugger to disconnect"):
+				s.mu.Lock()
+				if s.cdp != nil {
+					s.cdp.Close()
+				}
+				s.mu.Unlock()
+			case strings.HasPrefix(trimmed, "For help, see:"), trimmed == "Debugger attached.", strings.HasPrefix(trimmed, "Debugger ending on"):
+			default:
+				if line != "" {
+					s.output("stderr", line)
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		exitCode := 0
+		if err := cmd.Wait(); err != nil {
+			exitCode = 1
+			if err, ok := err.(*exec.ExitError); ok {
+				exitCode = err.ExitCode()
+			}
+		}
+		s.sendEvent("exited", map[string]int{"exitCode": exitCode})
+		s.sendEvent("terminated", nil)
+	}()
+
+	select {
+	case u := <-wsURL:
+		s.launched = true
+		s.stopOnEntry = args.StopOnEntry
+		return s.connect(u)
+	case <-time.After(30 * time.Second):
+		cmd.Process.Kill()
+		return errors.New("timed out waiting for the Node.js inspector to start")
+	}
+}
+
+func (s *session) forwardOutput(category string, r io.Reader) {
+	buf := make([]byte, 4096)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			s.output(category, string(buf[:n]))
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// attach connects to a program that is already running, e.g. a page in Chrome.
+func (s *session) attach(args attachArguments) error {
+	if s.cdp != nil {
+		return errors.New("already debugging a program")
+	}
+	u := args.URL
+	if u == "" {
+		if args.Address == "" {
+			return errors.New("set the address or url of the debugger to attach to")
+		}
+		var err error
+		if u, err = findTarget(args.Address); err != nil {
+			return err
+		}
+	}
+	return s.connect(u)
+}
+
+// connect connects to the debugger at the WebSocket URL wsURL and enables the
+// domains the session uses.
+func (s *session) connect(wsURL string) error {
+	c, err := dialCDP(wsURL, s.handleEvent, func() {
+		if !s.launched {
+			// Launched programs are terminated once Node.js exits.
+			s.sendEvent("terminated", nil)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	s.cdp = c
+	if err := c.Call("Runtime.enable", nil, nil); err != nil {
+		return err
+	}
+	if err := c.Call("Debugger.enable", nil, nil); err != nil {
+		return err
+	}
+	if s.pauseOnExceptions != "" {
+		if err := c.Call("Debugger.setPauseOnExceptions", map[string]string{"state": s.pauseOnExceptions}, nil); err != nil {
+			return err
+		}
+	}
+	return s.run()
+}
+
+// run lets a launched program run once it's been configured.
+func (s *session) run() error {
+	if !s.launched || !s.configured || s.started {
+		return nil
+	}
+	s.started = true
+	return s.cdp.Call("Runtime.runIfWaitingForDebugger", nil, nil)
+}
+
+// shutdown stops the launched program and cleans up.
+func (s *session) shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shutdownLocked()
+}
+
+func (s *session) shutdownLocked() {
+	if s.cdp != nil && !s.launched {
+		// Let an attached program go on without the debugger.
+		s.cdp.Call("Debugger.resume", nil, nil)
+	}
+	if s.node != nil && s.node.Process != nil {
+		s.node.Process.Kill()
+		s.node = nil
+	}
+	if s.cdp != nil {
+		s.cdp.Close()
+	}
+	if s.tmpDir != "" {
+		os.RemoveAll(s.tmpDir)
+		s.tmpDir = ""
+	}
+}
+
+// handleEvent handles an event of the debugger.
+func (s *session) handleEvent(method string, params json.RawMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch method {
+	case "Debugger.scriptParsed":
+		var ev cdpScriptParsed
+		if json.Unmarshal(params, &ev) != nil || ev.SourceMapURL == "" {
+			return
+		}
+		sm, err := loadSourceMap(ev.URL, ev.SourceMapURL)
+		if err != nil {
+			s.output("console", fmt.Sprintf("Could not load the source map of %s: %v\n", ev.URL, err))
+			return
+		}
+		sc := &script{id: ev.ScriptID, url: ev.URL, sm: sm}
+		s.scripts[sc.id] = sc
+		for path, bps := range s.breakpoints {
+			for _, bp := range bps {
+				if s.setBreakpointIn(sc, path, bp) {
+					s.sendEvent("breakpoint", map[string]interface{}{"reason": "changed", "breakpoint": bp.breakpoint})
+				}
+			}
+		}
+	case "Debugger.paused":
+		var ev cdpPaused
+		if json.Unmarshal(params, &ev) != nil {
+			return
+		}
+		s.paused(&ev)
+	case "Debugger.resumed":
+		s.frames = nil
+		s.handles = nil
+	case "Runtime.consoleAPICalled":
+		if s.launched {
+			return // Node.js writes console output to stdout and stderr, which are forwarded.
+		}
+		var ev cdpConsoleAPICalled
+		if json.Unmarshal(params, &ev) != nil {
+			return
+		}
+		var args []string
+		for _, arg := range ev.Args {
+			args = append(args, s.variable("", arg).Value)
+		}
+		category := "stdout"
+		if ev.Type == "error" || ev.Type == "warning" {
+			category = "stderr"
+		}
+		s.output(category, strings.Join(args, " ")+"\n")
+	}
+}
+
+// paused handles the program pausing, and continues steps that haven't got to
+// another line of Go source yet.
+func (s *session) paused(ev *cdpPaused) {
+	s.frames = ev.CallFrames
+	s.handles = nil
+
+	if ev.Reason == "Break on start" && !s.stopOnEntry {
+		s.cdp.Call("Debugger.resume", nil, nil)
+		return
+	}
+
+	reason, description := "pause", ""
+	switch {
+	case len(ev.HitBreakpoints) > 0:
+		reason = "breakpoint"
+	case ev.Reason == "exception" || ev.Reason == "promiseRejection":
+		reason = "exception"
+		if ev.Data != nil {
+			description = ev.Data.Description
+		}
+	case ev.Reason == "Break on start":
+		reason = "entry"
+	case s.step != nil:
+		reason = "step"
+		st := s.step
+		st.count++
+		var method string
+		file, line, ok := s.goPosition(&ev.CallFrames[0])
+		switch {
+		case st.count >= maxSteps:
+		case !ok && st.method == "Debugger.stepInto":
+			// Step through generated code until it calls Go code.
+			method = st.method
+		case !ok:
+			// Get out of generated code, e.g. from a function that returned into the
+			// prelude.
+			method = "Debugger.stepOut"
+		case file == st.file && line == st.line && len(ev.CallFrames) == st.depth:
+			// Still on the same line, e.g. in another of the statements it was
+			// compiled to.
+			method = st.method
+		}
+		if method != "" && s.cdp.Call(method, nil, nil) == nil {
+			return
+		}
+	}
+	s.step = nil
+	body := map[string]interface{}{"reason": reason, "threadId": threadID, "allThreadsStopped": true}
+	if description != "" {
+		body["description"] = description
+	}
+	s.sendEvent("stopped", body)
+}
+
+func (s *session) startStep(method string) error {
+	if len(s.frames) == 0 {
+		return errors.New("the program isn't paused")
+	}
+	st := &step{method: method, depth: len(s.frames)}
+	st.file, st.line, _ = s.goPosition(&s.frames[0])
+	if err := s.call(method, nil); err != nil {
+		return err
+	}
+	s.step = st
+	return nil
+}
+
+// goPosition returns the Go source position of a call frame, or false if it has
+// none.
+func (s *session) goPosition(f *cdpCallFrame) (file string, line int, ok bool) {
+	sc, ok := s.scripts[f.Location.ScriptID]
+	if !ok {
+		return "", 0, false
+	}
+	return sc.sm.original(f.Location.LineNumber, f.Location.ColumnNumber)
+}
+
+// localPath returns the path of the Go source file that file of a source map
+// refers to, or false if it can't be found.
+func (s *session) localPath(file string) (string, bool) {
+	if filepath.IsAbs(file) {
+		if _, err := os.Stat(file); err == nil {
+			return file, true
+		}
+	}
+	for _, dir := range s.cfg.SourceDirs {
+		p := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(file, "/")))
+		if _, err := os.Stat(p); err == nil {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+func (s *session) setBreakpoints(args setBreakpointsArguments) []breakpoint {
+	path := args.Source.Path
+	for _, bp := range s.breakpoints[path] {
+		for _, id := range bp.cdpIDs {
+			s.call("Debugger.removeBreakpoint", map[string]string{"breakpointId": id})
+		}
+	}
+
+	var bps []*sessionBreakpoint
+	var result []breakpoint
+	for _, sbp := range args.Breakpoints {
+		s.lastBreakpointID++
+		bp := &sessionBreakpoint{breakpoint: breakpoint{
+			ID:      s.lastBreakpointID,
+			Line:    sbp.Line,
+			Source:  &args.Source,
+			Message: "No code has been loaded for this line yet.",
+		}}
+		for _, sc := range s.scripts {
+			s.setBreakpointIn(sc, path, bp)
+		}
+		bps = append(bps, bp)
+		result = append(result, bp.breakpoint)
+	}
+	s.breakpoints[path] = bps
+	return result
+}
+
+// setBreakpointIn sets bp in the code sc has for its line, and reports whether
+// it did.
+func (s *session) setBreakpointIn(sc *script, path string, bp *sessionBreakpoint) bool {
+	line, column, actualLine, ok := sc.sm.generated(path, bp.Line)
+	if !ok || s.cdp == nil {
+		return false
+	}
+	var result struct {
+		BreakpointID string `json:"breakpointId"`
+	}
+	err := s.cdp.Call("Debugger.setBreakpoint", map[string]interface{}{
+		"location": cdpLocation{ScriptID: sc.id, LineNumber: line, ColumnNumber: column},
+	}, &result)
+	if err != nil {
+		return false
+	}
+	bp.cdpIDs = append(bp.cdpIDs, result.BreakpointID)
+	bp.Verified, bp.Line, bp.Message = true, actualLine, ""
+	return true
+}
+
+// stackTrace returns the frames of the paused program that have Go source
+// positions.
+func (s *session) stackTrace() []stackFrame {
+	var frames []stackFrame
+	for i := range s.frames {
+		f := &s.frames[i]
+		file, line, ok := s.goPosition(f)
+		if !ok {
+			continue
+		}
+		src := &source{Name: filepath.Base(file)}
+		if p, ok := s.localPath(file); ok {
+			src.Path = p
+		} else {
+			src.PresentationHint = "deemphasize"
+		}
+		frames = append(frames, stackFrame{ID: i, Name: funcName(f.FunctionName), Source: src, Line: line, Column: 1})
+	}
+	return frames
+}
+
+// funcName returns the Go name of a function, as far as it can be told from
+// the name the JavaScript engine infers for it.
+func funcName(name string) string {
+	if i := strings.Index(name, " [as "); i >= 0 {
+		name = name[:i]
+	}
+	name = strings.TrimPrefix(name, "Object.")
+	if i := strings.Index(name, ".ptr."); i >= 0 {
+		// The receiver type may be qualified by its package, like in
+		// $packages.main.T.ptr.M.
+		recv := name[:i]
+		recv = recv[strings.LastIndex(recv, ".")+1:]
+		name = "(*" + recv + ")." + strings.TrimPrefix(name[i+len(".ptr."):], "prototype.")
+	}
+	switch name {
+	case "", "$b":
+		return "(anonymous function)"
+	}
+	return name
+}
+
+func (s *session) newHandle(h handle) int {
+	s.handles = append(s.handles, h)
+	return len(s.handles)
+}
+
+func (s *session) scopes(frameID int) []scope {
+	if frameID < 0 || frameID >= len(s.frames) {
+		return nil
+	}
+	var scopes []scope
+	for _, sc := range s.frames[frameID].ScopeChain {
+		switch sc.Type {
+		case "local":
+			scopes = append(scopes, scope{Name: "Locals", VariablesReference: s.newHandle(handle{objectID: sc.Object.ObjectID, scope: true})})
+		case "closure":
+			// Package-level variables are variables of the closure of the package.
+			name, pkg := "Closure", s.isPackageScope(sc.Object.ObjectID)
+			if pkg {
+				name = "Package"
+			}
+			scopes = append(scopes, scope{Name: name, VariablesReference: s.newHandle(handle{objectID: sc.Object.ObjectID, scope: true, pkg: pkg}), Expensive: pkg})
+			if pkg {
+				// Outer scopes belong to the prelude and the JavaScript
+				// environment, not to Go code.
+				return scopes
+			}
+		}
+	}
+	return scopes
+}
+
+func (s *session) isPackageScope(objectID string) bool {
+	props, err := s.properties(objectID)
+	if err != nil {
+		return false
+	}
+	for _, p := range props {
+		if p.Name == "$pkg" {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *session) properties(objectID string) ([]cdpPropertyDescriptor, error) {
+	var result struct {
+		Result []cdpPropertyDescriptor `json:"result"`
+	}
+	err := s.cdp.Call("Runtime.getProperties", map[string]interface{}{"objectId": objectID, "ownProperties": true}, &result)
+	return result.Result, err
+}
+
+func (s *session) variables(ref int) ([]variable, error) {
+	if ref <= 0 || ref > len(s.handles) || s.cdp == nil {
+		return nil, errors.New("invalid variables reference")
+	}
+	h := s.handles[ref-1]
+	objectID := h.objectID
+	if !h.scope {
+		var result struct {
+			Result cdpRemoteObject `json:"result"`
+		}
+		err := s.cdp.Call("Runtime.callFunctionOn", map[string]interface{}{
+			"objectId":            objectID,
+			"functionDeclaration": childrenFunction,
+		}, &result)
+		if err != nil {
+			return nil, err
+		}
+		objectID = result.Result.ObjectID
+	}
+	props, err := s.properties(objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := []variable{}
+	for _, p := range props {
+		if p.Value == nil {
+			continue
+		}
+		name := p.Name
+		if h.scope {
+			var ok bool
+			if name, ok = goName(name); !ok {
+				continue
+			}
+			// Functions and types of packages aren't variables.
+			if h.pkg && p.Value.Type == "function" {
+				continue
+			}
+		}
+		vars = append(vars, s.variable(name, *p.Value))
+	}
+	if h.scope {
+		sort.SliceStable(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+	}
+	return vars, nil
+}
+
+// variable describes the value o with its Go type where possible.
+func (s *session) variable(name string, o cdpRemoteObject) variable {
+	v := variable{Name: name}
+	switch {
+	case o.Type == "undefined" || o.Subtype == "null":
+		v.Value = "nil"
+	case o.Type == "string":
+		var str string
+		json.Unmarshal(o.Value, &str)
+		v.Value, v.Type = quoteString(str), "string"
+	case o.ObjectID == "":
+		v.Value = o.Description
+		if v.Value == "" {
+			v.Value = o.UnserializableValue
+		}
+	default:
+		var result struct {
+			Result struct {
+				Value valueDescription `json:"value"`
+			} `json:"result"`
+		}
+		err := s.cdp.Call("Runtime.callFunctionOn", map[string]interface{}{
+			"objectId":            o.ObjectID,
+			"functionDeclaration": describeFunction,
+			"returnByValue":       true,
+		}, &result)
+		if err != nil {
+			v.Value = o.Description
+			break
+		}
+		d := result.Result.Value
+		v.Value, v.Type = d.Value, d.Type
+		if d.Children {
+			v.VariablesReference = s.newHandle(handle{objectID: o.ObjectID})
+		}
+	}
+	return v
+}
+
+// evaluate evaluates a Go expression in a call frame of the paused program, or
+// a JavaScript expression globally otherwise.
+func (s *session) evaluate(args evaluateArguments) (variable, error) {
+	if s.cdp == nil {
+		return variable{}, errors.New("the program isn't running")
+	}
+	var result struct {
+		Result           cdpRemoteObject      `json:"result"`
+		ExceptionDetails *cdpExceptionDetails `json:"exceptionDetails"`
+	}
+	var err error
+	if args.FrameID != nil && *args.FrameID >= 0 && *args.FrameID < len(s.frames) {
+		f := &s.frames[*args.FrameID]
+		err = s.cdp.Call("Debugger.evaluateOnCallFrame", map[string]interface{}{
+			"callFrameId": f.CallFrameID,
+			"expression":  translateExpr(args.Expression, s.frameNames(f)),
+			"silent":      true,
+		}, &result)
+	} else {
+		err = s.cdp.Call("Runtime.evaluate", map[string]interface{}{
+			"expression": args.Expression,
+			"silent":     true,
+		}, &result)
+	}
+	if err != nil {
+		return variable{}, err
+	}
+	if result.ExceptionDetails != nil {
+		return variable{}, errors.New(result.ExceptionDetails.message())
+	}
+	return s.variable("", result.Result), nil
+}
+
+// frameNames maps the Go names of the variables in scope of a call frame to
+// their JavaScript names. Where the compiler renamed variables that shadow
+// others, the one declared last is assumed to be in scope.
+func (s *session) frameNames(f *cdpCallFrame) map[string]string {
+	names := map[string]string{}
+	for _, sc := range f.ScopeChain {
+		if sc.Type != "local" && sc.Type != "closure" {
+			continue
+		}
+		props, err := s.properties(sc.Object.ObjectID)
+		if err != nil {
+			continue
+		}
+		scopeNames := map[string]string{}
+		for _, p := range props {
+			name, ok := goName(p.Name)
+			if !ok {
+				continue
+			}
+			if prev, ok := scopeNames[name]; !ok || renamedSuffix(p.Name) > renamedSuffix(prev) {
+				scopeNames[name] = p.Name
+			}
+		}
+		for name, jsName := range scopeNames {
+			if _, ok := names[name]; !ok { // Inner scopes come first.
+				names[name] = jsName
+			}
+		}
+	}
+	return names
+}
+
+// renamedSuffix returns the number the compiler added to a variable name, or 0.
+func renamedSuffix(jsName string) int {
+	n := 0
+	fmt.Sscanf(renamedVar.FindString(jsName), "$%d", &n)
+	return n
+}
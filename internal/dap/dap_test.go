@@ -0,0 +1,303 @@
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/neelance/sourcemap"
+)
+
+// testScript stands in for the output of the compiler, with the lines of
+// main.go the lines of code map to. Line zero marks unmapped code.
+var testScript = []struct {
+	code string
+	line int
+}{
+	{"var add = function(a, b) {", 0},
+	{"  var sum$1;", 0},
+	{"  sum$1 = a + b;", 4},
+	{"  return sum$1;", 5},
+	{"};", 0},
+	{"var main = function() {", 0},
+	{`  var s = "h\xc3\xa9llo";`, 9},
+	{"  console.log(add(1, 2));", 10},
+	{"};", 0},
+	{"main();", 0},
+}
+
+// writeTestScript writes testScript to output, with a source map that
+// refers to goFile.
+func writeTestScript(output, goFile string) error {
+	m := &sourcemap.Map{File: filepath.Base(output)}
+	var code strings.Builder
+	for i, l := range testScript {
+		code.WriteString(l.code + "\n")
+		if l.line == 0 {
+			m.AddMapping(&sourcemap.Mapping{GeneratedLine: i + 1})
+		} else {
+			m.AddMapping(&sourcemap.Mapping{GeneratedLine: i + 1, GeneratedColumn: 2, OriginalFile: goFile, OriginalLine: l.line, OriginalColumn: 1})
+		}
+	}
+	code.WriteString("//# sourceMappingURL=" + filepath.Base(output) + ".map\n")
+	f, err := os.Create(output + ".map")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := m.WriteTo(f); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(output, []byte(code.String()), 0666)
+}
+
+// testClient is a DAP client for tests.
+type testClient struct {
+	t        *testing.T
+	conn     net.Conn
+	seq      int
+	messages chan map[string]interface{}
+	output   strings.Builder
+}
+
+func newTestClient(t *testing.T, conn net.Conn) *testClient {
+	c := &testClient{t: t, conn: conn, messages: make(chan map[string]interface{}, 100)}
+	go func() {
+		defer close(c.messages)
+		r := bufio.NewReader(conn)
+		for {
+			data, err := readMessage(r)
+			if err != nil {
+				return
+			}
+			var msg map[string]interface{}
+			if err := json.Unmarshal(data, &msg); err != nil {
+				t.Errorf("Invalid message %s: %v", data, err)
+				return
+			}
+			c.messages <- msg
+		}
+	}()
+	return c
+}
+
+// next returns the next response or event other than output events.
+func (c *testClient) next() map[string]interface{} {
+	c.t.Helper()
+	for {
+		select {
+		case msg, ok := <-c.messages:
+			if !ok {
+				c.t.Fatalf("Connection closed")
+			}
+			if msg["event"] == "output" {
+				c.output.WriteString(msg["body"].(map[string]interface{})["output"].(string))
+				continue
+			}
+			return msg
+		case <-time.After(30 * time.Second):
+			c.t.Fatalf("Timed out waiting for a message")
+		}
+	}
+}
+
+// call sends a request and returns the body of the response, skipping
+// over any events before it.
+func (c *testClient) call(command string, args interface{}) map[string]interface{} {
+	c.t.Helper()
+	c.seq++
+	if err := writeMessage(c.conn, map[string]interface{}{"seq": c.seq, "type": "request", "command": command, "arguments": args}); err != nil {
+		c.t.Fatalf("Sending %s request: %v", command, err)
+	}
+	for {
+		msg := c.next()
+		if msg["type"] != "response" {
+			continue
+		}
+		if msg["success"] != true {
+			c.t.Fatalf("%s request failed: %v", command, msg["message"])
+		}
+		body, _ := msg["body"].(map[string]interface{})
+		return body
+	}
+}
+
+// waitEvent returns the body of the next event named name.
+func (c *testClient) waitEvent(name string) map[string]interface{} {
+	c.t.Helper()
+	for {
+		msg := c.next()
+		if msg["event"] == name {
+			body, _ := msg["body"].(map[string]interface{})
+			return body
+		}
+	}
+}
+
+func TestSession(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("Node.js is not installed")
+	}
+	// Stack frames only refer to Go source files that exist.
+	goFile := filepath.Join(t.TempDir(), "main.go")
+	if err := ioutil.WriteFile(goFile, []byte("package main\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	cfg := Config{
+		Build: func(program, output string) error {
+			if program != "example.com/main" {
+				t.Errorf("Got program %q, want example.com/main", program)
+			}
+			return writeTestScript(output, goFile)
+		},
+		Program: "example.com/main",
+	}
+	clientConn, serverConn := net.Pipe()
+	served := make(chan error, 1)
+	go func() { served <- Serve(serverConn, cfg) }()
+	c := newTestClient(t, clientConn)
+
+	c.call("initialize", map[string]interface{}{"adapterID": "gopherjs"})
+	c.waitEvent("initialized")
+	c.call("launch", map[string]interface{}{})
+	c.call("setBreakpoints", map[string]interface{}{
+		"source":      map[string]string{"path": goFile},
+		"breakpoints": []map[string]int{{"line": 3}}, // Moved to the next line with code.
+	})
+	c.call("configurationDone", nil)
+
+	if got := c.waitEvent("stopped")["reason"]; got != "breakpoint" {
+		t.Errorf("Got stop reason %v, want breakpoint", got)
+	}
+	type frame struct {
+		Name string
+		Line float64
+	}
+	stackFrames := func() []frame {
+		var frames []frame
+		for _, f := range c.call("stackTrace", map[string]int{"threadId": threadID})["stackFrames"].([]interface{}) {
+			f := f.(map[string]interface{})
+			if path := f["source"].(map[string]interface{})["path"]; path != goFile {
+				t.Errorf("Got frame source %v, want %s", path, goFile)
+			}
+			frames = append(frames, frame{f["name"].(string), f["line"].(float64)})
+		}
+		return frames
+	}
+	if diff := cmp.Diff([]frame{{"add", 4}, {"main", 10}}, stackFrames()); diff != "" {
+		t.Errorf("Got unexpected stack trace (-want,+got):\n%s", diff)
+	}
+
+	variables := func(frameID int) map[string]string {
+		scopes := c.call("scopes", map[string]int{"frameId": frameID})["scopes"].([]interface{})
+		ref := scopes[0].(map[string]interface{})["variablesReference"]
+		vars := map[string]string{}
+		for _, v := range c.call("variables", map[string]interface{}{"variablesReference": ref})["variables"].([]interface{}) {
+			v := v.(map[string]interface{})
+			vars[v["name"].(string)] = v["value"].(string)
+		}
+		return vars
+	}
+	if diff := cmp.Diff(map[string]string{"a": "1", "b": "2", "sum": "nil"}, variables(0)); diff != "" {
+		t.Errorf("Got unexpected variables of add (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"s": `"héllo"`}, variables(1)); diff != "" {
+		t.Errorf("Got unexpected variables of main (-want,+got):\n%s", diff)
+	}
+	if got := c.call("evaluate", map[string]interface{}{"expression": "a * 10 + b", "frameId": 0})["result"]; got != "12" {
+		t.Errorf("Got %v for a * 10 + b, want 12", got)
+	}
+
+	c.call("next", map[string]int{"threadId": threadID})
+	if got := c.waitEvent("stopped")["reason"]; got != "step" {
+		t.Errorf("Got stop reason %v after step, want step", got)
+	}
+	if got := c.call("evaluate", map[string]interface{}{"expression": "sum", "frameId": 0})["result"]; got != "3" {
+		t.Errorf("Got %v for sum after step, want 3", got)
+	}
+
+	c.call("continue", map[string]int{"threadId": threadID})
+	if got := c.waitEvent("exited")["exitCode"]; got != 0.0 {
+		t.Errorf("Got exit code %v, want 0", got)
+	}
+	c.waitEvent("terminated")
+	if got := c.output.String(); got != "3\n" {
+		t.Errorf("Got output %q, want %q", got, "3\n")
+	}
+
+	c.call("disconnect", nil)
+	if err := <-served; err != nil {
+		t.Errorf("Serve() returned error: %v", err)
+	}
+}
+
+func TestSourceMap(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "main.js")
+	if err := writeTestScript(output, "example.com/main/main.go"); err != nil {
+		t.Fatal(err)
+	}
+	sm, err := loadSourceMap("file://"+filepath.ToSlash(output), "main.js.map")
+	if err != nil {
+		t.Fatalf("loadSourceMap() returned error: %v", err)
+	}
+
+	if file, line, ok := sm.original(3, 9); file != "example.com/main/main.go" || line != 5 || !ok {
+		t.Errorf("original(3, 9) = %q, %d, %v, want example.com/main/main.go, 5, true", file, line, ok)
+	}
+	if _, _, ok := sm.original(0, 5); ok {
+		t.Errorf("original(0, 5) reported a position for unmapped code")
+	}
+
+	// Relative paths are matched against the end of local paths.
+	if line, column, actual, ok := sm.generated("/go/src/example.com/main/main.go", 6); line != 6 || column != 2 || actual != 9 || !ok {
+		t.Errorf("generated(main.go, 6) = %d, %d, %d, %v, want 6, 2, 9, true", line, column, actual, ok)
+	}
+	if _, _, _, ok := sm.generated("/go/src/example.com/other/main.go", 4); ok {
+		t.Errorf("generated() found code for another file")
+	}
+}
+
+func TestNames(t *testing.T) {
+	for _, test := range []struct {
+		jsName, goName string
+		ok             bool
+	}{
+		{"x", "x", true},
+		{"x$1", "x", true},
+		{"new$2", "new", true},
+		{"_tmp$3", "", false},
+		{"_r", "", false},
+		{"$s", "", false},
+	} {
+		if name, ok := goName(test.jsName); name != test.goName || ok != test.ok {
+			t.Errorf("goName(%q) = %q, %v, want %q, %v", test.jsName, name, ok, test.goName, test.ok)
+		}
+	}
+
+	names := map[string]string{"x": "x$1", "new": "new$2"}
+	if got, want := translateExpr(`x.x + len(new) + f("x")`, names), `x$1.x + len(new$2) + f("x")`; got != want {
+		t.Errorf("translateExpr() = %q, want %q", got, want)
+	}
+
+	for jsName, want := range map[string]string{
+		"main":                         "main",
+		"Object.main [as $blk]":        "main",
+		"$b":                           "(anonymous function)",
+		"Reader.ptr.Read":              "(*Reader).Read",
+		"Reader.ptr.prototype.Do":      "(*Reader).Do",
+		"$packages.io.Reader.ptr.Read": "(*Reader).Read",
+	} {
+		if got := funcName(jsName); got != want {
+			t.Errorf("funcName(%q) = %q, want %q", jsName, got, want)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package dap
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/neelance/sourcemap"
+)
+
+// maxBreakpointShift is how many lines after the requested one a breakpoint may
+// be moved to, if the requested line has no code, e.g. a comment.
+const maxBreakpointShift = 20
+
+// sourceMap maps between positions in a generated script and in the Go source
+// files it was compiled from.
+type sourceMap struct {
+	// Mappings sorted by their generated position.
+	mappings []*sourcemap.Mapping
+	// The first mapping of each Go line, by file and line.
+	lines map[string]map[int]*sourcemap.Mapping
+}
+
+func parseSourceMap(data []byte) (*sourceMap, error) {
+	m, err := sourcemap.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	sm := &sourceMap{
+		mappings: m.DecodedMappings(),
+		lines:    map[string]map[int]*sourcemap.Mapping{},
+	}
+	sort.SliceStable(sm.mappings, func(i, j int) bool {
+		return before(sm.mappings[i], sm.mappings[j].GeneratedLine, sm.mappings[j].GeneratedColumn)
+	})
+	for _, mapping := range sm.mappings {
+		if mapping.OriginalFile == "" {
+			continue
+		}
+		lines := sm.lines[mapping.OriginalFile]
+		if lines == nil {
+			lines = map[int]*sourcemap.Mapping{}
+			sm.lines[mapping.OriginalFile] = lines
+		}
+		if _, ok := lines[mapping.OriginalLine]; !ok {
+			lines[mapping.OriginalLine] = mapping
+		}
+	}
+	return sm, nil
+}
+
+// before reports whether the generated position of m is before line and
+// column, which are one- and zero-based like in source maps.
+func before(m *sourcemap.Mapping, line, column int) bool {
+	return m.GeneratedLine < line || m.GeneratedLine == line && m.GeneratedColumn < column
+}
+
+// original returns the Go source position of the zero-based generated line and
+// column, like in the Chrome DevTools Protocol. It reports false for code
+// without a Go source position, e.g. the prelude.
+func (sm *sourceMap) original(line, column int) (file string, originalLine int, ok bool) {
+	i := sort.Search(len(sm.mappings), func(i int) bool {
+		return !before(sm.mappings[i], line+1, column+1)
+	}) - 1
+	if i < 0 || sm.mappings[i].OriginalFile == "" {
+		return "", 0, false
+	}
+	return sm.mappings[i].OriginalFile, sm.mappings[i].OriginalLine, true
+}
+
+// generated returns the zero-based position of the first generated code for
+// line of the Go source file at the local path, and the line of that code,
+// which is after line if line has no code of its own.
+func (sm *sourceMap) generated(path string, line int) (genLine, genColumn, actualLine int, ok bool) {
+	for file, lines := range sm.lines {
+		if !sameFile(file, path) {
+			continue
+		}
+		for l := line; l <= line+maxBreakpointShift; l++ {
+			if m, ok := lines[l]; ok {
+				return m.GeneratedLine - 1, m.GeneratedColumn, l, true
+			}
+		}
+	}
+	return 0, 0, 0, false
+}
+
+// sameFile reports whether the file of a source map refers to the local path.
+// Source maps contain absolute paths when built by gopherjs debug, but paths
+// relative to GOPATH or GOROOT otherwise.
+func sameFile(file, path string) bool {
+	file, path = filepath.ToSlash(file), filepath.ToSlash(path)
+	return file == path || strings.HasSuffix(path, "/"+strings.TrimPrefix(file, "/"))
+}
+
+// loadSourceMap loads the source map at mapURL, which is relative to the URL of
+// the script it belongs to.
+func loadSourceMap(scriptURL, mapURL string) (*sourceMap, error) {
+	if strings.HasPrefix(mapURL, "data:") {
+		i := strings.Index(mapURL, ",")
+		if i < 0 || !strings.HasSuffix(mapURL[:i], ";base64") {
+			return nil, fmt.Errorf("unsupported source map URL %.40q", mapURL)
+		}
+		data, err := base64.StdEncoding.DecodeString(mapURL[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		return parseSourceMap(data)
+	}
+
+	base, err := url.Parse(scriptURL)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := url.Parse(mapURL)
+	if err != nil {
+		return nil, err
+	}
+	u := base.ResolveReference(ref)
+	var data []byte
+	switch u.Scheme {
+	case "file":
+		data, err = ioutil.ReadFile(filepath.FromSlash(u.Path))
+	case "http", "https":
+		data, err = fetch(u.String())
+	case "":
+		// Older versions of Node.js report scripts by their path.
+		data, err = ioutil.ReadFile(filepath.FromSlash(path.Join(path.Dir(filepath.ToSlash(scriptURL)), mapURL)))
+	default:
+		return nil, fmt.Errorf("unsupported source map URL %q", u)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseSourceMap(data)
+}
+
+func fetch(url string) ([]byte, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,221 @@
+package dap
+
+import (
+	"go/scanner"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// internalVar matches the names of variables the compiler introduces, like the
+// state of resumable functions and temporaries.
+var internalVar = regexp.MustCompile(`^(\$.*|_(arg|array|entry|i|key|keys|ok|ptr|q|r|ref|returncast|rune|selection|struct|tmp|tuple|v|view)(\$\d+)?)$`)
+
+// renamedVar matches the suffix the compiler adds to variables that shadow
+// others or JavaScript keywords.
+var renamedVar = regexp.MustCompile(`\$\d+$`)
+
+// goName returns the Go name of the JavaScript variable jsName, or false if it
+// was introduced by the compiler.
+func goName(jsName string) (string, bool) {
+	if internalVar.MatchString(jsName) {
+		return "", false
+	}
+	return renamedVar.ReplaceAllString(jsName, ""), true
+}
+
+// translateExpr replaces the Go names of variables in the Go expression expr
+// with their JavaScript names, for evaluation in a paused call frame.
+// Selectors, like the fields of structs, are left alone, since they keep their
+// names.
+func translateExpr(expr string, names map[string]string) string {
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(expr))
+	s.Init(file, []byte(expr), nil, 0)
+
+	var b strings.Builder
+	last, prev := 0, token.ILLEGAL
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.IDENT && prev != token.PERIOD {
+			if jsName, ok := names[lit]; ok {
+				offset := file.Offset(pos)
+				b.WriteString(expr[last:offset])
+				b.WriteString(jsName)
+				last = offset + len(lit)
+			}
+		}
+		prev = tok
+	}
+	b.WriteString(expr[last:])
+	return b.String()
+}
+
+// decodeString returns the Go string represented by the JavaScript string s.
+// Go strings are stored one byte per UTF-16 code unit, so that they can hold
+// any bytes.
+func decodeString(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r < utf8.RuneSelf || r > 0xff {
+			b = append(b, string(r)...)
+		} else {
+			b = append(b, byte(r))
+		}
+	}
+	return string(b)
+}
+
+// quoteString formats the JavaScript string s like a Go string literal.
+func quoteString(s string) string {
+	return strconv.Quote(decodeString(s))
+}
+
+// valueHelpers are JavaScript functions that describe values with their Go
+// types, from the type information GopherJS attaches to them. Values that
+// don't carry their type, like numbers, are described as they are. Pointers to
+// structs are described like structs, since they are represented by the same
+// object.
+const valueHelpers = `
+var kinds = { int64: 6, uint64: 11, complex64: 15, complex128: 16, array: 17, map: 21, ptr: 22, slice: 23, struct: 25 };
+var maxItems = 10;
+var decode = function(s) {
+  try { return decodeURIComponent(escape(s)); } catch (e) { return s; }
+};
+var isGoType = function(t) {
+  return typeof t === "function" && typeof t.kind === "number" && typeof t.string === "string";
+};
+var isChan = function(v) {
+  return v.$buffer !== undefined && v.$capacity !== undefined && v.$closed !== undefined;
+};
+var isMap = function(v) {
+  if (Object.getPrototypeOf(v) !== Object.prototype) { return false; }
+  return Object.keys(v).every(function(k) {
+    var e = v[k];
+    return e !== null && typeof e === "object" && "k" in e && "v" in e;
+  });
+};
+var isNilInterface = function(v) {
+  var keys = Object.keys(v);
+  return Object.getPrototypeOf(v) === Object.prototype && keys.length > 0 && keys.every(function(k) { return typeof v[k] === "function"; });
+};
+var describe = function(v, depth) {
+  switch (typeof v) {
+  case "undefined":
+    return { value: "nil" };
+  case "string":
+    return { type: "string", value: JSON.stringify(decode(v)) };
+  case "number":
+  case "boolean":
+  case "bigint":
+    return { value: String(v) };
+  case "function":
+    return { type: "func", value: v.name ? "func " + v.name : "func" };
+  }
+  if (v === null) {
+    return { value: "nil" };
+  }
+  var summary = function(items) {
+    if (depth > 0) { return "{...}"; }
+    return items.slice(0, maxItems).join(", ") + (items.length > maxItems ? ", ..." : "");
+  };
+  var t = v.constructor;
+  if (isGoType(t)) {
+    switch (t.kind) {
+    case kinds.int64:
+    case kinds.uint64:
+      return { type: t.string, value: String(BigInt(v.$high) * BigInt(4294967296) + BigInt(v.$low)) };
+    case kinds.complex64:
+    case kinds.complex128:
+      return { type: t.string, value: "(" + v.$real + (v.$imag < 0 ? "" : "+") + v.$imag + "i)" };
+    case kinds.slice:
+      if (v === t.nil) { return { type: t.string, value: "nil" }; }
+      var items = [];
+      for (var i = 0; i < v.$length && i <= maxItems; i++) { items.push(describe(v.$array[v.$offset + i], depth + 1).value); }
+      return { type: t.string, value: "len: " + v.$length + ", cap: " + v.$capacity + ", [" + summary(items) + "]", children: v.$length > 0 };
+    case kinds.ptr:
+      if (v === t.nil) { return { type: t.string, value: "nil" }; }
+      if (t.elem.kind === kinds.struct) {
+        var fields = t.elem.fields.map(function(f) { return f.name + ": " + describe(v[f.prop], depth + 1).value; });
+        return { type: t.elem.string, value: "{" + summary(fields) + "}", children: t.elem.fields.length > 0 };
+      }
+      return { type: t.string, value: "&" + describe(v.$get(), depth + 1).value, children: true };
+    }
+    if (t.wrapped && v.$val !== v) {
+      var d = describe(v.$val, depth);
+      d.type = t.string;
+      return d;
+    }
+  }
+  if (isChan(v)) {
+    return { type: "chan", value: "len: " + v.$buffer.length + ", cap: " + v.$capacity + (v.$closed ? ", closed" : "") };
+  }
+  if (Array.isArray(v) || ArrayBuffer.isView(v)) {
+    var elems = [];
+    for (var i = 0; i < v.length && i <= maxItems; i++) { elems.push(describe(v[i], depth + 1).value); }
+    return { type: "[" + v.length + "]", value: "[" + summary(elems) + "]", children: v.length > 0 };
+  }
+  if (isNilInterface(v)) {
+    return { value: "nil" };
+  }
+  if (isMap(v)) {
+    var keys = Object.keys(v);
+    var entries = keys.slice(0, maxItems + 1).map(function(k) { return describe(v[k].k, depth + 1).value + ": " + describe(v[k].v, depth + 1).value; });
+    return { type: "map", value: "len: " + keys.length + ", [" + summary(entries) + "]", children: keys.length > 0 };
+  }
+  return { type: "js", value: Object.prototype.toString.call(v), children: Object.keys(v).length > 0 };
+};
+var children = function(v) {
+  var c = Object.create(null);
+  var t = v.constructor;
+  if (isGoType(t)) {
+    switch (t.kind) {
+    case kinds.slice:
+      for (var i = 0; i < v.$length; i++) { c["[" + i + "]"] = v.$array[v.$offset + i]; }
+      return c;
+    case kinds.ptr:
+      if (t.elem.kind === kinds.struct) {
+        t.elem.fields.forEach(function(f) { c[f.name] = v[f.prop]; });
+      } else {
+        c["*"] = v.$get();
+      }
+      return c;
+    }
+    if (t.wrapped && v.$val !== v) {
+      return children(v.$val);
+    }
+  }
+  if (Array.isArray(v) || ArrayBuffer.isView(v)) {
+    for (var i = 0; i < v.length; i++) { c["[" + i + "]"] = v[i]; }
+    return c;
+  }
+  if (isMap(v)) {
+    Object.keys(v).forEach(function(k) { c["[" + describe(v[k].k, 1).value + "]"] = v[k].v; });
+    return c;
+  }
+  Object.keys(v).forEach(function(k) { c[k] = v[k]; });
+  return c;
+};
+`
+
+// describeFunction describes the value it is called on as an object with the
+// properties type, value and children, which reports whether childrenFunction
+// lists anything for the value.
+const describeFunction = "function() {" + valueHelpers + "return describe(this, 0); }"
+
+// childrenFunction returns an object with the elements, fields or entries of
+// the value it is called on as properties, named like in Go.
+const childrenFunction = "function() {" + valueHelpers + "return children(this); }"
+
+// valueDescription is the result of describeFunction.
+type valueDescription struct {
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Children bool   `json:"children"`
+}
@@ -0,0 +1,207 @@
+// Package objfile prints the contents of GopherJS package archives, for the
+// gopherjs tool nm and objdump commands, to help debug dead code elimination
+// and build cache issues.
+package objfile
+
+import (
+	"fmt"
+	"go/types"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// Open reads the archive file filename.
+func Open(filename string) (*compiler.Archive, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	// The import path is only used to name the types package of the archive,
+	// which isn't needed.
+	return compiler.ReadArchive(filename, filename, f, map[string]*types.Package{})
+}
+
+// Kinds of symbols, which are upper case for exported symbols and lower case
+// otherwise, like those of go tool nm.
+const (
+	KindFunc   = 'F' // Function or method.
+	KindType   = 'T' // Named or anonymous type.
+	KindVar    = 'V' // Package-level variable or its initialization.
+	KindInit   = 'I' // Package initialization code that is always live.
+	KindImport = 'P' // Imported package.
+)
+
+// Symbol is a declaration of an archive.
+type Symbol struct {
+	Name string
+	Kind rune
+	// Size is the size of the JavaScript code generated for the declaration,
+	// in bytes.
+	Size     int
+	Blocking bool
+	Decl     *compiler.Decl
+}
+
+// Symbols returns the symbols of the declarations of archive, in order.
+func Symbols(archive *compiler.Archive) []Symbol {
+	var symbols []Symbol
+	for _, d := range archive.Declarations {
+		sym := Symbol{
+			Size:     len(d.DeclCode) + len(d.MethodListCode) + len(d.TypeInitCode) + len(d.InitCode),
+			Blocking: d.Blocking,
+			Decl:     d,
+		}
+		// The kinds are told apart by the fields that the compiler sets for
+		// them, see Compile.
+		switch {
+		case d.FullName != "":
+			sym.Kind, sym.Name = KindFunc, d.FullName
+		case strings.Contains(string(d.DeclCode), "$packages["):
+			sym.Kind, sym.Name = KindImport, strings.TrimSpace(string(d.DeclCode))
+			if i, j := strings.Index(sym.Name, `"`), strings.LastIndex(sym.Name, `"`); i < j {
+				sym.Name = sym.Name[i+1 : j]
+			}
+		case len(d.DeclCode) != 0:
+			sym.Kind, sym.Name = KindType, archive.ImportPath+"."+d.DceObjectFilter
+		case d.DceObjectFilter != "":
+			sym.Kind, sym.Name = KindVar, archive.ImportPath+"."+d.DceObjectFilter
+		default:
+			// Initialization of variables with side effects, or the call of
+			// main.
+			sym.Kind, sym.Name = KindInit, archive.ImportPath+".init"
+		}
+		if sym.Kind != KindImport && sym.Kind != KindInit && !exported(sym.Name) {
+			sym.Kind = unicode.ToLower(sym.Kind)
+		}
+		symbols = append(symbols, sym)
+	}
+	return symbols
+}
+
+// exported reports whether the last element of the symbol name is exported.
+func exported(name string) bool {
+	name = name[strings.LastIndexAny(name, ".")+1:]
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
+
+// Nm writes a line for each symbol of archive to w, with the size of its
+// generated code, its kind and name, and whether it's blocking, sorted by name
+// or, if bySize is true, by decreasing size.
+func Nm(w io.Writer, archive *compiler.Archive, bySize bool) error {
+	symbols := Symbols(archive)
+	sort.SliceStable(symbols, func(i, j int) bool {
+		if bySize && symbols[i].Size != symbols[j].Size {
+			return symbols[i].Size > symbols[j].Size
+		}
+		return symbols[i].Name < symbols[j].Name
+	})
+	for _, sym := range symbols {
+		blocking := ""
+		if sym.Blocking {
+			blocking = " (blocking)"
+		}
+		if _, err := fmt.Fprintf(w, "%8d %c %s%s\n", sym.Size, sym.Kind, sym.Name, blocking); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Objdump writes the metadata of archive and, for each symbol whose name
+// matches filter, or all of them if filter is nil, its metadata and generated
+// code to w.
+func Objdump(w io.Writer, archive *compiler.Archive, filter *regexp.Regexp) error {
+	p := &printer{w: w}
+	p.printf("package %s %q\n", archive.Name, archive.ImportPath)
+	p.printf("minified: %t\n", archive.Minified)
+	p.list("imports", archive.Imports)
+	p.list("exports", archive.Exports)
+	for _, l := range archive.GoLinknames {
+		p.printf("linkname: %s.%s -> %s.%s\n", l.Reference.PkgPath, l.Reference.Name, l.Implementation.PkgPath, l.Implementation.Name)
+	}
+	for _, u := range archive.Unavailable {
+		p.printf("unavailable: %s at %s: %s\n", u.Symbol, u.Pos, u.Reason)
+	}
+	if len(archive.IncJSCode) != 0 {
+		p.printf("\n.inc.js code (%d bytes)\n", len(archive.IncJSCode))
+		p.code(archive.IncJSCode)
+	}
+
+	for _, sym := range Symbols(archive) {
+		if filter != nil && !filter.MatchString(sym.Name) {
+			continue
+		}
+		d := sym.Decl
+		p.printf("\n%c %s (%d bytes)\n", sym.Kind, sym.Name, sym.Size)
+		if sym.Blocking {
+			p.printf("  blocking\n")
+		}
+		if d.LinkingName.Name != "" {
+			p.printf("  linking name: %s.%s\n", d.LinkingName.PkgPath, d.LinkingName.Name)
+		}
+		p.list("  vars", d.Vars)
+		if d.DceObjectFilter == "" {
+			p.printf("  dce: always live\n")
+		} else {
+			p.printf("  dce: %s%s\n", d.DceObjectFilter, prefix(":", d.DceMethodFilter))
+		}
+		p.list("  dce deps", d.DceDeps)
+		for _, section := range []struct {
+			name string
+			code []byte
+		}{
+			{"declaration", d.DeclCode},
+			{"method list", d.MethodListCode},
+			{"type init", d.TypeInitCode},
+			{"init", d.InitCode},
+		} {
+			if len(section.code) == 0 {
+				continue
+			}
+			p.printf("  %s:\n", section.name)
+			p.code(section.code)
+		}
+	}
+	return p.err
+}
+
+func prefix(p, s string) string {
+	if s == "" {
+		return ""
+	}
+	return p + s
+}
+
+// printer writes to w, remembering the first error.
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+func (p *printer) list(name string, items []string) {
+	if len(items) != 0 {
+		p.printf("%s: %s\n", name, strings.Join(items, ", "))
+	}
+}
+
+// code writes generated code, indented by four spaces.
+func (p *printer) code(code []byte) {
+	for _, line := range strings.Split(strings.TrimRight(string(code), "\n"), "\n") {
+		p.printf("    %s\n", strings.TrimLeft(line, "\t"))
+	}
+}
@@ -0,0 +1,83 @@
+package objfile
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+func compile(t *testing.T, src string) *compiler.Archive {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "src.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("Failed to parse source: %v", err)
+	}
+	importContext := &compiler.ImportContext{Packages: map[string]*types.Package{}}
+	archive, err := compiler.Compile("example.com/pill", []*ast.File{file}, fset, importContext, false)
+	if err != nil {
+		t.Fatalf("Failed to compile source: %v", err)
+	}
+	return archive
+}
+
+func TestNm(t *testing.T) {
+	archive := compile(t, `package pill
+
+type Pill int
+
+func (p Pill) String() string { return "pill" }
+
+var Count = len(names)
+var names []string
+
+func helper() {}
+`)
+	var out strings.Builder
+	if err := Nm(&out, archive, false); err != nil {
+		t.Fatalf("Nm() returned error: %v", err)
+	}
+	var got []string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		fields := strings.Fields(line)
+		got = append(got, fields[1]+" "+fields[2])
+	}
+	want := []string{
+		"F (example.com/pill.Pill).String",
+		"V example.com/pill.Count",
+		"V example.com/pill.Count", // Initializer of Count, which only calls len, so it's eliminated with Count.
+		"T example.com/pill.Pill",
+		"f example.com/pill.helper",
+		"v example.com/pill.names",
+		"t example.com/pill.sliceType", // Anonymous type []string.
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Nm() listed:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestObjdump(t *testing.T) {
+	archive := compile(t, `package pill
+
+func Take() int { return 42 }
+func other() {}
+`)
+	var out strings.Builder
+	if err := Objdump(&out, archive, regexp.MustCompile(`Take`)); err != nil {
+		t.Fatalf("Objdump() returned error: %v", err)
+	}
+	for _, want := range []string{`package pill "example.com/pill"`, "F example.com/pill.Take", "return 42;"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Objdump() output does not contain %q:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "other") {
+		t.Errorf("Objdump() printed a symbol not matching the filter:\n%s", out.String())
+	}
+}
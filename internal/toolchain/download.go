@@ -0,0 +1,237 @@
+package toolchain
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Locations of the index of Go releases and of their archives, variables for
+// tests.
+var (
+	releasesURL = "https://go.dev/dl/?mode=json&include=all"
+	downloadURL = "https://dl.google.com/go/"
+)
+
+// release is an entry of the index of Go releases.
+type release struct {
+	Version string `json:"version"`
+	Files   []struct {
+		Filename string `json:"filename"`
+		OS       string `json:"os"`
+		Arch     string `json:"arch"`
+		SHA256   string `json:"sha256"`
+		Kind     string `json:"kind"`
+	} `json:"files"`
+}
+
+// CacheDir returns the directory that downloaded Go distributions are kept in.
+func CacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gopherjs", "toolchains"), nil
+}
+
+// Download returns the GOROOT of the Go release name, like "go1.16.5", for the
+// host platform, which is kept in cache. If it isn't there yet, it is
+// downloaded from the Go website, checked against the checksum published with
+// it and extracted into cache, and the download is reported to log.
+func Download(name, cache string, log io.Writer) (string, error) {
+	goroot := filepath.Join(cache, name)
+	if _, err := os.Stat(filepath.Join(goroot, "VERSION")); err == nil {
+		return goroot, nil
+	}
+
+	filename, sum, err := findArchive(name)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(cache, 0777); err != nil {
+		return "", err
+	}
+	fmt.Fprintf(log, "gopherjs: downloading %s\n", filename)
+	archive, err := ioutil.TempFile(cache, filename+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+	if err := fetch(downloadURL+filename, sum, archive); err != nil {
+		return "", fmt.Errorf("downloading %s: %v", filename, err)
+	}
+
+	tmp, err := ioutil.TempDir(cache, name+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+	if strings.HasSuffix(filename, ".zip") {
+		err = extractZip(archive, tmp)
+	} else {
+		err = extractTarGz(archive, tmp)
+	}
+	if err != nil {
+		return "", fmt.Errorf("extracting %s: %v", filename, err)
+	}
+	if err := os.Rename(filepath.Join(tmp, "go"), goroot); err != nil {
+		if _, statErr := os.Stat(filepath.Join(goroot, "VERSION")); statErr == nil {
+			return goroot, nil // Downloaded by another gopherjs command meanwhile.
+		}
+		return "", err
+	}
+	return goroot, nil
+}
+
+// findArchive returns the file name and SHA-256 checksum of the archive of the
+// Go release name for the host platform.
+func findArchive(name string) (filename, sum string, err error) {
+	resp, err := http.Get(releasesURL)
+	if err != nil {
+		return "", "", fmt.Errorf("listing Go releases: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("listing Go releases: %s", resp.Status)
+	}
+	var releases []release
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return "", "", fmt.Errorf("listing Go releases: %v", err)
+	}
+	for _, r := range releases {
+		if r.Version != name {
+			continue
+		}
+		for _, f := range r.Files {
+			if f.OS == runtime.GOOS && f.Arch == runtime.GOARCH && f.Kind == "archive" {
+				return f.Filename, f.SHA256, nil
+			}
+		}
+		return "", "", fmt.Errorf("Go release %s is not available for %s/%s", name, runtime.GOOS, runtime.GOARCH)
+	}
+	return "", "", fmt.Errorf("unknown Go release %s", name)
+}
+
+// fetch downloads url into w, and checks that the SHA-256 checksum of the
+// contents is sum.
+func fetch(url, sum string, w io.Writer) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s", resp.Status)
+	}
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(w, h), resp.Body); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != sum {
+		return fmt.Errorf("checksum mismatch: got sha256 %s, want %s", got, sum)
+	}
+	return nil
+}
+
+// target returns the path that the archive entry name is extracted to in dir,
+// refusing entries that would end up outside of dir.
+func target(dir, name string) (string, error) {
+	name = filepath.FromSlash(name)
+	if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(filepath.Clean(name), ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid archive entry %q", name)
+	}
+	return filepath.Join(dir, name), nil
+}
+
+func extractTarGz(f *os.File, dir string) error {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		path, err := target(dir, hdr.Name)
+		if err != nil {
+			return err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(path, 0777)
+		case tar.TypeReg:
+			err = writeFile(path, tr, hdr.FileInfo().Mode())
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func extractZip(f *os.File, dir string) error {
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(f, fi.Size())
+	if err != nil {
+		return err
+	}
+	for _, zf := range zr.File {
+		path, err := target(dir, zf.Name)
+		if err != nil {
+			return err
+		}
+		if zf.FileInfo().IsDir() {
+			if err := os.MkdirAll(path, 0777); err != nil {
+				return err
+			}
+			continue
+		}
+		r, err := zf.Open()
+		if err != nil {
+			return err
+		}
+		err = writeFile(path, r, zf.Mode())
+		r.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFile(path string, r io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return err
+	}
+	w, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm()|0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,166 @@
+// Package toolchain selects the Go distribution that GopherJS builds packages
+// against, following the go and toolchain directives of go.mod and the
+// GOPHERJS_TOOLCHAIN environment variable, and downloads supported Go releases
+// into the user cache directory when asked to, like cmd/go does for GOTOOLCHAIN.
+package toolchain
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// EnvVar is the environment variable that selects the Go distribution, see
+// Select.
+const EnvVar = "GOPHERJS_TOOLCHAIN"
+
+// Values of EnvVar other than a Go release.
+const (
+	// Local uses the local Go distribution, at GOPHERJS_GOROOT or the GOROOT
+	// of the system Go distribution. It is the default.
+	Local = "local"
+	// Auto uses the local Go distribution if GopherJS supports it, and
+	// downloads a supported release otherwise.
+	Auto = "auto"
+)
+
+// Mod holds the directives of a go.mod file that concern the Go version.
+type Mod struct {
+	File      string // Path of the go.mod file.
+	Go        string // Version of the go directive, like "1.16".
+	Toolchain string // Name of the toolchain directive, like "go1.16.5", or empty.
+}
+
+// FindMod reads the go.mod file of the module that dir belongs to, looking in
+// the parent directories of dir. It returns nil if dir is not in a module.
+func FindMod(dir string) (*Mod, error) {
+	for {
+		file := filepath.Join(dir, "go.mod")
+		data, err := ioutil.ReadFile(file)
+		if err == nil {
+			return ParseMod(file, data)
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// ParseMod parses the go and toolchain directives of the contents of the
+// go.mod file named file.
+func ParseMod(file string, data []byte) (*Mod, error) {
+	mod := &Mod{File: file}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		if i := strings.Index(text, "//"); i != -1 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 || (fields[0] != "go" && fields[0] != "toolchain") {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: usage: %s version", file, line, fields[0])
+		}
+		if fields[0] == "go" {
+			mod.Go = fields[1]
+		} else {
+			mod.Toolchain = fields[1]
+		}
+	}
+	return mod, s.Err()
+}
+
+// minor returns the minor version of the Go 1.x version v, with or without the
+// go prefix, like 16 for "1.16", "go1.16.5" or "go1.16rc1".
+func minor(v string) (int, error) {
+	s := strings.TrimPrefix(strings.TrimPrefix(v, "go"), "1.")
+	if i := strings.IndexAny(s, ".rb-+"); i != -1 {
+		s = s[:i] // Patch, pre-release or custom suffix.
+	}
+	m, err := strconv.Atoi(s)
+	if err != nil || !strings.HasPrefix(strings.TrimPrefix(v, "go"), "1.") {
+		return 0, fmt.Errorf("invalid Go version %q", v)
+	}
+	return m, nil
+}
+
+func supported(minor int) bool {
+	return minor >= compiler.MinGoVersion && minor <= compiler.GoVersion
+}
+
+// DefaultRelease returns the Go release that this version of GopherJS is
+// tested with, which is downloaded unless another supported release is
+// requested.
+func DefaultRelease() string {
+	return compiler.Version[strings.Index(compiler.Version, "+")+1:]
+}
+
+// Select returns the GOROOT of the Go distribution to build against, given the
+// value mode of EnvVar, the local GOROOT and the go.mod file of the current
+// module, which may be nil. It reports an error if the module requires a newer
+// Go version than GopherJS supports, since no distribution would help then.
+//
+// In Local mode, goroot is returned as is and its version is checked later by
+// the build. In Auto mode, goroot is returned if GopherJS supports it, and
+// otherwise the release named by the toolchain directive of mod, if supported,
+// or DefaultRelease is downloaded. A mode naming a Go release, like
+// "go1.16.5", downloads that release. Downloads are reported to log.
+func Select(mode, goroot string, mod *Mod, log io.Writer) (string, error) {
+	if mod != nil && mod.Go != "" {
+		m, err := minor(mod.Go)
+		if err != nil {
+			return "", fmt.Errorf("%s: %v", mod.File, err)
+		}
+		if m > compiler.GoVersion {
+			return "", fmt.Errorf("%s requires go >= %s, but GopherJS %s supports up to Go 1.%d; lower the go directive or use a newer GopherJS release", mod.File, mod.Go, compiler.Version, compiler.GoVersion)
+		}
+	}
+
+	switch mode {
+	case "", Local:
+		return goroot, nil
+	case Auto:
+		if v, err := compiler.GoRootVersion(goroot); err == nil && supported(v) {
+			return goroot, nil
+		}
+		release := DefaultRelease()
+		if mod != nil && mod.Toolchain != "" {
+			if m, err := minor(mod.Toolchain); err == nil && supported(m) {
+				release = mod.Toolchain
+			}
+		}
+		return download(release, log)
+	default:
+		m, err := minor(mode)
+		if err != nil || !strings.HasPrefix(mode, "go") {
+			return "", fmt.Errorf("invalid %s value %q, want %s, %s or a Go release like %s", EnvVar, mode, Local, Auto, DefaultRelease())
+		}
+		if !supported(m) {
+			return "", fmt.Errorf("%s=%s: GopherJS %s requires Go 1.%d.x to 1.%d.x", EnvVar, mode, compiler.Version, compiler.MinGoVersion, compiler.GoVersion)
+		}
+		return download(mode, log)
+	}
+}
+
+func download(release string, log io.Writer) (string, error) {
+	cache, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return Download(release, cache, log)
+}
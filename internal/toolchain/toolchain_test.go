@@ -0,0 +1,153 @@
+package toolchain
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+func TestParseMod(t *testing.T) {
+	mod, err := ParseMod("go.mod", []byte(`module example.com/m // go 1.99
+
+go 1.16
+
+toolchain go1.16.5 // pinned
+
+require (
+	golang.org/x/sys v0.0.0
+)
+`))
+	if err != nil {
+		t.Fatalf("ParseMod() returned error: %v", err)
+	}
+	if mod.Go != "1.16" || mod.Toolchain != "go1.16.5" {
+		t.Errorf("ParseMod() = go %q, toolchain %q, want go %q, toolchain %q", mod.Go, mod.Toolchain, "1.16", "go1.16.5")
+	}
+
+	if _, err := ParseMod("go.mod", []byte("go 1.16 1.17\n")); err == nil {
+		t.Errorf("ParseMod() with a malformed go directive returned no error")
+	}
+}
+
+func TestMinor(t *testing.T) {
+	tests := []struct {
+		v    string
+		want int
+	}{
+		{v: "1.16", want: 16},
+		{v: "1.16.5", want: 16},
+		{v: "go1.16.5", want: 16},
+		{v: "go1.17rc1", want: 17},
+		{v: "go1.16beta1", want: 16},
+		{v: "go1.21.0+auto", want: 21},
+	}
+	for _, test := range tests {
+		if got, err := minor(test.v); err != nil || got != test.want {
+			t.Errorf("minor(%q) = %d, %v, want %d", test.v, got, err, test.want)
+		}
+	}
+	for _, v := range []string{"", "default", "2.0", "go1.x"} {
+		if _, err := minor(v); err == nil {
+			t.Errorf("minor(%q) returned no error", v)
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	goroot := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(goroot, "VERSION"), []byte("go1.99.1"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	supportedMod := &Mod{File: "go.mod", Go: fmt.Sprintf("1.%d", compiler.GoVersion)}
+
+	if got, err := Select("", goroot, supportedMod, ioutil.Discard); err != nil || got != goroot {
+		t.Errorf("Select() in the default mode = %q, %v, want %q", got, err, goroot)
+	}
+	if got, err := Select(Local, goroot, nil, ioutil.Discard); err != nil || got != goroot {
+		t.Errorf("Select() in local mode = %q, %v, want %q", got, err, goroot)
+	}
+
+	newerMod := &Mod{File: "go.mod", Go: fmt.Sprintf("1.%d", compiler.GoVersion+1)}
+	if _, err := Select(Local, goroot, newerMod, ioutil.Discard); err == nil || !strings.Contains(err.Error(), "requires go >=") {
+		t.Errorf("Select() for a module requiring a newer Go version returned error %v", err)
+	}
+
+	for _, mode := range []string{"latest", "1.16.5", fmt.Sprintf("go1.%d.1", compiler.GoVersion+1)} {
+		if _, err := Select(mode, goroot, nil, ioutil.Discard); err == nil {
+			t.Errorf("Select(%q) returned no error", mode)
+		}
+	}
+}
+
+func TestDownload(t *testing.T) {
+	const name = "go1.16.99"
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gz)
+	for _, f := range []struct{ name, content string }{
+		{name: "go/VERSION", content: name},
+		{name: "go/src/fmt/print.go", content: "package fmt\n"},
+	} {
+		tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content)), Typeflag: tar.TypeReg})
+		tw.Write([]byte(f.content))
+	}
+	tw.Close()
+	gz.Close()
+	sum := sha256.Sum256(archive.Bytes())
+	filename := fmt.Sprintf("%s.%s-%s.tar.gz", name, runtime.GOOS, runtime.GOARCH)
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/dl/":
+			fmt.Fprintf(w, `[{"version": %q, "files": [{"filename": %q, "os": %q, "arch": %q, "sha256": %q, "kind": "archive"}]}]`,
+				name, filename, runtime.GOOS, runtime.GOARCH, hex.EncodeToString(sum[:]))
+		case "/go/" + filename:
+			w.Write(archive.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	defer func(r, d string) { releasesURL, downloadURL = r, d }(releasesURL, downloadURL)
+	releasesURL, downloadURL = srv.URL+"/dl/", srv.URL+"/go/"
+
+	cache := t.TempDir()
+	var log bytes.Buffer
+	goroot, err := Download(name, cache, &log)
+	if err != nil {
+		t.Fatalf("Download() returned error: %v", err)
+	}
+	if want := filepath.Join(cache, name); goroot != want {
+		t.Errorf("Download() = %q, want %q", goroot, want)
+	}
+	if v, err := ioutil.ReadFile(filepath.Join(goroot, "VERSION")); err != nil || string(v) != name {
+		t.Errorf("VERSION of the downloaded distribution = %q, %v, want %q", v, err, name)
+	}
+	if !strings.Contains(log.String(), filename) {
+		t.Errorf("Download() logged %q, want it to mention %s", log.String(), filename)
+	}
+
+	// The cached distribution is used without contacting the server.
+	requests = 0
+	if _, err := Download(name, cache, ioutil.Discard); err != nil || requests != 0 {
+		t.Errorf("Download() of a cached release = %v with %d requests, want no error and no requests", err, requests)
+	}
+
+	if _, err := Download("go1.16.98", cache, ioutil.Discard); err == nil {
+		t.Errorf("Download() of an unknown release returned no error")
+	}
+}
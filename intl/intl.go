@@ -0,0 +1,164 @@
+// Package intl compares strings and formats numbers by the rules of a locale
+// with the Intl API of the JavaScript environment. It's a much smaller
+// alternative to the golang.org/x/text/collate, number and message packages,
+// whose tables for all locales make up most of the size of the generated code
+// of localized programs:
+//
+//	c, err := intl.NewCollator("sv", intl.IgnoreCase)
+//	...
+//	c.SortStrings(names)
+//
+//	p, err := intl.NewPrinter("de")
+//	...
+//	p.Sprintf("%d files, %v free", 1234567, intl.Percent(0.25)) // "1.234.567 files, 25 % free"
+//
+// The API follows that of the x/text packages, but locales are BCP 47 language
+// tags as strings rather than language.Tag values, so that x/text isn't linked
+// into programs at all. Where the Intl API isn't available, strings are
+// compared by their bytes, ignoring case if requested, and numbers are
+// formatted like by fmt, without grouping separators.
+package intl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Error is an exception thrown by the Intl API, e.g. a RangeError for an
+// invalid locale.
+type Error struct {
+	Name    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("intl: %s: %s", e.Name, e.Message)
+}
+
+// catch converts JavaScript exceptions thrown by the Intl API, which GopherJS
+// turns into panics, into errors.
+func catch(err *error) {
+	e := recover()
+	if e == nil {
+		return
+	}
+	if jsErr, ok := e.(*js.Error); ok {
+		*err = &Error{Name: jsErr.Get("name").String(), Message: jsErr.Get("message").String()}
+		return
+	}
+	panic(e)
+}
+
+// Available reports whether the JavaScript environment provides the Intl API.
+// If not, collators and printers fall back to locale-independent rules.
+func Available() bool {
+	return js.Global.Get("Intl") != js.Undefined
+}
+
+// locales returns the locales argument of the Intl constructors for locale,
+// which is the default locale of the environment if empty.
+func locales(locale string) interface{} {
+	if locale == "" {
+		return js.Undefined
+	}
+	return locale
+}
+
+// checkLocale returns an error if the Intl API is available and locale isn't
+// a valid BCP 47 language tag.
+func checkLocale(locale string) (err error) {
+	if locale == "" || !Available() {
+		return nil
+	}
+	defer catch(&err)
+	js.Global.Get("Intl").Call("getCanonicalLocales", locale)
+	return nil
+}
+
+// CollateOption changes how a Collator compares strings, like the options of
+// golang.org/x/text/collate.
+type CollateOption int
+
+const (
+	// IgnoreCase compares strings that only differ in case as equal.
+	IgnoreCase CollateOption = 1 << iota
+	// IgnoreDiacritics compares strings that only differ in accents and other
+	// diacritics as equal. It's ignored without the Intl API.
+	IgnoreDiacritics
+	// Numeric compares runs of digits by their numeric value, so that "2"
+	// sorts before "10". It's ignored without the Intl API.
+	Numeric
+
+	// Loose ignores case and diacritics.
+	Loose = IgnoreCase | IgnoreDiacritics
+)
+
+// Collator compares strings by the rules of a locale, with an Intl.Collator.
+type Collator struct {
+	opts     CollateOption
+	collator *js.Object // nil without the Intl API.
+}
+
+// NewCollator returns a collator for the locale, a BCP 47 language tag like
+// "de" or "sv-SE", or the default locale of the environment if empty.
+func NewCollator(locale string, opts ...CollateOption) (c *Collator, err error) {
+	if err := checkLocale(locale); err != nil {
+		return nil, err
+	}
+	c = &Collator{}
+	for _, o := range opts {
+		c.opts |= o
+	}
+	if !Available() {
+		return c, nil
+	}
+	sensitivity := "variant"
+	switch {
+	case c.opts&Loose == Loose:
+		sensitivity = "base"
+	case c.opts&IgnoreCase != 0:
+		sensitivity = "accent"
+	case c.opts&IgnoreDiacritics != 0:
+		sensitivity = "case"
+	}
+	defer catch(&err)
+	c.collator = js.Global.Get("Intl").Get("Collator").New(locales(locale), js.M{
+		"sensitivity": sensitivity,
+		"numeric":     c.opts&Numeric != 0,
+	})
+	return c, nil
+}
+
+// CompareString returns -1, 0 or 1 if a sorts before, the same as, or after b.
+func (c *Collator) CompareString(a, b string) int {
+	var r int
+	if c.collator != nil {
+		r = c.collator.Call("compare", a, b).Int()
+	} else {
+		if c.opts&IgnoreCase != 0 {
+			a, b = strings.ToLower(a), strings.ToLower(b)
+		}
+		r = strings.Compare(a, b)
+	}
+	switch {
+	case r < 0:
+		return -1
+	case r > 0:
+		return 1
+	}
+	return 0
+}
+
+// Compare returns -1, 0 or 1 if the UTF-8 encoded a sorts before, the same
+// as, or after b.
+func (c *Collator) Compare(a, b []byte) int {
+	return c.CompareString(string(a), string(b))
+}
+
+// SortStrings sorts x in increasing order.
+func (c *Collator) SortStrings(x []string) {
+	sort.SliceStable(x, func(i, j int) bool { return c.CompareString(x[i], x[j]) < 0 })
+}
@@ -0,0 +1,127 @@
+//go:build js
+// +build js
+
+package intl
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// withoutIntl runs f with the Intl API hidden from the package.
+func withoutIntl(f func()) {
+	saved := js.Global.Get("Intl")
+	js.Global.Set("Intl", js.Undefined)
+	defer js.Global.Set("Intl", saved)
+	f()
+}
+
+func TestCollator(t *testing.T) {
+	if !Available() {
+		t.Fatal("Available() = false")
+	}
+	for _, test := range []struct {
+		locale string
+		opts   []CollateOption
+		a, b   string
+		want   int
+	}{
+		{"en", nil, "a", "b", -1},
+		{"en", nil, "ä", "b", -1},
+		{"sv", nil, "ä", "z", 1}, // Swedish sorts ä after z.
+		{"en", nil, "a", "A", -1},
+		{"en", []CollateOption{IgnoreCase}, "a", "A", 0},
+		{"en", []CollateOption{IgnoreCase}, "a", "á", -1},
+		{"en", []CollateOption{Loose}, "a", "Á", 0},
+		{"en", nil, "item2", "item10", 1},
+		{"en", []CollateOption{Numeric}, "item2", "item10", -1},
+	} {
+		c, err := NewCollator(test.locale, test.opts...)
+		if err != nil {
+			t.Fatalf("NewCollator(%q) returned error: %v", test.locale, err)
+		}
+		if got := c.CompareString(test.a, test.b); got != test.want {
+			t.Errorf("%s: CompareString(%q, %q) = %d, want %d", test.locale, test.a, test.b, got, test.want)
+		}
+	}
+
+	c, _ := NewCollator("de")
+	names := []string{"Zoe", "Ärger", "Anna", "Ober"}
+	c.SortStrings(names)
+	if want := []string{"Anna", "Ärger", "Ober", "Zoe"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("SortStrings() = %q, want %q", names, want)
+	}
+}
+
+func TestInvalidLocale(t *testing.T) {
+	var intlErr *Error
+	if _, err := NewCollator("not a locale!"); !errors.As(err, &intlErr) || intlErr.Name != "RangeError" {
+		t.Errorf("NewCollator() returned error %v, want a RangeError", err)
+	}
+	if _, err := NewPrinter("not a locale!"); !errors.As(err, &intlErr) || intlErr.Name != "RangeError" {
+		t.Errorf("NewPrinter() returned error %v, want a RangeError", err)
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	en, _ := NewPrinter("en-US")
+	de, _ := NewPrinter("de-DE")
+	for _, test := range []struct {
+		p      *Printer
+		format string
+		args   []interface{}
+		want   string
+	}{
+		{en, "%d files", []interface{}{1234567}, "1,234,567 files"},
+		{de, "%d files", []interface{}{1234567}, "1.234.567 files"},
+		{de, "%v", []interface{}{3.25}, "3,25"},
+		{de, "%.2f", []interface{}{3.14159}, "3,14"},
+		{en, "%f", []interface{}{1.5}, "1.500000"},
+		{en, "%6d|%-6d|", []interface{}{1234, 1234}, " 1,234|1,234 |"},
+		{en, "%+d", []interface{}{5}, "+5"},
+		{en, "%x %q %s", []interface{}{255, 'a', "text"}, "ff 'a' text"},
+		{en, "%v", []interface{}{Percent(0.25)}, "25%"},
+		{en, "%v", []interface{}{Decimal(1234.5678, MaxFractionDigits(1))}, "1,234.6"},
+		{en, "%v", []interface{}{Decimal(1234, NoSeparator(), MinFractionDigits(2))}, "1234.00"},
+		{en, "%v", []interface{}{Decimal(7, MinIntegerDigits(3))}, "007"},
+	} {
+		if got := test.p.Sprintf(test.format, test.args...); got != test.want {
+			t.Errorf("Sprintf(%q, %v) = %q, want %q", test.format, test.args, got, test.want)
+		}
+	}
+	if got, want := en.Sprint(1000, " items"), "1,000 items"; got != want {
+		t.Errorf("Sprint() = %q, want %q", got, want)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	withoutIntl(func() {
+		c, err := NewCollator("not checked", IgnoreCase)
+		if err != nil {
+			t.Fatalf("NewCollator() returned error: %v", err)
+		}
+		if got := c.CompareString("abc", "ABC"); got != 0 {
+			t.Errorf("CompareString() = %d, want 0", got)
+		}
+		p, _ := NewPrinter("de")
+		for _, test := range []struct {
+			format string
+			arg    interface{}
+			want   string
+		}{
+			{"%d", 1234567, "1234567"},
+			{"%v", 3.25, "3.25"},
+			{"%.2f", 3.14159, "3.14"},
+			{"%v", Percent(0.256), "26%"},
+			{"%v", Decimal(2.5, MinFractionDigits(2)), "2.50"},
+			{"%v", Decimal(-7, MinIntegerDigits(3)), "-007"},
+		} {
+			if got := p.Sprintf(test.format, test.arg); got != test.want {
+				t.Errorf("Sprintf(%q, %v) = %q, want %q", test.format, test.arg, got, test.want)
+			}
+		}
+	})
+}
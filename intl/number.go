@@ -0,0 +1,314 @@
+package intl
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// numberOptions are the options of a Number, -1 if not set.
+type numberOptions struct {
+	minFraction, maxFraction, minInteger int
+	noSeparator                          bool
+}
+
+// NumberOption changes how a Number is formatted, like the options of
+// golang.org/x/text/number.
+type NumberOption func(*numberOptions)
+
+// MinFractionDigits formats numbers with at least n digits after the decimal
+// separator.
+func MinFractionDigits(n int) NumberOption {
+	return func(o *numberOptions) { o.minFraction = n }
+}
+
+// MaxFractionDigits rounds numbers to at most n digits after the decimal
+// separator.
+func MaxFractionDigits(n int) NumberOption {
+	return func(o *numberOptions) { o.maxFraction = n }
+}
+
+// MinIntegerDigits pads numbers with zeros to at least n digits before the
+// decimal separator.
+func MinIntegerDigits(n int) NumberOption {
+	return func(o *numberOptions) { o.minInteger = n }
+}
+
+// NoSeparator formats numbers without grouping separators.
+func NoSeparator() NumberOption {
+	return func(o *numberOptions) { o.noSeparator = true }
+}
+
+// Number is a number formatted by the rules of the locale of the Printer that
+// prints it, or of the default locale of the environment otherwise. Numbers
+// are formatted with the %v, %d, %f and %F verbs, and like by fmt with other
+// verbs.
+type Number struct {
+	value   interface{}
+	percent bool
+	opts    numberOptions
+}
+
+func newNumber(x interface{}, percent bool, opts []NumberOption) Number {
+	n := Number{value: x, percent: percent, opts: numberOptions{-1, -1, -1, false}}
+	for _, o := range opts {
+		o(&n.opts)
+	}
+	return n
+}
+
+// Decimal returns the number x, a value of an integer or floating-point type,
+// formatted as a decimal number, like "1,234.5" in English.
+func Decimal(x interface{}, opts ...NumberOption) Number {
+	return newNumber(x, false, opts)
+}
+
+// Percent returns the number x, a value of an integer or floating-point type,
+// formatted as a percentage of 1, like "25%" for 0.25 in English.
+func Percent(x interface{}, opts ...NumberOption) Number {
+	return newNumber(x, true, opts)
+}
+
+// Format implements fmt.Formatter.
+func (n Number) Format(s fmt.State, verb rune) {
+	defaultPrinter.formatArg(s, verb, n, true)
+}
+
+// float returns the value of n as a float64, or false if it isn't a number.
+// Integers beyond 2^53 lose precision.
+func (n Number) float() (float64, bool) {
+	v := reflect.ValueOf(n.value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// Printer formats messages like fmt, but formats numbers by the rules of a
+// locale, with an Intl.NumberFormat, like a golang.org/x/text/message.Printer.
+type Printer struct {
+	locale  string
+	formats map[Number]*js.Object // Intl.NumberFormat of Numbers without value.
+}
+
+// defaultPrinter formats Numbers printed without a Printer.
+var defaultPrinter = &Printer{}
+
+// NewPrinter returns a printer for the locale, a BCP 47 language tag like "de"
+// or "sv-SE", or the default locale of the environment if empty.
+func NewPrinter(locale string) (*Printer, error) {
+	if err := checkLocale(locale); err != nil {
+		return nil, err
+	}
+	return &Printer{locale: locale}, nil
+}
+
+// Sprintf formats according to a format specifier and returns the resulting
+// string, see fmt.Sprintf.
+func (p *Printer) Sprintf(format string, a ...interface{}) string {
+	return fmt.Sprintf(format, p.localize(a)...)
+}
+
+// Sprint formats using the default formats for its operands and returns the
+// resulting string, see fmt.Sprint.
+func (p *Printer) Sprint(a ...interface{}) string {
+	return fmt.Sprint(p.localize(a)...)
+}
+
+// Fprintf formats according to a format specifier and writes to w, see
+// fmt.Fprintf.
+func (p *Printer) Fprintf(w io.Writer, format string, a ...interface{}) (int, error) {
+	return fmt.Fprintf(w, format, p.localize(a)...)
+}
+
+// localized is an operand of a Printer that it formats.
+type localized struct {
+	p *Printer
+	n Number
+	// explicit is set if the operand is a Number, rather than a number that
+	// is formatted as a Decimal.
+	explicit bool
+}
+
+func (l localized) Format(s fmt.State, verb rune) {
+	l.p.formatArg(s, verb, l.n, l.explicit)
+}
+
+// localize returns the operands a, with numbers and Numbers formatted by p.
+func (p *Printer) localize(a []interface{}) []interface{} {
+	result := make([]interface{}, len(a))
+	for i, arg := range a {
+		result[i] = arg
+		switch arg := arg.(type) {
+		case Number:
+			result[i] = localized{p: p, n: arg, explicit: true}
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, float64:
+			result[i] = localized{p: p, n: Decimal(arg)}
+		}
+	}
+	return result
+}
+
+// formatArg formats the number n for the verb, or its value like fmt if the
+// verb isn't one that numbers are localized for. Plain integers are only
+// localized with %v and %d, and floating-point numbers with %v, %f and %F, and
+// neither with the # flag.
+func (p *Printer) formatArg(s fmt.State, verb rune, n Number, explicit bool) {
+	x, ok := n.float()
+	isFloat := false
+	switch n.value.(type) {
+	case float32, float64:
+		isFloat = true
+	}
+	switch {
+	case !ok, s.Flag('#'):
+		ok = false
+	case verb == 'v', explicit && (verb == 'd' || verb == 'f' || verb == 'F'):
+		ok = true
+	case verb == 'd':
+		ok = !isFloat
+	case verb == 'f', verb == 'F':
+		ok = isFloat
+	default:
+		ok = false
+	}
+	if !ok {
+		fmt.Fprintf(s, directive(s, verb), n.value)
+		return
+	}
+
+	if prec, ok := s.Precision(); ok && verb != 'd' {
+		n.opts.minFraction, n.opts.maxFraction = prec, prec
+	} else if (verb == 'f' || verb == 'F') && n.opts.minFraction < 0 && n.opts.maxFraction < 0 {
+		n.opts.minFraction, n.opts.maxFraction = 6, 6 // Like fmt.
+	} else if verb == 'v' && isFloat && !n.percent && n.opts.maxFraction < 0 {
+		// All the digits of the shortest representation, like fmt.
+		digits := strconv.FormatFloat(x, 'f', -1, 64)
+		if i := strings.IndexByte(digits, '.'); i >= 0 {
+			n.opts.maxFraction = len(digits) - i - 1
+			if n.opts.maxFraction > 20 {
+				n.opts.maxFraction = 20
+			}
+		}
+	}
+	str := p.format(x, n)
+	if s.Flag('+') && x >= 0 {
+		str = "+" + str
+	}
+	if width, ok := s.Width(); ok {
+		if s.Flag('-') {
+			str = fmt.Sprintf("%-*s", width, str)
+		} else {
+			str = fmt.Sprintf("%*s", width, str)
+		}
+	}
+	io.WriteString(s, str)
+}
+
+// directive returns the formatting directive of fmt for verb and the flags,
+// width and precision of s.
+func directive(s fmt.State, verb rune) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, flag := range "+-# 0" {
+		if s.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+	if width, ok := s.Width(); ok {
+		b.WriteString(strconv.Itoa(width))
+	}
+	if prec, ok := s.Precision(); ok {
+		b.WriteString("." + strconv.Itoa(prec))
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
+
+// format returns x formatted with the options of n.
+func (p *Printer) format(x float64, n Number) string {
+	if !Available() {
+		return formatFallback(x, n)
+	}
+	key := Number{percent: n.percent, opts: n.opts}
+	f, ok := p.formats[key]
+	if !ok {
+		options := js.M{"useGrouping": !n.opts.noSeparator}
+		if n.percent {
+			options["style"] = "percent"
+		}
+		if n.opts.minFraction >= 0 {
+			options["minimumFractionDigits"] = n.opts.minFraction
+		}
+		if n.opts.maxFraction >= 0 {
+			options["maximumFractionDigits"] = n.opts.maxFraction
+			if n.opts.minFraction < 0 && n.opts.maxFraction == 0 {
+				options["minimumFractionDigits"] = 0
+			}
+		}
+		if n.opts.minInteger > 0 {
+			options["minimumIntegerDigits"] = n.opts.minInteger
+		}
+		f = js.Global.Get("Intl").Get("NumberFormat").New(locales(p.locale), options)
+		if p.formats == nil {
+			p.formats = map[Number]*js.Object{}
+		}
+		p.formats[key] = f
+	}
+	return f.Call("format", x).String()
+}
+
+// formatFallback returns x formatted with the options of n without the Intl
+// API, with the defaults of Intl.NumberFormat but without grouping separators.
+func formatFallback(x float64, n Number) string {
+	minFraction, maxFraction := 0, 3
+	if n.percent {
+		x *= 100
+		maxFraction = 0
+	}
+	if n.opts.minFraction >= 0 {
+		minFraction = n.opts.minFraction
+		if maxFraction < minFraction {
+			maxFraction = minFraction
+		}
+	}
+	if n.opts.maxFraction >= 0 {
+		maxFraction = n.opts.maxFraction
+	}
+	s := strconv.FormatFloat(x, 'f', maxFraction, 64)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		end := len(s)
+		for end > i+1+minFraction && s[end-1] == '0' {
+			end--
+		}
+		if end == i+1 {
+			end = i
+		}
+		s = s[:end]
+	}
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	integer := len(s)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer = i
+	}
+	if n.opts.minInteger > integer {
+		s = strings.Repeat("0", n.opts.minInteger-integer) + s
+	}
+	s = sign + s
+	if n.percent {
+		s += "%"
+	}
+	return s
+}
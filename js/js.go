@@ -86,20 +86,153 @@ type Error struct {
 
 // Error returns the message of the encapsulated JavaScript error object.
 func (err *Error) Error() string {
-	return "JavaScript error: " + err.Get("message").String()
+	return "JavaScript error: " + err.Message()
+}
+
+// Message returns the message property of the encapsulated JavaScript error object, or the value converted to a string if it has none, like when JavaScript code throws a string.
+func (err *Error) Message() string {
+	if err.Object == nil || err.Object == Undefined {
+		return Global.Call("String", err.Object).String()
+	}
+	if msg := err.Get("message"); msg != Undefined {
+		return msg.String()
+	}
+	return err.String()
+}
+
+// Name returns the name property of the encapsulated JavaScript error object, like "TypeError", or "AbortError" for a DOMException of an aborted operation. It returns an empty string if the value has no name.
+func (err *Error) Name() string {
+	if err.Object == nil || err.Object == Undefined {
+		return ""
+	}
+	if name := err.Get("name"); name != Undefined && name != nil {
+		return name.String()
+	}
+	return ""
+}
+
+// Is reports whether err matches target for errors.Is: an ErrorName equal to the name of the encapsulated JavaScript error object, or an *Error encapsulating the same object.
+func (err *Error) Is(target error) bool {
+	switch target := target.(type) {
+	case ErrorName:
+		return err.Name() == string(target)
+	case *Error:
+		return target != nil && err.Object == target.Object
+	}
+	return false
+}
+
+// Unwrap returns the error that err wraps for errors.Is and errors.As: the value of the Go panic that the JavaScript error was thrown for if it is an error, see PanicValue, or else its cause property, as set with new Error(message, { cause }), as an *Error. It returns nil if there is neither.
+func (err *Error) Unwrap() error {
+	if err.Object == nil || err.Object == Undefined {
+		return nil
+	}
+	if v, ok := err.PanicValue(); ok {
+		if e, ok := v.(error); ok {
+			return e
+		}
+	}
+	if cause := err.Get("cause"); cause != Undefined && cause != nil {
+		return &Error{Object: cause}
+	}
+	return nil
+}
+
+// ErrorName is the name of a kind of JavaScript errors, which matches the errors of that name with errors.Is:
+//
+//  if errors.Is(err, js.AbortError) {
+//  	return // Canceled with an AbortController.
+//  }
+type ErrorName string
+
+// Names of the standard JavaScript errors, and of common DOMException errors.
+const (
+	TypeError      ErrorName = "TypeError"
+	RangeError     ErrorName = "RangeError"
+	SyntaxError    ErrorName = "SyntaxError"
+	ReferenceError ErrorName = "ReferenceError"
+
+	AbortError         ErrorName = "AbortError"
+	NotFoundError      ErrorName = "NotFoundError"
+	NotAllowedError    ErrorName = "NotAllowedError"
+	NotSupportedError  ErrorName = "NotSupportedError"
+	InvalidStateError  ErrorName = "InvalidStateError"
+	NetworkError       ErrorName = "NetworkError"
+	QuotaExceededError ErrorName = "QuotaExceededError"
+	SecurityError      ErrorName = "SecurityError"
+	TimeoutError       ErrorName = "TimeoutError"
+	DataCloneError     ErrorName = "DataCloneError"
+)
+
+func (n ErrorName) Error() string {
+	return "JavaScript " + string(n)
 }
 
 // Stack returns the stack property of the encapsulated JavaScript error object.
 func (err *Error) Stack() string {
+	if err.Object == nil || err.Object == Undefined {
+		return ""
+	}
 	return err.Get("stack").String()
 }
 
+// PanicValue returns the value of the Go panic that the encapsulated JavaScript error was thrown for, if it is a panic of this program that reached JavaScript, like one in a function called by JavaScript, and the error was caught by JavaScript code before getting back to Go. Panics that get back to Go through JavaScript keep their values anyway, so recover returns them rather than an *Error. JavaScript code finds the description of the panic in the goPanic property of the error.
+func (err *Error) PanicValue() (interface{}, bool) {
+	if err.Object == nil || err.Object == Undefined {
+		return nil, false
+	}
+	p := err.Get("$goPanicValue")
+	if p == Undefined || p.Get("owner") != Global.Get("$panicOwner") {
+		return nil, false
+	}
+	var v struct{ value interface{} }
+	InternalObject(&v).Set("value", p.Get("value"))
+	return v.value, true
+}
+
+// ExternalizeError converts err and the errors it wraps into a JavaScript object for logging pipelines and error reporting services, like
+//
+//  {type: "*fmt.wrapError", message: "reading config: JavaScript error: Not found", causes: [
+//    {type: "*js.Error", message: "JavaScript error: Not found", stack: "NotFoundError: Not found\n    at ...", causes: []}
+//  ]}
+//
+// The causes are the errors returned by an Unwrap() error or Unwrap() []error method, like those of fmt.Errorf and errors.Join. The stack is set for errors with a Stack() string method, like *Error. ExternalizeError returns nil if err is nil.
+func ExternalizeError(err error) *Object {
+	if err == nil {
+		return nil
+	}
+	o := Global.Get("Object").New()
+	o.Set("type", InternalObject(err).Get("constructor").Get("string"))
+	o.Set("message", err.Error())
+	if s, ok := err.(interface{ Stack() string }); ok {
+		o.Set("stack", s.Stack())
+	}
+	causes := Global.Get("Array").New()
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		if cause := u.Unwrap(); cause != nil {
+			causes.Call("push", ExternalizeError(cause))
+		}
+	case interface{ Unwrap() []error }:
+		for _, cause := range u.Unwrap() {
+			if cause != nil {
+				causes.Call("push", ExternalizeError(cause))
+			}
+		}
+	}
+	o.Set("causes", causes)
+	return o
+}
+
 // Global gives JavaScript's global object ("window" for browsers and "GLOBAL" for Node.js).
 var Global *Object
 
 // Module gives the value of the "module" variable set by Node.js. Hint: Set a module export with 'js.Module.Get("exports").Set("exportName", ...)'.
 var Module *Object
 
+// ImportMeta gives the "import.meta" object of the program if it is loaded as an ECMAScript module (built with --format=esm), and the JavaScript value "undefined" otherwise. Bundlers may add configuration to it, like import.meta.env.
+var ImportMeta *Object
+
 // Undefined gives the JavaScript value "undefined".
 var Undefined *Object
 
@@ -116,6 +249,119 @@ func MakeFunc(fn func(this *Object, arguments []*Object) interface{}) *Object {
 	return Global.Call("$makeFunc", InternalObject(fn))
 }
 
+// FuncMode selects how a function created by FuncOf runs when it is called by JavaScript.
+type FuncMode int
+
+const (
+	// Sync runs the function synchronously when it is called, and returns its result to the caller, like MakeFunc. The function can't block, since there is no goroutine to suspend, see the //gopherjs:nonblocking directive.
+	Sync FuncMode = 0
+	// Async defers the function to a microtask, in which it runs in a new goroutine, so it can block. The call returns a Promise that is resolved with the result of the function, or rejected if it panics.
+	Async FuncMode = 1
+	// Deduplicate coalesces calls made while a previous call is waiting to run or running into a single call, with the "this" value and arguments of the last of them, for events that fire repeatedly, like resize or scroll. Coalesced calls return the same Promise. It implies Async.
+	Deduplicate FuncMode = 2
+)
+
+// FuncOf returns a JavaScript function that calls fn with the values of JavaScript's "this" and "arguments" keywords, scheduled according to mode. FuncOf(fn, Sync) is the same as MakeFunc(fn).
+func FuncOf(fn func(this *Object, arguments []*Object) interface{}, mode FuncMode) *Object {
+	switch {
+	case mode&Deduplicate != 0:
+		f := &coalescedFunc{fn: fn}
+		return MakeFunc(f.call)
+	case mode&Async != 0:
+		return MakeFunc(func(this *Object, arguments []*Object) interface{} {
+			c := newFuncCall(this, arguments)
+			queueMicrotask(func() { go c.run(fn) })
+			return c.promise
+		})
+	}
+	return MakeFunc(fn)
+}
+
+// funcCall is a call of a function created by FuncOf in the Async mode, which returns a Promise.
+type funcCall struct {
+	this                     *Object
+	arguments                []*Object
+	promise, resolve, reject *Object
+}
+
+func newFuncCall(this *Object, arguments []*Object) *funcCall {
+	c := &funcCall{this: this, arguments: arguments}
+	c.promise = Global.Get("Promise").New(func(resolve, reject *Object) {
+		c.resolve, c.reject = resolve, reject
+	})
+	return c
+}
+
+// run calls fn and settles the Promise of the call with its result.
+func (c *funcCall) run(fn func(this *Object, arguments []*Object) interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			c.reject.Invoke(panicError(r))
+		}
+	}()
+	c.resolve.Invoke(fn(c.this, c.arguments))
+}
+
+// coalescedFunc is a function created by FuncOf in the Deduplicate mode.
+type coalescedFunc struct {
+	fn      func(this *Object, arguments []*Object) interface{}
+	pending *funcCall // The call waiting to run, if any.
+	running bool
+}
+
+func (f *coalescedFunc) call(this *Object, arguments []*Object) interface{} {
+	if f.pending != nil {
+		f.pending.this, f.pending.arguments = this, arguments
+		return f.pending.promise
+	}
+	f.pending = newFuncCall(this, arguments)
+	if !f.running {
+		queueMicrotask(f.start)
+	}
+	return f.pending.promise
+}
+
+func (f *coalescedFunc) start() {
+	c := f.pending
+	f.pending, f.running = nil, true
+	go f.runCall(c)
+}
+
+func (f *coalescedFunc) runCall(c *funcCall) {
+	c.run(f.fn)
+	f.running = false
+	if f.pending != nil {
+		queueMicrotask(f.start)
+	}
+}
+
+// queueMicrotask calls f in a microtask.
+func queueMicrotask(f func()) {
+	if q := Global.Get("queueMicrotask"); q != Undefined {
+		q.Invoke(f)
+		return
+	}
+	Global.Get("Promise").Call("resolve").Call("then", f)
+}
+
+// panicError returns the JavaScript value that the Promise of a call of a function created by FuncOf is rejected with when it panics with r.
+func panicError(r interface{}) *Object {
+	var msg string
+	switch r := r.(type) {
+	case *Error:
+		return r.Object
+	case error:
+		msg = r.Error()
+	case string:
+		msg = r
+	default:
+		msg = "panic in function created by js.FuncOf"
+	}
+	err := Global.Get("Error").New(msg)
+	Global.Call("$attachPanic", err, InternalObject(r), msg)
+	return err
+}
+
 // Keys returns the keys of the given JavaScript object.
 func Keys(o *Object) []string {
 	if o == nil || o == Undefined {
@@ -129,11 +375,17 @@ func Keys(o *Object) []string {
 	return s
 }
 
+// ModuleURL returns the URL of the script or module the program was loaded from, or an empty string if it is not known. It is "import.meta.url" for ECMAScript modules, the file URL of the module under Node.js, the source of the script element that loaded the program in browsers, and the location of web workers. Assets shipped next to the program can be found with 'js.Global.Get("URL").New("asset.png", js.ModuleURL())'.
+func ModuleURL() string {
+	return Global.Get("$moduleURL").String()
+}
+
 // MakeWrapper creates a JavaScript object which has wrappers for the exported methods of i. Use explicit getter and setter methods to expose struct fields to JavaScript.
 func MakeWrapper(i interface{}) *Object {
 	v := InternalObject(i)
 	o := Global.Get("Object").New()
 	o.Set("__internal_object__", v)
+	o.Set("__internal_runtime__", Global.Get("$runtime"))
 	methods := v.Get("constructor").Get("methods")
 	for i := 0; i < methods.Length(); i++ {
 		m := methods.Index(i)
@@ -3,7 +3,10 @@
 package js_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gopherjs/gopherjs/js"
 )
@@ -16,3 +19,194 @@ func TestInternalizeCircularReference(t *testing.T) {
 	issue968a.b = issue968b;`)
 	_ = js.Global.Get("issue968a").Interface()
 }
+
+// await waits for the Promise p to be settled, and returns its value and whether it was fulfilled.
+func await(p *js.Object) (*js.Object, bool) {
+	type result struct {
+		value     *js.Object
+		fulfilled bool
+	}
+	ch := make(chan result, 1)
+	p.Call("then", func(v *js.Object) { ch <- result{v, true} }, func(err *js.Object) { ch <- result{err, false} })
+	r := <-ch
+	return r.value, r.fulfilled
+}
+
+func TestFuncOfAsync(t *testing.T) {
+	ran := false
+	f := js.FuncOf(func(this *js.Object, args []*js.Object) interface{} {
+		ran = true
+		time.Sleep(time.Millisecond) // Async functions can block.
+		return args[0].Int() * 2
+	}, js.Async)
+	p := f.Invoke(21)
+	if ran {
+		t.Error("Async function ran synchronously")
+	}
+	if v, ok := await(p); !ok || v.Int() != 42 {
+		t.Errorf("Async function call settled with %v, %t, want 42, true", v, ok)
+	}
+
+	failing := js.FuncOf(func(this *js.Object, args []*js.Object) interface{} {
+		panic("oops")
+	}, js.Async)
+	if err, ok := await(failing.Invoke()); ok || err.Get("message").String() != "oops" {
+		t.Errorf("Panicking async function call settled with %v, %t, want a rejection with an error", err, ok)
+	}
+}
+
+type panicStruct struct{ code int }
+
+func TestPanicValue(t *testing.T) {
+	want := &panicStruct{code: 42}
+	failing := js.FuncOf(func(this *js.Object, args []*js.Object) interface{} {
+		panic(want)
+	}, js.Async)
+	reason, ok := await(failing.Invoke())
+	if ok {
+		t.Fatal("Panicking async function call was fulfilled")
+	}
+	if typ := reason.Get("goPanic").Get("type").String(); typ != "*js_test.panicStruct" {
+		t.Errorf("Got goPanic.type %q, want %q", typ, "*js_test.panicStruct")
+	}
+	if got, ok := (&js.Error{Object: reason}).PanicValue(); !ok || got != want {
+		t.Errorf("Got PanicValue() %v, %t, want %v, true", got, ok, want)
+	}
+	if _, ok := (&js.Error{Object: js.Global.Get("Error").New("thrown by JavaScript")}).PanicValue(); ok {
+		t.Error("PanicValue() of an error thrown by JavaScript returned true")
+	}
+	for _, code := range []string{"throw null", "throw undefined"} {
+		if _, ok := thrownError(t, code).PanicValue(); ok {
+			t.Errorf("PanicValue() of %s returned true", code)
+		}
+	}
+}
+
+// thrownError returns the *js.Error that recover returns for the exception
+// thrown by the JavaScript code.
+func thrownError(t *testing.T, code string) (err *js.Error) {
+	t.Helper()
+	defer func() {
+		e := recover()
+		var ok bool
+		if err, ok = e.(*js.Error); !ok {
+			t.Fatalf("Recovered %#v from %s, want a *js.Error", e, code)
+		}
+	}()
+	js.Global.Call("eval", code)
+	return nil
+}
+
+func TestErrorIs(t *testing.T) {
+	err := &js.Error{Object: js.Global.Get("DOMException").New("The operation was aborted.", "AbortError")}
+	if got := err.Name(); got != "AbortError" {
+		t.Errorf("Got Name() %q, want %q", got, "AbortError")
+	}
+	if got := err.Error(); got != "JavaScript error: The operation was aborted." {
+		t.Errorf("Got Error() %q, want %q", got, "JavaScript error: The operation was aborted.")
+	}
+	if !errors.Is(err, js.AbortError) {
+		t.Error("errors.Is(err, js.AbortError) = false, want true")
+	}
+	if errors.Is(err, js.NotFoundError) {
+		t.Error("errors.Is(err, js.NotFoundError) = true, want false")
+	}
+	if !errors.Is(err, &js.Error{Object: err.Object}) {
+		t.Error("errors.Is(err, &js.Error{err.Object}) = false, want true")
+	}
+
+	thrown := &js.Error{Object: js.Global.Call("eval", `"a string"`)}
+	if got := thrown.Message(); got != "a string" {
+		t.Errorf("Got Message() of a thrown string %q, want %q", got, "a string")
+	}
+	if got := thrown.Name(); got != "" {
+		t.Errorf("Got Name() of a thrown string %q, want empty", got)
+	}
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	cause := js.Global.Get("TypeError").New("bad type")
+	options := js.Global.Get("Object").New()
+	options.Set("cause", cause)
+	err := &js.Error{Object: js.Global.Get("Error").New("wrapped", options)}
+	if !errors.Is(err, js.TypeError) {
+		t.Error("errors.Is(err, js.TypeError) = false, want true")
+	}
+	var target *js.Error
+	if !errors.As(err.Unwrap(), &target) || target.Object != cause {
+		t.Errorf("Got Unwrap() %v, want the cause", err.Unwrap())
+	}
+
+	want := errors.New("go error")
+	failing := js.FuncOf(func(this *js.Object, args []*js.Object) interface{} {
+		panic(want)
+	}, js.Async)
+	reason, _ := await(failing.Invoke())
+	if !errors.Is(&js.Error{Object: reason}, want) {
+		t.Error("errors.Is() of a Go error thrown to JavaScript = false, want true")
+	}
+
+	for _, code := range []string{"throw null", "throw undefined"} {
+		err := thrownError(t, code)
+		if got := err.Unwrap(); got != nil {
+			t.Errorf("Got Unwrap() of %s %v, want nil", code, got)
+		}
+		if errors.Is(err, want) {
+			t.Errorf("errors.Is() of %s = true, want false", code)
+		}
+	}
+}
+
+func TestFuncOfDeduplicate(t *testing.T) {
+	var calls []int
+	f := js.FuncOf(func(this *js.Object, args []*js.Object) interface{} {
+		calls = append(calls, args[0].Int())
+		time.Sleep(time.Millisecond)
+		return nil
+	}, js.Deduplicate)
+	p1 := f.Invoke(1)
+	p2 := f.Invoke(2) // Coalesced with the first call, which hasn't started yet.
+	if p1 != p2 {
+		t.Error("Coalesced calls returned different promises")
+	}
+	await(p1)
+	p3 := f.Invoke(3)
+	await(p3)
+	if len(calls) != 2 || calls[0] != 2 || calls[1] != 3 {
+		t.Errorf("Deduplicated function was called with %v, want [2 3]", calls)
+	}
+}
+
+func TestExternalizeError(t *testing.T) {
+	if got := js.ExternalizeError(nil); got != nil {
+		t.Errorf("Got ExternalizeError(nil) %v, want nil", got)
+	}
+
+	jsErr := &js.Error{Object: js.Global.Get("Error").New("not found")}
+	goErr := errors.New("permission denied")
+	err := fmt.Errorf("reading config: %w", errors.Join(jsErr, goErr))
+	o := js.ExternalizeError(err)
+	if got := o.Get("type").String(); got != "*fmt.wrapError" {
+		t.Errorf("Got type %q, want %q", got, "*fmt.wrapError")
+	}
+	if got := o.Get("message").String(); got != err.Error() {
+		t.Errorf("Got message %q, want %q", got, err.Error())
+	}
+	if got := o.Get("stack"); got != js.Undefined {
+		t.Errorf("Got stack %v of a Go error, want undefined", got)
+	}
+	joined := o.Get("causes").Index(0)
+	if got := joined.Get("causes").Length(); got != 2 {
+		t.Fatalf("Got %d causes of the joined error, want 2", got)
+	}
+	cause := joined.Get("causes").Index(0)
+	if got := cause.Get("type").String(); got != "*js.Error" {
+		t.Errorf("Got type %q, want %q", got, "*js.Error")
+	}
+	if got := cause.Get("stack").String(); got != jsErr.Stack() {
+		t.Errorf("Got stack %q, want %q", got, jsErr.Stack())
+	}
+	if got := joined.Get("causes").Index(1).Get("message").String(); got != "permission denied" {
+		t.Errorf("Got message %q, want %q", got, "permission denied")
+	}
+}
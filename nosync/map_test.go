@@ -0,0 +1,51 @@
+package nosync
+
+import "testing"
+
+func TestMapCompareAndSwap(t *testing.T) {
+	var m Map
+	if m.CompareAndSwap("a", nil, 1) {
+		t.Errorf("CompareAndSwap() of a missing key swapped")
+	}
+	if previous, loaded := m.Swap("a", 1); loaded {
+		t.Errorf("Swap() of a missing key = %v, true, want nil, false", previous)
+	}
+	if !m.CompareAndSwap("a", 1, 2) {
+		t.Errorf("CompareAndSwap(1, 2) didn't swap 1")
+	}
+	if m.CompareAndDelete("a", 1) {
+		t.Errorf("CompareAndDelete(1) deleted 2")
+	}
+	if !m.CompareAndDelete("a", 2) {
+		t.Errorf("CompareAndDelete(2) didn't delete 2")
+	}
+	m.Store("b", 3)
+	if value, loaded := m.LoadAndDelete("b"); value != 3 || !loaded {
+		t.Errorf("LoadAndDelete() = %v, %v, want 3, true", value, loaded)
+	}
+	if _, ok := m.Load("b"); ok {
+		t.Errorf("Key is present after LoadAndDelete()")
+	}
+}
+
+func TestOnceFunc(t *testing.T) {
+	calls := 0
+	f := OnceFunc(func() { calls++ })
+	f()
+	f()
+	if calls != 1 {
+		t.Errorf("Function called %d times, want 1", calls)
+	}
+
+	g := OnceFunc(func() { panic("boom") })
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				if p := recover(); p != "boom" {
+					t.Errorf("Call %d panicked with %v, want boom", i, p)
+				}
+			}()
+			g()
+		}()
+	}
+}
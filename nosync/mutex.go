@@ -1,5 +1,11 @@
 package nosync
 
+// A Locker represents an object that can be locked and unlocked.
+type Locker interface {
+	Lock()
+	Unlock()
+}
+
 // Mutex is a dummy which is non-blocking.
 type Mutex struct {
 	locked bool
@@ -13,6 +19,15 @@ func (m *Mutex) Lock() {
 	m.locked = true
 }
 
+// TryLock locks m if it is not locked, and reports whether it did.
+func (m *Mutex) TryLock() bool {
+	if m.locked {
+		return false
+	}
+	m.locked = true
+	return true
+}
+
 // Unlock unlocks m. It is a run-time error if m is not locked.
 func (m *Mutex) Unlock() {
 	if !m.locked {
@@ -35,6 +50,15 @@ func (rw *RWMutex) Lock() {
 	rw.writeLocked = true
 }
 
+// TryLock locks rw for writing if it is not locked for reading or writing, and reports whether it did.
+func (rw *RWMutex) TryLock() bool {
+	if rw.readLockCounter != 0 || rw.writeLocked {
+		return false
+	}
+	rw.writeLocked = true
+	return true
+}
+
 // Unlock unlocks rw for writing. It is a run-time error if rw is not locked for writing.
 func (rw *RWMutex) Unlock() {
 	if !rw.writeLocked {
@@ -51,6 +75,15 @@ func (rw *RWMutex) RLock() {
 	rw.readLockCounter++
 }
 
+// TryRLock locks rw for reading if it is not locked for writing, and reports whether it did.
+func (rw *RWMutex) TryRLock() bool {
+	if rw.writeLocked {
+		return false
+	}
+	rw.readLockCounter++
+	return true
+}
+
 // RUnlock undoes a single RLock call; it does not affect other simultaneous readers. It is a run-time error if rw is not locked for reading.
 func (rw *RWMutex) RUnlock() {
 	if rw.readLockCounter == 0 {
@@ -59,6 +92,16 @@ func (rw *RWMutex) RUnlock() {
 	rw.readLockCounter--
 }
 
+// RLocker returns a Locker interface that implements the Lock and Unlock methods by calling rw.RLock and rw.RUnlock.
+func (rw *RWMutex) RLocker() Locker {
+	return (*rlocker)(rw)
+}
+
+type rlocker RWMutex
+
+func (r *rlocker) Lock()   { (*RWMutex)(r).RLock() }
+func (r *rlocker) Unlock() { (*RWMutex)(r).RUnlock() }
+
 // WaitGroup is a dummy which is non-blocking.
 type WaitGroup struct {
 	counter int
@@ -0,0 +1,157 @@
+// +build js
+
+// Package jsruntime controls how the GopherJS scheduler interacts with the
+// event loop of the JavaScript host.
+//
+// Goroutines run until they block or yield with runtime.Gosched, then the
+// scheduler returns control to the host. SetYieldMode chooses how yielding
+// goroutines get resumed, trading responsiveness of the page for latency, or
+// batches them, and timer wakeups, to animation frames.
+//
+// Flush gives code called synchronously by JavaScript a deterministic point
+// at which goroutines have made all the progress they can, like the end of a
+// frame of a game loop, or of an event handler whose effects a UI library
+// must observe before it renders:
+//
+//	js.Global.Set("step", js.FuncOf(func(this *js.Object, args []*js.Object) interface{} {
+//		inputs <- args[0] // Handled by goroutines.
+//		jsruntime.Flush()
+//		return state() // Reflects the input.
+//	}, js.Sync))
+package jsruntime
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// YieldMode is how goroutines that yield get resumed.
+type YieldMode int
+
+const (
+	// Timeout resumes goroutines from a setTimeout callback. The host can
+	// render and handle events in between, but browsers delay nested timeouts
+	// by at least 4ms. This is the default.
+	Timeout YieldMode = 0
+	// Microtask resumes goroutines from a microtask, as soon as the current
+	// JavaScript task ends. The host can't render or handle events until all
+	// goroutines block, so goroutines that keep yielding freeze the page.
+	Microtask YieldMode = 1
+	// MessageChannel resumes goroutines from a message posted to a
+	// MessageChannel, which lets the host render and handle events like
+	// Timeout, without its delay. It falls back to Timeout on hosts without
+	// MessageChannel.
+	MessageChannel YieldMode = 2
+	// AnimationFrame resumes goroutines at the next animation frame, along
+	// with goroutines whose timers fired since the previous one, like those
+	// sleeping with time.Sleep or waiting for a time.Ticker, all in a batch.
+	// Games and visualizations get their state updated once per frame, right
+	// before the browser renders it, instead of at jittery times in between.
+	// While the page is hidden, browsers don't run animation frames, see
+	// SetBackgroundPolicy. On hosts without requestAnimationFrame, like
+	// Node.js, batches run from a setTimeout callback.
+	AnimationFrame YieldMode = 3
+)
+
+var yieldModes = [...]string{Timeout: "timeout", Microtask: "microtask", MessageChannel: "message", AnimationFrame: "frame"}
+
+func (m YieldMode) String() string {
+	switch m {
+	case Timeout:
+		return "Timeout"
+	case Microtask:
+		return "Microtask"
+	case MessageChannel:
+		return "MessageChannel"
+	case AnimationFrame:
+		return "AnimationFrame"
+	}
+	return "YieldMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// SetYieldMode sets how goroutines that yield to the host, with
+// runtime.Gosched, get resumed from then on, and returns the previous mode.
+func SetYieldMode(mode YieldMode) YieldMode {
+	if mode < 0 || int(mode) >= len(yieldModes) {
+		panic("jsruntime: invalid " + mode.String())
+	}
+	prev := Timeout
+	for m, name := range yieldModes {
+		if js.Global.Get("$yieldMode").String() == name {
+			prev = YieldMode(m)
+		}
+	}
+	js.Global.Set("$yieldMode", yieldModes[mode])
+	return prev
+}
+
+// BackgroundPolicy is what happens to goroutines waiting for an animation
+// frame in AnimationFrame mode while the page is hidden.
+type BackgroundPolicy int
+
+const (
+	// BackgroundRun keeps resuming goroutines from setTimeout callbacks while
+	// the page is hidden, which browsers throttle to about once a second. This
+	// is the default.
+	BackgroundRun BackgroundPolicy = 0
+	// BackgroundPause leaves goroutines waiting until the page is visible
+	// again, so that, e.g., a game doesn't keep running while nobody sees it.
+	BackgroundPause BackgroundPolicy = 1
+)
+
+// SetBackgroundPolicy sets what happens to goroutines waiting for an animation
+// frame while the page is hidden from then on, and returns the previous
+// policy.
+func SetBackgroundPolicy(policy BackgroundPolicy) BackgroundPolicy {
+	if policy != BackgroundRun && policy != BackgroundPause {
+		panic("jsruntime: invalid BackgroundPolicy(" + strconv.Itoa(int(policy)) + ")")
+	}
+	prev := BackgroundRun
+	if js.Global.Get("$framePauseInBackground").Bool() {
+		prev = BackgroundPause
+	}
+	js.Global.Set("$framePauseInBackground", policy == BackgroundPause)
+	return prev
+}
+
+// Flush runs the goroutines that are waiting to be resumed after yielding to
+// the host synchronously, until all goroutines are blocked or have yielded
+// again. Goroutines that are ready to run otherwise already run before
+// control returns to JavaScript, so when Flush returns, goroutines have
+// handled everything sent to them. Goroutines waiting for timers, like
+// time.Sleep, aren't resumed early.
+//
+// Flush must be called from code called synchronously by JavaScript, like a
+// function wrapped with js.FuncOf and js.Sync, and panics when called from a
+// goroutine, which can't run other goroutines.
+func Flush() {
+	if js.Global.Get("$curGoroutine") != js.Global.Get("$noGoroutine") {
+		panic("jsruntime: Flush called from a goroutine")
+	}
+	js.Global.Call("$flushYielded")
+}
+
+// Trim returns slice, which must be a slice, in a backing array of its own
+// length if its current backing array is larger, so that the memory of the
+// rest of that array can be reclaimed once nothing else refers to it. Use it
+// for long-lived slices that were built with append, or that are small parts
+// of large buffers, like a header read into a large []byte:
+//
+//	header = jsruntime.Trim(buf[:n]).([]byte)
+//
+// The returned slice doesn't share memory with slice unless slice is returned
+// as is.
+func Trim(slice interface{}) interface{} {
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice {
+		panic("jsruntime: Trim of non-slice " + v.Type().String())
+	}
+	if v.IsNil() || js.InternalObject(slice).Get("$array").Length() == v.Len() {
+		return slice
+	}
+	trimmed := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(trimmed, v)
+	return trimmed.Interface()
+}
@@ -0,0 +1,117 @@
+// +build js
+
+package jsruntime_test
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/gopherjs/gopherjs/runtime/jsruntime"
+)
+
+// callback calls f synchronously from a JavaScript timer, outside of
+// goroutines, and waits for it to return.
+func callback(f func()) {
+	done := make(chan struct{})
+	js.Global.Call("setTimeout", js.FuncOf(func(this *js.Object, args []*js.Object) interface{} {
+		f()
+		close(done)
+		return nil
+	}, js.Sync), 0)
+	<-done
+}
+
+func TestFlush(t *testing.T) {
+	var steps []int
+	callback(func() {
+		steps = append(steps, len(steps))
+		go func() {
+			for i := 0; i < 3; i++ {
+				runtime.Gosched()
+				steps = append(steps, len(steps))
+			}
+		}()
+		if len(steps) != 1 {
+			t.Errorf("Got %d steps before the goroutine yielded, want 1", len(steps))
+		}
+		jsruntime.Flush()
+		if len(steps) != 2 {
+			t.Errorf("Got %d steps after the first Flush, want 2", len(steps))
+		}
+		jsruntime.Flush()
+		jsruntime.Flush()
+		if len(steps) != 4 {
+			t.Errorf("Got %d steps after three Flush calls, want 4", len(steps))
+		}
+	})
+}
+
+func TestFlushInGoroutine(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Flush didn't panic when called from a goroutine")
+		}
+	}()
+	jsruntime.Flush()
+}
+
+func TestSetYieldMode(t *testing.T) {
+	defer jsruntime.SetYieldMode(jsruntime.Timeout)
+	for _, mode := range []jsruntime.YieldMode{jsruntime.Microtask, jsruntime.MessageChannel, jsruntime.AnimationFrame, jsruntime.Timeout} {
+		jsruntime.SetYieldMode(mode)
+		done := make(chan struct{})
+		go func() {
+			runtime.Gosched()
+			close(done)
+		}()
+		<-done
+		if got := jsruntime.SetYieldMode(mode); got != mode {
+			t.Errorf("SetYieldMode returned previous mode %v, want %v", got, mode)
+		}
+	}
+}
+
+func TestAnimationFrameTimers(t *testing.T) {
+	defer jsruntime.SetYieldMode(jsruntime.SetYieldMode(jsruntime.AnimationFrame))
+	start := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("time.Sleep(10ms) returned after %v in AnimationFrame mode", elapsed)
+	}
+	timer := time.NewTimer(time.Hour)
+	if !timer.Stop() {
+		t.Error("Stop of a pending timer returned false")
+	}
+}
+
+func TestSetBackgroundPolicy(t *testing.T) {
+	if got := jsruntime.SetBackgroundPolicy(jsruntime.BackgroundPause); got != jsruntime.BackgroundRun {
+		t.Errorf("Got initial policy %v, want BackgroundRun", got)
+	}
+	if got := jsruntime.SetBackgroundPolicy(jsruntime.BackgroundRun); got != jsruntime.BackgroundPause {
+		t.Errorf("SetBackgroundPolicy returned previous policy %v, want BackgroundPause", got)
+	}
+}
+
+func TestTrim(t *testing.T) {
+	buf := make([]byte, 1024)
+	copy(buf, "header")
+	header := jsruntime.Trim(buf[:6]).([]byte)
+	if string(header) != "header" || cap(header) != 6 {
+		t.Errorf("Got trimmed slice %q with capacity %d, want %q with capacity 6", header, cap(header), "header")
+	}
+	header[0] = 'H'
+	if buf[0] != 'h' {
+		t.Error("Trimmed slice shares memory with the original one")
+	}
+
+	exact := []int{1, 2, 3}
+	if got := jsruntime.Trim(exact).([]int); &got[0] != &exact[0] {
+		t.Error("Trim copied a slice that fills its backing array")
+	}
+	if got := jsruntime.Trim([]string(nil)).([]string); got != nil {
+		t.Errorf("Got Trim(nil) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,82 @@
+// +build js
+
+// Package weak provides weak pointers, which refer to memory without keeping
+// it alive, like the weak package of newer Go releases. It is implemented with
+// the WeakRef objects of JavaScript. As GopherJS doesn't support generics,
+// pointers are passed and returned as interface{} values.
+//
+// Weak pointers are useful for caches and canonicalization maps that must not
+// keep otherwise unused values alive:
+//
+//	p := weak.Make(value)
+//	...
+//	if v, ok := p.Value().(*Value); ok {
+//		// value is still alive.
+//	}
+package weak
+
+import "github.com/gopherjs/gopherjs/js"
+
+// Pointer is a weak pointer to a value. The zero value is a nil pointer.
+//
+// Two Pointers compare equal if they were made from the same pointer, even if
+// the value they point to has been collected since then.
+type Pointer struct {
+	ref *js.Object
+}
+
+// refs are the WeakRefs of the pointers passed to Make, so that Make returns
+// equal Pointers for the same pointer.
+var refs *js.Object
+
+// Make returns a weak pointer to the value ptr points to, which must be a
+// pointer or nil. On hosts without WeakRef, the weak pointer keeps the value
+// alive, as if it was an ordinary pointer.
+func Make(ptr interface{}) Pointer {
+	if ptr == nil {
+		return Pointer{}
+	}
+	o := js.InternalObject(ptr)
+	typ := o.Get("constructor")
+	if typ.Get("kind").Int() != js.Global.Get("$kindPtr").Int() {
+		panic("weak.Make: argument is " + typ.Get("string").String() + ", not pointer")
+	}
+	if o == typ.Get("nil") {
+		return Pointer{}
+	}
+	if refs == nil {
+		refs = js.Global.Get("WeakMap").New()
+	}
+	ref := refs.Call("get", o)
+	if ref == js.Undefined {
+		if js.Global.Get("WeakRef") != js.Undefined {
+			ref = js.Global.Get("WeakRef").New(o)
+		} else {
+			ref = js.Global.Get("Object").New()
+			ref.Set("deref", js.InternalObject(func() *js.Object { return o }))
+		}
+		refs.Call("set", o, ref)
+	}
+	return Pointer{ref: ref}
+}
+
+// box holds an interface value, so that a pointer can be stored into it
+// without being converted to a JavaScript value.
+type box struct {
+	v interface{}
+}
+
+// Value returns the pointer p was made from, or nil if p is nil or the value
+// it points to has been collected.
+func (p Pointer) Value() interface{} {
+	if p.ref == nil {
+		return nil
+	}
+	o := p.ref.Call("deref")
+	if o == js.Undefined {
+		return nil
+	}
+	b := &box{}
+	js.InternalObject(b).Set("v", o)
+	return b.v
+}
@@ -0,0 +1,47 @@
+// +build js
+
+package weak_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/runtime/weak"
+)
+
+func TestPointer(t *testing.T) {
+	type value struct{ n int }
+	v := &value{n: 1}
+	p := weak.Make(v)
+	if got, ok := p.Value().(*value); !ok || got != v {
+		t.Errorf("Got Value() %v, want %p", p.Value(), v)
+	}
+	if p != weak.Make(v) {
+		t.Error("Pointers made from the same pointer are not equal")
+	}
+	if p == weak.Make(&value{n: 1}) {
+		t.Error("Pointers made from different pointers are equal")
+	}
+
+	n := new(int)
+	if got, ok := weak.Make(n).Value().(*int); !ok || got != n {
+		t.Errorf("Got Value() %v, want %p", got, n)
+	}
+}
+
+func TestNil(t *testing.T) {
+	var nilValue *int
+	for _, ptr := range []interface{}{nil, nilValue} {
+		if p := weak.Make(ptr); p != (weak.Pointer{}) || p.Value() != nil {
+			t.Errorf("Make(%#v) = %v with value %v, want the zero Pointer", ptr, p, p.Value())
+		}
+	}
+}
+
+func TestMakeNonPointer(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Make of a non-pointer didn't panic")
+		}
+	}()
+	weak.Make(42)
+}
@@ -0,0 +1,298 @@
+// Package sqlproxy provides a database/sql driver that forwards queries to a
+// remote database over HTTP.
+//
+// It is intended for prototyping and testing of Go data access code compiled
+// with GopherJS, where a direct database connection is not possible. The driver
+// is registered under the name "sqlproxy", and the data source name is the
+// base URL of a proxy server, for example:
+//
+//  db, err := sql.Open("sqlproxy", "https://example.com/sqlproxy")
+//
+// A reference proxy server implementation is provided by NewHandler. Every
+// operation is a POST request with a JSON-encoded Request body to one of the
+// following paths relative to the base URL, the proxy replies with a
+// JSON-encoded Response:
+//
+//  /exec      executes Request.Query with Request.Args, which doesn't return rows.
+//  /query     executes Request.Query with Request.Args and returns all rows.
+//  /begin     starts a transaction and returns its identifier.
+//  /commit    commits transaction Request.Tx.
+//  /rollback  rolls back transaction Request.Tx.
+//
+// Statements executed with a non-empty Request.Tx are executed within the
+// transaction. Query results are fully buffered by the proxy, so the driver is
+// not suitable for large result sets.
+//
+// Never expose the proxy to untrusted clients: it executes arbitrary queries.
+package sqlproxy
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func init() {
+	sql.Register("sqlproxy", &Driver{})
+}
+
+// Driver implements database/sql/driver.Driver for the proxy protocol.
+type Driver struct {
+	// Client used to make requests to the proxy. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// Open returns a new connection to the proxy at the base URL name.
+func (d *Driver) Open(name string) (driver.Conn, error) {
+	c, err := d.OpenConnector(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.Connect(context.Background())
+}
+
+// OpenConnector returns a connector for the proxy at the base URL name.
+func (d *Driver) OpenConnector(name string) (driver.Connector, error) {
+	if name == "" {
+		return nil, errors.New("sqlproxy: proxy URL is required")
+	}
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &connector{driver: d, client: client, url: strings.TrimSuffix(name, "/")}, nil
+}
+
+type connector struct {
+	driver *Driver
+	client *http.Client
+	url    string
+}
+
+func (c *connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{client: c.client, url: c.url}, nil
+}
+
+func (c *connector) Driver() driver.Driver { return c.driver }
+
+// conn is a logical connection to the proxy. The proxy is stateless apart from
+// transactions, so the only per-connection state is the current transaction.
+type conn struct {
+	client *http.Client
+	url    string
+	tx     string
+}
+
+var (
+	_ driver.ConnBeginTx        = (*conn)(nil)
+	_ driver.ConnPrepareContext = (*conn)(nil)
+	_ driver.ExecerContext      = (*conn)(nil)
+	_ driver.QueryerContext     = (*conn)(nil)
+	_ driver.NamedValueChecker  = (*conn)(nil)
+	_ driver.StmtExecContext    = (*stmt)(nil)
+	_ driver.StmtQueryContext   = (*stmt)(nil)
+)
+
+// call sends the request to the given proxy endpoint and decodes the response.
+func (c *conn) call(ctx context.Context, path string, req *Request) (*Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpResp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("sqlproxy: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	resp := &Response{}
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("sqlproxy: failed to decode proxy response (HTTP status %q): %w", httpResp.Status, err)
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sqlproxy: unexpected HTTP status %q", httpResp.Status)
+	}
+	return resp, nil
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// PrepareContext returns a statement bound to the connection. Statements are
+// not prepared by the proxy, the query is sent on every execution instead.
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return &stmt{conn: c, query: query}, nil
+}
+
+func (c *conn) Close() error { return nil }
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.tx != "" {
+		return nil, errors.New("sqlproxy: transaction already in progress")
+	}
+	resp, err := c.call(ctx, pathBegin, &Request{ReadOnly: opts.ReadOnly, Isolation: int(opts.Isolation)})
+	if err != nil {
+		return nil, err
+	}
+	c.tx = resp.Tx
+	return &tx{conn: c}, nil
+}
+
+// CheckNamedValue restricts arguments to the types supported by the wire format.
+// Named arguments are not supported.
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if nv.Name != "" {
+		return errors.New("sqlproxy: named arguments are not supported")
+	}
+	var err error
+	nv.Value, err = driver.DefaultParameterConverter.ConvertValue(nv.Value)
+	return err
+}
+
+func (c *conn) request(query string, args []driver.NamedValue) (*Request, error) {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	encoded, err := encodeValues(values)
+	if err != nil {
+		return nil, err
+	}
+	return &Request{Query: query, Args: encoded, Tx: c.tx}, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	req, err := c.request(query, args)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.call(ctx, pathExec, req)
+	if err != nil {
+		return nil, err
+	}
+	return &result{resp: resp}, nil
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	req, err := c.request(query, args)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.call(ctx, pathQuery, req)
+	if err != nil {
+		return nil, err
+	}
+	return &rows{columns: resp.Columns, rows: resp.Rows}, nil
+}
+
+type stmt struct {
+	conn  *conn
+	query string
+}
+
+func (s *stmt) Close() error  { return nil }
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.ExecContext(ctx, s.query, args)
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.QueryContext(ctx, s.query, args)
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	result := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		result[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return result
+}
+
+type tx struct {
+	conn *conn
+}
+
+func (t *tx) Commit() error   { return t.finish(pathCommit) }
+func (t *tx) Rollback() error { return t.finish(pathRollback) }
+
+func (t *tx) finish(path string) error {
+	id := t.conn.tx
+	t.conn.tx = ""
+	_, err := t.conn.call(context.Background(), path, &Request{Tx: id})
+	return err
+}
+
+type result struct {
+	resp *Response
+}
+
+func (r *result) LastInsertId() (int64, error) {
+	if r.resp.LastInsertIDError != "" {
+		return 0, errors.New(r.resp.LastInsertIDError)
+	}
+	return r.resp.LastInsertID, nil
+}
+
+func (r *result) RowsAffected() (int64, error) {
+	if r.resp.RowsAffectedError != "" {
+		return 0, errors.New(r.resp.RowsAffectedError)
+	}
+	return r.resp.RowsAffected, nil
+}
+
+type rows struct {
+	columns []string
+	rows    [][]Value
+}
+
+func (r *rows) Columns() []string { return r.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	if len(row) != len(dest) {
+		return fmt.Errorf("sqlproxy: got %d values in a row, want %d", len(row), len(dest))
+	}
+	values, err := decodeValues(row)
+	if err != nil {
+		return err
+	}
+	copy(dest, values)
+	return nil
+}
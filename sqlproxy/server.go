@@ -0,0 +1,225 @@
+package sqlproxy
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// NewHandler returns a reference implementation of the proxy server, which
+// executes queries against db. Mount it under the base URL the driver is
+// opened with, for example:
+//
+//  http.Handle("/sqlproxy/", http.StripPrefix("/sqlproxy", sqlproxy.NewHandler(db)))
+//
+// The handler performs no authentication or authorization, and must only be
+// used in trusted environments such as local development and tests.
+// Transactions abandoned by clients are never rolled back.
+func NewHandler(db *sql.DB) http.Handler {
+	h := &handler{db: db, txs: map[string]*sql.Tx{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathExec, h.serve(h.exec))
+	mux.HandleFunc(pathQuery, h.serve(h.query))
+	mux.HandleFunc(pathBegin, h.serve(h.begin))
+	mux.HandleFunc(pathCommit, h.serve(h.commit))
+	mux.HandleFunc(pathRollback, h.serve(h.rollback))
+	return mux
+}
+
+type handler struct {
+	db *sql.DB
+
+	mu     sync.Mutex
+	nextTx int
+	txs    map[string]*sql.Tx
+}
+
+// serve adapts an endpoint implementation to http.HandlerFunc, taking care of
+// request and response encoding.
+func (h *handler) serve(endpoint func(ctx context.Context, req *Request) (*Response, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			json.NewEncoder(w).Encode(&Response{Error: "sqlproxy: method not allowed"})
+			return
+		}
+
+		req := &Request{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(&Response{Error: fmt.Sprintf("sqlproxy: failed to decode request: %s", err)})
+			return
+		}
+
+		resp, err := endpoint(r.Context(), req)
+		if err != nil {
+			// Database errors are reported with a successful HTTP status, so that the
+			// client can tell them apart from transport problems.
+			resp = &Response{Error: err.Error()}
+		}
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// execer is the subset of methods shared by *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// target returns the transaction the request refers to, or the database if the
+// request is not a part of a transaction.
+func (h *handler) target(req *Request) (execer, error) {
+	if req.Tx == "" {
+		return h.db, nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	tx, ok := h.txs[req.Tx]
+	if !ok {
+		return nil, fmt.Errorf("sqlproxy: unknown transaction %q", req.Tx)
+	}
+	return tx, nil
+}
+
+func requestArgs(req *Request) ([]interface{}, error) {
+	values, err := decodeValues(req.Args)
+	if err != nil {
+		return nil, err
+	}
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args, nil
+}
+
+func (h *handler) exec(ctx context.Context, req *Request) (*Response, error) {
+	target, err := h.target(req)
+	if err != nil {
+		return nil, err
+	}
+	args, err := requestArgs(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := target.ExecContext(ctx, req.Query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &Response{}
+	if resp.LastInsertID, err = result.LastInsertId(); err != nil {
+		resp.LastInsertIDError = err.Error()
+	}
+	if resp.RowsAffected, err = result.RowsAffected(); err != nil {
+		resp.RowsAffectedError = err.Error()
+	}
+	return resp, nil
+}
+
+func (h *handler) query(ctx context.Context, req *Request) (*Response, error) {
+	target, err := h.target(req)
+	if err != nil {
+		return nil, err
+	}
+	args, err := requestArgs(req)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := target.QueryContext(ctx, req.Query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &Response{}
+	if resp.Columns, err = rows.Columns(); err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(resp.Columns))
+	pointers := make([]interface{}, len(resp.Columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make([]Value, len(values))
+		for i, v := range values {
+			// Drivers may return types beyond the ones defined by database/sql/driver,
+			// normalize them before encoding.
+			dv, err := driver.DefaultParameterConverter.ConvertValue(v)
+			if err != nil {
+				return nil, err
+			}
+			if row[i], err = encodeValue(dv); err != nil {
+				return nil, err
+			}
+		}
+		resp.Rows = append(resp.Rows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (h *handler) begin(ctx context.Context, req *Request) (*Response, error) {
+	// The request context is canceled as soon as the response is sent, which
+	// would roll the transaction back, so it must not be used here.
+	tx, err := h.db.BeginTx(context.Background(), &sql.TxOptions{
+		Isolation: sql.IsolationLevel(req.Isolation),
+		ReadOnly:  req.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.nextTx++
+	id := strconv.Itoa(h.nextTx)
+	h.txs[id] = tx
+	return &Response{Tx: id}, nil
+}
+
+// finish removes the transaction the request refers to from the set of open
+// transactions and returns it.
+func (h *handler) finish(req *Request) (*sql.Tx, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	tx, ok := h.txs[req.Tx]
+	if !ok {
+		return nil, fmt.Errorf("sqlproxy: unknown transaction %q", req.Tx)
+	}
+	delete(h.txs, req.Tx)
+	return tx, nil
+}
+
+func (h *handler) commit(ctx context.Context, req *Request) (*Response, error) {
+	tx, err := h.finish(req)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{}, tx.Commit()
+}
+
+func (h *handler) rollback(ctx context.Context, req *Request) (*Response, error) {
+	tx, err := h.finish(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return nil, err
+	}
+	return &Response{}, nil
+}
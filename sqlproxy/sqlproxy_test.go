@@ -0,0 +1,200 @@
+package sqlproxy
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// echoDriver is a fake database driver backing the proxy in tests. Queries
+// return a single row with the query arguments, exec reports the number of
+// arguments as the number of affected rows. Transaction outcomes are recorded
+// in the log.
+type echoDriver struct {
+	mu  sync.Mutex
+	log []string
+}
+
+func (d *echoDriver) record(event string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.log = append(d.log, event)
+}
+
+func (d *echoDriver) Open(name string) (driver.Conn, error) { return &echoConn{d: d}, nil }
+
+type echoConn struct{ d *echoDriver }
+
+func (c *echoConn) Prepare(query string) (driver.Stmt, error) {
+	return &echoStmt{d: c.d, query: query}, nil
+}
+func (c *echoConn) Close() error              { return nil }
+func (c *echoConn) Begin() (driver.Tx, error) { return &echoTx{d: c.d}, nil }
+
+type echoTx struct{ d *echoDriver }
+
+func (t *echoTx) Commit() error   { t.d.record("commit"); return nil }
+func (t *echoTx) Rollback() error { t.d.record("rollback"); return nil }
+
+type echoStmt struct {
+	d     *echoDriver
+	query string
+}
+
+func (s *echoStmt) Close() error  { return nil }
+func (s *echoStmt) NumInput() int { return -1 }
+
+func (s *echoStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query == "fail" {
+		return nil, errors.New("exec failed")
+	}
+	s.d.record("exec " + s.query)
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (s *echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args}, nil
+}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *echoRows) Columns() []string {
+	columns := make([]string, len(r.values))
+	for i := range columns {
+		columns[i] = fmt.Sprintf("c%d", i)
+	}
+	return columns
+}
+
+func (r *echoRows) Close() error { return nil }
+
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func setup(t *testing.T) (*sql.DB, *echoDriver) {
+	t.Helper()
+	backend := &echoDriver{}
+	server := httptest.NewServer(NewHandler(sql.OpenDB(echoConnector{backend})))
+	t.Cleanup(server.Close)
+
+	db, err := sql.Open("sqlproxy", server.URL+"/")
+	if err != nil {
+		t.Fatalf("sql.Open() returned error: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, backend
+}
+
+// echoConnector opens connections to a specific echoDriver instance.
+type echoConnector struct{ d *echoDriver }
+
+func (c echoConnector) Connect(context.Context) (driver.Conn, error) { return c.d.Open("") }
+func (c echoConnector) Driver() driver.Driver                        { return c.d }
+
+func TestQuery(t *testing.T) {
+	db, _ := setup(t)
+
+	now := time.Date(2021, 3, 4, 5, 6, 7, 8, time.UTC)
+	var (
+		i  int64
+		f  float64
+		b  bool
+		bs []byte
+		s  string
+		tm time.Time
+		n  sql.NullString
+	)
+	err := db.QueryRow("select", int64(1)<<60+1, 1.5, true, []byte{0, 1, 255}, "hello", now, nil).Scan(&i, &f, &b, &bs, &s, &tm, &n)
+	if err != nil {
+		t.Fatalf("QueryRow() returned error: %s", err)
+	}
+	if i != int64(1)<<60+1 {
+		t.Errorf("Got int64 %d, want %d", i, int64(1)<<60+1)
+	}
+	if f != 1.5 {
+		t.Errorf("Got float64 %v, want 1.5", f)
+	}
+	if !b {
+		t.Errorf("Got bool %v, want true", b)
+	}
+	if !bytes.Equal(bs, []byte{0, 1, 255}) {
+		t.Errorf("Got bytes %v, want [0 1 255]", bs)
+	}
+	if s != "hello" {
+		t.Errorf("Got string %q, want %q", s, "hello")
+	}
+	if !tm.Equal(now) {
+		t.Errorf("Got time %v, want %v", tm, now)
+	}
+	if n.Valid {
+		t.Errorf("Got %v, want NULL", n)
+	}
+}
+
+func TestExec(t *testing.T) {
+	db, _ := setup(t)
+
+	result, err := db.Exec("update", 1, "two")
+	if err != nil {
+		t.Fatalf("Exec() returned error: %s", err)
+	}
+	if got, err := result.RowsAffected(); err != nil || got != 2 {
+		t.Errorf("RowsAffected() returned %d, %v; want 2, nil", got, err)
+	}
+	if _, err := result.LastInsertId(); err == nil {
+		t.Errorf("LastInsertId() returned no error, want an error")
+	}
+
+	if _, err := db.Exec("fail"); err == nil || err.Error() != "exec failed" {
+		t.Errorf("Exec() returned error %v, want %q", err, "exec failed")
+	}
+}
+
+func TestTx(t *testing.T) {
+	db, backend := setup(t)
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("Begin() returned error: %s", err)
+	}
+	if _, err := tx.Exec("first"); err != nil {
+		t.Fatalf("Exec() returned error: %s", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() returned error: %s", err)
+	}
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatalf("Begin() returned error: %s", err)
+	}
+	if _, err := tx.Exec("second"); err != nil {
+		t.Fatalf("Exec() returned error: %s", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback() returned error: %s", err)
+	}
+
+	want := []string{"exec first", "commit", "exec second", "rollback"}
+	if !reflect.DeepEqual(backend.log, want) {
+		t.Errorf("Got backend log %q, want %q", backend.log, want)
+	}
+}
@@ -0,0 +1,127 @@
+package sqlproxy
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Paths of the proxy endpoints, relative to the proxy base URL.
+const (
+	pathExec     = "/exec"
+	pathQuery    = "/query"
+	pathBegin    = "/begin"
+	pathCommit   = "/commit"
+	pathRollback = "/rollback"
+)
+
+// Value is the wire representation of a driver.Value.
+//
+// JSON can't distinguish between integers and floats, or strings and bytes,
+// so every value carries an explicit type tag. Numbers are encoded as strings
+// to avoid losing 64-bit integer precision in JavaScript.
+type Value struct {
+	// Type is one of "null", "int64", "float64", "bool", "bytes", "string" or "time".
+	Type string `json:"t"`
+	// Value is the string representation of the value: decimal for numbers,
+	// "true" or "false" for booleans, standard base64 for bytes and RFC 3339
+	// with nanoseconds for time. Omitted for null.
+	Value string `json:"v,omitempty"`
+}
+
+func encodeValue(v driver.Value) (Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return Value{Type: "null"}, nil
+	case int64:
+		return Value{Type: "int64", Value: strconv.FormatInt(v, 10)}, nil
+	case float64:
+		return Value{Type: "float64", Value: strconv.FormatFloat(v, 'g', -1, 64)}, nil
+	case bool:
+		return Value{Type: "bool", Value: strconv.FormatBool(v)}, nil
+	case []byte:
+		return Value{Type: "bytes", Value: base64.StdEncoding.EncodeToString(v)}, nil
+	case string:
+		return Value{Type: "string", Value: v}, nil
+	case time.Time:
+		return Value{Type: "time", Value: v.Format(time.RFC3339Nano)}, nil
+	default:
+		return Value{}, fmt.Errorf("sqlproxy: unsupported value type %T", v)
+	}
+}
+
+func (v Value) decode() (driver.Value, error) {
+	switch v.Type {
+	case "null":
+		return nil, nil
+	case "int64":
+		return strconv.ParseInt(v.Value, 10, 64)
+	case "float64":
+		return strconv.ParseFloat(v.Value, 64)
+	case "bool":
+		return strconv.ParseBool(v.Value)
+	case "bytes":
+		return base64.StdEncoding.DecodeString(v.Value)
+	case "string":
+		return v.Value, nil
+	case "time":
+		return time.Parse(time.RFC3339Nano, v.Value)
+	default:
+		return nil, fmt.Errorf("sqlproxy: unsupported value type %q", v.Type)
+	}
+}
+
+func encodeValues(vs []driver.Value) ([]Value, error) {
+	result := make([]Value, len(vs))
+	for i, v := range vs {
+		var err error
+		if result[i], err = encodeValue(v); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func decodeValues(vs []Value) ([]driver.Value, error) {
+	result := make([]driver.Value, len(vs))
+	for i, v := range vs {
+		var err error
+		if result[i], err = v.decode(); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Request is the body of every request sent to the proxy.
+type Request struct {
+	// Query to execute, for the exec and query endpoints.
+	Query string `json:"query,omitempty"`
+	// Positional query arguments.
+	Args []Value `json:"args,omitempty"`
+	// Transaction the statement belongs to, as returned by the begin endpoint.
+	// Empty for statements executed outside of a transaction.
+	Tx string `json:"tx,omitempty"`
+	// Transaction options, for the begin endpoint.
+	ReadOnly  bool `json:"readOnly,omitempty"`
+	Isolation int  `json:"isolation,omitempty"`
+}
+
+// Response is the body of every response sent by the proxy.
+type Response struct {
+	// Error message, if the operation failed. All other fields are unset.
+	Error string `json:"error,omitempty"`
+	// Result of the exec endpoint. If the database doesn't support one of the
+	// values, the corresponding error field is set instead.
+	LastInsertID      int64  `json:"lastInsertId,omitempty"`
+	LastInsertIDError string `json:"lastInsertIdError,omitempty"`
+	RowsAffected      int64  `json:"rowsAffected,omitempty"`
+	RowsAffectedError string `json:"rowsAffectedError,omitempty"`
+	// Result of the query endpoint.
+	Columns []string  `json:"columns,omitempty"`
+	Rows    [][]Value `json:"rows,omitempty"`
+	// Transaction identifier, returned by the begin endpoint.
+	Tx string `json:"tx,omitempty"`
+}
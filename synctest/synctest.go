@@ -0,0 +1,51 @@
+// +build js
+
+// Package synctest provides support for testing concurrent code with a fake
+// clock. It is the GopherJS equivalent of the experimental testing/synctest
+// package of newer Go releases.
+//
+// Run executes a function in an isolated "bubble" of goroutines. Within the
+// bubble, the time package uses a fake clock that starts at midnight UTC
+// 2000-01-01, and only advances when every goroutine in the bubble is blocked.
+// Timers and sleeps then complete instantly and in a deterministic order:
+//
+//  synctest.Run(func() {
+//  	start := time.Now()
+//  	time.Sleep(time.Hour) // Returns immediately.
+//  	fmt.Println(time.Since(start)) // Prints 1h0m0s.
+//  })
+//
+// Unlike upstream, goroutines blocked on anything, including channels created
+// outside of the bubble and JavaScript callbacks, are considered blocked.
+package synctest
+
+import (
+	_ "time"   // Implements the fake clock.
+	_ "unsafe" // For go:linkname.
+)
+
+// Run executes f in a new goroutine, and waits for it and all goroutines it
+// starts, directly or indirectly, to exit. Those goroutines form a bubble that
+// observes a fake clock, as described in the package documentation.
+//
+// Run panics if the goroutines of the bubble are all blocked and no timer is
+// pending, or if f panics. Run can't be called from within a bubble.
+func Run(f func()) {
+	done, finish := start(f)
+	<-done
+	finish()
+}
+
+// Wait blocks until every goroutine in the bubble of the current goroutine,
+// other than the current one, is blocked. It doesn't advance the fake clock.
+// Wait panics if called from outside a bubble, or concurrently with another
+// call to Wait in the same bubble.
+func Wait() {
+	<-startWait()
+}
+
+//go:linkname start time.synctestStart
+func start(f func()) (done <-chan struct{}, finish func())
+
+//go:linkname startWait time.synctestStartWait
+func startWait() <-chan struct{}
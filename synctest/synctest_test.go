@@ -0,0 +1,107 @@
+// +build js
+
+package synctest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/synctest"
+)
+
+func TestFakeClock(t *testing.T) {
+	realStart := time.Now()
+	synctest.Run(func() {
+		start := time.Now()
+		if want := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC); !start.Equal(want) {
+			t.Errorf("Got initial time %v, want %v", start, want)
+		}
+		time.Sleep(time.Hour)
+		if got := time.Since(start); got != time.Hour {
+			t.Errorf("Got %v elapsed after time.Sleep(time.Hour), want %v", got, time.Hour)
+		}
+	})
+	if elapsed := time.Since(realStart); elapsed > time.Minute {
+		t.Errorf("Run took %v of real time, want less than a minute", elapsed)
+	}
+}
+
+func TestTimers(t *testing.T) {
+	synctest.Run(func() {
+		start := time.Now()
+		var got []time.Duration
+		done := make(chan struct{})
+		time.AfterFunc(3*time.Second, func() {
+			got = append(got, time.Since(start))
+			close(done)
+		})
+		timer := time.NewTimer(2 * time.Second)
+		stopped := time.NewTimer(time.Second)
+		stopped.Stop()
+		ticker := time.NewTicker(time.Second)
+		for i := 0; i < 2; i++ {
+			<-ticker.C
+			got = append(got, time.Since(start))
+		}
+		ticker.Stop()
+		<-timer.C
+		got = append(got, time.Since(start))
+		<-done
+
+		want := []time.Duration{time.Second, 2 * time.Second, 2 * time.Second, 3 * time.Second}
+		if len(got) != len(want) {
+			t.Fatalf("Got events at %v, want %v", got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("Got events at %v, want %v", got, want)
+				break
+			}
+		}
+	})
+}
+
+func TestWait(t *testing.T) {
+	synctest.Run(func() {
+		start := time.Now()
+		c := make(chan int)
+		result := 0
+		go func() {
+			result = <-c * 2
+		}()
+		go func() {
+			c <- 21
+		}()
+		synctest.Wait()
+		if result != 42 {
+			t.Errorf("Got result %d after synctest.Wait(), want 42", result)
+		}
+		if !time.Now().Equal(start) {
+			t.Errorf("synctest.Wait() advanced the clock by %v", time.Since(start))
+		}
+	})
+}
+
+func TestDeadlock(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("synctest.Run() didn't panic for a deadlocked bubble")
+		}
+	}()
+	synctest.Run(func() {
+		c := make(chan int)
+		<-c
+	})
+}
+
+func TestPanic(t *testing.T) {
+	defer func() {
+		if got := recover(); got != "boom" {
+			t.Errorf("Got panic %v from synctest.Run(), want boom", got)
+		}
+	}()
+	synctest.Run(func() {
+		time.Sleep(time.Second)
+		panic("boom")
+	})
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestAtomic64(t *testing.T) {
+	// 64-bit integers are represented by a pair of 32-bit halves, make sure
+	// carries between them and wraparound work.
+	u := uint64(1<<32 - 1)
+	if got := atomic.AddUint64(&u, 1); got != 1<<32 {
+		t.Errorf("AddUint64 carry: got %#x, want %#x", got, uint64(1<<32))
+	}
+	u = 1<<64 - 1
+	if got := atomic.AddUint64(&u, 2); got != 1 {
+		t.Errorf("AddUint64 wraparound: got %#x, want 1", got)
+	}
+	if got := atomic.AddUint64(&u, ^uint64(0)); got != 0 {
+		t.Errorf("AddUint64 decrement: got %#x, want 0", got)
+	}
+
+	i := int64(-1 << 63)
+	if got := atomic.AddInt64(&i, -1); got != 1<<63-1 {
+		t.Errorf("AddInt64 wraparound: got %d, want %d", got, int64(1<<63-1))
+	}
+	if atomic.CompareAndSwapInt64(&i, 1<<31-1, 0) {
+		t.Errorf("CompareAndSwapInt64 swapped values with different high halves")
+	}
+	if !atomic.CompareAndSwapInt64(&i, 1<<63-1, -1<<32) || i != -1<<32 {
+		t.Errorf("CompareAndSwapInt64: got %d, want %d", i, int64(-1<<32))
+	}
+	if got := atomic.SwapInt64(&i, 5); got != -1<<32 || atomic.LoadInt64(&i) != 5 {
+		t.Errorf("SwapInt64: got old %d and new %d, want %d and 5", got, i, int64(-1<<32))
+	}
+}
+
+func TestAtomicValueTypeCheck(t *testing.T) {
+	type T struct{ x int }
+	type I int
+
+	// Struct values and pointers to structs share their representation, as do
+	// named types and their underlying types for wrapped kinds.
+	tests := []struct {
+		name        string
+		first, then interface{}
+	}{
+		{"struct then pointer", T{}, &T{}},
+		{"pointer then struct", &T{}, T{}},
+		{"int then named int", 1, I(1)},
+		{"slice then array", []int{1}, [1]int{1}},
+		{"arrays of different length", [2]int{}, [3]int{}},
+		{"funcs of different signature", func() {}, func(int) {}},
+		{"maps of different key", map[int]int{}, map[string]int{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var v atomic.Value
+			v.Store(test.first)
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Store(%T) after Store(%T) didn't panic", test.then, test.first)
+				}
+			}()
+			v.Store(test.then)
+		})
+	}
+
+	var v atomic.Value
+	v.Store(T{1})
+	v.Store(T{2})
+	if got := v.Load().(T); got.x != 2 {
+		t.Errorf("Load: got %v, want {2}", got)
+	}
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"math"
+	"testing"
+)
+
+var (
+	c128a, c128b, c128c complex128 = 1 + 2i, -3 + 0.5i, 0.1 + 0.2i
+	c64a, c64b, c64c    complex64  = 1 + 2i, -3 + 0.5i, 0.1 + 0.2i
+	cinf                           = complex(math.Inf(1), 0)
+	cnan                           = complex(math.NaN(), 1)
+	czero, cnegzero     complex128 = 0, complex(0, math.Copysign(0, -1))
+	ftwo                float32    = 2
+)
+
+// complexCases lists complex expressions with their results computed by the
+// gc compiler.
+var complexCases = []struct {
+	name string
+	got  func() complex128
+	// Bits of the real and imaginary parts.
+	wantRe, wantIm uint64
+}{
+	{"a/b", func() complex128 { return c128a / c128b }, 0xbfcbacf914c1bad0, 0xbfe67c8a60dd67c8},
+	{"a/c", func() complex128 { return c128a / c128c }, 0x4024000000000000, 0x0000000000000000},
+	{"1i/0", func() complex128 { return 1i / czero }, 0xfff8000000000000, 0x7ff0000000000000},
+	{"-1+2i/-0i", func() complex128 { return complex(-1, 2) / cnegzero }, 0xfff0000000000000, 0x7ff0000000000000},
+	{"0/0", func() complex128 { return czero / czero }, 0xfff8000000000000, 0xfff8000000000000},
+	{"inf/a", func() complex128 { return cinf / c128a }, 0x7ff0000000000000, 0xfff0000000000000},
+	{"a/inf", func() complex128 { return c128a / cinf }, 0x0000000000000000, 0x0000000000000000},
+	{"nan/a", func() complex128 { return cnan / c128a }, 0x7ff8000000000001, 0x7ff8000000000001},
+	{"a/nan", func() complex128 { return c128a / cnan }, 0x7ff8000000000001, 0x7ff8000000000001},
+	{"(1+1i)/(1+1i)", func() complex128 { return (c128a - 1i) / (c128a - 1i) }, 0x3ff0000000000000, 0x0000000000000000},
+	{"a*b+c", func() complex128 { return c128a*c128b + c128c }, 0xc00f333333333333, 0xc015333333333333},
+	{"(a+b)*(a-c)*b", func() complex128 { return (c128a + c128b) * (c128a - c128c) * c128b }, 0x4033933333333333, 0x3fecccccccccccd4},
+	{"a*a*a*a", func() complex128 { return c128a * c128a * c128a * c128a }, 0xc01c000000000000, 0xc038000000000000},
+	{"a*complex(2,3)", func() complex128 { return c128a * complex(real(c128b), 3) }, 0xc022000000000000, 0xc008000000000000},
+	{"inf*a", func() complex128 { return cinf * c128a }, 0x7ff0000000000000, 0x7ff0000000000000},
+	{"c64 a/b", func() complex128 { return complex128(c64a / c64b) }, 0xbfcbacf920000000, 0xbfe67c8a60000000},
+	{"c64 a/c", func() complex128 { return complex128(c64a / c64c) }, 0x4024000000000000, 0x0000000000000000},
+	{"c64 a*b+c", func() complex128 { return complex128(c64a*c64b + c64c) }, 0xc00f333340000000, 0xc015333340000000},
+	{"c64 c*c*c", func() complex128 { return complex128(c64c * c64c * c64c) }, 0xbf86872b20000000, 0xbf60624de0000000},
+	{"c64 c*complex", func() complex128 { return complex128(c64c * complex(ftwo/3, ftwo)) }, 0xbfd5555560000000, 0x3fd5555560000000},
+	{"c64(c128)", func() complex128 { return complex128(complex64(c128c / 3)) }, 0x3fa1111120000000, 0x3fb1111120000000},
+	{"c64 sum", func() complex128 {
+		var s complex64
+		for i := 0; i < 1000; i++ {
+			s += c64c * c64c
+		}
+		return complex128(s)
+	}, 0xc03e0011e0000000, 0x4044001180000000},
+}
+
+func TestComplexConformance(t *testing.T) {
+	same := func(f float64, bits uint64) bool {
+		if want := math.Float64frombits(bits); math.IsNaN(want) {
+			return math.IsNaN(f) // NaN payloads aren't preserved.
+		}
+		return math.Float64bits(f) == bits
+	}
+	for _, c := range complexCases {
+		got := c.got()
+		if want := complex(math.Float64frombits(c.wantRe), math.Float64frombits(c.wantIm)); !same(real(got), c.wantRe) || !same(imag(got), c.wantIm) {
+			t.Errorf("%s = %v, want %v", c.name, got, want)
+		}
+	}
+}
+
+func TestComplexOperandsEvaluatedOnce(t *testing.T) {
+	calls := 0
+	f := func() complex128 {
+		calls++
+		return complex(float64(calls), 1)
+	}
+	// (1+1i)*(2+1i) + (3+1i)*(4+1i)
+	if got, want := f()*f()+f()*complex(real(f()), 1), complex(12, 10); got != want {
+		t.Errorf("f()*f() + f()*complex(real(f()), 1) = %v, want %v", got, want)
+	}
+	if calls != 4 {
+		t.Errorf("f called %d times, want 4", calls)
+	}
+}
+
+func TestComplexNaNMapKey(t *testing.T) {
+	m := map[complex128]int{cnan: 1}
+	m[cnan] = 2
+	if len(m) != 2 {
+		t.Errorf("len(m) = %d after setting NaN key twice, want 2", len(m))
+	}
+	if _, ok := m[cnan]; ok {
+		t.Errorf("found NaN key in map")
+	}
+}
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"expvar"
+	_ "net/http/pprof"
+	"runtime/pprof"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// debugGet serves path with the gopherjsDebug hook installed by expvar and
+// net/http/pprof.
+func debugGet(t *testing.T, path string) (status int, body string) {
+	t.Helper()
+	done := make(chan *js.Object)
+	js.Global.Call("gopherjsDebug", path).Call("then", func(r *js.Object) {
+		go func() { done <- r }()
+	})
+	r := <-done
+	return r.Get("status").Int(), r.Get("body").String()
+}
+
+func TestDebugHandlers(t *testing.T) {
+	expvar.NewInt("debughook_test").Set(42)
+	p := pprof.NewProfile("debughook_test")
+	p.Add(p, 0)
+	defer p.Remove(p)
+
+	tests := []struct {
+		path   string
+		status int
+		want   string
+	}{
+		{path: "/debug/vars", status: 200, want: `"debughook_test": 42`},
+		{path: "/debug/vars", status: 200, want: `"HeapAlloc":`},
+		{path: "/debug/pprof/", status: 200, want: "debughook_test"},
+		{path: "/debug/pprof/goroutine?debug=1", status: 200, want: "goroutine profile: total "},
+		{path: "/debug/pprof/heap?debug=1", status: 200, want: "# HeapInuse = "},
+		{path: "/debug/pprof/debughook_test?debug=1", status: 200, want: "debughook_test profile: total 1"},
+		{path: "/debug/pprof/profile?seconds=1", status: 500, want: "not supported"},
+	}
+	for _, test := range tests {
+		status, body := debugGet(t, test.path)
+		if status != test.status || !strings.Contains(body, test.want) {
+			t.Errorf("GET %s: got status %d and body %q, want status %d and body containing %q", test.path, status, body, test.status, test.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Operands are variables, so that expressions aren't folded into constants.
+var (
+	f32a, f32b, f32c float32 = 0.1, 0.2, 3.3
+	f32big           float32 = 3e9
+	f32tiny          float32 = 1e-40
+	f64v             float64 = 0.1
+	f64tie           float64 = 1 + 1.0/(1<<24)
+	i32v             int32   = 16777217
+	intv             int     = 123456789
+	u32v             uint32  = math.MaxUint32
+	i64tie           int64   = 1<<60 + 1<<36 + 1
+	i64exact         int64   = 1<<60 + 1<<36
+	i64neg           int64   = -(1<<60 + 1<<36 + 1)
+	i64small         int64   = -16777217
+	u64tie           uint64  = 1<<63 + 1<<39 + 1
+	u64max           uint64  = math.MaxUint64
+)
+
+const f32third float32 = 1.0 / 3
+
+// float32Cases lists float32 expressions with their results computed by the gc
+// compiler.
+var float32Cases = []struct {
+	name string
+	got  func() float32
+	want uint32
+}{
+	{"float32(int32)", func() float32 { return float32(i32v) }, 0x4b800000},
+	{"float32(int)", func() float32 { return float32(intv) }, 0x4ceb79a3},
+	{"float32(uint32)", func() float32 { return float32(u32v) }, 0x4f800000},
+	{"float32(int64) tie", func() float32 { return float32(i64tie) }, 0x5d800001},
+	{"float32(int64) exact tie", func() float32 { return float32(i64exact) }, 0x5d800000},
+	{"float32(int64) negative", func() float32 { return float32(i64neg) }, 0xdd800001},
+	{"float32(int64) small", func() float32 { return float32(i64small) }, 0xcb800000},
+	{"float32(uint64) tie", func() float32 { return float32(u64tie) }, 0x5f000001},
+	{"float32(uint64) max", func() float32 { return float32(u64max) }, 0x5f800000},
+	{"float32(float64)", func() float32 { return float32(f64v) }, 0x3dcccccd},
+	{"float32(float64) tie", func() float32 { return float32(f64tie) }, 0x3f800000},
+	{"a+b", func() float32 { return f32a + f32b }, 0x3e99999a},
+	{"a-b", func() float32 { return f32a - f32b }, 0xbdcccccd},
+	{"a*b", func() float32 { return f32a * f32b }, 0x3ca3d70b},
+	{"a/b", func() float32 { return f32a / f32b }, 0x3f000000},
+	{"a*b+c", func() float32 { return f32a*f32b + f32c }, 0x40547ae1},
+	{"(a+b)*(a-c)/b", func() float32 { return (f32a + f32b) * (f32a - f32c) / f32b }, 0xc099999a},
+	{"a*b*c*a*b*c", func() float32 { return f32a * f32b * f32c * f32a * f32b * f32c }, 0x3b8ebcc8},
+	{"tiny*tiny", func() float32 { return f32tiny * f32tiny }, 0x00000000},
+	{"tiny/c", func() float32 { return f32tiny / f32c }, 0x00005479},
+	{"big*big", func() float32 { return f32big * f32big * f32big * f32big }, 0x7e73c022},
+	{"-a*c", func() float32 { return -f32a * f32c }, 0xbea8f5c3},
+	{"a*0.1", func() float32 { return f32a * 0.1 }, 0x3c23d70b},
+	{"third*3", func() float32 { return f32third * 3 }, 0x3f800000},
+	{"third+a", func() float32 { return f32third + f32a }, 0x3eddddde},
+	{"float32(int32)-2^24", func() float32 { return float32(i32v) - 16777216 }, 0x00000000},
+	{"float32(float64(a)*3)", func() float32 { return float32(float64(f32a) * 3) }, 0x3e99999a},
+	{"float32(math.Sqrt(c))", func() float32 { return float32(math.Sqrt(float64(f32c))) }, 0x3fe88607},
+	{"a+=0.7", func() float32 { x := f32a; x += 0.7; return x }, 0x3f4ccccd},
+	{"a++", func() float32 { x := f32c; x++; return x }, 0x4089999a},
+	{"a*=1.1", func() float32 { x := f32c; x *= 1.1; return x }, 0x406851ec},
+	{"a/=3", func() float32 { x := f32a; x /= 3; return x }, 0x3d088889},
+	{"sum", func() float32 {
+		var s float32
+		for i := 0; i < 1000; i++ {
+			s += f32a
+		}
+		return s
+	}, 0x42c7ff83},
+	{"dot", func() float32 {
+		var s float32
+		for i := 1; i <= 100; i++ {
+			s += float32(i) * f32a / float32(i+1)
+		}
+		return s
+	}, 0x411948ca},
+}
+
+func TestFloat32Conformance(t *testing.T) {
+	for _, c := range float32Cases {
+		if got := math.Float32bits(c.got()); got != c.want {
+			t.Errorf("%s = %#08x (%v), want %#08x (%v)", c.name, got, math.Float32frombits(got), c.want, math.Float32frombits(c.want))
+		}
+	}
+}
+
+func TestFloat32Rounded(t *testing.T) {
+	// Values must be rounded when they are produced, not only when they are
+	// stored in memory, or they compare unequal to their float32 values.
+	if x := float32(i32v); x != 16777216 {
+		t.Errorf("float32(%d) = %v, want 16777216", i32v, x)
+	}
+	if m := map[float32]bool{float32(intv): true}; !m[123456792] {
+		t.Errorf("map key float32(%d) not found as 123456792", intv)
+	}
+
+	o := js.Global.Get("Object").New()
+	o.Set("f", 0.1)
+	s := struct {
+		*js.Object
+		F float32 `js:"f"`
+	}{Object: o}
+	if s.F != f32a {
+		t.Errorf("float32 field from JavaScript = %v, want %v", float64(s.F), float64(f32a))
+	}
+}
+
+func TestFloatToUint32(t *testing.T) {
+	if got := uint32(f32big); got != 3000000000 {
+		t.Errorf("uint32(%v) = %d, want 3000000000", f32big, got)
+	}
+	if got := uint(float64(f32big)); got != 3000000000 {
+		t.Errorf("uint(%v) = %d, want 3000000000", f32big, got)
+	}
+}
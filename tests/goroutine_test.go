@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -179,6 +180,24 @@ func TestCloseAfterReceiving(t *testing.T) {
 	ch <- struct{}{}
 }
 
+func TestBlockingInCallback(t *testing.T) {
+	ch := make(chan int)
+	var msg string
+	js.Global.Get("Function").New("f", "f()").Invoke(func() {
+		defer func() { msg = fmt.Sprint(recover()) }()
+		<-ch
+	})
+	if want := "cannot block in JavaScript callback: channel receive would block"; !strings.Contains(msg, want) {
+		t.Errorf("Receiving in a JavaScript callback panicked with %q, want %q", msg, want)
+	}
+	// The failed receive must not leave a waiting receiver behind.
+	select {
+	case ch <- 1:
+		t.Error("Sending to a channel without receivers succeeded")
+	default:
+	}
+}
+
 func TestDeferWithBlocking(t *testing.T) {
 	ch := make(chan struct{})
 	go func() { ch <- struct{}{} }()
@@ -213,3 +232,40 @@ func TestReturnWithBlockingDefer(t *testing.T) {
 		t.Errorf("Return value was computed %d times. Want: exactly 1.", counter)
 	}
 }
+
+func TestTimers(t *testing.T) {
+	order := make(chan int, 4)
+	time.AfterFunc(30*time.Millisecond, func() { order <- 3 })
+	time.AfterFunc(10*time.Millisecond, func() { order <- 1 })
+	moved := time.AfterFunc(20*time.Millisecond, func() { order <- 2 })
+	stopped := time.AfterFunc(15*time.Millisecond, func() { order <- -1 })
+	if !stopped.Stop() {
+		t.Error("Stop of a pending timer returned false")
+	}
+	if stopped.Stop() {
+		t.Error("Stop of a stopped timer returned true")
+	}
+	moved.Reset(25 * time.Millisecond)
+	for want := 1; want <= 3; want++ {
+		if got := <-order; got != want {
+			t.Errorf("Timer %d fired, want %d", got, want)
+		}
+	}
+}
+
+func TestTimersFiringTogether(t *testing.T) {
+	// Timers due at the same time fire in one go.
+	timers := make([]*time.Timer, 100)
+	for i := range timers {
+		timers[i] = time.NewTimer(time.Duration(i%3) * time.Millisecond)
+	}
+	for _, timer := range timers {
+		<-timer.C
+	}
+
+	ticker := time.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	for i := 0; i < 3; i++ {
+		<-ticker.C
+	}
+}
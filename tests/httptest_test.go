@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHTTPTestServer(t *testing.T) {
+	handled := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("X-Path", r.URL.Path)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, "%s %s?%s %s", r.Method, r.URL.Path, r.URL.RawQuery, body)
+		handled = true
+	}))
+	defer ts.Close()
+
+	resp, err := ts.Client().Post(ts.URL+"/foo?bar=1", "text/plain", strings.NewReader("baz"))
+	if err != nil {
+		t.Fatalf("Post() returned error: %v", err)
+	}
+	if !handled {
+		t.Errorf("Handler didn't return before the response was read")
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("Reading response body returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated || resp.Header.Get("X-Path") != "/foo" {
+		t.Errorf("Got status %d and X-Path %q, want %d and /foo", resp.StatusCode, resp.Header.Get("X-Path"), http.StatusCreated)
+	}
+	if want := "POST /foo?bar=1 baz"; string(body) != want {
+		t.Errorf("Got body %q, want %q", body, want)
+	}
+	if resp.ContentLength != int64(len(body)) {
+		t.Errorf("Got content length %d, want %d", resp.ContentLength, len(body))
+	}
+
+	// The default client is served in-process too.
+	resp, err = http.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("Get() returned error: %v", err)
+	}
+	resp.Body.Close()
+
+	ts.Close()
+	if _, err := http.Get(ts.URL); err == nil {
+		t.Errorf("Get() succeeded after the server was closed")
+	}
+}
+
+func TestHTTPTestServerPanic(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	defer ts.Close()
+
+	if _, err := http.Get(ts.URL); err == nil {
+		t.Errorf("Get() succeeded for a panicking handler")
+	}
+}
@@ -620,3 +620,18 @@ func TestStructWithNonIdentifierJSTag(t *testing.T) {
 		t.Errorf("value via js.Object.Get gave %q, want %q", got, want)
 	}
 }
+
+//gopherjs:export gopherjsExportTestJoin
+func exportedJoin(sep string, values []string) (string, int) {
+	return strings.Join(values, sep), len(values)
+}
+
+func TestExportDirective(t *testing.T) {
+	result := js.Global.Call("gopherjsExportTestJoin", "-", []interface{}{"a", "b"})
+	if got := result.Index(0).String(); got != "a-b" {
+		t.Errorf("Exported function returned %q, want %q", got, "a-b")
+	}
+	if got := result.Index(1).Int(); got != 2 {
+		t.Errorf("Exported function returned %d, want %d", got, 2)
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// The tests below check that json.Marshal and json.Unmarshal, which use
+// JSON.stringify and JSON.parse where possible, behave like json.Encoder and
+// json.Decoder, which don't.
+
+type jsonTestStruct struct {
+	A     string
+	B     float64 `json:"b,omitempty"`
+	C     bool    `json:"-"`
+	D     interface{}
+	E     []int
+	F     map[string]uint8
+	G     *jsonTestStruct `json:",omitempty"`
+	H     int64
+	Proto string `json:"__proto__"`
+	Digit string `json:"1"`
+	jsonTestEmbedded
+}
+
+type jsonTestEmbedded struct {
+	Embedded string
+}
+
+func TestJSONMarshal(t *testing.T) {
+	values := []interface{}{
+		nil,
+		"<a href=\"x\">&  \b\f\n\r\t\x00\x1f\x7f\\ü€😀</a>",
+		"invalid \xff\xfe utf-8",
+		0.0, math.Copysign(0, -1), 1e-7, 1e20, 1e21, 123456789.125, math.MaxFloat64, math.SmallestNonzeroFloat64,
+		float32(0.1), int64(1) << 53, int64(1)<<53 + 1, uint64(math.MaxUint64),
+		map[string]interface{}{"b": []interface{}{1.5, "x", true, nil}, "a": map[string]interface{}{}, "10": 1.0, "9": 2.0},
+		map[string]interface{}{"__proto__": "p", "constructor": "c", "ü": "u"},
+		[]interface{}{},
+		[]interface{}(nil),
+		map[string]interface{}(nil),
+		[]byte("bytes"),
+		[3]byte{1, 2, 3},
+		&jsonTestStruct{A: "a", D: map[string]interface{}{"x": 1.0}, E: []int{1, 2}, F: map[string]uint8{"k": 1}, G: &jsonTestStruct{}},
+		jsonTestStruct{Proto: "p", Digit: "d", jsonTestEmbedded: jsonTestEmbedded{"e"}},
+		map[string]json.Number{"n": "1.50"},
+		map[int]string{1: "a"},
+		json.RawMessage(`{"raw":true}`),
+	}
+	for _, v := range values {
+		got, gotErr := json.Marshal(v)
+		var want bytes.Buffer
+		wantErr := json.NewEncoder(&want).Encode(v)
+		if string(got)+"\n" != want.String() || (gotErr == nil) != (wantErr == nil) {
+			t.Errorf("Marshal(%#v) = %s, %v; want %s, %v", v, got, gotErr, want.String(), wantErr)
+		}
+	}
+
+	cyclic := map[string]interface{}{}
+	cyclic["self"] = cyclic
+	if _, err := json.Marshal(cyclic); err == nil {
+		t.Errorf("Marshal of a cyclic map: got no error")
+	}
+}
+
+func TestJSONUnmarshal(t *testing.T) {
+	inputs := []string{
+		`null`, `true`, `"s"`, `1.5`, `-0`, `1e400`, `123456789012345678901`, `[]`, `{}`,
+		`{"A": "a", "b": 2, "C": true, "D": {"x": [1, null, "ü😀"]}, "Embedded": "e", "unknown": 1}`,
+		`{"a": "lower", "A": "upper", "__proto__": "p", "1": "d", "constructor": "c"}`,
+		`{"A": 1}`, `{"E": [1]}`, `{"b": null, "D": null}`,
+		`{"A": "\ud800"}`, "\"\xff\xfe\"", `[1, {"a": [true]}]`, `{"a": 1, "a": 2}`,
+		`{"A": "a",}`, ``,
+	}
+	newTargets := []func() interface{}{
+		func() interface{} { return new(interface{}) },
+		func() interface{} { return new(map[string]interface{}) },
+		func() interface{} { return &map[string]interface{}{"old": 1.0} },
+		func() interface{} { return new([]interface{}) },
+		func() interface{} { return &jsonTestStruct{A: "old", B: 1, D: "old"} },
+		func() interface{} { return new(json.Number) },
+	}
+	for _, in := range inputs {
+		for _, newTarget := range newTargets {
+			got, want := newTarget(), newTarget()
+			gotErr := json.Unmarshal([]byte(in), got)
+			wantErr := json.NewDecoder(bytes.NewReader([]byte(in))).Decode(want)
+			if !reflect.DeepEqual(got, want) || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Unmarshal(%q) into %T = %#v, %v; want %#v, %v", in, got, got, gotErr, want, wantErr)
+			}
+		}
+	}
+}
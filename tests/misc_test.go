@@ -259,6 +259,42 @@ func TestNilInterfaceError(t *testing.T) {
 	_ = err.Error()
 }
 
+type nilDerefStruct struct{ Name string }
+
+func (s *nilDerefStruct) name() string { return s.Name }
+
+func TestNilDereferenceMessages(t *testing.T) {
+	catch := func(f func()) (msg string) {
+		defer func() {
+			if err, ok := recover().(runtime.Error); ok {
+				msg = err.Error()
+			}
+		}()
+		f()
+		return ""
+	}
+	const prefix = "runtime error: invalid memory address or nil pointer dereference: "
+	var s *nilDerefStruct
+	var i *int
+	var a *[3]int
+	var err error
+	tests := []struct {
+		f    func()
+		want string
+	}{
+		{func() { _ = s.name() }, "accessing field Name of nil *github.com/gopherjs/gopherjs/tests.nilDerefStruct"},
+		{func() { s.Name = "x" }, "accessing field Name of nil *github.com/gopherjs/gopherjs/tests.nilDerefStruct"},
+		{func() { _ = *i }, "using nil *int"},
+		{func() { _ = a[1] }, "indexing nil *[3]int"},
+		{func() { _ = err.Error() }, "calling method Error of nil interface"},
+	}
+	for _, test := range tests {
+		if got := catch(test.f); got != prefix+test.want {
+			t.Errorf("Got %q, want %q", got, prefix+test.want)
+		}
+	}
+}
+
 func TestIndexOutOfRangeError(t *testing.T) {
 	defer func() {
 		if err := recover(); err == nil || !strings.Contains(err.(error).Error(), "index out of range") {
@@ -685,7 +721,16 @@ func TestInterfaceConversionRuntimeError(t *testing.T) {
 		if !ok {
 			t.Fatalf("got %T (%s), want runtime.Error", r, r)
 		}
-		if got, want := re.Error(), "interface conversion: int is not tests.I: missing method Get"; got != want {
+		// The position is only known if source maps are applied to stack
+		// traces.
+		got := re.Error()
+		if i := strings.Index(got, " at "); i != -1 {
+			if pos := got[i+len(" at "):]; !strings.Contains(pos, "misc_test.go:") {
+				t.Errorf("got position %q, want one in misc_test.go", pos)
+			}
+			got = got[:i]
+		}
+		if want := "interface conversion: int is not github.com/gopherjs/gopherjs/tests.I: missing method Get"; got != want {
 			t.Fatalf("got %q, want %q", got, want)
 		}
 	}()
@@ -696,6 +741,22 @@ func TestInterfaceConversionRuntimeError(t *testing.T) {
 	_ = e.(I)
 }
 
+func TestInterfaceConversionTypeNames(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("got no panic, want panic")
+		}
+		want := "interface conversion: interface is map[string][]*github.com/gopherjs/gopherjs/tests.T, not int"
+		if got := r.(runtime.Error).Error(); !strings.HasPrefix(got, want) {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	}()
+	type T struct{}
+	var e interface{} = map[string][]*T{}
+	_ = e.(int)
+}
+
 func TestReflectMapIterationAndDelete(t *testing.T) {
 	m := map[string]int{
 		"one":   1,
@@ -844,3 +905,130 @@ func TestUntypedNil(t *testing.T) {
 		f(nil)
 	}
 }
+
+func TestStringBytesConversionElision(t *testing.T) {
+	type Bytes []byte
+	type Str string
+
+	b := []byte("h\xC3\xA9llo")
+	s := "héllo"
+	if !(string(b) == s) || !(s == string(b)) || string(b) != s {
+		t.Error("string(b) and s compare unequal")
+	}
+	if string(b) == "hello" || string(b[:1]) != "h" || string(Bytes(nil)) != "" {
+		t.Error("string(b) compares wrong with a constant")
+	}
+	if Str(Bytes("x")) != "x" || string(b) != string(b) {
+		t.Error("named types or two conversions compare wrong")
+	}
+	if len(string(b)) != 6 || len([]byte(s)) != 6 || len(Bytes(Str("ab"))) != 2 {
+		t.Error("length of a conversion is wrong")
+	}
+
+	// Operands are evaluated in order.
+	var calls []string
+	get := func(s string) string {
+		calls = append(calls, s)
+		return s
+	}
+	if get("a") == string([]byte(get("b"))) || len(calls) != 2 || calls[0] != "a" {
+		t.Errorf("Got calls %q, want [a b]", calls)
+	}
+
+	var got []byte
+	for i, c := range []byte(s) {
+		if c != s[i] {
+			t.Errorf("Byte %d is %d, want %d", i, c, s[i])
+		}
+		got = append(got, c)
+	}
+	if string(got) != s {
+		t.Errorf("Ranged over %q, want %q", got, s)
+	}
+	n := 0
+	for range Bytes(s) {
+		n++
+	}
+	if n != len(s) {
+		t.Errorf("Ranged %d times, want %d", n, len(s))
+	}
+}
+
+func TestByteSliceAppendAndCopy(t *testing.T) {
+	type Bytes []byte
+
+	var b Bytes
+	for i := 0; i < 100; i++ {
+		b = append(b, byte(i))
+	}
+	b = append(b, 'a', 'b')
+	b = append(b, "cd"...)
+	b = append(b, []byte("ef")...)
+	b = append(b, ""...)
+	if len(b) != 106 || b[99] != 99 || string(b[100:]) != "abcdef" {
+		t.Errorf("Got %v, want 0 to 99 followed by abcdef", b)
+	}
+
+	// Appending within the capacity shares the backing array.
+	s := make([]byte, 2, 10)
+	s2 := append(s, 'x', 'y')
+	s3 := append(s, "z"...)
+	if &s2[0] != &s[0] || s2[2] != 'z' || s3[2] != 'z' || len(s2) != 4 || len(s3) != 3 {
+		t.Errorf("Got s2 %q and s3 %q, want them to share the array", s2, s3)
+	}
+
+	// Overlapping copies behave like memmove, for short and long lengths.
+	for _, n := range []int{5, 100} {
+		a := make([]byte, n+3)
+		for i := range a {
+			a[i] = byte(i)
+		}
+		copy(a[3:], a)
+		for i := 0; i < n; i++ {
+			if a[i+3] != byte(i) {
+				t.Fatalf("Forward overlapping copy of %d bytes: got %v", n, a)
+			}
+		}
+		copy(a, a[3:])
+		for i := 0; i < n; i++ {
+			if a[i] != byte(i) {
+				t.Fatalf("Backward overlapping copy of %d bytes: got %v", n, a)
+			}
+		}
+	}
+
+	a := []byte("0123456789")
+	a = append(a[:2], a[1:6]...)
+	if string(a) != "0112345" {
+		t.Errorf("Overlapping append: got %q, want %q", a, "0112345")
+	}
+}
+
+func TestSetFinalizer(t *testing.T) {
+	type resource struct{ handle int }
+	r := &resource{handle: 42}
+	runtime.SetFinalizer(r, func(r *resource) {})
+	runtime.SetFinalizer(r, func(interface{}) {})
+	runtime.SetFinalizer(r, nil)
+
+	for _, test := range []struct {
+		name      string
+		obj, f    interface{}
+		wantPanic string
+	}{
+		{"non-pointer", resource{}, func(resource) {}, "first argument is tests.resource, not pointer"},
+		{"not a function", r, 42, "second argument is int, not a function"},
+		{"wrong parameter", r, func(*int) {}, "cannot pass *tests.resource to finalizer func(*int)"},
+		{"unimplemented interface", r, func(error) {}, "cannot pass *tests.resource to finalizer func(error)"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				if err == nil || !strings.Contains(err.Error(), test.wantPanic) {
+					t.Errorf("Got panic %v, want one containing %q", err, test.wantPanic)
+				}
+			}()
+			runtime.SetFinalizer(test.obj, test.f)
+		})
+	}
+}
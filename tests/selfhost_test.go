@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+// TestSelfHosting compiles and links a small program with the compiler
+// package, the way the Playground does in the browser: packages are compiled
+// from source strings, and dependencies are imported from serialized archives.
+// Under gopherjs test, this checks that the compiler itself keeps working when
+// compiled by GopherJS.
+func TestSelfHosting(t *testing.T) {
+	sources := []struct{ path, src string }{
+		{path: "runtime", src: `package runtime`},
+		{path: "example.com/greet", src: `package greet
+
+			func Hello(name string) string { return "Hello, " + name + "!" }`},
+		{path: "main", src: `package main
+
+			import "example.com/greet"
+
+			func main() { println(greet.Hello("gopher")) }`},
+	}
+
+	archives := map[string][]byte{}
+	packages := map[string]*types.Package{}
+	importContext := &compiler.ImportContext{
+		Packages: packages,
+		Import: func(path string) (*compiler.Archive, error) {
+			data, ok := archives[path]
+			if !ok {
+				return nil, fmt.Errorf("cannot find package %q", path)
+			}
+			return compiler.ReadArchive(path+".a", path, bytes.NewReader(data), packages)
+		},
+	}
+
+	var mainPkg *compiler.Archive
+	for _, s := range sources {
+		fileSet := token.NewFileSet()
+		file, err := parser.ParseFile(fileSet, s.path+".go", s.src, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v", s.path, err)
+		}
+		archive, err := compiler.Compile(s.path, []*ast.File{file}, fileSet, importContext, false, false)
+		if err != nil {
+			t.Fatalf("Failed to compile %s: %v", s.path, err)
+		}
+		var buf bytes.Buffer
+		if err := compiler.WriteArchive(archive, &buf); err != nil {
+			t.Fatalf("Failed to write the archive of %s: %v", s.path, err)
+		}
+		archives[s.path] = buf.Bytes()
+		mainPkg = archive
+	}
+
+	deps, err := compiler.ImportDependencies(mainPkg, importContext.Import)
+	if err != nil {
+		t.Fatalf("Failed to import dependencies: %v", err)
+	}
+	var code bytes.Buffer
+	if err := compiler.WriteProgramCode(deps, &compiler.SourceMapFilter{Writer: &code}, compiler.LinkOptions{}); err != nil {
+		t.Fatalf("Failed to link the program: %v", err)
+	}
+	for _, want := range []string{`$packages["example.com/greet"]`, `Hello`, `$mainPkg`} {
+		if !strings.Contains(code.String(), want) {
+			t.Errorf("Linked program does not contain %s", want)
+		}
+	}
+}
@@ -3,10 +3,16 @@
 package tests
 
 import (
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
 )
 
 func TestGetpid(t *testing.T) {
@@ -34,3 +40,76 @@ func TestOpen(t *testing.T) {
 		t.Fatalf("syscall.Close() returned error: %s", err)
 	}
 }
+
+func TestOutputHooks(t *testing.T) {
+	var stdout, stderr []byte
+	js.Global.Set("goStdout", func(b []byte) { stdout = append(stdout, b...) })
+	js.Global.Set("goStderr", func(b []byte) { stderr = append(stderr, b...) })
+	fmt.Fprint(os.Stdout, "to stdout")
+	fmt.Fprint(os.Stderr, "to stderr")
+	js.Global.Delete("goStdout")
+	js.Global.Delete("goStderr")
+
+	if got, want := string(stdout), "to stdout"; got != want {
+		t.Errorf("Got stdout %q, want %q", got, want)
+	}
+	if got, want := string(stderr), "to stderr"; got != want {
+		t.Errorf("Got stderr %q, want %q", got, want)
+	}
+}
+
+func TestStdioStat(t *testing.T) {
+	for _, f := range []*os.File{os.Stdin, os.Stdout, os.Stderr} {
+		info, err := f.Stat()
+		if err != nil {
+			t.Errorf("%s.Stat() returned error: %s", f.Name(), err)
+			continue
+		}
+		if mode := info.Mode(); mode&(os.ModeCharDevice|os.ModeNamedPipe) == 0 && !mode.IsRegular() {
+			t.Errorf("%s.Stat() returned mode %v, want a character device, a pipe or a regular file", f.Name(), mode)
+		}
+	}
+}
+
+func TestFileOperations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Failed to create a temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(name, []byte("hello world"), 0600); err != nil {
+		t.Fatalf("ioutil.WriteFile() returned error: %s", err)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("os.Open() returned error: %s", err)
+	}
+	defer f.Close()
+	if _, err := f.Seek(6, io.SeekStart); err != nil {
+		t.Fatalf("f.Seek() returned error: %s", err)
+	}
+	if got, err := ioutil.ReadAll(f); err != nil || string(got) != "world" {
+		t.Errorf("ioutil.ReadAll() returned %q, %v, want %q, nil", got, err, "world")
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0700); err != nil {
+		t.Fatalf("os.Mkdir() returned error: %s", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("os.ReadDir() returned error: %s", err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, fmt.Sprintf("%s:%v", e.Name(), e.IsDir()))
+	}
+	if want := "file:false sub:true"; strings.Join(got, " ") != want {
+		t.Errorf("os.ReadDir() returned %v, want %s", got, want)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Errorf("os.Stat() returned error %v, want a not exist error", err)
+	}
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"runtime/trace"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	var buf bytes.Buffer
+	if err := trace.Start(&buf); err != nil {
+		t.Fatalf("trace.Start() returned error: %v", err)
+	}
+	if err := trace.Start(&buf); err == nil {
+		t.Errorf("Second trace.Start() returned no error")
+	}
+	if !trace.IsEnabled() {
+		t.Errorf("trace.IsEnabled() = false while tracing")
+	}
+
+	ctx, task := trace.NewTask(context.Background(), "work")
+	done := make(chan struct{})
+	go func() {
+		trace.WithRegion(ctx, "compute", func() {
+			trace.Log(ctx, "result", "42")
+		})
+		close(done)
+	}()
+	<-done
+	task.End()
+	trace.Stop()
+
+	if trace.IsEnabled() {
+		t.Errorf("trace.IsEnabled() = true after Stop")
+	}
+
+	var got struct {
+		TraceEvents []struct {
+			Name string                 `json:"name"`
+			Ph   string                 `json:"ph"`
+			Tid  int                    `json:"tid"`
+			Args map[string]interface{} `json:"args"`
+		} `json:"traceEvents"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Failed to parse trace %q: %v", buf.String(), err)
+	}
+
+	seen := map[string]bool{}
+	var worker int
+	for _, e := range got.TraceEvents {
+		seen[e.Ph+" "+e.Name] = true
+		if e.Name == "GoCreate" {
+			worker = int(e.Args["goroutine"].(float64))
+		}
+	}
+	for _, want := range []string{"M thread_name", "i GoCreate", "X running", "X blocked", "b work", "e work", "B compute", "E compute", "i result"} {
+		if !seen[want] {
+			t.Errorf("Trace has no %q event", want)
+		}
+	}
+	for _, e := range got.TraceEvents {
+		if e.Name == "compute" && e.Tid != worker {
+			t.Errorf("Region ran on thread %d, want goroutine %d", e.Tid, worker)
+		}
+		if e.Name == "result" && e.Args["message"] != "42" {
+			t.Errorf("Got log message %v, want 42", e.Args["message"])
+		}
+	}
+}
@@ -19,11 +19,13 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 	"syscall"
+	"text/tabwriter"
 	"text/template"
 	"time"
 	"unicode"
@@ -31,7 +33,11 @@ import (
 
 	gbuild "github.com/gopherjs/gopherjs/build"
 	"github.com/gopherjs/gopherjs/compiler"
+	"github.com/gopherjs/gopherjs/internal/config"
+	"github.com/gopherjs/gopherjs/internal/dap"
+	"github.com/gopherjs/gopherjs/internal/objfile"
 	"github.com/gopherjs/gopherjs/internal/sysutil"
+	"github.com/gopherjs/gopherjs/internal/toolchain"
 	"github.com/kisielk/gotool"
 	"github.com/neelance/sourcemap"
 	"github.com/spf13/cobra"
@@ -78,6 +84,24 @@ func main() {
 	compilerFlags.BoolVar(&options.Color, "color", terminal.IsTerminal(int(os.Stderr.Fd())) && os.Getenv("TERM") != "dumb", "colored output")
 	compilerFlags.StringVar(&tags, "tags", "", "a list of build tags to consider satisfied during the build")
 	compilerFlags.BoolVar(&options.MapToLocalDisk, "localmap", false, "use local paths for sourcemap")
+	compilerFlags.StringVar(&options.Sandbox, "sandbox", "", "restrict capabilities available to the program, e.g. fs:none,net:fetch-only")
+	compilerFlags.BoolVar(&options.CSP, "csp", false, "generate code that doesn't use eval, and write a Subresource Integrity hash of the output")
+	compilerFlags.StringVar(&options.ExportNamespace, "export-namespace", "", "dot-separated path of the global object property that //gopherjs:export functions are set on")
+	compilerFlags.BoolVar(&options.InitReport, "init-report", false, "instrument generated code to print time spent initializing each package at startup")
+	compilerFlags.BoolVar(&options.DevTools, "devtools", false, "register a Chrome DevTools custom formatter that shows Go values in Go syntax")
+	compilerFlags.BoolVar(&options.HeapNames, "heap-names", false, "name constructors of Go values after their types, so heap snapshots group memory by Go type")
+	compilerFlags.IntVar(&options.TargetES, "target-es", compiler.ES5, "version of ECMAScript the generated code may use: 5, or 2015 and later for class syntax, let, arrow functions and template literals")
+	compilerFlags.BoolVar(&options.Readable, "readable", false, "generate code for review: annotate it with the positions of the Go code, and name anonymous types after their types")
+	compilerFlags.BoolVar(&options.LTO, "lto", false, "optimize across package boundaries: inline small functions of imported packages and eliminate unused exported methods")
+	compilerFlags.BoolVar(&options.PreciseMath, "precise-math", false, "use pure Go implementations of math functions that JavaScript engines only approximate, like math.Sin")
+	compilerFlags.StringArrayVar(&options.Env, "embed-env", nil, "set an environment variable of the program at build time, as KEY=VALUE; may be repeated")
+	compilerFlags.StringVar(&options.EnvSources, "env-sources", strings.Join(compiler.DefaultEnvSources, ","), "comma-separated sources of environment variables of the program, later ones taking precedence: build, process, global, query, localstorage")
+	compilerFlags.StringVar(&options.StdlibBundle, "stdlib-bundle", os.Getenv("GOPHERJS_STDLIB_BUNDLE"), "file or URL of a bundle of precompiled standard library archives to seed the build cache from, see gopherjs stdlib")
+	compilerFlags.StringArrayVar(&options.SizeBudgets, "size-budget", nil, "warn if the gzipped size of a package of the output, or of the whole output with \"all\", exceeds a budget, as PACKAGE=SIZE like all=300KB; may be repeated")
+	compilerFlags.BoolVar(&options.SizeBudgetsFatal, "size-budget-error", false, "fail the build instead of warning if a size budget is exceeded")
+	compilerFlags.BoolVar(&options.PrintBlocking, "print-blocking", false, "print the blocking functions of the program's packages outside of the standard library, with the calls that make them blocking")
+	compilerFlags.StringVar(&options.BlockingFilter, "blocking-filter", "", "with --print-blocking, print the blocking functions whose full names match this regular expression instead")
+	compilerFlags.BoolVar(&options.StrictUnsupported, "strict-unsupported", false, "fail the build if a non-standard package uses standard library functionality that is unavailable with GopherJS")
 
 	flagWatch := pflag.NewFlagSet("", 0)
 	flagWatch.BoolVarP(&options.Watch, "watch", "w", false, "watch for changes to the source files")
@@ -87,6 +111,10 @@ func main() {
 		Short: "compile packages and dependencies",
 	}
 	cmdBuild.Flags().StringVarP(&pkgObj, "output", "o", "", "output file")
+	cmdBuild.Flags().StringVar(&options.BuildMode, "buildmode", gbuild.BuildModeDefault, "kind of output to build: default, or js-lib for a library of //gopherjs:export functions")
+	cmdBuild.Flags().StringVar(&options.Format, "format", compiler.FormatIIFE, "module format of the output: iife, umd, systemjs or esm")
+	cmdBuild.Flags().StringVar(&options.GlobalName, "global-name", "", "global variable set to the exports by the umd format, or module name registered by the systemjs format")
+	cmdBuild.Flags().BoolVar(&options.StartStop, "start-stop", false, "don't run the program when loaded, export start(args) and stop() functions that let the host control it instead")
 	cmdBuild.Flags().AddFlagSet(flagVerbose)
 	cmdBuild.Flags().AddFlagSet(flagQuiet)
 	cmdBuild.Flags().AddFlagSet(compilerFlags)
@@ -127,6 +155,9 @@ func main() {
 				// Expand import path patterns.
 				patternContext := gbuild.NewBuildContext("", options.BuildTags)
 				pkgs := (&gotool.Context{BuildContext: *patternContext}).ImportPaths(args)
+				if options.BuildMode == gbuild.BuildModeJSLib && len(pkgs) != 1 {
+					return fmt.Errorf("-buildmode=%s requires exactly one package", gbuild.BuildModeJSLib)
+				}
 
 				for _, pkgPath := range pkgs {
 					if s.Watcher != nil {
@@ -148,7 +179,11 @@ func main() {
 						if pkgObj == "" {
 							pkgObj = filepath.Base(pkg.Dir) + ".js"
 						}
-						if pkg.IsCommand() && !pkg.UpToDate {
+						if options.BuildMode == gbuild.BuildModeJSLib {
+							if err := s.WriteCommandPackage(archive, pkgObj); err != nil {
+								return err
+							}
+						} else if pkg.IsCommand() && !pkg.UpToDate {
 							if err := s.WriteCommandPackage(archive, pkgObj); err != nil {
 								return err
 							}
@@ -170,6 +205,10 @@ func main() {
 		Use:   "install [packages]",
 		Short: "compile and install packages and dependencies",
 	}
+	var npm bool
+	var npmVersion string
+	cmdInstall.Flags().BoolVar(&npm, "npm", false, "install non-main packages as npm packages in $GOPATH/npm, with a CommonJS and an ES module build of their //gopherjs:export functions")
+	cmdInstall.Flags().StringVar(&npmVersion, "npm-version", "0.0.0", "version of the npm packages installed with --npm")
 	cmdInstall.Flags().AddFlagSet(flagVerbose)
 	cmdInstall.Flags().AddFlagSet(flagQuiet)
 	cmdInstall.Flags().AddFlagSet(compilerFlags)
@@ -192,6 +231,7 @@ func main() {
 					goGet := exec.Command("go", append([]string{"get", "-d", "-tags=js"}, pkgs...)...)
 					goGet.Stdout = os.Stdout
 					goGet.Stderr = os.Stderr
+					goGet.Env = gbuild.GoToolEnv()
 					if err := goGet.Run(); err != nil {
 						return err
 					}
@@ -210,6 +250,12 @@ func main() {
 						return err
 					}
 
+					if npm {
+						if err := s.WriteNPMPackage(archive, pkg, gbuild.NPMDir(options.GOPATH, pkg.ImportPath), npmVersion); err != nil {
+							return err
+						}
+						continue
+					}
 					if pkg.IsCommand() && !pkg.UpToDate {
 						if err := s.WriteCommandPackage(archive, pkg.PkgObj); err != nil {
 							return err
@@ -235,12 +281,62 @@ func main() {
 		goDoc := exec.Command("go", append([]string{"doc"}, args...)...)
 		goDoc.Stdout = os.Stdout
 		goDoc.Stderr = os.Stderr
-		goDoc.Env = append(os.Environ(), "GOARCH=js")
+		goDoc.Env = append(gbuild.GoToolEnv(), "GOARCH=js")
 		err := goDoc.Run()
 		exitCode := handleError(err, options, nil)
 		os.Exit(exitCode)
 	}
 
+	cmdGenerate := &cobra.Command{
+		Use:   "generate [packages]",
+		Short: "generate Go files by processing source with GopherJS build constraints",
+		Long: `Generate runs the //go:generate directives of the Go files of packages, like go
+generate, but selects files with the build constraints of GopherJS builds.
+Commands run in the host environment, and GOPHERJS_VERSION and GOPHERJS_TARGET
+(like linux/js) are set so that generators can detect GopherJS.`,
+	}
+	var generateRun string
+	var generateDryRun, generateTrace bool
+	cmdGenerate.Flags().StringVar(&generateRun, "run", "", "run only directives whose full original source text matches the regular expression")
+	cmdGenerate.Flags().BoolVarP(&generateDryRun, "dry-run", "n", false, "print commands that would be run without running them")
+	cmdGenerate.Flags().BoolVarP(&generateTrace, "trace", "x", false, "print commands as they are run")
+	cmdGenerate.Flags().AddFlagSet(flagVerbose)
+	cmdGenerate.Flags().StringVar(&tags, "tags", "", "a list of build tags to consider satisfied")
+	cmdGenerate.Run = func(cmd *cobra.Command, args []string) {
+		options.BuildTags = strings.Fields(tags)
+		err := func() error {
+			genOpts := gbuild.GenerateOptions{
+				DryRun:  generateDryRun,
+				Trace:   generateTrace,
+				Verbose: options.Verbose,
+				Stdout:  os.Stdout,
+				Stderr:  os.Stderr,
+			}
+			if generateRun != "" {
+				re, err := regexp.Compile(generateRun)
+				if err != nil {
+					return err
+				}
+				genOpts.Run = re
+			}
+			if len(args) == 0 {
+				args = []string{"."}
+			}
+			patternContext := gbuild.NewBuildContext("", options.BuildTags)
+			for _, pkgPath := range (&gotool.Context{BuildContext: *patternContext}).ImportPaths(args) {
+				pkg, err := gbuild.Import(pkgPath, 0, "", options.BuildTags)
+				if err != nil {
+					return err
+				}
+				if err := gbuild.Generate(pkg, genOpts); err != nil {
+					return err
+				}
+			}
+			return nil
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+
 	cmdGet := &cobra.Command{
 		Use:   "get [packages]",
 		Short: "download and install packages and dependencies",
@@ -251,28 +347,68 @@ func main() {
 	cmdGet.Run = cmdInstall.Run
 
 	cmdRun := &cobra.Command{
-		Use:   "run [gofiles...] [arguments...]",
+		Use:   "run [gofiles... | package] [--] [arguments...]",
 		Short: "compile and run Go program",
 	}
+	var runEnv []string
+	cmdRun.Flags().StringArrayVar(&runEnv, "env", nil, "set an environment variable of the program, as KEY=VALUE; may be repeated")
 	cmdRun.Flags().AddFlagSet(flagVerbose)
 	cmdRun.Flags().AddFlagSet(flagQuiet)
 	cmdRun.Flags().AddFlagSet(compilerFlags)
 	cmdRun.Run = func(cmd *cobra.Command, args []string) {
+		options.BuildTags = strings.Fields(tags)
 		err := func() error {
+			for _, kv := range runEnv {
+				if !strings.Contains(kv, "=") {
+					return fmt.Errorf("gopherjs run: invalid --env value %q, want KEY=VALUE", kv)
+				}
+			}
+
+			// Arguments after "--" go to the program, even if they look like
+			// source files or flags.
+			sources, progArgs := args, []string(nil)
+			if dash := cmd.ArgsLenAtDash(); dash != -1 {
+				sources, progArgs = args[:dash], args[dash:]
+			}
 			lastSourceArg := 0
 			for {
-				if lastSourceArg == len(args) || !(strings.HasSuffix(args[lastSourceArg], ".go") || strings.HasSuffix(args[lastSourceArg], ".inc.js")) {
+				if lastSourceArg == len(sources) || !(strings.HasSuffix(sources[lastSourceArg], ".go") || strings.HasSuffix(sources[lastSourceArg], ".inc.js")) {
 					break
 				}
 				lastSourceArg++
 			}
+			if len(sources) == 0 {
+				return fmt.Errorf("gopherjs run: no go files or package listed")
+			}
+			s, err := gbuild.NewSession(options)
+			if err != nil {
+				return err
+			}
+			var pkg *gbuild.PackageData
 			if lastSourceArg == 0 {
-				return fmt.Errorf("gopherjs run: no go files listed")
+				// Handle "gopherjs run package" mode.
+				if fi, statErr := os.Stat(sources[0]); statErr == nil && fi.IsDir() {
+					pkg, err = gbuild.ImportDir(sources[0], 0, s.InstallSuffix(), options.BuildTags)
+				} else {
+					pkg, err = gbuild.Import(sources[0], 0, s.InstallSuffix(), options.BuildTags)
+				}
+				if err != nil {
+					return err
+				}
+				if !pkg.IsCommand() {
+					return fmt.Errorf("gopherjs run: package %s is not a main package", pkg.ImportPath)
+				}
+				lastSourceArg = 1
 			}
+			progArgs = append(append([]string{}, sources[lastSourceArg:]...), progArgs...)
 
-			tempfile, err := ioutil.TempFile(currentDirectory, filepath.Base(args[0])+".")
+			name := filepath.Base(sources[0])
+			if pkg != nil {
+				name = filepath.Base(pkg.Dir)
+			}
+			tempfile, err := ioutil.TempFile(currentDirectory, name+".")
 			if err != nil && strings.HasPrefix(currentDirectory, runtime.GOROOT()) {
-				tempfile, err = ioutil.TempFile("", filepath.Base(args[0])+".")
+				tempfile, err = ioutil.TempFile("", name+".")
 			}
 			if err != nil {
 				return err
@@ -282,14 +418,19 @@ func main() {
 				os.Remove(tempfile.Name())
 				os.Remove(tempfile.Name() + ".map")
 			}()
-			s, err := gbuild.NewSession(options)
-			if err != nil {
-				return err
-			}
-			if err := s.BuildFiles(args[:lastSourceArg], tempfile.Name(), currentDirectory); err != nil {
+			if pkg != nil {
+				pkg.PkgObj = "" // Don't install the command, it's written to tempfile.
+				archive, err := s.BuildPackage(pkg)
+				if err != nil {
+					return err
+				}
+				if err := s.WriteCommandPackage(archive, tempfile.Name()); err != nil {
+					return err
+				}
+			} else if err := s.BuildFiles(sources[:lastSourceArg], tempfile.Name(), currentDirectory); err != nil {
 				return err
 			}
-			if err := runNode(tempfile.Name(), args[lastSourceArg:], "", options.Quiet); err != nil {
+			if err := runNode(tempfile.Name(), progArgs, runEnv, "", options.Quiet); err != nil {
 				return err
 			}
 			return nil
@@ -311,6 +452,11 @@ func main() {
 	verbose := cmdTest.Flags().BoolP("verbose", "v", false, "Log all tests as they are run. Also print all text from Log and Logf calls even if the test succeeds.")
 	compileOnly := cmdTest.Flags().BoolP("compileonly", "c", false, "Compile the test binary to pkg.test.js but do not run it (where pkg is the last element of the package's import path). The file name can be changed with the -o flag.")
 	outputFilename := cmdTest.Flags().StringP("output", "o", "", "Compile the test binary to the named file. The test still runs (unless -c is specified).")
+	parallel := cmdTest.Flags().Int("p", runtime.NumCPU(), "The number of test binaries that can be run in parallel. Output of each package is printed once its tests have finished.")
+	timeout := cmdTest.Flags().Duration("timeout", 10*time.Minute, "If a test binary runs longer than duration d, panic. If d is 0, the timeout is disabled.")
+	benchWarmup := cmdTest.Flags().Int("bench-warmup", 0, "Run n iterations of each benchmark before measuring it, so that measurements start once the JavaScript engine has optimized its code.")
+	benchGC := cmdTest.Flags().Bool("bench-gc", false, "Run Node.js with --expose-gc, so that garbage is collected before each benchmark run rather than while it is measured.")
+	shard := cmdTest.Flags().String("shard", "", "Test only the N-th of M equal parts of the package list, specified as N/M (for example, --shard=2/4), to spread test runs across multiple machines.")
 	cmdTest.Flags().AddFlagSet(compilerFlags)
 	cmdTest.Run = func(cmd *cobra.Command, args []string) {
 		options.BuildTags = strings.Fields(tags)
@@ -326,6 +472,20 @@ func main() {
 				return errors.New("cannot use -o flag with multiple packages")
 			}
 
+			if *shard != "" {
+				index, total, err := parseShard(*shard)
+				if err != nil {
+					return err
+				}
+				var sharded []string
+				for i, pkgPath := range args {
+					if i%total == index-1 {
+						sharded = append(sharded, pkgPath)
+					}
+				}
+				args = sharded
+			}
+
 			pkgs := make([]*gbuild.PackageData, len(args))
 			for i, pkgPath := range args {
 				var err error
@@ -335,10 +495,12 @@ func main() {
 				}
 			}
 
-			var exitErr error
+			runner := newTestRunner(*parallel, len(pkgs), options.Quiet)
 			for _, pkg := range pkgs {
 				if len(pkg.TestGoFiles) == 0 && len(pkg.XTestGoFiles) == 0 {
-					fmt.Printf("?   \t%s\t[no test files]\n", pkg.ImportPath)
+					if err := runner.add(&testRun{importPath: pkg.ImportPath}); err != nil {
+						return err
+					}
 					continue
 				}
 				s, err := gbuild.NewSession(options)
@@ -409,6 +571,7 @@ func main() {
 						}
 						return s.BuildImportPath(path)
 					},
+					CSP: options.CSP,
 				}
 				mainPkgArchive, err := compiler.Compile("main", []*ast.File{mainFile}, fset, importContext, options.Minify)
 				if err != nil {
@@ -454,6 +617,9 @@ func main() {
 				if *benchtime != "" {
 					args = append(args, "-test.benchtime", *benchtime)
 				}
+				if *benchWarmup > 0 {
+					args = append(args, "-test.benchwarmup", strconv.Itoa(*benchWarmup))
+				}
 				if *count != "" {
 					args = append(args, "-test.count", *count)
 				}
@@ -466,18 +632,21 @@ func main() {
 				if *verbose {
 					args = append(args, "-test.v")
 				}
-				status := "ok  "
-				start := time.Now()
-				if err := runNode(outfile.Name(), args, runTestDir(pkg), options.Quiet); err != nil {
-					if _, ok := err.(*exec.ExitError); !ok {
-						return err
-					}
-					exitErr = err
-					status = "FAIL"
+				tr := &testRun{importPath: pkg.ImportPath, script: outfile.Name(), args: args, dir: runTestDir(pkg)}
+				if *benchGC {
+					tr.nodeArgs = []string{"--expose-gc"}
+				}
+				if *timeout > 0 {
+					tr.args = append(tr.args, "-test.timeout", timeout.String())
+					// Like go test, kill test binaries that don't exit on their own
+					// within a minute after their timeout.
+					tr.killAfter = *timeout + time.Minute
+				}
+				if err := runner.add(tr); err != nil {
+					return err
 				}
-				fmt.Printf("%s\t%s\t%.3fs\n", status, pkg.ImportPath, time.Since(start).Seconds())
 			}
-			return exitErr
+			return runner.wait()
 		}()
 		exitCode := handleError(err, options, nil)
 
@@ -534,6 +703,320 @@ func main() {
 		fmt.Fprintln(os.Stderr, http.Serve(tcpKeepAliveListener{ln.(*net.TCPListener)}, sourceFiles))
 	}
 
+	cmdDebug := &cobra.Command{
+		Use:   "debug [package]",
+		Short: "debug Go program through the Debug Adapter Protocol",
+		Long: `Debug serves the Debug Adapter Protocol (DAP) on standard input and output, or on
+the address given with --listen, for editors like VS Code to debug Go programs
+compiled with GopherJS.
+
+Launch requests build the main package named by their "program" argument, or
+the package given on the command line, and run it under the Node.js inspector.
+Attach requests connect to a program that is already running, at the "address"
+(host:port) of Node.js started with --inspect, or Chrome started with
+--remote-debugging-port. Breakpoints, stack traces and variables are mapped
+through source maps to the Go source.`,
+	}
+	cmdDebug.Flags().AddFlagSet(flagQuiet)
+	cmdDebug.Flags().AddFlagSet(compilerFlags)
+	var listen string
+	cmdDebug.Flags().StringVar(&listen, "listen", "", "serve the Debug Adapter Protocol on this address instead of standard input and output")
+	cmdDebug.Run = func(cmd *cobra.Command, args []string) {
+		if len(args) > 1 {
+			cmdDebug.HelpFunc()(cmd, args)
+			os.Exit(1)
+		}
+		cfg := dap.Config{
+			Build:      debugBuild(options, tags),
+			SourceDirs: debugSourceDirs(),
+		}
+		if len(args) == 1 {
+			cfg.Program = args[0]
+		}
+
+		if listen == "" {
+			// Standard output carries the protocol, the output of the program is
+			// sent to the client in output events.
+			if err := dap.Serve(struct {
+				io.Reader
+				io.Writer
+			}{os.Stdin, os.Stdout}, cfg); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+		ln, err := net.Listen("tcp", listen)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stderr, "serving the Debug Adapter Protocol at %s\n", ln.Addr())
+		conn, err := ln.Accept()
+		ln.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer conn.Close()
+		if err := dap.Serve(conn, cfg); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	cmdSupports := &cobra.Command{
+		Use:   "supports [package]",
+		Short: "report which exported symbols of a package work with GopherJS",
+		Long: `Supports builds a package and lists its exported symbols by how well they work
+when compiled by GopherJS: unavailable symbols always fail at run time, stubbed
+ones do nothing or less than with the gc compiler, and supported ones work like
+with gc. Symbols that GopherJS implements itself, instead of using the original
+source, are marked as such. Use it to vet dependencies before adopting them, and
+--strict-unsupported to reject uses of unavailable symbols at build time.`,
+	}
+	cmdSupports.Flags().AddFlagSet(compilerFlags)
+	cmdSupports.Run = func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			cmdSupports.HelpFunc()(cmd, args)
+			os.Exit(1)
+		}
+		options.BuildTags = strings.Fields(tags)
+		err := func() error {
+			s, err := gbuild.NewSession(options)
+			if err != nil {
+				return err
+			}
+			symbols, err := s.PackageSupport(args[0])
+			if err != nil {
+				return err
+			}
+			counts := map[compiler.SupportLevel]int{}
+			for _, sym := range symbols {
+				counts[sym.Level]++
+			}
+			fmt.Printf("package %s: %d unavailable, %d stubbed, %d supported\n", args[0], counts[compiler.Unavailable], counts[compiler.Stubbed], counts[compiler.Supported])
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+			for _, level := range []compiler.SupportLevel{compiler.Unavailable, compiler.Stubbed, compiler.Supported} {
+				for _, sym := range symbols {
+					if sym.Level != level {
+						continue
+					}
+					note := sym.Reason
+					if sym.Overlaid {
+						note = strings.TrimPrefix(note+"; implemented by GopherJS", "; ")
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\n", level, sym.Name, note)
+				}
+			}
+			return w.Flush()
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+
+	cmdStdlib := &cobra.Command{
+		Use:   "stdlib [packages]",
+		Short: "write a bundle of precompiled standard library archives",
+		Long: `Stdlib builds the standard library packages (all of them by default) and writes
+a bundle of their archives, named after the GopherJS release and the install
+suffix unless -o is given. Builds with the same GopherJS release, Go
+distribution and options that change the generated code, like --minify, can
+seed their build cache from the bundle with --stdlib-bundle or the
+GOPHERJS_STDLIB_BUNDLE environment variable, instead of compiling the standard
+library on fresh machines.`,
+	}
+	var stdlibOutput string
+	cmdStdlib.Flags().StringVarP(&stdlibOutput, "output", "o", "", "output file")
+	cmdStdlib.Flags().AddFlagSet(flagVerbose)
+	cmdStdlib.Flags().AddFlagSet(compilerFlags)
+	cmdStdlib.Run = func(cmd *cobra.Command, args []string) {
+		options.BuildTags = strings.Fields(tags)
+		err := func() error {
+			s, err := gbuild.NewSession(options)
+			if err != nil {
+				return err
+			}
+			if len(args) == 0 {
+				args = []string{"std"}
+			}
+			patternContext := gbuild.NewBuildContext("", options.BuildTags)
+			pkgs := (&gotool.Context{BuildContext: *patternContext}).ImportPaths(args)
+			if stdlibOutput == "" {
+				stdlibOutput = gbuild.StdlibBundleName(s.InstallSuffix())
+			}
+			f, err := os.Create(stdlibOutput)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if err := s.WriteStdlibBundle(f, pkgs); err != nil {
+				return err
+			}
+			return f.Close()
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+
+	cmdClean := &cobra.Command{
+		Use:   "clean",
+		Short: "remove installed package archives",
+		Long: `Clean removes the package archives installed by GopherJS in the pkg directories of
+GOROOT and the GOPATH workspaces, so that packages are rebuilt from source,
+e.g. when archives were built by another version of GopherJS.`,
+	}
+	cmdClean.Flags().AddFlagSet(flagVerbose)
+	cmdClean.Run = func(cmd *cobra.Command, args []string) {
+		if len(args) > 0 {
+			cmdClean.HelpFunc()(cmd, args)
+			os.Exit(1)
+		}
+		err := func() error {
+			for _, root := range append([]string{gbuild.DefaultGOROOT}, filepath.SplitList(build.Default.GOPATH)...) {
+				// Archives are installed in directories like linux_js_min, or
+				// linux_amd64_js for the syscall package.
+				var dirs []string
+				for _, pattern := range []string{"*_js", "*_js_*"} {
+					matches, err := filepath.Glob(filepath.Join(root, "pkg", pattern))
+					if err != nil {
+						return err
+					}
+					dirs = append(dirs, matches...)
+				}
+				for _, dir := range dirs {
+					if options.Verbose {
+						fmt.Println("rm -r", dir)
+					}
+					if err := os.RemoveAll(dir); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+
+	cmdWhy := &cobra.Command{
+		Use:   "why package [main package]",
+		Short: "explain why a package is part of a program",
+		Long: `Why prints the shortest chain of imports through which the program of the main
+package (the one in the current directory by default) depends on package, with
+the symbols of each package that refer to the next one. With --graph=dot, it
+writes the graph of all imports through which the program depends on package
+in the DOT language of Graphviz instead.`,
+	}
+	var whyGraph string
+	cmdWhy.Flags().StringVar(&whyGraph, "graph", "", "write the import graph in the given format instead: dot")
+	cmdWhy.Flags().AddFlagSet(compilerFlags)
+	cmdWhy.Run = func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 || len(args) > 2 {
+			cmdWhy.HelpFunc()(cmd, args)
+			os.Exit(1)
+		}
+		options.BuildTags = strings.Fields(tags)
+		err := func() error {
+			if whyGraph != "" && whyGraph != "dot" {
+				return fmt.Errorf("unsupported graph format %q, want dot", whyGraph)
+			}
+			s, err := gbuild.NewSession(options)
+			if err != nil {
+				return err
+			}
+			mainPath := "."
+			if len(args) == 2 {
+				mainPath = args[1]
+			}
+			pkg, err := gbuild.Import(mainPath, 0, s.InstallSuffix(), options.BuildTags)
+			if err != nil {
+				return err
+			}
+			pkg.PkgObj = "" // Always compile the main package, to get its archive.
+			archive, err := s.BuildPackage(pkg)
+			if err != nil {
+				return err
+			}
+			deps, err := compiler.ImportDependencies(archive, s.BuildImportPath)
+			if err != nil {
+				return err
+			}
+			if whyGraph == "dot" {
+				return gbuild.WriteImportGraph(os.Stdout, deps, archive.ImportPath, args[0])
+			}
+			chain, err := gbuild.ImportChain(deps, archive.ImportPath, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("# %s\n", args[0])
+			for _, link := range chain {
+				fmt.Println(link.ImportPath)
+				if len(link.Uses) != 0 {
+					fmt.Printf("\tused by %s\n", strings.Join(link.Uses, ", "))
+				}
+			}
+			return nil
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+
+	cmdTool := &cobra.Command{
+		Use:   "tool",
+		Short: "run tools that inspect package archives",
+	}
+	cmdNm := &cobra.Command{
+		Use:   "nm file.a",
+		Short: "list the symbols of a package archive",
+		Long: `Nm lists the symbols of a package archive installed by GopherJS, one per line,
+with the size of their generated code in bytes, their kind and name, and whether
+they are blocking. Kinds are F for functions and methods, T for types, V for
+variables, I for initialization code and P for imported packages; they are
+lower case for unexported symbols.`,
+	}
+	var nmSortSize bool
+	cmdNm.Flags().BoolVar(&nmSortSize, "size-sort", false, "sort symbols by decreasing size instead of by name")
+	cmdNm.Run = func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			cmdNm.HelpFunc()(cmd, args)
+			os.Exit(1)
+		}
+		err := func() error {
+			archive, err := objfile.Open(args[0])
+			if err != nil {
+				return err
+			}
+			return objfile.Nm(os.Stdout, archive, nmSortSize)
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+	cmdObjdump := &cobra.Command{
+		Use:   "objdump file.a",
+		Short: "print the metadata and generated code of a package archive",
+	}
+	var objdumpSymbols string
+	cmdObjdump.Flags().StringVarP(&objdumpSymbols, "symbols", "s", "", "only print symbols whose names match the regular expression")
+	cmdObjdump.Run = func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			cmdObjdump.HelpFunc()(cmd, args)
+			os.Exit(1)
+		}
+		err := func() error {
+			var filter *regexp.Regexp
+			if objdumpSymbols != "" {
+				var err error
+				if filter, err = regexp.Compile(objdumpSymbols); err != nil {
+					return err
+				}
+			}
+			archive, err := objfile.Open(args[0])
+			if err != nil {
+				return err
+			}
+			return objfile.Objdump(os.Stdout, archive, filter)
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+	cmdTool.AddCommand(cmdNm, cmdObjdump)
+
 	cmdVersion := &cobra.Command{
 		Use:   "version",
 		Short: "print GopherJS compiler version",
@@ -551,13 +1034,108 @@ func main() {
 		Use:  "gopherjs",
 		Long: "GopherJS is a tool for compiling Go source code to JavaScript.",
 	}
-	rootCmd.AddCommand(cmdBuild, cmdGet, cmdInstall, cmdRun, cmdTest, cmdServe, cmdVersion, cmdDoc)
+	var goos, goarch string
+	rootCmd.PersistentFlags().StringVar(&goos, "goos", "", "GOOS to build packages for instead of the platform gopherjs runs on: linux or darwin")
+	rootCmd.PersistentFlags().StringVar(&goarch, "goarch", "", "GOARCH to build the syscall package with instead of the one gopherjs runs on")
+	var configFile string
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "configuration file to use instead of the gopherjs.toml or gopherjs.json found in the current directory or its parents up to the module root, or none")
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		if cmd == cmdVersion {
+			return
+		}
+		if err := applyConfig(rootCmd, cmd, configFile); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := gbuild.SetTarget(goos, goarch); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := selectToolchain(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	rootCmd.AddCommand(cmdBuild, cmdGenerate, cmdGet, cmdInstall, cmdRun, cmdTest, cmdServe, cmdDebug, cmdSupports, cmdWhy, cmdStdlib, cmdClean, cmdTool, cmdVersion, cmdDoc)
 	err := rootCmd.Execute()
 	if err != nil {
 		os.Exit(2)
 	}
 }
 
+// applyConfig sets the flags of cmd that weren't set on the command line to
+// the settings of the configuration file file, or of the one found from the
+// current directory if file is empty, see package config.
+func applyConfig(rootCmd, cmd *cobra.Command, file string) error {
+	switch file {
+	case "none":
+		return nil
+	case "":
+		wd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		if file, err = config.Find(wd); err != nil || file == "" {
+			return err
+		}
+	}
+	c, err := config.Load(file)
+	if err != nil {
+		return err
+	}
+
+	commands := map[string]*cobra.Command{}
+	var collect func(*cobra.Command)
+	collect = func(c *cobra.Command) {
+		for _, sub := range c.Commands() {
+			commands[sub.Name()] = sub
+			collect(sub)
+		}
+	}
+	collect(rootCmd)
+	for section := range c.Sections {
+		if section != "" && commands[section] == nil {
+			return fmt.Errorf("%s: section [%s] is not a gopherjs command", file, section)
+		}
+	}
+	anyFlag := func(name string) bool {
+		if rootCmd.PersistentFlags().Lookup(name) != nil {
+			return true
+		}
+		for _, c := range commands {
+			if c.Flags().Lookup(name) != nil {
+				return true
+			}
+		}
+		return false
+	}
+	return c.Apply(cmd.Name(), cmd.Flags(), anyFlag)
+}
+
+// selectToolchain selects the Go distribution that packages are built against,
+// following the go.mod file of the current module and GOPHERJS_TOOLCHAIN, and
+// downloads it if needed.
+func selectToolchain() error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	mod, err := toolchain.FindMod(wd)
+	if err != nil {
+		return err
+	}
+	goroot, err := toolchain.Select(os.Getenv(toolchain.EnvVar), gbuild.DefaultGOROOT, mod, os.Stderr)
+	if err != nil {
+		return err
+	}
+	if goroot != gbuild.DefaultGOROOT {
+		gbuild.SetDefaultGOROOT(goroot)
+		// Also seen by runtime.GOROOT() of programs run by gopherjs.
+		os.Setenv("GOPHERJS_GOROOT", goroot)
+	}
+	return nil
+}
+
 // tcpKeepAliveListener sets TCP keep-alive timeouts on accepted
 // connections. It's used by ListenAndServe and ListenAndServeTLS so
 // dead TCP connections (e.g. closing laptop mid-download) eventually
@@ -626,7 +1204,7 @@ func (fs serveCommandFileSystem) Open(requestName string) (http.File, error) {
 				if err != nil {
 					return err
 				}
-				if err := compiler.WriteProgramCode(deps, sourceMapFilter); err != nil {
+				if err := compiler.WriteProgramCode(deps, sourceMapFilter, fs.options.LinkOptions()); err != nil {
 					return err
 				}
 
@@ -767,9 +1345,84 @@ func sprintError(err error) string {
 	}
 }
 
+// debugBuild returns the function gopherjs debug builds programs with: the
+// main package program, an import path, directory or .go file, is written to
+// output.
+func debugBuild(options *gbuild.Options, tags string) func(program, output string) error {
+	return func(program, output string) error {
+		options.BuildTags = strings.Fields(tags)
+		// Breakpoints are set by the absolute paths of Go source files.
+		options.MapToLocalDisk = true
+		s, err := gbuild.NewSession(options)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(program, ".go") {
+			return s.BuildFiles([]string{program}, output, currentDirectory)
+		}
+
+		var pkg *gbuild.PackageData
+		if fi, statErr := os.Stat(program); statErr == nil && fi.IsDir() {
+			pkg, err = gbuild.ImportDir(program, 0, s.InstallSuffix(), options.BuildTags)
+		} else {
+			pkg, err = gbuild.Import(program, 0, s.InstallSuffix(), options.BuildTags)
+		}
+		if err != nil {
+			return err
+		}
+		if !pkg.IsCommand() {
+			return fmt.Errorf("%s is not a main package", program)
+		}
+		archive, err := s.BuildPackage(pkg)
+		if err != nil {
+			return err
+		}
+		return s.WriteCommandPackage(archive, output)
+	}
+}
+
+// debugSourceDirs returns the directories Go source files are looked up in, if
+// source maps have paths relative to GOROOT or GOPATH.
+func debugSourceDirs() []string {
+	var dirs []string
+	for _, dir := range append([]string{gbuild.DefaultGOROOT}, filepath.SplitList(build.Default.GOPATH)...) {
+		dirs = append(dirs, filepath.Join(dir, "src"))
+	}
+	return dirs
+}
+
 // runNode runs script with args using Node.js in directory dir.
 // If dir is empty string, current directory is used.
-func runNode(script string, args []string, dir string, quiet bool) error {
+func runNode(script string, args []string, env []string, dir string, quiet bool) error {
+	node, err := nodeCommand(nil, script, args, dir, quiet)
+	if err != nil {
+		return err
+	}
+	if len(env) != 0 {
+		if node.Env == nil {
+			node.Env = os.Environ()
+		}
+		node.Env = append(node.Env, env...)
+	}
+	node.Stdin = os.Stdin
+	node.Stdout = os.Stdout
+	node.Stderr = os.Stderr
+	return runNodeCommand(node)
+}
+
+// runNodeCommand runs a command created by nodeCommand.
+func runNodeCommand(node *exec.Cmd) error {
+	err := node.Run()
+	if _, ok := err.(*exec.ExitError); err != nil && !ok {
+		err = fmt.Errorf("could not run Node.js: %s", err.Error())
+	}
+	return err
+}
+
+// nodeCommand returns the command that runs script with args using Node.js,
+// with the options nodeArgs, in directory dir, without standard streams
+// attached.
+func nodeCommand(nodeArgs []string, script string, args []string, dir string, quiet bool) (*exec.Cmd, error) {
 	var allArgs []string
 	if b, _ := strconv.ParseBool(os.Getenv("SOURCE_MAP_SUPPORT")); os.Getenv("SOURCE_MAP_SUPPORT") == "" || b {
 		allArgs = []string{"--require", "source-map-support/register"}
@@ -799,24 +1452,25 @@ func runNode(script string, args []string, dir string, quiet bool) error {
 		//
 		cur, err := sysutil.RlimitStack()
 		if err != nil {
-			return fmt.Errorf("failed to get stack size limit: %v", err)
+			return nil, fmt.Errorf("failed to get stack size limit: %v", err)
 		}
 		allArgs = append(allArgs, fmt.Sprintf("--stack_size=%v", cur/1000)) // Convert from bytes to KB.
 	}
 
+	allArgs = append(allArgs, nodeArgs...)
 	allArgs = append(allArgs, script)
 	allArgs = append(allArgs, args...)
 
 	node := exec.Command("node", allArgs...)
 	node.Dir = dir
-	node.Stdin = os.Stdin
-	node.Stdout = os.Stdout
-	node.Stderr = os.Stderr
-	err := node.Run()
-	if _, ok := err.(*exec.ExitError); err != nil && !ok {
-		err = fmt.Errorf("could not run Node.js: %s", err.Error())
+	if crashDir := os.Getenv("GOPHERJS_CRASH_DIR"); crashDir != "" && !filepath.IsAbs(crashDir) {
+		// Tests run in their package directory, but crash reports should end up
+		// in the same place for all packages.
+		if abs, err := filepath.Abs(crashDir); err == nil {
+			node.Env = append(os.Environ(), "GOPHERJS_CRASH_DIR="+abs)
+		}
 	}
-	return err
+	return node, nil
 }
 
 // runTestDir returns the directory for Node.js to use when running tests for package p.
@@ -830,6 +1484,160 @@ func runTestDir(p *gbuild.PackageData) string {
 	return p.Dir
 }
 
+// parseShard parses a --shard flag value of the form N/M, where 1 <= N <= M.
+func parseShard(spec string) (index, total int, err error) {
+	parts := strings.SplitN(spec, "/", 2)
+	if len(parts) == 2 {
+		index, err = strconv.Atoi(parts[0])
+		if err == nil {
+			total, err = strconv.Atoi(parts[1])
+		}
+		if err == nil && index >= 1 && index <= total {
+			return index, total, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("invalid shard %q, want N/M with 1 <= N <= M", spec)
+}
+
+// testRun is a run of the compiled tests of a package.
+type testRun struct {
+	importPath string
+	script     string // Empty if the package has no test files.
+	args       []string
+	nodeArgs   []string // Options of Node.js itself.
+	dir        string
+	killAfter  time.Duration // Zero if the run may take any time.
+
+	output   bytes.Buffer // Combined standard output and error, unless streamed.
+	err      error
+	duration time.Duration
+	done     chan struct{}
+}
+
+// execute runs the tests with Node.js. If stream is true, the output is
+// written to the standard streams directly rather than buffered.
+func (t *testRun) execute(quiet, stream bool) {
+	defer close(t.done)
+	if t.script == "" {
+		return
+	}
+	start := time.Now()
+	node, err := nodeCommand(t.nodeArgs, t.script, t.args, t.dir, quiet)
+	if err != nil {
+		t.err = err
+		return
+	}
+	if stream {
+		node.Stdin = os.Stdin
+		node.Stdout = os.Stdout
+		node.Stderr = os.Stderr
+	} else {
+		node.Stdout = &t.output
+		node.Stderr = &t.output
+	}
+	if t.killAfter == 0 {
+		t.err = runNodeCommand(node)
+		t.duration = time.Since(start)
+		return
+	}
+	if err := node.Start(); err != nil {
+		t.err = fmt.Errorf("could not run Node.js: %s", err.Error())
+		return
+	}
+	killed := make(chan struct{})
+	timer := time.AfterFunc(t.killAfter, func() {
+		close(killed)
+		node.Process.Kill()
+	})
+	t.err = node.Wait()
+	t.duration = time.Since(start)
+	if !timer.Stop() {
+		<-killed
+		fmt.Fprintf(node.Stderr, "*** Test killed: ran too long (%v).\n", t.killAfter)
+	}
+}
+
+// testRunner runs the tests of multiple packages in up to parallel concurrent
+// Node.js processes, and reports the results in the order the packages were
+// added, like go test does. If only one process may run at a time, tests run
+// synchronously with their output streamed as it is produced.
+type testRunner struct {
+	quiet   bool
+	sem     chan struct{}
+	queue   []*testRun // Runs that haven't been reported yet, in order.
+	exitErr error
+}
+
+func newTestRunner(parallel, packages int, quiet bool) *testRunner {
+	if parallel > packages {
+		parallel = packages
+	}
+	if parallel < 1 {
+		parallel = 1
+	}
+	return &testRunner{quiet: quiet, sem: make(chan struct{}, parallel)}
+}
+
+// add starts the run once a process slot is available, and reports results of
+// the runs that have finished so far.
+func (r *testRunner) add(t *testRun) error {
+	t.done = make(chan struct{})
+	r.queue = append(r.queue, t)
+	if cap(r.sem) == 1 {
+		t.execute(r.quiet, true)
+		return r.report(true)
+	}
+	r.sem <- struct{}{}
+	go func() {
+		defer func() { <-r.sem }()
+		t.execute(r.quiet, false)
+	}()
+	return r.report(false)
+}
+
+// wait waits for all runs to finish and reports their results. It returns the
+// error of a failed test run, if any.
+func (r *testRunner) wait() error {
+	if err := r.report(true); err != nil {
+		return err
+	}
+	return r.exitErr
+}
+
+// report prints the results of the finished runs at the head of the queue,
+// waiting for all runs to finish if block is true.
+func (r *testRunner) report(block bool) error {
+	for len(r.queue) != 0 {
+		t := r.queue[0]
+		if block {
+			<-t.done
+		} else {
+			select {
+			case <-t.done:
+			default:
+				return nil
+			}
+		}
+		r.queue = r.queue[1:]
+
+		if t.script == "" {
+			fmt.Printf("?   \t%s\t[no test files]\n", t.importPath)
+			continue
+		}
+		os.Stdout.Write(t.output.Bytes())
+		status := "ok  "
+		if t.err != nil {
+			if _, ok := t.err.(*exec.ExitError); !ok {
+				return t.err
+			}
+			r.exitErr = t.err
+			status = "FAIL"
+		}
+		fmt.Printf("%s\t%s\t%.3fs\n", status, t.importPath, t.duration.Seconds())
+	}
+	return nil
+}
+
 type testFuncs struct {
 	BuildContext *build.Context
 	Tests        []testFunc
@@ -945,6 +1753,7 @@ var testmainTmpl = template.Must(template.New("main").Parse(`
 package main
 
 import (
+	"flag"
 {{if not .TestMain}}
 	"os"
 {{end}}
@@ -967,10 +1776,31 @@ var tests = []testing.InternalTest{
 
 var benchmarks = []testing.InternalBenchmark{
 {{range .Benchmarks}}
-	{"{{.Name}}", {{.Package}}.{{.Name}}},
+	{"{{.Name}}", warmUp({{.Package}}.{{.Name}})},
 {{end}}
 }
 
+var benchWarmup = flag.Int("test.benchwarmup", 0, "run ` + "`n`" + ` iterations of each benchmark before measuring it")
+
+// warmUp runs -test.benchwarmup iterations of the benchmark f before its first
+// measured run, so that the JavaScript engine has optimized its code by then.
+// The first run, with b.N == 1, only finds out whether f has sub-benchmarks,
+// and isn't followed by measured runs if it has, so the warmup comes after it.
+func warmUp(f func(*testing.B)) func(*testing.B) {
+	runs := 0
+	return func(b *testing.B) {
+		runs++
+		if runs == 2 && *benchWarmup > 0 {
+			n := b.N
+			b.N = *benchWarmup
+			f(b)
+			b.N = n
+			b.ResetTimer()
+		}
+		f(b)
+	}
+}
+
 var examples = []testing.InternalExample{
 {{range .Examples}}
 	{"{{.Name}}", {{.Package}}.{{.Name}}, {{.Output | printf "%q"}}, {{.Unordered}}},
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// compilerBinary is a gopherjs binary built from a particular revision.
+type compilerBinary struct {
+	path     string
+	revision string // Commit hash, with a "+dirty" suffix for a modified working tree.
+}
+
+// repoRoot returns the top-level directory of the git repository containing
+// the current directory.
+func repoRoot() (string, error) {
+	out, err := git("", "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// buildCompiler builds gopherjs into dir from the repository in root at the
+// commit rev, or from the working tree if rev is empty.
+func buildCompiler(root, rev, dir string) (*compilerBinary, error) {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	src := root
+	if rev != "" {
+		// Check out the commit into a separate worktree, so that the working
+		// tree doesn't have to be clean.
+		src = filepath.Join(dir, "src")
+		if _, err := git(root, "worktree", "add", "--detach", src, rev); err != nil {
+			return nil, err
+		}
+		defer git(root, "worktree", "remove", "--force", src)
+	}
+
+	revision, err := git(src, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	revision = strings.TrimSpace(revision)
+	if rev == "" {
+		if status, err := git(src, "status", "--porcelain"); err != nil {
+			return nil, err
+		} else if status != "" {
+			revision += "+dirty"
+		}
+	}
+
+	c := &compilerBinary{path: filepath.Join(dir, "gopherjs"), revision: revision}
+	build := exec.Command("go", "build", "-o", c.path, ".")
+	build.Dir = src
+	// Build in module mode, so that packages of the checked out commit are used
+	// rather than the ones in GOPATH.
+	build.Env = append(os.Environ(), "GO111MODULE=on")
+	if out, err := build.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("building gopherjs at %s: %w\n%s", revision, err, out)
+	}
+	return c, nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("git %s: %w\n%s", strings.Join(args, " "), err, exitErr.Stderr)
+		}
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
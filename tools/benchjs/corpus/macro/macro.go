@@ -0,0 +1,30 @@
+// Package macro holds benchmarks of standard library packages commonly used
+// in GopherJS programs, whose performance depends on the generated code as a
+// whole.
+package macro
+
+// Record is a value used to benchmark encoding and formatting.
+type Record struct {
+	ID      int               `json:"id"`
+	Name    string            `json:"name"`
+	Tags    []string          `json:"tags"`
+	Score   float64           `json:"score"`
+	Active  bool              `json:"active"`
+	Details map[string]string `json:"details"`
+}
+
+// NewRecords returns n records with deterministic contents.
+func NewRecords(n int) []Record {
+	records := make([]Record, n)
+	for i := range records {
+		records[i] = Record{
+			ID:      i,
+			Name:    "record",
+			Tags:    []string{"a", "b", "c"},
+			Score:   float64(i) * 1.5,
+			Active:  i%2 == 0,
+			Details: map[string]string{"key": "value"},
+		}
+	}
+	return records
+}
@@ -0,0 +1,97 @@
+package macro
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var sink interface{}
+
+func BenchmarkJSONMarshal(b *testing.B) {
+	records := NewRecords(100)
+	for i := 0; i < b.N; i++ {
+		data, err := json.Marshal(records)
+		if err != nil {
+			b.Fatal(err)
+		}
+		sink = data
+	}
+}
+
+func BenchmarkJSONUnmarshal(b *testing.B) {
+	data, err := json.Marshal(NewRecords(100))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var records []Record
+		if err := json.Unmarshal(data, &records); err != nil {
+			b.Fatal(err)
+		}
+		sink = records
+	}
+}
+
+func BenchmarkSprintf(b *testing.B) {
+	r := NewRecords(1)[0]
+	for i := 0; i < b.N; i++ {
+		sink = fmt.Sprintf("%d %s %v %.2f %t", r.ID, r.Name, r.Tags, r.Score, r.Active)
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	values := make([]int, 1000)
+	for i := 0; i < b.N; i++ {
+		for j := range values {
+			values[j] = (j * 7919) % 1000
+		}
+		sort.Ints(values)
+	}
+	sink = values
+}
+
+func BenchmarkRegexp(b *testing.B) {
+	re := regexp.MustCompile(`(\w+)@(\w+)\.com`)
+	text := strings.Repeat("contact someone@example.com or other@example.org ", 20)
+	for i := 0; i < b.N; i++ {
+		sink = re.FindAllStringSubmatch(text, -1)
+	}
+}
+
+func BenchmarkSHA256(b *testing.B) {
+	data := bytes.Repeat([]byte("gopherjs"), 128)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		sink = sha256.Sum256(data)
+	}
+}
+
+func BenchmarkStrconv(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		s := strconv.FormatFloat(float64(i)*1.25, 'g', -1, 64)
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			b.Fatal(err)
+		}
+		sink = f
+	}
+}
+
+func BenchmarkStringsBuilder(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var sb strings.Builder
+		for j := 0; j < 100; j++ {
+			sb.WriteString("gopher")
+			sb.WriteByte(' ')
+		}
+		sink = sb.String()
+	}
+}
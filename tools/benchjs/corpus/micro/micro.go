@@ -0,0 +1,30 @@
+// Package micro holds benchmarks of individual language features, whose
+// performance depends directly on the code generated for them.
+package micro
+
+// Shape is implemented by types used to benchmark dynamic method calls.
+type Shape interface {
+	Area() float64
+}
+
+type Rect struct{ W, H float64 }
+
+func (r Rect) Area() float64 { return r.W * r.H }
+
+type Square struct{ S float64 }
+
+func (s *Square) Area() float64 { return s.S * s.S }
+
+// Fib computes Fibonacci numbers recursively, to benchmark function calls.
+func Fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return Fib(n-1) + Fib(n-2)
+}
+
+// Deferred returns its argument through a deferred closure.
+func Deferred(x int) (result int) {
+	defer func() { result = x }()
+	return 0
+}
@@ -0,0 +1,107 @@
+package micro
+
+import (
+	"testing"
+)
+
+var sink interface{}
+
+func BenchmarkFuncCall(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink = Fib(15)
+	}
+}
+
+func BenchmarkInterfaceCall(b *testing.B) {
+	shapes := []Shape{Rect{2, 3}, &Square{4}}
+	var total float64
+	for i := 0; i < b.N; i++ {
+		total += shapes[i%len(shapes)].Area()
+	}
+	sink = total
+}
+
+func BenchmarkInt64Arithmetic(b *testing.B) {
+	var x uint64 = 0x123456789
+	for i := 0; i < b.N; i++ {
+		x = x*6364136223846793005 + 1442695040888963407
+		x ^= x >> 17
+	}
+	sink = x
+}
+
+func BenchmarkFloatArithmetic(b *testing.B) {
+	x := 1.0
+	for i := 0; i < b.N; i++ {
+		x = x*1.0000001 + 0.5/x
+	}
+	sink = x
+}
+
+func BenchmarkSliceAppend(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var s []int
+		for j := 0; j < 100; j++ {
+			s = append(s, j)
+		}
+		sink = s
+	}
+}
+
+func BenchmarkMapAccess(b *testing.B) {
+	m := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		m[i] = i
+	}
+	total := 0
+	for i := 0; i < b.N; i++ {
+		total += m[i%1000]
+	}
+	sink = total
+}
+
+func BenchmarkStringConcat(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		s := ""
+		for j := 0; j < 10; j++ {
+			s += "x"
+		}
+		sink = s
+	}
+}
+
+func BenchmarkStructCopy(b *testing.B) {
+	type big struct {
+		a, b, c, d int
+		e          [8]byte
+	}
+	src := big{a: 1, e: [8]byte{1, 2, 3}}
+	var dst big
+	for i := 0; i < b.N; i++ {
+		dst = src
+		dst.a = i
+	}
+	sink = dst
+}
+
+func BenchmarkDefer(b *testing.B) {
+	total := 0
+	for i := 0; i < b.N; i++ {
+		total += Deferred(i)
+	}
+	sink = total
+}
+
+func BenchmarkChannelPingPong(b *testing.B) {
+	ping, pong := make(chan int), make(chan int)
+	go func() {
+		for v := range ping {
+			pong <- v
+		}
+	}()
+	for i := 0; i < b.N; i++ {
+		ping <- i
+		<-pong
+	}
+	close(ping)
+}
@@ -0,0 +1,138 @@
+// Command benchjs measures the size and speed of code generated by GopherJS,
+// and compares it against a baseline commit.
+//
+// It compiles a corpus of representative packages (see the corpus directory)
+// into test bundles, runs their benchmarks under Node.js and writes a JSON
+// report with the output size, startup time and time per operation of each
+// benchmark. Usage:
+//
+//  go run ./tools/benchjs -baseline=origin/master -o report.json
+//
+// The compiler is built from the working tree, and from the baseline commit
+// if one is given, in which case the report includes relative deltas. Both
+// compilers build the corpus of the working tree, which must be located in
+// GOPATH, the same as for running gopherjs itself. With -threshold, benchjs
+// exits with a non-zero status if any metric regressed by more than the given
+// fraction.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// corpusPrefix is the import path of the directory holding the corpus.
+const corpusPrefix = "github.com/gopherjs/gopherjs/tools/benchjs/corpus/"
+
+var (
+	baseline  = flag.String("baseline", "", "commit to compare against; if empty, only the working tree is measured")
+	corpus    = flag.String("corpus", "micro,macro", "comma-separated list of corpus packages to measure")
+	count     = flag.Int("count", 5, "number of times to run each benchmark; the median is reported")
+	benchtime = flag.String("benchtime", "1s", "value of -test.benchtime for benchmark runs")
+	minify    = flag.Bool("minify", true, "minify generated code")
+	threshold = flag.Float64("threshold", 0, "exit with a non-zero status if any metric regressed by more than this fraction, e.g. 0.05; disabled if 0")
+	output    = flag.String("o", "", "file to write the report to; defaults to standard output")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("benchjs: ")
+	flag.Parse()
+	if *count < 1 {
+		log.Fatal("-count must be positive")
+	}
+
+	root, err := repoRoot()
+	if err != nil {
+		log.Fatal(err)
+	}
+	workDir, err := ioutil.TempDir("", "benchjs")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(workDir)
+
+	report, err := run(root, workDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	data = append(data, '\n')
+	if *output == "" {
+		os.Stdout.Write(data)
+	} else if err := ioutil.WriteFile(*output, data, 0666); err != nil {
+		log.Fatal(err)
+	}
+
+	if regressions := report.Regressions(*threshold); len(regressions) != 0 {
+		for _, r := range regressions {
+			log.Printf("regression: %s", r)
+		}
+		os.Exit(1)
+	}
+}
+
+// run measures the corpus with the compiler built from the working tree in
+// root and, if requested, the baseline commit, using workDir for temporary
+// files.
+func run(root, workDir string) (*Report, error) {
+	var pkgs []string
+	for _, name := range strings.Split(*corpus, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			pkgs = append(pkgs, corpusPrefix+name)
+		}
+	}
+	opts := measureOptions{count: *count, benchtime: *benchtime, minify: *minify}
+
+	log.Print("building compiler from the working tree")
+	current, err := buildCompiler(root, "", filepath.Join(workDir, "current"))
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Current: current.revision}
+	currentResults, err := measureCorpus(current, pkgs, filepath.Join(workDir, "current"), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if *baseline == "" {
+		report.add(currentResults, nil)
+		return report, nil
+	}
+
+	log.Printf("building compiler at %s", *baseline)
+	base, err := buildCompiler(root, *baseline, filepath.Join(workDir, "baseline"))
+	if err != nil {
+		return nil, err
+	}
+	report.Baseline = base.revision
+	baseResults, err := measureCorpus(base, pkgs, filepath.Join(workDir, "baseline"), opts)
+	if err != nil {
+		return nil, err
+	}
+	report.add(currentResults, baseResults)
+	return report, nil
+}
+
+func measureCorpus(c *compilerBinary, pkgs []string, dir string, opts measureOptions) (map[string]*measurement, error) {
+	results := make(map[string]*measurement)
+	for _, pkg := range pkgs {
+		log.Printf("measuring %s with %s", pkg, c.revision)
+		m, err := measure(c, pkg, dir, opts)
+		if err != nil {
+			return nil, fmt.Errorf("measuring %s with %s: %w", pkg, c.revision, err)
+		}
+		results[pkg] = m
+	}
+	return results, nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"golang.org/x/tools/benchmark/parse"
+)
+
+// measureOptions controls how corpus packages are compiled and run.
+type measureOptions struct {
+	count     int
+	benchtime string
+	minify    bool
+}
+
+// measurement holds the metrics of a corpus package compiled with a
+// particular compiler.
+type measurement struct {
+	size     int64              // Size of the test bundle in bytes.
+	gzipSize int64              // Size of the gzip-compressed test bundle in bytes.
+	startup  float64            // Median time to load the test bundle and run no tests, in milliseconds.
+	nsPerOp  map[string]float64 // Median time per operation of each benchmark, in nanoseconds.
+}
+
+// measure compiles the tests of pkg with the compiler c into dir, and runs its
+// benchmarks.
+func measure(c *compilerBinary, pkg, dir string, opts measureOptions) (*measurement, error) {
+	bundle := filepath.Join(dir, path.Base(pkg)+".test.js")
+	args := []string{"test", "--compileonly", "--output", bundle}
+	if opts.minify {
+		args = append(args, "--minify")
+	}
+	compile := exec.Command(c.path, append(args, pkg)...)
+	if out, err := compile.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("compiling: %w\n%s", err, out)
+	}
+
+	code, err := ioutil.ReadFile(bundle)
+	if err != nil {
+		return nil, err
+	}
+	var compressed bytes.Buffer
+	zw, _ := gzip.NewWriterLevel(&compressed, gzip.BestCompression)
+	zw.Write(code)
+	zw.Close()
+	m := &measurement{
+		size:     int64(len(code)),
+		gzipSize: int64(compressed.Len()),
+		nsPerOp:  make(map[string]float64),
+	}
+
+	var startups []float64
+	for i := 0; i < opts.count; i++ {
+		start := time.Now()
+		if _, err := runBundle(bundle, "-test.run=^$"); err != nil {
+			return nil, err
+		}
+		startups = append(startups, float64(time.Since(start))/float64(time.Millisecond))
+	}
+	m.startup = median(startups)
+
+	out, err := runBundle(bundle, "-test.run=^$", "-test.bench=.", "-test.benchtime="+opts.benchtime, "-test.count="+strconv.Itoa(opts.count))
+	if err != nil {
+		return nil, err
+	}
+	set, err := parse.ParseSet(bytes.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+	for name, runs := range set {
+		var values []float64
+		for _, b := range runs {
+			if b.Measured&parse.NsPerOp != 0 {
+				values = append(values, b.NsPerOp)
+			}
+		}
+		if len(values) != 0 {
+			m.nsPerOp[name] = median(values)
+		}
+	}
+	return m, nil
+}
+
+// runBundle runs a compiled test bundle under Node.js and returns its output.
+func runBundle(bundle string, args ...string) ([]byte, error) {
+	node := exec.Command("node", append([]string{bundle}, args...)...)
+	node.Stderr = os.Stderr
+	out, err := node.Output()
+	if _, ok := err.(*exec.ExitError); ok && bytes.HasSuffix(out, []byte("\nPASS\n")) {
+		// Test bundles that don't run any tests may fail to exit cleanly after
+		// the testing package reported success, which doesn't affect results.
+		err = nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("running %s: %w\n%s", filepath.Base(bundle), err, out)
+	}
+	return out, nil
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
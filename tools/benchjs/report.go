@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Report is the machine-readable output of benchjs.
+type Report struct {
+	// Current is the revision of the working tree.
+	Current string `json:"current"`
+	// Baseline is the revision compared against, if any.
+	Baseline string `json:"baseline,omitempty"`
+	// Packages holds the results of each corpus package, sorted by import path.
+	Packages []*PackageResult `json:"packages"`
+}
+
+// PackageResult holds the metrics of a corpus package.
+type PackageResult struct {
+	Package string `json:"package"`
+	// Size and GzipSize are the sizes of the compiled test bundle in bytes.
+	Size     Metric `json:"size"`
+	GzipSize Metric `json:"gzip_size"`
+	// StartupMillis is the time it takes Node.js to load the test bundle and
+	// initialize all packages.
+	StartupMillis Metric `json:"startup_ms"`
+	// Benchmarks holds the time per operation of each benchmark, sorted by name.
+	Benchmarks []*BenchmarkResult `json:"benchmarks"`
+}
+
+// BenchmarkResult holds the metrics of a benchmark.
+type BenchmarkResult struct {
+	Name    string `json:"name"`
+	NsPerOp Metric `json:"ns_per_op"`
+}
+
+// Metric is a measured value, lower being better. Baseline and Delta are only
+// set if the metric was also measured for the baseline revision.
+type Metric struct {
+	Current  float64  `json:"current"`
+	Baseline *float64 `json:"baseline,omitempty"`
+	// Delta is the relative change of Current compared to Baseline, e.g. 0.1
+	// for a 10% increase.
+	Delta *float64 `json:"delta,omitempty"`
+}
+
+func newMetric(current float64, baseline float64, hasBaseline bool) Metric {
+	m := Metric{Current: current}
+	if hasBaseline {
+		m.Baseline = &baseline
+		if baseline != 0 {
+			delta := (current - baseline) / baseline
+			m.Delta = &delta
+		}
+	}
+	return m
+}
+
+// add adds the results of the current revision to the report, compared to the
+// results of the baseline revision if not nil.
+func (r *Report) add(current, baseline map[string]*measurement) {
+	var pkgs []string
+	for pkg := range current {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	for _, pkg := range pkgs {
+		cur := current[pkg]
+		base, hasBase := baseline[pkg]
+		if !hasBase {
+			base = &measurement{}
+		}
+		result := &PackageResult{
+			Package:       pkg,
+			Size:          newMetric(float64(cur.size), float64(base.size), hasBase),
+			GzipSize:      newMetric(float64(cur.gzipSize), float64(base.gzipSize), hasBase),
+			StartupMillis: newMetric(cur.startup, base.startup, hasBase),
+		}
+		var names []string
+		for name := range cur.nsPerOp {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			baseNs, hasBaseNs := base.nsPerOp[name]
+			result.Benchmarks = append(result.Benchmarks, &BenchmarkResult{
+				Name:    name,
+				NsPerOp: newMetric(cur.nsPerOp[name], baseNs, hasBaseNs),
+			})
+		}
+		r.Packages = append(r.Packages, result)
+	}
+}
+
+// Regressions returns descriptions of the metrics that increased by more than
+// threshold relative to the baseline. It returns nothing if threshold is zero.
+func (r *Report) Regressions(threshold float64) []string {
+	if threshold <= 0 {
+		return nil
+	}
+	var regressions []string
+	check := func(pkg, name string, m Metric) {
+		if m.Delta != nil && *m.Delta > threshold {
+			regressions = append(regressions, fmt.Sprintf("%s %s: %g -> %g (%+.1f%%)", pkg, name, *m.Baseline, m.Current, *m.Delta*100))
+		}
+	}
+	for _, p := range r.Packages {
+		check(p.Package, "size", p.Size)
+		check(p.Package, "gzip_size", p.GzipSize)
+		check(p.Package, "startup_ms", p.StartupMillis)
+		for _, b := range p.Benchmarks {
+			check(p.Package, b.Name, b.NsPerOp)
+		}
+	}
+	return regressions
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+	current := map[string]*measurement{
+		"pkg": {size: 1100, gzipSize: 300, startup: 50, nsPerOp: map[string]float64{"BenchmarkA": 200, "BenchmarkNew": 10}},
+	}
+	baseline := map[string]*measurement{
+		"pkg": {size: 1000, gzipSize: 300, startup: 100, nsPerOp: map[string]float64{"BenchmarkA": 100}},
+	}
+	r := &Report{}
+	r.add(current, baseline)
+
+	if len(r.Packages) != 1 {
+		t.Fatalf("Got %d packages, want 1", len(r.Packages))
+	}
+	p := r.Packages[0]
+	if p.Size.Delta == nil || *p.Size.Delta != 0.1 {
+		t.Errorf("Got size delta %v, want 0.1", p.Size.Delta)
+	}
+	if len(p.Benchmarks) != 2 {
+		t.Fatalf("Got %d benchmarks, want 2", len(p.Benchmarks))
+	}
+	if p.Benchmarks[1].Name != "BenchmarkNew" || p.Benchmarks[1].NsPerOp.Baseline != nil {
+		t.Errorf("Got %+v, want BenchmarkNew without a baseline", p.Benchmarks[1])
+	}
+
+	if got := r.Regressions(0); len(got) != 0 {
+		t.Errorf("Regressions(0) = %q, want none", got)
+	}
+	// Size grew by 10%, BenchmarkA by 100%, startup time improved.
+	if got := r.Regressions(0.05); len(got) != 2 {
+		t.Errorf("Regressions(0.05) = %q, want 2 regressions", got)
+	}
+	if got := r.Regressions(0.5); len(got) != 1 {
+		t.Errorf("Regressions(0.5) = %q, want 1 regression", got)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		values []float64
+		want   float64
+	}{
+		{values: []float64{3}, want: 3},
+		{values: []float64{3, 1, 2}, want: 2},
+		{values: []float64{4, 1, 3, 2}, want: 2.5},
+	}
+	for _, test := range tests {
+		if got := median(test.values); got != test.want {
+			t.Errorf("median(%v) = %v, want %v", test.values, got, test.want)
+		}
+	}
+}
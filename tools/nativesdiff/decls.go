@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler/astutil"
+	"github.com/gopherjs/gopherjs/compiler/natives"
+)
+
+// loadOverlays returns the symbols declared by the natives for Go 1.x release
+// goVersion, by import path.
+func loadOverlays(goVersion int) (map[string][]string, error) {
+	overlays := map[string][]string{}
+	for _, root := range natives.Roots(goVersion) {
+		if err := walkOverlays(path.Join(root, "src"), root, overlays); err != nil {
+			return nil, err
+		}
+	}
+	return overlays, nil
+}
+
+func walkOverlays(dir, root string, overlays map[string][]string) error {
+	f, err := natives.FS.Open(dir)
+	if err != nil {
+		return err
+	}
+	infos, err := f.Readdir(0)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	importPath := strings.TrimPrefix(strings.TrimPrefix(dir, path.Join(root, "src")), "/")
+	_, seen := overlays[importPath]
+	fileSet := token.NewFileSet()
+	var symbols []string
+	for _, info := range infos {
+		name := path.Join(dir, info.Name())
+		if info.IsDir() {
+			if err := walkOverlays(name, root, overlays); err != nil {
+				return err
+			}
+			continue
+		}
+		if seen || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		src, err := natives.FS.Open(name)
+		if err != nil {
+			return err
+		}
+		file, err := parser.ParseFile(fileSet, name, src, 0)
+		src.Close()
+		if err != nil {
+			return err
+		}
+		symbols = append(symbols, declNames(file)...)
+	}
+	// Natives of newer releases take precedence, see natives.Roots.
+	if importPath != "" && !seen && len(symbols) != 0 {
+		overlays[importPath] = symbols
+	}
+	return nil
+}
+
+// declNames returns the names of the package-level declarations of file, named
+// like during augmentation.
+func declNames(file *ast.File) []string {
+	var names []string
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if k := astutil.FuncKey(d); k != "init" {
+				names = append(names, k)
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, s.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range s.Names {
+						if name.Name != "_" {
+							names = append(names, name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// upstreamDecls returns the source code of the package-level declarations of
+// the package importPath in the Go distribution at goroot, of Go 1.x release
+// goVersion, without comments. Files are selected like by GopherJS.
+func upstreamDecls(goroot string, goVersion int, importPath string) (map[string]string, error) {
+	bctx := build.Context{
+		GOROOT:      goroot,
+		GOOS:        build.Default.GOOS,
+		GOARCH:      "js",
+		Compiler:    "gc",
+		BuildTags:   []string{"netgo", "purego", "math_big_pure_go"},
+		ReleaseTags: releaseTags(goVersion),
+	}
+	if importPath == "syscall" {
+		// See importWithSrcDir in github.com/gopherjs/gopherjs/build.
+		bctx.GOARCH = build.Default.GOARCH
+	}
+	dir := filepath.Join(goroot, "src", filepath.FromSlash(importPath))
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return map[string]string{}, nil // The package doesn't exist in this release.
+	}
+	pkg, err := bctx.ImportDir(dir, 0)
+	if _, ok := err.(*build.NoGoError); err != nil && !ok {
+		return nil, err
+	}
+
+	decls := map[string]string{}
+	fileSet := token.NewFileSet()
+	for _, name := range pkg.GoFiles {
+		file, err := parser.ParseFile(fileSet, filepath.Join(pkg.Dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				decls[astutil.FuncKey(d)] += source(fileSet, d)
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						decls[s.Name.Name] += "type " + source(fileSet, s)
+					case *ast.ValueSpec:
+						for _, name := range s.Names {
+							decls[name.Name] += d.Tok.String() + " " + source(fileSet, s)
+						}
+					}
+				}
+			}
+		}
+	}
+	return decls, nil
+}
+
+// source formats node, which was parsed without comments.
+func source(fileSet *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fileSet, node); err != nil {
+		panic(err)
+	}
+	buf.WriteByte('\n')
+	return buf.String()
+}
+
+func releaseTags(goVersion int) []string {
+	tags := make([]string, goVersion)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("go1.%d", i+1)
+	}
+	return tags
+}
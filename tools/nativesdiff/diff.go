@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// change is a difference in the upstream definition of a symbol that the
+// natives declare.
+type change struct {
+	symbol   string
+	kind     string
+	old, new string
+}
+
+// compare returns the changes between the upstream declarations oldDecls and
+// newDecls of the symbols declared by natives, in the order of symbols.
+func compare(symbols []string, oldDecls, newDecls map[string]string) []change {
+	var changes []change
+	for _, symbol := range symbols {
+		old, inOld := oldDecls[symbol]
+		new, inNew := newDecls[symbol]
+		switch {
+		case inOld && inNew && old != new:
+			changes = append(changes, change{symbol: symbol, kind: "changed upstream", old: old, new: new})
+		case inOld && !inNew:
+			changes = append(changes, change{symbol: symbol, kind: "removed upstream", old: old})
+		case !inOld && inNew:
+			// The natives would now silently replace the new upstream symbol.
+			changes = append(changes, change{symbol: symbol, kind: "added upstream", new: new})
+		}
+	}
+	return changes
+}
+
+// lineDiff returns a diff of the lines of a and b, with context unchanged
+// lines around each change. Lines are prefixed with "-" if they are only in a,
+// "+" if they are only in b, and " " otherwise.
+func lineDiff(a, b string, context int) string {
+	x, y := splitLines(a), splitLines(b)
+
+	// lcs[i][j] is the length of the longest common subsequence of x[i:] and
+	// y[j:].
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []string
+	for i, j := 0, 0; i < len(x) || j < len(y); {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			lines = append(lines, " "+x[i])
+			i++
+			j++
+		case j == len(y) || i < len(x) && lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, "-"+x[i])
+			i++
+		default:
+			lines = append(lines, "+"+y[j])
+			j++
+		}
+	}
+
+	// Keep changed lines and their context.
+	keep := make([]bool, len(lines))
+	for i, line := range lines {
+		if line[0] == ' ' {
+			continue
+		}
+		for k := i - context; k <= i+context; k++ {
+			if k >= 0 && k < len(lines) {
+				keep[k] = true
+			}
+		}
+	}
+	var out strings.Builder
+	for i, line := range lines {
+		if !keep[i] {
+			if i > 0 && keep[i-1] {
+				out.WriteString("...\n")
+			}
+			continue
+		}
+		fmt.Fprintln(&out, line)
+	}
+	return out.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestLineDiff(t *testing.T) {
+	a := "func f() {\n\ta()\n\tb()\n\tc()\n\td()\n\te()\n}\n"
+	b := "func f() {\n\ta()\n\tb()\n\tx()\n\td()\n\te()\n}\n"
+	want := " func f() {\n \ta()\n \tb()\n-\tc()\n+\tx()\n \td()\n \te()\n }\n"
+	if got := lineDiff(a, b, 3); got != want {
+		t.Errorf("lineDiff() with context 3 = %q, want %q", got, want)
+	}
+	want = " \tb()\n-\tc()\n+\tx()\n \td()\n...\n"
+	if got := lineDiff(a, b, 1); got != want {
+		t.Errorf("lineDiff() with context 1 = %q, want %q", got, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	oldDecls := map[string]string{"Same": "a", "Changed": "b", "Removed": "c", "NotReplaced": "d"}
+	newDecls := map[string]string{"Same": "a", "Changed": "B", "Added": "e", "NotReplaced": "D"}
+	got := compare([]string{"Same", "Changed", "Removed", "Added", "New"}, oldDecls, newDecls)
+	want := []change{
+		{symbol: "Changed", kind: "changed upstream", old: "b", new: "B"},
+		{symbol: "Removed", kind: "removed upstream", old: "c"},
+		{symbol: "Added", kind: "added upstream", new: "e"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("compare() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("compare()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
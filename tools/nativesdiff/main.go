@@ -0,0 +1,99 @@
+// Command nativesdiff helps to update the natives of GopherJS for a new Go
+// release. For each standard library package augmented by the natives, it
+// shows how the upstream definitions of the symbols the natives replace
+// changed between two Go distributions, and flags the packages whose natives
+// likely need updating. Usage:
+//
+//  go run ./tools/nativesdiff -from /path/to/go1.16 -to /path/to/go1.17 [packages]
+//
+// The -from distribution is the one the natives were written against, and -to
+// the one to update them for. Symbols are matched the same way as during
+// augmentation, by name, with methods qualified by their receiver type, like
+// "Dialer.Dial". Only code is compared, changes to comments are ignored. The
+// natives are taken from the overlay set for the -from release, see
+// natives.Roots. Without arguments, all augmented packages are compared.
+//
+// The exit status is 1 if any package needs updating, which is the case if an
+// upstream definition of a replaced symbol changed, was removed, or was added
+// for a symbol that the natives define on their own.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/gopherjs/gopherjs/compiler"
+)
+
+var (
+	from    = flag.String("from", "", "GOROOT of the Go distribution the natives were written against")
+	to      = flag.String("to", "", "GOROOT of the Go distribution to update the natives for")
+	context = flag.Int("context", 3, "number of unchanged lines to show around changes")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("nativesdiff: ")
+	flag.Parse()
+	if *from == "" || *to == "" {
+		log.Fatal("both -from and -to are required")
+	}
+	fromVersion, err := compiler.GoRootVersion(*from)
+	if err != nil {
+		log.Fatal(err)
+	}
+	toVersion, err := compiler.GoRootVersion(*to)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	overlays, err := loadOverlays(fromVersion)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkgs := flag.Args()
+	if len(pkgs) == 0 {
+		for importPath := range overlays {
+			pkgs = append(pkgs, importPath)
+		}
+		sort.Strings(pkgs)
+	}
+
+	var outdated []string
+	for _, importPath := range pkgs {
+		symbols, ok := overlays[importPath]
+		if !ok {
+			log.Fatalf("package %s has no natives", importPath)
+		}
+		oldDecls, err := upstreamDecls(*from, fromVersion, importPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		newDecls, err := upstreamDecls(*to, toVersion, importPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		changes := compare(symbols, oldDecls, newDecls)
+		if len(changes) == 0 {
+			continue
+		}
+		outdated = append(outdated, importPath)
+		for _, c := range changes {
+			fmt.Printf("--- %s.%s: %s\n", importPath, c.symbol, c.kind)
+			fmt.Print(lineDiff(c.old, c.new, *context))
+		}
+	}
+
+	if len(outdated) == 0 {
+		fmt.Fprintf(os.Stderr, "natives of %d packages are up to date\n", len(pkgs))
+		return
+	}
+	fmt.Fprintf(os.Stderr, "natives of %d of %d packages likely need updating:\n", len(outdated), len(pkgs))
+	for _, importPath := range outdated {
+		fmt.Fprintf(os.Stderr, "\t%s\n", importPath)
+	}
+	os.Exit(1)
+}
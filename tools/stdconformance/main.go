@@ -0,0 +1,94 @@
+// Command stdconformance runs the upstream tests of the standard library
+// packages augmented by the natives under GopherJS, and compares the outcome of
+// each test with a golden manifest. Usage:
+//
+//  go run ./tools/stdconformance [-update] [packages]
+//
+// Tests are run with "gopherjs test -v --short", so gopherjs must be installed
+// and Node.js set up like for running any other tests. Without arguments, all
+// augmented packages are tested, except for those listed in
+// .std_test_pkg_exclusions.
+//
+// The manifest, tests/testdata/std_conformance.txt by default, records whether
+// each test passed, failed or was skipped. The exit status is 1 if a test that
+// the manifest records as passing failed, was skipped or didn't run, which
+// usually means that the natives drifted from the upstream code they augment.
+// Tests that pass now but didn't before are listed too, but aren't an error.
+// With -update, the results of the tested packages are written to the manifest
+// instead.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+)
+
+var (
+	manifestPath = flag.String("manifest", "tests/testdata/std_conformance.txt", "golden manifest of test results")
+	update       = flag.Bool("update", false, "write the results of the tested packages to the manifest instead of comparing them")
+	gopherjs     = flag.String("gopherjs", "gopherjs", "gopherjs binary to run the tests with")
+	exclusions   = flag.String("exclusions", ".std_test_pkg_exclusions", "file listing packages not to test when none are given")
+	timeout      = flag.Duration("timeout", 10*time.Minute, "time limit for testing a single package")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("stdconformance: ")
+	flag.Parse()
+
+	pkgs := flag.Args()
+	if len(pkgs) == 0 {
+		var err error
+		if pkgs, err = augmentedPackages(*exclusions); err != nil {
+			log.Fatal(err)
+		}
+	}
+	golden, err := readManifest(*manifestPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	current := results{}
+	for _, importPath := range pkgs {
+		fmt.Fprintf(os.Stderr, "testing %s\n", importPath)
+		r, err := runTests(*gopherjs, importPath, *timeout)
+		if err != nil {
+			// Tests that didn't run are reported as regressions below.
+			fmt.Fprintf(os.Stderr, "%s: %s\n", importPath, err)
+		}
+		current[importPath] = r
+	}
+
+	if *update {
+		for importPath, r := range current {
+			golden[importPath] = r
+		}
+		if err := writeManifest(*manifestPath, golden); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	regressions, fixes := compare(golden, current)
+	sort.Strings(fixes)
+	for _, f := range fixes {
+		fmt.Printf("fixed: %s\n", f)
+	}
+	if len(fixes) != 0 {
+		fmt.Fprintf(os.Stderr, "%d tests pass now, run with -update to record them\n", len(fixes))
+	}
+	if len(regressions) == 0 {
+		fmt.Fprintf(os.Stderr, "no regressions in %d packages\n", len(pkgs))
+		return
+	}
+	sort.Strings(regressions)
+	for _, r := range regressions {
+		fmt.Printf("regressed: %s\n", r)
+	}
+	fmt.Fprintf(os.Stderr, "%d tests regressed\n", len(regressions))
+	os.Exit(1)
+}
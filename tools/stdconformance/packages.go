@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/compiler"
+	"github.com/gopherjs/gopherjs/compiler/natives"
+)
+
+// augmentedPackages returns the standard library packages that have natives
+// for the latest supported Go release, sorted, except for those listed in the
+// file exclusions.
+func augmentedPackages(exclusions string) ([]string, error) {
+	excluded := map[string]bool{}
+	f, err := os.Open(exclusions)
+	if err != nil {
+		return nil, err
+	}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		excluded[strings.TrimSpace(s.Text())] = true
+	}
+	f.Close()
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, root := range natives.Roots(compiler.GoVersion) {
+		if err := walkNatives(path.Join(root, "src"), path.Join(root, "src"), seen); err != nil {
+			return nil, err
+		}
+	}
+	var pkgs []string
+	for importPath := range seen {
+		isStd := !strings.Contains(strings.Split(importPath, "/")[0], ".")
+		if isStd && !excluded[importPath] {
+			pkgs = append(pkgs, importPath)
+		}
+	}
+	sort.Strings(pkgs)
+	return pkgs, nil
+}
+
+// walkNatives adds the import paths of the directories under dir that contain
+// Go files to pkgs.
+func walkNatives(dir, src string, pkgs map[string]bool) error {
+	f, err := natives.FS.Open(dir)
+	if err != nil {
+		return err
+	}
+	infos, err := f.Readdir(0)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if info.IsDir() {
+			if err := walkNatives(path.Join(dir, info.Name()), src, pkgs); err != nil {
+				return err
+			}
+		} else if strings.HasSuffix(info.Name(), ".go") && dir != src {
+			pkgs[strings.TrimPrefix(dir, src+"/")] = true
+		}
+	}
+	return nil
+}
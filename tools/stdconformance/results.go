@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Outcomes of a test, as recorded in the manifest.
+const (
+	pass = "pass"
+	fail = "fail"
+	skip = "skip"
+)
+
+// results holds the outcome of each test by name, including subtests, by
+// import path.
+type results map[string]map[string]string
+
+// testLine matches the lines of verbose test output that report the outcome
+// of a test or subtest.
+var testLine = regexp.MustCompile(`^\s*--- (PASS|FAIL|SKIP): (\S+)`)
+
+// parseOutput returns the outcome of each test reported in the verbose output
+// of a test binary.
+func parseOutput(r io.Reader) (map[string]string, error) {
+	outcomes := map[string]string{}
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		if m := testLine.FindStringSubmatch(s.Text()); m != nil {
+			outcomes[m[2]] = strings.ToLower(m[1])
+		}
+	}
+	return outcomes, s.Err()
+}
+
+// runTests runs the tests of the package importPath with gopherjs and returns
+// their outcomes. If the tests fail to build or time out, the outcomes of the
+// tests that finished are returned along with an error.
+func runTests(gopherjs, importPath string, timeout time.Duration) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, gopherjs, "test", "-v", "--short", importPath)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	runErr := cmd.Run()
+	outcomes, err := parseOutput(&out)
+	if err != nil {
+		return outcomes, err
+	}
+	if ctx.Err() != nil {
+		return outcomes, fmt.Errorf("timed out after %s", timeout)
+	}
+	if _, ok := runErr.(*exec.ExitError); ok && len(outcomes) != 0 {
+		// Failing tests are expected, and recorded in outcomes.
+		return outcomes, nil
+	}
+	if runErr != nil {
+		return outcomes, fmt.Errorf("%s\n%s", runErr, out.Bytes())
+	}
+	return outcomes, nil
+}
+
+// compare returns the tests that passed in golden, but didn't in current, and
+// those that passed in current, but didn't in golden. Only packages in current
+// are compared.
+func compare(golden, current results) (regressions, fixes []string) {
+	for importPath, outcomes := range current {
+		for name, outcome := range golden[importPath] {
+			if outcome == pass && outcomes[name] != pass {
+				got := outcomes[name]
+				if got == "" {
+					got = "didn't run"
+				}
+				regressions = append(regressions, fmt.Sprintf("%s %s (%s)", importPath, name, got))
+			}
+		}
+		for name, outcome := range outcomes {
+			if outcome == pass && golden[importPath][name] != pass {
+				fixes = append(fixes, importPath+" "+name)
+			}
+		}
+	}
+	return regressions, fixes
+}
+
+// readManifest reads the manifest at path, which has a line with the import
+// path, test name and outcome of each test. Blank lines and lines starting
+// with # are ignored. A missing manifest is empty.
+func readManifest(path string) (results, error) {
+	r := results{}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 3 || (fields[2] != pass && fields[2] != fail && fields[2] != skip) {
+			return nil, fmt.Errorf("%s:%d: want \"<package> <test> pass|fail|skip\", got %q", path, line, text)
+		}
+		if r[fields[0]] == nil {
+			r[fields[0]] = map[string]string{}
+		}
+		r[fields[0]][fields[1]] = fields[2]
+	}
+	return r, s.Err()
+}
+
+// writeManifest writes r to the manifest at path, sorted by import path and
+// test name.
+func writeManifest(path string, r results) error {
+	var buf bytes.Buffer
+	buf.WriteString("# Outcomes of the upstream tests of augmented standard library packages under\n")
+	buf.WriteString("# GopherJS. Generated by go run ./tools/stdconformance -update.\n")
+	var pkgs []string
+	for importPath := range r {
+		pkgs = append(pkgs, importPath)
+	}
+	sort.Strings(pkgs)
+	for _, importPath := range pkgs {
+		var names []string
+		for name := range r[importPath] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&buf, "%s %s %s\n", importPath, name, r[importPath][name])
+		}
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseOutput(t *testing.T) {
+	out := `=== RUN   TestA
+--- PASS: TestA (0.00s)
+=== RUN   TestB
+=== RUN   TestB/sub
+    --- FAIL: TestB/sub (0.01s)
+--- FAIL: TestB (0.01s)
+=== RUN   TestC
+    c_test.go:10: not supported by GopherJS
+--- SKIP: TestC (0.00s)
+FAIL
+`
+	got, err := parseOutput(strings.NewReader(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"TestA": pass, "TestB": fail, "TestB/sub": fail, "TestC": skip}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseOutput() = %v, want %v", got, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	golden := results{
+		"bytes":   {"TestA": pass, "TestB": pass, "TestC": fail, "TestD": pass},
+		"strings": {"TestA": pass},
+	}
+	current := results{
+		"bytes": {"TestA": pass, "TestB": skip, "TestC": pass, "TestE": pass},
+	}
+	regressions, fixes := compare(golden, current)
+	if want := []string{"bytes TestB (skip)", "bytes TestD (didn't run)"}; !sameElements(regressions, want) {
+		t.Errorf("compare() returned regressions %q, want %q", regressions, want)
+	}
+	if want := []string{"bytes TestC", "bytes TestE"}; !sameElements(fixes, want) {
+		t.Errorf("compare() returned fixes %q, want %q", fixes, want)
+	}
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stdconformance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "manifest.txt")
+
+	want := results{"bytes": {"TestA": pass, "TestB/sub": fail}, "strings": {"TestC": skip}}
+	if err := writeManifest(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readManifest(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readManifest() = %v, want %v", got, want)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("bytes TestA passed\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readManifest(path); err == nil {
+		t.Error("readManifest() returned no error for an invalid outcome")
+	}
+}
+
+func sameElements(a, b []string) bool {
+	m := map[string]int{}
+	for _, s := range a {
+		m[s]++
+	}
+	for _, s := range b {
+		m[s]--
+	}
+	for _, n := range m {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,308 @@
+// Package webrtc provides net.Conn bindings for WebRTC data channels.
+//
+// The package doesn't deal with signalling: create an RTCPeerConnection and
+// exchange session descriptions and ICE candidates with the remote peer using
+// any suitable mechanism, then use Dial on one side and a Listener on the
+// other to establish a connection:
+//
+//  pc := js.Global.Get("RTCPeerConnection").New(config)
+//  l := webrtc.Listen(pc)
+//  ... // Signalling.
+//  conn, err := l.Accept()
+//
+// Data channels created by Dial are reliable and ordered, so the connection
+// behaves as a byte stream, similar to a TCP connection.
+package webrtc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+const (
+	// maxMessageSize is the largest message sent over a data channel. Larger
+	// writes are split. 16 KiB is the largest size supported by all browsers.
+	maxMessageSize = 16 * 1024
+	// maxBufferedAmount is the amount of data queued by the data channel after
+	// which writes block until the queue drains.
+	maxBufferedAmount = 1024 * 1024
+)
+
+// Addr is the address of a data channel connection, which is the channel label.
+type Addr string
+
+// Network returns "webrtc".
+func (a Addr) Network() string { return "webrtc" }
+
+func (a Addr) String() string { return string(a) }
+
+// Conn is a net.Conn over an RTCDataChannel.
+type Conn struct {
+	dc *js.Object
+
+	queue [][]byte
+	err   error // Terminal error, returned once the queue is drained.
+	// readReady and writeReady signal state changes to blocked readers and
+	// writers respectively.
+	readReady  chan struct{}
+	writeReady chan struct{}
+
+	readDeadline  time.Time
+	writeDeadline time.Time
+}
+
+var _ net.Conn = (*Conn)(nil)
+
+// NewConn returns a connection over the RTCDataChannel dc, waiting for it to
+// open if needed. Messages received on the channel before NewConn is called
+// are lost.
+func NewConn(ctx context.Context, dc *js.Object) (*Conn, error) {
+	c := &Conn{
+		dc:         dc,
+		readReady:  make(chan struct{}, 1),
+		writeReady: make(chan struct{}, 1),
+	}
+	dc.Set("binaryType", "arraybuffer")
+	dc.Set("bufferedAmountLowThreshold", maxBufferedAmount/2)
+	dc.Set("onmessage", func(event *js.Object) {
+		data := event.Get("data")
+		if js.Global.Get("ArrayBuffer").Get("prototype").Call("isPrototypeOf", data).Bool() {
+			c.queue = append(c.queue, js.Global.Get("Uint8Array").New(data).Interface().([]byte))
+		} else {
+			// Text messages sent by non-Go peers.
+			c.queue = append(c.queue, []byte(data.String()))
+		}
+		notify(c.readReady)
+	})
+	dc.Set("onbufferedamountlow", func() { notify(c.writeReady) })
+	dc.Set("onerror", func(event *js.Object) {
+		c.fail(jsError(event.Get("error")))
+	})
+	dc.Set("onclose", func() { c.fail(io.EOF) })
+
+	if state := dc.Get("readyState").String(); state != "connecting" {
+		if state != "open" {
+			return nil, errors.New("webrtc: data channel is " + state)
+		}
+		return c, nil
+	}
+	opened := make(chan struct{}, 1)
+	dc.Set("onopen", func() { notify(opened) })
+	select {
+	case <-opened:
+	case <-c.readReady:
+		// Closed or failed before opening.
+		return nil, c.err
+	case <-ctx.Done():
+		dc.Call("close")
+		return nil, ctx.Err()
+	}
+	return c, nil
+}
+
+// Dial creates a reliable, ordered data channel with the given label on the
+// RTCPeerConnection pc and returns a connection over it once it's open.
+func Dial(ctx context.Context, pc *js.Object, label string) (c *Conn, err error) {
+	defer catch(&err)
+	dc := pc.Call("createDataChannel", label, js.M{"ordered": true})
+	return NewConn(ctx, dc)
+}
+
+// notify wakes up a goroutine waiting on ch, if any. It never blocks.
+func notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// fail sets the terminal error of the connection, unless it's already set.
+func (c *Conn) fail(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+	notify(c.readReady)
+	notify(c.writeReady)
+}
+
+// wait blocks until ch is signaled or the deadline expires.
+func wait(ch chan struct{}, deadline time.Time) error {
+	if deadline.IsZero() {
+		<-ch
+		return nil
+	}
+	d := time.Until(deadline)
+	if d <= 0 {
+		return os.ErrDeadlineExceeded
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ch:
+		return nil
+	case <-t.C:
+		return os.ErrDeadlineExceeded
+	}
+}
+
+// Read reads data received from the remote peer. It returns io.EOF once the
+// data channel is closed by the remote peer and all data has been read.
+func (c *Conn) Read(p []byte) (int, error) {
+	for len(c.queue) == 0 {
+		if c.err != nil {
+			return 0, c.err
+		}
+		if err := wait(c.readReady, c.readDeadline); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.queue[0])
+	if n == len(c.queue[0]) {
+		c.queue = c.queue[1:]
+	} else {
+		c.queue[0] = c.queue[0][n:]
+	}
+	return n, nil
+}
+
+// Write sends data to the remote peer. It blocks while the amount of data
+// queued by the data channel is too large.
+func (c *Conn) Write(p []byte) (n int, err error) {
+	defer catch(&err)
+	for n < len(p) {
+		if c.err != nil {
+			return n, c.err
+		}
+		if c.dc.Get("bufferedAmount").Int() > maxBufferedAmount {
+			if err := wait(c.writeReady, c.writeDeadline); err != nil {
+				return n, err
+			}
+			continue
+		}
+		chunk := p[n:]
+		if len(chunk) > maxMessageSize {
+			chunk = chunk[:maxMessageSize]
+		}
+		// Copy the data, since it's sent asynchronously.
+		c.dc.Call("send", js.Global.Get("Uint8Array").New(chunk))
+		n += len(chunk)
+	}
+	return n, nil
+}
+
+// Close closes the data channel. Data already written is still delivered.
+func (c *Conn) Close() error {
+	if c.err == net.ErrClosed {
+		return net.ErrClosed
+	}
+	c.err = net.ErrClosed
+	c.queue = nil
+	notify(c.readReady)
+	notify(c.writeReady)
+	c.dc.Call("close")
+	return nil
+}
+
+// LocalAddr returns the data channel label.
+func (c *Conn) LocalAddr() net.Addr { return Addr(c.dc.Get("label").String()) }
+
+// RemoteAddr returns the data channel label.
+func (c *Conn) RemoteAddr() net.Addr { return Addr(c.dc.Get("label").String()) }
+
+func (c *Conn) SetDeadline(t time.Time) error {
+	c.readDeadline = t
+	c.writeDeadline = t
+	return nil
+}
+
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	c.readDeadline = t
+	return nil
+}
+
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	c.writeDeadline = t
+	return nil
+}
+
+// Listener accepts data channels created by the remote peer.
+type Listener struct {
+	pc       *js.Object
+	channels chan *js.Object
+	done     chan struct{}
+}
+
+var _ net.Listener = (*Listener)(nil)
+
+// Listen returns a listener for data channels created by the remote peer of
+// the RTCPeerConnection pc. It replaces the ondatachannel handler of pc.
+func Listen(pc *js.Object) *Listener {
+	l := &Listener{
+		pc:       pc,
+		channels: make(chan *js.Object, 16),
+		done:     make(chan struct{}),
+	}
+	pc.Set("ondatachannel", func(event *js.Object) {
+		select {
+		case l.channels <- event.Get("channel"):
+		default:
+			// Nobody is accepting connections.
+			event.Get("channel").Call("close")
+		}
+	})
+	return l
+}
+
+// Accept waits for the remote peer to create a data channel and returns a
+// connection over it.
+func (l *Listener) Accept() (net.Conn, error) {
+	select {
+	case dc := <-l.channels:
+		return NewConn(context.Background(), dc)
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops accepting data channels. Established connections are not affected.
+func (l *Listener) Close() error {
+	select {
+	case <-l.done:
+		return net.ErrClosed
+	default:
+	}
+	close(l.done)
+	l.pc.Set("ondatachannel", nil)
+	return nil
+}
+
+// Addr returns an empty address, since all data channels share the same
+// underlying peer connection.
+func (l *Listener) Addr() net.Addr { return Addr("") }
+
+func jsError(err *js.Object) error {
+	if err == nil || err == js.Undefined {
+		return errors.New("webrtc: data channel error")
+	}
+	return errors.New("webrtc: " + err.Get("message").String())
+}
+
+// catch converts JavaScript exceptions, which GopherJS turns into panics, into
+// errors.
+func catch(err *error) {
+	e := recover()
+	if e == nil {
+		return
+	}
+	if jsErr, ok := e.(*js.Error); ok {
+		*err = jsError(jsErr.Object)
+		return
+	}
+	panic(e)
+}
@@ -0,0 +1,114 @@
+// +build js
+
+package webrtc
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// fakePeers returns a pair of objects that mimic connected RTCPeerConnections:
+// data channels created by one of them are announced to the other.
+var fakePeers = js.Global.Call("eval", `(function() {
+  var channel = function(label) {
+    return {
+      label: label, readyState: "connecting", bufferedAmount: 0,
+      send: function(data) {
+        if (this.readyState !== "open") { throw new Error("InvalidStateError"); }
+        var remote = this.remote;
+        var copy = data instanceof Uint8Array ? data.slice().buffer : data;
+        setTimeout(function() { remote.onmessage({data: copy}); }, 0);
+      },
+      close: function() {
+        if (this.readyState === "closed") { return; }
+        var self = this, remote = this.remote;
+        this.readyState = remote.readyState = "closed";
+        setTimeout(function() { self.onclose(); remote.onclose(); }, 0);
+      }
+    };
+  };
+  var peer = function() {
+    return {
+      createDataChannel: function(label) {
+        var local = channel(label), remote = channel(label), other = this.other;
+        local.remote = remote;
+        remote.remote = local;
+        setTimeout(function() {
+          local.readyState = remote.readyState = "open";
+          other.ondatachannel({channel: remote});
+          local.onopen();
+        }, 0);
+        return local;
+      }
+    };
+  };
+  return function() {
+    var a = peer(), b = peer();
+    a.other = b;
+    b.other = a;
+    return [a, b];
+  };
+})()`)
+
+func connect(t *testing.T) (client, server net.Conn) {
+	t.Helper()
+	peers := fakePeers.Invoke()
+	l := Listen(peers.Index(1))
+	defer l.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			t.Errorf("Accept() returned error: %s", err)
+		}
+		accepted <- conn
+	}()
+	client, err := Dial(context.Background(), peers.Index(0), "test")
+	if err != nil {
+		t.Fatalf("Dial() returned error: %s", err)
+	}
+	return client, <-accepted
+}
+
+func TestConn(t *testing.T) {
+	client, server := connect(t)
+	if got := client.LocalAddr().String(); got != "test" {
+		t.Errorf("Got local address %q, want %q", got, "test")
+	}
+
+	// Larger than a single message.
+	want := strings.Repeat("0123456789", 5000)
+	go func() {
+		if _, err := io.WriteString(client, want); err != nil {
+			t.Errorf("Write() returned error: %s", err)
+		}
+		client.Close()
+	}()
+	got, err := io.ReadAll(server)
+	if err != nil {
+		t.Fatalf("ReadAll() returned error: %s", err)
+	}
+	if string(got) != want {
+		t.Errorf("Got %d bytes, want %d bytes", len(got), len(want))
+	}
+
+	if _, err := client.Write([]byte("x")); err != net.ErrClosed {
+		t.Errorf("Write() after Close() returned error %v, want %v", err, net.ErrClosed)
+	}
+}
+
+func TestConnDeadline(t *testing.T) {
+	_, server := connect(t)
+	server.SetReadDeadline(time.Now().Add(10 * time.Millisecond))
+	if _, err := server.Read(make([]byte, 1)); err != os.ErrDeadlineExceeded {
+		t.Errorf("Read() returned error %v, want %v", err, os.ErrDeadlineExceeded)
+	}
+}